@@ -83,6 +83,17 @@ const (
 	ClusterLastInstallationPreparationPending           string                             = "Cluster preparation has never been performed for this cluster"
 	ClusterLastInstallationPreparationFailedCondition   hivev1.ClusterInstallConditionType = "LastInstallationPreparationFailed"
 
+	ClusterSpokeClusterVersionHealthyCondition   hivev1.ClusterInstallConditionType = "SpokeClusterVersionHealthy"
+	ClusterSpokeClusterOperatorsHealthyCondition hivev1.ClusterInstallConditionType = "SpokeClusterOperatorsHealthy"
+	ClusterSpokeNodesReadyCondition              hivev1.ClusterInstallConditionType = "SpokeNodesReady"
+	ClusterSpokeCertificatesValidCondition       hivev1.ClusterInstallConditionType = "SpokeCertificatesValid"
+
+	ClusterSpokeHealthyReason              string = "SpokeHealthy"
+	ClusterSpokeDegradedReason             string = "SpokeDegraded"
+	ClusterSpokeCertificatesExpiringReason string = "SpokeCertificatesExpiring"
+	ClusterSpokeUnreachableReason          string = "SpokeUnreachable"
+	ClusterSpokeUnreachableMsg             string = "The health of the installed cluster could not be checked:"
+
 	ClusterConsumerLabel string = "agentclusterinstalls.agent-install.openshift.io/consumer"
 )
 
@@ -220,6 +231,34 @@ type AgentClusterInstallSpec struct {
 	// LoadBalancer defines the load balancer used by the cluster for ingress traffic.
 	// +optional
 	LoadBalancer *LoadBalancer `json:"loadBalancer,omitempty"`
+
+	// CSRApprovalPolicy restricts the certificate signing requests of the nodes of the cluster that are automatically
+	// approved by the assisted-service when nodes are added to the cluster.
+	// +optional
+	CSRApprovalPolicy *CSRApprovalPolicy `json:"csrApprovalPolicy,omitempty"`
+}
+
+// CSRApprovalPolicy defines the rules that a certificate signing request of a node must satisfy to be automatically
+// approved. Certificate signing requests that don't satisfy them are left pending for manual approval.
+type CSRApprovalPolicy struct {
+	// RequireBareMetalHost requires the agent of the node to have a matching BareMetalHost.
+	// +optional
+	RequireBareMetalHost bool `json:"requireBareMetalHost,omitempty"`
+
+	// RestrictIPsToMachineNetworks requires the IP addresses of a serving certificate signing request to be
+	// within the machine networks of the cluster.
+	// +optional
+	RestrictIPsToMachineNetworks bool `json:"restrictIPsToMachineNetworks,omitempty"`
+
+	// ApprovalWindow is the duration after the first approval attempt for the node during which its certificate
+	// signing requests are approved.
+	// +optional
+	ApprovalWindow *metav1.Duration `json:"approvalWindow,omitempty"`
+
+	// MaxCSRsPerNode is the maximum number of certificate signing requests that are approved for a node.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxCSRsPerNode int `json:"maxCSRsPerNode,omitempty"`
 }
 
 // IgnitionEndpoint stores the data to of the custom ignition endpoint.
@@ -24,6 +24,7 @@ import (
 	"github.com/openshift/assisted-service/api/common"
 	"github.com/openshift/hive/apis/hive/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -167,6 +168,11 @@ func (in *AgentClusterInstallSpec) DeepCopyInto(out *AgentClusterInstallSpec) {
 		*out = new(LoadBalancer)
 		**out = **in
 	}
+	if in.CSRApprovalPolicy != nil {
+		in, out := &in.CSRApprovalPolicy, &out.CSRApprovalPolicy
+		*out = new(CSRApprovalPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentClusterInstallSpec.
@@ -254,6 +260,26 @@ func (in *AgentMachinePool) DeepCopy() *AgentMachinePool {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSRApprovalPolicy) DeepCopyInto(out *CSRApprovalPolicy) {
+	*out = *in
+	if in.ApprovalWindow != nil {
+		in, out := &in.ApprovalWindow, &out.ApprovalWindow
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSRApprovalPolicy.
+func (in *CSRApprovalPolicy) DeepCopy() *CSRApprovalPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CSRApprovalPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CaCertificateReference) DeepCopyInto(out *CaCertificateReference) {
 	*out = *in
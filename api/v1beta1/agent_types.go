@@ -92,6 +92,19 @@ const (
 
 	CleanupCondition    conditionsv1.ConditionType = "Cleanup"
 	CleanupFailedReason string                     = "CleanupFailed"
+
+	DecommissionedCondition        conditionsv1.ConditionType = "Decommissioned"
+	DecommissionNotInstalledReason string                     = "NotInstalled"
+	DecommissionNotInstalledMsg    string                     = "The agent can be decommissioned only after it is installed in a cluster"
+	DecommissionDrainingReason     string                     = "Draining"
+	DecommissionDrainingMsg        string                     = "The node of the agent is being cordoned and drained"
+	DecommissionRemovingNodeReason string                     = "RemovingNode"
+	DecommissionRemovingNodeMsg    string                     = "The node of the agent and its certificate signing requests are being removed from the cluster"
+	DecommissionUnbindingReason    string                     = "Unbinding"
+	DecommissionUnbindingMsg       string                     = "The agent is being unbound from the cluster deployment"
+	DecommissionedReason           string                     = "Decommissioned"
+	DecommissionedMsg              string                     = "The agent was decommissioned and unbound from the cluster deployment"
+	DecommissionedDisksNotWipedMsg string                     = "The agent was decommissioned and unbound from the cluster deployment. Its disks were not wiped, as the host is not rebooted into discovery"
 )
 
 type HostMemory struct {
@@ -219,6 +232,16 @@ type AgentSpec struct {
 	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
 	// FencingCredentialsSecretRef is a name of a secret in the Agent's namespace that contains fencing credentials
 	FencingCredentialsSecretRef string `json:"fencingCredentialsSecretRef,omitempty"`
+	// Decommission removes the node of an installed agent from its cluster and unbinds the agent from the cluster
+	// deployment. The node is cordoned and drained, it is deleted with its certificate signing requests, and the
+	// host is rebooted into discovery when it can be reclaimed.
+	// +optional
+	Decommission bool `json:"decommission,omitempty"`
+	// WipeDisksOnDecommission wipes the disks of the host, other than the installation disk, before the host is
+	// rebooted into discovery when the agent is decommissioned. The disks are not wiped when the host is not rebooted
+	// into discovery, e.g. when it has a BareMetalHost, and the Decommissioned condition says so.
+	// +optional
+	WipeDisksOnDecommission bool `json:"wipeDisksOnDecommission,omitempty"`
 }
 
 type IgnitionEndpointTokenReference struct {
@@ -327,6 +350,21 @@ type CSRStatus struct {
 
 	// Last time we attempted a CSR approval
 	LastApprovalAttempt metav1.Time `json:"lastApprovalAttempt,omitempty"`
+
+	// First time we attempted a CSR approval, the start of the approval window of the CSR approval policy
+	ApprovalStartedAt metav1.Time `json:"approvalStartedAt,omitempty"`
+
+	// The most recent decisions taken for the CSRs of the agent
+	Decisions []CSRDecision `json:"decisions,omitempty"`
+}
+
+// CSRDecision records why a CSR of the agent was approved or denied by the assisted-service
+type CSRDecision struct {
+	Name     string      `json:"name"`
+	Type     CSRType     `json:"type"`
+	Approved bool        `json:"approved"`
+	Reason   string      `json:"reason"`
+	Time     metav1.Time `json:"time"`
 }
 
 // +kubebuilder:object:root=true
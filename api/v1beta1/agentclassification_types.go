@@ -27,6 +27,23 @@ const (
 	QueryHasErrorsReason string                     = "HasQueryErrors"
 )
 
+// AgentClassificationLabel is an additional label to apply to matched Agents
+type AgentClassificationLabel struct {
+	// Key specifies the label key
+	Key string `json:"key"`
+
+	// Value specifies the label value. Exactly one of Value and ValueQuery must be set.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// ValueQuery is in gojq format (https://github.com/itchyny/gojq#difference-to-jq)
+	// and will be invoked on the inventory of each matched Agent. The query
+	// should return a string, a number or a boolean, which is used as the
+	// label value.
+	// +optional
+	ValueQuery string `json:"valueQuery,omitempty"`
+}
+
 // AgentClassificationSpec defines the desired state of AgentClassification
 type AgentClassificationSpec struct {
 	// LabelKey specifies the label key to apply to matched Agents
@@ -44,6 +61,25 @@ type AgentClassificationSpec struct {
 	// boolean. The operator will apply the label to any Agent for which "true"
 	// is returned.
 	Query string `json:"query"`
+
+	// AdditionalLabels specifies more labels to apply to matched Agents,
+	// with static or computed values
+	//
+	// +immutable
+	// +optional
+	AdditionalLabels []AgentClassificationLabel `json:"additionalLabels,omitempty"`
+
+	// Priority decides which classification applies a label when several
+	// matching classifications specify the same label key. The classification
+	// with the highest priority applies the label, and classifications with
+	// the same priority are ordered by name.
+	// +optional
+	Priority int `json:"priority,omitempty"`
+
+	// DryRun specifies that the labels are not applied. The Agents that match
+	// the classification are listed in the status instead.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
 }
 
 // AgentClassificationStatus defines the observed state of AgentClassification
@@ -54,6 +90,9 @@ type AgentClassificationStatus struct {
 	// ErrorCount shows how many Agents encountered errors when matching the classification
 	ErrorCount int `json:"errorCount,omitempty"`
 
+	// MatchingAgents lists the Agents that match the classification when it is a dry run
+	MatchingAgents []string `json:"matchingAgents,omitempty"`
+
 	Conditions []conditionsv1.Condition `json:"conditions,omitempty"`
 }
 
@@ -19,6 +19,7 @@ package v1beta1
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/itchyny/gojq"
@@ -68,6 +69,7 @@ func (r *AgentClassification) ValidateCreate(ctx context.Context, obj runtime.Ob
 	if err != nil {
 		errs = append(errs, field.Invalid(f, agentClassification.Spec.Query, err.Error()))
 	}
+	errs = append(errs, validateAdditionalLabels(&agentClassification.Spec, f.Child("additionalLabels"))...)
 
 	if len(errs) > 0 {
 		err := fmt.Errorf("Validation failed: %s", errs.ToAggregate().Error())
@@ -92,7 +94,8 @@ func (r *AgentClassification) ValidateUpdate(ctx context.Context, oldObj, newObj
 	}
 
 	// Validate that the label key and value haven't changed
-	if (oldAgentClassification.Spec.LabelKey != agentClassification.Spec.LabelKey) || (oldAgentClassification.Spec.LabelValue != agentClassification.Spec.LabelValue) {
+	if (oldAgentClassification.Spec.LabelKey != agentClassification.Spec.LabelKey) || (oldAgentClassification.Spec.LabelValue != agentClassification.Spec.LabelValue) ||
+		!reflect.DeepEqual(oldAgentClassification.Spec.AdditionalLabels, agentClassification.Spec.AdditionalLabels) {
 		return nil, fmt.Errorf("Label modified: the specified label may not be modified after creation")
 	}
 
@@ -105,3 +108,32 @@ func (r *AgentClassification) ValidateUpdate(ctx context.Context, oldObj, newObj
 func (r *AgentClassification) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	return nil, nil
 }
+
+// validateAdditionalLabels validates that every additional label has a valid and unique key, and exactly one of a valid
+// value and a query that can be parsed
+func validateAdditionalLabels(spec *AgentClassificationSpec, f *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	keys := map[string]bool{spec.LabelKey: true}
+	for i, label := range spec.AdditionalLabels {
+		labelPath := f.Index(i)
+		if keys[label.Key] {
+			errs = append(errs, field.Duplicate(labelPath.Child("key"), label.Key))
+		}
+		keys[label.Key] = true
+		switch {
+		case (label.Value == "") == (label.ValueQuery == ""):
+			errs = append(errs, field.Invalid(labelPath, label.Key, "exactly one of value and valueQuery must be set"))
+		case label.ValueQuery != "":
+			errs = append(errs, validation.ValidateLabels(map[string]string{ClassificationLabelPrefix + label.Key: ""}, labelPath)...)
+			if _, err := gojq.Parse(label.ValueQuery); err != nil {
+				errs = append(errs, field.Invalid(labelPath.Child("valueQuery"), label.ValueQuery, err.Error()))
+			}
+		default:
+			errs = append(errs, validation.ValidateLabels(map[string]string{ClassificationLabelPrefix + label.Key: label.Value}, labelPath)...)
+			if strings.HasPrefix(label.Value, "QUERYERROR") {
+				errs = append(errs, field.Invalid(labelPath.Child("value"), label.Value, "label must not start with QUERYERROR as this is reserved"))
+			}
+		}
+	}
+	return errs
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	AgentPoolSatisfiedCondition       conditionsv1.ConditionType = "Satisfied"
+	AgentPoolSatisfiedReason          string                     = "Satisfied"
+	AgentPoolInsufficientAgentsReason string                     = "InsufficientAgents"
+	AgentPoolInvalidSelectorReason    string                     = "InvalidSelector"
+)
+
+// AgentPoolHardwareConstraints are the minimal hardware of the Agents that are selected by the pool
+type AgentPoolHardwareConstraints struct {
+	// MinCPUCores is the minimal number of CPU cores of the Agents
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinCPUCores int64 `json:"minCPUCores,omitempty"`
+
+	// MinMemoryMiB is the minimal physical memory of the Agents, in MiB
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinMemoryMiB int64 `json:"minMemoryMiB,omitempty"`
+
+	// MinDiskSizeGB is the minimal size of a disk of the Agents that is eligible for installation, in GB
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinDiskSizeGB int64 `json:"minDiskSizeGB,omitempty"`
+
+	// Architecture is the CPU architecture of the Agents
+	// +optional
+	Architecture string `json:"architecture,omitempty"`
+}
+
+// AgentPoolSpec defines the desired state of AgentPool
+type AgentPoolSpec struct {
+	// ClusterDeploymentName is the cluster that the Agents of the pool are bound to
+	ClusterDeploymentName ClusterReference `json:"clusterDeploymentName"`
+
+	// AgentSelector selects the Agents of the namespace of the pool by their labels, including the labels that
+	// are applied by AgentClassifications
+	AgentSelector metav1.LabelSelector `json:"agentSelector"`
+
+	// Masters is the number of Agents that are bound with the master role
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Masters int `json:"masters,omitempty"`
+
+	// Workers is the number of Agents that are bound with the worker role
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Workers int `json:"workers,omitempty"`
+
+	// HardwareConstraints are the minimal hardware of the Agents
+	// +optional
+	HardwareConstraints *AgentPoolHardwareConstraints `json:"hardwareConstraints,omitempty"`
+}
+
+// AgentPoolStatus defines the observed state of AgentPool
+type AgentPoolStatus struct {
+	// Masters is the number of Agents of the pool that are bound with the master role
+	Masters int `json:"masters,omitempty"`
+
+	// Workers is the number of Agents of the pool that are bound with the worker role
+	Workers int `json:"workers,omitempty"`
+
+	Conditions []conditionsv1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Masters",type="integer",JSONPath=".status.masters"
+//+kubebuilder:printcolumn:name="Workers",type="integer",JSONPath=".status.workers"
+
+// AgentPool is the Schema for the AgentPools API
+type AgentPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AgentPoolSpec   `json:"spec,omitempty"`
+	Status AgentPoolStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AgentPoolList contains a list of AgentPool
+type AgentPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AgentPool `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AgentPool{}, &AgentPoolList{})
+}
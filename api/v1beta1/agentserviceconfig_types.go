@@ -52,6 +52,69 @@ type MustGatherImage struct {
 	Url string `json:"url"`
 }
 
+// PrewarmRelease defines a release image whose installer is extracted
+// into the installer cache ahead of demand.
+type PrewarmRelease struct {
+	// ReleaseImage is the release image to extract the installer from.
+	ReleaseImage string `json:"releaseImage"`
+	// Pinned prevents the installer of the release from being evicted
+	// from the installer cache.
+	// +optional
+	Pinned bool `json:"pinned,omitempty"`
+}
+
+// CustomStep defines a step that the agent runs as a container on the hosts
+// in the given states, in addition to the built-in steps.
+type CustomStep struct {
+	// Name identifies the step and its result on the host. It must consist of
+	// lower case alphanumeric characters or '-'.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`
+	Name string `json:"name"`
+	// Image is the container image that the agent runs.
+	Image string `json:"image"`
+	// Args are the arguments of the container.
+	// +optional
+	Args []string `json:"args,omitempty"`
+	// TimeoutSeconds is the time after which the agent stops the container.
+	// Defaults to 60 seconds.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty"`
+	// States are the host states in which the step runs.
+	// +kubebuilder:validation:MinItems=1
+	States []string `json:"states"`
+	// ReplySchema is a JSON schema that the output of the container must
+	// match for the step to succeed.
+	// +optional
+	ReplySchema string `json:"replySchema,omitempty"`
+	// Required steps fail the custom-steps-succeeded host validation until
+	// they succeed.
+	// +optional
+	Required bool `json:"required,omitempty"`
+}
+
+// UserValidation defines a host or cluster validation as a jq expression,
+// which is evaluated alongside the built-in validations.
+type UserValidation struct {
+	// ID identifies the validation in the validations info and in the
+	// ignored validations. It must consist of lower case alphanumeric
+	// characters or '-'.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`
+	ID string `json:"id"`
+	// Target is the kind of object that the validation is evaluated for.
+	// +kubebuilder:validation:Enum=host;cluster
+	Target string `json:"target"`
+	// Expression is a jq expression that returns true when the validation
+	// passes.
+	Expression string `json:"expression"`
+	// SuccessMessage is the message of the validation when it passes.
+	// +optional
+	SuccessMessage string `json:"successMessage,omitempty"`
+	// FailureMessage is the message of the validation when it fails.
+	// +optional
+	FailureMessage string `json:"failureMessage,omitempty"`
+}
+
 // AgentServiceConfigSpec defines the desired state of AgentServiceConfig.
 type AgentServiceConfigSpec struct {
 	// FileSystemStorage defines the spec of the PersistentVolumeClaim to be
@@ -91,6 +154,31 @@ type AgentServiceConfigSpec struct {
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Must-Gather Images"
 	MustGatherImages []MustGatherImage `json:"mustGatherImages,omitempty"`
 
+	// PrewarmReleases defines a collection of release images whose installers
+	// are extracted into the installer cache when the assisted-service starts,
+	// so that the first cluster to install them doesn't wait for the extraction
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Pre-warmed Releases"
+	// +optional
+	PrewarmReleases []PrewarmRelease `json:"prewarmReleases,omitempty"`
+
+	// PrewarmPullSecretRef is a reference to a secret whose .dockerconfigjson
+	// key holds the pull secret used to pull the pre-warmed release images
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Pre-warmed Releases Pull Secret reference"
+	// +optional
+	PrewarmPullSecretRef *corev1.LocalObjectReference `json:"prewarmPullSecretRef,omitempty"`
+
+	// CustomSteps defines a collection of steps that the agent runs as
+	// containers on the hosts, whose results are stored on the hosts
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Custom Agent Steps"
+	// +optional
+	CustomSteps []CustomStep `json:"customSteps,omitempty"`
+
+	// UserValidations defines a collection of host and cluster validations
+	// that are evaluated alongside the built-in validations
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="User-defined Validations"
+	// +optional
+	UserValidations []UserValidation `json:"userValidations,omitempty"`
+
 	// IPXEHTTPRoute is controlling whether the operator is creating plain HTTP routes
 	// iPXE hosts may not work with router cyphers and may access artifacts via HTTP only
 	// This setting accepts "enabled,disabled", defaults to disabled. Empty value defaults to disabled
@@ -0,0 +1,126 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	ClusterTemplateSyncedCondition    conditionsv1.ConditionType = "Synced"
+	ClusterTemplateSyncedReason       string                     = "SyncOK"
+	ClusterTemplateInvalidReason      string                     = "InvalidTemplate"
+	ClusterTemplateBackendErrorReason string                     = "BackendError"
+)
+
+// ClusterTemplateParameter is a value that is set when a cluster is registered from the template, and is referenced
+// as ${name} in the strings of the template
+type ClusterTemplateParameter struct {
+	// Name of the parameter
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z_][a-zA-Z0-9_]*$`
+	Name string `json:"name"`
+
+	// Description of the parameter
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Default is the value of the parameter when it is not set
+	// +optional
+	Default *string `json:"default,omitempty"`
+
+	// Required parameters must be set when no default is defined
+	// +optional
+	Required bool `json:"required,omitempty"`
+}
+
+// ClusterTemplateManifest is a custom manifest that is added to the clusters registered from the template
+type ClusterTemplateManifest struct {
+	// Folder of the manifest, manifests or openshift
+	// +kubebuilder:validation:Enum=manifests;openshift
+	// +optional
+	Folder string `json:"folder,omitempty"`
+
+	// FileName of the manifest
+	FileName string `json:"fileName"`
+
+	// Content of the manifest, in plain text
+	Content string `json:"content"`
+}
+
+// ClusterTemplateSpec defines the desired state of ClusterTemplate
+type ClusterTemplateSpec struct {
+	// Description of the template
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Parameters of the template
+	// +optional
+	Parameters []ClusterTemplateParameter `json:"parameters,omitempty"`
+
+	// ClusterParams are the cluster-create-params of the REST API that are set on registration, using the
+	// same property names. The pull secret is set on registration and may not be part of the template.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	ClusterParams *runtime.RawExtension `json:"clusterParams,omitempty"`
+
+	// InfraEnvParams are the infra-env-create-params of the REST API of an infra-env that is created with the
+	// cluster, using the same property names. No infra-env is created when they are not set.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	InfraEnvParams *runtime.RawExtension `json:"infraEnvParams,omitempty"`
+
+	// Manifests that are added to the clusters registered from the template
+	// +optional
+	Manifests []ClusterTemplateManifest `json:"manifests,omitempty"`
+}
+
+// ClusterTemplateStatus defines the observed state of ClusterTemplate
+type ClusterTemplateStatus struct {
+	// TemplateID is the ID that is used to register clusters from the template through the REST API
+	// +optional
+	TemplateID string `json:"templateID,omitempty"`
+
+	Conditions []conditionsv1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Template ID",type="string",JSONPath=".status.templateID"
+
+// ClusterTemplate is the Schema for the ClusterTemplates API
+type ClusterTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterTemplateSpec   `json:"spec,omitempty"`
+	Status ClusterTemplateStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClusterTemplateList contains a list of ClusterTemplate
+type ClusterTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterTemplate{}, &ClusterTemplateList{})
+}
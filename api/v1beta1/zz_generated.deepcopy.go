@@ -76,7 +76,7 @@ func (in *AgentClassification) DeepCopyInto(out *AgentClassification) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -98,6 +98,21 @@ func (in *AgentClassification) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentClassificationLabel) DeepCopyInto(out *AgentClassificationLabel) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentClassificationLabel.
+func (in *AgentClassificationLabel) DeepCopy() *AgentClassificationLabel {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentClassificationLabel)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentClassificationList) DeepCopyInto(out *AgentClassificationList) {
 	*out = *in
@@ -133,6 +148,11 @@ func (in *AgentClassificationList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentClassificationSpec) DeepCopyInto(out *AgentClassificationSpec) {
 	*out = *in
+	if in.AdditionalLabels != nil {
+		in, out := &in.AdditionalLabels, &out.AdditionalLabels
+		*out = make([]AgentClassificationLabel, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentClassificationSpec.
@@ -148,6 +168,11 @@ func (in *AgentClassificationSpec) DeepCopy() *AgentClassificationSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentClassificationStatus) DeepCopyInto(out *AgentClassificationStatus) {
 	*out = *in
+	if in.MatchingAgents != nil {
+		in, out := &in.MatchingAgents, &out.MatchingAgents
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -214,6 +239,124 @@ func (in *AgentList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentPool) DeepCopyInto(out *AgentPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPool.
+func (in *AgentPool) DeepCopy() *AgentPool {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AgentPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentPoolHardwareConstraints) DeepCopyInto(out *AgentPoolHardwareConstraints) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPoolHardwareConstraints.
+func (in *AgentPoolHardwareConstraints) DeepCopy() *AgentPoolHardwareConstraints {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentPoolHardwareConstraints)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentPoolList) DeepCopyInto(out *AgentPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AgentPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPoolList.
+func (in *AgentPoolList) DeepCopy() *AgentPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AgentPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentPoolSpec) DeepCopyInto(out *AgentPoolSpec) {
+	*out = *in
+	out.ClusterDeploymentName = in.ClusterDeploymentName
+	in.AgentSelector.DeepCopyInto(&out.AgentSelector)
+	if in.HardwareConstraints != nil {
+		in, out := &in.HardwareConstraints, &out.HardwareConstraints
+		*out = new(AgentPoolHardwareConstraints)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPoolSpec.
+func (in *AgentPoolSpec) DeepCopy() *AgentPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentPoolStatus) DeepCopyInto(out *AgentPoolStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPoolStatus.
+func (in *AgentPoolStatus) DeepCopy() *AgentPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentServiceConfig) DeepCopyInto(out *AgentServiceConfig) {
 	*out = *in
@@ -298,6 +441,28 @@ func (in *AgentServiceConfigSpec) DeepCopyInto(out *AgentServiceConfigSpec) {
 		*out = make([]MustGatherImage, len(*in))
 		copy(*out, *in)
 	}
+	if in.PrewarmReleases != nil {
+		in, out := &in.PrewarmReleases, &out.PrewarmReleases
+		*out = make([]PrewarmRelease, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrewarmPullSecretRef != nil {
+		in, out := &in.PrewarmPullSecretRef, &out.PrewarmPullSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.CustomSteps != nil {
+		in, out := &in.CustomSteps, &out.CustomSteps
+		*out = make([]CustomStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UserValidations != nil {
+		in, out := &in.UserValidations, &out.UserValidations
+		*out = make([]UserValidation, len(*in))
+		copy(*out, *in)
+	}
 	if in.UnauthenticatedRegistries != nil {
 		in, out := &in.UnauthenticatedRegistries, &out.UnauthenticatedRegistries
 		*out = make([]string, len(*in))
@@ -465,6 +630,22 @@ func (in *BootArtifacts) DeepCopy() *BootArtifacts {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSRDecision) DeepCopyInto(out *CSRDecision) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSRDecision.
+func (in *CSRDecision) DeepCopy() *CSRDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(CSRDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CSRInfo) DeepCopyInto(out *CSRInfo) {
 	*out = *in
@@ -492,6 +673,14 @@ func (in *CSRStatus) DeepCopyInto(out *CSRStatus) {
 		}
 	}
 	in.LastApprovalAttempt.DeepCopyInto(&out.LastApprovalAttempt)
+	in.ApprovalStartedAt.DeepCopyInto(&out.ApprovalStartedAt)
+	if in.Decisions != nil {
+		in, out := &in.Decisions, &out.Decisions
+		*out = make([]CSRDecision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSRStatus.
@@ -519,6 +708,184 @@ func (in *ClusterReference) DeepCopy() *ClusterReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplate) DeepCopyInto(out *ClusterTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplate.
+func (in *ClusterTemplate) DeepCopy() *ClusterTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateList) DeepCopyInto(out *ClusterTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateList.
+func (in *ClusterTemplateList) DeepCopy() *ClusterTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateManifest) DeepCopyInto(out *ClusterTemplateManifest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateManifest.
+func (in *ClusterTemplateManifest) DeepCopy() *ClusterTemplateManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateParameter) DeepCopyInto(out *ClusterTemplateParameter) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateParameter.
+func (in *ClusterTemplateParameter) DeepCopy() *ClusterTemplateParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateSpec) DeepCopyInto(out *ClusterTemplateSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]ClusterTemplateParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ClusterParams != nil {
+		in, out := &in.ClusterParams, &out.ClusterParams
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InfraEnvParams != nil {
+		in, out := &in.InfraEnvParams, &out.InfraEnvParams
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Manifests != nil {
+		in, out := &in.Manifests, &out.Manifests
+		*out = make([]ClusterTemplateManifest, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateSpec.
+func (in *ClusterTemplateSpec) DeepCopy() *ClusterTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateStatus) DeepCopyInto(out *ClusterTemplateStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateStatus.
+func (in *ClusterTemplateStatus) DeepCopy() *ClusterTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomStep) DeepCopyInto(out *CustomStep) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.States != nil {
+		in, out := &in.States, &out.States
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomStep.
+func (in *CustomStep) DeepCopy() *CustomStep {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DebugInfo) DeepCopyInto(out *DebugInfo) {
 	*out = *in
@@ -1240,6 +1607,21 @@ func (in *OSImage) DeepCopy() *OSImage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrewarmRelease) DeepCopyInto(out *PrewarmRelease) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrewarmRelease.
+func (in *PrewarmRelease) DeepCopy() *PrewarmRelease {
+	if in == nil {
+		return nil
+	}
+	out := new(PrewarmRelease)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Proxy) DeepCopyInto(out *Proxy) {
 	*out = *in
@@ -1273,3 +1655,18 @@ func (in RawNetConfig) DeepCopy() RawNetConfig {
 	in.DeepCopyInto(out)
 	return *out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserValidation) DeepCopyInto(out *UserValidation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserValidation.
+func (in *UserValidation) DeepCopy() *UserValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(UserValidation)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -13,11 +13,17 @@ import (
 	rtclient "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 
+	"github.com/openshift/assisted-service/client/cluster_archives"
+	"github.com/openshift/assisted-service/client/cluster_plan"
+	"github.com/openshift/assisted-service/client/cluster_revisions"
+	"github.com/openshift/assisted-service/client/cluster_templates"
 	"github.com/openshift/assisted-service/client/events"
 	"github.com/openshift/assisted-service/client/installer"
+	"github.com/openshift/assisted-service/client/installer_cache"
 	"github.com/openshift/assisted-service/client/managed_domains"
 	"github.com/openshift/assisted-service/client/manifests"
 	"github.com/openshift/assisted-service/client/operators"
+	"github.com/openshift/assisted-service/client/subscriptions"
 	"github.com/openshift/assisted-service/client/versions"
 )
 
@@ -63,22 +69,34 @@ func New(c Config) *AssistedInstall {
 
 	cli := new(AssistedInstall)
 	cli.Transport = transport
+	cli.ClusterArchives = cluster_archives.New(transport, strfmt.Default, c.AuthInfo)
+	cli.ClusterPlan = cluster_plan.New(transport, strfmt.Default, c.AuthInfo)
+	cli.ClusterRevisions = cluster_revisions.New(transport, strfmt.Default, c.AuthInfo)
+	cli.ClusterTemplates = cluster_templates.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Events = events.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Installer = installer.New(transport, strfmt.Default, c.AuthInfo)
+	cli.InstallerCache = installer_cache.New(transport, strfmt.Default, c.AuthInfo)
 	cli.ManagedDomains = managed_domains.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Manifests = manifests.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Operators = operators.New(transport, strfmt.Default, c.AuthInfo)
+	cli.Subscriptions = subscriptions.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Versions = versions.New(transport, strfmt.Default, c.AuthInfo)
 	return cli
 }
 
 // AssistedInstall is a client for assisted install
 type AssistedInstall struct {
-	Events         *events.Client
-	Installer      *installer.Client
-	ManagedDomains *managed_domains.Client
-	Manifests      *manifests.Client
-	Operators      *operators.Client
-	Versions       *versions.Client
-	Transport      runtime.ClientTransport
+	ClusterArchives  *cluster_archives.Client
+	ClusterPlan      *cluster_plan.Client
+	ClusterRevisions *cluster_revisions.Client
+	ClusterTemplates *cluster_templates.Client
+	Events           *events.Client
+	Installer        *installer.Client
+	InstallerCache   *installer_cache.Client
+	ManagedDomains   *managed_domains.Client
+	Manifests        *manifests.Client
+	Operators        *operators.Client
+	Subscriptions    *subscriptions.Client
+	Versions         *versions.Client
+	Transport        runtime.ClientTransport
 }
@@ -0,0 +1,122 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_archives
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the cluster archives client
+type API interface {
+	/*
+	   V2ExportCluster Exports the definition of a cluster to an archive that can be imported by another service. The secrets of the cluster are encrypted with the key of the service that imports the archive.*/
+	V2ExportCluster(ctx context.Context, params *V2ExportClusterParams) (*V2ExportClusterOK, error)
+	/*
+	   V2GetClusterArchiveEncryptionKey Retrieves the public key that the secrets of the archives imported by this service are encrypted with. Set it as the recipient key when exporting a cluster from another service.*/
+	V2GetClusterArchiveEncryptionKey(ctx context.Context, params *V2GetClusterArchiveEncryptionKeyParams) (*V2GetClusterArchiveEncryptionKeyOK, error)
+	/*
+	   V2ImportClusterArchive Registers a cluster, its infra-envs and its custom manifests from an archive exported by another service. The secrets of the archive must be encrypted with the key of this service.*/
+	V2ImportClusterArchive(ctx context.Context, params *V2ImportClusterArchiveParams) (*V2ImportClusterArchiveCreated, error)
+}
+
+// New creates a new cluster archives API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for cluster archives API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+V2ExportCluster Exports the definition of a cluster to an archive that can be imported by another service. The secrets of the cluster are encrypted with the key of the service that imports the archive.
+*/
+func (a *Client) V2ExportCluster(ctx context.Context, params *V2ExportClusterParams) (*V2ExportClusterOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ExportCluster",
+		Method:             "POST",
+		PathPattern:        "/v2/clusters/{cluster_id}/actions/export",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ExportClusterReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ExportClusterOK), nil
+
+}
+
+/*
+V2GetClusterArchiveEncryptionKey Retrieves the public key that the secrets of the archives imported by this service are encrypted with. Set it as the recipient key when exporting a cluster from another service.
+*/
+func (a *Client) V2GetClusterArchiveEncryptionKey(ctx context.Context, params *V2GetClusterArchiveEncryptionKeyParams) (*V2GetClusterArchiveEncryptionKeyOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetClusterArchiveEncryptionKey",
+		Method:             "GET",
+		PathPattern:        "/v2/cluster-archives/encryption-key",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetClusterArchiveEncryptionKeyReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetClusterArchiveEncryptionKeyOK), nil
+
+}
+
+/*
+V2ImportClusterArchive Registers a cluster, its infra-envs and its custom manifests from an archive exported by another service. The secrets of the archive must be encrypted with the key of this service.
+*/
+func (a *Client) V2ImportClusterArchive(ctx context.Context, params *V2ImportClusterArchiveParams) (*V2ImportClusterArchiveCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ImportClusterArchive",
+		Method:             "POST",
+		PathPattern:        "/v2/cluster-archives/import",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ImportClusterArchiveReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ImportClusterArchiveCreated), nil
+
+}
@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_archives
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2ExportClusterParams creates a new V2ExportClusterParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ExportClusterParams() *V2ExportClusterParams {
+	return &V2ExportClusterParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ExportClusterParamsWithTimeout creates a new V2ExportClusterParams object
+// with the ability to set a timeout on a request.
+func NewV2ExportClusterParamsWithTimeout(timeout time.Duration) *V2ExportClusterParams {
+	return &V2ExportClusterParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ExportClusterParamsWithContext creates a new V2ExportClusterParams object
+// with the ability to set a context for a request.
+func NewV2ExportClusterParamsWithContext(ctx context.Context) *V2ExportClusterParams {
+	return &V2ExportClusterParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ExportClusterParamsWithHTTPClient creates a new V2ExportClusterParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ExportClusterParamsWithHTTPClient(client *http.Client) *V2ExportClusterParams {
+	return &V2ExportClusterParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2ExportClusterParams contains all the parameters to send to the API endpoint
+
+	for the v2 export cluster operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2ExportClusterParams struct {
+
+	/* ClusterID.
+
+	   The cluster to be exported.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	/* ExportParams.
+
+	   The key of the service that imports the archive.
+	*/
+	ExportParams *models.ClusterExportParams
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 export cluster params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ExportClusterParams) WithDefaults() *V2ExportClusterParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 export cluster params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ExportClusterParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 export cluster params
+func (o *V2ExportClusterParams) WithTimeout(timeout time.Duration) *V2ExportClusterParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 export cluster params
+func (o *V2ExportClusterParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 export cluster params
+func (o *V2ExportClusterParams) WithContext(ctx context.Context) *V2ExportClusterParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 export cluster params
+func (o *V2ExportClusterParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 export cluster params
+func (o *V2ExportClusterParams) WithHTTPClient(client *http.Client) *V2ExportClusterParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 export cluster params
+func (o *V2ExportClusterParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 export cluster params
+func (o *V2ExportClusterParams) WithClusterID(clusterID strfmt.UUID) *V2ExportClusterParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 export cluster params
+func (o *V2ExportClusterParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithExportParams adds the exportParams to the v2 export cluster params
+func (o *V2ExportClusterParams) WithExportParams(exportParams *models.ClusterExportParams) *V2ExportClusterParams {
+	o.SetExportParams(exportParams)
+	return o
+}
+
+// SetExportParams adds the exportParams to the v2 export cluster params
+func (o *V2ExportClusterParams) SetExportParams(exportParams *models.ClusterExportParams) {
+	o.ExportParams = exportParams
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ExportClusterParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+	if o.ExportParams != nil {
+		if err := r.SetBodyParam(o.ExportParams); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,443 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_archives
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ExportClusterReader is a Reader for the V2ExportCluster structure.
+type V2ExportClusterReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ExportClusterReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ExportClusterOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2ExportClusterBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2ExportClusterUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ExportClusterForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2ExportClusterNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ExportClusterInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ExportClusterOK creates a V2ExportClusterOK with default headers values
+func NewV2ExportClusterOK() *V2ExportClusterOK {
+	return &V2ExportClusterOK{}
+}
+
+/*
+V2ExportClusterOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ExportClusterOK struct {
+	Payload *models.ClusterArchive
+}
+
+// IsSuccess returns true when this v2 export cluster o k response has a 2xx status code
+func (o *V2ExportClusterOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 export cluster o k response has a 3xx status code
+func (o *V2ExportClusterOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 export cluster o k response has a 4xx status code
+func (o *V2ExportClusterOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 export cluster o k response has a 5xx status code
+func (o *V2ExportClusterOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 export cluster o k response a status code equal to that given
+func (o *V2ExportClusterOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2ExportClusterOK) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/export][%d] v2ExportClusterOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ExportClusterOK) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/export][%d] v2ExportClusterOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ExportClusterOK) GetPayload() *models.ClusterArchive {
+	return o.Payload
+}
+
+func (o *V2ExportClusterOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ClusterArchive)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ExportClusterBadRequest creates a V2ExportClusterBadRequest with default headers values
+func NewV2ExportClusterBadRequest() *V2ExportClusterBadRequest {
+	return &V2ExportClusterBadRequest{}
+}
+
+/*
+V2ExportClusterBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2ExportClusterBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 export cluster bad request response has a 2xx status code
+func (o *V2ExportClusterBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 export cluster bad request response has a 3xx status code
+func (o *V2ExportClusterBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 export cluster bad request response has a 4xx status code
+func (o *V2ExportClusterBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 export cluster bad request response has a 5xx status code
+func (o *V2ExportClusterBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 export cluster bad request response a status code equal to that given
+func (o *V2ExportClusterBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2ExportClusterBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/export][%d] v2ExportClusterBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2ExportClusterBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/export][%d] v2ExportClusterBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2ExportClusterBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ExportClusterBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ExportClusterUnauthorized creates a V2ExportClusterUnauthorized with default headers values
+func NewV2ExportClusterUnauthorized() *V2ExportClusterUnauthorized {
+	return &V2ExportClusterUnauthorized{}
+}
+
+/*
+V2ExportClusterUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ExportClusterUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 export cluster unauthorized response has a 2xx status code
+func (o *V2ExportClusterUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 export cluster unauthorized response has a 3xx status code
+func (o *V2ExportClusterUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 export cluster unauthorized response has a 4xx status code
+func (o *V2ExportClusterUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 export cluster unauthorized response has a 5xx status code
+func (o *V2ExportClusterUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 export cluster unauthorized response a status code equal to that given
+func (o *V2ExportClusterUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2ExportClusterUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/export][%d] v2ExportClusterUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ExportClusterUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/export][%d] v2ExportClusterUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ExportClusterUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ExportClusterUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ExportClusterForbidden creates a V2ExportClusterForbidden with default headers values
+func NewV2ExportClusterForbidden() *V2ExportClusterForbidden {
+	return &V2ExportClusterForbidden{}
+}
+
+/*
+V2ExportClusterForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ExportClusterForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 export cluster forbidden response has a 2xx status code
+func (o *V2ExportClusterForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 export cluster forbidden response has a 3xx status code
+func (o *V2ExportClusterForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 export cluster forbidden response has a 4xx status code
+func (o *V2ExportClusterForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 export cluster forbidden response has a 5xx status code
+func (o *V2ExportClusterForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 export cluster forbidden response a status code equal to that given
+func (o *V2ExportClusterForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2ExportClusterForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/export][%d] v2ExportClusterForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ExportClusterForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/export][%d] v2ExportClusterForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ExportClusterForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ExportClusterForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ExportClusterNotFound creates a V2ExportClusterNotFound with default headers values
+func NewV2ExportClusterNotFound() *V2ExportClusterNotFound {
+	return &V2ExportClusterNotFound{}
+}
+
+/*
+V2ExportClusterNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2ExportClusterNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 export cluster not found response has a 2xx status code
+func (o *V2ExportClusterNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 export cluster not found response has a 3xx status code
+func (o *V2ExportClusterNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 export cluster not found response has a 4xx status code
+func (o *V2ExportClusterNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 export cluster not found response has a 5xx status code
+func (o *V2ExportClusterNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 export cluster not found response a status code equal to that given
+func (o *V2ExportClusterNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2ExportClusterNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/export][%d] v2ExportClusterNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2ExportClusterNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/export][%d] v2ExportClusterNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2ExportClusterNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ExportClusterNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ExportClusterInternalServerError creates a V2ExportClusterInternalServerError with default headers values
+func NewV2ExportClusterInternalServerError() *V2ExportClusterInternalServerError {
+	return &V2ExportClusterInternalServerError{}
+}
+
+/*
+V2ExportClusterInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ExportClusterInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 export cluster internal server error response has a 2xx status code
+func (o *V2ExportClusterInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 export cluster internal server error response has a 3xx status code
+func (o *V2ExportClusterInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 export cluster internal server error response has a 4xx status code
+func (o *V2ExportClusterInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 export cluster internal server error response has a 5xx status code
+func (o *V2ExportClusterInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 export cluster internal server error response a status code equal to that given
+func (o *V2ExportClusterInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2ExportClusterInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/export][%d] v2ExportClusterInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ExportClusterInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/export][%d] v2ExportClusterInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ExportClusterInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ExportClusterInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
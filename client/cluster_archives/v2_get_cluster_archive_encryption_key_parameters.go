@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_archives
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2GetClusterArchiveEncryptionKeyParams creates a new V2GetClusterArchiveEncryptionKeyParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2GetClusterArchiveEncryptionKeyParams() *V2GetClusterArchiveEncryptionKeyParams {
+	return &V2GetClusterArchiveEncryptionKeyParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2GetClusterArchiveEncryptionKeyParamsWithTimeout creates a new V2GetClusterArchiveEncryptionKeyParams object
+// with the ability to set a timeout on a request.
+func NewV2GetClusterArchiveEncryptionKeyParamsWithTimeout(timeout time.Duration) *V2GetClusterArchiveEncryptionKeyParams {
+	return &V2GetClusterArchiveEncryptionKeyParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2GetClusterArchiveEncryptionKeyParamsWithContext creates a new V2GetClusterArchiveEncryptionKeyParams object
+// with the ability to set a context for a request.
+func NewV2GetClusterArchiveEncryptionKeyParamsWithContext(ctx context.Context) *V2GetClusterArchiveEncryptionKeyParams {
+	return &V2GetClusterArchiveEncryptionKeyParams{
+		Context: ctx,
+	}
+}
+
+// NewV2GetClusterArchiveEncryptionKeyParamsWithHTTPClient creates a new V2GetClusterArchiveEncryptionKeyParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2GetClusterArchiveEncryptionKeyParamsWithHTTPClient(client *http.Client) *V2GetClusterArchiveEncryptionKeyParams {
+	return &V2GetClusterArchiveEncryptionKeyParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2GetClusterArchiveEncryptionKeyParams contains all the parameters to send to the API endpoint
+
+	for the v2 get cluster archive encryption key operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2GetClusterArchiveEncryptionKeyParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 get cluster archive encryption key params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetClusterArchiveEncryptionKeyParams) WithDefaults() *V2GetClusterArchiveEncryptionKeyParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 get cluster archive encryption key params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetClusterArchiveEncryptionKeyParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 get cluster archive encryption key params
+func (o *V2GetClusterArchiveEncryptionKeyParams) WithTimeout(timeout time.Duration) *V2GetClusterArchiveEncryptionKeyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 get cluster archive encryption key params
+func (o *V2GetClusterArchiveEncryptionKeyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 get cluster archive encryption key params
+func (o *V2GetClusterArchiveEncryptionKeyParams) WithContext(ctx context.Context) *V2GetClusterArchiveEncryptionKeyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 get cluster archive encryption key params
+func (o *V2GetClusterArchiveEncryptionKeyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 get cluster archive encryption key params
+func (o *V2GetClusterArchiveEncryptionKeyParams) WithHTTPClient(client *http.Client) *V2GetClusterArchiveEncryptionKeyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 get cluster archive encryption key params
+func (o *V2GetClusterArchiveEncryptionKeyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2GetClusterArchiveEncryptionKeyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,374 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_archives
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetClusterArchiveEncryptionKeyReader is a Reader for the V2GetClusterArchiveEncryptionKey structure.
+type V2GetClusterArchiveEncryptionKeyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2GetClusterArchiveEncryptionKeyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2GetClusterArchiveEncryptionKeyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2GetClusterArchiveEncryptionKeyUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2GetClusterArchiveEncryptionKeyForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2GetClusterArchiveEncryptionKeyInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 501:
+		result := NewV2GetClusterArchiveEncryptionKeyNotImplemented()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2GetClusterArchiveEncryptionKeyOK creates a V2GetClusterArchiveEncryptionKeyOK with default headers values
+func NewV2GetClusterArchiveEncryptionKeyOK() *V2GetClusterArchiveEncryptionKeyOK {
+	return &V2GetClusterArchiveEncryptionKeyOK{}
+}
+
+/*
+V2GetClusterArchiveEncryptionKeyOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2GetClusterArchiveEncryptionKeyOK struct {
+	Payload *models.ClusterArchiveEncryptionKey
+}
+
+// IsSuccess returns true when this v2 get cluster archive encryption key o k response has a 2xx status code
+func (o *V2GetClusterArchiveEncryptionKeyOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 get cluster archive encryption key o k response has a 3xx status code
+func (o *V2GetClusterArchiveEncryptionKeyOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster archive encryption key o k response has a 4xx status code
+func (o *V2GetClusterArchiveEncryptionKeyOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get cluster archive encryption key o k response has a 5xx status code
+func (o *V2GetClusterArchiveEncryptionKeyOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster archive encryption key o k response a status code equal to that given
+func (o *V2GetClusterArchiveEncryptionKeyOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2GetClusterArchiveEncryptionKeyOK) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-archives/encryption-key][%d] v2GetClusterArchiveEncryptionKeyOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetClusterArchiveEncryptionKeyOK) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-archives/encryption-key][%d] v2GetClusterArchiveEncryptionKeyOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetClusterArchiveEncryptionKeyOK) GetPayload() *models.ClusterArchiveEncryptionKey {
+	return o.Payload
+}
+
+func (o *V2GetClusterArchiveEncryptionKeyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ClusterArchiveEncryptionKey)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterArchiveEncryptionKeyUnauthorized creates a V2GetClusterArchiveEncryptionKeyUnauthorized with default headers values
+func NewV2GetClusterArchiveEncryptionKeyUnauthorized() *V2GetClusterArchiveEncryptionKeyUnauthorized {
+	return &V2GetClusterArchiveEncryptionKeyUnauthorized{}
+}
+
+/*
+V2GetClusterArchiveEncryptionKeyUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2GetClusterArchiveEncryptionKeyUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get cluster archive encryption key unauthorized response has a 2xx status code
+func (o *V2GetClusterArchiveEncryptionKeyUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster archive encryption key unauthorized response has a 3xx status code
+func (o *V2GetClusterArchiveEncryptionKeyUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster archive encryption key unauthorized response has a 4xx status code
+func (o *V2GetClusterArchiveEncryptionKeyUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get cluster archive encryption key unauthorized response has a 5xx status code
+func (o *V2GetClusterArchiveEncryptionKeyUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster archive encryption key unauthorized response a status code equal to that given
+func (o *V2GetClusterArchiveEncryptionKeyUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2GetClusterArchiveEncryptionKeyUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-archives/encryption-key][%d] v2GetClusterArchiveEncryptionKeyUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetClusterArchiveEncryptionKeyUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-archives/encryption-key][%d] v2GetClusterArchiveEncryptionKeyUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetClusterArchiveEncryptionKeyUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetClusterArchiveEncryptionKeyUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterArchiveEncryptionKeyForbidden creates a V2GetClusterArchiveEncryptionKeyForbidden with default headers values
+func NewV2GetClusterArchiveEncryptionKeyForbidden() *V2GetClusterArchiveEncryptionKeyForbidden {
+	return &V2GetClusterArchiveEncryptionKeyForbidden{}
+}
+
+/*
+V2GetClusterArchiveEncryptionKeyForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2GetClusterArchiveEncryptionKeyForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get cluster archive encryption key forbidden response has a 2xx status code
+func (o *V2GetClusterArchiveEncryptionKeyForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster archive encryption key forbidden response has a 3xx status code
+func (o *V2GetClusterArchiveEncryptionKeyForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster archive encryption key forbidden response has a 4xx status code
+func (o *V2GetClusterArchiveEncryptionKeyForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get cluster archive encryption key forbidden response has a 5xx status code
+func (o *V2GetClusterArchiveEncryptionKeyForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster archive encryption key forbidden response a status code equal to that given
+func (o *V2GetClusterArchiveEncryptionKeyForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2GetClusterArchiveEncryptionKeyForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-archives/encryption-key][%d] v2GetClusterArchiveEncryptionKeyForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetClusterArchiveEncryptionKeyForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-archives/encryption-key][%d] v2GetClusterArchiveEncryptionKeyForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetClusterArchiveEncryptionKeyForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetClusterArchiveEncryptionKeyForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterArchiveEncryptionKeyInternalServerError creates a V2GetClusterArchiveEncryptionKeyInternalServerError with default headers values
+func NewV2GetClusterArchiveEncryptionKeyInternalServerError() *V2GetClusterArchiveEncryptionKeyInternalServerError {
+	return &V2GetClusterArchiveEncryptionKeyInternalServerError{}
+}
+
+/*
+V2GetClusterArchiveEncryptionKeyInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2GetClusterArchiveEncryptionKeyInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get cluster archive encryption key internal server error response has a 2xx status code
+func (o *V2GetClusterArchiveEncryptionKeyInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster archive encryption key internal server error response has a 3xx status code
+func (o *V2GetClusterArchiveEncryptionKeyInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster archive encryption key internal server error response has a 4xx status code
+func (o *V2GetClusterArchiveEncryptionKeyInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get cluster archive encryption key internal server error response has a 5xx status code
+func (o *V2GetClusterArchiveEncryptionKeyInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 get cluster archive encryption key internal server error response a status code equal to that given
+func (o *V2GetClusterArchiveEncryptionKeyInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2GetClusterArchiveEncryptionKeyInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-archives/encryption-key][%d] v2GetClusterArchiveEncryptionKeyInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetClusterArchiveEncryptionKeyInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-archives/encryption-key][%d] v2GetClusterArchiveEncryptionKeyInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetClusterArchiveEncryptionKeyInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetClusterArchiveEncryptionKeyInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterArchiveEncryptionKeyNotImplemented creates a V2GetClusterArchiveEncryptionKeyNotImplemented with default headers values
+func NewV2GetClusterArchiveEncryptionKeyNotImplemented() *V2GetClusterArchiveEncryptionKeyNotImplemented {
+	return &V2GetClusterArchiveEncryptionKeyNotImplemented{}
+}
+
+/*
+V2GetClusterArchiveEncryptionKeyNotImplemented describes a response with status code 501, with default header values.
+
+Not implemented.
+*/
+type V2GetClusterArchiveEncryptionKeyNotImplemented struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get cluster archive encryption key not implemented response has a 2xx status code
+func (o *V2GetClusterArchiveEncryptionKeyNotImplemented) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster archive encryption key not implemented response has a 3xx status code
+func (o *V2GetClusterArchiveEncryptionKeyNotImplemented) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster archive encryption key not implemented response has a 4xx status code
+func (o *V2GetClusterArchiveEncryptionKeyNotImplemented) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get cluster archive encryption key not implemented response has a 5xx status code
+func (o *V2GetClusterArchiveEncryptionKeyNotImplemented) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 get cluster archive encryption key not implemented response a status code equal to that given
+func (o *V2GetClusterArchiveEncryptionKeyNotImplemented) IsCode(code int) bool {
+	return code == 501
+}
+
+func (o *V2GetClusterArchiveEncryptionKeyNotImplemented) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-archives/encryption-key][%d] v2GetClusterArchiveEncryptionKeyNotImplemented  %+v", 501, o.Payload)
+}
+
+func (o *V2GetClusterArchiveEncryptionKeyNotImplemented) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-archives/encryption-key][%d] v2GetClusterArchiveEncryptionKeyNotImplemented  %+v", 501, o.Payload)
+}
+
+func (o *V2GetClusterArchiveEncryptionKeyNotImplemented) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetClusterArchiveEncryptionKeyNotImplemented) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_archives
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2ImportClusterArchiveParams creates a new V2ImportClusterArchiveParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ImportClusterArchiveParams() *V2ImportClusterArchiveParams {
+	return &V2ImportClusterArchiveParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ImportClusterArchiveParamsWithTimeout creates a new V2ImportClusterArchiveParams object
+// with the ability to set a timeout on a request.
+func NewV2ImportClusterArchiveParamsWithTimeout(timeout time.Duration) *V2ImportClusterArchiveParams {
+	return &V2ImportClusterArchiveParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ImportClusterArchiveParamsWithContext creates a new V2ImportClusterArchiveParams object
+// with the ability to set a context for a request.
+func NewV2ImportClusterArchiveParamsWithContext(ctx context.Context) *V2ImportClusterArchiveParams {
+	return &V2ImportClusterArchiveParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ImportClusterArchiveParamsWithHTTPClient creates a new V2ImportClusterArchiveParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ImportClusterArchiveParamsWithHTTPClient(client *http.Client) *V2ImportClusterArchiveParams {
+	return &V2ImportClusterArchiveParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2ImportClusterArchiveParams contains all the parameters to send to the API endpoint
+
+	for the v2 import cluster archive operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2ImportClusterArchiveParams struct {
+
+	/* Archive.
+
+	   The exported cluster.
+	*/
+	Archive *models.ClusterArchive
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 import cluster archive params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ImportClusterArchiveParams) WithDefaults() *V2ImportClusterArchiveParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 import cluster archive params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ImportClusterArchiveParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 import cluster archive params
+func (o *V2ImportClusterArchiveParams) WithTimeout(timeout time.Duration) *V2ImportClusterArchiveParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 import cluster archive params
+func (o *V2ImportClusterArchiveParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 import cluster archive params
+func (o *V2ImportClusterArchiveParams) WithContext(ctx context.Context) *V2ImportClusterArchiveParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 import cluster archive params
+func (o *V2ImportClusterArchiveParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 import cluster archive params
+func (o *V2ImportClusterArchiveParams) WithHTTPClient(client *http.Client) *V2ImportClusterArchiveParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 import cluster archive params
+func (o *V2ImportClusterArchiveParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithArchive adds the archive to the v2 import cluster archive params
+func (o *V2ImportClusterArchiveParams) WithArchive(archive *models.ClusterArchive) *V2ImportClusterArchiveParams {
+	o.SetArchive(archive)
+	return o
+}
+
+// SetArchive adds the archive to the v2 import cluster archive params
+func (o *V2ImportClusterArchiveParams) SetArchive(archive *models.ClusterArchive) {
+	o.Archive = archive
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ImportClusterArchiveParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Archive != nil {
+		if err := r.SetBodyParam(o.Archive); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,443 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_archives
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ImportClusterArchiveReader is a Reader for the V2ImportClusterArchive structure.
+type V2ImportClusterArchiveReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ImportClusterArchiveReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewV2ImportClusterArchiveCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2ImportClusterArchiveBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2ImportClusterArchiveUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ImportClusterArchiveForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ImportClusterArchiveInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 501:
+		result := NewV2ImportClusterArchiveNotImplemented()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ImportClusterArchiveCreated creates a V2ImportClusterArchiveCreated with default headers values
+func NewV2ImportClusterArchiveCreated() *V2ImportClusterArchiveCreated {
+	return &V2ImportClusterArchiveCreated{}
+}
+
+/*
+V2ImportClusterArchiveCreated describes a response with status code 201, with default header values.
+
+Success.
+*/
+type V2ImportClusterArchiveCreated struct {
+	Payload *models.ClusterImportResult
+}
+
+// IsSuccess returns true when this v2 import cluster archive created response has a 2xx status code
+func (o *V2ImportClusterArchiveCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 import cluster archive created response has a 3xx status code
+func (o *V2ImportClusterArchiveCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 import cluster archive created response has a 4xx status code
+func (o *V2ImportClusterArchiveCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 import cluster archive created response has a 5xx status code
+func (o *V2ImportClusterArchiveCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 import cluster archive created response a status code equal to that given
+func (o *V2ImportClusterArchiveCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+func (o *V2ImportClusterArchiveCreated) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-archives/import][%d] v2ImportClusterArchiveCreated  %+v", 201, o.Payload)
+}
+
+func (o *V2ImportClusterArchiveCreated) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-archives/import][%d] v2ImportClusterArchiveCreated  %+v", 201, o.Payload)
+}
+
+func (o *V2ImportClusterArchiveCreated) GetPayload() *models.ClusterImportResult {
+	return o.Payload
+}
+
+func (o *V2ImportClusterArchiveCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ClusterImportResult)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ImportClusterArchiveBadRequest creates a V2ImportClusterArchiveBadRequest with default headers values
+func NewV2ImportClusterArchiveBadRequest() *V2ImportClusterArchiveBadRequest {
+	return &V2ImportClusterArchiveBadRequest{}
+}
+
+/*
+V2ImportClusterArchiveBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2ImportClusterArchiveBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 import cluster archive bad request response has a 2xx status code
+func (o *V2ImportClusterArchiveBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 import cluster archive bad request response has a 3xx status code
+func (o *V2ImportClusterArchiveBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 import cluster archive bad request response has a 4xx status code
+func (o *V2ImportClusterArchiveBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 import cluster archive bad request response has a 5xx status code
+func (o *V2ImportClusterArchiveBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 import cluster archive bad request response a status code equal to that given
+func (o *V2ImportClusterArchiveBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2ImportClusterArchiveBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-archives/import][%d] v2ImportClusterArchiveBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2ImportClusterArchiveBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-archives/import][%d] v2ImportClusterArchiveBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2ImportClusterArchiveBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ImportClusterArchiveBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ImportClusterArchiveUnauthorized creates a V2ImportClusterArchiveUnauthorized with default headers values
+func NewV2ImportClusterArchiveUnauthorized() *V2ImportClusterArchiveUnauthorized {
+	return &V2ImportClusterArchiveUnauthorized{}
+}
+
+/*
+V2ImportClusterArchiveUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ImportClusterArchiveUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 import cluster archive unauthorized response has a 2xx status code
+func (o *V2ImportClusterArchiveUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 import cluster archive unauthorized response has a 3xx status code
+func (o *V2ImportClusterArchiveUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 import cluster archive unauthorized response has a 4xx status code
+func (o *V2ImportClusterArchiveUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 import cluster archive unauthorized response has a 5xx status code
+func (o *V2ImportClusterArchiveUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 import cluster archive unauthorized response a status code equal to that given
+func (o *V2ImportClusterArchiveUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2ImportClusterArchiveUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-archives/import][%d] v2ImportClusterArchiveUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ImportClusterArchiveUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-archives/import][%d] v2ImportClusterArchiveUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ImportClusterArchiveUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ImportClusterArchiveUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ImportClusterArchiveForbidden creates a V2ImportClusterArchiveForbidden with default headers values
+func NewV2ImportClusterArchiveForbidden() *V2ImportClusterArchiveForbidden {
+	return &V2ImportClusterArchiveForbidden{}
+}
+
+/*
+V2ImportClusterArchiveForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ImportClusterArchiveForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 import cluster archive forbidden response has a 2xx status code
+func (o *V2ImportClusterArchiveForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 import cluster archive forbidden response has a 3xx status code
+func (o *V2ImportClusterArchiveForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 import cluster archive forbidden response has a 4xx status code
+func (o *V2ImportClusterArchiveForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 import cluster archive forbidden response has a 5xx status code
+func (o *V2ImportClusterArchiveForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 import cluster archive forbidden response a status code equal to that given
+func (o *V2ImportClusterArchiveForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2ImportClusterArchiveForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-archives/import][%d] v2ImportClusterArchiveForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ImportClusterArchiveForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-archives/import][%d] v2ImportClusterArchiveForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ImportClusterArchiveForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ImportClusterArchiveForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ImportClusterArchiveInternalServerError creates a V2ImportClusterArchiveInternalServerError with default headers values
+func NewV2ImportClusterArchiveInternalServerError() *V2ImportClusterArchiveInternalServerError {
+	return &V2ImportClusterArchiveInternalServerError{}
+}
+
+/*
+V2ImportClusterArchiveInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ImportClusterArchiveInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 import cluster archive internal server error response has a 2xx status code
+func (o *V2ImportClusterArchiveInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 import cluster archive internal server error response has a 3xx status code
+func (o *V2ImportClusterArchiveInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 import cluster archive internal server error response has a 4xx status code
+func (o *V2ImportClusterArchiveInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 import cluster archive internal server error response has a 5xx status code
+func (o *V2ImportClusterArchiveInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 import cluster archive internal server error response a status code equal to that given
+func (o *V2ImportClusterArchiveInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2ImportClusterArchiveInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-archives/import][%d] v2ImportClusterArchiveInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ImportClusterArchiveInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-archives/import][%d] v2ImportClusterArchiveInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ImportClusterArchiveInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ImportClusterArchiveInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ImportClusterArchiveNotImplemented creates a V2ImportClusterArchiveNotImplemented with default headers values
+func NewV2ImportClusterArchiveNotImplemented() *V2ImportClusterArchiveNotImplemented {
+	return &V2ImportClusterArchiveNotImplemented{}
+}
+
+/*
+V2ImportClusterArchiveNotImplemented describes a response with status code 501, with default header values.
+
+Not implemented.
+*/
+type V2ImportClusterArchiveNotImplemented struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 import cluster archive not implemented response has a 2xx status code
+func (o *V2ImportClusterArchiveNotImplemented) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 import cluster archive not implemented response has a 3xx status code
+func (o *V2ImportClusterArchiveNotImplemented) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 import cluster archive not implemented response has a 4xx status code
+func (o *V2ImportClusterArchiveNotImplemented) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 import cluster archive not implemented response has a 5xx status code
+func (o *V2ImportClusterArchiveNotImplemented) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 import cluster archive not implemented response a status code equal to that given
+func (o *V2ImportClusterArchiveNotImplemented) IsCode(code int) bool {
+	return code == 501
+}
+
+func (o *V2ImportClusterArchiveNotImplemented) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-archives/import][%d] v2ImportClusterArchiveNotImplemented  %+v", 501, o.Payload)
+}
+
+func (o *V2ImportClusterArchiveNotImplemented) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-archives/import][%d] v2ImportClusterArchiveNotImplemented  %+v", 501, o.Payload)
+}
+
+func (o *V2ImportClusterArchiveNotImplemented) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ImportClusterArchiveNotImplemented) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
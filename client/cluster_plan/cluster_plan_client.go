@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_plan
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the cluster plan client
+type API interface {
+	/*
+	   V2ApplyClusterPlan Computes the changes needed to bring the cluster, its infra-env, hosts, custom manifests and operators
+	   to the state described by the plan, and applies them unless dry_run is set. Applying a plan that was
+	   already applied makes no changes.
+	*/
+	V2ApplyClusterPlan(ctx context.Context, params *V2ApplyClusterPlanParams) (*V2ApplyClusterPlanOK, error)
+}
+
+// New creates a new cluster plan API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for cluster plan API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+V2ApplyClusterPlan Computes the changes needed to bring the cluster, its infra-env, hosts, custom manifests and operators
+to the state described by the plan, and applies them unless dry_run is set. Applying a plan that was
+already applied makes no changes.
+*/
+func (a *Client) V2ApplyClusterPlan(ctx context.Context, params *V2ApplyClusterPlanParams) (*V2ApplyClusterPlanOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ApplyClusterPlan",
+		Method:             "POST",
+		PathPattern:        "/v2/clusters/{cluster_id}/plan",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ApplyClusterPlanReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ApplyClusterPlanOK), nil
+
+}
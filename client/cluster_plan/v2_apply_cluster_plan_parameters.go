@@ -0,0 +1,223 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_plan
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2ApplyClusterPlanParams creates a new V2ApplyClusterPlanParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ApplyClusterPlanParams() *V2ApplyClusterPlanParams {
+	return &V2ApplyClusterPlanParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ApplyClusterPlanParamsWithTimeout creates a new V2ApplyClusterPlanParams object
+// with the ability to set a timeout on a request.
+func NewV2ApplyClusterPlanParamsWithTimeout(timeout time.Duration) *V2ApplyClusterPlanParams {
+	return &V2ApplyClusterPlanParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ApplyClusterPlanParamsWithContext creates a new V2ApplyClusterPlanParams object
+// with the ability to set a context for a request.
+func NewV2ApplyClusterPlanParamsWithContext(ctx context.Context) *V2ApplyClusterPlanParams {
+	return &V2ApplyClusterPlanParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ApplyClusterPlanParamsWithHTTPClient creates a new V2ApplyClusterPlanParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ApplyClusterPlanParamsWithHTTPClient(client *http.Client) *V2ApplyClusterPlanParams {
+	return &V2ApplyClusterPlanParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2ApplyClusterPlanParams contains all the parameters to send to the API endpoint
+
+	for the v2 apply cluster plan operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2ApplyClusterPlanParams struct {
+
+	/* ClusterID.
+
+	   The cluster the plan is applied to.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	/* DryRun.
+
+	   Only computes the changes, without applying them.
+	*/
+	DryRun *bool
+
+	/* Plan.
+
+	   The desired state of the cluster.
+	*/
+	Plan *models.ClusterPlan
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 apply cluster plan params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ApplyClusterPlanParams) WithDefaults() *V2ApplyClusterPlanParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 apply cluster plan params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ApplyClusterPlanParams) SetDefaults() {
+	var (
+		dryRunDefault = bool(false)
+	)
+
+	val := V2ApplyClusterPlanParams{
+		DryRun: &dryRunDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the v2 apply cluster plan params
+func (o *V2ApplyClusterPlanParams) WithTimeout(timeout time.Duration) *V2ApplyClusterPlanParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 apply cluster plan params
+func (o *V2ApplyClusterPlanParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 apply cluster plan params
+func (o *V2ApplyClusterPlanParams) WithContext(ctx context.Context) *V2ApplyClusterPlanParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 apply cluster plan params
+func (o *V2ApplyClusterPlanParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 apply cluster plan params
+func (o *V2ApplyClusterPlanParams) WithHTTPClient(client *http.Client) *V2ApplyClusterPlanParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 apply cluster plan params
+func (o *V2ApplyClusterPlanParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 apply cluster plan params
+func (o *V2ApplyClusterPlanParams) WithClusterID(clusterID strfmt.UUID) *V2ApplyClusterPlanParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 apply cluster plan params
+func (o *V2ApplyClusterPlanParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithDryRun adds the dryRun to the v2 apply cluster plan params
+func (o *V2ApplyClusterPlanParams) WithDryRun(dryRun *bool) *V2ApplyClusterPlanParams {
+	o.SetDryRun(dryRun)
+	return o
+}
+
+// SetDryRun adds the dryRun to the v2 apply cluster plan params
+func (o *V2ApplyClusterPlanParams) SetDryRun(dryRun *bool) {
+	o.DryRun = dryRun
+}
+
+// WithPlan adds the plan to the v2 apply cluster plan params
+func (o *V2ApplyClusterPlanParams) WithPlan(plan *models.ClusterPlan) *V2ApplyClusterPlanParams {
+	o.SetPlan(plan)
+	return o
+}
+
+// SetPlan adds the plan to the v2 apply cluster plan params
+func (o *V2ApplyClusterPlanParams) SetPlan(plan *models.ClusterPlan) {
+	o.Plan = plan
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ApplyClusterPlanParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if o.DryRun != nil {
+
+		// query param dry_run
+		var qrDryRun bool
+
+		if o.DryRun != nil {
+			qrDryRun = *o.DryRun
+		}
+		qDryRun := swag.FormatBool(qrDryRun)
+		if qDryRun != "" {
+
+			if err := r.SetQueryParam("dry_run", qDryRun); err != nil {
+				return err
+			}
+		}
+	}
+	if o.Plan != nil {
+		if err := r.SetBodyParam(o.Plan); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,581 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_plan
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ApplyClusterPlanReader is a Reader for the V2ApplyClusterPlan structure.
+type V2ApplyClusterPlanReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ApplyClusterPlanReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ApplyClusterPlanOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2ApplyClusterPlanBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2ApplyClusterPlanUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ApplyClusterPlanForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2ApplyClusterPlanNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2ApplyClusterPlanMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewV2ApplyClusterPlanConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ApplyClusterPlanInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ApplyClusterPlanOK creates a V2ApplyClusterPlanOK with default headers values
+func NewV2ApplyClusterPlanOK() *V2ApplyClusterPlanOK {
+	return &V2ApplyClusterPlanOK{}
+}
+
+/*
+V2ApplyClusterPlanOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ApplyClusterPlanOK struct {
+	Payload *models.ClusterPlanResult
+}
+
+// IsSuccess returns true when this v2 apply cluster plan o k response has a 2xx status code
+func (o *V2ApplyClusterPlanOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 apply cluster plan o k response has a 3xx status code
+func (o *V2ApplyClusterPlanOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 apply cluster plan o k response has a 4xx status code
+func (o *V2ApplyClusterPlanOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 apply cluster plan o k response has a 5xx status code
+func (o *V2ApplyClusterPlanOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 apply cluster plan o k response a status code equal to that given
+func (o *V2ApplyClusterPlanOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2ApplyClusterPlanOK) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/plan][%d] v2ApplyClusterPlanOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ApplyClusterPlanOK) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/plan][%d] v2ApplyClusterPlanOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ApplyClusterPlanOK) GetPayload() *models.ClusterPlanResult {
+	return o.Payload
+}
+
+func (o *V2ApplyClusterPlanOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ClusterPlanResult)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ApplyClusterPlanBadRequest creates a V2ApplyClusterPlanBadRequest with default headers values
+func NewV2ApplyClusterPlanBadRequest() *V2ApplyClusterPlanBadRequest {
+	return &V2ApplyClusterPlanBadRequest{}
+}
+
+/*
+V2ApplyClusterPlanBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2ApplyClusterPlanBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 apply cluster plan bad request response has a 2xx status code
+func (o *V2ApplyClusterPlanBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 apply cluster plan bad request response has a 3xx status code
+func (o *V2ApplyClusterPlanBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 apply cluster plan bad request response has a 4xx status code
+func (o *V2ApplyClusterPlanBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 apply cluster plan bad request response has a 5xx status code
+func (o *V2ApplyClusterPlanBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 apply cluster plan bad request response a status code equal to that given
+func (o *V2ApplyClusterPlanBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2ApplyClusterPlanBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/plan][%d] v2ApplyClusterPlanBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2ApplyClusterPlanBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/plan][%d] v2ApplyClusterPlanBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2ApplyClusterPlanBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ApplyClusterPlanBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ApplyClusterPlanUnauthorized creates a V2ApplyClusterPlanUnauthorized with default headers values
+func NewV2ApplyClusterPlanUnauthorized() *V2ApplyClusterPlanUnauthorized {
+	return &V2ApplyClusterPlanUnauthorized{}
+}
+
+/*
+V2ApplyClusterPlanUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ApplyClusterPlanUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 apply cluster plan unauthorized response has a 2xx status code
+func (o *V2ApplyClusterPlanUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 apply cluster plan unauthorized response has a 3xx status code
+func (o *V2ApplyClusterPlanUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 apply cluster plan unauthorized response has a 4xx status code
+func (o *V2ApplyClusterPlanUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 apply cluster plan unauthorized response has a 5xx status code
+func (o *V2ApplyClusterPlanUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 apply cluster plan unauthorized response a status code equal to that given
+func (o *V2ApplyClusterPlanUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2ApplyClusterPlanUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/plan][%d] v2ApplyClusterPlanUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ApplyClusterPlanUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/plan][%d] v2ApplyClusterPlanUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ApplyClusterPlanUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ApplyClusterPlanUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ApplyClusterPlanForbidden creates a V2ApplyClusterPlanForbidden with default headers values
+func NewV2ApplyClusterPlanForbidden() *V2ApplyClusterPlanForbidden {
+	return &V2ApplyClusterPlanForbidden{}
+}
+
+/*
+V2ApplyClusterPlanForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ApplyClusterPlanForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 apply cluster plan forbidden response has a 2xx status code
+func (o *V2ApplyClusterPlanForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 apply cluster plan forbidden response has a 3xx status code
+func (o *V2ApplyClusterPlanForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 apply cluster plan forbidden response has a 4xx status code
+func (o *V2ApplyClusterPlanForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 apply cluster plan forbidden response has a 5xx status code
+func (o *V2ApplyClusterPlanForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 apply cluster plan forbidden response a status code equal to that given
+func (o *V2ApplyClusterPlanForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2ApplyClusterPlanForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/plan][%d] v2ApplyClusterPlanForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ApplyClusterPlanForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/plan][%d] v2ApplyClusterPlanForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ApplyClusterPlanForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ApplyClusterPlanForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ApplyClusterPlanNotFound creates a V2ApplyClusterPlanNotFound with default headers values
+func NewV2ApplyClusterPlanNotFound() *V2ApplyClusterPlanNotFound {
+	return &V2ApplyClusterPlanNotFound{}
+}
+
+/*
+V2ApplyClusterPlanNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2ApplyClusterPlanNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 apply cluster plan not found response has a 2xx status code
+func (o *V2ApplyClusterPlanNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 apply cluster plan not found response has a 3xx status code
+func (o *V2ApplyClusterPlanNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 apply cluster plan not found response has a 4xx status code
+func (o *V2ApplyClusterPlanNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 apply cluster plan not found response has a 5xx status code
+func (o *V2ApplyClusterPlanNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 apply cluster plan not found response a status code equal to that given
+func (o *V2ApplyClusterPlanNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2ApplyClusterPlanNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/plan][%d] v2ApplyClusterPlanNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2ApplyClusterPlanNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/plan][%d] v2ApplyClusterPlanNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2ApplyClusterPlanNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ApplyClusterPlanNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ApplyClusterPlanMethodNotAllowed creates a V2ApplyClusterPlanMethodNotAllowed with default headers values
+func NewV2ApplyClusterPlanMethodNotAllowed() *V2ApplyClusterPlanMethodNotAllowed {
+	return &V2ApplyClusterPlanMethodNotAllowed{}
+}
+
+/*
+V2ApplyClusterPlanMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2ApplyClusterPlanMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 apply cluster plan method not allowed response has a 2xx status code
+func (o *V2ApplyClusterPlanMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 apply cluster plan method not allowed response has a 3xx status code
+func (o *V2ApplyClusterPlanMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 apply cluster plan method not allowed response has a 4xx status code
+func (o *V2ApplyClusterPlanMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 apply cluster plan method not allowed response has a 5xx status code
+func (o *V2ApplyClusterPlanMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 apply cluster plan method not allowed response a status code equal to that given
+func (o *V2ApplyClusterPlanMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2ApplyClusterPlanMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/plan][%d] v2ApplyClusterPlanMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2ApplyClusterPlanMethodNotAllowed) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/plan][%d] v2ApplyClusterPlanMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2ApplyClusterPlanMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ApplyClusterPlanMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ApplyClusterPlanConflict creates a V2ApplyClusterPlanConflict with default headers values
+func NewV2ApplyClusterPlanConflict() *V2ApplyClusterPlanConflict {
+	return &V2ApplyClusterPlanConflict{}
+}
+
+/*
+V2ApplyClusterPlanConflict describes a response with status code 409, with default header values.
+
+Error.
+*/
+type V2ApplyClusterPlanConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 apply cluster plan conflict response has a 2xx status code
+func (o *V2ApplyClusterPlanConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 apply cluster plan conflict response has a 3xx status code
+func (o *V2ApplyClusterPlanConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 apply cluster plan conflict response has a 4xx status code
+func (o *V2ApplyClusterPlanConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 apply cluster plan conflict response has a 5xx status code
+func (o *V2ApplyClusterPlanConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 apply cluster plan conflict response a status code equal to that given
+func (o *V2ApplyClusterPlanConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+func (o *V2ApplyClusterPlanConflict) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/plan][%d] v2ApplyClusterPlanConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2ApplyClusterPlanConflict) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/plan][%d] v2ApplyClusterPlanConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2ApplyClusterPlanConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ApplyClusterPlanConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ApplyClusterPlanInternalServerError creates a V2ApplyClusterPlanInternalServerError with default headers values
+func NewV2ApplyClusterPlanInternalServerError() *V2ApplyClusterPlanInternalServerError {
+	return &V2ApplyClusterPlanInternalServerError{}
+}
+
+/*
+V2ApplyClusterPlanInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ApplyClusterPlanInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 apply cluster plan internal server error response has a 2xx status code
+func (o *V2ApplyClusterPlanInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 apply cluster plan internal server error response has a 3xx status code
+func (o *V2ApplyClusterPlanInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 apply cluster plan internal server error response has a 4xx status code
+func (o *V2ApplyClusterPlanInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 apply cluster plan internal server error response has a 5xx status code
+func (o *V2ApplyClusterPlanInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 apply cluster plan internal server error response a status code equal to that given
+func (o *V2ApplyClusterPlanInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2ApplyClusterPlanInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/plan][%d] v2ApplyClusterPlanInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ApplyClusterPlanInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/plan][%d] v2ApplyClusterPlanInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ApplyClusterPlanInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ApplyClusterPlanInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_revisions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the cluster revisions client
+type API interface {
+	/*
+	   V2GetClusterRevisionDiff Returns the differences between a revision of the configuration of the cluster and its current
+	   configuration, or another revision if base_revision is set.
+	*/
+	V2GetClusterRevisionDiff(ctx context.Context, params *V2GetClusterRevisionDiffParams) (*V2GetClusterRevisionDiffOK, error)
+	/*
+	   V2ListClusterRevisions Lists the revisions of the configuration of the cluster, newest first. A revision is recorded whenever
+	   an update changes the configuration of the cluster.
+	*/
+	V2ListClusterRevisions(ctx context.Context, params *V2ListClusterRevisionsParams) (*V2ListClusterRevisionsOK, error)
+	/*
+	   V2RollbackClusterRevision Re-applies a revision of the configuration of the cluster. The changes are applied through the same
+	   updates and validations as the equivalent REST calls. Manifests are not rolled back.
+	*/
+	V2RollbackClusterRevision(ctx context.Context, params *V2RollbackClusterRevisionParams) (*V2RollbackClusterRevisionOK, error)
+}
+
+// New creates a new cluster revisions API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for cluster revisions API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+V2GetClusterRevisionDiff Returns the differences between a revision of the configuration of the cluster and its current
+configuration, or another revision if base_revision is set.
+*/
+func (a *Client) V2GetClusterRevisionDiff(ctx context.Context, params *V2GetClusterRevisionDiffParams) (*V2GetClusterRevisionDiffOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetClusterRevisionDiff",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/revisions/{revision}/diff",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetClusterRevisionDiffReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetClusterRevisionDiffOK), nil
+
+}
+
+/*
+V2ListClusterRevisions Lists the revisions of the configuration of the cluster, newest first. A revision is recorded whenever
+an update changes the configuration of the cluster.
+*/
+func (a *Client) V2ListClusterRevisions(ctx context.Context, params *V2ListClusterRevisionsParams) (*V2ListClusterRevisionsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListClusterRevisions",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/revisions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListClusterRevisionsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListClusterRevisionsOK), nil
+
+}
+
+/*
+V2RollbackClusterRevision Re-applies a revision of the configuration of the cluster. The changes are applied through the same
+updates and validations as the equivalent REST calls. Manifests are not rolled back.
+*/
+func (a *Client) V2RollbackClusterRevision(ctx context.Context, params *V2RollbackClusterRevisionParams) (*V2RollbackClusterRevisionOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2RollbackClusterRevision",
+		Method:             "POST",
+		PathPattern:        "/v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2RollbackClusterRevisionReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2RollbackClusterRevisionOK), nil
+
+}
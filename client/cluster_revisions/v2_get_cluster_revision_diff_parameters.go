@@ -0,0 +1,214 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_revisions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewV2GetClusterRevisionDiffParams creates a new V2GetClusterRevisionDiffParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2GetClusterRevisionDiffParams() *V2GetClusterRevisionDiffParams {
+	return &V2GetClusterRevisionDiffParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2GetClusterRevisionDiffParamsWithTimeout creates a new V2GetClusterRevisionDiffParams object
+// with the ability to set a timeout on a request.
+func NewV2GetClusterRevisionDiffParamsWithTimeout(timeout time.Duration) *V2GetClusterRevisionDiffParams {
+	return &V2GetClusterRevisionDiffParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2GetClusterRevisionDiffParamsWithContext creates a new V2GetClusterRevisionDiffParams object
+// with the ability to set a context for a request.
+func NewV2GetClusterRevisionDiffParamsWithContext(ctx context.Context) *V2GetClusterRevisionDiffParams {
+	return &V2GetClusterRevisionDiffParams{
+		Context: ctx,
+	}
+}
+
+// NewV2GetClusterRevisionDiffParamsWithHTTPClient creates a new V2GetClusterRevisionDiffParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2GetClusterRevisionDiffParamsWithHTTPClient(client *http.Client) *V2GetClusterRevisionDiffParams {
+	return &V2GetClusterRevisionDiffParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2GetClusterRevisionDiffParams contains all the parameters to send to the API endpoint
+
+	for the v2 get cluster revision diff operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2GetClusterRevisionDiffParams struct {
+
+	/* BaseRevision.
+
+	   The revision to compare with, instead of the current configuration.
+
+	   Format: int64
+	*/
+	BaseRevision *int64
+
+	/* ClusterID.
+
+	   The cluster whose revisions are used.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	/* Revision.
+
+	   The revision of the configuration of the cluster.
+
+	   Format: int64
+	*/
+	Revision int64
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 get cluster revision diff params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetClusterRevisionDiffParams) WithDefaults() *V2GetClusterRevisionDiffParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 get cluster revision diff params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetClusterRevisionDiffParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 get cluster revision diff params
+func (o *V2GetClusterRevisionDiffParams) WithTimeout(timeout time.Duration) *V2GetClusterRevisionDiffParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 get cluster revision diff params
+func (o *V2GetClusterRevisionDiffParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 get cluster revision diff params
+func (o *V2GetClusterRevisionDiffParams) WithContext(ctx context.Context) *V2GetClusterRevisionDiffParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 get cluster revision diff params
+func (o *V2GetClusterRevisionDiffParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 get cluster revision diff params
+func (o *V2GetClusterRevisionDiffParams) WithHTTPClient(client *http.Client) *V2GetClusterRevisionDiffParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 get cluster revision diff params
+func (o *V2GetClusterRevisionDiffParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBaseRevision adds the baseRevision to the v2 get cluster revision diff params
+func (o *V2GetClusterRevisionDiffParams) WithBaseRevision(baseRevision *int64) *V2GetClusterRevisionDiffParams {
+	o.SetBaseRevision(baseRevision)
+	return o
+}
+
+// SetBaseRevision adds the baseRevision to the v2 get cluster revision diff params
+func (o *V2GetClusterRevisionDiffParams) SetBaseRevision(baseRevision *int64) {
+	o.BaseRevision = baseRevision
+}
+
+// WithClusterID adds the clusterID to the v2 get cluster revision diff params
+func (o *V2GetClusterRevisionDiffParams) WithClusterID(clusterID strfmt.UUID) *V2GetClusterRevisionDiffParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 get cluster revision diff params
+func (o *V2GetClusterRevisionDiffParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithRevision adds the revision to the v2 get cluster revision diff params
+func (o *V2GetClusterRevisionDiffParams) WithRevision(revision int64) *V2GetClusterRevisionDiffParams {
+	o.SetRevision(revision)
+	return o
+}
+
+// SetRevision adds the revision to the v2 get cluster revision diff params
+func (o *V2GetClusterRevisionDiffParams) SetRevision(revision int64) {
+	o.Revision = revision
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2GetClusterRevisionDiffParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.BaseRevision != nil {
+
+		// query param base_revision
+		var qrBaseRevision int64
+
+		if o.BaseRevision != nil {
+			qrBaseRevision = *o.BaseRevision
+		}
+		qBaseRevision := swag.FormatInt64(qrBaseRevision)
+		if qBaseRevision != "" {
+
+			if err := r.SetQueryParam("base_revision", qBaseRevision); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	// path param revision
+	if err := r.SetPathParam("revision", swag.FormatInt64(o.Revision)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
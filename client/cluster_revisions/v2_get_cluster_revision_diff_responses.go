@@ -0,0 +1,512 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_revisions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetClusterRevisionDiffReader is a Reader for the V2GetClusterRevisionDiff structure.
+type V2GetClusterRevisionDiffReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2GetClusterRevisionDiffReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2GetClusterRevisionDiffOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2GetClusterRevisionDiffBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2GetClusterRevisionDiffUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2GetClusterRevisionDiffForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2GetClusterRevisionDiffNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2GetClusterRevisionDiffMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2GetClusterRevisionDiffInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2GetClusterRevisionDiffOK creates a V2GetClusterRevisionDiffOK with default headers values
+func NewV2GetClusterRevisionDiffOK() *V2GetClusterRevisionDiffOK {
+	return &V2GetClusterRevisionDiffOK{}
+}
+
+/*
+V2GetClusterRevisionDiffOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2GetClusterRevisionDiffOK struct {
+	Payload *models.ClusterRevisionDiff
+}
+
+// IsSuccess returns true when this v2 get cluster revision diff o k response has a 2xx status code
+func (o *V2GetClusterRevisionDiffOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 get cluster revision diff o k response has a 3xx status code
+func (o *V2GetClusterRevisionDiffOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster revision diff o k response has a 4xx status code
+func (o *V2GetClusterRevisionDiffOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get cluster revision diff o k response has a 5xx status code
+func (o *V2GetClusterRevisionDiffOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster revision diff o k response a status code equal to that given
+func (o *V2GetClusterRevisionDiffOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2GetClusterRevisionDiffOK) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions/{revision}/diff][%d] v2GetClusterRevisionDiffOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetClusterRevisionDiffOK) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions/{revision}/diff][%d] v2GetClusterRevisionDiffOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetClusterRevisionDiffOK) GetPayload() *models.ClusterRevisionDiff {
+	return o.Payload
+}
+
+func (o *V2GetClusterRevisionDiffOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ClusterRevisionDiff)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterRevisionDiffBadRequest creates a V2GetClusterRevisionDiffBadRequest with default headers values
+func NewV2GetClusterRevisionDiffBadRequest() *V2GetClusterRevisionDiffBadRequest {
+	return &V2GetClusterRevisionDiffBadRequest{}
+}
+
+/*
+V2GetClusterRevisionDiffBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2GetClusterRevisionDiffBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get cluster revision diff bad request response has a 2xx status code
+func (o *V2GetClusterRevisionDiffBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster revision diff bad request response has a 3xx status code
+func (o *V2GetClusterRevisionDiffBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster revision diff bad request response has a 4xx status code
+func (o *V2GetClusterRevisionDiffBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get cluster revision diff bad request response has a 5xx status code
+func (o *V2GetClusterRevisionDiffBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster revision diff bad request response a status code equal to that given
+func (o *V2GetClusterRevisionDiffBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2GetClusterRevisionDiffBadRequest) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions/{revision}/diff][%d] v2GetClusterRevisionDiffBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2GetClusterRevisionDiffBadRequest) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions/{revision}/diff][%d] v2GetClusterRevisionDiffBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2GetClusterRevisionDiffBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetClusterRevisionDiffBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterRevisionDiffUnauthorized creates a V2GetClusterRevisionDiffUnauthorized with default headers values
+func NewV2GetClusterRevisionDiffUnauthorized() *V2GetClusterRevisionDiffUnauthorized {
+	return &V2GetClusterRevisionDiffUnauthorized{}
+}
+
+/*
+V2GetClusterRevisionDiffUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2GetClusterRevisionDiffUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get cluster revision diff unauthorized response has a 2xx status code
+func (o *V2GetClusterRevisionDiffUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster revision diff unauthorized response has a 3xx status code
+func (o *V2GetClusterRevisionDiffUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster revision diff unauthorized response has a 4xx status code
+func (o *V2GetClusterRevisionDiffUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get cluster revision diff unauthorized response has a 5xx status code
+func (o *V2GetClusterRevisionDiffUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster revision diff unauthorized response a status code equal to that given
+func (o *V2GetClusterRevisionDiffUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2GetClusterRevisionDiffUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions/{revision}/diff][%d] v2GetClusterRevisionDiffUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetClusterRevisionDiffUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions/{revision}/diff][%d] v2GetClusterRevisionDiffUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetClusterRevisionDiffUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetClusterRevisionDiffUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterRevisionDiffForbidden creates a V2GetClusterRevisionDiffForbidden with default headers values
+func NewV2GetClusterRevisionDiffForbidden() *V2GetClusterRevisionDiffForbidden {
+	return &V2GetClusterRevisionDiffForbidden{}
+}
+
+/*
+V2GetClusterRevisionDiffForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2GetClusterRevisionDiffForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get cluster revision diff forbidden response has a 2xx status code
+func (o *V2GetClusterRevisionDiffForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster revision diff forbidden response has a 3xx status code
+func (o *V2GetClusterRevisionDiffForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster revision diff forbidden response has a 4xx status code
+func (o *V2GetClusterRevisionDiffForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get cluster revision diff forbidden response has a 5xx status code
+func (o *V2GetClusterRevisionDiffForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster revision diff forbidden response a status code equal to that given
+func (o *V2GetClusterRevisionDiffForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2GetClusterRevisionDiffForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions/{revision}/diff][%d] v2GetClusterRevisionDiffForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetClusterRevisionDiffForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions/{revision}/diff][%d] v2GetClusterRevisionDiffForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetClusterRevisionDiffForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetClusterRevisionDiffForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterRevisionDiffNotFound creates a V2GetClusterRevisionDiffNotFound with default headers values
+func NewV2GetClusterRevisionDiffNotFound() *V2GetClusterRevisionDiffNotFound {
+	return &V2GetClusterRevisionDiffNotFound{}
+}
+
+/*
+V2GetClusterRevisionDiffNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2GetClusterRevisionDiffNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get cluster revision diff not found response has a 2xx status code
+func (o *V2GetClusterRevisionDiffNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster revision diff not found response has a 3xx status code
+func (o *V2GetClusterRevisionDiffNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster revision diff not found response has a 4xx status code
+func (o *V2GetClusterRevisionDiffNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get cluster revision diff not found response has a 5xx status code
+func (o *V2GetClusterRevisionDiffNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster revision diff not found response a status code equal to that given
+func (o *V2GetClusterRevisionDiffNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2GetClusterRevisionDiffNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions/{revision}/diff][%d] v2GetClusterRevisionDiffNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetClusterRevisionDiffNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions/{revision}/diff][%d] v2GetClusterRevisionDiffNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetClusterRevisionDiffNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetClusterRevisionDiffNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterRevisionDiffMethodNotAllowed creates a V2GetClusterRevisionDiffMethodNotAllowed with default headers values
+func NewV2GetClusterRevisionDiffMethodNotAllowed() *V2GetClusterRevisionDiffMethodNotAllowed {
+	return &V2GetClusterRevisionDiffMethodNotAllowed{}
+}
+
+/*
+V2GetClusterRevisionDiffMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2GetClusterRevisionDiffMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get cluster revision diff method not allowed response has a 2xx status code
+func (o *V2GetClusterRevisionDiffMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster revision diff method not allowed response has a 3xx status code
+func (o *V2GetClusterRevisionDiffMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster revision diff method not allowed response has a 4xx status code
+func (o *V2GetClusterRevisionDiffMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get cluster revision diff method not allowed response has a 5xx status code
+func (o *V2GetClusterRevisionDiffMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster revision diff method not allowed response a status code equal to that given
+func (o *V2GetClusterRevisionDiffMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2GetClusterRevisionDiffMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions/{revision}/diff][%d] v2GetClusterRevisionDiffMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2GetClusterRevisionDiffMethodNotAllowed) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions/{revision}/diff][%d] v2GetClusterRevisionDiffMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2GetClusterRevisionDiffMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetClusterRevisionDiffMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterRevisionDiffInternalServerError creates a V2GetClusterRevisionDiffInternalServerError with default headers values
+func NewV2GetClusterRevisionDiffInternalServerError() *V2GetClusterRevisionDiffInternalServerError {
+	return &V2GetClusterRevisionDiffInternalServerError{}
+}
+
+/*
+V2GetClusterRevisionDiffInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2GetClusterRevisionDiffInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get cluster revision diff internal server error response has a 2xx status code
+func (o *V2GetClusterRevisionDiffInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster revision diff internal server error response has a 3xx status code
+func (o *V2GetClusterRevisionDiffInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster revision diff internal server error response has a 4xx status code
+func (o *V2GetClusterRevisionDiffInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get cluster revision diff internal server error response has a 5xx status code
+func (o *V2GetClusterRevisionDiffInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 get cluster revision diff internal server error response a status code equal to that given
+func (o *V2GetClusterRevisionDiffInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2GetClusterRevisionDiffInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions/{revision}/diff][%d] v2GetClusterRevisionDiffInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetClusterRevisionDiffInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions/{revision}/diff][%d] v2GetClusterRevisionDiffInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetClusterRevisionDiffInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetClusterRevisionDiffInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_revisions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2ListClusterRevisionsParams creates a new V2ListClusterRevisionsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListClusterRevisionsParams() *V2ListClusterRevisionsParams {
+	return &V2ListClusterRevisionsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListClusterRevisionsParamsWithTimeout creates a new V2ListClusterRevisionsParams object
+// with the ability to set a timeout on a request.
+func NewV2ListClusterRevisionsParamsWithTimeout(timeout time.Duration) *V2ListClusterRevisionsParams {
+	return &V2ListClusterRevisionsParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListClusterRevisionsParamsWithContext creates a new V2ListClusterRevisionsParams object
+// with the ability to set a context for a request.
+func NewV2ListClusterRevisionsParamsWithContext(ctx context.Context) *V2ListClusterRevisionsParams {
+	return &V2ListClusterRevisionsParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListClusterRevisionsParamsWithHTTPClient creates a new V2ListClusterRevisionsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListClusterRevisionsParamsWithHTTPClient(client *http.Client) *V2ListClusterRevisionsParams {
+	return &V2ListClusterRevisionsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2ListClusterRevisionsParams contains all the parameters to send to the API endpoint
+
+	for the v2 list cluster revisions operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2ListClusterRevisionsParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose revisions are used.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list cluster revisions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListClusterRevisionsParams) WithDefaults() *V2ListClusterRevisionsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list cluster revisions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListClusterRevisionsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 list cluster revisions params
+func (o *V2ListClusterRevisionsParams) WithTimeout(timeout time.Duration) *V2ListClusterRevisionsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list cluster revisions params
+func (o *V2ListClusterRevisionsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list cluster revisions params
+func (o *V2ListClusterRevisionsParams) WithContext(ctx context.Context) *V2ListClusterRevisionsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list cluster revisions params
+func (o *V2ListClusterRevisionsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list cluster revisions params
+func (o *V2ListClusterRevisionsParams) WithHTTPClient(client *http.Client) *V2ListClusterRevisionsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list cluster revisions params
+func (o *V2ListClusterRevisionsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 list cluster revisions params
+func (o *V2ListClusterRevisionsParams) WithClusterID(clusterID strfmt.UUID) *V2ListClusterRevisionsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 list cluster revisions params
+func (o *V2ListClusterRevisionsParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListClusterRevisionsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
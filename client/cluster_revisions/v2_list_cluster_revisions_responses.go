@@ -0,0 +1,510 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_revisions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListClusterRevisionsReader is a Reader for the V2ListClusterRevisions structure.
+type V2ListClusterRevisionsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListClusterRevisionsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListClusterRevisionsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2ListClusterRevisionsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2ListClusterRevisionsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListClusterRevisionsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2ListClusterRevisionsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2ListClusterRevisionsMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListClusterRevisionsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListClusterRevisionsOK creates a V2ListClusterRevisionsOK with default headers values
+func NewV2ListClusterRevisionsOK() *V2ListClusterRevisionsOK {
+	return &V2ListClusterRevisionsOK{}
+}
+
+/*
+V2ListClusterRevisionsOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListClusterRevisionsOK struct {
+	Payload models.ClusterRevisionList
+}
+
+// IsSuccess returns true when this v2 list cluster revisions o k response has a 2xx status code
+func (o *V2ListClusterRevisionsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 list cluster revisions o k response has a 3xx status code
+func (o *V2ListClusterRevisionsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list cluster revisions o k response has a 4xx status code
+func (o *V2ListClusterRevisionsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list cluster revisions o k response has a 5xx status code
+func (o *V2ListClusterRevisionsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list cluster revisions o k response a status code equal to that given
+func (o *V2ListClusterRevisionsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2ListClusterRevisionsOK) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions][%d] v2ListClusterRevisionsOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListClusterRevisionsOK) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions][%d] v2ListClusterRevisionsOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListClusterRevisionsOK) GetPayload() models.ClusterRevisionList {
+	return o.Payload
+}
+
+func (o *V2ListClusterRevisionsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterRevisionsBadRequest creates a V2ListClusterRevisionsBadRequest with default headers values
+func NewV2ListClusterRevisionsBadRequest() *V2ListClusterRevisionsBadRequest {
+	return &V2ListClusterRevisionsBadRequest{}
+}
+
+/*
+V2ListClusterRevisionsBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2ListClusterRevisionsBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list cluster revisions bad request response has a 2xx status code
+func (o *V2ListClusterRevisionsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list cluster revisions bad request response has a 3xx status code
+func (o *V2ListClusterRevisionsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list cluster revisions bad request response has a 4xx status code
+func (o *V2ListClusterRevisionsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list cluster revisions bad request response has a 5xx status code
+func (o *V2ListClusterRevisionsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list cluster revisions bad request response a status code equal to that given
+func (o *V2ListClusterRevisionsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2ListClusterRevisionsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions][%d] v2ListClusterRevisionsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2ListClusterRevisionsBadRequest) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions][%d] v2ListClusterRevisionsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2ListClusterRevisionsBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListClusterRevisionsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterRevisionsUnauthorized creates a V2ListClusterRevisionsUnauthorized with default headers values
+func NewV2ListClusterRevisionsUnauthorized() *V2ListClusterRevisionsUnauthorized {
+	return &V2ListClusterRevisionsUnauthorized{}
+}
+
+/*
+V2ListClusterRevisionsUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListClusterRevisionsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list cluster revisions unauthorized response has a 2xx status code
+func (o *V2ListClusterRevisionsUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list cluster revisions unauthorized response has a 3xx status code
+func (o *V2ListClusterRevisionsUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list cluster revisions unauthorized response has a 4xx status code
+func (o *V2ListClusterRevisionsUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list cluster revisions unauthorized response has a 5xx status code
+func (o *V2ListClusterRevisionsUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list cluster revisions unauthorized response a status code equal to that given
+func (o *V2ListClusterRevisionsUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2ListClusterRevisionsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions][%d] v2ListClusterRevisionsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListClusterRevisionsUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions][%d] v2ListClusterRevisionsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListClusterRevisionsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListClusterRevisionsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterRevisionsForbidden creates a V2ListClusterRevisionsForbidden with default headers values
+func NewV2ListClusterRevisionsForbidden() *V2ListClusterRevisionsForbidden {
+	return &V2ListClusterRevisionsForbidden{}
+}
+
+/*
+V2ListClusterRevisionsForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListClusterRevisionsForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list cluster revisions forbidden response has a 2xx status code
+func (o *V2ListClusterRevisionsForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list cluster revisions forbidden response has a 3xx status code
+func (o *V2ListClusterRevisionsForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list cluster revisions forbidden response has a 4xx status code
+func (o *V2ListClusterRevisionsForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list cluster revisions forbidden response has a 5xx status code
+func (o *V2ListClusterRevisionsForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list cluster revisions forbidden response a status code equal to that given
+func (o *V2ListClusterRevisionsForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2ListClusterRevisionsForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions][%d] v2ListClusterRevisionsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListClusterRevisionsForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions][%d] v2ListClusterRevisionsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListClusterRevisionsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListClusterRevisionsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterRevisionsNotFound creates a V2ListClusterRevisionsNotFound with default headers values
+func NewV2ListClusterRevisionsNotFound() *V2ListClusterRevisionsNotFound {
+	return &V2ListClusterRevisionsNotFound{}
+}
+
+/*
+V2ListClusterRevisionsNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2ListClusterRevisionsNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list cluster revisions not found response has a 2xx status code
+func (o *V2ListClusterRevisionsNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list cluster revisions not found response has a 3xx status code
+func (o *V2ListClusterRevisionsNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list cluster revisions not found response has a 4xx status code
+func (o *V2ListClusterRevisionsNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list cluster revisions not found response has a 5xx status code
+func (o *V2ListClusterRevisionsNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list cluster revisions not found response a status code equal to that given
+func (o *V2ListClusterRevisionsNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2ListClusterRevisionsNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions][%d] v2ListClusterRevisionsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2ListClusterRevisionsNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions][%d] v2ListClusterRevisionsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2ListClusterRevisionsNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListClusterRevisionsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterRevisionsMethodNotAllowed creates a V2ListClusterRevisionsMethodNotAllowed with default headers values
+func NewV2ListClusterRevisionsMethodNotAllowed() *V2ListClusterRevisionsMethodNotAllowed {
+	return &V2ListClusterRevisionsMethodNotAllowed{}
+}
+
+/*
+V2ListClusterRevisionsMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2ListClusterRevisionsMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list cluster revisions method not allowed response has a 2xx status code
+func (o *V2ListClusterRevisionsMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list cluster revisions method not allowed response has a 3xx status code
+func (o *V2ListClusterRevisionsMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list cluster revisions method not allowed response has a 4xx status code
+func (o *V2ListClusterRevisionsMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list cluster revisions method not allowed response has a 5xx status code
+func (o *V2ListClusterRevisionsMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list cluster revisions method not allowed response a status code equal to that given
+func (o *V2ListClusterRevisionsMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2ListClusterRevisionsMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions][%d] v2ListClusterRevisionsMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2ListClusterRevisionsMethodNotAllowed) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions][%d] v2ListClusterRevisionsMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2ListClusterRevisionsMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListClusterRevisionsMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterRevisionsInternalServerError creates a V2ListClusterRevisionsInternalServerError with default headers values
+func NewV2ListClusterRevisionsInternalServerError() *V2ListClusterRevisionsInternalServerError {
+	return &V2ListClusterRevisionsInternalServerError{}
+}
+
+/*
+V2ListClusterRevisionsInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListClusterRevisionsInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list cluster revisions internal server error response has a 2xx status code
+func (o *V2ListClusterRevisionsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list cluster revisions internal server error response has a 3xx status code
+func (o *V2ListClusterRevisionsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list cluster revisions internal server error response has a 4xx status code
+func (o *V2ListClusterRevisionsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list cluster revisions internal server error response has a 5xx status code
+func (o *V2ListClusterRevisionsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 list cluster revisions internal server error response a status code equal to that given
+func (o *V2ListClusterRevisionsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2ListClusterRevisionsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions][%d] v2ListClusterRevisionsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListClusterRevisionsInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/revisions][%d] v2ListClusterRevisionsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListClusterRevisionsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListClusterRevisionsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_revisions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewV2RollbackClusterRevisionParams creates a new V2RollbackClusterRevisionParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2RollbackClusterRevisionParams() *V2RollbackClusterRevisionParams {
+	return &V2RollbackClusterRevisionParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2RollbackClusterRevisionParamsWithTimeout creates a new V2RollbackClusterRevisionParams object
+// with the ability to set a timeout on a request.
+func NewV2RollbackClusterRevisionParamsWithTimeout(timeout time.Duration) *V2RollbackClusterRevisionParams {
+	return &V2RollbackClusterRevisionParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2RollbackClusterRevisionParamsWithContext creates a new V2RollbackClusterRevisionParams object
+// with the ability to set a context for a request.
+func NewV2RollbackClusterRevisionParamsWithContext(ctx context.Context) *V2RollbackClusterRevisionParams {
+	return &V2RollbackClusterRevisionParams{
+		Context: ctx,
+	}
+}
+
+// NewV2RollbackClusterRevisionParamsWithHTTPClient creates a new V2RollbackClusterRevisionParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2RollbackClusterRevisionParamsWithHTTPClient(client *http.Client) *V2RollbackClusterRevisionParams {
+	return &V2RollbackClusterRevisionParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2RollbackClusterRevisionParams contains all the parameters to send to the API endpoint
+
+	for the v2 rollback cluster revision operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2RollbackClusterRevisionParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose revisions are used.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	/* Revision.
+
+	   The revision of the configuration of the cluster.
+
+	   Format: int64
+	*/
+	Revision int64
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 rollback cluster revision params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2RollbackClusterRevisionParams) WithDefaults() *V2RollbackClusterRevisionParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 rollback cluster revision params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2RollbackClusterRevisionParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 rollback cluster revision params
+func (o *V2RollbackClusterRevisionParams) WithTimeout(timeout time.Duration) *V2RollbackClusterRevisionParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 rollback cluster revision params
+func (o *V2RollbackClusterRevisionParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 rollback cluster revision params
+func (o *V2RollbackClusterRevisionParams) WithContext(ctx context.Context) *V2RollbackClusterRevisionParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 rollback cluster revision params
+func (o *V2RollbackClusterRevisionParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 rollback cluster revision params
+func (o *V2RollbackClusterRevisionParams) WithHTTPClient(client *http.Client) *V2RollbackClusterRevisionParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 rollback cluster revision params
+func (o *V2RollbackClusterRevisionParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 rollback cluster revision params
+func (o *V2RollbackClusterRevisionParams) WithClusterID(clusterID strfmt.UUID) *V2RollbackClusterRevisionParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 rollback cluster revision params
+func (o *V2RollbackClusterRevisionParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithRevision adds the revision to the v2 rollback cluster revision params
+func (o *V2RollbackClusterRevisionParams) WithRevision(revision int64) *V2RollbackClusterRevisionParams {
+	o.SetRevision(revision)
+	return o
+}
+
+// SetRevision adds the revision to the v2 rollback cluster revision params
+func (o *V2RollbackClusterRevisionParams) SetRevision(revision int64) {
+	o.Revision = revision
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2RollbackClusterRevisionParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	// path param revision
+	if err := r.SetPathParam("revision", swag.FormatInt64(o.Revision)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,581 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_revisions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2RollbackClusterRevisionReader is a Reader for the V2RollbackClusterRevision structure.
+type V2RollbackClusterRevisionReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2RollbackClusterRevisionReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2RollbackClusterRevisionOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2RollbackClusterRevisionBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2RollbackClusterRevisionUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2RollbackClusterRevisionForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2RollbackClusterRevisionNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2RollbackClusterRevisionMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewV2RollbackClusterRevisionConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2RollbackClusterRevisionInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2RollbackClusterRevisionOK creates a V2RollbackClusterRevisionOK with default headers values
+func NewV2RollbackClusterRevisionOK() *V2RollbackClusterRevisionOK {
+	return &V2RollbackClusterRevisionOK{}
+}
+
+/*
+V2RollbackClusterRevisionOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2RollbackClusterRevisionOK struct {
+	Payload *models.Cluster
+}
+
+// IsSuccess returns true when this v2 rollback cluster revision o k response has a 2xx status code
+func (o *V2RollbackClusterRevisionOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 rollback cluster revision o k response has a 3xx status code
+func (o *V2RollbackClusterRevisionOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 rollback cluster revision o k response has a 4xx status code
+func (o *V2RollbackClusterRevisionOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 rollback cluster revision o k response has a 5xx status code
+func (o *V2RollbackClusterRevisionOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 rollback cluster revision o k response a status code equal to that given
+func (o *V2RollbackClusterRevisionOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2RollbackClusterRevisionOK) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback][%d] v2RollbackClusterRevisionOK  %+v", 200, o.Payload)
+}
+
+func (o *V2RollbackClusterRevisionOK) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback][%d] v2RollbackClusterRevisionOK  %+v", 200, o.Payload)
+}
+
+func (o *V2RollbackClusterRevisionOK) GetPayload() *models.Cluster {
+	return o.Payload
+}
+
+func (o *V2RollbackClusterRevisionOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Cluster)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RollbackClusterRevisionBadRequest creates a V2RollbackClusterRevisionBadRequest with default headers values
+func NewV2RollbackClusterRevisionBadRequest() *V2RollbackClusterRevisionBadRequest {
+	return &V2RollbackClusterRevisionBadRequest{}
+}
+
+/*
+V2RollbackClusterRevisionBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2RollbackClusterRevisionBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 rollback cluster revision bad request response has a 2xx status code
+func (o *V2RollbackClusterRevisionBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 rollback cluster revision bad request response has a 3xx status code
+func (o *V2RollbackClusterRevisionBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 rollback cluster revision bad request response has a 4xx status code
+func (o *V2RollbackClusterRevisionBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 rollback cluster revision bad request response has a 5xx status code
+func (o *V2RollbackClusterRevisionBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 rollback cluster revision bad request response a status code equal to that given
+func (o *V2RollbackClusterRevisionBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2RollbackClusterRevisionBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback][%d] v2RollbackClusterRevisionBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2RollbackClusterRevisionBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback][%d] v2RollbackClusterRevisionBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2RollbackClusterRevisionBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RollbackClusterRevisionBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RollbackClusterRevisionUnauthorized creates a V2RollbackClusterRevisionUnauthorized with default headers values
+func NewV2RollbackClusterRevisionUnauthorized() *V2RollbackClusterRevisionUnauthorized {
+	return &V2RollbackClusterRevisionUnauthorized{}
+}
+
+/*
+V2RollbackClusterRevisionUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2RollbackClusterRevisionUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 rollback cluster revision unauthorized response has a 2xx status code
+func (o *V2RollbackClusterRevisionUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 rollback cluster revision unauthorized response has a 3xx status code
+func (o *V2RollbackClusterRevisionUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 rollback cluster revision unauthorized response has a 4xx status code
+func (o *V2RollbackClusterRevisionUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 rollback cluster revision unauthorized response has a 5xx status code
+func (o *V2RollbackClusterRevisionUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 rollback cluster revision unauthorized response a status code equal to that given
+func (o *V2RollbackClusterRevisionUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2RollbackClusterRevisionUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback][%d] v2RollbackClusterRevisionUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2RollbackClusterRevisionUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback][%d] v2RollbackClusterRevisionUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2RollbackClusterRevisionUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2RollbackClusterRevisionUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RollbackClusterRevisionForbidden creates a V2RollbackClusterRevisionForbidden with default headers values
+func NewV2RollbackClusterRevisionForbidden() *V2RollbackClusterRevisionForbidden {
+	return &V2RollbackClusterRevisionForbidden{}
+}
+
+/*
+V2RollbackClusterRevisionForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2RollbackClusterRevisionForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 rollback cluster revision forbidden response has a 2xx status code
+func (o *V2RollbackClusterRevisionForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 rollback cluster revision forbidden response has a 3xx status code
+func (o *V2RollbackClusterRevisionForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 rollback cluster revision forbidden response has a 4xx status code
+func (o *V2RollbackClusterRevisionForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 rollback cluster revision forbidden response has a 5xx status code
+func (o *V2RollbackClusterRevisionForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 rollback cluster revision forbidden response a status code equal to that given
+func (o *V2RollbackClusterRevisionForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2RollbackClusterRevisionForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback][%d] v2RollbackClusterRevisionForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2RollbackClusterRevisionForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback][%d] v2RollbackClusterRevisionForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2RollbackClusterRevisionForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2RollbackClusterRevisionForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RollbackClusterRevisionNotFound creates a V2RollbackClusterRevisionNotFound with default headers values
+func NewV2RollbackClusterRevisionNotFound() *V2RollbackClusterRevisionNotFound {
+	return &V2RollbackClusterRevisionNotFound{}
+}
+
+/*
+V2RollbackClusterRevisionNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2RollbackClusterRevisionNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 rollback cluster revision not found response has a 2xx status code
+func (o *V2RollbackClusterRevisionNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 rollback cluster revision not found response has a 3xx status code
+func (o *V2RollbackClusterRevisionNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 rollback cluster revision not found response has a 4xx status code
+func (o *V2RollbackClusterRevisionNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 rollback cluster revision not found response has a 5xx status code
+func (o *V2RollbackClusterRevisionNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 rollback cluster revision not found response a status code equal to that given
+func (o *V2RollbackClusterRevisionNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2RollbackClusterRevisionNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback][%d] v2RollbackClusterRevisionNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2RollbackClusterRevisionNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback][%d] v2RollbackClusterRevisionNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2RollbackClusterRevisionNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RollbackClusterRevisionNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RollbackClusterRevisionMethodNotAllowed creates a V2RollbackClusterRevisionMethodNotAllowed with default headers values
+func NewV2RollbackClusterRevisionMethodNotAllowed() *V2RollbackClusterRevisionMethodNotAllowed {
+	return &V2RollbackClusterRevisionMethodNotAllowed{}
+}
+
+/*
+V2RollbackClusterRevisionMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2RollbackClusterRevisionMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 rollback cluster revision method not allowed response has a 2xx status code
+func (o *V2RollbackClusterRevisionMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 rollback cluster revision method not allowed response has a 3xx status code
+func (o *V2RollbackClusterRevisionMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 rollback cluster revision method not allowed response has a 4xx status code
+func (o *V2RollbackClusterRevisionMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 rollback cluster revision method not allowed response has a 5xx status code
+func (o *V2RollbackClusterRevisionMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 rollback cluster revision method not allowed response a status code equal to that given
+func (o *V2RollbackClusterRevisionMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2RollbackClusterRevisionMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback][%d] v2RollbackClusterRevisionMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2RollbackClusterRevisionMethodNotAllowed) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback][%d] v2RollbackClusterRevisionMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2RollbackClusterRevisionMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RollbackClusterRevisionMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RollbackClusterRevisionConflict creates a V2RollbackClusterRevisionConflict with default headers values
+func NewV2RollbackClusterRevisionConflict() *V2RollbackClusterRevisionConflict {
+	return &V2RollbackClusterRevisionConflict{}
+}
+
+/*
+V2RollbackClusterRevisionConflict describes a response with status code 409, with default header values.
+
+Error.
+*/
+type V2RollbackClusterRevisionConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 rollback cluster revision conflict response has a 2xx status code
+func (o *V2RollbackClusterRevisionConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 rollback cluster revision conflict response has a 3xx status code
+func (o *V2RollbackClusterRevisionConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 rollback cluster revision conflict response has a 4xx status code
+func (o *V2RollbackClusterRevisionConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 rollback cluster revision conflict response has a 5xx status code
+func (o *V2RollbackClusterRevisionConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 rollback cluster revision conflict response a status code equal to that given
+func (o *V2RollbackClusterRevisionConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+func (o *V2RollbackClusterRevisionConflict) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback][%d] v2RollbackClusterRevisionConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2RollbackClusterRevisionConflict) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback][%d] v2RollbackClusterRevisionConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2RollbackClusterRevisionConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RollbackClusterRevisionConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RollbackClusterRevisionInternalServerError creates a V2RollbackClusterRevisionInternalServerError with default headers values
+func NewV2RollbackClusterRevisionInternalServerError() *V2RollbackClusterRevisionInternalServerError {
+	return &V2RollbackClusterRevisionInternalServerError{}
+}
+
+/*
+V2RollbackClusterRevisionInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2RollbackClusterRevisionInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 rollback cluster revision internal server error response has a 2xx status code
+func (o *V2RollbackClusterRevisionInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 rollback cluster revision internal server error response has a 3xx status code
+func (o *V2RollbackClusterRevisionInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 rollback cluster revision internal server error response has a 4xx status code
+func (o *V2RollbackClusterRevisionInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 rollback cluster revision internal server error response has a 5xx status code
+func (o *V2RollbackClusterRevisionInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 rollback cluster revision internal server error response a status code equal to that given
+func (o *V2RollbackClusterRevisionInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2RollbackClusterRevisionInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback][%d] v2RollbackClusterRevisionInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2RollbackClusterRevisionInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback][%d] v2RollbackClusterRevisionInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2RollbackClusterRevisionInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RollbackClusterRevisionInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the cluster templates client
+type API interface {
+	/*
+	   V2DeregisterClusterTemplate Deletes a cluster template. Clusters that were registered from the template are not modified.*/
+	V2DeregisterClusterTemplate(ctx context.Context, params *V2DeregisterClusterTemplateParams) (*V2DeregisterClusterTemplateNoContent, error)
+	/*
+	   V2GetClusterTemplate Retrieves the details of the cluster template.*/
+	V2GetClusterTemplate(ctx context.Context, params *V2GetClusterTemplateParams) (*V2GetClusterTemplateOK, error)
+	/*
+	   V2ListClusterTemplates Lists the cluster templates that are accessible by the user.*/
+	V2ListClusterTemplates(ctx context.Context, params *V2ListClusterTemplatesParams) (*V2ListClusterTemplatesOK, error)
+	/*
+	   V2RegisterClusterTemplate Creates a cluster template, from which clusters with the same configuration can be registered.*/
+	V2RegisterClusterTemplate(ctx context.Context, params *V2RegisterClusterTemplateParams) (*V2RegisterClusterTemplateCreated, error)
+	/*
+	   V2UpdateClusterTemplate Updates a cluster template. Clusters that were registered from the template are not modified.*/
+	V2UpdateClusterTemplate(ctx context.Context, params *V2UpdateClusterTemplateParams) (*V2UpdateClusterTemplateCreated, error)
+}
+
+// New creates a new cluster templates API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for cluster templates API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+V2DeregisterClusterTemplate Deletes a cluster template. Clusters that were registered from the template are not modified.
+*/
+func (a *Client) V2DeregisterClusterTemplate(ctx context.Context, params *V2DeregisterClusterTemplateParams) (*V2DeregisterClusterTemplateNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DeregisterClusterTemplate",
+		Method:             "DELETE",
+		PathPattern:        "/v2/cluster-templates/{cluster_template_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DeregisterClusterTemplateReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DeregisterClusterTemplateNoContent), nil
+
+}
+
+/*
+V2GetClusterTemplate Retrieves the details of the cluster template.
+*/
+func (a *Client) V2GetClusterTemplate(ctx context.Context, params *V2GetClusterTemplateParams) (*V2GetClusterTemplateOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetClusterTemplate",
+		Method:             "GET",
+		PathPattern:        "/v2/cluster-templates/{cluster_template_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetClusterTemplateReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetClusterTemplateOK), nil
+
+}
+
+/*
+V2ListClusterTemplates Lists the cluster templates that are accessible by the user.
+*/
+func (a *Client) V2ListClusterTemplates(ctx context.Context, params *V2ListClusterTemplatesParams) (*V2ListClusterTemplatesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListClusterTemplates",
+		Method:             "GET",
+		PathPattern:        "/v2/cluster-templates",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListClusterTemplatesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListClusterTemplatesOK), nil
+
+}
+
+/*
+V2RegisterClusterTemplate Creates a cluster template, from which clusters with the same configuration can be registered.
+*/
+func (a *Client) V2RegisterClusterTemplate(ctx context.Context, params *V2RegisterClusterTemplateParams) (*V2RegisterClusterTemplateCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2RegisterClusterTemplate",
+		Method:             "POST",
+		PathPattern:        "/v2/cluster-templates",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2RegisterClusterTemplateReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2RegisterClusterTemplateCreated), nil
+
+}
+
+/*
+V2UpdateClusterTemplate Updates a cluster template. Clusters that were registered from the template are not modified.
+*/
+func (a *Client) V2UpdateClusterTemplate(ctx context.Context, params *V2UpdateClusterTemplateParams) (*V2UpdateClusterTemplateCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2UpdateClusterTemplate",
+		Method:             "PATCH",
+		PathPattern:        "/v2/cluster-templates/{cluster_template_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2UpdateClusterTemplateReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2UpdateClusterTemplateCreated), nil
+
+}
@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2ListClusterTemplatesParams creates a new V2ListClusterTemplatesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListClusterTemplatesParams() *V2ListClusterTemplatesParams {
+	return &V2ListClusterTemplatesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListClusterTemplatesParamsWithTimeout creates a new V2ListClusterTemplatesParams object
+// with the ability to set a timeout on a request.
+func NewV2ListClusterTemplatesParamsWithTimeout(timeout time.Duration) *V2ListClusterTemplatesParams {
+	return &V2ListClusterTemplatesParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListClusterTemplatesParamsWithContext creates a new V2ListClusterTemplatesParams object
+// with the ability to set a context for a request.
+func NewV2ListClusterTemplatesParamsWithContext(ctx context.Context) *V2ListClusterTemplatesParams {
+	return &V2ListClusterTemplatesParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListClusterTemplatesParamsWithHTTPClient creates a new V2ListClusterTemplatesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListClusterTemplatesParamsWithHTTPClient(client *http.Client) *V2ListClusterTemplatesParams {
+	return &V2ListClusterTemplatesParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2ListClusterTemplatesParams contains all the parameters to send to the API endpoint
+
+	for the v2 list cluster templates operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2ListClusterTemplatesParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list cluster templates params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListClusterTemplatesParams) WithDefaults() *V2ListClusterTemplatesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list cluster templates params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListClusterTemplatesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 list cluster templates params
+func (o *V2ListClusterTemplatesParams) WithTimeout(timeout time.Duration) *V2ListClusterTemplatesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list cluster templates params
+func (o *V2ListClusterTemplatesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list cluster templates params
+func (o *V2ListClusterTemplatesParams) WithContext(ctx context.Context) *V2ListClusterTemplatesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list cluster templates params
+func (o *V2ListClusterTemplatesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list cluster templates params
+func (o *V2ListClusterTemplatesParams) WithHTTPClient(client *http.Client) *V2ListClusterTemplatesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list cluster templates params
+func (o *V2ListClusterTemplatesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListClusterTemplatesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,303 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListClusterTemplatesReader is a Reader for the V2ListClusterTemplates structure.
+type V2ListClusterTemplatesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListClusterTemplatesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListClusterTemplatesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2ListClusterTemplatesUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListClusterTemplatesForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListClusterTemplatesInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListClusterTemplatesOK creates a V2ListClusterTemplatesOK with default headers values
+func NewV2ListClusterTemplatesOK() *V2ListClusterTemplatesOK {
+	return &V2ListClusterTemplatesOK{}
+}
+
+/*
+V2ListClusterTemplatesOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListClusterTemplatesOK struct {
+	Payload models.ClusterTemplateList
+}
+
+// IsSuccess returns true when this v2 list cluster templates o k response has a 2xx status code
+func (o *V2ListClusterTemplatesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 list cluster templates o k response has a 3xx status code
+func (o *V2ListClusterTemplatesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list cluster templates o k response has a 4xx status code
+func (o *V2ListClusterTemplatesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list cluster templates o k response has a 5xx status code
+func (o *V2ListClusterTemplatesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list cluster templates o k response a status code equal to that given
+func (o *V2ListClusterTemplatesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2ListClusterTemplatesOK) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-templates][%d] v2ListClusterTemplatesOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListClusterTemplatesOK) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-templates][%d] v2ListClusterTemplatesOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListClusterTemplatesOK) GetPayload() models.ClusterTemplateList {
+	return o.Payload
+}
+
+func (o *V2ListClusterTemplatesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterTemplatesUnauthorized creates a V2ListClusterTemplatesUnauthorized with default headers values
+func NewV2ListClusterTemplatesUnauthorized() *V2ListClusterTemplatesUnauthorized {
+	return &V2ListClusterTemplatesUnauthorized{}
+}
+
+/*
+V2ListClusterTemplatesUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListClusterTemplatesUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list cluster templates unauthorized response has a 2xx status code
+func (o *V2ListClusterTemplatesUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list cluster templates unauthorized response has a 3xx status code
+func (o *V2ListClusterTemplatesUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list cluster templates unauthorized response has a 4xx status code
+func (o *V2ListClusterTemplatesUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list cluster templates unauthorized response has a 5xx status code
+func (o *V2ListClusterTemplatesUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list cluster templates unauthorized response a status code equal to that given
+func (o *V2ListClusterTemplatesUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2ListClusterTemplatesUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-templates][%d] v2ListClusterTemplatesUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListClusterTemplatesUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-templates][%d] v2ListClusterTemplatesUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListClusterTemplatesUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListClusterTemplatesUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterTemplatesForbidden creates a V2ListClusterTemplatesForbidden with default headers values
+func NewV2ListClusterTemplatesForbidden() *V2ListClusterTemplatesForbidden {
+	return &V2ListClusterTemplatesForbidden{}
+}
+
+/*
+V2ListClusterTemplatesForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListClusterTemplatesForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list cluster templates forbidden response has a 2xx status code
+func (o *V2ListClusterTemplatesForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list cluster templates forbidden response has a 3xx status code
+func (o *V2ListClusterTemplatesForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list cluster templates forbidden response has a 4xx status code
+func (o *V2ListClusterTemplatesForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list cluster templates forbidden response has a 5xx status code
+func (o *V2ListClusterTemplatesForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list cluster templates forbidden response a status code equal to that given
+func (o *V2ListClusterTemplatesForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2ListClusterTemplatesForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-templates][%d] v2ListClusterTemplatesForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListClusterTemplatesForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-templates][%d] v2ListClusterTemplatesForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListClusterTemplatesForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListClusterTemplatesForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterTemplatesInternalServerError creates a V2ListClusterTemplatesInternalServerError with default headers values
+func NewV2ListClusterTemplatesInternalServerError() *V2ListClusterTemplatesInternalServerError {
+	return &V2ListClusterTemplatesInternalServerError{}
+}
+
+/*
+V2ListClusterTemplatesInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListClusterTemplatesInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list cluster templates internal server error response has a 2xx status code
+func (o *V2ListClusterTemplatesInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list cluster templates internal server error response has a 3xx status code
+func (o *V2ListClusterTemplatesInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list cluster templates internal server error response has a 4xx status code
+func (o *V2ListClusterTemplatesInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list cluster templates internal server error response has a 5xx status code
+func (o *V2ListClusterTemplatesInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 list cluster templates internal server error response a status code equal to that given
+func (o *V2ListClusterTemplatesInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2ListClusterTemplatesInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-templates][%d] v2ListClusterTemplatesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListClusterTemplatesInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-templates][%d] v2ListClusterTemplatesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListClusterTemplatesInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListClusterTemplatesInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
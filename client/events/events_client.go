@@ -7,6 +7,7 @@ package events
 
 import (
 	"context"
+	"io"
 
 	"github.com/go-openapi/runtime"
 
@@ -23,6 +24,9 @@ type API interface {
 	/*
 	   V2TriggerEvent Add new assisted installer event.*/
 	V2TriggerEvent(ctx context.Context, params *V2TriggerEventParams) (*V2TriggerEventCreated, error)
+	/*
+	   V2Watch Streams cluster, host, infra-env and event notifications as server-sent events.*/
+	V2Watch(ctx context.Context, params *V2WatchParams, writer io.Writer) (*V2WatchOK, error)
 }
 
 // New creates a new events API client.
@@ -92,3 +96,28 @@ func (a *Client) V2TriggerEvent(ctx context.Context, params *V2TriggerEventParam
 	return result.(*V2TriggerEventCreated), nil
 
 }
+
+/*
+V2Watch Streams cluster, host, infra-env and event notifications as server-sent events.
+*/
+func (a *Client) V2Watch(ctx context.Context, params *V2WatchParams, writer io.Writer) (*V2WatchOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2Watch",
+		Method:             "GET",
+		PathPattern:        "/v2/watch",
+		ProducesMediaTypes: []string{"text/event-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2WatchReader{formats: a.formats, writer: writer},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2WatchOK), nil
+
+}
@@ -0,0 +1,308 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewV2WatchParams creates a new V2WatchParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2WatchParams() *V2WatchParams {
+	return &V2WatchParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2WatchParamsWithTimeout creates a new V2WatchParams object
+// with the ability to set a timeout on a request.
+func NewV2WatchParamsWithTimeout(timeout time.Duration) *V2WatchParams {
+	return &V2WatchParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2WatchParamsWithContext creates a new V2WatchParams object
+// with the ability to set a context for a request.
+func NewV2WatchParamsWithContext(ctx context.Context) *V2WatchParams {
+	return &V2WatchParams{
+		Context: ctx,
+	}
+}
+
+// NewV2WatchParamsWithHTTPClient creates a new V2WatchParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2WatchParamsWithHTTPClient(client *http.Client) *V2WatchParams {
+	return &V2WatchParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2WatchParams contains all the parameters to send to the API endpoint
+
+	for the v2 watch operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2WatchParams struct {
+
+	/* LastEventID.
+
+	   Resume the stream after the notification with this offset, as sent by reconnecting server-sent events clients. Ignored if 'since' is specified.
+	*/
+	LastEventID *string
+
+	/* ClusterID.
+
+	   The cluster to stream notifications for.
+
+	   Format: uuid
+	*/
+	ClusterID *strfmt.UUID
+
+	/* InfraEnvID.
+
+	   The infra-env to stream notifications for.
+
+	   Format: uuid
+	*/
+	InfraEnvID *strfmt.UUID
+
+	/* NotificationTypes.
+
+	   The notification types to stream. All types are streamed if not specified.
+	*/
+	NotificationTypes []string
+
+	/* Since.
+
+	   Resume the stream after the notification with this offset.
+
+	   Format: int64
+	*/
+	Since *int64
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 watch params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2WatchParams) WithDefaults() *V2WatchParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 watch params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2WatchParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 watch params
+func (o *V2WatchParams) WithTimeout(timeout time.Duration) *V2WatchParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 watch params
+func (o *V2WatchParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 watch params
+func (o *V2WatchParams) WithContext(ctx context.Context) *V2WatchParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 watch params
+func (o *V2WatchParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 watch params
+func (o *V2WatchParams) WithHTTPClient(client *http.Client) *V2WatchParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 watch params
+func (o *V2WatchParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLastEventID adds the lastEventID to the v2 watch params
+func (o *V2WatchParams) WithLastEventID(lastEventID *string) *V2WatchParams {
+	o.SetLastEventID(lastEventID)
+	return o
+}
+
+// SetLastEventID adds the lastEventId to the v2 watch params
+func (o *V2WatchParams) SetLastEventID(lastEventID *string) {
+	o.LastEventID = lastEventID
+}
+
+// WithClusterID adds the clusterID to the v2 watch params
+func (o *V2WatchParams) WithClusterID(clusterID *strfmt.UUID) *V2WatchParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 watch params
+func (o *V2WatchParams) SetClusterID(clusterID *strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 watch params
+func (o *V2WatchParams) WithInfraEnvID(infraEnvID *strfmt.UUID) *V2WatchParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 watch params
+func (o *V2WatchParams) SetInfraEnvID(infraEnvID *strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WithNotificationTypes adds the notificationTypes to the v2 watch params
+func (o *V2WatchParams) WithNotificationTypes(notificationTypes []string) *V2WatchParams {
+	o.SetNotificationTypes(notificationTypes)
+	return o
+}
+
+// SetNotificationTypes adds the notificationTypes to the v2 watch params
+func (o *V2WatchParams) SetNotificationTypes(notificationTypes []string) {
+	o.NotificationTypes = notificationTypes
+}
+
+// WithSince adds the since to the v2 watch params
+func (o *V2WatchParams) WithSince(since *int64) *V2WatchParams {
+	o.SetSince(since)
+	return o
+}
+
+// SetSince adds the since to the v2 watch params
+func (o *V2WatchParams) SetSince(since *int64) {
+	o.Since = since
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2WatchParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.LastEventID != nil {
+
+		// header param Last-Event-ID
+		if err := r.SetHeaderParam("Last-Event-ID", *o.LastEventID); err != nil {
+			return err
+		}
+	}
+
+	if o.ClusterID != nil {
+
+		// query param cluster_id
+		var qrClusterID strfmt.UUID
+
+		if o.ClusterID != nil {
+			qrClusterID = *o.ClusterID
+		}
+		qClusterID := qrClusterID.String()
+		if qClusterID != "" {
+
+			if err := r.SetQueryParam("cluster_id", qClusterID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.InfraEnvID != nil {
+
+		// query param infra_env_id
+		var qrInfraEnvID strfmt.UUID
+
+		if o.InfraEnvID != nil {
+			qrInfraEnvID = *o.InfraEnvID
+		}
+		qInfraEnvID := qrInfraEnvID.String()
+		if qInfraEnvID != "" {
+
+			if err := r.SetQueryParam("infra_env_id", qInfraEnvID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.NotificationTypes != nil {
+
+		// binding items for notification_types
+		joinedNotificationTypes := o.bindParamNotificationTypes(reg)
+
+		// query array param notification_types
+		if err := r.SetQueryParam("notification_types", joinedNotificationTypes...); err != nil {
+			return err
+		}
+	}
+
+	if o.Since != nil {
+
+		// query param since
+		var qrSince int64
+
+		if o.Since != nil {
+			qrSince = *o.Since
+		}
+		qSince := swag.FormatInt64(qrSince)
+		if qSince != "" {
+
+			if err := r.SetQueryParam("since", qSince); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindParamV2Watch binds the parameter notification_types
+func (o *V2WatchParams) bindParamNotificationTypes(formats strfmt.Registry) []string {
+	notificationTypesIR := o.NotificationTypes
+
+	var notificationTypesIC []string
+	for _, notificationTypesIIR := range notificationTypesIR { // explode []string
+
+		notificationTypesIIV := notificationTypesIIR // string as string
+		notificationTypesIC = append(notificationTypesIC, notificationTypesIIV)
+	}
+
+	// items.CollectionFormat: ""
+	notificationTypesIS := swag.JoinByFormat(notificationTypesIC, "")
+
+	return notificationTypesIS
+}
@@ -0,0 +1,514 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2WatchReader is a Reader for the V2Watch structure.
+type V2WatchReader struct {
+	formats strfmt.Registry
+	writer  io.Writer
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2WatchReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2WatchOK(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2WatchBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2WatchUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2WatchForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2WatchNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2WatchInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 501:
+		result := NewV2WatchNotImplemented()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2WatchOK creates a V2WatchOK with default headers values
+func NewV2WatchOK(writer io.Writer) *V2WatchOK {
+	return &V2WatchOK{
+
+		Payload: writer,
+	}
+}
+
+/*
+V2WatchOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2WatchOK struct {
+	Payload io.Writer
+}
+
+// IsSuccess returns true when this v2 watch o k response has a 2xx status code
+func (o *V2WatchOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 watch o k response has a 3xx status code
+func (o *V2WatchOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 watch o k response has a 4xx status code
+func (o *V2WatchOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 watch o k response has a 5xx status code
+func (o *V2WatchOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 watch o k response a status code equal to that given
+func (o *V2WatchOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2WatchOK) Error() string {
+	return fmt.Sprintf("[GET /v2/watch][%d] v2WatchOK  %+v", 200, o.Payload)
+}
+
+func (o *V2WatchOK) String() string {
+	return fmt.Sprintf("[GET /v2/watch][%d] v2WatchOK  %+v", 200, o.Payload)
+}
+
+func (o *V2WatchOK) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *V2WatchOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2WatchBadRequest creates a V2WatchBadRequest with default headers values
+func NewV2WatchBadRequest() *V2WatchBadRequest {
+	return &V2WatchBadRequest{}
+}
+
+/*
+V2WatchBadRequest describes a response with status code 400, with default header values.
+
+Bad Request.
+*/
+type V2WatchBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 watch bad request response has a 2xx status code
+func (o *V2WatchBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 watch bad request response has a 3xx status code
+func (o *V2WatchBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 watch bad request response has a 4xx status code
+func (o *V2WatchBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 watch bad request response has a 5xx status code
+func (o *V2WatchBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 watch bad request response a status code equal to that given
+func (o *V2WatchBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2WatchBadRequest) Error() string {
+	return fmt.Sprintf("[GET /v2/watch][%d] v2WatchBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2WatchBadRequest) String() string {
+	return fmt.Sprintf("[GET /v2/watch][%d] v2WatchBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2WatchBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2WatchBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2WatchUnauthorized creates a V2WatchUnauthorized with default headers values
+func NewV2WatchUnauthorized() *V2WatchUnauthorized {
+	return &V2WatchUnauthorized{}
+}
+
+/*
+V2WatchUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2WatchUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 watch unauthorized response has a 2xx status code
+func (o *V2WatchUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 watch unauthorized response has a 3xx status code
+func (o *V2WatchUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 watch unauthorized response has a 4xx status code
+func (o *V2WatchUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 watch unauthorized response has a 5xx status code
+func (o *V2WatchUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 watch unauthorized response a status code equal to that given
+func (o *V2WatchUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2WatchUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/watch][%d] v2WatchUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2WatchUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/watch][%d] v2WatchUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2WatchUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2WatchUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2WatchForbidden creates a V2WatchForbidden with default headers values
+func NewV2WatchForbidden() *V2WatchForbidden {
+	return &V2WatchForbidden{}
+}
+
+/*
+V2WatchForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2WatchForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 watch forbidden response has a 2xx status code
+func (o *V2WatchForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 watch forbidden response has a 3xx status code
+func (o *V2WatchForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 watch forbidden response has a 4xx status code
+func (o *V2WatchForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 watch forbidden response has a 5xx status code
+func (o *V2WatchForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 watch forbidden response a status code equal to that given
+func (o *V2WatchForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2WatchForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/watch][%d] v2WatchForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2WatchForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/watch][%d] v2WatchForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2WatchForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2WatchForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2WatchNotFound creates a V2WatchNotFound with default headers values
+func NewV2WatchNotFound() *V2WatchNotFound {
+	return &V2WatchNotFound{}
+}
+
+/*
+V2WatchNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2WatchNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 watch not found response has a 2xx status code
+func (o *V2WatchNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 watch not found response has a 3xx status code
+func (o *V2WatchNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 watch not found response has a 4xx status code
+func (o *V2WatchNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 watch not found response has a 5xx status code
+func (o *V2WatchNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 watch not found response a status code equal to that given
+func (o *V2WatchNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2WatchNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/watch][%d] v2WatchNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2WatchNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/watch][%d] v2WatchNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2WatchNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2WatchNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2WatchInternalServerError creates a V2WatchInternalServerError with default headers values
+func NewV2WatchInternalServerError() *V2WatchInternalServerError {
+	return &V2WatchInternalServerError{}
+}
+
+/*
+V2WatchInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2WatchInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 watch internal server error response has a 2xx status code
+func (o *V2WatchInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 watch internal server error response has a 3xx status code
+func (o *V2WatchInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 watch internal server error response has a 4xx status code
+func (o *V2WatchInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 watch internal server error response has a 5xx status code
+func (o *V2WatchInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 watch internal server error response a status code equal to that given
+func (o *V2WatchInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2WatchInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/watch][%d] v2WatchInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2WatchInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/watch][%d] v2WatchInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2WatchInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2WatchInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2WatchNotImplemented creates a V2WatchNotImplemented with default headers values
+func NewV2WatchNotImplemented() *V2WatchNotImplemented {
+	return &V2WatchNotImplemented{}
+}
+
+/*
+V2WatchNotImplemented describes a response with status code 501, with default header values.
+
+Not implemented.
+*/
+type V2WatchNotImplemented struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 watch not implemented response has a 2xx status code
+func (o *V2WatchNotImplemented) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 watch not implemented response has a 3xx status code
+func (o *V2WatchNotImplemented) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 watch not implemented response has a 4xx status code
+func (o *V2WatchNotImplemented) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 watch not implemented response has a 5xx status code
+func (o *V2WatchNotImplemented) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 watch not implemented response a status code equal to that given
+func (o *V2WatchNotImplemented) IsCode(code int) bool {
+	return code == 501
+}
+
+func (o *V2WatchNotImplemented) Error() string {
+	return fmt.Sprintf("[GET /v2/watch][%d] v2WatchNotImplemented  %+v", 501, o.Payload)
+}
+
+func (o *V2WatchNotImplemented) String() string {
+	return fmt.Sprintf("[GET /v2/watch][%d] v2WatchNotImplemented  %+v", 501, o.Payload)
+}
+
+func (o *V2WatchNotImplemented) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2WatchNotImplemented) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -109,6 +109,14 @@ type API interface {
 	/*
 	   V2UploadLogs Agent API to upload logs.*/
 	V2UploadLogs(ctx context.Context, params *V2UploadLogsParams) (*V2UploadLogsNoContent, error)
+	/*
+	   V2AcceptHostHardware Accepts the current hardware of a host whose hardware changed after its validations passed, so that the
+	   hardware drift no longer blocks the installation.
+	*/
+	V2AcceptHostHardware(ctx context.Context, params *V2AcceptHostHardwareParams) (*V2AcceptHostHardwareOK, error)
+	/*
+	   V2BulkUpdateHosts Applies a list of operations to the hosts of the infra-env. The operations that update the hosts in place are applied in a single transaction, so either all of them are applied or none is. Bind and unbind operations are applied one by one before them. Each operation is subject to the same validations as the equivalent single host call.*/
+	V2BulkUpdateHosts(ctx context.Context, params *V2BulkUpdateHostsParams) (*V2BulkUpdateHostsOK, error)
 	/*
 	   V2CompleteInstallation Agent API to mark a finalizing installation as complete and progress to 100%.*/
 	V2CompleteInstallation(ctx context.Context, params *V2CompleteInstallationParams) (*V2CompleteInstallationAccepted, error)
@@ -124,12 +132,26 @@ type API interface {
 	/*
 	   V2DownloadInfraEnvFiles Downloads the customized ignition file for this host*/
 	V2DownloadInfraEnvFiles(ctx context.Context, params *V2DownloadInfraEnvFilesParams, writer io.Writer) (*V2DownloadInfraEnvFilesOK, error)
+	/*
+	   V2DryRunInstallCluster Renders the install-config, the install manifests and ignitions, the operator manifests and the installer
+	   arguments of each host, as the installation of the cluster would, and returns them as a tar.gz bundle.
+	   The cluster is not modified and its stored files are not changed. Generation errors are listed in the
+	   dry-run-report.json file of the bundle.
+	*/
+	V2DryRunInstallCluster(ctx context.Context, params *V2DryRunInstallClusterParams, writer io.Writer) (*V2DryRunInstallClusterOK, error)
 	/*
 	   V2GetCluster Retrieves the details of the OpenShift cluster.*/
 	V2GetCluster(ctx context.Context, params *V2GetClusterParams) (*V2GetClusterOK, error)
 	/*
 	   V2GetClusterInstallConfig Get the cluster's install config YAML.*/
 	V2GetClusterInstallConfig(ctx context.Context, params *V2GetClusterInstallConfigParams) (*V2GetClusterInstallConfigOK, error)
+	/*
+	   V2GetClusterNetworkTopology Returns the network topology of the hosts of the cluster, as discovered by their connectivity checks: the
+	   interfaces and subnets of the hosts, the L2 and L3 links between them with their latency and packet loss, and
+	   the majority groups of the cluster. The topology is returned as JSON, or as a Graphviz DOT graph when the dot
+	   format is requested.
+	*/
+	V2GetClusterNetworkTopology(ctx context.Context, params *V2GetClusterNetworkTopologyParams) (*V2GetClusterNetworkTopologyOK, error)
 	/*
 	   V2GetHost Retrieves the details of the OpenShift host.*/
 	V2GetHost(ctx context.Context, params *V2GetHostParams) (*V2GetHostOK, error)
@@ -157,6 +179,12 @@ type API interface {
 	/*
 	   V2ListClusters Retrieves the list of OpenShift clusters.*/
 	V2ListClusters(ctx context.Context, params *V2ListClustersParams) (*V2ListClustersOK, error)
+	/*
+	   V2ListHostHardwareRevisions Lists the revisions of the hardware of the host, newest first, with the changes of each revision from the
+	   previous one. A revision is recorded whenever the inventory of the host reports a different hardware
+	   fingerprint.
+	*/
+	V2ListHostHardwareRevisions(ctx context.Context, params *V2ListHostHardwareRevisionsParams) (*V2ListHostHardwareRevisionsOK, error)
 	/*
 	   V2ListHosts Retrieves the list of OpenShift hosts that belong the infra-env.*/
 	V2ListHosts(ctx context.Context, params *V2ListHostsParams) (*V2ListHostsOK, error)
@@ -989,6 +1017,57 @@ func (a *Client) V2UploadLogs(ctx context.Context, params *V2UploadLogsParams) (
 
 }
 
+/*
+V2AcceptHostHardware Accepts the current hardware of a host whose hardware changed after its validations passed, so that the
+hardware drift no longer blocks the installation.
+*/
+func (a *Client) V2AcceptHostHardware(ctx context.Context, params *V2AcceptHostHardwareParams) (*V2AcceptHostHardwareOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2AcceptHostHardware",
+		Method:             "POST",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/accept-hardware",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2AcceptHostHardwareReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2AcceptHostHardwareOK), nil
+
+}
+
+/*
+V2BulkUpdateHosts Applies a list of operations to the hosts of the infra-env. The operations that update the hosts in place are applied in a single transaction, so either all of them are applied or none is. Bind and unbind operations are applied one by one before them. Each operation is subject to the same validations as the equivalent single host call.
+*/
+func (a *Client) V2BulkUpdateHosts(ctx context.Context, params *V2BulkUpdateHostsParams) (*V2BulkUpdateHostsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2BulkUpdateHosts",
+		Method:             "POST",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/actions/bulk",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2BulkUpdateHostsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2BulkUpdateHostsOK), nil
+
+}
+
 /*
 V2CompleteInstallation Agent API to mark a finalizing installation as complete and progress to 100%.
 */
@@ -1114,6 +1193,34 @@ func (a *Client) V2DownloadInfraEnvFiles(ctx context.Context, params *V2Download
 
 }
 
+/*
+V2DryRunInstallCluster Renders the install-config, the install manifests and ignitions, the operator manifests and the installer
+arguments of each host, as the installation of the cluster would, and returns them as a tar.gz bundle.
+The cluster is not modified and its stored files are not changed. Generation errors are listed in the
+dry-run-report.json file of the bundle.
+*/
+func (a *Client) V2DryRunInstallCluster(ctx context.Context, params *V2DryRunInstallClusterParams, writer io.Writer) (*V2DryRunInstallClusterOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DryRunInstallCluster",
+		Method:             "POST",
+		PathPattern:        "/v2/clusters/{cluster_id}/actions/dry-run",
+		ProducesMediaTypes: []string{"application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DryRunInstallClusterReader{formats: a.formats, writer: writer},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DryRunInstallClusterOK), nil
+
+}
+
 /*
 V2GetCluster Retrieves the details of the OpenShift cluster.
 */
@@ -1164,6 +1271,34 @@ func (a *Client) V2GetClusterInstallConfig(ctx context.Context, params *V2GetClu
 
 }
 
+/*
+V2GetClusterNetworkTopology Returns the network topology of the hosts of the cluster, as discovered by their connectivity checks: the
+interfaces and subnets of the hosts, the L2 and L3 links between them with their latency and packet loss, and
+the majority groups of the cluster. The topology is returned as JSON, or as a Graphviz DOT graph when the dot
+format is requested.
+*/
+func (a *Client) V2GetClusterNetworkTopology(ctx context.Context, params *V2GetClusterNetworkTopologyParams) (*V2GetClusterNetworkTopologyOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetClusterNetworkTopology",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/network-topology",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetClusterNetworkTopologyReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetClusterNetworkTopologyOK), nil
+
+}
+
 /*
 V2GetHost Retrieves the details of the OpenShift host.
 */
@@ -1389,6 +1524,33 @@ func (a *Client) V2ListClusters(ctx context.Context, params *V2ListClustersParam
 
 }
 
+/*
+V2ListHostHardwareRevisions Lists the revisions of the hardware of the host, newest first, with the changes of each revision from the
+previous one. A revision is recorded whenever the inventory of the host reports a different hardware
+fingerprint.
+*/
+func (a *Client) V2ListHostHardwareRevisions(ctx context.Context, params *V2ListHostHardwareRevisionsParams) (*V2ListHostHardwareRevisionsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListHostHardwareRevisions",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/hardware-revisions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListHostHardwareRevisionsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListHostHardwareRevisionsOK), nil
+
+}
+
 /*
 V2ListHosts Retrieves the list of OpenShift hosts that belong the infra-env.
 */
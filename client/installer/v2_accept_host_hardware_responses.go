@@ -0,0 +1,374 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2AcceptHostHardwareReader is a Reader for the V2AcceptHostHardware structure.
+type V2AcceptHostHardwareReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2AcceptHostHardwareReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2AcceptHostHardwareOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2AcceptHostHardwareUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2AcceptHostHardwareForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2AcceptHostHardwareNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2AcceptHostHardwareInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2AcceptHostHardwareOK creates a V2AcceptHostHardwareOK with default headers values
+func NewV2AcceptHostHardwareOK() *V2AcceptHostHardwareOK {
+	return &V2AcceptHostHardwareOK{}
+}
+
+/*
+V2AcceptHostHardwareOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2AcceptHostHardwareOK struct {
+	Payload *models.Host
+}
+
+// IsSuccess returns true when this v2 accept host hardware o k response has a 2xx status code
+func (o *V2AcceptHostHardwareOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 accept host hardware o k response has a 3xx status code
+func (o *V2AcceptHostHardwareOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 accept host hardware o k response has a 4xx status code
+func (o *V2AcceptHostHardwareOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 accept host hardware o k response has a 5xx status code
+func (o *V2AcceptHostHardwareOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 accept host hardware o k response a status code equal to that given
+func (o *V2AcceptHostHardwareOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2AcceptHostHardwareOK) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/accept-hardware][%d] v2AcceptHostHardwareOK  %+v", 200, o.Payload)
+}
+
+func (o *V2AcceptHostHardwareOK) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/accept-hardware][%d] v2AcceptHostHardwareOK  %+v", 200, o.Payload)
+}
+
+func (o *V2AcceptHostHardwareOK) GetPayload() *models.Host {
+	return o.Payload
+}
+
+func (o *V2AcceptHostHardwareOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Host)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2AcceptHostHardwareUnauthorized creates a V2AcceptHostHardwareUnauthorized with default headers values
+func NewV2AcceptHostHardwareUnauthorized() *V2AcceptHostHardwareUnauthorized {
+	return &V2AcceptHostHardwareUnauthorized{}
+}
+
+/*
+V2AcceptHostHardwareUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2AcceptHostHardwareUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 accept host hardware unauthorized response has a 2xx status code
+func (o *V2AcceptHostHardwareUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 accept host hardware unauthorized response has a 3xx status code
+func (o *V2AcceptHostHardwareUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 accept host hardware unauthorized response has a 4xx status code
+func (o *V2AcceptHostHardwareUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 accept host hardware unauthorized response has a 5xx status code
+func (o *V2AcceptHostHardwareUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 accept host hardware unauthorized response a status code equal to that given
+func (o *V2AcceptHostHardwareUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2AcceptHostHardwareUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/accept-hardware][%d] v2AcceptHostHardwareUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2AcceptHostHardwareUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/accept-hardware][%d] v2AcceptHostHardwareUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2AcceptHostHardwareUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2AcceptHostHardwareUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2AcceptHostHardwareForbidden creates a V2AcceptHostHardwareForbidden with default headers values
+func NewV2AcceptHostHardwareForbidden() *V2AcceptHostHardwareForbidden {
+	return &V2AcceptHostHardwareForbidden{}
+}
+
+/*
+V2AcceptHostHardwareForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2AcceptHostHardwareForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 accept host hardware forbidden response has a 2xx status code
+func (o *V2AcceptHostHardwareForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 accept host hardware forbidden response has a 3xx status code
+func (o *V2AcceptHostHardwareForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 accept host hardware forbidden response has a 4xx status code
+func (o *V2AcceptHostHardwareForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 accept host hardware forbidden response has a 5xx status code
+func (o *V2AcceptHostHardwareForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 accept host hardware forbidden response a status code equal to that given
+func (o *V2AcceptHostHardwareForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2AcceptHostHardwareForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/accept-hardware][%d] v2AcceptHostHardwareForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2AcceptHostHardwareForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/accept-hardware][%d] v2AcceptHostHardwareForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2AcceptHostHardwareForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2AcceptHostHardwareForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2AcceptHostHardwareNotFound creates a V2AcceptHostHardwareNotFound with default headers values
+func NewV2AcceptHostHardwareNotFound() *V2AcceptHostHardwareNotFound {
+	return &V2AcceptHostHardwareNotFound{}
+}
+
+/*
+V2AcceptHostHardwareNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2AcceptHostHardwareNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 accept host hardware not found response has a 2xx status code
+func (o *V2AcceptHostHardwareNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 accept host hardware not found response has a 3xx status code
+func (o *V2AcceptHostHardwareNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 accept host hardware not found response has a 4xx status code
+func (o *V2AcceptHostHardwareNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 accept host hardware not found response has a 5xx status code
+func (o *V2AcceptHostHardwareNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 accept host hardware not found response a status code equal to that given
+func (o *V2AcceptHostHardwareNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2AcceptHostHardwareNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/accept-hardware][%d] v2AcceptHostHardwareNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2AcceptHostHardwareNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/accept-hardware][%d] v2AcceptHostHardwareNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2AcceptHostHardwareNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2AcceptHostHardwareNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2AcceptHostHardwareInternalServerError creates a V2AcceptHostHardwareInternalServerError with default headers values
+func NewV2AcceptHostHardwareInternalServerError() *V2AcceptHostHardwareInternalServerError {
+	return &V2AcceptHostHardwareInternalServerError{}
+}
+
+/*
+V2AcceptHostHardwareInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2AcceptHostHardwareInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 accept host hardware internal server error response has a 2xx status code
+func (o *V2AcceptHostHardwareInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 accept host hardware internal server error response has a 3xx status code
+func (o *V2AcceptHostHardwareInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 accept host hardware internal server error response has a 4xx status code
+func (o *V2AcceptHostHardwareInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 accept host hardware internal server error response has a 5xx status code
+func (o *V2AcceptHostHardwareInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 accept host hardware internal server error response a status code equal to that given
+func (o *V2AcceptHostHardwareInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2AcceptHostHardwareInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/accept-hardware][%d] v2AcceptHostHardwareInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2AcceptHostHardwareInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/accept-hardware][%d] v2AcceptHostHardwareInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2AcceptHostHardwareInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2AcceptHostHardwareInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
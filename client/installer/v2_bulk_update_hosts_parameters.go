@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2BulkUpdateHostsParams creates a new V2BulkUpdateHostsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2BulkUpdateHostsParams() *V2BulkUpdateHostsParams {
+	return &V2BulkUpdateHostsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2BulkUpdateHostsParamsWithTimeout creates a new V2BulkUpdateHostsParams object
+// with the ability to set a timeout on a request.
+func NewV2BulkUpdateHostsParamsWithTimeout(timeout time.Duration) *V2BulkUpdateHostsParams {
+	return &V2BulkUpdateHostsParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2BulkUpdateHostsParamsWithContext creates a new V2BulkUpdateHostsParams object
+// with the ability to set a context for a request.
+func NewV2BulkUpdateHostsParamsWithContext(ctx context.Context) *V2BulkUpdateHostsParams {
+	return &V2BulkUpdateHostsParams{
+		Context: ctx,
+	}
+}
+
+// NewV2BulkUpdateHostsParamsWithHTTPClient creates a new V2BulkUpdateHostsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2BulkUpdateHostsParamsWithHTTPClient(client *http.Client) *V2BulkUpdateHostsParams {
+	return &V2BulkUpdateHostsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2BulkUpdateHostsParams contains all the parameters to send to the API endpoint
+
+	for the v2 bulk update hosts operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2BulkUpdateHostsParams struct {
+
+	/* BulkHostOperations.
+
+	   The operations to apply.
+	*/
+	BulkHostOperations *models.BulkHostOperations
+
+	/* InfraEnvID.
+
+	   The infra-env of the hosts.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 bulk update hosts params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2BulkUpdateHostsParams) WithDefaults() *V2BulkUpdateHostsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 bulk update hosts params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2BulkUpdateHostsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 bulk update hosts params
+func (o *V2BulkUpdateHostsParams) WithTimeout(timeout time.Duration) *V2BulkUpdateHostsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 bulk update hosts params
+func (o *V2BulkUpdateHostsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 bulk update hosts params
+func (o *V2BulkUpdateHostsParams) WithContext(ctx context.Context) *V2BulkUpdateHostsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 bulk update hosts params
+func (o *V2BulkUpdateHostsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 bulk update hosts params
+func (o *V2BulkUpdateHostsParams) WithHTTPClient(client *http.Client) *V2BulkUpdateHostsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 bulk update hosts params
+func (o *V2BulkUpdateHostsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBulkHostOperations adds the bulkHostOperations to the v2 bulk update hosts params
+func (o *V2BulkUpdateHostsParams) WithBulkHostOperations(bulkHostOperations *models.BulkHostOperations) *V2BulkUpdateHostsParams {
+	o.SetBulkHostOperations(bulkHostOperations)
+	return o
+}
+
+// SetBulkHostOperations adds the bulkHostOperations to the v2 bulk update hosts params
+func (o *V2BulkUpdateHostsParams) SetBulkHostOperations(bulkHostOperations *models.BulkHostOperations) {
+	o.BulkHostOperations = bulkHostOperations
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 bulk update hosts params
+func (o *V2BulkUpdateHostsParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2BulkUpdateHostsParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 bulk update hosts params
+func (o *V2BulkUpdateHostsParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2BulkUpdateHostsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.BulkHostOperations != nil {
+		if err := r.SetBodyParam(o.BulkHostOperations); err != nil {
+			return err
+		}
+	}
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
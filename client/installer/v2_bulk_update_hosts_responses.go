@@ -0,0 +1,443 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2BulkUpdateHostsReader is a Reader for the V2BulkUpdateHosts structure.
+type V2BulkUpdateHostsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2BulkUpdateHostsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2BulkUpdateHostsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2BulkUpdateHostsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2BulkUpdateHostsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2BulkUpdateHostsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2BulkUpdateHostsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2BulkUpdateHostsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2BulkUpdateHostsOK creates a V2BulkUpdateHostsOK with default headers values
+func NewV2BulkUpdateHostsOK() *V2BulkUpdateHostsOK {
+	return &V2BulkUpdateHostsOK{}
+}
+
+/*
+V2BulkUpdateHostsOK describes a response with status code 200, with default header values.
+
+The result of each operation.
+*/
+type V2BulkUpdateHostsOK struct {
+	Payload *models.BulkHostOperationsResult
+}
+
+// IsSuccess returns true when this v2 bulk update hosts o k response has a 2xx status code
+func (o *V2BulkUpdateHostsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 bulk update hosts o k response has a 3xx status code
+func (o *V2BulkUpdateHostsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bulk update hosts o k response has a 4xx status code
+func (o *V2BulkUpdateHostsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 bulk update hosts o k response has a 5xx status code
+func (o *V2BulkUpdateHostsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 bulk update hosts o k response a status code equal to that given
+func (o *V2BulkUpdateHostsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2BulkUpdateHostsOK) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/actions/bulk][%d] v2BulkUpdateHostsOK  %+v", 200, o.Payload)
+}
+
+func (o *V2BulkUpdateHostsOK) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/actions/bulk][%d] v2BulkUpdateHostsOK  %+v", 200, o.Payload)
+}
+
+func (o *V2BulkUpdateHostsOK) GetPayload() *models.BulkHostOperationsResult {
+	return o.Payload
+}
+
+func (o *V2BulkUpdateHostsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.BulkHostOperationsResult)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2BulkUpdateHostsBadRequest creates a V2BulkUpdateHostsBadRequest with default headers values
+func NewV2BulkUpdateHostsBadRequest() *V2BulkUpdateHostsBadRequest {
+	return &V2BulkUpdateHostsBadRequest{}
+}
+
+/*
+V2BulkUpdateHostsBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2BulkUpdateHostsBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 bulk update hosts bad request response has a 2xx status code
+func (o *V2BulkUpdateHostsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 bulk update hosts bad request response has a 3xx status code
+func (o *V2BulkUpdateHostsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bulk update hosts bad request response has a 4xx status code
+func (o *V2BulkUpdateHostsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 bulk update hosts bad request response has a 5xx status code
+func (o *V2BulkUpdateHostsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 bulk update hosts bad request response a status code equal to that given
+func (o *V2BulkUpdateHostsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2BulkUpdateHostsBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/actions/bulk][%d] v2BulkUpdateHostsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2BulkUpdateHostsBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/actions/bulk][%d] v2BulkUpdateHostsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2BulkUpdateHostsBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2BulkUpdateHostsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2BulkUpdateHostsUnauthorized creates a V2BulkUpdateHostsUnauthorized with default headers values
+func NewV2BulkUpdateHostsUnauthorized() *V2BulkUpdateHostsUnauthorized {
+	return &V2BulkUpdateHostsUnauthorized{}
+}
+
+/*
+V2BulkUpdateHostsUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2BulkUpdateHostsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 bulk update hosts unauthorized response has a 2xx status code
+func (o *V2BulkUpdateHostsUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 bulk update hosts unauthorized response has a 3xx status code
+func (o *V2BulkUpdateHostsUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bulk update hosts unauthorized response has a 4xx status code
+func (o *V2BulkUpdateHostsUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 bulk update hosts unauthorized response has a 5xx status code
+func (o *V2BulkUpdateHostsUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 bulk update hosts unauthorized response a status code equal to that given
+func (o *V2BulkUpdateHostsUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2BulkUpdateHostsUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/actions/bulk][%d] v2BulkUpdateHostsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2BulkUpdateHostsUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/actions/bulk][%d] v2BulkUpdateHostsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2BulkUpdateHostsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2BulkUpdateHostsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2BulkUpdateHostsForbidden creates a V2BulkUpdateHostsForbidden with default headers values
+func NewV2BulkUpdateHostsForbidden() *V2BulkUpdateHostsForbidden {
+	return &V2BulkUpdateHostsForbidden{}
+}
+
+/*
+V2BulkUpdateHostsForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2BulkUpdateHostsForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 bulk update hosts forbidden response has a 2xx status code
+func (o *V2BulkUpdateHostsForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 bulk update hosts forbidden response has a 3xx status code
+func (o *V2BulkUpdateHostsForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bulk update hosts forbidden response has a 4xx status code
+func (o *V2BulkUpdateHostsForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 bulk update hosts forbidden response has a 5xx status code
+func (o *V2BulkUpdateHostsForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 bulk update hosts forbidden response a status code equal to that given
+func (o *V2BulkUpdateHostsForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2BulkUpdateHostsForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/actions/bulk][%d] v2BulkUpdateHostsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2BulkUpdateHostsForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/actions/bulk][%d] v2BulkUpdateHostsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2BulkUpdateHostsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2BulkUpdateHostsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2BulkUpdateHostsNotFound creates a V2BulkUpdateHostsNotFound with default headers values
+func NewV2BulkUpdateHostsNotFound() *V2BulkUpdateHostsNotFound {
+	return &V2BulkUpdateHostsNotFound{}
+}
+
+/*
+V2BulkUpdateHostsNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2BulkUpdateHostsNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 bulk update hosts not found response has a 2xx status code
+func (o *V2BulkUpdateHostsNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 bulk update hosts not found response has a 3xx status code
+func (o *V2BulkUpdateHostsNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bulk update hosts not found response has a 4xx status code
+func (o *V2BulkUpdateHostsNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 bulk update hosts not found response has a 5xx status code
+func (o *V2BulkUpdateHostsNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 bulk update hosts not found response a status code equal to that given
+func (o *V2BulkUpdateHostsNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2BulkUpdateHostsNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/actions/bulk][%d] v2BulkUpdateHostsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2BulkUpdateHostsNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/actions/bulk][%d] v2BulkUpdateHostsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2BulkUpdateHostsNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2BulkUpdateHostsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2BulkUpdateHostsInternalServerError creates a V2BulkUpdateHostsInternalServerError with default headers values
+func NewV2BulkUpdateHostsInternalServerError() *V2BulkUpdateHostsInternalServerError {
+	return &V2BulkUpdateHostsInternalServerError{}
+}
+
+/*
+V2BulkUpdateHostsInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2BulkUpdateHostsInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 bulk update hosts internal server error response has a 2xx status code
+func (o *V2BulkUpdateHostsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 bulk update hosts internal server error response has a 3xx status code
+func (o *V2BulkUpdateHostsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bulk update hosts internal server error response has a 4xx status code
+func (o *V2BulkUpdateHostsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 bulk update hosts internal server error response has a 5xx status code
+func (o *V2BulkUpdateHostsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 bulk update hosts internal server error response a status code equal to that given
+func (o *V2BulkUpdateHostsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2BulkUpdateHostsInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/actions/bulk][%d] v2BulkUpdateHostsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2BulkUpdateHostsInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/actions/bulk][%d] v2BulkUpdateHostsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2BulkUpdateHostsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2BulkUpdateHostsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
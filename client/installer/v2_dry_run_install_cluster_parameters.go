@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2DryRunInstallClusterParams creates a new V2DryRunInstallClusterParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2DryRunInstallClusterParams() *V2DryRunInstallClusterParams {
+	return &V2DryRunInstallClusterParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2DryRunInstallClusterParamsWithTimeout creates a new V2DryRunInstallClusterParams object
+// with the ability to set a timeout on a request.
+func NewV2DryRunInstallClusterParamsWithTimeout(timeout time.Duration) *V2DryRunInstallClusterParams {
+	return &V2DryRunInstallClusterParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2DryRunInstallClusterParamsWithContext creates a new V2DryRunInstallClusterParams object
+// with the ability to set a context for a request.
+func NewV2DryRunInstallClusterParamsWithContext(ctx context.Context) *V2DryRunInstallClusterParams {
+	return &V2DryRunInstallClusterParams{
+		Context: ctx,
+	}
+}
+
+// NewV2DryRunInstallClusterParamsWithHTTPClient creates a new V2DryRunInstallClusterParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2DryRunInstallClusterParamsWithHTTPClient(client *http.Client) *V2DryRunInstallClusterParams {
+	return &V2DryRunInstallClusterParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2DryRunInstallClusterParams contains all the parameters to send to the API endpoint
+
+	for the v2 dry run install cluster operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2DryRunInstallClusterParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose installation is rendered.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 dry run install cluster params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DryRunInstallClusterParams) WithDefaults() *V2DryRunInstallClusterParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 dry run install cluster params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DryRunInstallClusterParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 dry run install cluster params
+func (o *V2DryRunInstallClusterParams) WithTimeout(timeout time.Duration) *V2DryRunInstallClusterParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 dry run install cluster params
+func (o *V2DryRunInstallClusterParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 dry run install cluster params
+func (o *V2DryRunInstallClusterParams) WithContext(ctx context.Context) *V2DryRunInstallClusterParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 dry run install cluster params
+func (o *V2DryRunInstallClusterParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 dry run install cluster params
+func (o *V2DryRunInstallClusterParams) WithHTTPClient(client *http.Client) *V2DryRunInstallClusterParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 dry run install cluster params
+func (o *V2DryRunInstallClusterParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 dry run install cluster params
+func (o *V2DryRunInstallClusterParams) WithClusterID(clusterID strfmt.UUID) *V2DryRunInstallClusterParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 dry run install cluster params
+func (o *V2DryRunInstallClusterParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2DryRunInstallClusterParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
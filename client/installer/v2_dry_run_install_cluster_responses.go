@@ -0,0 +1,583 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DryRunInstallClusterReader is a Reader for the V2DryRunInstallCluster structure.
+type V2DryRunInstallClusterReader struct {
+	formats strfmt.Registry
+	writer  io.Writer
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2DryRunInstallClusterReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2DryRunInstallClusterOK(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2DryRunInstallClusterBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2DryRunInstallClusterUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2DryRunInstallClusterForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2DryRunInstallClusterNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2DryRunInstallClusterMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewV2DryRunInstallClusterConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2DryRunInstallClusterInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2DryRunInstallClusterOK creates a V2DryRunInstallClusterOK with default headers values
+func NewV2DryRunInstallClusterOK(writer io.Writer) *V2DryRunInstallClusterOK {
+	return &V2DryRunInstallClusterOK{
+
+		Payload: writer,
+	}
+}
+
+/*
+V2DryRunInstallClusterOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2DryRunInstallClusterOK struct {
+	Payload io.Writer
+}
+
+// IsSuccess returns true when this v2 dry run install cluster o k response has a 2xx status code
+func (o *V2DryRunInstallClusterOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 dry run install cluster o k response has a 3xx status code
+func (o *V2DryRunInstallClusterOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 dry run install cluster o k response has a 4xx status code
+func (o *V2DryRunInstallClusterOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 dry run install cluster o k response has a 5xx status code
+func (o *V2DryRunInstallClusterOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 dry run install cluster o k response a status code equal to that given
+func (o *V2DryRunInstallClusterOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2DryRunInstallClusterOK) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallClusterOK  %+v", 200, o.Payload)
+}
+
+func (o *V2DryRunInstallClusterOK) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallClusterOK  %+v", 200, o.Payload)
+}
+
+func (o *V2DryRunInstallClusterOK) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *V2DryRunInstallClusterOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DryRunInstallClusterBadRequest creates a V2DryRunInstallClusterBadRequest with default headers values
+func NewV2DryRunInstallClusterBadRequest() *V2DryRunInstallClusterBadRequest {
+	return &V2DryRunInstallClusterBadRequest{}
+}
+
+/*
+V2DryRunInstallClusterBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2DryRunInstallClusterBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 dry run install cluster bad request response has a 2xx status code
+func (o *V2DryRunInstallClusterBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 dry run install cluster bad request response has a 3xx status code
+func (o *V2DryRunInstallClusterBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 dry run install cluster bad request response has a 4xx status code
+func (o *V2DryRunInstallClusterBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 dry run install cluster bad request response has a 5xx status code
+func (o *V2DryRunInstallClusterBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 dry run install cluster bad request response a status code equal to that given
+func (o *V2DryRunInstallClusterBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2DryRunInstallClusterBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallClusterBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2DryRunInstallClusterBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallClusterBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2DryRunInstallClusterBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DryRunInstallClusterBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DryRunInstallClusterUnauthorized creates a V2DryRunInstallClusterUnauthorized with default headers values
+func NewV2DryRunInstallClusterUnauthorized() *V2DryRunInstallClusterUnauthorized {
+	return &V2DryRunInstallClusterUnauthorized{}
+}
+
+/*
+V2DryRunInstallClusterUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2DryRunInstallClusterUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 dry run install cluster unauthorized response has a 2xx status code
+func (o *V2DryRunInstallClusterUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 dry run install cluster unauthorized response has a 3xx status code
+func (o *V2DryRunInstallClusterUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 dry run install cluster unauthorized response has a 4xx status code
+func (o *V2DryRunInstallClusterUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 dry run install cluster unauthorized response has a 5xx status code
+func (o *V2DryRunInstallClusterUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 dry run install cluster unauthorized response a status code equal to that given
+func (o *V2DryRunInstallClusterUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2DryRunInstallClusterUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallClusterUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DryRunInstallClusterUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallClusterUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DryRunInstallClusterUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DryRunInstallClusterUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DryRunInstallClusterForbidden creates a V2DryRunInstallClusterForbidden with default headers values
+func NewV2DryRunInstallClusterForbidden() *V2DryRunInstallClusterForbidden {
+	return &V2DryRunInstallClusterForbidden{}
+}
+
+/*
+V2DryRunInstallClusterForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2DryRunInstallClusterForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 dry run install cluster forbidden response has a 2xx status code
+func (o *V2DryRunInstallClusterForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 dry run install cluster forbidden response has a 3xx status code
+func (o *V2DryRunInstallClusterForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 dry run install cluster forbidden response has a 4xx status code
+func (o *V2DryRunInstallClusterForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 dry run install cluster forbidden response has a 5xx status code
+func (o *V2DryRunInstallClusterForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 dry run install cluster forbidden response a status code equal to that given
+func (o *V2DryRunInstallClusterForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2DryRunInstallClusterForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallClusterForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DryRunInstallClusterForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallClusterForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DryRunInstallClusterForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DryRunInstallClusterForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DryRunInstallClusterNotFound creates a V2DryRunInstallClusterNotFound with default headers values
+func NewV2DryRunInstallClusterNotFound() *V2DryRunInstallClusterNotFound {
+	return &V2DryRunInstallClusterNotFound{}
+}
+
+/*
+V2DryRunInstallClusterNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2DryRunInstallClusterNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 dry run install cluster not found response has a 2xx status code
+func (o *V2DryRunInstallClusterNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 dry run install cluster not found response has a 3xx status code
+func (o *V2DryRunInstallClusterNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 dry run install cluster not found response has a 4xx status code
+func (o *V2DryRunInstallClusterNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 dry run install cluster not found response has a 5xx status code
+func (o *V2DryRunInstallClusterNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 dry run install cluster not found response a status code equal to that given
+func (o *V2DryRunInstallClusterNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2DryRunInstallClusterNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallClusterNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DryRunInstallClusterNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallClusterNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DryRunInstallClusterNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DryRunInstallClusterNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DryRunInstallClusterMethodNotAllowed creates a V2DryRunInstallClusterMethodNotAllowed with default headers values
+func NewV2DryRunInstallClusterMethodNotAllowed() *V2DryRunInstallClusterMethodNotAllowed {
+	return &V2DryRunInstallClusterMethodNotAllowed{}
+}
+
+/*
+V2DryRunInstallClusterMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2DryRunInstallClusterMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 dry run install cluster method not allowed response has a 2xx status code
+func (o *V2DryRunInstallClusterMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 dry run install cluster method not allowed response has a 3xx status code
+func (o *V2DryRunInstallClusterMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 dry run install cluster method not allowed response has a 4xx status code
+func (o *V2DryRunInstallClusterMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 dry run install cluster method not allowed response has a 5xx status code
+func (o *V2DryRunInstallClusterMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 dry run install cluster method not allowed response a status code equal to that given
+func (o *V2DryRunInstallClusterMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2DryRunInstallClusterMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallClusterMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2DryRunInstallClusterMethodNotAllowed) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallClusterMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2DryRunInstallClusterMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DryRunInstallClusterMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DryRunInstallClusterConflict creates a V2DryRunInstallClusterConflict with default headers values
+func NewV2DryRunInstallClusterConflict() *V2DryRunInstallClusterConflict {
+	return &V2DryRunInstallClusterConflict{}
+}
+
+/*
+V2DryRunInstallClusterConflict describes a response with status code 409, with default header values.
+
+Error.
+*/
+type V2DryRunInstallClusterConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 dry run install cluster conflict response has a 2xx status code
+func (o *V2DryRunInstallClusterConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 dry run install cluster conflict response has a 3xx status code
+func (o *V2DryRunInstallClusterConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 dry run install cluster conflict response has a 4xx status code
+func (o *V2DryRunInstallClusterConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 dry run install cluster conflict response has a 5xx status code
+func (o *V2DryRunInstallClusterConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 dry run install cluster conflict response a status code equal to that given
+func (o *V2DryRunInstallClusterConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+func (o *V2DryRunInstallClusterConflict) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallClusterConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2DryRunInstallClusterConflict) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallClusterConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2DryRunInstallClusterConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DryRunInstallClusterConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DryRunInstallClusterInternalServerError creates a V2DryRunInstallClusterInternalServerError with default headers values
+func NewV2DryRunInstallClusterInternalServerError() *V2DryRunInstallClusterInternalServerError {
+	return &V2DryRunInstallClusterInternalServerError{}
+}
+
+/*
+V2DryRunInstallClusterInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2DryRunInstallClusterInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 dry run install cluster internal server error response has a 2xx status code
+func (o *V2DryRunInstallClusterInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 dry run install cluster internal server error response has a 3xx status code
+func (o *V2DryRunInstallClusterInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 dry run install cluster internal server error response has a 4xx status code
+func (o *V2DryRunInstallClusterInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 dry run install cluster internal server error response has a 5xx status code
+func (o *V2DryRunInstallClusterInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 dry run install cluster internal server error response a status code equal to that given
+func (o *V2DryRunInstallClusterInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2DryRunInstallClusterInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallClusterInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DryRunInstallClusterInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallClusterInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DryRunInstallClusterInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DryRunInstallClusterInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,374 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetClusterNetworkTopologyReader is a Reader for the V2GetClusterNetworkTopology structure.
+type V2GetClusterNetworkTopologyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2GetClusterNetworkTopologyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2GetClusterNetworkTopologyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2GetClusterNetworkTopologyUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2GetClusterNetworkTopologyForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2GetClusterNetworkTopologyNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2GetClusterNetworkTopologyInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2GetClusterNetworkTopologyOK creates a V2GetClusterNetworkTopologyOK with default headers values
+func NewV2GetClusterNetworkTopologyOK() *V2GetClusterNetworkTopologyOK {
+	return &V2GetClusterNetworkTopologyOK{}
+}
+
+/*
+V2GetClusterNetworkTopologyOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2GetClusterNetworkTopologyOK struct {
+	Payload *models.NetworkTopology
+}
+
+// IsSuccess returns true when this v2 get cluster network topology o k response has a 2xx status code
+func (o *V2GetClusterNetworkTopologyOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 get cluster network topology o k response has a 3xx status code
+func (o *V2GetClusterNetworkTopologyOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster network topology o k response has a 4xx status code
+func (o *V2GetClusterNetworkTopologyOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get cluster network topology o k response has a 5xx status code
+func (o *V2GetClusterNetworkTopologyOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster network topology o k response a status code equal to that given
+func (o *V2GetClusterNetworkTopologyOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2GetClusterNetworkTopologyOK) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-topology][%d] v2GetClusterNetworkTopologyOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetClusterNetworkTopologyOK) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-topology][%d] v2GetClusterNetworkTopologyOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetClusterNetworkTopologyOK) GetPayload() *models.NetworkTopology {
+	return o.Payload
+}
+
+func (o *V2GetClusterNetworkTopologyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.NetworkTopology)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterNetworkTopologyUnauthorized creates a V2GetClusterNetworkTopologyUnauthorized with default headers values
+func NewV2GetClusterNetworkTopologyUnauthorized() *V2GetClusterNetworkTopologyUnauthorized {
+	return &V2GetClusterNetworkTopologyUnauthorized{}
+}
+
+/*
+V2GetClusterNetworkTopologyUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2GetClusterNetworkTopologyUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get cluster network topology unauthorized response has a 2xx status code
+func (o *V2GetClusterNetworkTopologyUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster network topology unauthorized response has a 3xx status code
+func (o *V2GetClusterNetworkTopologyUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster network topology unauthorized response has a 4xx status code
+func (o *V2GetClusterNetworkTopologyUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get cluster network topology unauthorized response has a 5xx status code
+func (o *V2GetClusterNetworkTopologyUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster network topology unauthorized response a status code equal to that given
+func (o *V2GetClusterNetworkTopologyUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2GetClusterNetworkTopologyUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-topology][%d] v2GetClusterNetworkTopologyUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetClusterNetworkTopologyUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-topology][%d] v2GetClusterNetworkTopologyUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetClusterNetworkTopologyUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetClusterNetworkTopologyUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterNetworkTopologyForbidden creates a V2GetClusterNetworkTopologyForbidden with default headers values
+func NewV2GetClusterNetworkTopologyForbidden() *V2GetClusterNetworkTopologyForbidden {
+	return &V2GetClusterNetworkTopologyForbidden{}
+}
+
+/*
+V2GetClusterNetworkTopologyForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2GetClusterNetworkTopologyForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get cluster network topology forbidden response has a 2xx status code
+func (o *V2GetClusterNetworkTopologyForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster network topology forbidden response has a 3xx status code
+func (o *V2GetClusterNetworkTopologyForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster network topology forbidden response has a 4xx status code
+func (o *V2GetClusterNetworkTopologyForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get cluster network topology forbidden response has a 5xx status code
+func (o *V2GetClusterNetworkTopologyForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster network topology forbidden response a status code equal to that given
+func (o *V2GetClusterNetworkTopologyForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2GetClusterNetworkTopologyForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-topology][%d] v2GetClusterNetworkTopologyForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetClusterNetworkTopologyForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-topology][%d] v2GetClusterNetworkTopologyForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetClusterNetworkTopologyForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetClusterNetworkTopologyForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterNetworkTopologyNotFound creates a V2GetClusterNetworkTopologyNotFound with default headers values
+func NewV2GetClusterNetworkTopologyNotFound() *V2GetClusterNetworkTopologyNotFound {
+	return &V2GetClusterNetworkTopologyNotFound{}
+}
+
+/*
+V2GetClusterNetworkTopologyNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2GetClusterNetworkTopologyNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get cluster network topology not found response has a 2xx status code
+func (o *V2GetClusterNetworkTopologyNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster network topology not found response has a 3xx status code
+func (o *V2GetClusterNetworkTopologyNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster network topology not found response has a 4xx status code
+func (o *V2GetClusterNetworkTopologyNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get cluster network topology not found response has a 5xx status code
+func (o *V2GetClusterNetworkTopologyNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster network topology not found response a status code equal to that given
+func (o *V2GetClusterNetworkTopologyNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2GetClusterNetworkTopologyNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-topology][%d] v2GetClusterNetworkTopologyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetClusterNetworkTopologyNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-topology][%d] v2GetClusterNetworkTopologyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetClusterNetworkTopologyNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetClusterNetworkTopologyNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterNetworkTopologyInternalServerError creates a V2GetClusterNetworkTopologyInternalServerError with default headers values
+func NewV2GetClusterNetworkTopologyInternalServerError() *V2GetClusterNetworkTopologyInternalServerError {
+	return &V2GetClusterNetworkTopologyInternalServerError{}
+}
+
+/*
+V2GetClusterNetworkTopologyInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2GetClusterNetworkTopologyInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get cluster network topology internal server error response has a 2xx status code
+func (o *V2GetClusterNetworkTopologyInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster network topology internal server error response has a 3xx status code
+func (o *V2GetClusterNetworkTopologyInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster network topology internal server error response has a 4xx status code
+func (o *V2GetClusterNetworkTopologyInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get cluster network topology internal server error response has a 5xx status code
+func (o *V2GetClusterNetworkTopologyInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 get cluster network topology internal server error response a status code equal to that given
+func (o *V2GetClusterNetworkTopologyInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2GetClusterNetworkTopologyInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-topology][%d] v2GetClusterNetworkTopologyInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetClusterNetworkTopologyInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-topology][%d] v2GetClusterNetworkTopologyInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetClusterNetworkTopologyInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetClusterNetworkTopologyInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2ListHostHardwareRevisionsParams creates a new V2ListHostHardwareRevisionsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListHostHardwareRevisionsParams() *V2ListHostHardwareRevisionsParams {
+	return &V2ListHostHardwareRevisionsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListHostHardwareRevisionsParamsWithTimeout creates a new V2ListHostHardwareRevisionsParams object
+// with the ability to set a timeout on a request.
+func NewV2ListHostHardwareRevisionsParamsWithTimeout(timeout time.Duration) *V2ListHostHardwareRevisionsParams {
+	return &V2ListHostHardwareRevisionsParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListHostHardwareRevisionsParamsWithContext creates a new V2ListHostHardwareRevisionsParams object
+// with the ability to set a context for a request.
+func NewV2ListHostHardwareRevisionsParamsWithContext(ctx context.Context) *V2ListHostHardwareRevisionsParams {
+	return &V2ListHostHardwareRevisionsParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListHostHardwareRevisionsParamsWithHTTPClient creates a new V2ListHostHardwareRevisionsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListHostHardwareRevisionsParamsWithHTTPClient(client *http.Client) *V2ListHostHardwareRevisionsParams {
+	return &V2ListHostHardwareRevisionsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2ListHostHardwareRevisionsParams contains all the parameters to send to the API endpoint
+
+	for the v2 list host hardware revisions operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2ListHostHardwareRevisionsParams struct {
+
+	/* HostID.
+
+	   The host whose hardware revisions are listed.
+
+	   Format: uuid
+	*/
+	HostID strfmt.UUID
+
+	/* InfraEnvID.
+
+	   The infra-env of the host.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list host hardware revisions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListHostHardwareRevisionsParams) WithDefaults() *V2ListHostHardwareRevisionsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list host hardware revisions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListHostHardwareRevisionsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 list host hardware revisions params
+func (o *V2ListHostHardwareRevisionsParams) WithTimeout(timeout time.Duration) *V2ListHostHardwareRevisionsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list host hardware revisions params
+func (o *V2ListHostHardwareRevisionsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list host hardware revisions params
+func (o *V2ListHostHardwareRevisionsParams) WithContext(ctx context.Context) *V2ListHostHardwareRevisionsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list host hardware revisions params
+func (o *V2ListHostHardwareRevisionsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list host hardware revisions params
+func (o *V2ListHostHardwareRevisionsParams) WithHTTPClient(client *http.Client) *V2ListHostHardwareRevisionsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list host hardware revisions params
+func (o *V2ListHostHardwareRevisionsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithHostID adds the hostID to the v2 list host hardware revisions params
+func (o *V2ListHostHardwareRevisionsParams) WithHostID(hostID strfmt.UUID) *V2ListHostHardwareRevisionsParams {
+	o.SetHostID(hostID)
+	return o
+}
+
+// SetHostID adds the hostId to the v2 list host hardware revisions params
+func (o *V2ListHostHardwareRevisionsParams) SetHostID(hostID strfmt.UUID) {
+	o.HostID = hostID
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 list host hardware revisions params
+func (o *V2ListHostHardwareRevisionsParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2ListHostHardwareRevisionsParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 list host hardware revisions params
+func (o *V2ListHostHardwareRevisionsParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListHostHardwareRevisionsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param host_id
+	if err := r.SetPathParam("host_id", o.HostID.String()); err != nil {
+		return err
+	}
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
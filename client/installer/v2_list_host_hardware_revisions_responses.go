@@ -0,0 +1,372 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListHostHardwareRevisionsReader is a Reader for the V2ListHostHardwareRevisions structure.
+type V2ListHostHardwareRevisionsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListHostHardwareRevisionsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListHostHardwareRevisionsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2ListHostHardwareRevisionsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListHostHardwareRevisionsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2ListHostHardwareRevisionsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListHostHardwareRevisionsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListHostHardwareRevisionsOK creates a V2ListHostHardwareRevisionsOK with default headers values
+func NewV2ListHostHardwareRevisionsOK() *V2ListHostHardwareRevisionsOK {
+	return &V2ListHostHardwareRevisionsOK{}
+}
+
+/*
+V2ListHostHardwareRevisionsOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListHostHardwareRevisionsOK struct {
+	Payload models.HostHardwareRevisionList
+}
+
+// IsSuccess returns true when this v2 list host hardware revisions o k response has a 2xx status code
+func (o *V2ListHostHardwareRevisionsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 list host hardware revisions o k response has a 3xx status code
+func (o *V2ListHostHardwareRevisionsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list host hardware revisions o k response has a 4xx status code
+func (o *V2ListHostHardwareRevisionsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list host hardware revisions o k response has a 5xx status code
+func (o *V2ListHostHardwareRevisionsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list host hardware revisions o k response a status code equal to that given
+func (o *V2ListHostHardwareRevisionsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2ListHostHardwareRevisionsOK) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/hardware-revisions][%d] v2ListHostHardwareRevisionsOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListHostHardwareRevisionsOK) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/hardware-revisions][%d] v2ListHostHardwareRevisionsOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListHostHardwareRevisionsOK) GetPayload() models.HostHardwareRevisionList {
+	return o.Payload
+}
+
+func (o *V2ListHostHardwareRevisionsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostHardwareRevisionsUnauthorized creates a V2ListHostHardwareRevisionsUnauthorized with default headers values
+func NewV2ListHostHardwareRevisionsUnauthorized() *V2ListHostHardwareRevisionsUnauthorized {
+	return &V2ListHostHardwareRevisionsUnauthorized{}
+}
+
+/*
+V2ListHostHardwareRevisionsUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListHostHardwareRevisionsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list host hardware revisions unauthorized response has a 2xx status code
+func (o *V2ListHostHardwareRevisionsUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list host hardware revisions unauthorized response has a 3xx status code
+func (o *V2ListHostHardwareRevisionsUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list host hardware revisions unauthorized response has a 4xx status code
+func (o *V2ListHostHardwareRevisionsUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list host hardware revisions unauthorized response has a 5xx status code
+func (o *V2ListHostHardwareRevisionsUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list host hardware revisions unauthorized response a status code equal to that given
+func (o *V2ListHostHardwareRevisionsUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2ListHostHardwareRevisionsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/hardware-revisions][%d] v2ListHostHardwareRevisionsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListHostHardwareRevisionsUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/hardware-revisions][%d] v2ListHostHardwareRevisionsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListHostHardwareRevisionsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListHostHardwareRevisionsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostHardwareRevisionsForbidden creates a V2ListHostHardwareRevisionsForbidden with default headers values
+func NewV2ListHostHardwareRevisionsForbidden() *V2ListHostHardwareRevisionsForbidden {
+	return &V2ListHostHardwareRevisionsForbidden{}
+}
+
+/*
+V2ListHostHardwareRevisionsForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListHostHardwareRevisionsForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list host hardware revisions forbidden response has a 2xx status code
+func (o *V2ListHostHardwareRevisionsForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list host hardware revisions forbidden response has a 3xx status code
+func (o *V2ListHostHardwareRevisionsForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list host hardware revisions forbidden response has a 4xx status code
+func (o *V2ListHostHardwareRevisionsForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list host hardware revisions forbidden response has a 5xx status code
+func (o *V2ListHostHardwareRevisionsForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list host hardware revisions forbidden response a status code equal to that given
+func (o *V2ListHostHardwareRevisionsForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2ListHostHardwareRevisionsForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/hardware-revisions][%d] v2ListHostHardwareRevisionsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListHostHardwareRevisionsForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/hardware-revisions][%d] v2ListHostHardwareRevisionsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListHostHardwareRevisionsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListHostHardwareRevisionsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostHardwareRevisionsNotFound creates a V2ListHostHardwareRevisionsNotFound with default headers values
+func NewV2ListHostHardwareRevisionsNotFound() *V2ListHostHardwareRevisionsNotFound {
+	return &V2ListHostHardwareRevisionsNotFound{}
+}
+
+/*
+V2ListHostHardwareRevisionsNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2ListHostHardwareRevisionsNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list host hardware revisions not found response has a 2xx status code
+func (o *V2ListHostHardwareRevisionsNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list host hardware revisions not found response has a 3xx status code
+func (o *V2ListHostHardwareRevisionsNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list host hardware revisions not found response has a 4xx status code
+func (o *V2ListHostHardwareRevisionsNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list host hardware revisions not found response has a 5xx status code
+func (o *V2ListHostHardwareRevisionsNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list host hardware revisions not found response a status code equal to that given
+func (o *V2ListHostHardwareRevisionsNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2ListHostHardwareRevisionsNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/hardware-revisions][%d] v2ListHostHardwareRevisionsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2ListHostHardwareRevisionsNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/hardware-revisions][%d] v2ListHostHardwareRevisionsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2ListHostHardwareRevisionsNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListHostHardwareRevisionsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostHardwareRevisionsInternalServerError creates a V2ListHostHardwareRevisionsInternalServerError with default headers values
+func NewV2ListHostHardwareRevisionsInternalServerError() *V2ListHostHardwareRevisionsInternalServerError {
+	return &V2ListHostHardwareRevisionsInternalServerError{}
+}
+
+/*
+V2ListHostHardwareRevisionsInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListHostHardwareRevisionsInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list host hardware revisions internal server error response has a 2xx status code
+func (o *V2ListHostHardwareRevisionsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list host hardware revisions internal server error response has a 3xx status code
+func (o *V2ListHostHardwareRevisionsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list host hardware revisions internal server error response has a 4xx status code
+func (o *V2ListHostHardwareRevisionsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list host hardware revisions internal server error response has a 5xx status code
+func (o *V2ListHostHardwareRevisionsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 list host hardware revisions internal server error response a status code equal to that given
+func (o *V2ListHostHardwareRevisionsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2ListHostHardwareRevisionsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/hardware-revisions][%d] v2ListHostHardwareRevisionsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListHostHardwareRevisionsInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/hardware-revisions][%d] v2ListHostHardwareRevisionsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListHostHardwareRevisionsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListHostHardwareRevisionsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
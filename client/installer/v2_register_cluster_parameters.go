@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/openshift/assisted-service/models"
 )
@@ -63,12 +64,26 @@ V2RegisterClusterParams contains all the parameters to send to the API endpoint
 */
 type V2RegisterClusterParams struct {
 
+	/* ClusterTemplateID.
+
+	   The template the cluster is registered from. The manifests and the infra-env of the template are created with the cluster.
+
+	   Format: uuid
+	*/
+	ClusterTemplateID *strfmt.UUID
+
 	/* NewClusterParams.
 
-	   The properties describing the new cluster.
+	   The properties describing the new cluster. When the cluster is registered from a template, the properties that are set override the ones of the template.
 	*/
 	NewClusterParams *models.ClusterCreateParams
 
+	/* TemplateParameters.
+
+	   The values of the parameters of the template, formatted as name=value.
+	*/
+	TemplateParameters []string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -122,6 +137,17 @@ func (o *V2RegisterClusterParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithClusterTemplateID adds the clusterTemplateID to the v2 register cluster params
+func (o *V2RegisterClusterParams) WithClusterTemplateID(clusterTemplateID *strfmt.UUID) *V2RegisterClusterParams {
+	o.SetClusterTemplateID(clusterTemplateID)
+	return o
+}
+
+// SetClusterTemplateID adds the clusterTemplateId to the v2 register cluster params
+func (o *V2RegisterClusterParams) SetClusterTemplateID(clusterTemplateID *strfmt.UUID) {
+	o.ClusterTemplateID = clusterTemplateID
+}
+
 // WithNewClusterParams adds the newClusterParams to the v2 register cluster params
 func (o *V2RegisterClusterParams) WithNewClusterParams(newClusterParams *models.ClusterCreateParams) *V2RegisterClusterParams {
 	o.SetNewClusterParams(newClusterParams)
@@ -133,6 +159,17 @@ func (o *V2RegisterClusterParams) SetNewClusterParams(newClusterParams *models.C
 	o.NewClusterParams = newClusterParams
 }
 
+// WithTemplateParameters adds the templateParameters to the v2 register cluster params
+func (o *V2RegisterClusterParams) WithTemplateParameters(templateParameters []string) *V2RegisterClusterParams {
+	o.SetTemplateParameters(templateParameters)
+	return o
+}
+
+// SetTemplateParameters adds the templateParameters to the v2 register cluster params
+func (o *V2RegisterClusterParams) SetTemplateParameters(templateParameters []string) {
+	o.TemplateParameters = templateParameters
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *V2RegisterClusterParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -140,14 +177,59 @@ func (o *V2RegisterClusterParams) WriteToRequest(r runtime.ClientRequest, reg st
 		return err
 	}
 	var res []error
+
+	if o.ClusterTemplateID != nil {
+
+		// query param cluster_template_id
+		var qrClusterTemplateID strfmt.UUID
+
+		if o.ClusterTemplateID != nil {
+			qrClusterTemplateID = *o.ClusterTemplateID
+		}
+		qClusterTemplateID := qrClusterTemplateID.String()
+		if qClusterTemplateID != "" {
+
+			if err := r.SetQueryParam("cluster_template_id", qClusterTemplateID); err != nil {
+				return err
+			}
+		}
+	}
 	if o.NewClusterParams != nil {
 		if err := r.SetBodyParam(o.NewClusterParams); err != nil {
 			return err
 		}
 	}
 
+	if o.TemplateParameters != nil {
+
+		// binding items for template_parameters
+		joinedTemplateParameters := o.bindParamTemplateParameters(reg)
+
+		// query array param template_parameters
+		if err := r.SetQueryParam("template_parameters", joinedTemplateParameters...); err != nil {
+			return err
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
+
+// bindParamV2RegisterCluster binds the parameter template_parameters
+func (o *V2RegisterClusterParams) bindParamTemplateParameters(formats strfmt.Registry) []string {
+	templateParametersIR := o.TemplateParameters
+
+	var templateParametersIC []string
+	for _, templateParametersIIR := range templateParametersIR { // explode []string
+
+		templateParametersIIV := templateParametersIIR // string as string
+		templateParametersIC = append(templateParametersIC, templateParametersIIV)
+	}
+
+	// items.CollectionFormat: "multi"
+	templateParametersIS := swag.JoinByFormat(templateParametersIC, "multi")
+
+	return templateParametersIS
+}
@@ -47,6 +47,12 @@ func (o *V2RegisterClusterReader) ReadResponse(response runtime.ClientResponse,
 			return nil, err
 		}
 		return nil, result
+	case 404:
+		result := NewV2RegisterClusterNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 405:
 		result := NewV2RegisterClusterMethodNotAllowed()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -316,6 +322,69 @@ func (o *V2RegisterClusterForbidden) readResponse(response runtime.ClientRespons
 	return nil
 }
 
+// NewV2RegisterClusterNotFound creates a V2RegisterClusterNotFound with default headers values
+func NewV2RegisterClusterNotFound() *V2RegisterClusterNotFound {
+	return &V2RegisterClusterNotFound{}
+}
+
+/*
+V2RegisterClusterNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2RegisterClusterNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 register cluster not found response has a 2xx status code
+func (o *V2RegisterClusterNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 register cluster not found response has a 3xx status code
+func (o *V2RegisterClusterNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 register cluster not found response has a 4xx status code
+func (o *V2RegisterClusterNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 register cluster not found response has a 5xx status code
+func (o *V2RegisterClusterNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 register cluster not found response a status code equal to that given
+func (o *V2RegisterClusterNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2RegisterClusterNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters][%d] v2RegisterClusterNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2RegisterClusterNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/clusters][%d] v2RegisterClusterNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2RegisterClusterNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RegisterClusterNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewV2RegisterClusterMethodNotAllowed creates a V2RegisterClusterMethodNotAllowed with default headers values
 func NewV2RegisterClusterMethodNotAllowed() *V2RegisterClusterMethodNotAllowed {
 	return &V2RegisterClusterMethodNotAllowed{}
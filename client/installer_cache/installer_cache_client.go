@@ -0,0 +1,122 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer_cache
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the installer cache client
+type API interface {
+	/*
+	   V2ListInstallerCacheReleases Lists the releases in the installer cache, with their size, last use and pin status. The local cache is the one of the replica that serves the request.*/
+	V2ListInstallerCacheReleases(ctx context.Context, params *V2ListInstallerCacheReleasesParams) (*V2ListInstallerCacheReleasesOK, error)
+	/*
+	   V2PrewarmInstallerCacheRelease Pre-warms the installer cache with the installer binary of a release image ahead of demand. The binary is extracted in the background.*/
+	V2PrewarmInstallerCacheRelease(ctx context.Context, params *V2PrewarmInstallerCacheReleaseParams) (*V2PrewarmInstallerCacheReleaseAccepted, error)
+	/*
+	   V2UpdateInstallerCacheRelease Pins or unpins a release that was pre-warmed. Pinned releases are never evicted from the installer cache.*/
+	V2UpdateInstallerCacheRelease(ctx context.Context, params *V2UpdateInstallerCacheReleaseParams) (*V2UpdateInstallerCacheReleaseOK, error)
+}
+
+// New creates a new installer cache API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for installer cache API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+V2ListInstallerCacheReleases Lists the releases in the installer cache, with their size, last use and pin status. The local cache is the one of the replica that serves the request.
+*/
+func (a *Client) V2ListInstallerCacheReleases(ctx context.Context, params *V2ListInstallerCacheReleasesParams) (*V2ListInstallerCacheReleasesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListInstallerCacheReleases",
+		Method:             "GET",
+		PathPattern:        "/v2/installer-cache/releases",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListInstallerCacheReleasesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListInstallerCacheReleasesOK), nil
+
+}
+
+/*
+V2PrewarmInstallerCacheRelease Pre-warms the installer cache with the installer binary of a release image ahead of demand. The binary is extracted in the background.
+*/
+func (a *Client) V2PrewarmInstallerCacheRelease(ctx context.Context, params *V2PrewarmInstallerCacheReleaseParams) (*V2PrewarmInstallerCacheReleaseAccepted, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2PrewarmInstallerCacheRelease",
+		Method:             "POST",
+		PathPattern:        "/v2/installer-cache/releases",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2PrewarmInstallerCacheReleaseReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2PrewarmInstallerCacheReleaseAccepted), nil
+
+}
+
+/*
+V2UpdateInstallerCacheRelease Pins or unpins a release that was pre-warmed. Pinned releases are never evicted from the installer cache.
+*/
+func (a *Client) V2UpdateInstallerCacheRelease(ctx context.Context, params *V2UpdateInstallerCacheReleaseParams) (*V2UpdateInstallerCacheReleaseOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2UpdateInstallerCacheRelease",
+		Method:             "PATCH",
+		PathPattern:        "/v2/installer-cache/releases",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2UpdateInstallerCacheReleaseReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2UpdateInstallerCacheReleaseOK), nil
+
+}
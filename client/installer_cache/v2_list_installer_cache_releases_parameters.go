@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer_cache
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2ListInstallerCacheReleasesParams creates a new V2ListInstallerCacheReleasesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListInstallerCacheReleasesParams() *V2ListInstallerCacheReleasesParams {
+	return &V2ListInstallerCacheReleasesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListInstallerCacheReleasesParamsWithTimeout creates a new V2ListInstallerCacheReleasesParams object
+// with the ability to set a timeout on a request.
+func NewV2ListInstallerCacheReleasesParamsWithTimeout(timeout time.Duration) *V2ListInstallerCacheReleasesParams {
+	return &V2ListInstallerCacheReleasesParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListInstallerCacheReleasesParamsWithContext creates a new V2ListInstallerCacheReleasesParams object
+// with the ability to set a context for a request.
+func NewV2ListInstallerCacheReleasesParamsWithContext(ctx context.Context) *V2ListInstallerCacheReleasesParams {
+	return &V2ListInstallerCacheReleasesParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListInstallerCacheReleasesParamsWithHTTPClient creates a new V2ListInstallerCacheReleasesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListInstallerCacheReleasesParamsWithHTTPClient(client *http.Client) *V2ListInstallerCacheReleasesParams {
+	return &V2ListInstallerCacheReleasesParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2ListInstallerCacheReleasesParams contains all the parameters to send to the API endpoint
+
+	for the v2 list installer cache releases operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2ListInstallerCacheReleasesParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list installer cache releases params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListInstallerCacheReleasesParams) WithDefaults() *V2ListInstallerCacheReleasesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list installer cache releases params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListInstallerCacheReleasesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 list installer cache releases params
+func (o *V2ListInstallerCacheReleasesParams) WithTimeout(timeout time.Duration) *V2ListInstallerCacheReleasesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list installer cache releases params
+func (o *V2ListInstallerCacheReleasesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list installer cache releases params
+func (o *V2ListInstallerCacheReleasesParams) WithContext(ctx context.Context) *V2ListInstallerCacheReleasesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list installer cache releases params
+func (o *V2ListInstallerCacheReleasesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list installer cache releases params
+func (o *V2ListInstallerCacheReleasesParams) WithHTTPClient(client *http.Client) *V2ListInstallerCacheReleasesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list installer cache releases params
+func (o *V2ListInstallerCacheReleasesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListInstallerCacheReleasesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,303 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer_cache
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListInstallerCacheReleasesReader is a Reader for the V2ListInstallerCacheReleases structure.
+type V2ListInstallerCacheReleasesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListInstallerCacheReleasesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListInstallerCacheReleasesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2ListInstallerCacheReleasesUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListInstallerCacheReleasesForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListInstallerCacheReleasesInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListInstallerCacheReleasesOK creates a V2ListInstallerCacheReleasesOK with default headers values
+func NewV2ListInstallerCacheReleasesOK() *V2ListInstallerCacheReleasesOK {
+	return &V2ListInstallerCacheReleasesOK{}
+}
+
+/*
+V2ListInstallerCacheReleasesOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListInstallerCacheReleasesOK struct {
+	Payload models.InstallerCacheReleaseList
+}
+
+// IsSuccess returns true when this v2 list installer cache releases o k response has a 2xx status code
+func (o *V2ListInstallerCacheReleasesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 list installer cache releases o k response has a 3xx status code
+func (o *V2ListInstallerCacheReleasesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list installer cache releases o k response has a 4xx status code
+func (o *V2ListInstallerCacheReleasesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list installer cache releases o k response has a 5xx status code
+func (o *V2ListInstallerCacheReleasesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list installer cache releases o k response a status code equal to that given
+func (o *V2ListInstallerCacheReleasesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2ListInstallerCacheReleasesOK) Error() string {
+	return fmt.Sprintf("[GET /v2/installer-cache/releases][%d] v2ListInstallerCacheReleasesOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListInstallerCacheReleasesOK) String() string {
+	return fmt.Sprintf("[GET /v2/installer-cache/releases][%d] v2ListInstallerCacheReleasesOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListInstallerCacheReleasesOK) GetPayload() models.InstallerCacheReleaseList {
+	return o.Payload
+}
+
+func (o *V2ListInstallerCacheReleasesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListInstallerCacheReleasesUnauthorized creates a V2ListInstallerCacheReleasesUnauthorized with default headers values
+func NewV2ListInstallerCacheReleasesUnauthorized() *V2ListInstallerCacheReleasesUnauthorized {
+	return &V2ListInstallerCacheReleasesUnauthorized{}
+}
+
+/*
+V2ListInstallerCacheReleasesUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListInstallerCacheReleasesUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list installer cache releases unauthorized response has a 2xx status code
+func (o *V2ListInstallerCacheReleasesUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list installer cache releases unauthorized response has a 3xx status code
+func (o *V2ListInstallerCacheReleasesUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list installer cache releases unauthorized response has a 4xx status code
+func (o *V2ListInstallerCacheReleasesUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list installer cache releases unauthorized response has a 5xx status code
+func (o *V2ListInstallerCacheReleasesUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list installer cache releases unauthorized response a status code equal to that given
+func (o *V2ListInstallerCacheReleasesUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2ListInstallerCacheReleasesUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/installer-cache/releases][%d] v2ListInstallerCacheReleasesUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListInstallerCacheReleasesUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/installer-cache/releases][%d] v2ListInstallerCacheReleasesUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListInstallerCacheReleasesUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListInstallerCacheReleasesUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListInstallerCacheReleasesForbidden creates a V2ListInstallerCacheReleasesForbidden with default headers values
+func NewV2ListInstallerCacheReleasesForbidden() *V2ListInstallerCacheReleasesForbidden {
+	return &V2ListInstallerCacheReleasesForbidden{}
+}
+
+/*
+V2ListInstallerCacheReleasesForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListInstallerCacheReleasesForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list installer cache releases forbidden response has a 2xx status code
+func (o *V2ListInstallerCacheReleasesForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list installer cache releases forbidden response has a 3xx status code
+func (o *V2ListInstallerCacheReleasesForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list installer cache releases forbidden response has a 4xx status code
+func (o *V2ListInstallerCacheReleasesForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list installer cache releases forbidden response has a 5xx status code
+func (o *V2ListInstallerCacheReleasesForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list installer cache releases forbidden response a status code equal to that given
+func (o *V2ListInstallerCacheReleasesForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2ListInstallerCacheReleasesForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/installer-cache/releases][%d] v2ListInstallerCacheReleasesForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListInstallerCacheReleasesForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/installer-cache/releases][%d] v2ListInstallerCacheReleasesForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListInstallerCacheReleasesForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListInstallerCacheReleasesForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListInstallerCacheReleasesInternalServerError creates a V2ListInstallerCacheReleasesInternalServerError with default headers values
+func NewV2ListInstallerCacheReleasesInternalServerError() *V2ListInstallerCacheReleasesInternalServerError {
+	return &V2ListInstallerCacheReleasesInternalServerError{}
+}
+
+/*
+V2ListInstallerCacheReleasesInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListInstallerCacheReleasesInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list installer cache releases internal server error response has a 2xx status code
+func (o *V2ListInstallerCacheReleasesInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list installer cache releases internal server error response has a 3xx status code
+func (o *V2ListInstallerCacheReleasesInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list installer cache releases internal server error response has a 4xx status code
+func (o *V2ListInstallerCacheReleasesInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list installer cache releases internal server error response has a 5xx status code
+func (o *V2ListInstallerCacheReleasesInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 list installer cache releases internal server error response a status code equal to that given
+func (o *V2ListInstallerCacheReleasesInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2ListInstallerCacheReleasesInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/installer-cache/releases][%d] v2ListInstallerCacheReleasesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListInstallerCacheReleasesInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/installer-cache/releases][%d] v2ListInstallerCacheReleasesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListInstallerCacheReleasesInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListInstallerCacheReleasesInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer_cache
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2PrewarmInstallerCacheReleaseParams creates a new V2PrewarmInstallerCacheReleaseParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2PrewarmInstallerCacheReleaseParams() *V2PrewarmInstallerCacheReleaseParams {
+	return &V2PrewarmInstallerCacheReleaseParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2PrewarmInstallerCacheReleaseParamsWithTimeout creates a new V2PrewarmInstallerCacheReleaseParams object
+// with the ability to set a timeout on a request.
+func NewV2PrewarmInstallerCacheReleaseParamsWithTimeout(timeout time.Duration) *V2PrewarmInstallerCacheReleaseParams {
+	return &V2PrewarmInstallerCacheReleaseParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2PrewarmInstallerCacheReleaseParamsWithContext creates a new V2PrewarmInstallerCacheReleaseParams object
+// with the ability to set a context for a request.
+func NewV2PrewarmInstallerCacheReleaseParamsWithContext(ctx context.Context) *V2PrewarmInstallerCacheReleaseParams {
+	return &V2PrewarmInstallerCacheReleaseParams{
+		Context: ctx,
+	}
+}
+
+// NewV2PrewarmInstallerCacheReleaseParamsWithHTTPClient creates a new V2PrewarmInstallerCacheReleaseParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2PrewarmInstallerCacheReleaseParamsWithHTTPClient(client *http.Client) *V2PrewarmInstallerCacheReleaseParams {
+	return &V2PrewarmInstallerCacheReleaseParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2PrewarmInstallerCacheReleaseParams contains all the parameters to send to the API endpoint
+
+	for the v2 prewarm installer cache release operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2PrewarmInstallerCacheReleaseParams struct {
+
+	/* PrewarmParams.
+
+	   The release image to pre-warm.
+	*/
+	PrewarmParams *models.InstallerCachePrewarmParams
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 prewarm installer cache release params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2PrewarmInstallerCacheReleaseParams) WithDefaults() *V2PrewarmInstallerCacheReleaseParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 prewarm installer cache release params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2PrewarmInstallerCacheReleaseParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 prewarm installer cache release params
+func (o *V2PrewarmInstallerCacheReleaseParams) WithTimeout(timeout time.Duration) *V2PrewarmInstallerCacheReleaseParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 prewarm installer cache release params
+func (o *V2PrewarmInstallerCacheReleaseParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 prewarm installer cache release params
+func (o *V2PrewarmInstallerCacheReleaseParams) WithContext(ctx context.Context) *V2PrewarmInstallerCacheReleaseParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 prewarm installer cache release params
+func (o *V2PrewarmInstallerCacheReleaseParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 prewarm installer cache release params
+func (o *V2PrewarmInstallerCacheReleaseParams) WithHTTPClient(client *http.Client) *V2PrewarmInstallerCacheReleaseParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 prewarm installer cache release params
+func (o *V2PrewarmInstallerCacheReleaseParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithPrewarmParams adds the prewarmParams to the v2 prewarm installer cache release params
+func (o *V2PrewarmInstallerCacheReleaseParams) WithPrewarmParams(prewarmParams *models.InstallerCachePrewarmParams) *V2PrewarmInstallerCacheReleaseParams {
+	o.SetPrewarmParams(prewarmParams)
+	return o
+}
+
+// SetPrewarmParams adds the prewarmParams to the v2 prewarm installer cache release params
+func (o *V2PrewarmInstallerCacheReleaseParams) SetPrewarmParams(prewarmParams *models.InstallerCachePrewarmParams) {
+	o.PrewarmParams = prewarmParams
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2PrewarmInstallerCacheReleaseParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.PrewarmParams != nil {
+		if err := r.SetBodyParam(o.PrewarmParams); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,374 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer_cache
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2PrewarmInstallerCacheReleaseReader is a Reader for the V2PrewarmInstallerCacheRelease structure.
+type V2PrewarmInstallerCacheReleaseReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2PrewarmInstallerCacheReleaseReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewV2PrewarmInstallerCacheReleaseAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2PrewarmInstallerCacheReleaseBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2PrewarmInstallerCacheReleaseUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2PrewarmInstallerCacheReleaseForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2PrewarmInstallerCacheReleaseInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2PrewarmInstallerCacheReleaseAccepted creates a V2PrewarmInstallerCacheReleaseAccepted with default headers values
+func NewV2PrewarmInstallerCacheReleaseAccepted() *V2PrewarmInstallerCacheReleaseAccepted {
+	return &V2PrewarmInstallerCacheReleaseAccepted{}
+}
+
+/*
+V2PrewarmInstallerCacheReleaseAccepted describes a response with status code 202, with default header values.
+
+Success.
+*/
+type V2PrewarmInstallerCacheReleaseAccepted struct {
+	Payload *models.InstallerCacheRelease
+}
+
+// IsSuccess returns true when this v2 prewarm installer cache release accepted response has a 2xx status code
+func (o *V2PrewarmInstallerCacheReleaseAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 prewarm installer cache release accepted response has a 3xx status code
+func (o *V2PrewarmInstallerCacheReleaseAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 prewarm installer cache release accepted response has a 4xx status code
+func (o *V2PrewarmInstallerCacheReleaseAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 prewarm installer cache release accepted response has a 5xx status code
+func (o *V2PrewarmInstallerCacheReleaseAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 prewarm installer cache release accepted response a status code equal to that given
+func (o *V2PrewarmInstallerCacheReleaseAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+func (o *V2PrewarmInstallerCacheReleaseAccepted) Error() string {
+	return fmt.Sprintf("[POST /v2/installer-cache/releases][%d] v2PrewarmInstallerCacheReleaseAccepted  %+v", 202, o.Payload)
+}
+
+func (o *V2PrewarmInstallerCacheReleaseAccepted) String() string {
+	return fmt.Sprintf("[POST /v2/installer-cache/releases][%d] v2PrewarmInstallerCacheReleaseAccepted  %+v", 202, o.Payload)
+}
+
+func (o *V2PrewarmInstallerCacheReleaseAccepted) GetPayload() *models.InstallerCacheRelease {
+	return o.Payload
+}
+
+func (o *V2PrewarmInstallerCacheReleaseAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InstallerCacheRelease)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2PrewarmInstallerCacheReleaseBadRequest creates a V2PrewarmInstallerCacheReleaseBadRequest with default headers values
+func NewV2PrewarmInstallerCacheReleaseBadRequest() *V2PrewarmInstallerCacheReleaseBadRequest {
+	return &V2PrewarmInstallerCacheReleaseBadRequest{}
+}
+
+/*
+V2PrewarmInstallerCacheReleaseBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2PrewarmInstallerCacheReleaseBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 prewarm installer cache release bad request response has a 2xx status code
+func (o *V2PrewarmInstallerCacheReleaseBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 prewarm installer cache release bad request response has a 3xx status code
+func (o *V2PrewarmInstallerCacheReleaseBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 prewarm installer cache release bad request response has a 4xx status code
+func (o *V2PrewarmInstallerCacheReleaseBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 prewarm installer cache release bad request response has a 5xx status code
+func (o *V2PrewarmInstallerCacheReleaseBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 prewarm installer cache release bad request response a status code equal to that given
+func (o *V2PrewarmInstallerCacheReleaseBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2PrewarmInstallerCacheReleaseBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/installer-cache/releases][%d] v2PrewarmInstallerCacheReleaseBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2PrewarmInstallerCacheReleaseBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/installer-cache/releases][%d] v2PrewarmInstallerCacheReleaseBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2PrewarmInstallerCacheReleaseBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2PrewarmInstallerCacheReleaseBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2PrewarmInstallerCacheReleaseUnauthorized creates a V2PrewarmInstallerCacheReleaseUnauthorized with default headers values
+func NewV2PrewarmInstallerCacheReleaseUnauthorized() *V2PrewarmInstallerCacheReleaseUnauthorized {
+	return &V2PrewarmInstallerCacheReleaseUnauthorized{}
+}
+
+/*
+V2PrewarmInstallerCacheReleaseUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2PrewarmInstallerCacheReleaseUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 prewarm installer cache release unauthorized response has a 2xx status code
+func (o *V2PrewarmInstallerCacheReleaseUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 prewarm installer cache release unauthorized response has a 3xx status code
+func (o *V2PrewarmInstallerCacheReleaseUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 prewarm installer cache release unauthorized response has a 4xx status code
+func (o *V2PrewarmInstallerCacheReleaseUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 prewarm installer cache release unauthorized response has a 5xx status code
+func (o *V2PrewarmInstallerCacheReleaseUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 prewarm installer cache release unauthorized response a status code equal to that given
+func (o *V2PrewarmInstallerCacheReleaseUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2PrewarmInstallerCacheReleaseUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/installer-cache/releases][%d] v2PrewarmInstallerCacheReleaseUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2PrewarmInstallerCacheReleaseUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/installer-cache/releases][%d] v2PrewarmInstallerCacheReleaseUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2PrewarmInstallerCacheReleaseUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2PrewarmInstallerCacheReleaseUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2PrewarmInstallerCacheReleaseForbidden creates a V2PrewarmInstallerCacheReleaseForbidden with default headers values
+func NewV2PrewarmInstallerCacheReleaseForbidden() *V2PrewarmInstallerCacheReleaseForbidden {
+	return &V2PrewarmInstallerCacheReleaseForbidden{}
+}
+
+/*
+V2PrewarmInstallerCacheReleaseForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2PrewarmInstallerCacheReleaseForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 prewarm installer cache release forbidden response has a 2xx status code
+func (o *V2PrewarmInstallerCacheReleaseForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 prewarm installer cache release forbidden response has a 3xx status code
+func (o *V2PrewarmInstallerCacheReleaseForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 prewarm installer cache release forbidden response has a 4xx status code
+func (o *V2PrewarmInstallerCacheReleaseForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 prewarm installer cache release forbidden response has a 5xx status code
+func (o *V2PrewarmInstallerCacheReleaseForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 prewarm installer cache release forbidden response a status code equal to that given
+func (o *V2PrewarmInstallerCacheReleaseForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2PrewarmInstallerCacheReleaseForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/installer-cache/releases][%d] v2PrewarmInstallerCacheReleaseForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2PrewarmInstallerCacheReleaseForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/installer-cache/releases][%d] v2PrewarmInstallerCacheReleaseForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2PrewarmInstallerCacheReleaseForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2PrewarmInstallerCacheReleaseForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2PrewarmInstallerCacheReleaseInternalServerError creates a V2PrewarmInstallerCacheReleaseInternalServerError with default headers values
+func NewV2PrewarmInstallerCacheReleaseInternalServerError() *V2PrewarmInstallerCacheReleaseInternalServerError {
+	return &V2PrewarmInstallerCacheReleaseInternalServerError{}
+}
+
+/*
+V2PrewarmInstallerCacheReleaseInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2PrewarmInstallerCacheReleaseInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 prewarm installer cache release internal server error response has a 2xx status code
+func (o *V2PrewarmInstallerCacheReleaseInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 prewarm installer cache release internal server error response has a 3xx status code
+func (o *V2PrewarmInstallerCacheReleaseInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 prewarm installer cache release internal server error response has a 4xx status code
+func (o *V2PrewarmInstallerCacheReleaseInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 prewarm installer cache release internal server error response has a 5xx status code
+func (o *V2PrewarmInstallerCacheReleaseInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 prewarm installer cache release internal server error response a status code equal to that given
+func (o *V2PrewarmInstallerCacheReleaseInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2PrewarmInstallerCacheReleaseInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/installer-cache/releases][%d] v2PrewarmInstallerCacheReleaseInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2PrewarmInstallerCacheReleaseInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/installer-cache/releases][%d] v2PrewarmInstallerCacheReleaseInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2PrewarmInstallerCacheReleaseInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2PrewarmInstallerCacheReleaseInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer_cache
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2UpdateInstallerCacheReleaseParams creates a new V2UpdateInstallerCacheReleaseParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2UpdateInstallerCacheReleaseParams() *V2UpdateInstallerCacheReleaseParams {
+	return &V2UpdateInstallerCacheReleaseParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2UpdateInstallerCacheReleaseParamsWithTimeout creates a new V2UpdateInstallerCacheReleaseParams object
+// with the ability to set a timeout on a request.
+func NewV2UpdateInstallerCacheReleaseParamsWithTimeout(timeout time.Duration) *V2UpdateInstallerCacheReleaseParams {
+	return &V2UpdateInstallerCacheReleaseParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2UpdateInstallerCacheReleaseParamsWithContext creates a new V2UpdateInstallerCacheReleaseParams object
+// with the ability to set a context for a request.
+func NewV2UpdateInstallerCacheReleaseParamsWithContext(ctx context.Context) *V2UpdateInstallerCacheReleaseParams {
+	return &V2UpdateInstallerCacheReleaseParams{
+		Context: ctx,
+	}
+}
+
+// NewV2UpdateInstallerCacheReleaseParamsWithHTTPClient creates a new V2UpdateInstallerCacheReleaseParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2UpdateInstallerCacheReleaseParamsWithHTTPClient(client *http.Client) *V2UpdateInstallerCacheReleaseParams {
+	return &V2UpdateInstallerCacheReleaseParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2UpdateInstallerCacheReleaseParams contains all the parameters to send to the API endpoint
+
+	for the v2 update installer cache release operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2UpdateInstallerCacheReleaseParams struct {
+
+	/* UpdateParams.
+
+	   The release image and its pin status.
+	*/
+	UpdateParams *models.InstallerCacheReleaseUpdateParams
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 update installer cache release params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2UpdateInstallerCacheReleaseParams) WithDefaults() *V2UpdateInstallerCacheReleaseParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 update installer cache release params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2UpdateInstallerCacheReleaseParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 update installer cache release params
+func (o *V2UpdateInstallerCacheReleaseParams) WithTimeout(timeout time.Duration) *V2UpdateInstallerCacheReleaseParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 update installer cache release params
+func (o *V2UpdateInstallerCacheReleaseParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 update installer cache release params
+func (o *V2UpdateInstallerCacheReleaseParams) WithContext(ctx context.Context) *V2UpdateInstallerCacheReleaseParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 update installer cache release params
+func (o *V2UpdateInstallerCacheReleaseParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 update installer cache release params
+func (o *V2UpdateInstallerCacheReleaseParams) WithHTTPClient(client *http.Client) *V2UpdateInstallerCacheReleaseParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 update installer cache release params
+func (o *V2UpdateInstallerCacheReleaseParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithUpdateParams adds the updateParams to the v2 update installer cache release params
+func (o *V2UpdateInstallerCacheReleaseParams) WithUpdateParams(updateParams *models.InstallerCacheReleaseUpdateParams) *V2UpdateInstallerCacheReleaseParams {
+	o.SetUpdateParams(updateParams)
+	return o
+}
+
+// SetUpdateParams adds the updateParams to the v2 update installer cache release params
+func (o *V2UpdateInstallerCacheReleaseParams) SetUpdateParams(updateParams *models.InstallerCacheReleaseUpdateParams) {
+	o.UpdateParams = updateParams
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2UpdateInstallerCacheReleaseParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.UpdateParams != nil {
+		if err := r.SetBodyParam(o.UpdateParams); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,443 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer_cache
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2UpdateInstallerCacheReleaseReader is a Reader for the V2UpdateInstallerCacheRelease structure.
+type V2UpdateInstallerCacheReleaseReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2UpdateInstallerCacheReleaseReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2UpdateInstallerCacheReleaseOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2UpdateInstallerCacheReleaseBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2UpdateInstallerCacheReleaseUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2UpdateInstallerCacheReleaseForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2UpdateInstallerCacheReleaseNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2UpdateInstallerCacheReleaseInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2UpdateInstallerCacheReleaseOK creates a V2UpdateInstallerCacheReleaseOK with default headers values
+func NewV2UpdateInstallerCacheReleaseOK() *V2UpdateInstallerCacheReleaseOK {
+	return &V2UpdateInstallerCacheReleaseOK{}
+}
+
+/*
+V2UpdateInstallerCacheReleaseOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2UpdateInstallerCacheReleaseOK struct {
+	Payload *models.InstallerCacheRelease
+}
+
+// IsSuccess returns true when this v2 update installer cache release o k response has a 2xx status code
+func (o *V2UpdateInstallerCacheReleaseOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 update installer cache release o k response has a 3xx status code
+func (o *V2UpdateInstallerCacheReleaseOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update installer cache release o k response has a 4xx status code
+func (o *V2UpdateInstallerCacheReleaseOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 update installer cache release o k response has a 5xx status code
+func (o *V2UpdateInstallerCacheReleaseOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update installer cache release o k response a status code equal to that given
+func (o *V2UpdateInstallerCacheReleaseOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2UpdateInstallerCacheReleaseOK) Error() string {
+	return fmt.Sprintf("[PATCH /v2/installer-cache/releases][%d] v2UpdateInstallerCacheReleaseOK  %+v", 200, o.Payload)
+}
+
+func (o *V2UpdateInstallerCacheReleaseOK) String() string {
+	return fmt.Sprintf("[PATCH /v2/installer-cache/releases][%d] v2UpdateInstallerCacheReleaseOK  %+v", 200, o.Payload)
+}
+
+func (o *V2UpdateInstallerCacheReleaseOK) GetPayload() *models.InstallerCacheRelease {
+	return o.Payload
+}
+
+func (o *V2UpdateInstallerCacheReleaseOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InstallerCacheRelease)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateInstallerCacheReleaseBadRequest creates a V2UpdateInstallerCacheReleaseBadRequest with default headers values
+func NewV2UpdateInstallerCacheReleaseBadRequest() *V2UpdateInstallerCacheReleaseBadRequest {
+	return &V2UpdateInstallerCacheReleaseBadRequest{}
+}
+
+/*
+V2UpdateInstallerCacheReleaseBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2UpdateInstallerCacheReleaseBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 update installer cache release bad request response has a 2xx status code
+func (o *V2UpdateInstallerCacheReleaseBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update installer cache release bad request response has a 3xx status code
+func (o *V2UpdateInstallerCacheReleaseBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update installer cache release bad request response has a 4xx status code
+func (o *V2UpdateInstallerCacheReleaseBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 update installer cache release bad request response has a 5xx status code
+func (o *V2UpdateInstallerCacheReleaseBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update installer cache release bad request response a status code equal to that given
+func (o *V2UpdateInstallerCacheReleaseBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2UpdateInstallerCacheReleaseBadRequest) Error() string {
+	return fmt.Sprintf("[PATCH /v2/installer-cache/releases][%d] v2UpdateInstallerCacheReleaseBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2UpdateInstallerCacheReleaseBadRequest) String() string {
+	return fmt.Sprintf("[PATCH /v2/installer-cache/releases][%d] v2UpdateInstallerCacheReleaseBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2UpdateInstallerCacheReleaseBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateInstallerCacheReleaseBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateInstallerCacheReleaseUnauthorized creates a V2UpdateInstallerCacheReleaseUnauthorized with default headers values
+func NewV2UpdateInstallerCacheReleaseUnauthorized() *V2UpdateInstallerCacheReleaseUnauthorized {
+	return &V2UpdateInstallerCacheReleaseUnauthorized{}
+}
+
+/*
+V2UpdateInstallerCacheReleaseUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2UpdateInstallerCacheReleaseUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 update installer cache release unauthorized response has a 2xx status code
+func (o *V2UpdateInstallerCacheReleaseUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update installer cache release unauthorized response has a 3xx status code
+func (o *V2UpdateInstallerCacheReleaseUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update installer cache release unauthorized response has a 4xx status code
+func (o *V2UpdateInstallerCacheReleaseUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 update installer cache release unauthorized response has a 5xx status code
+func (o *V2UpdateInstallerCacheReleaseUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update installer cache release unauthorized response a status code equal to that given
+func (o *V2UpdateInstallerCacheReleaseUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2UpdateInstallerCacheReleaseUnauthorized) Error() string {
+	return fmt.Sprintf("[PATCH /v2/installer-cache/releases][%d] v2UpdateInstallerCacheReleaseUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2UpdateInstallerCacheReleaseUnauthorized) String() string {
+	return fmt.Sprintf("[PATCH /v2/installer-cache/releases][%d] v2UpdateInstallerCacheReleaseUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2UpdateInstallerCacheReleaseUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2UpdateInstallerCacheReleaseUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateInstallerCacheReleaseForbidden creates a V2UpdateInstallerCacheReleaseForbidden with default headers values
+func NewV2UpdateInstallerCacheReleaseForbidden() *V2UpdateInstallerCacheReleaseForbidden {
+	return &V2UpdateInstallerCacheReleaseForbidden{}
+}
+
+/*
+V2UpdateInstallerCacheReleaseForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2UpdateInstallerCacheReleaseForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 update installer cache release forbidden response has a 2xx status code
+func (o *V2UpdateInstallerCacheReleaseForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update installer cache release forbidden response has a 3xx status code
+func (o *V2UpdateInstallerCacheReleaseForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update installer cache release forbidden response has a 4xx status code
+func (o *V2UpdateInstallerCacheReleaseForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 update installer cache release forbidden response has a 5xx status code
+func (o *V2UpdateInstallerCacheReleaseForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update installer cache release forbidden response a status code equal to that given
+func (o *V2UpdateInstallerCacheReleaseForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2UpdateInstallerCacheReleaseForbidden) Error() string {
+	return fmt.Sprintf("[PATCH /v2/installer-cache/releases][%d] v2UpdateInstallerCacheReleaseForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2UpdateInstallerCacheReleaseForbidden) String() string {
+	return fmt.Sprintf("[PATCH /v2/installer-cache/releases][%d] v2UpdateInstallerCacheReleaseForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2UpdateInstallerCacheReleaseForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2UpdateInstallerCacheReleaseForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateInstallerCacheReleaseNotFound creates a V2UpdateInstallerCacheReleaseNotFound with default headers values
+func NewV2UpdateInstallerCacheReleaseNotFound() *V2UpdateInstallerCacheReleaseNotFound {
+	return &V2UpdateInstallerCacheReleaseNotFound{}
+}
+
+/*
+V2UpdateInstallerCacheReleaseNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2UpdateInstallerCacheReleaseNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 update installer cache release not found response has a 2xx status code
+func (o *V2UpdateInstallerCacheReleaseNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update installer cache release not found response has a 3xx status code
+func (o *V2UpdateInstallerCacheReleaseNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update installer cache release not found response has a 4xx status code
+func (o *V2UpdateInstallerCacheReleaseNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 update installer cache release not found response has a 5xx status code
+func (o *V2UpdateInstallerCacheReleaseNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update installer cache release not found response a status code equal to that given
+func (o *V2UpdateInstallerCacheReleaseNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2UpdateInstallerCacheReleaseNotFound) Error() string {
+	return fmt.Sprintf("[PATCH /v2/installer-cache/releases][%d] v2UpdateInstallerCacheReleaseNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2UpdateInstallerCacheReleaseNotFound) String() string {
+	return fmt.Sprintf("[PATCH /v2/installer-cache/releases][%d] v2UpdateInstallerCacheReleaseNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2UpdateInstallerCacheReleaseNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateInstallerCacheReleaseNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateInstallerCacheReleaseInternalServerError creates a V2UpdateInstallerCacheReleaseInternalServerError with default headers values
+func NewV2UpdateInstallerCacheReleaseInternalServerError() *V2UpdateInstallerCacheReleaseInternalServerError {
+	return &V2UpdateInstallerCacheReleaseInternalServerError{}
+}
+
+/*
+V2UpdateInstallerCacheReleaseInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2UpdateInstallerCacheReleaseInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 update installer cache release internal server error response has a 2xx status code
+func (o *V2UpdateInstallerCacheReleaseInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update installer cache release internal server error response has a 3xx status code
+func (o *V2UpdateInstallerCacheReleaseInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update installer cache release internal server error response has a 4xx status code
+func (o *V2UpdateInstallerCacheReleaseInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 update installer cache release internal server error response has a 5xx status code
+func (o *V2UpdateInstallerCacheReleaseInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 update installer cache release internal server error response a status code equal to that given
+func (o *V2UpdateInstallerCacheReleaseInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2UpdateInstallerCacheReleaseInternalServerError) Error() string {
+	return fmt.Sprintf("[PATCH /v2/installer-cache/releases][%d] v2UpdateInstallerCacheReleaseInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2UpdateInstallerCacheReleaseInternalServerError) String() string {
+	return fmt.Sprintf("[PATCH /v2/installer-cache/releases][%d] v2UpdateInstallerCacheReleaseInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2UpdateInstallerCacheReleaseInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateInstallerCacheReleaseInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
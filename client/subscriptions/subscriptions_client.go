@@ -0,0 +1,234 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the subscriptions client
+type API interface {
+	/*
+	   V2DeregisterSubscription Deletes a webhook subscription and its deliveries.*/
+	V2DeregisterSubscription(ctx context.Context, params *V2DeregisterSubscriptionParams) (*V2DeregisterSubscriptionNoContent, error)
+	/*
+	   V2GetSubscription Retrieves the details of the webhook subscription.*/
+	V2GetSubscription(ctx context.Context, params *V2GetSubscriptionParams) (*V2GetSubscriptionOK, error)
+	/*
+	   V2ListSubscriptionDeliveries Lists the deliveries of the webhook subscription, along with their delivery attempts, starting from the most recent.*/
+	V2ListSubscriptionDeliveries(ctx context.Context, params *V2ListSubscriptionDeliveriesParams) (*V2ListSubscriptionDeliveriesOK, error)
+	/*
+	   V2ListSubscriptions Lists the webhook subscriptions of the organization.*/
+	V2ListSubscriptions(ctx context.Context, params *V2ListSubscriptionsParams) (*V2ListSubscriptionsOK, error)
+	/*
+	   V2RedeliverSubscriptionDelivery Schedules the delivery to be sent again, regardless of its previous attempts.*/
+	V2RedeliverSubscriptionDelivery(ctx context.Context, params *V2RedeliverSubscriptionDeliveryParams) (*V2RedeliverSubscriptionDeliveryAccepted, error)
+	/*
+	   V2RegisterSubscription Registers a webhook that is called when the subscribed clusters and hosts change.*/
+	V2RegisterSubscription(ctx context.Context, params *V2RegisterSubscriptionParams) (*V2RegisterSubscriptionCreated, error)
+	/*
+	   V2UpdateSubscription Updates a webhook subscription.*/
+	V2UpdateSubscription(ctx context.Context, params *V2UpdateSubscriptionParams) (*V2UpdateSubscriptionCreated, error)
+}
+
+// New creates a new subscriptions API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for subscriptions API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+V2DeregisterSubscription Deletes a webhook subscription and its deliveries.
+*/
+func (a *Client) V2DeregisterSubscription(ctx context.Context, params *V2DeregisterSubscriptionParams) (*V2DeregisterSubscriptionNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DeregisterSubscription",
+		Method:             "DELETE",
+		PathPattern:        "/v2/subscriptions/{subscription_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DeregisterSubscriptionReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DeregisterSubscriptionNoContent), nil
+
+}
+
+/*
+V2GetSubscription Retrieves the details of the webhook subscription.
+*/
+func (a *Client) V2GetSubscription(ctx context.Context, params *V2GetSubscriptionParams) (*V2GetSubscriptionOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetSubscription",
+		Method:             "GET",
+		PathPattern:        "/v2/subscriptions/{subscription_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetSubscriptionReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetSubscriptionOK), nil
+
+}
+
+/*
+V2ListSubscriptionDeliveries Lists the deliveries of the webhook subscription, along with their delivery attempts, starting from the most recent.
+*/
+func (a *Client) V2ListSubscriptionDeliveries(ctx context.Context, params *V2ListSubscriptionDeliveriesParams) (*V2ListSubscriptionDeliveriesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListSubscriptionDeliveries",
+		Method:             "GET",
+		PathPattern:        "/v2/subscriptions/{subscription_id}/deliveries",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListSubscriptionDeliveriesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListSubscriptionDeliveriesOK), nil
+
+}
+
+/*
+V2ListSubscriptions Lists the webhook subscriptions of the organization.
+*/
+func (a *Client) V2ListSubscriptions(ctx context.Context, params *V2ListSubscriptionsParams) (*V2ListSubscriptionsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListSubscriptions",
+		Method:             "GET",
+		PathPattern:        "/v2/subscriptions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListSubscriptionsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListSubscriptionsOK), nil
+
+}
+
+/*
+V2RedeliverSubscriptionDelivery Schedules the delivery to be sent again, regardless of its previous attempts.
+*/
+func (a *Client) V2RedeliverSubscriptionDelivery(ctx context.Context, params *V2RedeliverSubscriptionDeliveryParams) (*V2RedeliverSubscriptionDeliveryAccepted, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2RedeliverSubscriptionDelivery",
+		Method:             "POST",
+		PathPattern:        "/v2/subscriptions/{subscription_id}/deliveries/{delivery_id}/actions/redeliver",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2RedeliverSubscriptionDeliveryReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2RedeliverSubscriptionDeliveryAccepted), nil
+
+}
+
+/*
+V2RegisterSubscription Registers a webhook that is called when the subscribed clusters and hosts change.
+*/
+func (a *Client) V2RegisterSubscription(ctx context.Context, params *V2RegisterSubscriptionParams) (*V2RegisterSubscriptionCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2RegisterSubscription",
+		Method:             "POST",
+		PathPattern:        "/v2/subscriptions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2RegisterSubscriptionReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2RegisterSubscriptionCreated), nil
+
+}
+
+/*
+V2UpdateSubscription Updates a webhook subscription.
+*/
+func (a *Client) V2UpdateSubscription(ctx context.Context, params *V2UpdateSubscriptionParams) (*V2UpdateSubscriptionCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2UpdateSubscription",
+		Method:             "PATCH",
+		PathPattern:        "/v2/subscriptions/{subscription_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2UpdateSubscriptionReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2UpdateSubscriptionCreated), nil
+
+}
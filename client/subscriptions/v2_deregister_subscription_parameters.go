@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2DeregisterSubscriptionParams creates a new V2DeregisterSubscriptionParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2DeregisterSubscriptionParams() *V2DeregisterSubscriptionParams {
+	return &V2DeregisterSubscriptionParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2DeregisterSubscriptionParamsWithTimeout creates a new V2DeregisterSubscriptionParams object
+// with the ability to set a timeout on a request.
+func NewV2DeregisterSubscriptionParamsWithTimeout(timeout time.Duration) *V2DeregisterSubscriptionParams {
+	return &V2DeregisterSubscriptionParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2DeregisterSubscriptionParamsWithContext creates a new V2DeregisterSubscriptionParams object
+// with the ability to set a context for a request.
+func NewV2DeregisterSubscriptionParamsWithContext(ctx context.Context) *V2DeregisterSubscriptionParams {
+	return &V2DeregisterSubscriptionParams{
+		Context: ctx,
+	}
+}
+
+// NewV2DeregisterSubscriptionParamsWithHTTPClient creates a new V2DeregisterSubscriptionParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2DeregisterSubscriptionParamsWithHTTPClient(client *http.Client) *V2DeregisterSubscriptionParams {
+	return &V2DeregisterSubscriptionParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2DeregisterSubscriptionParams contains all the parameters to send to the API endpoint
+
+	for the v2 deregister subscription operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2DeregisterSubscriptionParams struct {
+
+	/* SubscriptionID.
+
+	   The subscription to be deleted.
+
+	   Format: uuid
+	*/
+	SubscriptionID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 deregister subscription params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DeregisterSubscriptionParams) WithDefaults() *V2DeregisterSubscriptionParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 deregister subscription params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DeregisterSubscriptionParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 deregister subscription params
+func (o *V2DeregisterSubscriptionParams) WithTimeout(timeout time.Duration) *V2DeregisterSubscriptionParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 deregister subscription params
+func (o *V2DeregisterSubscriptionParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 deregister subscription params
+func (o *V2DeregisterSubscriptionParams) WithContext(ctx context.Context) *V2DeregisterSubscriptionParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 deregister subscription params
+func (o *V2DeregisterSubscriptionParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 deregister subscription params
+func (o *V2DeregisterSubscriptionParams) WithHTTPClient(client *http.Client) *V2DeregisterSubscriptionParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 deregister subscription params
+func (o *V2DeregisterSubscriptionParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithSubscriptionID adds the subscriptionID to the v2 deregister subscription params
+func (o *V2DeregisterSubscriptionParams) WithSubscriptionID(subscriptionID strfmt.UUID) *V2DeregisterSubscriptionParams {
+	o.SetSubscriptionID(subscriptionID)
+	return o
+}
+
+// SetSubscriptionID adds the subscriptionId to the v2 deregister subscription params
+func (o *V2DeregisterSubscriptionParams) SetSubscriptionID(subscriptionID strfmt.UUID) {
+	o.SubscriptionID = subscriptionID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2DeregisterSubscriptionParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param subscription_id
+	if err := r.SetPathParam("subscription_id", o.SubscriptionID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
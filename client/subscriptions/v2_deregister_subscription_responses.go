@@ -0,0 +1,431 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DeregisterSubscriptionReader is a Reader for the V2DeregisterSubscription structure.
+type V2DeregisterSubscriptionReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2DeregisterSubscriptionReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewV2DeregisterSubscriptionNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2DeregisterSubscriptionUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2DeregisterSubscriptionForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2DeregisterSubscriptionNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2DeregisterSubscriptionInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 501:
+		result := NewV2DeregisterSubscriptionNotImplemented()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2DeregisterSubscriptionNoContent creates a V2DeregisterSubscriptionNoContent with default headers values
+func NewV2DeregisterSubscriptionNoContent() *V2DeregisterSubscriptionNoContent {
+	return &V2DeregisterSubscriptionNoContent{}
+}
+
+/*
+V2DeregisterSubscriptionNoContent describes a response with status code 204, with default header values.
+
+Success.
+*/
+type V2DeregisterSubscriptionNoContent struct {
+}
+
+// IsSuccess returns true when this v2 deregister subscription no content response has a 2xx status code
+func (o *V2DeregisterSubscriptionNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 deregister subscription no content response has a 3xx status code
+func (o *V2DeregisterSubscriptionNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 deregister subscription no content response has a 4xx status code
+func (o *V2DeregisterSubscriptionNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 deregister subscription no content response has a 5xx status code
+func (o *V2DeregisterSubscriptionNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 deregister subscription no content response a status code equal to that given
+func (o *V2DeregisterSubscriptionNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+func (o *V2DeregisterSubscriptionNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /v2/subscriptions/{subscription_id}][%d] v2DeregisterSubscriptionNoContent ", 204)
+}
+
+func (o *V2DeregisterSubscriptionNoContent) String() string {
+	return fmt.Sprintf("[DELETE /v2/subscriptions/{subscription_id}][%d] v2DeregisterSubscriptionNoContent ", 204)
+}
+
+func (o *V2DeregisterSubscriptionNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewV2DeregisterSubscriptionUnauthorized creates a V2DeregisterSubscriptionUnauthorized with default headers values
+func NewV2DeregisterSubscriptionUnauthorized() *V2DeregisterSubscriptionUnauthorized {
+	return &V2DeregisterSubscriptionUnauthorized{}
+}
+
+/*
+V2DeregisterSubscriptionUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2DeregisterSubscriptionUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 deregister subscription unauthorized response has a 2xx status code
+func (o *V2DeregisterSubscriptionUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 deregister subscription unauthorized response has a 3xx status code
+func (o *V2DeregisterSubscriptionUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 deregister subscription unauthorized response has a 4xx status code
+func (o *V2DeregisterSubscriptionUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 deregister subscription unauthorized response has a 5xx status code
+func (o *V2DeregisterSubscriptionUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 deregister subscription unauthorized response a status code equal to that given
+func (o *V2DeregisterSubscriptionUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2DeregisterSubscriptionUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /v2/subscriptions/{subscription_id}][%d] v2DeregisterSubscriptionUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DeregisterSubscriptionUnauthorized) String() string {
+	return fmt.Sprintf("[DELETE /v2/subscriptions/{subscription_id}][%d] v2DeregisterSubscriptionUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DeregisterSubscriptionUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DeregisterSubscriptionUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeregisterSubscriptionForbidden creates a V2DeregisterSubscriptionForbidden with default headers values
+func NewV2DeregisterSubscriptionForbidden() *V2DeregisterSubscriptionForbidden {
+	return &V2DeregisterSubscriptionForbidden{}
+}
+
+/*
+V2DeregisterSubscriptionForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2DeregisterSubscriptionForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 deregister subscription forbidden response has a 2xx status code
+func (o *V2DeregisterSubscriptionForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 deregister subscription forbidden response has a 3xx status code
+func (o *V2DeregisterSubscriptionForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 deregister subscription forbidden response has a 4xx status code
+func (o *V2DeregisterSubscriptionForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 deregister subscription forbidden response has a 5xx status code
+func (o *V2DeregisterSubscriptionForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 deregister subscription forbidden response a status code equal to that given
+func (o *V2DeregisterSubscriptionForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2DeregisterSubscriptionForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /v2/subscriptions/{subscription_id}][%d] v2DeregisterSubscriptionForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DeregisterSubscriptionForbidden) String() string {
+	return fmt.Sprintf("[DELETE /v2/subscriptions/{subscription_id}][%d] v2DeregisterSubscriptionForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DeregisterSubscriptionForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DeregisterSubscriptionForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeregisterSubscriptionNotFound creates a V2DeregisterSubscriptionNotFound with default headers values
+func NewV2DeregisterSubscriptionNotFound() *V2DeregisterSubscriptionNotFound {
+	return &V2DeregisterSubscriptionNotFound{}
+}
+
+/*
+V2DeregisterSubscriptionNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2DeregisterSubscriptionNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 deregister subscription not found response has a 2xx status code
+func (o *V2DeregisterSubscriptionNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 deregister subscription not found response has a 3xx status code
+func (o *V2DeregisterSubscriptionNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 deregister subscription not found response has a 4xx status code
+func (o *V2DeregisterSubscriptionNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 deregister subscription not found response has a 5xx status code
+func (o *V2DeregisterSubscriptionNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 deregister subscription not found response a status code equal to that given
+func (o *V2DeregisterSubscriptionNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2DeregisterSubscriptionNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /v2/subscriptions/{subscription_id}][%d] v2DeregisterSubscriptionNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DeregisterSubscriptionNotFound) String() string {
+	return fmt.Sprintf("[DELETE /v2/subscriptions/{subscription_id}][%d] v2DeregisterSubscriptionNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DeregisterSubscriptionNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DeregisterSubscriptionNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeregisterSubscriptionInternalServerError creates a V2DeregisterSubscriptionInternalServerError with default headers values
+func NewV2DeregisterSubscriptionInternalServerError() *V2DeregisterSubscriptionInternalServerError {
+	return &V2DeregisterSubscriptionInternalServerError{}
+}
+
+/*
+V2DeregisterSubscriptionInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2DeregisterSubscriptionInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 deregister subscription internal server error response has a 2xx status code
+func (o *V2DeregisterSubscriptionInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 deregister subscription internal server error response has a 3xx status code
+func (o *V2DeregisterSubscriptionInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 deregister subscription internal server error response has a 4xx status code
+func (o *V2DeregisterSubscriptionInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 deregister subscription internal server error response has a 5xx status code
+func (o *V2DeregisterSubscriptionInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 deregister subscription internal server error response a status code equal to that given
+func (o *V2DeregisterSubscriptionInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2DeregisterSubscriptionInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /v2/subscriptions/{subscription_id}][%d] v2DeregisterSubscriptionInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DeregisterSubscriptionInternalServerError) String() string {
+	return fmt.Sprintf("[DELETE /v2/subscriptions/{subscription_id}][%d] v2DeregisterSubscriptionInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DeregisterSubscriptionInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DeregisterSubscriptionInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeregisterSubscriptionNotImplemented creates a V2DeregisterSubscriptionNotImplemented with default headers values
+func NewV2DeregisterSubscriptionNotImplemented() *V2DeregisterSubscriptionNotImplemented {
+	return &V2DeregisterSubscriptionNotImplemented{}
+}
+
+/*
+V2DeregisterSubscriptionNotImplemented describes a response with status code 501, with default header values.
+
+Not implemented.
+*/
+type V2DeregisterSubscriptionNotImplemented struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 deregister subscription not implemented response has a 2xx status code
+func (o *V2DeregisterSubscriptionNotImplemented) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 deregister subscription not implemented response has a 3xx status code
+func (o *V2DeregisterSubscriptionNotImplemented) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 deregister subscription not implemented response has a 4xx status code
+func (o *V2DeregisterSubscriptionNotImplemented) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 deregister subscription not implemented response has a 5xx status code
+func (o *V2DeregisterSubscriptionNotImplemented) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 deregister subscription not implemented response a status code equal to that given
+func (o *V2DeregisterSubscriptionNotImplemented) IsCode(code int) bool {
+	return code == 501
+}
+
+func (o *V2DeregisterSubscriptionNotImplemented) Error() string {
+	return fmt.Sprintf("[DELETE /v2/subscriptions/{subscription_id}][%d] v2DeregisterSubscriptionNotImplemented  %+v", 501, o.Payload)
+}
+
+func (o *V2DeregisterSubscriptionNotImplemented) String() string {
+	return fmt.Sprintf("[DELETE /v2/subscriptions/{subscription_id}][%d] v2DeregisterSubscriptionNotImplemented  %+v", 501, o.Payload)
+}
+
+func (o *V2DeregisterSubscriptionNotImplemented) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DeregisterSubscriptionNotImplemented) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
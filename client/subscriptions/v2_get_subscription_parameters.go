@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2GetSubscriptionParams creates a new V2GetSubscriptionParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2GetSubscriptionParams() *V2GetSubscriptionParams {
+	return &V2GetSubscriptionParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2GetSubscriptionParamsWithTimeout creates a new V2GetSubscriptionParams object
+// with the ability to set a timeout on a request.
+func NewV2GetSubscriptionParamsWithTimeout(timeout time.Duration) *V2GetSubscriptionParams {
+	return &V2GetSubscriptionParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2GetSubscriptionParamsWithContext creates a new V2GetSubscriptionParams object
+// with the ability to set a context for a request.
+func NewV2GetSubscriptionParamsWithContext(ctx context.Context) *V2GetSubscriptionParams {
+	return &V2GetSubscriptionParams{
+		Context: ctx,
+	}
+}
+
+// NewV2GetSubscriptionParamsWithHTTPClient creates a new V2GetSubscriptionParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2GetSubscriptionParamsWithHTTPClient(client *http.Client) *V2GetSubscriptionParams {
+	return &V2GetSubscriptionParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2GetSubscriptionParams contains all the parameters to send to the API endpoint
+
+	for the v2 get subscription operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2GetSubscriptionParams struct {
+
+	/* SubscriptionID.
+
+	   The subscription to be retrieved.
+
+	   Format: uuid
+	*/
+	SubscriptionID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 get subscription params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetSubscriptionParams) WithDefaults() *V2GetSubscriptionParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 get subscription params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetSubscriptionParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 get subscription params
+func (o *V2GetSubscriptionParams) WithTimeout(timeout time.Duration) *V2GetSubscriptionParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 get subscription params
+func (o *V2GetSubscriptionParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 get subscription params
+func (o *V2GetSubscriptionParams) WithContext(ctx context.Context) *V2GetSubscriptionParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 get subscription params
+func (o *V2GetSubscriptionParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 get subscription params
+func (o *V2GetSubscriptionParams) WithHTTPClient(client *http.Client) *V2GetSubscriptionParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 get subscription params
+func (o *V2GetSubscriptionParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithSubscriptionID adds the subscriptionID to the v2 get subscription params
+func (o *V2GetSubscriptionParams) WithSubscriptionID(subscriptionID strfmt.UUID) *V2GetSubscriptionParams {
+	o.SetSubscriptionID(subscriptionID)
+	return o
+}
+
+// SetSubscriptionID adds the subscriptionId to the v2 get subscription params
+func (o *V2GetSubscriptionParams) SetSubscriptionID(subscriptionID strfmt.UUID) {
+	o.SubscriptionID = subscriptionID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2GetSubscriptionParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param subscription_id
+	if err := r.SetPathParam("subscription_id", o.SubscriptionID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
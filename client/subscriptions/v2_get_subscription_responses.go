@@ -0,0 +1,443 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetSubscriptionReader is a Reader for the V2GetSubscription structure.
+type V2GetSubscriptionReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2GetSubscriptionReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2GetSubscriptionOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2GetSubscriptionUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2GetSubscriptionForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2GetSubscriptionNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2GetSubscriptionInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 501:
+		result := NewV2GetSubscriptionNotImplemented()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2GetSubscriptionOK creates a V2GetSubscriptionOK with default headers values
+func NewV2GetSubscriptionOK() *V2GetSubscriptionOK {
+	return &V2GetSubscriptionOK{}
+}
+
+/*
+V2GetSubscriptionOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2GetSubscriptionOK struct {
+	Payload *models.Subscription
+}
+
+// IsSuccess returns true when this v2 get subscription o k response has a 2xx status code
+func (o *V2GetSubscriptionOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 get subscription o k response has a 3xx status code
+func (o *V2GetSubscriptionOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get subscription o k response has a 4xx status code
+func (o *V2GetSubscriptionOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get subscription o k response has a 5xx status code
+func (o *V2GetSubscriptionOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get subscription o k response a status code equal to that given
+func (o *V2GetSubscriptionOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2GetSubscriptionOK) Error() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}][%d] v2GetSubscriptionOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetSubscriptionOK) String() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}][%d] v2GetSubscriptionOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetSubscriptionOK) GetPayload() *models.Subscription {
+	return o.Payload
+}
+
+func (o *V2GetSubscriptionOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Subscription)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetSubscriptionUnauthorized creates a V2GetSubscriptionUnauthorized with default headers values
+func NewV2GetSubscriptionUnauthorized() *V2GetSubscriptionUnauthorized {
+	return &V2GetSubscriptionUnauthorized{}
+}
+
+/*
+V2GetSubscriptionUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2GetSubscriptionUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get subscription unauthorized response has a 2xx status code
+func (o *V2GetSubscriptionUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get subscription unauthorized response has a 3xx status code
+func (o *V2GetSubscriptionUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get subscription unauthorized response has a 4xx status code
+func (o *V2GetSubscriptionUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get subscription unauthorized response has a 5xx status code
+func (o *V2GetSubscriptionUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get subscription unauthorized response a status code equal to that given
+func (o *V2GetSubscriptionUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2GetSubscriptionUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}][%d] v2GetSubscriptionUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetSubscriptionUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}][%d] v2GetSubscriptionUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetSubscriptionUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetSubscriptionUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetSubscriptionForbidden creates a V2GetSubscriptionForbidden with default headers values
+func NewV2GetSubscriptionForbidden() *V2GetSubscriptionForbidden {
+	return &V2GetSubscriptionForbidden{}
+}
+
+/*
+V2GetSubscriptionForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2GetSubscriptionForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get subscription forbidden response has a 2xx status code
+func (o *V2GetSubscriptionForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get subscription forbidden response has a 3xx status code
+func (o *V2GetSubscriptionForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get subscription forbidden response has a 4xx status code
+func (o *V2GetSubscriptionForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get subscription forbidden response has a 5xx status code
+func (o *V2GetSubscriptionForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get subscription forbidden response a status code equal to that given
+func (o *V2GetSubscriptionForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2GetSubscriptionForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}][%d] v2GetSubscriptionForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetSubscriptionForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}][%d] v2GetSubscriptionForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetSubscriptionForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetSubscriptionForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetSubscriptionNotFound creates a V2GetSubscriptionNotFound with default headers values
+func NewV2GetSubscriptionNotFound() *V2GetSubscriptionNotFound {
+	return &V2GetSubscriptionNotFound{}
+}
+
+/*
+V2GetSubscriptionNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2GetSubscriptionNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get subscription not found response has a 2xx status code
+func (o *V2GetSubscriptionNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get subscription not found response has a 3xx status code
+func (o *V2GetSubscriptionNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get subscription not found response has a 4xx status code
+func (o *V2GetSubscriptionNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get subscription not found response has a 5xx status code
+func (o *V2GetSubscriptionNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get subscription not found response a status code equal to that given
+func (o *V2GetSubscriptionNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2GetSubscriptionNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}][%d] v2GetSubscriptionNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetSubscriptionNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}][%d] v2GetSubscriptionNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetSubscriptionNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetSubscriptionNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetSubscriptionInternalServerError creates a V2GetSubscriptionInternalServerError with default headers values
+func NewV2GetSubscriptionInternalServerError() *V2GetSubscriptionInternalServerError {
+	return &V2GetSubscriptionInternalServerError{}
+}
+
+/*
+V2GetSubscriptionInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2GetSubscriptionInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get subscription internal server error response has a 2xx status code
+func (o *V2GetSubscriptionInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get subscription internal server error response has a 3xx status code
+func (o *V2GetSubscriptionInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get subscription internal server error response has a 4xx status code
+func (o *V2GetSubscriptionInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get subscription internal server error response has a 5xx status code
+func (o *V2GetSubscriptionInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 get subscription internal server error response a status code equal to that given
+func (o *V2GetSubscriptionInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2GetSubscriptionInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}][%d] v2GetSubscriptionInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetSubscriptionInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}][%d] v2GetSubscriptionInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetSubscriptionInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetSubscriptionInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetSubscriptionNotImplemented creates a V2GetSubscriptionNotImplemented with default headers values
+func NewV2GetSubscriptionNotImplemented() *V2GetSubscriptionNotImplemented {
+	return &V2GetSubscriptionNotImplemented{}
+}
+
+/*
+V2GetSubscriptionNotImplemented describes a response with status code 501, with default header values.
+
+Not implemented.
+*/
+type V2GetSubscriptionNotImplemented struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get subscription not implemented response has a 2xx status code
+func (o *V2GetSubscriptionNotImplemented) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get subscription not implemented response has a 3xx status code
+func (o *V2GetSubscriptionNotImplemented) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get subscription not implemented response has a 4xx status code
+func (o *V2GetSubscriptionNotImplemented) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get subscription not implemented response has a 5xx status code
+func (o *V2GetSubscriptionNotImplemented) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 get subscription not implemented response a status code equal to that given
+func (o *V2GetSubscriptionNotImplemented) IsCode(code int) bool {
+	return code == 501
+}
+
+func (o *V2GetSubscriptionNotImplemented) Error() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}][%d] v2GetSubscriptionNotImplemented  %+v", 501, o.Payload)
+}
+
+func (o *V2GetSubscriptionNotImplemented) String() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}][%d] v2GetSubscriptionNotImplemented  %+v", 501, o.Payload)
+}
+
+func (o *V2GetSubscriptionNotImplemented) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetSubscriptionNotImplemented) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
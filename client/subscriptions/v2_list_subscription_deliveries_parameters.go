@@ -0,0 +1,269 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewV2ListSubscriptionDeliveriesParams creates a new V2ListSubscriptionDeliveriesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListSubscriptionDeliveriesParams() *V2ListSubscriptionDeliveriesParams {
+	return &V2ListSubscriptionDeliveriesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListSubscriptionDeliveriesParamsWithTimeout creates a new V2ListSubscriptionDeliveriesParams object
+// with the ability to set a timeout on a request.
+func NewV2ListSubscriptionDeliveriesParamsWithTimeout(timeout time.Duration) *V2ListSubscriptionDeliveriesParams {
+	return &V2ListSubscriptionDeliveriesParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListSubscriptionDeliveriesParamsWithContext creates a new V2ListSubscriptionDeliveriesParams object
+// with the ability to set a context for a request.
+func NewV2ListSubscriptionDeliveriesParamsWithContext(ctx context.Context) *V2ListSubscriptionDeliveriesParams {
+	return &V2ListSubscriptionDeliveriesParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListSubscriptionDeliveriesParamsWithHTTPClient creates a new V2ListSubscriptionDeliveriesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListSubscriptionDeliveriesParamsWithHTTPClient(client *http.Client) *V2ListSubscriptionDeliveriesParams {
+	return &V2ListSubscriptionDeliveriesParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2ListSubscriptionDeliveriesParams contains all the parameters to send to the API endpoint
+
+	for the v2 list subscription deliveries operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2ListSubscriptionDeliveriesParams struct {
+
+	/* Limit.
+
+	   The maximum number of records to retrieve.
+
+	   Default: 100
+	*/
+	Limit *int64
+
+	/* Offset.
+
+	   Number of records to skip before starting to return the records.
+	*/
+	Offset *int64
+
+	/* Status.
+
+	   If provided, returns only deliveries with this status.
+	*/
+	Status *string
+
+	/* SubscriptionID.
+
+	   The subscription to list the deliveries of.
+
+	   Format: uuid
+	*/
+	SubscriptionID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list subscription deliveries params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListSubscriptionDeliveriesParams) WithDefaults() *V2ListSubscriptionDeliveriesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list subscription deliveries params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListSubscriptionDeliveriesParams) SetDefaults() {
+	var (
+		limitDefault = int64(100)
+	)
+
+	val := V2ListSubscriptionDeliveriesParams{
+		Limit: &limitDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the v2 list subscription deliveries params
+func (o *V2ListSubscriptionDeliveriesParams) WithTimeout(timeout time.Duration) *V2ListSubscriptionDeliveriesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list subscription deliveries params
+func (o *V2ListSubscriptionDeliveriesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list subscription deliveries params
+func (o *V2ListSubscriptionDeliveriesParams) WithContext(ctx context.Context) *V2ListSubscriptionDeliveriesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list subscription deliveries params
+func (o *V2ListSubscriptionDeliveriesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list subscription deliveries params
+func (o *V2ListSubscriptionDeliveriesParams) WithHTTPClient(client *http.Client) *V2ListSubscriptionDeliveriesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list subscription deliveries params
+func (o *V2ListSubscriptionDeliveriesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLimit adds the limit to the v2 list subscription deliveries params
+func (o *V2ListSubscriptionDeliveriesParams) WithLimit(limit *int64) *V2ListSubscriptionDeliveriesParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the v2 list subscription deliveries params
+func (o *V2ListSubscriptionDeliveriesParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithOffset adds the offset to the v2 list subscription deliveries params
+func (o *V2ListSubscriptionDeliveriesParams) WithOffset(offset *int64) *V2ListSubscriptionDeliveriesParams {
+	o.SetOffset(offset)
+	return o
+}
+
+// SetOffset adds the offset to the v2 list subscription deliveries params
+func (o *V2ListSubscriptionDeliveriesParams) SetOffset(offset *int64) {
+	o.Offset = offset
+}
+
+// WithStatus adds the status to the v2 list subscription deliveries params
+func (o *V2ListSubscriptionDeliveriesParams) WithStatus(status *string) *V2ListSubscriptionDeliveriesParams {
+	o.SetStatus(status)
+	return o
+}
+
+// SetStatus adds the status to the v2 list subscription deliveries params
+func (o *V2ListSubscriptionDeliveriesParams) SetStatus(status *string) {
+	o.Status = status
+}
+
+// WithSubscriptionID adds the subscriptionID to the v2 list subscription deliveries params
+func (o *V2ListSubscriptionDeliveriesParams) WithSubscriptionID(subscriptionID strfmt.UUID) *V2ListSubscriptionDeliveriesParams {
+	o.SetSubscriptionID(subscriptionID)
+	return o
+}
+
+// SetSubscriptionID adds the subscriptionId to the v2 list subscription deliveries params
+func (o *V2ListSubscriptionDeliveriesParams) SetSubscriptionID(subscriptionID strfmt.UUID) {
+	o.SubscriptionID = subscriptionID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListSubscriptionDeliveriesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Offset != nil {
+
+		// query param offset
+		var qrOffset int64
+
+		if o.Offset != nil {
+			qrOffset = *o.Offset
+		}
+		qOffset := swag.FormatInt64(qrOffset)
+		if qOffset != "" {
+
+			if err := r.SetQueryParam("offset", qOffset); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Status != nil {
+
+		// query param status
+		var qrStatus string
+
+		if o.Status != nil {
+			qrStatus = *o.Status
+		}
+		qStatus := qrStatus
+		if qStatus != "" {
+
+			if err := r.SetQueryParam("status", qStatus); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param subscription_id
+	if err := r.SetPathParam("subscription_id", o.SubscriptionID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
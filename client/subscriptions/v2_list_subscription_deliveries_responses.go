@@ -0,0 +1,441 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListSubscriptionDeliveriesReader is a Reader for the V2ListSubscriptionDeliveries structure.
+type V2ListSubscriptionDeliveriesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListSubscriptionDeliveriesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListSubscriptionDeliveriesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2ListSubscriptionDeliveriesUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListSubscriptionDeliveriesForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2ListSubscriptionDeliveriesNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListSubscriptionDeliveriesInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 501:
+		result := NewV2ListSubscriptionDeliveriesNotImplemented()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListSubscriptionDeliveriesOK creates a V2ListSubscriptionDeliveriesOK with default headers values
+func NewV2ListSubscriptionDeliveriesOK() *V2ListSubscriptionDeliveriesOK {
+	return &V2ListSubscriptionDeliveriesOK{}
+}
+
+/*
+V2ListSubscriptionDeliveriesOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListSubscriptionDeliveriesOK struct {
+	Payload models.SubscriptionDeliveryList
+}
+
+// IsSuccess returns true when this v2 list subscription deliveries o k response has a 2xx status code
+func (o *V2ListSubscriptionDeliveriesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 list subscription deliveries o k response has a 3xx status code
+func (o *V2ListSubscriptionDeliveriesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list subscription deliveries o k response has a 4xx status code
+func (o *V2ListSubscriptionDeliveriesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list subscription deliveries o k response has a 5xx status code
+func (o *V2ListSubscriptionDeliveriesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list subscription deliveries o k response a status code equal to that given
+func (o *V2ListSubscriptionDeliveriesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2ListSubscriptionDeliveriesOK) Error() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}/deliveries][%d] v2ListSubscriptionDeliveriesOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListSubscriptionDeliveriesOK) String() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}/deliveries][%d] v2ListSubscriptionDeliveriesOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListSubscriptionDeliveriesOK) GetPayload() models.SubscriptionDeliveryList {
+	return o.Payload
+}
+
+func (o *V2ListSubscriptionDeliveriesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListSubscriptionDeliveriesUnauthorized creates a V2ListSubscriptionDeliveriesUnauthorized with default headers values
+func NewV2ListSubscriptionDeliveriesUnauthorized() *V2ListSubscriptionDeliveriesUnauthorized {
+	return &V2ListSubscriptionDeliveriesUnauthorized{}
+}
+
+/*
+V2ListSubscriptionDeliveriesUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListSubscriptionDeliveriesUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list subscription deliveries unauthorized response has a 2xx status code
+func (o *V2ListSubscriptionDeliveriesUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list subscription deliveries unauthorized response has a 3xx status code
+func (o *V2ListSubscriptionDeliveriesUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list subscription deliveries unauthorized response has a 4xx status code
+func (o *V2ListSubscriptionDeliveriesUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list subscription deliveries unauthorized response has a 5xx status code
+func (o *V2ListSubscriptionDeliveriesUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list subscription deliveries unauthorized response a status code equal to that given
+func (o *V2ListSubscriptionDeliveriesUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2ListSubscriptionDeliveriesUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}/deliveries][%d] v2ListSubscriptionDeliveriesUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListSubscriptionDeliveriesUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}/deliveries][%d] v2ListSubscriptionDeliveriesUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListSubscriptionDeliveriesUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListSubscriptionDeliveriesUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListSubscriptionDeliveriesForbidden creates a V2ListSubscriptionDeliveriesForbidden with default headers values
+func NewV2ListSubscriptionDeliveriesForbidden() *V2ListSubscriptionDeliveriesForbidden {
+	return &V2ListSubscriptionDeliveriesForbidden{}
+}
+
+/*
+V2ListSubscriptionDeliveriesForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListSubscriptionDeliveriesForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list subscription deliveries forbidden response has a 2xx status code
+func (o *V2ListSubscriptionDeliveriesForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list subscription deliveries forbidden response has a 3xx status code
+func (o *V2ListSubscriptionDeliveriesForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list subscription deliveries forbidden response has a 4xx status code
+func (o *V2ListSubscriptionDeliveriesForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list subscription deliveries forbidden response has a 5xx status code
+func (o *V2ListSubscriptionDeliveriesForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list subscription deliveries forbidden response a status code equal to that given
+func (o *V2ListSubscriptionDeliveriesForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2ListSubscriptionDeliveriesForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}/deliveries][%d] v2ListSubscriptionDeliveriesForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListSubscriptionDeliveriesForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}/deliveries][%d] v2ListSubscriptionDeliveriesForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListSubscriptionDeliveriesForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListSubscriptionDeliveriesForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListSubscriptionDeliveriesNotFound creates a V2ListSubscriptionDeliveriesNotFound with default headers values
+func NewV2ListSubscriptionDeliveriesNotFound() *V2ListSubscriptionDeliveriesNotFound {
+	return &V2ListSubscriptionDeliveriesNotFound{}
+}
+
+/*
+V2ListSubscriptionDeliveriesNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2ListSubscriptionDeliveriesNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list subscription deliveries not found response has a 2xx status code
+func (o *V2ListSubscriptionDeliveriesNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list subscription deliveries not found response has a 3xx status code
+func (o *V2ListSubscriptionDeliveriesNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list subscription deliveries not found response has a 4xx status code
+func (o *V2ListSubscriptionDeliveriesNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list subscription deliveries not found response has a 5xx status code
+func (o *V2ListSubscriptionDeliveriesNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list subscription deliveries not found response a status code equal to that given
+func (o *V2ListSubscriptionDeliveriesNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2ListSubscriptionDeliveriesNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}/deliveries][%d] v2ListSubscriptionDeliveriesNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2ListSubscriptionDeliveriesNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}/deliveries][%d] v2ListSubscriptionDeliveriesNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2ListSubscriptionDeliveriesNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListSubscriptionDeliveriesNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListSubscriptionDeliveriesInternalServerError creates a V2ListSubscriptionDeliveriesInternalServerError with default headers values
+func NewV2ListSubscriptionDeliveriesInternalServerError() *V2ListSubscriptionDeliveriesInternalServerError {
+	return &V2ListSubscriptionDeliveriesInternalServerError{}
+}
+
+/*
+V2ListSubscriptionDeliveriesInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListSubscriptionDeliveriesInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list subscription deliveries internal server error response has a 2xx status code
+func (o *V2ListSubscriptionDeliveriesInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list subscription deliveries internal server error response has a 3xx status code
+func (o *V2ListSubscriptionDeliveriesInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list subscription deliveries internal server error response has a 4xx status code
+func (o *V2ListSubscriptionDeliveriesInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list subscription deliveries internal server error response has a 5xx status code
+func (o *V2ListSubscriptionDeliveriesInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 list subscription deliveries internal server error response a status code equal to that given
+func (o *V2ListSubscriptionDeliveriesInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2ListSubscriptionDeliveriesInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}/deliveries][%d] v2ListSubscriptionDeliveriesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListSubscriptionDeliveriesInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}/deliveries][%d] v2ListSubscriptionDeliveriesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListSubscriptionDeliveriesInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListSubscriptionDeliveriesInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListSubscriptionDeliveriesNotImplemented creates a V2ListSubscriptionDeliveriesNotImplemented with default headers values
+func NewV2ListSubscriptionDeliveriesNotImplemented() *V2ListSubscriptionDeliveriesNotImplemented {
+	return &V2ListSubscriptionDeliveriesNotImplemented{}
+}
+
+/*
+V2ListSubscriptionDeliveriesNotImplemented describes a response with status code 501, with default header values.
+
+Not implemented.
+*/
+type V2ListSubscriptionDeliveriesNotImplemented struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list subscription deliveries not implemented response has a 2xx status code
+func (o *V2ListSubscriptionDeliveriesNotImplemented) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list subscription deliveries not implemented response has a 3xx status code
+func (o *V2ListSubscriptionDeliveriesNotImplemented) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list subscription deliveries not implemented response has a 4xx status code
+func (o *V2ListSubscriptionDeliveriesNotImplemented) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list subscription deliveries not implemented response has a 5xx status code
+func (o *V2ListSubscriptionDeliveriesNotImplemented) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 list subscription deliveries not implemented response a status code equal to that given
+func (o *V2ListSubscriptionDeliveriesNotImplemented) IsCode(code int) bool {
+	return code == 501
+}
+
+func (o *V2ListSubscriptionDeliveriesNotImplemented) Error() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}/deliveries][%d] v2ListSubscriptionDeliveriesNotImplemented  %+v", 501, o.Payload)
+}
+
+func (o *V2ListSubscriptionDeliveriesNotImplemented) String() string {
+	return fmt.Sprintf("[GET /v2/subscriptions/{subscription_id}/deliveries][%d] v2ListSubscriptionDeliveriesNotImplemented  %+v", 501, o.Payload)
+}
+
+func (o *V2ListSubscriptionDeliveriesNotImplemented) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListSubscriptionDeliveriesNotImplemented) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2ListSubscriptionsParams creates a new V2ListSubscriptionsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListSubscriptionsParams() *V2ListSubscriptionsParams {
+	return &V2ListSubscriptionsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListSubscriptionsParamsWithTimeout creates a new V2ListSubscriptionsParams object
+// with the ability to set a timeout on a request.
+func NewV2ListSubscriptionsParamsWithTimeout(timeout time.Duration) *V2ListSubscriptionsParams {
+	return &V2ListSubscriptionsParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListSubscriptionsParamsWithContext creates a new V2ListSubscriptionsParams object
+// with the ability to set a context for a request.
+func NewV2ListSubscriptionsParamsWithContext(ctx context.Context) *V2ListSubscriptionsParams {
+	return &V2ListSubscriptionsParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListSubscriptionsParamsWithHTTPClient creates a new V2ListSubscriptionsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListSubscriptionsParamsWithHTTPClient(client *http.Client) *V2ListSubscriptionsParams {
+	return &V2ListSubscriptionsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2ListSubscriptionsParams contains all the parameters to send to the API endpoint
+
+	for the v2 list subscriptions operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2ListSubscriptionsParams struct {
+
+	/* ClusterID.
+
+	   If provided, returns only subscriptions for this cluster.
+
+	   Format: uuid
+	*/
+	ClusterID *strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list subscriptions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListSubscriptionsParams) WithDefaults() *V2ListSubscriptionsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list subscriptions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListSubscriptionsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 list subscriptions params
+func (o *V2ListSubscriptionsParams) WithTimeout(timeout time.Duration) *V2ListSubscriptionsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list subscriptions params
+func (o *V2ListSubscriptionsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list subscriptions params
+func (o *V2ListSubscriptionsParams) WithContext(ctx context.Context) *V2ListSubscriptionsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list subscriptions params
+func (o *V2ListSubscriptionsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list subscriptions params
+func (o *V2ListSubscriptionsParams) WithHTTPClient(client *http.Client) *V2ListSubscriptionsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list subscriptions params
+func (o *V2ListSubscriptionsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 list subscriptions params
+func (o *V2ListSubscriptionsParams) WithClusterID(clusterID *strfmt.UUID) *V2ListSubscriptionsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 list subscriptions params
+func (o *V2ListSubscriptionsParams) SetClusterID(clusterID *strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListSubscriptionsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.ClusterID != nil {
+
+		// query param cluster_id
+		var qrClusterID strfmt.UUID
+
+		if o.ClusterID != nil {
+			qrClusterID = *o.ClusterID
+		}
+		qClusterID := qrClusterID.String()
+		if qClusterID != "" {
+
+			if err := r.SetQueryParam("cluster_id", qClusterID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,372 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListSubscriptionsReader is a Reader for the V2ListSubscriptions structure.
+type V2ListSubscriptionsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListSubscriptionsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListSubscriptionsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2ListSubscriptionsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListSubscriptionsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListSubscriptionsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 501:
+		result := NewV2ListSubscriptionsNotImplemented()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListSubscriptionsOK creates a V2ListSubscriptionsOK with default headers values
+func NewV2ListSubscriptionsOK() *V2ListSubscriptionsOK {
+	return &V2ListSubscriptionsOK{}
+}
+
+/*
+V2ListSubscriptionsOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListSubscriptionsOK struct {
+	Payload models.SubscriptionList
+}
+
+// IsSuccess returns true when this v2 list subscriptions o k response has a 2xx status code
+func (o *V2ListSubscriptionsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 list subscriptions o k response has a 3xx status code
+func (o *V2ListSubscriptionsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list subscriptions o k response has a 4xx status code
+func (o *V2ListSubscriptionsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list subscriptions o k response has a 5xx status code
+func (o *V2ListSubscriptionsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list subscriptions o k response a status code equal to that given
+func (o *V2ListSubscriptionsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2ListSubscriptionsOK) Error() string {
+	return fmt.Sprintf("[GET /v2/subscriptions][%d] v2ListSubscriptionsOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListSubscriptionsOK) String() string {
+	return fmt.Sprintf("[GET /v2/subscriptions][%d] v2ListSubscriptionsOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListSubscriptionsOK) GetPayload() models.SubscriptionList {
+	return o.Payload
+}
+
+func (o *V2ListSubscriptionsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListSubscriptionsUnauthorized creates a V2ListSubscriptionsUnauthorized with default headers values
+func NewV2ListSubscriptionsUnauthorized() *V2ListSubscriptionsUnauthorized {
+	return &V2ListSubscriptionsUnauthorized{}
+}
+
+/*
+V2ListSubscriptionsUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListSubscriptionsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list subscriptions unauthorized response has a 2xx status code
+func (o *V2ListSubscriptionsUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list subscriptions unauthorized response has a 3xx status code
+func (o *V2ListSubscriptionsUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list subscriptions unauthorized response has a 4xx status code
+func (o *V2ListSubscriptionsUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list subscriptions unauthorized response has a 5xx status code
+func (o *V2ListSubscriptionsUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list subscriptions unauthorized response a status code equal to that given
+func (o *V2ListSubscriptionsUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2ListSubscriptionsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/subscriptions][%d] v2ListSubscriptionsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListSubscriptionsUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/subscriptions][%d] v2ListSubscriptionsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListSubscriptionsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListSubscriptionsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListSubscriptionsForbidden creates a V2ListSubscriptionsForbidden with default headers values
+func NewV2ListSubscriptionsForbidden() *V2ListSubscriptionsForbidden {
+	return &V2ListSubscriptionsForbidden{}
+}
+
+/*
+V2ListSubscriptionsForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListSubscriptionsForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list subscriptions forbidden response has a 2xx status code
+func (o *V2ListSubscriptionsForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list subscriptions forbidden response has a 3xx status code
+func (o *V2ListSubscriptionsForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list subscriptions forbidden response has a 4xx status code
+func (o *V2ListSubscriptionsForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list subscriptions forbidden response has a 5xx status code
+func (o *V2ListSubscriptionsForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list subscriptions forbidden response a status code equal to that given
+func (o *V2ListSubscriptionsForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2ListSubscriptionsForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/subscriptions][%d] v2ListSubscriptionsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListSubscriptionsForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/subscriptions][%d] v2ListSubscriptionsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListSubscriptionsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListSubscriptionsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListSubscriptionsInternalServerError creates a V2ListSubscriptionsInternalServerError with default headers values
+func NewV2ListSubscriptionsInternalServerError() *V2ListSubscriptionsInternalServerError {
+	return &V2ListSubscriptionsInternalServerError{}
+}
+
+/*
+V2ListSubscriptionsInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListSubscriptionsInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list subscriptions internal server error response has a 2xx status code
+func (o *V2ListSubscriptionsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list subscriptions internal server error response has a 3xx status code
+func (o *V2ListSubscriptionsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list subscriptions internal server error response has a 4xx status code
+func (o *V2ListSubscriptionsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list subscriptions internal server error response has a 5xx status code
+func (o *V2ListSubscriptionsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 list subscriptions internal server error response a status code equal to that given
+func (o *V2ListSubscriptionsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2ListSubscriptionsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/subscriptions][%d] v2ListSubscriptionsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListSubscriptionsInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/subscriptions][%d] v2ListSubscriptionsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListSubscriptionsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListSubscriptionsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListSubscriptionsNotImplemented creates a V2ListSubscriptionsNotImplemented with default headers values
+func NewV2ListSubscriptionsNotImplemented() *V2ListSubscriptionsNotImplemented {
+	return &V2ListSubscriptionsNotImplemented{}
+}
+
+/*
+V2ListSubscriptionsNotImplemented describes a response with status code 501, with default header values.
+
+Not implemented.
+*/
+type V2ListSubscriptionsNotImplemented struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list subscriptions not implemented response has a 2xx status code
+func (o *V2ListSubscriptionsNotImplemented) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list subscriptions not implemented response has a 3xx status code
+func (o *V2ListSubscriptionsNotImplemented) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list subscriptions not implemented response has a 4xx status code
+func (o *V2ListSubscriptionsNotImplemented) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list subscriptions not implemented response has a 5xx status code
+func (o *V2ListSubscriptionsNotImplemented) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 list subscriptions not implemented response a status code equal to that given
+func (o *V2ListSubscriptionsNotImplemented) IsCode(code int) bool {
+	return code == 501
+}
+
+func (o *V2ListSubscriptionsNotImplemented) Error() string {
+	return fmt.Sprintf("[GET /v2/subscriptions][%d] v2ListSubscriptionsNotImplemented  %+v", 501, o.Payload)
+}
+
+func (o *V2ListSubscriptionsNotImplemented) String() string {
+	return fmt.Sprintf("[GET /v2/subscriptions][%d] v2ListSubscriptionsNotImplemented  %+v", 501, o.Payload)
+}
+
+func (o *V2ListSubscriptionsNotImplemented) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListSubscriptionsNotImplemented) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
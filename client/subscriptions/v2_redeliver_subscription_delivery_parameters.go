@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2RedeliverSubscriptionDeliveryParams creates a new V2RedeliverSubscriptionDeliveryParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2RedeliverSubscriptionDeliveryParams() *V2RedeliverSubscriptionDeliveryParams {
+	return &V2RedeliverSubscriptionDeliveryParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2RedeliverSubscriptionDeliveryParamsWithTimeout creates a new V2RedeliverSubscriptionDeliveryParams object
+// with the ability to set a timeout on a request.
+func NewV2RedeliverSubscriptionDeliveryParamsWithTimeout(timeout time.Duration) *V2RedeliverSubscriptionDeliveryParams {
+	return &V2RedeliverSubscriptionDeliveryParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2RedeliverSubscriptionDeliveryParamsWithContext creates a new V2RedeliverSubscriptionDeliveryParams object
+// with the ability to set a context for a request.
+func NewV2RedeliverSubscriptionDeliveryParamsWithContext(ctx context.Context) *V2RedeliverSubscriptionDeliveryParams {
+	return &V2RedeliverSubscriptionDeliveryParams{
+		Context: ctx,
+	}
+}
+
+// NewV2RedeliverSubscriptionDeliveryParamsWithHTTPClient creates a new V2RedeliverSubscriptionDeliveryParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2RedeliverSubscriptionDeliveryParamsWithHTTPClient(client *http.Client) *V2RedeliverSubscriptionDeliveryParams {
+	return &V2RedeliverSubscriptionDeliveryParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2RedeliverSubscriptionDeliveryParams contains all the parameters to send to the API endpoint
+
+	for the v2 redeliver subscription delivery operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2RedeliverSubscriptionDeliveryParams struct {
+
+	/* DeliveryID.
+
+	   The delivery to be sent again.
+
+	   Format: uuid
+	*/
+	DeliveryID strfmt.UUID
+
+	/* SubscriptionID.
+
+	   The subscription of the delivery.
+
+	   Format: uuid
+	*/
+	SubscriptionID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 redeliver subscription delivery params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2RedeliverSubscriptionDeliveryParams) WithDefaults() *V2RedeliverSubscriptionDeliveryParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 redeliver subscription delivery params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2RedeliverSubscriptionDeliveryParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 redeliver subscription delivery params
+func (o *V2RedeliverSubscriptionDeliveryParams) WithTimeout(timeout time.Duration) *V2RedeliverSubscriptionDeliveryParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 redeliver subscription delivery params
+func (o *V2RedeliverSubscriptionDeliveryParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 redeliver subscription delivery params
+func (o *V2RedeliverSubscriptionDeliveryParams) WithContext(ctx context.Context) *V2RedeliverSubscriptionDeliveryParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 redeliver subscription delivery params
+func (o *V2RedeliverSubscriptionDeliveryParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 redeliver subscription delivery params
+func (o *V2RedeliverSubscriptionDeliveryParams) WithHTTPClient(client *http.Client) *V2RedeliverSubscriptionDeliveryParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 redeliver subscription delivery params
+func (o *V2RedeliverSubscriptionDeliveryParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDeliveryID adds the deliveryID to the v2 redeliver subscription delivery params
+func (o *V2RedeliverSubscriptionDeliveryParams) WithDeliveryID(deliveryID strfmt.UUID) *V2RedeliverSubscriptionDeliveryParams {
+	o.SetDeliveryID(deliveryID)
+	return o
+}
+
+// SetDeliveryID adds the deliveryId to the v2 redeliver subscription delivery params
+func (o *V2RedeliverSubscriptionDeliveryParams) SetDeliveryID(deliveryID strfmt.UUID) {
+	o.DeliveryID = deliveryID
+}
+
+// WithSubscriptionID adds the subscriptionID to the v2 redeliver subscription delivery params
+func (o *V2RedeliverSubscriptionDeliveryParams) WithSubscriptionID(subscriptionID strfmt.UUID) *V2RedeliverSubscriptionDeliveryParams {
+	o.SetSubscriptionID(subscriptionID)
+	return o
+}
+
+// SetSubscriptionID adds the subscriptionId to the v2 redeliver subscription delivery params
+func (o *V2RedeliverSubscriptionDeliveryParams) SetSubscriptionID(subscriptionID strfmt.UUID) {
+	o.SubscriptionID = subscriptionID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2RedeliverSubscriptionDeliveryParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param delivery_id
+	if err := r.SetPathParam("delivery_id", o.DeliveryID.String()); err != nil {
+		return err
+	}
+
+	// path param subscription_id
+	if err := r.SetPathParam("subscription_id", o.SubscriptionID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
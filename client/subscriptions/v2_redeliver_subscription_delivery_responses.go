@@ -0,0 +1,512 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2RedeliverSubscriptionDeliveryReader is a Reader for the V2RedeliverSubscriptionDelivery structure.
+type V2RedeliverSubscriptionDeliveryReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2RedeliverSubscriptionDeliveryReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewV2RedeliverSubscriptionDeliveryAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2RedeliverSubscriptionDeliveryUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2RedeliverSubscriptionDeliveryForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2RedeliverSubscriptionDeliveryNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewV2RedeliverSubscriptionDeliveryConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2RedeliverSubscriptionDeliveryInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 501:
+		result := NewV2RedeliverSubscriptionDeliveryNotImplemented()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2RedeliverSubscriptionDeliveryAccepted creates a V2RedeliverSubscriptionDeliveryAccepted with default headers values
+func NewV2RedeliverSubscriptionDeliveryAccepted() *V2RedeliverSubscriptionDeliveryAccepted {
+	return &V2RedeliverSubscriptionDeliveryAccepted{}
+}
+
+/*
+V2RedeliverSubscriptionDeliveryAccepted describes a response with status code 202, with default header values.
+
+Success.
+*/
+type V2RedeliverSubscriptionDeliveryAccepted struct {
+	Payload *models.SubscriptionDelivery
+}
+
+// IsSuccess returns true when this v2 redeliver subscription delivery accepted response has a 2xx status code
+func (o *V2RedeliverSubscriptionDeliveryAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 redeliver subscription delivery accepted response has a 3xx status code
+func (o *V2RedeliverSubscriptionDeliveryAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 redeliver subscription delivery accepted response has a 4xx status code
+func (o *V2RedeliverSubscriptionDeliveryAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 redeliver subscription delivery accepted response has a 5xx status code
+func (o *V2RedeliverSubscriptionDeliveryAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 redeliver subscription delivery accepted response a status code equal to that given
+func (o *V2RedeliverSubscriptionDeliveryAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+func (o *V2RedeliverSubscriptionDeliveryAccepted) Error() string {
+	return fmt.Sprintf("[POST /v2/subscriptions/{subscription_id}/deliveries/{delivery_id}/actions/redeliver][%d] v2RedeliverSubscriptionDeliveryAccepted  %+v", 202, o.Payload)
+}
+
+func (o *V2RedeliverSubscriptionDeliveryAccepted) String() string {
+	return fmt.Sprintf("[POST /v2/subscriptions/{subscription_id}/deliveries/{delivery_id}/actions/redeliver][%d] v2RedeliverSubscriptionDeliveryAccepted  %+v", 202, o.Payload)
+}
+
+func (o *V2RedeliverSubscriptionDeliveryAccepted) GetPayload() *models.SubscriptionDelivery {
+	return o.Payload
+}
+
+func (o *V2RedeliverSubscriptionDeliveryAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.SubscriptionDelivery)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RedeliverSubscriptionDeliveryUnauthorized creates a V2RedeliverSubscriptionDeliveryUnauthorized with default headers values
+func NewV2RedeliverSubscriptionDeliveryUnauthorized() *V2RedeliverSubscriptionDeliveryUnauthorized {
+	return &V2RedeliverSubscriptionDeliveryUnauthorized{}
+}
+
+/*
+V2RedeliverSubscriptionDeliveryUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2RedeliverSubscriptionDeliveryUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 redeliver subscription delivery unauthorized response has a 2xx status code
+func (o *V2RedeliverSubscriptionDeliveryUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 redeliver subscription delivery unauthorized response has a 3xx status code
+func (o *V2RedeliverSubscriptionDeliveryUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 redeliver subscription delivery unauthorized response has a 4xx status code
+func (o *V2RedeliverSubscriptionDeliveryUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 redeliver subscription delivery unauthorized response has a 5xx status code
+func (o *V2RedeliverSubscriptionDeliveryUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 redeliver subscription delivery unauthorized response a status code equal to that given
+func (o *V2RedeliverSubscriptionDeliveryUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2RedeliverSubscriptionDeliveryUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/subscriptions/{subscription_id}/deliveries/{delivery_id}/actions/redeliver][%d] v2RedeliverSubscriptionDeliveryUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2RedeliverSubscriptionDeliveryUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/subscriptions/{subscription_id}/deliveries/{delivery_id}/actions/redeliver][%d] v2RedeliverSubscriptionDeliveryUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2RedeliverSubscriptionDeliveryUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2RedeliverSubscriptionDeliveryUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RedeliverSubscriptionDeliveryForbidden creates a V2RedeliverSubscriptionDeliveryForbidden with default headers values
+func NewV2RedeliverSubscriptionDeliveryForbidden() *V2RedeliverSubscriptionDeliveryForbidden {
+	return &V2RedeliverSubscriptionDeliveryForbidden{}
+}
+
+/*
+V2RedeliverSubscriptionDeliveryForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2RedeliverSubscriptionDeliveryForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 redeliver subscription delivery forbidden response has a 2xx status code
+func (o *V2RedeliverSubscriptionDeliveryForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 redeliver subscription delivery forbidden response has a 3xx status code
+func (o *V2RedeliverSubscriptionDeliveryForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 redeliver subscription delivery forbidden response has a 4xx status code
+func (o *V2RedeliverSubscriptionDeliveryForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 redeliver subscription delivery forbidden response has a 5xx status code
+func (o *V2RedeliverSubscriptionDeliveryForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 redeliver subscription delivery forbidden response a status code equal to that given
+func (o *V2RedeliverSubscriptionDeliveryForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2RedeliverSubscriptionDeliveryForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/subscriptions/{subscription_id}/deliveries/{delivery_id}/actions/redeliver][%d] v2RedeliverSubscriptionDeliveryForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2RedeliverSubscriptionDeliveryForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/subscriptions/{subscription_id}/deliveries/{delivery_id}/actions/redeliver][%d] v2RedeliverSubscriptionDeliveryForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2RedeliverSubscriptionDeliveryForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2RedeliverSubscriptionDeliveryForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RedeliverSubscriptionDeliveryNotFound creates a V2RedeliverSubscriptionDeliveryNotFound with default headers values
+func NewV2RedeliverSubscriptionDeliveryNotFound() *V2RedeliverSubscriptionDeliveryNotFound {
+	return &V2RedeliverSubscriptionDeliveryNotFound{}
+}
+
+/*
+V2RedeliverSubscriptionDeliveryNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2RedeliverSubscriptionDeliveryNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 redeliver subscription delivery not found response has a 2xx status code
+func (o *V2RedeliverSubscriptionDeliveryNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 redeliver subscription delivery not found response has a 3xx status code
+func (o *V2RedeliverSubscriptionDeliveryNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 redeliver subscription delivery not found response has a 4xx status code
+func (o *V2RedeliverSubscriptionDeliveryNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 redeliver subscription delivery not found response has a 5xx status code
+func (o *V2RedeliverSubscriptionDeliveryNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 redeliver subscription delivery not found response a status code equal to that given
+func (o *V2RedeliverSubscriptionDeliveryNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2RedeliverSubscriptionDeliveryNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/subscriptions/{subscription_id}/deliveries/{delivery_id}/actions/redeliver][%d] v2RedeliverSubscriptionDeliveryNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2RedeliverSubscriptionDeliveryNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/subscriptions/{subscription_id}/deliveries/{delivery_id}/actions/redeliver][%d] v2RedeliverSubscriptionDeliveryNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2RedeliverSubscriptionDeliveryNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RedeliverSubscriptionDeliveryNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RedeliverSubscriptionDeliveryConflict creates a V2RedeliverSubscriptionDeliveryConflict with default headers values
+func NewV2RedeliverSubscriptionDeliveryConflict() *V2RedeliverSubscriptionDeliveryConflict {
+	return &V2RedeliverSubscriptionDeliveryConflict{}
+}
+
+/*
+V2RedeliverSubscriptionDeliveryConflict describes a response with status code 409, with default header values.
+
+Error.
+*/
+type V2RedeliverSubscriptionDeliveryConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 redeliver subscription delivery conflict response has a 2xx status code
+func (o *V2RedeliverSubscriptionDeliveryConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 redeliver subscription delivery conflict response has a 3xx status code
+func (o *V2RedeliverSubscriptionDeliveryConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 redeliver subscription delivery conflict response has a 4xx status code
+func (o *V2RedeliverSubscriptionDeliveryConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 redeliver subscription delivery conflict response has a 5xx status code
+func (o *V2RedeliverSubscriptionDeliveryConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 redeliver subscription delivery conflict response a status code equal to that given
+func (o *V2RedeliverSubscriptionDeliveryConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+func (o *V2RedeliverSubscriptionDeliveryConflict) Error() string {
+	return fmt.Sprintf("[POST /v2/subscriptions/{subscription_id}/deliveries/{delivery_id}/actions/redeliver][%d] v2RedeliverSubscriptionDeliveryConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2RedeliverSubscriptionDeliveryConflict) String() string {
+	return fmt.Sprintf("[POST /v2/subscriptions/{subscription_id}/deliveries/{delivery_id}/actions/redeliver][%d] v2RedeliverSubscriptionDeliveryConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2RedeliverSubscriptionDeliveryConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RedeliverSubscriptionDeliveryConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RedeliverSubscriptionDeliveryInternalServerError creates a V2RedeliverSubscriptionDeliveryInternalServerError with default headers values
+func NewV2RedeliverSubscriptionDeliveryInternalServerError() *V2RedeliverSubscriptionDeliveryInternalServerError {
+	return &V2RedeliverSubscriptionDeliveryInternalServerError{}
+}
+
+/*
+V2RedeliverSubscriptionDeliveryInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2RedeliverSubscriptionDeliveryInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 redeliver subscription delivery internal server error response has a 2xx status code
+func (o *V2RedeliverSubscriptionDeliveryInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 redeliver subscription delivery internal server error response has a 3xx status code
+func (o *V2RedeliverSubscriptionDeliveryInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 redeliver subscription delivery internal server error response has a 4xx status code
+func (o *V2RedeliverSubscriptionDeliveryInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 redeliver subscription delivery internal server error response has a 5xx status code
+func (o *V2RedeliverSubscriptionDeliveryInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 redeliver subscription delivery internal server error response a status code equal to that given
+func (o *V2RedeliverSubscriptionDeliveryInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2RedeliverSubscriptionDeliveryInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/subscriptions/{subscription_id}/deliveries/{delivery_id}/actions/redeliver][%d] v2RedeliverSubscriptionDeliveryInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2RedeliverSubscriptionDeliveryInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/subscriptions/{subscription_id}/deliveries/{delivery_id}/actions/redeliver][%d] v2RedeliverSubscriptionDeliveryInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2RedeliverSubscriptionDeliveryInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RedeliverSubscriptionDeliveryInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RedeliverSubscriptionDeliveryNotImplemented creates a V2RedeliverSubscriptionDeliveryNotImplemented with default headers values
+func NewV2RedeliverSubscriptionDeliveryNotImplemented() *V2RedeliverSubscriptionDeliveryNotImplemented {
+	return &V2RedeliverSubscriptionDeliveryNotImplemented{}
+}
+
+/*
+V2RedeliverSubscriptionDeliveryNotImplemented describes a response with status code 501, with default header values.
+
+Not implemented.
+*/
+type V2RedeliverSubscriptionDeliveryNotImplemented struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 redeliver subscription delivery not implemented response has a 2xx status code
+func (o *V2RedeliverSubscriptionDeliveryNotImplemented) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 redeliver subscription delivery not implemented response has a 3xx status code
+func (o *V2RedeliverSubscriptionDeliveryNotImplemented) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 redeliver subscription delivery not implemented response has a 4xx status code
+func (o *V2RedeliverSubscriptionDeliveryNotImplemented) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 redeliver subscription delivery not implemented response has a 5xx status code
+func (o *V2RedeliverSubscriptionDeliveryNotImplemented) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 redeliver subscription delivery not implemented response a status code equal to that given
+func (o *V2RedeliverSubscriptionDeliveryNotImplemented) IsCode(code int) bool {
+	return code == 501
+}
+
+func (o *V2RedeliverSubscriptionDeliveryNotImplemented) Error() string {
+	return fmt.Sprintf("[POST /v2/subscriptions/{subscription_id}/deliveries/{delivery_id}/actions/redeliver][%d] v2RedeliverSubscriptionDeliveryNotImplemented  %+v", 501, o.Payload)
+}
+
+func (o *V2RedeliverSubscriptionDeliveryNotImplemented) String() string {
+	return fmt.Sprintf("[POST /v2/subscriptions/{subscription_id}/deliveries/{delivery_id}/actions/redeliver][%d] v2RedeliverSubscriptionDeliveryNotImplemented  %+v", 501, o.Payload)
+}
+
+func (o *V2RedeliverSubscriptionDeliveryNotImplemented) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RedeliverSubscriptionDeliveryNotImplemented) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
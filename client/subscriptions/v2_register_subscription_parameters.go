@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2RegisterSubscriptionParams creates a new V2RegisterSubscriptionParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2RegisterSubscriptionParams() *V2RegisterSubscriptionParams {
+	return &V2RegisterSubscriptionParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2RegisterSubscriptionParamsWithTimeout creates a new V2RegisterSubscriptionParams object
+// with the ability to set a timeout on a request.
+func NewV2RegisterSubscriptionParamsWithTimeout(timeout time.Duration) *V2RegisterSubscriptionParams {
+	return &V2RegisterSubscriptionParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2RegisterSubscriptionParamsWithContext creates a new V2RegisterSubscriptionParams object
+// with the ability to set a context for a request.
+func NewV2RegisterSubscriptionParamsWithContext(ctx context.Context) *V2RegisterSubscriptionParams {
+	return &V2RegisterSubscriptionParams{
+		Context: ctx,
+	}
+}
+
+// NewV2RegisterSubscriptionParamsWithHTTPClient creates a new V2RegisterSubscriptionParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2RegisterSubscriptionParamsWithHTTPClient(client *http.Client) *V2RegisterSubscriptionParams {
+	return &V2RegisterSubscriptionParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2RegisterSubscriptionParams contains all the parameters to send to the API endpoint
+
+	for the v2 register subscription operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2RegisterSubscriptionParams struct {
+
+	/* NewSubscriptionParams.
+
+	   The properties describing the new subscription.
+	*/
+	NewSubscriptionParams *models.SubscriptionCreateParams
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 register subscription params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2RegisterSubscriptionParams) WithDefaults() *V2RegisterSubscriptionParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 register subscription params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2RegisterSubscriptionParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 register subscription params
+func (o *V2RegisterSubscriptionParams) WithTimeout(timeout time.Duration) *V2RegisterSubscriptionParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 register subscription params
+func (o *V2RegisterSubscriptionParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 register subscription params
+func (o *V2RegisterSubscriptionParams) WithContext(ctx context.Context) *V2RegisterSubscriptionParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 register subscription params
+func (o *V2RegisterSubscriptionParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 register subscription params
+func (o *V2RegisterSubscriptionParams) WithHTTPClient(client *http.Client) *V2RegisterSubscriptionParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 register subscription params
+func (o *V2RegisterSubscriptionParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithNewSubscriptionParams adds the newSubscriptionParams to the v2 register subscription params
+func (o *V2RegisterSubscriptionParams) WithNewSubscriptionParams(newSubscriptionParams *models.SubscriptionCreateParams) *V2RegisterSubscriptionParams {
+	o.SetNewSubscriptionParams(newSubscriptionParams)
+	return o
+}
+
+// SetNewSubscriptionParams adds the newSubscriptionParams to the v2 register subscription params
+func (o *V2RegisterSubscriptionParams) SetNewSubscriptionParams(newSubscriptionParams *models.SubscriptionCreateParams) {
+	o.NewSubscriptionParams = newSubscriptionParams
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2RegisterSubscriptionParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.NewSubscriptionParams != nil {
+		if err := r.SetBodyParam(o.NewSubscriptionParams); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
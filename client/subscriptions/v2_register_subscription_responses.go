@@ -0,0 +1,512 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2RegisterSubscriptionReader is a Reader for the V2RegisterSubscription structure.
+type V2RegisterSubscriptionReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2RegisterSubscriptionReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewV2RegisterSubscriptionCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2RegisterSubscriptionBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2RegisterSubscriptionUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2RegisterSubscriptionForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2RegisterSubscriptionNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2RegisterSubscriptionInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 501:
+		result := NewV2RegisterSubscriptionNotImplemented()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2RegisterSubscriptionCreated creates a V2RegisterSubscriptionCreated with default headers values
+func NewV2RegisterSubscriptionCreated() *V2RegisterSubscriptionCreated {
+	return &V2RegisterSubscriptionCreated{}
+}
+
+/*
+V2RegisterSubscriptionCreated describes a response with status code 201, with default header values.
+
+Success.
+*/
+type V2RegisterSubscriptionCreated struct {
+	Payload *models.Subscription
+}
+
+// IsSuccess returns true when this v2 register subscription created response has a 2xx status code
+func (o *V2RegisterSubscriptionCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 register subscription created response has a 3xx status code
+func (o *V2RegisterSubscriptionCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 register subscription created response has a 4xx status code
+func (o *V2RegisterSubscriptionCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 register subscription created response has a 5xx status code
+func (o *V2RegisterSubscriptionCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 register subscription created response a status code equal to that given
+func (o *V2RegisterSubscriptionCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+func (o *V2RegisterSubscriptionCreated) Error() string {
+	return fmt.Sprintf("[POST /v2/subscriptions][%d] v2RegisterSubscriptionCreated  %+v", 201, o.Payload)
+}
+
+func (o *V2RegisterSubscriptionCreated) String() string {
+	return fmt.Sprintf("[POST /v2/subscriptions][%d] v2RegisterSubscriptionCreated  %+v", 201, o.Payload)
+}
+
+func (o *V2RegisterSubscriptionCreated) GetPayload() *models.Subscription {
+	return o.Payload
+}
+
+func (o *V2RegisterSubscriptionCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Subscription)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RegisterSubscriptionBadRequest creates a V2RegisterSubscriptionBadRequest with default headers values
+func NewV2RegisterSubscriptionBadRequest() *V2RegisterSubscriptionBadRequest {
+	return &V2RegisterSubscriptionBadRequest{}
+}
+
+/*
+V2RegisterSubscriptionBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2RegisterSubscriptionBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 register subscription bad request response has a 2xx status code
+func (o *V2RegisterSubscriptionBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 register subscription bad request response has a 3xx status code
+func (o *V2RegisterSubscriptionBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 register subscription bad request response has a 4xx status code
+func (o *V2RegisterSubscriptionBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 register subscription bad request response has a 5xx status code
+func (o *V2RegisterSubscriptionBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 register subscription bad request response a status code equal to that given
+func (o *V2RegisterSubscriptionBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2RegisterSubscriptionBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/subscriptions][%d] v2RegisterSubscriptionBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2RegisterSubscriptionBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/subscriptions][%d] v2RegisterSubscriptionBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2RegisterSubscriptionBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RegisterSubscriptionBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RegisterSubscriptionUnauthorized creates a V2RegisterSubscriptionUnauthorized with default headers values
+func NewV2RegisterSubscriptionUnauthorized() *V2RegisterSubscriptionUnauthorized {
+	return &V2RegisterSubscriptionUnauthorized{}
+}
+
+/*
+V2RegisterSubscriptionUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2RegisterSubscriptionUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 register subscription unauthorized response has a 2xx status code
+func (o *V2RegisterSubscriptionUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 register subscription unauthorized response has a 3xx status code
+func (o *V2RegisterSubscriptionUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 register subscription unauthorized response has a 4xx status code
+func (o *V2RegisterSubscriptionUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 register subscription unauthorized response has a 5xx status code
+func (o *V2RegisterSubscriptionUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 register subscription unauthorized response a status code equal to that given
+func (o *V2RegisterSubscriptionUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2RegisterSubscriptionUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/subscriptions][%d] v2RegisterSubscriptionUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2RegisterSubscriptionUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/subscriptions][%d] v2RegisterSubscriptionUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2RegisterSubscriptionUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2RegisterSubscriptionUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RegisterSubscriptionForbidden creates a V2RegisterSubscriptionForbidden with default headers values
+func NewV2RegisterSubscriptionForbidden() *V2RegisterSubscriptionForbidden {
+	return &V2RegisterSubscriptionForbidden{}
+}
+
+/*
+V2RegisterSubscriptionForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2RegisterSubscriptionForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 register subscription forbidden response has a 2xx status code
+func (o *V2RegisterSubscriptionForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 register subscription forbidden response has a 3xx status code
+func (o *V2RegisterSubscriptionForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 register subscription forbidden response has a 4xx status code
+func (o *V2RegisterSubscriptionForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 register subscription forbidden response has a 5xx status code
+func (o *V2RegisterSubscriptionForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 register subscription forbidden response a status code equal to that given
+func (o *V2RegisterSubscriptionForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2RegisterSubscriptionForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/subscriptions][%d] v2RegisterSubscriptionForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2RegisterSubscriptionForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/subscriptions][%d] v2RegisterSubscriptionForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2RegisterSubscriptionForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2RegisterSubscriptionForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RegisterSubscriptionNotFound creates a V2RegisterSubscriptionNotFound with default headers values
+func NewV2RegisterSubscriptionNotFound() *V2RegisterSubscriptionNotFound {
+	return &V2RegisterSubscriptionNotFound{}
+}
+
+/*
+V2RegisterSubscriptionNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2RegisterSubscriptionNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 register subscription not found response has a 2xx status code
+func (o *V2RegisterSubscriptionNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 register subscription not found response has a 3xx status code
+func (o *V2RegisterSubscriptionNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 register subscription not found response has a 4xx status code
+func (o *V2RegisterSubscriptionNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 register subscription not found response has a 5xx status code
+func (o *V2RegisterSubscriptionNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 register subscription not found response a status code equal to that given
+func (o *V2RegisterSubscriptionNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2RegisterSubscriptionNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/subscriptions][%d] v2RegisterSubscriptionNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2RegisterSubscriptionNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/subscriptions][%d] v2RegisterSubscriptionNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2RegisterSubscriptionNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RegisterSubscriptionNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RegisterSubscriptionInternalServerError creates a V2RegisterSubscriptionInternalServerError with default headers values
+func NewV2RegisterSubscriptionInternalServerError() *V2RegisterSubscriptionInternalServerError {
+	return &V2RegisterSubscriptionInternalServerError{}
+}
+
+/*
+V2RegisterSubscriptionInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2RegisterSubscriptionInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 register subscription internal server error response has a 2xx status code
+func (o *V2RegisterSubscriptionInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 register subscription internal server error response has a 3xx status code
+func (o *V2RegisterSubscriptionInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 register subscription internal server error response has a 4xx status code
+func (o *V2RegisterSubscriptionInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 register subscription internal server error response has a 5xx status code
+func (o *V2RegisterSubscriptionInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 register subscription internal server error response a status code equal to that given
+func (o *V2RegisterSubscriptionInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2RegisterSubscriptionInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/subscriptions][%d] v2RegisterSubscriptionInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2RegisterSubscriptionInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/subscriptions][%d] v2RegisterSubscriptionInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2RegisterSubscriptionInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RegisterSubscriptionInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RegisterSubscriptionNotImplemented creates a V2RegisterSubscriptionNotImplemented with default headers values
+func NewV2RegisterSubscriptionNotImplemented() *V2RegisterSubscriptionNotImplemented {
+	return &V2RegisterSubscriptionNotImplemented{}
+}
+
+/*
+V2RegisterSubscriptionNotImplemented describes a response with status code 501, with default header values.
+
+Not implemented.
+*/
+type V2RegisterSubscriptionNotImplemented struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 register subscription not implemented response has a 2xx status code
+func (o *V2RegisterSubscriptionNotImplemented) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 register subscription not implemented response has a 3xx status code
+func (o *V2RegisterSubscriptionNotImplemented) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 register subscription not implemented response has a 4xx status code
+func (o *V2RegisterSubscriptionNotImplemented) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 register subscription not implemented response has a 5xx status code
+func (o *V2RegisterSubscriptionNotImplemented) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 register subscription not implemented response a status code equal to that given
+func (o *V2RegisterSubscriptionNotImplemented) IsCode(code int) bool {
+	return code == 501
+}
+
+func (o *V2RegisterSubscriptionNotImplemented) Error() string {
+	return fmt.Sprintf("[POST /v2/subscriptions][%d] v2RegisterSubscriptionNotImplemented  %+v", 501, o.Payload)
+}
+
+func (o *V2RegisterSubscriptionNotImplemented) String() string {
+	return fmt.Sprintf("[POST /v2/subscriptions][%d] v2RegisterSubscriptionNotImplemented  %+v", 501, o.Payload)
+}
+
+func (o *V2RegisterSubscriptionNotImplemented) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RegisterSubscriptionNotImplemented) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
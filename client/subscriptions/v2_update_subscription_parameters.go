@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2UpdateSubscriptionParams creates a new V2UpdateSubscriptionParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2UpdateSubscriptionParams() *V2UpdateSubscriptionParams {
+	return &V2UpdateSubscriptionParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2UpdateSubscriptionParamsWithTimeout creates a new V2UpdateSubscriptionParams object
+// with the ability to set a timeout on a request.
+func NewV2UpdateSubscriptionParamsWithTimeout(timeout time.Duration) *V2UpdateSubscriptionParams {
+	return &V2UpdateSubscriptionParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2UpdateSubscriptionParamsWithContext creates a new V2UpdateSubscriptionParams object
+// with the ability to set a context for a request.
+func NewV2UpdateSubscriptionParamsWithContext(ctx context.Context) *V2UpdateSubscriptionParams {
+	return &V2UpdateSubscriptionParams{
+		Context: ctx,
+	}
+}
+
+// NewV2UpdateSubscriptionParamsWithHTTPClient creates a new V2UpdateSubscriptionParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2UpdateSubscriptionParamsWithHTTPClient(client *http.Client) *V2UpdateSubscriptionParams {
+	return &V2UpdateSubscriptionParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2UpdateSubscriptionParams contains all the parameters to send to the API endpoint
+
+	for the v2 update subscription operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2UpdateSubscriptionParams struct {
+
+	/* SubscriptionUpdateParams.
+
+	   The properties to update.
+	*/
+	SubscriptionUpdateParams *models.SubscriptionUpdateParams
+
+	/* SubscriptionID.
+
+	   The subscription to be updated.
+
+	   Format: uuid
+	*/
+	SubscriptionID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 update subscription params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2UpdateSubscriptionParams) WithDefaults() *V2UpdateSubscriptionParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 update subscription params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2UpdateSubscriptionParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 update subscription params
+func (o *V2UpdateSubscriptionParams) WithTimeout(timeout time.Duration) *V2UpdateSubscriptionParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 update subscription params
+func (o *V2UpdateSubscriptionParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 update subscription params
+func (o *V2UpdateSubscriptionParams) WithContext(ctx context.Context) *V2UpdateSubscriptionParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 update subscription params
+func (o *V2UpdateSubscriptionParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 update subscription params
+func (o *V2UpdateSubscriptionParams) WithHTTPClient(client *http.Client) *V2UpdateSubscriptionParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 update subscription params
+func (o *V2UpdateSubscriptionParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithSubscriptionUpdateParams adds the subscriptionUpdateParams to the v2 update subscription params
+func (o *V2UpdateSubscriptionParams) WithSubscriptionUpdateParams(subscriptionUpdateParams *models.SubscriptionUpdateParams) *V2UpdateSubscriptionParams {
+	o.SetSubscriptionUpdateParams(subscriptionUpdateParams)
+	return o
+}
+
+// SetSubscriptionUpdateParams adds the subscriptionUpdateParams to the v2 update subscription params
+func (o *V2UpdateSubscriptionParams) SetSubscriptionUpdateParams(subscriptionUpdateParams *models.SubscriptionUpdateParams) {
+	o.SubscriptionUpdateParams = subscriptionUpdateParams
+}
+
+// WithSubscriptionID adds the subscriptionID to the v2 update subscription params
+func (o *V2UpdateSubscriptionParams) WithSubscriptionID(subscriptionID strfmt.UUID) *V2UpdateSubscriptionParams {
+	o.SetSubscriptionID(subscriptionID)
+	return o
+}
+
+// SetSubscriptionID adds the subscriptionId to the v2 update subscription params
+func (o *V2UpdateSubscriptionParams) SetSubscriptionID(subscriptionID strfmt.UUID) {
+	o.SubscriptionID = subscriptionID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2UpdateSubscriptionParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.SubscriptionUpdateParams != nil {
+		if err := r.SetBodyParam(o.SubscriptionUpdateParams); err != nil {
+			return err
+		}
+	}
+
+	// path param subscription_id
+	if err := r.SetPathParam("subscription_id", o.SubscriptionID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,512 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2UpdateSubscriptionReader is a Reader for the V2UpdateSubscription structure.
+type V2UpdateSubscriptionReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2UpdateSubscriptionReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewV2UpdateSubscriptionCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2UpdateSubscriptionBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2UpdateSubscriptionUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2UpdateSubscriptionForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2UpdateSubscriptionNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2UpdateSubscriptionInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 501:
+		result := NewV2UpdateSubscriptionNotImplemented()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2UpdateSubscriptionCreated creates a V2UpdateSubscriptionCreated with default headers values
+func NewV2UpdateSubscriptionCreated() *V2UpdateSubscriptionCreated {
+	return &V2UpdateSubscriptionCreated{}
+}
+
+/*
+V2UpdateSubscriptionCreated describes a response with status code 201, with default header values.
+
+Success.
+*/
+type V2UpdateSubscriptionCreated struct {
+	Payload *models.Subscription
+}
+
+// IsSuccess returns true when this v2 update subscription created response has a 2xx status code
+func (o *V2UpdateSubscriptionCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 update subscription created response has a 3xx status code
+func (o *V2UpdateSubscriptionCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update subscription created response has a 4xx status code
+func (o *V2UpdateSubscriptionCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 update subscription created response has a 5xx status code
+func (o *V2UpdateSubscriptionCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update subscription created response a status code equal to that given
+func (o *V2UpdateSubscriptionCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+func (o *V2UpdateSubscriptionCreated) Error() string {
+	return fmt.Sprintf("[PATCH /v2/subscriptions/{subscription_id}][%d] v2UpdateSubscriptionCreated  %+v", 201, o.Payload)
+}
+
+func (o *V2UpdateSubscriptionCreated) String() string {
+	return fmt.Sprintf("[PATCH /v2/subscriptions/{subscription_id}][%d] v2UpdateSubscriptionCreated  %+v", 201, o.Payload)
+}
+
+func (o *V2UpdateSubscriptionCreated) GetPayload() *models.Subscription {
+	return o.Payload
+}
+
+func (o *V2UpdateSubscriptionCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Subscription)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateSubscriptionBadRequest creates a V2UpdateSubscriptionBadRequest with default headers values
+func NewV2UpdateSubscriptionBadRequest() *V2UpdateSubscriptionBadRequest {
+	return &V2UpdateSubscriptionBadRequest{}
+}
+
+/*
+V2UpdateSubscriptionBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2UpdateSubscriptionBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 update subscription bad request response has a 2xx status code
+func (o *V2UpdateSubscriptionBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update subscription bad request response has a 3xx status code
+func (o *V2UpdateSubscriptionBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update subscription bad request response has a 4xx status code
+func (o *V2UpdateSubscriptionBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 update subscription bad request response has a 5xx status code
+func (o *V2UpdateSubscriptionBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update subscription bad request response a status code equal to that given
+func (o *V2UpdateSubscriptionBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2UpdateSubscriptionBadRequest) Error() string {
+	return fmt.Sprintf("[PATCH /v2/subscriptions/{subscription_id}][%d] v2UpdateSubscriptionBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2UpdateSubscriptionBadRequest) String() string {
+	return fmt.Sprintf("[PATCH /v2/subscriptions/{subscription_id}][%d] v2UpdateSubscriptionBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2UpdateSubscriptionBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateSubscriptionBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateSubscriptionUnauthorized creates a V2UpdateSubscriptionUnauthorized with default headers values
+func NewV2UpdateSubscriptionUnauthorized() *V2UpdateSubscriptionUnauthorized {
+	return &V2UpdateSubscriptionUnauthorized{}
+}
+
+/*
+V2UpdateSubscriptionUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2UpdateSubscriptionUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 update subscription unauthorized response has a 2xx status code
+func (o *V2UpdateSubscriptionUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update subscription unauthorized response has a 3xx status code
+func (o *V2UpdateSubscriptionUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update subscription unauthorized response has a 4xx status code
+func (o *V2UpdateSubscriptionUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 update subscription unauthorized response has a 5xx status code
+func (o *V2UpdateSubscriptionUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update subscription unauthorized response a status code equal to that given
+func (o *V2UpdateSubscriptionUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2UpdateSubscriptionUnauthorized) Error() string {
+	return fmt.Sprintf("[PATCH /v2/subscriptions/{subscription_id}][%d] v2UpdateSubscriptionUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2UpdateSubscriptionUnauthorized) String() string {
+	return fmt.Sprintf("[PATCH /v2/subscriptions/{subscription_id}][%d] v2UpdateSubscriptionUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2UpdateSubscriptionUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2UpdateSubscriptionUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateSubscriptionForbidden creates a V2UpdateSubscriptionForbidden with default headers values
+func NewV2UpdateSubscriptionForbidden() *V2UpdateSubscriptionForbidden {
+	return &V2UpdateSubscriptionForbidden{}
+}
+
+/*
+V2UpdateSubscriptionForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2UpdateSubscriptionForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 update subscription forbidden response has a 2xx status code
+func (o *V2UpdateSubscriptionForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update subscription forbidden response has a 3xx status code
+func (o *V2UpdateSubscriptionForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update subscription forbidden response has a 4xx status code
+func (o *V2UpdateSubscriptionForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 update subscription forbidden response has a 5xx status code
+func (o *V2UpdateSubscriptionForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update subscription forbidden response a status code equal to that given
+func (o *V2UpdateSubscriptionForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2UpdateSubscriptionForbidden) Error() string {
+	return fmt.Sprintf("[PATCH /v2/subscriptions/{subscription_id}][%d] v2UpdateSubscriptionForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2UpdateSubscriptionForbidden) String() string {
+	return fmt.Sprintf("[PATCH /v2/subscriptions/{subscription_id}][%d] v2UpdateSubscriptionForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2UpdateSubscriptionForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2UpdateSubscriptionForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateSubscriptionNotFound creates a V2UpdateSubscriptionNotFound with default headers values
+func NewV2UpdateSubscriptionNotFound() *V2UpdateSubscriptionNotFound {
+	return &V2UpdateSubscriptionNotFound{}
+}
+
+/*
+V2UpdateSubscriptionNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2UpdateSubscriptionNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 update subscription not found response has a 2xx status code
+func (o *V2UpdateSubscriptionNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update subscription not found response has a 3xx status code
+func (o *V2UpdateSubscriptionNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update subscription not found response has a 4xx status code
+func (o *V2UpdateSubscriptionNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 update subscription not found response has a 5xx status code
+func (o *V2UpdateSubscriptionNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update subscription not found response a status code equal to that given
+func (o *V2UpdateSubscriptionNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2UpdateSubscriptionNotFound) Error() string {
+	return fmt.Sprintf("[PATCH /v2/subscriptions/{subscription_id}][%d] v2UpdateSubscriptionNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2UpdateSubscriptionNotFound) String() string {
+	return fmt.Sprintf("[PATCH /v2/subscriptions/{subscription_id}][%d] v2UpdateSubscriptionNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2UpdateSubscriptionNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateSubscriptionNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateSubscriptionInternalServerError creates a V2UpdateSubscriptionInternalServerError with default headers values
+func NewV2UpdateSubscriptionInternalServerError() *V2UpdateSubscriptionInternalServerError {
+	return &V2UpdateSubscriptionInternalServerError{}
+}
+
+/*
+V2UpdateSubscriptionInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2UpdateSubscriptionInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 update subscription internal server error response has a 2xx status code
+func (o *V2UpdateSubscriptionInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update subscription internal server error response has a 3xx status code
+func (o *V2UpdateSubscriptionInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update subscription internal server error response has a 4xx status code
+func (o *V2UpdateSubscriptionInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 update subscription internal server error response has a 5xx status code
+func (o *V2UpdateSubscriptionInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 update subscription internal server error response a status code equal to that given
+func (o *V2UpdateSubscriptionInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2UpdateSubscriptionInternalServerError) Error() string {
+	return fmt.Sprintf("[PATCH /v2/subscriptions/{subscription_id}][%d] v2UpdateSubscriptionInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2UpdateSubscriptionInternalServerError) String() string {
+	return fmt.Sprintf("[PATCH /v2/subscriptions/{subscription_id}][%d] v2UpdateSubscriptionInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2UpdateSubscriptionInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateSubscriptionInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateSubscriptionNotImplemented creates a V2UpdateSubscriptionNotImplemented with default headers values
+func NewV2UpdateSubscriptionNotImplemented() *V2UpdateSubscriptionNotImplemented {
+	return &V2UpdateSubscriptionNotImplemented{}
+}
+
+/*
+V2UpdateSubscriptionNotImplemented describes a response with status code 501, with default header values.
+
+Not implemented.
+*/
+type V2UpdateSubscriptionNotImplemented struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 update subscription not implemented response has a 2xx status code
+func (o *V2UpdateSubscriptionNotImplemented) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update subscription not implemented response has a 3xx status code
+func (o *V2UpdateSubscriptionNotImplemented) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update subscription not implemented response has a 4xx status code
+func (o *V2UpdateSubscriptionNotImplemented) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 update subscription not implemented response has a 5xx status code
+func (o *V2UpdateSubscriptionNotImplemented) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 update subscription not implemented response a status code equal to that given
+func (o *V2UpdateSubscriptionNotImplemented) IsCode(code int) bool {
+	return code == 501
+}
+
+func (o *V2UpdateSubscriptionNotImplemented) Error() string {
+	return fmt.Sprintf("[PATCH /v2/subscriptions/{subscription_id}][%d] v2UpdateSubscriptionNotImplemented  %+v", 501, o.Payload)
+}
+
+func (o *V2UpdateSubscriptionNotImplemented) String() string {
+	return fmt.Sprintf("[PATCH /v2/subscriptions/{subscription_id}][%d] v2UpdateSubscriptionNotImplemented  %+v", 501, o.Payload)
+}
+
+func (o *V2UpdateSubscriptionNotImplemented) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateSubscriptionNotImplemented) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -14,7 +14,6 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/NYTimes/gziphandler"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -24,11 +23,15 @@ import (
 	"github.com/openshift/assisted-service/internal/bminventory"
 	"github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
+	"github.com/openshift/assisted-service/internal/clusterarchive"
+	"github.com/openshift/assisted-service/internal/clusterplan"
+	"github.com/openshift/assisted-service/internal/clustertemplates"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/connectivity"
 	"github.com/openshift/assisted-service/internal/controller/controllers"
 	"github.com/openshift/assisted-service/internal/dns"
 	"github.com/openshift/assisted-service/internal/domains"
+	"github.com/openshift/assisted-service/internal/dryrun"
 	"github.com/openshift/assisted-service/internal/events"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/feature"
@@ -51,9 +54,11 @@ import (
 	"github.com/openshift/assisted-service/internal/operators/handler"
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	"github.com/openshift/assisted-service/internal/releasesources"
+	"github.com/openshift/assisted-service/internal/revisions"
 	"github.com/openshift/assisted-service/internal/spec"
 	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
 	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/openshift/assisted-service/internal/subscriptions"
 	"github.com/openshift/assisted-service/internal/system"
 	"github.com/openshift/assisted-service/internal/uploader"
 	"github.com/openshift/assisted-service/internal/usage"
@@ -162,6 +167,12 @@ var Options struct {
 	ConnMaxLifetime                      time.Duration `envconfig:"DB_CONNECTIONS_MAX_LIFETIME" default:"30m"`
 	FileSystemUsageThreshold             int           `envconfig:"FILESYSTEM_USAGE_THRESHOLD" default:"80"`
 	EnableNotificationStreaming          bool          `envconfig:"ENABLE_EVENT_STREAMING" default:"false"`
+	NotificationStreamWriters            []string      `envconfig:"EVENT_STREAM_WRITERS" default:"kafka"`
+	WatchConfig                          stream.WatchConfig
+	OutboxConfig                         stream.OutboxConfig
+	SubscriptionsConfig                  subscriptions.Config
+	RevisionsConfig                      revisions.Config
+	ClusterArchiveConfig                 clusterarchive.Config
 	WorkDir                              string        `envconfig:"WORK_DIR" default:"/data/"`
 	LivenessValidationTimeout            time.Duration `envconfig:"LIVENESS_VALIDATION_TIMEOUT" default:"5m"`
 	ApproveCsrsRequeueDuration           time.Duration `envconfig:"APPROVE_CSRS_REQUEUE_DURATION" default:"1m"`
@@ -171,7 +182,9 @@ var Options struct {
 	ForceInsecurePolicyJson              bool          `envconfig:"FORCE_INSECURE_POLICY_JSON" default:"false"`
 	PreprovisioningImageControllerConfig controllers.PreprovisioningImageControllerConfig
 	BMACConfig                           controllers.BMACConfig
+	SpokeHealthConfig                    controllers.SpokeHealthConfig
 	InstallerCacheConfig                 installercache.Config
+	ReleaseRegistryConfig                oc.RegistryConfig
 
 	// EnableSoftTimeouts is a boolean flag to enable Soft timeouts by assisted installer
 	EnableSoftTimeouts bool `envconfig:"ENABLE_SOFT_TIMEOUTS" default:"false"`
@@ -278,8 +291,10 @@ func startKubeAPIControllers(
 	clusterApi cluster.API,
 	hostApi host.API,
 	manifestsApi manifestsapi.ManifestsAPI,
+	clusterTemplatesApi clustertemplates.ClusterTemplateInternals,
 	generateInsecureIPXEURLs bool,
 	sys system.SystemInfo,
+	metricsManager metrics.API,
 ) {
 	if !Options.EnableKubeAPI {
 		return
@@ -358,6 +373,8 @@ func startKubeAPIControllers(
 		AgentContainerImage:        Options.BMConfig.AgentDockerImg,
 		HostFSMountDir:             hostFSMountDir,
 		ImageServiceEnabled:        Options.EnableImageService,
+		Drainer:                    &controllers.KubectlDrainer{},
+		Recorder:                   ctrlMgr.GetEventRecorderFor("agent-controller"),
 	}).SetupWithManager(ctrlMgr), "unable to create controller Agent")
 
 	if Options.EnableImageService {
@@ -385,6 +402,28 @@ func startKubeAPIControllers(
 		Log:    log,
 	}).SetupWithManager(ctrlMgr), "unable to create controller AgentClassification")
 
+	failOnError((&controllers.AgentPoolReconciler{
+		Client: ctrlMgr.GetClient(),
+		Log:    log,
+	}).SetupWithManager(ctrlMgr), "unable to create controller AgentPool")
+
+	if Options.SpokeHealthConfig.Enabled {
+		failOnError((&controllers.SpokeHealthReconciler{
+			Client:                ctrlMgr.GetClient(),
+			APIReader:             ctrlMgr.GetAPIReader(),
+			Log:                   log,
+			SpokeK8sClientFactory: spokeClientFactory,
+			Metrics:               metricsManager,
+			Config:                Options.SpokeHealthConfig,
+		}).SetupWithManager(ctrlMgr), "unable to create controller SpokeHealth")
+	}
+
+	failOnError((&controllers.ClusterTemplateReconciler{
+		Client:    ctrlMgr.GetClient(),
+		Log:       log,
+		Templates: clusterTemplatesApi,
+	}).SetupWithManager(ctrlMgr), "unable to create controller ClusterTemplate")
+
 	failOnError((&controllers.AgentLabelReconciler{
 		Client: ctrlMgr.GetClient(),
 		Log:    log,
@@ -414,9 +453,14 @@ func main() {
 	log := InitLogs(Options.LogConfig.LogLevel, Options.LogConfig.LogFormat)
 	log.Infof("Starting assisted-service version: %s", versions.GetRevision())
 
+	if err == nil {
+		err = Options.HostConfig.UserValidations.Compile(log)
+	}
 	if err != nil {
 		log.Fatal(err.Error())
 	}
+	Options.ClusterConfig.UserValidations = Options.HostConfig.UserValidations
+	Options.BMConfig.UserValidations = Options.HostConfig.UserValidations
 
 	failOnError := func(err error, msg string, args ...interface{}) {
 		if err != nil {
@@ -461,16 +505,18 @@ func main() {
 	ctrlMgr, err := createControllerManager()
 	failOnError(err, "failed to create controller manager")
 
-	notificationStream := getNotificationStream(log)
-	defer notificationStream.Close()
-
-	usageManager := usage.NewManager(log, notificationStream)
 	ocmClient := getOCMClient(log)
 
 	authHandler, err := auth.NewAuthenticator(&Options.Auth, ocmClient, log.WithField("pkg", "auth"), db)
 	failOnError(err, "failed to create authenticator")
 	authzHandler := auth.NewAuthzHandler(&Options.Auth, ocmClient, log.WithField("pkg", "authz"), db)
 
+	deliveryReporter := stream.NewDeliveryReporter()
+	notificationStream := getNotificationStream(log, db, authzHandler, deliveryReporter)
+	defer notificationStream.Close()
+
+	usageManager := usage.NewManager(log, notificationStream)
+
 	crdEventsHandler := createCRDEventsHandler()
 	eventsHandler := createEventsHandler(crdEventsHandler, db, authzHandler, notificationStream, log)
 
@@ -484,6 +530,7 @@ func main() {
 	}
 	diskStatsHelper := metrics.NewOSDiskStatsHelper(log)
 	metricsManager := metrics.NewMetricsManager(prometheusRegistry, eventsHandler, diskStatsHelper, metricsManagerConfig, log)
+	deliveryReporter.SetMetrics(metricsManager)
 	if ocmClient != nil {
 		//inject the metric server to the ocm client for purpose of
 		//performance monitoring the calls to ACM. This could not be done
@@ -513,6 +560,16 @@ func main() {
 		mirrorRegistriesBuilder,
 		sys,
 	)
+	switch Options.ReleaseRegistryConfig.Inspector {
+	case oc.InspectorOC:
+	case oc.InspectorRegistry:
+		if Options.ReleaseRegistryConfig.CacheDir == "" {
+			Options.ReleaseRegistryConfig.CacheDir = filepath.Join(Options.WorkDir, "release-manifests")
+		}
+		releaseHandler = oc.NewRegistryRelease(log, Options.ReleaseRegistryConfig, mirrorRegistriesBuilder, releaseHandler)
+	default:
+		log.Fatalf("Unsupported release image inspector %q, must be %q or %q", Options.ReleaseRegistryConfig.Inspector, oc.InspectorOC, oc.InspectorRegistry)
+	}
 
 	versionHandler, versionsAPIHandler, err := createVersionHandlers(
 		log,
@@ -546,6 +603,7 @@ func main() {
 	hwValidator := hardware.NewValidator(log.WithField("pkg", "validators"), Options.HWValidatorConfig, operatorsManager, providerRegistry)
 	connectivityValidator := connectivity.NewValidator(log.WithField("pkg", "validators"))
 	Options.InstructionConfig.HostFSMountDir = hostFSMountDir
+	Options.InstructionConfig.CustomSteps = Options.HostConfig.CustomSteps
 	instructionApi := hostcommands.NewInstructionManager(log.WithField("pkg", "instructions"), db, hwValidator,
 		releaseHandler, Options.InstructionConfig, connectivityValidator, eventsHandler, versionHandler, osImages, Options.EnableKubeAPI)
 
@@ -610,6 +668,13 @@ func main() {
 
 	failOnError(autoMigrationWithLeader(startupLeader, db, log), "Failed auto migration process")
 
+	if Options.OutboxConfig.EnableOutbox {
+		outboxRelay := notificationStream.NewOutboxRelay(lead, Options.OutboxConfig, log.WithField("pkg", "outbox"))
+		outboxRelayThread := thread.New(log.WithField("pkg", "outbox"), "Outbox Relay", Options.OutboxConfig.RelayInterval, outboxRelay.Relay)
+		outboxRelayThread.Start()
+		defer outboxRelayThread.Stop()
+	}
+
 	Options.UploaderConfig.AssistedServiceVersion = versions.GetRevision()
 	Options.UploaderConfig.Versions = Options.Versions
 	if Options.GeneratorConfig.InstallInvoker == "agent-installer" {
@@ -658,7 +723,7 @@ func main() {
 	Options.BMConfig.S3EndpointURL = newUrl
 
 	Options.InstallerCacheConfig.CacheDir = filepath.Join(Options.GeneratorConfig.GetWorkingDirectory(), "installercache")
-	installerCache, err := installercache.New(Options.InstallerCacheConfig, eventsHandler, metricsManager, diskStatsHelper, log)
+	installerCache, err := installercache.New(Options.InstallerCacheConfig, db, objectHandler, eventsHandler, metricsManager, diskStatsHelper, log)
 	failOnError(err, "failed to instantiate installercache")
 
 	generator := generator.New(log, objectHandler, Options.GeneratorConfig, providerRegistry, manifestsApi, eventsHandler, installerCache)
@@ -723,11 +788,44 @@ func main() {
 		Options.GeneratorConfig.GetWorkingDirectory(),
 	)
 
+	dryRunRenderer := dryrun.NewRenderer(log.WithField("pkg", "dryrun"), db, objectHandler, usageManager, Options.OperatorsConfig,
+		Options.ManifestsGeneratorConfig, Options.GeneratorConfig, providerRegistry, eventsHandler, installerCache)
+	revisionRecorder := revisions.NewRecorder(db, manifestsApi, Options.RevisionsConfig, log.WithField("pkg", "revisions"))
+	revisionRecorderThread := thread.New(log.WithField("pkg", "revisions"), "Revision Recorder", Options.RevisionsConfig.RecordInterval, revisionRecorder.RecordPendingRevisions)
+	revisionRecorderThread.Start()
+	defer revisionRecorderThread.Stop()
+	clusterTemplatesApi := clustertemplates.NewApi(db, authzHandler, manifestsApi, log.WithField("pkg", "clusterTemplatesApi"))
 	bm := bminventory.NewBareMetalInventory(db, notificationStream, log.WithField("pkg", "Inventory"), hostApi, clusterApi, infraEnvApi, Options.BMConfig,
 		generator, eventsHandler, objectHandler, metricsManager, usageManager, operatorsManager, authHandler, authzHandler, ocpClient, ocmClient,
 		lead, pullSecretValidator, versionHandler, osImages, crdUtils, ignitionBuilder, hwValidator, dnsApi, installConfigBuilder, staticNetworkConfig,
-		Options.GCConfig, providerRegistry, generateInsecureIPXEURLs, Options.GeneratorConfig.InstallInvoker, disconnectedIgnitionGenerator)
-	events := events.NewApi(eventsHandler, logrus.WithField("pkg", "eventsApi"))
+		Options.GCConfig, providerRegistry, generateInsecureIPXEURLs, Options.GeneratorConfig.InstallInvoker, disconnectedIgnitionGenerator,
+		dryRunRenderer, revisionRecorder, clusterTemplatesApi)
+	var watcher stream.Watcher
+	if Options.WatchConfig.EnableWatchAPI {
+		watchFeed := stream.NewWatchFeed(db, log.WithField("pkg", "watch"), Options.WatchConfig)
+		watchFeedPoller := thread.New(log.WithField("pkg", "watch"), "Watch Feed", Options.WatchConfig.PollInterval, watchFeed.Poll)
+		watchFeedPoller.Start()
+		defer watchFeedPoller.Stop()
+		watcher = watchFeed
+	}
+	events := events.NewApi(eventsHandler, watcher, authzHandler, Options.WatchConfig.HeartbeatInterval, logrus.WithField("pkg", "eventsApi"))
+	if Options.SubscriptionsConfig.EnableSubscriptions {
+		dispatcher, err := subscriptions.NewDispatcher(db, Options.SubscriptionsConfig, deliveryReporter, log.WithField("pkg", "subscriptions"))
+		failOnError(err, "failed to create the subscriptions dispatcher")
+		subscriptionsDispatcher := thread.New(log.WithField("pkg", "subscriptions"), "Subscriptions Dispatcher", Options.SubscriptionsConfig.DeliveryInterval, dispatcher.Deliver)
+		subscriptionsDispatcher.Start()
+		defer subscriptionsDispatcher.Stop()
+	}
+	subscriptionsApi, err := subscriptions.NewApi(db, authzHandler, Options.SubscriptionsConfig, log.WithField("pkg", "subscriptionsApi"))
+	failOnError(err, "failed to create the subscriptions API")
+	clusterPlanApi := clusterplan.NewApi(db, bm, manifestsApi, revisionRecorder, log.WithField("pkg", "clusterPlanApi"))
+	revisionsApi := revisions.NewApi(db, bm, clusterPlanApi, revisionRecorder, log.WithField("pkg", "revisionsApi"))
+	clusterArchiveApi, err := clusterarchive.NewApi(db, bm, manifestsApi, revisionRecorder, Options.ClusterArchiveConfig, log.WithField("pkg", "clusterArchiveApi"))
+	failOnError(err, "failed to create the cluster archive API")
+	installerCacheApi := installercache.NewApi(db, installerCache, releaseHandler, log.WithField("pkg", "installerCacheApi"))
+	if err = installerCacheApi.PrewarmConfiguredReleases(context.Background()); err != nil {
+		log.WithError(err).Error("failed to pre-warm the configured releases of the installer cache")
+	}
 
 	//Set inner handler chain. Inner handlers requires access to the Route
 	innerHandler := func() func(http.Handler) http.Handler {
@@ -756,6 +854,12 @@ func main() {
 		Authorizer:          authzHandler.CreateAuthorizer(),
 		InstallerAPI:        bm,
 		EventsAPI:           events,
+		SubscriptionsAPI:    subscriptionsApi,
+		ClusterArchivesAPI:  clusterArchiveApi,
+		ClusterPlanAPI:      clusterPlanApi,
+		ClusterRevisionsAPI: revisionsApi,
+		ClusterTemplatesAPI: clusterTemplatesApi,
+		InstallerCacheAPI:   installerCacheApi,
 		Logger:              log.Printf,
 		VersionsAPI:         versionsAPIHandler,
 		ManagedDomainsAPI:   domainHandler,
@@ -774,7 +878,7 @@ func main() {
 		h = app.SetupCORSMiddleware(h, allowedDomains)
 	}
 
-	h = gziphandler.GzipHandler(h)
+	h = app.WithGzipMiddleware(h)
 	h = app.WithMetricsResponderMiddleware(h)
 	h = app.WithHealthMiddleware(h, []*thread.Thread{hostStateMonitor, clusterStateMonitor},
 		log.WithField("pkg", "healthcheck"), Options.LivenessValidationTimeout)
@@ -784,7 +888,7 @@ func main() {
 		go startPPROF(log)
 	}
 
-	go startKubeAPIControllers(ctrlMgr, log, bm, crdEventsHandler, osImages, versionHandler, releaseHandler, clusterApi, hostApi, manifestsApi, generateInsecureIPXEURLs, sys)
+	go startKubeAPIControllers(ctrlMgr, log, bm, crdEventsHandler, osImages, versionHandler, releaseHandler, clusterApi, hostApi, manifestsApi, clusterTemplatesApi, generateInsecureIPXEURLs, sys, metricsManager)
 
 	// Interrupt servers on SIGINT/SIGTERM
 	stop := make(chan os.Signal, 1)
@@ -1024,13 +1128,25 @@ func createVersionHandlers(
 	return versionsHandler, versionsAPIHandler, nil
 }
 
-func getNotificationStream(log *logrus.Logger) *stream.NotificationStream {
+func getNotificationStream(log *logrus.Logger, db *gorm.DB, authzHandler auth.Authorizer, deliveryReporter *stream.DeliveryReporter) *stream.NotificationStream {
 	metadata := map[string]interface{}{
 		"versions": versions.GetListVersionsFromVersions(Options.Versions),
 	}
-	writer, err := stream.NewWriter(log, Options.EnableNotificationStreaming)
+	writer, err := stream.NewWriter(log, Options.EnableNotificationStreaming, Options.NotificationStreamWriters, deliveryReporter)
 	if err != nil {
-		log.WithError(err).Fatal("kafka writer failed to initialize")
+		log.WithError(err).Fatal("event stream writer failed to initialize")
+	}
+	if Options.WatchConfig.EnableWatchAPI {
+		log.Info("Initializing watch API notifications writer")
+		writer = stream.NewMultiWriter(writer, stream.NewWatchWriter(db))
+	}
+	if Options.SubscriptionsConfig.EnableSubscriptions {
+		log.Info("Initializing subscriptions notifications writer")
+		writer = stream.NewMultiWriter(writer, subscriptions.NewWriter(db, authzHandler, log.WithField("pkg", "subscriptions")))
+	}
+	if Options.OutboxConfig.EnableOutbox {
+		log.Info("Initializing notifications outbox")
+		return stream.NewOutboxNotificationStream(db, writer, log, metadata)
 	}
 	return stream.NewNotificationStream(writer, log, metadata)
 }
@@ -0,0 +1,355 @@
+package bminventory
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/usage"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/transaction"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+)
+
+// bulkHostOperation is an operation of a bulk request, with its position in the request and the host it applies to
+type bulkHostOperation struct {
+	index  int
+	hostID strfmt.UUID
+	*models.BulkHostOperation
+}
+
+func (b *bareMetalInventory) V2BulkUpdateHosts(ctx context.Context, params installer.V2BulkUpdateHostsParams) middleware.Responder {
+	results, err := b.bulkUpdateHosts(ctx, params.InfraEnvID, params.BulkHostOperations.Operations)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2BulkUpdateHostsOK().WithPayload(&models.BulkHostOperationsResult{Results: results})
+}
+
+// bulkUpdateHosts applies the operations to the hosts of the infra-env. Bind and unbind operations are applied one by
+// one, as each of them refreshes the cluster that the host leaves or joins. The operations that update the hosts in
+// place are applied afterwards in a single transaction, so that they can use the cluster that the host was bound to.
+func (b *bareMetalInventory) bulkUpdateHosts(ctx context.Context, infraEnvID strfmt.UUID, operations []*models.BulkHostOperation) ([]*models.BulkHostOperationResult, error) {
+	log := logutil.FromContext(ctx, b.log)
+	if _, err := common.GetInfraEnvFromDB(b.db, infraEnvID); err != nil {
+		log.WithError(err).Errorf("failed to get infra env %s", infraEnvID)
+		return nil, common.NewApiError(http.StatusNotFound, err)
+	}
+	hosts, err := common.GetInfraEnvHostsFromDB(b.db, infraEnvID)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get the hosts of infra env %s", infraEnvID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	hostsByMacAddress, err := bulkHostsByMacAddress(hosts)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	hostIDs := make(map[strfmt.UUID]bool, len(hosts))
+	for _, h := range hosts {
+		hostIDs[*h.ID] = true
+	}
+
+	results := make([]*models.BulkHostOperationResult, len(operations))
+	var bindOperations, updateOperations []*bulkHostOperation
+	updatesValid := true
+	for i, op := range operations {
+		results[i] = &models.BulkHostOperationResult{Index: swag.Int64(int64(i))}
+		isBind := funk.ContainsString([]string{models.BulkHostOperationActionBind, models.BulkHostOperationActionUnbind}, swag.StringValue(op.Action))
+		hostID, err := resolveBulkHostOperation(op, hostIDs, hostsByMacAddress)
+		if err == nil {
+			results[i].HostID = hostID
+			err = validateBulkHostOperation(op)
+		}
+		if err != nil {
+			setBulkHostOperationFailed(results[i], err)
+			updatesValid = updatesValid && isBind
+			continue
+		}
+		operation := &bulkHostOperation{index: i, hostID: hostID, BulkHostOperation: op}
+		if isBind {
+			bindOperations = append(bindOperations, operation)
+		} else {
+			updateOperations = append(updateOperations, operation)
+		}
+	}
+
+	for _, operation := range bindOperations {
+		var err error
+		if *operation.Action == models.BulkHostOperationActionBind {
+			_, err = b.bindHost(ctx, installer.BindHostParams{
+				InfraEnvID:     infraEnvID,
+				HostID:         operation.hostID,
+				BindHostParams: &models.BindHostParams{ClusterID: operation.ClusterID},
+			})
+		} else {
+			_, err = b.unbindHost(ctx, installer.UnbindHostParams{InfraEnvID: infraEnvID, HostID: operation.hostID})
+		}
+		if err != nil {
+			setBulkHostOperationFailed(results[operation.index], err)
+		} else {
+			results[operation.index].Status = swag.String(models.BulkHostOperationResultStatusSucceeded)
+		}
+	}
+
+	if len(updateOperations) == 0 {
+		return results, nil
+	}
+	if !updatesValid {
+		for _, operation := range updateOperations {
+			setBulkHostOperationNotApplied(results[operation.index], "another operation of the transaction is invalid")
+		}
+		return results, nil
+	}
+	failed, err := b.applyBulkHostUpdates(ctx, infraEnvID, updateOperations)
+	for _, operation := range updateOperations {
+		switch {
+		case err == nil:
+			results[operation.index].Status = swag.String(models.BulkHostOperationResultStatusSucceeded)
+		case failed == nil || failed == operation:
+			setBulkHostOperationFailed(results[operation.index], err)
+		default:
+			setBulkHostOperationNotApplied(results[operation.index], fmt.Sprintf("operation %d of the transaction failed", failed.index))
+		}
+	}
+	return results, nil
+}
+
+// applyBulkHostUpdates applies the operations in a single transaction. If an operation fails, it is returned with
+// the error and none of the operations is applied.
+func (b *bareMetalInventory) applyBulkHostUpdates(ctx context.Context, infraEnvID strfmt.UUID, operations []*bulkHostOperation) (*bulkHostOperation, error) {
+	log := logutil.FromContext(ctx, b.log)
+	var hostIDs []string
+	for _, operation := range operations {
+		if !funk.ContainsString(hostIDs, operation.hostID.String()) {
+			hostIDs = append(hostIDs, operation.hostID.String())
+		}
+	}
+	sort.Strings(hostIDs)
+
+	var clusterIDs []string
+	clusters := make(map[strfmt.UUID]*common.Cluster)
+	usages := make(map[strfmt.UUID]usage.FeatureUsage)
+	hosts := make(map[strfmt.UUID]*common.Host)
+	var failed *bulkHostOperation
+	err := b.db.Transaction(func(tx *gorm.DB) error {
+		// Lock the clusters before the hosts, and both in a consistent order, to avoid deadlocks
+		for _, hostID := range hostIDs {
+			clusterID, err := b.getClusterIDFromHost(tx, strfmt.UUID(hostID), infraEnvID)
+			if err != nil {
+				return err
+			}
+			if clusterID != "" && !funk.ContainsString(clusterIDs, clusterID.String()) {
+				clusterIDs = append(clusterIDs, clusterID.String())
+			}
+		}
+		sort.Strings(clusterIDs)
+		for _, clusterID := range clusterIDs {
+			cluster, err := common.GetClusterFromDBForUpdate(tx, strfmt.UUID(clusterID), common.SkipEagerLoading)
+			if err != nil {
+				return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "can not find cluster %s", clusterID))
+			}
+			clusters[*cluster.ID] = cluster
+			usages[*cluster.ID] = make(usage.FeatureUsage)
+		}
+		for _, hostID := range hostIDs {
+			h, err := common.GetHostFromDB(transaction.AddForUpdateQueryOption(tx), infraEnvID.String(), hostID)
+			if err != nil {
+				log.WithError(err).Errorf("failed to find host <%s>, infra env <%s>", hostID, infraEnvID)
+				return common.NewApiError(http.StatusNotFound, err)
+			}
+			hosts[*h.ID] = h
+		}
+
+		for _, operation := range operations {
+			if err := b.applyBulkHostUpdate(ctx, operation, hosts, clusters, usages, tx); err != nil {
+				failed = operation
+				return err
+			}
+		}
+
+		for clusterID, clusterUsages := range usages {
+			if funk.NotEmpty(clusterUsages) {
+				if clusterusage, e := usage.Unmarshal(clusters[clusterID].FeatureUsage); e == nil {
+					for k, v := range clusterUsages {
+						clusterusage[k] = v
+					}
+					b.usageApi.Save(tx, clusterID, clusterusage)
+				}
+			}
+		}
+		for _, hostID := range hostIDs {
+			if err := b.refreshHostAfterUpdate(ctx, hosts[strfmt.UUID(hostID)], tx); err != nil {
+				return err
+			}
+		}
+		for _, clusterID := range clusterIDs {
+			if _, err := b.clusterApi.RefreshStatus(ctx, clusters[strfmt.UUID(clusterID)], tx); err != nil {
+				log.WithError(err).Errorf("Failed to refresh cluster %s during bulk host update", clusterID)
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return failed, err
+	}
+
+	for _, operation := range operations {
+		if *operation.Action == models.BulkHostOperationActionApprove {
+			h := hosts[operation.hostID]
+			eventgen.SendHostApprovedUpdatedEvent(ctx, b.eventsHandler, *h.ID, infraEnvID,
+				hostutil.GetHostnameForMsg(&h.Host), h.Approved)
+		}
+	}
+	for _, clusterID := range clusterIDs {
+		b.revisionRecorder.RecordRevision(ctx, strfmt.UUID(clusterID), models.ClusterRevisionReasonHostUpdate)
+	}
+	return nil, nil
+}
+
+func (b *bareMetalInventory) applyBulkHostUpdate(ctx context.Context, operation *bulkHostOperation, hosts map[strfmt.UUID]*common.Host,
+	clusters map[strfmt.UUID]*common.Cluster, usages map[strfmt.UUID]usage.FeatureUsage, tx *gorm.DB) error {
+	h := hosts[operation.hostID]
+	var cluster *common.Cluster
+	hostUsages := make(usage.FeatureUsage)
+	if h.ClusterID != nil {
+		var ok bool
+		if cluster, ok = clusters[*h.ClusterID]; !ok {
+			return common.NewApiError(http.StatusConflict, errors.Errorf("Host %s was bound to cluster %s during the update", h.ID, *h.ClusterID))
+		}
+		hostUsages = usages[*h.ClusterID]
+	}
+
+	switch *operation.Action {
+	case models.BulkHostOperationActionSetRole:
+		return b.updateHostRole(ctx, h, operation.HostRole, cluster, tx)
+	case models.BulkHostOperationActionSetHostname:
+		return b.updateHostName(ctx, h, operation.HostName, hostUsages, tx)
+	case models.BulkHostOperationActionSetInstallationDisk:
+		return b.updateHostDisksSelectionConfig(ctx, h,
+			[]*models.DiskConfigParams{{ID: operation.InstallationDiskID, Role: models.DiskRoleInstall}}, tx)
+	case models.BulkHostOperationActionResetValidation:
+		if err := b.hostApi.ResetHostValidation(ctx, *h.ID, h.InfraEnvID, *operation.ValidationID, tx); err != nil {
+			return err
+		}
+		// The validation is reset on a copy of the host, so the host is reloaded for the following operations
+		reloaded, err := common.GetHostFromDB(tx, h.InfraEnvID.String(), h.ID.String())
+		if err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		hosts[operation.hostID] = reloaded
+		return nil
+	case models.BulkHostOperationActionApprove:
+		approved := operation.Approved == nil || *operation.Approved
+		if err := tx.Model(&common.Host{}).Where("id = ? and infra_env_id = ?", h.ID, h.InfraEnvID).Update("approved", approved).Error; err != nil {
+			logutil.FromContext(ctx, b.log).WithError(err).Errorf("failed to update 'approved' in host: %s", h.ID)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		h.Approved = approved
+		return nil
+	default:
+		return common.NewApiError(http.StatusBadRequest, errors.Errorf("Unsupported action %s", *operation.Action))
+	}
+}
+
+// bulkHostsByMacAddress returns the hosts by the MAC addresses of their interfaces. A MAC address that is reported
+// by more than one host does not identify a host, and is mapped to an empty ID.
+func bulkHostsByMacAddress(hosts []*common.Host) (map[string]strfmt.UUID, error) {
+	ret := make(map[string]strfmt.UUID)
+	for _, h := range hosts {
+		if h.Inventory == "" {
+			continue
+		}
+		inventory, err := common.UnmarshalInventory(h.Inventory)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse the inventory of host %s", h.ID)
+		}
+		for _, iface := range inventory.Interfaces {
+			if iface.MacAddress == "" {
+				continue
+			}
+			macAddress := normalizeMacAddress(iface.MacAddress)
+			if hostID, ok := ret[macAddress]; ok && hostID != *h.ID {
+				ret[macAddress] = ""
+			} else {
+				ret[macAddress] = *h.ID
+			}
+		}
+	}
+	return ret, nil
+}
+
+func normalizeMacAddress(macAddress string) string {
+	return strings.ToLower(strings.ReplaceAll(macAddress, "-", ":"))
+}
+
+func resolveBulkHostOperation(op *models.BulkHostOperation, hostIDs map[strfmt.UUID]bool, hostsByMacAddress map[string]strfmt.UUID) (strfmt.UUID, error) {
+	if (op.HostID == nil) == (op.MacAddress == nil) {
+		return "", common.NewApiError(http.StatusBadRequest, errors.New("Exactly one of host_id and mac_address must be set"))
+	}
+	if op.HostID != nil {
+		if !hostIDs[*op.HostID] {
+			return "", common.NewApiError(http.StatusNotFound, errors.Errorf("Host %s was not found in the infra-env", *op.HostID))
+		}
+		return *op.HostID, nil
+	}
+	hostID, ok := hostsByMacAddress[normalizeMacAddress(*op.MacAddress)]
+	if !ok {
+		return "", common.NewApiError(http.StatusNotFound, errors.Errorf("No host of the infra-env has MAC address %s", *op.MacAddress))
+	}
+	if hostID == "" {
+		return "", common.NewApiError(http.StatusConflict, errors.Errorf("More than one host of the infra-env has MAC address %s", *op.MacAddress))
+	}
+	return hostID, nil
+}
+
+// validateBulkHostOperation checks that the operation sets the properties that its action requires
+func validateBulkHostOperation(op *models.BulkHostOperation) error {
+	required := map[string]struct {
+		name  string
+		isSet bool
+	}{
+		models.BulkHostOperationActionBind:                {"cluster_id", op.ClusterID != nil},
+		models.BulkHostOperationActionSetRole:             {"host_role", op.HostRole != nil},
+		models.BulkHostOperationActionSetHostname:         {"host_name", op.HostName != nil},
+		models.BulkHostOperationActionSetInstallationDisk: {"installation_disk_id", op.InstallationDiskID != nil},
+		models.BulkHostOperationActionResetValidation:     {"validation_id", op.ValidationID != nil},
+	}
+	if property, ok := required[swag.StringValue(op.Action)]; ok && !property.isSet {
+		return common.NewApiError(http.StatusBadRequest, errors.Errorf("%s must be set for action %s", property.name, *op.Action))
+	}
+	return nil
+}
+
+func setBulkHostOperationFailed(result *models.BulkHostOperationResult, err error) {
+	result.Status = swag.String(models.BulkHostOperationResultStatusFailed)
+	switch errValue := err.(type) {
+	case *common.ApiErrorResponse:
+		result.Code = int64(errValue.StatusCode())
+	case *common.InfraErrorResponse:
+		result.Code = int64(errValue.StatusCode())
+	default:
+		result.Code = http.StatusInternalServerError
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			result.Code = http.StatusNotFound
+		}
+	}
+	result.Reason = err.Error()
+}
+
+func setBulkHostOperationNotApplied(result *models.BulkHostOperationResult, reason string) {
+	result.Status = swag.String(models.BulkHostOperationResultStatusNotApplied)
+	result.Reason = reason
+}
@@ -0,0 +1,93 @@
+package bminventory
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/host/fingerprint"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+func (b *bareMetalInventory) V2ListHostHardwareRevisions(ctx context.Context, params installer.V2ListHostHardwareRevisionsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if _, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String()); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		return common.GenerateErrorResponder(err)
+	}
+
+	var records []*common.HostHardwareRevision
+	if err := b.db.Where("host_id = ? and infra_env_id = ?", params.HostID.String(), params.InfraEnvID.String()).
+		Order("revision ASC").Find(&records).Error; err != nil {
+		log.WithError(err).Errorf("failed to get the hardware revisions of host %s", params.HostID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	// The changes of each revision are computed from the previous one, the oldest stored revision has no previous
+	// revision when older revisions were deleted, so its changes are computed from an empty fingerprint
+	ret := make(models.HostHardwareRevisionList, len(records))
+	var previous *models.HardwareFingerprint
+	for i, record := range records {
+		revision := record.HostHardwareRevision
+		current, err := host.ParseHardwareFingerprint(record.FingerprintJSON)
+		if err != nil {
+			log.WithError(err).Errorf("failed to parse hardware revision %d of host %s", *revision.Revision, params.HostID)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		revision.Fingerprint = current
+		revision.Changes = fingerprint.Diff(previous, current)
+		previous = current
+		ret[len(records)-1-i] = &revision
+	}
+	return installer.NewV2ListHostHardwareRevisionsOK().WithPayload(ret)
+}
+
+func (b *bareMetalInventory) V2AcceptHostHardware(ctx context.Context, params installer.V2AcceptHostHardwareParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	h, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		return common.GenerateErrorResponder(err)
+	}
+	if err = b.hostApi.AcceptHardware(ctx, &h.Host, b.db); err != nil {
+		log.WithError(err).Errorf("failed to accept the hardware of host %s", params.HostID)
+		return common.GenerateErrorResponder(err)
+	}
+	if h, err = common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String()); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2AcceptHostHardwareOK().WithPayload(&h.Host)
+}
+
+// validateNoHardwareDrift fails when the installation of hosts whose hardware changed after their validations passed is
+// blocked, and one of the hosts has a hardware drift that was not accepted
+func (b *bareMetalInventory) validateNoHardwareDrift(hosts ...*models.Host) error {
+	if !b.BlockInstallOnHardwareDrift {
+		return nil
+	}
+	var drifted []string
+	for _, h := range hosts {
+		if h.HardwareDriftDetectedAt != nil {
+			drifted = append(drifted, hostutil.GetHostnameForMsg(h))
+		}
+	}
+	if len(drifted) == 0 {
+		return nil
+	}
+	return common.NewApiError(http.StatusConflict,
+		fmt.Errorf("the hardware of hosts %s changed after their validations passed, review the hardware revisions of the hosts and accept their hardware before installing",
+			strings.Join(drifted, ", ")))
+}
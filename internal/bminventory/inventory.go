@@ -28,6 +28,7 @@ import (
 	ignitioncommon "github.com/openshift/assisted-service/internal/common/ignition"
 	"github.com/openshift/assisted-service/internal/constants"
 	"github.com/openshift/assisted-service/internal/dns"
+	"github.com/openshift/assisted-service/internal/dryrun"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/featuresupport"
 	"github.com/openshift/assisted-service/internal/garbagecollector"
@@ -51,6 +52,7 @@ import (
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	"github.com/openshift/assisted-service/internal/stream"
 	"github.com/openshift/assisted-service/internal/usage"
+	"github.com/openshift/assisted-service/internal/uservalidations"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
@@ -132,6 +134,14 @@ type Config struct {
 
 	// Directory containing pre-generated TLS certs/keys for the ephemeral installer
 	ClusterTLSCertOverrideDir string `envconfig:"EPHEMERAL_INSTALLER_CLUSTER_TLS_CERTS_OVERRIDE_DIR" default:""`
+
+	// UserValidations are the validations that are defined by the administrator of the service, they can be
+	// ignored like the built-in validations. They are loaded with the configuration of the hosts
+	UserValidations uservalidations.Validations `ignored:"true"`
+
+	// Block the installation of hosts whose hardware changed after their validations passed, until their current
+	// hardware is accepted
+	BlockInstallOnHardwareDrift bool `envconfig:"BLOCK_INSTALL_ON_HARDWARE_DRIFT" default:"false"`
 }
 
 const minimalOpenShiftVersionForSingleNode = "4.8.0-0.0"
@@ -155,9 +165,12 @@ type InstallerInternals interface {
 	RegisterClusterInternal(ctx context.Context, kubeKey *types.NamespacedName, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration, params installer.V2RegisterClusterParams) (*common.Cluster, error)
 	GetClusterInternal(ctx context.Context, params installer.V2GetClusterParams) (*common.Cluster, error)
 	UpdateClusterNonInteractive(ctx context.Context, params installer.V2UpdateClusterParams, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration) (*common.Cluster, error)
+	UpdateClusterInteractive(ctx context.Context, params installer.V2UpdateClusterParams) (*common.Cluster, error)
 	GetClusterByKubeKey(key types.NamespacedName) (*common.Cluster, error)
 	GetHostByKubeKey(key types.NamespacedName) (*common.Host, error)
 	InstallClusterInternal(ctx context.Context, params installer.V2InstallClusterParams) (*common.Cluster, error)
+	DryRunInstallClusterInternal(ctx context.Context, params installer.V2DryRunInstallClusterParams) ([]byte, error)
+	SetIgnoredValidationsInternal(ctx context.Context, params installer.V2SetIgnoredValidationsParams) (*models.IgnoredValidations, error)
 	DeregisterClusterInternal(ctx context.Context, cluster *common.Cluster) error
 	V2DeregisterHostInternal(ctx context.Context, params installer.V2DeregisterHostParams, interactivity Interactivity) error
 	GetCommonHostInternal(ctx context.Context, infraEnvId string, hostId string) (*common.Host, error)
@@ -191,6 +204,31 @@ type InstallerInternals interface {
 	GetHostByIdInternal(ctx context.Context, hostId string) (*common.Host, error)
 }
 
+//go:generate mockgen --build_flags=--mod=mod -package bminventory -destination mock_revision_recorder.go . RevisionRecorder
+type RevisionRecorder interface {
+	// RecordRevision requests a revision of the configuration of the cluster, which is stored in the background
+	// unless the configuration did not change since the latest revision. Failures are logged, since the update that
+	// triggered the revision has already succeeded.
+	RecordRevision(ctx context.Context, clusterID strfmt.UUID, reason string)
+}
+
+// ClusterTemplateInstance holds the params of a cluster registered from a cluster template
+type ClusterTemplateInstance struct {
+	ClusterParams *models.ClusterCreateParams
+	// InfraEnvParams is set if an infra-env is created with the cluster. Its pull secret and cluster are not set.
+	InfraEnvParams *models.InfraEnvCreateParams
+	Manifests      []*models.CreateManifestParams
+}
+
+//go:generate mockgen --build_flags=--mod=mod -package bminventory -destination mock_cluster_templates.go . ClusterTemplates
+type ClusterTemplates interface {
+	// InstantiateClusterTemplate expands the template with the parameters, and applies the properties that are set in
+	// the overrides over the ones of the template
+	InstantiateClusterTemplate(ctx context.Context, templateID strfmt.UUID, parameters []string, overrides *models.ClusterCreateParams) (*ClusterTemplateInstance, error)
+	// CreateClusterTemplateManifests creates the manifests of an instance in the registered cluster
+	CreateClusterTemplateManifests(ctx context.Context, clusterID strfmt.UUID, manifests []*models.CreateManifestParams) error
+}
+
 //go:generate mockgen --build_flags=--mod=mod -package bminventory -destination mock_crd_utils.go . CRDUtils
 type CRDUtils interface {
 	CreateAgentCR(ctx context.Context, log logrus.FieldLogger, hostId string, infraenv *common.InfraEnv, cluster *common.Cluster) error
@@ -228,6 +266,9 @@ type bareMetalInventory struct {
 	insecureIPXEURLs              bool
 	installerInvoker              string
 	disconnectedIgnitionGenerator *ignition.DisconnectedIgnitionGenerator
+	dryRunRenderer                dryrun.Renderer
+	revisionRecorder              RevisionRecorder
+	clusterTemplates              ClusterTemplates
 }
 
 func NewBareMetalInventory(
@@ -263,6 +304,9 @@ func NewBareMetalInventory(
 	insecureIPXEURLs bool,
 	installerInvoker string,
 	oveIgnitionGenerator *ignition.DisconnectedIgnitionGenerator,
+	dryRunRenderer dryrun.Renderer,
+	revisionRecorder RevisionRecorder,
+	clusterTemplates ClusterTemplates,
 ) *bareMetalInventory {
 	return &bareMetalInventory{
 		db:                            db,
@@ -297,6 +341,9 @@ func NewBareMetalInventory(
 		insecureIPXEURLs:              insecureIPXEURLs,
 		installerInvoker:              installerInvoker,
 		disconnectedIgnitionGenerator: oveIgnitionGenerator,
+		dryRunRenderer:                dryRunRenderer,
+		revisionRecorder:              revisionRecorder,
+		clusterTemplates:              clusterTemplates,
 	}
 }
 
@@ -423,6 +470,10 @@ func (b *bareMetalInventory) setDefaultRegisterClusterParams(ctx context.Context
 		params.NewClusterParams.SchedulableMasters = swag.Bool(false)
 	}
 
+	if params.NewClusterParams.BandwidthCheckEnabled == nil {
+		params.NewClusterParams.BandwidthCheckEnabled = swag.Bool(false)
+	}
+
 	params.NewClusterParams.HighAvailabilityMode, params.NewClusterParams.ControlPlaneCount = common.GetDefaultHighAvailabilityAndMasterCountParams(
 		params.NewClusterParams.HighAvailabilityMode, params.NewClusterParams.ControlPlaneCount,
 	)
@@ -671,6 +722,11 @@ func (b *bareMetalInventory) RegisterClusterInternal(ctx context.Context, kubeKe
 		return nil, err
 	}
 
+	firmwarePolicy, err := hardware.FormatFirmwarePolicy(params.NewClusterParams.FirmwarePolicy)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
 	params, err = b.setDefaultRegisterClusterParams(ctx, params)
 	if err != nil {
 		return nil, err
@@ -750,6 +806,8 @@ func (b *bareMetalInventory) RegisterClusterInternal(ctx context.Context, kubeKe
 			OrgSoftTimeoutsEnabled:       orgSoftTimeoutsEnabled,
 			ControlPlaneCount:            swag.Int64Value(params.NewClusterParams.ControlPlaneCount),
 			LoadBalancer:                 params.NewClusterParams.LoadBalancer,
+			FirmwarePolicy:               firmwarePolicy,
+			BandwidthCheckEnabled:        params.NewClusterParams.BandwidthCheckEnabled,
 		},
 		KubeKeyName:                 kubeKey.Name,
 		KubeKeyNamespace:            kubeKey.Namespace,
@@ -1407,6 +1465,70 @@ func (b *bareMetalInventory) integrateWithAMSClusterPreInstallation(ctx context.
 	return nil
 }
 
+// DryRunInstallClusterInternal renders the files that the installation of the cluster would generate, and returns them
+// as a tar.gz bundle. Neither the cluster nor its stored files are modified.
+func (b *bareMetalInventory) DryRunInstallClusterInternal(ctx context.Context, params installer.V2DryRunInstallClusterParams) ([]byte, error) {
+	log := logutil.FromContext(ctx, b.log)
+	cluster, err := common.GetClusterFromDBWithHosts(b.db, params.ClusterID)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusNotFound, err)
+	}
+	if !funk.ContainsString([]string{models.ClusterStatusInsufficient, models.ClusterStatusReady, models.ClusterStatusPendingForInput},
+		swag.StringValue(cluster.Status)) {
+		return nil, common.NewApiError(http.StatusConflict,
+			errors.Errorf("cluster %s in status %s cannot be rendered, the installation has already started", params.ClusterID, swag.StringValue(cluster.Status)))
+	}
+
+	input := &dryrun.Input{Cluster: cluster, ForceInsecurePolicyJson: b.ForceInsecurePolicyJson}
+	input.InfraEnvs, err = b.getClusterInfraenvs(cluster)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	// Apply the roles and bootstrap that the installation would select, on the loaded cluster only
+	for _, h := range cluster.Hosts {
+		h.Role = common.GetEffectiveRole(h)
+	}
+	if err = selectDryRunBootstrapHost(cluster); err != nil {
+		input.Errors = append(input.Errors, dryrun.StepError{Step: dryrun.StepBootstrap, Error: err.Error()})
+	}
+
+	if input.InstallConfig, err = b.getClusterInstallConfig(ctx, cluster, input.InfraEnvs); err != nil {
+		input.Errors = append(input.Errors, dryrun.StepError{Step: dryrun.StepInstallConfig, Error: err.Error()})
+	}
+	if input.ReleaseImage, input.InstallerReleaseImageOverride, err = b.getClusterReleaseImages(ctx, cluster); err != nil {
+		input.Errors = append(input.Errors, dryrun.StepError{Step: dryrun.StepReleaseImage, Error: err.Error()})
+	}
+
+	log.Infof("rendering the installation of cluster %s", params.ClusterID)
+	data, err := b.dryRunRenderer.Render(ctx, input)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return data, nil
+}
+
+// selectDryRunBootstrapHost marks the host that the installation would select as bootstrap. It is selected by the
+// effective roles that were applied to the hosts of the loaded cluster, as the roles stored for the hosts whose role
+// is auto-assign are not the ones that the installation would use.
+func selectDryRunBootstrapHost(cluster *common.Cluster) error {
+	var bootstrap *models.Host
+	for _, h := range cluster.Hosts {
+		if h.Bootstrap {
+			return nil
+		}
+		if h.Role == models.HostRoleMaster &&
+			funk.ContainsString([]string{models.HostStatusKnown, models.HostStatusPreparingForInstallation}, swag.StringValue(h.Status)) {
+			bootstrap = h
+		}
+	}
+	if bootstrap == nil {
+		return errors.Errorf("Cluster have no master hosts that can operate as bootstrap")
+	}
+	bootstrap.Bootstrap = true
+	return nil
+}
+
 func (b *bareMetalInventory) InstallClusterInternal(ctx context.Context, params installer.V2InstallClusterParams) (*common.Cluster, error) {
 	log := logutil.FromContext(ctx, b.log)
 	var err error
@@ -1475,6 +1597,9 @@ func (b *bareMetalInventory) InstallClusterInternal(ctx context.Context, params
 		return nil, common.NewApiError(http.StatusConflict,
 			errors.Errorf("Cluster is not ready for installation, %s validation_info=%s", reason, cluster.ValidationsInfo))
 	}
+	if err = b.validateNoHardwareDrift(cluster.Hosts...); err != nil {
+		return nil, err
+	}
 
 	// prepare cluster and hosts for installation
 	err = b.db.Transaction(func(tx *gorm.DB) error {
@@ -1683,6 +1808,9 @@ func (b *bareMetalInventory) V2InstallHost(ctx context.Context, params installer
 	if swag.StringValue(h.Status) != models.HostStatusKnown {
 		return common.NewApiError(http.StatusConflict, fmt.Errorf("cannot install host in state %s after refresh", swag.StringValue(h.Status)))
 	}
+	if err = b.validateNoHardwareDrift(h); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
 	if cluster, err = common.GetClusterFromDB(b.db, *h.ClusterID, common.SkipEagerLoading); err != nil {
 		return common.GenerateErrorResponder(err)
 	}
@@ -1911,23 +2039,50 @@ func (b *bareMetalInventory) setInstallConfigOverridesUsage(featureUsages string
 }
 
 func (b *bareMetalInventory) generateClusterInstallConfig(ctx context.Context, cluster common.Cluster, clusterInfraenvs []*common.InfraEnv) error {
+	log := logutil.FromContext(ctx, b.log)
+	cfg, err := b.getClusterInstallConfig(ctx, &cluster, clusterInfraenvs)
+	if err != nil {
+		return err
+	}
+
+	releaseImage, installerReleaseImageOverride, err := b.getClusterReleaseImages(ctx, &cluster)
+	if err != nil {
+		return err
+	}
+
+	if err := b.generator.GenerateInstallConfig(ctx, cluster, cfg, releaseImage, installerReleaseImageOverride, b.ForceInsecurePolicyJson); err != nil {
+		msg := fmt.Sprintf("failed generating install config for cluster %s", cluster.ID)
+		log.WithError(err).Error(msg)
+		return errors.Wrap(err, msg)
+	}
+
+	return nil
+}
+
+func (b *bareMetalInventory) getClusterInstallConfig(ctx context.Context, cluster *common.Cluster, clusterInfraenvs []*common.InfraEnv) ([]byte, error) {
 	log := logutil.FromContext(ctx, b.log)
 	rhRootCa := ignition.RedhatRootCA
 	if !b.Config.InstallRHCa {
 		rhRootCa = ""
 	}
 
-	cfg, err := b.installConfigBuilder.GetInstallConfig(&cluster, clusterInfraenvs, rhRootCa)
+	cfg, err := b.installConfigBuilder.GetInstallConfig(cluster, clusterInfraenvs, rhRootCa)
 	if err != nil {
 		log.WithError(err).Errorf("failed to get install config for cluster %s", cluster.ID)
-		return errors.Wrapf(err, "failed to get install config for cluster %s", cluster.ID)
+		return nil, errors.Wrapf(err, "failed to get install config for cluster %s", cluster.ID)
 	}
+	return cfg, nil
+}
 
+// getClusterReleaseImages returns the release image the cluster is installed from, and the release image of the
+// installer binary if it has to be taken from another release image
+func (b *bareMetalInventory) getClusterReleaseImages(ctx context.Context, cluster *common.Cluster) (string, string, error) {
+	log := logutil.FromContext(ctx, b.log)
 	releaseImage, err := b.versionsHandler.GetReleaseImage(ctx, cluster.OpenshiftVersion, cluster.CPUArchitecture, cluster.PullSecret)
 	if err != nil {
 		msg := fmt.Sprintf("failed to get OpenshiftVersion for cluster %s with openshift version %s", cluster.ID, cluster.OpenshiftVersion)
 		log.WithError(err).Error(msg)
-		return errors.Wrap(err, msg)
+		return "", "", errors.Wrap(err, msg)
 	}
 
 	installerReleaseImageOverride := ""
@@ -1937,20 +2092,13 @@ func (b *bareMetalInventory) generateClusterInstallConfig(ctx context.Context, c
 			msg := fmt.Sprintf("failed to get image for installer image override "+
 				"for cluster %s with openshift version %s and %s arch", cluster.ID, cluster.OpenshiftVersion, cluster.CPUArchitecture)
 			log.WithError(err).Error(msg)
-			return errors.Wrap(err, msg)
+			return "", "", errors.Wrap(err, msg)
 		}
 		log.Infof("Overriding %s baremetal installer image image: %s with %s: %s", cluster.CPUArchitecture,
 			*releaseImage.URL, common.DefaultCPUArchitecture, *defaultArchImage.URL)
 		installerReleaseImageOverride = *defaultArchImage.URL
 	}
-
-	if err := b.generator.GenerateInstallConfig(ctx, cluster, cfg, *releaseImage.URL, installerReleaseImageOverride, b.ForceInsecurePolicyJson); err != nil {
-		msg := fmt.Sprintf("failed generating install config for cluster %s", cluster.ID)
-		log.WithError(err).Error(msg)
-		return errors.Wrap(err, msg)
-	}
-
-	return nil
+	return *releaseImage.URL, installerReleaseImageOverride, nil
 }
 
 func (b *bareMetalInventory) refreshClusterHosts(ctx context.Context, cluster *common.Cluster, tx *gorm.DB, log logrus.FieldLogger) error {
@@ -2170,6 +2318,7 @@ func (b *bareMetalInventory) V2UpdateCluster(ctx context.Context, params install
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
+	b.revisionRecorder.RecordRevision(ctx, params.ClusterID, models.ClusterRevisionReasonClusterUpdate)
 	return installer.NewV2UpdateClusterCreated().WithPayload(&c.Cluster)
 }
 
@@ -2177,6 +2326,10 @@ func (b *bareMetalInventory) UpdateClusterNonInteractive(ctx context.Context, pa
 	return b.v2UpdateClusterInternal(ctx, params, NonInteractive, mirrorRegistryConfiguration)
 }
 
+func (b *bareMetalInventory) UpdateClusterInteractive(ctx context.Context, params installer.V2UpdateClusterParams) (*common.Cluster, error) {
+	return b.v2UpdateClusterInternal(ctx, params, Interactive, nil)
+}
+
 func getPlatformType(platform *models.Platform) string {
 	if platform != nil && platform.Type != nil {
 		return string(*platform.Type)
@@ -2640,6 +2793,10 @@ func (b *bareMetalInventory) updateClusterData(_ context.Context, cluster *commo
 		return err
 	}
 
+	if err = b.updateClusterFirmwarePolicy(params, updates, log); err != nil {
+		return err
+	}
+
 	if params.ClusterUpdateParams.PullSecret != nil {
 		cluster.PullSecret = *params.ClusterUpdateParams.PullSecret
 		updates["pull_secret"] = *params.ClusterUpdateParams.PullSecret
@@ -2667,6 +2824,10 @@ func (b *bareMetalInventory) updateClusterData(_ context.Context, cluster *commo
 		b.setUsage(value, usage.SchedulableMasters, nil, usages)
 	}
 
+	if params.ClusterUpdateParams.BandwidthCheckEnabled != nil {
+		updates["bandwidth_check_enabled"] = swag.BoolValue(params.ClusterUpdateParams.BandwidthCheckEnabled)
+	}
+
 	if params.ClusterUpdateParams.DiskEncryption != nil {
 		// Disk encryption settings for hosts in an imported cluster will be taken from the host ignition.
 		// So we should prevent update of this here and explain the problem to the user.
@@ -3199,6 +3360,23 @@ func (b *bareMetalInventory) updateClusterTags(params installer.V2UpdateClusterP
 	return nil
 }
 
+func (b *bareMetalInventory) updateClusterFirmwarePolicy(params installer.V2UpdateClusterParams, updates map[string]interface{}, log logrus.FieldLogger) error {
+	if params.ClusterUpdateParams.FirmwarePolicy == nil {
+		return nil
+	}
+	firmwarePolicy, err := hardware.FormatFirmwarePolicy(params.ClusterUpdateParams.FirmwarePolicy)
+	if err != nil {
+		log.WithError(err).Error("Failed to validate firmware policy")
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if firmwarePolicy != nil {
+		updates["firmware_policy"] = *firmwarePolicy
+	} else {
+		updates["firmware_policy"] = gorm.Expr("NULL")
+	}
+	return nil
+}
+
 func (b *bareMetalInventory) updateClusterNetworkVMUsage(cluster *common.Cluster, updateParams *models.V2ClusterUpdateParams, usages map[string]models.Usage, log logrus.FieldLogger) {
 	platform := cluster.Platform
 	usageEnable := true
@@ -3701,6 +3879,9 @@ func (b *bareMetalInventory) handleReplyError(params installer.V2PostStepReplyPa
 	case models.StepTypeDownloadBootArtifacts:
 		log.Errorf("Failed to download boot artifacts to reclaim host %s, output: %s, error: %s", h.ID, params.Reply.Output, params.Reply.Error)
 		return b.hostApi.HandleReclaimFailure(ctx, h)
+
+	case models.StepTypeCustomStep:
+		return b.hostApi.UpdateCustomStepResult(ctx, h, params.Reply.StepID, exitCode, params.Reply.Output, params.Reply.Error)
 	}
 	return nil
 }
@@ -3926,6 +4107,8 @@ func handleReplyByType(params installer.V2PostStepReplyParams, b *bareMetalInven
 		err = b.hostApi.UpdateApiVipConnectivityReport(ctx, &host, stepReply)
 	case models.StepTypeTangConnectivityCheck:
 		err = b.hostApi.UpdateTangConnectivityReport(ctx, &host, stepReply)
+	case models.StepTypeBandwidthCheck:
+		err = b.hostApi.UpdateBandwidthReport(ctx, &host, stepReply)
 	case models.StepTypeFreeNetworkAddresses:
 		err = b.updateFreeAddressesReport(ctx, &host, stepReply)
 	case models.StepTypeDhcpLeaseAllocate:
@@ -3944,6 +4127,9 @@ func handleReplyByType(params installer.V2PostStepReplyParams, b *bareMetalInven
 		err = b.hostApi.HandleReclaimBootArtifactDownload(ctx, &host)
 	case models.StepTypeVerifyVips:
 		err = b.HandleVerifyVipsResponse(ctx, &host, stepReply)
+	case models.StepTypeCustomStep:
+		// The output of custom steps is validated against the reply schema of the step instead of being filtered
+		err = b.hostApi.UpdateCustomStepResult(ctx, &host, params.Reply.StepID, 0, params.Reply.Output, "")
 	}
 	return err
 }
@@ -3990,6 +4176,8 @@ func filterReplyByType(params installer.V2PostStepReplyParams) (string, error) {
 		stepReply, err = filterReply(&models.APIVipConnectivityResponse{}, params.Reply.Output)
 	case models.StepTypeTangConnectivityCheck:
 		stepReply, err = filterReply(&models.TangConnectivityResponse{}, params.Reply.Output)
+	case models.StepTypeBandwidthCheck:
+		stepReply, err = filterReply(&models.BandwidthCheckResponse{}, params.Reply.Output)
 	case models.StepTypeFreeNetworkAddresses:
 		stepReply, err = filterReply(&models.FreeNetworksAddresses{}, params.Reply.Output)
 	case models.StepTypeDhcpLeaseAllocate:
@@ -5036,6 +5224,11 @@ func (b *bareMetalInventory) RegisterInfraEnvInternal(ctx context.Context, kubeK
 			return common.NewApiError(http.StatusBadRequest, err)
 		}
 
+		var firmwarePolicy *string
+		if firmwarePolicy, err = hardware.FormatFirmwarePolicy(params.InfraenvCreateParams.FirmwarePolicy); err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+
 		var kernelArguments *string
 		if len(params.InfraenvCreateParams.KernelArguments) > 0 {
 			var b []byte
@@ -5065,6 +5258,7 @@ func (b *bareMetalInventory) RegisterInfraEnvInternal(ctx context.Context, kubeK
 				RendezvousIP:           params.InfraenvCreateParams.RendezvousIP,
 				CPUArchitecture:        params.InfraenvCreateParams.CPUArchitecture,
 				KernelArguments:        kernelArguments,
+				FirmwarePolicy:         firmwarePolicy,
 				AdditionalTrustBundle:  params.InfraenvCreateParams.AdditionalTrustBundle,
 			},
 			KubeKeyNamespace: kubeKey.Namespace,
@@ -5510,6 +5704,10 @@ func (b *bareMetalInventory) updateInfraEnvData(infraEnv *common.InfraEnv, param
 		return err
 	}
 
+	if err := b.updateInfraEnvFirmwarePolicy(params, updates, log); err != nil {
+		return err
+	}
+
 	inputSSHKey := swag.StringValue(params.InfraEnvUpdateParams.SSHAuthorizedKey)
 	if inputSSHKey != "" && inputSSHKey != infraEnv.SSHAuthorizedKey {
 		updates["ssh_authorized_key"] = inputSSHKey
@@ -5761,6 +5959,23 @@ func (b *bareMetalInventory) updateInfraEnvKernelArguments(params installer.Upda
 	return nil
 }
 
+func (b *bareMetalInventory) updateInfraEnvFirmwarePolicy(params installer.UpdateInfraEnvParams, updates map[string]interface{}, log logrus.FieldLogger) error {
+	if params.InfraEnvUpdateParams.FirmwarePolicy == nil {
+		return nil
+	}
+	firmwarePolicy, err := hardware.FormatFirmwarePolicy(params.InfraEnvUpdateParams.FirmwarePolicy)
+	if err != nil {
+		log.WithError(err).Error("Failed to validate firmware policy")
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if firmwarePolicy != nil {
+		updates["firmware_policy"] = *firmwarePolicy
+	} else {
+		updates["firmware_policy"] = gorm.Expr("NULL")
+	}
+	return nil
+}
+
 func (b *bareMetalInventory) GetInfraEnvByKubeKey(key types.NamespacedName) (*common.InfraEnv, error) {
 	infraEnv, err := common.GetInfraEnvFromDBWhere(b.db, "name = ? and kube_key_namespace = ?", key.Name, key.Namespace)
 	if err != nil {
@@ -6122,13 +6337,22 @@ func (b *bareMetalInventory) V2UpdateHostInstallProgressInternal(ctx context.Con
 }
 
 func (b *bareMetalInventory) BindHost(ctx context.Context, params installer.BindHostParams) middleware.Responder {
+	h, err := b.bindHost(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewBindHostOK().WithPayload(&h.Host)
+}
+
+// bindHost binds the host and reports the result in an event
+func (b *bareMetalInventory) bindHost(ctx context.Context, params installer.BindHostParams) (*common.Host, error) {
 	h, err := b.BindHostInternal(ctx, params)
 	if err != nil {
 		eventgen.SendHostBindFailedEvent(ctx, b.eventsHandler, params.HostID, params.InfraEnvID, params.BindHostParams.ClusterID, err.Error())
-		return common.GenerateErrorResponder(err)
+		return nil, err
 	}
 	eventgen.SendHostBindSucceededEvent(ctx, b.eventsHandler, params.HostID, params.InfraEnvID, params.BindHostParams.ClusterID, hostutil.GetHostnameForMsg(&h.Host))
-	return installer.NewBindHostOK().WithPayload(&h.Host)
+	return h, nil
 }
 
 func (b *bareMetalInventory) BindHostInternal(ctx context.Context, params installer.BindHostParams) (*common.Host, error) {
@@ -6249,23 +6473,32 @@ func (b *bareMetalInventory) UnbindHostInternal(ctx context.Context, params inst
 }
 
 func (b *bareMetalInventory) UnbindHost(ctx context.Context, params installer.UnbindHostParams) middleware.Responder {
+	h, err := b.unbindHost(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewUnbindHostOK().WithPayload(&h.Host)
+}
+
+// unbindHost unbinds a host that is not installing and reports the result in an event
+func (b *bareMetalInventory) unbindHost(ctx context.Context, params installer.UnbindHostParams) (*common.Host, error) {
 	// Installing hosts should not be allowed to unbind
 	dbHost, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String())
 	if err != nil {
 		b.log.WithError(err).Errorf("failed to find host <%s> in infraEnv <%s> during unbind",
 			params.HostID, params.InfraEnvID)
-		return common.NewApiError(http.StatusNotFound, err)
+		return nil, common.NewApiError(http.StatusNotFound, err)
 	}
 	if dbHost.Status != nil && funk.ContainsString(host.HostInstallingStatuses, *dbHost.Status) {
-		return common.NewApiError(http.StatusConflict, errors.Errorf("Cannot unbind Host %s while it is in the middle of installing.", params.HostID))
+		return nil, common.NewApiError(http.StatusConflict, errors.Errorf("Cannot unbind Host %s while it is in the middle of installing.", params.HostID))
 	}
 	h, err := b.UnbindHostInternal(ctx, params, false, Interactive)
 	if err != nil {
 		eventgen.SendHostUnbindFailedEvent(ctx, b.eventsHandler, params.HostID, params.InfraEnvID, err.Error())
-		return common.GenerateErrorResponder(err)
+		return nil, err
 	}
 	eventgen.SendHostUnbindSucceededEvent(ctx, b.eventsHandler, params.HostID, params.InfraEnvID, hostutil.GetHostnameForMsg(&h.Host))
-	return installer.NewUnbindHostOK().WithPayload(&h.Host)
+	return h, nil
 }
 
 func (b *bareMetalInventory) V2ListHosts(ctx context.Context, params installer.V2ListHostsParams) middleware.Responder {
@@ -6965,6 +7198,24 @@ func (b *bareMetalInventory) updateHostFencing(ctx context.Context, host *common
 }
 
 func (b *bareMetalInventory) refreshAfterUpdate(ctx context.Context, cluster *common.Cluster, host *common.Host, db *gorm.DB) error {
+	log := logutil.FromContext(ctx, b.log)
+	err := b.refreshHostAfterUpdate(ctx, host, db)
+	if err != nil {
+		return err
+	}
+
+	if host.ClusterID != nil {
+		_, err = b.clusterApi.RefreshStatus(ctx, cluster, db)
+		if err != nil {
+			log.WithError(err).Errorf("Failed to refresh cluster %s, infra env %s during host update", host.ID, host.InfraEnvID)
+			return err
+		}
+	}
+	return err
+}
+
+// refreshHostAfterUpdate refreshes the inventory and the status of the host, without refreshing its cluster
+func (b *bareMetalInventory) refreshHostAfterUpdate(ctx context.Context, host *common.Host, db *gorm.DB) error {
 	log := logutil.FromContext(ctx, b.log)
 	if host.ClusterID != nil {
 		if host.Inventory != "" {
@@ -6980,15 +7231,7 @@ func (b *bareMetalInventory) refreshAfterUpdate(ctx context.Context, cluster *co
 		log.WithError(err).Errorf("Failed to refresh host %s, infra env %s during update", host.ID, host.InfraEnvID)
 		return err
 	}
-
-	if host.ClusterID != nil {
-		_, err = b.clusterApi.RefreshStatus(ctx, cluster, db)
-		if err != nil {
-			log.WithError(err).Errorf("Failed to refresh cluster %s, infra env %s during host update", host.ID, host.InfraEnvID)
-			return err
-		}
-	}
-	return err
+	return nil
 }
 
 func (b *bareMetalInventory) getBoundClusterForUpdate(db *gorm.DB, infraEnv *common.InfraEnv, infraEnvID, hostID strfmt.UUID) (*common.Cluster, error) {
@@ -45,6 +45,7 @@ import (
 	commontesting "github.com/openshift/assisted-service/internal/common/testing"
 	"github.com/openshift/assisted-service/internal/constants"
 	"github.com/openshift/assisted-service/internal/dns"
+	"github.com/openshift/assisted-service/internal/dryrun"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/events/eventstest"
 	"github.com/openshift/assisted-service/internal/featuresupport"
@@ -52,6 +53,7 @@ import (
 	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/host/fingerprint"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/ignition"
 	"github.com/openshift/assisted-service/internal/infraenv"
@@ -66,6 +68,7 @@ import (
 	"github.com/openshift/assisted-service/internal/stream"
 	testutils "github.com/openshift/assisted-service/internal/testing"
 	"github.com/openshift/assisted-service/internal/usage"
+	"github.com/openshift/assisted-service/internal/uservalidations"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
@@ -177,6 +180,9 @@ var (
 	mockMirrorRegistriesConfigBuilder *mirrorregistries.MockServiceMirrorRegistriesConfigBuilder
 	mockInstallerCache                *installercache.MockInstallerCache
 	mockExecuter                      *executer.MockExecuter
+	mockDryRunRenderer                *dryrun.MockRenderer
+	mockRevisionRecorder              *MockRevisionRecorder
+	mockClusterTemplates              *MockClusterTemplates
 	secondDayWorkerIgnition           = []byte(`{
 		"ignition": {
 		  "version": "3.1.0",
@@ -3759,6 +3765,61 @@ var _ = Describe("cluster", func() {
 			})
 		})
 
+		Context("Update Cluster Firmware Policy", func() {
+			BeforeEach(func() {
+				clusterID = strfmt.UUID(uuid.New().String())
+				cluster := &common.Cluster{Cluster: models.Cluster{
+					ID:   &clusterID,
+					Kind: swag.String(models.ClusterKindAddHostsCluster),
+					Platform: &models.Platform{
+						Type: common.PlatformTypePtr(models.PlatformTypeBaremetal),
+					},
+					CPUArchitecture: common.DefaultCPUArchitecture,
+					FirmwarePolicy:  swag.String(`{"virtualization_required":true}`),
+				}}
+				err := db.Create(cluster).Error
+				Expect(err).ShouldNot(HaveOccurred())
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(createClusterIdMatcher(cluster)).Return(nil).Times(1)
+			})
+
+			It("Update firmware policy success", func() {
+				mockSuccess()
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						FirmwarePolicy: &models.FirmwarePolicy{BootMode: "uefi", SecureBootRequired: true},
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+				actual := reply.(*installer.V2UpdateClusterCreated)
+				Expect(swag.StringValue(actual.Payload.FirmwarePolicy)).To(Equal(`{"boot_mode":"uefi","secure_boot_required":true}`))
+			})
+
+			It("Remove firmware policy with an empty policy", func() {
+				mockSuccess()
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						FirmwarePolicy: &models.FirmwarePolicy{},
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+				actual := reply.(*installer.V2UpdateClusterCreated)
+				Expect(actual.Payload.FirmwarePolicy).To(BeNil())
+			})
+
+			It("Update cluster with invalid firmware policy", func() {
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						FirmwarePolicy: &models.FirmwarePolicy{BootMode: "bios", SecureBootRequired: true},
+					},
+				})
+				Expect(reply).Should(BeAssignableToTypeOf(common.NewApiError(http.StatusBadRequest, errors.Errorf("error"))))
+				verifyApiErrorString(reply, http.StatusBadRequest, "Secure Boot requires the uefi boot mode")
+			})
+		})
+
 		Context("Update Network", func() {
 			var cluster *common.Cluster
 			BeforeEach(func() {
@@ -12702,6 +12763,128 @@ var _ = Describe("GetClusterInstallConfig", func() {
 
 })
 
+var _ = Describe("V2DryRunInstallCluster", func() {
+	var (
+		bm         *bareMetalInventory
+		cfg        Config
+		db         *gorm.DB
+		ctx        = context.Background()
+		c          *common.Cluster
+		infraEnvID strfmt.UUID
+		masterID   strfmt.UUID
+		workerID   strfmt.UUID
+		dbName     string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+		c = createCluster(db, models.ClusterStatusReady)
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		createInfraEnv(db, infraEnvID, *c.ID)
+		masterID = strfmt.UUID(uuid.New().String())
+		workerID = strfmt.UUID(uuid.New().String())
+		addHost(masterID, models.HostRoleMaster, models.HostStatusKnown, models.HostKindHost, infraEnvID, *c.ID, getInventoryStr("master", "bios"), db)
+		addHost(workerID, models.HostRoleAutoAssign, models.HostStatusKnown, models.HostKindHost, infraEnvID, *c.ID, getInventoryStr("worker", "bios"), db)
+		Expect(db.Model(&models.Host{}).Where("id = ?", workerID.String()).Update("suggested_role", models.HostRoleWorker).Error).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("renders the installation without changing the cluster", func() {
+		var input *dryrun.Input
+		mockInstallConfigBuilder.EXPECT().GetInstallConfig(gomock.Any(), gomock.Any(), "").Return([]byte("install-config"), nil).Times(1)
+		mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.ReleaseImage, nil).Times(1)
+		mockDryRunRenderer.EXPECT().Render(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, in *dryrun.Input) ([]byte, error) {
+			input = in
+			return []byte("bundle"), nil
+		}).Times(1)
+
+		response := bm.V2DryRunInstallCluster(ctx, installer.V2DryRunInstallClusterParams{ClusterID: *c.ID})
+		Expect(response).To(BeAssignableToTypeOf(&filemiddleware.FileMiddlewareResponder{}))
+
+		Expect(input.Errors).To(BeEmpty())
+		Expect(input.InstallConfig).To(Equal([]byte("install-config")))
+		Expect(input.ReleaseImage).To(Equal(*common.TestDefaultConfig.ReleaseImage.URL))
+		Expect(input.InfraEnvs).To(HaveLen(1))
+		for _, h := range input.Cluster.Hosts {
+			Expect(h.Bootstrap).To(Equal(*h.ID == masterID))
+			if *h.ID == workerID {
+				Expect(h.Role).To(Equal(models.HostRoleWorker))
+			}
+		}
+
+		dbCluster, err := common.GetClusterFromDBWithHosts(db, *c.ID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(swag.StringValue(dbCluster.Status)).To(Equal(models.ClusterStatusReady))
+		for _, h := range dbCluster.Hosts {
+			Expect(h.Bootstrap).To(BeFalse())
+			if *h.ID == workerID {
+				Expect(h.Role).To(Equal(models.HostRoleAutoAssign))
+			}
+		}
+	})
+
+	It("selects the bootstrap by the effective roles of the hosts", func() {
+		var input *dryrun.Input
+		Expect(db.Model(&models.Host{}).Where("id = ?", masterID.String()).Updates(map[string]interface{}{
+			"role":           models.HostRoleAutoAssign,
+			"suggested_role": models.HostRoleMaster,
+		}).Error).ToNot(HaveOccurred())
+		mockInstallConfigBuilder.EXPECT().GetInstallConfig(gomock.Any(), gomock.Any(), "").Return([]byte("install-config"), nil).Times(1)
+		mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.ReleaseImage, nil).Times(1)
+		mockDryRunRenderer.EXPECT().Render(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, in *dryrun.Input) ([]byte, error) {
+			input = in
+			return []byte("bundle"), nil
+		}).Times(1)
+
+		response := bm.V2DryRunInstallCluster(ctx, installer.V2DryRunInstallClusterParams{ClusterID: *c.ID})
+		Expect(response).To(BeAssignableToTypeOf(&filemiddleware.FileMiddlewareResponder{}))
+
+		Expect(input.Errors).To(BeEmpty())
+		for _, h := range input.Cluster.Hosts {
+			Expect(h.Bootstrap).To(Equal(*h.ID == masterID))
+		}
+	})
+
+	It("reports generation errors to the renderer", func() {
+		var input *dryrun.Input
+		// Without a suggested role, no host would be a master
+		Expect(db.Model(&models.Host{}).Where("id = ?", masterID.String()).Update("role", models.HostRoleAutoAssign).Error).ToNot(HaveOccurred())
+		mockInstallConfigBuilder.EXPECT().GetInstallConfig(gomock.Any(), gomock.Any(), "").Return(nil, errors.New("invalid install config")).Times(1)
+		mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("no release image")).Times(1)
+		mockDryRunRenderer.EXPECT().Render(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, in *dryrun.Input) ([]byte, error) {
+			input = in
+			return []byte("bundle"), nil
+		}).Times(1)
+
+		response := bm.V2DryRunInstallCluster(ctx, installer.V2DryRunInstallClusterParams{ClusterID: *c.ID})
+		Expect(response).To(BeAssignableToTypeOf(&filemiddleware.FileMiddlewareResponder{}))
+
+		steps := make([]string, 0, len(input.Errors))
+		for _, stepError := range input.Errors {
+			steps = append(steps, stepError.Step)
+		}
+		Expect(steps).To(ConsistOf(dryrun.StepBootstrap, dryrun.StepInstallConfig, dryrun.StepReleaseImage))
+		Expect(input.InstallConfig).To(BeNil())
+		Expect(input.ReleaseImage).To(BeEmpty())
+	})
+
+	It("fails for a cluster that is installing", func() {
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", c.ID.String()).Update("status", models.ClusterStatusInstalling).Error).ToNot(HaveOccurred())
+		response := bm.V2DryRunInstallCluster(ctx, installer.V2DryRunInstallClusterParams{ClusterID: *c.ID})
+		verifyApiError(response, http.StatusConflict)
+	})
+
+	It("fails for a missing cluster", func() {
+		response := bm.V2DryRunInstallCluster(ctx, installer.V2DryRunInstallClusterParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(response, http.StatusNotFound)
+	})
+})
+
 var _ = Describe("UpdateClusterInstallConfig", func() {
 	var (
 		bm        *bareMetalInventory
@@ -15874,6 +16057,57 @@ var _ = Describe("RegisterCluster", func() {
 			common.DeleteTestDB(db, dbName)
 		})
 
+		Context("V2 Register cluster from a template", func() {
+			var templateID strfmt.UUID
+
+			BeforeEach(func() {
+				templateID = strfmt.UUID(uuid.New().String())
+			})
+
+			It("registers the cluster with the params of the template", func() {
+				mockClusterRegisterSuccess(true)
+				mockAMSSubscription(ctx)
+
+				clusterCreateParams := getDefaultClusterCreateParams()
+				manifests := []*models.CreateManifestParams{{FileName: swag.String("a.yaml"), Content: swag.String("YTogYg==")}}
+				mockClusterTemplates.EXPECT().InstantiateClusterTemplate(gomock.Any(), templateID, []string{"domain=example.com"}, clusterCreateParams).
+					DoAndReturn(func(_ context.Context, _ strfmt.UUID, _ []string, overrides *models.ClusterCreateParams) (*ClusterTemplateInstance, error) {
+						params := *overrides
+						params.BaseDNSDomain = "example.com"
+						return &ClusterTemplateInstance{ClusterParams: &params, Manifests: manifests}, nil
+					}).Times(1)
+				mockClusterTemplates.EXPECT().CreateClusterTemplateManifests(gomock.Any(), gomock.Any(), manifests).Return(nil).Times(1)
+
+				reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+					NewClusterParams:   clusterCreateParams,
+					ClusterTemplateID:  &templateID,
+					TemplateParameters: []string{"domain=example.com"},
+				})
+				Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2RegisterClusterCreated()))
+				actual := reply.(*installer.V2RegisterClusterCreated).Payload
+				Expect(actual.BaseDNSDomain).To(Equal("example.com"))
+			})
+
+			It("fails when the template cannot be instantiated", func() {
+				mockClusterTemplates.EXPECT().InstantiateClusterTemplate(gomock.Any(), templateID, gomock.Any(), gomock.Any()).
+					Return(nil, common.NewApiError(http.StatusNotFound, errors.New("cluster template not found"))).Times(1)
+
+				reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+					NewClusterParams:  getDefaultClusterCreateParams(),
+					ClusterTemplateID: &templateID,
+				})
+				verifyApiError(reply, http.StatusNotFound)
+			})
+
+			It("rejects template parameters without a template", func() {
+				reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+					NewClusterParams:   getDefaultClusterCreateParams(),
+					TemplateParameters: []string{"domain=example.com"},
+				})
+				verifyApiError(reply, http.StatusBadRequest)
+			})
+		})
+
 		Context("V2 Register cluster", func() {
 			It("Dual-stack cluster with VIPs - positive", func() {
 				mockClusterRegisterSuccess(true)
@@ -16515,6 +16749,34 @@ var _ = Describe("RegisterCluster", func() {
 		})
 	})
 
+	Context("Cluster Firmware Policy", func() {
+		It("Register cluster with firmware policy", func() {
+			mockClusterRegisterSuccess(true)
+			mockAMSSubscription(ctx)
+
+			params := getDefaultClusterCreateParams()
+			params.FirmwarePolicy = &models.FirmwarePolicy{VirtualizationRequired: true}
+			reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+				NewClusterParams: params,
+			})
+			Expect(reflect.TypeOf(reply)).Should(Equal(reflect.TypeOf(installer.NewV2RegisterClusterCreated())))
+			actual := reply.(*installer.V2RegisterClusterCreated)
+			Expect(swag.StringValue(actual.Payload.FirmwarePolicy)).To(Equal(`{"virtualization_required":true}`))
+		})
+
+		It("Register cluster with invalid firmware policy", func() {
+			params := getDefaultClusterCreateParams()
+			params.FirmwarePolicy = &models.FirmwarePolicy{MinBiosVersions: []*models.FirmwarePolicyBiosVersion{
+				{Manufacturer: swag.String("HPE"), Version: swag.String("latest")},
+			}}
+			reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+				NewClusterParams: params,
+			})
+			Expect(reply).Should(BeAssignableToTypeOf(common.NewApiError(http.StatusBadRequest, errors.Errorf("error"))))
+			verifyApiErrorString(reply, http.StatusBadRequest, `minimum BIOS version "latest" for manufacturer HPE must contain a number`)
+		})
+	})
+
 	Context("Networking", func() {
 		var (
 			clusterNetworks = common.TestIPv4Networking.ClusterNetworks
@@ -19324,6 +19586,272 @@ var _ = Describe("UpdateHostApproved", func() {
 
 })
 
+var _ = Describe("V2BulkUpdateHosts", func() {
+	var (
+		bm         *bareMetalInventory
+		cfg        Config
+		db         *gorm.DB
+		ctx        = context.Background()
+		clusterID  strfmt.UUID
+		infraEnvID strfmt.UUID
+		hostID     strfmt.UUID
+		dbName     string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ShouldNot(HaveOccurred())
+		Expect(db.Create(&common.InfraEnv{InfraEnv: models.InfraEnv{ID: &infraEnvID}}).Error).ShouldNot(HaveOccurred())
+		inventory, err := common.MarshalInventory(&models.Inventory{
+			Interfaces: []*models.Interface{{Name: "eth0", MacAddress: "52:54:00:aa:bb:cc"}},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		addHost(hostID, models.HostRoleAutoAssign, models.HostStatusKnown, models.HostKindHost, infraEnvID, clusterID, inventory, db)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	bulkUpdate := func(operations ...*models.BulkHostOperation) []*models.BulkHostOperationResult {
+		response := bm.V2BulkUpdateHosts(ctx, installer.V2BulkUpdateHostsParams{
+			InfraEnvID:         infraEnvID,
+			BulkHostOperations: &models.BulkHostOperations{Operations: operations},
+		})
+		ExpectWithOffset(1, response).To(BeAssignableToTypeOf(&installer.V2BulkUpdateHostsOK{}))
+		return response.(*installer.V2BulkUpdateHostsOK).Payload.Results
+	}
+
+	expectStatuses := func(results []*models.BulkHostOperationResult, statuses ...string) {
+		ExpectWithOffset(1, results).To(HaveLen(len(statuses)))
+		for i, result := range results {
+			ExpectWithOffset(1, *result.Index).To(Equal(int64(i)))
+			ExpectWithOffset(1, *result.Status).To(Equal(statuses[i]), result.Reason)
+		}
+	}
+
+	isApproved := func() bool {
+		h, err := bm.GetCommonHostInternal(ctx, infraEnvID.String(), hostID.String())
+		ExpectWithOffset(1, err).ShouldNot(HaveOccurred())
+		return h.Approved
+	}
+
+	It("fails for a missing infra-env", func() {
+		response := bm.V2BulkUpdateHosts(ctx, installer.V2BulkUpdateHostsParams{
+			InfraEnvID: strfmt.UUID(uuid.New().String()),
+			BulkHostOperations: &models.BulkHostOperations{Operations: []*models.BulkHostOperation{
+				{HostID: &hostID, Action: swag.String(models.BulkHostOperationActionApprove)},
+			}},
+		})
+		verifyApiError(response, http.StatusNotFound)
+	})
+
+	It("applies the updates in a single transaction", func() {
+		mockHostApi.EXPECT().UpdateRole(gomock.Any(), gomock.Any(), models.HostRoleMaster, gomock.Any()).Return(nil).Times(1)
+		mockHostApi.EXPECT().RefreshInventory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.HostApprovedUpdatedEventName),
+			eventstest.WithHostIdMatcher(hostID.String()),
+			eventstest.WithInfraEnvIdMatcher(infraEnvID.String()))).Times(1)
+		results := bulkUpdate(
+			&models.BulkHostOperation{HostID: &hostID, Action: swag.String(models.BulkHostOperationActionSetRole), HostRole: swag.String(string(models.HostRoleMaster))},
+			&models.BulkHostOperation{MacAddress: swag.String("52-54-00-AA-BB-CC"), Action: swag.String(models.BulkHostOperationActionApprove)},
+		)
+		expectStatuses(results, models.BulkHostOperationResultStatusSucceeded, models.BulkHostOperationResultStatusSucceeded)
+		Expect(results[1].HostID).To(Equal(hostID))
+		Expect(isApproved()).To(BeTrue())
+	})
+
+	It("rolls back the transaction when an update fails", func() {
+		results := bulkUpdate(
+			&models.BulkHostOperation{HostID: &hostID, Action: swag.String(models.BulkHostOperationActionApprove)},
+			&models.BulkHostOperation{HostID: &hostID, Action: swag.String(models.BulkHostOperationActionSetHostname), HostName: swag.String("Invalid_Name")},
+		)
+		expectStatuses(results, models.BulkHostOperationResultStatusNotApplied, models.BulkHostOperationResultStatusFailed)
+		Expect(results[1].Code).To(Equal(int64(http.StatusBadRequest)))
+		Expect(isApproved()).To(BeFalse())
+	})
+
+	It("does not apply the updates when an operation is invalid", func() {
+		otherHostID := strfmt.UUID(uuid.New().String())
+		results := bulkUpdate(
+			&models.BulkHostOperation{HostID: &hostID, Action: swag.String(models.BulkHostOperationActionApprove)},
+			&models.BulkHostOperation{HostID: &hostID, Action: swag.String(models.BulkHostOperationActionSetRole)},
+			&models.BulkHostOperation{HostID: &otherHostID, Action: swag.String(models.BulkHostOperationActionApprove)},
+			&models.BulkHostOperation{MacAddress: swag.String("52:54:00:00:00:01"), Action: swag.String(models.BulkHostOperationActionApprove)},
+			&models.BulkHostOperation{HostID: &hostID, MacAddress: swag.String("52:54:00:aa:bb:cc"), Action: swag.String(models.BulkHostOperationActionApprove)},
+		)
+		expectStatuses(results, models.BulkHostOperationResultStatusNotApplied, models.BulkHostOperationResultStatusFailed,
+			models.BulkHostOperationResultStatusFailed, models.BulkHostOperationResultStatusFailed, models.BulkHostOperationResultStatusFailed)
+		Expect(results[1].Code).To(Equal(int64(http.StatusBadRequest)))
+		Expect(results[2].Code).To(Equal(int64(http.StatusNotFound)))
+		Expect(results[3].Code).To(Equal(int64(http.StatusNotFound)))
+		Expect(results[4].Code).To(Equal(int64(http.StatusBadRequest)))
+		Expect(isApproved()).To(BeFalse())
+	})
+
+	It("reports the result of each bind operation", func() {
+		mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.HostBindFailedEventName),
+			eventstest.WithHostIdMatcher(hostID.String()),
+			eventstest.WithInfraEnvIdMatcher(infraEnvID.String()))).Times(1)
+		results := bulkUpdate(
+			&models.BulkHostOperation{HostID: &hostID, Action: swag.String(models.BulkHostOperationActionBind), ClusterID: &clusterID},
+		)
+		expectStatuses(results, models.BulkHostOperationResultStatusFailed)
+		Expect(results[0].Code).To(Equal(int64(http.StatusConflict)))
+	})
+})
+
+var _ = Describe("Host hardware revisions", func() {
+	var (
+		bm         *bareMetalInventory
+		cfg        Config
+		db         *gorm.DB
+		ctx        = context.Background()
+		clusterID  strfmt.UUID
+		infraEnvID strfmt.UUID
+		hostID     strfmt.UUID
+		dbName     string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		addHost(hostID, models.HostRoleAutoAssign, models.HostStatusKnown, models.HostKindHost, infraEnvID, clusterID, "", db)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	addRevision := func(revision int64, fp *models.HardwareFingerprint) {
+		b, err := json.Marshal(fp)
+		Expect(err).ShouldNot(HaveOccurred())
+		createdAt := strfmt.DateTime(time.Now())
+		Expect(db.Create(&common.HostHardwareRevision{
+			HostHardwareRevision: models.HostHardwareRevision{
+				HostID:          &hostID,
+				InfraEnvID:      &infraEnvID,
+				Revision:        swag.Int64(revision),
+				CreatedAt:       &createdAt,
+				FingerprintHash: swag.String(fmt.Sprintf("hash-%d", revision)),
+			},
+			FingerprintJSON: string(b),
+		}).Error).ShouldNot(HaveOccurred())
+	}
+
+	It("lists the revisions newest first with their changes", func() {
+		addRevision(1, &models.HardwareFingerprint{Nics: []*models.HardwareFingerprintNic{{MacAddress: "52:54:00:aa:bb:cc"}}})
+		addRevision(2, &models.HardwareFingerprint{Nics: []*models.HardwareFingerprintNic{{MacAddress: "52:54:00:aa:bb:dd"}}})
+		response := bm.V2ListHostHardwareRevisions(ctx, installer.V2ListHostHardwareRevisionsParams{InfraEnvID: infraEnvID, HostID: hostID})
+		Expect(response).Should(BeAssignableToTypeOf(installer.NewV2ListHostHardwareRevisionsOK()))
+		revisions := response.(*installer.V2ListHostHardwareRevisionsOK).Payload
+		Expect(revisions).To(HaveLen(2))
+		Expect(*revisions[0].Revision).To(BeEquivalentTo(2))
+		Expect(revisions[0].Fingerprint.Nics[0].MacAddress).To(Equal("52:54:00:aa:bb:dd"))
+		Expect(fingerprint.Summary(revisions[0].Changes)).To(Equal("nic 52:54:00:aa:bb:cc removed, nic 52:54:00:aa:bb:dd added"))
+		Expect(*revisions[1].Revision).To(BeEquivalentTo(1))
+	})
+
+	It("fails to list the revisions of a missing host", func() {
+		response := bm.V2ListHostHardwareRevisions(ctx, installer.V2ListHostHardwareRevisionsParams{InfraEnvID: infraEnvID, HostID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(response, http.StatusNotFound)
+	})
+
+	It("accepts the hardware of the host", func() {
+		mockHostApi.EXPECT().AcceptHardware(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		response := bm.V2AcceptHostHardware(ctx, installer.V2AcceptHostHardwareParams{InfraEnvID: infraEnvID, HostID: hostID})
+		Expect(response).Should(BeAssignableToTypeOf(installer.NewV2AcceptHostHardwareOK()))
+	})
+
+	Context("blocking the installation", func() {
+		var drifted *models.Host
+
+		BeforeEach(func() {
+			detectedAt := strfmt.DateTime(time.Now())
+			drifted = &models.Host{ID: &hostID, RequestedHostname: "drifted", HardwareDriftDetectedAt: &detectedAt}
+		})
+
+		It("doesn't block hosts with a hardware drift by default", func() {
+			Expect(bm.validateNoHardwareDrift(drifted)).To(Succeed())
+		})
+
+		It("blocks hosts with a hardware drift when configured", func() {
+			bm.BlockInstallOnHardwareDrift = true
+			err := bm.validateNoHardwareDrift(drifted, &models.Host{ID: &hostID})
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusConflict))
+			Expect(err.Error()).To(ContainSubstring("drifted"))
+		})
+
+		It("doesn't block hosts whose hardware was accepted", func() {
+			bm.BlockInstallOnHardwareDrift = true
+			Expect(bm.validateNoHardwareDrift(&models.Host{ID: &hostID})).To(Succeed())
+		})
+	})
+})
+
+var _ = Describe("V2GetClusterNetworkTopology", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		dbName    string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ShouldNot(HaveOccurred())
+		inventory, err := common.MarshalInventory(&models.Inventory{
+			Interfaces: []*models.Interface{{Name: "eth0", MacAddress: "52:54:00:aa:bb:cc", IPV4Addresses: []string{"10.0.0.1/24"}}},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		addHost(strfmt.UUID(uuid.New().String()), models.HostRoleMaster, models.HostStatusKnown, models.HostKindHost,
+			strfmt.UUID(uuid.New().String()), clusterID, inventory, db)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("returns the topology as JSON", func() {
+		response := bm.V2GetClusterNetworkTopology(ctx, installer.V2GetClusterNetworkTopologyParams{ClusterID: clusterID, Format: swag.String("json")})
+		Expect(response).Should(BeAssignableToTypeOf(installer.NewV2GetClusterNetworkTopologyOK()))
+		topology := response.(*installer.V2GetClusterNetworkTopologyOK).Payload
+		Expect(topology.Hosts).To(HaveLen(1))
+		Expect(topology.Subnets).To(HaveLen(1))
+		Expect(topology.Subnets[0].Cidr).To(Equal("10.0.0.0/24"))
+	})
+
+	It("returns the topology as a DOT graph", func() {
+		response := bm.V2GetClusterNetworkTopology(ctx, installer.V2GetClusterNetworkTopologyParams{ClusterID: clusterID, Format: swag.String("dot")})
+		recorder := httptest.NewRecorder()
+		response.WriteResponse(recorder, runtime.JSONProducer())
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Header().Get("Content-Type")).To(Equal("text/vnd.graphviz"))
+		Expect(recorder.Body.String()).To(ContainSubstring(`"10.0.0.0/24" [shape=ellipse];`))
+	})
+
+	It("fails for a missing cluster", func() {
+		response := bm.V2GetClusterNetworkTopology(ctx, installer.V2GetClusterNetworkTopologyParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(response, http.StatusNotFound)
+	})
+})
+
 var _ = Describe("Calculate host networks", func() {
 	var (
 		cfg       *Config
@@ -19564,6 +20092,10 @@ func createInventoryWithImageService(db *gorm.DB, cfg Config, enableImageService
 	mockExecuter = executer.NewMockExecuter(ctrl)
 	mockMirrorRegistriesConfigBuilder = mirrorregistries.NewMockServiceMirrorRegistriesConfigBuilder(ctrl)
 	mockInstallerCache = installercache.NewMockInstallerCache(ctrl)
+	mockDryRunRenderer = dryrun.NewMockRenderer(ctrl)
+	mockRevisionRecorder = NewMockRevisionRecorder(ctrl)
+	mockRevisionRecorder.EXPECT().RecordRevision(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mockClusterTemplates = NewMockClusterTemplates(ctrl)
 	dnsApi := dns.NewDNSHandler(cfg.BaseDNSDomains, common.GetTestLog())
 	gcConfig := garbagecollector.Config{DeregisterInactiveAfter: 20 * 24 * time.Hour}
 
@@ -19580,7 +20112,8 @@ func createInventoryWithImageService(db *gorm.DB, cfg Config, enableImageService
 		mockGenerator, mockEvents, mockS3Client, mockMetric, mockUsage, mockOperatorManager,
 		getTestAuthHandler(), getTestAuthzHandler(), mockK8sClient, ocmClient, nil, mockSecretValidator, mockVersions,
 		mockOSImages, mockCRDUtils, mockIgnitionBuilder, mockHwValidator, dnsApi, mockInstallConfigBuilder,
-		mockStaticNetworkConfig, gcConfig, mockProviderRegistry, true, "", disconnectedIgnitionGenerator)
+		mockStaticNetworkConfig, gcConfig, mockProviderRegistry, true, "", disconnectedIgnitionGenerator, mockDryRunRenderer,
+		mockRevisionRecorder, mockClusterTemplates)
 
 	if enableImageService {
 		bm.ImageServiceBaseURL = imageServiceBaseURL
@@ -22123,3 +22656,13 @@ var _ = Describe("Primary IP Stack Functionality", func() {
 		})
 	})
 })
+
+var _ = Describe("validateIgnoredValidations", func() {
+	It("accepts the IDs of user-defined validations of the same type", func() {
+		var userValidations uservalidations.Validations
+		Expect(userValidations.Decode(`[{"id": "no-small-disks", "target": "host", "expression": "true"}]`)).To(Succeed())
+		bm := &bareMetalInventory{Config: Config{UserValidations: userValidations}}
+		Expect(bm.validateIgnoredValidations([]string{}, `["no-small-disks"]`, common.NonIgnorableHostValidations, common.ValidationTypeHost)).To(BeEmpty())
+		Expect(bm.validateIgnoredValidations([]string{}, `["no-small-disks"]`, common.NonIgnorableClusterValidations, common.ValidationTypeCluster)).To(HaveLen(1))
+	})
+})
@@ -1,6 +1,7 @@
 package bminventory
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -43,17 +44,71 @@ func (b *bareMetalInventory) V2UpdateHost(ctx context.Context, params installer.
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
+	if host.ClusterID != nil {
+		b.revisionRecorder.RecordRevision(ctx, *host.ClusterID, models.ClusterRevisionReasonHostUpdate)
+	}
 	return installer.NewV2UpdateHostCreated().WithPayload(&host.Host)
 }
 
 func (b *bareMetalInventory) V2RegisterCluster(ctx context.Context, params installer.V2RegisterClusterParams) middleware.Responder {
+	var instance *ClusterTemplateInstance
+	if params.ClusterTemplateID != nil {
+		var err error
+		instance, err = b.clusterTemplates.InstantiateClusterTemplate(ctx, *params.ClusterTemplateID, params.TemplateParameters, params.NewClusterParams)
+		if err != nil {
+			return common.GenerateErrorResponder(err)
+		}
+		params.NewClusterParams = instance.ClusterParams
+	} else if len(params.TemplateParameters) > 0 {
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusBadRequest,
+			errors.New("template_parameters may only be set with cluster_template_id")))
+	}
+
 	c, err := b.RegisterClusterInternal(ctx, nil, nil, params)
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
+	if instance != nil {
+		if err = b.createClusterTemplateResources(ctx, c, params.NewClusterParams.PullSecret, instance); err != nil {
+			// The cluster is not usable without the resources of the template
+			if deregisterErr := b.DeregisterClusterInternal(ctx, c); deregisterErr != nil {
+				logutil.FromContext(ctx, b.log).WithError(deregisterErr).Errorf("failed to deregister cluster %s after failing to create the resources of template %s",
+					c.ID, params.ClusterTemplateID)
+			}
+			return common.GenerateErrorResponder(err)
+		}
+	}
+	b.revisionRecorder.RecordRevision(ctx, *c.ID, models.ClusterRevisionReasonRegister)
 	return installer.NewV2RegisterClusterCreated().WithPayload(&c.Cluster)
 }
 
+// createClusterTemplateResources creates the manifests and the infra-env of a cluster registered from a template
+func (b *bareMetalInventory) createClusterTemplateResources(ctx context.Context, c *common.Cluster, pullSecret *string,
+	instance *ClusterTemplateInstance) error {
+	if len(instance.Manifests) > 0 {
+		if err := b.clusterTemplates.CreateClusterTemplateManifests(ctx, *c.ID, instance.Manifests); err != nil {
+			return err
+		}
+	}
+	if instance.InfraEnvParams == nil {
+		return nil
+	}
+	infraEnvParams := instance.InfraEnvParams
+	infraEnvParams.ClusterID = c.ID
+	infraEnvParams.PullSecret = pullSecret
+	if swag.StringValue(infraEnvParams.Name) == "" {
+		infraEnvParams.Name = swag.String(fmt.Sprintf("%s_infra-env", c.Name))
+	}
+	if infraEnvParams.OpenshiftVersion == "" {
+		infraEnvParams.OpenshiftVersion = c.OpenshiftVersion
+	}
+	if infraEnvParams.CPUArchitecture == "" {
+		infraEnvParams.CPUArchitecture = c.CPUArchitecture
+	}
+	_, err := b.RegisterInfraEnvInternal(ctx, nil, nil, installer.RegisterInfraEnvParams{InfraenvCreateParams: infraEnvParams})
+	return err
+}
+
 func (b *bareMetalInventory) V2RegisterDisconnectedCluster(ctx context.Context, params installer.V2RegisterDisconnectedClusterParams) middleware.Responder {
 	id := strfmt.UUID(uuid.New().String())
 	url := installer.V2GetClusterURL{ClusterID: id}
@@ -282,6 +337,15 @@ func (b *bareMetalInventory) V2InstallCluster(ctx context.Context, params instal
 	return installer.NewV2InstallClusterAccepted().WithPayload(&cluster.Cluster)
 }
 
+func (b *bareMetalInventory) V2DryRunInstallCluster(ctx context.Context, params installer.V2DryRunInstallClusterParams) middleware.Responder {
+	data, err := b.DryRunInstallClusterInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return filemiddleware.NewResponder(installer.NewV2DryRunInstallClusterOK().WithPayload(io.NopCloser(bytes.NewReader(data))),
+		fmt.Sprintf("dry-run-%s.tar.gz", params.ClusterID), int64(len(data)), nil)
+}
+
 func (b *bareMetalInventory) V2CancelInstallation(ctx context.Context, params installer.V2CancelInstallationParams) middleware.Responder {
 	cluster, err := b.CancelInstallationInternal(ctx, params)
 	if err != nil {
@@ -691,6 +755,10 @@ func (b *bareMetalInventory) validateIgnoredValidations(problems []string, ignor
 			if strings.ToLower(v) == "all" {
 				continue
 			}
+			// User-defined validations may be ignored like the built-in ones
+			if funk.ContainsString(b.UserValidations.IDs(validationType), v) {
+				continue
+			}
 			var err error
 			if validationType == common.ValidationTypeCluster {
 				validation := models.NewClusterValidationID(models.ClusterValidationID(v))
@@ -730,18 +798,30 @@ func (b *bareMetalInventory) V2GetIgnoredValidations(ctx context.Context, params
 }
 
 func (b *bareMetalInventory) V2SetIgnoredValidations(ctx context.Context, params installer.V2SetIgnoredValidationsParams) middleware.Responder {
+	ignoredValidations, err := b.SetIgnoredValidationsInternal(ctx, params)
+	if err != nil {
+		var apiErr *common.ApiErrorResponse
+		if errors.As(err, &apiErr) && apiErr.StatusCode() == http.StatusBadRequest {
+			return b.setIgnoredValidationsBadRequest(apiErr.Error())
+		}
+		return installer.NewV2SetIgnoredValidationsInternalServerError().WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+	b.revisionRecorder.RecordRevision(ctx, params.ClusterID, models.ClusterRevisionReasonIgnoredValidationsUpdate)
+	return installer.NewV2SetIgnoredValidationsCreated().WithPayload(ignoredValidations)
+}
+
+func (b *bareMetalInventory) SetIgnoredValidationsInternal(ctx context.Context, params installer.V2SetIgnoredValidationsParams) (*models.IgnoredValidations, error) {
 	// Restrict access to users who are permitted to ignore validations.
 	if !b.allowedToIgnoreValidations(ctx) {
-		return b.setIgnoredValidationsBadRequest("the capability to ignore validations is not available")
+		return nil, common.NewApiError(http.StatusBadRequest, errors.New("the capability to ignore validations is not available"))
 	}
 	cluster, err := b.getCluster(ctx, params.ClusterID.String())
 	if err != nil {
-		err = errors.Wrapf(err, "failed to fetch cluster %s to apply ignored validations", *cluster.ID)
-		return installer.NewV2SetIgnoredValidationsInternalServerError().WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+		return nil, errors.Wrapf(err, "failed to fetch cluster %s to apply ignored validations", params.ClusterID)
 	}
 	err = b.clusterApi.VerifyClusterUpdatability(cluster)
 	if err != nil {
-		return b.setIgnoredValidationsBadRequest(err.Error())
+		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
 	problems := []string{}
 	cluster.IgnoredClusterValidations = params.IgnoredValidations.ClusterValidationIds
@@ -750,18 +830,16 @@ func (b *bareMetalInventory) V2SetIgnoredValidations(ctx context.Context, params
 	problems = b.validateIgnoredValidations(problems, cluster.IgnoredClusterValidations, common.NonIgnorableClusterValidations, common.ValidationTypeCluster)
 	problems = b.validateIgnoredValidations(problems, cluster.IgnoredHostValidations, common.NonIgnorableHostValidations, common.ValidationTypeHost)
 	if len(problems) > 0 {
-		return b.setIgnoredValidationsBadRequest("cannot proceed due to the following errors: " + strings.Join(problems, "\n"))
+		return nil, common.NewApiError(http.StatusBadRequest, errors.New("cannot proceed due to the following errors: "+strings.Join(problems, "\n")))
 	}
 
 	if err = b.db.Save(cluster).Error; err != nil {
-		err = errors.Wrapf(err, "failed to apply ignored validations to cluster %s", *cluster.ID)
-		return installer.NewV2SetIgnoredValidationsInternalServerError().WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+		return nil, errors.Wrapf(err, "failed to apply ignored validations to cluster %s", *cluster.ID)
 	}
-	ignoredValidations := models.IgnoredValidations{
+	return &models.IgnoredValidations{
 		ClusterValidationIds: cluster.IgnoredClusterValidations,
 		HostValidationIds:    cluster.IgnoredHostValidations,
-	}
-	return installer.NewV2SetIgnoredValidationsCreated().WithPayload(&ignoredValidations)
+	}, nil
 }
 
 func (b *bareMetalInventory) V2GetClusterUISettings(ctx context.Context, params installer.V2GetClusterUISettingsParams) middleware.Responder {
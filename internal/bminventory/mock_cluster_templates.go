@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/openshift/assisted-service/internal/bminventory (interfaces: ClusterTemplates)
+
+// Package bminventory is a generated GoMock package.
+package bminventory
+
+import (
+	context "context"
+	reflect "reflect"
+
+	strfmt "github.com/go-openapi/strfmt"
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/openshift/assisted-service/models"
+)
+
+// MockClusterTemplates is a mock of ClusterTemplates interface.
+type MockClusterTemplates struct {
+	ctrl     *gomock.Controller
+	recorder *MockClusterTemplatesMockRecorder
+}
+
+// MockClusterTemplatesMockRecorder is the mock recorder for MockClusterTemplates.
+type MockClusterTemplatesMockRecorder struct {
+	mock *MockClusterTemplates
+}
+
+// NewMockClusterTemplates creates a new mock instance.
+func NewMockClusterTemplates(ctrl *gomock.Controller) *MockClusterTemplates {
+	mock := &MockClusterTemplates{ctrl: ctrl}
+	mock.recorder = &MockClusterTemplatesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClusterTemplates) EXPECT() *MockClusterTemplatesMockRecorder {
+	return m.recorder
+}
+
+// CreateClusterTemplateManifests mocks base method.
+func (m *MockClusterTemplates) CreateClusterTemplateManifests(arg0 context.Context, arg1 strfmt.UUID, arg2 []*models.CreateManifestParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClusterTemplateManifests", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateClusterTemplateManifests indicates an expected call of CreateClusterTemplateManifests.
+func (mr *MockClusterTemplatesMockRecorder) CreateClusterTemplateManifests(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterTemplateManifests", reflect.TypeOf((*MockClusterTemplates)(nil).CreateClusterTemplateManifests), arg0, arg1, arg2)
+}
+
+// InstantiateClusterTemplate mocks base method.
+func (m *MockClusterTemplates) InstantiateClusterTemplate(arg0 context.Context, arg1 strfmt.UUID, arg2 []string, arg3 *models.ClusterCreateParams) (*ClusterTemplateInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstantiateClusterTemplate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*ClusterTemplateInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstantiateClusterTemplate indicates an expected call of InstantiateClusterTemplate.
+func (mr *MockClusterTemplatesMockRecorder) InstantiateClusterTemplate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstantiateClusterTemplate", reflect.TypeOf((*MockClusterTemplates)(nil).InstantiateClusterTemplate), arg0, arg1, arg2, arg3)
+}
@@ -112,6 +112,21 @@ func (mr *MockInstallerInternalsMockRecorder) DeregisterInfraEnvInternal(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterInfraEnvInternal", reflect.TypeOf((*MockInstallerInternals)(nil).DeregisterInfraEnvInternal), arg0, arg1)
 }
 
+// DryRunInstallClusterInternal mocks base method.
+func (m *MockInstallerInternals) DryRunInstallClusterInternal(arg0 context.Context, arg1 installer.V2DryRunInstallClusterParams) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRunInstallClusterInternal", arg0, arg1)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DryRunInstallClusterInternal indicates an expected call of DryRunInstallClusterInternal.
+func (mr *MockInstallerInternalsMockRecorder) DryRunInstallClusterInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRunInstallClusterInternal", reflect.TypeOf((*MockInstallerInternals)(nil).DryRunInstallClusterInternal), arg0, arg1)
+}
+
 // GetClusterByKubeKey mocks base method.
 func (m *MockInstallerInternals) GetClusterByKubeKey(arg0 types.NamespacedName) (*common.Cluster, error) {
 	m.ctrl.T.Helper()
@@ -367,6 +382,21 @@ func (mr *MockInstallerInternalsMockRecorder) RegisterInfraEnvInternal(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterInfraEnvInternal", reflect.TypeOf((*MockInstallerInternals)(nil).RegisterInfraEnvInternal), arg0, arg1, arg2, arg3)
 }
 
+// SetIgnoredValidationsInternal mocks base method.
+func (m *MockInstallerInternals) SetIgnoredValidationsInternal(arg0 context.Context, arg1 installer.V2SetIgnoredValidationsParams) (*models.IgnoredValidations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIgnoredValidationsInternal", arg0, arg1)
+	ret0, _ := ret[0].(*models.IgnoredValidations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetIgnoredValidationsInternal indicates an expected call of SetIgnoredValidationsInternal.
+func (mr *MockInstallerInternalsMockRecorder) SetIgnoredValidationsInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIgnoredValidationsInternal", reflect.TypeOf((*MockInstallerInternals)(nil).SetIgnoredValidationsInternal), arg0, arg1)
+}
+
 // TransformClusterToDay2Internal mocks base method.
 func (m *MockInstallerInternals) TransformClusterToDay2Internal(arg0 context.Context, arg1 strfmt.UUID) (*common.Cluster, error) {
 	m.ctrl.T.Helper()
@@ -412,6 +442,21 @@ func (mr *MockInstallerInternalsMockRecorder) UpdateClusterInstallConfigInternal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterInstallConfigInternal", reflect.TypeOf((*MockInstallerInternals)(nil).UpdateClusterInstallConfigInternal), arg0, arg1)
 }
 
+// UpdateClusterInteractive mocks base method.
+func (m *MockInstallerInternals) UpdateClusterInteractive(arg0 context.Context, arg1 installer.V2UpdateClusterParams) (*common.Cluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterInteractive", arg0, arg1)
+	ret0, _ := ret[0].(*common.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClusterInteractive indicates an expected call of UpdateClusterInteractive.
+func (mr *MockInstallerInternalsMockRecorder) UpdateClusterInteractive(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterInteractive", reflect.TypeOf((*MockInstallerInternals)(nil).UpdateClusterInteractive), arg0, arg1)
+}
+
 // UpdateClusterNonInteractive mocks base method.
 func (m *MockInstallerInternals) UpdateClusterNonInteractive(arg0 context.Context, arg1 installer.V2UpdateClusterParams, arg2 *common.MirrorRegistryConfiguration) (*common.Cluster, error) {
 	m.ctrl.T.Helper()
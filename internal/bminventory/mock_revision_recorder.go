@@ -0,0 +1,48 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/openshift/assisted-service/internal/bminventory (interfaces: RevisionRecorder)
+
+// Package bminventory is a generated GoMock package.
+package bminventory
+
+import (
+	context "context"
+	reflect "reflect"
+
+	strfmt "github.com/go-openapi/strfmt"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRevisionRecorder is a mock of RevisionRecorder interface.
+type MockRevisionRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevisionRecorderMockRecorder
+}
+
+// MockRevisionRecorderMockRecorder is the mock recorder for MockRevisionRecorder.
+type MockRevisionRecorderMockRecorder struct {
+	mock *MockRevisionRecorder
+}
+
+// NewMockRevisionRecorder creates a new mock instance.
+func NewMockRevisionRecorder(ctrl *gomock.Controller) *MockRevisionRecorder {
+	mock := &MockRevisionRecorder{ctrl: ctrl}
+	mock.recorder = &MockRevisionRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevisionRecorder) EXPECT() *MockRevisionRecorderMockRecorder {
+	return m.recorder
+}
+
+// RecordRevision mocks base method.
+func (m *MockRevisionRecorder) RecordRevision(arg0 context.Context, arg1 strfmt.UUID, arg2 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordRevision", arg0, arg1, arg2)
+}
+
+// RecordRevision indicates an expected call of RecordRevision.
+func (mr *MockRevisionRecorderMockRecorder) RecordRevision(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordRevision", reflect.TypeOf((*MockRevisionRecorder)(nil).RecordRevision), arg0, arg1, arg2)
+}
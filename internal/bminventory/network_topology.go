@@ -0,0 +1,41 @@
+package bminventory
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/network"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+)
+
+const networkTopologyFormatDOT = "dot"
+
+func (b *bareMetalInventory) V2GetClusterNetworkTopology(ctx context.Context, params installer.V2GetClusterNetworkTopologyParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	cluster, err := common.GetClusterFromDBWithHosts(b.db, params.ClusterID)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(err)
+	}
+	topology, err := network.BuildNetworkTopology(cluster)
+	if err != nil {
+		log.WithError(err).Errorf("failed to build the network topology of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if swag.StringValue(params.Format) != networkTopologyFormatDOT {
+		return installer.NewV2GetClusterNetworkTopologyOK().WithPayload(topology)
+	}
+	dot := network.NetworkTopologyToDOT(topology)
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		rw.Header().Set("Content-Type", "text/vnd.graphviz")
+		rw.WriteHeader(http.StatusOK)
+		if _, err := rw.Write([]byte(dot)); err != nil {
+			log.WithError(err).Warnf("failed to write the network topology of cluster %s", params.ClusterID)
+		}
+	})
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/openshift/assisted-service/internal/stream"
 	"github.com/openshift/assisted-service/internal/uploader"
 	"github.com/openshift/assisted-service/internal/usage"
+	"github.com/openshift/assisted-service/internal/uservalidations"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
 	"github.com/openshift/assisted-service/pkg/commonutils"
@@ -155,6 +156,9 @@ type Config struct {
 	MonitorBlacklistDuration time.Duration `envconfig:"CLUSTER_MONITOR_BLACKLIST_DURATION" default:"15m"`
 	// MonitorCycleDeadline bounds the total time for one ClusterMonitoring cycle
 	MonitorCycleDeadline time.Duration `envconfig:"CLUSTER_MONITOR_CYCLE_DEADLINE" default:"4m"`
+	// UserValidations are the validations that are defined by the administrator of the service, they are loaded
+	// with the configuration of the hosts
+	UserValidations uservalidations.Validations `ignored:"true"`
 }
 
 type Manager struct {
@@ -213,7 +217,7 @@ func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, stream stream.N
 		metricAPI:             metricApi,
 		manifestsGeneratorAPI: manifestsGeneratorAPI,
 		hostAPI:               hostAPI,
-		rp:                    newRefreshPreprocessor(log, hostAPI, operatorsApi, usageApi, eventsHandler),
+		rp:                    newRefreshPreprocessor(log, hostAPI, operatorsApi, usageApi, eventsHandler, cfg.UserValidations),
 		leaderElector:         leaderElector,
 		prevMonitorInvokedAt:  time.Time{},
 		ocmClient:             ocmClient,
@@ -1733,6 +1737,7 @@ func (m *Manager) PermanentClustersDeletion(ctx context.Context, olderThan strfm
 			&models.ClusterNetwork{},
 			&models.ServiceNetwork{},
 			&models.MachineNetwork{},
+			&common.ClusterRevision{},
 		}
 		for _, model := range modelsToDelete {
 			if err := common.DeleteRecordsByClusterID(m.db.Unscoped(), *c.ID, []interface{}{model}); err != nil {
@@ -1759,35 +1764,41 @@ func (m *Manager) GetClusterByKubeKey(key types.NamespacedName) (*common.Cluster
 }
 
 func (m *Manager) GenerateAdditionalManifests(ctx context.Context, cluster *common.Cluster) error {
-	log := logutil.FromContext(ctx, m.log)
-	if err := m.manifestsGeneratorAPI.AddChronyManifest(ctx, log, cluster); err != nil {
+	return GenerateAdditionalManifests(ctx, logutil.FromContext(ctx, m.log), cluster, m.manifestsGeneratorAPI, m.rp.operatorsAPI)
+}
+
+// GenerateAdditionalManifests generates the manifests that are added by the service to the manifests of the
+// cluster before its installation starts
+func GenerateAdditionalManifests(ctx context.Context, log logrus.FieldLogger, cluster *common.Cluster,
+	manifestsGeneratorAPI network.ManifestsGeneratorAPI, operatorsAPI operators.API) error {
+	if err := manifestsGeneratorAPI.AddChronyManifest(ctx, log, cluster); err != nil {
 		return errors.Wrap(err, "failed to add chrony manifest")
 	}
 
-	if common.IsSingleNodeCluster(cluster) && m.manifestsGeneratorAPI.IsSNODNSMasqEnabled() {
-		if err := m.manifestsGeneratorAPI.AddDnsmasqForSingleNode(ctx, log, cluster); err != nil {
+	if common.IsSingleNodeCluster(cluster) && manifestsGeneratorAPI.IsSNODNSMasqEnabled() {
+		if err := manifestsGeneratorAPI.AddDnsmasqForSingleNode(ctx, log, cluster); err != nil {
 			return errors.Wrap(err, "failed to add dnsmasq manifest")
 		}
 	}
 
-	if err := m.rp.operatorsAPI.GenerateManifests(ctx, cluster); err != nil {
+	if err := operatorsAPI.GenerateManifests(ctx, cluster); err != nil {
 		return errors.Wrap(err, "failed to add operator manifests")
 	}
-	if err := m.manifestsGeneratorAPI.AddTelemeterManifest(ctx, log, cluster); err != nil {
+	if err := manifestsGeneratorAPI.AddTelemeterManifest(ctx, log, cluster); err != nil {
 		return errors.Wrap(err, "failed to add telemeter manifest")
 	}
 
 	if common.AreMastersSchedulable(cluster) {
-		if err := m.manifestsGeneratorAPI.AddSchedulableMastersManifest(ctx, log, cluster); err != nil {
+		if err := manifestsGeneratorAPI.AddSchedulableMastersManifest(ctx, log, cluster); err != nil {
 			return errors.Wrap(err, "failed to add schedulable masters manifest")
 		}
 	}
 
-	if err := m.manifestsGeneratorAPI.AddDiskEncryptionManifest(ctx, log, cluster); err != nil {
+	if err := manifestsGeneratorAPI.AddDiskEncryptionManifest(ctx, log, cluster); err != nil {
 		return errors.Wrap(err, "failed to add disk encryption manifest")
 	}
 
-	if err := m.manifestsGeneratorAPI.AddNicReapply(ctx, log, cluster); err != nil {
+	if err := manifestsGeneratorAPI.AddNicReapply(ctx, log, cluster); err != nil {
 		return errors.Wrap(err, "failed to add nic reapply manifest")
 	}
 	return nil
@@ -149,27 +149,30 @@ func UpdateCluster(ctx context.Context, log logrus.FieldLogger, db *gorm.DB, not
 		updates[extra[i].(string)] = extra[i+1]
 	}
 
-	// Query by <cluster-id, status>
-	// Status is required as well to avoid races between different components.
-	dbReply := db.Model(&common.Cluster{}).Where("id = ? and status = ?", clusterId, srcStatus).Updates(updates)
+	var cluster *common.Cluster
+	err := notificationStream.NotifyInTransaction(ctx, db, func(tx *gorm.DB, notify stream.NotifyFunc) error {
+		// Query by <cluster-id, status>
+		// Status is required as well to avoid races between different components.
+		dbReply := tx.Model(&common.Cluster{}).Where("id = ? and status = ?", clusterId, srcStatus).Updates(updates)
 
-	if dbReply.Error != nil {
-		return nil, errors.Wrapf(dbReply.Error, "failed to update cluster %s", clusterId)
-	}
+		if dbReply.Error != nil {
+			return errors.Wrapf(dbReply.Error, "failed to update cluster %s", clusterId)
+		}
 
-	if dbReply.RowsAffected == 0 && !clusterExistsInDB(db, clusterId, updates) {
-		return nil, errors.Errorf("failed to update cluster %s. nothing has changed", clusterId)
-	}
+		if dbReply.RowsAffected == 0 && !clusterExistsInDB(tx, clusterId, updates) {
+			return errors.Errorf("failed to update cluster %s. nothing has changed", clusterId)
+		}
 
-	cluster, err := common.GetClusterFromDB(db, clusterId, common.UseEagerLoading)
-	if err == nil {
-		notifiableCluster := stream.GetNotifiableCluster(cluster)
-		if err = notificationStream.Notify(ctx, notifiableCluster); err != nil {
-			log.WithError(err).Warning("failed to notify cluster update event")
+		var err error
+		if cluster, err = common.GetClusterFromDB(tx, clusterId, common.UseEagerLoading); err != nil {
+			return err
 		}
-		return cluster, nil
+		return notify(stream.GetNotifiableCluster(cluster))
+	})
+	if err != nil {
+		return nil, err
 	}
-	return cluster, err
+	return cluster, nil
 }
 
 func getKnownMastersNodesIds(c *common.Cluster, db *gorm.DB) ([]*strfmt.UUID, error) {
@@ -19,6 +19,8 @@ const (
 	FailedPreparingtHostsExist   = conditionId("failed-preparing-hosts-exist")
 	ClusterPreparationSucceeded  = conditionId("cluster-preparation-succeeded")
 	ClusterPreparationFailed     = conditionId("cluster-preparation-failed")
+
+	UserDefinedValidationsSucceeded = conditionId("user-defined-validations-succeeded")
 )
 
 func (c conditionId) String() string {
@@ -17,6 +17,7 @@ import (
 	"github.com/openshift/assisted-service/internal/operators/api"
 	operatorcommon "github.com/openshift/assisted-service/internal/operators/common"
 	"github.com/openshift/assisted-service/internal/usage"
+	"github.com/openshift/assisted-service/internal/uservalidations"
 	"github.com/openshift/assisted-service/models"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
@@ -36,28 +37,30 @@ type stringer interface {
 }
 
 type refreshPreprocessor struct {
-	log           logrus.FieldLogger
-	validations   []validation
-	conditions    []condition
-	operatorsAPI  operators.API
-	usageAPI      usage.API
-	eventsHandler eventsapi.Handler
+	log             logrus.FieldLogger
+	validations     []validation
+	conditions      []condition
+	operatorsAPI    operators.API
+	usageAPI        usage.API
+	eventsHandler   eventsapi.Handler
+	userValidations uservalidations.Validations
 }
 
 func newRefreshPreprocessor(log logrus.FieldLogger, hostAPI host.API, operatorsAPI operators.API, usageAPI usage.API,
-	eventsHandler eventsapi.Handler) *refreshPreprocessor {
+	eventsHandler eventsapi.Handler, userValidations uservalidations.Validations) *refreshPreprocessor {
 	v := clusterValidator{
 		log:     log,
 		hostAPI: hostAPI,
 	}
 
 	return &refreshPreprocessor{
-		log:           log,
-		validations:   newValidations(&v),
-		conditions:    newConditions(&v),
-		operatorsAPI:  operatorsAPI,
-		usageAPI:      usageAPI,
-		eventsHandler: eventsHandler,
+		log:             log,
+		validations:     newValidations(&v),
+		conditions:      newConditions(&v),
+		operatorsAPI:    operatorsAPI,
+		usageAPI:        usageAPI,
+		eventsHandler:   eventsHandler,
+		userValidations: userValidations.ForTarget(uservalidations.TargetCluster),
 	}
 }
 
@@ -132,6 +135,14 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *clusterPreproce
 	for _, condition := range r.conditions {
 		stateMachineInput[condition.id.String()] = condition.fn(c)
 	}
+
+	if userResults := r.evaluateUserValidations(c); len(userResults) > 0 {
+		for _, result := range userResults {
+			stateMachineInput[result.ID.String()] = result.Status == ValidationSuccess
+		}
+		validationsOutput[uservalidations.Category] = userResults
+	}
+
 	for _, validationResults := range validationsOutput {
 		sortByValidationResultID(validationResults)
 	}
@@ -143,9 +154,44 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *clusterPreproce
 			}
 		}
 	}
+	// The state machine checks the user-defined validations together, after the ignored ones were forced to pass
+	userValidationsSucceeded := true
+	for _, v := range r.userValidations {
+		userValidationsSucceeded = userValidationsSucceeded && stateMachineInput[v.ID]
+	}
+	stateMachineInput[UserDefinedValidationsSucceeded.String()] = userValidationsSucceeded
 	return stateMachineInput, validationsOutput, nil
 }
 
+// evaluateUserValidations evaluates the validations that are defined by the administrator of the service
+func (r *refreshPreprocessor) evaluateUserValidations(c *clusterPreprocessContext) []ValidationResult {
+	if len(r.userValidations) == 0 {
+		return nil
+	}
+	input, inputErr := uservalidations.NewClusterInput(c.cluster)
+	results := make([]ValidationResult, 0, len(r.userValidations))
+	for _, v := range r.userValidations {
+		result := ValidationResult{ID: ValidationID(v.ID)}
+		if inputErr != nil {
+			result.Status, result.Message = ValidationError, "Failed to parse the inventories of the hosts"
+			results = append(results, result)
+			continue
+		}
+		passed, err := v.Evaluate(input)
+		switch {
+		case err != nil:
+			r.log.WithError(err).Warnf("Failed to evaluate user-defined validation %s for cluster %s", v.ID, c.clusterId)
+			result.Status, result.Message = ValidationError, fmt.Sprintf("Failed to evaluate the %s validation", v.ID)
+		case passed:
+			result.Status, result.Message = ValidationSuccess, v.SuccessMessage
+		default:
+			result.Status, result.Message = ValidationFailure, v.FailureMessage
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
 // recalculateOperatorDependencies calculates the operator dependencies and updates the database and the passed cluster
 // accordingly.
 func (r *refreshPreprocessor) recalculateOperatorDependencies(ctx context.Context, c *clusterPreprocessContext) error {
@@ -14,6 +14,7 @@ import (
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/usage"
+	"github.com/openshift/assisted-service/internal/uservalidations"
 	"github.com/openshift/assisted-service/models"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
@@ -48,6 +49,7 @@ var _ = Describe("Cluster Refresh Status Preprocessor", func() {
 			mockOperatorManager,
 			mockUsageApi,
 			nil,
+			nil,
 		)
 	})
 
@@ -199,6 +201,49 @@ var _ = Describe("Cluster Refresh Status Preprocessor", func() {
 		})
 	})
 
+	Context("User-defined validations", func() {
+		var validationContext *clusterPreprocessContext
+
+		BeforeEach(func() {
+			var userValidations uservalidations.Validations
+			Expect(userValidations.Decode(`[{"id": "masters-same-model", "target": "cluster",
+				"expression": "[.hosts[] | select(.host.role == \"master\") | .inventory.system_vendor.product_name] | unique | length <= 1",
+				"failure_message": "All masters must be the same model"}]`)).To(Succeed())
+			Expect(userValidations.Compile(logrus.New())).To(Succeed())
+			preprocessor.userValidations = userValidations.ForTarget(uservalidations.TargetCluster)
+			createCluster()
+			mockFailAllValidations()
+			mockNoChangeInOperatorDependencies()
+			mockOperatorValidationsSuccess()
+			validationContext = newClusterValidationContext(cluster, db)
+			validationContext.cluster.Hosts = []*models.Host{
+				{Role: models.HostRoleMaster, Inventory: `{"system_vendor": {"product_name": "R650"}}`},
+				{Role: models.HostRoleMaster, Inventory: `{"system_vendor": {"product_name": "R750"}}`},
+			}
+		})
+
+		AfterEach(func() {
+			deleteCluster()
+		})
+
+		It("reports the failed validation in its own category", func() {
+			conditions, validations, err := preprocessor.preprocess(ctx, validationContext)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(validations[uservalidations.Category]).To(Equal([]ValidationResult{
+				{ID: "masters-same-model", Status: ValidationFailure, Message: "All masters must be the same model"},
+			}))
+			Expect(conditions["masters-same-model"]).To(BeFalse())
+			Expect(conditions[UserDefinedValidationsSucceeded.String()]).To(BeFalse())
+		})
+
+		It("passes when the failing validation is ignored", func() {
+			validationContext.cluster.IgnoredClusterValidations = `["masters-same-model"]`
+			conditions, _, err := preprocessor.preprocess(ctx, validationContext)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conditions[UserDefinedValidationsSucceeded.String()]).To(BeTrue())
+		})
+	})
+
 	Context("Recalculate operator dependencies", func() {
 		var validationContext *clusterPreprocessContext
 
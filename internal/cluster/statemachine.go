@@ -148,6 +148,7 @@ func NewClusterStateMachine(th TransitionHandler) stateswitch.StateMachine {
 		If(AreMetallbRequirementsSatisfied),
 		If(IsLokiRequirementsSatisfied),
 		If(IsOpenShiftLoggingRequirementsSatisfied),
+		If(UserDefinedValidationsSucceeded),
 	)
 
 	// Refresh cluster status conditions - Non DHCP
@@ -0,0 +1,273 @@
+package clusterarchive
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/bminventory"
+	"github.com/openshift/assisted-service/internal/common"
+	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/cluster_archives"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	manifestsops "github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ restapi.ClusterArchivesAPI = &Api{}
+
+type Config struct {
+	// The PEM encoded RSA private key that the secrets of imported archives are encrypted to. Archives cannot be
+	// imported when it is not set.
+	PrivateKeyPEM string `envconfig:"CLUSTER_ARCHIVE_PRIVATE_KEY_PEM" default:""`
+}
+
+// Api exports clusters to archives, and registers clusters from archives through the same internal handlers that
+// serve the equivalent REST calls. The secrets of an archive are encrypted to the key of the service that imports
+// it, so they are never exposed in plaintext while the archive is transferred.
+type Api struct {
+	db        *gorm.DB
+	installer bminventory.InstallerInternals
+	manifests manifestsapi.ClusterManifestsInternals
+	revisions bminventory.RevisionRecorder
+	key       *rsa.PrivateKey
+	log       logrus.FieldLogger
+}
+
+func NewApi(db *gorm.DB, installer bminventory.InstallerInternals, manifests manifestsapi.ClusterManifestsInternals,
+	revisions bminventory.RevisionRecorder, config Config, log logrus.FieldLogger) (*Api, error) {
+	a := &Api{
+		db:        db,
+		installer: installer,
+		manifests: manifests,
+		revisions: revisions,
+		log:       log,
+	}
+	if config.PrivateKeyPEM != "" {
+		key, err := parsePrivateKey(config.PrivateKeyPEM)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid cluster archive private key")
+		}
+		a.key = key
+	}
+	return a, nil
+}
+
+func (a *Api) V2GetClusterArchiveEncryptionKey(ctx context.Context, params operations.V2GetClusterArchiveEncryptionKeyParams) middleware.Responder {
+	if a.key == nil {
+		return common.NewApiError(http.StatusNotImplemented, errors.New("no cluster archive key is configured"))
+	}
+	id, err := keyID(&a.key.PublicKey)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	publicKey, err := encodePublicKey(&a.key.PublicKey)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return operations.NewV2GetClusterArchiveEncryptionKeyOK().WithPayload(&models.ClusterArchiveEncryptionKey{
+		KeyID:     swag.String(id),
+		PublicKey: swag.String(publicKey),
+	})
+}
+
+func (a *Api) V2ExportCluster(ctx context.Context, params operations.V2ExportClusterParams) middleware.Responder {
+	archive, err := a.ExportClusterInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewV2ExportClusterOK().WithPayload(archive)
+}
+
+func (a *Api) ExportClusterInternal(ctx context.Context, params operations.V2ExportClusterParams) (*models.ClusterArchive, error) {
+	log := logutil.FromContext(ctx, a.log)
+	recipient, err := parsePublicKey(swag.StringValue(params.ExportParams.RecipientPublicKey))
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.Wrap(err, "invalid recipient public key"))
+	}
+	cluster, err := common.GetClusterFromDB(a.db, params.ClusterID, common.UseEagerLoading)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	archive := &models.ClusterArchive{
+		FormatVersion:      swag.Int64(FormatVersion),
+		ExportedAt:         strfmt.DateTime(time.Now()),
+		SourceClusterID:    cluster.ID,
+		InfraEnvs:          []*models.ClusterArchiveInfraEnv{},
+		Manifests:          []*models.CreateManifestParams{},
+		IgnoredValidations: exportIgnoredValidations(cluster),
+		UISettings:         cluster.UISettings,
+	}
+	secrets := &archiveSecrets{
+		PullSecret:   cluster.PullSecret,
+		SSHPublicKey: cluster.SSHPublicKey,
+		InfraEnvs:    map[strfmt.UUID]*infraEnvSecrets{},
+	}
+	if archive.Cluster, err = exportClusterParams(cluster); err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if archive.Hosts, err = exportHosts(cluster.Hosts); err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	var infraEnvs []*common.InfraEnv
+	if err = a.db.Where("cluster_id = ?", params.ClusterID.String()).Order("created_at").Find(&infraEnvs).Error; err != nil {
+		log.WithError(err).Errorf("failed to list the infra-envs of cluster %s", params.ClusterID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	for _, infraEnv := range infraEnvs {
+		infraEnvParams, err := exportInfraEnvParams(infraEnv)
+		if err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		archive.InfraEnvs = append(archive.InfraEnvs, &models.ClusterArchiveInfraEnv{SourceID: infraEnv.ID, Params: infraEnvParams})
+		secrets.InfraEnvs[*infraEnv.ID] = &infraEnvSecrets{
+			PullSecret:       infraEnv.PullSecret,
+			SSHAuthorizedKey: infraEnv.SSHAuthorizedKey,
+		}
+	}
+
+	manifests, err := a.manifests.ListClusterManifestsInternal(ctx, manifestsops.V2ListClusterManifestsParams{ClusterID: params.ClusterID})
+	if err != nil {
+		return nil, err
+	}
+	for _, manifest := range manifests {
+		content, err := a.manifests.GetClusterManifestContentInternal(ctx, params.ClusterID, manifest.Folder, manifest.FileName)
+		if err != nil {
+			return nil, err
+		}
+		archive.Manifests = append(archive.Manifests, &models.CreateManifestParams{
+			Folder:   swag.String(manifest.Folder),
+			FileName: swag.String(manifest.FileName),
+			Content:  swag.String(base64.StdEncoding.EncodeToString(content)),
+		})
+	}
+
+	secretsJSON, err := json.Marshal(secrets)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, errors.Wrap(err, "failed to encode the secrets"))
+	}
+	if archive.Secrets, err = seal(recipient, secretsJSON, []byte(params.ClusterID.String())); err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	log.Infof("Exported cluster %s with %d infra-envs and %d manifests", params.ClusterID, len(archive.InfraEnvs), len(archive.Manifests))
+	return archive, nil
+}
+
+func (a *Api) V2ImportClusterArchive(ctx context.Context, params operations.V2ImportClusterArchiveParams) middleware.Responder {
+	result, err := a.ImportClusterArchiveInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	a.revisions.RecordRevision(ctx, *result.Cluster.ID, models.ClusterRevisionReasonRegister)
+	return operations.NewV2ImportClusterArchiveCreated().WithPayload(result)
+}
+
+func (a *Api) ImportClusterArchiveInternal(ctx context.Context, params operations.V2ImportClusterArchiveParams) (*models.ClusterImportResult, error) {
+	log := logutil.FromContext(ctx, a.log)
+	archive := params.Archive
+	if a.key == nil {
+		return nil, common.NewApiError(http.StatusNotImplemented, errors.New("no cluster archive key is configured"))
+	}
+	if swag.Int64Value(archive.FormatVersion) != FormatVersion {
+		return nil, common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("unsupported archive format version %d, expected %d", swag.Int64Value(archive.FormatVersion), FormatVersion))
+	}
+	secretsJSON, err := open(a.key, archive.Secrets, []byte(archive.SourceClusterID.String()))
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	secrets := &archiveSecrets{}
+	if err = json.Unmarshal(secretsJSON, secrets); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.Wrap(err, "failed to decode the secrets"))
+	}
+	clusterParams, err := importClusterParams(archive, secrets)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	cluster, err := a.installer.RegisterClusterInternal(ctx, nil, nil, installer.V2RegisterClusterParams{NewClusterParams: clusterParams})
+	if err != nil {
+		return nil, err
+	}
+	result := &models.ClusterImportResult{
+		InfraEnvIds: map[string]strfmt.UUID{},
+		HostPlan:    &models.ClusterPlan{Hosts: archive.Hosts},
+	}
+	if err = a.importResources(ctx, cluster, archive, secrets, result); err != nil {
+		// The cluster is not usable without the resources of the archive
+		for _, infraEnvID := range result.InfraEnvIds {
+			if deregisterErr := a.installer.DeregisterInfraEnvInternal(ctx, installer.DeregisterInfraEnvParams{InfraEnvID: infraEnvID}); deregisterErr != nil {
+				log.WithError(deregisterErr).Errorf("failed to deregister infra-env %s after failing to import the archive of cluster %s",
+					infraEnvID, archive.SourceClusterID)
+			}
+		}
+		if deregisterErr := a.installer.DeregisterClusterInternal(ctx, cluster); deregisterErr != nil {
+			log.WithError(deregisterErr).Errorf("failed to deregister cluster %s after failing to import the archive of cluster %s",
+				cluster.ID, archive.SourceClusterID)
+		}
+		return nil, err
+	}
+
+	if cluster, err = a.installer.GetClusterInternal(ctx, installer.V2GetClusterParams{ClusterID: *cluster.ID}); err != nil {
+		return nil, err
+	}
+	result.Cluster = &cluster.Cluster
+	log.Infof("Imported the archive of cluster %s as cluster %s", archive.SourceClusterID, cluster.ID)
+	return result, nil
+}
+
+// importResources creates the infra-envs and the manifests of the archive in the imported cluster, and sets its
+// ignored validations and UI settings
+func (a *Api) importResources(ctx context.Context, cluster *common.Cluster, archive *models.ClusterArchive, secrets *archiveSecrets,
+	result *models.ClusterImportResult) error {
+	for _, archiveInfraEnv := range archive.InfraEnvs {
+		infraEnvParams, err := importInfraEnvParams(archiveInfraEnv, *cluster.ID, secrets)
+		if err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+		infraEnv, err := a.installer.RegisterInfraEnvInternal(ctx, nil, nil, installer.RegisterInfraEnvParams{InfraenvCreateParams: infraEnvParams})
+		if err != nil {
+			return err
+		}
+		result.InfraEnvIds[archiveInfraEnv.SourceID.String()] = *infraEnv.ID
+	}
+	for _, manifest := range archive.Manifests {
+		if _, err := a.manifests.CreateClusterManifestInternal(ctx, manifestsops.V2CreateClusterManifestParams{
+			ClusterID:            *cluster.ID,
+			CreateManifestParams: manifest,
+		}, true); err != nil {
+			return err
+		}
+	}
+	if archive.IgnoredValidations != nil {
+		if _, err := a.installer.SetIgnoredValidationsInternal(ctx, installer.V2SetIgnoredValidationsParams{
+			ClusterID:          *cluster.ID,
+			IgnoredValidations: archive.IgnoredValidations,
+		}); err != nil {
+			return err
+		}
+	}
+	if archive.UISettings != "" {
+		if err := a.db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).
+			Updates(map[string]interface{}{"ui_settings": archive.UISettings}).Error; err != nil {
+			return common.NewApiError(http.StatusInternalServerError, errors.Wrap(err, "failed to set the UI settings"))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,244 @@
+package clusterarchive
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/bminventory"
+	"github.com/openshift/assisted-service/internal/common"
+	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
+	"github.com/openshift/assisted-service/models"
+	operations "github.com/openshift/assisted-service/restapi/operations/cluster_archives"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	manifestsops "github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const testPullSecret = `{"auths":{"cloud.openshift.com":{"auth":"dG9rZW4="}}}`
+
+var _ = Describe("Cluster archive API", func() {
+	var (
+		ctx           = context.Background()
+		db            *gorm.DB
+		dbName        string
+		ctrl          *gomock.Controller
+		mockInstaller *bminventory.MockInstallerInternals
+		mockManifests *manifestsapi.MockClusterManifestsInternals
+		mockRevisions *bminventory.MockRevisionRecorder
+		api           *Api
+		publicKey     string
+		clusterID     strfmt.UUID
+		infraEnvID    strfmt.UUID
+	)
+
+	newApi := func(config Config) *Api {
+		a, err := NewApi(db, mockInstaller, mockManifests, mockRevisions, config, logrus.New())
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		return a
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockInstaller = bminventory.NewMockInstallerInternals(ctrl)
+		mockManifests = manifestsapi.NewMockClusterManifestsInternals(ctrl)
+		mockRevisions = bminventory.NewMockRevisionRecorder(ctrl)
+		api = newApi(Config{PrivateKeyPEM: generateKeyPEM(2048)})
+		reply := api.V2GetClusterArchiveEncryptionKey(ctx, operations.V2GetClusterArchiveEncryptionKeyParams{})
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2GetClusterArchiveEncryptionKeyOK()))
+		publicKey = swag.StringValue(reply.(*operations.V2GetClusterArchiveEncryptionKeyOK).Payload.PublicKey)
+
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{
+			Cluster: models.Cluster{
+				ID:               &clusterID,
+				Name:             "cluster",
+				OpenshiftVersion: "4.14",
+				BaseDNSDomain:    "example.com",
+				SSHPublicKey:     "ssh-rsa cluster",
+			},
+			PullSecret:             testPullSecret,
+			UISettings:             `{"page":"networking"}`,
+			IgnoredHostValidations: `["all"]`,
+		}).Error).ToNot(HaveOccurred())
+		Expect(db.Create(&common.InfraEnv{
+			InfraEnv: models.InfraEnv{
+				ID:               &infraEnvID,
+				ClusterID:        clusterID,
+				Name:             swag.String("infra-env"),
+				Type:             models.ImageTypeFullIso.Pointer(),
+				SSHAuthorizedKey: "ssh-rsa infra-env",
+			},
+			PullSecret: testPullSecret,
+		}).Error).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	export := func() *models.ClusterArchive {
+		mockManifests.EXPECT().ListClusterManifestsInternal(gomock.Any(), manifestsops.V2ListClusterManifestsParams{ClusterID: clusterID}).
+			Return(models.ListManifests{{Folder: "openshift", FileName: "a.yaml"}}, nil).Times(1)
+		mockManifests.EXPECT().GetClusterManifestContentInternal(gomock.Any(), clusterID, "openshift", "a.yaml").
+			Return([]byte("a: b"), nil).Times(1)
+		reply := api.V2ExportCluster(ctx, operations.V2ExportClusterParams{
+			ClusterID:    clusterID,
+			ExportParams: &models.ClusterExportParams{RecipientPublicKey: swag.String(publicKey)},
+		})
+		ExpectWithOffset(1, reply).To(BeAssignableToTypeOf(operations.NewV2ExportClusterOK()))
+		return reply.(*operations.V2ExportClusterOK).Payload
+	}
+
+	It("exports a cluster without plaintext secrets", func() {
+		archive := export()
+		Expect(archive.FormatVersion).To(Equal(swag.Int64(FormatVersion)))
+		Expect(archive.SourceClusterID).To(Equal(&clusterID))
+		Expect(archive.Cluster).To(HaveKeyWithValue("base_dns_domain", "example.com"))
+		Expect(archive.InfraEnvs).To(HaveLen(1))
+		Expect(archive.InfraEnvs[0].SourceID).To(Equal(&infraEnvID))
+		Expect(archive.Manifests).To(Equal([]*models.CreateManifestParams{{
+			Folder:   swag.String("openshift"),
+			FileName: swag.String("a.yaml"),
+			Content:  swag.String(base64.StdEncoding.EncodeToString([]byte("a: b"))),
+		}}))
+		Expect(archive.IgnoredValidations).To(Equal(&models.IgnoredValidations{ClusterValidationIds: "[]", HostValidationIds: `["all"]`}))
+		Expect(archive.UISettings).To(Equal(`{"page":"networking"}`))
+
+		archiveJSON, err := json.Marshal(archive)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(archiveJSON)).ToNot(ContainSubstring("dG9rZW4="))
+		Expect(string(archiveJSON)).ToNot(ContainSubstring("ssh-rsa"))
+	})
+
+	It("rejects an invalid recipient key", func() {
+		verifyApiError(api.V2ExportCluster(ctx, operations.V2ExportClusterParams{
+			ClusterID:    clusterID,
+			ExportParams: &models.ClusterExportParams{RecipientPublicKey: swag.String("key")},
+		}), http.StatusBadRequest)
+	})
+
+	It("fails to export a missing cluster", func() {
+		verifyApiError(api.V2ExportCluster(ctx, operations.V2ExportClusterParams{
+			ClusterID:    strfmt.UUID(uuid.New().String()),
+			ExportParams: &models.ClusterExportParams{RecipientPublicKey: swag.String(publicKey)},
+		}), http.StatusNotFound)
+	})
+
+	Context("import", func() {
+		var importedID strfmt.UUID
+
+		BeforeEach(func() {
+			importedID = strfmt.UUID(uuid.New().String())
+		})
+
+		expectRegisterCluster := func() {
+			mockInstaller.EXPECT().RegisterClusterInternal(gomock.Any(), nil, nil, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ *types.NamespacedName, _ *common.MirrorRegistryConfiguration, params installer.V2RegisterClusterParams) (*common.Cluster, error) {
+					Expect(params.NewClusterParams.Name).To(Equal(swag.String("cluster")))
+					Expect(params.NewClusterParams.PullSecret).To(Equal(swag.String(testPullSecret)))
+					Expect(params.NewClusterParams.SSHPublicKey).To(Equal("ssh-rsa cluster"))
+					cluster := &common.Cluster{Cluster: models.Cluster{ID: &importedID, Name: "cluster"}}
+					Expect(db.Create(cluster).Error).ToNot(HaveOccurred())
+					return cluster, nil
+				}).Times(1)
+		}
+
+		It("registers the cluster and its resources with new IDs", func() {
+			archive := export()
+			importedInfraEnvID := strfmt.UUID(uuid.New().String())
+			expectRegisterCluster()
+			mockInstaller.EXPECT().RegisterInfraEnvInternal(gomock.Any(), nil, nil, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ *types.NamespacedName, _ *common.MirrorRegistryConfiguration, params installer.RegisterInfraEnvParams) (*common.InfraEnv, error) {
+					Expect(params.InfraenvCreateParams.ClusterID).To(Equal(&importedID))
+					Expect(params.InfraenvCreateParams.PullSecret).To(Equal(swag.String(testPullSecret)))
+					Expect(params.InfraenvCreateParams.SSHAuthorizedKey).To(Equal(swag.String("ssh-rsa infra-env")))
+					return &common.InfraEnv{InfraEnv: models.InfraEnv{ID: &importedInfraEnvID}}, nil
+				}).Times(1)
+			mockManifests.EXPECT().CreateClusterManifestInternal(gomock.Any(), manifestsops.V2CreateClusterManifestParams{
+				ClusterID:            importedID,
+				CreateManifestParams: archive.Manifests[0],
+			}, true).Return(&models.Manifest{}, nil).Times(1)
+			mockInstaller.EXPECT().SetIgnoredValidationsInternal(gomock.Any(), installer.V2SetIgnoredValidationsParams{
+				ClusterID:          importedID,
+				IgnoredValidations: archive.IgnoredValidations,
+			}).Return(archive.IgnoredValidations, nil).Times(1)
+			mockInstaller.EXPECT().GetClusterInternal(gomock.Any(), installer.V2GetClusterParams{ClusterID: importedID}).
+				Return(&common.Cluster{Cluster: models.Cluster{ID: &importedID}}, nil).Times(1)
+			mockRevisions.EXPECT().RecordRevision(gomock.Any(), importedID, models.ClusterRevisionReasonRegister).Times(1)
+
+			reply := api.V2ImportClusterArchive(ctx, operations.V2ImportClusterArchiveParams{Archive: archive})
+			Expect(reply).To(BeAssignableToTypeOf(operations.NewV2ImportClusterArchiveCreated()))
+			result := reply.(*operations.V2ImportClusterArchiveCreated).Payload
+			Expect(result.Cluster.ID).To(Equal(&importedID))
+			Expect(result.InfraEnvIds).To(Equal(map[string]strfmt.UUID{infraEnvID.String(): importedInfraEnvID}))
+
+			cluster, err := common.GetClusterFromDB(db, importedID, common.SkipEagerLoading)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cluster.UISettings).To(Equal(`{"page":"networking"}`))
+		})
+
+		It("deregisters the cluster when its resources fail to be created", func() {
+			archive := export()
+			expectRegisterCluster()
+			mockInstaller.EXPECT().RegisterInfraEnvInternal(gomock.Any(), nil, nil, gomock.Any()).
+				Return(nil, common.NewApiError(http.StatusBadRequest, errors.New("invalid infra-env"))).Times(1)
+			mockInstaller.EXPECT().DeregisterClusterInternal(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, cluster *common.Cluster) error {
+					Expect(cluster.ID).To(Equal(&importedID))
+					return nil
+				}).Times(1)
+
+			verifyApiError(api.V2ImportClusterArchive(ctx, operations.V2ImportClusterArchiveParams{Archive: archive}),
+				http.StatusBadRequest)
+		})
+
+		It("rejects an archive that is encrypted to another key", func() {
+			archive := export()
+			other := newApi(Config{PrivateKeyPEM: generateKeyPEM(2048)})
+			verifyApiError(other.V2ImportClusterArchive(ctx, operations.V2ImportClusterArchiveParams{Archive: archive}),
+				http.StatusBadRequest)
+		})
+
+		It("rejects an archive with secrets of another archive", func() {
+			archive := export()
+			otherID := strfmt.UUID(uuid.New().String())
+			archive.SourceClusterID = &otherID
+			verifyApiError(api.V2ImportClusterArchive(ctx, operations.V2ImportClusterArchiveParams{Archive: archive}),
+				http.StatusBadRequest)
+		})
+
+		It("rejects an unsupported format version", func() {
+			archive := export()
+			archive.FormatVersion = swag.Int64(FormatVersion + 1)
+			verifyApiError(api.V2ImportClusterArchive(ctx, operations.V2ImportClusterArchiveParams{Archive: archive}),
+				http.StatusBadRequest)
+		})
+	})
+
+	It("does not import without a key", func() {
+		api = newApi(Config{})
+		verifyApiError(api.V2GetClusterArchiveEncryptionKey(ctx, operations.V2GetClusterArchiveEncryptionKeyParams{}),
+			http.StatusNotImplemented)
+		verifyApiError(api.V2ImportClusterArchive(ctx, operations.V2ImportClusterArchiveParams{Archive: &models.ClusterArchive{}}),
+			http.StatusNotImplemented)
+	})
+
+	It("fails on an invalid key", func() {
+		_, err := NewApi(db, mockInstaller, mockManifests, mockRevisions, Config{PrivateKeyPEM: "key"}, logrus.New())
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,250 @@
+package clusterarchive
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// FormatVersion is the version of the archives that are exported. It is increased when the format changes in a way
+// that older services cannot import.
+const FormatVersion int64 = 1
+
+// The secret properties of the params are never stored in plaintext in an archive, and the cluster of an
+// infra-env is set on import
+var (
+	clusterSecretFields  = []string{"pull_secret", "ssh_public_key"}
+	infraEnvSecretFields = []string{"pull_secret", "ssh_authorized_key", "cluster_id"}
+)
+
+// archiveSecrets holds the secrets of an archive, before they are encrypted
+type archiveSecrets struct {
+	PullSecret   string `json:"pull_secret"`
+	SSHPublicKey string `json:"ssh_public_key,omitempty"`
+	// InfraEnvs holds the secrets of the infra-envs, keyed by their ID in the exported cluster
+	InfraEnvs map[strfmt.UUID]*infraEnvSecrets `json:"infra_envs,omitempty"`
+}
+
+type infraEnvSecrets struct {
+	PullSecret       string `json:"pull_secret"`
+	SSHAuthorizedKey string `json:"ssh_authorized_key,omitempty"`
+}
+
+// exportClusterParams returns the cluster-create-params that register a cluster with the configuration of the
+// cluster, without its secrets
+func exportClusterParams(cluster *common.Cluster) (map[string]interface{}, error) {
+	clusterJSON, err := json.Marshal(cluster.Cluster)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to encode cluster %s", cluster.ID)
+	}
+	params := &models.ClusterCreateParams{}
+	if err = json.Unmarshal(clusterJSON, params); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode the params of cluster %s", cluster.ID)
+	}
+	// The legacy network fields are set from the network lists
+	params.ClusterNetworkCidr = nil
+	params.ClusterNetworkHostPrefix = 0
+	params.ServiceNetworkCidr = nil
+	// The release image is resolved from the OpenShift version by the importing service
+	params.OcpReleaseImage = ""
+	for _, network := range params.ClusterNetworks {
+		network.ClusterID = ""
+	}
+	for _, network := range params.ServiceNetworks {
+		network.ClusterID = ""
+	}
+	for _, network := range params.MachineNetworks {
+		network.ClusterID = ""
+	}
+	if swag.BoolValue(params.VipDhcpAllocation) {
+		// The VIPs were allocated by DHCP, and are allocated again for the imported cluster
+		params.APIVips = nil
+		params.IngressVips = nil
+	}
+	for _, vip := range params.APIVips {
+		vip.ClusterID = ""
+		vip.Verification = nil
+	}
+	for _, vip := range params.IngressVips {
+		vip.ClusterID = ""
+		vip.Verification = nil
+	}
+	params.OlmOperators = nil
+	for _, operator := range cluster.MonitoredOperators {
+		if operator.OperatorType == models.OperatorTypeOlm && !operator.DependencyOnly {
+			params.OlmOperators = append(params.OlmOperators, &models.OperatorCreateParams{Name: operator.Name, Properties: operator.Properties})
+		}
+	}
+	sort.Slice(params.OlmOperators, func(i, j int) bool { return params.OlmOperators[i].Name < params.OlmOperators[j].Name })
+	return toMap(params, clusterSecretFields)
+}
+
+// exportInfraEnvParams returns the infra-env-create-params that register an infra-env with the configuration of the
+// infra-env, without its secrets and its cluster
+func exportInfraEnvParams(infraEnv *common.InfraEnv) (map[string]interface{}, error) {
+	params := &models.InfraEnvCreateParams{
+		Name:                   infraEnv.Name,
+		AdditionalTrustBundle:  infraEnv.AdditionalTrustBundle,
+		CPUArchitecture:        infraEnv.CPUArchitecture,
+		IgnitionConfigOverride: infraEnv.IgnitionConfigOverride,
+		OpenshiftVersion:       infraEnv.OpenshiftVersion,
+		Proxy:                  infraEnv.Proxy,
+		RendezvousIP:           infraEnv.RendezvousIP,
+	}
+	if infraEnv.AdditionalNtpSources != "" {
+		params.AdditionalNtpSources = swag.String(infraEnv.AdditionalNtpSources)
+	}
+	if infraEnv.Type != nil {
+		params.ImageType = *infraEnv.Type
+	}
+	if infraEnv.StaticNetworkConfig != "" {
+		if err := json.Unmarshal([]byte(infraEnv.StaticNetworkConfig), &params.StaticNetworkConfig); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode the static network config of infra-env %s", infraEnv.ID)
+		}
+	}
+	if swag.StringValue(infraEnv.KernelArguments) != "" {
+		if err := json.Unmarshal([]byte(*infraEnv.KernelArguments), &params.KernelArguments); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode the kernel arguments of infra-env %s", infraEnv.ID)
+		}
+	}
+	return toMap(params, infraEnvSecretFields)
+}
+
+// exportHosts returns the properties that were set on the hosts of the cluster, identified by the MAC address of
+// their first interface. Hosts that did not report an inventory cannot be identified, and are skipped.
+func exportHosts(hosts []*models.Host) ([]*models.ClusterPlanHost, error) {
+	ret := make([]*models.ClusterPlanHost, 0, len(hosts))
+	for _, host := range hosts {
+		if host.Inventory == "" {
+			continue
+		}
+		inventory, err := common.UnmarshalInventory(host.Inventory)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse the inventory of host %s", host.ID)
+		}
+		var macAddress string
+		for _, iface := range inventory.Interfaces {
+			if iface.MacAddress != "" {
+				macAddress = strings.ToLower(iface.MacAddress)
+				break
+			}
+		}
+		if macAddress == "" {
+			continue
+		}
+		planHost := &models.ClusterPlanHost{MacAddress: swag.String(macAddress)}
+		if host.Role != "" {
+			planHost.HostRole = swag.String(string(host.Role))
+		}
+		if host.RequestedHostname != "" {
+			planHost.HostName = swag.String(host.RequestedHostname)
+		}
+		if host.InstallationDiskID != "" {
+			planHost.InstallationDiskID = swag.String(host.InstallationDiskID)
+		}
+		ret = append(ret, planHost)
+	}
+	sort.Slice(ret, func(i, j int) bool { return *ret[i].MacAddress < *ret[j].MacAddress })
+	return ret, nil
+}
+
+// exportIgnoredValidations returns the ignored validations of the cluster, or nil if no validation is ignored
+func exportIgnoredValidations(cluster *common.Cluster) *models.IgnoredValidations {
+	isEmpty := func(ids string) bool {
+		return ids == "" || ids == "[]"
+	}
+	if isEmpty(cluster.IgnoredClusterValidations) && isEmpty(cluster.IgnoredHostValidations) {
+		return nil
+	}
+	ret := &models.IgnoredValidations{ClusterValidationIds: "[]", HostValidationIds: "[]"}
+	if !isEmpty(cluster.IgnoredClusterValidations) {
+		ret.ClusterValidationIds = cluster.IgnoredClusterValidations
+	}
+	if !isEmpty(cluster.IgnoredHostValidations) {
+		ret.HostValidationIds = cluster.IgnoredHostValidations
+	}
+	return ret
+}
+
+// importClusterParams returns the params of the archive with the decrypted secrets
+func importClusterParams(archive *models.ClusterArchive, secrets *archiveSecrets) (*models.ClusterCreateParams, error) {
+	params := &models.ClusterCreateParams{}
+	if err := fromMap(archive.Cluster, params, clusterSecretFields); err != nil {
+		return nil, errors.Wrap(err, "invalid cluster params")
+	}
+	params.PullSecret = swag.String(secrets.PullSecret)
+	params.SSHPublicKey = secrets.SSHPublicKey
+	if err := params.Validate(strfmt.Default); err != nil {
+		return nil, errors.Wrap(err, "invalid cluster params")
+	}
+	return params, nil
+}
+
+// importInfraEnvParams returns the params of an infra-env of the archive, bound to the imported cluster
+func importInfraEnvParams(infraEnv *models.ClusterArchiveInfraEnv, clusterID strfmt.UUID, secrets *archiveSecrets) (*models.InfraEnvCreateParams, error) {
+	params := &models.InfraEnvCreateParams{}
+	if err := fromMap(infraEnv.Params, params, infraEnvSecretFields); err != nil {
+		return nil, errors.Wrapf(err, "invalid params of infra-env %s", infraEnv.SourceID)
+	}
+	infraEnvSecrets, ok := secrets.InfraEnvs[*infraEnv.SourceID]
+	if !ok {
+		return nil, errors.Errorf("the archive has no secrets for infra-env %s", infraEnv.SourceID)
+	}
+	params.ClusterID = &clusterID
+	params.PullSecret = swag.String(infraEnvSecrets.PullSecret)
+	if infraEnvSecrets.SSHAuthorizedKey != "" {
+		params.SSHAuthorizedKey = swag.String(infraEnvSecrets.SSHAuthorizedKey)
+	}
+	if err := params.Validate(strfmt.Default); err != nil {
+		return nil, errors.Wrapf(err, "invalid params of infra-env %s", infraEnv.SourceID)
+	}
+	return params, nil
+}
+
+// toMap encodes the params as an object without the excluded properties. Properties that are not set are omitted.
+func toMap(params interface{}, excluded []string) (map[string]interface{}, error) {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode the params")
+	}
+	var ret map[string]interface{}
+	if err = json.Unmarshal(data, &ret); err != nil {
+		return nil, errors.Wrap(err, "failed to decode the params")
+	}
+	for field, value := range ret {
+		if value == nil {
+			delete(ret, field)
+		}
+	}
+	for _, field := range excluded {
+		delete(ret, field)
+	}
+	return ret, nil
+}
+
+// fromMap decodes an object of the archive into the params, and rejects objects that set the excluded properties
+func fromMap(value interface{}, params interface{}, excluded []string) error {
+	values, ok := value.(map[string]interface{})
+	if !ok {
+		return errors.New("params must be an object")
+	}
+	for _, field := range excluded {
+		if _, ok = values[field]; ok {
+			return errors.Errorf("%s may not be set in the params of an archive", field)
+		}
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(params)
+}
@@ -0,0 +1,39 @@
+package clusterarchive
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/go-openapi/runtime/middleware"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+)
+
+func TestClusterArchive(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Cluster archive test Suite")
+}
+
+var _ = BeforeSuite(func() {
+	common.InitializeDBTest()
+})
+
+var _ = AfterSuite(func() {
+	common.TerminateDBTest()
+})
+
+func generateKeyPEM(bits int) string {
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+}
+
+func verifyApiError(responder middleware.Responder, expectedHttpStatus int32) {
+	ExpectWithOffset(1, responder).To(BeAssignableToTypeOf(common.NewApiError(expectedHttpStatus, nil)))
+	concreteError := responder.(*common.ApiErrorResponse)
+	ExpectWithOffset(1, concreteError.StatusCode()).To(Equal(expectedHttpStatus))
+}
@@ -0,0 +1,217 @@
+package clusterarchive
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Secrets encryption", func() {
+	var (
+		keyPEM    string
+		publicPEM string
+	)
+
+	BeforeEach(func() {
+		keyPEM = generateKeyPEM(2048)
+		key, err := parsePrivateKey(keyPEM)
+		Expect(err).ToNot(HaveOccurred())
+		publicPEM, err = encodePublicKey(&key.PublicKey)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	sealed := func(additionalData string) *models.ClusterArchiveSecrets {
+		recipient, err := parsePublicKey(publicPEM)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		secrets, err := seal(recipient, []byte("secret"), []byte(additionalData))
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		return secrets
+	}
+
+	It("opens sealed secrets", func() {
+		key, err := parsePrivateKey(keyPEM)
+		Expect(err).ToNot(HaveOccurred())
+		secrets := sealed("cluster")
+		Expect(string(*secrets.Ciphertext)).ToNot(ContainSubstring("secret"))
+		plaintext, err := open(key, secrets, []byte("cluster"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(plaintext)).To(Equal("secret"))
+	})
+
+	It("fails to open secrets of another archive", func() {
+		key, err := parsePrivateKey(keyPEM)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = open(key, sealed("cluster"), []byte("other"))
+		Expect(err).To(MatchError(ContainSubstring("failed to decrypt the secrets")))
+	})
+
+	It("fails to open secrets encrypted to another key", func() {
+		key, err := parsePrivateKey(generateKeyPEM(2048))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = open(key, sealed("cluster"), []byte("cluster"))
+		Expect(err).To(MatchError(ContainSubstring("and not to the key of this service")))
+	})
+
+	It("rejects small keys", func() {
+		_, err := parsePrivateKey(generateKeyPEM(1024))
+		Expect(err).To(MatchError(ContainSubstring("at least 2048 bits")))
+	})
+
+	It("rejects keys that are not RSA keys", func() {
+		ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		der, err := x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = parsePublicKey(string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})))
+		Expect(err).To(MatchError(ContainSubstring("not an RSA key")))
+		_, err = parsePublicKey("key")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Export", func() {
+	It("exports the cluster params without secrets", func() {
+		clusterID := strfmt.UUID("0d1c9d4f-4bbb-4c39-8b39-8b2f2a44f2a5")
+		params, err := exportClusterParams(&common.Cluster{
+			Cluster: models.Cluster{
+				ID:               &clusterID,
+				Name:             "cluster",
+				OpenshiftVersion: "4.14",
+				OcpReleaseImage:  "quay.io/openshift-release-dev/ocp-release:4.14.0-x86_64",
+				BaseDNSDomain:    "example.com",
+				SSHPublicKey:     "ssh-rsa key",
+				ClusterNetworks:  []*models.ClusterNetwork{{ClusterID: clusterID, Cidr: "10.128.0.0/14", HostPrefix: 23}},
+				APIVips:          []*models.APIVip{{ClusterID: clusterID, IP: "1.2.3.4", Verification: models.VipVerificationSucceeded.Pointer()}},
+				MonitoredOperators: []*models.MonitoredOperator{
+					{Name: "odf", OperatorType: models.OperatorTypeOlm},
+					{Name: "lso", OperatorType: models.OperatorTypeOlm, DependencyOnly: true},
+					{Name: "console", OperatorType: models.OperatorTypeBuiltin},
+				},
+			},
+			PullSecret: "secret",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(params).To(HaveKeyWithValue("name", "cluster"))
+		Expect(params).To(HaveKeyWithValue("base_dns_domain", "example.com"))
+		Expect(params).To(HaveKeyWithValue("cluster_networks", []interface{}{map[string]interface{}{"cidr": "10.128.0.0/14", "host_prefix": float64(23)}}))
+		Expect(params).To(HaveKeyWithValue("api_vips", []interface{}{map[string]interface{}{"ip": "1.2.3.4"}}))
+		Expect(params).To(HaveKeyWithValue("olm_operators", []interface{}{map[string]interface{}{"name": "odf"}}))
+		Expect(params).ToNot(HaveKey("pull_secret"))
+		Expect(params).ToNot(HaveKey("ssh_public_key"))
+		Expect(params).ToNot(HaveKey("ocp_release_image"))
+	})
+
+	It("exports the infra-env params without secrets", func() {
+		infraEnvID := strfmt.UUID("5b7b7b22-5e4c-4b2a-9e0a-3c0b7a1f8d11")
+		params, err := exportInfraEnvParams(&common.InfraEnv{
+			InfraEnv: models.InfraEnv{
+				ID:                  &infraEnvID,
+				ClusterID:           "0d1c9d4f-4bbb-4c39-8b39-8b2f2a44f2a5",
+				Name:                swag.String("infra-env"),
+				Type:                models.ImageTypeMinimalIso.Pointer(),
+				SSHAuthorizedKey:    "ssh-rsa key",
+				KernelArguments:     swag.String(`[{"operation":"append","value":"p1"}]`),
+				StaticNetworkConfig: `[{"network_yaml":"interfaces: []"}]`,
+			},
+			PullSecret: "secret",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(params).To(HaveKeyWithValue("name", "infra-env"))
+		Expect(params).To(HaveKeyWithValue("image_type", "minimal-iso"))
+		Expect(params).To(HaveKeyWithValue("kernel_arguments", []interface{}{map[string]interface{}{"operation": "append", "value": "p1"}}))
+		Expect(params).To(HaveKeyWithValue("static_network_config", []interface{}{map[string]interface{}{"network_yaml": "interfaces: []"}}))
+		Expect(params).ToNot(HaveKey("pull_secret"))
+		Expect(params).ToNot(HaveKey("ssh_authorized_key"))
+		Expect(params).ToNot(HaveKey("cluster_id"))
+	})
+
+	It("exports the hosts by MAC address", func() {
+		inventory, err := common.MarshalInventory(&models.Inventory{
+			Interfaces: []*models.Interface{{Name: "eth0", MacAddress: "52:54:00:AA:BB:CC"}, {Name: "eth1", MacAddress: "52:54:00:dd:ee:ff"}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		hosts, err := exportHosts([]*models.Host{
+			{Inventory: inventory, Role: models.HostRoleMaster, RequestedHostname: "master-0", InstallationDiskID: "/dev/sda"},
+			{Role: models.HostRoleWorker},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hosts).To(Equal([]*models.ClusterPlanHost{{
+			MacAddress:         swag.String("52:54:00:aa:bb:cc"),
+			HostRole:           swag.String(string(models.HostRoleMaster)),
+			HostName:           swag.String("master-0"),
+			InstallationDiskID: swag.String("/dev/sda"),
+		}}))
+	})
+
+	It("exports the ignored validations", func() {
+		Expect(exportIgnoredValidations(&common.Cluster{})).To(BeNil())
+		Expect(exportIgnoredValidations(&common.Cluster{IgnoredClusterValidations: "[]"})).To(BeNil())
+		Expect(exportIgnoredValidations(&common.Cluster{IgnoredHostValidations: `["all"]`})).To(Equal(
+			&models.IgnoredValidations{ClusterValidationIds: "[]", HostValidationIds: `["all"]`}))
+	})
+})
+
+var _ = Describe("Import", func() {
+	secrets := &archiveSecrets{
+		PullSecret:   "secret",
+		SSHPublicKey: "ssh-rsa key",
+		InfraEnvs: map[strfmt.UUID]*infraEnvSecrets{
+			"5b7b7b22-5e4c-4b2a-9e0a-3c0b7a1f8d11": {PullSecret: "infra-env secret"},
+		},
+	}
+
+	It("sets the secrets of the cluster", func() {
+		params, err := importClusterParams(&models.ClusterArchive{
+			Cluster: map[string]interface{}{"name": "cluster", "openshift_version": "4.14"},
+		}, secrets)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(params.Name).To(Equal(swag.String("cluster")))
+		Expect(params.PullSecret).To(Equal(swag.String("secret")))
+		Expect(params.SSHPublicKey).To(Equal("ssh-rsa key"))
+	})
+
+	It("binds the infra-envs to the cluster", func() {
+		sourceID := strfmt.UUID("5b7b7b22-5e4c-4b2a-9e0a-3c0b7a1f8d11")
+		clusterID := strfmt.UUID("0d1c9d4f-4bbb-4c39-8b39-8b2f2a44f2a5")
+		params, err := importInfraEnvParams(&models.ClusterArchiveInfraEnv{
+			SourceID: &sourceID,
+			Params:   map[string]interface{}{"name": "infra-env"},
+		}, clusterID, secrets)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(params.ClusterID).To(Equal(&clusterID))
+		Expect(params.PullSecret).To(Equal(swag.String("infra-env secret")))
+		Expect(params.SSHAuthorizedKey).To(BeNil())
+	})
+
+	It("fails on an infra-env without secrets", func() {
+		sourceID := strfmt.UUID("2f0f3a7e-9b1d-4c55-a0e4-1a9d52b0c6e3")
+		_, err := importInfraEnvParams(&models.ClusterArchiveInfraEnv{
+			SourceID: &sourceID,
+			Params:   map[string]interface{}{"name": "infra-env"},
+		}, "0d1c9d4f-4bbb-4c39-8b39-8b2f2a44f2a5", secrets)
+		Expect(err).To(MatchError(ContainSubstring("has no secrets for infra-env")))
+	})
+
+	DescribeTable("rejects invalid cluster params",
+		func(cluster interface{}, message string) {
+			_, err := importClusterParams(&models.ClusterArchive{Cluster: cluster}, secrets)
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("params that are not an object", []interface{}{}, "params must be an object"),
+		Entry("plaintext pull secret", map[string]interface{}{"name": "cluster", "openshift_version": "4.14", "pull_secret": "secret"},
+			"pull_secret may not be set"),
+		Entry("unknown property", map[string]interface{}{"name": "cluster", "openshift_version": "4.14", "unknown": "a"},
+			"unknown field"),
+		Entry("missing name", map[string]interface{}{"openshift_version": "4.14"}, "name"),
+	)
+})
@@ -0,0 +1,162 @@
+package clusterarchive
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// minKeyBits is the minimal size of the RSA keys that archives are encrypted to
+const minKeyBits = 2048
+
+// dataKeySize is the size of the AES-256 key that encrypts the secrets of an archive
+const dataKeySize = 32
+
+func parsePrivateKey(keyPEM string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(keyPEM))
+	if block == nil {
+		return nil, errors.New("failed to decode the PEM encoded private key")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, checkKeySize(&key.PublicKey)
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the private key")
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("the private key is not an RSA key")
+	}
+	return key, checkKeySize(&key.PublicKey)
+}
+
+func parsePublicKey(keyPEM string) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode([]byte(keyPEM))
+	if block == nil {
+		return nil, errors.New("failed to decode the PEM encoded public key")
+	}
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return key, checkKeySize(key)
+	}
+	parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the public key")
+	}
+	key, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("the public key is not an RSA key")
+	}
+	return key, checkKeySize(key)
+}
+
+func checkKeySize(key *rsa.PublicKey) error {
+	if key.N.BitLen() < minKeyBits {
+		return errors.Errorf("RSA keys must have at least %d bits, the key has %d", minKeyBits, key.N.BitLen())
+	}
+	return nil
+}
+
+func encodePublicKey(key *rsa.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode the public key")
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})), nil
+}
+
+// keyID is the SHA-256 fingerprint of the public key, so that archives encrypted to another key are rejected with
+// a clear error rather than failing to decrypt
+func keyID(key *rsa.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode the public key")
+	}
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// seal encrypts the plaintext with a random AES-256-GCM key, and encrypts the key to the recipient with RSA-OAEP.
+// The additional data is authenticated but not encrypted, and must be the same when the secrets are opened.
+func seal(recipient *rsa.PublicKey, plaintext []byte, additionalData []byte) (*models.ClusterArchiveSecrets, error) {
+	id, err := keyID(recipient)
+	if err != nil {
+		return nil, err
+	}
+	dataKey := make([]byte, dataKeySize)
+	if _, err = rand.Read(dataKey); err != nil {
+		return nil, errors.Wrap(err, "failed to generate the data key")
+	}
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "failed to generate the nonce")
+	}
+	encryptedKey, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, recipient, dataKey, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encrypt the data key")
+	}
+
+	encryptedKeyB64 := strfmt.Base64(encryptedKey)
+	nonceB64 := strfmt.Base64(nonce)
+	ciphertext := strfmt.Base64(gcm.Seal(nil, nonce, plaintext, additionalData))
+	return &models.ClusterArchiveSecrets{
+		KeyID:        swag.String(id),
+		EncryptedKey: &encryptedKeyB64,
+		Nonce:        &nonceB64,
+		Ciphertext:   &ciphertext,
+	}, nil
+}
+
+// open decrypts secrets that were sealed to the public key of the private key
+func open(key *rsa.PrivateKey, secrets *models.ClusterArchiveSecrets, additionalData []byte) ([]byte, error) {
+	id, err := keyID(&key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if swag.StringValue(secrets.KeyID) != id {
+		return nil, errors.Errorf("the secrets are encrypted to key %s, and not to the key of this service (%s)",
+			swag.StringValue(secrets.KeyID), id)
+	}
+	dataKey, err := rsa.DecryptOAEP(sha256.New(), nil, key, *secrets.EncryptedKey, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt the data key")
+	}
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(*secrets.Nonce) != gcm.NonceSize() {
+		return nil, errors.Errorf("the nonce must have %d bytes", gcm.NonceSize())
+	}
+	plaintext, err := gcm.Open(nil, *secrets.Nonce, *secrets.Ciphertext, additionalData)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt the secrets")
+	}
+	return plaintext, nil
+}
+
+func newGCM(dataKey []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the cipher")
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the cipher")
+	}
+	return gcm, nil
+}
@@ -0,0 +1,482 @@
+package clusterplan
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/bminventory"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/cluster_plan"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	manifestsops "github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ restapi.ClusterPlanAPI = &Api{}
+
+// infraEnvFieldNames maps the infra-env update params that are named differently in the infra-env
+var infraEnvFieldNames = map[string]string{
+	"image_type": "type",
+}
+
+// Api computes the changes described by a cluster plan, and applies them through the same internal handlers
+// that serve the equivalent REST calls, so a plan is subject to the same validations. Applying is not atomic,
+// but since only the changes are applied, a plan that partially failed can be applied again.
+type Api struct {
+	db        *gorm.DB
+	installer bminventory.InstallerInternals
+	manifests manifestsapi.ClusterManifestsInternals
+	revisions bminventory.RevisionRecorder
+	log       logrus.FieldLogger
+}
+
+func NewApi(db *gorm.DB, installer bminventory.InstallerInternals, manifests manifestsapi.ClusterManifestsInternals,
+	revisions bminventory.RevisionRecorder, log logrus.FieldLogger) *Api {
+	return &Api{
+		db:        db,
+		installer: installer,
+		manifests: manifests,
+		revisions: revisions,
+		log:       log,
+	}
+}
+
+// step holds the changes of a single resource kind, and applies them
+type step struct {
+	changes []*models.ClusterPlanChange
+	apply   func(ctx context.Context) error
+}
+
+func (a *Api) V2ApplyClusterPlan(ctx context.Context, params operations.V2ApplyClusterPlanParams) middleware.Responder {
+	result, err := a.ApplyClusterPlanInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	if swag.BoolValue(result.Applied) {
+		a.revisions.RecordRevision(ctx, params.ClusterID, models.ClusterRevisionReasonPlanApply)
+	}
+	return operations.NewV2ApplyClusterPlanOK().WithPayload(result)
+}
+
+func (a *Api) ApplyClusterPlanInternal(ctx context.Context, params operations.V2ApplyClusterPlanParams) (*models.ClusterPlanResult, error) {
+	log := logutil.FromContext(ctx, a.log)
+	plan := params.Plan
+	if plan == nil {
+		plan = &models.ClusterPlan{}
+	}
+	if plan.Operators != nil && plan.Cluster != nil && plan.Cluster.OlmOperators != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.New("operators may not be set both in the plan and in its cluster params"))
+	}
+
+	cluster, err := common.GetClusterFromDB(a.db, params.ClusterID, common.UseEagerLoading)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	steps := make([]*step, 0)
+	for _, planStep := range []func(context.Context, *common.Cluster, *models.ClusterPlan) (*step, error){
+		a.planInfraEnv,
+		a.planCluster,
+		a.planManifests,
+		a.planHosts,
+		a.planInstall,
+	} {
+		s, err := planStep(ctx, cluster, plan)
+		if err != nil {
+			return nil, err
+		}
+		if s != nil && len(s.changes) > 0 {
+			steps = append(steps, s)
+		}
+	}
+
+	result := &models.ClusterPlanResult{
+		Changes: make([]*models.ClusterPlanChange, 0),
+		Applied: swag.Bool(false),
+		Cluster: &cluster.Cluster,
+	}
+	for _, s := range steps {
+		result.Changes = append(result.Changes, s.changes...)
+	}
+	if swag.BoolValue(params.DryRun) || len(steps) == 0 {
+		return result, nil
+	}
+
+	log.Infof("applying %d changes of the plan of cluster %s", len(result.Changes), params.ClusterID)
+	for _, s := range steps {
+		if err = s.apply(ctx); err != nil {
+			log.WithError(err).Errorf("failed to apply the plan of cluster %s", params.ClusterID)
+			return nil, err
+		}
+	}
+	if cluster, err = a.installer.GetClusterInternal(ctx, installer.V2GetClusterParams{ClusterID: params.ClusterID}); err != nil {
+		return nil, err
+	}
+	result.Applied = swag.Bool(true)
+	result.Cluster = &cluster.Cluster
+	return result, nil
+}
+
+func (a *Api) planInfraEnv(ctx context.Context, cluster *common.Cluster, plan *models.ClusterPlan) (*step, error) {
+	if plan.InfraEnv == nil {
+		return nil, nil
+	}
+	infraEnv, err := a.planInfraEnvOf(cluster, plan.InfraEnvID)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := diffFields(models.ClusterPlanChangeResourceInfraEnv, infraEnv.ID.String(), plan.InfraEnv, infraEnv, infraEnvFieldNames)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return &step{
+		changes: changes,
+		apply: func(ctx context.Context) error {
+			updateParams := &models.InfraEnvUpdateParams{}
+			if err := selectFields(plan.InfraEnv, changedFields(changes), updateParams); err != nil {
+				return common.NewApiError(http.StatusInternalServerError, err)
+			}
+			_, err := a.installer.UpdateInfraEnvInternal(ctx, installer.UpdateInfraEnvParams{
+				InfraEnvID:           *infraEnv.ID,
+				InfraEnvUpdateParams: updateParams,
+			}, nil, nil)
+			return err
+		},
+	}, nil
+}
+
+// planInfraEnvOf returns the infra-env the plan applies to, which defaults to the single infra-env of the cluster
+func (a *Api) planInfraEnvOf(cluster *common.Cluster, infraEnvID *strfmt.UUID) (*common.InfraEnv, error) {
+	if infraEnvID != nil {
+		infraEnv, err := common.GetInfraEnvFromDB(a.db, *infraEnvID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, common.NewApiError(http.StatusNotFound, err)
+			}
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if infraEnv.ClusterID != *cluster.ID {
+			return nil, common.NewApiError(http.StatusBadRequest,
+				errors.Errorf("infra-env %s does not belong to cluster %s", infraEnvID, cluster.ID))
+		}
+		return infraEnv, nil
+	}
+
+	infraEnvs, err := common.GetInfraEnvsFromDBWhere(a.db, "cluster_id = ?", cluster.ID.String())
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	switch len(infraEnvs) {
+	case 0:
+		return nil, common.NewApiError(http.StatusConflict, errors.Errorf("cluster %s has no infra-env", cluster.ID))
+	case 1:
+		return infraEnvs[0], nil
+	default:
+		return nil, common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("cluster %s has %d infra-envs, infra_env_id must be set", cluster.ID, len(infraEnvs)))
+	}
+}
+
+func (a *Api) planCluster(ctx context.Context, cluster *common.Cluster, plan *models.ClusterPlan) (*step, error) {
+	var changes []*models.ClusterPlanChange
+	var err error
+	desiredOperators := plan.Operators
+	if plan.Cluster != nil {
+		if desiredOperators == nil {
+			desiredOperators = plan.Cluster.OlmOperators
+		}
+		clusterParams := *plan.Cluster
+		clusterParams.OlmOperators = nil
+		if changes, err = diffFields(models.ClusterPlanChangeResourceCluster, cluster.ID.String(), &clusterParams, cluster, nil); err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+	operatorChanges := diffOperators(cluster, desiredOperators)
+
+	return &step{
+		changes: append(changes, operatorChanges...),
+		apply: func(ctx context.Context) error {
+			updateParams := &models.V2ClusterUpdateParams{}
+			if plan.Cluster != nil {
+				if err := selectFields(plan.Cluster, changedFields(changes), updateParams); err != nil {
+					return common.NewApiError(http.StatusInternalServerError, err)
+				}
+			}
+			if len(operatorChanges) > 0 {
+				updateParams.OlmOperators = desiredOperators
+			}
+			_, err := a.installer.UpdateClusterInteractive(ctx, installer.V2UpdateClusterParams{
+				ClusterID:           *cluster.ID,
+				ClusterUpdateParams: updateParams,
+			})
+			return err
+		},
+	}, nil
+}
+
+// diffOperators compares the desired OLM operators with the ones of the cluster that were not only installed as
+// a dependency of another operator
+func diffOperators(cluster *common.Cluster, desired []*models.OperatorCreateParams) []*models.ClusterPlanChange {
+	if desired == nil {
+		return nil
+	}
+	current := make(map[string]string)
+	for _, operator := range cluster.MonitoredOperators {
+		if operator.OperatorType == models.OperatorTypeOlm && !operator.DependencyOnly {
+			current[operator.Name] = operator.Properties
+		}
+	}
+
+	var changes []*models.ClusterPlanChange
+	desiredNames := make(map[string]bool)
+	for _, operator := range desired {
+		name := operator.Name
+		// ocs was renamed to odf
+		if name == "ocs" {
+			name = "odf"
+		}
+		desiredNames[name] = true
+		properties, exists := current[name]
+		switch {
+		case !exists:
+			changes = append(changes, newChange(models.ClusterPlanChangeResourceOperator, name, "properties",
+				models.ClusterPlanChangeActionCreate, nil, operator.Properties))
+		case properties != operator.Properties:
+			changes = append(changes, newChange(models.ClusterPlanChangeResourceOperator, name, "properties",
+				models.ClusterPlanChangeActionUpdate, properties, operator.Properties))
+		}
+	}
+
+	removed := make([]string, 0)
+	for name := range current {
+		if !desiredNames[name] {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(removed)
+	for _, name := range removed {
+		changes = append(changes, newChange(models.ClusterPlanChangeResourceOperator, name, "",
+			models.ClusterPlanChangeActionDelete, nil, nil))
+	}
+	return changes
+}
+
+func (a *Api) planManifests(ctx context.Context, cluster *common.Cluster, plan *models.ClusterPlan) (*step, error) {
+	if plan.Manifests == nil && !swag.BoolValue(plan.PruneManifests) {
+		return nil, nil
+	}
+	currentManifests, err := a.manifests.ListClusterManifestsInternal(ctx, manifestsops.V2ListClusterManifestsParams{ClusterID: *cluster.ID})
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[string]*models.Manifest, len(currentManifests))
+	for _, manifest := range currentManifests {
+		current[filepath.Join(manifest.Folder, manifest.FileName)] = manifest
+	}
+
+	s := &step{}
+	var applies []func(context.Context) error
+	desiredPaths := make(map[string]bool)
+	for _, manifest := range plan.Manifests {
+		folder := swag.StringValue(manifest.Folder)
+		if folder == "" {
+			folder = models.CreateManifestParamsFolderManifests
+		}
+		fileName := swag.StringValue(manifest.FileName)
+		path := filepath.Join(folder, fileName)
+		desiredPaths[path] = true
+		content, err := base64.StdEncoding.DecodeString(swag.StringValue(manifest.Content))
+		if err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, errors.Wrapf(err, "failed to decode the content of manifest %s", path))
+		}
+
+		if _, exists := current[path]; !exists {
+			s.changes = append(s.changes, newChange(models.ClusterPlanChangeResourceManifest, path, "content",
+				models.ClusterPlanChangeActionCreate, nil, nil))
+			createParams := &models.CreateManifestParams{Folder: swag.String(folder), FileName: manifest.FileName, Content: manifest.Content}
+			applies = append(applies, func(ctx context.Context) error {
+				_, err := a.manifests.CreateClusterManifestInternal(ctx, manifestsops.V2CreateClusterManifestParams{
+					ClusterID:            *cluster.ID,
+					CreateManifestParams: createParams,
+				}, true)
+				return err
+			})
+			continue
+		}
+
+		currentContent, err := a.manifests.GetClusterManifestContentInternal(ctx, *cluster.ID, folder, fileName)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(currentContent, content) {
+			continue
+		}
+		s.changes = append(s.changes, newChange(models.ClusterPlanChangeResourceManifest, path, "content",
+			models.ClusterPlanChangeActionUpdate, nil, nil))
+		updateParams := &models.UpdateManifestParams{Folder: folder, FileName: fileName, UpdatedContent: manifest.Content}
+		applies = append(applies, func(ctx context.Context) error {
+			_, err := a.manifests.UpdateClusterManifestInternal(ctx, manifestsops.V2UpdateClusterManifestParams{
+				ClusterID:            *cluster.ID,
+				UpdateManifestParams: updateParams,
+			})
+			return err
+		})
+	}
+
+	if swag.BoolValue(plan.PruneManifests) {
+		for _, manifest := range currentManifests {
+			path := filepath.Join(manifest.Folder, manifest.FileName)
+			if desiredPaths[path] {
+				continue
+			}
+			s.changes = append(s.changes, newChange(models.ClusterPlanChangeResourceManifest, path, "",
+				models.ClusterPlanChangeActionDelete, nil, nil))
+			deleteParams := manifestsops.V2DeleteClusterManifestParams{
+				ClusterID: *cluster.ID,
+				Folder:    swag.String(manifest.Folder),
+				FileName:  manifest.FileName,
+			}
+			applies = append(applies, func(ctx context.Context) error {
+				return a.manifests.DeleteClusterManifestInternal(ctx, deleteParams)
+			})
+		}
+	}
+
+	s.apply = func(ctx context.Context) error {
+		for _, apply := range applies {
+			if err := apply(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return s, nil
+}
+
+func (a *Api) planHosts(ctx context.Context, cluster *common.Cluster, plan *models.ClusterPlan) (*step, error) {
+	if len(plan.Hosts) == 0 {
+		return nil, nil
+	}
+	hostsByMac, err := hostsByMacAddress(cluster.Hosts)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	s := &step{}
+	var updates []installer.V2UpdateHostParams
+	for _, planHost := range plan.Hosts {
+		host, ok := hostsByMac[normalizeMacAddress(swag.StringValue(planHost.MacAddress))]
+		if !ok {
+			return nil, common.NewApiError(http.StatusConflict,
+				errors.Errorf("no host with MAC address %s is bound to cluster %s", swag.StringValue(planHost.MacAddress), cluster.ID))
+		}
+		hostID := host.ID.String()
+		updateParams := &models.HostUpdateParams{}
+		changed := false
+
+		if planHost.HostRole != nil && *planHost.HostRole != string(host.Role) {
+			s.changes = append(s.changes, newChange(models.ClusterPlanChangeResourceHost, hostID, "host_role",
+				models.ClusterPlanChangeActionUpdate, string(host.Role), *planHost.HostRole))
+			updateParams.HostRole = planHost.HostRole
+			changed = true
+		}
+		if planHost.HostName != nil {
+			// The host name is reported by the agent until it is set explicitly
+			hostName, _ := hostutil.GetCurrentHostName(&host.Host)
+			if *planHost.HostName != hostName {
+				s.changes = append(s.changes, newChange(models.ClusterPlanChangeResourceHost, hostID, "host_name",
+					models.ClusterPlanChangeActionUpdate, hostName, *planHost.HostName))
+				updateParams.HostName = planHost.HostName
+				changed = true
+			}
+		}
+		if planHost.InstallationDiskID != nil && *planHost.InstallationDiskID != host.InstallationDiskID {
+			s.changes = append(s.changes, newChange(models.ClusterPlanChangeResourceHost, hostID, "installation_disk_id",
+				models.ClusterPlanChangeActionUpdate, host.InstallationDiskID, *planHost.InstallationDiskID))
+			updateParams.DisksSelectedConfig = []*models.DiskConfigParams{
+				{ID: planHost.InstallationDiskID, Role: models.DiskRoleInstall},
+			}
+			changed = true
+		}
+		if changed {
+			updates = append(updates, installer.V2UpdateHostParams{
+				InfraEnvID:       host.InfraEnvID,
+				HostID:           *host.ID,
+				HostUpdateParams: updateParams,
+			})
+		}
+	}
+
+	s.apply = func(ctx context.Context) error {
+		for _, update := range updates {
+			if _, err := a.installer.V2UpdateHostInternal(ctx, update, bminventory.Interactive); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return s, nil
+}
+
+func hostsByMacAddress(hosts []*models.Host) (map[string]*common.Host, error) {
+	ret := make(map[string]*common.Host)
+	for _, host := range hosts {
+		if host.Inventory == "" {
+			continue
+		}
+		inventory, err := common.UnmarshalInventory(host.Inventory)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse the inventory of host %s", host.ID)
+		}
+		for _, iface := range inventory.Interfaces {
+			if iface.MacAddress != "" {
+				ret[normalizeMacAddress(iface.MacAddress)] = &common.Host{Host: *host}
+			}
+		}
+	}
+	return ret, nil
+}
+
+func normalizeMacAddress(macAddress string) string {
+	return strings.ToLower(strings.ReplaceAll(macAddress, "-", ":"))
+}
+
+func (a *Api) planInstall(ctx context.Context, cluster *common.Cluster, plan *models.ClusterPlan) (*step, error) {
+	if !swag.BoolValue(plan.Install) {
+		return nil, nil
+	}
+	status := swag.StringValue(cluster.Status)
+	switch status {
+	case models.ClusterStatusPreparingForInstallation, models.ClusterStatusInstalling, models.ClusterStatusInstallingPendingUserAction,
+		models.ClusterStatusFinalizing, models.ClusterStatusInstalled, models.ClusterStatusAddingHosts:
+		return nil, nil
+	}
+	return &step{
+		changes: []*models.ClusterPlanChange{
+			newChange(models.ClusterPlanChangeResourceCluster, cluster.ID.String(), "status",
+				models.ClusterPlanChangeActionInstall, status, nil),
+		},
+		apply: func(ctx context.Context) error {
+			_, err := a.installer.InstallClusterInternal(ctx, installer.V2InstallClusterParams{ClusterID: *cluster.ID})
+			return err
+		},
+	}, nil
+}
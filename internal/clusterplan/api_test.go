@@ -0,0 +1,372 @@
+package clusterplan
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/bminventory"
+	"github.com/openshift/assisted-service/internal/common"
+	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
+	"github.com/openshift/assisted-service/models"
+	operations "github.com/openshift/assisted-service/restapi/operations/cluster_plan"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	manifestsops "github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Cluster plan", func() {
+	var (
+		ctx           = context.Background()
+		db            *gorm.DB
+		dbName        string
+		ctrl          *gomock.Controller
+		mockInstaller *bminventory.MockInstallerInternals
+		mockManifests *manifestsapi.MockClusterManifestsInternals
+		mockRevisions *bminventory.MockRevisionRecorder
+		api           *Api
+		clusterID     strfmt.UUID
+		infraEnvID    strfmt.UUID
+		hostID        strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockInstaller = bminventory.NewMockInstallerInternals(ctrl)
+		mockManifests = manifestsapi.NewMockClusterManifestsInternals(ctrl)
+		mockRevisions = bminventory.NewMockRevisionRecorder(ctrl)
+		api = NewApi(db, mockInstaller, mockManifests, mockRevisions, logrus.New())
+
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		cluster := &common.Cluster{
+			Cluster: models.Cluster{
+				ID:                 &clusterID,
+				Name:               "cluster",
+				OpenshiftVersion:   "4.14",
+				BaseDNSDomain:      "example.com",
+				Status:             swag.String(models.ClusterStatusReady),
+				SchedulableMasters: swag.Bool(false),
+				MonitoredOperators: []*models.MonitoredOperator{
+					{Name: "odf", OperatorType: models.OperatorTypeOlm},
+					{Name: "lso", OperatorType: models.OperatorTypeOlm, DependencyOnly: true},
+					{Name: "console", OperatorType: models.OperatorTypeBuiltin},
+				},
+			},
+			PullSecret: "secret",
+		}
+		Expect(db.Create(cluster).Error).ToNot(HaveOccurred())
+		infraEnv := &common.InfraEnv{InfraEnv: models.InfraEnv{
+			ID:        &infraEnvID,
+			ClusterID: clusterID,
+			Type:      models.ImageTypeFullIso.Pointer(),
+		}}
+		Expect(db.Create(infraEnv).Error).ToNot(HaveOccurred())
+		inventory, err := common.MarshalInventory(&models.Inventory{
+			Hostname:   "host-0",
+			Interfaces: []*models.Interface{{Name: "eth0", MacAddress: "52:54:00:aa:bb:cc"}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		host := &common.Host{Host: models.Host{
+			ID:                 &hostID,
+			InfraEnvID:         infraEnvID,
+			ClusterID:          &clusterID,
+			Status:             swag.String(models.HostStatusKnown),
+			Role:               models.HostRoleAutoAssign,
+			Inventory:          inventory,
+			InstallationDiskID: "/dev/disk/by-id/disk-0",
+		}}
+		Expect(db.Create(host).Error).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	apply := func(plan *models.ClusterPlan, dryRun bool) *models.ClusterPlanResult {
+		reply := api.V2ApplyClusterPlan(ctx, operations.V2ApplyClusterPlanParams{ClusterID: clusterID, DryRun: swag.Bool(dryRun), Plan: plan})
+		ExpectWithOffset(1, reply).To(BeAssignableToTypeOf(operations.NewV2ApplyClusterPlanOK()))
+		return reply.(*operations.V2ApplyClusterPlanOK).Payload
+	}
+
+	changedFieldsOf := func(result *models.ClusterPlanResult, resource string) []string {
+		fields := []string{}
+		for _, change := range result.Changes {
+			if swag.StringValue(change.Resource) == resource {
+				fields = append(fields, change.Field)
+			}
+		}
+		return fields
+	}
+
+	expectGetCluster := func() {
+		mockInstaller.EXPECT().GetClusterInternal(gomock.Any(), installer.V2GetClusterParams{ClusterID: clusterID}).
+			Return(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}, nil).Times(1)
+		mockRevisions.EXPECT().RecordRevision(gomock.Any(), clusterID, models.ClusterRevisionReasonPlanApply).Times(1)
+	}
+
+	It("computes the changes of the cluster without applying them on dry run", func() {
+		result := apply(&models.ClusterPlan{Cluster: &models.V2ClusterUpdateParams{
+			Name:               swag.String("cluster"),
+			BaseDNSDomain:      swag.String("example.org"),
+			SchedulableMasters: swag.Bool(true),
+			PullSecret:         swag.String("other secret"),
+		}}, true)
+		Expect(result.Applied).To(Equal(swag.Bool(false)))
+		Expect(changedFieldsOf(result, models.ClusterPlanChangeResourceCluster)).To(Equal([]string{"base_dns_domain", "pull_secret", "schedulable_masters"}))
+		Expect(result.Changes[0].Current).To(Equal("example.com"))
+		Expect(result.Changes[0].Desired).To(Equal("example.org"))
+		Expect(result.Changes[1].Current).To(BeNil())
+		Expect(result.Changes[1].Desired).To(BeNil())
+	})
+
+	It("applies only the changed cluster fields", func() {
+		mockInstaller.EXPECT().UpdateClusterInteractive(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, params installer.V2UpdateClusterParams) (*common.Cluster, error) {
+				Expect(params.ClusterID).To(Equal(clusterID))
+				Expect(params.ClusterUpdateParams).To(Equal(&models.V2ClusterUpdateParams{BaseDNSDomain: swag.String("example.org")}))
+				return nil, nil
+			}).Times(1)
+		expectGetCluster()
+		result := apply(&models.ClusterPlan{Cluster: &models.V2ClusterUpdateParams{
+			Name:          swag.String("cluster"),
+			BaseDNSDomain: swag.String("example.org"),
+		}}, false)
+		Expect(result.Applied).To(Equal(swag.Bool(true)))
+	})
+
+	It("does not apply a plan that matches the cluster", func() {
+		result := apply(&models.ClusterPlan{
+			Cluster: &models.V2ClusterUpdateParams{
+				Name:               swag.String("cluster"),
+				SchedulableMasters: swag.Bool(false),
+				HTTPProxy:          swag.String(""),
+				PullSecret:         swag.String("secret"),
+			},
+			InfraEnv:  &models.InfraEnvUpdateParams{ImageType: models.ImageTypeFullIso},
+			Hosts:     []*models.ClusterPlanHost{{MacAddress: swag.String("52-54-00-AA-BB-CC"), HostName: swag.String("host-0")}},
+			Operators: []*models.OperatorCreateParams{{Name: "odf"}},
+		}, false)
+		Expect(result.Changes).To(BeEmpty())
+		Expect(result.Applied).To(Equal(swag.Bool(false)))
+	})
+
+	It("rejects operators that are set both in the plan and in the cluster params", func() {
+		verifyApiError(api.V2ApplyClusterPlan(ctx, operations.V2ApplyClusterPlanParams{ClusterID: clusterID, Plan: &models.ClusterPlan{
+			Cluster:   &models.V2ClusterUpdateParams{OlmOperators: []*models.OperatorCreateParams{{Name: "odf"}}},
+			Operators: []*models.OperatorCreateParams{{Name: "odf"}},
+		}}), http.StatusBadRequest)
+	})
+
+	It("fails on clusters that do not exist", func() {
+		verifyApiError(api.V2ApplyClusterPlan(ctx, operations.V2ApplyClusterPlanParams{
+			ClusterID: strfmt.UUID(uuid.New().String()),
+			Plan:      &models.ClusterPlan{},
+		}), http.StatusNotFound)
+	})
+
+	Context("infra-env", func() {
+		It("updates the infra-env of the cluster", func() {
+			mockInstaller.EXPECT().UpdateInfraEnvInternal(gomock.Any(), installer.UpdateInfraEnvParams{
+				InfraEnvID:           infraEnvID,
+				InfraEnvUpdateParams: &models.InfraEnvUpdateParams{ImageType: models.ImageTypeMinimalIso},
+			}, nil, nil).Return(nil, nil).Times(1)
+			expectGetCluster()
+			result := apply(&models.ClusterPlan{InfraEnv: &models.InfraEnvUpdateParams{ImageType: models.ImageTypeMinimalIso}}, false)
+			Expect(changedFieldsOf(result, models.ClusterPlanChangeResourceInfraEnv)).To(Equal([]string{"image_type"}))
+		})
+
+		It("requires the infra-env to be set when the cluster has several", func() {
+			otherInfraEnvID := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.InfraEnv{InfraEnv: models.InfraEnv{ID: &otherInfraEnvID, ClusterID: clusterID}}).Error).ToNot(HaveOccurred())
+			verifyApiError(api.V2ApplyClusterPlan(ctx, operations.V2ApplyClusterPlanParams{ClusterID: clusterID, Plan: &models.ClusterPlan{
+				InfraEnv: &models.InfraEnvUpdateParams{ImageType: models.ImageTypeMinimalIso},
+			}}), http.StatusBadRequest)
+
+			result := apply(&models.ClusterPlan{
+				InfraEnvID: &otherInfraEnvID,
+				InfraEnv:   &models.InfraEnvUpdateParams{ImageType: models.ImageTypeMinimalIso},
+			}, true)
+			Expect(result.Changes).To(HaveLen(1))
+			Expect(result.Changes[0].ID).To(Equal(otherInfraEnvID.String()))
+		})
+
+		It("does not apply infra-envs of other clusters", func() {
+			otherInfraEnvID := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.InfraEnv{InfraEnv: models.InfraEnv{ID: &otherInfraEnvID}}).Error).ToNot(HaveOccurred())
+			verifyApiError(api.V2ApplyClusterPlan(ctx, operations.V2ApplyClusterPlanParams{ClusterID: clusterID, Plan: &models.ClusterPlan{
+				InfraEnvID: &otherInfraEnvID,
+				InfraEnv:   &models.InfraEnvUpdateParams{ImageType: models.ImageTypeMinimalIso},
+			}}), http.StatusBadRequest)
+		})
+	})
+
+	Context("hosts", func() {
+		It("updates the hosts matched by MAC address", func() {
+			mockInstaller.EXPECT().V2UpdateHostInternal(gomock.Any(), installer.V2UpdateHostParams{
+				InfraEnvID: infraEnvID,
+				HostID:     hostID,
+				HostUpdateParams: &models.HostUpdateParams{
+					HostRole: swag.String(string(models.HostRoleMaster)),
+					HostName: swag.String("master-0"),
+					DisksSelectedConfig: []*models.DiskConfigParams{
+						{ID: swag.String("/dev/disk/by-id/disk-1"), Role: models.DiskRoleInstall},
+					},
+				},
+			}, bminventory.Interactive).Return(nil, nil).Times(1)
+			expectGetCluster()
+			result := apply(&models.ClusterPlan{Hosts: []*models.ClusterPlanHost{{
+				MacAddress:         swag.String("52:54:00:AA:BB:CC"),
+				HostRole:           swag.String(string(models.HostRoleMaster)),
+				HostName:           swag.String("master-0"),
+				InstallationDiskID: swag.String("/dev/disk/by-id/disk-1"),
+			}}}, false)
+			Expect(changedFieldsOf(result, models.ClusterPlanChangeResourceHost)).To(Equal([]string{"host_role", "host_name", "installation_disk_id"}))
+			Expect(result.Changes[1].Current).To(Equal("host-0"))
+		})
+
+		It("fails when a host was not discovered", func() {
+			verifyApiError(api.V2ApplyClusterPlan(ctx, operations.V2ApplyClusterPlanParams{ClusterID: clusterID, Plan: &models.ClusterPlan{
+				Hosts: []*models.ClusterPlanHost{{MacAddress: swag.String("52:54:00:00:00:01"), HostRole: swag.String(string(models.HostRoleWorker))}},
+			}}), http.StatusConflict)
+		})
+	})
+
+	Context("operators", func() {
+		It("ignores operators that are only installed as dependencies", func() {
+			mockInstaller.EXPECT().UpdateClusterInteractive(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, params installer.V2UpdateClusterParams) (*common.Cluster, error) {
+					Expect(params.ClusterUpdateParams.OlmOperators).To(Equal([]*models.OperatorCreateParams{{Name: "cnv"}}))
+					return nil, nil
+				}).Times(1)
+			expectGetCluster()
+			result := apply(&models.ClusterPlan{Operators: []*models.OperatorCreateParams{{Name: "cnv"}}}, false)
+			Expect(result.Changes).To(HaveLen(2))
+			Expect(result.Changes[0].ID).To(Equal("cnv"))
+			Expect(result.Changes[0].Action).To(Equal(swag.String(models.ClusterPlanChangeActionCreate)))
+			Expect(result.Changes[1].ID).To(Equal("odf"))
+			Expect(result.Changes[1].Action).To(Equal(swag.String(models.ClusterPlanChangeActionDelete)))
+		})
+	})
+
+	Context("manifests", func() {
+		encode := func(content string) *string {
+			return swag.String(base64.StdEncoding.EncodeToString([]byte(content)))
+		}
+
+		BeforeEach(func() {
+			mockManifests.EXPECT().ListClusterManifestsInternal(gomock.Any(), manifestsops.V2ListClusterManifestsParams{ClusterID: clusterID}).
+				Return(models.ListManifests{
+					{Folder: models.ManifestFolderManifests, FileName: "same.yaml"},
+					{Folder: models.ManifestFolderManifests, FileName: "changed.yaml"},
+					{Folder: models.ManifestFolderOpenshift, FileName: "stale.yaml"},
+				}, nil).Times(1)
+			mockManifests.EXPECT().GetClusterManifestContentInternal(gomock.Any(), clusterID, models.ManifestFolderManifests, "same.yaml").
+				Return([]byte("same"), nil).AnyTimes()
+			mockManifests.EXPECT().GetClusterManifestContentInternal(gomock.Any(), clusterID, models.ManifestFolderManifests, "changed.yaml").
+				Return([]byte("before"), nil).AnyTimes()
+		})
+
+		It("creates, updates and prunes manifests", func() {
+			mockManifests.EXPECT().CreateClusterManifestInternal(gomock.Any(), manifestsops.V2CreateClusterManifestParams{
+				ClusterID: clusterID,
+				CreateManifestParams: &models.CreateManifestParams{
+					Folder:   swag.String(models.ManifestFolderManifests),
+					FileName: swag.String("new.yaml"),
+					Content:  encode("new"),
+				},
+			}, true).Return(nil, nil).Times(1)
+			mockManifests.EXPECT().UpdateClusterManifestInternal(gomock.Any(), manifestsops.V2UpdateClusterManifestParams{
+				ClusterID: clusterID,
+				UpdateManifestParams: &models.UpdateManifestParams{
+					Folder:         models.ManifestFolderManifests,
+					FileName:       "changed.yaml",
+					UpdatedContent: encode("after"),
+				},
+			}).Return(nil, nil).Times(1)
+			mockManifests.EXPECT().DeleteClusterManifestInternal(gomock.Any(), manifestsops.V2DeleteClusterManifestParams{
+				ClusterID: clusterID,
+				Folder:    swag.String(models.ManifestFolderOpenshift),
+				FileName:  "stale.yaml",
+			}).Return(nil).Times(1)
+			expectGetCluster()
+
+			result := apply(&models.ClusterPlan{
+				Manifests: []*models.CreateManifestParams{
+					{FileName: swag.String("same.yaml"), Content: encode("same")},
+					{FileName: swag.String("changed.yaml"), Content: encode("after")},
+					{FileName: swag.String("new.yaml"), Content: encode("new")},
+				},
+				PruneManifests: swag.Bool(true),
+			}, false)
+			ids := []string{}
+			for _, change := range result.Changes {
+				ids = append(ids, change.ID+":"+swag.StringValue(change.Action))
+			}
+			Expect(ids).To(Equal([]string{"manifests/changed.yaml:update", "manifests/new.yaml:create", "openshift/stale.yaml:delete"}))
+		})
+
+		It("keeps the manifests that are not in the plan unless pruning", func() {
+			result := apply(&models.ClusterPlan{
+				Manifests: []*models.CreateManifestParams{{FileName: swag.String("same.yaml"), Content: encode("same")}},
+			}, false)
+			Expect(result.Changes).To(BeEmpty())
+		})
+	})
+
+	Context("install", func() {
+		It("installs the cluster after applying the rest of the plan", func() {
+			gomock.InOrder(
+				mockInstaller.EXPECT().UpdateClusterInteractive(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1),
+				mockInstaller.EXPECT().InstallClusterInternal(gomock.Any(), installer.V2InstallClusterParams{ClusterID: clusterID}).Return(nil, nil).Times(1),
+			)
+			expectGetCluster()
+			result := apply(&models.ClusterPlan{
+				Cluster: &models.V2ClusterUpdateParams{Name: swag.String("renamed")},
+				Install: swag.Bool(true),
+			}, false)
+			Expect(result.Changes).To(HaveLen(2))
+			Expect(result.Changes[1].Action).To(Equal(swag.String(models.ClusterPlanChangeActionInstall)))
+		})
+
+		It("does not install a cluster that is already installing", func() {
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).
+				Update("status", models.ClusterStatusInstalling).Error).ToNot(HaveOccurred())
+			result := apply(&models.ClusterPlan{Install: swag.Bool(true)}, false)
+			Expect(result.Changes).To(BeEmpty())
+		})
+	})
+})
+
+var _ = Describe("matches", func() {
+	It("compares the properties that are set in the desired objects", func() {
+		Expect(matches(map[string]interface{}{"ip": "1.2.3.4"}, map[string]interface{}{"ip": "1.2.3.4", "cluster_id": "id"})).To(BeTrue())
+		Expect(matches(map[string]interface{}{"ip": "1.2.3.5"}, map[string]interface{}{"ip": "1.2.3.4"})).To(BeFalse())
+	})
+
+	It("compares arrays element by element", func() {
+		Expect(matches([]interface{}{"a", "b"}, []interface{}{"a", "b"})).To(BeTrue())
+		Expect(matches([]interface{}{"a"}, []interface{}{"a", "b"})).To(BeFalse())
+		Expect(matches([]interface{}{}, nil)).To(BeTrue())
+	})
+
+	It("treats omitted values as empty", func() {
+		Expect(matches("", nil)).To(BeTrue())
+		Expect(matches(false, nil)).To(BeTrue())
+		Expect(matches("value", nil)).To(BeFalse())
+	})
+
+	It("decodes values that are stored as JSON", func() {
+		Expect(matches([]interface{}{map[string]interface{}{"value": "arg"}}, `[{"value":"arg","operation":"append"}]`)).To(BeTrue())
+	})
+})
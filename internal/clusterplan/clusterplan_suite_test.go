@@ -0,0 +1,29 @@
+package clusterplan
+
+import (
+	"testing"
+
+	"github.com/go-openapi/runtime/middleware"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+)
+
+func TestClusterPlan(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Cluster plan test Suite")
+}
+
+var _ = BeforeSuite(func() {
+	common.InitializeDBTest()
+})
+
+var _ = AfterSuite(func() {
+	common.TerminateDBTest()
+})
+
+func verifyApiError(responder middleware.Responder, expectedHttpStatus int32) {
+	ExpectWithOffset(1, responder).To(BeAssignableToTypeOf(common.NewApiError(expectedHttpStatus, nil)))
+	concreteError := responder.(*common.ApiErrorResponse)
+	ExpectWithOffset(1, concreteError.StatusCode()).To(Equal(expectedHttpStatus))
+}
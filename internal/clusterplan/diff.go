@@ -0,0 +1,150 @@
+package clusterplan
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// sensitiveFields are compared, but their values are not included in the changes
+var sensitiveFields = map[string]bool{
+	"pull_secret": true,
+}
+
+func newChange(resource, id, field, action string, current, desired interface{}) *models.ClusterPlanChange {
+	change := &models.ClusterPlanChange{
+		Resource: swag.String(resource),
+		ID:       id,
+		Field:    field,
+		Action:   swag.String(action),
+	}
+	if !sensitiveFields[field] {
+		change.Current = current
+		change.Desired = desired
+	}
+	return change
+}
+
+func toMap(obj interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string]interface{}{}
+	if err = json.Unmarshal(b, &ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// diffFields compares the fields that are set in the desired update params with the current state of the resource,
+// and returns the changes sorted by field. currentNames maps the names of the update params that are named
+// differently in the resource.
+func diffFields(resource, id string, desired, current interface{}, currentNames map[string]string) ([]*models.ClusterPlanChange, error) {
+	desiredFields, err := toMap(desired)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to encode the desired %s", resource)
+	}
+	currentFields, err := toMap(current)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to encode the current %s", resource)
+	}
+
+	fields := make([]string, 0, len(desiredFields))
+	for field := range desiredFields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var changes []*models.ClusterPlanChange
+	for _, field := range fields {
+		desiredValue := desiredFields[field]
+		// Fields that are not set in the update params are not managed by the plan
+		if desiredValue == nil {
+			continue
+		}
+		currentName := field
+		if name, ok := currentNames[field]; ok {
+			currentName = name
+		}
+		currentValue := currentFields[currentName]
+		if !matches(desiredValue, currentValue) {
+			changes = append(changes, newChange(resource, id, field, models.ClusterPlanChangeActionUpdate, currentValue, desiredValue))
+		}
+	}
+	return changes, nil
+}
+
+// matches returns true if the current value already has the desired value. Objects match if the properties that
+// are set in the desired object match, since the resources hold more properties than their update params.
+func matches(desired, current interface{}) bool {
+	// Some structured params are stored as JSON strings
+	if s, ok := current.(string); ok {
+		switch desired.(type) {
+		case map[string]interface{}, []interface{}:
+			var decoded interface{}
+			if err := json.Unmarshal([]byte(s), &decoded); err == nil {
+				current = decoded
+			}
+		}
+	}
+
+	switch d := desired.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		c, _ := current.(map[string]interface{})
+		for key, value := range d {
+			if !matches(value, c[key]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		c, _ := current.([]interface{})
+		if len(c) != len(d) {
+			return false
+		}
+		for i := range d {
+			if !matches(d[i], c[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		// Empty values are omitted when the resource is encoded
+		if current == nil {
+			return reflect.ValueOf(desired).IsZero()
+		}
+		return reflect.DeepEqual(desired, current)
+	}
+}
+
+// selectFields returns update params holding only the given fields of the desired update params
+func selectFields(desired interface{}, fields []string, out interface{}) error {
+	desiredFields, err := toMap(desired)
+	if err != nil {
+		return err
+	}
+	selected := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		selected[field] = desiredFields[field]
+	}
+	b, err := json.Marshal(selected)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
+}
+
+func changedFields(changes []*models.ClusterPlanChange) []string {
+	fields := make([]string, 0, len(changes))
+	for _, change := range changes {
+		fields = append(fields, change.Field)
+	}
+	return fields
+}
@@ -0,0 +1,298 @@
+package clustertemplates
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/bminventory"
+	"github.com/openshift/assisted-service/internal/common"
+	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/cluster_templates"
+	manifestsops "github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ restapi.ClusterTemplatesAPI = &Api{}
+var _ bminventory.ClusterTemplates = &Api{}
+var _ ClusterTemplateInternals = &Api{}
+
+//go:generate mockgen --build_flags=--mod=mod -package=clustertemplates -destination=mock_cluster_template_internals.go . ClusterTemplateInternals
+type ClusterTemplateInternals interface {
+	// SyncClusterTemplateInternal creates or updates a template that is managed by the kube-api
+	SyncClusterTemplateInternal(ctx context.Context, id strfmt.UUID, name string, description string, spec *models.ClusterTemplateSpec) (*models.ClusterTemplate, error)
+	// DeleteClusterTemplateInternal deletes a template that is managed by the kube-api, if it exists
+	DeleteClusterTemplateInternal(ctx context.Context, id strfmt.UUID) error
+}
+
+// Api manages cluster templates. Templates are owned by the user that registered them, and are shared with the
+// members of its organization when organization tenancy is enabled, in the same way as clusters.
+type Api struct {
+	db        *gorm.DB
+	authz     auth.Authorizer
+	manifests manifestsapi.ClusterManifestsInternals
+	log       logrus.FieldLogger
+}
+
+func NewApi(db *gorm.DB, authz auth.Authorizer, manifests manifestsapi.ClusterManifestsInternals, log logrus.FieldLogger) *Api {
+	return &Api{
+		db:        db,
+		authz:     authz,
+		manifests: manifests,
+		log:       log,
+	}
+}
+
+func (a *Api) V2ListClusterTemplates(ctx context.Context, params operations.V2ListClusterTemplatesParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+	var templates []*common.ClusterTemplate
+	if err := a.authz.OwnedBy(ctx, a.db).Order("name").Find(&templates).Error; err != nil {
+		log.WithError(err).Error("failed to list cluster templates")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	ret := make(models.ClusterTemplateList, 0, len(templates))
+	for _, template := range templates {
+		model, err := toModel(template)
+		if err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		ret = append(ret, model)
+	}
+	return operations.NewV2ListClusterTemplatesOK().WithPayload(ret)
+}
+
+func (a *Api) V2RegisterClusterTemplate(ctx context.Context, params operations.V2RegisterClusterTemplateParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+	createParams := params.NewClusterTemplateParams
+	if err := ValidateSpec(createParams.Spec); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	id := strfmt.UUID(uuid.New().String())
+	now := strfmt.DateTime(time.Now())
+	template := &common.ClusterTemplate{
+		ClusterTemplate: models.ClusterTemplate{
+			ID:          &id,
+			Name:        createParams.Name,
+			Description: createParams.Description,
+			Source:      models.ClusterTemplateSourceAPI,
+			UserName:    ocm.UserNameFromContext(ctx),
+			OrgID:       ocm.OrgIDFromContext(ctx),
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+	}
+	if err := setSpec(template, createParams.Spec); err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if err := a.db.Create(template).Error; err != nil {
+		log.WithError(err).Errorf("failed to create cluster template %s", swag.StringValue(createParams.Name))
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	log.Infof("Registered cluster template %s (%s)", id, swag.StringValue(createParams.Name))
+	return a.templateResponder(template, func(model *models.ClusterTemplate) middleware.Responder {
+		return operations.NewV2RegisterClusterTemplateCreated().WithPayload(model)
+	})
+}
+
+func (a *Api) V2GetClusterTemplate(ctx context.Context, params operations.V2GetClusterTemplateParams) middleware.Responder {
+	template, err := a.getTemplate(ctx, a.db, params.ClusterTemplateID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return a.templateResponder(template, func(model *models.ClusterTemplate) middleware.Responder {
+		return operations.NewV2GetClusterTemplateOK().WithPayload(model)
+	})
+}
+
+func (a *Api) V2UpdateClusterTemplate(ctx context.Context, params operations.V2UpdateClusterTemplateParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+	updateParams := params.ClusterTemplateUpdateParams
+	if updateParams.Name != nil && *updateParams.Name == "" {
+		return common.NewApiError(http.StatusBadRequest, errors.New("the name of a cluster template may not be empty"))
+	}
+	if err := ValidateSpec(updateParams.Spec); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	var template *common.ClusterTemplate
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		if template, err = a.getTemplate(ctx, tx, params.ClusterTemplateID); err != nil {
+			return err
+		}
+		if template.Source == models.ClusterTemplateSourceKubeAPI {
+			return common.NewApiError(http.StatusConflict,
+				errors.Errorf("cluster template %s is managed by the kube-api and cannot be updated", params.ClusterTemplateID))
+		}
+		if updateParams.Name != nil {
+			template.Name = updateParams.Name
+		}
+		if updateParams.Description != nil {
+			template.Description = *updateParams.Description
+		}
+		if updateParams.Spec != nil {
+			if err = setSpec(template, updateParams.Spec); err != nil {
+				return err
+			}
+		}
+		template.UpdatedAt = strfmt.DateTime(time.Now())
+		return tx.Save(template).Error
+	})
+	if err != nil {
+		log.WithError(err).Errorf("failed to update cluster template %s", params.ClusterTemplateID)
+		return common.GenerateErrorResponder(err)
+	}
+	return a.templateResponder(template, func(model *models.ClusterTemplate) middleware.Responder {
+		return operations.NewV2UpdateClusterTemplateCreated().WithPayload(model)
+	})
+}
+
+func (a *Api) V2DeregisterClusterTemplate(ctx context.Context, params operations.V2DeregisterClusterTemplateParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		template, err := a.getTemplate(ctx, tx, params.ClusterTemplateID)
+		if err != nil {
+			return err
+		}
+		if template.Source == models.ClusterTemplateSourceKubeAPI {
+			return common.NewApiError(http.StatusConflict,
+				errors.Errorf("cluster template %s is managed by the kube-api and cannot be deregistered", params.ClusterTemplateID))
+		}
+		return tx.Delete(template).Error
+	})
+	if err != nil {
+		log.WithError(err).Errorf("failed to deregister cluster template %s", params.ClusterTemplateID)
+		return common.GenerateErrorResponder(err)
+	}
+	log.Infof("Deregistered cluster template %s", params.ClusterTemplateID)
+	return operations.NewV2DeregisterClusterTemplateNoContent()
+}
+
+func (a *Api) InstantiateClusterTemplate(ctx context.Context, templateID strfmt.UUID, parameters []string,
+	overrides *models.ClusterCreateParams) (*bminventory.ClusterTemplateInstance, error) {
+	template, err := a.getTemplate(ctx, a.db, templateID)
+	if err != nil {
+		return nil, err
+	}
+	model, err := toModel(template)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	instance, err := instantiate(model.Spec, parameters, overrides)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest,
+			errors.Wrapf(err, "failed to instantiate cluster template %s", templateID))
+	}
+	return instance, nil
+}
+
+func (a *Api) CreateClusterTemplateManifests(ctx context.Context, clusterID strfmt.UUID, manifests []*models.CreateManifestParams) error {
+	for _, manifest := range manifests {
+		if _, err := a.manifests.CreateClusterManifestInternal(ctx, manifestsops.V2CreateClusterManifestParams{
+			ClusterID:            clusterID,
+			CreateManifestParams: manifest,
+		}, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *Api) SyncClusterTemplateInternal(ctx context.Context, id strfmt.UUID, name string, description string,
+	spec *models.ClusterTemplateSpec) (*models.ClusterTemplate, error) {
+	log := logutil.FromContext(ctx, a.log)
+	if err := ValidateSpec(spec); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	var template common.ClusterTemplate
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		now := strfmt.DateTime(time.Now())
+		err := tx.Take(&template, "id = ?", id.String()).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			template = common.ClusterTemplate{ClusterTemplate: models.ClusterTemplate{
+				ID:        &id,
+				Source:    models.ClusterTemplateSourceKubeAPI,
+				CreatedAt: now,
+			}}
+		case err != nil:
+			return err
+		case template.Source != models.ClusterTemplateSourceKubeAPI:
+			return common.NewApiError(http.StatusConflict, errors.Errorf("cluster template %s is not managed by the kube-api", id))
+		}
+		template.Name = swag.String(name)
+		template.Description = description
+		template.UpdatedAt = now
+		if err = setSpec(&template, spec); err != nil {
+			return err
+		}
+		return tx.Save(&template).Error
+	})
+	if err != nil {
+		log.WithError(err).Errorf("failed to sync cluster template %s", id)
+		return nil, err
+	}
+	return toModel(&template)
+}
+
+func (a *Api) DeleteClusterTemplateInternal(ctx context.Context, id strfmt.UUID) error {
+	return a.db.Where("id = ? AND source = ?", id.String(), models.ClusterTemplateSourceKubeAPI).
+		Delete(&common.ClusterTemplate{}).Error
+}
+
+// getTemplate returns the template if it is owned by the user, in the same way as clusters are accessible by their
+// owner and the members of its organization
+func (a *Api) getTemplate(ctx context.Context, db *gorm.DB, id strfmt.UUID) (*common.ClusterTemplate, error) {
+	var template common.ClusterTemplate
+	if err := a.authz.OwnedBy(ctx, db).Take(&template, "id = ?", id.String()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("cluster template %s not found", id))
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return &template, nil
+}
+
+func (a *Api) templateResponder(template *common.ClusterTemplate, responder func(*models.ClusterTemplate) middleware.Responder) middleware.Responder {
+	model, err := toModel(template)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return responder(model)
+}
+
+func setSpec(template *common.ClusterTemplate, spec *models.ClusterTemplateSpec) error {
+	if spec == nil {
+		spec = &models.ClusterTemplateSpec{}
+	}
+	specJSON, err := json.Marshal(spec)
+	if err != nil {
+		return errors.Wrapf(err, "failed to encode the spec of cluster template %s", template.ID)
+	}
+	template.SpecJSON = string(specJSON)
+	return nil
+}
+
+func toModel(template *common.ClusterTemplate) (*models.ClusterTemplate, error) {
+	ret := template.ClusterTemplate
+	ret.Spec = &models.ClusterTemplateSpec{}
+	if template.SpecJSON != "" {
+		if err := json.Unmarshal([]byte(template.SpecJSON), ret.Spec); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode the spec of cluster template %s", template.ID)
+		}
+	}
+	return &ret, nil
+}
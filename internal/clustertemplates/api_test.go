@@ -0,0 +1,196 @@
+package clustertemplates
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	operations "github.com/openshift/assisted-service/restapi/operations/cluster_templates"
+	manifestsops "github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Cluster templates API", func() {
+	var (
+		db            *gorm.DB
+		dbName        string
+		ctrl          *gomock.Controller
+		mockManifests *manifestsapi.MockClusterManifestsInternals
+		api           *Api
+		ctx           context.Context
+		sameOrgCtx    context.Context
+		otherOrgCtx   context.Context
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockManifests = manifestsapi.NewMockClusterManifestsInternals(ctrl)
+		cfg := &auth.Config{AuthType: auth.TypeRHSSO, EnableOrgTenancy: true}
+		authzHandler := auth.NewAuthzHandler(cfg, nil, logrus.New(), db)
+		api = NewApi(db, authzHandler, mockManifests, logrus.WithField("pkg", "clustertemplates"))
+		ctx = userContext("user1", "org1")
+		sameOrgCtx = userContext("user2", "org1")
+		otherOrgCtx = userContext("user3", "org2")
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	register := func(ctx context.Context, name string, spec *models.ClusterTemplateSpec) *models.ClusterTemplate {
+		reply := api.V2RegisterClusterTemplate(ctx, operations.V2RegisterClusterTemplateParams{
+			NewClusterTemplateParams: &models.ClusterTemplateCreateParams{Name: swag.String(name), Spec: spec},
+		})
+		ExpectWithOffset(1, reply).To(BeAssignableToTypeOf(operations.NewV2RegisterClusterTemplateCreated()))
+		return reply.(*operations.V2RegisterClusterTemplateCreated).Payload
+	}
+
+	It("registers a template owned by the user", func() {
+		template := register(ctx, "edge", newTestSpec())
+		Expect(template.UserName).To(Equal("user1"))
+		Expect(template.OrgID).To(Equal("org1"))
+		Expect(template.Source).To(Equal(models.ClusterTemplateSourceAPI))
+
+		reply := api.V2GetClusterTemplate(ctx, operations.V2GetClusterTemplateParams{ClusterTemplateID: *template.ID})
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2GetClusterTemplateOK()))
+		spec := reply.(*operations.V2GetClusterTemplateOK).Payload.Spec
+		Expect(spec.Parameters).To(HaveLen(3))
+		Expect(spec.ClusterParams).To(HaveKeyWithValue("base_dns_domain", "${domain}"))
+		Expect(spec.Manifests).To(HaveLen(1))
+	})
+
+	It("rejects an invalid template", func() {
+		spec := newTestSpec()
+		spec.ClusterParams.(map[string]interface{})["pull_secret"] = "secret"
+		verifyApiError(api.V2RegisterClusterTemplate(ctx, operations.V2RegisterClusterTemplateParams{
+			NewClusterTemplateParams: &models.ClusterTemplateCreateParams{Name: swag.String("edge"), Spec: spec},
+		}), http.StatusBadRequest)
+	})
+
+	It("shares templates with the organization of the owner", func() {
+		template := register(ctx, "edge", newTestSpec())
+		register(otherOrgCtx, "other", nil)
+
+		for _, c := range []context.Context{ctx, sameOrgCtx} {
+			reply := api.V2ListClusterTemplates(c, operations.V2ListClusterTemplatesParams{})
+			Expect(reply).To(BeAssignableToTypeOf(operations.NewV2ListClusterTemplatesOK()))
+			templates := reply.(*operations.V2ListClusterTemplatesOK).Payload
+			Expect(templates).To(HaveLen(1))
+			Expect(templates[0].ID).To(Equal(template.ID))
+		}
+		verifyApiError(api.V2GetClusterTemplate(otherOrgCtx, operations.V2GetClusterTemplateParams{ClusterTemplateID: *template.ID}),
+			http.StatusNotFound)
+		verifyApiError(api.V2DeregisterClusterTemplate(otherOrgCtx, operations.V2DeregisterClusterTemplateParams{ClusterTemplateID: *template.ID}),
+			http.StatusNotFound)
+	})
+
+	It("updates a template", func() {
+		template := register(ctx, "edge", newTestSpec())
+		reply := api.V2UpdateClusterTemplate(sameOrgCtx, operations.V2UpdateClusterTemplateParams{
+			ClusterTemplateID: *template.ID,
+			ClusterTemplateUpdateParams: &models.ClusterTemplateUpdateParams{
+				Description: swag.String("edge clusters"),
+				Spec:        &models.ClusterTemplateSpec{},
+			},
+		})
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2UpdateClusterTemplateCreated()))
+		updated := reply.(*operations.V2UpdateClusterTemplateCreated).Payload
+		Expect(updated.Name).To(Equal(swag.String("edge")))
+		Expect(updated.Description).To(Equal("edge clusters"))
+		Expect(updated.Spec.Parameters).To(BeEmpty())
+	})
+
+	It("deregisters a template", func() {
+		template := register(ctx, "edge", nil)
+		Expect(api.V2DeregisterClusterTemplate(ctx, operations.V2DeregisterClusterTemplateParams{ClusterTemplateID: *template.ID})).
+			To(BeAssignableToTypeOf(operations.NewV2DeregisterClusterTemplateNoContent()))
+		verifyApiError(api.V2GetClusterTemplate(ctx, operations.V2GetClusterTemplateParams{ClusterTemplateID: *template.ID}),
+			http.StatusNotFound)
+	})
+
+	Context("instantiate", func() {
+		It("instantiates a template", func() {
+			template := register(ctx, "edge", newTestSpec())
+			instance, err := api.InstantiateClusterTemplate(sameOrgCtx, *template.ID, []string{"domain=example.com"},
+				&models.ClusterCreateParams{Name: swag.String("cluster"), OpenshiftVersion: swag.String("4.14"), PullSecret: swag.String("secret")})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instance.ClusterParams.BaseDNSDomain).To(Equal("example.com"))
+			Expect(instance.Manifests).To(HaveLen(1))
+		})
+
+		It("fails to instantiate a template of another organization", func() {
+			template := register(otherOrgCtx, "edge", nil)
+			_, err := api.InstantiateClusterTemplate(ctx, *template.ID, nil, &models.ClusterCreateParams{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusNotFound)))
+		})
+
+		It("fails on missing parameters", func() {
+			template := register(ctx, "edge", newTestSpec())
+			_, err := api.InstantiateClusterTemplate(ctx, *template.ID, nil, &models.ClusterCreateParams{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+		})
+
+		It("creates the manifests of the template", func() {
+			clusterID := strfmt.UUID(uuid.New().String())
+			manifest := &models.CreateManifestParams{FileName: swag.String("a.yaml"), Content: encodeManifest("a: b")}
+			mockManifests.EXPECT().CreateClusterManifestInternal(gomock.Any(), manifestsops.V2CreateClusterManifestParams{
+				ClusterID:            clusterID,
+				CreateManifestParams: manifest,
+			}, true).Return(&models.Manifest{}, nil).Times(1)
+			Expect(api.CreateClusterTemplateManifests(ctx, clusterID, []*models.CreateManifestParams{manifest})).To(Succeed())
+		})
+	})
+
+	Context("kube-api templates", func() {
+		var id strfmt.UUID
+
+		BeforeEach(func() {
+			id = strfmt.UUID(uuid.New().String())
+			template, err := api.SyncClusterTemplateInternal(context.Background(), id, "ns/edge", "", newTestSpec())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(template.Source).To(Equal(models.ClusterTemplateSourceKubeAPI))
+		})
+
+		It("updates the template on sync", func() {
+			template, err := api.SyncClusterTemplateInternal(context.Background(), id, "ns/edge", "updated", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(template.Description).To(Equal("updated"))
+			Expect(template.Spec.Parameters).To(BeEmpty())
+		})
+
+		It("cannot be modified through the API", func() {
+			verifyApiError(api.V2UpdateClusterTemplate(context.Background(), operations.V2UpdateClusterTemplateParams{
+				ClusterTemplateID:           id,
+				ClusterTemplateUpdateParams: &models.ClusterTemplateUpdateParams{Description: swag.String("a")},
+			}), http.StatusConflict)
+			verifyApiError(api.V2DeregisterClusterTemplate(context.Background(), operations.V2DeregisterClusterTemplateParams{ClusterTemplateID: id}),
+				http.StatusConflict)
+		})
+
+		It("deletes the template", func() {
+			Expect(api.DeleteClusterTemplateInternal(context.Background(), id)).To(Succeed())
+			verifyApiError(api.V2GetClusterTemplate(context.Background(), operations.V2GetClusterTemplateParams{ClusterTemplateID: id}),
+				http.StatusNotFound)
+		})
+
+		It("does not sync over a template of the API", func() {
+			template := register(ctx, "edge", nil)
+			_, err := api.SyncClusterTemplateInternal(context.Background(), *template.ID, "ns/edge", "", nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,287 @@
+package clustertemplates
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/bminventory"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// ClusterNameParameter is set to the name of the registered cluster, and may be referenced without being declared
+const ClusterNameParameter = "cluster_name"
+
+var (
+	parameterName      = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	parameterReference = regexp.MustCompile(`\$\{([a-zA-Z_][a-zA-Z0-9_]*)\}`)
+
+	clusterParamsFields  = jsonFields(models.ClusterCreateParams{})
+	infraEnvParamsFields = jsonFields(models.InfraEnvCreateParams{})
+)
+
+// forbiddenClusterParams and forbiddenInfraEnvParams are set on registration, secrets are never stored in a template
+var (
+	forbiddenClusterParams  = []string{"pull_secret"}
+	forbiddenInfraEnvParams = []string{"pull_secret", "cluster_id"}
+)
+
+// jsonFields returns the JSON names of the fields of a struct
+func jsonFields(obj interface{}) map[string]bool {
+	ret := make(map[string]bool)
+	t := reflect.TypeOf(obj)
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			ret[name] = true
+		}
+	}
+	return ret
+}
+
+// ValidateSpec verifies that the spec only sets known properties, and only references declared parameters
+func ValidateSpec(spec *models.ClusterTemplateSpec) error {
+	if spec == nil {
+		return nil
+	}
+	declared := map[string]bool{ClusterNameParameter: true}
+	for _, parameter := range spec.Parameters {
+		name := swag.StringValue(parameter.Name)
+		if !parameterName.MatchString(name) {
+			return errors.Errorf("invalid parameter name %q", name)
+		}
+		if declared[name] {
+			return errors.Errorf("parameter %s is declared more than once", name)
+		}
+		declared[name] = true
+	}
+
+	if err := validateParams("cluster_params", spec.ClusterParams, clusterParamsFields, forbiddenClusterParams); err != nil {
+		return err
+	}
+	if err := validateParams("infra_env_params", spec.InfraEnvParams, infraEnvParamsFields, forbiddenInfraEnvParams); err != nil {
+		return err
+	}
+	references := append(referencesOf(spec.ClusterParams), referencesOf(spec.InfraEnvParams)...)
+
+	paths := make(map[string]bool)
+	for _, manifest := range spec.Manifests {
+		if err := manifest.Validate(strfmt.Default); err != nil {
+			return errors.Wrap(err, "invalid manifest")
+		}
+		path := filepath.Join(manifestFolder(manifest), swag.StringValue(manifest.FileName))
+		if paths[path] {
+			return errors.Errorf("manifest %s is set more than once", path)
+		}
+		paths[path] = true
+		content, err := base64.StdEncoding.DecodeString(swag.StringValue(manifest.Content))
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode the content of manifest %s", path)
+		}
+		references = append(references, referencesOf(string(content))...)
+	}
+
+	for _, name := range references {
+		if !declared[name] {
+			return errors.Errorf("parameter %s is referenced but not declared", name)
+		}
+	}
+	return nil
+}
+
+func validateParams(name string, params interface{}, fields map[string]bool, forbidden []string) error {
+	if params == nil {
+		return nil
+	}
+	values, ok := params.(map[string]interface{})
+	if !ok {
+		return errors.Errorf("%s must be an object", name)
+	}
+	for field := range values {
+		if !fields[field] {
+			return errors.Errorf("%s has unknown property %s", name, field)
+		}
+	}
+	for _, field := range forbidden {
+		if _, ok := values[field]; ok {
+			return errors.Errorf("%s may not be set in %s of a template", field, name)
+		}
+	}
+	return nil
+}
+
+func manifestFolder(manifest *models.CreateManifestParams) string {
+	folder := swag.StringValue(manifest.Folder)
+	if folder == "" {
+		folder = models.CreateManifestParamsFolderManifests
+	}
+	return folder
+}
+
+// referencesOf returns the names of the parameters referenced by the strings of the value
+func referencesOf(value interface{}) []string {
+	var ret []string
+	switch v := value.(type) {
+	case string:
+		for _, match := range parameterReference.FindAllStringSubmatch(v, -1) {
+			ret = append(ret, match[1])
+		}
+	case map[string]interface{}:
+		for _, item := range v {
+			ret = append(ret, referencesOf(item)...)
+		}
+	case []interface{}:
+		for _, item := range v {
+			ret = append(ret, referencesOf(item)...)
+		}
+	}
+	return ret
+}
+
+// parameterValues returns the value of each parameter of the spec, from the name=value assignments
+func parameterValues(spec *models.ClusterTemplateSpec, assignments []string, clusterName string) (map[string]string, error) {
+	set := make(map[string]string)
+	for _, assignment := range assignments {
+		name, value, ok := strings.Cut(assignment, "=")
+		if !ok {
+			return nil, errors.Errorf("template parameter %q is not formatted as name=value", assignment)
+		}
+		set[name] = value
+	}
+
+	ret := map[string]string{ClusterNameParameter: clusterName}
+	for _, parameter := range spec.Parameters {
+		name := swag.StringValue(parameter.Name)
+		value, ok := set[name]
+		switch {
+		case ok:
+			ret[name] = value
+			delete(set, name)
+		case parameter.Default != nil:
+			ret[name] = *parameter.Default
+		case swag.BoolValue(parameter.Required):
+			return nil, errors.Errorf("template parameter %s is required", name)
+		default:
+			ret[name] = ""
+		}
+	}
+	for name := range set {
+		return nil, errors.Errorf("template has no parameter %s", name)
+	}
+	return ret, nil
+}
+
+// expand replaces the parameter references in the strings of the value. A string that is a single reference is
+// replaced by the JSON value of the parameter when it is a number, a boolean, a list or an object, so that
+// parameters can set properties that are not strings.
+func expand(value interface{}, values map[string]string) interface{} {
+	switch v := value.(type) {
+	case string:
+		if match := parameterReference.FindStringSubmatch(v); match != nil && match[0] == v {
+			var decoded interface{}
+			if err := json.Unmarshal([]byte(values[match[1]]), &decoded); err == nil && decoded != nil {
+				if _, isString := decoded.(string); !isString {
+					return decoded
+				}
+			}
+		}
+		return expandString(v, values)
+	case map[string]interface{}:
+		ret := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			ret[key] = expand(item, values)
+		}
+		return ret
+	case []interface{}:
+		ret := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			ret = append(ret, expand(item, values))
+		}
+		return ret
+	default:
+		return v
+	}
+}
+
+func expandString(s string, values map[string]string) string {
+	return parameterReference.ReplaceAllStringFunc(s, func(reference string) string {
+		return values[parameterReference.FindStringSubmatch(reference)[1]]
+	})
+}
+
+// instantiate expands the spec with the parameters, and applies the properties that are set in the overrides over
+// the cluster params of the spec
+func instantiate(spec *models.ClusterTemplateSpec, assignments []string, overrides *models.ClusterCreateParams) (*bminventory.ClusterTemplateInstance, error) {
+	if spec == nil {
+		spec = &models.ClusterTemplateSpec{}
+	}
+	if overrides == nil {
+		overrides = &models.ClusterCreateParams{}
+	}
+	values, err := parameterValues(spec, assignments, swag.StringValue(overrides.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	clusterParams := make(map[string]interface{})
+	if spec.ClusterParams != nil {
+		clusterParams = expand(spec.ClusterParams, values).(map[string]interface{})
+	}
+	overridesJSON, err := json.Marshal(overrides)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode the cluster params")
+	}
+	var overridesMap map[string]interface{}
+	if err = json.Unmarshal(overridesJSON, &overridesMap); err != nil {
+		return nil, errors.Wrap(err, "failed to decode the cluster params")
+	}
+	// Lists that are not set in the request are encoded as null, and do not override the template
+	for field, value := range overridesMap {
+		if value != nil {
+			clusterParams[field] = value
+		}
+	}
+
+	instance := &bminventory.ClusterTemplateInstance{
+		ClusterParams: &models.ClusterCreateParams{},
+		Manifests:     make([]*models.CreateManifestParams, 0, len(spec.Manifests)),
+	}
+	if err = convert(clusterParams, instance.ClusterParams); err != nil {
+		return nil, errors.Wrap(err, "invalid cluster params")
+	}
+	if err = instance.ClusterParams.Validate(strfmt.Default); err != nil {
+		return nil, errors.Wrap(err, "invalid cluster params")
+	}
+	if spec.InfraEnvParams != nil {
+		instance.InfraEnvParams = &models.InfraEnvCreateParams{}
+		if err = convert(expand(spec.InfraEnvParams, values), instance.InfraEnvParams); err != nil {
+			return nil, errors.Wrap(err, "invalid infra-env params")
+		}
+	}
+	for _, manifest := range spec.Manifests {
+		content, err := base64.StdEncoding.DecodeString(swag.StringValue(manifest.Content))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode the content of manifest %s", swag.StringValue(manifest.FileName))
+		}
+		instance.Manifests = append(instance.Manifests, &models.CreateManifestParams{
+			Folder:   swag.String(manifestFolder(manifest)),
+			FileName: manifest.FileName,
+			Content:  swag.String(base64.StdEncoding.EncodeToString([]byte(expandString(string(content), values)))),
+		})
+	}
+	return instance, nil
+}
+
+func convert(from interface{}, to interface{}) error {
+	data, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, to)
+}
@@ -0,0 +1,40 @@
+package clustertemplates
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/runtime/middleware"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+)
+
+func TestClusterTemplates(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Cluster templates test Suite")
+}
+
+var _ = BeforeSuite(func() {
+	common.InitializeDBTest()
+})
+
+var _ = AfterSuite(func() {
+	common.TerminateDBTest()
+})
+
+func userContext(username, orgID string) context.Context {
+	payload := &ocm.AuthPayload{}
+	payload.Role = ocm.UserRole
+	payload.Username = username
+	payload.Organization = orgID
+	return context.WithValue(context.TODO(), restapi.AuthKey, payload)
+}
+
+func verifyApiError(responder middleware.Responder, expectedHttpStatus int32) {
+	ExpectWithOffset(1, responder).To(BeAssignableToTypeOf(common.NewApiError(expectedHttpStatus, nil)))
+	concreteError := responder.(*common.ApiErrorResponse)
+	ExpectWithOffset(1, concreteError.StatusCode()).To(Equal(expectedHttpStatus))
+}
@@ -0,0 +1,146 @@
+package clustertemplates
+
+import (
+	"encoding/base64"
+
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+func encodeManifest(content string) *string {
+	return swag.String(base64.StdEncoding.EncodeToString([]byte(content)))
+}
+
+func newTestSpec() *models.ClusterTemplateSpec {
+	return &models.ClusterTemplateSpec{
+		Parameters: []*models.ClusterTemplateParameter{
+			{Name: swag.String("domain"), Required: swag.Bool(true)},
+			{Name: swag.String("masters"), Default: swag.String("3")},
+			{Name: swag.String("ntp")},
+		},
+		ClusterParams: map[string]interface{}{
+			"openshift_version":     "4.14",
+			"base_dns_domain":       "${domain}",
+			"control_plane_count":   "${masters}",
+			"additional_ntp_source": "ntp-${ntp}.${domain}",
+		},
+		InfraEnvParams: map[string]interface{}{
+			"ssh_authorized_key": "ssh-rsa ${cluster_name}",
+		},
+		Manifests: []*models.CreateManifestParams{
+			{FileName: swag.String("a.yaml"), Content: encodeManifest("domain: ${domain}")},
+		},
+	}
+}
+
+var _ = Describe("ValidateSpec", func() {
+	It("accepts a valid spec", func() {
+		Expect(ValidateSpec(newTestSpec())).To(Succeed())
+		Expect(ValidateSpec(nil)).To(Succeed())
+		Expect(ValidateSpec(&models.ClusterTemplateSpec{})).To(Succeed())
+	})
+
+	DescribeTable("rejects an invalid spec",
+		func(update func(spec *models.ClusterTemplateSpec), message string) {
+			spec := newTestSpec()
+			update(spec)
+			Expect(ValidateSpec(spec)).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("invalid parameter name", func(spec *models.ClusterTemplateSpec) {
+			spec.Parameters[0].Name = swag.String("1domain")
+		}, "invalid parameter name"),
+		Entry("duplicate parameter", func(spec *models.ClusterTemplateSpec) {
+			spec.Parameters[1].Name = swag.String("domain")
+		}, "declared more than once"),
+		Entry("builtin parameter", func(spec *models.ClusterTemplateSpec) {
+			spec.Parameters[0].Name = swag.String(ClusterNameParameter)
+		}, "declared more than once"),
+		Entry("cluster params that are not an object", func(spec *models.ClusterTemplateSpec) {
+			spec.ClusterParams = []interface{}{"a"}
+		}, "cluster_params must be an object"),
+		Entry("unknown cluster property", func(spec *models.ClusterTemplateSpec) {
+			spec.ClusterParams.(map[string]interface{})["unknown"] = "a"
+		}, "cluster_params has unknown property unknown"),
+		Entry("pull secret in the cluster params", func(spec *models.ClusterTemplateSpec) {
+			spec.ClusterParams.(map[string]interface{})["pull_secret"] = "secret"
+		}, "pull_secret may not be set"),
+		Entry("cluster id in the infra-env params", func(spec *models.ClusterTemplateSpec) {
+			spec.InfraEnvParams.(map[string]interface{})["cluster_id"] = "id"
+		}, "cluster_id may not be set"),
+		Entry("undeclared parameter", func(spec *models.ClusterTemplateSpec) {
+			spec.InfraEnvParams.(map[string]interface{})["additional_ntp_sources"] = "${proxy}"
+		}, "parameter proxy is referenced but not declared"),
+		Entry("undeclared parameter in a manifest", func(spec *models.ClusterTemplateSpec) {
+			spec.Manifests[0].Content = encodeManifest("proxy: ${proxy}")
+		}, "parameter proxy is referenced but not declared"),
+		Entry("duplicate manifest", func(spec *models.ClusterTemplateSpec) {
+			spec.Manifests = append(spec.Manifests, &models.CreateManifestParams{
+				Folder:   swag.String(models.CreateManifestParamsFolderManifests),
+				FileName: swag.String("a.yaml"),
+				Content:  encodeManifest("a: b"),
+			})
+		}, "manifest manifests/a.yaml is set more than once"),
+		Entry("manifest that is not encoded", func(spec *models.ClusterTemplateSpec) {
+			spec.Manifests[0].Content = swag.String("a: b")
+		}, "failed to decode the content of manifest"),
+	)
+})
+
+var _ = Describe("instantiate", func() {
+	overrides := func() *models.ClusterCreateParams {
+		return &models.ClusterCreateParams{
+			Name:             swag.String("cluster"),
+			OpenshiftVersion: swag.String("4.15"),
+			PullSecret:       swag.String("secret"),
+		}
+	}
+
+	It("expands the parameters", func() {
+		instance, err := instantiate(newTestSpec(), []string{"domain=example.com", "ntp=1"}, overrides())
+		Expect(err).ToNot(HaveOccurred())
+		params := instance.ClusterParams
+		Expect(params.Name).To(Equal(swag.String("cluster")))
+		Expect(params.PullSecret).To(Equal(swag.String("secret")))
+		Expect(params.BaseDNSDomain).To(Equal("example.com"))
+		Expect(params.ControlPlaneCount).To(Equal(swag.Int64(3)))
+		Expect(params.AdditionalNtpSource).To(Equal(swag.String("ntp-1.example.com")))
+		Expect(instance.InfraEnvParams.SSHAuthorizedKey).To(Equal(swag.String("ssh-rsa cluster")))
+		Expect(instance.Manifests).To(Equal([]*models.CreateManifestParams{{
+			Folder:   swag.String(models.CreateManifestParamsFolderManifests),
+			FileName: swag.String("a.yaml"),
+			Content:  encodeManifest("domain: example.com"),
+		}}))
+	})
+
+	It("applies the overrides over the template", func() {
+		params := overrides()
+		params.BaseDNSDomain = "override.com"
+		instance, err := instantiate(newTestSpec(), []string{"domain=example.com", "masters=1"}, params)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(instance.ClusterParams.OpenshiftVersion).To(Equal(swag.String("4.15")))
+		Expect(instance.ClusterParams.BaseDNSDomain).To(Equal("override.com"))
+		Expect(instance.ClusterParams.ControlPlaneCount).To(Equal(swag.Int64(1)))
+		Expect(instance.ClusterParams.AdditionalNtpSource).To(Equal(swag.String("ntp-.example.com")))
+	})
+
+	It("does not create an infra-env when the template has no infra-env params", func() {
+		instance, err := instantiate(&models.ClusterTemplateSpec{}, nil, overrides())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(instance.InfraEnvParams).To(BeNil())
+		Expect(instance.Manifests).To(BeEmpty())
+	})
+
+	DescribeTable("fails on invalid parameters",
+		func(parameters []string, message string) {
+			_, err := instantiate(newTestSpec(), parameters, overrides())
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("missing required parameter", []string{}, "template parameter domain is required"),
+		Entry("unknown parameter", []string{"domain=a", "other=b"}, "template has no parameter other"),
+		Entry("malformed parameter", []string{"domain"}, "is not formatted as name=value"),
+		Entry("value of the wrong type", []string{"domain=a", "masters=[1]"}, "invalid cluster params"),
+	)
+})
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/openshift/assisted-service/internal/clustertemplates (interfaces: ClusterTemplateInternals)
+
+// Package clustertemplates is a generated GoMock package.
+package clustertemplates
+
+import (
+	context "context"
+	reflect "reflect"
+
+	strfmt "github.com/go-openapi/strfmt"
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/openshift/assisted-service/models"
+)
+
+// MockClusterTemplateInternals is a mock of ClusterTemplateInternals interface.
+type MockClusterTemplateInternals struct {
+	ctrl     *gomock.Controller
+	recorder *MockClusterTemplateInternalsMockRecorder
+}
+
+// MockClusterTemplateInternalsMockRecorder is the mock recorder for MockClusterTemplateInternals.
+type MockClusterTemplateInternalsMockRecorder struct {
+	mock *MockClusterTemplateInternals
+}
+
+// NewMockClusterTemplateInternals creates a new mock instance.
+func NewMockClusterTemplateInternals(ctrl *gomock.Controller) *MockClusterTemplateInternals {
+	mock := &MockClusterTemplateInternals{ctrl: ctrl}
+	mock.recorder = &MockClusterTemplateInternalsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClusterTemplateInternals) EXPECT() *MockClusterTemplateInternalsMockRecorder {
+	return m.recorder
+}
+
+// DeleteClusterTemplateInternal mocks base method.
+func (m *MockClusterTemplateInternals) DeleteClusterTemplateInternal(arg0 context.Context, arg1 strfmt.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteClusterTemplateInternal", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteClusterTemplateInternal indicates an expected call of DeleteClusterTemplateInternal.
+func (mr *MockClusterTemplateInternalsMockRecorder) DeleteClusterTemplateInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClusterTemplateInternal", reflect.TypeOf((*MockClusterTemplateInternals)(nil).DeleteClusterTemplateInternal), arg0, arg1)
+}
+
+// SyncClusterTemplateInternal mocks base method.
+func (m *MockClusterTemplateInternals) SyncClusterTemplateInternal(arg0 context.Context, arg1 strfmt.UUID, arg2, arg3 string, arg4 *models.ClusterTemplateSpec) (*models.ClusterTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncClusterTemplateInternal", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*models.ClusterTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncClusterTemplateInternal indicates an expected call of SyncClusterTemplateInternal.
+func (mr *MockClusterTemplateInternalsMockRecorder) SyncClusterTemplateInternal(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncClusterTemplateInternal", reflect.TypeOf((*MockClusterTemplateInternals)(nil).SyncClusterTemplateInternal), arg0, arg1, arg2, arg3, arg4)
+}
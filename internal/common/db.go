@@ -282,6 +282,109 @@ func (i *InfraEnv) SetMirrorRegistryConfiguration(configuration *MirrorRegistryC
 	return nil
 }
 
+// WatchNotification is a notification envelope persisted so that it can be served to, and replayed by,
+// clients of the watch API. The auto-incremented ID is the offset clients resume from.
+type WatchNotification struct {
+	ID         int64        `gorm:"primaryKey"`
+	CreatedAt  time.Time    `gorm:"index"`
+	ClusterID  *strfmt.UUID `gorm:"index"`
+	InfraEnvID *strfmt.UUID `gorm:"index"`
+	HostID     *strfmt.UUID
+	Type       string
+	Envelope   string `gorm:"type:text"`
+}
+
+// OutboxNotification is a notification written by the transaction that persists the change it is about, so that
+// it is relayed to the stream writers if and only if the change is committed
+type OutboxNotification struct {
+	ID        int64 `gorm:"primaryKey"`
+	CreatedAt time.Time
+	// Key of the notification, usually the cluster ID. Notifications with the same key are relayed in order.
+	Key       string `gorm:"index"`
+	Type      string
+	Payload   string `gorm:"type:text"`
+	Attempts  int
+	LastError string `gorm:"type:varchar(1024)"`
+	// DeadLettered is set when the notification can't be relayed, and it's no longer retried
+	DeadLettered bool `gorm:"index;default:false"`
+}
+
+// Subscription is a webhook registered by a user in order to be notified about changes of its clusters
+type Subscription struct {
+	models.Subscription
+	// EncryptedSecret is the secret used to sign the notifications, encrypted with the secret encryption key of the
+	// service. The secret is never returned by the API.
+	EncryptedSecret string `gorm:"type:text"`
+}
+
+// SubscriptionDelivery is a notification of a change to a subscriber, along with its delivery state
+type SubscriptionDelivery struct {
+	models.SubscriptionDelivery
+	// DedupeKey identifies the change that triggered the delivery, so that a change that is notified more
+	// than once is delivered only once
+	DedupeKey string `gorm:"uniqueIndex:idx_subscription_deliveries_dedup,priority:2"`
+	// Payload is the request body posted to the subscriber
+	Payload string `gorm:"type:text"`
+}
+
+// ClusterRevision is a versioned snapshot of the configuration of a cluster
+type ClusterRevision struct {
+	models.ClusterRevision
+	// SnapshotJSON holds the encoded snapshot of the revision
+	SnapshotJSON string `gorm:"column:snapshot;type:text"`
+}
+
+// HostHardwareRevision is a revision of the hardware fingerprint of a host
+type HostHardwareRevision struct {
+	models.HostHardwareRevision
+	// FingerprintJSON holds the encoded fingerprint of the revision
+	FingerprintJSON string `gorm:"column:fingerprint;type:text"`
+}
+
+// ClusterTemplate is a reusable configuration clusters are registered from
+type ClusterTemplate struct {
+	models.ClusterTemplate
+	// SpecJSON holds the encoded spec of the template
+	SpecJSON string `gorm:"column:spec;type:text"`
+}
+
+// InstallerCacheEntry is an installer binary of the tier of the installer cache that is shared by the replicas of
+// the service. The binary itself is kept in the object storage. The entry also serves as the lock that ensures that
+// only one replica extracts the binary.
+type InstallerCacheEntry struct {
+	// ID identifies the binary by the digest of its release, the architecture and the name of the binary
+	ID             string `gorm:"primaryKey"`
+	ReleaseDigest  string `gorm:"index"`
+	ReleaseVersion string
+	Binary         string
+	// SHA256 is the checksum of the binary, it is empty until the binary is stored
+	SHA256 string
+	Size   int64
+	// Owner is the replica that extracts the binary, until LockExpiresAt when another replica may take over
+	Owner         string
+	LockExpiresAt time.Time
+	CreatedAt     time.Time
+	LastUsedAt    time.Time `gorm:"index"`
+}
+
+// InstallerCacheRelease is a release that was pre-warmed in the installer cache by an administrator, and that may be
+// pinned so that it is never evicted
+type InstallerCacheRelease struct {
+	ReleaseImage       string `gorm:"primaryKey"`
+	ReleaseImageMirror string
+	// ReleaseDigest is the digest of the release image, which keys the binaries of the shared installer cache
+	ReleaseDigest     string
+	OpenshiftVersion  string
+	Pinned            bool
+	PrewarmStatus     string
+	PrewarmStatusInfo string `gorm:"type:varchar(1024)"`
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	// Configured is set for the releases that are listed in the configuration of the installer cache, whose pin is
+	// removed once they are no longer listed
+	Configured bool
+}
+
 type EagerLoadingState bool
 
 const (
@@ -328,6 +431,16 @@ func AutoMigrate(db *gorm.DB) error {
 		&models.MachineNetwork{},
 		&models.APIVip{},
 		&models.IngressVip{},
+		&WatchNotification{},
+		&OutboxNotification{},
+		&Subscription{},
+		&SubscriptionDelivery{},
+		&models.SubscriptionDeliveryAttempt{},
+		&ClusterRevision{},
+		&HostHardwareRevision{},
+		&ClusterTemplate{},
+		&InstallerCacheEntry{},
+		&InstallerCacheRelease{},
 	)
 }
 
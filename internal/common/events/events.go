@@ -2703,6 +2703,109 @@ func (e *HostRoleUpdatedEvent) FormatMessage() string {
     return e.format(&s)
 }
 
+//
+// Event host_hardware_drift_detected
+//
+type HostHardwareDriftDetectedEvent struct {
+    eventName string
+    ClusterId *strfmt.UUID
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    HostName string
+    Changes string
+}
+
+var HostHardwareDriftDetectedEventName string = "host_hardware_drift_detected"
+
+func NewHostHardwareDriftDetectedEvent(
+    clusterId *strfmt.UUID,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    hostName string,
+    changes string,
+) *HostHardwareDriftDetectedEvent {
+    return &HostHardwareDriftDetectedEvent{
+        eventName: HostHardwareDriftDetectedEventName,
+        ClusterId: clusterId,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        HostName: hostName,
+        Changes: changes,
+    }
+}
+
+func SendHostHardwareDriftDetectedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId *strfmt.UUID,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    hostName string,
+    changes string,) {
+    ev := NewHostHardwareDriftDetectedEvent(
+        clusterId,
+        hostId,
+        infraEnvId,
+        hostName,
+        changes,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostHardwareDriftDetectedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId *strfmt.UUID,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    hostName string,
+    changes string,
+    eventTime time.Time) {
+    ev := NewHostHardwareDriftDetectedEvent(
+        clusterId,
+        hostId,
+        infraEnvId,
+        hostName,
+        changes,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostHardwareDriftDetectedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostHardwareDriftDetectedEvent) GetSeverity() string {
+    return "warning"
+}
+func (e *HostHardwareDriftDetectedEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostHardwareDriftDetectedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostHardwareDriftDetectedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostHardwareDriftDetectedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{changes}", fmt.Sprint(e.Changes),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostHardwareDriftDetectedEvent) FormatMessage() string {
+    s := "Host {host_name}: hardware changed after the host was validated: {changes}"
+    return e.format(&s)
+}
+
 //
 // Event image_status_updated
 //
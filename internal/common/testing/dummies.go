@@ -1,12 +1,20 @@
 package testing
 
 import (
+	"context"
+
 	"github.com/golang/mock/gomock"
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/stream"
+	"gorm.io/gorm"
 )
 
 func GetDummyNotificationStream(ctrl *gomock.Controller) *stream.MockNotifier {
 	dummyStream := stream.NewMockNotifier(ctrl)
 	dummyStream.EXPECT().Notify(gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+	dummyStream.EXPECT().NotifyInTransaction(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+		func(_ context.Context, db *gorm.DB, fn func(tx *gorm.DB, notify stream.NotifyFunc) error) error {
+			return fn(db, func(common.Notifiable) error { return nil })
+		})
 	return dummyStream
 }
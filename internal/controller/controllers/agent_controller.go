@@ -57,6 +57,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -103,6 +104,8 @@ type AgentReconciler struct {
 	HostFSMountDir             string
 	reclaimer                  *agentReclaimer
 	ImageServiceEnabled        bool
+	Drainer                    Drainer
+	Recorder                   record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=agent-install.openshift.io,resources=agents,verbs=get;list;watch;create;update;patch;delete
@@ -110,6 +113,7 @@ type AgentReconciler struct {
 // +kubebuilder:rbac:groups=agent-install.openshift.io,resources=agents/ai-deprovision,verbs=update
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 // +kubebuilder:rbac:groups=agent-install.openshift.io,resources=infraenvs,verbs=get
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 func (r *AgentReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	ctx := addRequestIdIfNeeded(origCtx)
@@ -192,6 +196,10 @@ func (r *AgentReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (
 		}
 	}
 
+	if res, handled, decommissionErr := r.decommission(ctx, log, agent, origAgent, h); handled {
+		return res, decommissionErr
+	}
+
 	if agent.Spec.ClusterDeploymentName == nil && h.ClusterID != nil {
 		log.Debugf("ClusterDeploymentName is unset in Agent %s.", agent.Name)
 		if funk.ContainsString(host.HostInstallingStatuses, *h.Status) && swag.StringValue(h.Kind) != models.HostKindAddToExistingClusterHost {
@@ -576,7 +584,7 @@ func (r *AgentReconciler) shouldApproveCSR(csr *certificatesv1.CertificateSignin
 	return validateNodeCsr(agent, csr, x509CSR)
 }
 
-func (r *AgentReconciler) approveAIHostsCSRs(ctx context.Context, clients spoke_k8s_client.SpokeK8sClient, agent *aiv1beta1.Agent, validateNodeCsr nodeCsrValidator, csrType aiv1beta1.CSRType) {
+func (r *AgentReconciler) approveAIHostsCSRs(ctx context.Context, clients spoke_k8s_client.SpokeK8sClient, agent *aiv1beta1.Agent, validateNodeCsr nodeCsrValidator, csrType aiv1beta1.CSRType, policy *csrApprovalPolicy) {
 	csrList, err := clients.ListCsrs(ctx)
 	if err != nil {
 		r.Log.WithError(err).Errorf("Failed to get CSRs for agent %s/%s", agent.Namespace, agent.Name)
@@ -587,6 +595,9 @@ func (r *AgentReconciler) approveAIHostsCSRs(ctx context.Context, clients spoke_
 	if agent.Status.CSRStatus.ApprovedCSRs == nil {
 		agent.Status.CSRStatus.ApprovedCSRs = []aiv1beta1.CSRInfo{}
 	}
+	if agent.Status.CSRStatus.ApprovalStartedAt.IsZero() {
+		agent.Status.CSRStatus.ApprovalStartedAt = metav1.Now()
+	}
 
 	for i := range csrList.Items {
 		csr := &csrList.Items[i]
@@ -607,10 +618,17 @@ func (r *AgentReconciler) approveAIHostsCSRs(ctx context.Context, clients spoke_
 
 		approvedAt := metav1.Now()
 		if !isApproved {
+			approve, reason := policy.evaluate(agent, csr, approvedAt.Time)
+			if !approve {
+				r.Log.Infof("Not approving CSR %s for agent %s/%s: %s", csr.Name, agent.Namespace, agent.Name, reason)
+				r.recordCSRDecision(agent, csr.Name, csrType, false, reason)
+				continue
+			}
 			if err = clients.ApproveCsr(ctx, csr); err != nil {
 				r.Log.WithError(err).Errorf("Failed to approve CSR %s for agent %s/%s", csr.Name, agent.Namespace, agent.Name)
 				continue
 			}
+			r.recordCSRDecision(agent, csr.Name, csrType, true, reason)
 		} else {
 			approvedAt = getCSRApprovalTime(csr)
 		}
@@ -640,10 +658,20 @@ func (r *AgentReconciler) trackCSR(agent *aiv1beta1.Agent, csrName string, csrTy
 }
 
 func (r *AgentReconciler) spokeKubeClient(ctx context.Context, clusterRef *aiv1beta1.ClusterReference) (spoke_k8s_client.SpokeK8sClient, error) {
+	clusterDeployment, secret, err := r.spokeKubeconfig(ctx, clusterRef)
+	if err != nil {
+		return nil, err
+	}
+	return r.SpokeK8sClientFactory.CreateFromSecret(clusterDeployment, secret)
+}
+
+// spokeKubeconfig returns the cluster deployment and the kubeconfig secret of the spoke cluster. The cluster deployment
+// is nil if it doesn't exist
+func (r *AgentReconciler) spokeKubeconfig(ctx context.Context, clusterRef *aiv1beta1.ClusterReference) (*hivev1.ClusterDeployment, *corev1.Secret, error) {
 	secret, err := spokeKubeconfigSecret(ctx, r.Log, r.Client, r.APIReader, clusterRef)
 	if err != nil {
 		r.Log.WithError(err).Errorf("failed to get spoke secret for cluster %s/%s", clusterRef.Namespace, clusterRef.Name)
-		return nil, err
+		return nil, nil, err
 	}
 	clusterDeploymentKey := types.NamespacedName{
 		Namespace: clusterRef.Namespace,
@@ -662,9 +690,9 @@ func (r *AgentReconciler) spokeKubeClient(ctx context.Context, clusterRef *aiv1b
 			"failed to get cluster deployment for cluster %s/%s",
 			clusterRef.Namespace, clusterRef.Name,
 		)
-		return nil, err
+		return nil, nil, err
 	}
-	return r.SpokeK8sClientFactory.CreateFromSecret(clusterDeployment, secret)
+	return clusterDeployment, secret, nil
 }
 
 // Attempt to approve CSRs for agent. If already approved then the node will be marked as done
@@ -672,12 +700,18 @@ func (r *AgentReconciler) spokeKubeClient(ctx context.Context, clusterRef *aiv1b
 func (r *AgentReconciler) tryApproveDay2CSRs(ctx context.Context, agent *aiv1beta1.Agent, node *corev1.Node, client spoke_k8s_client.SpokeK8sClient) {
 	r.Log.Infof("Approving CSRs for agent %s/%s", agent.Namespace, agent.Name)
 
+	policy, err := r.getCSRApprovalPolicy(ctx, agent)
+	if err != nil {
+		r.Log.WithError(err).Errorf("Failed to get the CSR approval policy for agent %s/%s", agent.Namespace, agent.Name)
+		return
+	}
+
 	// Try to approve client CSRs
-	r.approveAIHostsCSRs(ctx, client, agent, validateNodeClientCSR, aiv1beta1.CSRTypeClient)
+	r.approveAIHostsCSRs(ctx, client, agent, validateNodeClientCSR, aiv1beta1.CSRTypeClient, policy)
 
 	// Also try serving CSRs if node exists
 	if node != nil {
-		r.approveAIHostsCSRs(ctx, client, agent, createNodeServerCsrValidator(node), aiv1beta1.CSRTypeServing)
+		r.approveAIHostsCSRs(ctx, client, agent, createNodeServerCsrValidator(node), aiv1beta1.CSRTypeServing, policy)
 	}
 }
 
@@ -764,6 +798,14 @@ func (r *AgentReconciler) runReclaimAgent(ctx context.Context, log logrus.FieldL
 		return err
 	}
 
+	var wipeDisks []string
+	if agent.Spec.Decommission && agent.Spec.WipeDisksOnDecommission {
+		wipeDisks, err = decommissionWipeDisks(&host.Host)
+		if err != nil {
+			return err
+		}
+	}
+
 	hostname := getAgentHostname(agent)
 	r.Log.Infof("Starting agent pod for reclaim on node %s", hostname)
 	if err := ensureSpokeNamespace(ctx, client, log); err != nil {
@@ -784,7 +826,7 @@ func (r *AgentReconciler) runReclaimAgent(ctx context.Context, log logrus.FieldL
 	if err := r.reclaimer.ensureSpokeAgentCertCM(ctx, client, log); err != nil {
 		return err
 	}
-	return r.reclaimer.createNextStepRunnerDaemonSet(ctx, client, log, hostname, host.InfraEnvID.String(), host.ID.String())
+	return r.reclaimer.createNextStepRunnerDaemonSet(ctx, client, log, hostname, host.InfraEnvID.String(), host.ID.String(), wipeDisks)
 }
 
 func (r *AgentReconciler) unbindHost(ctx context.Context, log logrus.FieldLogger, agent, origAgent *aiv1beta1.Agent, h *common.Host) (ctrl.Result, error) {
@@ -2243,11 +2285,13 @@ func createNewHost(agent *v1beta1.Agent, clusterID *strfmt.UUID, infraEnvID strf
 	return host, nil
 }
 
-// resetCSRStatus resets all CSR tracking information in the agent status
+// resetCSRStatus resets all CSR tracking information in the agent status. The CSR decisions are kept as an audit trail
 func resetCSRStatus(agent *aiv1beta1.Agent) {
 	agent.Status.CSRStatus = aiv1beta1.CSRStatus{
 		ApprovedCSRs:        []aiv1beta1.CSRInfo{},
 		LastApprovalAttempt: metav1.Time{},
+		ApprovalStartedAt:   metav1.Time{},
+		Decisions:           agent.Status.CSRStatus.Decisions,
 	}
 }
 
@@ -41,6 +41,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -2997,6 +2998,7 @@ VU1eS0RiS/Lz6HwRs2mATNY5FrpZOgdM3cI=
 			SpokeK8sClientFactory:      mockClientFactory,
 			ApproveCsrsRequeueDuration: time.Minute,
 			ImageServiceEnabled:        true,
+			Recorder:                   record.NewFakeRecorder(10),
 		}
 		sId := strfmt.UUID(uuid.New().String())
 		hostId = strfmt.UUID(uuid.New().String())
@@ -4030,6 +4032,109 @@ VU1eS0RiS/Lz6HwRs2mATNY5FrpZOgdM3cI=
 		Expect(agent.Status.CSRStatus.ApprovedCSRs).To(HaveLen(2), "Both CSRs should be tracked")
 	})
 
+	It("records the CSRs denied by the CSR approval policy of the cluster", func() {
+		aci := newAciWithUserManagedNetworkingNoSNO("test-cluster-aci", testNamespace)
+		aci.Spec.CSRApprovalPolicy = &hiveext.CSRApprovalPolicy{MaxCSRsPerNode: 1}
+		Expect(c.Create(ctx, aci)).To(BeNil())
+
+		agentSpec := v1beta1.AgentSpec{
+			ClusterDeploymentName: &v1beta1.ClusterReference{Name: "clusterDeployment", Namespace: testNamespace},
+			Hostname:              CommonHostname,
+		}
+		host := newAgent(hostId.String(), testNamespace, agentSpec)
+		host.Spec.Approved = true
+		Expect(c.Create(ctx, host)).To(BeNil())
+
+		mockInstallerInternal.EXPECT().UpdateHostApprovedInternal(gomock.Any(), gomock.Any(), gomock.Any(), true).Return(nil)
+		mockInstallerInternal.EXPECT().V2UpdateHostInstallProgressInternal(gomock.Any(), gomock.Any())
+
+		node := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: CommonHostname,
+			},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{
+					{
+						Type:   corev1.NodeReady,
+						Status: corev1.ConditionTrue,
+					},
+				},
+				Addresses: []corev1.NodeAddress{
+					{
+						Type:    corev1.NodeInternalIP,
+						Address: "192.168.111.28",
+					},
+				},
+			},
+		}
+
+		bothCsrs := &certificatesv1.CertificateSigningRequestList{
+			Items: []certificatesv1.CertificateSigningRequest{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "client-csr"},
+					Spec: certificatesv1.CertificateSigningRequestSpec{
+						Request: []byte(x509ClientCsr),
+						Usages: []certificatesv1.KeyUsage{
+							certificatesv1.UsageDigitalSignature,
+							certificatesv1.UsageClientAuth,
+						},
+						Groups: []string{
+							"system:serviceaccounts:openshift-machine-config-operator",
+							"system:serviceaccounts",
+							"system:authenticated",
+						},
+						Username: "system:serviceaccount:openshift-machine-config-operator:node-bootstrapper",
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "server-csr"},
+					Spec: certificatesv1.CertificateSigningRequestSpec{
+						Request: []byte(x509ServerCSR),
+						Usages: []certificatesv1.KeyUsage{
+							certificatesv1.UsageDigitalSignature,
+							certificatesv1.UsageServerAuth,
+						},
+						Groups: []string{
+							"system:authenticated",
+							"system:nodes",
+						},
+						Username: nodeUserPrefix + CommonHostname,
+					},
+				},
+			},
+		}
+
+		mockClient := spoke_k8s_client.NewMockSpokeK8sClient(mockCtrl)
+		mockClientFactory.EXPECT().CreateFromSecret(gomock.Any(), gomock.Any()).Return(mockClient, nil)
+		mockClient.EXPECT().GetNode(gomock.Any(), gomock.Any()).Return(node, nil).Times(1)
+		mockClient.EXPECT().ListCsrs(gomock.Any()).Return(bothCsrs, nil).Times(2)
+		// Only the client CSR is approved, the policy allows a single CSR for the node
+		mockClient.EXPECT().ApproveCsr(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+		hostRequest = newHostRequest(host)
+		result, err := hr.Reconcile(ctx, hostRequest)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{RequeueAfter: time.Minute}))
+
+		agent := &v1beta1.Agent{}
+		Expect(c.Get(ctx, agentKey, agent)).To(BeNil())
+		Expect(agent.Status.Progress.CurrentStage).To(Equal(models.HostStageJoined))
+		Expect(agent.Status.CSRStatus.ApprovedCSRs).To(HaveLen(1))
+		Expect(agent.Status.CSRStatus.ApprovalStartedAt.IsZero()).To(BeFalse())
+		decisions := agent.Status.CSRStatus.Decisions
+		Expect(decisions).To(HaveLen(2))
+		Expect(decisions[0].Name).To(Equal("client-csr"))
+		Expect(decisions[0].Approved).To(BeTrue())
+		Expect(decisions[1].Name).To(Equal("server-csr"))
+		Expect(decisions[1].Approved).To(BeFalse())
+		Expect(decisions[1].Reason).To(Equal("The maximum of 1 approved CSRs for the node was reached"))
+
+		recorder := hr.Recorder.(*record.FakeRecorder)
+		Expect(recorder.Events).To(HaveLen(2))
+		Expect(<-recorder.Events).To(HavePrefix("Normal CSRApproved Approved client CSR client-csr"))
+		Expect(<-recorder.Events).To(HavePrefix("Warning CSRDenied Denied serving CSR server-csr"))
+	})
+
 	AfterEach(func() {
 		mockCtrl.Finish()
 	})
@@ -0,0 +1,232 @@
+package controllers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/swag"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const decommissionDrainRequeueAfter = 20 * time.Second
+
+// decommission runs the phase of the decommission workflow that the Decommissioned condition of the agent records, so
+// that the workflow resumes from the same phase after the controller restarts:
+// * Draining: the node is cordoned and drained.
+// * RemovingNode: the certificate signing requests of the node are deleted. The node is deleted too, unless the host is
+// rebooted into discovery by an agent that runs on the node. In that case the node is deleted by the spoke cleanup once
+// the host is unbound.
+// * Unbinding: the agent is unbound from the cluster deployment by the rest of the reconcile.
+// It returns true when the reconcile should return the result, and false when the rest of the reconcile should continue.
+func (r *AgentReconciler) decommission(ctx context.Context, log logrus.FieldLogger, agent, origAgent *aiv1beta1.Agent, h *common.Host) (ctrl.Result, bool, error) {
+	condition := conditionsv1.FindStatusCondition(agent.Status.Conditions, aiv1beta1.DecommissionedCondition)
+	if !agent.Spec.Decommission {
+		if condition != nil {
+			conditionsv1.RemoveStatusCondition(&agent.Status.Conditions, aiv1beta1.DecommissionedCondition)
+		}
+		return ctrl.Result{}, false, nil
+	}
+
+	phase := ""
+	if condition != nil {
+		phase = condition.Reason
+	}
+	if agent.Spec.ClusterDeploymentName == nil && (phase == aiv1beta1.DecommissionDrainingReason || phase == aiv1beta1.DecommissionRemovingNodeReason) {
+		// The agent was unbound by the user in the middle of the workflow
+		phase = aiv1beta1.DecommissionUnbindingReason
+	}
+
+	log = log.WithField("decommission_phase", phase)
+	switch phase {
+	case "", aiv1beta1.DecommissionNotInstalledReason:
+		if agent.Spec.ClusterDeploymentName == nil || h.ClusterID == nil ||
+			!funk.ContainsString([]string{models.HostStatusInstalled, models.HostStatusAddedToExistingCluster}, swag.StringValue(h.Status)) {
+			setDecommissionedCondition(agent, aiv1beta1.DecommissionNotInstalledReason, aiv1beta1.DecommissionNotInstalledMsg, nil)
+			return ctrl.Result{}, false, nil
+		}
+		log.Infof("Starting to decommission agent %s", agent.Name)
+		return r.setDecommissionPhase(ctx, log, agent, origAgent, aiv1beta1.DecommissionDrainingReason, aiv1beta1.DecommissionDrainingMsg)
+	case aiv1beta1.DecommissionDrainingReason:
+		requeue, err := r.drainDecommissionedNode(ctx, log, agent)
+		if err != nil {
+			setDecommissionedCondition(agent, aiv1beta1.DecommissionDrainingReason, aiv1beta1.DecommissionDrainingMsg, err)
+			return r.patchDecommissionStatus(ctx, log, agent, origAgent, err)
+		}
+		if requeue {
+			return ctrl.Result{RequeueAfter: decommissionDrainRequeueAfter}, true, nil
+		}
+		return r.setDecommissionPhase(ctx, log, agent, origAgent, aiv1beta1.DecommissionRemovingNodeReason, aiv1beta1.DecommissionRemovingNodeMsg)
+	case aiv1beta1.DecommissionRemovingNodeReason:
+		if err := r.removeDecommissionedNode(ctx, log, agent); err != nil {
+			setDecommissionedCondition(agent, aiv1beta1.DecommissionRemovingNodeReason, aiv1beta1.DecommissionRemovingNodeMsg, err)
+			return r.patchDecommissionStatus(ctx, log, agent, origAgent, err)
+		}
+		return r.setDecommissionPhase(ctx, log, agent, origAgent, aiv1beta1.DecommissionUnbindingReason, aiv1beta1.DecommissionUnbindingMsg)
+	case aiv1beta1.DecommissionUnbindingReason:
+		if agent.Spec.ClusterDeploymentName != nil {
+			log.Infof("Unbinding decommissioned agent %s from cluster deployment %s/%s", agent.Name,
+				agent.Spec.ClusterDeploymentName.Namespace, agent.Spec.ClusterDeploymentName.Name)
+			agent.Spec.ClusterDeploymentName = nil
+			if err := r.Update(ctx, agent); err != nil {
+				log.WithError(err).Error("failed to unbind decommissioned agent")
+				return ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}, true, err
+			}
+			return ctrl.Result{Requeue: true}, true, nil
+		}
+		if h.ClusterID == nil && agent.Status.DeprovisionInfo == nil {
+			log.Infof("Agent %s was decommissioned", agent.Name)
+			msg := aiv1beta1.DecommissionedMsg
+			if agent.Spec.WipeDisksOnDecommission && !r.shouldReclaimOnUnbind(ctx, agent) {
+				msg = aiv1beta1.DecommissionedDisksNotWipedMsg
+			}
+			conditionsv1.SetStatusConditionNoHeartbeat(&agent.Status.Conditions, conditionsv1.Condition{
+				Type:    aiv1beta1.DecommissionedCondition,
+				Status:  corev1.ConditionTrue,
+				Reason:  aiv1beta1.DecommissionedReason,
+				Message: msg,
+			})
+		}
+	}
+	return ctrl.Result{}, false, nil
+}
+
+func setDecommissionedCondition(agent *aiv1beta1.Agent, reason, msg string, err error) {
+	if err != nil {
+		msg = msg + ": " + err.Error()
+	}
+	conditionsv1.SetStatusConditionNoHeartbeat(&agent.Status.Conditions, conditionsv1.Condition{
+		Type:    aiv1beta1.DecommissionedCondition,
+		Status:  corev1.ConditionFalse,
+		Reason:  reason,
+		Message: msg,
+	})
+}
+
+// setDecommissionPhase moves the decommission workflow of the agent to the given phase, and requeues the agent to run it
+func (r *AgentReconciler) setDecommissionPhase(ctx context.Context, log logrus.FieldLogger, agent, origAgent *aiv1beta1.Agent, reason, msg string) (ctrl.Result, bool, error) {
+	setDecommissionedCondition(agent, reason, msg, nil)
+	res, handled, err := r.patchDecommissionStatus(ctx, log, agent, origAgent, nil)
+	if err == nil {
+		res = ctrl.Result{Requeue: true}
+	}
+	return res, handled, err
+}
+
+func (r *AgentReconciler) patchDecommissionStatus(ctx context.Context, log logrus.FieldLogger, agent, origAgent *aiv1beta1.Agent, err error) (ctrl.Result, bool, error) {
+	if patchErr := r.Status().Patch(ctx, agent, client.MergeFrom(origAgent)); patchErr != nil {
+		log.WithError(patchErr).Error("failed to patch agent status")
+		if err == nil {
+			err = patchErr
+		}
+	}
+	if err != nil {
+		return ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}, true, err
+	}
+	return ctrl.Result{}, true, nil
+}
+
+// drainDecommissionedNode cordons and drains the node of the agent. It returns true when the drain should be retried
+func (r *AgentReconciler) drainDecommissionedNode(ctx context.Context, log logrus.FieldLogger, agent *aiv1beta1.Agent) (bool, error) {
+	clusterDeployment, secret, err := r.spokeKubeconfig(ctx, agent.Spec.ClusterDeploymentName)
+	if err != nil {
+		return false, err
+	}
+	spokeClient, clientset, err := r.SpokeK8sClientFactory.ClientAndSetFromSecret(clusterDeployment, secret)
+	if err != nil {
+		log.WithError(err).Error("failed to create spoke client")
+		return false, err
+	}
+
+	nodeName := getAgentHostname(agent)
+	node, err := spokeClient.GetNode(ctx, nodeName)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			log.Infof("Node %s of decommissioned agent not found, skipping drain", nodeName)
+			return false, nil
+		}
+		log.WithError(err).Errorf("failed to get node %s", nodeName)
+		return false, err
+	}
+	log.Infof("Draining node %s of decommissioned agent", nodeName)
+	return cordonAndDrainNode(ctx, log, r.Drainer, clientset, node)
+}
+
+// removeDecommissionedNode deletes the certificate signing requests of the node of the agent, and the node itself
+// unless the host is going to be rebooted into discovery by an agent that runs on the node
+func (r *AgentReconciler) removeDecommissionedNode(ctx context.Context, log logrus.FieldLogger, agent *aiv1beta1.Agent) error {
+	spokeClient, err := r.spokeKubeClient(ctx, agent.Spec.ClusterDeploymentName)
+	if err != nil {
+		return err
+	}
+
+	nodeName := getAgentHostname(agent)
+	csrs, err := spokeClient.ListCsrs(ctx)
+	if err != nil {
+		log.WithError(err).Error("failed to list CSRs")
+		return err
+	}
+	for i := range csrs.Items {
+		csr := &csrs.Items[i]
+		x509CSR, err := getX509ParsedRequest(csr)
+		if err != nil {
+			log.WithError(err).Warnf("failed to parse CSR %s", csr.Name)
+			continue
+		}
+		if !isCsrAssociatedWithAgent(x509CSR, agent) {
+			continue
+		}
+		if err = client.IgnoreNotFound(spokeClient.DeleteCsr(ctx, csr.Name)); err != nil {
+			log.WithError(err).Errorf("failed to delete CSR %s", csr.Name)
+			return errors.Wrapf(err, "failed to delete CSR %s", csr.Name)
+		}
+		log.Infof("Deleted CSR %s of node %s", csr.Name, nodeName)
+	}
+
+	if r.shouldReclaimOnUnbind(ctx, agent) {
+		log.Infof("Node %s will be removed once the host is rebooted into discovery", nodeName)
+		return nil
+	}
+	return removeSpokeResources(ctx, log, spokeClient, nodeName)
+}
+
+// decommissionWipeDisks returns the disks of the host to wipe before it's rebooted into discovery. These are the local
+// disks other than the installation disk and the disks that it's made of
+func decommissionWipeDisks(host *models.Host) ([]string, error) {
+	inventory, err := common.UnmarshalInventory(host.Inventory)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the inventory of host %s", host.ID)
+	}
+
+	var installationDiskName string
+	for _, disk := range inventory.Disks {
+		if disk.ID == host.InstallationDiskID {
+			installationDiskName = disk.Name
+		}
+	}
+
+	var ret []string
+	for _, disk := range inventory.Disks {
+		if disk.ID == host.InstallationDiskID || disk.IsInstallationMedia || disk.Removable {
+			continue
+		}
+		if disk.DriveType != models.DriveTypeHDD && disk.DriveType != models.DriveTypeSSD {
+			continue
+		}
+		if installationDiskName != "" && funk.ContainsString(strings.Split(disk.Holders, ","), installationDiskName) {
+			continue
+		}
+		ret = append(ret, common.GetDeviceIdentifier(disk))
+	}
+	return ret, nil
+}
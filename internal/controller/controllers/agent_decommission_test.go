@@ -0,0 +1,308 @@
+package controllers
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"github.com/openshift/assisted-service/models"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/pkg/errors"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/kubectl/pkg/drain"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newNodeCSR(name, nodeName string, ips ...net.IP) certificatesv1.CertificateSigningRequest {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+	request, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:     pkix.Name{Organization: []string{nodeGroup}, CommonName: nodeUserPrefix + nodeName},
+		IPAddresses: ips,
+	}, key)
+	Expect(err).ToNot(HaveOccurred())
+	return certificatesv1.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: certificatesv1.CertificateSigningRequestSpec{
+			Request: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: request}),
+		},
+	}
+}
+
+var _ = Describe("agent decommission", func() {
+	var (
+		c                     client.Client
+		hr                    *AgentReconciler
+		ctx                   = context.Background()
+		mockCtrl              *gomock.Controller
+		mockClientFactory     *spoke_k8s_client.MockSpokeK8sClientFactory
+		mockSpokeClient       *spoke_k8s_client.MockSpokeK8sClient
+		mockDrainer           *MockDrainer
+		clusterDeploymentName = "test-cluster"
+		agentHostname         = "worker-0.example.com"
+		agentKey              types.NamespacedName
+		host                  *common.Host
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).
+			WithStatusSubresource(&v1beta1.Agent{}).Build()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockClientFactory = spoke_k8s_client.NewMockSpokeK8sClientFactory(mockCtrl)
+		mockSpokeClient = spoke_k8s_client.NewMockSpokeK8sClient(mockCtrl)
+		mockDrainer = NewMockDrainer(mockCtrl)
+		hr = &AgentReconciler{
+			Client:                c,
+			APIReader:             c,
+			Scheme:                scheme.Scheme,
+			Log:                   common.GetTestLog(),
+			SpokeK8sClientFactory: mockClientFactory,
+			ImageServiceEnabled:   true,
+			Drainer:               mockDrainer,
+		}
+
+		secretName := fmt.Sprintf(adminKubeConfigStringTemplate, clusterDeploymentName)
+		cdSpec := getDefaultClusterDeploymentSpec(clusterDeploymentName, "test-cluster-aci", "pull-secret")
+		cdSpec.ClusterMetadata = &hivev1.ClusterMetadata{AdminKubeconfigSecretRef: corev1.LocalObjectReference{Name: secretName}}
+		Expect(c.Create(ctx, newClusterDeployment(clusterDeploymentName, testNamespace, cdSpec))).To(Succeed())
+		Expect(c.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: testNamespace},
+			Data:       map[string][]byte{"kubeconfig": []byte("somekubeconfig")},
+		})).To(Succeed())
+
+		clusterID := strfmt.UUID(uuid.New().String())
+		hostID := strfmt.UUID(uuid.New().String())
+		host = &common.Host{Host: models.Host{
+			ID:         &hostID,
+			ClusterID:  &clusterID,
+			InfraEnvID: strfmt.UUID(uuid.New().String()),
+			Status:     swag.String(models.HostStatusAddedToExistingCluster),
+		}}
+
+		agent := newAgent("agent", testNamespace, v1beta1.AgentSpec{
+			ClusterDeploymentName: &v1beta1.ClusterReference{Name: clusterDeploymentName, Namespace: testNamespace},
+			Hostname:              agentHostname,
+			Decommission:          true,
+		})
+		Expect(c.Create(ctx, agent)).To(Succeed())
+		agentKey = types.NamespacedName{Name: agent.Name, Namespace: agent.Namespace}
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	getAgent := func() *v1beta1.Agent {
+		agent := &v1beta1.Agent{}
+		Expect(c.Get(ctx, agentKey, agent)).To(Succeed())
+		return agent
+	}
+
+	withPhase := func(reason string) {
+		agent := getAgent()
+		setDecommissionedCondition(agent, reason, "", nil)
+		Expect(c.Status().Update(ctx, agent)).To(Succeed())
+	}
+
+	decommission := func() (*v1beta1.Agent, ctrl.Result, bool, error) {
+		agent := getAgent()
+		res, handled, err := hr.decommission(ctx, common.GetTestLog(), agent, agent.DeepCopy(), host)
+		return agent, res, handled, err
+	}
+
+	expectPhase := func(agent *v1beta1.Agent, status corev1.ConditionStatus, reason string) {
+		condition := conditionsv1.FindStatusCondition(agent.Status.Conditions, v1beta1.DecommissionedCondition)
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(status))
+		Expect(condition.Reason).To(Equal(reason))
+	}
+
+	It("waits for the agent to be installed", func() {
+		host.Status = swag.String(models.HostStatusKnown)
+		agent, _, handled, err := decommission()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(handled).To(BeFalse())
+		expectPhase(agent, corev1.ConditionFalse, v1beta1.DecommissionNotInstalledReason)
+	})
+
+	It("starts by draining the node of an installed agent", func() {
+		_, res, handled, err := decommission()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(handled).To(BeTrue())
+		Expect(res).To(Equal(ctrl.Result{Requeue: true}))
+		expectPhase(getAgent(), corev1.ConditionFalse, v1beta1.DecommissionDrainingReason)
+	})
+
+	Context("draining", func() {
+		var node *corev1.Node
+
+		BeforeEach(func() {
+			withPhase(v1beta1.DecommissionDrainingReason)
+			node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: agentHostname}}
+			mockClientFactory.EXPECT().ClientAndSetFromSecret(gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).Return(mockSpokeClient, &kubernetes.Clientset{}, nil)
+			mockSpokeClient.EXPECT().GetNode(gomock.Any(), agentHostname).Return(node, nil)
+			mockDrainer.EXPECT().RunCordonOrUncordon(gomock.AssignableToTypeOf(&drain.Helper{}), node, true).Return(nil)
+		})
+
+		It("moves to removing the node once it's drained", func() {
+			mockDrainer.EXPECT().RunNodeDrain(gomock.AssignableToTypeOf(&drain.Helper{}), agentHostname).Return(nil)
+			_, res, handled, err := decommission()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handled).To(BeTrue())
+			Expect(res).To(Equal(ctrl.Result{Requeue: true}))
+			expectPhase(getAgent(), corev1.ConditionFalse, v1beta1.DecommissionRemovingNodeReason)
+		})
+
+		It("retries a drain that timed out", func() {
+			mockDrainer.EXPECT().RunNodeDrain(gomock.AssignableToTypeOf(&drain.Helper{}), agentHostname).Return(errors.New("timed out"))
+			_, res, handled, err := decommission()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handled).To(BeTrue())
+			Expect(res).To(Equal(ctrl.Result{RequeueAfter: 20 * time.Second}))
+			expectPhase(getAgent(), corev1.ConditionFalse, v1beta1.DecommissionDrainingReason)
+		})
+	})
+
+	Context("removing the node", func() {
+		BeforeEach(func() {
+			withPhase(v1beta1.DecommissionRemovingNodeReason)
+			mockClientFactory.EXPECT().CreateFromSecret(gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).Return(mockSpokeClient, nil)
+			mockSpokeClient.EXPECT().ListCsrs(gomock.Any()).Return(&certificatesv1.CertificateSigningRequestList{
+				Items: []certificatesv1.CertificateSigningRequest{
+					newNodeCSR("csr-node", agentHostname),
+					newNodeCSR("csr-other-node", "worker-1.example.com"),
+				},
+			}, nil)
+			mockSpokeClient.EXPECT().DeleteCsr(gomock.Any(), "csr-node").Return(nil)
+		})
+
+		It("deletes the CSRs and the node of a host that isn't reclaimed", func() {
+			hr.ImageServiceEnabled = false
+			mockSpokeClient.EXPECT().Get(gomock.Any(), client.ObjectKey{Name: agentHostname}, gomock.AssignableToTypeOf(&corev1.Node{})).Return(nil)
+			mockSpokeClient.EXPECT().Delete(gomock.Any(), gomock.AssignableToTypeOf(&corev1.Node{})).Return(nil)
+			_, res, handled, err := decommission()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handled).To(BeTrue())
+			Expect(res).To(Equal(ctrl.Result{Requeue: true}))
+			expectPhase(getAgent(), corev1.ConditionFalse, v1beta1.DecommissionUnbindingReason)
+		})
+
+		It("keeps the node of a host that is rebooted into discovery", func() {
+			_, _, handled, err := decommission()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handled).To(BeTrue())
+			expectPhase(getAgent(), corev1.ConditionFalse, v1beta1.DecommissionUnbindingReason)
+		})
+	})
+
+	It("unbinds the agent", func() {
+		withPhase(v1beta1.DecommissionUnbindingReason)
+		_, res, handled, err := decommission()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(handled).To(BeTrue())
+		Expect(res).To(Equal(ctrl.Result{Requeue: true}))
+		Expect(getAgent().Spec.ClusterDeploymentName).To(BeNil())
+	})
+
+	It("waits for the spoke cleanup of the unbound host", func() {
+		withPhase(v1beta1.DecommissionUnbindingReason)
+		agent := getAgent()
+		agent.Spec.ClusterDeploymentName = nil
+		Expect(c.Update(ctx, agent)).To(Succeed())
+		host.ClusterID = nil
+		agent.Status.DeprovisionInfo = &v1beta1.AgentDeprovisionInfo{ClusterName: clusterDeploymentName, ClusterNamespace: testNamespace}
+		Expect(c.Status().Update(ctx, agent)).To(Succeed())
+
+		agent, _, handled, err := decommission()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(handled).To(BeFalse())
+		expectPhase(agent, corev1.ConditionFalse, v1beta1.DecommissionUnbindingReason)
+
+		agent.Status.DeprovisionInfo = nil
+		Expect(c.Status().Update(ctx, agent)).To(Succeed())
+		agent, _, handled, err = decommission()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(handled).To(BeFalse())
+		expectPhase(agent, corev1.ConditionTrue, v1beta1.DecommissionedReason)
+	})
+
+	It("reports that the disks were not wiped when the host isn't rebooted into discovery", func() {
+		withPhase(v1beta1.DecommissionUnbindingReason)
+		hr.ImageServiceEnabled = false
+		agent := getAgent()
+		agent.Spec.ClusterDeploymentName = nil
+		agent.Spec.WipeDisksOnDecommission = true
+		Expect(c.Update(ctx, agent)).To(Succeed())
+		host.ClusterID = nil
+
+		agent, _, handled, err := decommission()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(handled).To(BeFalse())
+		expectPhase(agent, corev1.ConditionTrue, v1beta1.DecommissionedReason)
+		condition := conditionsv1.FindStatusCondition(agent.Status.Conditions, v1beta1.DecommissionedCondition)
+		Expect(condition.Message).To(Equal(v1beta1.DecommissionedDisksNotWipedMsg))
+	})
+
+	It("removes the condition when the decommission is cancelled", func() {
+		withPhase(v1beta1.DecommissionDrainingReason)
+		agent := getAgent()
+		agent.Spec.Decommission = false
+		Expect(c.Update(ctx, agent)).To(Succeed())
+
+		agent, _, handled, err := decommission()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(handled).To(BeFalse())
+		Expect(conditionsv1.FindStatusCondition(agent.Status.Conditions, v1beta1.DecommissionedCondition)).To(BeNil())
+	})
+})
+
+var _ = Describe("decommissionWipeDisks", func() {
+	It("returns the local disks other than the installation disk", func() {
+		inventory := models.Inventory{Disks: []*models.Disk{
+			{ID: "/dev/disk/by-id/dm-uuid-mpath-1", Name: "dm-0", DriveType: models.DriveTypeMultipath},
+			{ID: "/dev/disk/by-id/wwn-0x1", Name: "sda", DriveType: models.DriveTypeHDD, Holders: "dm-0"},
+			{ID: "/dev/disk/by-id/wwn-0x2", Name: "sdb", DriveType: models.DriveTypeHDD, Holders: "dm-0"},
+			{ID: "/dev/disk/by-id/wwn-0x3", Name: "sdc", DriveType: models.DriveTypeSSD},
+			{ID: "/dev/disk/by-id/usb-1", Name: "sdd", DriveType: models.DriveTypeHDD, Removable: true},
+			{ID: "/dev/disk/by-id/iscsi-1", Name: "sde", DriveType: models.DriveTypeISCSI},
+			{ID: "/dev/sr0", Name: "sr0", DriveType: models.DriveTypeODD},
+			{Name: "nvme0n1", DriveType: models.DriveTypeSSD},
+		}}
+		b, err := json.Marshal(&inventory)
+		Expect(err).ToNot(HaveOccurred())
+
+		disks, err := decommissionWipeDisks(&models.Host{Inventory: string(b), InstallationDiskID: "/dev/disk/by-id/dm-uuid-mpath-1"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(disks).To(Equal([]string{"/dev/disk/by-id/wwn-0x3", "/dev/nvme0n1"}))
+	})
+
+	It("fails with an invalid inventory", func() {
+		_, err := decommissionWipeDisks(&models.Host{Inventory: "not json"})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -193,7 +193,9 @@ func (r *agentReclaimer) ensureSpokeAgentCertCM(ctx context.Context, c client.Cl
 	return err
 }
 
-func (r *agentReclaimer) createNextStepRunnerDaemonSet(ctx context.Context, c client.Client, log logrus.FieldLogger, nodeName string, infraEnvID string, hostID string) error {
+// createNextStepRunnerDaemonSet runs the agent on the node to reboot the host into discovery. The given disks are wiped
+// before the agent starts
+func (r *agentReclaimer) createNextStepRunnerDaemonSet(ctx context.Context, c client.Client, log logrus.FieldLogger, nodeName string, infraEnvID string, hostID string, wipeDisks []string) error {
 	node := &corev1.Node{}
 	if err := c.Get(ctx, types.NamespacedName{Name: nodeName}, node); err != nil {
 		return errors.Wrapf(err, "failed to find node %s", nodeName)
@@ -256,6 +258,17 @@ func (r *agentReclaimer) createNextStepRunnerDaemonSet(ctx context.Context, c cl
 		}},
 	}}
 
+	var initContainers []corev1.Container
+	if len(wipeDisks) > 0 {
+		initContainers = []corev1.Container{{
+			Name:            "wipe-disks",
+			Image:           r.AgentContainerImage,
+			Command:         append([]string{"chroot", r.hostFSMountDir, "wipefs", "--all", "--force"}, wipeDisks...),
+			SecurityContext: &corev1.SecurityContext{Privileged: &privileged},
+			VolumeMounts:    []corev1.VolumeMount{{Name: "host", MountPath: r.hostFSMountDir}},
+		}}
+	}
+
 	labels := map[string]string{"name": name}
 	daemonSet := &appsv1.DaemonSet{
 		ObjectMeta: metav1.ObjectMeta{
@@ -284,6 +297,7 @@ func (r *agentReclaimer) createNextStepRunnerDaemonSet(ctx context.Context, c cl
 		}}
 		daemonSet.Spec.Template.Spec.PriorityClassName = "system-node-critical"
 		daemonSet.Spec.Template.Spec.ServiceAccountName = spokeRBACName
+		daemonSet.Spec.Template.Spec.InitContainers = initContainers
 		daemonSet.Spec.Template.Spec.Containers = containers
 		return nil
 	}
@@ -322,7 +322,7 @@ var _ = Context("with a fake client", func() {
 
 		It("creates a daemon set correctly on the spoke node", func() {
 			withANode(nodeName)
-			Expect(reclaimer.createNextStepRunnerDaemonSet(ctx, c, common.GetTestLog(), nodeName, infraEnvID, hostID)).To(Succeed())
+			Expect(reclaimer.createNextStepRunnerDaemonSet(ctx, c, common.GetTestLog(), nodeName, infraEnvID, hostID, nil)).To(Succeed())
 
 			ds := &appsv1.DaemonSet{}
 			daemonSetNsName := types.NamespacedName{
@@ -350,12 +350,33 @@ var _ = Context("with a fake client", func() {
 
 			// no cacert should be provided by default
 			Expect(container.Args).NotTo(ContainElement(ContainSubstring("-cacert")))
+			Expect(ds.Spec.Template.Spec.InitContainers).To(BeEmpty())
+		})
+
+		It("wipes the disks before the agent starts", func() {
+			withANode(nodeName)
+			reclaimer.hostFSMountDir = "/host"
+			wipeDisks := []string{"/dev/disk/by-id/wwn-0x1", "/dev/sdc"}
+			Expect(reclaimer.createNextStepRunnerDaemonSet(ctx, c, common.GetTestLog(), nodeName, infraEnvID, hostID, wipeDisks)).To(Succeed())
+
+			ds := &appsv1.DaemonSet{}
+			daemonSetNsName := types.NamespacedName{
+				Name:      daemonSetName,
+				Namespace: spokeReclaimNamespaceName,
+			}
+			Expect(c.Get(ctx, daemonSetNsName, ds)).To(Succeed())
+
+			Expect(ds.Spec.Template.Spec.InitContainers).To(HaveLen(1))
+			initContainer := ds.Spec.Template.Spec.InitContainers[0]
+			Expect(initContainer.Image).To(Equal(agentImage))
+			Expect(initContainer.SecurityContext.Privileged).To(HaveValue(Equal(true)))
+			Expect(initContainer.Command).To(Equal([]string{"chroot", "/host", "wipefs", "--all", "--force", "/dev/disk/by-id/wwn-0x1", "/dev/sdc"}))
 		})
 
 		It("adds cert configuration when CA cert path is set", func() {
 			withANode(nodeName)
 			reclaimer.ServiceCACertPath = "/etc/assisted/cert.crt"
-			Expect(reclaimer.createNextStepRunnerDaemonSet(ctx, c, common.GetTestLog(), nodeName, infraEnvID, hostID)).To(Succeed())
+			Expect(reclaimer.createNextStepRunnerDaemonSet(ctx, c, common.GetTestLog(), nodeName, infraEnvID, hostID, nil)).To(Succeed())
 
 			ds := &appsv1.DaemonSet{}
 			daemonSetNsName := types.NamespacedName{
@@ -382,7 +403,7 @@ var _ = Context("with a fake client", func() {
 		})
 
 		It("fails when the node doesn't exist", func() {
-			Expect(reclaimer.createNextStepRunnerDaemonSet(ctx, c, common.GetTestLog(), nodeName, infraEnvID, hostID)).ToNot(Succeed())
+			Expect(reclaimer.createNextStepRunnerDaemonSet(ctx, c, common.GetTestLog(), nodeName, infraEnvID, hostID, nil)).ToNot(Succeed())
 		})
 	})
 })
@@ -19,8 +19,10 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/itchyny/gojq"
 	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
@@ -90,11 +92,27 @@ func (r *AgentClassificationReconciler) Reconcile(origCtx context.Context, req c
 	if err := r.List(ctx, &agents, opts); err != nil {
 		return ctrl.Result{}, err
 	}
-	matchedCount, errorCount = countAgentsByClassification(log, &agents, classification)
+	var matchingAgents []string
+	switch {
+	case classification.Spec.DryRun && classification.DeletionTimestamp.IsZero():
+		matchingAgents, errorCount = matchAgentsByClassification(log, &agents, classification)
+		matchedCount = len(matchingAgents)
+	case classification.Spec.DryRun:
+		// A dry run classification applies no labels, so there are none to wait for
+	case !classification.DeletionTimestamp.IsZero():
+		classifications := aiv1beta1.AgentClassificationList{}
+		if err := r.List(ctx, &classifications, opts); err != nil {
+			return ctrl.Result{}, err
+		}
+		matchedCount, errorCount = countAgentsOwnedByClassification(log, &agents, classifications.Items, classification)
+	default:
+		matchedCount, errorCount = countAgentsByClassification(log, &agents, classification)
+	}
 
 	setErrorCountCondition(classification, errorCount)
 	classification.Status.MatchedCount = matchedCount
 	classification.Status.ErrorCount = errorCount
+	classification.Status.MatchingAgents = matchingAgents
 
 	if !classification.DeletionTimestamp.IsZero() {
 		if matchedCount > 0 {
@@ -136,6 +154,42 @@ func countAgentsByClassification(log logrus.FieldLogger, agents *aiv1beta1.Agent
 	return
 }
 
+// countAgentsOwnedByClassification counts the Agents that have the label of a classification that is being deleted,
+// skipping the Agents on which another classification applies the same label, since that label stays after the
+// classification is deleted
+func countAgentsOwnedByClassification(log *logrus.Entry, agents *aiv1beta1.AgentList, classifications []aiv1beta1.AgentClassification, classification *aiv1beta1.AgentClassification) (matchedCount, errorCount int) {
+	owned := aiv1beta1.AgentList{}
+	for i := range agents.Items {
+		_, labels := resolveClassificationLabels(log, classifications, agentInventoryInterface(&agents.Items[i]))
+		if label, ok := labels[classification.Spec.LabelKey]; ok && label.value == agents.Items[i].GetLabels()[ClassificationLabelPrefix+classification.Spec.LabelKey] {
+			continue
+		}
+		owned.Items = append(owned.Items, agents.Items[i])
+	}
+	return countAgentsByClassification(log, &owned, classification)
+}
+
+// matchAgentsByClassification runs the query of a dry run classification on the Agents, since its labels are not
+// applied, and returns the names of the matching Agents
+func matchAgentsByClassification(log *logrus.Entry, agents *aiv1beta1.AgentList, classification *aiv1beta1.AgentClassification) (matchingAgents []string, errorCount int) {
+	query, err := gojq.Parse(classification.Spec.Query)
+	if err != nil {
+		// Should not happen - validated via webhook
+		log.Errorf("Failed to parse query: %s\n", classification.Spec.Query)
+		return nil, len(agents.Items)
+	}
+	for i := range agents.Items {
+		matched, err := checkMatch(log, query, agentInventoryInterface(&agents.Items[i]))
+		if err != nil {
+			errorCount++
+		} else if matched {
+			matchingAgents = append(matchingAgents, agents.Items[i].Name)
+		}
+	}
+	sort.Strings(matchingAgents)
+	return
+}
+
 func setErrorCountCondition(classification *aiv1beta1.AgentClassification, errorCount int) {
 	if errorCount != 0 {
 		conditionsv1.SetStatusConditionNoHeartbeat(&classification.Status.Conditions, conditionsv1.Condition{
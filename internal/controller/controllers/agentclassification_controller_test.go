@@ -140,4 +140,71 @@ var _ = Describe("AgentClassification reconcile", func() {
 		classification = getTestClassification()
 		Expect(classification.GetFinalizers()).ToNot(ContainElement(AgentClassificationFinalizer))
 	})
+
+	It("AgentClassification dry run", func() {
+		defaultClassificationSpec.DryRun = true
+		classification := newAgentClassification(defaultClassificationName, testNamespace, defaultClassificationSpec, true)
+		Expect(c.Create(ctx, classification)).ShouldNot(HaveOccurred())
+
+		Expect(c.Create(ctx, newAgentWithInventory("agent2", testNamespace, 2, 4294967296))).ShouldNot(HaveOccurred())
+		Expect(c.Create(ctx, newAgentWithInventory("agent1", testNamespace, 2, 6442450944))).ShouldNot(HaveOccurred())
+		Expect(c.Create(ctx, newAgentWithInventory("agent3", testNamespace, 4, 4294967296))).ShouldNot(HaveOccurred())
+
+		reconcileClassification(classification)
+
+		classification = getTestClassification()
+		Expect(classification.Status.MatchingAgents).To(Equal([]string{"agent1", "agent2"}))
+		Expect(classification.Status.MatchedCount).To(Equal(2))
+		Expect(classification.Status.ErrorCount).To(Equal(0))
+
+		// Once the classification is applied, the matching agents are not listed
+		classification.Spec.DryRun = false
+		Expect(c.Update(ctx, classification)).ShouldNot(HaveOccurred())
+		reconcileClassification(classification)
+		classification = getTestClassification()
+		Expect(classification.Status.MatchingAgents).To(BeEmpty())
+		Expect(classification.Status.MatchedCount).To(Equal(0))
+	})
+	It("AgentClassification deleted while another classification applies the same label", func() {
+		classification := newAgentClassification(defaultClassificationName, testNamespace, defaultClassificationSpec, true)
+		Expect(c.Create(ctx, classification)).ShouldNot(HaveOccurred())
+		otherSpec := defaultClassificationSpec
+		otherSpec.Query = ".cpu.count == 2 and .memory.physicalBytes >= 6442450944"
+		Expect(c.Create(ctx, newAgentClassification("other-medium-size", testNamespace, otherSpec, true))).ShouldNot(HaveOccurred())
+
+		// Both classifications apply the label to agent1, and only the deleted one applies it to agent2
+		agent1 := newAgentWithInventory("agent1", testNamespace, 2, 6442450944)
+		agent1.Labels = map[string]string{ClassificationLabelPrefix + defaultClassificationSpec.LabelKey: defaultClassificationSpec.LabelValue}
+		Expect(c.Create(ctx, agent1)).ShouldNot(HaveOccurred())
+		agent2 := newAgentWithInventory("agent2", testNamespace, 2, 4294967296)
+		agent2.Labels = map[string]string{ClassificationLabelPrefix + defaultClassificationSpec.LabelKey: defaultClassificationSpec.LabelValue}
+		Expect(c.Create(ctx, agent2)).ShouldNot(HaveOccurred())
+
+		Expect(c.Delete(ctx, classification)).ShouldNot(HaveOccurred())
+		reconcileClassification(classification)
+		classification = getTestClassification()
+		Expect(classification.Status.MatchedCount).To(Equal(1))
+
+		// Once the label is removed from agent2, the label of agent1 doesn't hold the deletion
+		Expect(c.Delete(ctx, agent2)).ShouldNot(HaveOccurred())
+		reconcileClassification(classification)
+		classification = getTestClassification()
+		Expect(classification.GetFinalizers()).ToNot(ContainElement(AgentClassificationFinalizer))
+	})
+
+	It("AgentClassification dry run deleted while another classification applies the same label", func() {
+		Expect(c.Create(ctx, newAgentClassification("applied-medium-size", testNamespace, defaultClassificationSpec, true))).ShouldNot(HaveOccurred())
+		defaultClassificationSpec.DryRun = true
+		classification := newAgentClassification(defaultClassificationName, testNamespace, defaultClassificationSpec, true)
+		Expect(c.Create(ctx, classification)).ShouldNot(HaveOccurred())
+
+		agent := newAgentWithInventory("agent1", testNamespace, 2, 6442450944)
+		agent.Labels = map[string]string{ClassificationLabelPrefix + defaultClassificationSpec.LabelKey: defaultClassificationSpec.LabelValue}
+		Expect(c.Create(ctx, agent)).ShouldNot(HaveOccurred())
+
+		Expect(c.Delete(ctx, classification)).ShouldNot(HaveOccurred())
+		reconcileClassification(classification)
+		classification = getTestClassification()
+		Expect(classification.GetFinalizers()).ToNot(ContainElement(AgentClassificationFinalizer))
+	})
 })
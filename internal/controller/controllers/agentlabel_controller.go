@@ -20,6 +20,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/itchyny/gojq"
 	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
@@ -27,6 +31,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -63,10 +68,7 @@ func (r *AgentLabelReconciler) Reconcile(origCtx context.Context, req ctrl.Reque
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	// Get the inventory into interfaces by way of json marshal/unmarshal
-	var inventoryInterface interface{}
-	jsonInventory, _ := json.Marshal(agent.Status.Inventory)
-	_ = json.Unmarshal(jsonInventory, &inventoryInterface)
+	inventoryInterface := agentInventoryInterface(agent)
 
 	classifications := aiv1beta1.AgentClassificationList{}
 	opts := &client.ListOptions{
@@ -76,30 +78,14 @@ func (r *AgentLabelReconciler) Reconcile(origCtx context.Context, req ctrl.Reque
 	if err != nil {
 		return ctrl.Result{}, err
 	}
+	managedKeys, labels := resolveClassificationLabels(log, classifications.Items, inventoryInterface)
 
 	changed := false
-	for _, classification := range classifications.Items {
-		if !classification.DeletionTimestamp.IsZero() {
-			log.Infof("classification %s is being deleted", classification.Name)
-			changed = deleteAgentLabel(log, agent, ClassificationLabelPrefix+classification.Spec.LabelKey, classification.Spec.LabelValue) || changed
-			continue
-		}
-
-		query, err := gojq.Parse(classification.Spec.Query)
-		if err != nil {
-			// Should not happen - validated via webhook
-			log.Errorf("Failed to parse query: %s\n", query)
-			changed = setAgentLabel(log, agent, ClassificationLabelPrefix+classification.Spec.LabelKey, queryErrorValue(classification.Spec.LabelValue)) || changed
-			continue
-		}
-
-		matched, err := checkMatch(log, query, inventoryInterface)
-		if err != nil {
-			changed = setAgentLabel(log, agent, ClassificationLabelPrefix+classification.Spec.LabelKey, queryErrorValue(classification.Spec.LabelValue)) || changed
-		} else if !matched {
-			changed = deleteAgentLabel(log, agent, ClassificationLabelPrefix+classification.Spec.LabelKey, classification.Spec.LabelValue) || changed
+	for key := range managedKeys {
+		if label, ok := labels[key]; ok {
+			changed = setAgentLabel(log, agent, ClassificationLabelPrefix+key, label.value) || changed
 		} else {
-			changed = setAgentLabel(log, agent, ClassificationLabelPrefix+classification.Spec.LabelKey, classification.Spec.LabelValue) || changed
+			changed = deleteAgentLabel(log, agent, ClassificationLabelPrefix+key) || changed
 		}
 	}
 
@@ -113,10 +99,148 @@ func (r *AgentLabelReconciler) Reconcile(origCtx context.Context, req ctrl.Reque
 	return ctrl.Result{}, nil
 }
 
+// resolveClassificationLabels returns the label keys that the classifications manage, and the labels that they apply
+// to an Agent with the given inventory, by label key. The keys of all the classifications are managed by the
+// classifications, and the labels of the keys that no classification applies are removed. When several
+// classifications apply the same key, the classification with the highest priority wins, and the first one by name
+// wins among the same priority. Classifications that are being deleted and dry run classifications apply no labels.
+func resolveClassificationLabels(log *logrus.Entry, classifications []aiv1beta1.AgentClassification, inventoryInterface interface{}) (map[string]bool, map[string]classificationLabel) {
+	sort.Slice(classifications, func(i, j int) bool {
+		return classifications[i].Name < classifications[j].Name
+	})
+
+	managedKeys := make(map[string]bool)
+	labels := make(map[string]classificationLabel)
+	for i := range classifications {
+		classification := &classifications[i]
+		for _, key := range classificationLabelKeys(classification) {
+			managedKeys[key] = true
+		}
+		if !classification.DeletionTimestamp.IsZero() {
+			log.Infof("classification %s is being deleted", classification.Name)
+			continue
+		}
+		if classification.Spec.DryRun {
+			continue
+		}
+		for key, value := range classifyAgent(log, classification, inventoryInterface) {
+			if current, ok := labels[key]; !ok || classification.Spec.Priority > current.priority {
+				labels[key] = classificationLabel{value: value, priority: classification.Spec.Priority}
+			}
+		}
+	}
+	return managedKeys, labels
+}
+
+// classificationLabel is a label that a classification applies to an Agent
+type classificationLabel struct {
+	value    string
+	priority int
+}
+
 func queryErrorValue(originalValue string) string {
+	if originalValue == "" {
+		return "QUERYERROR"
+	}
 	return fmt.Sprintf("QUERYERROR-%s", originalValue)
 }
 
+// agentInventoryInterface returns the inventory of the Agent as the interfaces that queries are run on
+func agentInventoryInterface(agent *aiv1beta1.Agent) interface{} {
+	// Get the inventory into interfaces by way of json marshal/unmarshal
+	var inventoryInterface interface{}
+	jsonInventory, _ := json.Marshal(agent.Status.Inventory)
+	_ = json.Unmarshal(jsonInventory, &inventoryInterface)
+	return inventoryInterface
+}
+
+func classificationLabelKeys(classification *aiv1beta1.AgentClassification) []string {
+	keys := []string{classification.Spec.LabelKey}
+	for _, label := range classification.Spec.AdditionalLabels {
+		keys = append(keys, label.Key)
+	}
+	return keys
+}
+
+// classifyAgent returns the labels that the classification applies to the Agent, by label key. The values of all
+// the labels are errors when the query of the classification fails
+func classifyAgent(log *logrus.Entry, classification *aiv1beta1.AgentClassification, inventoryInterface interface{}) map[string]string {
+	query, err := gojq.Parse(classification.Spec.Query)
+	if err != nil {
+		// Should not happen - validated via webhook
+		log.Errorf("Failed to parse query: %s\n", classification.Spec.Query)
+		return classificationErrorLabels(classification)
+	}
+	matched, err := checkMatch(log, query, inventoryInterface)
+	if err != nil {
+		return classificationErrorLabels(classification)
+	}
+	if !matched {
+		return nil
+	}
+
+	labels := map[string]string{classification.Spec.LabelKey: classification.Spec.LabelValue}
+	for _, label := range classification.Spec.AdditionalLabels {
+		if label.ValueQuery == "" {
+			labels[label.Key] = label.Value
+			continue
+		}
+		value, err := evaluateLabelValue(label.ValueQuery, inventoryInterface)
+		if err != nil {
+			log.WithError(err).Errorf("Failed to evaluate the value of label %s of classification %s", label.Key, classification.Name)
+			value = queryErrorValue("")
+		}
+		labels[label.Key] = value
+	}
+	return labels
+}
+
+func classificationErrorLabels(classification *aiv1beta1.AgentClassification) map[string]string {
+	labels := map[string]string{classification.Spec.LabelKey: queryErrorValue(classification.Spec.LabelValue)}
+	for _, label := range classification.Spec.AdditionalLabels {
+		labels[label.Key] = queryErrorValue(label.Value)
+	}
+	return labels
+}
+
+// evaluateLabelValue runs the value query of a label on the inventory, and returns its result as a label value. The
+// result must be a valid label value, since an invalid value would fail the update of the Agent with all its labels
+func evaluateLabelValue(valueQuery string, inventoryInterface interface{}) (string, error) {
+	query, err := gojq.Parse(valueQuery)
+	if err != nil {
+		return "", err
+	}
+	iter := query.Run(inventoryInterface)
+	v, ok := iter.Next()
+	if !ok {
+		return "", errors.New("Expected a value, found no values")
+	}
+	if _, ok = iter.Next(); ok {
+		return "", errors.New("Expected a value, found multiple values")
+	}
+	var ret string
+	switch value := v.(type) {
+	case error:
+		return "", value
+	case string:
+		ret = value
+	case bool:
+		ret = strconv.FormatBool(value)
+	case int:
+		ret = strconv.Itoa(value)
+	case float64:
+		ret = strconv.FormatFloat(value, 'f', -1, 64)
+	case *big.Int:
+		ret = value.String()
+	default:
+		return "", errors.Errorf("Expected a string, a number or a boolean, found %T", v)
+	}
+	if errs := validation.IsValidLabelValue(ret); len(errs) > 0 {
+		return "", errors.Errorf("Invalid label value %q: %s", ret, strings.Join(errs, "; "))
+	}
+	return ret, nil
+}
+
 func checkMatch(log *logrus.Entry, query *gojq.Query, inventoryInterface interface{}) (bool, error) {
 	iter := query.Run(inventoryInterface)
 	values := []interface{}{}
@@ -145,7 +269,7 @@ func checkMatch(log *logrus.Entry, query *gojq.Query, inventoryInterface interfa
 	return false, nil
 }
 
-func deleteAgentLabel(log *logrus.Entry, agent *aiv1beta1.Agent, labelKey, labelValue string) bool {
+func deleteAgentLabel(log *logrus.Entry, agent *aiv1beta1.Agent, labelKey string) bool {
 	labels := agent.GetLabels()
 
 	if labels == nil {
@@ -156,13 +280,6 @@ func deleteAgentLabel(log *logrus.Entry, agent *aiv1beta1.Agent, labelKey, label
 		return false
 	}
 
-	// If the label has a different value, then don't delete
-	if val, ok := labels[labelKey]; ok {
-		if val != labelValue && val != queryErrorValue(labelValue) {
-			return false
-		}
-	}
-
 	delete(labels, labelKey)
 	agent.SetLabels(labels)
 	log.Infof("Deleted label %s from agent %s/%s", labelKey, agent.Namespace, agent.Name)
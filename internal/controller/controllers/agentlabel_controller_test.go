@@ -130,4 +130,91 @@ var _ = Describe("AgentLabel reconcile", func() {
 		Expect(len(agent.GetLabels())).To(Equal(1))
 		Expect(agent.GetLabels()[ClassificationLabelPrefix+"size"]).To(Equal("xlarge"))
 	})
+
+	It("AgentLabel additional labels", func() {
+		classificationSpec := v1beta1.AgentClassificationSpec{
+			LabelKey:   "size",
+			LabelValue: "medium",
+			Query:      ".cpu.count == 2",
+			AdditionalLabels: []v1beta1.AgentClassificationLabel{
+				{Key: "memory-class", Value: "large"},
+				{Key: "cpu-count", ValueQuery: ".cpu.count"},
+				{Key: "hostname", ValueQuery: ".hostname"},
+				{Key: "error", ValueQuery: ".cpu.count & .memory.physicalBytes"},
+				{Key: "invalid", ValueQuery: "\"Intel(R) Xeon(R) CPU\""},
+			},
+		}
+		classification := newAgentClassification("medium", testNamespace, classificationSpec, true)
+		Expect(c.Create(ctx, classification)).ShouldNot(HaveOccurred())
+
+		agent := newAgentWithInventory(agentName, testNamespace, 2, 4294967296)
+		Expect(c.Create(ctx, agent)).ShouldNot(HaveOccurred())
+
+		reconcileAgent(agent)
+		agent = getTestAgent()
+		Expect(agent.GetLabels()).To(Equal(map[string]string{
+			ClassificationLabelPrefix + "size":         "medium",
+			ClassificationLabelPrefix + "memory-class": "large",
+			ClassificationLabelPrefix + "cpu-count":    "2",
+			ClassificationLabelPrefix + "hostname":     agentName,
+			ClassificationLabelPrefix + "error":        queryErrorValue(""),
+			ClassificationLabelPrefix + "invalid":      queryErrorValue(""),
+		}))
+
+		// Once the agent doesn't match, all the labels are removed
+		classification.Spec.Query = ".cpu.count == 4"
+		Expect(c.Update(ctx, classification)).ShouldNot(HaveOccurred())
+		reconcileAgent(agent)
+		Expect(getTestAgent().GetLabels()).To(BeEmpty())
+	})
+
+	It("AgentLabel priorities", func() {
+		classificationSpecLow := v1beta1.AgentClassificationSpec{
+			LabelKey:   "size",
+			LabelValue: "small",
+			Query:      ".cpu.count >= 1",
+		}
+		Expect(c.Create(ctx, newAgentClassification("a-low", testNamespace, classificationSpecLow, true))).ShouldNot(HaveOccurred())
+		classificationSpecHigh := v1beta1.AgentClassificationSpec{
+			LabelKey:   "size",
+			LabelValue: "medium",
+			Query:      ".cpu.count >= 2",
+			Priority:   10,
+		}
+		classificationHigh := newAgentClassification("b-high", testNamespace, classificationSpecHigh, true)
+		Expect(c.Create(ctx, classificationHigh)).ShouldNot(HaveOccurred())
+
+		agent := newAgentWithInventory(agentName, testNamespace, 2, 4294967296)
+		Expect(c.Create(ctx, agent)).ShouldNot(HaveOccurred())
+
+		reconcileAgent(agent)
+		Expect(getTestAgent().GetLabels()[ClassificationLabelPrefix+"size"]).To(Equal("medium"))
+
+		// With the same priority, the first classification by name wins
+		classificationHigh.Spec.Priority = 0
+		Expect(c.Update(ctx, classificationHigh)).ShouldNot(HaveOccurred())
+		reconcileAgent(agent)
+		Expect(getTestAgent().GetLabels()[ClassificationLabelPrefix+"size"]).To(Equal("small"))
+	})
+
+	It("AgentLabel dry run", func() {
+		classificationSpec := v1beta1.AgentClassificationSpec{
+			LabelKey:   "size",
+			LabelValue: "medium",
+			Query:      ".cpu.count == 2",
+		}
+		classification := newAgentClassification("medium", testNamespace, classificationSpec, true)
+		Expect(c.Create(ctx, classification)).ShouldNot(HaveOccurred())
+
+		agent := newAgentWithInventory(agentName, testNamespace, 2, 4294967296)
+		Expect(c.Create(ctx, agent)).ShouldNot(HaveOccurred())
+		reconcileAgent(agent)
+		Expect(getTestAgent().GetLabels()).To(HaveKey(ClassificationLabelPrefix + "size"))
+
+		// The labels of a dry run classification are not applied
+		classification.Spec.DryRun = true
+		Expect(c.Update(ctx, classification)).ShouldNot(HaveOccurred())
+		reconcileAgent(agent)
+		Expect(getTestAgent().GetLabels()).To(BeEmpty())
+	})
 })
@@ -0,0 +1,309 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// AgentPoolLabel is set on the Agents that were bound by a pool, with the name of the pool as the value
+	AgentPoolLabel = "agentpool." + aiv1beta1.Group + "/name"
+	// AgentPoolReplacedAnnotation is set on the Agents that were released by a pool because they failed, with the
+	// name of the pool as the value. The pool doesn't select them again until the annotation is removed
+	AgentPoolReplacedAnnotation = "agentpool." + aiv1beta1.Group + "/replaced"
+)
+
+// AgentPoolReconciler binds the Agents that match an AgentPool to its cluster until the desired number of masters
+// and workers is reached, and replaces the bound Agents that fail
+type AgentPoolReconciler struct {
+	client.Client
+	Log logrus.FieldLogger
+}
+
+//+kubebuilder:rbac:groups=agent-install.openshift.io,resources=agentpools,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=agent-install.openshift.io,resources=agentpools/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=agent-install.openshift.io,resources=agents,verbs=get;list;watch;update;patch
+
+func (r *AgentPoolReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx := addRequestIdIfNeeded(origCtx)
+	log := r.Log.WithFields(
+		logrus.Fields{
+			"agent_pool":           req.Name,
+			"agent_pool_namespace": req.Namespace,
+		})
+
+	defer func() {
+		log.Debug("AgentPool Reconcile ended")
+	}()
+
+	log.Debug("AgentPool Reconcile started")
+
+	pool := &aiv1beta1.AgentPool{}
+	if err := r.Get(ctx, req.NamespacedName, pool); err != nil {
+		log.WithError(err).Errorf("Failed to get AgentPool %s", req.NamespacedName)
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !pool.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&pool.Spec.AgentSelector)
+	if err != nil {
+		log.WithError(err).Error("invalid agent selector")
+		conditionsv1.SetStatusConditionNoHeartbeat(&pool.Status.Conditions, conditionsv1.Condition{
+			Type:    aiv1beta1.AgentPoolSatisfiedCondition,
+			Status:  corev1.ConditionFalse,
+			Reason:  aiv1beta1.AgentPoolInvalidSelectorReason,
+			Message: fmt.Sprintf("The agent selector is invalid: %s", err.Error()),
+		})
+		return ctrl.Result{}, r.updateStatus(ctx, log, pool)
+	}
+
+	agents := aiv1beta1.AgentList{}
+	if err = r.List(ctx, &agents, client.InNamespace(pool.Namespace)); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	var masters, workers, candidates []*aiv1beta1.Agent
+	for i := range agents.Items {
+		agent := &agents.Items[i]
+		if agent.GetLabels()[AgentPoolLabel] == pool.Name {
+			if !isBoundToAgentPool(agent, pool) {
+				log.Infof("agent %s was bound to another cluster, removing it from the pool", agent.Name)
+				if err = r.releaseAgent(ctx, agent, false); err != nil {
+					log.WithError(err).Errorf("failed to remove agent %s from the pool", agent.Name)
+					return ctrl.Result{}, err
+				}
+				continue
+			}
+			if isFailedAgentPoolMember(agent) {
+				log.Infof("agent %s failed, replacing it", agent.Name)
+				if err = r.releaseAgent(ctx, agent, true); err != nil {
+					log.WithError(err).Errorf("failed to release agent %s", agent.Name)
+					return ctrl.Result{}, err
+				}
+				continue
+			}
+			if agent.Spec.Role == models.HostRoleMaster {
+				masters = append(masters, agent)
+			} else {
+				workers = append(workers, agent)
+			}
+			continue
+		}
+		if isAgentPoolCandidate(agent, pool, selector) {
+			candidates = append(candidates, agent)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Name < candidates[j].Name
+	})
+
+	for _, desired := range []struct {
+		role   models.HostRole
+		agents *[]*aiv1beta1.Agent
+		count  int
+	}{
+		{role: models.HostRoleMaster, agents: &masters, count: pool.Spec.Masters},
+		{role: models.HostRoleWorker, agents: &workers, count: pool.Spec.Workers},
+	} {
+		for len(*desired.agents) < desired.count && len(candidates) > 0 {
+			agent := candidates[0]
+			candidates = candidates[1:]
+			log.Infof("binding agent %s with the %s role", agent.Name, desired.role)
+			if err = r.bindAgent(ctx, log, agent, pool, desired.role); err != nil {
+				log.WithError(err).Errorf("failed to bind agent %s", agent.Name)
+				return ctrl.Result{}, err
+			}
+			*desired.agents = append(*desired.agents, agent)
+		}
+	}
+
+	pool.Status.Masters = len(masters)
+	pool.Status.Workers = len(workers)
+	setAgentPoolSatisfiedCondition(pool)
+	return ctrl.Result{}, r.updateStatus(ctx, log, pool)
+}
+
+func (r *AgentPoolReconciler) updateStatus(ctx context.Context, log logrus.FieldLogger, pool *aiv1beta1.AgentPool) error {
+	if err := r.Status().Update(ctx, pool); err != nil {
+		log.WithError(err).Error("failed to update agent pool status")
+		return err
+	}
+	return nil
+}
+
+func (r *AgentPoolReconciler) bindAgent(ctx context.Context, log logrus.FieldLogger, agent *aiv1beta1.Agent, pool *aiv1beta1.AgentPool, role models.HostRole) error {
+	clusterRef := pool.Spec.ClusterDeploymentName
+	if clusterRef.Namespace == "" {
+		clusterRef.Namespace = pool.Namespace
+	}
+	agent.Spec.ClusterDeploymentName = &clusterRef
+	agent.Spec.Role = role
+	setAgentLabel(log, agent, AgentPoolLabel, pool.Name)
+	return r.Update(ctx, agent)
+}
+
+// releaseAgent removes the Agent from its pool. Failed Agents are unbound from the cluster and annotated, so that the
+// pool doesn't select them again
+func (r *AgentPoolReconciler) releaseAgent(ctx context.Context, agent *aiv1beta1.Agent, failed bool) error {
+	if failed {
+		setAnnotation(&agent.ObjectMeta, AgentPoolReplacedAnnotation, agent.Labels[AgentPoolLabel])
+		agent.Spec.ClusterDeploymentName = nil
+	}
+	delete(agent.Labels, AgentPoolLabel)
+	return r.Update(ctx, agent)
+}
+
+func isBoundToAgentPool(agent *aiv1beta1.Agent, pool *aiv1beta1.AgentPool) bool {
+	if agent.Spec.ClusterDeploymentName == nil {
+		return false
+	}
+	namespace := pool.Spec.ClusterDeploymentName.Namespace
+	if namespace == "" {
+		namespace = pool.Namespace
+	}
+	return agent.Spec.ClusterDeploymentName.Name == pool.Spec.ClusterDeploymentName.Name &&
+		agent.Spec.ClusterDeploymentName.Namespace == namespace
+}
+
+// isFailedAgentPoolMember returns true for Agents whose own installation failed, and for Agents that disconnected before
+// their installation started. Agents that were aborted because their cluster failed to install are kept, as releasing
+// them wouldn't fix the cluster.
+func isFailedAgentPoolMember(agent *aiv1beta1.Agent) bool {
+	installed := conditionsv1.FindStatusCondition(agent.Status.Conditions, aiv1beta1.InstalledCondition)
+	if installed != nil && installed.Reason == aiv1beta1.InstallationFailedReason {
+		return agent.Status.DebugInfo.StateInfo != host.StatusInfoAbortingDueClusterErrors
+	}
+	return (installed == nil || installed.Reason == aiv1beta1.InstallationNotStartedReason) &&
+		conditionsv1.IsStatusConditionFalse(agent.Status.Conditions, aiv1beta1.ConnectedCondition)
+}
+
+// isAgentPoolCandidate returns true for the approved, unbound, connected and validated Agents that match the selector
+// and the hardware constraints of the pool
+func isAgentPoolCandidate(agent *aiv1beta1.Agent, pool *aiv1beta1.AgentPool, selector labels.Selector) bool {
+	if _, ok := agent.GetLabels()[AgentPoolLabel]; ok {
+		return false
+	}
+	if agent.GetAnnotations()[AgentPoolReplacedAnnotation] == pool.Name {
+		return false
+	}
+	if agent.Spec.ClusterDeploymentName != nil || !agent.Spec.Approved {
+		return false
+	}
+	bound := conditionsv1.FindStatusCondition(agent.Status.Conditions, aiv1beta1.BoundCondition)
+	if bound == nil || bound.Reason != aiv1beta1.UnboundReason {
+		return false
+	}
+	if !conditionsv1.IsStatusConditionTrue(agent.Status.Conditions, aiv1beta1.ConnectedCondition) ||
+		!conditionsv1.IsStatusConditionTrue(agent.Status.Conditions, aiv1beta1.ValidatedCondition) {
+		return false
+	}
+	return selector.Matches(labels.Set(agent.GetLabels())) && agentSatisfiesHardwareConstraints(agent, pool.Spec.HardwareConstraints)
+}
+
+func agentSatisfiesHardwareConstraints(agent *aiv1beta1.Agent, constraints *aiv1beta1.AgentPoolHardwareConstraints) bool {
+	if constraints == nil {
+		return true
+	}
+	inventory := &agent.Status.Inventory
+	if inventory.Cpu.Count < constraints.MinCPUCores ||
+		inventory.Memory.PhysicalBytes < conversions.MibToBytes(constraints.MinMemoryMiB) {
+		return false
+	}
+	if constraints.Architecture != "" && inventory.Cpu.Architecture != constraints.Architecture {
+		return false
+	}
+	if constraints.MinDiskSizeGB == 0 {
+		return true
+	}
+	for _, disk := range inventory.Disks {
+		if disk.InstallationEligibility.Eligible && disk.SizeBytes >= conversions.GbToBytes(constraints.MinDiskSizeGB) {
+			return true
+		}
+	}
+	return false
+}
+
+func setAgentPoolSatisfiedCondition(pool *aiv1beta1.AgentPool) {
+	missingMasters := pool.Spec.Masters - pool.Status.Masters
+	missingWorkers := pool.Spec.Workers - pool.Status.Workers
+	if missingMasters > 0 || missingWorkers > 0 {
+		conditionsv1.SetStatusConditionNoHeartbeat(&pool.Status.Conditions, conditionsv1.Condition{
+			Type:   aiv1beta1.AgentPoolSatisfiedCondition,
+			Status: corev1.ConditionFalse,
+			Reason: aiv1beta1.AgentPoolInsufficientAgentsReason,
+			Message: fmt.Sprintf("The pool has %d of %d masters and %d of %d workers, no matching Agents are available",
+				pool.Status.Masters, pool.Spec.Masters, pool.Status.Workers, pool.Spec.Workers),
+		})
+	} else {
+		conditionsv1.SetStatusConditionNoHeartbeat(&pool.Status.Conditions, conditionsv1.Condition{
+			Type:    aiv1beta1.AgentPoolSatisfiedCondition,
+			Status:  corev1.ConditionTrue,
+			Reason:  aiv1beta1.AgentPoolSatisfiedReason,
+			Message: "The pool has the desired number of masters and workers",
+		})
+	}
+}
+
+func (r *AgentPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	mapAgentToAgentPool := func(ctx context.Context, agent client.Object) []reconcile.Request {
+		log := logutil.FromContext(context.Background(), r.Log).WithFields(
+			logrus.Fields{
+				"agent":           agent.GetName(),
+				"agent_namespace": agent.GetNamespace(),
+			})
+		poolList := &aiv1beta1.AgentPoolList{}
+		if err := r.List(context.Background(), poolList, client.InNamespace(agent.GetNamespace())); err != nil {
+			log.Debugf("failed to list agent pools")
+			return []reconcile.Request{}
+		}
+
+		reply := make([]reconcile.Request, 0, len(poolList.Items))
+		for _, pool := range poolList.Items {
+			reply = append(reply, reconcile.Request{NamespacedName: types.NamespacedName{
+				Namespace: pool.Namespace,
+				Name:      pool.Name,
+			}})
+		}
+		return reply
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&aiv1beta1.AgentPool{}).
+		Watches(&aiv1beta1.Agent{}, handler.EnqueueRequestsFromMapFunc(mapAgentToAgentPool)).
+		Complete(r)
+}
@@ -0,0 +1,232 @@
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newAgentPoolCandidate(name string, labels map[string]string, cpuCount int64) *v1beta1.Agent {
+	agent := &v1beta1.Agent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+			Labels:    labels,
+		},
+		Spec: v1beta1.AgentSpec{Approved: true},
+		Status: v1beta1.AgentStatus{
+			Inventory: v1beta1.HostInventory{
+				Cpu:    v1beta1.HostCPU{Count: cpuCount, Architecture: "x86_64"},
+				Memory: v1beta1.HostMemory{PhysicalBytes: conversions.GibToBytes(16)},
+				Disks: []v1beta1.HostDisk{{
+					SizeBytes:               conversions.GbToBytes(200),
+					InstallationEligibility: v1beta1.HostInstallationEligibility{Eligible: true},
+				}},
+			},
+		},
+	}
+	for _, condition := range []conditionsv1.Condition{
+		{Type: v1beta1.BoundCondition, Status: corev1.ConditionFalse, Reason: v1beta1.UnboundReason},
+		{Type: v1beta1.ConnectedCondition, Status: corev1.ConditionTrue, Reason: v1beta1.AgentConnectedReason},
+		{Type: v1beta1.ValidatedCondition, Status: corev1.ConditionTrue, Reason: v1beta1.ValidationsPassingReason},
+	} {
+		conditionsv1.SetStatusConditionNoHeartbeat(&agent.Status.Conditions, condition)
+	}
+	return agent
+}
+
+var _ = Describe("AgentPool reconcile", func() {
+	var (
+		c        client.Client
+		r        *AgentPoolReconciler
+		ctx      = context.Background()
+		key      = types.NamespacedName{Namespace: testNamespace, Name: "compact"}
+		selected = map[string]string{ClassificationLabelPrefix + "size": "large"}
+		cdRef    = v1beta1.ClusterReference{Name: "test-cluster", Namespace: testNamespace}
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithStatusSubresource(&v1beta1.AgentPool{}).Build()
+		r = &AgentPoolReconciler{
+			Client: c,
+			Log:    common.GetTestLog(),
+		}
+		Expect(c.Create(ctx, &v1beta1.AgentPool{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Spec: v1beta1.AgentPoolSpec{
+				ClusterDeploymentName: v1beta1.ClusterReference{Name: cdRef.Name},
+				AgentSelector:         metav1.LabelSelector{MatchLabels: selected},
+				Masters:               1,
+				Workers:               1,
+				HardwareConstraints:   &v1beta1.AgentPoolHardwareConstraints{MinCPUCores: 4, MinDiskSizeGB: 120},
+			},
+		})).To(Succeed())
+	})
+
+	reconcilePool := func() *v1beta1.AgentPool {
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		ExpectWithOffset(1, result).To(Equal(ctrl.Result{}))
+		pool := &v1beta1.AgentPool{}
+		ExpectWithOffset(1, c.Get(ctx, key, pool)).To(Succeed())
+		return pool
+	}
+
+	getAgent := func(name string) *v1beta1.Agent {
+		agent := &v1beta1.Agent{}
+		ExpectWithOffset(1, c.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: name}, agent)).To(Succeed())
+		return agent
+	}
+
+	It("binds matching agents with the desired roles", func() {
+		Expect(c.Create(ctx, newAgentPoolCandidate("agent-a", selected, 8))).To(Succeed())
+		Expect(c.Create(ctx, newAgentPoolCandidate("agent-b", selected, 8))).To(Succeed())
+		Expect(c.Create(ctx, newAgentPoolCandidate("agent-c", selected, 8))).To(Succeed())
+
+		pool := reconcilePool()
+		Expect(pool.Status.Masters).To(Equal(1))
+		Expect(pool.Status.Workers).To(Equal(1))
+		condition := conditionsv1.FindStatusCondition(pool.Status.Conditions, v1beta1.AgentPoolSatisfiedCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(v1beta1.AgentPoolSatisfiedReason))
+
+		agent := getAgent("agent-a")
+		Expect(agent.Spec.ClusterDeploymentName).To(Equal(&cdRef))
+		Expect(agent.Spec.Role).To(Equal(models.HostRoleMaster))
+		Expect(agent.Labels[AgentPoolLabel]).To(Equal(key.Name))
+		agent = getAgent("agent-b")
+		Expect(agent.Spec.ClusterDeploymentName).To(Equal(&cdRef))
+		Expect(agent.Spec.Role).To(Equal(models.HostRoleWorker))
+		Expect(getAgent("agent-c").Spec.ClusterDeploymentName).To(BeNil())
+	})
+
+	It("skips agents that don't match the pool", func() {
+		unlabeled := newAgentPoolCandidate("agent-a", nil, 8)
+		Expect(c.Create(ctx, unlabeled)).To(Succeed())
+		small := newAgentPoolCandidate("agent-b", selected, 2)
+		Expect(c.Create(ctx, small)).To(Succeed())
+		notValidated := newAgentPoolCandidate("agent-c", selected, 8)
+		conditionsv1.SetStatusConditionNoHeartbeat(&notValidated.Status.Conditions, conditionsv1.Condition{
+			Type: v1beta1.ValidatedCondition, Status: corev1.ConditionFalse, Reason: v1beta1.ValidationsFailingReason,
+		})
+		Expect(c.Create(ctx, notValidated)).To(Succeed())
+		bound := newAgentPoolCandidate("agent-d", selected, 8)
+		bound.Spec.ClusterDeploymentName = &v1beta1.ClusterReference{Name: "other", Namespace: testNamespace}
+		Expect(c.Create(ctx, bound)).To(Succeed())
+		Expect(c.Create(ctx, newAgentPoolCandidate("agent-e", selected, 8))).To(Succeed())
+
+		pool := reconcilePool()
+		Expect(pool.Status.Masters).To(Equal(1))
+		Expect(pool.Status.Workers).To(Equal(0))
+		condition := conditionsv1.FindStatusCondition(pool.Status.Conditions, v1beta1.AgentPoolSatisfiedCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(v1beta1.AgentPoolInsufficientAgentsReason))
+		Expect(condition.Message).To(Equal("The pool has 1 of 1 masters and 0 of 1 workers, no matching Agents are available"))
+
+		for _, name := range []string{"agent-a", "agent-b", "agent-c"} {
+			Expect(getAgent(name).Spec.ClusterDeploymentName).To(BeNil())
+		}
+		Expect(getAgent("agent-d").Spec.ClusterDeploymentName.Name).To(Equal("other"))
+		Expect(getAgent("agent-e").Spec.Role).To(Equal(models.HostRoleMaster))
+	})
+
+	It("replaces failed agents", func() {
+		Expect(c.Create(ctx, newAgentPoolCandidate("agent-a", selected, 8))).To(Succeed())
+		Expect(c.Create(ctx, newAgentPoolCandidate("agent-b", selected, 8))).To(Succeed())
+		reconcilePool()
+
+		failed := getAgent("agent-a")
+		conditionsv1.SetStatusConditionNoHeartbeat(&failed.Status.Conditions, conditionsv1.Condition{
+			Type: v1beta1.ConnectedCondition, Status: corev1.ConditionFalse, Reason: v1beta1.AgentDisconnectedReason,
+		})
+		Expect(c.Update(ctx, failed)).To(Succeed())
+		Expect(c.Create(ctx, newAgentPoolCandidate("agent-c", selected, 8))).To(Succeed())
+
+		pool := reconcilePool()
+		Expect(pool.Status.Masters).To(Equal(1))
+		Expect(pool.Status.Workers).To(Equal(1))
+
+		failed = getAgent("agent-a")
+		Expect(failed.Spec.ClusterDeploymentName).To(BeNil())
+		Expect(failed.Labels).ToNot(HaveKey(AgentPoolLabel))
+		Expect(failed.Annotations[AgentPoolReplacedAnnotation]).To(Equal(key.Name))
+		replacement := getAgent("agent-c")
+		Expect(replacement.Spec.ClusterDeploymentName).To(Equal(&cdRef))
+		Expect(replacement.Spec.Role).To(Equal(models.HostRoleMaster))
+	})
+
+	It("replaces agents whose installation failed", func() {
+		Expect(c.Create(ctx, newAgentPoolCandidate("agent-a", selected, 8))).To(Succeed())
+		reconcilePool()
+
+		failed := getAgent("agent-a")
+		conditionsv1.SetStatusConditionNoHeartbeat(&failed.Status.Conditions, conditionsv1.Condition{
+			Type: v1beta1.InstalledCondition, Status: corev1.ConditionFalse, Reason: v1beta1.InstallationFailedReason,
+		})
+		failed.Status.DebugInfo.StateInfo = "Host failed to install due to timeout while starting installation"
+		Expect(c.Update(ctx, failed)).To(Succeed())
+		reconcilePool()
+
+		failed = getAgent("agent-a")
+		Expect(failed.Spec.ClusterDeploymentName).To(BeNil())
+		Expect(failed.Annotations[AgentPoolReplacedAnnotation]).To(Equal(key.Name))
+	})
+
+	It("keeps agents that were aborted because the cluster failed to install", func() {
+		Expect(c.Create(ctx, newAgentPoolCandidate("agent-a", selected, 8))).To(Succeed())
+		reconcilePool()
+
+		aborted := getAgent("agent-a")
+		conditionsv1.SetStatusConditionNoHeartbeat(&aborted.Status.Conditions, conditionsv1.Condition{
+			Type: v1beta1.InstalledCondition, Status: corev1.ConditionFalse, Reason: v1beta1.InstallationFailedReason,
+		})
+		aborted.Status.DebugInfo.StateInfo = host.StatusInfoAbortingDueClusterErrors
+		Expect(c.Update(ctx, aborted)).To(Succeed())
+		pool := reconcilePool()
+		Expect(pool.Status.Masters).To(Equal(1))
+
+		aborted = getAgent("agent-a")
+		Expect(aborted.Spec.ClusterDeploymentName).To(Equal(&cdRef))
+		Expect(aborted.Labels).To(HaveKey(AgentPoolLabel))
+	})
+
+	It("removes agents that were bound to another cluster from the pool", func() {
+		Expect(c.Create(ctx, newAgentPoolCandidate("agent-a", selected, 8))).To(Succeed())
+		reconcilePool()
+
+		agent := getAgent("agent-a")
+		agent.Spec.ClusterDeploymentName = &v1beta1.ClusterReference{Name: "other", Namespace: testNamespace}
+		Expect(c.Update(ctx, agent)).To(Succeed())
+
+		pool := reconcilePool()
+		Expect(pool.Status.Masters).To(Equal(0))
+		agent = getAgent("agent-a")
+		Expect(agent.Spec.ClusterDeploymentName.Name).To(Equal("other"))
+		Expect(agent.Labels).ToNot(HaveKey(AgentPoolLabel))
+	})
+
+	It("reports an invalid selector", func() {
+		pool := &v1beta1.AgentPool{}
+		Expect(c.Get(ctx, key, pool)).To(Succeed())
+		pool.Spec.AgentSelector = metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "size", Operator: "Bad"}}}
+		Expect(c.Update(ctx, pool)).To(Succeed())
+
+		pool = reconcilePool()
+		condition := conditionsv1.FindStatusCondition(pool.Status.Conditions, v1beta1.AgentPoolSatisfiedCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(v1beta1.AgentPoolInvalidSelectorReason))
+	})
+})
@@ -37,7 +37,9 @@ import (
 	"github.com/openshift/assisted-service/internal/cluster/validations"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/gencrypto"
+	"github.com/openshift/assisted-service/internal/host/customsteps"
 	"github.com/openshift/assisted-service/internal/kubernetes"
+	"github.com/openshift/assisted-service/internal/uservalidations"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
@@ -1325,21 +1327,24 @@ func newAssistedCM(ctx context.Context, log logrus.FieldLogger, asc ASC) (client
 			"IMAGE_SERVICE_BASE_URL": imageServiceURL,
 
 			// image overrides
-			"AGENT_DOCKER_IMAGE":     AgentImage(),
-			"CONTROLLER_IMAGE":       ControllerImage(),
-			"INSTALLER_IMAGE":        InstallerImage(),
-			"SELF_VERSION":           ServiceImage(asc.Object),
-			"OS_IMAGES":              getOSImages(log, asc.spec, asc.Object.GetAnnotations()),
-			"MUST_GATHER_IMAGES":     getMustGatherImages(log, asc.spec),
-			"ISO_IMAGE_TYPE":         "minimal-iso",
-			"S3_USE_SSL":             "false",
-			"LOG_LEVEL":              "info",
-			"LOG_FORMAT":             "text",
-			"INSTALL_RH_CA":          "false",
-			"REGISTRY_CREDS":         "",
-			"DEPLOY_TARGET":          "k8s",
-			"STORAGE":                "filesystem",
-			"ISO_WORKSPACE_BASE_DIR": "/data",
+			"AGENT_DOCKER_IMAGE":               AgentImage(),
+			"CONTROLLER_IMAGE":                 ControllerImage(),
+			"INSTALLER_IMAGE":                  InstallerImage(),
+			"SELF_VERSION":                     ServiceImage(asc.Object),
+			"OS_IMAGES":                        getOSImages(log, asc.spec, asc.Object.GetAnnotations()),
+			"MUST_GATHER_IMAGES":               getMustGatherImages(log, asc.spec),
+			"INSTALLER_CACHE_PREWARM_RELEASES": getPrewarmReleases(log, asc.spec),
+			"CUSTOM_STEPS":                     getCustomSteps(log, asc.spec),
+			"USER_VALIDATIONS":                 getUserValidations(log, asc.spec),
+			"ISO_IMAGE_TYPE":                   "minimal-iso",
+			"S3_USE_SSL":                       "false",
+			"LOG_LEVEL":                        "info",
+			"LOG_FORMAT":                       "text",
+			"INSTALL_RH_CA":                    "false",
+			"REGISTRY_CREDS":                   "",
+			"DEPLOY_TARGET":                    "k8s",
+			"STORAGE":                          "filesystem",
+			"ISO_WORKSPACE_BASE_DIR":           "/data",
 
 			// from configmap
 			"AUTH_TYPE":                   "local",
@@ -1898,6 +1903,11 @@ func newAssistedServiceDeployment(ctx context.Context, log logrus.FieldLogger, a
 		envSecrets = append(envSecrets, corev1.EnvVar{Name: "HTTP_LISTEN_PORT", Value: serviceHTTPPort.String()})
 	}
 
+	if asc.spec.PrewarmPullSecretRef != nil {
+		envSecrets = append(envSecrets, newStaticSecretEnvVar("INSTALLER_CACHE_PREWARM_PULL_SECRET",
+			corev1.DockerConfigJsonKey, asc.spec.PrewarmPullSecretRef.Name))
+	}
+
 	envFrom := []corev1.EnvFromSource{
 		{
 			ConfigMapRef: &corev1.ConfigMapEnvSource{
@@ -2334,6 +2344,84 @@ func getMustGatherImages(log logrus.FieldLogger, spec *aiv1beta1.AgentServiceCon
 	return string(encodedVersions)
 }
 
+// getPrewarmReleases returns the value of INSTALLER_CACHE_PREWARM_RELEASES variable
+// to be stored in the service's ConfigMap, which lists the releases to pre-warm
+// in the installer cache in the format of the parameters of the pre-warm API
+func getPrewarmReleases(log logrus.FieldLogger, spec *aiv1beta1.AgentServiceConfigSpec) string {
+	if len(spec.PrewarmReleases) == 0 {
+		return ""
+	}
+	releases := make([]*models.InstallerCachePrewarmParams, 0, len(spec.PrewarmReleases))
+	for _, release := range spec.PrewarmReleases {
+		releases = append(releases, &models.InstallerCachePrewarmParams{
+			ReleaseImage: swag.String(release.ReleaseImage),
+			Pinned:       swag.Bool(release.Pinned),
+		})
+	}
+	encodedReleases, err := json.Marshal(releases)
+	if err != nil {
+		log.WithError(err).Error("Problem marshaling the releases to pre-warm in the installer cache")
+		return ""
+	}
+	return string(encodedReleases)
+}
+
+// getCustomSteps returns the value of CUSTOM_STEPS variable to be stored in
+// the service's ConfigMap, which lists the steps that the agent runs in
+// addition to the built-in steps
+func getCustomSteps(log logrus.FieldLogger, spec *aiv1beta1.AgentServiceConfigSpec) string {
+	if len(spec.CustomSteps) == 0 {
+		return ""
+	}
+	steps := make(customsteps.Steps, 0, len(spec.CustomSteps))
+	for _, step := range spec.CustomSteps {
+		var replySchema json.RawMessage
+		if step.ReplySchema != "" {
+			replySchema = json.RawMessage(step.ReplySchema)
+		}
+		steps = append(steps, &customsteps.Step{
+			Name:           step.Name,
+			Image:          step.Image,
+			Args:           step.Args,
+			TimeoutSeconds: step.TimeoutSeconds,
+			States:         step.States,
+			ReplySchema:    replySchema,
+			Required:       step.Required,
+		})
+	}
+	encodedSteps, err := json.Marshal(steps)
+	if err != nil {
+		log.WithError(err).Error("Problem marshaling the custom steps")
+		return ""
+	}
+	return string(encodedSteps)
+}
+
+// getUserValidations returns the value of USER_VALIDATIONS variable to be
+// stored in the service's ConfigMap, which lists the validations that are
+// evaluated alongside the built-in validations
+func getUserValidations(log logrus.FieldLogger, spec *aiv1beta1.AgentServiceConfigSpec) string {
+	if len(spec.UserValidations) == 0 {
+		return ""
+	}
+	validations := make(uservalidations.Validations, 0, len(spec.UserValidations))
+	for _, validation := range spec.UserValidations {
+		validations = append(validations, &uservalidations.Validation{
+			ID:             validation.ID,
+			Target:         validation.Target,
+			Expression:     validation.Expression,
+			SuccessMessage: validation.SuccessMessage,
+			FailureMessage: validation.FailureMessage,
+		})
+	}
+	encodedValidations, err := json.Marshal(validations)
+	if err != nil {
+		log.WithError(err).Error("Problem marshaling the user-defined validations")
+		return ""
+	}
+	return string(encodedValidations)
+}
+
 // getOSImages returns the value of OS_IMAGES variable
 // to be stored in the service's ConfigMap
 //
@@ -14,7 +14,9 @@ import (
 	routev1 "github.com/openshift/api/route/v1"
 	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/customsteps"
 	"github.com/openshift/assisted-service/internal/testing"
+	"github.com/openshift/assisted-service/internal/uservalidations"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
@@ -1078,6 +1080,35 @@ var _ = Describe("agentserviceconfig_controller reconcile", func() {
 		})
 	})
 
+	Context("with a pull secret for the pre-warmed releases", func() {
+		It("should reference the pull secret in the deployment", func() {
+			asc = newASCDefault()
+			asc.Spec.PrewarmPullSecretRef = &corev1.LocalObjectReference{Name: "prewarm-pull-secret"}
+
+			ascr = newTestReconciler(asc, ingressCM, route, imageRoute, clusterTrustedCM)
+			_, err := ascr.Reconcile(ctx, newAgentServiceConfigRequest(asc))
+			Expect(err).NotTo(HaveOccurred())
+
+			found := &appsv1.Deployment{}
+			Expect(ascr.Client.Get(ctx, types.NamespacedName{Name: serviceName, Namespace: testNamespace}, found)).To(Succeed())
+			Expect(found.Spec.Template.Spec.Containers[0].Env).To(
+				ContainElement(
+					corev1.EnvVar{
+						Name: "INSTALLER_CACHE_PREWARM_PULL_SECRET",
+						ValueFrom: &corev1.EnvVarSource{
+							SecretKeyRef: &corev1.SecretKeySelector{
+								Key: corev1.DockerConfigJsonKey,
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "prewarm-pull-secret",
+								},
+							},
+						},
+					},
+				),
+			)
+		})
+	})
+
 	Context("with PVC prefix annotation on AgentServiceConfig", func() {
 		It("should create prefixed PVC names", func() {
 			asc = newASCDefault()
@@ -2432,6 +2463,87 @@ var _ = Describe("getMustGatherImages", func() {
 
 })
 
+var _ = Describe("getPrewarmReleases", func() {
+	It("returns an empty string when no release is pre-warmed", func() {
+		asc := newASCDefault()
+		Expect(getPrewarmReleases(logrus.New(), &asc.Spec)).To(Equal(""))
+	})
+
+	It("returns the releases in the format of the pre-warm API", func() {
+		asc := newASCDefault()
+		asc.Spec.PrewarmReleases = []aiv1beta1.PrewarmRelease{
+			{ReleaseImage: "quay.io/openshift-release-dev/ocp-release:4.17.0-x86_64", Pinned: true},
+			{ReleaseImage: "quay.io/openshift-release-dev/ocp-release:4.16.0-x86_64"},
+		}
+		Expect(getPrewarmReleases(logrus.New(), &asc.Spec)).To(MatchJSON(`[
+			{"release_image": "quay.io/openshift-release-dev/ocp-release:4.17.0-x86_64", "pinned": true},
+			{"release_image": "quay.io/openshift-release-dev/ocp-release:4.16.0-x86_64", "pinned": false}
+		]`))
+	})
+})
+
+var _ = Describe("getCustomSteps", func() {
+	It("returns an empty string when no custom step is defined", func() {
+		asc := newASCDefault()
+		Expect(getCustomSteps(logrus.New(), &asc.Spec)).To(Equal(""))
+	})
+
+	It("returns the custom steps in the format of the service", func() {
+		asc := newASCDefault()
+		asc.Spec.CustomSteps = []aiv1beta1.CustomStep{
+			{
+				Name:        "firmware-probe",
+				Image:       "quay.io/example/firmware-probe:latest",
+				Args:        []string{"--bios"},
+				States:      []string{models.HostStatusKnown, models.HostStatusInsufficient},
+				ReplySchema: `{"type": "object", "required": ["version"]}`,
+				Required:    true,
+			},
+			{
+				Name:           "bmc-reachability",
+				Image:          "quay.io/example/bmc-reachability:latest",
+				TimeoutSeconds: 30,
+				States:         []string{models.HostStatusKnownUnbound},
+			},
+		}
+		value := getCustomSteps(logrus.New(), &asc.Spec)
+		Expect(value).To(MatchJSON(`[
+			{"name": "firmware-probe", "image": "quay.io/example/firmware-probe:latest", "args": ["--bios"],
+				"states": ["known", "insufficient"], "reply_schema": {"type": "object", "required": ["version"]}, "required": true},
+			{"name": "bmc-reachability", "image": "quay.io/example/bmc-reachability:latest", "timeout_seconds": 30,
+				"states": ["known-unbound"]}
+		]`))
+		var steps customsteps.Steps
+		Expect(steps.Decode(value)).To(Succeed())
+		Expect(steps).To(HaveLen(2))
+	})
+})
+
+var _ = Describe("getUserValidations", func() {
+	It("returns an empty string when no validation is defined", func() {
+		asc := newASCDefault()
+		Expect(getUserValidations(logrus.New(), &asc.Spec)).To(Equal(""))
+	})
+
+	It("returns the validations in the format of the service", func() {
+		asc := newASCDefault()
+		asc.Spec.UserValidations = []aiv1beta1.UserValidation{
+			{
+				ID:             "no-small-disks",
+				Target:         "host",
+				Expression:     "all(.inventory.disks[]; .size_bytes >= 500000000000)",
+				FailureMessage: "Disks must be at least 500GB",
+			},
+		}
+		value := getUserValidations(logrus.New(), &asc.Spec)
+		Expect(value).To(MatchJSON(`[{"id": "no-small-disks", "target": "host",
+			"expression": "all(.inventory.disks[]; .size_bytes >= 500000000000)", "failure_message": "Disks must be at least 500GB"}]`))
+		var validations uservalidations.Validations
+		Expect(validations.Decode(value)).To(Succeed())
+		Expect(validations).To(HaveLen(1))
+	})
+})
+
 var _ = Describe("getOSImages", func() {
 	const OS_IMAGES_ENVVAR string = "OS_IMAGES"
 	var defaultSpecOsImages = []aiv1beta1.OSImage{
@@ -49,7 +49,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/kubectl/pkg/drain"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -1992,44 +1991,7 @@ func (r *BMACReconciler) drainAgentNode(ctx context.Context, log logrus.FieldLog
 		return false, err
 	}
 
-	out := new(bytes.Buffer)
-	drainHelper := &drain.Helper{
-		Client:              clientset,
-		Ctx:                 ctx,
-		Force:               true,
-		IgnoreAllDaemonSets: true,
-		DeleteEmptyDirData:  true,
-		GracePeriodSeconds:  -1,
-		Timeout:             20 * time.Second,
-		OnPodDeletionOrEvictionFinished: func(pod *corev1.Pod, usingEviction bool, err error) {
-			verbStr := "Deleted"
-			if usingEviction {
-				verbStr = "Evicted"
-			}
-			if err != nil {
-				log.Warnf("%s Pod %s/%s from Node %s, %s", verbStr, pod.Namespace, pod.Name, nodeName, err.Error())
-				return
-			}
-			log.Infof("%s Pod %s/%s from Node %s", verbStr, pod.Namespace, pod.Name, nodeName)
-		},
-		Out:    out,
-		ErrOut: out,
-	}
-	if nodeUnreachable(node) {
-		// When the node is unreachable and some pods are not evicted for as long as this timeout, we ignore them.
-		drainHelper.SkipWaitForDeleteTimeoutSeconds = 60 * 5 // 5 minutes
-	}
-	if err := r.Drainer.RunCordonOrUncordon(drainHelper, node, true); err != nil {
-		log.WithError(err).Errorf("failed to cordon node %s: output: %s", nodeName, out)
-		return false, errors.Wrapf(err, "failed to cordon node %s", nodeName)
-	}
-	if err := r.Drainer.RunNodeDrain(drainHelper, nodeName); err != nil {
-		log.WithError(err).Warnf("failed to drain node %s within %d timeout", nodeName, drainHelper.Timeout)
-		log.Debugf("node %s drain output: %s", nodeName, out)
-		return true, nil
-	}
-
-	return false, nil
+	return cordonAndDrainNode(ctx, log, r.Drainer, clientset, node)
 }
 
 // Adding 'status' and 'paused' annotations to the BMH.
@@ -0,0 +1,191 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/clustertemplates"
+	"github.com/openshift/assisted-service/models"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const ClusterTemplateFinalizer = "clustertemplate." + aiv1beta1.Group
+
+// ClusterTemplateReconciler syncs ClusterTemplate resources to the cluster templates of the REST API. The template
+// ID is the UID of the resource.
+type ClusterTemplateReconciler struct {
+	client.Client
+	Log       logrus.FieldLogger
+	Templates clustertemplates.ClusterTemplateInternals
+}
+
+//+kubebuilder:rbac:groups=agent-install.openshift.io,resources=clustertemplates,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=agent-install.openshift.io,resources=clustertemplates/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=agent-install.openshift.io,resources=clustertemplates/finalizers,verbs=update
+
+func (r *ClusterTemplateReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx := addRequestIdIfNeeded(origCtx)
+	log := r.Log.WithFields(
+		logrus.Fields{
+			"cluster_template":           req.Name,
+			"cluster_template_namespace": req.Namespace,
+		})
+
+	defer func() {
+		log.Debug("ClusterTemplate Reconcile ended")
+	}()
+
+	log.Debug("ClusterTemplate Reconcile started")
+
+	template := &aiv1beta1.ClusterTemplate{}
+	if err := r.Get(ctx, req.NamespacedName, template); err != nil {
+		log.WithError(err).Errorf("Failed to get ClusterTemplate %s", req.NamespacedName)
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	templateID := strfmt.UUID(template.UID)
+
+	if !template.DeletionTimestamp.IsZero() {
+		if funk.ContainsString(template.GetFinalizers(), ClusterTemplateFinalizer) {
+			if err := r.Templates.DeleteClusterTemplateInternal(ctx, templateID); err != nil {
+				log.WithError(err).Errorf("failed to delete cluster template %s", templateID)
+				return ctrl.Result{}, err
+			}
+			controllerutil.RemoveFinalizer(template, ClusterTemplateFinalizer)
+			if err := r.Update(ctx, template); err != nil {
+				log.WithError(err).Errorf("failed to remove finalizer %s from resource %s %s", ClusterTemplateFinalizer, template.Name, template.Namespace)
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// Add a finalizer to newly created objects.
+	if !funk.ContainsString(template.GetFinalizers(), ClusterTemplateFinalizer) {
+		controllerutil.AddFinalizer(template, ClusterTemplateFinalizer)
+		if err := r.Update(ctx, template); err != nil {
+			log.WithError(err).Errorf("failed to add finalizer %s to resource %s %s", ClusterTemplateFinalizer, template.Name, template.Namespace)
+			return ctrl.Result{}, err
+		}
+	}
+
+	var syncErr error
+	spec, err := clusterTemplateSpec(&template.Spec)
+	if err != nil {
+		syncErr = newInputError("%s", err.Error())
+	} else {
+		_, syncErr = r.Templates.SyncClusterTemplateInternal(ctx, templateID,
+			fmt.Sprintf("%s/%s", template.Namespace, template.Name), template.Spec.Description, spec)
+	}
+	setClusterTemplateSyncedCondition(template, syncErr)
+	if syncErr == nil {
+		template.Status.TemplateID = templateID.String()
+	}
+
+	if err := r.Status().Update(ctx, template); err != nil {
+		log.WithError(err).Error("failed to update cluster template status")
+		return ctrl.Result{}, err
+	}
+	if syncErr != nil && !IsUserError(syncErr) {
+		return ctrl.Result{}, syncErr
+	}
+	return ctrl.Result{}, nil
+}
+
+// clusterTemplateSpec converts the spec of the resource to the spec of the REST API
+func clusterTemplateSpec(spec *aiv1beta1.ClusterTemplateSpec) (*models.ClusterTemplateSpec, error) {
+	ret := &models.ClusterTemplateSpec{
+		Parameters: make([]*models.ClusterTemplateParameter, 0, len(spec.Parameters)),
+		Manifests:  make([]*models.CreateManifestParams, 0, len(spec.Manifests)),
+	}
+	for _, parameter := range spec.Parameters {
+		ret.Parameters = append(ret.Parameters, &models.ClusterTemplateParameter{
+			Name:        swag.String(parameter.Name),
+			Description: parameter.Description,
+			Default:     parameter.Default,
+			Required:    swag.Bool(parameter.Required),
+		})
+	}
+	var err error
+	if ret.ClusterParams, err = decodeRawExtension(spec.ClusterParams); err != nil {
+		return nil, errors.Wrap(err, "invalid clusterParams")
+	}
+	if ret.InfraEnvParams, err = decodeRawExtension(spec.InfraEnvParams); err != nil {
+		return nil, errors.Wrap(err, "invalid infraEnvParams")
+	}
+	for _, manifest := range spec.Manifests {
+		folder := manifest.Folder
+		if folder == "" {
+			folder = models.CreateManifestParamsFolderManifests
+		}
+		ret.Manifests = append(ret.Manifests, &models.CreateManifestParams{
+			Folder:   swag.String(folder),
+			FileName: swag.String(manifest.FileName),
+			Content:  swag.String(base64.StdEncoding.EncodeToString([]byte(manifest.Content))),
+		})
+	}
+	return ret, nil
+}
+
+func decodeRawExtension(raw *runtime.RawExtension) (interface{}, error) {
+	if raw == nil || len(raw.Raw) == 0 {
+		return nil, nil
+	}
+	var ret interface{}
+	if err := json.Unmarshal(raw.Raw, &ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func setClusterTemplateSyncedCondition(template *aiv1beta1.ClusterTemplate, err error) {
+	condition := conditionsv1.Condition{
+		Type:    aiv1beta1.ClusterTemplateSyncedCondition,
+		Status:  corev1.ConditionTrue,
+		Reason:  aiv1beta1.ClusterTemplateSyncedReason,
+		Message: "The template was synced",
+	}
+	if err != nil {
+		condition.Status = corev1.ConditionFalse
+		condition.Reason = aiv1beta1.ClusterTemplateBackendErrorReason
+		if IsUserError(err) {
+			condition.Reason = aiv1beta1.ClusterTemplateInvalidReason
+		}
+		condition.Message = fmt.Sprintf("The template was not synced: %s", err.Error())
+	}
+	conditionsv1.SetStatusConditionNoHeartbeat(&template.Status.Conditions, condition)
+}
+
+func (r *ClusterTemplateReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&aiv1beta1.ClusterTemplate{}).
+		Complete(r)
+}
@@ -0,0 +1,134 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/clustertemplates"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("ClusterTemplate reconcile", func() {
+	var (
+		c             client.Client
+		r             *ClusterTemplateReconciler
+		mockCtrl      *gomock.Controller
+		mockTemplates *clustertemplates.MockClusterTemplateInternals
+		ctx           = context.Background()
+		key           = types.NamespacedName{Namespace: testNamespace, Name: "edge"}
+		templateID    = strfmt.UUID("7b4c5d36-2f1c-4a6e-9b8e-1d2f3a4b5c6d")
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithStatusSubresource(&v1beta1.ClusterTemplate{}).Build()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTemplates = clustertemplates.NewMockClusterTemplateInternals(mockCtrl)
+		r = &ClusterTemplateReconciler{
+			Client:    c,
+			Log:       common.GetTestLog(),
+			Templates: mockTemplates,
+		}
+		Expect(c.Create(ctx, &v1beta1.ClusterTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace, UID: types.UID(templateID)},
+			Spec: v1beta1.ClusterTemplateSpec{
+				Description:   "edge clusters",
+				Parameters:    []v1beta1.ClusterTemplateParameter{{Name: "domain", Required: true}},
+				ClusterParams: &runtime.RawExtension{Raw: []byte(`{"base_dns_domain": "${domain}"}`)},
+				Manifests:     []v1beta1.ClusterTemplateManifest{{FileName: "a.yaml", Content: "a: ${domain}"}},
+			},
+		})).To(Succeed())
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	getTemplate := func() *v1beta1.ClusterTemplate {
+		template := &v1beta1.ClusterTemplate{}
+		ExpectWithOffset(1, c.Get(ctx, key, template)).To(Succeed())
+		return template
+	}
+
+	It("syncs the template", func() {
+		mockTemplates.EXPECT().SyncClusterTemplateInternal(gomock.Any(), templateID, "test-namespace/edge", "edge clusters", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ strfmt.UUID, _ string, _ string, spec *models.ClusterTemplateSpec) (*models.ClusterTemplate, error) {
+				Expect(spec.Parameters).To(Equal([]*models.ClusterTemplateParameter{
+					{Name: swag.String("domain"), Required: swag.Bool(true)},
+				}))
+				Expect(spec.ClusterParams).To(Equal(map[string]interface{}{"base_dns_domain": "${domain}"}))
+				Expect(spec.InfraEnvParams).To(BeNil())
+				Expect(spec.Manifests).To(Equal([]*models.CreateManifestParams{{
+					Folder:   swag.String(models.CreateManifestParamsFolderManifests),
+					FileName: swag.String("a.yaml"),
+					Content:  swag.String("YTogJHtkb21haW59"),
+				}}))
+				return &models.ClusterTemplate{ID: &templateID}, nil
+			}).Times(1)
+
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+
+		template := getTemplate()
+		Expect(template.Finalizers).To(ContainElement(ClusterTemplateFinalizer))
+		Expect(template.Status.TemplateID).To(Equal(templateID.String()))
+		condition := conditionsv1.FindStatusCondition(template.Status.Conditions, v1beta1.ClusterTemplateSyncedCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(v1beta1.ClusterTemplateSyncedReason))
+	})
+
+	It("reports an invalid template without requeueing", func() {
+		mockTemplates.EXPECT().SyncClusterTemplateInternal(gomock.Any(), templateID, gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, common.NewApiError(http.StatusBadRequest, errors.New("parameter proxy is referenced but not declared"))).Times(1)
+
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).ToNot(HaveOccurred())
+
+		template := getTemplate()
+		Expect(template.Status.TemplateID).To(BeEmpty())
+		condition := conditionsv1.FindStatusCondition(template.Status.Conditions, v1beta1.ClusterTemplateSyncedCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(v1beta1.ClusterTemplateInvalidReason))
+		Expect(condition.Message).To(ContainSubstring("parameter proxy is referenced but not declared"))
+	})
+
+	It("requeues on backend errors", func() {
+		mockTemplates.EXPECT().SyncClusterTemplateInternal(gomock.Any(), templateID, gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("db error")).Times(1)
+
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).To(HaveOccurred())
+		condition := conditionsv1.FindStatusCondition(getTemplate().Status.Conditions, v1beta1.ClusterTemplateSyncedCondition)
+		Expect(condition.Reason).To(Equal(v1beta1.ClusterTemplateBackendErrorReason))
+	})
+
+	It("deletes the template with the resource", func() {
+		mockTemplates.EXPECT().SyncClusterTemplateInternal(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&models.ClusterTemplate{}, nil).Times(1)
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).ToNot(HaveOccurred())
+
+		mockTemplates.EXPECT().DeleteClusterTemplateInternal(gomock.Any(), templateID).Return(nil).Times(1)
+		Expect(c.Delete(ctx, getTemplate())).To(Succeed())
+		_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(k8serrors.IsNotFound(c.Get(ctx, key, &v1beta1.ClusterTemplate{}))).To(BeTrue())
+	})
+})
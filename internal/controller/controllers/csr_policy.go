@@ -0,0 +1,157 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/pkg/errors"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// maxCSRDecisions is the number of the most recent CSR decisions that are kept in the status of the agent
+	maxCSRDecisions = 20
+
+	CSRApprovedEventReason = "CSRApproved"
+	CSRDeniedEventReason   = "CSRDenied"
+)
+
+// csrApprovalPolicy is the CSR approval policy of the cluster of an agent, with the cluster data needed to evaluate it
+type csrApprovalPolicy struct {
+	*hiveext.CSRApprovalPolicy
+	machineNetworks []*net.IPNet
+	bmhExists       bool
+}
+
+// getCSRApprovalPolicy returns the CSR approval policy of the cluster of the agent, or nil if the cluster has none. A
+// cluster whose ClusterDeployment or AgentClusterInstall doesn't exist has no policy.
+func (r *AgentReconciler) getCSRApprovalPolicy(ctx context.Context, agent *aiv1beta1.Agent) (*csrApprovalPolicy, error) {
+	cd, err := getClusterDeploymentFromAgent(ctx, r.Client, agent)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if cd.Spec.ClusterInstallRef == nil {
+		return nil, nil
+	}
+	clusterInstall := &hiveext.AgentClusterInstall{}
+	namespacedName := types.NamespacedName{
+		Namespace: cd.Namespace,
+		Name:      cd.Spec.ClusterInstallRef.Name,
+	}
+	if err = r.Client.Get(ctx, namespacedName, clusterInstall); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "Could not get AgentClusterInstall %s for ClusterDeployment %s", cd.Spec.ClusterInstallRef.Name, cd.Name)
+	}
+	if clusterInstall.Spec.CSRApprovalPolicy == nil {
+		return nil, nil
+	}
+
+	policy := &csrApprovalPolicy{CSRApprovalPolicy: clusterInstall.Spec.CSRApprovalPolicy}
+	if policy.RestrictIPsToMachineNetworks {
+		machineNetworks := clusterInstall.Spec.Networking.MachineNetwork
+		if len(machineNetworks) == 0 {
+			machineNetworks = clusterInstall.Status.MachineNetwork
+		}
+		for _, machineNetwork := range machineNetworks {
+			_, ipNet, err := net.ParseCIDR(machineNetwork.CIDR)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse machine network %s of AgentClusterInstall %s", machineNetwork.CIDR, clusterInstall.Name)
+			}
+			policy.machineNetworks = append(policy.machineNetworks, ipNet)
+		}
+	}
+	if policy.RequireBareMetalHost {
+		if policy.bmhExists, err = r.bmhExists(ctx, agent); err != nil {
+			return nil, err
+		}
+	}
+	return policy, nil
+}
+
+// evaluate returns whether the policy allows to approve the CSR of the agent, and the reason of the decision
+func (p *csrApprovalPolicy) evaluate(agent *aiv1beta1.Agent, csr *certificatesv1.CertificateSigningRequest, now time.Time) (bool, string) {
+	if p == nil || p.CSRApprovalPolicy == nil {
+		return true, "The CSR was validated for the node of the agent"
+	}
+	if p.RequireBareMetalHost && !p.bmhExists {
+		return false, "The agent has no matching BareMetalHost"
+	}
+	if p.ApprovalWindow != nil && !agent.Status.CSRStatus.ApprovalStartedAt.IsZero() {
+		windowEnd := agent.Status.CSRStatus.ApprovalStartedAt.Add(p.ApprovalWindow.Duration)
+		if now.After(windowEnd) {
+			return false, fmt.Sprintf("The approval window of %s ended at %s", p.ApprovalWindow.Duration, windowEnd.UTC().Format(time.RFC3339))
+		}
+	}
+	if p.MaxCSRsPerNode > 0 && len(agent.Status.CSRStatus.ApprovedCSRs) >= p.MaxCSRsPerNode {
+		return false, fmt.Sprintf("The maximum of %d approved CSRs for the node was reached", p.MaxCSRsPerNode)
+	}
+	if p.RestrictIPsToMachineNetworks {
+		x509CSR, err := getX509ParsedRequest(csr)
+		if err != nil {
+			return false, err.Error()
+		}
+		if len(x509CSR.IPAddresses) > 0 && len(p.machineNetworks) == 0 {
+			return false, "The IP addresses of the CSR can't be restricted to the machine networks of the cluster, as the cluster has no machine networks"
+		}
+		for _, ip := range x509CSR.IPAddresses {
+			if !ipInNetworks(ip, p.machineNetworks) {
+				return false, fmt.Sprintf("IP address %s is not within the machine networks of the cluster", ip)
+			}
+		}
+	}
+	return true, "The CSR was validated for the node of the agent and satisfies the CSR approval policy of the cluster"
+}
+
+func ipInNetworks(ip net.IP, networks []*net.IPNet) bool {
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// recordCSRDecision records the decision for the CSR in the status of the agent and as an event of the agent, unless
+// it's the same as the last decision that was recorded for the CSR
+func (r *AgentReconciler) recordCSRDecision(agent *aiv1beta1.Agent, csrName string, csrType aiv1beta1.CSRType, approved bool, reason string) {
+	decisions := agent.Status.CSRStatus.Decisions
+	for i := len(decisions) - 1; i >= 0; i-- {
+		if decisions[i].Name == csrName {
+			if decisions[i].Approved == approved && decisions[i].Reason == reason {
+				return
+			}
+			break
+		}
+	}
+
+	decisions = append(decisions, aiv1beta1.CSRDecision{
+		Name:     csrName,
+		Type:     csrType,
+		Approved: approved,
+		Reason:   reason,
+		Time:     metav1.Now(),
+	})
+	if len(decisions) > maxCSRDecisions {
+		decisions = decisions[len(decisions)-maxCSRDecisions:]
+	}
+	agent.Status.CSRStatus.Decisions = decisions
+
+	if approved {
+		r.Recorder.Eventf(agent, corev1.EventTypeNormal, CSRApprovedEventReason, "Approved %s CSR %s: %s", csrType, csrName, reason)
+	} else {
+		r.Recorder.Eventf(agent, corev1.EventTypeWarning, CSRDeniedEventReason, "Denied %s CSR %s: %s", csrType, csrName, reason)
+	}
+}
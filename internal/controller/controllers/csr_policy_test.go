@@ -0,0 +1,234 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	bmh_v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
+	"github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/common"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("CSR approval policy", func() {
+	var (
+		c                     client.Client
+		hr                    *AgentReconciler
+		recorder              *record.FakeRecorder
+		ctx                   = context.Background()
+		agent                 *v1beta1.Agent
+		aci                   *hiveext.AgentClusterInstall
+		clusterDeploymentName = "test-cluster"
+		agentHostname         = "worker-0.example.com"
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		recorder = record.NewFakeRecorder(10)
+		hr = &AgentReconciler{
+			Client:   c,
+			Scheme:   scheme.Scheme,
+			Log:      common.GetTestLog(),
+			Recorder: recorder,
+		}
+
+		cd := newClusterDeployment(clusterDeploymentName, testNamespace,
+			getDefaultClusterDeploymentSpec(clusterDeploymentName, "test-cluster-aci", "pull-secret"))
+		Expect(c.Create(ctx, cd)).To(Succeed())
+		aci = newAgentClusterInstall("test-cluster-aci", testNamespace, hiveext.AgentClusterInstallSpec{
+			Networking: hiveext.Networking{
+				MachineNetwork: []hiveext.MachineNetworkEntry{{CIDR: "192.168.111.0/24"}},
+			},
+		}, cd)
+
+		agent = newAgent("agent", testNamespace, v1beta1.AgentSpec{
+			ClusterDeploymentName: &v1beta1.ClusterReference{Name: clusterDeploymentName, Namespace: testNamespace},
+			Hostname:              agentHostname,
+		})
+		agent.Status.CSRStatus.ApprovalStartedAt = metav1.Now()
+	})
+
+	Context("getCSRApprovalPolicy", func() {
+		It("returns nil when the cluster has no policy", func() {
+			Expect(c.Create(ctx, aci)).To(Succeed())
+			policy, err := hr.getCSRApprovalPolicy(ctx, agent)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policy).To(BeNil())
+		})
+
+		It("returns the policy with the machine networks and the BareMetalHost of the agent", func() {
+			aci.Spec.CSRApprovalPolicy = &hiveext.CSRApprovalPolicy{
+				RequireBareMetalHost:         true,
+				RestrictIPsToMachineNetworks: true,
+			}
+			Expect(c.Create(ctx, aci)).To(Succeed())
+			Expect(c.Create(ctx, newBMH("bmh", &bmh_v1alpha1.BareMetalHostSpec{}))).To(Succeed())
+			agent.Labels = map[string]string{AGENT_BMH_LABEL: "bmh"}
+
+			policy, err := hr.getCSRApprovalPolicy(ctx, agent)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policy.bmhExists).To(BeTrue())
+			Expect(policy.machineNetworks).To(HaveLen(1))
+			Expect(policy.machineNetworks[0].String()).To(Equal("192.168.111.0/24"))
+		})
+
+		It("uses the machine networks in the status of the cluster when they aren't specified", func() {
+			aci.Spec.Networking.MachineNetwork = nil
+			aci.Spec.CSRApprovalPolicy = &hiveext.CSRApprovalPolicy{RestrictIPsToMachineNetworks: true}
+			aci.Status.MachineNetwork = []hiveext.MachineNetworkEntry{{CIDR: "10.0.0.0/16"}}
+			Expect(c.Create(ctx, aci)).To(Succeed())
+
+			policy, err := hr.getCSRApprovalPolicy(ctx, agent)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policy.bmhExists).To(BeFalse())
+			Expect(policy.machineNetworks).To(HaveLen(1))
+			Expect(policy.machineNetworks[0].String()).To(Equal("10.0.0.0/16"))
+		})
+
+		It("returns nil when the cluster install doesn't exist", func() {
+			policy, err := hr.getCSRApprovalPolicy(ctx, agent)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policy).To(BeNil())
+		})
+
+		It("returns nil when the cluster deployment doesn't exist", func() {
+			agent.Spec.ClusterDeploymentName.Name = "missing"
+			policy, err := hr.getCSRApprovalPolicy(ctx, agent)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policy).To(BeNil())
+		})
+	})
+
+	Context("evaluate", func() {
+		var (
+			csr   certificatesv1.CertificateSigningRequest
+			ipNet *net.IPNet
+		)
+
+		BeforeEach(func() {
+			csr = newNodeCSR("csr", agentHostname, net.ParseIP("192.168.111.28"))
+			_, ipNet, _ = net.ParseCIDR("192.168.111.0/24")
+		})
+
+		It("approves without a policy", func() {
+			var policy *csrApprovalPolicy
+			approved, reason := policy.evaluate(agent, &csr, time.Now())
+			Expect(approved).To(BeTrue())
+			Expect(reason).To(Equal("The CSR was validated for the node of the agent"))
+		})
+
+		It("approves when all the rules are satisfied", func() {
+			policy := &csrApprovalPolicy{
+				CSRApprovalPolicy: &hiveext.CSRApprovalPolicy{
+					RequireBareMetalHost:         true,
+					RestrictIPsToMachineNetworks: true,
+					ApprovalWindow:               &metav1.Duration{Duration: time.Hour},
+					MaxCSRsPerNode:               2,
+				},
+				machineNetworks: []*net.IPNet{ipNet},
+				bmhExists:       true,
+			}
+			approved, reason := policy.evaluate(agent, &csr, time.Now())
+			Expect(approved).To(BeTrue())
+			Expect(reason).To(ContainSubstring("satisfies the CSR approval policy"))
+		})
+
+		It("denies when the agent has no BareMetalHost", func() {
+			policy := &csrApprovalPolicy{CSRApprovalPolicy: &hiveext.CSRApprovalPolicy{RequireBareMetalHost: true}}
+			approved, reason := policy.evaluate(agent, &csr, time.Now())
+			Expect(approved).To(BeFalse())
+			Expect(reason).To(Equal("The agent has no matching BareMetalHost"))
+		})
+
+		It("denies after the approval window", func() {
+			policy := &csrApprovalPolicy{CSRApprovalPolicy: &hiveext.CSRApprovalPolicy{
+				ApprovalWindow: &metav1.Duration{Duration: time.Hour},
+			}}
+			approved, reason := policy.evaluate(agent, &csr, time.Now().Add(2*time.Hour))
+			Expect(approved).To(BeFalse())
+			Expect(reason).To(HavePrefix("The approval window of 1h0m0s ended at"))
+		})
+
+		It("denies when the maximum of approved CSRs was reached", func() {
+			agent.Status.CSRStatus.ApprovedCSRs = []v1beta1.CSRInfo{{Name: "client-csr", Type: v1beta1.CSRTypeClient}}
+			policy := &csrApprovalPolicy{CSRApprovalPolicy: &hiveext.CSRApprovalPolicy{MaxCSRsPerNode: 1}}
+			approved, reason := policy.evaluate(agent, &csr, time.Now())
+			Expect(approved).To(BeFalse())
+			Expect(reason).To(Equal("The maximum of 1 approved CSRs for the node was reached"))
+		})
+
+		It("denies an IP address outside of the machine networks", func() {
+			_, otherNet, _ := net.ParseCIDR("10.0.0.0/16")
+			policy := &csrApprovalPolicy{
+				CSRApprovalPolicy: &hiveext.CSRApprovalPolicy{RestrictIPsToMachineNetworks: true},
+				machineNetworks:   []*net.IPNet{otherNet},
+			}
+			approved, reason := policy.evaluate(agent, &csr, time.Now())
+			Expect(approved).To(BeFalse())
+			Expect(reason).To(Equal("IP address 192.168.111.28 is not within the machine networks of the cluster"))
+		})
+
+		It("denies an IP address when the cluster has no machine networks", func() {
+			policy := &csrApprovalPolicy{CSRApprovalPolicy: &hiveext.CSRApprovalPolicy{RestrictIPsToMachineNetworks: true}}
+			approved, reason := policy.evaluate(agent, &csr, time.Now())
+			Expect(approved).To(BeFalse())
+			Expect(reason).To(Equal("The IP addresses of the CSR can't be restricted to the machine networks of the cluster, as the cluster has no machine networks"))
+		})
+	})
+
+	Context("recordCSRDecision", func() {
+		It("records every decision once, with an event", func() {
+			hr.recordCSRDecision(agent, "client-csr", v1beta1.CSRTypeClient, true, "approved")
+			hr.recordCSRDecision(agent, "server-csr", v1beta1.CSRTypeServing, false, "denied")
+			hr.recordCSRDecision(agent, "server-csr", v1beta1.CSRTypeServing, false, "denied")
+
+			decisions := agent.Status.CSRStatus.Decisions
+			Expect(decisions).To(HaveLen(2))
+			Expect(decisions[0].Name).To(Equal("client-csr"))
+			Expect(decisions[0].Approved).To(BeTrue())
+			Expect(decisions[1].Name).To(Equal("server-csr"))
+			Expect(decisions[1].Type).To(Equal(v1beta1.CSRTypeServing))
+			Expect(decisions[1].Approved).To(BeFalse())
+			Expect(decisions[1].Reason).To(Equal("denied"))
+
+			Expect(recorder.Events).To(HaveLen(2))
+			Expect(<-recorder.Events).To(Equal("Normal CSRApproved Approved client CSR client-csr: approved"))
+			Expect(<-recorder.Events).To(Equal("Warning CSRDenied Denied serving CSR server-csr: denied"))
+		})
+
+		It("records a new decision for a CSR when it changes", func() {
+			hr.recordCSRDecision(agent, "server-csr", v1beta1.CSRTypeServing, false, "denied")
+			hr.recordCSRDecision(agent, "server-csr", v1beta1.CSRTypeServing, true, "approved")
+			Expect(agent.Status.CSRStatus.Decisions).To(HaveLen(2))
+			Expect(agent.Status.CSRStatus.Decisions[1].Approved).To(BeTrue())
+		})
+
+		It("keeps only the most recent decisions", func() {
+			hr.Recorder = &record.FakeRecorder{}
+			for i := 0; i < maxCSRDecisions+5; i++ {
+				hr.recordCSRDecision(agent, fmt.Sprintf("csr-%d", i), v1beta1.CSRTypeClient, true, "approved")
+			}
+			decisions := agent.Status.CSRStatus.Decisions
+			Expect(decisions).To(HaveLen(maxCSRDecisions))
+			Expect(decisions[0].Name).To(Equal("csr-5"))
+			Expect(decisions[maxCSRDecisions-1].Name).To(Equal(fmt.Sprintf("csr-%d", maxCSRDecisions+4)))
+		})
+
+		It("keeps the decisions when the CSR status is reset", func() {
+			hr.recordCSRDecision(agent, "client-csr", v1beta1.CSRTypeClient, true, "approved")
+			resetCSRStatus(agent)
+			Expect(agent.Status.CSRStatus.ApprovalStartedAt.IsZero()).To(BeTrue())
+			Expect(agent.Status.CSRStatus.Decisions).To(HaveLen(1))
+		})
+	})
+})
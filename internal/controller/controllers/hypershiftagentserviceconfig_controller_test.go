@@ -652,6 +652,10 @@ func (c fakeSpokeK8sClient) ApproveCsr(ctx context.Context, csr *certificatesv1.
 	return nil
 }
 
+func (c fakeSpokeK8sClient) DeleteCsr(ctx context.Context, name string) error {
+	return nil
+}
+
 func (c fakeSpokeK8sClient) GetNode(ctx context.Context, name string) (*corev1.Node, error) {
 	return nil, nil
 }
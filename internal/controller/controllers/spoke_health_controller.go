@@ -0,0 +1,415 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const (
+	SpokeHealthCheckReachable        = "reachable"
+	SpokeHealthCheckClusterVersion   = "cluster_version"
+	SpokeHealthCheckClusterOperators = "cluster_operators"
+	SpokeHealthCheckNodes            = "nodes"
+	SpokeHealthCheckCertificates     = "certificates"
+
+	clusterVersionFailingCondition configv1.ClusterStatusConditionType = "Failing"
+)
+
+type SpokeHealthConfig struct {
+	Enabled                    bool          `envconfig:"SPOKE_HEALTH_MONITOR_ENABLED" default:"false"`
+	Interval                   time.Duration `envconfig:"SPOKE_HEALTH_MONITOR_INTERVAL" default:"5m"`
+	CertificateExpiryThreshold time.Duration `envconfig:"SPOKE_HEALTH_CERTIFICATE_EXPIRY_THRESHOLD" default:"168h"`
+	CertificateNamespaces      []string      `envconfig:"SPOKE_HEALTH_CERTIFICATE_NAMESPACES" default:"openshift-kube-apiserver,openshift-ingress"`
+}
+
+// SpokeHealthReconciler periodically checks the health of the clusters that were installed by an
+// AgentClusterInstall, and reports it in the conditions of the AgentClusterInstall and in metrics
+type SpokeHealthReconciler struct {
+	client.Client
+	APIReader             client.Reader
+	Log                   logrus.FieldLogger
+	SpokeK8sClientFactory spoke_k8s_client.SpokeK8sClientFactory
+	Metrics               metrics.API
+	Config                SpokeHealthConfig
+}
+
+// spokeHealthCheck is the result of a single health check of a spoke cluster
+type spokeHealthCheck struct {
+	name          string
+	conditionType hivev1.ClusterInstallConditionType
+	healthy       bool
+	reason        string
+	message       string
+}
+
+//+kubebuilder:rbac:groups=extensions.hive.openshift.io,resources=agentclusterinstalls,verbs=get;list;watch
+//+kubebuilder:rbac:groups=extensions.hive.openshift.io,resources=agentclusterinstalls/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=hive.openshift.io,resources=clusterdeployments,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;update
+
+func (r *SpokeHealthReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx := addRequestIdIfNeeded(origCtx)
+	log := r.Log.WithFields(
+		logrus.Fields{
+			"agent_cluster_install":           req.Name,
+			"agent_cluster_install_namespace": req.Namespace,
+		})
+
+	defer func() {
+		log.Debug("SpokeHealth Reconcile ended")
+	}()
+
+	log.Debug("SpokeHealth Reconcile started")
+
+	clusterInstall := &hiveext.AgentClusterInstall{}
+	if err := r.Get(ctx, req.NamespacedName, clusterInstall); err != nil {
+		if k8serrors.IsNotFound(err) {
+			r.Metrics.SpokeClusterHealthRemoved(req.Namespace, req.Name)
+			return ctrl.Result{}, nil
+		}
+		log.WithError(err).Errorf("Failed to get AgentClusterInstall %s", req.NamespacedName)
+		return ctrl.Result{}, err
+	}
+	if !clusterInstall.DeletionTimestamp.IsZero() {
+		r.Metrics.SpokeClusterHealthRemoved(req.Namespace, req.Name)
+		return ctrl.Result{}, nil
+	}
+
+	clusterDeployment := &hivev1.ClusterDeployment{}
+	cdKey := types.NamespacedName{Namespace: clusterInstall.Namespace, Name: clusterInstall.Spec.ClusterDeploymentRef.Name}
+	if err := r.Get(ctx, cdKey, clusterDeployment); err != nil {
+		if k8serrors.IsNotFound(err) {
+			r.Metrics.SpokeClusterHealthRemoved(req.Namespace, req.Name)
+			return ctrl.Result{}, nil
+		}
+		log.WithError(err).Errorf("Failed to get ClusterDeployment %s", cdKey)
+		return ctrl.Result{}, err
+	}
+	if !isInstalled(clusterDeployment, clusterInstall) {
+		return ctrl.Result{}, nil
+	}
+
+	checks, expiry, err := r.checkSpoke(ctx, log, clusterDeployment)
+	if err != nil {
+		log.WithError(err).Warn("failed to check the health of the spoke cluster")
+		checks = unreachableSpokeHealthChecks(err)
+		r.Metrics.SpokeClusterHealthRemoved(req.Namespace, req.Name)
+		r.Metrics.SpokeClusterHealthChecked(req.Namespace, req.Name, SpokeHealthCheckReachable, false)
+	} else {
+		r.Metrics.SpokeClusterHealthChecked(req.Namespace, req.Name, SpokeHealthCheckReachable, true)
+		for _, check := range checks {
+			r.Metrics.SpokeClusterHealthChecked(req.Namespace, req.Name, check.name, check.healthy)
+		}
+		if !expiry.IsZero() {
+			r.Metrics.SpokeClusterCertificateExpiry(req.Namespace, req.Name, expiry)
+		}
+	}
+
+	origConditions := make([]hivev1.ClusterInstallCondition, len(clusterInstall.Status.Conditions))
+	copy(origConditions, clusterInstall.Status.Conditions)
+	for _, check := range checks {
+		status := corev1.ConditionTrue
+		if !check.healthy {
+			status = corev1.ConditionFalse
+		}
+		if check.reason == hiveext.ClusterSpokeUnreachableReason {
+			status = corev1.ConditionUnknown
+		}
+		setClusterCondition(&clusterInstall.Status.Conditions, hivev1.ClusterInstallCondition{
+			Type:    check.conditionType,
+			Status:  status,
+			Reason:  check.reason,
+			Message: check.message,
+		})
+	}
+	if !reflect.DeepEqual(origConditions, clusterInstall.Status.Conditions) {
+		if err := r.Status().Update(ctx, clusterInstall); err != nil {
+			log.WithError(err).Error("failed to update AgentClusterInstall status")
+			return ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}, err
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: r.Config.Interval}, nil
+}
+
+// checkSpoke runs the health checks of the spoke cluster, and returns them with the expiry time of the first certificate
+// to expire. It returns an error if the spoke cluster can't be reached
+func (r *SpokeHealthReconciler) checkSpoke(ctx context.Context, log logrus.FieldLogger, clusterDeployment *hivev1.ClusterDeployment) ([]spokeHealthCheck, time.Time, error) {
+	clusterRef := &aiv1beta1.ClusterReference{Namespace: clusterDeployment.Namespace, Name: clusterDeployment.Name}
+	secret, err := spokeKubeconfigSecret(ctx, log, r.Client, r.APIReader, clusterRef)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	spokeClient, err := r.SpokeK8sClientFactory.CreateFromSecret(clusterDeployment, secret)
+	if err != nil {
+		log.WithError(err).Error("failed to create spoke client")
+		return nil, time.Time{}, err
+	}
+
+	clusterVersion, err := checkSpokeClusterVersion(ctx, spokeClient)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	clusterOperators, err := checkSpokeClusterOperators(ctx, spokeClient)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	nodes, err := checkSpokeNodes(ctx, spokeClient)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	certificates, expiry, err := r.checkSpokeCertificates(ctx, log, spokeClient)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return []spokeHealthCheck{clusterVersion, clusterOperators, nodes, certificates}, expiry, nil
+}
+
+func unreachableSpokeHealthChecks(err error) []spokeHealthCheck {
+	message := fmt.Sprintf("%s %s", hiveext.ClusterSpokeUnreachableMsg, err.Error())
+	var checks []spokeHealthCheck
+	for _, check := range []struct {
+		name          string
+		conditionType hivev1.ClusterInstallConditionType
+	}{
+		{SpokeHealthCheckClusterVersion, hiveext.ClusterSpokeClusterVersionHealthyCondition},
+		{SpokeHealthCheckClusterOperators, hiveext.ClusterSpokeClusterOperatorsHealthyCondition},
+		{SpokeHealthCheckNodes, hiveext.ClusterSpokeNodesReadyCondition},
+		{SpokeHealthCheckCertificates, hiveext.ClusterSpokeCertificatesValidCondition},
+	} {
+		checks = append(checks, spokeHealthCheck{
+			name:          check.name,
+			conditionType: check.conditionType,
+			reason:        hiveext.ClusterSpokeUnreachableReason,
+			message:       message,
+		})
+	}
+	return checks
+}
+
+func findClusterStatusCondition(conditions []configv1.ClusterOperatorStatusCondition, conditionType configv1.ClusterStatusConditionType) *configv1.ClusterOperatorStatusCondition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// isClusterStatusConditionTrue returns whether the condition exists and is true
+func isClusterStatusConditionTrue(conditions []configv1.ClusterOperatorStatusCondition, conditionType configv1.ClusterStatusConditionType) bool {
+	condition := findClusterStatusCondition(conditions, conditionType)
+	return condition != nil && condition.Status == configv1.ConditionTrue
+}
+
+func checkSpokeClusterVersion(ctx context.Context, spokeClient spoke_k8s_client.SpokeK8sClient) (spokeHealthCheck, error) {
+	check := spokeHealthCheck{
+		name:          SpokeHealthCheckClusterVersion,
+		conditionType: hiveext.ClusterSpokeClusterVersionHealthyCondition,
+	}
+	clusterVersion := &configv1.ClusterVersion{}
+	if err := spokeClient.Get(ctx, types.NamespacedName{Name: "version"}, clusterVersion); err != nil {
+		return check, errors.Wrap(err, "failed to get the cluster version")
+	}
+
+	conditions := clusterVersion.Status.Conditions
+	switch {
+	case !isClusterStatusConditionTrue(conditions, configv1.OperatorAvailable):
+		check.reason = hiveext.ClusterSpokeDegradedReason
+		check.message = "The cluster version is not available"
+	case isClusterStatusConditionTrue(conditions, clusterVersionFailingCondition):
+		check.reason = hiveext.ClusterSpokeDegradedReason
+		check.message = fmt.Sprintf("The cluster version is failing: %s",
+			findClusterStatusCondition(conditions, clusterVersionFailingCondition).Message)
+	default:
+		check.healthy = true
+		check.reason = hiveext.ClusterSpokeHealthyReason
+		check.message = fmt.Sprintf("The cluster version %s is available", clusterVersion.Status.Desired.Version)
+	}
+	return check, nil
+}
+
+func checkSpokeClusterOperators(ctx context.Context, spokeClient spoke_k8s_client.SpokeK8sClient) (spokeHealthCheck, error) {
+	check := spokeHealthCheck{
+		name:          SpokeHealthCheckClusterOperators,
+		conditionType: hiveext.ClusterSpokeClusterOperatorsHealthyCondition,
+	}
+	clusterOperators := &configv1.ClusterOperatorList{}
+	if err := spokeClient.List(ctx, clusterOperators); err != nil {
+		return check, errors.Wrap(err, "failed to list the cluster operators")
+	}
+
+	var unhealthy []string
+	for _, operator := range clusterOperators.Items {
+		conditions := operator.Status.Conditions
+		if !isClusterStatusConditionTrue(conditions, configv1.OperatorAvailable) || isClusterStatusConditionTrue(conditions, configv1.OperatorDegraded) {
+			unhealthy = append(unhealthy, operator.Name)
+		}
+	}
+	if len(unhealthy) > 0 {
+		sort.Strings(unhealthy)
+		check.reason = hiveext.ClusterSpokeDegradedReason
+		check.message = fmt.Sprintf("The following cluster operators are unavailable or degraded: %s", strings.Join(unhealthy, ", "))
+		return check, nil
+	}
+	check.healthy = true
+	check.reason = hiveext.ClusterSpokeHealthyReason
+	check.message = fmt.Sprintf("All %d cluster operators are available", len(clusterOperators.Items))
+	return check, nil
+}
+
+func checkSpokeNodes(ctx context.Context, spokeClient spoke_k8s_client.SpokeK8sClient) (spokeHealthCheck, error) {
+	check := spokeHealthCheck{
+		name:          SpokeHealthCheckNodes,
+		conditionType: hiveext.ClusterSpokeNodesReadyCondition,
+	}
+	nodes := &corev1.NodeList{}
+	if err := spokeClient.List(ctx, nodes); err != nil {
+		return check, errors.Wrap(err, "failed to list the nodes")
+	}
+
+	var notReady []string
+	for i := range nodes.Items {
+		if !isNodeReady(&nodes.Items[i]) {
+			notReady = append(notReady, nodes.Items[i].Name)
+		}
+	}
+	if len(notReady) > 0 {
+		sort.Strings(notReady)
+		check.reason = hiveext.ClusterSpokeDegradedReason
+		check.message = fmt.Sprintf("The following nodes are not ready: %s", strings.Join(notReady, ", "))
+		return check, nil
+	}
+	check.healthy = true
+	check.reason = hiveext.ClusterSpokeHealthyReason
+	check.message = fmt.Sprintf("All %d nodes are ready", len(nodes.Items))
+	return check, nil
+}
+
+// checkSpokeCertificates finds the serving certificate that expires first in the configured namespaces of the spoke
+// cluster. It returns the check and the expiry time of that certificate, which is zero if no certificate was found
+func (r *SpokeHealthReconciler) checkSpokeCertificates(ctx context.Context, log logrus.FieldLogger, spokeClient spoke_k8s_client.SpokeK8sClient) (spokeHealthCheck, time.Time, error) {
+	check := spokeHealthCheck{
+		name:          SpokeHealthCheckCertificates,
+		conditionType: hiveext.ClusterSpokeCertificatesValidCondition,
+	}
+
+	var expiry time.Time
+	var expiringSecret string
+	for _, namespace := range r.Config.CertificateNamespaces {
+		secrets := &corev1.SecretList{}
+		if err := spokeClient.List(ctx, secrets, client.InNamespace(namespace)); err != nil {
+			return check, expiry, errors.Wrapf(err, "failed to list the secrets in namespace %s", namespace)
+		}
+		for i := range secrets.Items {
+			secret := &secrets.Items[i]
+			if secret.Type != corev1.SecretTypeTLS {
+				continue
+			}
+			notAfter, err := certificateNotAfter(secret.Data[corev1.TLSCertKey])
+			if err != nil {
+				log.WithError(err).Warnf("failed to parse the certificate of secret %s/%s", secret.Namespace, secret.Name)
+				continue
+			}
+			if expiry.IsZero() || notAfter.Before(expiry) {
+				expiry = notAfter
+				expiringSecret = fmt.Sprintf("%s/%s", secret.Namespace, secret.Name)
+			}
+		}
+	}
+
+	switch {
+	case expiry.IsZero():
+		check.healthy = true
+		check.reason = hiveext.ClusterSpokeHealthyReason
+		check.message = "No certificates were found to check"
+	case time.Until(expiry) < r.Config.CertificateExpiryThreshold:
+		check.reason = hiveext.ClusterSpokeCertificatesExpiringReason
+		check.message = fmt.Sprintf("The certificate in secret %s expires at %s", expiringSecret, expiry.UTC().Format(time.RFC3339))
+	default:
+		check.healthy = true
+		check.reason = hiveext.ClusterSpokeHealthyReason
+		check.message = fmt.Sprintf("The first certificate to expire is in secret %s, at %s", expiringSecret, expiry.UTC().Format(time.RFC3339))
+	}
+	return check, expiry, nil
+}
+
+// certificateNotAfter returns the expiry time of the first certificate in the PEM data
+func certificateNotAfter(data []byte) (time.Time, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return time.Time{}, errors.New("no PEM data was found")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return cert.NotAfter, nil
+}
+
+func (r *SpokeHealthReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// The spoke cluster is checked periodically, so only the updates that may start or stop the checks are of interest
+	installedChangedPredicate := builder.WithPredicates(predicate.Funcs{
+		UpdateFunc: func(updateEvent event.UpdateEvent) bool {
+			oldClusterInstall, ok := updateEvent.ObjectOld.(*hiveext.AgentClusterInstall)
+			if !ok {
+				return false
+			}
+			newClusterInstall, ok := updateEvent.ObjectNew.(*hiveext.AgentClusterInstall)
+			if !ok {
+				return false
+			}
+			if oldClusterInstall.Generation != newClusterInstall.Generation {
+				return true
+			}
+			oldCompleted := FindStatusCondition(oldClusterInstall.Status.Conditions, hiveext.ClusterCompletedCondition)
+			newCompleted := FindStatusCondition(newClusterInstall.Status.Conditions, hiveext.ClusterCompletedCondition)
+			return (oldCompleted == nil) != (newCompleted == nil) ||
+				(oldCompleted != nil && oldCompleted.Reason != newCompleted.Reason)
+		},
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("spoke-health").
+		For(&hiveext.AgentClusterInstall{}, installedChangedPredicate).
+		Complete(r)
+}
@@ -0,0 +1,262 @@
+package controllers
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTLSSecret(name, namespace string, notAfter time.Time) *corev1.Secret {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).ToNot(HaveOccurred())
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}),
+		},
+	}
+}
+
+func newClusterOperator(name string, available, degraded configv1.ConditionStatus) *configv1.ClusterOperator {
+	return &configv1.ClusterOperator{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: configv1.ClusterOperatorStatus{
+			Conditions: []configv1.ClusterOperatorStatusCondition{
+				{Type: configv1.OperatorAvailable, Status: available},
+				{Type: configv1.OperatorDegraded, Status: degraded},
+			},
+		},
+	}
+}
+
+func newSpokeNode(name string, ready corev1.ConditionStatus) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready}},
+		},
+	}
+}
+
+var _ = Describe("SpokeHealth reconcile", func() {
+	var (
+		c                     client.Client
+		sr                    *SpokeHealthReconciler
+		ctx                   = context.Background()
+		mockCtrl              *gomock.Controller
+		mockClientFactory     *spoke_k8s_client.MockSpokeK8sClientFactory
+		mockMetrics           *metrics.MockAPI
+		cd                    *hivev1.ClusterDeployment
+		aci                   *hiveext.AgentClusterInstall
+		aciKey                types.NamespacedName
+		clusterDeploymentName = "test-cluster"
+		aciName               = "test-cluster-aci"
+		interval              = 5 * time.Minute
+	)
+
+	newSpokeClient := func(objs ...client.Object) spoke_k8s_client.SpokeK8sClient {
+		clusterVersion := &configv1.ClusterVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: "version"},
+			Status: configv1.ClusterVersionStatus{
+				Desired: configv1.Release{Version: "4.14.0"},
+				Conditions: []configv1.ClusterOperatorStatusCondition{
+					{Type: configv1.OperatorAvailable, Status: configv1.ConditionTrue},
+					{Type: clusterVersionFailingCondition, Status: configv1.ConditionFalse},
+				},
+			},
+		}
+		objs = append(objs, clusterVersion)
+		return fakeSpokeK8sClient{Client: fakeclient.NewClientBuilder().WithScheme(GetKubeClientSchemes()).WithObjects(objs...).Build()}
+	}
+
+	expectConditions := func(status corev1.ConditionStatus, reason string) {
+		updated := &hiveext.AgentClusterInstall{}
+		Expect(c.Get(ctx, aciKey, updated)).To(Succeed())
+		for _, conditionType := range []hivev1.ClusterInstallConditionType{
+			hiveext.ClusterSpokeClusterVersionHealthyCondition,
+			hiveext.ClusterSpokeClusterOperatorsHealthyCondition,
+			hiveext.ClusterSpokeNodesReadyCondition,
+			hiveext.ClusterSpokeCertificatesValidCondition,
+		} {
+			condition := FindStatusCondition(updated.Status.Conditions, conditionType)
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(status), string(conditionType))
+			Expect(condition.Reason).To(Equal(reason), string(conditionType))
+		}
+	}
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).
+			WithStatusSubresource(&hiveext.AgentClusterInstall{}).Build()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockClientFactory = spoke_k8s_client.NewMockSpokeK8sClientFactory(mockCtrl)
+		mockMetrics = metrics.NewMockAPI(mockCtrl)
+		sr = &SpokeHealthReconciler{
+			Client:                c,
+			APIReader:             c,
+			Log:                   common.GetTestLog(),
+			SpokeK8sClientFactory: mockClientFactory,
+			Metrics:               mockMetrics,
+			Config: SpokeHealthConfig{
+				Interval:                   interval,
+				CertificateExpiryThreshold: 7 * 24 * time.Hour,
+				CertificateNamespaces:      []string{"openshift-ingress"},
+			},
+		}
+
+		secretName := fmt.Sprintf(adminKubeConfigStringTemplate, clusterDeploymentName)
+		cdSpec := getDefaultClusterDeploymentSpec(clusterDeploymentName, aciName, "pull-secret")
+		cdSpec.ClusterMetadata = &hivev1.ClusterMetadata{AdminKubeconfigSecretRef: corev1.LocalObjectReference{Name: secretName}}
+		cdSpec.Installed = true
+		cd = newClusterDeployment(clusterDeploymentName, testNamespace, cdSpec)
+		Expect(c.Create(ctx, cd)).To(Succeed())
+		Expect(c.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: testNamespace},
+			Data:       map[string][]byte{"kubeconfig": []byte("somekubeconfig")},
+		})).To(Succeed())
+
+		aci = newAgentClusterInstall(aciName, testNamespace, hiveext.AgentClusterInstallSpec{
+			ClusterDeploymentRef: corev1.LocalObjectReference{Name: clusterDeploymentName},
+		}, cd)
+		Expect(c.Create(ctx, aci)).To(Succeed())
+		aciKey = types.NamespacedName{Name: aciName, Namespace: testNamespace}
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("doesn't check a cluster that isn't installed", func() {
+		cd.Spec.Installed = false
+		Expect(c.Update(ctx, cd)).To(Succeed())
+
+		result, err := sr.Reconcile(ctx, ctrl.Request{NamespacedName: aciKey})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+
+		updated := &hiveext.AgentClusterInstall{}
+		Expect(c.Get(ctx, aciKey, updated)).To(Succeed())
+		Expect(FindStatusCondition(updated.Status.Conditions, hiveext.ClusterSpokeNodesReadyCondition)).To(BeNil())
+	})
+
+	It("removes the metrics of a deleted cluster", func() {
+		Expect(c.Delete(ctx, aci)).To(Succeed())
+		mockMetrics.EXPECT().SpokeClusterHealthRemoved(testNamespace, aciName)
+
+		result, err := sr.Reconcile(ctx, ctrl.Request{NamespacedName: aciKey})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+	})
+
+	It("reports a healthy cluster", func() {
+		expiry := time.Now().Add(365 * 24 * time.Hour).Truncate(time.Second)
+		spokeClient := newSpokeClient(
+			newClusterOperator("console", configv1.ConditionTrue, configv1.ConditionFalse),
+			newSpokeNode("master-0", corev1.ConditionTrue),
+			newTLSSecret("router-certs", "openshift-ingress", expiry),
+		)
+		mockClientFactory.EXPECT().CreateFromSecret(gomock.Any(), gomock.Any()).Return(spokeClient, nil)
+		for _, check := range []string{SpokeHealthCheckReachable, SpokeHealthCheckClusterVersion,
+			SpokeHealthCheckClusterOperators, SpokeHealthCheckNodes, SpokeHealthCheckCertificates} {
+			mockMetrics.EXPECT().SpokeClusterHealthChecked(testNamespace, aciName, check, true)
+		}
+		mockMetrics.EXPECT().SpokeClusterCertificateExpiry(testNamespace, aciName, gomock.Any()).Do(
+			func(_, _ string, actual time.Time) {
+				Expect(actual.Equal(expiry)).To(BeTrue())
+			})
+
+		result, err := sr.Reconcile(ctx, ctrl.Request{NamespacedName: aciKey})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{RequeueAfter: interval}))
+		expectConditions(corev1.ConditionTrue, hiveext.ClusterSpokeHealthyReason)
+	})
+
+	It("reports the unhealthy cluster operators, nodes and certificates", func() {
+		spokeClient := newSpokeClient(
+			newClusterOperator("console", configv1.ConditionTrue, configv1.ConditionFalse),
+			newClusterOperator("ingress", configv1.ConditionTrue, configv1.ConditionTrue),
+			newClusterOperator("dns", configv1.ConditionFalse, configv1.ConditionFalse),
+			newSpokeNode("master-0", corev1.ConditionTrue),
+			newSpokeNode("worker-0", corev1.ConditionUnknown),
+			newTLSSecret("router-certs", "openshift-ingress", time.Now().Add(24*time.Hour)),
+			newTLSSecret("ignored", "other", time.Now().Add(time.Hour)),
+		)
+		mockClientFactory.EXPECT().CreateFromSecret(gomock.Any(), gomock.Any()).Return(spokeClient, nil)
+		mockMetrics.EXPECT().SpokeClusterHealthChecked(testNamespace, aciName, SpokeHealthCheckReachable, true)
+		mockMetrics.EXPECT().SpokeClusterHealthChecked(testNamespace, aciName, SpokeHealthCheckClusterVersion, true)
+		mockMetrics.EXPECT().SpokeClusterHealthChecked(testNamespace, aciName, SpokeHealthCheckClusterOperators, false)
+		mockMetrics.EXPECT().SpokeClusterHealthChecked(testNamespace, aciName, SpokeHealthCheckNodes, false)
+		mockMetrics.EXPECT().SpokeClusterHealthChecked(testNamespace, aciName, SpokeHealthCheckCertificates, false)
+		mockMetrics.EXPECT().SpokeClusterCertificateExpiry(testNamespace, aciName, gomock.Any())
+
+		result, err := sr.Reconcile(ctx, ctrl.Request{NamespacedName: aciKey})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{RequeueAfter: interval}))
+
+		updated := &hiveext.AgentClusterInstall{}
+		Expect(c.Get(ctx, aciKey, updated)).To(Succeed())
+		condition := FindStatusCondition(updated.Status.Conditions, hiveext.ClusterSpokeClusterVersionHealthyCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		condition = FindStatusCondition(updated.Status.Conditions, hiveext.ClusterSpokeClusterOperatorsHealthyCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(hiveext.ClusterSpokeDegradedReason))
+		Expect(condition.Message).To(Equal("The following cluster operators are unavailable or degraded: dns, ingress"))
+		condition = FindStatusCondition(updated.Status.Conditions, hiveext.ClusterSpokeNodesReadyCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Message).To(Equal("The following nodes are not ready: worker-0"))
+		condition = FindStatusCondition(updated.Status.Conditions, hiveext.ClusterSpokeCertificatesValidCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(hiveext.ClusterSpokeCertificatesExpiringReason))
+		Expect(condition.Message).To(HavePrefix("The certificate in secret openshift-ingress/router-certs expires at"))
+	})
+
+	It("reports a cluster that can't be reached", func() {
+		mockClientFactory.EXPECT().CreateFromSecret(gomock.Any(), gomock.Any()).Return(nil, errors.New("connection refused"))
+		mockMetrics.EXPECT().SpokeClusterHealthRemoved(testNamespace, aciName)
+		mockMetrics.EXPECT().SpokeClusterHealthChecked(testNamespace, aciName, SpokeHealthCheckReachable, false)
+
+		result, err := sr.Reconcile(ctx, ctrl.Request{NamespacedName: aciKey})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{RequeueAfter: interval}))
+		expectConditions(corev1.ConditionUnknown, hiveext.ClusterSpokeUnreachableReason)
+
+		updated := &hiveext.AgentClusterInstall{}
+		Expect(c.Get(ctx, aciKey, updated)).To(Succeed())
+		condition := FindStatusCondition(updated.Status.Conditions, hiveext.ClusterSpokeNodesReadyCondition)
+		Expect(condition.Message).To(Equal(hiveext.ClusterSpokeUnreachableMsg + " connection refused"))
+	})
+})
@@ -0,0 +1,227 @@
+package dryrun
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/cluster"
+	"github.com/openshift/assisted-service/internal/common"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/host/hostcommands"
+	"github.com/openshift/assisted-service/internal/installercache"
+	"github.com/openshift/assisted-service/internal/manifests"
+	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/provider/registry"
+	"github.com/openshift/assisted-service/internal/usage"
+	"github.com/openshift/assisted-service/pkg/generator"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	ReportFileName = "dry-run-report.json"
+
+	StepInstallConfig = "install-config"
+	StepReleaseImage  = "release-image"
+	StepBootstrap     = "bootstrap"
+	StepManifests     = "manifests"
+	StepIgnition      = "ignition"
+	StepInstallerArgs = "installer-args"
+)
+
+// excludedFiles are generated with credentials that are only valid for the rendered installation, so they are not
+// added to the bundle
+var excludedFiles = map[string]bool{
+	"kubeadmin-password":   true,
+	"kubeconfig-noingress": true,
+}
+
+type StepError struct {
+	Step   string       `json:"step"`
+	HostID *strfmt.UUID `json:"host_id,omitempty"`
+	Error  string       `json:"error"`
+}
+
+type Report struct {
+	ClusterID        strfmt.UUID `json:"cluster_id"`
+	OpenshiftVersion string      `json:"openshift_version"`
+	ReleaseImage     string      `json:"release_image,omitempty"`
+	RenderedAt       time.Time   `json:"rendered_at"`
+	Files            []string    `json:"files"`
+	Errors           []StepError `json:"errors"`
+}
+
+// Input holds the state the installation of the cluster is rendered from
+type Input struct {
+	// Cluster is loaded with its hosts, and may be modified by the rendering
+	Cluster   *common.Cluster
+	InfraEnvs []*common.InfraEnv
+	// InstallConfig is not set if it could not be generated
+	InstallConfig []byte
+	// ReleaseImage is not set if it could not be resolved
+	ReleaseImage                  string
+	InstallerReleaseImageOverride string
+	ForceInsecurePolicyJson       bool
+	// Errors are the errors of the steps that ran before the rendering
+	Errors []StepError
+}
+
+//go:generate mockgen --build_flags=--mod=mod -package=dryrun -destination=mock_renderer.go . Renderer
+type Renderer interface {
+	// Render generates the installation files of the cluster without storing them, and returns them as a tar.gz
+	// bundle. Errors of a generation step are added to the report of the bundle and do not stop the next steps.
+	Render(ctx context.Context, input *Input) ([]byte, error)
+}
+
+type renderer struct {
+	log                      logrus.FieldLogger
+	db                       *gorm.DB
+	objectHandler            s3wrapper.API
+	usageAPI                 usage.API
+	operatorsOptions         operators.Options
+	manifestsGeneratorConfig network.Config
+	generatorConfig          generator.Config
+	providerRegistry         registry.ProviderRegistry
+	eventsHandler            eventsapi.Handler
+	installerCache           *installercache.Installers
+}
+
+func NewRenderer(log logrus.FieldLogger, db *gorm.DB, objectHandler s3wrapper.API, usageAPI usage.API, operatorsOptions operators.Options,
+	manifestsGeneratorConfig network.Config, generatorConfig generator.Config, providerRegistry registry.ProviderRegistry,
+	eventsHandler eventsapi.Handler, installerCache *installercache.Installers) Renderer {
+	return &renderer{
+		log:                      log,
+		db:                       db,
+		objectHandler:            objectHandler,
+		usageAPI:                 usageAPI,
+		operatorsOptions:         operatorsOptions,
+		manifestsGeneratorConfig: manifestsGeneratorConfig,
+		generatorConfig:          generatorConfig,
+		providerRegistry:         providerRegistry,
+		eventsHandler:            eventsHandler,
+		installerCache:           installerCache,
+	}
+}
+
+func (r *renderer) Render(ctx context.Context, input *Input) ([]byte, error) {
+	log := logutil.FromContext(ctx, r.log)
+	c := input.Cluster
+	report := &Report{
+		ClusterID:        *c.ID,
+		OpenshiftVersion: c.OpenshiftVersion,
+		ReleaseImage:     input.ReleaseImage,
+		RenderedAt:       time.Now().UTC(),
+		Files:            []string{},
+		Errors:           append([]StepError{}, input.Errors...),
+	}
+	addError := func(step string, hostID *strfmt.UUID, err error) {
+		log.WithError(err).Infof("dry run of cluster %s failed at step %s", c.ID, step)
+		report.Errors = append(report.Errors, StepError{Step: step, HostID: hostID, Error: err.Error()})
+	}
+
+	// The generators write to an overlay, so the files of the cluster in the object store are never modified
+	objects := s3wrapper.NewOverlay(r.objectHandler)
+	manifestsAPI := manifests.NewManifestsAPI(r.db, log, objects, r.usageAPI)
+	operatorsAPI := operators.NewManager(log, manifestsAPI, r.operatorsOptions, objects)
+	manifestsGenerator := network.NewManifestsGenerator(manifestsAPI, r.manifestsGeneratorConfig, r.db)
+
+	if err := cluster.GenerateAdditionalManifests(ctx, log, c, manifestsGenerator, operatorsAPI); err != nil {
+		addError(StepManifests, nil, err)
+	}
+
+	if input.InstallConfig != nil && input.ReleaseImage != "" {
+		installGenerator := generator.New(log, objects, r.generatorConfig, r.providerRegistry, manifestsAPI, r.eventsHandler, r.installerCache)
+		if err := installGenerator.GenerateInstallConfig(ctx, *c, input.InstallConfig, input.ReleaseImage,
+			input.InstallerReleaseImageOverride, input.ForceInsecurePolicyJson); err != nil {
+			addError(StepIgnition, nil, err)
+		}
+	}
+
+	files := make(map[string][]byte)
+	if input.InstallConfig != nil {
+		files["install-config.yaml"] = input.InstallConfig
+	}
+	prefix := c.ID.String() + "/"
+	for name, data := range objects.Written() {
+		fileName := strings.TrimPrefix(name, prefix)
+		if fileName == name || excludedFiles[fileName] {
+			continue
+		}
+		files[fileName] = data
+	}
+
+	infraEnvs := make(map[strfmt.UUID]*common.InfraEnv, len(input.InfraEnvs))
+	for _, infraEnv := range input.InfraEnvs {
+		infraEnvs[*infraEnv.ID] = infraEnv
+	}
+	for _, h := range c.Hosts {
+		installerArgs, err := hostcommands.HostInstallerArgs(c, h, infraEnvs[h.InfraEnvID], log)
+		if err != nil {
+			addError(StepInstallerArgs, h.ID, err)
+			continue
+		}
+		files[fmt.Sprintf("hosts/%s/installer-args.json", h.ID)] = []byte(installerArgs)
+	}
+
+	return bundle(c, files, report)
+}
+
+func bundle(c *common.Cluster, files map[string][]byte, report *Report) ([]byte, error) {
+	fileNames := make([]string, 0, len(files))
+	for fileName := range files {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+	report.Files = append(report.Files, fileNames...)
+
+	reportJson, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode the dry run report")
+	}
+
+	buffer := &bytes.Buffer{}
+	gz := gzip.NewWriter(buffer)
+	tw := tar.NewWriter(gz)
+	if err = addFile(tw, reportJson, fmt.Sprintf("%s/%s", c.ID, ReportFileName)); err != nil {
+		return nil, err
+	}
+	for _, fileName := range fileNames {
+		if err = addFile(tw, files[fileName], fmt.Sprintf("%s/%s", c.ID, fileName)); err != nil {
+			return nil, err
+		}
+	}
+	if err = tw.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed closing tar file")
+	}
+	if err = gz.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed closing gzip file")
+	}
+	return buffer.Bytes(), nil
+}
+
+func addFile(tw *tar.Writer, contents []byte, fileName string) error {
+	hdr := &tar.Header{
+		Name: fileName,
+		Mode: 0644,
+		Size: int64(len(contents)),
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return errors.Wrapf(err, "failed writing file header for %s", fileName)
+	}
+	if _, err := tw.Write(contents); err != nil {
+		return errors.Wrapf(err, "failed writing contents to file %s", fileName)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package dryrun
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/provider/registry"
+	"github.com/openshift/assisted-service/internal/usage"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/generator"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"gorm.io/gorm"
+)
+
+func extractBundle(data []byte) map[string][]byte {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	Expect(err).ToNot(HaveOccurred())
+	tr := tar.NewReader(gz)
+	files := make(map[string][]byte)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		Expect(err).ToNot(HaveOccurred())
+		contents, err := io.ReadAll(tr)
+		Expect(err).ToNot(HaveOccurred())
+		files[hdr.Name] = contents
+	}
+	return files
+}
+
+var _ = Describe("Render", func() {
+	var (
+		ctx          = context.Background()
+		ctrl         *gomock.Controller
+		db           *gorm.DB
+		dbName       string
+		workDir      string
+		mockS3Client *s3wrapper.MockAPI
+		r            Renderer
+		c            *common.Cluster
+		infraEnv     *common.InfraEnv
+		masterID     strfmt.UUID
+		invalidID    strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		var err error
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		workDir, err = os.MkdirTemp("", "dryrun-test-")
+		Expect(err).ToNot(HaveOccurred())
+
+		// Only reads are expected, the generated files must not be written to the object store
+		mockS3Client = s3wrapper.NewMockAPI(ctrl)
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+		mockS3Client.EXPECT().Download(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, objectName string) (io.ReadCloser, int64, error) {
+			return nil, 0, common.NotFound(objectName)
+		}).AnyTimes()
+		mockS3Client.EXPECT().ListObjectsByPrefix(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		mockS3Client.EXPECT().ListObjectsByPrefixWithMetadata(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+		r = NewRenderer(common.GetTestLog(), db, mockS3Client, usage.NewMockAPI(ctrl), operators.Options{}, network.Config{},
+			generator.Config{WorkDir: workDir, DummyIgnition: true}, registry.NewMockProviderRegistry(ctrl),
+			eventsapi.NewMockHandler(ctrl), nil)
+
+		clusterID := strfmt.UUID(uuid.New().String())
+		infraEnvID := strfmt.UUID(uuid.New().String())
+		masterID = strfmt.UUID(uuid.New().String())
+		invalidID = strfmt.UUID(uuid.New().String())
+		c = &common.Cluster{Cluster: models.Cluster{
+			ID:                &clusterID,
+			Status:            swag.String(models.ClusterStatusReady),
+			OpenshiftVersion:  common.TestDefaultConfig.OpenShiftVersion,
+			CPUArchitecture:   common.DefaultCPUArchitecture,
+			ControlPlaneCount: 1,
+			Hosts: []*models.Host{
+				{
+					ID:         &masterID,
+					InfraEnvID: infraEnvID,
+					ClusterID:  &clusterID,
+					Status:     swag.String(models.HostStatusKnown),
+					Role:       models.HostRoleMaster,
+					Bootstrap:  true,
+					Inventory:  common.GenerateTestDefaultInventory(),
+				},
+				{
+					ID:         &invalidID,
+					InfraEnvID: infraEnvID,
+					ClusterID:  &clusterID,
+					Status:     swag.String(models.HostStatusKnown),
+					Role:       models.HostRoleWorker,
+					Inventory:  "not an inventory",
+				},
+			},
+		}}
+		Expect(db.Create(c).Error).ToNot(HaveOccurred())
+		infraEnv = &common.InfraEnv{InfraEnv: models.InfraEnv{ID: &infraEnvID, ClusterID: clusterID}}
+		Expect(db.Create(infraEnv).Error).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+		Expect(os.RemoveAll(workDir)).To(Succeed())
+	})
+
+	render := func(input *Input) (map[string][]byte, *Report) {
+		data, err := r.Render(ctx, input)
+		Expect(err).ToNot(HaveOccurred())
+		files := extractBundle(data)
+		prefix := c.ID.String() + "/"
+		ret := make(map[string][]byte)
+		for name, contents := range files {
+			Expect(strings.HasPrefix(name, prefix)).To(BeTrue())
+			ret[strings.TrimPrefix(name, prefix)] = contents
+		}
+		Expect(ret).To(HaveKey(ReportFileName))
+		var report Report
+		Expect(json.Unmarshal(ret[ReportFileName], &report)).To(Succeed())
+		return ret, &report
+	}
+
+	It("bundles the generated files and the errors", func() {
+		files, report := render(&Input{
+			Cluster:       c,
+			InfraEnvs:     []*common.InfraEnv{infraEnv},
+			InstallConfig: []byte("install-config"),
+			ReleaseImage:  *common.TestDefaultConfig.ReleaseImage.URL,
+			Errors:        []StepError{{Step: StepBootstrap, Error: "no bootstrap"}},
+		})
+
+		Expect(files["install-config.yaml"]).To(Equal([]byte("install-config")))
+		Expect(files).To(HaveKey("bootstrap.ign"))
+		Expect(files).To(HaveKey("master.ign"))
+		Expect(files).ToNot(HaveKey("kubeadmin-password"))
+		Expect(files).ToNot(HaveKey("kubeconfig-noingress"))
+		Expect(files).To(HaveKey("hosts/" + masterID.String() + "/installer-args.json"))
+		Expect(files).ToNot(HaveKey("hosts/" + invalidID.String() + "/installer-args.json"))
+
+		Expect(report.ClusterID).To(Equal(*c.ID))
+		Expect(report.ReleaseImage).To(Equal(*common.TestDefaultConfig.ReleaseImage.URL))
+		Expect(report.Files).To(ContainElements("install-config.yaml", "bootstrap.ign"))
+		Expect(report.Errors).To(ContainElement(StepError{Step: StepBootstrap, Error: "no bootstrap"}))
+		Expect(report.Errors).To(ContainElement(WithTransform(func(e StepError) bool {
+			return e.Step == StepInstallerArgs && e.HostID != nil && *e.HostID == invalidID
+		}, BeTrue())))
+	})
+
+	It("skips the ignitions when the install config could not be generated", func() {
+		files, report := render(&Input{
+			Cluster:      c,
+			InfraEnvs:    []*common.InfraEnv{infraEnv},
+			ReleaseImage: *common.TestDefaultConfig.ReleaseImage.URL,
+			Errors:       []StepError{{Step: StepInstallConfig, Error: "invalid"}},
+		})
+
+		Expect(files).ToNot(HaveKey("install-config.yaml"))
+		Expect(files).ToNot(HaveKey("bootstrap.ign"))
+		Expect(report.Errors).To(ContainElement(StepError{Step: StepInstallConfig, Error: "invalid"}))
+	})
+})
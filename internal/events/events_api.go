@@ -10,7 +10,9 @@ import (
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/stream"
 	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/openshift/assisted-service/restapi"
 	"github.com/openshift/assisted-service/restapi/operations/events"
@@ -22,14 +24,21 @@ import (
 var _ restapi.EventsAPI = &Api{}
 
 type Api struct {
-	handler eventsapi.Handler
-	log     logrus.FieldLogger
+	handler           eventsapi.Handler
+	watcher           stream.Watcher
+	authz             auth.Authorizer
+	heartbeatInterval time.Duration
+	log               logrus.FieldLogger
 }
 
-func NewApi(handler eventsapi.Handler, log logrus.FieldLogger) *Api {
+// NewApi creates the events API. The watch API is disabled if watcher is nil.
+func NewApi(handler eventsapi.Handler, watcher stream.Watcher, authz auth.Authorizer, heartbeatInterval time.Duration, log logrus.FieldLogger) *Api {
 	return &Api{
-		handler: handler,
-		log:     log,
+		handler:           handler,
+		watcher:           watcher,
+		authz:             authz,
+		heartbeatInterval: heartbeatInterval,
+		log:               log,
 	}
 }
 
@@ -0,0 +1,143 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi/operations/events"
+	"github.com/pkg/errors"
+)
+
+func (a *Api) V2Watch(ctx context.Context, params events.V2WatchParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+	if a.watcher == nil {
+		return jsonResponder(common.NewApiError(http.StatusNotImplemented, errors.New("the watch API is not enabled")))
+	}
+	if err := a.authorizeWatch(ctx, params.ClusterID, params.InfraEnvID); err != nil {
+		return jsonResponder(err)
+	}
+	since, err := watchOffset(params)
+	if err != nil {
+		return jsonResponder(common.NewApiError(http.StatusBadRequest, err))
+	}
+	filter := stream.WatchFilter{
+		ClusterID:  params.ClusterID,
+		InfraEnvID: params.InfraEnvID,
+		Types:      params.NotificationTypes,
+	}
+	subscription, err := a.watcher.Subscribe(ctx, filter, since)
+	if err != nil {
+		log.WithError(err).Error("failed to subscribe to notifications")
+		return jsonResponder(common.NewApiError(http.StatusInternalServerError, err))
+	}
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		defer subscription.Close()
+		a.serveWatch(params.HTTPRequest.Context(), rw, subscription)
+	})
+}
+
+// authorizeWatch verifies that the user may read the watched resources. Watching all resources is only
+// allowed for admins, in the same way as listing all events.
+func (a *Api) authorizeWatch(ctx context.Context, clusterID, infraEnvID *strfmt.UUID) error {
+	if clusterID == nil && infraEnvID == nil {
+		if a.authz.IsAdmin(ctx) {
+			return nil
+		}
+		return common.NewApiError(http.StatusBadRequest, errors.New("either cluster_id or infra_env_id must be specified"))
+	}
+	if clusterID != nil {
+		if err := a.checkReadAccess(ctx, &common.Cluster{Cluster: models.Cluster{ID: clusterID}}); err != nil {
+			return err
+		}
+	}
+	if infraEnvID != nil {
+		if err := a.checkReadAccess(ctx, &common.InfraEnv{InfraEnv: models.InfraEnv{ID: infraEnvID}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *Api) checkReadAccess(ctx context.Context, obj interface{}) error {
+	canRead, err := a.authz.HasAccessTo(ctx, obj, auth.ReadAction)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if !canRead {
+		return common.NewApiError(http.StatusNotFound, errors.New("Object Not Found"))
+	}
+	return nil
+}
+
+func watchOffset(params events.V2WatchParams) (*int64, error) {
+	if params.Since != nil {
+		return params.Since, nil
+	}
+	if params.LastEventID == nil || *params.LastEventID == "" {
+		return nil, nil
+	}
+	offset, err := strconv.ParseInt(*params.LastEventID, 10, 64)
+	if err != nil || offset < 0 {
+		return nil, errors.Errorf("invalid Last-Event-ID %q", *params.LastEventID)
+	}
+	return &offset, nil
+}
+
+// serveWatch writes the subscription messages as server-sent events until either the client goes away or
+// the subscription ends, in which case the client is expected to reconnect with the last offset it received
+func (a *Api) serveWatch(ctx context.Context, rw http.ResponseWriter, subscription stream.WatchSubscription) {
+	controller := http.NewResponseController(rw)
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.Header().Set("X-Accel-Buffering", "no")
+	rw.WriteHeader(http.StatusOK)
+	if err := controller.Flush(); err != nil {
+		a.log.WithError(err).Error("watch response does not support flushing")
+		return
+	}
+
+	heartbeat := time.NewTicker(a.heartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		var err error
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-subscription.Messages():
+			if !ok {
+				return
+			}
+			_, err = fmt.Fprintf(rw, "id: %d\nevent: %s\ndata: %s\n\n", msg.Offset, msg.Type, msg.Data)
+		case <-heartbeat.C:
+			_, err = fmt.Fprint(rw, ": keep-alive\n\n")
+		}
+		if err == nil {
+			err = controller.Flush()
+		}
+		if err != nil {
+			a.log.WithError(err).Debug("failed to write watch notification, closing the stream")
+			return
+		}
+	}
+}
+
+// jsonResponder writes the error as JSON regardless of the negotiated content type, which is
+// text/event-stream for the watch API
+func jsonResponder(err error) middleware.Responder {
+	responder := common.GenerateErrorResponder(err)
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		rw.Header().Set("Content-Type", runtime.JSONMime)
+		responder.WriteResponse(rw, runtime.JSONProducer())
+	})
+}
@@ -0,0 +1,142 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	"github.com/openshift/assisted-service/restapi/operations/events"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Watch", func() {
+	var (
+		ctrl         *gomock.Controller
+		db           *gorm.DB
+		dbName       string
+		api          *Api
+		watcher      *stream.MockWatcher
+		subscription *stream.MockWatchSubscription
+		messages     chan *stream.WatchMessage
+		ctx          context.Context
+		clusterID    strfmt.UUID
+		otherCluster strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		watcher = stream.NewMockWatcher(ctrl)
+		subscription = stream.NewMockWatchSubscription(ctrl)
+		messages = make(chan *stream.WatchMessage, 10)
+		subscription.EXPECT().Messages().Return(messages).AnyTimes()
+		cfg := &auth.Config{AuthType: auth.TypeRHSSO, EnableOrgTenancy: true}
+		authzHandler := auth.NewAuthzHandler(cfg, nil, logrus.New(), db)
+		api = NewApi(nil, watcher, authzHandler, time.Minute, logrus.WithField("pkg", "events"))
+
+		clusterID = strfmt.UUID(uuid.New().String())
+		otherCluster = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, UserName: "user1", OrgID: "org1"}}).Error).ShouldNot(HaveOccurred())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &otherCluster, UserName: "user2", OrgID: "org2"}}).Error).ShouldNot(HaveOccurred())
+
+		payload := &ocm.AuthPayload{}
+		payload.Role = ocm.UserRole
+		payload.Username = "user1"
+		payload.Organization = "org1"
+		ctx = context.WithValue(context.TODO(), restapi.AuthKey, payload)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	serve := func(params events.V2WatchParams) *httptest.ResponseRecorder {
+		if params.HTTPRequest == nil {
+			params.HTTPRequest = httptest.NewRequest(http.MethodGet, "/v2/watch", nil)
+		}
+		recorder := httptest.NewRecorder()
+		api.V2Watch(ctx, params).WriteResponse(recorder, runtime.TextProducer())
+		return recorder
+	}
+
+	expectError := func(recorder *httptest.ResponseRecorder, code int) {
+		Expect(recorder.Code).To(Equal(code))
+		Expect(recorder.Header().Get("Content-Type")).To(Equal(runtime.JSONMime))
+		var apiErr models.Error
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &apiErr)).To(Succeed())
+		Expect(swag.StringValue(apiErr.Code)).To(Equal(strconv.Itoa(code)))
+	}
+
+	It("is not implemented when the watch API is disabled", func() {
+		api.watcher = nil
+		expectError(serve(events.V2WatchParams{ClusterID: &clusterID}), http.StatusNotImplemented)
+	})
+
+	It("requires a filter for non-admin users", func() {
+		expectError(serve(events.V2WatchParams{}), http.StatusBadRequest)
+	})
+
+	It("does not stream notifications of clusters owned by another org", func() {
+		expectError(serve(events.V2WatchParams{ClusterID: &otherCluster}), http.StatusNotFound)
+	})
+
+	It("rejects an invalid Last-Event-ID", func() {
+		expectError(serve(events.V2WatchParams{ClusterID: &clusterID, LastEventID: swag.String("abc")}), http.StatusBadRequest)
+	})
+
+	It("streams notifications as server-sent events", func() {
+		watcher.EXPECT().Subscribe(gomock.Any(), stream.WatchFilter{
+			ClusterID: &clusterID,
+			Types:     []string{common.NotificationTypeHost},
+		}, nil).Return(subscription, nil)
+		subscription.EXPECT().Close()
+		messages <- &stream.WatchMessage{Offset: 7, Type: common.NotificationTypeHost, Data: []byte(`{"Name":"HostState"}`)}
+		close(messages)
+
+		recorder := serve(events.V2WatchParams{ClusterID: &clusterID, NotificationTypes: []string{common.NotificationTypeHost}})
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Header().Get("Content-Type")).To(Equal("text/event-stream"))
+		Expect(recorder.Body.String()).To(Equal("id: 7\nevent: HostState\ndata: {\"Name\":\"HostState\"}\n\n"))
+	})
+
+	It("resumes from Last-Event-ID", func() {
+		watcher.EXPECT().Subscribe(gomock.Any(), stream.WatchFilter{ClusterID: &clusterID}, swag.Int64(42)).Return(subscription, nil)
+		subscription.EXPECT().Close()
+		close(messages)
+		Expect(serve(events.V2WatchParams{ClusterID: &clusterID, LastEventID: swag.String("42")}).Code).To(Equal(http.StatusOK))
+	})
+
+	It("prefers since over Last-Event-ID", func() {
+		watcher.EXPECT().Subscribe(gomock.Any(), stream.WatchFilter{ClusterID: &clusterID}, swag.Int64(3)).Return(subscription, nil)
+		subscription.EXPECT().Close()
+		close(messages)
+		Expect(serve(events.V2WatchParams{ClusterID: &clusterID, Since: swag.Int64(3), LastEventID: swag.String("42")}).Code).To(Equal(http.StatusOK))
+	})
+
+	It("stops streaming when the client goes away", func() {
+		watcher.EXPECT().Subscribe(gomock.Any(), gomock.Any(), nil).Return(subscription, nil)
+		subscription.EXPECT().Close()
+		requestCtx, cancel := context.WithCancel(context.Background())
+		cancel()
+		request := httptest.NewRequest(http.MethodGet, "/v2/watch", nil).WithContext(requestCtx)
+		Expect(serve(events.V2WatchParams{ClusterID: &clusterID, HTTPRequest: request}).Code).To(Equal(http.StatusOK))
+	})
+})
@@ -0,0 +1,157 @@
+package hardware
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+)
+
+// ATA SMART attributes that the health of the disk is taken from
+const (
+	ataReallocatedSectorCount  = 5
+	ataWearLevelingCount       = 177
+	ataReportedUncorrectable   = 187
+	ataOfflineUncorrectable    = 198
+	ataSSDLifeLeft             = 231
+	ataMediaWearoutIndicator   = 233
+	maxAtaNormalizedPercentage = 100
+)
+
+// smartctlOutput is the part of the JSON output of smartctl that the health of the disk is taken from. ATA, NVMe and SCSI
+// disks report their health in different sections
+type smartctlOutput struct {
+	SmartStatus *struct {
+		Passed *bool `json:"passed"`
+	} `json:"smart_status"`
+	Temperature *struct {
+		Current *int64 `json:"current"`
+	} `json:"temperature"`
+	AtaSmartAttributes *struct {
+		Table []smartctlAtaAttribute `json:"table"`
+	} `json:"ata_smart_attributes"`
+	NvmeSmartHealthInformationLog *struct {
+		PercentageUsed *int64 `json:"percentage_used"`
+		MediaErrors    *int64 `json:"media_errors"`
+	} `json:"nvme_smart_health_information_log"`
+	ScsiGrownDefectList                  *int64 `json:"scsi_grown_defect_list"`
+	ScsiPercentageUsedEnduranceIndicator *int64 `json:"scsi_percentage_used_endurance_indicator"`
+	ScsiErrorCounterLog                  *struct {
+		Read  *smartctlScsiErrorCounter `json:"read"`
+		Write *smartctlScsiErrorCounter `json:"write"`
+	} `json:"scsi_error_counter_log"`
+}
+
+type smartctlAtaAttribute struct {
+	ID    int64 `json:"id"`
+	Value int64 `json:"value"`
+	Raw   struct {
+		Value int64 `json:"value"`
+	} `json:"raw"`
+}
+
+type smartctlScsiErrorCounter struct {
+	TotalUncorrectedErrors int64 `json:"total_uncorrected_errors"`
+}
+
+// ParseDiskHealth returns the health of a disk from the JSON output of smartctl that the agent reports in the smart
+// property of the disk. It returns nil if the agent didn't report SMART data for the disk
+func ParseDiskHealth(smart string) (*models.DiskHealth, error) {
+	if smart == "" {
+		return nil, nil
+	}
+	var output smartctlOutput
+	if err := json.Unmarshal([]byte(smart), &output); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the SMART data of the disk")
+	}
+	ret := &models.DiskHealth{}
+	if output.SmartStatus != nil {
+		ret.SmartPassed = output.SmartStatus.Passed
+	}
+	if output.Temperature != nil {
+		ret.TemperatureCelsius = output.Temperature.Current
+	}
+	if output.AtaSmartAttributes != nil {
+		attributes := make(map[int64]smartctlAtaAttribute)
+		for _, attribute := range output.AtaSmartAttributes.Table {
+			attributes[attribute.ID] = attribute
+		}
+		if attribute, ok := attributes[ataReallocatedSectorCount]; ok {
+			ret.ReallocatedSectors = ptr.To(attribute.Raw.Value)
+		}
+		for _, id := range []int64{ataReportedUncorrectable, ataOfflineUncorrectable} {
+			if attribute, ok := attributes[id]; ok {
+				ret.MediaErrors = ptr.To(attribute.Raw.Value)
+				break
+			}
+		}
+		// The normalized values of the wear attributes are the percentage of the endurance that is left
+		for _, id := range []int64{ataMediaWearoutIndicator, ataWearLevelingCount, ataSSDLifeLeft} {
+			if attribute, ok := attributes[id]; ok && attribute.Value <= maxAtaNormalizedPercentage {
+				ret.WearLevelPercentage = ptr.To(maxAtaNormalizedPercentage - attribute.Value)
+				break
+			}
+		}
+	}
+	if output.NvmeSmartHealthInformationLog != nil {
+		ret.WearLevelPercentage = output.NvmeSmartHealthInformationLog.PercentageUsed
+		ret.MediaErrors = output.NvmeSmartHealthInformationLog.MediaErrors
+	}
+	if output.ScsiGrownDefectList != nil {
+		ret.ReallocatedSectors = output.ScsiGrownDefectList
+	}
+	if output.ScsiPercentageUsedEnduranceIndicator != nil {
+		ret.WearLevelPercentage = output.ScsiPercentageUsedEnduranceIndicator
+	}
+	if output.ScsiErrorCounterLog != nil {
+		var mediaErrors int64
+		for _, counter := range []*smartctlScsiErrorCounter{output.ScsiErrorCounterLog.Read, output.ScsiErrorCounterLog.Write} {
+			if counter != nil {
+				mediaErrors += counter.TotalUncorrectedErrors
+			}
+		}
+		ret.MediaErrors = ptr.To(mediaErrors)
+	}
+	return ret, nil
+}
+
+// DiskHealthIssues returns the reasons that the disk is failing and the reasons that it is degraded, according to the
+// disk health thresholds of the configuration. Failing disks shouldn't be used, and degraded disks can be used but
+// should be looked at
+func DiskHealthIssues(health *models.DiskHealth, cfg *ValidatorCfg) (failures []string, warnings []string) {
+	if health == nil {
+		return nil, nil
+	}
+	if health.SmartPassed != nil && !*health.SmartPassed {
+		failures = append(failures, "the SMART self-assessment failed")
+	}
+	if health.ReallocatedSectors != nil && *health.ReallocatedSectors > cfg.DiskHealthMaxReallocatedSectors {
+		failures = append(failures, fmt.Sprintf("%d reallocated sectors, above the maximum of %d",
+			*health.ReallocatedSectors, cfg.DiskHealthMaxReallocatedSectors))
+	}
+	if health.MediaErrors != nil && *health.MediaErrors > cfg.DiskHealthMaxMediaErrors {
+		failures = append(failures, fmt.Sprintf("%d media errors, above the maximum of %d",
+			*health.MediaErrors, cfg.DiskHealthMaxMediaErrors))
+	}
+	if health.WearLevelPercentage != nil {
+		if *health.WearLevelPercentage > cfg.DiskHealthMaxWearLevelPercentage {
+			failures = append(failures, fmt.Sprintf("%d%% of its endurance used, above the maximum of %d%%",
+				*health.WearLevelPercentage, cfg.DiskHealthMaxWearLevelPercentage))
+		} else if *health.WearLevelPercentage > cfg.DiskHealthWearLevelWarningPercentage {
+			warnings = append(warnings, fmt.Sprintf("%d%% of its endurance used, above the warning threshold of %d%%",
+				*health.WearLevelPercentage, cfg.DiskHealthWearLevelWarningPercentage))
+		}
+	}
+	if health.TemperatureCelsius != nil {
+		if *health.TemperatureCelsius > cfg.DiskHealthMaxTemperatureCelsius {
+			failures = append(failures, fmt.Sprintf("a temperature of %d°C, above the maximum of %d°C",
+				*health.TemperatureCelsius, cfg.DiskHealthMaxTemperatureCelsius))
+		} else if *health.TemperatureCelsius > cfg.DiskHealthTemperatureWarningCelsius {
+			warnings = append(warnings, fmt.Sprintf("a temperature of %d°C, above the warning threshold of %d°C",
+				*health.TemperatureCelsius, cfg.DiskHealthTemperatureWarningCelsius))
+		}
+	}
+	return failures, warnings
+}
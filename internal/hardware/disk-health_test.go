@@ -0,0 +1,129 @@
+package hardware
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("Disk health", func() {
+	cfg := &ValidatorCfg{
+		DiskHealthMaxReallocatedSectors:      10,
+		DiskHealthMaxMediaErrors:             0,
+		DiskHealthWearLevelWarningPercentage: 80,
+		DiskHealthMaxWearLevelPercentage:     100,
+		DiskHealthTemperatureWarningCelsius:  60,
+		DiskHealthMaxTemperatureCelsius:      70,
+	}
+
+	Context("ParseDiskHealth", func() {
+		It("returns nil without SMART data", func() {
+			Expect(ParseDiskHealth("")).To(BeNil())
+		})
+
+		It("parses the health of an ATA disk", func() {
+			health, err := ParseDiskHealth(`{
+				"smart_status": {"passed": true},
+				"temperature": {"current": 38},
+				"ata_smart_attributes": {"table": [
+					{"id": 5, "name": "Reallocated_Sector_Ct", "value": 100, "raw": {"value": 3}},
+					{"id": 187, "name": "Reported_Uncorrect", "value": 100, "raw": {"value": 1}},
+					{"id": 233, "name": "Media_Wearout_Indicator", "value": 85, "raw": {"value": 0}}
+				]}
+			}`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(health).To(Equal(&models.DiskHealth{
+				SmartPassed:         ptr.To(true),
+				TemperatureCelsius:  ptr.To(int64(38)),
+				ReallocatedSectors:  ptr.To(int64(3)),
+				MediaErrors:         ptr.To(int64(1)),
+				WearLevelPercentage: ptr.To(int64(15)),
+			}))
+		})
+
+		It("parses the health of an NVMe disk", func() {
+			health, err := ParseDiskHealth(`{
+				"smart_status": {"passed": false, "nvme": {"value": 4}},
+				"temperature": {"current": 45},
+				"nvme_smart_health_information_log": {"critical_warning": 4, "temperature": 45, "percentage_used": 104, "media_errors": 2}
+			}`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(health).To(Equal(&models.DiskHealth{
+				SmartPassed:         ptr.To(false),
+				TemperatureCelsius:  ptr.To(int64(45)),
+				MediaErrors:         ptr.To(int64(2)),
+				WearLevelPercentage: ptr.To(int64(104)),
+			}))
+		})
+
+		It("parses the health of a SCSI disk", func() {
+			health, err := ParseDiskHealth(`{
+				"smart_status": {"passed": true},
+				"scsi_grown_defect_list": 12,
+				"scsi_error_counter_log": {"read": {"total_uncorrected_errors": 1}, "write": {"total_uncorrected_errors": 2}}
+			}`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(health).To(Equal(&models.DiskHealth{
+				SmartPassed:        ptr.To(true),
+				ReallocatedSectors: ptr.To(int64(12)),
+				MediaErrors:        ptr.To(int64(3)),
+			}))
+		})
+
+		It("fails with invalid SMART data", func() {
+			_, err := ParseDiskHealth("not json")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("DiskHealthIssues", func() {
+		It("returns no issues for a healthy disk", func() {
+			failures, warnings := DiskHealthIssues(&models.DiskHealth{
+				SmartPassed:         ptr.To(true),
+				ReallocatedSectors:  ptr.To(int64(10)),
+				MediaErrors:         ptr.To(int64(0)),
+				WearLevelPercentage: ptr.To(int64(80)),
+				TemperatureCelsius:  ptr.To(int64(60)),
+			}, cfg)
+			Expect(failures).To(BeEmpty())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("returns no issues for a disk without SMART data", func() {
+			failures, warnings := DiskHealthIssues(nil, cfg)
+			Expect(failures).To(BeEmpty())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("warns about a worn and hot disk", func() {
+			failures, warnings := DiskHealthIssues(&models.DiskHealth{
+				WearLevelPercentage: ptr.To(int64(85)),
+				TemperatureCelsius:  ptr.To(int64(65)),
+			}, cfg)
+			Expect(failures).To(BeEmpty())
+			Expect(warnings).To(Equal([]string{
+				"85% of its endurance used, above the warning threshold of 80%",
+				"a temperature of 65°C, above the warning threshold of 60°C",
+			}))
+		})
+
+		It("fails a degraded disk", func() {
+			failures, warnings := DiskHealthIssues(&models.DiskHealth{
+				SmartPassed:         ptr.To(false),
+				ReallocatedSectors:  ptr.To(int64(11)),
+				MediaErrors:         ptr.To(int64(1)),
+				WearLevelPercentage: ptr.To(int64(101)),
+				TemperatureCelsius:  ptr.To(int64(71)),
+			}, cfg)
+			Expect(warnings).To(BeEmpty())
+			Expect(failures).To(Equal([]string{
+				"the SMART self-assessment failed",
+				"11 reallocated sectors, above the maximum of 10",
+				"1 media errors, above the maximum of 0",
+				"101% of its endurance used, above the maximum of 100%",
+				"a temperature of 71°C, above the maximum of 70°C",
+			}))
+		})
+	})
+})
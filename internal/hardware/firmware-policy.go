@@ -0,0 +1,211 @@
+package hardware
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/hardware/virt"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+const (
+	FirmwareBootModeUEFI = "uefi"
+	FirmwareBootModeBIOS = "bios"
+)
+
+var versionComponentRegex = regexp.MustCompile(`\d+`)
+
+// ParseFirmwarePolicy parses the JSON formatted firmware policy of a cluster or an infra-env. It returns nil if there
+// is no policy
+func ParseFirmwarePolicy(policy *string) (*models.FirmwarePolicy, error) {
+	if policy == nil || *policy == "" {
+		return nil, nil
+	}
+	var ret models.FirmwarePolicy
+	if err := json.Unmarshal([]byte(*policy), &ret); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the firmware policy")
+	}
+	return &ret, nil
+}
+
+// FormatFirmwarePolicy validates the firmware policy and formats it as JSON. It returns nil for empty policies, which
+// don't require anything from the hosts
+func FormatFirmwarePolicy(policy *models.FirmwarePolicy) (*string, error) {
+	if policy == nil || isEmptyFirmwarePolicy(policy) {
+		return nil, nil
+	}
+	if err := validateFirmwarePolicy(policy); err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(policy)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to format the firmware policy as json")
+	}
+	ret := string(b)
+	return &ret, nil
+}
+
+func isEmptyFirmwarePolicy(policy *models.FirmwarePolicy) bool {
+	return policy.BootMode == "" && !policy.SecureBootRequired && !policy.VirtualizationRequired && len(policy.MinBiosVersions) == 0
+}
+
+func validateFirmwarePolicy(policy *models.FirmwarePolicy) error {
+	if policy.BootMode != "" && policy.BootMode != FirmwareBootModeUEFI && policy.BootMode != FirmwareBootModeBIOS {
+		return errors.Errorf("boot mode %s is not supported, must be %s or %s", policy.BootMode, FirmwareBootModeUEFI, FirmwareBootModeBIOS)
+	}
+	if policy.SecureBootRequired && policy.BootMode == FirmwareBootModeBIOS {
+		return errors.Errorf("Secure Boot requires the %s boot mode", FirmwareBootModeUEFI)
+	}
+	manufacturers := make(map[string]bool)
+	for _, minVersion := range policy.MinBiosVersions {
+		if minVersion == nil {
+			return errors.New("minimum BIOS versions must not be null")
+		}
+		manufacturer := normalizeManufacturer(swag.StringValue(minVersion.Manufacturer))
+		if manufacturer == "" {
+			return errors.New("the manufacturer of minimum BIOS versions must not be empty")
+		}
+		if manufacturers[manufacturer] {
+			return errors.Errorf("minimum BIOS version for manufacturer %s is defined more than once", swag.StringValue(minVersion.Manufacturer))
+		}
+		manufacturers[manufacturer] = true
+		if len(versionComponents(swag.StringValue(minVersion.Version))) == 0 {
+			return errors.Errorf("minimum BIOS version %q for manufacturer %s must contain a number",
+				swag.StringValue(minVersion.Version), swag.StringValue(minVersion.Manufacturer))
+		}
+	}
+	return nil
+}
+
+// FirmwarePolicyViolations returns a message for each setting of the firmware of the host that doesn't comply with
+// the firmware policy
+func FirmwarePolicyViolations(policy *models.FirmwarePolicy, inventory *models.Inventory) []string {
+	var ret []string
+	var bootMode string
+	var secureBootState models.SecureBootState
+	if inventory.Boot != nil {
+		bootMode = inventory.Boot.CurrentBootMode
+		secureBootState = inventory.Boot.SecureBootState
+	}
+	if policy.BootMode != "" && bootMode != policy.BootMode {
+		ret = append(ret, fmt.Sprintf("The host must boot in %s mode, but it boots in %s mode", bootModeName(policy.BootMode), bootModeName(bootMode)))
+	}
+	if policy.SecureBootRequired && secureBootState != models.SecureBootStateEnabled {
+		ret = append(ret, fmt.Sprintf("Secure Boot must be enabled, but its state is %s", secureBootStateName(secureBootState)))
+	}
+	if policy.VirtualizationRequired && (inventory.CPU == nil || !virt.IsVirtSupported(inventory)) {
+		ret = append(ret, "The virtualization extensions of the CPU must be enabled")
+	}
+	if message := biosVersionViolation(policy, inventory); message != "" {
+		ret = append(ret, message)
+	}
+	return ret
+}
+
+func biosVersionViolation(policy *models.FirmwarePolicy, inventory *models.Inventory) string {
+	minVersion := minBiosVersion(policy, inventory)
+	if minVersion == nil || inventory.Bios == nil || inventory.Bios.Version == "" {
+		return ""
+	}
+	required := swag.StringValue(minVersion.Version)
+	if CompareFirmwareVersions(inventory.Bios.Version, required) < 0 {
+		return fmt.Sprintf("The BIOS version %s of the host is older than the version %s required for %s hosts", inventory.Bios.Version, required, inventory.SystemVendor.Manufacturer)
+	}
+	return ""
+}
+
+// FirmwarePolicyUncheckedSettings returns a message for each setting of the firmware policy that can't be checked
+// because the agent of the host doesn't report it. These settings don't fail the policy
+func FirmwarePolicyUncheckedSettings(policy *models.FirmwarePolicy, inventory *models.Inventory) []string {
+	var ret []string
+	if minVersion := minBiosVersion(policy, inventory); minVersion != nil && (inventory.Bios == nil || inventory.Bios.Version == "") {
+		ret = append(ret, fmt.Sprintf("The BIOS version of the host is not reported, so the minimum version %s for %s hosts is not checked",
+			swag.StringValue(minVersion.Version), inventory.SystemVendor.Manufacturer))
+	}
+	return ret
+}
+
+// minBiosVersion returns the minimum BIOS version of the policy for the manufacturer of the host, or nil if there is none
+func minBiosVersion(policy *models.FirmwarePolicy, inventory *models.Inventory) *models.FirmwarePolicyBiosVersion {
+	if inventory.SystemVendor == nil {
+		return nil
+	}
+	minVersion, found := funk.Find(policy.MinBiosVersions, func(minVersion *models.FirmwarePolicyBiosVersion) bool {
+		return normalizeManufacturer(swag.StringValue(minVersion.Manufacturer)) == normalizeManufacturer(inventory.SystemVendor.Manufacturer)
+	}).(*models.FirmwarePolicyBiosVersion)
+	if !found {
+		return nil
+	}
+	return minVersion
+}
+
+// CompareFirmwareVersions compares two firmware versions by their numeric components, ignoring any other characters,
+// as vendors format their versions differently (e.g. 2.12.1, U30 v2.68 or 1.4.9). It returns a negative number if the
+// first version is older, zero if they are equal and a positive number if it is newer
+func CompareFirmwareVersions(a, b string) int {
+	aComponents := versionComponents(a)
+	bComponents := versionComponents(b)
+	for i := 0; i < len(aComponents) || i < len(bComponents); i++ {
+		var aComponent, bComponent uint64
+		if i < len(aComponents) {
+			aComponent = aComponents[i]
+		}
+		if i < len(bComponents) {
+			bComponent = bComponents[i]
+		}
+		if aComponent != bComponent {
+			if aComponent < bComponent {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func versionComponents(version string) []uint64 {
+	var ret []uint64
+	for _, component := range versionComponentRegex.FindAllString(version, -1) {
+		value, err := strconv.ParseUint(component, 10, 64)
+		if err != nil {
+			// Too large to be a version component, the digits are compared as the largest value
+			value = ^uint64(0)
+		}
+		ret = append(ret, value)
+	}
+	return ret
+}
+
+func normalizeManufacturer(manufacturer string) string {
+	return strings.ToLower(strings.TrimSpace(manufacturer))
+}
+
+func bootModeName(bootMode string) string {
+	switch bootMode {
+	case FirmwareBootModeUEFI:
+		return "UEFI"
+	case FirmwareBootModeBIOS:
+		return "legacy BIOS"
+	case "":
+		return "an unknown"
+	default:
+		return bootMode
+	}
+}
+
+func secureBootStateName(state models.SecureBootState) string {
+	switch state {
+	case models.SecureBootStateDisabled:
+		return "disabled"
+	case models.SecureBootStateNotSupported:
+		return "not supported"
+	default:
+		return "unknown"
+	}
+}
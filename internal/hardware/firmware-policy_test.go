@@ -0,0 +1,127 @@
+package hardware
+
+import (
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Firmware policy", func() {
+	minBiosVersion := func(manufacturer, version string) *models.FirmwarePolicyBiosVersion {
+		return &models.FirmwarePolicyBiosVersion{Manufacturer: swag.String(manufacturer), Version: swag.String(version)}
+	}
+
+	compliantInventory := func() *models.Inventory {
+		return &models.Inventory{
+			Boot:         &models.Boot{CurrentBootMode: "uefi", SecureBootState: models.SecureBootStateEnabled},
+			CPU:          &models.CPU{Architecture: models.ClusterCPUArchitectureX8664, Flags: []string{"vmx"}},
+			SystemVendor: &models.SystemVendor{Manufacturer: "Dell Inc."},
+			Bios:         &models.Bios{Vendor: "Dell Inc.", Version: "2.12.1"},
+		}
+	}
+
+	policy := &models.FirmwarePolicy{
+		BootMode:               FirmwareBootModeUEFI,
+		SecureBootRequired:     true,
+		VirtualizationRequired: true,
+		MinBiosVersions: []*models.FirmwarePolicyBiosVersion{
+			minBiosVersion("dell inc.", "2.12.0"),
+			minBiosVersion("HPE", "U30 v2.68"),
+		},
+	}
+
+	Context("Format and parse", func() {
+		It("round trips the policy", func() {
+			formatted, err := FormatFirmwarePolicy(policy)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(formatted).ToNot(BeNil())
+			parsed, err := ParseFirmwarePolicy(formatted)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsed).To(Equal(policy))
+		})
+
+		It("formats empty policies as nil", func() {
+			Expect(FormatFirmwarePolicy(nil)).To(BeNil())
+			Expect(FormatFirmwarePolicy(&models.FirmwarePolicy{})).To(BeNil())
+			Expect(ParseFirmwarePolicy(nil)).To(BeNil())
+			Expect(ParseFirmwarePolicy(swag.String(""))).To(BeNil())
+		})
+
+		It("fails to parse invalid policies", func() {
+			_, err := ParseFirmwarePolicy(swag.String("{"))
+			Expect(err).To(HaveOccurred())
+		})
+
+		table.DescribeTable("rejects invalid policies", func(invalid *models.FirmwarePolicy) {
+			_, err := FormatFirmwarePolicy(invalid)
+			Expect(err).To(HaveOccurred())
+		},
+			table.Entry("unknown boot mode", &models.FirmwarePolicy{BootMode: "efi"}),
+			table.Entry("Secure Boot in legacy BIOS mode", &models.FirmwarePolicy{BootMode: FirmwareBootModeBIOS, SecureBootRequired: true}),
+			table.Entry("empty manufacturer", &models.FirmwarePolicy{MinBiosVersions: []*models.FirmwarePolicyBiosVersion{minBiosVersion(" ", "1.0")}}),
+			table.Entry("duplicate manufacturer", &models.FirmwarePolicy{MinBiosVersions: []*models.FirmwarePolicyBiosVersion{
+				minBiosVersion("HPE", "1.0"), minBiosVersion("hpe", "2.0")}}),
+			table.Entry("version without numbers", &models.FirmwarePolicy{MinBiosVersions: []*models.FirmwarePolicyBiosVersion{minBiosVersion("HPE", "latest")}}),
+		)
+	})
+
+	Context("Violations", func() {
+		It("accepts compliant hosts", func() {
+			Expect(FirmwarePolicyViolations(policy, compliantInventory())).To(BeEmpty())
+		})
+
+		It("ignores the BIOS version of other manufacturers", func() {
+			inventory := compliantInventory()
+			inventory.SystemVendor.Manufacturer = "Lenovo"
+			inventory.Bios = nil
+			Expect(FirmwarePolicyViolations(policy, inventory)).To(BeEmpty())
+		})
+
+		It("reports each setting that doesn't comply", func() {
+			inventory := compliantInventory()
+			inventory.Boot = &models.Boot{CurrentBootMode: "bios", SecureBootState: models.SecureBootStateNotSupported}
+			inventory.CPU.Flags = []string{"sse"}
+			inventory.Bios.Version = "2.9.4"
+			Expect(FirmwarePolicyViolations(policy, inventory)).To(Equal([]string{
+				"The host must boot in UEFI mode, but it boots in legacy BIOS mode",
+				"Secure Boot must be enabled, but its state is not supported",
+				"The virtualization extensions of the CPU must be enabled",
+				"The BIOS version 2.9.4 of the host is older than the version 2.12.0 required for Dell Inc. hosts",
+			}))
+		})
+
+		It("reports unknown settings", func() {
+			inventory := compliantInventory()
+			inventory.Boot = nil
+			Expect(FirmwarePolicyViolations(policy, inventory)).To(Equal([]string{
+				"The host must boot in UEFI mode, but it boots in an unknown mode",
+				"Secure Boot must be enabled, but its state is unknown",
+			}))
+		})
+
+		It("doesn't fail a host whose agent doesn't report the BIOS version", func() {
+			inventory := compliantInventory()
+			inventory.Bios = nil
+			Expect(FirmwarePolicyViolations(policy, inventory)).To(BeEmpty())
+			Expect(FirmwarePolicyUncheckedSettings(policy, inventory)).To(Equal([]string{
+				"The BIOS version of the host is not reported, so the minimum version 2.12.0 for Dell Inc. hosts is not checked",
+			}))
+		})
+
+		It("has no unchecked settings when the BIOS version is reported", func() {
+			Expect(FirmwarePolicyUncheckedSettings(policy, compliantInventory())).To(BeEmpty())
+		})
+	})
+
+	table.DescribeTable("CompareFirmwareVersions", func(a, b string, expected int) {
+		Expect(CompareFirmwareVersions(a, b)).To(Equal(expected))
+	},
+		table.Entry("equal", "2.12.1", "2.12.1", 0),
+		table.Entry("numeric components", "2.9.4", "2.12.1", -1),
+		table.Entry("more components", "2.12.1.1", "2.12.1", 1),
+		table.Entry("missing components are zero", "2.12", "2.12.0", 0),
+		table.Entry("vendor prefixes", "U30 v2.70", "U30 v2.68", 1),
+	)
+})
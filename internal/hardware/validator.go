@@ -86,6 +86,17 @@ type ValidatorCfg struct {
 	MaxHostDisconnectionTime      time.Duration                `envconfig:"HOST_MAX_DISCONNECTION_TIME" default:"3m"`
 	AgentDockerImage              string                       `envconfig:"AGENT_DOCKER_IMAGE" default:"quay.io/edge-infrastructure/assisted-installer-agent:latest"`
 	EdgeWorkerProductNames        string                       `envconfig:"EDGE_WORKERS_PRODUCT_NAMES" default:"BlueField SoC"`
+
+	// Whether failing disks fail the disks-healthy validation, and block the installation of the host, rather than
+	// only being reported by it
+	DiskHealthBlocking bool `envconfig:"DISK_HEALTH_BLOCKING" default:"false"`
+	// Thresholds of the health of the installation disk and the disks used by storage operators
+	DiskHealthMaxReallocatedSectors      int64 `envconfig:"DISK_HEALTH_MAX_REALLOCATED_SECTORS" default:"10"`
+	DiskHealthMaxMediaErrors             int64 `envconfig:"DISK_HEALTH_MAX_MEDIA_ERRORS" default:"0"`
+	DiskHealthWearLevelWarningPercentage int64 `envconfig:"DISK_HEALTH_WEAR_LEVEL_WARNING_PERCENTAGE" default:"80"`
+	DiskHealthMaxWearLevelPercentage     int64 `envconfig:"DISK_HEALTH_MAX_WEAR_LEVEL_PERCENTAGE" default:"100"`
+	DiskHealthTemperatureWarningCelsius  int64 `envconfig:"DISK_HEALTH_TEMPERATURE_WARNING_CELSIUS" default:"60"`
+	DiskHealthMaxTemperatureCelsius      int64 `envconfig:"DISK_HEALTH_MAX_TEMPERATURE_CELSIUS" default:"70"`
 }
 
 type validator struct {
@@ -456,6 +467,13 @@ func totalizeRequirements(ocpRequirements models.ClusterHostRequirementsDetails,
 				total.PacketLossPercentage = ptr.To(math.Min(*total.PacketLossPercentage, *details.PacketLossPercentage))
 			}
 		}
+		if details.NetworkBandwidthThresholdMbps != nil && *details.NetworkBandwidthThresholdMbps >= 0 {
+			if total.NetworkBandwidthThresholdMbps == nil {
+				total.NetworkBandwidthThresholdMbps = details.NetworkBandwidthThresholdMbps
+			} else {
+				total.NetworkBandwidthThresholdMbps = ptr.To(math.Max(*total.NetworkBandwidthThresholdMbps, *details.NetworkBandwidthThresholdMbps))
+			}
+		}
 	}
 	return total
 }
@@ -1265,6 +1265,22 @@ var _ = Describe("Cluster host requirements", func() {
 		Expect(result.Total.InstallationDiskSpeedThresholdMs).To(BeEquivalentTo(defaultMasterDiskSpeedThreshold))
 	})
 
+	It("should contain the highest network bandwidth threshold of the requirements", func() {
+		role := models.HostRoleMaster
+		id1 := strfmt.UUID(uuid.New().String())
+		host = &models.Host{ID: &id1, ClusterID: cluster.ID, Role: role}
+		details1.NetworkBandwidthThresholdMbps = ptr.To(float64(300))
+		details2.NetworkBandwidthThresholdMbps = ptr.To(float64(200))
+
+		operatorsMock.EXPECT().GetRequirementsBreakdownForHostInCluster(gomock.Any(), gomock.Eq(cluster), gomock.Eq(host)).Return(operatorRequirements, nil)
+
+		result, err := hwvalidator.GetClusterHostRequirements(context.TODO(), cluster, host)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Ocp.NetworkBandwidthThresholdMbps).To(BeNil())
+		Expect(result.Total.NetworkBandwidthThresholdMbps).To(Equal(details1.NetworkBandwidthThresholdMbps))
+	})
+
 	It("should contain correct default requirements for arbiter host", func() {
 		role := models.HostRoleArbiter
 		id1 := strfmt.UUID(uuid.New().String())
@@ -33,7 +33,7 @@ const (
 	statusInfoPreparingForInstallation                                    = "Host is preparing for installation"
 	statusInfoHostPreparationSuccessful                                   = "Host finished successfully to prepare for installation"
 	statusInfoHostPreparationFailure                                      = "Host failed to prepare for installation due to following failing validation(s): $FAILING_VALIDATIONS"
-	statusInfoAbortingDueClusterErrors                                    = "Host is part of a cluster that failed to install"
+	StatusInfoAbortingDueClusterErrors                                    = "Host is part of a cluster that failed to install"
 	statusInfoInstallationTimedOut                                        = "Host failed to install due to timeout while starting installation"
 	statusInfoConnectionTimedOutInstalling                                = "Host failed to install due to timeout while connecting to host during the installation phase."
 	statusInfoConnectionSoftTimedOutInstalling                            = "Host is failing to perform periodic health check during the installation phase."
@@ -26,6 +26,7 @@ const (
 	HostStageTimedOut                    = conditionId("host-stage-timed-out")
 	SoftTimeoutsEnabled                  = conditionId("soft-timeouts-enabled")
 	ConnectionTimedOut                   = conditionId("connection-timed-out")
+	UserDefinedValidationsSucceeded      = conditionId("user-defined-validations-succeeded")
 )
 
 func (c conditionId) String() string {
@@ -6,6 +6,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/openshift/assisted-service/internal/host/customsteps"
+	"github.com/openshift/assisted-service/internal/uservalidations"
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
 )
@@ -26,6 +28,13 @@ type Config struct {
 	EnableVirtualInterfaces  bool                    `envconfig:"ENABLE_VIRTUAL_INTERFACES" default:"false"`
 	// Per-host monitor refresh timeout to bound time spent refreshing a single host during monitoring
 	MonitorPerHostTimeout time.Duration `envconfig:"HOST_MONITOR_PER_HOST_TIMEOUT" default:"2m"`
+	// CustomSteps are the steps that are defined by the administrator of the service, as a JSON list
+	CustomSteps customsteps.Steps `envconfig:"CUSTOM_STEPS" default:""`
+	// UserValidations are the validations that are defined by the administrator of the service, as a JSON list.
+	// Their expressions are compiled once the configuration is loaded
+	UserValidations uservalidations.Validations `envconfig:"USER_VALIDATIONS" default:""`
+	// The number of hardware revisions kept for each host, older revisions are deleted
+	MaxHardwareRevisions int64 `envconfig:"HOST_HARDWARE_REVISIONS_LIMIT" default:"20"`
 
 	// hostStageTimeouts contains the values of the host stage timeouts. Don't use this
 	// directly, use the HostStageTimeout method instead.
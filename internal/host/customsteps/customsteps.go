@@ -0,0 +1,173 @@
+package customsteps
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+// DefaultTimeoutSeconds is the timeout of the steps that don't set one
+const DefaultTimeoutSeconds = int64(60)
+
+var nameRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// states are the host states in which custom steps may run
+var states = []string{
+	models.HostStatusDiscovering,
+	models.HostStatusKnown,
+	models.HostStatusInsufficient,
+	models.HostStatusPendingForInput,
+	models.HostStatusDiscoveringUnbound,
+	models.HostStatusKnownUnbound,
+	models.HostStatusInsufficientUnbound,
+}
+
+// Step is a step that is defined by the administrator of the service, which the agent runs as a container
+type Step struct {
+	// Name identifies the step and its result on the host
+	Name string `json:"name"`
+	// Image is the container image that the agent runs
+	Image string `json:"image"`
+	// Args are the arguments of the container
+	Args []string `json:"args,omitempty"`
+	// TimeoutSeconds is the time after which the agent stops the container
+	TimeoutSeconds int64 `json:"timeout_seconds,omitempty"`
+	// States are the host states in which the step runs
+	States []string `json:"states"`
+	// ReplySchema is the JSON schema that the output of the container must match
+	ReplySchema json.RawMessage `json:"reply_schema,omitempty"`
+	// Required steps fail the custom-steps-succeeded host validation until they succeed
+	Required bool `json:"required,omitempty"`
+
+	schema *spec.Schema
+}
+
+// Steps are the custom steps of the service, they are decoded from a JSON list by envconfig
+type Steps []*Step
+
+func (s *Steps) Decode(value string) error {
+	steps := Steps{}
+	if strings.TrimSpace(value) != "" {
+		if err := json.Unmarshal([]byte(value), &steps); err != nil {
+			return errors.Wrap(err, "failed to parse the custom steps")
+		}
+	}
+	names := make(map[string]bool)
+	for _, step := range steps {
+		if err := step.complete(); err != nil {
+			return errors.Wrapf(err, "invalid custom step %s", step.Name)
+		}
+		if names[step.Name] {
+			return errors.Errorf("custom step %s is defined more than once", step.Name)
+		}
+		names[step.Name] = true
+	}
+	*s = steps
+	return nil
+}
+
+func (s *Step) complete() error {
+	if !nameRegex.MatchString(s.Name) {
+		return errors.Errorf("the name must consist of lower case alphanumeric characters or '-'")
+	}
+	if s.Image == "" {
+		return errors.New("the image is required")
+	}
+	if s.TimeoutSeconds < 0 {
+		return errors.New("the timeout must not be negative")
+	}
+	if s.TimeoutSeconds == 0 {
+		s.TimeoutSeconds = DefaultTimeoutSeconds
+	}
+	if len(s.States) == 0 {
+		return errors.New("at least one host state is required")
+	}
+	for _, state := range s.States {
+		if !funk.ContainsString(states, state) {
+			return errors.Errorf("custom steps can't run in host state %s, the supported states are %s", state, strings.Join(states, ", "))
+		}
+	}
+	if len(s.ReplySchema) > 0 {
+		s.schema = &spec.Schema{}
+		if err := json.Unmarshal(s.ReplySchema, s.schema); err != nil {
+			return errors.Wrap(err, "failed to parse the reply schema")
+		}
+	}
+	return nil
+}
+
+// Get returns the step with the given name, or nil if there is none
+func (s Steps) Get(name string) *Step {
+	for _, step := range s {
+		if step.Name == name {
+			return step
+		}
+	}
+	return nil
+}
+
+// Required returns the steps that are required to succeed
+func (s Steps) Required() Steps {
+	return funk.Filter(s, func(step *Step) bool { return step.Required }).([]*Step)
+}
+
+// Request returns the request that is sent to the agent to run the step
+func (s *Step) Request() *models.CustomStepRequest {
+	return &models.CustomStepRequest{
+		Name:    swag.String(s.Name),
+		Image:   swag.String(s.Image),
+		Args:    s.Args,
+		Timeout: s.TimeoutSeconds,
+	}
+}
+
+// ValidateReply checks that the output of the container is a JSON document that matches the reply schema of the step
+func (s *Step) ValidateReply(output string) error {
+	var reply interface{}
+	if err := json.Unmarshal([]byte(output), &reply); err != nil {
+		return errors.Wrap(err, "the output is not a JSON document")
+	}
+	if s.schema == nil {
+		return nil
+	}
+	if err := validate.AgainstSchema(s.schema, reply, strfmt.Default); err != nil {
+		return errors.Wrap(err, "the output doesn't match the reply schema")
+	}
+	return nil
+}
+
+// StepID returns an ID for a run of the step, which identifies the step in the reply of the agent
+func StepID(name, suffix string) string {
+	return fmt.Sprintf("%s-%s-%s", models.StepTypeCustomStep, name, suffix)
+}
+
+// NameFromStepID returns the name of the step that a reply is for
+func NameFromStepID(stepID string) (string, error) {
+	name := strings.TrimPrefix(stepID, string(models.StepTypeCustomStep)+"-")
+	index := strings.LastIndex(name, "-")
+	if name == stepID || index <= 0 {
+		return "", errors.Errorf("step ID %s isn't the ID of a custom step", stepID)
+	}
+	return name[:index], nil
+}
+
+// ParseResults parses the custom step results of a host
+func ParseResults(results string) ([]*models.CustomStepResult, error) {
+	ret := make([]*models.CustomStepResult, 0)
+	if results == "" {
+		return ret, nil
+	}
+	if err := json.Unmarshal([]byte(results), &ret); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the custom step results")
+	}
+	return ret, nil
+}
@@ -0,0 +1,86 @@
+package customsteps
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Decode", func() {
+	It("accepts an empty value", func() {
+		var steps Steps
+		Expect(steps.Decode("")).To(Succeed())
+		Expect(steps).To(BeEmpty())
+	})
+
+	It("sets the default timeout", func() {
+		var steps Steps
+		Expect(steps.Decode(`[{"name": "raid-inventory", "image": "quay.io/example/raid:latest", "states": ["known", "insufficient"], "required": true}]`)).To(Succeed())
+		Expect(steps).To(HaveLen(1))
+		Expect(steps[0].TimeoutSeconds).To(Equal(DefaultTimeoutSeconds))
+		Expect(steps.Required()).To(HaveLen(1))
+		Expect(steps.Get("raid-inventory")).To(Equal(steps[0]))
+		Expect(steps.Get("other")).To(BeNil())
+	})
+
+	DescribeTable("rejects invalid steps",
+		func(value string) {
+			var steps Steps
+			Expect(steps.Decode(value)).ToNot(Succeed())
+		},
+		Entry("invalid JSON", `[{"name": `),
+		Entry("invalid name", `[{"name": "Firmware_Probe", "image": "quay.io/example/fw:latest", "states": ["known"]}]`),
+		Entry("missing image", `[{"name": "firmware-probe", "states": ["known"]}]`),
+		Entry("negative timeout", `[{"name": "firmware-probe", "image": "quay.io/example/fw:latest", "timeout_seconds": -1, "states": ["known"]}]`),
+		Entry("missing states", `[{"name": "firmware-probe", "image": "quay.io/example/fw:latest"}]`),
+		Entry("unsupported state", `[{"name": "firmware-probe", "image": "quay.io/example/fw:latest", "states": ["installing"]}]`),
+		Entry("invalid reply schema", `[{"name": "firmware-probe", "image": "quay.io/example/fw:latest", "states": ["known"], "reply_schema": {"type": 5}}]`),
+		Entry("duplicate name", `[{"name": "firmware-probe", "image": "quay.io/example/fw:latest", "states": ["known"]},
+			{"name": "firmware-probe", "image": "quay.io/example/fw:latest", "states": ["insufficient"]}]`),
+	)
+})
+
+var _ = Describe("ValidateReply", func() {
+	var step *Step
+
+	BeforeEach(func() {
+		var steps Steps
+		Expect(steps.Decode(`[{"name": "firmware-probe", "image": "quay.io/example/fw:latest", "states": ["known"],
+			"reply_schema": {"type": "object", "required": ["version"], "properties": {"version": {"type": "string"}}}}]`)).To(Succeed())
+		step = steps[0]
+	})
+
+	It("accepts a reply that matches the schema", func() {
+		Expect(step.ValidateReply(`{"version": "2.14.1"}`)).To(Succeed())
+	})
+
+	It("rejects a reply that doesn't match the schema", func() {
+		Expect(step.ValidateReply(`{"version": 2}`)).ToNot(Succeed())
+		Expect(step.ValidateReply(`{}`)).ToNot(Succeed())
+	})
+
+	It("rejects a reply that isn't JSON", func() {
+		Expect(step.ValidateReply("version 2.14.1")).ToNot(Succeed())
+	})
+})
+
+var _ = Describe("NameFromStepID", func() {
+	It("returns the name of the step", func() {
+		name, err := NameFromStepID(StepID("bmc-reachability", "1a2b3c4d"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(name).To(Equal("bmc-reachability"))
+	})
+
+	It("fails for the ID of a built-in step", func() {
+		_, err := NameFromStepID(string(models.StepTypeInventory) + "-1a2b3c4d")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+func TestCustomSteps(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Custom steps Tests")
+}
@@ -0,0 +1,169 @@
+package fingerprint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+// New returns the hardware fingerprint of the inventory. The fingerprint only contains the properties of the hardware
+// that don't change between boots, so it only changes when the hardware of the host changes
+func New(inventory *models.Inventory) *models.HardwareFingerprint {
+	ret := &models.HardwareFingerprint{
+		Disks: make([]*models.HardwareFingerprintDisk, 0, len(inventory.Disks)),
+		Nics:  make([]*models.HardwareFingerprintNic, 0, len(inventory.Interfaces)),
+	}
+	if inventory.CPU != nil {
+		ret.CPUModel = strings.TrimSpace(inventory.CPU.ModelName)
+		ret.CPUCount = inventory.CPU.Count
+	}
+	if inventory.Memory != nil {
+		ret.MemoryBytes = inventory.Memory.PhysicalBytes
+	}
+	for _, disk := range inventory.Disks {
+		if disk.IsInstallationMedia {
+			continue
+		}
+		ret.Disks = append(ret.Disks, &models.HardwareFingerprintDisk{
+			ID:        diskID(disk),
+			Model:     strings.TrimSpace(disk.Model),
+			SizeBytes: disk.SizeBytes,
+		})
+	}
+	sort.Slice(ret.Disks, func(i, j int) bool { return ret.Disks[i].ID < ret.Disks[j].ID })
+	for _, nic := range inventory.Interfaces {
+		// Virtual interfaces, such as bonds and VLANs, are not hardware
+		if nic.MacAddress == "" || (nic.Type != "" && nic.Type != "physical") {
+			continue
+		}
+		ret.Nics = append(ret.Nics, &models.HardwareFingerprintNic{
+			MacAddress: strings.ToLower(nic.MacAddress),
+			Product:    strings.TrimSpace(nic.Product),
+		})
+	}
+	sort.Slice(ret.Nics, func(i, j int) bool { return ret.Nics[i].MacAddress < ret.Nics[j].MacAddress })
+	return ret
+}
+
+// diskID identifies the disk by the most stable of its identifiers
+func diskID(disk *models.Disk) string {
+	for _, id := range []string{disk.Wwn, disk.Serial, disk.ByPath, disk.ByID, disk.ID} {
+		if id = strings.TrimSpace(id); id != "" {
+			return id
+		}
+	}
+	return disk.Name
+}
+
+// Hash returns the hash of the fingerprint, equal fingerprints have equal hashes
+func Hash(fingerprint *models.HardwareFingerprint) (string, error) {
+	b, err := json.Marshal(fingerprint)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode the hardware fingerprint")
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Diff returns the changes of the hardware from one fingerprint to another. The first fingerprint may be nil
+func Diff(from, to *models.HardwareFingerprint) []*models.HardwareFingerprintChange {
+	if from == nil {
+		from = &models.HardwareFingerprint{}
+	}
+	ret := make([]*models.HardwareFingerprintChange, 0)
+	if cpu(from) != cpu(to) {
+		ret = append(ret, &models.HardwareFingerprintChange{
+			Component: swag.String(models.HardwareFingerprintChangeComponentCPU),
+			Type:      swag.String(models.HardwareFingerprintChangeTypeChanged),
+			From:      cpu(from),
+			To:        cpu(to),
+		})
+	}
+	if from.MemoryBytes != to.MemoryBytes {
+		ret = append(ret, &models.HardwareFingerprintChange{
+			Component: swag.String(models.HardwareFingerprintChangeComponentMemory),
+			Type:      swag.String(models.HardwareFingerprintChangeTypeChanged),
+			From:      conversions.BytesToString(from.MemoryBytes),
+			To:        conversions.BytesToString(to.MemoryBytes),
+		})
+	}
+	ret = append(ret, diffComponents(models.HardwareFingerprintChangeComponentDisk,
+		disksByID(from.Disks), disksByID(to.Disks))...)
+	ret = append(ret, diffComponents(models.HardwareFingerprintChangeComponentNic,
+		nicsByMAC(from.Nics), nicsByMAC(to.Nics))...)
+	return ret
+}
+
+// Summary returns a short description of the changes, to be used in events
+func Summary(changes []*models.HardwareFingerprintChange) string {
+	descriptions := make([]string, 0, len(changes))
+	for _, change := range changes {
+		description := fmt.Sprintf("%s %s", *change.Component, *change.Type)
+		if change.ID != "" {
+			description = fmt.Sprintf("%s %s %s", *change.Component, change.ID, *change.Type)
+		}
+		descriptions = append(descriptions, description)
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+func cpu(fingerprint *models.HardwareFingerprint) string {
+	if fingerprint.CPUModel == "" && fingerprint.CPUCount == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d x %s", fingerprint.CPUCount, fingerprint.CPUModel)
+}
+
+func disksByID(disks []*models.HardwareFingerprintDisk) map[string]string {
+	ret := make(map[string]string, len(disks))
+	for _, disk := range disks {
+		ret[disk.ID] = strings.TrimSpace(fmt.Sprintf("%s %s", disk.Model, conversions.BytesToString(disk.SizeBytes)))
+	}
+	return ret
+}
+
+func nicsByMAC(nics []*models.HardwareFingerprintNic) map[string]string {
+	ret := make(map[string]string, len(nics))
+	for _, nic := range nics {
+		ret[nic.MacAddress] = nic.Product
+	}
+	return ret
+}
+
+// diffComponents compares components that are identified by an ID, given as maps from their IDs to their descriptions
+func diffComponents(component string, from, to map[string]string) []*models.HardwareFingerprintChange {
+	ids := funk.UniqString(append(funk.Keys(from).([]string), funk.Keys(to).([]string)...))
+	sort.Strings(ids)
+	ret := make([]*models.HardwareFingerprintChange, 0)
+	for _, id := range ids {
+		fromDescription, inFrom := from[id]
+		toDescription, inTo := to[id]
+		change := &models.HardwareFingerprintChange{
+			Component: swag.String(component),
+			ID:        id,
+			From:      fromDescription,
+			To:        toDescription,
+		}
+		switch {
+		case !inFrom:
+			change.Type = swag.String(models.HardwareFingerprintChangeTypeAdded)
+		case !inTo:
+			change.Type = swag.String(models.HardwareFingerprintChangeTypeRemoved)
+		case fromDescription != toDescription:
+			change.Type = swag.String(models.HardwareFingerprintChangeTypeChanged)
+		default:
+			continue
+		}
+		ret = append(ret, change)
+	}
+	return ret
+}
@@ -0,0 +1,101 @@
+package fingerprint
+
+import (
+	"testing"
+
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+)
+
+func TestFingerprint(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Hardware fingerprint tests")
+}
+
+var _ = Describe("Hardware fingerprint", func() {
+	var inventory *models.Inventory
+
+	BeforeEach(func() {
+		inventory = &models.Inventory{
+			CPU:    &models.CPU{ModelName: "Intel Xeon ", Count: 8},
+			Memory: &models.Memory{PhysicalBytes: conversions.GibToBytes(16), UsableBytes: conversions.GibToBytes(15)},
+			Disks: []*models.Disk{
+				{Name: "sdb", Serial: "serial-b", Model: "SSD", SizeBytes: 100},
+				{Name: "sda", Wwn: "wwn-a", Serial: "serial-a", Model: "HDD", SizeBytes: 200},
+				{Name: "sr0", IsInstallationMedia: true, SizeBytes: 1},
+			},
+			Interfaces: []*models.Interface{
+				{Name: "eth1", MacAddress: "AA:BB:CC:DD:EE:02", Type: "physical", Product: "NIC", IPV4Addresses: []string{"10.0.0.2/24"}},
+				{Name: "eth0", MacAddress: "aa:bb:cc:dd:ee:01", Type: "physical", Product: "NIC"},
+				{Name: "bond0", MacAddress: "aa:bb:cc:dd:ee:01", Type: "bond"},
+			},
+		}
+	})
+
+	It("normalizes the hardware of the inventory", func() {
+		Expect(New(inventory)).To(Equal(&models.HardwareFingerprint{
+			CPUModel:    "Intel Xeon",
+			CPUCount:    8,
+			MemoryBytes: conversions.GibToBytes(16),
+			Disks: []*models.HardwareFingerprintDisk{
+				{ID: "serial-b", Model: "SSD", SizeBytes: 100},
+				{ID: "wwn-a", Model: "HDD", SizeBytes: 200},
+			},
+			Nics: []*models.HardwareFingerprintNic{
+				{MacAddress: "aa:bb:cc:dd:ee:01", Product: "NIC"},
+				{MacAddress: "aa:bb:cc:dd:ee:02", Product: "NIC"},
+			},
+		}))
+	})
+
+	It("doesn't change the hash when properties that aren't hardware change", func() {
+		hash, err := Hash(New(inventory))
+		Expect(err).ToNot(HaveOccurred())
+		inventory.Memory.UsableBytes = conversions.GibToBytes(14)
+		inventory.Interfaces[0].IPV4Addresses = []string{"10.0.0.3/24"}
+		inventory.Disks[0], inventory.Disks[1] = inventory.Disks[1], inventory.Disks[0]
+		Expect(Hash(New(inventory))).To(Equal(hash))
+	})
+
+	It("changes the hash when a disk is replaced", func() {
+		hash, err := Hash(New(inventory))
+		Expect(err).ToNot(HaveOccurred())
+		inventory.Disks[0].Serial = "serial-c"
+		Expect(Hash(New(inventory))).ToNot(Equal(hash))
+	})
+
+	It("returns the changes between fingerprints", func() {
+		from := New(inventory)
+		inventory.Disks[0].Serial = "serial-c"
+		inventory.Interfaces = inventory.Interfaces[1:]
+		inventory.Memory.PhysicalBytes = conversions.GibToBytes(32)
+		changes := Diff(from, New(inventory))
+		Expect(changes).To(HaveLen(4))
+		Expect(changes[0]).To(Equal(&models.HardwareFingerprintChange{
+			Component: swag.String(models.HardwareFingerprintChangeComponentMemory),
+			Type:      swag.String(models.HardwareFingerprintChangeTypeChanged),
+			From:      "16.00 GiB",
+			To:        "32.00 GiB",
+		}))
+		Expect(Summary(changes)).To(Equal("memory changed, disk serial-b removed, disk serial-c added, nic aa:bb:cc:dd:ee:02 removed"))
+	})
+
+	It("returns all the hardware as added when there is no previous fingerprint", func() {
+		changes := Diff(nil, New(inventory))
+		Expect(changes).To(HaveLen(6))
+		for _, change := range changes {
+			if *change.Component == models.HardwareFingerprintChangeComponentCPU || *change.Component == models.HardwareFingerprintChangeComponentMemory {
+				Expect(*change.Type).To(Equal(models.HardwareFingerprintChangeTypeChanged))
+			} else {
+				Expect(*change.Type).To(Equal(models.HardwareFingerprintChangeTypeAdded))
+			}
+		}
+	})
+
+	It("returns no changes between equal fingerprints", func() {
+		Expect(Diff(New(inventory), New(inventory))).To(BeEmpty())
+	})
+})
@@ -0,0 +1,116 @@
+package host
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/host/fingerprint"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+)
+
+// hostStatusesValidated are the statuses of hosts whose validations passed, a change of their hardware is a drift
+var hostStatusesValidated = []string{
+	models.HostStatusKnown,
+	models.HostStatusKnownUnbound,
+	models.HostStatusPreparingForInstallation,
+	models.HostStatusPreparingSuccessful,
+}
+
+// recordHardwareRevision records a revision of the hardware of the host when the fingerprint of the inventory differs
+// from the fingerprint of the previous inventory, and flags a hardware drift when the hardware changed after the
+// validations of the host passed. The changes of the host are added to the updates
+func (m *Manager) recordHardwareRevision(ctx context.Context, db *gorm.DB, h *models.Host, inventory *models.Inventory, updates map[string]interface{}) error {
+	log := logutil.FromContext(ctx, m.log)
+	current := fingerprint.New(inventory)
+	hash, err := fingerprint.Hash(current)
+	if err != nil {
+		return err
+	}
+	if hash == h.HardwareFingerprintHash {
+		return nil
+	}
+
+	var latest common.HostHardwareRevision
+	var previous *models.HardwareFingerprint
+	revision := int64(1)
+	err = db.Where("host_id = ? and infra_env_id = ?", h.ID.String(), h.InfraEnvID.String()).Order("revision DESC").Take(&latest).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+	case err != nil:
+		return errors.Wrapf(err, "failed to get the latest hardware revision of host %s", h.ID)
+	default:
+		revision = swag.Int64Value(latest.Revision) + 1
+		if previous, err = ParseHardwareFingerprint(latest.FingerprintJSON); err != nil {
+			log.WithError(err).Warnf("failed to parse the latest hardware revision of host %s", h.ID)
+		}
+	}
+
+	// The hardware of hosts that were registered before their fingerprint was recorded is not known to have changed
+	drift := h.HardwareFingerprintHash != "" && funk.ContainsString(hostStatusesValidated, swag.StringValue(h.Status))
+	fingerprintJSON, err := json.Marshal(current)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode the hardware fingerprint")
+	}
+	createdAt := strfmt.DateTime(time.Now())
+	record := &common.HostHardwareRevision{
+		HostHardwareRevision: models.HostHardwareRevision{
+			HostID:          h.ID,
+			InfraEnvID:      &h.InfraEnvID,
+			Revision:        swag.Int64(revision),
+			CreatedAt:       &createdAt,
+			HostStatus:      swag.StringValue(h.Status),
+			Drift:           drift,
+			FingerprintHash: swag.String(hash),
+		},
+		FingerprintJSON: string(fingerprintJSON),
+	}
+	if err = db.Create(record).Error; err != nil {
+		return errors.Wrapf(err, "failed to store the hardware revision of host %s", h.ID)
+	}
+	if m.Config.MaxHardwareRevisions > 0 {
+		if err = db.Where("host_id = ? and infra_env_id = ? and revision <= ?", h.ID.String(), h.InfraEnvID.String(), revision-m.Config.MaxHardwareRevisions).
+			Delete(&common.HostHardwareRevision{}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete old hardware revisions of host %s", h.ID)
+		}
+	}
+
+	updates["hardware_fingerprint_hash"] = hash
+	if drift {
+		changes := fingerprint.Summary(fingerprint.Diff(previous, current))
+		log.Infof("Hardware of host %s changed after it was validated: %s", h.ID, changes)
+		updates["hardware_drift_detected_at"] = createdAt
+		eventgen.SendHostHardwareDriftDetectedEvent(ctx, m.eventsHandler, h.ClusterID, *h.ID, h.InfraEnvID, hostutil.GetHostnameForMsg(h), changes)
+	}
+	return nil
+}
+
+// ParseHardwareFingerprint parses the encoded fingerprint of a hardware revision
+func ParseHardwareFingerprint(fingerprintJSON string) (*models.HardwareFingerprint, error) {
+	var ret models.HardwareFingerprint
+	if err := json.Unmarshal([]byte(fingerprintJSON), &ret); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the hardware fingerprint")
+	}
+	return &ret, nil
+}
+
+// AcceptHardware accepts the current hardware of the host, clearing its hardware drift
+func (m *Manager) AcceptHardware(ctx context.Context, h *models.Host, db *gorm.DB) error {
+	if h.HardwareDriftDetectedAt == nil {
+		return nil
+	}
+	log := logutil.FromContext(ctx, m.log)
+	log.Infof("Accepting the hardware of host %s", h.ID)
+	return m.updateHostAndNotify(ctx, db, h, map[string]interface{}{
+		"hardware_drift_detected_at": gorm.Expr("NULL"),
+	}).Error
+}
@@ -18,6 +18,7 @@ import (
 	eventgen "github.com/openshift/assisted-service/internal/common/events"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/host/customsteps"
 	"github.com/openshift/assisted-service/internal/host/hostcommands"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/metrics"
@@ -80,6 +81,8 @@ type API interface {
 	UpdateConnectivityReport(ctx context.Context, h *models.Host, connectivityReport string) error
 	UpdateApiVipConnectivityReport(ctx context.Context, h *models.Host, connectivityReport string) error
 	UpdateTangConnectivityReport(ctx context.Context, h *models.Host, connectivityReport string) error
+	UpdateBandwidthReport(ctx context.Context, h *models.Host, bandwidthReport string) error
+	UpdateCustomStepResult(ctx context.Context, h *models.Host, stepID string, exitCode int64, output, errorMessage string) error
 	HostMonitoring()
 	CancelInstallation(ctx context.Context, h *models.Host, reason string, db *gorm.DB) *common.ApiErrorResponse
 	IsRequireUserActionReset(h *models.Host) bool
@@ -105,6 +108,7 @@ type API interface {
 	UpdateHostname(ctx context.Context, h *models.Host, hostname string, db *gorm.DB) error
 	UpdateInventory(ctx context.Context, h *models.Host, inventory string) error
 	UpdateMediaConnected(ctx context.Context, h *models.Host) error
+	AcceptHardware(ctx context.Context, h *models.Host, db *gorm.DB) error
 	RefreshInventory(ctx context.Context, cluster *common.Cluster, h *models.Host, db *gorm.DB) error
 	UpdateNTP(ctx context.Context, h *models.Host, ntpSources []*models.NtpSource, db *gorm.DB) error
 	UpdateMachineConfigPoolName(ctx context.Context, db *gorm.DB, h *models.Host, machineConfigPoolName string) error
@@ -168,7 +172,7 @@ func NewManager(log logrus.FieldLogger, db *gorm.DB, notificationStream stream.N
 		hwValidator:         hwValidator,
 		eventsHandler:       eventsHandler,
 		sm:                  sm,
-		rp:                  newRefreshPreprocessor(log, hwValidatorCfg, hwValidator, operatorsApi, config.DisabledHostvalidations, providerRegistry, versionHandler),
+		rp:                  newRefreshPreprocessor(log, hwValidatorCfg, hwValidator, operatorsApi, config.DisabledHostvalidations, providerRegistry, versionHandler, config.CustomSteps, config.UserValidations),
 		metricApi:           metricApi,
 		Config:              *config,
 		leaderElector:       leaderElector,
@@ -269,6 +273,19 @@ func (m *Manager) populateDisksEligibility(ctx context.Context, inventory *model
 	return nil
 }
 
+// populateDisksHealth updates the health of each disk in the inventory from
+// the SMART data that the agent reported for it. Disks with SMART data that
+// can't be parsed are left without health.
+func (m *Manager) populateDisksHealth(log logrus.FieldLogger, inventory *models.Inventory, host *models.Host) {
+	for _, disk := range inventory.Disks {
+		health, err := hardware.ParseDiskHealth(disk.Smart)
+		if err != nil {
+			log.WithError(err).Warnf("failed to parse the SMART data of disk %s of host %s", disk.Name, host.ID)
+		}
+		disk.Health = health
+	}
+}
+
 // populateDisksId ensures that every disk has an id.
 // The id used to identify the disk and mark a disk as selected
 // This value should be equal to the host.installationDiskId
@@ -388,6 +405,7 @@ func (m *Manager) updateInventory(ctx context.Context, cluster *common.Cluster,
 	}
 
 	m.populateDisksId(inventory)
+	m.populateDisksHealth(log, inventory, h)
 	inventoryStr, err = common.MarshalInventory(inventory)
 	if err != nil {
 		return err
@@ -419,6 +437,9 @@ func (m *Manager) updateInventory(ctx context.Context, cluster *common.Cluster,
 		"installation_disk_id":   installationDiskID,
 		"disks_to_be_formatted":  disksToBeFormatted,
 	}
+	if err = m.recordHardwareRevision(ctx, db, h, inventory, updates); err != nil {
+		return err
+	}
 	return m.updateHostAndNotify(ctx, db, h, updates).Error
 }
 
@@ -757,6 +778,68 @@ func (m *Manager) UpdateTangConnectivityReport(ctx context.Context, h *models.Ho
 	return nil
 }
 
+func (m *Manager) UpdateBandwidthReport(ctx context.Context, h *models.Host, bandwidthReport string) error {
+	if h.Bandwidth != bandwidthReport {
+		updates := map[string]interface{}{"bandwidth": bandwidthReport}
+
+		if err := m.updateHost(ctx, m.db, h, updates).Error; err != nil {
+			return errors.Wrapf(err, "failed to set bandwidth to host %s", h.ID.String())
+		}
+	}
+	return nil
+}
+
+// UpdateCustomStepResult records the result of the last run of a custom step on the host
+func (m *Manager) UpdateCustomStepResult(ctx context.Context, h *models.Host, stepID string, exitCode int64, output, errorMessage string) error {
+	name, err := customsteps.NameFromStepID(stepID)
+	if err != nil {
+		return err
+	}
+	step := m.Config.CustomSteps.Get(name)
+	if step == nil {
+		return errors.Errorf("custom step %s isn't defined", name)
+	}
+	result := &models.CustomStepResult{
+		Name:     name,
+		ExitCode: exitCode,
+		Output:   output,
+		Error:    errorMessage,
+	}
+	if exitCode == 0 {
+		if err = step.ValidateReply(output); err != nil {
+			result.Error = err.Error()
+		} else {
+			result.Succeeded = true
+		}
+	}
+
+	results, err := customsteps.ParseResults(h.CustomStepResults)
+	if err != nil {
+		m.log.WithError(err).Warnf("Overwriting the custom step results of host %s", h.ID.String())
+		results = nil
+	}
+	updated := false
+	for i, existing := range results {
+		if existing.Name == name {
+			results[i] = result
+			updated = true
+		}
+	}
+	if !updated {
+		results = append(results, result)
+	}
+	b, err := json.Marshal(results)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal the custom step results of host %s", h.ID.String())
+	}
+	if h.CustomStepResults != string(b) {
+		if err = m.updateHost(ctx, m.db, h, map[string]interface{}{"custom_step_results": string(b)}).Error; err != nil {
+			return errors.Wrapf(err, "failed to set custom_step_results to host %s", h.ID.String())
+		}
+	}
+	return nil
+}
+
 func (m *Manager) UpdateRole(ctx context.Context, h *models.Host, role models.HostRole, db *gorm.DB) error {
 	cdb := m.db
 	if db != nil {
@@ -1490,6 +1573,12 @@ func (m Manager) PermanentHostsDeletion(olderThan strfmt.DateTime) error {
 	} else if reply.RowsAffected > 0 {
 		m.log.Debugf("Deleted %s hosts from db", reply.RowsAffected)
 	}
+	if reply := db.Where("NOT EXISTS (SELECT 1 FROM hosts WHERE hosts.id = host_hardware_revisions.host_id AND hosts.infra_env_id = host_hardware_revisions.infra_env_id)").
+		Delete(&common.HostHardwareRevision{}); reply.Error != nil {
+		return reply.Error
+	} else if reply.RowsAffected > 0 {
+		m.log.Debugf("Deleted %d hardware revisions of deleted hosts from db", reply.RowsAffected)
+	}
 	return nil
 }
 
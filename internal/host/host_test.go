@@ -27,6 +27,7 @@ import (
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/events/eventstest"
 	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/host/customsteps"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/operators"
@@ -1383,6 +1384,85 @@ var _ = Describe("UpdateInventory", func() {
 		Expect(h.Role).NotTo(Equal(models.HostRoleBootstrap))
 	})
 
+	Context("Hardware revisions", func() {
+		var inventory *models.Inventory
+
+		BeforeEach(func() {
+			inventory = &models.Inventory{
+				CPU:        &models.CPU{ModelName: "Intel Xeon", Count: 8},
+				Disks:      []*models.Disk{{Name: "sda", Serial: "serial-a", SizeBytes: conversions.GibToBytes(120)}},
+				Interfaces: []*models.Interface{{Name: "eth0", MacAddress: "52:54:00:aa:bb:cc", Type: "physical"}},
+			}
+			mockValidator.EXPECT().ListEligibleDisks(gomock.Any()).Return([]*models.Disk{}).AnyTimes()
+			mockValidator.EXPECT().DiskIsEligible(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		})
+
+		updateInventory := func() {
+			inventoryStr, err := common.MarshalInventory(inventory)
+			Expect(err).NotTo(HaveOccurred())
+			h := hostutil.GetHostFromDB(hostId, infraEnvId, db)
+			Expect(hapi.UpdateInventory(ctx, &h.Host, inventoryStr)).To(Succeed())
+		}
+
+		revisions := func() []*common.HostHardwareRevision {
+			var ret []*common.HostHardwareRevision
+			Expect(db.Where("host_id = ? and infra_env_id = ?", hostId.String(), infraEnvId.String()).Order("revision ASC").Find(&ret).Error).To(Succeed())
+			return ret
+		}
+
+		It("records a revision only when the hardware changes", func() {
+			host = hostutil.GenerateTestHost(hostId, infraEnvId, clusterId, models.HostStatusDiscovering)
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			updateInventory()
+			updateInventory()
+			Expect(revisions()).To(HaveLen(1))
+			h := hostutil.GetHostFromDB(hostId, infraEnvId, db)
+			Expect(h.HardwareFingerprintHash).To(Equal(swag.StringValue(revisions()[0].FingerprintHash)))
+			Expect(h.HardwareDriftDetectedAt).To(BeNil())
+		})
+
+		It("detects a hardware drift of a validated host and accepts its hardware", func() {
+			host = hostutil.GenerateTestHost(hostId, infraEnvId, clusterId, models.HostStatusDiscovering)
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			updateInventory()
+			Expect(db.Model(&models.Host{}).Where("id = ?", hostId.String()).Update("status", models.HostStatusKnown).Error).To(Succeed())
+
+			mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.HostHardwareDriftDetectedEventName),
+				eventstest.WithHostIdMatcher(hostId.String()),
+				eventstest.WithInfraEnvIdMatcher(infraEnvId.String()),
+				eventstest.WithMessageContainsMatcher("disk serial-a removed, disk serial-b added"))).Times(1)
+			inventory.Disks[0].Serial = "serial-b"
+			updateInventory()
+
+			records := revisions()
+			Expect(records).To(HaveLen(2))
+			Expect(records[0].Drift).To(BeFalse())
+			Expect(records[1].Drift).To(BeTrue())
+			Expect(records[1].HostStatus).To(Equal(models.HostStatusKnown))
+			h := hostutil.GetHostFromDB(hostId, infraEnvId, db)
+			Expect(h.HardwareDriftDetectedAt).NotTo(BeNil())
+
+			Expect(hapi.AcceptHardware(ctx, &h.Host, db)).To(Succeed())
+			h = hostutil.GetHostFromDB(hostId, infraEnvId, db)
+			Expect(h.HardwareDriftDetectedAt).To(BeNil())
+		})
+
+		It("keeps the configured number of revisions", func() {
+			hapi.(*Manager).Config.MaxHardwareRevisions = 2
+			host = hostutil.GenerateTestHost(hostId, infraEnvId, clusterId, models.HostStatusDiscovering)
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			for _, serial := range []string{"serial-a", "serial-b", "serial-c"} {
+				inventory.Disks[0].Serial = serial
+				updateInventory()
+			}
+			records := revisions()
+			Expect(records).To(HaveLen(2))
+			Expect(*records[0].Revision).To(BeEquivalentTo(2))
+			Expect(*records[1].Revision).To(BeEquivalentTo(3))
+		})
+	})
+
 	Context("Test update default installation disk", func() {
 		const (
 			diskName = "FirstDisk"
@@ -2289,6 +2369,74 @@ var _ = Describe("UpdateNTP", func() {
 	}
 })
 
+var _ = Describe("UpdateCustomStepResult", func() {
+	var (
+		ctx                           = context.Background()
+		hapi                          API
+		db                            *gorm.DB
+		ctrl                          *gomock.Controller
+		hostId, clusterId, infraEnvId strfmt.UUID
+		host                          models.Host
+		dbName                        string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		cfg := *defaultConfig
+		Expect(cfg.CustomSteps.Decode(`[{"name": "firmware-probe", "image": "quay.io/example/fw:latest", "states": ["known"],
+			"reply_schema": {"type": "object", "required": ["version"]}}]`)).To(Succeed())
+		dummy := &leader.DummyElector{}
+		hapi = NewManager(common.GetTestLog(), db, testing.GetDummyNotificationStream(ctrl), eventsapi.NewMockHandler(ctrl), nil, nil, createValidatorCfg(), nil, &cfg, dummy, nil, nil, false, nil, nil, false)
+		hostId = strfmt.UUID(uuid.New().String())
+		clusterId = strfmt.UUID(uuid.New().String())
+		infraEnvId = strfmt.UUID(uuid.New().String())
+		host = hostutil.GenerateTestHost(hostId, infraEnvId, clusterId, models.HostStatusKnown)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	getResults := func() []*models.CustomStepResult {
+		h := hostutil.GetHostFromDB(*host.ID, host.InfraEnvID, db)
+		results, err := customsteps.ParseResults(h.CustomStepResults)
+		Expect(err).ToNot(HaveOccurred())
+		return results
+	}
+
+	It("stores a reply that matches the reply schema as succeeded", func() {
+		Expect(hapi.UpdateCustomStepResult(ctx, &host, customsteps.StepID("firmware-probe", "1a2b3c4d"), 0, `{"version": "2.14.1"}`, "")).To(Succeed())
+		results := getResults()
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].Name).To(Equal("firmware-probe"))
+		Expect(results[0].Succeeded).To(BeTrue())
+	})
+
+	It("stores a reply that doesn't match the reply schema as failed", func() {
+		Expect(hapi.UpdateCustomStepResult(ctx, &host, customsteps.StepID("firmware-probe", "1a2b3c4d"), 0, `{}`, "")).To(Succeed())
+		results := getResults()
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].Succeeded).To(BeFalse())
+		Expect(results[0].Error).To(ContainSubstring("the output doesn't match the reply schema"))
+	})
+
+	It("replaces the previous result of the step", func() {
+		Expect(hapi.UpdateCustomStepResult(ctx, &host, customsteps.StepID("firmware-probe", "1a2b3c4d"), 1, "", "probe failed")).To(Succeed())
+		host = hostutil.GetHostFromDB(*host.ID, host.InfraEnvID, db).Host
+		Expect(hapi.UpdateCustomStepResult(ctx, &host, customsteps.StepID("firmware-probe", "5e6f7a8b"), 0, `{"version": "2.14.1"}`, "")).To(Succeed())
+		results := getResults()
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].Succeeded).To(BeTrue())
+	})
+
+	It("fails for a step that isn't defined", func() {
+		Expect(hapi.UpdateCustomStepResult(ctx, &host, customsteps.StepID("bmc-reachability", "1a2b3c4d"), 0, `{}`, "")).ToNot(Succeed())
+	})
+})
+
 var _ = Describe("UpdateFencing", func() {
 	var (
 		ctx                           = context.Background()
@@ -0,0 +1,142 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"github.com/patrickmn/go-cache"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// maxBandwidthCheckPeers is the number of hosts that each host measures the bandwidth to, so that the number of
+// measurements grows linearly with the number of hosts in the cluster
+const maxBandwidthCheckPeers = 3
+
+type bandwidthCheckCmd struct {
+	baseCmd
+	db       *gorm.DB
+	duration time.Duration
+	// lastChecks holds the hosts whose bandwidth was checked in the last interval
+	lastChecks *cache.Cache
+}
+
+func NewBandwidthCheckCmd(log logrus.FieldLogger, db *gorm.DB, duration, interval time.Duration) *bandwidthCheckCmd {
+	return &bandwidthCheckCmd{
+		baseCmd:    baseCmd{log: log},
+		db:         db,
+		duration:   duration,
+		lastChecks: cache.New(interval, interval),
+	}
+}
+
+func (c *bandwidthCheckCmd) GetSteps(ctx context.Context, host *models.Host) ([]*models.Step, error) {
+	if host.ClusterID == nil || hostutil.IsDay2Host(host) {
+		return nil, nil
+	}
+	var cluster common.Cluster
+	if err := c.db.Select("id", "bandwidth_check_enabled").Take(&cluster, "id = ?", host.ClusterID.String()).Error; err != nil {
+		c.log.WithError(err).Errorf("failed to fetch cluster %s", host.ClusterID)
+		return nil, err
+	}
+	if !swag.BoolValue(cluster.BandwidthCheckEnabled) {
+		return nil, nil
+	}
+	var hosts []*models.Host
+	if err := c.db.Select("id", "role", "suggested_role").Find(&hosts, "cluster_id = ?", host.ClusterID.String()).Error; err != nil {
+		c.log.WithError(err).Errorf("failed to get list of hosts for cluster %s", host.ClusterID)
+		return nil, err
+	}
+	peers, err := bandwidthCheckPeers(host, hosts)
+	if err != nil {
+		c.log.WithError(err).Errorf("failed to get the bandwidth check peers of host %s", host.ID)
+		return nil, err
+	}
+	if len(peers) == 0 {
+		return nil, nil
+	}
+	// The measurement loads the network of the hosts, so it is repeated only once in an interval
+	if err = c.lastChecks.Add(common.GetHostKey(host), true, cache.DefaultExpiration); err != nil {
+		return nil, nil
+	}
+	request, err := json.Marshal(&models.BandwidthCheckRequest{
+		Hosts:           peers,
+		DurationSeconds: swag.Int64(int64(c.duration.Seconds())),
+	})
+	if err != nil {
+		c.log.WithError(err).Errorf("failed to marshal the bandwidth check request of host %s", host.ID)
+		return nil, err
+	}
+	step := &models.Step{
+		StepType: models.StepTypeBandwidthCheck,
+		Args: []string{
+			string(request),
+		},
+	}
+	return []*models.Step{step}, nil
+}
+
+// bandwidthCheckPeers returns the hosts that the host measures the bandwidth to: the hosts with the same role that follow
+// the host when the hosts are ordered by their IDs, so that every host is measured by the hosts that precede it. A host
+// that is the only one with its role, e.g. the arbiter or a single worker, measures the bandwidth to the hosts with the
+// other roles instead, so that its bandwidth validation doesn't wait forever. The addresses of the hosts are taken from
+// the successful L3 connectivity checks of the host
+func bandwidthCheckPeers(host *models.Host, hosts []*models.Host) ([]*models.BandwidthCheckHost, error) {
+	if host.Connectivity == "" {
+		return nil, nil
+	}
+	report, err := hostutil.UnmarshalConnectivityReport(host.Connectivity)
+	if err != nil {
+		return nil, err
+	}
+	addresses := make(map[strfmt.UUID]string)
+	for _, rh := range report.RemoteHosts {
+		for _, l3 := range rh.L3Connectivity {
+			if l3.Successful && l3.RemoteIPAddress != "" {
+				addresses[rh.HostID] = l3.RemoteIPAddress
+				break
+			}
+		}
+	}
+
+	role := common.GetEffectiveRole(host)
+	var sameRole []*models.Host
+	for _, h := range hosts {
+		if common.GetEffectiveRole(h) == role {
+			sameRole = append(sameRole, h)
+		}
+	}
+	candidates := sameRole
+	if len(sameRole) == 1 {
+		candidates = append([]*models.Host{}, hosts...)
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].ID.String() < candidates[j].ID.String() })
+	index := -1
+	for i, h := range candidates {
+		if *h.ID == *host.ID {
+			index = i
+		}
+	}
+	if index == -1 {
+		return nil, nil
+	}
+
+	ret := make([]*models.BandwidthCheckHost, 0, maxBandwidthCheckPeers)
+	for i := 1; i < len(candidates) && len(ret) < maxBandwidthCheckPeers; i++ {
+		peer := candidates[(index+i)%len(candidates)]
+		if address, ok := addresses[*peer.ID]; ok {
+			ret = append(ret, &models.BandwidthCheckHost{
+				HostID:    peer.ID,
+				IPAddress: swag.String(address),
+			})
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,171 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+func bandwidthCheckConnectivity(addresses map[strfmt.UUID]string) string {
+	report := models.ConnectivityReport{}
+	for id, address := range addresses {
+		report.RemoteHosts = append(report.RemoteHosts, &models.ConnectivityRemoteHost{
+			HostID: id,
+			L3Connectivity: []*models.L3Connectivity{
+				{RemoteIPAddress: "192.168.0.1", Successful: false},
+				{RemoteIPAddress: address, Successful: true},
+			},
+		})
+	}
+	b, err := json.Marshal(&report)
+	Expect(err).ToNot(HaveOccurred())
+	return string(b)
+}
+
+var _ = Describe("bandwidthcheckcmd", func() {
+	ctx := context.Background()
+	var host, peer models.Host
+	var cluster common.Cluster
+	var db *gorm.DB
+	var bandwidthCheckCmd *bandwidthCheckCmd
+	var id, peerID, clusterID, infraEnvID strfmt.UUID
+	var dbName string
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		bandwidthCheckCmd = NewBandwidthCheckCmd(common.GetTestLog(), db, 5*time.Second, time.Hour)
+
+		id = strfmt.UUID(uuid.New().String())
+		peerID = strfmt.UUID(uuid.New().String())
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		cluster = common.Cluster{Cluster: models.Cluster{ID: &clusterID, BandwidthCheckEnabled: swag.Bool(true)}}
+		Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+		host = hostutil.GenerateTestHostByKind(id, infraEnvID, &clusterID, models.HostStatusKnown, models.HostKindHost, models.HostRoleMaster)
+		host.Connectivity = bandwidthCheckConnectivity(map[strfmt.UUID]string{peerID: "10.0.0.2"})
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		peer = hostutil.GenerateTestHostByKind(peerID, infraEnvID, &clusterID, models.HostStatusKnown, models.HostKindHost, models.HostRoleMaster)
+		Expect(db.Create(&peer).Error).ShouldNot(HaveOccurred())
+	})
+
+	It("get_step", func() {
+		stepReply, stepErr := bandwidthCheckCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(HaveLen(1))
+		Expect(stepReply[0].StepType).To(Equal(models.StepTypeBandwidthCheck))
+		var request models.BandwidthCheckRequest
+		Expect(json.Unmarshal([]byte(stepReply[0].Args[0]), &request)).To(Succeed())
+		Expect(swag.Int64Value(request.DurationSeconds)).To(BeEquivalentTo(5))
+		Expect(request.Hosts).To(Equal([]*models.BandwidthCheckHost{{HostID: &peerID, IPAddress: swag.String("10.0.0.2")}}))
+	})
+
+	It("get_step only once in an interval", func() {
+		stepReply, stepErr := bandwidthCheckCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(HaveLen(1))
+		stepReply, stepErr = bandwidthCheckCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+	})
+
+	It("get_step bandwidth check disabled", func() {
+		Expect(db.Model(&cluster).Update("bandwidth_check_enabled", false).Error).ShouldNot(HaveOccurred())
+		stepReply, stepErr := bandwidthCheckCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+	})
+
+	It("get_step peer with a different role", func() {
+		Expect(db.Model(&peer).Update("role", models.HostRoleWorker).Error).ShouldNot(HaveOccurred())
+		stepReply, stepErr := bandwidthCheckCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+})
+
+var _ = Describe("bandwidthCheckPeers", func() {
+	var hosts []*models.Host
+
+	hostForIndex := func(index int, role models.HostRole) *models.Host {
+		id := strfmt.UUID(fmt.Sprintf("00000000-0000-0000-0000-0000000%05x", index))
+		return &models.Host{ID: &id, Role: role}
+	}
+
+	BeforeEach(func() {
+		hosts = nil
+		for i := 0; i != 6; i++ {
+			hosts = append(hosts, hostForIndex(i, models.HostRoleMaster))
+		}
+		hosts = append(hosts, hostForIndex(6, models.HostRoleWorker))
+		addresses := make(map[strfmt.UUID]string)
+		for i, h := range hosts {
+			addresses[*h.ID] = fmt.Sprintf("10.0.0.%d", i)
+		}
+		for _, h := range hosts {
+			h.Connectivity = bandwidthCheckConnectivity(addresses)
+		}
+	})
+
+	peerIDs := func(peers []*models.BandwidthCheckHost) []strfmt.UUID {
+		var ret []strfmt.UUID
+		for _, p := range peers {
+			ret = append(ret, *p.HostID)
+		}
+		return ret
+	}
+
+	It("returns the following hosts with the same role", func() {
+		peers, err := bandwidthCheckPeers(hosts[4], hosts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(peerIDs(peers)).To(Equal([]strfmt.UUID{*hosts[5].ID, *hosts[0].ID, *hosts[1].ID}))
+		Expect(swag.StringValue(peers[0].IPAddress)).To(Equal("10.0.0.5"))
+	})
+
+	It("skips hosts without a successful connectivity check", func() {
+		hosts[4].Connectivity = bandwidthCheckConnectivity(map[strfmt.UUID]string{*hosts[1].ID: "10.0.0.1"})
+		peers, err := bandwidthCheckPeers(hosts[4], hosts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(peerIDs(peers)).To(Equal([]strfmt.UUID{*hosts[1].ID}))
+	})
+
+	It("returns the hosts with the other roles for the only host with its role", func() {
+		peers, err := bandwidthCheckPeers(hosts[6], hosts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(peerIDs(peers)).To(Equal([]strfmt.UUID{*hosts[0].ID, *hosts[1].ID, *hosts[2].ID}))
+		Expect(swag.StringValue(peers[0].IPAddress)).To(Equal("10.0.0.0"))
+	})
+
+	It("returns the hosts with the other roles for an arbiter", func() {
+		hosts[6].Role = models.HostRoleArbiter
+		peers, err := bandwidthCheckPeers(hosts[6], hosts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(peers).To(HaveLen(maxBandwidthCheckPeers))
+	})
+
+	It("returns no peers for a host without other hosts", func() {
+		peers, err := bandwidthCheckPeers(hosts[6], hosts[6:])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(peers).To(BeEmpty())
+	})
+
+	It("fails with an invalid connectivity report", func() {
+		hosts[0].Connectivity = "not json"
+		_, err := bandwidthCheckPeers(hosts[0], hosts)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,40 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/host/customsteps"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+type customStepCmd struct {
+	baseCmd
+	step *customsteps.Step
+}
+
+func NewCustomStepCmd(log logrus.FieldLogger, step *customsteps.Step) *customStepCmd {
+	return &customStepCmd{
+		baseCmd: baseCmd{log: log},
+		step:    step,
+	}
+}
+
+func (c *customStepCmd) GetSteps(ctx context.Context, host *models.Host) ([]*models.Step, error) {
+	request, err := json.Marshal(c.step.Request())
+	if err != nil {
+		c.log.WithError(err).Warnf("Json marshal custom step %s", c.step.Name)
+		return nil, err
+	}
+	step := &models.Step{
+		StepType: models.StepTypeCustomStep,
+		// The step ID identifies the custom step in the reply
+		StepID: customsteps.StepID(c.step.Name, uuid.New().String()[:8]),
+		Args: []string{
+			string(request),
+		},
+	}
+	return []*models.Step{step}, nil
+}
@@ -0,0 +1,51 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/customsteps"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("custom_step_cmd.GetSteps", func() {
+	var (
+		ctx           = context.Background()
+		host          models.Host
+		customStepCmd *customStepCmd
+	)
+
+	BeforeEach(func() {
+		var steps customsteps.Steps
+		Expect(steps.Decode(`[{"name": "bmc-reachability", "image": "quay.io/example/bmc:latest", "args": ["--timeout", "5"], "timeout_seconds": 30, "states": ["known"]}]`)).To(Succeed())
+		customStepCmd = NewCustomStepCmd(common.GetTestLog(), steps.Get("bmc-reachability"))
+
+		id := strfmt.UUID(uuid.New().String())
+		infraEnvId := strfmt.UUID(uuid.New().String())
+		host = hostutil.GenerateTestHostWithInfraEnv(id, infraEnvId, models.HostStatusKnown, models.HostRoleWorker)
+	})
+
+	It("returns a request with the image, args and timeout of the step", func() {
+		stepReply, stepErr := customStepCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ToNot(HaveOccurred())
+		Expect(stepReply).To(HaveLen(1))
+		Expect(stepReply[0].StepType).To(Equal(models.StepTypeCustomStep))
+		name, err := customsteps.NameFromStepID(stepReply[0].StepID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(name).To(Equal("bmc-reachability"))
+
+		Expect(stepReply[0].Args).To(HaveLen(1))
+		var request models.CustomStepRequest
+		Expect(json.Unmarshal([]byte(stepReply[0].Args[0]), &request)).To(Succeed())
+		Expect(*request.Name).To(Equal("bmc-reachability"))
+		Expect(*request.Image).To(Equal("quay.io/example/bmc:latest"))
+		Expect(request.Args).To(Equal([]string{"--timeout", "5"}))
+		Expect(request.Timeout).To(Equal(int64(30)))
+	})
+})
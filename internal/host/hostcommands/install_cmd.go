@@ -300,6 +300,16 @@ func (i *installCmd) getDisksToFormat(ctx context.Context, host *models.Host, in
 	return unskippedDisksIdentifiers
 }
 
+// HostInstallerArgs returns the coreos-installer arguments that the install command of the host will pass, encoded
+// as a JSON list
+func HostInstallerArgs(cluster *common.Cluster, host *models.Host, infraEnv *common.InfraEnv, log logrus.FieldLogger) (string, error) {
+	inventory, err := common.UnmarshalInventory(host.Inventory)
+	if err != nil {
+		return "", err
+	}
+	return constructHostInstallerArgs(cluster, host, inventory, infraEnv, log)
+}
+
 /*
 This function combines existing InstallerArgs ( set by user for his own reasons ) with the
 --copy-network argument needed by the static ips configuration. In case user has also
@@ -20,6 +20,7 @@ import (
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/events/eventstest"
 	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/host/customsteps"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/oc"
 	"github.com/openshift/assisted-service/internal/stream"
@@ -585,6 +586,26 @@ func checkStepsByState(state string, host *models.Host, db *gorm.DB, mockEvents
 	ExpectWithOffset(1, stepsErr).ShouldNot(HaveOccurred())
 }
 
+var _ = Describe("custom steps", func() {
+	It("adds the custom steps to the steps of the host states they run in", func() {
+		var steps customsteps.Steps
+		Expect(steps.Decode(`[{"name": "bmc-reachability", "image": "quay.io/example/bmc:latest", "states": ["known", "known-unbound"]}]`)).To(Succeed())
+		instMng := NewInstructionManager(common.GetTestLog(), nil, nil, nil, InstructionConfig{CustomSteps: steps}, nil, nil, nil, nil, false)
+		hasCustomStep := func(stateToSteps stateToStepsMap, state string) bool {
+			for _, cmd := range stateToSteps[state].Commands {
+				if _, ok := cmd.(*customStepCmd); ok {
+					return true
+				}
+			}
+			return false
+		}
+		Expect(hasCustomStep(instMng.installingClusterStateToSteps, models.HostStatusKnown)).To(BeTrue())
+		Expect(hasCustomStep(instMng.addHostsClusterToSteps, models.HostStatusKnown)).To(BeTrue())
+		Expect(hasCustomStep(instMng.poolHostToSteps, models.HostStatusKnownUnbound)).To(BeTrue())
+		Expect(hasCustomStep(instMng.installingClusterStateToSteps, models.HostStatusInsufficient)).To(BeFalse())
+	})
+})
+
 func TestHostCommands(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Host commands test Suite")
@@ -85,18 +85,20 @@ func UpdateHostStageTimeout(ctx context.Context, log logrus.FieldLogger, db *gor
 	return host, nil
 }
 
-func UpdateHostAndNotify(ctx context.Context, log logrus.FieldLogger, db *gorm.DB, stream stream.Notifier, infraEnvId strfmt.UUID,
+func UpdateHostAndNotify(ctx context.Context, log logrus.FieldLogger, db *gorm.DB, notificationStream stream.Notifier, infraEnvId strfmt.UUID,
 	hostId strfmt.UUID, srcStatus string, extra ...interface{}) (*common.Host, error) {
-	host, err := UpdateHost(log, db, infraEnvId, hostId, srcStatus, extra...)
+	var host *common.Host
+	err := notificationStream.NotifyInTransaction(ctx, db, func(tx *gorm.DB, notify stream.NotifyFunc) error {
+		var err error
+		if host, err = UpdateHost(log, tx, infraEnvId, hostId, srcStatus, extra...); err != nil {
+			return err
+		}
+		return notify(host)
+	})
 	if err != nil {
 		return nil, err
 	}
-	err = stream.Notify(ctx, host)
-	if err != nil {
-		log.WithError(err).Warning("failed to notify host update event")
-	}
 	return host, nil
-
 }
 
 func UpdateHost(_ logrus.FieldLogger, db *gorm.DB, infraEnvId strfmt.UUID, hostId strfmt.UUID,
@@ -40,6 +40,20 @@ func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
 	return m.recorder
 }
 
+// AcceptHardware mocks base method.
+func (m *MockAPI) AcceptHardware(arg0 context.Context, arg1 *models.Host, arg2 *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptHardware", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptHardware indicates an expected call of AcceptHardware.
+func (mr *MockAPIMockRecorder) AcceptHardware(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptHardware", reflect.TypeOf((*MockAPI)(nil).AcceptHardware), arg0, arg1, arg2)
+}
+
 // AutoAssignRole mocks base method.
 func (m *MockAPI) AutoAssignRole(arg0 context.Context, arg1 *models.Host, arg2 *gorm.DB) (bool, error) {
 	m.ctrl.T.Helper()
@@ -551,6 +565,20 @@ func (mr *MockAPIMockRecorder) UpdateApiVipConnectivityReport(arg0, arg1, arg2 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApiVipConnectivityReport", reflect.TypeOf((*MockAPI)(nil).UpdateApiVipConnectivityReport), arg0, arg1, arg2)
 }
 
+// UpdateBandwidthReport mocks base method.
+func (m *MockAPI) UpdateBandwidthReport(arg0 context.Context, arg1 *models.Host, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBandwidthReport", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBandwidthReport indicates an expected call of UpdateBandwidthReport.
+func (mr *MockAPIMockRecorder) UpdateBandwidthReport(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBandwidthReport", reflect.TypeOf((*MockAPI)(nil).UpdateBandwidthReport), arg0, arg1, arg2)
+}
+
 // UpdateConnectivityReport mocks base method.
 func (m *MockAPI) UpdateConnectivityReport(arg0 context.Context, arg1 *models.Host, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -565,6 +593,20 @@ func (mr *MockAPIMockRecorder) UpdateConnectivityReport(arg0, arg1, arg2 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConnectivityReport", reflect.TypeOf((*MockAPI)(nil).UpdateConnectivityReport), arg0, arg1, arg2)
 }
 
+// UpdateCustomStepResult mocks base method.
+func (m *MockAPI) UpdateCustomStepResult(arg0 context.Context, arg1 *models.Host, arg2 string, arg3 int64, arg4, arg5 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomStepResult", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCustomStepResult indicates an expected call of UpdateCustomStepResult.
+func (mr *MockAPIMockRecorder) UpdateCustomStepResult(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomStepResult", reflect.TypeOf((*MockAPI)(nil).UpdateCustomStepResult), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // UpdateDomainNameResolution mocks base method.
 func (m *MockAPI) UpdateDomainNameResolution(arg0 context.Context, arg1 *models.Host, arg2 models.DomainResolutionResponse, arg3 *gorm.DB) error {
 	m.ctrl.T.Helper()
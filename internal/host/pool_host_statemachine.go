@@ -108,7 +108,7 @@ func NewPoolHostStateMachine(sm stateswitch.StateMachine, th TransitionHandler)
 	})
 
 	var hasMinRequiredHardware = stateswitch.And(If(HasMinValidDisks), If(HasMinCPUCores), If(HasMinMemory))
-	sufficientToBeBound := stateswitch.And(hasMinRequiredHardware, If(IsHostnameValid))
+	sufficientToBeBound := stateswitch.And(hasMinRequiredHardware, If(IsHostnameValid), If(FirmwarePolicySatisfied))
 
 	sm.AddTransitionRule(stateswitch.TransitionRule{
 		TransitionType: TransitionTypeRefresh,
@@ -9,9 +9,11 @@ import (
 
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/host/customsteps"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/operators/api"
 	"github.com/openshift/assisted-service/internal/provider/registry"
+	"github.com/openshift/assisted-service/internal/uservalidations"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
@@ -39,11 +41,12 @@ type refreshPreprocessor struct {
 	conditions              []condition
 	operatorsApi            operators.API
 	disabledHostValidations DisabledHostValidations
+	userValidations         uservalidations.Validations
 }
 
 func newRefreshPreprocessor(log logrus.FieldLogger, hwValidatorCfg *hardware.ValidatorCfg, hwValidator hardware.Validator,
 	operatorsApi operators.API, disabledHostValidations DisabledHostValidations, providerRegistry registry.ProviderRegistry,
-	versionHandler versions.Handler) *refreshPreprocessor {
+	versionHandler versions.Handler, customSteps customsteps.Steps, userValidations uservalidations.Validations) *refreshPreprocessor {
 	v := &validator{
 		log:              log,
 		hwValidatorCfg:   hwValidatorCfg,
@@ -51,6 +54,7 @@ func newRefreshPreprocessor(log logrus.FieldLogger, hwValidatorCfg *hardware.Val
 		operatorsAPI:     operatorsApi,
 		providerRegistry: providerRegistry,
 		versionHandler:   versionHandler,
+		customSteps:      customSteps,
 	}
 	return &refreshPreprocessor{
 		log:                     log,
@@ -58,6 +62,7 @@ func newRefreshPreprocessor(log logrus.FieldLogger, hwValidatorCfg *hardware.Val
 		conditions:              newConditions(v),
 		operatorsApi:            operatorsApi,
 		disabledHostValidations: disabledHostValidations,
+		userValidations:         userValidations.ForTarget(uservalidations.TargetHost),
 	}
 }
 
@@ -141,6 +146,15 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *validationConte
 			sortByValidationResultID(validationsOutput[category])
 		}
 	}
+
+	if userResults := r.evaluateUserValidations(c); len(userResults) > 0 {
+		for _, result := range userResults {
+			conditions[result.ID.String()] = result.Status == ValidationSuccess
+		}
+		sortByValidationResultID(userResults)
+		validationsOutput[uservalidations.Category] = userResults
+	}
+
 	for _, currentResult := range validationsOutput {
 		for _, v := range currentResult {
 			if common.ShouldIgnoreValidation(ignoredValidations, string(v.ID), common.NonIgnorableHostValidations) {
@@ -149,9 +163,46 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *validationConte
 			}
 		}
 	}
+	// The state machine checks the user-defined validations together, after the ignored ones were forced to pass
+	userValidationsSucceeded := true
+	for _, v := range r.userValidations {
+		userValidationsSucceeded = userValidationsSucceeded && conditions[v.ID]
+	}
+	conditions[UserDefinedValidationsSucceeded.String()] = userValidationsSucceeded
 	return conditions, validationsOutput, nil
 }
 
+// evaluateUserValidations evaluates the validations that are defined by the administrator of the service
+func (r *refreshPreprocessor) evaluateUserValidations(c *validationContext) []ValidationResult {
+	if len(r.userValidations) == 0 {
+		return nil
+	}
+	input, inputErr := uservalidations.NewHostInput(c.host, c.cluster)
+	results := make([]ValidationResult, 0, len(r.userValidations))
+	for _, v := range r.userValidations {
+		result := ValidationResult{ID: validationID(v.ID)}
+		switch {
+		case inputErr != nil:
+			result.Status, result.Message = ValidationError, "Failed to parse the inventory of the host"
+		case input.Inventory == nil:
+			result.Status, result.Message = ValidationPending, "Missing inventory"
+		default:
+			passed, err := v.Evaluate(input)
+			switch {
+			case err != nil:
+				r.log.WithError(err).Warnf("Failed to evaluate user-defined validation %s for host %s", v.ID, c.host.ID.String())
+				result.Status, result.Message = ValidationError, fmt.Sprintf("Failed to evaluate the %s validation", v.ID)
+			case passed:
+				result.Status, result.Message = ValidationSuccess, v.SuccessMessage
+			default:
+				result.Status, result.Message = ValidationFailure, v.FailureMessage
+			}
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
 // sortByValidationResultID sorts results by models.HostValidationID
 func sortByValidationResultID(validationResults []ValidationResult) {
 	sort.SliceStable(validationResults, func(i, j int) bool {
@@ -251,6 +302,9 @@ func newValidations(v *validator) []validation {
 		}, {
 			id:        HasSufficientPacketLossRequirementForRole,
 			condition: v.hasSufficientPacketLossRequirementForRole,
+		}, {
+			id:        HasSufficientNetworkBandwidthRequirementForRole,
+			condition: v.hasSufficientNetworkBandwidthRequirementForRole,
 		},
 		{
 			id:        HasDefaultRoute,
@@ -318,6 +372,18 @@ func newValidations(v *validator) []validation {
 			id:        NoIscsiNicBelongsToMachineCidr,
 			condition: v.noIscsiNicBelongsToMachineCidr,
 		},
+		{
+			id:        CustomStepsSucceeded,
+			condition: v.customStepsSucceeded,
+		},
+		{
+			id:        FirmwarePolicySatisfied,
+			condition: v.firmwarePolicySatisfied,
+		},
+		{
+			id:        AreDisksHealthy,
+			condition: v.areDisksHealthy,
+		},
 	}
 }
 
@@ -2,6 +2,7 @@ package host
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -14,6 +15,7 @@ import (
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/provider/registry"
+	"github.com/openshift/assisted-service/internal/uservalidations"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
@@ -77,6 +79,8 @@ var _ = Describe("Cluster Refresh Status Preprocessor", func() {
 			disabledHostValidations,
 			mockProviderRegistry,
 			mockVersions,
+			nil,
+			nil,
 		)
 	})
 
@@ -212,4 +216,64 @@ var _ = Describe("Cluster Refresh Status Preprocessor", func() {
 			}
 		})
 	})
+
+	Context("User-defined validations", func() {
+		var validationContext *validationContext
+
+		setDisks := func(sizes ...int64) {
+			inventory := models.Inventory{}
+			for _, size := range sizes {
+				inventory.Disks = append(inventory.Disks, &models.Disk{SizeBytes: size})
+			}
+			b, err := json.Marshal(&inventory)
+			Expect(err).ToNot(HaveOccurred())
+			host.Inventory = string(b)
+			validationContext, err = newValidationContext(ctx, host, cluster, infraEnv, db, inventoryCache, mockHardwareValidator, false, mockS3WrapperAPI, false)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			var userValidations uservalidations.Validations
+			Expect(userValidations.Decode(`[{"id": "no-small-disks", "target": "host", "expression": "all(.inventory.disks[]; .size_bytes >= 500000000000)",
+				"success_message": "All disks are at least 500GB", "failure_message": "Disks must be at least 500GB"},
+				{"id": "same-vendor", "target": "cluster", "expression": "true"}]`)).To(Succeed())
+			Expect(userValidations.Compile(logrus.New())).To(Succeed())
+			preprocessor.userValidations = userValidations.ForTarget(uservalidations.TargetHost)
+			createCluster()
+			mockFailAllValidations()
+		})
+
+		AfterEach(func() {
+			deleteCluster()
+		})
+
+		It("reports the validations in their own category", func() {
+			setDisks(600000000000, 500000000000)
+			conditions, validations, err := preprocessor.preprocess(ctx, validationContext)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(validations[uservalidations.Category]).To(Equal(ValidationResults{
+				{ID: "no-small-disks", Status: ValidationSuccess, Message: "All disks are at least 500GB"},
+			}))
+			Expect(conditions["no-small-disks"]).To(BeTrue())
+			Expect(conditions[UserDefinedValidationsSucceeded.String()]).To(BeTrue())
+		})
+
+		It("fails when the expression is false", func() {
+			setDisks(600000000000, 100000000000)
+			conditions, validations, err := preprocessor.preprocess(ctx, validationContext)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(validations[uservalidations.Category]).To(Equal(ValidationResults{
+				{ID: "no-small-disks", Status: ValidationFailure, Message: "Disks must be at least 500GB"},
+			}))
+			Expect(conditions[UserDefinedValidationsSucceeded.String()]).To(BeFalse())
+		})
+
+		It("passes when the failing validation is ignored", func() {
+			setDisks(100000000000)
+			validationContext.cluster.IgnoredHostValidations = `["no-small-disks"]`
+			conditions, _, err := preprocessor.preprocess(ctx, validationContext)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conditions[UserDefinedValidationsSucceeded.String()]).To(BeTrue())
+		})
+	})
 })
@@ -693,7 +693,7 @@ func NewHostStateMachine(sm stateswitch.StateMachine, th TransitionHandler) stat
 		},
 		Condition:        stateswitch.And(If(ClusterInError), stateswitch.Not(th.IsDay2Host)),
 		DestinationState: stateswitch.State(models.HostStatusError),
-		PostTransition:   th.PostRefreshHost(statusInfoAbortingDueClusterErrors),
+		PostTransition:   th.PostRefreshHost(StatusInfoAbortingDueClusterErrors),
 		Documentation: stateswitch.TransitionRuleDoc{
 			Name:        "Move host to error when cluster is in error",
 			Description: "TODO: Document this transition rule. Why not day 2?",
@@ -985,6 +985,7 @@ func NewHostStateMachine(sm stateswitch.StateMachine, th TransitionHandler) stat
 		If(AreOscRequirementsSatisfied),
 		If(HasSufficientNetworkLatencyRequirementForRole),
 		If(HasSufficientPacketLossRequirementForRole),
+		If(HasSufficientNetworkBandwidthRequirementForRole),
 		If(HasDefaultRoute),
 		If(IsAPIDomainNameResolvedCorrectly),
 		If(IsAPIInternalDomainNameResolvedCorrectly),
@@ -999,6 +1000,10 @@ func NewHostStateMachine(sm stateswitch.StateMachine, th TransitionHandler) stat
 		If(NoSkipMissingDisk),
 		If(NoIPCollisionsInNetwork),
 		If(NoIscsiNicBelongsToMachineCidr),
+		If(CustomStepsSucceeded),
+		If(FirmwarePolicySatisfied),
+		If(AreDisksHealthy),
+		If(UserDefinedValidationsSucceeded),
 		If(AreNodeFeatureDiscoveryRequirementsSatisfied),
 		If(AreNvidiaGPURequirementsSatisfied),
 		If(ArePipelinesRequirementsSatisfied),
@@ -5403,7 +5403,7 @@ var _ = Describe("Refresh Host", func() {
 
 					var resultHost models.Host
 					Expect(db.Take(&resultHost, "id = ? and cluster_id = ?", hostId.String(), clusterId.String()).Error).ToNot(HaveOccurred())
-					Expect(swag.StringValue(resultHost.StatusInfo)).Should(Equal(statusInfoAbortingDueClusterErrors))
+					Expect(swag.StringValue(resultHost.StatusInfo)).Should(Equal(StatusInfoAbortingDueClusterErrors))
 				})
 			}
 		}
@@ -6640,6 +6640,7 @@ var allValidationIDs = []validationID{
 	SufficientOrUnknownInstallationDiskSpeed,
 	HasSufficientNetworkLatencyRequirementForRole,
 	HasSufficientPacketLossRequirementForRole,
+	HasSufficientNetworkBandwidthRequirementForRole,
 	HasDefaultRoute,
 	IsAPIDomainNameResolvedCorrectly,
 	IsAPIInternalDomainNameResolvedCorrectly,
@@ -6676,6 +6677,9 @@ var allValidationIDs = []validationID{
 	AreMetalLBRequirementsSatisfied,
 	AreLokiRequirementsSatisfied,
 	AreOpenShiftLoggingRequirementsSatisfied,
+	CustomStepsSucceeded,
+	FirmwarePolicySatisfied,
+	AreDisksHealthy,
 }
 
 var allConditions = []conditionId{
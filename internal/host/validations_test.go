@@ -22,6 +22,7 @@ import (
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/events/eventstest"
 	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/host/customsteps"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/network"
@@ -2255,6 +2256,270 @@ var _ = Describe("Validations test", func() {
 		})
 	})
 
+	Context("Custom steps succeeded", func() {
+		var hostValidator validator
+
+		BeforeEach(func() {
+			var steps customsteps.Steps
+			Expect(steps.Decode(`[{"name": "firmware-probe", "image": "quay.io/example/fw:latest", "states": ["known"], "required": true},
+				{"name": "raid-inventory", "image": "quay.io/example/raid:latest", "states": ["known"]}]`)).To(Succeed())
+			hostValidator = validator{customSteps: steps}
+		})
+
+		validate := func(results string) (ValidationStatus, string) {
+			return hostValidator.customStepsSucceeded(&validationContext{host: &models.Host{CustomStepResults: results}})
+		}
+
+		It("suppresses the output when no custom step is required", func() {
+			hostValidator = validator{}
+			status, message := validate("")
+			Expect(status).To(Equal(ValidationSuccessSuppressOutput))
+			Expect(message).To(BeEmpty())
+		})
+
+		It("is pending until the required steps have a result", func() {
+			status, message := validate(`[{"name": "raid-inventory", "succeeded": true}]`)
+			Expect(status).To(Equal(ValidationPending))
+			Expect(message).To(Equal("Waiting for the results of custom steps: firmware-probe"))
+		})
+
+		It("fails when a required step failed", func() {
+			status, message := validate(`[{"name": "firmware-probe", "exit_code": 2, "succeeded": false}]`)
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(Equal("Custom steps failed: firmware-probe (exit code 2)"))
+		})
+
+		It("succeeds when the required steps succeeded", func() {
+			status, message := validate(`[{"name": "firmware-probe", "succeeded": true}, {"name": "raid-inventory", "exit_code": 1}]`)
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("All custom steps succeeded"))
+		})
+	})
+
+	Context("Firmware policy satisfied", func() {
+		const policy = `{"boot_mode": "uefi", "secure_boot_required": true, "min_bios_versions": [{"manufacturer": "Red Hat", "version": "1.16"}]}`
+
+		var inventory *models.Inventory
+
+		BeforeEach(func() {
+			inventory = &models.Inventory{
+				Boot:         &models.Boot{CurrentBootMode: "uefi", SecureBootState: models.SecureBootStateEnabled},
+				SystemVendor: &models.SystemVendor{Manufacturer: "Red Hat"},
+				Bios:         &models.Bios{Version: "1.16.3"},
+			}
+		})
+
+		validate := func(c *validationContext) (ValidationStatus, string) {
+			return (&validator{}).firmwarePolicySatisfied(c)
+		}
+
+		It("suppresses the output when there is no policy", func() {
+			status, message := validate(&validationContext{cluster: &common.Cluster{}, inventory: inventory})
+			Expect(status).To(Equal(ValidationSuccessSuppressOutput))
+			Expect(message).To(BeEmpty())
+		})
+
+		It("is pending until the host has an inventory", func() {
+			status, _ := validate(&validationContext{cluster: &common.Cluster{Cluster: models.Cluster{FirmwarePolicy: swag.String(policy)}}})
+			Expect(status).To(Equal(ValidationPending))
+		})
+
+		It("succeeds when the host complies with the policy of its cluster", func() {
+			status, message := validate(&validationContext{cluster: &common.Cluster{Cluster: models.Cluster{FirmwarePolicy: swag.String(policy)}}, inventory: inventory})
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("The firmware of the host complies with the firmware policy"))
+		})
+
+		It("succeeds with a message when the agent of the host doesn't report the BIOS version", func() {
+			inventory.Bios = nil
+			status, message := validate(&validationContext{cluster: &common.Cluster{Cluster: models.Cluster{FirmwarePolicy: swag.String(policy)}}, inventory: inventory})
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("The firmware of the host complies with the firmware policy, except for settings that can't be checked: " +
+				"The BIOS version of the host is not reported, so the minimum version 1.16 for Red Hat hosts is not checked"))
+		})
+
+		It("fails with a message per setting when an unbound host doesn't comply with the policy of its infra-env", func() {
+			inventory.Boot.SecureBootState = models.SecureBootStateDisabled
+			inventory.Bios.Version = "1.15.0"
+			status, message := validate(&validationContext{infraEnv: &common.InfraEnv{InfraEnv: models.InfraEnv{FirmwarePolicy: swag.String(policy)}}, inventory: inventory})
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(Equal("The firmware of the host doesn't comply with the firmware policy: " +
+				"Secure Boot must be enabled, but its state is disabled; " +
+				"The BIOS version 1.15.0 of the host is older than the version 1.16 required for Red Hat hosts"))
+		})
+	})
+
+	Context("Has sufficient network bandwidth requirements for role", func() {
+		var (
+			c                    *validationContext
+			hostID, peer1, peer2 strfmt.UUID
+		)
+
+		bandwidthReport := func(remoteHosts ...*models.BandwidthCheckRemoteHost) string {
+			b, err := json.Marshal(&models.BandwidthCheckResponse{RemoteHosts: remoteHosts})
+			Expect(err).ToNot(HaveOccurred())
+			return string(b)
+		}
+
+		BeforeEach(func() {
+			hostID, peer1, peer2 = strfmt.UUID(uuid.New().String()), strfmt.UUID(uuid.New().String()), strfmt.UUID(uuid.New().String())
+			host := &models.Host{ID: &hostID, ClusterID: &clusterID, Role: models.HostRoleMaster, Inventory: hostutil.GenerateMasterInventoryWithHostname("master-0")}
+			c = &validationContext{
+				host: host,
+				cluster: &common.Cluster{Cluster: models.Cluster{
+					ID:                    &clusterID,
+					BandwidthCheckEnabled: swag.Bool(true),
+					Hosts: []*models.Host{
+						host,
+						{ID: &peer1, ClusterID: &clusterID, Role: models.HostRoleMaster, Inventory: hostutil.GenerateMasterInventoryWithHostname("master-1")},
+						{ID: &peer2, ClusterID: &clusterID, Role: models.HostRoleMaster, Inventory: hostutil.GenerateMasterInventoryWithHostname("master-2")},
+					},
+				}},
+				inventory:               &models.Inventory{},
+				inventoryCache:          make(InventoryCache),
+				clusterHostRequirements: &models.ClusterHostRequirements{Total: &models.ClusterHostRequirementsDetails{NetworkBandwidthThresholdMbps: swag.Float64(1000)}},
+			}
+		})
+
+		validate := func(c *validationContext) (ValidationStatus, string) {
+			return (&validator{log: common.GetTestLog()}).hasSufficientNetworkBandwidthRequirementForRole(c)
+		}
+
+		It("suppresses the output when the bandwidth check is disabled", func() {
+			c.cluster.BandwidthCheckEnabled = swag.Bool(false)
+			status, message := validate(c)
+			Expect(status).To(Equal(ValidationSuccessSuppressOutput))
+			Expect(message).To(BeEmpty())
+		})
+
+		It("succeeds when no threshold is defined for the role", func() {
+			c.clusterHostRequirements.Total.NetworkBandwidthThresholdMbps = nil
+			status, message := validate(c)
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("Network bandwidth requirement has been satisfied."))
+		})
+
+		It("is pending until the bandwidth was measured", func() {
+			status, message := validate(c)
+			Expect(status).To(Equal(ValidationPending))
+			Expect(message).To(Equal("Missing network bandwidth information."))
+		})
+
+		It("succeeds when the bandwidth to all the hosts is above the threshold", func() {
+			c.host.Bandwidth = bandwidthReport(
+				&models.BandwidthCheckRemoteHost{HostID: peer1, Successful: true, ThroughputMbps: 9400},
+				&models.BandwidthCheckRemoteHost{HostID: peer2, Successful: true, ThroughputMbps: 1000},
+			)
+			status, message := validate(c)
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("Network bandwidth requirement has been satisfied."))
+		})
+
+		It("fails with the hosts below the threshold and the hosts that weren't measured", func() {
+			c.host.Bandwidth = bandwidthReport(
+				&models.BandwidthCheckRemoteHost{HostID: peer1, Successful: true, ThroughputMbps: 940.5},
+				&models.BandwidthCheckRemoteHost{HostID: peer2, Successful: false, Error: "connection refused"},
+				&models.BandwidthCheckRemoteHost{HostID: strfmt.UUID(uuid.New().String()), Successful: false},
+			)
+			status, message := validate(c)
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(Equal(fmt.Sprintf("A network bandwidth below the required threshold of 1000.00 Mbps was measured between host %s and master-1 (940.50 Mbps). "+
+				"The network bandwidth between host %s and master-2 could not be measured.", hostID, hostID)))
+		})
+
+		It("fails to parse an invalid bandwidth report", func() {
+			c.host.Bandwidth = "not json"
+			status, _ := validate(c)
+			Expect(status).To(Equal(ValidationError))
+		})
+	})
+
+	Context("Disks healthy", func() {
+		var (
+			c                *validationContext
+			installationDisk *models.Disk
+			storageDisk      *models.Disk
+		)
+
+		BeforeEach(func() {
+			installationDisk = &models.Disk{ID: "/dev/disk/by-id/sda", Name: "sda", Health: &models.DiskHealth{SmartPassed: swag.Bool(true), TemperatureCelsius: swag.Int64(35)}}
+			storageDisk = &models.Disk{ID: "/dev/disk/by-id/sdb", Name: "sdb", DriveType: models.DriveTypeSSD, SizeBytes: conversions.GibToBytes(100),
+				Health: &models.DiskHealth{SmartPassed: swag.Bool(true), MediaErrors: swag.Int64(3)}}
+			c = &validationContext{
+				host:      &models.Host{InstallationDiskID: installationDisk.ID},
+				cluster:   &common.Cluster{},
+				inventory: &models.Inventory{Disks: []*models.Disk{installationDisk, storageDisk}},
+			}
+		})
+
+		validate := func(c *validationContext) (ValidationStatus, string) {
+			return (&validator{hwValidatorCfg: &hardware.ValidatorCfg{
+				DiskHealthBlocking:                   true,
+				DiskHealthMaxReallocatedSectors:      10,
+				DiskHealthWearLevelWarningPercentage: 80,
+				DiskHealthMaxWearLevelPercentage:     100,
+				DiskHealthTemperatureWarningCelsius:  60,
+				DiskHealthMaxTemperatureCelsius:      70,
+			}}).areDisksHealthy(c)
+		}
+
+		It("is pending until the host has an inventory", func() {
+			c.inventory = nil
+			status, _ := validate(c)
+			Expect(status).To(Equal(ValidationPending))
+		})
+
+		It("succeeds when the installation disk is healthy and the storage operators are disabled", func() {
+			status, message := validate(c)
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("Disks are healthy"))
+		})
+
+		It("succeeds when the health of the disks wasn't reported", func() {
+			installationDisk.Health = nil
+			status, message := validate(c)
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("Health of disks has not been reported"))
+		})
+
+		It("warns about a degraded installation disk", func() {
+			installationDisk.Health.TemperatureCelsius = swag.Int64(65)
+			status, message := validate(c)
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("Disks are degraded but can be used: sda (a temperature of 65°C, above the warning threshold of 60°C)"))
+		})
+
+		It("fails when a disk of the storage operators is failing", func() {
+			c.cluster.MonitoredOperators = []*models.MonitoredOperator{{Name: "lvm"}}
+			status, message := validate(c)
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(Equal("Disks are failing: sdb (3 media errors, above the maximum of 0)"))
+		})
+
+		It("fails when the installation disk failed its SMART self-assessment", func() {
+			installationDisk.Health.SmartPassed = swag.Bool(false)
+			status, message := validate(c)
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(Equal("Disks are failing: sda (the SMART self-assessment failed)"))
+		})
+
+		It("ignores the disks that the storage operators don't use", func() {
+			c.cluster.MonitoredOperators = []*models.MonitoredOperator{{Name: "lvm"}}
+			storageDisk.DriveType = models.DriveTypeODD
+			storageDisk.InstallationEligibility = models.DiskInstallationEligibility{Eligible: true}
+			status, message := validate(c)
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("Disks are healthy"))
+		})
+
+		It("reports failing disks without failing when the validation is not blocking", func() {
+			installationDisk.Health.SmartPassed = swag.Bool(false)
+			status, message := (&validator{hwValidatorCfg: &hardware.ValidatorCfg{}}).areDisksHealthy(c)
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("Disks are failing, which doesn't block the installation: sda (the SMART self-assessment failed)"))
+		})
+	})
+
 	Context("Has Min Valid Disks", func() {
 		var (
 			host    models.Host
@@ -18,9 +18,13 @@ import (
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/constants"
 	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/host/customsteps"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/internal/operators/lvm"
+	"github.com/openshift/assisted-service/internal/operators/odf"
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
@@ -294,6 +298,7 @@ type validator struct {
 	operatorsAPI     operators.API
 	providerRegistry registry.ProviderRegistry
 	versionHandler   versions.Handler
+	customSteps      customsteps.Steps
 }
 
 func (v *validator) isMediaConnected(c *validationContext) (ValidationStatus, string) {
@@ -1223,6 +1228,57 @@ func (v *validator) hasSufficientNetworkLatencyRequirementForRole(c *validationC
 	return ValidationSuccess, "Network latency requirement has been satisfied."
 }
 
+func (v *validator) hasSufficientNetworkBandwidthRequirementForRole(c *validationContext) (ValidationStatus, string) {
+	if c.inventory == nil {
+		return ValidationPending, "The inventory is not available yet."
+	}
+	if c.infraEnv != nil || !swag.BoolValue(c.cluster.BandwidthCheckEnabled) {
+		return ValidationSuccessSuppressOutput, ""
+	}
+	if len(c.cluster.Hosts) == 1 || c.clusterHostRequirements.Total.NetworkBandwidthThresholdMbps == nil || common.GetEffectiveRole(c.host) == models.HostRoleAutoAssign || hostutil.IsDay2Host(c.host) {
+		// Single Node use case || no requirements defined || role is auto assign
+		return ValidationSuccess, "Network bandwidth requirement has been satisfied."
+	}
+	if len(c.host.Bandwidth) == 0 {
+		return ValidationPending, "Missing network bandwidth information."
+	}
+	var report models.BandwidthCheckResponse
+	if err := json.Unmarshal([]byte(c.host.Bandwidth), &report); err != nil {
+		v.log.WithError(err).Errorf("Unable to unmarshal host bandwidth for %s", c.host.ID)
+		return ValidationError, "Parse error while attempting to process the bandwidth report"
+	}
+	threshold := *c.clusterHostRequirements.Total.NetworkBandwidthThresholdMbps
+	var belowThreshold []hostTimingMetric
+	var unmeasured []string
+	for _, r := range report.RemoteHosts {
+		// Hosts that were removed from the cluster since the measurement are ignored
+		if FindHostByID(r.HostID, c.cluster.Hosts) == nil {
+			continue
+		}
+		hostname, _, err := GetHostnameAndEffectiveRoleByHostID(r.HostID, c.cluster.Hosts, c.inventoryCache)
+		if err != nil || hostname == "" {
+			hostname = r.HostID.String()
+		}
+		if !r.Successful {
+			unmeasured = append(unmeasured, hostname)
+		} else if r.ThroughputMbps < threshold {
+			belowThreshold = append(belowThreshold, hostTimingMetric{otherHostName: hostname, timingMetric: r.ThroughputMbps, timingSuffix: " Mbps"})
+		}
+	}
+	if len(belowThreshold) == 0 && len(unmeasured) == 0 {
+		return ValidationSuccess, "Network bandwidth requirement has been satisfied."
+	}
+	var messages []string
+	if len(belowThreshold) > 0 {
+		messages = append(messages, fmt.Sprintf("A network bandwidth below the required threshold of %.2f Mbps was measured between host %s and %s.",
+			threshold, c.host.ID, v.summarizeHostTimingMetrics(belowThreshold, true)))
+	}
+	if len(unmeasured) > 0 {
+		messages = append(messages, fmt.Sprintf("The network bandwidth between host %s and %s could not be measured.", c.host.ID, strings.Join(unmeasured, ", ")))
+	}
+	return ValidationFailure, strings.Join(messages, " ")
+}
+
 func (v *validator) generateExcessiveLatencyAdvisoryForHost(c *validationContext) string {
 	var message string
 	inventory, err := c.inventoryCache.GetOrUnmarshal(c.host)
@@ -2008,3 +2064,102 @@ func (v *validator) multipathiSCSI(c *validationContext, installationDisk *model
 	}
 	return ValidationSuccess, msg
 }
+
+func (v *validator) customStepsSucceeded(c *validationContext) (ValidationStatus, string) {
+	required := v.customSteps.Required()
+	if len(required) == 0 {
+		return ValidationSuccessSuppressOutput, ""
+	}
+	results, err := customsteps.ParseResults(c.host.CustomStepResults)
+	if err != nil {
+		return ValidationError, "Failed to parse the results of the custom steps"
+	}
+	var pending, failed []string
+	for _, step := range required {
+		result, found := funk.Find(results, func(result *models.CustomStepResult) bool {
+			return result.Name == step.Name
+		}).(*models.CustomStepResult)
+		switch {
+		case !found:
+			pending = append(pending, step.Name)
+		case !result.Succeeded:
+			reason := strings.TrimSpace(result.Error)
+			if reason == "" {
+				reason = fmt.Sprintf("exit code %d", result.ExitCode)
+			}
+			failed = append(failed, fmt.Sprintf("%s (%s)", step.Name, reason))
+		}
+	}
+	if len(failed) > 0 {
+		return ValidationFailure, fmt.Sprintf("Custom steps failed: %s", strings.Join(failed, ", "))
+	}
+	if len(pending) > 0 {
+		return ValidationPending, fmt.Sprintf("Waiting for the results of custom steps: %s", strings.Join(pending, ", "))
+	}
+	return ValidationSuccess, "All custom steps succeeded"
+}
+
+func (v *validator) firmwarePolicySatisfied(c *validationContext) (ValidationStatus, string) {
+	// Hosts that are bound to a cluster comply with the policy of the cluster, others with the policy of their infra-env
+	var policy *string
+	if c.cluster != nil {
+		policy = c.cluster.FirmwarePolicy
+	} else if c.infraEnv != nil {
+		policy = c.infraEnv.FirmwarePolicy
+	}
+	firmwarePolicy, err := hardware.ParseFirmwarePolicy(policy)
+	if err != nil {
+		return ValidationError, "Failed to parse the firmware policy"
+	}
+	if firmwarePolicy == nil {
+		return ValidationSuccessSuppressOutput, ""
+	}
+	if c.inventory == nil {
+		return ValidationPending, "Missing inventory"
+	}
+	if violations := hardware.FirmwarePolicyViolations(firmwarePolicy, c.inventory); len(violations) > 0 {
+		return ValidationFailure, fmt.Sprintf("The firmware of the host doesn't comply with the firmware policy: %s", strings.Join(violations, "; "))
+	}
+	if unchecked := hardware.FirmwarePolicyUncheckedSettings(firmwarePolicy, c.inventory); len(unchecked) > 0 {
+		return ValidationSuccess, fmt.Sprintf("The firmware of the host complies with the firmware policy, except for settings that can't be checked: %s", strings.Join(unchecked, "; "))
+	}
+	return ValidationSuccess, "The firmware of the host complies with the firmware policy"
+}
+
+func (v *validator) areDisksHealthy(c *validationContext) (ValidationStatus, string) {
+	if c.inventory == nil {
+		return ValidationPending, "Missing inventory"
+	}
+	// Besides the installation disk, the HDD and SSD disks are used by the storage operators when they are enabled
+	storageOperatorsEnabled := c.cluster != nil && (operatorscommon.HasOperator(c.cluster.MonitoredOperators, odf.Operator.Name) ||
+		operatorscommon.HasOperator(c.cluster.MonitoredOperators, lvm.Operator.Name))
+	var failing, degraded []string
+	reported := false
+	for _, disk := range c.inventory.Disks {
+		isInstallationDisk := disk.ID != "" && disk.ID == c.host.InstallationDiskID
+		isStorageDisk := storageOperatorsEnabled && operatorscommon.IsNonInstallationStorageDisk(disk, c.host.InstallationDiskID)
+		if disk.Health == nil || (!isInstallationDisk && !isStorageDisk) {
+			continue
+		}
+		reported = true
+		failures, warnings := hardware.DiskHealthIssues(disk.Health, v.hwValidatorCfg)
+		if len(failures) > 0 {
+			failing = append(failing, fmt.Sprintf("%s (%s)", disk.Name, strings.Join(failures, "; ")))
+		} else if len(warnings) > 0 {
+			degraded = append(degraded, fmt.Sprintf("%s (%s)", disk.Name, strings.Join(warnings, "; ")))
+		}
+	}
+	if len(failing) > 0 {
+		if !v.hwValidatorCfg.DiskHealthBlocking {
+			return ValidationSuccess, fmt.Sprintf("Disks are failing, which doesn't block the installation: %s", strings.Join(failing, ", "))
+		}
+		return ValidationFailure, fmt.Sprintf("Disks are failing: %s", strings.Join(failing, ", "))
+	}
+	if len(degraded) > 0 {
+		return ValidationSuccess, fmt.Sprintf("Disks are degraded but can be used: %s", strings.Join(degraded, ", "))
+	}
+	if !reported {
+		return ValidationSuccess, "Health of disks has not been reported"
+	}
+	return ValidationSuccess, "Disks are healthy"
+}
@@ -0,0 +1,331 @@
+package installercache
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/oc"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/installer_cache"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ restapi.InstallerCacheAPI = &Api{}
+
+// prewarmTimeout bounds the time it takes to pre-warm a release, including the wait for the capacity of the cache
+const prewarmTimeout = time.Hour
+
+// Api pre-warms and pins releases in the installer cache and reports its contents. The releases are pre-warmed in
+// the local cache of the replica that receives the request, and in the shared cache when it is enabled. The pins and
+// the status of the pre-warming are stored in the database so that they apply to all the replicas.
+type Api struct {
+	db         *gorm.DB
+	installers *Installers
+	ocRelease  oc.Release
+	log        logrus.FieldLogger
+
+	// prewarming holds the release images that this replica is pre-warming
+	prewarming   map[string]bool
+	prewarmingMu sync.Mutex
+}
+
+func NewApi(db *gorm.DB, installers *Installers, ocRelease oc.Release, log logrus.FieldLogger) *Api {
+	return &Api{
+		db:         db,
+		installers: installers,
+		ocRelease:  ocRelease,
+		log:        log,
+		prewarming: make(map[string]bool),
+	}
+}
+
+func (a *Api) V2ListInstallerCacheReleases(ctx context.Context, params operations.V2ListInstallerCacheReleasesParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+	binaries, err := a.installers.Binaries()
+	if err != nil {
+		log.WithError(err).Error("failed to list the binaries of the installer cache")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	var releases []*common.InstallerCacheRelease
+	if err = a.db.Find(&releases).Error; err != nil {
+		log.WithError(err).Error("failed to list the pre-warmed releases of the installer cache")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	var entries []*common.InstallerCacheEntry
+	if a.installers.shared != nil {
+		if err = a.db.Where("sha256 <> ''").Find(&entries).Error; err != nil {
+			log.WithError(err).Error("failed to list the binaries of the shared installer cache")
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+	return operations.NewV2ListInstallerCacheReleasesOK().WithPayload(mergeReleases(binaries, releases, entries))
+}
+
+// mergeReleases combines the binaries of the local cache, the pre-warmed releases and the binaries of the shared cache
+// into one item per release image and binary. Binaries of the shared cache are matched to release images by their
+// digest, those that don't match any release are reported with their digest only.
+func mergeReleases(binaries []*models.InstallerCacheRelease, releases []*common.InstallerCacheRelease,
+	entries []*common.InstallerCacheEntry) models.InstallerCacheReleaseList {
+	releasesByImage := make(map[string]*common.InstallerCacheRelease)
+	for _, release := range releases {
+		releasesByImage[release.ReleaseImage] = release
+	}
+	matched := make(map[string]bool)
+	// matchEntry marks the item as shared if the binary is stored in the shared cache
+	matchEntry := func(item *models.InstallerCacheRelease) {
+		for _, entry := range entries {
+			if entry.ReleaseDigest == item.ReleaseDigest && entry.Binary == item.Binary {
+				item.Shared = true
+				matched[entry.ID] = true
+			}
+		}
+	}
+
+	ret := make(models.InstallerCacheReleaseList, 0, len(binaries)+len(releases)+len(entries))
+	local := make(map[string]bool)
+	for _, binary := range binaries {
+		item := *binary
+		if release, ok := releasesByImage[item.ReleaseImage]; ok {
+			setRelease(&item, release)
+			matchEntry(&item)
+		}
+		local[item.ReleaseImage] = true
+		ret = append(ret, &item)
+	}
+	for _, release := range releases {
+		if local[release.ReleaseImage] {
+			continue
+		}
+		found := false
+		for _, entry := range entries {
+			if release.ReleaseDigest == "" || entry.ReleaseDigest != release.ReleaseDigest {
+				continue
+			}
+			item := sharedItem(entry)
+			setRelease(item, release)
+			matched[entry.ID] = true
+			ret = append(ret, item)
+			found = true
+		}
+		if !found {
+			item := &models.InstallerCacheRelease{}
+			setRelease(item, release)
+			ret = append(ret, item)
+		}
+	}
+	for _, entry := range entries {
+		if !matched[entry.ID] {
+			ret = append(ret, sharedItem(entry))
+		}
+	}
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		if ret[i].ReleaseImage != ret[j].ReleaseImage {
+			return ret[i].ReleaseImage < ret[j].ReleaseImage
+		}
+		if ret[i].ReleaseDigest != ret[j].ReleaseDigest {
+			return ret[i].ReleaseDigest < ret[j].ReleaseDigest
+		}
+		return ret[i].Binary < ret[j].Binary
+	})
+	return ret
+}
+
+func setRelease(item *models.InstallerCacheRelease, release *common.InstallerCacheRelease) {
+	item.ReleaseImage = release.ReleaseImage
+	item.ReleaseDigest = release.ReleaseDigest
+	if item.OpenshiftVersion == "" {
+		item.OpenshiftVersion = release.OpenshiftVersion
+	}
+	item.Pinned = release.Pinned
+	item.PrewarmStatus = release.PrewarmStatus
+	item.PrewarmStatusInfo = release.PrewarmStatusInfo
+}
+
+func sharedItem(entry *common.InstallerCacheEntry) *models.InstallerCacheRelease {
+	return &models.InstallerCacheRelease{
+		ReleaseDigest:    entry.ReleaseDigest,
+		OpenshiftVersion: entry.ReleaseVersion,
+		Binary:           entry.Binary,
+		SizeBytes:        entry.Size,
+		LastUsedAt:       strfmt.DateTime(entry.LastUsedAt),
+		Shared:           true,
+	}
+}
+
+func (a *Api) V2PrewarmInstallerCacheRelease(ctx context.Context, params operations.V2PrewarmInstallerCacheReleaseParams) middleware.Responder {
+	release, err := a.prewarmRelease(ctx, params.PrewarmParams, false)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewV2PrewarmInstallerCacheReleaseAccepted().WithPayload(toModel(release))
+}
+
+func (a *Api) V2UpdateInstallerCacheRelease(ctx context.Context, params operations.V2UpdateInstallerCacheReleaseParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+	releaseImage := swag.StringValue(params.UpdateParams.ReleaseImage)
+	pinned := swag.BoolValue(params.UpdateParams.Pinned)
+	release := &common.InstallerCacheRelease{}
+	if err := a.db.Take(release, "release_image = ?", releaseImage).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return common.NewApiError(http.StatusNotFound, errors.Errorf("release %s was not pre-warmed", releaseImage))
+		}
+		log.WithError(err).Errorf("failed to get pre-warmed release %s", releaseImage)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if err := a.db.Model(release).Update("pinned", pinned).Error; err != nil {
+		log.WithError(err).Errorf("failed to update pre-warmed release %s", releaseImage)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	release.Pinned = pinned
+	log.Infof("Set pinned of release %s in the installer cache to %t", releaseImage, pinned)
+	return operations.NewV2UpdateInstallerCacheReleaseOK().WithPayload(toModel(release))
+}
+
+// PrewarmConfiguredReleases pre-warms the releases that are listed in the configuration of the installer cache, and
+// unpins the releases that were listed and no longer are
+func (a *Api) PrewarmConfiguredReleases(ctx context.Context) error {
+	var releases []*models.InstallerCachePrewarmParams
+	if a.installers.config.PrewarmReleases != "" {
+		if err := json.Unmarshal([]byte(a.installers.config.PrewarmReleases), &releases); err != nil {
+			return errors.Wrap(err, "failed to parse the releases to pre-warm")
+		}
+	}
+	releaseImages := make([]string, 0, len(releases))
+	for _, params := range releases {
+		if params.PullSecret == "" {
+			params.PullSecret = a.installers.config.PrewarmPullSecret
+		}
+		if _, err := a.prewarmRelease(ctx, params, true); err != nil {
+			return errors.Wrapf(err, "failed to pre-warm release %s", swag.StringValue(params.ReleaseImage))
+		}
+		releaseImages = append(releaseImages, swag.StringValue(params.ReleaseImage))
+	}
+
+	query := a.db.Model(&common.InstallerCacheRelease{}).Where("configured = ?", true)
+	if len(releaseImages) > 0 {
+		query = query.Where("release_image NOT IN ?", releaseImages)
+	}
+	reply := query.Updates(map[string]interface{}{"pinned": false, "configured": false})
+	if reply.Error != nil {
+		return errors.Wrap(reply.Error, "failed to unpin the releases that are no longer configured")
+	}
+	if reply.RowsAffected > 0 {
+		logutil.FromContext(ctx, a.log).Infof("Unpinned %d releases that are no longer configured to be pre-warmed", reply.RowsAffected)
+	}
+	return nil
+}
+
+// prewarmRelease records the release and starts pre-warming it in the background, unless this replica is already
+// pre-warming it. Configured is set for the releases that are listed in the configuration of the installer cache.
+func (a *Api) prewarmRelease(ctx context.Context, params *models.InstallerCachePrewarmParams, configured bool) (*common.InstallerCacheRelease, error) {
+	log := logutil.FromContext(ctx, a.log)
+	releaseImage := swag.StringValue(params.ReleaseImage)
+	if releaseImage == "" {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.New("the release image is required"))
+	}
+
+	a.prewarmingMu.Lock()
+	defer a.prewarmingMu.Unlock()
+	release := &common.InstallerCacheRelease{
+		ReleaseImage:       releaseImage,
+		ReleaseImageMirror: params.ReleaseImageMirror,
+		Pinned:             swag.BoolValue(params.Pinned),
+		Configured:         configured,
+		PrewarmStatus:      models.InstallerCacheReleasePrewarmStatusPending,
+	}
+	updateColumns := []string{"release_image_mirror", "pinned", "updated_at"}
+	if configured {
+		updateColumns = append(updateColumns, "configured")
+	}
+	if !a.prewarming[releaseImage] {
+		updateColumns = append(updateColumns, "prewarm_status", "prewarm_status_info")
+	}
+	err := a.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "release_image"}},
+		DoUpdates: clause.AssignmentColumns(updateColumns),
+	}).Create(release).Error
+	if err == nil {
+		err = a.db.Take(release, "release_image = ?", releaseImage).Error
+	}
+	if err != nil {
+		log.WithError(err).Errorf("failed to record pre-warmed release %s", releaseImage)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if a.prewarming[releaseImage] {
+		log.Infof("Release %s is already being pre-warmed in the installer cache", releaseImage)
+		return release, nil
+	}
+	a.prewarming[releaseImage] = true
+	log.Infof("Pre-warming release %s in the installer cache", releaseImage)
+	go a.prewarm(releaseImage, params.ReleaseImageMirror, params.PullSecret)
+	return release, nil
+}
+
+func (a *Api) prewarm(releaseImage, releaseImageMirror, pullSecret string) {
+	defer func() {
+		a.prewarmingMu.Lock()
+		defer a.prewarmingMu.Unlock()
+		delete(a.prewarming, releaseImage)
+	}()
+	log := a.log.WithField("release_image", releaseImage)
+	ctx, cancel := context.WithTimeout(context.Background(), prewarmTimeout)
+	defer cancel()
+
+	a.updateStatus(log, releaseImage, map[string]interface{}{"prewarm_status": models.InstallerCacheReleasePrewarmStatusPrewarming})
+	// The digest is recorded first so that the release is pinned in the shared cache as soon as it is stored
+	digest, err := a.ocRelease.GetReleaseDigest(log, releaseImage, releaseImageMirror, pullSecret)
+	if err != nil {
+		log.WithError(err).Warn("failed to get the digest of the pre-warmed release")
+	} else {
+		a.updateStatus(log, releaseImage, map[string]interface{}{"release_digest": digest})
+	}
+	ocpVersion, err := a.installers.Prewarm(ctx, releaseImage, releaseImageMirror, pullSecret, a.ocRelease)
+	if err != nil {
+		log.WithError(err).Error("failed to pre-warm release in the installer cache")
+		a.updateStatus(log, releaseImage, map[string]interface{}{
+			"prewarm_status":      models.InstallerCacheReleasePrewarmStatusFailed,
+			"prewarm_status_info": truncate(err.Error(), 1024),
+		})
+		return
+	}
+	log.Infof("Pre-warmed release %s in the installer cache", ocpVersion)
+	a.updateStatus(log, releaseImage, map[string]interface{}{
+		"openshift_version":   ocpVersion,
+		"prewarm_status":      models.InstallerCacheReleasePrewarmStatusReady,
+		"prewarm_status_info": "",
+	})
+}
+
+func (a *Api) updateStatus(log logrus.FieldLogger, releaseImage string, updates map[string]interface{}) {
+	err := a.db.Model(&common.InstallerCacheRelease{}).Where("release_image = ?", releaseImage).Updates(updates).Error
+	if err != nil {
+		log.WithError(err).Warn("failed to update the status of the pre-warmed release")
+	}
+}
+
+func truncate(s string, length int) string {
+	if len(s) <= length {
+		return s
+	}
+	return s[:length]
+}
+
+func toModel(release *common.InstallerCacheRelease) *models.InstallerCacheRelease {
+	item := &models.InstallerCacheRelease{}
+	setRelease(item, release)
+	return item
+}
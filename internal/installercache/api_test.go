@@ -0,0 +1,304 @@
+package installercache
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/oc"
+	"github.com/openshift/assisted-service/models"
+	operations "github.com/openshift/assisted-service/restapi/operations/installer_cache"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("installer cache API", func() {
+	const (
+		releaseID     = "quay.io/openshift-release-dev/ocp-release:4.17.11-x86_64"
+		version       = "4.17.11"
+		binary        = "openshift-install"
+		releaseDigest = "sha256:7c0c4ad8b36ef3e3ac3ff2a2a6b3a1e0a1d40b4e0ab5a3f6ec5e1c1e1a0d5a11"
+	)
+
+	var (
+		ctx         context.Context
+		ctrl        *gomock.Controller
+		db          *gorm.DB
+		dbName      string
+		cacheDir    string
+		mockRelease *oc.MockRelease
+		installers  *Installers
+		api         *Api
+	)
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+		db, dbName = common.PrepareTestDB()
+		cacheDir, err = os.MkdirTemp("", "installerCacheApi")
+		Expect(err).ToNot(HaveOccurred())
+		eventsHandler := eventsapi.NewMockHandler(ctrl)
+		eventsHandler.EXPECT().V2AddMetricsEvent(gomock.Any(), gomock.Any(), nil, nil, "", gomock.Any(), metricEventInstallerCacheRelease,
+			gomock.Any(), gomock.Any()).AnyTimes()
+		metricsAPI := metrics.NewMockAPI(ctrl)
+		metricsAPI.EXPECT().InstallerCacheGetReleaseCached(gomock.Any(), gomock.Any()).AnyTimes()
+		metricsAPI.EXPECT().InstallerCacheReleaseEvicted(gomock.Any()).AnyTimes()
+		metricsAPI.EXPECT().InstallerCacheReleaseEvent(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		installers, err = New(Config{CacheDir: cacheDir, ReleaseFetchRetryInterval: time.Millisecond}, db, nil, eventsHandler, metricsAPI,
+			metrics.NewOSDiskStatsHelper(logrus.New()), logrus.New())
+		Expect(err).ToNot(HaveOccurred())
+		mockRelease = oc.NewMockRelease(ctrl)
+		api = NewApi(db, installers, mockRelease, logrus.New())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(cacheDir)
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	mockReleaseCalls := func(extractErr error) {
+		workdir := filepath.Join(cacheDir, releaseID)
+		path := filepath.Join(workdir, binary)
+		mockRelease.EXPECT().GetOpenshiftVersion(gomock.Any(), releaseID, "", "pull-secret").Return(version, nil).AnyTimes()
+		mockRelease.EXPECT().GetMajorMinorVersion(gomock.Any(), releaseID, "", "pull-secret").Return("4.17", nil).AnyTimes()
+		mockRelease.EXPECT().GetReleaseDigest(gomock.Any(), releaseID, "", "pull-secret").Return(releaseDigest, nil).AnyTimes()
+		mockRelease.EXPECT().GetReleaseBinaryPath(releaseID, cacheDir, version).Return(workdir, binary, path, nil).AnyTimes()
+		mockRelease.EXPECT().Extract(gomock.Any(), releaseID, "", cacheDir, "pull-secret", version).DoAndReturn(
+			func(_ logrus.FieldLogger, _, _, _, _, _ string) (string, error) {
+				if extractErr != nil {
+					return "", extractErr
+				}
+				Expect(os.MkdirAll(workdir, 0755)).To(Succeed())
+				return path, os.WriteFile(path, []byte("installer"), 0600)
+			}).AnyTimes()
+	}
+
+	prewarm := func(pinned bool) *models.InstallerCacheRelease {
+		reply := api.V2PrewarmInstallerCacheRelease(ctx, operations.V2PrewarmInstallerCacheReleaseParams{
+			PrewarmParams: &models.InstallerCachePrewarmParams{
+				ReleaseImage: swag.String(releaseID),
+				PullSecret:   "pull-secret",
+				Pinned:       swag.Bool(pinned),
+			},
+		})
+		Expect(reply).To(BeAssignableToTypeOf(&operations.V2PrewarmInstallerCacheReleaseAccepted{}))
+		return reply.(*operations.V2PrewarmInstallerCacheReleaseAccepted).Payload
+	}
+
+	getRelease := func() *common.InstallerCacheRelease {
+		release := &common.InstallerCacheRelease{}
+		Expect(db.Take(release, "release_image = ?", releaseID).Error).To(Succeed())
+		return release
+	}
+
+	list := func() models.InstallerCacheReleaseList {
+		reply := api.V2ListInstallerCacheReleases(ctx, operations.V2ListInstallerCacheReleasesParams{})
+		Expect(reply).To(BeAssignableToTypeOf(&operations.V2ListInstallerCacheReleasesOK{}))
+		return reply.(*operations.V2ListInstallerCacheReleasesOK).Payload
+	}
+
+	It("pre-warms a release in the background", func() {
+		mockReleaseCalls(nil)
+		release := prewarm(true)
+		Expect(release.ReleaseImage).To(Equal(releaseID))
+		Expect(release.Pinned).To(BeTrue())
+		Expect(release.PrewarmStatus).To(Equal(models.InstallerCacheReleasePrewarmStatusPending))
+
+		Eventually(func() string {
+			return getRelease().PrewarmStatus
+		}).Should(Equal(models.InstallerCacheReleasePrewarmStatusReady))
+		Expect(getRelease().OpenshiftVersion).To(Equal(version))
+		Expect(getRelease().ReleaseDigest).To(Equal(releaseDigest))
+
+		releases := list()
+		Expect(releases).To(HaveLen(1))
+		Expect(releases[0].ReleaseImage).To(Equal(releaseID))
+		Expect(releases[0].ReleaseDigest).To(Equal(releaseDigest))
+		Expect(releases[0].OpenshiftVersion).To(Equal(version))
+		Expect(releases[0].Binary).To(Equal(binary))
+		Expect(releases[0].SizeBytes).To(Equal(int64(len("installer"))))
+		Expect(releases[0].Cached).To(BeTrue())
+		Expect(releases[0].Shared).To(BeFalse())
+		Expect(releases[0].Pinned).To(BeTrue())
+
+		// the link that is created by the cache is removed
+		entries, err := os.ReadDir(filepath.Join(cacheDir, releaseID))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+	})
+
+	It("reports the failure of the pre-warming", func() {
+		mockReleaseCalls(errors.New("failed to pull the release"))
+		prewarm(false)
+		Eventually(func() string {
+			return getRelease().PrewarmStatus
+		}).Should(Equal(models.InstallerCacheReleasePrewarmStatusFailed))
+		Expect(getRelease().PrewarmStatusInfo).To(ContainSubstring("failed to pull the release"))
+
+		releases := list()
+		Expect(releases).To(HaveLen(1))
+		Expect(releases[0].Cached).To(BeFalse())
+		Expect(releases[0].PrewarmStatus).To(Equal(models.InstallerCacheReleasePrewarmStatusFailed))
+	})
+
+	It("rejects a pre-warm without a release image", func() {
+		reply := api.V2PrewarmInstallerCacheRelease(ctx, operations.V2PrewarmInstallerCacheReleaseParams{
+			PrewarmParams: &models.InstallerCachePrewarmParams{ReleaseImage: swag.String("")},
+		})
+		Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+	})
+
+	It("pins and unpins a pre-warmed release", func() {
+		mockReleaseCalls(nil)
+		prewarm(false)
+		Eventually(func() string {
+			return getRelease().PrewarmStatus
+		}).Should(Equal(models.InstallerCacheReleasePrewarmStatusReady))
+
+		for _, pinned := range []bool{true, false} {
+			reply := api.V2UpdateInstallerCacheRelease(ctx, operations.V2UpdateInstallerCacheReleaseParams{
+				UpdateParams: &models.InstallerCacheReleaseUpdateParams{ReleaseImage: swag.String(releaseID), Pinned: swag.Bool(pinned)},
+			})
+			Expect(reply).To(BeAssignableToTypeOf(&operations.V2UpdateInstallerCacheReleaseOK{}))
+			Expect(reply.(*operations.V2UpdateInstallerCacheReleaseOK).Payload.Pinned).To(Equal(pinned))
+			Expect(getRelease().Pinned).To(Equal(pinned))
+		}
+	})
+
+	It("fails to pin a release that wasn't pre-warmed", func() {
+		reply := api.V2UpdateInstallerCacheRelease(ctx, operations.V2UpdateInstallerCacheReleaseParams{
+			UpdateParams: &models.InstallerCacheReleaseUpdateParams{ReleaseImage: swag.String(releaseID), Pinned: swag.Bool(true)},
+		})
+		Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusNotFound)))
+	})
+
+	It("pre-warms the configured releases", func() {
+		mockReleaseCalls(nil)
+		installers.config.PrewarmReleases = `[{"release_image": "` + releaseID + `", "pull_secret": "pull-secret", "pinned": true}]`
+		Expect(api.PrewarmConfiguredReleases(ctx)).To(Succeed())
+		Eventually(func() string {
+			return getRelease().PrewarmStatus
+		}).Should(Equal(models.InstallerCacheReleasePrewarmStatusReady))
+		Expect(getRelease().Pinned).To(BeTrue())
+	})
+
+	It("pre-warms the configured releases with the configured pull secret", func() {
+		mockReleaseCalls(nil)
+		installers.config.PrewarmReleases = `[{"release_image": "` + releaseID + `"}]`
+		installers.config.PrewarmPullSecret = "pull-secret"
+		Expect(api.PrewarmConfiguredReleases(ctx)).To(Succeed())
+		Eventually(func() string {
+			return getRelease().PrewarmStatus
+		}).Should(Equal(models.InstallerCacheReleasePrewarmStatusReady))
+		Expect(getRelease().Configured).To(BeTrue())
+	})
+
+	It("unpins the releases that are no longer configured", func() {
+		removedID := "quay.io/openshift-release-dev/ocp-release:4.16.0-x86_64"
+		pinnedID := "quay.io/openshift-release-dev/ocp-release:4.15.0-x86_64"
+		Expect(db.Create(&common.InstallerCacheRelease{ReleaseImage: removedID, Pinned: true, Configured: true}).Error).To(Succeed())
+		Expect(db.Create(&common.InstallerCacheRelease{ReleaseImage: pinnedID, Pinned: true}).Error).To(Succeed())
+
+		Expect(api.PrewarmConfiguredReleases(ctx)).To(Succeed())
+		removed := &common.InstallerCacheRelease{}
+		Expect(db.Take(removed, "release_image = ?", removedID).Error).To(Succeed())
+		Expect(removed.Pinned).To(BeFalse())
+		Expect(removed.Configured).To(BeFalse())
+		// releases that were pinned through the API are left pinned
+		pinned := &common.InstallerCacheRelease{}
+		Expect(db.Take(pinned, "release_image = ?", pinnedID).Error).To(Succeed())
+		Expect(pinned.Pinned).To(BeTrue())
+	})
+
+	It("fails on invalid configured releases", func() {
+		installers.config.PrewarmReleases = "not json"
+		Expect(api.PrewarmConfiguredReleases(ctx)).ToNot(Succeed())
+	})
+
+	It("never evicts pinned releases", func() {
+		pinnedDir := filepath.Join(cacheDir, releaseID)
+		otherDir := filepath.Join(cacheDir, "quay.io/openshift-release-dev/ocp-release:4.16.0-x86_64")
+		for _, dir := range []string{pinnedDir, otherDir} {
+			Expect(os.MkdirAll(dir, 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, binary), make([]byte, 10), 0600)).To(Succeed())
+		}
+		// the pinned release is the least recently used
+		past := time.Now().Add(-time.Hour)
+		Expect(os.Chtimes(filepath.Join(pinnedDir, binary), past, past)).To(Succeed())
+		Expect(db.Create(&common.InstallerCacheRelease{ReleaseImage: releaseID, Pinned: true}).Error).To(Succeed())
+
+		installers.config.MaxCapacity = 15
+		installers.config.MaxReleaseSize = 10
+		Expect(installers.evict()).To(BeTrue())
+		Expect(filepath.Join(pinnedDir, binary)).To(BeAnExistingFile())
+		Expect(filepath.Join(otherDir, binary)).ToNot(BeAnExistingFile())
+
+		// the pinned release is not evicted even if the cache remains full
+		Expect(installers.evict()).To(BeFalse())
+		Expect(filepath.Join(pinnedDir, binary)).To(BeAnExistingFile())
+	})
+})
+
+var _ = Describe("mergeReleases", func() {
+	const (
+		releaseID     = "quay.io/openshift-release-dev/ocp-release:4.17.11-x86_64"
+		releaseDigest = "sha256:7c0c4ad8b36ef3e3ac3ff2a2a6b3a1e0a1d40b4e0ab5a3f6ec5e1c1e1a0d5a11"
+		otherDigest   = "sha256:0d5a117c0c4ad8b36ef3e3ac3ff2a2a6b3a1e0a1d40b4e0ab5a3f6ec5e1c1e1a"
+	)
+
+	lastUsed := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	It("merges the local binaries, the pre-warmed releases and the shared binaries", func() {
+		binaries := []*models.InstallerCacheRelease{
+			{ReleaseImage: releaseID, Binary: "openshift-install", SizeBytes: 10, Cached: true},
+			{ReleaseImage: "quay.io/other:4.16.0", OpenshiftVersion: "4.16.0", Binary: "openshift-install", SizeBytes: 20, Cached: true},
+		}
+		releases := []*common.InstallerCacheRelease{
+			{ReleaseImage: releaseID, ReleaseDigest: releaseDigest, OpenshiftVersion: "4.17.11", Pinned: true,
+				PrewarmStatus: models.InstallerCacheReleasePrewarmStatusReady},
+			{ReleaseImage: "quay.io/pending:4.18.0", PrewarmStatus: models.InstallerCacheReleasePrewarmStatusPending},
+		}
+		entries := []*common.InstallerCacheEntry{
+			{ID: "a", ReleaseDigest: releaseDigest, ReleaseVersion: "4.17.11", Binary: "openshift-install", Size: 10, LastUsedAt: lastUsed},
+			{ID: "b", ReleaseDigest: otherDigest, ReleaseVersion: "4.15.0", Binary: "openshift-baremetal-install", Size: 30, LastUsedAt: lastUsed},
+		}
+
+		Expect(mergeReleases(binaries, releases, entries)).To(Equal(models.InstallerCacheReleaseList{
+			{ReleaseDigest: otherDigest, OpenshiftVersion: "4.15.0", Binary: "openshift-baremetal-install", SizeBytes: 30,
+				LastUsedAt: strfmt.DateTime(lastUsed), Shared: true},
+			{ReleaseImage: releaseID, ReleaseDigest: releaseDigest, OpenshiftVersion: "4.17.11", Binary: "openshift-install", SizeBytes: 10,
+				Cached: true, Shared: true, Pinned: true, PrewarmStatus: models.InstallerCacheReleasePrewarmStatusReady},
+			{ReleaseImage: "quay.io/other:4.16.0", OpenshiftVersion: "4.16.0", Binary: "openshift-install", SizeBytes: 20, Cached: true},
+			{ReleaseImage: "quay.io/pending:4.18.0", PrewarmStatus: models.InstallerCacheReleasePrewarmStatusPending},
+		}))
+	})
+
+	It("reports the shared binaries of a pre-warmed release that isn't in the local cache", func() {
+		releases := []*common.InstallerCacheRelease{
+			{ReleaseImage: releaseID, ReleaseDigest: releaseDigest, OpenshiftVersion: "4.17.11", Pinned: true},
+		}
+		entries := []*common.InstallerCacheEntry{
+			{ID: "a", ReleaseDigest: releaseDigest, ReleaseVersion: "4.17.11", Binary: "openshift-install", Size: 10, LastUsedAt: lastUsed},
+		}
+
+		Expect(mergeReleases(nil, releases, entries)).To(Equal(models.InstallerCacheReleaseList{
+			{ReleaseImage: releaseID, ReleaseDigest: releaseDigest, OpenshiftVersion: "4.17.11", Binary: "openshift-install", SizeBytes: 10,
+				LastUsedAt: strfmt.DateTime(lastUsed), Shared: true, Pinned: true},
+		}))
+	})
+})
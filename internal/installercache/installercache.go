@@ -15,12 +15,15 @@ import (
 	"github.com/alecthomas/units"
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/common"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/oc"
 	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sys/unix"
+	"gorm.io/gorm"
 )
 
 var (
@@ -38,6 +41,12 @@ type Installers struct {
 	diskStatsHelper metrics.DiskStatsHelper
 	config          Config
 	metricsAPI      metrics.API
+	// db holds the releases that are pinned by an administrator, it may be nil
+	db *gorm.DB
+	// shared is the tier of the cache that is shared by the replicas, nil if it is disabled
+	shared *sharedCache
+	// versions holds the OpenShift versions of the cached binaries by their path, in order to report the evictions
+	versions map[string]string
 }
 
 type Size int64
@@ -50,6 +59,20 @@ type Config struct {
 	MaxReleaseSize Size `envconfig:"INSTALLER_CACHE_MAX_RELEASE_SIZE" default:"2GiB"`
 	// ReleaseFetchRetryIntervalMicroseconds is the number of microseconds that the cache should wait before retrying the fetch of a release if unable to do so for capacity reasons.
 	ReleaseFetchRetryInterval time.Duration `envconfig:"INSTALLER_CACHE_RELEASE_FETCH_RETRY_INTERVAL" default:"30s"`
+	// SharedCache enables the tier of the cache that is shared by the replicas of the service through the object storage
+	SharedCache bool `envconfig:"INSTALLER_CACHE_SHARED" default:"false"`
+	// SharedCapacity is the capacity of the shared tier of the cache, in a form like "100 GiB". Zero disables the eviction.
+	SharedCapacity Size `envconfig:"INSTALLER_CACHE_SHARED_CAPACITY" default:"0"`
+	// SharedLockTimeout is the time after which the extraction of a release by another replica is considered to have failed
+	SharedLockTimeout time.Duration `envconfig:"INSTALLER_CACHE_SHARED_LOCK_TIMEOUT" default:"10m"`
+	// SharedPollInterval is the interval at which a replica checks whether the release that another replica extracts is stored
+	SharedPollInterval time.Duration `envconfig:"INSTALLER_CACHE_SHARED_POLL_INTERVAL" default:"5s"`
+	// PrewarmReleases is a JSON list of the releases to pre-warm when the service starts, in the format of the
+	// parameters of the pre-warm API
+	PrewarmReleases string `envconfig:"INSTALLER_CACHE_PREWARM_RELEASES" default:""`
+	// PrewarmPullSecret is the pull secret of the releases to pre-warm when the service starts, for the releases
+	// that don't set their own
+	PrewarmPullSecret string `envconfig:"INSTALLER_CACHE_PREWARM_PULL_SECRET" default:""`
 }
 
 func (s *Size) Decode(value string) error {
@@ -93,7 +116,7 @@ type Release struct {
 	clusterID strfmt.UUID
 	// releaseId is the release that is being fetched, for example "4.10.67-x86_64".
 	releaseID string
-	// cached is `true` if the release was found in the cache, otherwise `false`.
+	// cached is `true` if the release was found in either tier of the cache, otherwise `false`.
 	cached bool
 	// extractDuration is the amount of time taken to perform extraction, zero if no extraction took place.
 	extractDuration float64
@@ -111,7 +134,11 @@ func (rl *Release) Cleanup(ctx context.Context) error {
 		"cached", rl.cached,
 		"extract_duration", rl.extractDuration,
 	)
+	return rl.removeLink()
+}
 
+// removeLink removes the hard link to the binary that was returned to the caller
+func (rl *Release) removeLink() error {
 	var ret error
 
 	// Ensure page cache is removed for the file
@@ -147,21 +174,35 @@ func (r *Release) cleanPageCache() error {
 	return nil
 }
 
-// New constructs an installer cache with a given storage capacity
-func New(config Config, eventsHandler eventsapi.Handler, metricsAPI metrics.API, diskStatsHelper metrics.DiskStatsHelper, log logrus.FieldLogger) (*Installers, error) {
+// New constructs an installer cache with a given storage capacity. The database and the object storage are only
+// used by the shared tier of the cache, and may be nil if it is disabled.
+func New(config Config, db *gorm.DB, objectHandler s3wrapper.API, eventsHandler eventsapi.Handler, metricsAPI metrics.API,
+	diskStatsHelper metrics.DiskStatsHelper, log logrus.FieldLogger) (*Installers, error) {
 	if config.MaxCapacity > 0 && config.MaxReleaseSize == 0 {
 		return nil, fmt.Errorf("config.MaxReleaseSize (%d bytes) must not be zero", config.MaxReleaseSize)
 	}
 	if config.MaxCapacity > 0 && config.MaxReleaseSize > config.MaxCapacity {
 		return nil, fmt.Errorf("config.MaxReleaseSize (%d bytes) must not be greater than config.MaxCapacity (%d bytes)", config.MaxReleaseSize, config.MaxCapacity)
 	}
-	return &Installers{
+	installers := &Installers{
 		log:             log,
 		eventsHandler:   eventsHandler,
 		diskStatsHelper: diskStatsHelper,
 		config:          config,
 		metricsAPI:      metricsAPI,
-	}, nil
+		db:              db,
+		versions:        make(map[string]string),
+	}
+	if config.SharedCache {
+		if db == nil || objectHandler == nil {
+			return nil, errors.New("the shared installer cache requires a database and an object storage")
+		}
+		if config.SharedLockTimeout <= 0 || config.SharedPollInterval <= 0 {
+			return nil, fmt.Errorf("config.SharedLockTimeout (%s) and config.SharedPollInterval (%s) must be positive", config.SharedLockTimeout, config.SharedPollInterval)
+		}
+		installers.shared = newSharedCache(config, db, objectHandler, metricsAPI, log)
+	}
+	return installers, nil
 }
 
 // Get returns the path to an openshift-baremetal-install binary extracted from
@@ -178,7 +219,7 @@ func (i *Installers) Get(ctx context.Context, releaseID, releaseIDMirror, pullSe
 		case <-ctx.Done():
 			return nil, ctx.Err()
 		default:
-			release, err := i.get(releaseID, releaseIDMirror, pullSecret, ocRelease, ocpVersion, clusterID)
+			release, err := i.get(ctx, releaseID, releaseIDMirror, pullSecret, ocRelease, ocpVersion, clusterID)
 			if err == nil {
 				i.metricsAPI.InstallerCacheGetReleaseCached(majorMinorVersion, release.cached)
 				return release, nil
@@ -192,6 +233,49 @@ func (i *Installers) Get(ctx context.Context, releaseID, releaseIDMirror, pullSe
 	}
 }
 
+// Prewarm extracts the installer binary of the release into the cache ahead of demand, and returns the OpenShift
+// version of the release
+func (i *Installers) Prewarm(ctx context.Context, releaseID, releaseIDMirror, pullSecret string, ocRelease oc.Release) (string, error) {
+	ocpVersion, err := ocRelease.GetOpenshiftVersion(i.log, releaseID, releaseIDMirror, pullSecret)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the version of release %s: %w", releaseID, err)
+	}
+	release, err := i.Get(ctx, releaseID, releaseIDMirror, pullSecret, ocRelease, ocpVersion, "")
+	if err != nil {
+		return "", err
+	}
+	return ocpVersion, release.removeLink()
+}
+
+// Binaries returns the installer binaries that are stored in the local cache
+func (i *Installers) Binaries() ([]*models.InstallerCacheRelease, error) {
+	i.Lock()
+	defer i.Unlock()
+	binaries := make([]*models.InstallerCacheRelease, 0)
+	visit := func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		// hard links and ongoing downloads are not binaries of the cache
+		if !info.Mode().IsRegular() || strings.HasPrefix(info.Name(), "ln_") || strings.HasPrefix(info.Name(), "download_") {
+			return nil
+		}
+		binaries = append(binaries, &models.InstallerCacheRelease{
+			ReleaseImage:     i.releaseOfPath(path),
+			OpenshiftVersion: i.versions[path],
+			Binary:           info.Name(),
+			SizeBytes:        info.Size(),
+			LastUsedAt:       strfmt.DateTime(info.ModTime()),
+			Cached:           true,
+		})
+		return nil
+	}
+	if err := filepath.Walk(i.config.CacheDir, visit); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to list the binaries of directory %s: %w", i.config.CacheDir, err)
+	}
+	return binaries, nil
+}
+
 func (i *Installers) getDiskUsageIncludingHardlinks() (uint64, error) {
 	usedBytes, _, err := i.diskStatsHelper.GetDiskUsage(i.config.CacheDir)
 	if err != nil && !os.IsNotExist(err) {
@@ -200,9 +284,10 @@ func (i *Installers) getDiskUsageIncludingHardlinks() (uint64, error) {
 	return usedBytes, nil
 }
 
-func (i *Installers) extractReleaseIfNeeded(path, releaseID, releaseIDMirror, pullSecret, ocpVersion string, ocRelease oc.Release) (extractDuration float64, cached bool, err error) {
+func (i *Installers) extractReleaseIfNeeded(ctx context.Context, path, binary, releaseID, releaseIDMirror, pullSecret, ocpVersion string, ocRelease oc.Release) (extractDuration float64, cached bool, err error) {
 	_, err = os.Stat(path)
 	if err == nil {
+		i.metricsAPI.InstallerCacheReleaseEvent(tierLocal, ocpVersion, cacheEventHit)
 		return 0, true, nil // release was found in the cache
 	}
 	if !os.IsNotExist(err) {
@@ -215,15 +300,37 @@ func (i *Installers) extractReleaseIfNeeded(path, releaseID, releaseIDMirror, pu
 	if i.shouldEvict(int64(usedBytes)) && !i.evict() { // nolint: gosec
 		return 0, false, &errorInsufficientCacheCapacity{Message: fmt.Sprintf("insufficient capacity in %s to store release", i.config.CacheDir)}
 	}
+	i.metricsAPI.InstallerCacheReleaseEvent(tierLocal, ocpVersion, cacheEventMiss)
 	extractStartTime := time.Now()
-	_, err = ocRelease.Extract(i.log, releaseID, releaseIDMirror, i.config.CacheDir, pullSecret, ocpVersion)
+	cached, err = i.extract(ctx, path, binary, releaseID, releaseIDMirror, pullSecret, ocpVersion, ocRelease)
 	if err != nil {
 		return 0, false, err
 	}
+	if cached {
+		return 0, true, nil // release was found in the shared cache
+	}
 	return time.Since(extractStartTime).Seconds(), false, nil
 }
 
-func (i *Installers) get(releaseID, releaseIDMirror, pullSecret string, ocRelease oc.Release, ocpVersion string, clusterID strfmt.UUID) (*Release, error) {
+// extract places the binary of the release at path, from the shared tier of the cache if it is enabled. Returns true
+// if the binary was found in the shared tier.
+func (i *Installers) extract(ctx context.Context, path, binary, releaseID, releaseIDMirror, pullSecret, ocpVersion string, ocRelease oc.Release) (bool, error) {
+	extract := func() error {
+		_, err := ocRelease.Extract(i.log, releaseID, releaseIDMirror, i.config.CacheDir, pullSecret, ocpVersion)
+		return err
+	}
+	if i.shared == nil {
+		return false, extract()
+	}
+	digest, err := ocRelease.GetReleaseDigest(i.log, releaseID, releaseIDMirror, pullSecret)
+	if err != nil {
+		i.log.WithError(err).Warnf("failed to get the digest of release %s, skipping the shared installer cache", releaseID)
+		return false, extract()
+	}
+	return i.shared.fetch(ctx, sharedBinary{digest: digest, version: ocpVersion, binary: binary}, path, extract)
+}
+
+func (i *Installers) get(ctx context.Context, releaseID, releaseIDMirror, pullSecret string, ocRelease oc.Release, ocpVersion string, clusterID strfmt.UUID) (*Release, error) {
 	i.Lock()
 	defer i.Unlock()
 
@@ -238,10 +345,11 @@ func (i *Installers) get(releaseID, releaseIDMirror, pullSecret string, ocReleas
 	if err != nil {
 		return nil, err
 	}
-	release.extractDuration, release.cached, err = i.extractReleaseIfNeeded(path, releaseID, releaseIDMirror, pullSecret, ocpVersion, ocRelease)
+	release.extractDuration, release.cached, err = i.extractReleaseIfNeeded(ctx, path, binary, releaseID, releaseIDMirror, pullSecret, ocpVersion, ocRelease)
 	if err != nil {
 		return nil, err
 	}
+	i.versions[path] = ocpVersion
 
 	// update the file mtime to signal it was recently used
 	err = os.Chtimes(path, time.Now(), time.Now())
@@ -287,6 +395,12 @@ func (i *Installers) shouldEvict(totalUsed int64) (shouldEvict bool) {
 //
 // Locking must be done outside evict() to avoid contentions.
 func (i *Installers) evict() bool {
+	pinned, err := i.pinnedReleases()
+	if err != nil {
+		i.log.WithError(err).Error("release binary eviction failed to list the pinned releases")
+		return false
+	}
+
 	// store the file paths
 	files := NewPriorityQueue(&fileInfo{})
 	links := make([]*fileInfo, 0)
@@ -313,14 +427,17 @@ func (i *Installers) evict() bool {
 			if !ok {
 				i.log.Warnf("could not determine hardlink status for %s - item will not be filtered", path)
 			}
-			files.Add(&fileInfo{path, info})
+			// pinned releases use capacity but are never evicted
+			if !pinned[i.releaseOfPath(path)] {
+				files.Add(&fileInfo{path, info})
+			}
 			totalSize += info.Size()
 		}
 
 		return nil
 	}
 
-	err := filepath.Walk(i.config.CacheDir, visit)
+	err = filepath.Walk(i.config.CacheDir, visit)
 	if err != nil {
 		if !os.IsNotExist(err) { //ignore first invocation where the cacheDir does not exist
 			i.log.WithError(err).Errorf("release binary eviction failed to inspect directory %s", i.config.CacheDir)
@@ -342,11 +459,44 @@ func (i *Installers) evict() bool {
 			continue
 		}
 		evicted = true
+		version, ok := i.versions[finfo.path]
+		if !ok {
+			version = "unknown"
+		}
+		delete(i.versions, finfo.path)
+		i.metricsAPI.InstallerCacheReleaseEvent(tierLocal, version, cacheEventEviction)
 	}
 	i.metricsAPI.InstallerCacheReleaseEvicted(evicted)
 	return evicted
 }
 
+// pinnedReleases returns the release images that are pinned by an administrator
+func (i *Installers) pinnedReleases() (map[string]bool, error) {
+	pinned := make(map[string]bool)
+	if i.db == nil {
+		return pinned, nil
+	}
+	var releaseImages []string
+	err := i.db.Model(&common.InstallerCacheRelease{}).Where("pinned = ?", true).Pluck("release_image", &releaseImages).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, releaseImage := range releaseImages {
+		pinned[releaseImage] = true
+	}
+	return pinned, nil
+}
+
+// releaseOfPath returns the release image of a binary in the cache, as binaries are extracted to a directory that is
+// named after their release image
+func (i *Installers) releaseOfPath(filePath string) string {
+	releaseID, err := filepath.Rel(i.config.CacheDir, filepath.Dir(filePath))
+	if err != nil {
+		return ""
+	}
+	return releaseID
+}
+
 func (i *Installers) evictFile(filePath string) error {
 	i.log.Infof("evicting binary file %s due to storage pressure", filePath)
 	err := os.Remove(filePath)
@@ -18,6 +18,7 @@ import (
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/oc"
@@ -96,7 +97,7 @@ var _ = Describe("installer cache", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(os.Mkdir(filepath.Join(cacheDir, "quay.io"), 0755)).To(Succeed())
 		Expect(os.Mkdir(filepath.Join(filepath.Join(cacheDir, "quay.io"), "release-dev"), 0755)).To(Succeed())
-		manager, err = New(getInstallerCacheConfig(12, 5), eventsHandler, metricsAPI, diskStatsHelper, logrus.New())
+		manager, err = New(getInstallerCacheConfig(12, 5), nil, nil, eventsHandler, metricsAPI, diskStatsHelper, logrus.New())
 		Expect(err).NotTo(HaveOccurred())
 		ctx = context.TODO()
 	})
@@ -134,6 +135,7 @@ var _ = Describe("installer cache", func() {
 			DoAndReturn(writeMockedReleaseToDisk).AnyTimes()
 
 		metricsAPI.EXPECT().InstallerCacheReleaseEvicted(gomock.Any()).AnyTimes()
+		metricsAPI.EXPECT().InstallerCacheReleaseEvent(tierLocal, gomock.Any(), gomock.Any()).AnyTimes()
 
 	}
 
@@ -232,7 +234,7 @@ var _ = Describe("installer cache", func() {
 	// returns the first error encountered or nil if no error encountered.
 	runParallelTest := func(maxCapacity int64, maxReleaseSize int64, tests []test) error {
 		var err error
-		manager, err = New(getInstallerCacheConfig(maxCapacity, maxReleaseSize), eventsHandler, metricsAPI, diskStatsHelper, getLogger())
+		manager, err = New(getInstallerCacheConfig(maxCapacity, maxReleaseSize), nil, nil, eventsHandler, metricsAPI, diskStatsHelper, getLogger())
 		Expect(err).ToNot(HaveOccurred())
 		var wg sync.WaitGroup
 		var reportedError error
@@ -301,30 +303,30 @@ var _ = Describe("installer cache", func() {
 	})
 
 	It("Should raise error on construction if max release size is larger than cache and cache is enabled", func() {
-		_, err := New(getInstallerCacheConfig(5, 10), eventsHandler, metricsAPI, diskStatsHelper, logrus.New())
+		_, err := New(getInstallerCacheConfig(5, 10), nil, nil, eventsHandler, metricsAPI, diskStatsHelper, logrus.New())
 		Expect(err).To(HaveOccurred())
 		Expect(err.Error()).To(Equal("config.MaxReleaseSize (10 bytes) must not be greater than config.MaxCapacity (5 bytes)"))
 	})
 
 	It("Should raise error on construction if max release size is zero and cache is enabled", func() {
-		_, err := New(getInstallerCacheConfig(5, 0), eventsHandler, metricsAPI, diskStatsHelper, logrus.New())
+		_, err := New(getInstallerCacheConfig(5, 0), nil, nil, eventsHandler, metricsAPI, diskStatsHelper, logrus.New())
 		Expect(err).To(HaveOccurred())
 		Expect(err.Error()).To(Equal("config.MaxReleaseSize (0 bytes) must not be zero"))
 	})
 
 	It("Should not raise error on construction if max release size is larger than cache and cache eviction is disabled", func() {
-		_, err := New(getInstallerCacheConfig(0, 10), eventsHandler, metricsAPI, diskStatsHelper, logrus.New())
+		_, err := New(getInstallerCacheConfig(0, 10), nil, nil, eventsHandler, metricsAPI, diskStatsHelper, logrus.New())
 		Expect(err).ToNot(HaveOccurred())
 	})
 
 	It("Should not raise error on construction if max release size is zero and cache eviction is disabled", func() {
-		_, err := New(getInstallerCacheConfig(0, 0), eventsHandler, metricsAPI, diskStatsHelper, logrus.New())
+		_, err := New(getInstallerCacheConfig(0, 0), nil, nil, eventsHandler, metricsAPI, diskStatsHelper, logrus.New())
 		Expect(err).ToNot(HaveOccurred())
 	})
 
 	It("when cache limit is zero - eviction is skipped", func() {
 		var err error
-		manager, err = New(getInstallerCacheConfig(0, 5), eventsHandler, metricsAPI, diskStatsHelper, logrus.New())
+		manager, err = New(getInstallerCacheConfig(0, 5), nil, nil, eventsHandler, metricsAPI, diskStatsHelper, logrus.New())
 		Expect(err).ToNot(HaveOccurred())
 		clusterId := strfmt.UUID(uuid.New().String())
 		r1, _ := testGet("4.8", "4.8.0", clusterId, false, "4.8")
@@ -450,3 +452,11 @@ func TestInstallerCache(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "installercache tests")
 }
+
+var _ = BeforeSuite(func() {
+	common.InitializeDBTest()
+})
+
+var _ = AfterSuite(func() {
+	common.TerminateDBTest()
+})
@@ -0,0 +1,346 @@
+package installercache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/openshift/assisted-service/pkg/transaction"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Tiers and events of the installer cache, as reported by the metrics
+const (
+	tierLocal  = "local"
+	tierShared = "shared"
+
+	cacheEventHit       = "hit"
+	cacheEventMiss      = "miss"
+	cacheEventEviction  = "eviction"
+	cacheEventCorrupted = "corrupted"
+)
+
+// sharedCachePrefix is the prefix of the objects of the shared cache in the object storage
+const sharedCachePrefix = "installer-cache"
+
+var errSharedBinaryCorrupted = errors.New("the installer binary does not match its checksum")
+
+// sharedBinary identifies an installer binary in the shared cache
+type sharedBinary struct {
+	digest  string
+	version string
+	binary  string
+}
+
+// id returns the ID of the entry of the binary. The binary depends on the architecture of the service, as oc extracts
+// the binary of the architecture it runs on.
+func (b sharedBinary) id() string {
+	return path.Join(strings.ReplaceAll(b.digest, ":", "-"), runtime.GOARCH, b.binary)
+}
+
+func sharedObjectName(id string) string {
+	return path.Join(sharedCachePrefix, id)
+}
+
+// sharedCache is the tier of the installer cache that is shared by the replicas of the service. The binaries are
+// stored in the object storage and indexed by entries in the database, which also coordinate the replicas so that
+// each binary is extracted by one replica only.
+type sharedCache struct {
+	db            *gorm.DB
+	objectHandler s3wrapper.API
+	metricsAPI    metrics.API
+	config        Config
+	log           logrus.FieldLogger
+	// owner identifies the replica in the locks it holds
+	owner string
+}
+
+func newSharedCache(config Config, db *gorm.DB, objectHandler s3wrapper.API, metricsAPI metrics.API, log logrus.FieldLogger) *sharedCache {
+	owner := uuid.NewString()
+	if hostname, err := os.Hostname(); err == nil {
+		owner = fmt.Sprintf("%s-%s", hostname, owner)
+	}
+	return &sharedCache{
+		db:            db,
+		objectHandler: objectHandler,
+		metricsAPI:    metricsAPI,
+		config:        config,
+		log:           log,
+		owner:         owner,
+	}
+}
+
+// fetch places the binary at path, by downloading it from the shared cache if it is stored there, otherwise by
+// calling extract and then storing it. While another replica extracts the binary, fetch waits for it to be stored.
+// Failures of the shared cache are not returned, the binary is extracted instead. Returns true if the binary was
+// downloaded from the shared cache.
+func (s *sharedCache) fetch(ctx context.Context, b sharedBinary, path string, extract func() error) (bool, error) {
+	for {
+		entry, locked, err := s.lock(b)
+		if err != nil {
+			s.log.WithError(err).Warnf("failed to lock installer binary %s in the shared installer cache", b.id())
+			return false, extract()
+		}
+		if entry.SHA256 != "" {
+			err = s.download(ctx, entry, path)
+			if err == nil {
+				s.metricsAPI.InstallerCacheReleaseEvent(tierShared, b.version, cacheEventHit)
+				return true, nil
+			}
+			s.log.WithError(err).Warnf("failed to download installer binary %s from the shared installer cache", entry.ID)
+			if !errors.Is(err, errSharedBinaryCorrupted) && !isNotFound(err) {
+				return false, extract()
+			}
+			// The binary is removed so that it is extracted again
+			if errors.Is(err, errSharedBinaryCorrupted) {
+				s.metricsAPI.InstallerCacheReleaseEvent(tierShared, b.version, cacheEventCorrupted)
+			}
+			if err = s.remove(ctx, entry); err != nil {
+				s.log.WithError(err).Warnf("failed to remove installer binary %s from the shared installer cache", entry.ID)
+				return false, extract()
+			}
+			continue
+		}
+		if locked {
+			s.metricsAPI.InstallerCacheReleaseEvent(tierShared, b.version, cacheEventMiss)
+			return false, s.extractAndStore(ctx, entry, path, extract)
+		}
+		s.log.Debugf("waiting for installer binary %s to be extracted by %s", entry.ID, entry.Owner)
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-time.After(s.config.SharedPollInterval):
+		}
+	}
+}
+
+// lock returns the entry of the binary, which is created if it doesn't exist. It returns true if the binary isn't
+// stored yet and the lock of the entry was acquired, in which case the caller is expected to extract and store it.
+func (s *sharedCache) lock(b sharedBinary) (*common.InstallerCacheEntry, bool, error) {
+	now := time.Now()
+	entry := &common.InstallerCacheEntry{
+		ID:             b.id(),
+		ReleaseDigest:  b.digest,
+		ReleaseVersion: b.version,
+		Binary:         b.binary,
+		Owner:          s.owner,
+		LockExpiresAt:  now.Add(s.config.SharedLockTimeout),
+		LastUsedAt:     now,
+	}
+	locked := false
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(entry)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 1 {
+			locked = true
+			return nil
+		}
+		entry = &common.InstallerCacheEntry{}
+		if err := transaction.AddForUpdateQueryOption(tx).Take(entry, "id = ?", b.id()).Error; err != nil {
+			return err
+		}
+		if entry.SHA256 != "" || (entry.Owner != s.owner && entry.LockExpiresAt.After(now)) {
+			return nil
+		}
+		if entry.Owner != s.owner {
+			s.log.Infof("the extraction of installer binary %s by %s timed out, taking it over", entry.ID, entry.Owner)
+		}
+		locked = true
+		entry.Owner = s.owner
+		entry.LockExpiresAt = now.Add(s.config.SharedLockTimeout)
+		return tx.Model(entry).Updates(map[string]interface{}{
+			"owner":           entry.Owner,
+			"lock_expires_at": entry.LockExpiresAt,
+		}).Error
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return entry, locked, nil
+}
+
+// unlock deletes the entry of a binary that could not be stored, so that the replicas that wait for it extract it
+func (s *sharedCache) unlock(entry *common.InstallerCacheEntry) {
+	err := s.db.Where("id = ? and owner = ? and sha256 = ''", entry.ID, s.owner).Delete(&common.InstallerCacheEntry{}).Error
+	if err != nil {
+		s.log.WithError(err).Warnf("failed to unlock installer binary %s in the shared installer cache", entry.ID)
+	}
+}
+
+func (s *sharedCache) extractAndStore(ctx context.Context, entry *common.InstallerCacheEntry, path string, extract func() error) error {
+	if err := extract(); err != nil {
+		s.unlock(entry)
+		return err
+	}
+	if err := s.store(ctx, entry, path); err != nil {
+		s.log.WithError(err).Warnf("failed to store installer binary %s in the shared installer cache", entry.ID)
+		s.unlock(entry)
+		return nil
+	}
+	s.evict(ctx)
+	return nil
+}
+
+// store uploads the binary and records its checksum, which marks it as stored
+func (s *sharedCache) store(ctx context.Context, entry *common.InstallerCacheEntry, path string) error {
+	checksum, size, err := fileChecksum(path)
+	if err != nil {
+		return err
+	}
+	if err = s.objectHandler.UploadFile(ctx, path, sharedObjectName(entry.ID)); err != nil {
+		return err
+	}
+	result := s.db.Model(&common.InstallerCacheEntry{}).Where("id = ? and owner = ?", entry.ID, s.owner).Updates(map[string]interface{}{
+		"sha256":       checksum,
+		"size":         size,
+		"owner":        "",
+		"last_used_at": time.Now(),
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		s.log.Warnf("the lock of installer binary %s was taken over before it was stored", entry.ID)
+		return nil
+	}
+	s.log.Infof("stored installer binary %s of release %s in the shared installer cache", entry.ID, entry.ReleaseVersion)
+	return nil
+}
+
+// download writes the binary to path after verifying it against its checksum
+func (s *sharedCache) download(ctx context.Context, entry *common.InstallerCacheEntry, path string) error {
+	reader, _, err := s.objectHandler.Download(ctx, sharedObjectName(entry.ID))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	dir := filepath.Dir(path)
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(dir, "download_")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(file, hash), reader)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to download installer binary %s: %w", entry.ID, err)
+	}
+	if size != entry.Size || hex.EncodeToString(hash.Sum(nil)) != entry.SHA256 {
+		return fmt.Errorf("%w: installer binary %s", errSharedBinaryCorrupted, entry.ID)
+	}
+	if err = os.Chmod(file.Name(), 0755); err != nil {
+		return err
+	}
+	if err = os.Rename(file.Name(), path); err != nil {
+		return err
+	}
+
+	err = s.db.Model(&common.InstallerCacheEntry{}).Where("id = ?", entry.ID).Update("last_used_at", time.Now()).Error
+	if err != nil {
+		s.log.WithError(err).Warnf("failed to update the last use of installer binary %s", entry.ID)
+	}
+	return nil
+}
+
+// remove deletes a stored binary and its entry
+func (s *sharedCache) remove(ctx context.Context, entry *common.InstallerCacheEntry) error {
+	err := s.db.Where("id = ? and sha256 = ?", entry.ID, entry.SHA256).Delete(&common.InstallerCacheEntry{}).Error
+	if err != nil {
+		return err
+	}
+	if _, err = s.objectHandler.DeleteObject(ctx, sharedObjectName(entry.ID)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// evict removes the least recently used binaries until the stored binaries fit in the capacity of the shared cache
+func (s *sharedCache) evict(ctx context.Context) {
+	if s.config.SharedCapacity == 0 {
+		return
+	}
+	var entries []*common.InstallerCacheEntry
+	if err := s.db.Where("sha256 <> ''").Order("last_used_at").Find(&entries).Error; err != nil {
+		s.log.WithError(err).Warn("failed to list the binaries of the shared installer cache")
+		return
+	}
+	var pinnedDigests []string
+	err := s.db.Model(&common.InstallerCacheRelease{}).Where("pinned = ? and release_digest <> ''", true).
+		Pluck("release_digest", &pinnedDigests).Error
+	if err != nil {
+		s.log.WithError(err).Warn("failed to list the pinned releases of the shared installer cache")
+		return
+	}
+	var totalSize int64
+	for _, entry := range entries {
+		totalSize += entry.Size
+	}
+	for _, entry := range entries {
+		if totalSize <= int64(s.config.SharedCapacity) {
+			return
+		}
+		// Pinned releases use capacity but are never evicted
+		if funk.ContainsString(pinnedDigests, entry.ReleaseDigest) {
+			continue
+		}
+		// Binaries that were used since they were listed are skipped
+		result := s.db.Where("id = ? and last_used_at = ?", entry.ID, entry.LastUsedAt).Delete(&common.InstallerCacheEntry{})
+		if result.Error != nil {
+			s.log.WithError(result.Error).Warnf("failed to evict installer binary %s from the shared installer cache", entry.ID)
+			continue
+		}
+		if result.RowsAffected == 0 {
+			continue
+		}
+		s.log.Infof("evicting installer binary %s of release %s from the shared installer cache", entry.ID, entry.ReleaseVersion)
+		if _, err := s.objectHandler.DeleteObject(ctx, sharedObjectName(entry.ID)); err != nil {
+			s.log.WithError(err).Warnf("failed to delete installer binary %s from the object storage", entry.ID)
+		}
+		totalSize -= entry.Size
+		s.metricsAPI.InstallerCacheReleaseEvent(tierShared, entry.ReleaseVersion, cacheEventEviction)
+	}
+}
+
+func fileChecksum(path string) (string, int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", 0, err
+	}
+	defer file.Close()
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to read installer binary %s: %w", path, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
+
+func isNotFound(err error) bool {
+	var notFound common.NotFound
+	return errors.As(err, &notFound)
+}
@@ -0,0 +1,397 @@
+package installercache
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/oc"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("shared installer cache", func() {
+	const (
+		releaseID     = "quay.io/openshift-release-dev/ocp-release:4.17.11-x86_64"
+		version       = "4.17.11"
+		binary        = "openshift-install"
+		releaseDigest = "sha256:7c0c4ad8b36ef3e3ac3ff2a2a6b3a1e0a1d40b4e0ab5a3f6ec5e1c1e1a0d5a11"
+		content       = "installer"
+	)
+
+	var (
+		ctx           context.Context
+		ctrl          *gomock.Controller
+		db            *gorm.DB
+		dbName        string
+		mockS3        *s3wrapper.MockAPI
+		eventsHandler *eventsapi.MockHandler
+		metricsAPI    *metrics.MockAPI
+		objects       map[string][]byte
+		objectsMutex  sync.Mutex
+		sharedEvents  []string
+		eventsMutex   sync.Mutex
+		cacheDirs     []string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+		db, dbName = common.PrepareTestDB()
+		mockS3 = s3wrapper.NewMockAPI(ctrl)
+		eventsHandler = eventsapi.NewMockHandler(ctrl)
+		metricsAPI = metrics.NewMockAPI(ctrl)
+		objects = map[string][]byte{}
+		sharedEvents = nil
+		cacheDirs = nil
+
+		mockS3.EXPECT().UploadFile(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, filePath, objectName string) error {
+				data, err := os.ReadFile(filePath)
+				if err != nil {
+					return err
+				}
+				objectsMutex.Lock()
+				defer objectsMutex.Unlock()
+				objects[objectName] = data
+				return nil
+			}).AnyTimes()
+		mockS3.EXPECT().Download(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, objectName string) (io.ReadCloser, int64, error) {
+				objectsMutex.Lock()
+				defer objectsMutex.Unlock()
+				data, ok := objects[objectName]
+				if !ok {
+					return nil, 0, common.NotFound(objectName)
+				}
+				return io.NopCloser(bytes.NewReader(data)), int64(len(data)), nil
+			}).AnyTimes()
+		mockS3.EXPECT().DeleteObject(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, objectName string) (bool, error) {
+				objectsMutex.Lock()
+				defer objectsMutex.Unlock()
+				_, ok := objects[objectName]
+				delete(objects, objectName)
+				return ok, nil
+			}).AnyTimes()
+
+		eventsHandler.EXPECT().V2AddMetricsEvent(gomock.Any(), gomock.Any(), nil, nil, "", gomock.Any(), metricEventInstallerCacheRelease,
+			gomock.Any(), gomock.Any()).AnyTimes()
+		metricsAPI.EXPECT().InstallerCacheGetReleaseCached(gomock.Any(), gomock.Any()).AnyTimes()
+		metricsAPI.EXPECT().InstallerCacheReleaseEvicted(gomock.Any()).AnyTimes()
+		metricsAPI.EXPECT().InstallerCacheReleaseEvent(tierLocal, gomock.Any(), gomock.Any()).AnyTimes()
+		metricsAPI.EXPECT().InstallerCacheReleaseEvent(tierShared, gomock.Any(), gomock.Any()).Do(
+			func(_, releaseVersion, event string) {
+				eventsMutex.Lock()
+				defer eventsMutex.Unlock()
+				sharedEvents = append(sharedEvents, releaseVersion+"/"+event)
+			}).AnyTimes()
+	})
+
+	AfterEach(func() {
+		for _, dir := range cacheDirs {
+			os.RemoveAll(dir)
+		}
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	getSharedEvents := func() []string {
+		eventsMutex.Lock()
+		defer eventsMutex.Unlock()
+		return append([]string{}, sharedEvents...)
+	}
+
+	sharedConfig := func() Config {
+		cacheDir, err := os.MkdirTemp("", "sharedCacheDir")
+		Expect(err).ToNot(HaveOccurred())
+		cacheDirs = append(cacheDirs, cacheDir)
+		return Config{
+			CacheDir:                  cacheDir,
+			ReleaseFetchRetryInterval: time.Millisecond,
+			SharedCache:               true,
+			SharedLockTimeout:         time.Minute,
+			SharedPollInterval:        10 * time.Millisecond,
+		}
+	}
+
+	// newReplica returns the installer cache of a replica of the service, which has its own local cache directory
+	newReplica := func(config Config) *Installers {
+		installers, err := New(config, db, mockS3, eventsHandler, metricsAPI, metrics.NewOSDiskStatsHelper(logrus.New()), logrus.New())
+		Expect(err).ToNot(HaveOccurred())
+		return installers
+	}
+
+	// mockRelease mocks a release that is extracted into the cache directory of the replica, extractions counts the
+	// number of times the release was extracted
+	mockRelease := func(installers *Installers, releaseID, version, digest, content string, extractions *int) *oc.MockRelease {
+		release := oc.NewMockRelease(ctrl)
+		workdir := filepath.Join(installers.config.CacheDir, releaseID)
+		path := filepath.Join(workdir, binary)
+		release.EXPECT().GetMajorMinorVersion(gomock.Any(), releaseID, gomock.Any(), gomock.Any()).Return("4.17", nil).AnyTimes()
+		release.EXPECT().GetReleaseBinaryPath(releaseID, installers.config.CacheDir, version).Return(workdir, binary, path, nil).AnyTimes()
+		release.EXPECT().GetReleaseDigest(gomock.Any(), releaseID, gomock.Any(), gomock.Any()).Return(digest, nil).AnyTimes()
+		release.EXPECT().Extract(gomock.Any(), releaseID, gomock.Any(), installers.config.CacheDir, gomock.Any(), version).DoAndReturn(
+			func(_ logrus.FieldLogger, _, _, _, _, _ string) (string, error) {
+				*extractions++
+				Expect(os.MkdirAll(workdir, 0755)).To(Succeed())
+				return path, os.WriteFile(path, []byte(content), 0600)
+			}).AnyTimes()
+		return release
+	}
+
+	get := func(installers *Installers, release oc.Release, releaseID, version string) *Release {
+		r, err := installers.Get(ctx, releaseID, "", "pull-secret", release, version, strfmt.UUID(uuid.NewString()))
+		Expect(err).ToNot(HaveOccurred())
+		data, err := os.ReadFile(r.Path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(content))
+		Expect(r.Cleanup(ctx)).To(Succeed())
+		return r
+	}
+
+	getEntry := func(releaseDigest string) *common.InstallerCacheEntry {
+		entry := &common.InstallerCacheEntry{}
+		Expect(db.Take(entry, "release_digest = ?", releaseDigest).Error).To(Succeed())
+		return entry
+	}
+
+	checksum := func(data string) string {
+		sum := sha256.Sum256([]byte(data))
+		return hex.EncodeToString(sum[:])
+	}
+
+	It("reuses the binary that was extracted by another replica", func() {
+		var extractions int
+		first := newReplica(sharedConfig())
+		r := get(first, mockRelease(first, releaseID, version, releaseDigest, content, &extractions), releaseID, version)
+		Expect(r.cached).To(BeFalse())
+		Expect(extractions).To(Equal(1))
+
+		entry := getEntry(releaseDigest)
+		Expect(entry.SHA256).To(Equal(checksum(content)))
+		Expect(entry.Size).To(Equal(int64(len(content))))
+		Expect(entry.ReleaseVersion).To(Equal(version))
+		Expect(entry.Owner).To(BeEmpty())
+		Expect(objects).To(HaveKeyWithValue(sharedObjectName(entry.ID), []byte(content)))
+
+		second := newReplica(sharedConfig())
+		r = get(second, mockRelease(second, releaseID, version, releaseDigest, content, &extractions), releaseID, version)
+		Expect(r.cached).To(BeTrue())
+		Expect(r.extractDuration).To(BeZero())
+		Expect(extractions).To(Equal(1))
+		info, err := os.Stat(filepath.Join(second.config.CacheDir, releaseID, binary))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0755)))
+		Expect(getSharedEvents()).To(Equal([]string{version + "/" + cacheEventMiss, version + "/" + cacheEventHit}))
+	})
+
+	It("extracts the binary again when the stored binary is corrupted", func() {
+		var extractions int
+		first := newReplica(sharedConfig())
+		get(first, mockRelease(first, releaseID, version, releaseDigest, content, &extractions), releaseID, version)
+		entry := getEntry(releaseDigest)
+		objects[sharedObjectName(entry.ID)] = []byte("corrupted")
+
+		second := newReplica(sharedConfig())
+		r := get(second, mockRelease(second, releaseID, version, releaseDigest, content, &extractions), releaseID, version)
+		Expect(r.cached).To(BeFalse())
+		Expect(extractions).To(Equal(2))
+		Expect(objects).To(HaveKeyWithValue(sharedObjectName(entry.ID), []byte(content)))
+		Expect(getSharedEvents()).To(ContainElement(version + "/" + cacheEventCorrupted))
+	})
+
+	It("extracts the binary again when the stored binary is missing", func() {
+		var extractions int
+		first := newReplica(sharedConfig())
+		get(first, mockRelease(first, releaseID, version, releaseDigest, content, &extractions), releaseID, version)
+		entry := getEntry(releaseDigest)
+		delete(objects, sharedObjectName(entry.ID))
+
+		second := newReplica(sharedConfig())
+		r := get(second, mockRelease(second, releaseID, version, releaseDigest, content, &extractions), releaseID, version)
+		Expect(r.cached).To(BeFalse())
+		Expect(extractions).To(Equal(2))
+		Expect(getEntry(releaseDigest).SHA256).To(Equal(checksum(content)))
+	})
+
+	It("waits for the binary that another replica extracts", func() {
+		var extractions int
+		installers := newReplica(sharedConfig())
+		release := mockRelease(installers, releaseID, version, releaseDigest, content, &extractions)
+		id := sharedBinary{digest: releaseDigest, version: version, binary: binary}.id()
+		Expect(db.Create(&common.InstallerCacheEntry{
+			ID:            id,
+			ReleaseDigest: releaseDigest,
+			Owner:         "other-replica",
+			LockExpiresAt: time.Now().Add(time.Hour),
+		}).Error).To(Succeed())
+
+		done := make(chan *Release)
+		go func() {
+			defer GinkgoRecover()
+			done <- get(installers, release, releaseID, version)
+		}()
+		Consistently(done, 100*time.Millisecond).ShouldNot(Receive())
+
+		objectsMutex.Lock()
+		objects[sharedObjectName(id)] = []byte(content)
+		objectsMutex.Unlock()
+		Expect(db.Model(&common.InstallerCacheEntry{}).Where("id = ?", id).Updates(map[string]interface{}{
+			"sha256": checksum(content),
+			"size":   len(content),
+			"owner":  "",
+		}).Error).To(Succeed())
+
+		var r *Release
+		Eventually(done, 5*time.Second).Should(Receive(&r))
+		Expect(r.cached).To(BeTrue())
+		Expect(extractions).To(BeZero())
+	})
+
+	It("takes over the extraction of a replica whose lock expired", func() {
+		var extractions int
+		installers := newReplica(sharedConfig())
+		id := sharedBinary{digest: releaseDigest, version: version, binary: binary}.id()
+		Expect(db.Create(&common.InstallerCacheEntry{
+			ID:            id,
+			ReleaseDigest: releaseDigest,
+			Owner:         "other-replica",
+			LockExpiresAt: time.Now().Add(-time.Minute),
+		}).Error).To(Succeed())
+
+		r := get(installers, mockRelease(installers, releaseID, version, releaseDigest, content, &extractions), releaseID, version)
+		Expect(r.cached).To(BeFalse())
+		Expect(extractions).To(Equal(1))
+		entry := getEntry(releaseDigest)
+		Expect(entry.SHA256).To(Equal(checksum(content)))
+		Expect(entry.Owner).To(BeEmpty())
+	})
+
+	It("extracts the binary only once when replicas request it at the same time", func() {
+		var wg sync.WaitGroup
+		var extractionsMutex sync.Mutex
+		var extractions int
+		replicas := make([]*Installers, 3)
+		releases := make([]*oc.MockRelease, 3)
+		for i := range replicas {
+			replicas[i] = newReplica(sharedConfig())
+			releases[i] = oc.NewMockRelease(ctrl)
+			workdir := filepath.Join(replicas[i].config.CacheDir, releaseID)
+			path := filepath.Join(workdir, binary)
+			releases[i].EXPECT().GetMajorMinorVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("4.17", nil).AnyTimes()
+			releases[i].EXPECT().GetReleaseBinaryPath(gomock.Any(), gomock.Any(), gomock.Any()).Return(workdir, binary, path, nil).AnyTimes()
+			releases[i].EXPECT().GetReleaseDigest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(releaseDigest, nil).AnyTimes()
+			releases[i].EXPECT().Extract(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ logrus.FieldLogger, _, _, _, _, _ string) (string, error) {
+					extractionsMutex.Lock()
+					extractions++
+					extractionsMutex.Unlock()
+					time.Sleep(50 * time.Millisecond)
+					Expect(os.MkdirAll(workdir, 0755)).To(Succeed())
+					return path, os.WriteFile(path, []byte(content), 0600)
+				}).AnyTimes()
+		}
+		for i := range replicas {
+			wg.Add(1)
+			go func(i int) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				get(replicas[i], releases[i], releaseID, version)
+			}(i)
+		}
+		wg.Wait()
+		Expect(extractions).To(Equal(1))
+	})
+
+	It("evicts the least recently used binaries beyond the capacity", func() {
+		var extractions int
+		config := sharedConfig()
+		config.SharedCapacity = Size(len(content))
+		installers := newReplica(config)
+		get(installers, mockRelease(installers, releaseID, version, releaseDigest, content, &extractions), releaseID, version)
+		firstEntry := getEntry(releaseDigest)
+
+		otherReleaseID := "quay.io/openshift-release-dev/ocp-release:4.17.12-x86_64"
+		otherDigest := "sha256:1f9d1e1b0a3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6"
+		get(installers, mockRelease(installers, otherReleaseID, "4.17.12", otherDigest, content, &extractions), otherReleaseID, "4.17.12")
+
+		Expect(db.Take(&common.InstallerCacheEntry{}, "release_digest = ?", releaseDigest).Error).To(MatchError(gorm.ErrRecordNotFound))
+		Expect(objects).ToNot(HaveKey(sharedObjectName(firstEntry.ID)))
+		Expect(getEntry(otherDigest).SHA256).To(Equal(checksum(content)))
+		Expect(getSharedEvents()).To(ContainElement(version + "/" + cacheEventEviction))
+	})
+
+	It("doesn't evict the binaries of pinned releases", func() {
+		var extractions int
+		config := sharedConfig()
+		config.SharedCapacity = Size(len(content))
+		installers := newReplica(config)
+		Expect(db.Create(&common.InstallerCacheRelease{ReleaseImage: releaseID, ReleaseDigest: releaseDigest, Pinned: true}).Error).To(Succeed())
+		get(installers, mockRelease(installers, releaseID, version, releaseDigest, content, &extractions), releaseID, version)
+
+		otherReleaseID := "quay.io/openshift-release-dev/ocp-release:4.17.12-x86_64"
+		otherDigest := "sha256:1f9d1e1b0a3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6"
+		get(installers, mockRelease(installers, otherReleaseID, "4.17.12", otherDigest, content, &extractions), otherReleaseID, "4.17.12")
+
+		Expect(getEntry(releaseDigest).SHA256).To(Equal(checksum(content)))
+		Expect(db.Take(&common.InstallerCacheEntry{}, "release_digest = ?", otherDigest).Error).To(MatchError(gorm.ErrRecordNotFound))
+	})
+
+	It("extracts the binary without the shared cache when the digest of the release is unknown", func() {
+		var extractions int
+		installers := newReplica(sharedConfig())
+		release := oc.NewMockRelease(ctrl)
+		workdir := filepath.Join(installers.config.CacheDir, releaseID)
+		path := filepath.Join(workdir, binary)
+		release.EXPECT().GetMajorMinorVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("4.17", nil).AnyTimes()
+		release.EXPECT().GetReleaseBinaryPath(gomock.Any(), gomock.Any(), gomock.Any()).Return(workdir, binary, path, nil).AnyTimes()
+		release.EXPECT().GetReleaseDigest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("no digest"))
+		release.EXPECT().Extract(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ logrus.FieldLogger, _, _, _, _, _ string) (string, error) {
+				extractions++
+				Expect(os.MkdirAll(workdir, 0755)).To(Succeed())
+				return path, os.WriteFile(path, []byte(content), 0600)
+			})
+
+		r := get(installers, release, releaseID, version)
+		Expect(r.cached).To(BeFalse())
+		Expect(extractions).To(Equal(1))
+		Expect(objects).To(BeEmpty())
+	})
+
+	It("releases the lock when the extraction fails", func() {
+		installers := newReplica(sharedConfig())
+		release := oc.NewMockRelease(ctrl)
+		workdir := filepath.Join(installers.config.CacheDir, releaseID)
+		release.EXPECT().GetMajorMinorVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("4.17", nil).AnyTimes()
+		release.EXPECT().GetReleaseBinaryPath(gomock.Any(), gomock.Any(), gomock.Any()).Return(workdir, binary, filepath.Join(workdir, binary), nil).AnyTimes()
+		release.EXPECT().GetReleaseDigest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(releaseDigest, nil)
+		release.EXPECT().Extract(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("extraction failed"))
+
+		_, err := installers.Get(ctx, releaseID, "", "pull-secret", release, version, strfmt.UUID(uuid.NewString()))
+		Expect(err).To(MatchError(ContainSubstring("extraction failed")))
+		Expect(db.Take(&common.InstallerCacheEntry{}, "release_digest = ?", releaseDigest).Error).To(MatchError(gorm.ErrRecordNotFound))
+	})
+
+	It("requires a database and an object storage", func() {
+		_, err := New(sharedConfig(), nil, nil, eventsHandler, metricsAPI, metrics.NewOSDiskStatsHelper(logrus.New()), logrus.New())
+		Expect(err).To(MatchError("the shared installer cache requires a database and an object storage"))
+	})
+})
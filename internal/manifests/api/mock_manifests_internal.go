@@ -81,6 +81,21 @@ func (mr *MockClusterManifestsInternalsMockRecorder) FindUserManifestPathsByLega
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUserManifestPathsByLegacyMetadata", reflect.TypeOf((*MockClusterManifestsInternals)(nil).FindUserManifestPathsByLegacyMetadata), arg0, arg1)
 }
 
+// GetClusterManifestContentInternal mocks base method.
+func (m *MockClusterManifestsInternals) GetClusterManifestContentInternal(arg0 context.Context, arg1 strfmt.UUID, arg2, arg3 string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterManifestContentInternal", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterManifestContentInternal indicates an expected call of GetClusterManifestContentInternal.
+func (mr *MockClusterManifestsInternalsMockRecorder) GetClusterManifestContentInternal(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterManifestContentInternal", reflect.TypeOf((*MockClusterManifestsInternals)(nil).GetClusterManifestContentInternal), arg0, arg1, arg2, arg3)
+}
+
 // ListClusterManifestsInternal mocks base method.
 func (m *MockClusterManifestsInternals) ListClusterManifestsInternal(arg0 context.Context, arg1 manifests.V2ListClusterManifestsParams) (models.ListManifests, error) {
 	m.ctrl.T.Helper()
@@ -281,6 +281,13 @@ func (m *Manifests) UpdateClusterManifestInternal(ctx context.Context, params op
 	return &manifest, nil
 }
 
+func (m *Manifests) GetClusterManifestContentInternal(ctx context.Context, clusterID strfmt.UUID, folder string, fileName string) ([]byte, error) {
+	if _, err := common.GetClusterFromDB(m.db, clusterID, common.SkipEagerLoading); err != nil {
+		return nil, common.NewApiError(http.StatusNotFound, fmt.Errorf("Object Not Found"))
+	}
+	return m.fetchManifestContent(ctx, clusterID, folder, fileName)
+}
+
 func (m *Manifests) V2DownloadClusterManifest(ctx context.Context, params operations.V2DownloadClusterManifestParams) middleware.Responder {
 	log := logutil.FromContext(ctx, m.log)
 	if params.Folder == nil {
@@ -44,6 +44,11 @@ const (
 	histogramMonitoredHostsCycleDurationMs        = "assisted_installer_monitored_hosts_cycle_duration_ms"
 	counterInstallerReleaseCache                  = "assisted_installer_release_cache"
 	counterInstallerReleaseCacheEviction          = "assisted_installer_release_cache_eviction"
+	counterInstallerReleaseCacheEvents            = "assisted_installer_release_cache_events"
+	counterNotificationDeliveries                 = "assisted_installer_notification_deliveries"
+	histogramNotificationDeliveryDurationMs       = "assisted_installer_notification_delivery_duration_ms"
+	gaugeSpokeClusterHealthy                      = "assisted_installer_spoke_cluster_healthy"
+	gaugeSpokeClusterCertificateExpiry            = "assisted_installer_spoke_cluster_certificate_expiry_timestamp_seconds"
 	// blacklist metrics
 	counterClusterBlacklistedEvents = "assisted_installer_cluster_blacklisted_events_total"
 	gaugeBlacklistedClustersCurrent = "assisted_installer_blacklisted_clusters_current"
@@ -73,6 +78,11 @@ const (
 	histogramDescriptionMonitoredHostsCycleDurationMs        = "Histogram/sum/count of full monitoring cycle duration (ms) with fullscan label"
 	counterDescriptionInstallerReleaseCache                  = "Counts the cache hit status for the labelled release"
 	counterDescriptionInstallerReleaseCacheEviction          = "Counts the number of times that at least one release was evicted"
+	counterDescriptionInstallerReleaseCacheEvents            = "Counts the hits, misses and evictions of the installer cache tiers, by tier, release, event"
+	counterDescriptionNotificationDeliveries                 = "Number of notifications delivered by the notification stream writers, by writer, success"
+	histogramDescriptionNotificationDeliveryDurationMs       = "Histogram/sum/count of notification delivery duration (ms), by writer"
+	gaugeDescriptionSpokeClusterHealthy                      = "Whether a health check of an installed cluster passes, by cluster namespace, cluster name, check"
+	gaugeDescriptionSpokeClusterCertificateExpiry            = "The expiry time of the first certificate of an installed cluster to expire, by cluster namespace, cluster name"
 	// blacklist metric descriptions
 	counterDescriptionClusterBlacklistedEvents = "Counts cluster blacklisting events (no cluster labels to avoid high cardinality)"
 	gaugeDescriptionBlacklistedClustersCurrent = "Current number of clusters that are blacklisted"
@@ -96,6 +106,12 @@ const (
 	labelReleaseID             = "releaseId"
 	labelSuccess               = "success"
 	labelFullScan              = "fullscan"
+	labelWriter                = "writer"
+	labelTier                  = "tier"
+	labelEvent                 = "event"
+	labelClusterNamespace      = "clusterNamespace"
+	labelClusterName           = "clusterName"
+	labelCheck                 = "check"
 )
 
 type API interface {
@@ -117,6 +133,11 @@ type API interface {
 	MonitoredHostsCycleDurationMs(ctx context.Context, duration time.Duration, fullScan bool)
 	InstallerCacheGetReleaseCached(releaseId string, cacheHit bool)
 	InstallerCacheReleaseEvicted(success bool)
+	InstallerCacheReleaseEvent(tier, releaseId, event string)
+	NotificationDelivered(writer string, success bool, duration time.Duration)
+	SpokeClusterHealthChecked(clusterNamespace, clusterName, check string, healthy bool)
+	SpokeClusterCertificateExpiry(clusterNamespace, clusterName string, expiry time.Time)
+	SpokeClusterHealthRemoved(clusterNamespace, clusterName string)
 	// blacklist metrics
 	BlacklistedClusterInc()
 	BlacklistedClustersCurrent(count int)
@@ -150,6 +171,11 @@ type MetricsManager struct {
 	serviceLogicMonitoredHostsCycleDurationMs          *prometheus.HistogramVec
 	serviceLogicInstallerReleaseCache                  *prometheus.CounterVec
 	serviceLogicInstallerReleaseEvicted                *prometheus.CounterVec
+	serviceLogicInstallerReleaseCacheEvents            *prometheus.CounterVec
+	serviceLogicNotificationDeliveries                 *prometheus.CounterVec
+	serviceLogicNotificationDeliveryDurationMs         *prometheus.HistogramVec
+	serviceLogicSpokeClusterHealthy                    *prometheus.GaugeVec
+	serviceLogicSpokeClusterCertificateExpiry          *prometheus.GaugeVec
 	// blacklist metrics
 	serviceLogicClusterBlacklistedEvents   *prometheus.CounterVec
 	serviceLogicBlacklistedClustersCurrent *prometheus.GaugeVec
@@ -363,6 +389,46 @@ func NewMetricsManager(registry prometheus.Registerer, eventsHandler eventsapi.H
 				Help:      counterDescriptionInstallerReleaseCacheEviction,
 			}, []string{labelSuccess}),
 
+		serviceLogicInstallerReleaseCacheEvents: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      counterInstallerReleaseCacheEvents,
+				Help:      counterDescriptionInstallerReleaseCacheEvents,
+			}, []string{labelTier, labelReleaseID, labelEvent}),
+
+		serviceLogicNotificationDeliveries: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      counterNotificationDeliveries,
+				Help:      counterDescriptionNotificationDeliveries,
+			}, []string{labelWriter, labelSuccess}),
+
+		serviceLogicNotificationDeliveryDurationMs: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      histogramNotificationDeliveryDurationMs,
+			Help:      histogramDescriptionNotificationDeliveryDurationMs,
+			Buckets:   []float64{1, 5, 10, 50, 100, 500, 1000, 5000, 10000, 30000},
+		}, []string{labelWriter}),
+
+		serviceLogicSpokeClusterHealthy: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      gaugeSpokeClusterHealthy,
+				Help:      gaugeDescriptionSpokeClusterHealthy,
+			}, []string{labelClusterNamespace, labelClusterName, labelCheck}),
+
+		serviceLogicSpokeClusterCertificateExpiry: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      gaugeSpokeClusterCertificateExpiry,
+				Help:      gaugeDescriptionSpokeClusterCertificateExpiry,
+			}, []string{labelClusterNamespace, labelClusterName}),
+
 		// blacklist metrics
 		serviceLogicClusterBlacklistedEvents: prometheus.NewCounterVec(
 			prometheus.CounterOpts{
@@ -406,6 +472,11 @@ func NewMetricsManager(registry prometheus.Registerer, eventsHandler eventsapi.H
 		m.serviceLogicMonitoredHostsCycleDurationMs,
 		m.serviceLogicInstallerReleaseCache,
 		m.serviceLogicInstallerReleaseEvicted,
+		m.serviceLogicInstallerReleaseCacheEvents,
+		m.serviceLogicNotificationDeliveries,
+		m.serviceLogicNotificationDeliveryDurationMs,
+		m.serviceLogicSpokeClusterHealthy,
+		m.serviceLogicSpokeClusterCertificateExpiry,
 		// blacklist metrics
 		m.serviceLogicClusterBlacklistedEvents,
 		m.serviceLogicBlacklistedClustersCurrent,
@@ -617,3 +688,36 @@ func (m *MetricsManager) InstallerCacheGetReleaseCached(releaseId string, cacheH
 func (m *MetricsManager) InstallerCacheReleaseEvicted(success bool) {
 	m.serviceLogicInstallerReleaseEvicted.WithLabelValues(fmt.Sprintf("%t", success)).Inc()
 }
+
+func (m *MetricsManager) InstallerCacheReleaseEvent(tier, releaseId, event string) {
+	m.serviceLogicInstallerReleaseCacheEvents.WithLabelValues(tier, releaseId, event).Inc()
+}
+
+func (m *MetricsManager) NotificationDelivered(writer string, success bool, duration time.Duration) {
+	m.serviceLogicNotificationDeliveries.WithLabelValues(writer, fmt.Sprintf("%t", success)).Inc()
+	m.serviceLogicNotificationDeliveryDurationMs.WithLabelValues(writer).Observe(float64(duration.Milliseconds()))
+}
+
+func boolToFloat(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+// SpokeClusterHealthChecked sets whether the given health check of an installed cluster passes.
+func (m *MetricsManager) SpokeClusterHealthChecked(clusterNamespace, clusterName, check string, healthy bool) {
+	m.serviceLogicSpokeClusterHealthy.WithLabelValues(clusterNamespace, clusterName, check).Set(boolToFloat(healthy))
+}
+
+// SpokeClusterCertificateExpiry sets the expiry time of the first certificate of an installed cluster to expire.
+func (m *MetricsManager) SpokeClusterCertificateExpiry(clusterNamespace, clusterName string, expiry time.Time) {
+	m.serviceLogicSpokeClusterCertificateExpiry.WithLabelValues(clusterNamespace, clusterName).Set(float64(expiry.Unix()))
+}
+
+// SpokeClusterHealthRemoved removes the health metrics of a cluster that is no longer monitored.
+func (m *MetricsManager) SpokeClusterHealthRemoved(clusterNamespace, clusterName string) {
+	labels := prometheus.Labels{labelClusterNamespace: clusterNamespace, labelClusterName: clusterName}
+	m.serviceLogicSpokeClusterHealthy.DeletePartialMatch(labels)
+	m.serviceLogicSpokeClusterCertificateExpiry.DeletePartialMatch(labels)
+}
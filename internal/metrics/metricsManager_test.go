@@ -87,3 +87,65 @@ var _ = DescribeTable(
 		`^service_assisted_installer_host_installation_phase_seconds_count\{.*phase="Configuring".*\} .*$`,
 	),
 )
+
+var _ = Describe("Notification delivery metrics", func() {
+	It("counts deliveries by writer and result", func() {
+		server := NewMetricsServer()
+		defer server.Close()
+		ctrl := gomock.NewController(GinkgoT())
+		defer ctrl.Finish()
+
+		metricsManagerConfig := &MetricsManagerConfig{
+			DirectoryUsageMonitorConfig: DirectoryUsageMonitorConfig{
+				Directories: []string{"/data"}}}
+		manager := NewMetricsManager(server.Registry(), eventsapi.NewMockHandler(ctrl), NewOSDiskStatsHelper(logrus.New()), metricsManagerConfig, logrus.New())
+		manager.NotificationDelivered("webhook", true, 20*time.Millisecond)
+		manager.NotificationDelivered("webhook", false, time.Second)
+
+		metrics := server.Metrics()
+		Expect(metrics).To(MatchLine(`^service_assisted_installer_notification_deliveries\{success="true",writer="webhook"\} 1$`))
+		Expect(metrics).To(MatchLine(`^service_assisted_installer_notification_deliveries\{success="false",writer="webhook"\} 1$`))
+		Expect(metrics).To(MatchLine(`^service_assisted_installer_notification_delivery_duration_ms_count\{writer="webhook"\} 2$`))
+	})
+})
+
+var _ = Describe("Spoke cluster health metrics", func() {
+	var (
+		server  *MetricsServer
+		ctrl    *gomock.Controller
+		manager *MetricsManager
+	)
+
+	BeforeEach(func() {
+		server = NewMetricsServer()
+		ctrl = gomock.NewController(GinkgoT())
+		metricsManagerConfig := &MetricsManagerConfig{
+			DirectoryUsageMonitorConfig: DirectoryUsageMonitorConfig{
+				Directories: []string{"/data"}}}
+		manager = NewMetricsManager(server.Registry(), eventsapi.NewMockHandler(ctrl), NewOSDiskStatsHelper(logrus.New()), metricsManagerConfig, logrus.New())
+		manager.SpokeClusterHealthChecked("ns", "cluster", "nodes", true)
+		manager.SpokeClusterHealthChecked("ns", "cluster", "cluster_operators", false)
+		manager.SpokeClusterHealthChecked("ns", "other", "nodes", true)
+		manager.SpokeClusterCertificateExpiry("ns", "cluster", time.Unix(1700000000, 0))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		server.Close()
+	})
+
+	It("reports the health checks and the certificate expiry by cluster", func() {
+		metrics := server.Metrics()
+		Expect(metrics).To(MatchLine(`^service_assisted_installer_spoke_cluster_healthy\{check="nodes",clusterName="cluster",clusterNamespace="ns"\} 1$`))
+		Expect(metrics).To(MatchLine(`^service_assisted_installer_spoke_cluster_healthy\{check="cluster_operators",clusterName="cluster",clusterNamespace="ns"\} 0$`))
+		Expect(metrics).To(MatchLine(`^service_assisted_installer_spoke_cluster_certificate_expiry_timestamp_seconds\{clusterName="cluster",clusterNamespace="ns"\} 1\.7e\+09$`))
+	})
+
+	It("removes the metrics of a cluster", func() {
+		manager.SpokeClusterHealthRemoved("ns", "cluster")
+		metrics := server.Metrics()
+		Expect(metrics).ToNot(MatchLine(`^service_assisted_installer_spoke_cluster_healthy\{.*clusterName="cluster".*\} .*$`))
+		Expect(metrics).ToNot(MatchLine(`^service_assisted_installer_spoke_cluster_certificate_expiry_timestamp_seconds\{.*\} .*$`))
+		Expect(metrics).To(MatchLine(`^service_assisted_installer_spoke_cluster_healthy\{check="nodes",clusterName="other",clusterNamespace="ns"\} 1$`))
+	})
+})
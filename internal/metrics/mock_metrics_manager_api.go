@@ -205,6 +205,18 @@ func (mr *MockAPIMockRecorder) InstallerCacheGetReleaseCached(releaseId, cacheHi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallerCacheGetReleaseCached", reflect.TypeOf((*MockAPI)(nil).InstallerCacheGetReleaseCached), releaseId, cacheHit)
 }
 
+// InstallerCacheReleaseEvent mocks base method.
+func (m *MockAPI) InstallerCacheReleaseEvent(tier, releaseId, event string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InstallerCacheReleaseEvent", tier, releaseId, event)
+}
+
+// InstallerCacheReleaseEvent indicates an expected call of InstallerCacheReleaseEvent.
+func (mr *MockAPIMockRecorder) InstallerCacheReleaseEvent(tier, releaseId, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallerCacheReleaseEvent", reflect.TypeOf((*MockAPI)(nil).InstallerCacheReleaseEvent), tier, releaseId, event)
+}
+
 // InstallerCacheReleaseEvicted mocks base method.
 func (m *MockAPI) InstallerCacheReleaseEvicted(success bool) {
 	m.ctrl.T.Helper()
@@ -265,6 +277,18 @@ func (mr *MockAPIMockRecorder) MonitoredHostsDurationMs(ctx, hostID, clusterID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MonitoredHostsDurationMs", reflect.TypeOf((*MockAPI)(nil).MonitoredHostsDurationMs), ctx, hostID, clusterID, duration)
 }
 
+// NotificationDelivered mocks base method.
+func (m *MockAPI) NotificationDelivered(writer string, success bool, duration time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotificationDelivered", writer, success, duration)
+}
+
+// NotificationDelivered indicates an expected call of NotificationDelivered.
+func (mr *MockAPIMockRecorder) NotificationDelivered(writer, success, duration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationDelivered", reflect.TypeOf((*MockAPI)(nil).NotificationDelivered), writer, success, duration)
+}
+
 // ReportHostInstallationMetrics mocks base method.
 func (m *MockAPI) ReportHostInstallationMetrics(ctx context.Context, clusterVersion string, clusterID strfmt.UUID, emailDomain string, boot *models.Disk, h *models.Host, previousProgress *models.HostProgressInfo, currentStage models.HostStage) {
 	m.ctrl.T.Helper()
@@ -276,3 +300,39 @@ func (mr *MockAPIMockRecorder) ReportHostInstallationMetrics(ctx, clusterVersion
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportHostInstallationMetrics", reflect.TypeOf((*MockAPI)(nil).ReportHostInstallationMetrics), ctx, clusterVersion, clusterID, emailDomain, boot, h, previousProgress, currentStage)
 }
+
+// SpokeClusterCertificateExpiry mocks base method.
+func (m *MockAPI) SpokeClusterCertificateExpiry(clusterNamespace, clusterName string, expiry time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SpokeClusterCertificateExpiry", clusterNamespace, clusterName, expiry)
+}
+
+// SpokeClusterCertificateExpiry indicates an expected call of SpokeClusterCertificateExpiry.
+func (mr *MockAPIMockRecorder) SpokeClusterCertificateExpiry(clusterNamespace, clusterName, expiry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpokeClusterCertificateExpiry", reflect.TypeOf((*MockAPI)(nil).SpokeClusterCertificateExpiry), clusterNamespace, clusterName, expiry)
+}
+
+// SpokeClusterHealthChecked mocks base method.
+func (m *MockAPI) SpokeClusterHealthChecked(clusterNamespace, clusterName, check string, healthy bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SpokeClusterHealthChecked", clusterNamespace, clusterName, check, healthy)
+}
+
+// SpokeClusterHealthChecked indicates an expected call of SpokeClusterHealthChecked.
+func (mr *MockAPIMockRecorder) SpokeClusterHealthChecked(clusterNamespace, clusterName, check, healthy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpokeClusterHealthChecked", reflect.TypeOf((*MockAPI)(nil).SpokeClusterHealthChecked), clusterNamespace, clusterName, check, healthy)
+}
+
+// SpokeClusterHealthRemoved mocks base method.
+func (m *MockAPI) SpokeClusterHealthRemoved(clusterNamespace, clusterName string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SpokeClusterHealthRemoved", clusterNamespace, clusterName)
+}
+
+// SpokeClusterHealthRemoved indicates an expected call of SpokeClusterHealthRemoved.
+func (mr *MockAPIMockRecorder) SpokeClusterHealthRemoved(clusterNamespace, clusterName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpokeClusterHealthRemoved", reflect.TypeOf((*MockAPI)(nil).SpokeClusterHealthRemoved), clusterNamespace, clusterName)
+}
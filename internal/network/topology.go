@@ -0,0 +1,267 @@
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+// topologyHost is a host of the topology with the addresses of its interfaces, used to resolve the targets of the
+// connectivity checks
+type topologyHost struct {
+	*models.NetworkTopologyHost
+	interfacesByMAC     map[string]string
+	interfacesByAddress map[string]string
+}
+
+// BuildNetworkTopology returns the network topology of the hosts of the cluster, built from the inventories of the hosts,
+// the results of their connectivity checks and the majority groups of the cluster
+func BuildNetworkTopology(cluster *common.Cluster) (*models.NetworkTopology, error) {
+	topology := &models.NetworkTopology{
+		ClusterID: *cluster.ID,
+		Hosts:     make([]*models.NetworkTopologyHost, 0, len(cluster.Hosts)),
+		Subnets:   make([]*models.NetworkTopologySubnet, 0),
+		Links:     make([]*models.NetworkTopologyLink, 0),
+	}
+	if cluster.ConnectivityMajorityGroups != "" {
+		var connectivity Connectivity
+		if err := json.Unmarshal([]byte(cluster.ConnectivityMajorityGroups), &connectivity); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse the connectivity majority groups of cluster %s", cluster.ID)
+		}
+		topology.MajorityGroups = connectivity.MajorityGroups
+	}
+	groups := make([]string, 0, len(topology.MajorityGroups))
+	for group := range topology.MajorityGroups {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+
+	hosts := make([]*models.Host, len(cluster.Hosts))
+	copy(hosts, cluster.Hosts)
+	sort.Slice(hosts, func(i, j int) bool { return hosts[i].ID.String() < hosts[j].ID.String() })
+
+	hostsByID := make(map[strfmt.UUID]*topologyHost, len(hosts))
+	subnets := make(map[string]*models.NetworkTopologySubnet)
+	for _, h := range hosts {
+		th, err := newTopologyHost(h, groups, topology.MajorityGroups)
+		if err != nil {
+			return nil, err
+		}
+		hostsByID[*h.ID] = th
+		topology.Hosts = append(topology.Hosts, th.NetworkTopologyHost)
+		for _, cidr := range th.subnets() {
+			subnet, ok := subnets[cidr]
+			if !ok {
+				subnet = &models.NetworkTopologySubnet{
+					Cidr:           cidr,
+					MachineNetwork: funk.ContainsString(GetMachineNetworkCidrs(cluster), cidr),
+				}
+				subnets[cidr] = subnet
+				topology.Subnets = append(topology.Subnets, subnet)
+			}
+			subnet.HostIds = append(subnet.HostIds, *h.ID)
+		}
+	}
+	sort.Slice(topology.Subnets, func(i, j int) bool { return topology.Subnets[i].Cidr < topology.Subnets[j].Cidr })
+
+	for _, h := range hosts {
+		links, err := topologyLinks(h, hostsByID)
+		if err != nil {
+			return nil, err
+		}
+		topology.Links = append(topology.Links, links...)
+	}
+	return topology, nil
+}
+
+func newTopologyHost(h *models.Host, groups []string, majorityGroups map[string][]strfmt.UUID) (*topologyHost, error) {
+	ret := &topologyHost{
+		NetworkTopologyHost: &models.NetworkTopologyHost{
+			ID:                    *h.ID,
+			Hostname:              h.RequestedHostname,
+			Role:                  common.GetEffectiveRole(h),
+			Status:                swag.StringValue(h.Status),
+			Interfaces:            make([]*models.NetworkTopologyInterface, 0),
+			MajorityGroups:        make([]string, 0),
+			OutsideMajorityGroups: make([]string, 0),
+		},
+		interfacesByMAC:     make(map[string]string),
+		interfacesByAddress: make(map[string]string),
+	}
+	for _, group := range groups {
+		if funk.Contains(majorityGroups[group], *h.ID) {
+			ret.MajorityGroups = append(ret.MajorityGroups, group)
+		} else {
+			ret.OutsideMajorityGroups = append(ret.OutsideMajorityGroups, group)
+		}
+	}
+	if h.Inventory == "" {
+		return ret, nil
+	}
+	inventory, err := common.UnmarshalInventory(h.Inventory)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the inventory of host %s", h.ID)
+	}
+	if ret.Hostname == "" {
+		ret.Hostname = inventory.Hostname
+	}
+	for _, intf := range inventory.Interfaces {
+		ti := &models.NetworkTopologyInterface{
+			Name:          intf.Name,
+			Type:          intf.Type,
+			MacAddress:    intf.MacAddress,
+			Mtu:           intf.Mtu,
+			SpeedMbps:     intf.SpeedMbps,
+			IPV4Addresses: intf.IPV4Addresses,
+			IPV6Addresses: intf.IPV6Addresses,
+		}
+		// VLAN interfaces are named after the interface that they are defined on and their VLAN ID, like eth0.100
+		if intf.Type == "vlan" {
+			if i := strings.LastIndex(intf.Name, "."); i > 0 {
+				if vlanID, err := strconv.ParseInt(intf.Name[i+1:], 10, 64); err == nil {
+					ti.Parent = intf.Name[:i]
+					ti.VlanID = vlanID
+				}
+			}
+		}
+		ret.Interfaces = append(ret.Interfaces, ti)
+		if intf.MacAddress != "" {
+			ret.interfacesByMAC[strings.ToLower(intf.MacAddress)] = intf.Name
+		}
+		for _, address := range append(append([]string{}, intf.IPV4Addresses...), intf.IPV6Addresses...) {
+			if ip, _, err := net.ParseCIDR(address); err == nil {
+				ret.interfacesByAddress[ip.String()] = intf.Name
+			}
+		}
+	}
+	return ret, nil
+}
+
+// subnets returns the subnets of the addresses of the interfaces of the host
+func (t *topologyHost) subnets() []string {
+	var ret []string
+	for _, intf := range t.Interfaces {
+		for _, address := range append(append([]string{}, intf.IPV4Addresses...), intf.IPV6Addresses...) {
+			if _, ipnet, err := net.ParseCIDR(address); err == nil {
+				ret = append(ret, ipnet.String())
+			}
+		}
+	}
+	return funk.UniqString(ret)
+}
+
+// interfaceByAddress returns the interface of the host that has the address
+func (t *topologyHost) interfaceByAddress(address string) string {
+	if ip := net.ParseIP(address); ip != nil {
+		return t.interfacesByAddress[ip.String()]
+	}
+	return ""
+}
+
+// topologyLinks returns the links from the host to the other hosts of the cluster, as reported by the connectivity
+// checks of the host
+func topologyLinks(h *models.Host, hostsByID map[strfmt.UUID]*topologyHost) ([]*models.NetworkTopologyLink, error) {
+	if h.Connectivity == "" {
+		return nil, nil
+	}
+	var report models.ConnectivityReport
+	if err := json.Unmarshal([]byte(h.Connectivity), &report); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the connectivity report of host %s", h.ID)
+	}
+	var ret []*models.NetworkTopologyLink
+	for _, rh := range report.RemoteHosts {
+		target, ok := hostsByID[rh.HostID]
+		if !ok || rh.HostID == *h.ID {
+			continue
+		}
+		for _, l2 := range rh.L2Connectivity {
+			link := &models.NetworkTopologyLink{
+				Type:             models.NetworkTopologyLinkTypeL2,
+				SourceHostID:     *h.ID,
+				SourceInterface:  l2.OutgoingNic,
+				TargetHostID:     rh.HostID,
+				TargetAddress:    l2.RemoteIPAddress,
+				TargetMacAddress: l2.RemoteMac,
+				Successful:       l2.Successful,
+			}
+			if link.TargetInterface = target.interfacesByMAC[strings.ToLower(l2.RemoteMac)]; link.TargetInterface == "" {
+				link.TargetInterface = target.interfaceByAddress(l2.RemoteIPAddress)
+			}
+			ret = append(ret, link)
+		}
+		for _, l3 := range rh.L3Connectivity {
+			ret = append(ret, &models.NetworkTopologyLink{
+				Type:                 models.NetworkTopologyLinkTypeL3,
+				SourceHostID:         *h.ID,
+				SourceInterface:      l3.OutgoingNic,
+				TargetHostID:         rh.HostID,
+				TargetInterface:      target.interfaceByAddress(l3.RemoteIPAddress),
+				TargetAddress:        l3.RemoteIPAddress,
+				Successful:           l3.Successful,
+				AverageRttMs:         l3.AverageRTTMs,
+				PacketLossPercentage: l3.PacketLossPercentage,
+			})
+		}
+	}
+	return ret, nil
+}
+
+// NetworkTopologyToDOT returns the topology as a Graphviz DOT graph. Hosts that are outside of a majority group of the
+// cluster and failed connectivity checks are drawn in red
+func NetworkTopologyToDOT(topology *models.NetworkTopology) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %q {\n", fmt.Sprintf("cluster %s", topology.ClusterID))
+	b.WriteString("\tnode [shape=box];\n")
+	for _, h := range topology.Hosts {
+		label := fmt.Sprintf("%s\n%s, %s", h.Hostname, h.Role, h.Status)
+		attributes := ""
+		if len(h.OutsideMajorityGroups) > 0 {
+			label += fmt.Sprintf("\noutside of %s", strings.Join(h.OutsideMajorityGroups, ", "))
+			attributes = ", color=red"
+		}
+		fmt.Fprintf(&b, "\t%q [label=%q%s];\n", h.ID, label, attributes)
+	}
+	for _, subnet := range topology.Subnets {
+		attributes := ""
+		if subnet.MachineNetwork {
+			attributes = ", style=bold"
+		}
+		fmt.Fprintf(&b, "\t%q [shape=ellipse%s];\n", subnet.Cidr, attributes)
+	}
+	for _, h := range topology.Hosts {
+		for _, intf := range h.Interfaces {
+			label := intf.Name
+			if intf.VlanID != 0 {
+				label = fmt.Sprintf("%s (VLAN %d)", intf.Name, intf.VlanID)
+			}
+			for _, address := range append(append([]string{}, intf.IPV4Addresses...), intf.IPV6Addresses...) {
+				if _, ipnet, err := net.ParseCIDR(address); err == nil {
+					fmt.Fprintf(&b, "\t%q -> %q [dir=none, label=%q];\n", h.ID, ipnet.String(), label)
+				}
+			}
+		}
+	}
+	for _, link := range topology.Links {
+		label := fmt.Sprintf("%s %s", link.Type, link.SourceInterface)
+		if link.Type == models.NetworkTopologyLinkTypeL3 && link.Successful {
+			label += fmt.Sprintf("\n%.2fms, %.0f%% loss", link.AverageRttMs, link.PacketLossPercentage)
+		}
+		attributes := ""
+		if !link.Successful {
+			attributes = ", style=dashed, color=red"
+		}
+		fmt.Fprintf(&b, "\t%q -> %q [label=%q%s];\n", link.SourceHostID, link.TargetHostID, label, attributes)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
@@ -0,0 +1,143 @@
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Network topology", func() {
+	var (
+		cluster        *common.Cluster
+		master, worker strfmt.UUID
+	)
+
+	marshal := func(v interface{}) string {
+		b, err := json.Marshal(v)
+		Expect(err).ToNot(HaveOccurred())
+		return string(b)
+	}
+
+	BeforeEach(func() {
+		master = strfmt.UUID(uuid.New().String())
+		worker = strfmt.UUID(uuid.New().String())
+		clusterID := strfmt.UUID(uuid.New().String())
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			ID:              &clusterID,
+			MachineNetworks: []*models.MachineNetwork{{Cidr: "10.0.0.0/24"}},
+			ConnectivityMajorityGroups: marshal(&Connectivity{MajorityGroups: map[string][]strfmt.UUID{
+				"10.0.0.0/24": {master},
+				"IPv4":        {master, worker},
+			}}),
+			Hosts: []*models.Host{
+				{
+					ID:     &master,
+					Status: swag.String(models.HostStatusKnown),
+					Role:   models.HostRoleMaster,
+					Inventory: marshal(&models.Inventory{
+						Hostname: "master-0",
+						Interfaces: []*models.Interface{
+							{Name: "eth0", Type: "physical", MacAddress: "52:54:00:00:00:01", IPV4Addresses: []string{"10.0.0.1/24"}},
+							{Name: "eth0.100", Type: "vlan", MacAddress: "52:54:00:00:00:01", IPV4Addresses: []string{"192.168.100.1/24"}},
+						},
+					}),
+					Connectivity: marshal(&models.ConnectivityReport{RemoteHosts: []*models.ConnectivityRemoteHost{{
+						HostID:         worker,
+						L2Connectivity: []*models.L2Connectivity{{OutgoingNic: "eth0", RemoteIPAddress: "10.0.0.2", RemoteMac: "52:54:00:00:00:02"}},
+						L3Connectivity: []*models.L3Connectivity{{OutgoingNic: "eth0.100", RemoteIPAddress: "192.168.100.2", Successful: true, AverageRTTMs: 0.5}},
+					}}}),
+				},
+				{
+					ID:                &worker,
+					Status:            swag.String(models.HostStatusInsufficient),
+					Role:              models.HostRoleWorker,
+					RequestedHostname: "worker-0",
+					Inventory: marshal(&models.Inventory{
+						Interfaces: []*models.Interface{
+							{Name: "eth0", Type: "physical", MacAddress: "52:54:00:00:00:02", IPV4Addresses: []string{"10.0.0.2/24"}},
+							{Name: "eth1", Type: "physical", MacAddress: "52:54:00:00:00:03", IPV4Addresses: []string{"192.168.100.2/24"}},
+						},
+					}),
+				},
+			},
+		}}
+	})
+
+	hostByID := func(topology *models.NetworkTopology, id strfmt.UUID) *models.NetworkTopologyHost {
+		for _, h := range topology.Hosts {
+			if h.ID == id {
+				return h
+			}
+		}
+		return nil
+	}
+
+	It("builds the topology of the hosts", func() {
+		topology, err := BuildNetworkTopology(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(topology.Hosts).To(HaveLen(2))
+
+		m := hostByID(topology, master)
+		Expect(m.Hostname).To(Equal("master-0"))
+		Expect(m.MajorityGroups).To(Equal([]string{"10.0.0.0/24", "IPv4"}))
+		Expect(m.OutsideMajorityGroups).To(BeEmpty())
+		Expect(m.Interfaces[1].Parent).To(Equal("eth0"))
+		Expect(m.Interfaces[1].VlanID).To(BeEquivalentTo(100))
+
+		w := hostByID(topology, worker)
+		Expect(w.Hostname).To(Equal("worker-0"))
+		Expect(w.MajorityGroups).To(Equal([]string{"IPv4"}))
+		Expect(w.OutsideMajorityGroups).To(Equal([]string{"10.0.0.0/24"}))
+
+		Expect(topology.Subnets).To(HaveLen(2))
+		Expect(topology.Subnets[0].Cidr).To(Equal("10.0.0.0/24"))
+		Expect(topology.Subnets[0].MachineNetwork).To(BeTrue())
+		Expect(topology.Subnets[0].HostIds).To(ConsistOf(master, worker))
+		Expect(topology.Subnets[1].MachineNetwork).To(BeFalse())
+
+		Expect(topology.Links).To(ConsistOf(
+			&models.NetworkTopologyLink{
+				Type:             models.NetworkTopologyLinkTypeL2,
+				SourceHostID:     master,
+				SourceInterface:  "eth0",
+				TargetHostID:     worker,
+				TargetInterface:  "eth0",
+				TargetAddress:    "10.0.0.2",
+				TargetMacAddress: "52:54:00:00:00:02",
+			},
+			&models.NetworkTopologyLink{
+				Type:            models.NetworkTopologyLinkTypeL3,
+				SourceHostID:    master,
+				SourceInterface: "eth0.100",
+				TargetHostID:    worker,
+				TargetInterface: "eth1",
+				TargetAddress:   "192.168.100.2",
+				Successful:      true,
+				AverageRttMs:    0.5,
+			},
+		))
+	})
+
+	It("returns the topology as a DOT graph", func() {
+		topology, err := BuildNetworkTopology(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		dot := NetworkTopologyToDOT(topology)
+		Expect(dot).To(HavePrefix("digraph"))
+		Expect(dot).To(ContainSubstring(`"` + worker.String() + `" [label="worker-0\nworker, insufficient\noutside of 10.0.0.0/24", color=red];`))
+		Expect(dot).To(ContainSubstring(`"10.0.0.0/24" [shape=ellipse, style=bold];`))
+		Expect(dot).To(ContainSubstring(`"` + master.String() + `" -> "192.168.100.0/24" [dir=none, label="eth0.100 (VLAN 100)"];`))
+		Expect(dot).To(ContainSubstring(`"` + master.String() + `" -> "` + worker.String() + `" [label="l2 eth0", style=dashed, color=red];`))
+	})
+
+	It("fails with an invalid connectivity report", func() {
+		cluster.Hosts[0].Connectivity = "not json"
+		_, err := BuildNetworkTopology(cluster)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -200,3 +200,18 @@ func (mr *MockReleaseMockRecorder) GetReleaseBinaryPath(releaseImage, cacheDir,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReleaseBinaryPath", reflect.TypeOf((*MockRelease)(nil).GetReleaseBinaryPath), releaseImage, cacheDir, ocpVersion)
 }
+
+// GetReleaseDigest mocks base method.
+func (m *MockRelease) GetReleaseDigest(log logrus.FieldLogger, releaseImage, releaseImageMirror, pullSecret string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReleaseDigest", log, releaseImage, releaseImageMirror, pullSecret)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReleaseDigest indicates an expected call of GetReleaseDigest.
+func (mr *MockReleaseMockRecorder) GetReleaseDigest(log, releaseImage, releaseImageMirror, pullSecret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReleaseDigest", reflect.TypeOf((*MockRelease)(nil).GetReleaseDigest), log, releaseImage, releaseImageMirror, pullSecret)
+}
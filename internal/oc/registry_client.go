@@ -0,0 +1,379 @@
+package oc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/distribution/reference"
+	"github.com/sirupsen/logrus"
+)
+
+// Media types of the manifests that are read from registries
+const (
+	mediaTypeDockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+	mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+	mediaTypeOCIManifest        = "application/vnd.oci.image.manifest.v1+json"
+	mediaTypeOCIIndex           = "application/vnd.oci.image.index.v1+json"
+)
+
+// dockerHubDomain is the domain of normalized Docker Hub references, and dockerHubAPIHost the host that serves its
+// registry API
+const (
+	dockerHubDomain  = "docker.io"
+	dockerHubAPIHost = "registry-1.docker.io"
+)
+
+var manifestMediaTypes = strings.Join([]string{mediaTypeDockerManifestList, mediaTypeOCIIndex, mediaTypeDockerManifest, mediaTypeOCIManifest}, ", ")
+
+type platform struct {
+	Architecture string `json:"architecture"`
+	OS           string `json:"os"`
+}
+
+type descriptor struct {
+	MediaType string    `json:"mediaType"`
+	Digest    string    `json:"digest"`
+	Size      int64     `json:"size"`
+	Platform  *platform `json:"platform,omitempty"`
+}
+
+// imageManifest holds the properties of image manifests and of manifest lists that are needed to inspect images
+type imageManifest struct {
+	MediaType string       `json:"mediaType"`
+	Config    descriptor   `json:"config"`
+	Layers    []descriptor `json:"layers"`
+	Manifests []descriptor `json:"manifests"`
+
+	// digest is the digest of the manifest, which is not part of its content
+	digest string
+}
+
+func (m *imageManifest) isList() bool {
+	return m.MediaType == mediaTypeDockerManifestList || m.MediaType == mediaTypeOCIIndex || len(m.Manifests) > 0
+}
+
+// imageConfig holds the properties of the configuration of an image that are needed to inspect images
+type imageConfig struct {
+	Architecture string `json:"architecture"`
+	OS           string `json:"os"`
+}
+
+// repository is a repository of a registry that images are read from
+type repository struct {
+	domain string
+	path   string
+	// insecure repositories are read without verifying the certificate of the registry, or over plain HTTP
+	insecure bool
+}
+
+func (r repository) String() string {
+	return r.domain + "/" + r.path
+}
+
+// imageLocation is an image in a repository, identified by a tag or a digest
+type imageLocation struct {
+	repository
+	reference string
+}
+
+func (l imageLocation) String() string {
+	if strings.HasPrefix(l.reference, "sha256:") {
+		return l.repository.String() + "@" + l.reference
+	}
+	return l.repository.String() + ":" + l.reference
+}
+
+// parseImageLocation parses a pull spec. Images without a tag or a digest refer to the latest tag.
+func parseImageLocation(image string, insecure bool) (imageLocation, error) {
+	named, err := reference.ParseNormalizedNamed(strings.TrimSpace(image))
+	if err != nil {
+		return imageLocation{}, fmt.Errorf("failed to parse image %s: %w", image, err)
+	}
+	location := imageLocation{
+		repository: repository{domain: reference.Domain(named), path: reference.Path(named), insecure: insecure},
+		reference:  "latest",
+	}
+	if digested, ok := named.(reference.Digested); ok {
+		location.reference = digested.Digest().String()
+	} else if tagged, ok := named.(reference.Tagged); ok {
+		location.reference = tagged.Tag()
+	}
+	return location, nil
+}
+
+// registryClient reads manifests and blobs with the registry API, authenticating with the credentials of a pull secret
+type registryClient struct {
+	log logrus.FieldLogger
+	// client is used for secure repositories, and insecureClient for insecure ones
+	client         *http.Client
+	insecureClient *http.Client
+	// auths holds the base64 encoded credentials of the pull secret, by registry and optionally repository
+	auths map[string]string
+	// tokens holds the authorization headers that the registries issued, by repository
+	tokens      map[string]string
+	tokensMutex sync.Mutex
+}
+
+func newRegistryClient(log logrus.FieldLogger, client, insecureClient *http.Client, pullSecret string) (*registryClient, error) {
+	auths, err := parsePullSecretAuths(pullSecret)
+	if err != nil {
+		return nil, err
+	}
+	return &registryClient{
+		log:            log,
+		client:         client,
+		insecureClient: insecureClient,
+		auths:          auths,
+		tokens:         make(map[string]string),
+	}, nil
+}
+
+// parsePullSecretAuths returns the credentials of the pull secret, keyed by registry (and optionally repository)
+func parsePullSecretAuths(pullSecret string) (map[string]string, error) {
+	ret := make(map[string]string)
+	if strings.TrimSpace(pullSecret) == "" {
+		return ret, nil
+	}
+	var secret struct {
+		Auths map[string]struct {
+			Auth string `json:"auth"`
+		} `json:"auths"`
+	}
+	if err := json.Unmarshal([]byte(pullSecret), &secret); err != nil {
+		return nil, fmt.Errorf("failed to parse the pull secret: %w", err)
+	}
+	for key, value := range secret.Auths {
+		if value.Auth != "" {
+			ret[normalizeAuthKey(key)] = value.Auth
+		}
+	}
+	return ret, nil
+}
+
+func normalizeAuthKey(key string) string {
+	key = strings.TrimPrefix(strings.TrimPrefix(key, "https://"), "http://")
+	key = strings.TrimSuffix(key, "/")
+	if strings.HasPrefix(key, "index.docker.io") || strings.HasPrefix(key, dockerHubAPIHost) {
+		return dockerHubDomain
+	}
+	return key
+}
+
+// credentials returns the credentials of the repository, preferring the credentials of the repository over those
+// of its registry
+func (c *registryClient) credentials(repo repository) string {
+	name := repo.String()
+	var ret, matched string
+	for key, auth := range c.auths {
+		if (key == repo.domain || key == name || strings.HasPrefix(name, key+"/")) && len(key) > len(matched) {
+			ret, matched = auth, key
+		}
+	}
+	return ret
+}
+
+// getManifest returns the manifest of the image and its digest. Manifests that are read by digest are verified.
+func (c *registryClient) getManifest(ctx context.Context, location imageLocation) (*imageManifest, []byte, string, error) {
+	response, err := c.get(ctx, location.repository, "manifests/"+location.reference, manifestMediaTypes)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("failed to read the manifest of %s: %w", location, err)
+	}
+	digest := computeDigest(body)
+	if strings.HasPrefix(location.reference, "sha256:") && digest != location.reference {
+		return nil, nil, "", fmt.Errorf("the manifest of %s has digest %s", location, digest)
+	}
+	manifest, err := parseManifest(body)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("failed to parse the manifest of %s: %w", location, err)
+	}
+	if manifest.MediaType == "" {
+		manifest.MediaType = response.Header.Get("Content-Type")
+	}
+	manifest.digest = digest
+	return manifest, body, digest, nil
+}
+
+func parseManifest(body []byte) (*imageManifest, error) {
+	manifest := &imageManifest{}
+	if err := json.Unmarshal(body, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// getBlob returns the content of a blob of the repository. The caller closes it.
+func (c *registryClient) getBlob(ctx context.Context, repo repository, digest string) (io.ReadCloser, error) {
+	response, err := c.get(ctx, repo, "blobs/"+digest, "")
+	if err != nil {
+		return nil, err
+	}
+	return response.Body, nil
+}
+
+// getVerifiedBlob returns the content of a small blob of the repository, after checking its digest
+func (c *registryClient) getVerifiedBlob(ctx context.Context, repo repository, digest string) ([]byte, error) {
+	blob, err := c.getBlob(ctx, repo, digest)
+	if err != nil {
+		return nil, err
+	}
+	defer blob.Close()
+	body, err := io.ReadAll(blob)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read blob %s of %s: %w", digest, repo, err)
+	}
+	if actual := computeDigest(body); actual != digest {
+		return nil, fmt.Errorf("blob %s of %s has digest %s", digest, repo, actual)
+	}
+	return body, nil
+}
+
+func computeDigest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// get sends a GET request to the registry API of the repository. Insecure repositories are read over plain HTTP
+// when the registry does not serve HTTPS.
+func (c *registryClient) get(ctx context.Context, repo repository, resource string, accept string) (*http.Response, error) {
+	host := repo.domain
+	if host == dockerHubDomain {
+		host = dockerHubAPIHost
+	}
+	requestURL := fmt.Sprintf("https://%s/v2/%s/%s", host, repo.path, resource)
+	response, err := c.getWithAuth(ctx, repo, requestURL, accept)
+	if err != nil && repo.insecure {
+		c.log.WithError(err).Debugf("Failed to read %s over HTTPS, retrying over HTTP", repo)
+		response, err = c.getWithAuth(ctx, repo, "http"+strings.TrimPrefix(requestURL, "https"), accept)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		defer response.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return nil, fmt.Errorf("failed to read %s of %s: registry returned %s: %s", resource, repo, response.Status, strings.TrimSpace(string(body)))
+	}
+	return response, nil
+}
+
+func (c *registryClient) getWithAuth(ctx context.Context, repo repository, requestURL string, accept string) (*http.Response, error) {
+	c.tokensMutex.Lock()
+	authorization := c.tokens[repo.String()]
+	c.tokensMutex.Unlock()
+
+	response, err := c.send(ctx, repo, requestURL, accept, authorization)
+	if err != nil || response.StatusCode != http.StatusUnauthorized {
+		return response, err
+	}
+	challenge := response.Header.Get("WWW-Authenticate")
+	response.Body.Close()
+	authorization, err = c.authorize(ctx, repo, challenge)
+	if err != nil {
+		return nil, err
+	}
+	c.tokensMutex.Lock()
+	c.tokens[repo.String()] = authorization
+	c.tokensMutex.Unlock()
+	return c.send(ctx, repo, requestURL, accept, authorization)
+}
+
+func (c *registryClient) send(ctx context.Context, repo repository, requestURL string, accept string, authorization string) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		request.Header.Set("Accept", accept)
+	}
+	if authorization != "" {
+		request.Header.Set("Authorization", authorization)
+	}
+	client := c.client
+	if repo.insecure {
+		client = c.insecureClient
+	}
+	return client.Do(request)
+}
+
+// challengeParamRE matches the parameters of a WWW-Authenticate challenge, like realm="https://auth.example.com"
+var challengeParamRE = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// authorize answers the authentication challenge of the registry with the credentials of the repository, and
+// returns the value of the Authorization header for the following requests
+func (c *registryClient) authorize(ctx context.Context, repo repository, challenge string) (string, error) {
+	credentials := c.credentials(repo)
+	scheme, params, _ := strings.Cut(challenge, " ")
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if credentials == "" {
+			return "", fmt.Errorf("registry %s requires credentials, and the pull secret has none for %s", repo.domain, repo)
+		}
+		return "Basic " + credentials, nil
+	case "bearer":
+	default:
+		return "", fmt.Errorf("registry %s returned an unsupported authentication challenge: %q", repo.domain, challenge)
+	}
+
+	values := make(map[string]string)
+	for _, match := range challengeParamRE.FindAllStringSubmatch(params, -1) {
+		values[strings.ToLower(match[1])] = match[2]
+	}
+	realm, err := url.Parse(values["realm"])
+	if err != nil || values["realm"] == "" {
+		return "", fmt.Errorf("registry %s returned an authentication challenge without a valid realm: %q", repo.domain, challenge)
+	}
+	query := realm.Query()
+	if values["service"] != "" {
+		query.Set("service", values["service"])
+	}
+	query.Set("scope", fmt.Sprintf("repository:%s:pull", repo.path))
+	realm.RawQuery = query.Encode()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if credentials != "" {
+		request.Header.Set("Authorization", "Basic "+credentials)
+	}
+	client := c.client
+	if repo.insecure {
+		client = c.insecureClient
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to get a token for %s: %w", repo, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get a token for %s: %s", repo, response.Status)
+	}
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err = json.NewDecoder(response.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("failed to parse the token for %s: %w", repo, err)
+	}
+	if token.Token == "" {
+		token.Token = token.AccessToken
+	}
+	if token.Token == "" {
+		return "", fmt.Errorf("registry %s returned an empty token for %s", repo.domain, repo)
+	}
+	return "Bearer " + token.Token, nil
+}
@@ -0,0 +1,610 @@
+package oc
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/pkg/mirrorregistries"
+	"github.com/patrickmn/go-cache"
+	"github.com/sirupsen/logrus"
+)
+
+// Values of RegistryConfig.Inspector
+const (
+	// InspectorOC inspects release images by running the oc command
+	InspectorOC = "oc"
+	// InspectorRegistry inspects release images by reading the release payload from the registry
+	InspectorRegistry = "registry"
+)
+
+// The files of the release payload that describe the release
+const (
+	imageReferencesFile = "release-manifests/image-references"
+	releaseMetadataFile = "release-manifests/release-metadata"
+)
+
+type RegistryConfig struct {
+	// Inspector selects how release images are inspected, either InspectorOC or InspectorRegistry
+	Inspector string `envconfig:"RELEASE_IMAGE_INSPECTOR" default:"oc"`
+	// CacheDir is the directory where the manifests and the metadata of the releases that were read from registries
+	// are kept. The cache is disabled if it is empty.
+	CacheDir string `envconfig:"RELEASE_IMAGE_MANIFEST_CACHE_DIR" default:""`
+	// Timeout is the maximal duration of the inspection of an image
+	Timeout time.Duration `envconfig:"RELEASE_IMAGE_REGISTRY_TIMEOUT" default:"5m"`
+}
+
+// releaseInfo is the description of a release that is read from its payload
+type releaseInfo struct {
+	// Digest is the digest of the manifest of the release image for the platform of the service
+	Digest  string `json:"digest"`
+	Version string `json:"version"`
+	// Images holds the pull specs of the images of the release by their name
+	Images map[string]string `json:"images"`
+}
+
+type releaseInfoValue struct {
+	value *releaseInfo
+	mutex sync.Mutex
+}
+
+type registryRelease struct {
+	Release
+	config                  RegistryConfig
+	mirrorRegistriesBuilder mirrorregistries.ServiceMirrorRegistriesConfigBuilder
+	log                     logrus.FieldLogger
+
+	// transport is used for secure registries, and insecureTransport for the release image mirror, which is not
+	// verified like with oc --insecure
+	transport         http.RoundTripper
+	insecureTransport http.RoundTripper
+
+	// A map for caching releases (release image > release info)
+	releasesMap common.ExpiringCache
+}
+
+// NewRegistryRelease returns a Release that inspects release images by reading their manifests and the
+// image-references and release-metadata files of their payload directly from the registry, instead of running oc.
+// The installer binary is still extracted with the given Release, which runs oc.
+func NewRegistryRelease(log logrus.FieldLogger, config RegistryConfig, mirrorRegistriesBuilder mirrorregistries.ServiceMirrorRegistriesConfigBuilder,
+	ocRelease Release) Release {
+	return &registryRelease{
+		Release:                 ocRelease,
+		config:                  config,
+		mirrorRegistriesBuilder: mirrorRegistriesBuilder,
+		log:                     log,
+		transport:               newRegistryTransport(log, mirrorRegistriesBuilder, false),
+		insecureTransport:       newRegistryTransport(log, mirrorRegistriesBuilder, true),
+		releasesMap:             common.NewExpiringCache(cache.NoExpiration, cache.NoExpiration),
+	}
+}
+
+// newRegistryTransport returns a transport that trusts the system certificates and the certificate of the mirror
+// registries, if they are configured
+func newRegistryTransport(log logrus.FieldLogger, mirrorRegistriesBuilder mirrorregistries.ServiceMirrorRegistriesConfigBuilder, insecure bool) http.RoundTripper {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if insecure {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec // like oc --insecure for the release image mirror
+		return transport
+	}
+	if !mirrorRegistriesBuilder.IsMirrorRegistriesConfigured() {
+		return transport
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		log.WithError(err).Warn("Failed to load the system certificates, using only the certificate of the mirror registries")
+		pool = x509.NewCertPool()
+	}
+	ca, err := mirrorRegistriesBuilder.GetMirrorCA()
+	if err != nil || !pool.AppendCertsFromPEM(ca) {
+		log.WithError(err).Warn("Failed to load the certificate of the mirror registries")
+	}
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	return transport
+}
+
+func (r *registryRelease) GetMCOImage(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+	return r.getImageByName(log, mcoImageName, releaseImage, releaseImageMirror, pullSecret)
+}
+
+func (r *registryRelease) GetIronicAgentImage(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+	return r.getImageByName(log, ironicAgentImageName, releaseImage, releaseImageMirror, pullSecret)
+}
+
+func (r *registryRelease) GetOKDRPMSImage(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+	return r.getImageByName(log, okdRPMSImageName, releaseImage, releaseImageMirror, pullSecret)
+}
+
+func (r *registryRelease) GetMustGatherImage(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+	return r.getImageByName(log, mustGatherImageName, releaseImage, releaseImageMirror, pullSecret)
+}
+
+func (r *registryRelease) GetCoreOSImage(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+	return getCoreOSImage(log, func(imageName string) (string, error) {
+		return r.getImageByName(log, imageName, releaseImage, releaseImageMirror, pullSecret)
+	})
+}
+
+func (r *registryRelease) getImageByName(log logrus.FieldLogger, imageName, releaseImage, releaseImageMirror, pullSecret string) (string, error) {
+	if releaseImage == "" && releaseImageMirror == "" {
+		return "", errors.New("neither releaseImage, nor releaseImageMirror are provided")
+	}
+	info, err := r.getReleaseInfo(log, releaseImage, releaseImageMirror, pullSecret)
+	if err == nil {
+		image, ok := info.Images[imageName]
+		if ok {
+			return image, nil
+		}
+		err = fmt.Errorf("no image tag %q exists in the release image", imageName)
+	}
+	if releaseImageMirror != "" {
+		log.WithError(err).Errorf("failed to get %s image from mirror release image %s", imageName, releaseImageMirror)
+	} else {
+		log.WithError(err).Errorf("failed to get %s image from release image %s", imageName, releaseImage)
+	}
+	return "", err
+}
+
+func (r *registryRelease) GetOpenshiftVersion(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+	if releaseImage == "" && releaseImageMirror == "" {
+		return "", errors.New("no releaseImage nor releaseImageMirror provided")
+	}
+	info, err := r.getReleaseInfo(log, releaseImage, releaseImageMirror, pullSecret)
+	if err != nil {
+		if releaseImageMirror != "" {
+			log.WithError(err).Errorf("failed to get openshift version from mirror release image %s", releaseImageMirror)
+		} else {
+			log.WithError(err).Errorf("failed to get openshift version from release image %s", releaseImage)
+		}
+		return "", err
+	}
+	return info.Version, nil
+}
+
+func (r *registryRelease) GetMajorMinorVersion(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+	openshiftVersion, err := r.GetOpenshiftVersion(log, releaseImage, releaseImageMirror, pullSecret)
+	if err != nil {
+		return "", err
+	}
+	return majorMinorVersion(openshiftVersion)
+}
+
+func (r *registryRelease) GetReleaseDigest(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+	if releaseImage == "" && releaseImageMirror == "" {
+		return "", errors.New("no releaseImage nor releaseImageMirror provided")
+	}
+	info, err := r.getReleaseInfo(log, releaseImage, releaseImageMirror, pullSecret)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get the digest of release image %s or mirror %s", releaseImage, releaseImageMirror)
+		return "", err
+	}
+	return info.Digest, nil
+}
+
+func (r *registryRelease) GetReleaseArchitecture(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) ([]string, error) {
+	if releaseImage == "" && releaseImageMirror == "" {
+		return nil, errors.New("no releaseImage nor releaseImageMirror provided")
+	}
+	mirrors, err := r.getMirrors(log)
+	if err != nil {
+		return nil, err
+	}
+	image, insecure := releaseImageToUse(releaseImage, releaseImageMirror, mirrors)
+	return r.getImageArchitecture(log, image, insecure, mirrors, pullSecret)
+}
+
+func (r *registryRelease) GetImageArchitecture(log logrus.FieldLogger, image string, pullSecret string) ([]string, error) {
+	mirrors, err := r.getMirrors(log)
+	if err != nil {
+		return nil, err
+	}
+	return r.getImageArchitecture(log, image, false, mirrors, pullSecret)
+}
+
+func (r *registryRelease) getImageArchitecture(log logrus.FieldLogger, image string, insecure bool, mirrors []mirrorregistries.RegistriesConf,
+	pullSecret string) ([]string, error) {
+	ctx, cancel := r.context()
+	defer cancel()
+	client, err := r.newClient(log, pullSecret)
+	if err != nil {
+		return nil, err
+	}
+	location, manifest, err := r.getManifest(ctx, log, client, image, insecure, mirrors)
+	if err != nil {
+		return nil, err
+	}
+
+	if manifest.isList() {
+		var architectures []string
+		for _, m := range manifest.Manifests {
+			if m.Platform == nil {
+				continue
+			}
+			// Convert architecture naming to supported values
+			if architecture := common.NormalizeCPUArchitecture(m.Platform.Architecture); architecture != "" {
+				architectures = append(architectures, architecture)
+			}
+		}
+		if len(architectures) == 0 {
+			return nil, fmt.Errorf("image manifest of %s does not contain architecture", image)
+		}
+		return architectures, nil
+	}
+
+	config, err := r.getConfig(ctx, client, location.repository, manifest)
+	if err != nil {
+		return nil, err
+	}
+	if config.Architecture == "" {
+		return nil, fmt.Errorf("image config of %s does not contain architecture", image)
+	}
+	return []string{common.NormalizeCPUArchitecture(config.Architecture)}, nil
+}
+
+func (r *registryRelease) context() (context.Context, context.CancelFunc) {
+	if r.config.Timeout <= 0 {
+		return context.WithCancel(context.Background())
+	}
+	return context.WithTimeout(context.Background(), r.config.Timeout)
+}
+
+func (r *registryRelease) newClient(log logrus.FieldLogger, pullSecret string) (*registryClient, error) {
+	return newRegistryClient(log, &http.Client{Transport: r.transport}, &http.Client{Transport: r.insecureTransport}, pullSecret)
+}
+
+// getMirrors returns the mirror registries configuration, or nil if no mirror is configured
+func (r *registryRelease) getMirrors(log logrus.FieldLogger) ([]mirrorregistries.RegistriesConf, error) {
+	if !r.mirrorRegistriesBuilder.IsMirrorRegistriesConfigured() {
+		log.Debugf("No mirrors configured")
+		return nil, nil
+	}
+	mirrors, err := r.mirrorRegistriesBuilder.ExtractLocationMirrorDataFromRegistries()
+	if err != nil {
+		log.WithError(err).Errorf("Failed to get the mirror registries")
+		return nil, err
+	}
+	return mirrors, nil
+}
+
+// releaseImageToUse returns the release image mirror, which is read without verifying the registry certificate, if
+// no mirror registry is configured, and otherwise the release image, like getReleaseImageToUse does for oc
+func releaseImageToUse(releaseImage, releaseImageMirror string, mirrors []mirrorregistries.RegistriesConf) (string, bool) {
+	if releaseImageMirror != "" && len(mirrors) == 0 {
+		return releaseImageMirror, true
+	}
+	return releaseImage, false
+}
+
+// imageLocations returns the locations that the image is read from, in order. Like with an ImageDigestMirrorSet,
+// images that are referenced by digest are read from their mirrors before their source, and images that are
+// referenced by tag only from their source.
+func imageLocations(image string, insecure bool, mirrors []mirrorregistries.RegistriesConf) ([]imageLocation, error) {
+	source, err := parseImageLocation(image, insecure)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(source.reference, "sha256:") {
+		return []imageLocation{source}, nil
+	}
+	var ret []imageLocation
+	name := source.repository.String()
+	for _, registry := range mirrors {
+		location := strings.TrimSuffix(registry.Location, "/")
+		if name != location && !strings.HasPrefix(name, location+"/") {
+			continue
+		}
+		for _, mirror := range registry.Mirror {
+			mirrorLocation, err := parseImageLocation(strings.TrimSuffix(mirror, "/")+strings.TrimPrefix(name, location)+"@"+source.reference, false)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, mirrorLocation)
+		}
+	}
+	return append(ret, source), nil
+}
+
+// getManifest returns the manifest of the image, from the first of its locations that serves it. Manifest lists are
+// resolved to the manifest of the platform of the service.
+func (r *registryRelease) getManifest(ctx context.Context, log logrus.FieldLogger, client *registryClient, image string, insecure bool,
+	mirrors []mirrorregistries.RegistriesConf) (imageLocation, *imageManifest, error) {
+	locations, err := imageLocations(image, insecure, mirrors)
+	if err != nil {
+		return imageLocation{}, nil, err
+	}
+	var errs []error
+	for _, location := range locations {
+		manifest, err := r.readManifest(ctx, client, location)
+		if err == nil {
+			return location, manifest, nil
+		}
+		log.WithError(err).Debugf("Failed to read the manifest of %s", location)
+		errs = append(errs, err)
+	}
+	return imageLocation{}, nil, fmt.Errorf("failed to read the manifest of %s: %w", image, errors.Join(errs...))
+}
+
+// readManifest returns the manifest of the location, from the cache if it is referenced by digest
+func (r *registryRelease) readManifest(ctx context.Context, client *registryClient, location imageLocation) (*imageManifest, error) {
+	if strings.HasPrefix(location.reference, "sha256:") {
+		if body, ok := r.readCache("manifests", location.reference); ok {
+			if manifest, err := parseManifest(body); err == nil {
+				manifest.digest = location.reference
+				return manifest, nil
+			}
+		}
+	}
+	manifest, body, digest, err := client.getManifest(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+	r.writeCache("manifests", digest, body)
+	return manifest, nil
+}
+
+// platformManifest returns the manifest of the platform of the service in the manifest list, or the first Linux
+// manifest if the list has none for the platform
+func (r *registryRelease) platformManifest(ctx context.Context, client *registryClient, location imageLocation, list *imageManifest) (imageLocation, *imageManifest, error) {
+	var selected *descriptor
+	for i, m := range list.Manifests {
+		if m.Platform == nil || m.Platform.OS != "linux" {
+			continue
+		}
+		if selected == nil || m.Platform.Architecture == runtime.GOARCH {
+			selected = &list.Manifests[i]
+		}
+		if m.Platform.Architecture == runtime.GOARCH {
+			break
+		}
+	}
+	if selected == nil {
+		return imageLocation{}, nil, fmt.Errorf("the manifest list of %s has no Linux manifest", location)
+	}
+	platformLocation := imageLocation{repository: location.repository, reference: selected.Digest}
+	manifest, err := r.readManifest(ctx, client, platformLocation)
+	if err != nil {
+		return imageLocation{}, nil, err
+	}
+	return platformLocation, manifest, nil
+}
+
+func (r *registryRelease) getConfig(ctx context.Context, client *registryClient, repo repository, manifest *imageManifest) (*imageConfig, error) {
+	body, err := client.getVerifiedBlob(ctx, repo, manifest.Config.Digest)
+	if err != nil {
+		return nil, err
+	}
+	config := &imageConfig{}
+	if err = json.Unmarshal(body, config); err != nil {
+		return nil, fmt.Errorf("failed to parse the config of %s: %w", repo, err)
+	}
+	return config, nil
+}
+
+func (r *registryRelease) getReleaseInfoValue(image string) (*releaseInfoValue, error) {
+	actualIntf, _ := r.releasesMap.GetOrInsert(image, &releaseInfoValue{})
+	value, ok := actualIntf.(*releaseInfoValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected error - could not cast value for release %s", image)
+	}
+	return value, nil
+}
+
+// getReleaseInfo returns the description of the release, from the caches if it was already read
+func (r *registryRelease) getReleaseInfo(log logrus.FieldLogger, releaseImage, releaseImageMirror, pullSecret string) (*releaseInfo, error) {
+	mirrors, err := r.getMirrors(log)
+	if err != nil {
+		return nil, err
+	}
+	image, insecure := releaseImageToUse(releaseImage, releaseImageMirror, mirrors)
+
+	value, err := r.getReleaseInfoValue(image)
+	if err != nil {
+		return nil, err
+	}
+	value.mutex.Lock()
+	defer value.mutex.Unlock()
+	if value.value != nil {
+		return value.value, nil
+	}
+
+	ctx, cancel := r.context()
+	defer cancel()
+	client, err := r.newClient(log, pullSecret)
+	if err != nil {
+		return nil, err
+	}
+	location, manifest, err := r.getManifest(ctx, log, client, image, insecure, mirrors)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.isList() {
+		if location, manifest, err = r.platformManifest(ctx, client, location, manifest); err != nil {
+			return nil, err
+		}
+	}
+
+	info := &releaseInfo{}
+	if body, ok := r.readCache("releases", manifest.digest); ok && json.Unmarshal(body, info) == nil {
+		log.Debugf("Using the cached release info of %s", image)
+	} else {
+		log.Infof("Reading the release info of %s from %s", image, location)
+		if info, err = r.readReleaseInfo(ctx, client, location.repository, manifest); err != nil {
+			return nil, err
+		}
+		if body, err = json.Marshal(info); err == nil {
+			r.writeCache("releases", manifest.digest, body)
+		}
+	}
+	info.Digest = manifest.digest
+	value.value = info
+	return info, nil
+}
+
+// readReleaseInfo reads the image-references and release-metadata files from the layers of the release payload.
+// The files are in the last layers, so the layers are read from the last one.
+func (r *registryRelease) readReleaseInfo(ctx context.Context, client *registryClient, repo repository, manifest *imageManifest) (*releaseInfo, error) {
+	files := map[string][]byte{}
+	for i := len(manifest.Layers) - 1; i >= 0 && len(files) < 2; i-- {
+		if err := readLayerFiles(ctx, client, repo, manifest.Layers[i], files); err != nil {
+			return nil, err
+		}
+	}
+	imageReferences, ok := files[imageReferencesFile]
+	if !ok {
+		return nil, fmt.Errorf("the payload of %s has no %s file, it is not a release image", repo, imageReferencesFile)
+	}
+	return parseReleaseInfo(imageReferences, files[releaseMetadataFile])
+}
+
+// readLayerFiles adds the release files of the layer that were not found in a later layer
+func readLayerFiles(ctx context.Context, client *registryClient, repo repository, layer descriptor, files map[string][]byte) error {
+	blob, err := client.getBlob(ctx, repo, layer.Digest)
+	if err != nil {
+		return err
+	}
+	defer blob.Close()
+
+	var content io.Reader = blob
+	switch {
+	case strings.HasSuffix(layer.MediaType, "gzip"):
+		gzipReader, err := gzip.NewReader(blob)
+		if err != nil {
+			return fmt.Errorf("failed to decompress layer %s of %s: %w", layer.Digest, repo, err)
+		}
+		defer gzipReader.Close()
+		content = gzipReader
+	case strings.HasSuffix(layer.MediaType, "tar"):
+	default:
+		return fmt.Errorf("layer %s of %s has unsupported media type %s", layer.Digest, repo, layer.MediaType)
+	}
+
+	tarReader := tar.NewReader(content)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read layer %s of %s: %w", layer.Digest, repo, err)
+		}
+		name := strings.TrimPrefix(filepath.Clean("/"+header.Name), "/")
+		if name != imageReferencesFile && name != releaseMetadataFile {
+			continue
+		}
+		if _, ok := files[name]; ok {
+			continue
+		}
+		data, err := io.ReadAll(tarReader)
+		if err != nil {
+			return fmt.Errorf("failed to read %s from layer %s of %s: %w", name, layer.Digest, repo, err)
+		}
+		files[name] = data
+		if len(files) == 2 {
+			return nil
+		}
+	}
+}
+
+// parseReleaseInfo parses the image-references image stream and the release-metadata of a release payload. The
+// version is read from the image stream if the payload has no metadata.
+func parseReleaseInfo(imageReferences, releaseMetadata []byte) (*releaseInfo, error) {
+	var imageStream struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Spec struct {
+			Tags []struct {
+				Name string `json:"name"`
+				From struct {
+					Name string `json:"name"`
+				} `json:"from"`
+			} `json:"tags"`
+		} `json:"spec"`
+	}
+	if err := json.Unmarshal(imageReferences, &imageStream); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", imageReferencesFile, err)
+	}
+	info := &releaseInfo{Version: imageStream.Metadata.Name, Images: make(map[string]string)}
+	for _, tag := range imageStream.Spec.Tags {
+		if tag.From.Name != "" {
+			info.Images[tag.Name] = tag.From.Name
+		}
+	}
+	if releaseMetadata != nil {
+		var metadata struct {
+			Version string `json:"version"`
+		}
+		if err := json.Unmarshal(releaseMetadata, &metadata); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", releaseMetadataFile, err)
+		}
+		if metadata.Version != "" {
+			info.Version = metadata.Version
+		}
+	}
+	if info.Version == "" {
+		return nil, errors.New("the release payload does not specify its version")
+	}
+	return info, nil
+}
+
+// readCache returns the cached content of the digest. The content is addressed by the digest of an immutable
+// manifest, so it never expires.
+func (r *registryRelease) readCache(kind, digest string) ([]byte, bool) {
+	if r.config.CacheDir == "" || digest == "" {
+		return nil, false
+	}
+	data, err := os.ReadFile(r.cachePath(kind, digest))
+	if err != nil {
+		return nil, false
+	}
+	if kind == "manifests" && computeDigest(data) != digest {
+		return nil, false
+	}
+	return data, true
+}
+
+func (r *registryRelease) writeCache(kind, digest string, data []byte) {
+	if r.config.CacheDir == "" || digest == "" {
+		return
+	}
+	path := r.cachePath(kind, digest)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		r.log.WithError(err).Warnf("Failed to create the release manifest cache directory %s", filepath.Dir(path))
+		return
+	}
+	// Write to a temporary file first, so that concurrent readers never see partial content
+	file, err := os.CreateTemp(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		r.log.WithError(err).Warnf("Failed to write %s to the release manifest cache", path)
+		return
+	}
+	defer os.Remove(file.Name())
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+	if err != nil {
+		r.log.WithError(err).Warnf("Failed to write %s to the release manifest cache", path)
+	}
+}
+
+func (r *registryRelease) cachePath(kind, digest string) string {
+	return filepath.Join(r.config.CacheDir, kind, strings.ReplaceAll(digest, ":", "-"))
+}
@@ -0,0 +1,327 @@
+package oc
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+
+	gomock "github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/pkg/mirrorregistries"
+)
+
+// testRegistry is an in-process registry that serves manifests and blobs, and requires a bearer token that is issued
+// for the credentials of its user when a user is set
+type testRegistry struct {
+	server    *httptest.Server
+	user      string
+	manifests map[string][]byte
+	blobs     map[string][]byte
+	requests  []string
+	mutex     sync.Mutex
+}
+
+func newTestRegistry(tls bool, user string) *testRegistry {
+	registry := &testRegistry{user: user, manifests: map[string][]byte{}, blobs: map[string][]byte{}}
+	if tls {
+		registry.server = httptest.NewTLSServer(registry)
+	} else {
+		registry.server = httptest.NewServer(registry)
+	}
+	return registry
+}
+
+func (t *testRegistry) host() string {
+	return strings.TrimPrefix(strings.TrimPrefix(t.server.URL, "https://"), "http://")
+}
+
+func (t *testRegistry) pullSecret() string {
+	return fmt.Sprintf(`{"auths":{"%s":{"auth":"%s"}}}`, t.host(), base64.StdEncoding.EncodeToString([]byte(t.user)))
+}
+
+func (t *testRegistry) requestCount(kind string) int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	count := 0
+	for _, request := range t.requests {
+		if strings.Contains(request, "/"+kind+"/") {
+			count++
+		}
+	}
+	return count
+}
+
+func (t *testRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	t.mutex.Lock()
+	t.requests = append(t.requests, r.URL.Path)
+	t.mutex.Unlock()
+
+	if r.URL.Path == "/token" {
+		if r.Header.Get("Authorization") != "Basic "+base64.StdEncoding.EncodeToString([]byte(t.user)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"token":"test-token"}`))
+		return
+	}
+	if t.user != "" && r.Header.Get("Authorization") != "Bearer test-token" {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="test"`, t.server.URL))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if content, ok := t.manifests[r.URL.Path]; ok {
+		w.Header().Set("Content-Type", mediaTypeDockerManifest)
+		_, _ = w.Write(content)
+		return
+	}
+	if index := strings.LastIndex(r.URL.Path, "/blobs/"); index != -1 {
+		if content, ok := t.blobs[r.URL.Path[index+len("/blobs/"):]]; ok {
+			_, _ = w.Write(content)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNotFound)
+}
+
+func (t *testRegistry) pushBlob(content []byte) descriptor {
+	digest := computeDigest(content)
+	t.blobs[digest] = content
+	return descriptor{Digest: digest, Size: int64(len(content))}
+}
+
+func (t *testRegistry) pushManifest(repo, tag string, manifest interface{}) string {
+	content, err := json.Marshal(manifest)
+	ExpectWithOffset(2, err).ToNot(HaveOccurred())
+	digest := computeDigest(content)
+	t.manifests[fmt.Sprintf("/v2/%s/manifests/%s", repo, digest)] = content
+	if tag != "" {
+		t.manifests[fmt.Sprintf("/v2/%s/manifests/%s", repo, tag)] = content
+	}
+	return digest
+}
+
+// pushRelease pushes a release payload with a base layer and a layer with the release files, and returns its digest
+func (t *testRegistry) pushRelease(repo, tag, version, architecture string, images map[string]string) string {
+	imageStream := map[string]interface{}{
+		"kind":     "ImageStream",
+		"metadata": map[string]interface{}{"name": version},
+	}
+	var tags []interface{}
+	for name, image := range images {
+		tags = append(tags, map[string]interface{}{"name": name, "from": map[string]interface{}{"kind": "DockerImage", "name": image}})
+	}
+	imageStream["spec"] = map[string]interface{}{"tags": tags}
+	imageReferences, err := json.Marshal(imageStream)
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	releaseMetadata := fmt.Sprintf(`{"kind":"cincinnati-metadata-v0","version":%q}`, version)
+
+	config := t.pushBlob([]byte(fmt.Sprintf(`{"architecture":%q,"os":"linux"}`, architecture)))
+	config.MediaType = "application/vnd.docker.container.image.v1+json"
+	baseLayer := t.pushBlob(tarGz(map[string]string{"usr/bin/cluster-version-operator": "binary"}))
+	baseLayer.MediaType = "application/vnd.docker.image.rootfs.diff.tar.gzip"
+	releaseLayer := t.pushBlob(tarGz(map[string]string{
+		"./release-manifests/image-references": string(imageReferences),
+		"release-manifests/release-metadata":   releaseMetadata,
+	}))
+	releaseLayer.MediaType = "application/vnd.docker.image.rootfs.diff.tar.gzip"
+	return t.pushManifest(repo, tag, &imageManifest{
+		MediaType: mediaTypeDockerManifest,
+		Config:    config,
+		Layers:    []descriptor{baseLayer, releaseLayer},
+	})
+}
+
+func tarGz(files map[string]string) []byte {
+	var buffer bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buffer)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range files {
+		ExpectWithOffset(2, tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content))})).To(Succeed())
+		_, err := tarWriter.Write([]byte(content))
+		ExpectWithOffset(2, err).ToNot(HaveOccurred())
+	}
+	ExpectWithOffset(2, tarWriter.Close()).To(Succeed())
+	ExpectWithOffset(2, gzipWriter.Close()).To(Succeed())
+	return buffer.Bytes()
+}
+
+var _ = Describe("registry release", func() {
+	var (
+		ctrl           *gomock.Controller
+		mockMirrors    *mirrorregistries.MockServiceMirrorRegistriesConfigBuilder
+		registry       *testRegistry
+		cacheDir       string
+		releaseImage   string
+		releaseDigest  string
+		releaseImages  = map[string]string{mcoImageName: "quay.io/mco@sha256:1", mustGatherImageName: "quay.io/must-gather@sha256:2", scosImageName: "quay.io/scos@sha256:3"}
+		releaseVersion = "4.16.3"
+	)
+
+	newRelease := func(ocRelease Release) *registryRelease {
+		r := NewRegistryRelease(log, RegistryConfig{CacheDir: cacheDir}, mockMirrors, ocRelease).(*registryRelease)
+		r.transport = registry.server.Client().Transport
+		return r
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockMirrors = mirrorregistries.NewMockServiceMirrorRegistriesConfigBuilder(ctrl)
+		mockMirrors.EXPECT().IsMirrorRegistriesConfigured().Return(false).AnyTimes()
+		registry = newTestRegistry(true, "user:password")
+		var err error
+		cacheDir, err = os.MkdirTemp("", "release-manifests")
+		Expect(err).ToNot(HaveOccurred())
+		releaseDigest = registry.pushRelease("ocp/release", "4.16.3-x86_64", releaseVersion, "amd64", releaseImages)
+		releaseImage = registry.host() + "/ocp/release:4.16.3-x86_64"
+	})
+
+	AfterEach(func() {
+		registry.server.Close()
+		os.RemoveAll(cacheDir)
+		ctrl.Finish()
+	})
+
+	It("reads the images and the version of the release", func() {
+		r := newRelease(nil)
+		image, err := r.GetMCOImage(log, releaseImage, "", registry.pullSecret())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(image).To(Equal(releaseImages[mcoImageName]))
+		image, err = r.GetMustGatherImage(log, releaseImage, "", registry.pullSecret())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(image).To(Equal(releaseImages[mustGatherImageName]))
+		image, err = r.GetCoreOSImage(log, releaseImage, "", registry.pullSecret())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(image).To(Equal(releaseImages[scosImageName]))
+		version, err := r.GetOpenshiftVersion(log, releaseImage, "", registry.pullSecret())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(version).To(Equal(releaseVersion))
+		version, err = r.GetMajorMinorVersion(log, releaseImage, "", registry.pullSecret())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(version).To(Equal("4.16"))
+		digest, err := r.GetReleaseDigest(log, releaseImage, "", registry.pullSecret())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(digest).To(Equal(releaseDigest))
+		Expect(registry.requestCount("blobs")).To(Equal(1), "only the release layer should be read, once")
+	})
+
+	It("fails for an image that is not part of the release", func() {
+		_, err := newRelease(nil).GetIronicAgentImage(log, releaseImage, "", registry.pullSecret())
+		Expect(err).To(MatchError(ContainSubstring(`no image tag "ironic-agent" exists`)))
+	})
+
+	It("fails without credentials for the registry", func() {
+		_, err := newRelease(nil).GetMCOImage(log, releaseImage, "", "")
+		Expect(err).To(MatchError(ContainSubstring("failed to get a token")))
+	})
+
+	It("fails for an image that is not a release", func() {
+		registry.pushManifest("ocp/other", "latest", &imageManifest{MediaType: mediaTypeDockerManifest,
+			Layers: []descriptor{{MediaType: "application/vnd.docker.image.rootfs.diff.tar.gzip", Digest: registry.pushBlob(tarGz(map[string]string{"a": "b"})).Digest}}})
+		_, err := newRelease(nil).GetOpenshiftVersion(log, registry.host()+"/ocp/other", "", registry.pullSecret())
+		Expect(err).To(MatchError(ContainSubstring("it is not a release image")))
+	})
+
+	It("reads the architectures of single and multi-arch images", func() {
+		r := newRelease(nil)
+		architectures, err := r.GetReleaseArchitecture(log, releaseImage, "", registry.pullSecret())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(architectures).To(Equal([]string{"x86_64"}))
+
+		armDigest := registry.pushRelease("ocp/release", "", releaseVersion, "arm64", releaseImages)
+		registry.pushManifest("ocp/release", "4.16.3-multi", &imageManifest{
+			MediaType: mediaTypeDockerManifestList,
+			Manifests: []descriptor{
+				{MediaType: mediaTypeDockerManifest, Digest: releaseDigest, Platform: &platform{Architecture: "amd64", OS: "linux"}},
+				{MediaType: mediaTypeDockerManifest, Digest: armDigest, Platform: &platform{Architecture: "arm64", OS: "linux"}},
+			},
+		})
+		multiImage := registry.host() + "/ocp/release:4.16.3-multi"
+		architectures, err = r.GetImageArchitecture(log, multiImage, registry.pullSecret())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(architectures).To(Equal([]string{"x86_64", "arm64"}))
+		version, err := r.GetOpenshiftVersion(log, multiImage, "", registry.pullSecret())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(version).To(Equal(releaseVersion))
+	})
+
+	It("reads the release image mirror over plain HTTP when no mirror registry is configured", func() {
+		mirror := newTestRegistry(false, "")
+		defer mirror.server.Close()
+		mirror.pushRelease("mirror/release", "4.17.0", "4.17.0", "amd64", releaseImages)
+		version, err := newRelease(nil).GetOpenshiftVersion(log, "quay.invalid/ocp/release:4.17.0", mirror.host()+"/mirror/release:4.17.0", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(version).To(Equal("4.17.0"))
+	})
+
+	It("reads images that are referenced by digest from the configured mirrors", func() {
+		mockMirrors = mirrorregistries.NewMockServiceMirrorRegistriesConfigBuilder(ctrl)
+		mockMirrors.EXPECT().IsMirrorRegistriesConfigured().Return(true).AnyTimes()
+		mockMirrors.EXPECT().GetMirrorCA().Return(nil, os.ErrNotExist).AnyTimes()
+		mockMirrors.EXPECT().ExtractLocationMirrorDataFromRegistries().Return([]mirrorregistries.RegistriesConf{
+			{Location: "quay.invalid/ocp", Mirror: []string{registry.host() + "/ocp"}},
+		}, nil).AnyTimes()
+		version, err := newRelease(nil).GetOpenshiftVersion(log, "quay.invalid/ocp/release@"+releaseDigest, "quay.invalid/ignored:4.16", registry.pullSecret())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(version).To(Equal(releaseVersion))
+	})
+
+	It("reads releases that were already read from the disk cache", func() {
+		image := registry.host() + "/ocp/release@" + releaseDigest
+		_, err := newRelease(nil).GetOpenshiftVersion(log, image, "", registry.pullSecret())
+		Expect(err).ToNot(HaveOccurred())
+		manifestRequests := registry.requestCount("manifests")
+		blobRequests := registry.requestCount("blobs")
+
+		r := newRelease(nil)
+		version, err := r.GetOpenshiftVersion(log, image, "", registry.pullSecret())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(version).To(Equal(releaseVersion))
+		digest, err := r.GetReleaseDigest(log, image, "", registry.pullSecret())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(digest).To(Equal(releaseDigest))
+		Expect(registry.requestCount("manifests")).To(Equal(manifestRequests))
+		Expect(registry.requestCount("blobs")).To(Equal(blobRequests))
+	})
+
+	It("extracts the installer with oc", func() {
+		mockRelease := NewMockRelease(ctrl)
+		mockRelease.EXPECT().Extract(log, releaseImage, "", "/cache", "pull-secret", "4.16.3").Return("/cache/openshift-install", nil)
+		path, err := newRelease(mockRelease).Extract(log, releaseImage, "", "/cache", "pull-secret", "4.16.3")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(path).To(Equal("/cache/openshift-install"))
+	})
+})
+
+var _ = Describe("imageLocations", func() {
+	mirrors := []mirrorregistries.RegistriesConf{
+		{Location: "quay.io/openshift-release-dev", Mirror: []string{"mirror.example.com:5000/ocp", "backup.example.com/ocp"}},
+	}
+	digest := "sha256:" + strings.Repeat("a", 64)
+
+	It("reads images by digest from their mirrors first", func() {
+		locations, err := imageLocations("quay.io/openshift-release-dev/ocp-release@"+digest, false, mirrors)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fmt.Sprint(locations)).To(Equal(fmt.Sprintf("[mirror.example.com:5000/ocp/ocp-release@%[1]s backup.example.com/ocp/ocp-release@%[1]s quay.io/openshift-release-dev/ocp-release@%[1]s]", digest)))
+	})
+
+	It("reads images by tag from their source", func() {
+		locations, err := imageLocations("quay.io/openshift-release-dev/ocp-release:4.16.3-x86_64", false, mirrors)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fmt.Sprint(locations)).To(Equal("[quay.io/openshift-release-dev/ocp-release:4.16.3-x86_64]"))
+	})
+
+	It("does not read images of other repositories from the mirrors", func() {
+		locations, err := imageLocations("quay.io/openshift-release-dev-other/ocp-release@"+digest, false, mirrors)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(locations).To(HaveLen(1))
+	})
+})
@@ -62,6 +62,7 @@ type Release interface {
 	GetCoreOSImage(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error)
 	GetOpenshiftVersion(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error)
 	GetMajorMinorVersion(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error)
+	GetReleaseDigest(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error)
 	GetReleaseArchitecture(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) ([]string, error)
 	GetImageArchitecture(log logrus.FieldLogger, image string, pullSecret string) ([]string, error)
 	GetReleaseBinaryPath(releaseImage string, cacheDir string, ocpVersion string) (workdir string, binary string, path string, err error)
@@ -96,6 +97,7 @@ func NewRelease(executer executer.Executer, config Config, mirrorRegistriesBuild
 const (
 	templateGetImage              = "oc adm release info --image-for=%s --insecure=%t %s %s"
 	templateGetVersion            = "oc adm release info -o template --template '{{.metadata.version}}' --insecure=%t %s %s"
+	templateGetDigest             = "oc adm release info -o template --template '{{.digest}}' --insecure=%t %s %s"
 	templateExtract               = "oc adm release extract --command=%s --to=%s --insecure=%t %s %s"
 	templateImageInfo             = "oc image info --output json %s %s"
 	templateSkopeoDetectMultiarch = "skopeo inspect --raw --no-tags docker://%s"
@@ -127,15 +129,22 @@ func (r *release) GetMustGatherImage(log logrus.FieldLogger, releaseImage string
 
 // GetCoreOSImage gets rhel-coreos image URL from the release image or releaseImageMirror, if provided.
 func (r *release) GetCoreOSImage(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+	return getCoreOSImage(log, func(imageName string) (string, error) {
+		return r.getImageByName(log, imageName, releaseImage, releaseImageMirror, pullSecret)
+	})
+}
+
+// getCoreOSImage gets the rhel-coreos image, or the stream-coreos image if the release has no rhel-coreos image
+func getCoreOSImage(log logrus.FieldLogger, getImageByName func(imageName string) (string, error)) (string, error) {
 	var image string
 	var rhcosErr, scosErr error
-	image, rhcosErr = r.getImageByName(log, rhcosImageName, releaseImage, releaseImageMirror, pullSecret)
+	image, rhcosErr = getImageByName(rhcosImageName)
 	if rhcosErr == nil {
 		return image, nil
 	}
 
 	// if rhcos image is not found, we can try scos image
-	image, scosErr = r.getImageByName(log, scosImageName, releaseImage, releaseImageMirror, pullSecret)
+	image, scosErr = getImageByName(scosImageName)
 	if scosErr == nil {
 		log.WithError(rhcosErr).Info("failed to get rhel-coreos image, using stream-coreos image")
 		return image, nil
@@ -193,7 +202,10 @@ func (r *release) GetMajorMinorVersion(log logrus.FieldLogger, releaseImage stri
 	if err != nil {
 		return "", err
 	}
+	return majorMinorVersion(openshiftVersion)
+}
 
+func majorMinorVersion(openshiftVersion string) (string, error) {
 	v, err := version.NewVersion(openshiftVersion)
 	if err != nil {
 		return "", err
@@ -202,6 +214,20 @@ func (r *release) GetMajorMinorVersion(log logrus.FieldLogger, releaseImage stri
 	return fmt.Sprintf("%d.%d", v.Segments()[0], v.Segments()[1]), nil
 }
 
+// GetReleaseDigest returns the digest of the release image, which identifies the content of the release
+// regardless of the tag it was referenced by
+func (r *release) GetReleaseDigest(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+	if releaseImage == "" && releaseImageMirror == "" {
+		return "", errors.New("no releaseImage nor releaseImageMirror provided")
+	}
+	digest, err := r.getTemplateFromRelease(log, templateGetDigest, releaseImage, releaseImageMirror, pullSecret)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get the digest of release image %s or mirror %s", releaseImage, releaseImageMirror)
+		return "", err
+	}
+	return digest, nil
+}
+
 func (r *release) GetReleaseArchitecture(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) ([]string, error) {
 	if releaseImage == "" && releaseImageMirror == "" {
 		return nil, errors.New("no releaseImage nor releaseImageMirror provided")
@@ -322,20 +348,26 @@ func (r *release) getImageFromRelease(log logrus.FieldLogger, imageName, release
 }
 
 func (r *release) getOpenshiftVersionFromRelease(log logrus.FieldLogger, releaseImage, releaseImageMirror, pullSecret string) (string, error) {
-	mirrorsFlag, err := r.getMirrorsFlagFromRegistriesConfig(log, templateGetVersion)
+	return r.getTemplateFromRelease(log, templateGetVersion, releaseImage, releaseImageMirror, pullSecret)
+}
+
+// getTemplateFromRelease returns the output of an 'oc adm release info' command that renders a template of the
+// release info
+func (r *release) getTemplateFromRelease(log logrus.FieldLogger, template, releaseImage, releaseImageMirror, pullSecret string) (string, error) {
+	mirrorsFlag, err := r.getMirrorsFlagFromRegistriesConfig(log, template)
 	if err != nil {
 		return "", err
 	}
 	defer mirrorsFlag.Delete()
 	image, insecure := r.getReleaseImageToUse(releaseImage, releaseImageMirror, mirrorsFlag)
 
-	cmd := fmt.Sprintf(templateGetVersion, insecure, mirrorsFlag, image)
-	version, err := execute(log, r.executer, pullSecret, cmd, ocAuthArgument)
+	cmd := fmt.Sprintf(template, insecure, mirrorsFlag, image)
+	value, err := execute(log, r.executer, pullSecret, cmd, ocAuthArgument)
 	if err != nil {
 		return "", err
 	}
 	// Trimming as output is retrieved wrapped with single quotes.
-	return strings.Trim(version, "'"), nil
+	return strings.Trim(value, "'"), nil
 }
 
 // Extract installer binary from releaseImageMirror if provided.
@@ -213,6 +213,38 @@ var _ = Describe("oc", func() {
 		})
 	})
 
+	Context("GetReleaseDigest", func() {
+		digest := "sha256:0c7d7a4a8b2e44dd3e1fdc9e2d2d45b2b8a7f14b5a1d06dc4b0c7e8e1a1b2c3d"
+
+		It("digest from release image", func() {
+			command := fmt.Sprintf(templateGetDigest+" --registry-config=%s",
+				false, releaseImage, "", tempFilePath)
+			args := splitStringToInterfacesArray(command)
+			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("'"+digest+"'", "", 0).Times(1)
+
+			result, err := oc.GetReleaseDigest(log, releaseImage, "", pullSecret)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).Should(Equal(digest))
+		})
+
+		It("digest from release image mirror", func() {
+			command := fmt.Sprintf(templateGetDigest+" --registry-config=%s",
+				true, releaseImageMirror, "", tempFilePath)
+			args := splitStringToInterfacesArray(command)
+			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return(digest, "", 0).Times(1)
+
+			result, err := oc.GetReleaseDigest(log, releaseImage, releaseImageMirror, pullSecret)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).Should(Equal(digest))
+		})
+
+		It("digest with no release image or mirror", func() {
+			result, err := oc.GetReleaseDigest(log, "", "", pullSecret)
+			Expect(result).Should(BeEmpty())
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
 	Context("GetMajorMinorVersion", func() {
 		tests := []struct {
 			fullVersion  string
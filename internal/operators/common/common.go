@@ -18,7 +18,7 @@ func NonInstallationDiskCount(disks []*models.Disk, installationDiskID string, m
 	var availableDisks int64
 
 	for _, disk := range disks {
-		if (disk.DriveType == models.DriveTypeSSD || disk.DriveType == models.DriveTypeHDD) && installationDiskID != disk.ID && disk.SizeBytes != 0 {
+		if IsNonInstallationStorageDisk(disk, installationDiskID) {
 			if disk.SizeBytes >= conversions.GbToBytes(minSizeGB) {
 				eligibleDisks++
 			} else {
@@ -30,6 +30,12 @@ func NonInstallationDiskCount(disks []*models.Disk, installationDiskID string, m
 	return eligibleDisks, availableDisks
 }
 
+// IsNonInstallationStorageDisk returns whether the disk can be used by the storage operators, that is an HDD or SSD
+// disk other than the installation disk
+func IsNonInstallationStorageDisk(disk *models.Disk, installationDiskID string) bool {
+	return (disk.DriveType == models.DriveTypeSSD || disk.DriveType == models.DriveTypeHDD) && installationDiskID != disk.ID && disk.SizeBytes != 0
+}
+
 func HasOperator(operators []*models.MonitoredOperator, operatorName string) bool {
 	for _, o := range operators {
 		if o.Name == operatorName {
@@ -0,0 +1,258 @@
+package revisions
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/bminventory"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi"
+	clusterplanops "github.com/openshift/assisted-service/restapi/operations/cluster_plan"
+	operations "github.com/openshift/assisted-service/restapi/operations/cluster_revisions"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	manifestsops "github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ restapi.ClusterRevisionsAPI = &Api{}
+
+//go:generate mockgen --build_flags=--mod=mod -package=revisions -destination=mock_plan_applier.go . PlanApplier
+type PlanApplier interface {
+	ApplyClusterPlanInternal(ctx context.Context, params clusterplanops.V2ApplyClusterPlanParams) (*models.ClusterPlanResult, error)
+}
+
+// Api lists and compares the revisions of clusters, and rolls back to them by applying the revision as a cluster
+// plan, so a rollback is subject to the same validations as the equivalent updates
+type Api struct {
+	db        *gorm.DB
+	installer bminventory.InstallerInternals
+	plans     PlanApplier
+	recorder  *Recorder
+	log       logrus.FieldLogger
+}
+
+func NewApi(db *gorm.DB, installer bminventory.InstallerInternals, plans PlanApplier, recorder *Recorder, log logrus.FieldLogger) *Api {
+	return &Api{
+		db:        db,
+		installer: installer,
+		plans:     plans,
+		recorder:  recorder,
+		log:       log,
+	}
+}
+
+func (a *Api) V2ListClusterRevisions(ctx context.Context, params operations.V2ListClusterRevisionsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+	if _, err := a.getCluster(params.ClusterID, common.SkipEagerLoading); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	var revisions []*common.ClusterRevision
+	if err := a.db.Where("cluster_id = ?", params.ClusterID.String()).Order("revision DESC").Find(&revisions).Error; err != nil {
+		log.WithError(err).Errorf("failed to list the revisions of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	ret := make(models.ClusterRevisionList, 0, len(revisions))
+	for _, revision := range revisions {
+		model, err := toModel(revision)
+		if err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		ret = append(ret, model)
+	}
+	return operations.NewV2ListClusterRevisionsOK().WithPayload(ret)
+}
+
+func (a *Api) V2GetClusterRevisionDiff(ctx context.Context, params operations.V2GetClusterRevisionDiffParams) middleware.Responder {
+	revision, err := a.getRevision(params.ClusterID, params.Revision)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	var base *models.ClusterRevisionSnapshot
+	if params.BaseRevision != nil {
+		baseRevision, err := a.getRevision(params.ClusterID, *params.BaseRevision)
+		if err != nil {
+			return common.GenerateErrorResponder(err)
+		}
+		base = baseRevision.Snapshot
+	} else if base, err = a.currentSnapshot(ctx, params.ClusterID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	changes, err := diff(base, revision.Snapshot)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return operations.NewV2GetClusterRevisionDiffOK().WithPayload(&models.ClusterRevisionDiff{
+		Revision:     swag.Int64(params.Revision),
+		BaseRevision: params.BaseRevision,
+		Changes:      changes,
+	})
+}
+
+func (a *Api) V2RollbackClusterRevision(ctx context.Context, params operations.V2RollbackClusterRevisionParams) middleware.Responder {
+	cluster, err := a.RollbackClusterRevisionInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewV2RollbackClusterRevisionOK().WithPayload(&cluster.Cluster)
+}
+
+func (a *Api) RollbackClusterRevisionInternal(ctx context.Context, params operations.V2RollbackClusterRevisionParams) (*common.Cluster, error) {
+	log := logutil.FromContext(ctx, a.log)
+	revision, err := a.getRevision(params.ClusterID, params.Revision)
+	if err != nil {
+		return nil, err
+	}
+	cluster, err := a.getCluster(params.ClusterID, common.UseEagerLoading)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := revision.Snapshot
+	plan, err := rollbackPlan(cluster, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("rolling back cluster %s to revision %d", params.ClusterID, params.Revision)
+	if _, err = a.plans.ApplyClusterPlanInternal(ctx, clusterplanops.V2ApplyClusterPlanParams{
+		ClusterID: params.ClusterID,
+		Plan:      plan,
+	}); err != nil {
+		return nil, err
+	}
+	if snapshot.IgnoredClusterValidations != cluster.IgnoredClusterValidations ||
+		snapshot.IgnoredHostValidations != cluster.IgnoredHostValidations {
+		if _, err = a.installer.SetIgnoredValidationsInternal(ctx, installer.V2SetIgnoredValidationsParams{
+			ClusterID: params.ClusterID,
+			IgnoredValidations: &models.IgnoredValidations{
+				ClusterValidationIds: snapshot.IgnoredClusterValidations,
+				HostValidationIds:    snapshot.IgnoredHostValidations,
+			},
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	a.recorder.RecordRevision(ctx, params.ClusterID, models.ClusterRevisionReasonRollback)
+	return a.installer.GetClusterInternal(ctx, installer.V2GetClusterParams{ClusterID: params.ClusterID})
+}
+
+// rollbackPlan returns the plan that sets the configuration of the revision. The hosts of the revision are matched
+// to the hosts of the cluster by their ID, and hosts that were bound to the cluster since are left unchanged.
+func rollbackPlan(cluster *common.Cluster, snapshot *models.ClusterRevisionSnapshot) (*models.ClusterPlan, error) {
+	hosts := make(map[strfmt.UUID]*models.Host, len(cluster.Hosts))
+	for _, host := range cluster.Hosts {
+		hosts[*host.ID] = host
+	}
+
+	plan := &models.ClusterPlan{
+		Cluster:   snapshot.Cluster,
+		Operators: snapshot.Operators,
+	}
+	if plan.Operators == nil {
+		plan.Operators = []*models.OperatorCreateParams{}
+	}
+	for _, revisionHost := range snapshot.Hosts {
+		host, ok := hosts[revisionHost.HostID]
+		if !ok {
+			return nil, common.NewApiError(http.StatusConflict,
+				errors.Errorf("host %s of the revision is no longer bound to cluster %s", revisionHost.HostID, cluster.ID))
+		}
+		macAddress, err := hostMacAddress(host)
+		if err != nil {
+			return nil, err
+		}
+		planHost := &models.ClusterPlanHost{MacAddress: swag.String(macAddress)}
+		if revisionHost.HostRole != "" {
+			planHost.HostRole = swag.String(revisionHost.HostRole)
+		}
+		if revisionHost.HostName != "" {
+			planHost.HostName = swag.String(revisionHost.HostName)
+		}
+		if revisionHost.InstallationDiskID != "" {
+			planHost.InstallationDiskID = swag.String(revisionHost.InstallationDiskID)
+		}
+		plan.Hosts = append(plan.Hosts, planHost)
+	}
+	return plan, nil
+}
+
+// hostMacAddress returns a MAC address that identifies the host in a cluster plan
+func hostMacAddress(host *models.Host) (string, error) {
+	if host.Inventory != "" {
+		inventory, err := common.UnmarshalInventory(host.Inventory)
+		if err != nil {
+			return "", common.NewApiError(http.StatusInternalServerError,
+				errors.Wrapf(err, "failed to parse the inventory of host %s", host.ID))
+		}
+		for _, iface := range inventory.Interfaces {
+			if iface.MacAddress != "" {
+				return iface.MacAddress, nil
+			}
+		}
+	}
+	return "", common.NewApiError(http.StatusConflict, errors.Errorf("host %s has no known MAC address", host.ID))
+}
+
+func (a *Api) currentSnapshot(ctx context.Context, clusterID strfmt.UUID) (*models.ClusterRevisionSnapshot, error) {
+	cluster, err := a.getCluster(clusterID, common.UseEagerLoading)
+	if err != nil {
+		return nil, err
+	}
+	manifests, err := a.recorder.manifests.ListClusterManifestsInternal(ctx, manifestsops.V2ListClusterManifestsParams{
+		ClusterID:              clusterID,
+		IncludeSystemGenerated: swag.Bool(false),
+	})
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := newSnapshot(cluster, manifests)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return snapshot, nil
+}
+
+func (a *Api) getCluster(clusterID strfmt.UUID, eagerLoading common.EagerLoadingState) (*common.Cluster, error) {
+	cluster, err := common.GetClusterFromDB(a.db, clusterID, eagerLoading)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return cluster, nil
+}
+
+func (a *Api) getRevision(clusterID strfmt.UUID, revision int64) (*models.ClusterRevision, error) {
+	var ret common.ClusterRevision
+	err := a.db.Where("cluster_id = ? AND revision = ?", clusterID.String(), revision).Take(&ret).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("revision %d of cluster %s not found", revision, clusterID))
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	model, err := toModel(&ret)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return model, nil
+}
+
+func toModel(revision *common.ClusterRevision) (*models.ClusterRevision, error) {
+	ret := revision.ClusterRevision
+	ret.Snapshot = &models.ClusterRevisionSnapshot{}
+	if err := json.Unmarshal([]byte(revision.SnapshotJSON), ret.Snapshot); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode revision %d of cluster %s", swag.Int64Value(revision.Revision), revision.ClusterID)
+	}
+	return &ret, nil
+}
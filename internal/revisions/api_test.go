@@ -0,0 +1,191 @@
+package revisions
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/bminventory"
+	"github.com/openshift/assisted-service/internal/common"
+	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
+	"github.com/openshift/assisted-service/models"
+	clusterplanops "github.com/openshift/assisted-service/restapi/operations/cluster_plan"
+	operations "github.com/openshift/assisted-service/restapi/operations/cluster_revisions"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Cluster revisions API", func() {
+	var (
+		ctx           = context.Background()
+		db            *gorm.DB
+		dbName        string
+		ctrl          *gomock.Controller
+		mockInstaller *bminventory.MockInstallerInternals
+		mockPlans     *MockPlanApplier
+		mockManifests *manifestsapi.MockClusterManifestsInternals
+		recorder      *Recorder
+		api           *Api
+		clusterID     strfmt.UUID
+		hostID        strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockInstaller = bminventory.NewMockInstallerInternals(ctrl)
+		mockPlans = NewMockPlanApplier(ctrl)
+		mockManifests = manifestsapi.NewMockClusterManifestsInternals(ctrl)
+		mockManifests.EXPECT().ListClusterManifestsInternal(gomock.Any(), gomock.Any()).Return(models.ListManifests{}, nil).AnyTimes()
+		recorder = NewRecorder(db, mockManifests, Config{}, logrus.New())
+		api = NewApi(db, mockInstaller, mockPlans, recorder, logrus.New())
+		clusterID, hostID = createTestCluster(db)
+
+		// Revision 1 is the initial configuration, revision 2 renames the cluster and ignores all cluster validations
+		recorder.RecordRevision(ctx, clusterID, models.ClusterRevisionReasonRegister)
+		recorder.RecordPendingRevisions()
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).Updates(map[string]interface{}{
+			"name":                        "renamed",
+			"ignored_cluster_validations": `["all"]`,
+		}).Error).ToNot(HaveOccurred())
+		recorder.RecordRevision(ctx, clusterID, models.ClusterRevisionReasonClusterUpdate)
+		recorder.RecordPendingRevisions()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	getDiff := func(revision int64, baseRevision *int64) *models.ClusterRevisionDiff {
+		reply := api.V2GetClusterRevisionDiff(ctx, operations.V2GetClusterRevisionDiffParams{
+			ClusterID:    clusterID,
+			Revision:     revision,
+			BaseRevision: baseRevision,
+		})
+		ExpectWithOffset(1, reply).To(BeAssignableToTypeOf(operations.NewV2GetClusterRevisionDiffOK()))
+		return reply.(*operations.V2GetClusterRevisionDiffOK).Payload
+	}
+
+	It("lists the revisions newest first", func() {
+		reply := api.V2ListClusterRevisions(ctx, operations.V2ListClusterRevisionsParams{ClusterID: clusterID})
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2ListClusterRevisionsOK()))
+		revisions := reply.(*operations.V2ListClusterRevisionsOK).Payload
+		Expect(revisions).To(HaveLen(2))
+		Expect(*revisions[0].Revision).To(Equal(int64(2)))
+		Expect(*revisions[0].Reason).To(Equal(models.ClusterRevisionReasonClusterUpdate))
+		Expect(revisions[0].Snapshot.Cluster.Name).To(Equal(swag.String("renamed")))
+		Expect(*revisions[1].Revision).To(Equal(int64(1)))
+	})
+
+	It("fails to list the revisions of a missing cluster", func() {
+		verifyApiError(api.V2ListClusterRevisions(ctx, operations.V2ListClusterRevisionsParams{
+			ClusterID: strfmt.UUID(uuid.New().String()),
+		}), http.StatusNotFound)
+	})
+
+	It("compares a revision with the current configuration", func() {
+		result := getDiff(1, nil)
+		Expect(result.BaseRevision).To(BeNil())
+		Expect(result.Changes).To(Equal([]*models.ClusterRevisionChange{
+			{Path: swag.String("cluster.name"), From: "renamed", To: "cluster"},
+			{Path: swag.String("ignored_cluster_validations"), From: `["all"]`},
+		}))
+		Expect(getDiff(2, nil).Changes).To(BeEmpty())
+	})
+
+	It("compares a revision with another revision", func() {
+		result := getDiff(2, swag.Int64(1))
+		Expect(result.BaseRevision).To(Equal(swag.Int64(1)))
+		Expect(result.Changes).To(Equal([]*models.ClusterRevisionChange{
+			{Path: swag.String("cluster.name"), From: "cluster", To: "renamed"},
+			{Path: swag.String("ignored_cluster_validations"), To: `["all"]`},
+		}))
+	})
+
+	It("fails to compare missing revisions", func() {
+		verifyApiError(api.V2GetClusterRevisionDiff(ctx, operations.V2GetClusterRevisionDiffParams{
+			ClusterID: clusterID,
+			Revision:  3,
+		}), http.StatusNotFound)
+		verifyApiError(api.V2GetClusterRevisionDiff(ctx, operations.V2GetClusterRevisionDiffParams{
+			ClusterID:    clusterID,
+			Revision:     1,
+			BaseRevision: swag.Int64(3),
+		}), http.StatusNotFound)
+	})
+
+	Context("rollback", func() {
+		It("applies the revision as a cluster plan", func() {
+			mockPlans.EXPECT().ApplyClusterPlanInternal(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, params clusterplanops.V2ApplyClusterPlanParams) (*models.ClusterPlanResult, error) {
+					Expect(params.ClusterID).To(Equal(clusterID))
+					Expect(params.DryRun).To(BeNil())
+					Expect(params.Plan.Cluster.Name).To(Equal(swag.String("cluster")))
+					Expect(params.Plan.Operators).To(Equal([]*models.OperatorCreateParams{{Name: "odf"}}))
+					Expect(params.Plan.Hosts).To(Equal([]*models.ClusterPlanHost{{
+						MacAddress:         swag.String("52:54:00:aa:bb:cc"),
+						HostRole:           swag.String(string(models.HostRoleAutoAssign)),
+						HostName:           swag.String("host-0"),
+						InstallationDiskID: swag.String("/dev/disk/by-id/disk-0"),
+					}}))
+					Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).Update("name", "cluster").Error).ToNot(HaveOccurred())
+					return &models.ClusterPlanResult{Applied: swag.Bool(true)}, nil
+				}).Times(1)
+			mockInstaller.EXPECT().SetIgnoredValidationsInternal(gomock.Any(), installer.V2SetIgnoredValidationsParams{
+				ClusterID:          clusterID,
+				IgnoredValidations: &models.IgnoredValidations{},
+			}).Return(&models.IgnoredValidations{}, nil).Times(1)
+			mockInstaller.EXPECT().GetClusterInternal(gomock.Any(), installer.V2GetClusterParams{ClusterID: clusterID}).
+				Return(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}, nil).Times(1)
+
+			reply := api.V2RollbackClusterRevision(ctx, operations.V2RollbackClusterRevisionParams{ClusterID: clusterID, Revision: 1})
+			Expect(reply).To(BeAssignableToTypeOf(operations.NewV2RollbackClusterRevisionOK()))
+
+			recorder.RecordPendingRevisions()
+			var latest common.ClusterRevision
+			Expect(db.Where("cluster_id = ?", clusterID.String()).Order("revision DESC").Take(&latest).Error).ToNot(HaveOccurred())
+			Expect(*latest.Revision).To(Equal(int64(3)))
+			Expect(*latest.Reason).To(Equal(models.ClusterRevisionReasonRollback))
+		})
+
+		It("does not update the ignored validations when they did not change", func() {
+			mockPlans.EXPECT().ApplyClusterPlanInternal(gomock.Any(), gomock.Any()).Return(&models.ClusterPlanResult{}, nil).Times(1)
+			mockInstaller.EXPECT().GetClusterInternal(gomock.Any(), gomock.Any()).
+				Return(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}, nil).Times(1)
+
+			reply := api.V2RollbackClusterRevision(ctx, operations.V2RollbackClusterRevisionParams{ClusterID: clusterID, Revision: 2})
+			Expect(reply).To(BeAssignableToTypeOf(operations.NewV2RollbackClusterRevisionOK()))
+		})
+
+		It("returns the errors of the plan", func() {
+			mockPlans.EXPECT().ApplyClusterPlanInternal(gomock.Any(), gomock.Any()).
+				Return(nil, common.NewApiError(http.StatusBadRequest, nil)).Times(1)
+			verifyApiError(api.V2RollbackClusterRevision(ctx, operations.V2RollbackClusterRevisionParams{
+				ClusterID: clusterID,
+				Revision:  1,
+			}), http.StatusBadRequest)
+		})
+
+		It("fails when a host of the revision is no longer bound to the cluster", func() {
+			Expect(db.Model(&common.Host{}).Where("id = ?", hostID.String()).Update("cluster_id", nil).Error).ToNot(HaveOccurred())
+			verifyApiError(api.V2RollbackClusterRevision(ctx, operations.V2RollbackClusterRevisionParams{
+				ClusterID: clusterID,
+				Revision:  1,
+			}), http.StatusConflict)
+		})
+
+		It("fails to roll back to a missing revision", func() {
+			verifyApiError(api.V2RollbackClusterRevision(ctx, operations.V2RollbackClusterRevisionParams{
+				ClusterID: clusterID,
+				Revision:  3,
+			}), http.StatusNotFound)
+		})
+	})
+})
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/openshift/assisted-service/internal/revisions (interfaces: PlanApplier)
+
+// Package revisions is a generated GoMock package.
+package revisions
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/openshift/assisted-service/models"
+	cluster_plan "github.com/openshift/assisted-service/restapi/operations/cluster_plan"
+)
+
+// MockPlanApplier is a mock of PlanApplier interface.
+type MockPlanApplier struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlanApplierMockRecorder
+}
+
+// MockPlanApplierMockRecorder is the mock recorder for MockPlanApplier.
+type MockPlanApplierMockRecorder struct {
+	mock *MockPlanApplier
+}
+
+// NewMockPlanApplier creates a new mock instance.
+func NewMockPlanApplier(ctrl *gomock.Controller) *MockPlanApplier {
+	mock := &MockPlanApplier{ctrl: ctrl}
+	mock.recorder = &MockPlanApplierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlanApplier) EXPECT() *MockPlanApplierMockRecorder {
+	return m.recorder
+}
+
+// ApplyClusterPlanInternal mocks base method.
+func (m *MockPlanApplier) ApplyClusterPlanInternal(arg0 context.Context, arg1 cluster_plan.V2ApplyClusterPlanParams) (*models.ClusterPlanResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyClusterPlanInternal", arg0, arg1)
+	ret0, _ := ret[0].(*models.ClusterPlanResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyClusterPlanInternal indicates an expected call of ApplyClusterPlanInternal.
+func (mr *MockPlanApplierMockRecorder) ApplyClusterPlanInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyClusterPlanInternal", reflect.TypeOf((*MockPlanApplier)(nil).ApplyClusterPlanInternal), arg0, arg1)
+}
@@ -0,0 +1,295 @@
+package revisions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/bminventory"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	manifestsops "github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type Config struct {
+	// The number of revisions kept for each cluster, older revisions are deleted
+	MaxRevisions int64 `envconfig:"CLUSTER_REVISIONS_LIMIT" default:"50"`
+	// The interval at which the requested revisions are recorded
+	RecordInterval time.Duration `envconfig:"CLUSTER_REVISIONS_RECORD_INTERVAL" default:"2s"`
+}
+
+// Recorder records the revisions of the configuration of clusters. The revisions are requested by the API calls that
+// change the configuration, and recorded in the background by RecordPendingRevisions, so that taking the snapshot,
+// which lists the manifests in the object storage, does not slow down or fail those calls.
+type Recorder struct {
+	db        *gorm.DB
+	manifests manifestsapi.ClusterManifestsInternals
+	config    Config
+	log       logrus.FieldLogger
+
+	pendingLock sync.Mutex
+	pending     []pendingRevision
+}
+
+// pendingRevision is a revision that was requested and not recorded yet
+type pendingRevision struct {
+	clusterID strfmt.UUID
+	reason    string
+	log       logrus.FieldLogger
+}
+
+var _ bminventory.RevisionRecorder = &Recorder{}
+
+func NewRecorder(db *gorm.DB, manifests manifestsapi.ClusterManifestsInternals, config Config, log logrus.FieldLogger) *Recorder {
+	return &Recorder{
+		db:        db,
+		manifests: manifests,
+		config:    config,
+		log:       log,
+	}
+}
+
+func (r *Recorder) RecordRevision(ctx context.Context, clusterID strfmt.UUID, reason string) {
+	r.pendingLock.Lock()
+	defer r.pendingLock.Unlock()
+	r.pending = append(r.pending, pendingRevision{
+		clusterID: clusterID,
+		reason:    reason,
+		log:       logutil.FromContext(ctx, r.log),
+	})
+}
+
+// RecordPendingRevisions records the requested revisions in the order they were requested
+func (r *Recorder) RecordPendingRevisions() {
+	r.pendingLock.Lock()
+	pending := r.pending
+	r.pending = nil
+	r.pendingLock.Unlock()
+
+	for _, revision := range pending {
+		if _, err := r.recordRevision(context.Background(), revision.clusterID, revision.reason); err != nil {
+			revision.log.WithError(err).Errorf("failed to record a %s revision of cluster %s", revision.reason, revision.clusterID)
+		}
+	}
+}
+
+// recordRevision returns the recorded revision, or nil if the configuration did not change since the latest revision
+func (r *Recorder) recordRevision(ctx context.Context, clusterID strfmt.UUID, reason string) (*common.ClusterRevision, error) {
+	manifests, err := r.manifests.ListClusterManifestsInternal(ctx, manifestsops.V2ListClusterManifestsParams{
+		ClusterID:              clusterID,
+		IncludeSystemGenerated: swag.Bool(false),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list the manifests of cluster %s", clusterID)
+	}
+
+	var ret *common.ClusterRevision
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		// Locking the cluster serializes the revisions of the cluster
+		cluster, err := common.GetClusterFromDBForUpdate(tx, clusterID, common.UseEagerLoading)
+		if err != nil {
+			return err
+		}
+		snapshot, err := newSnapshot(cluster, manifests)
+		if err != nil {
+			return err
+		}
+		snapshotJSON, err := json.Marshal(snapshot)
+		if err != nil {
+			return errors.Wrap(err, "failed to encode the snapshot")
+		}
+
+		var latest common.ClusterRevision
+		err = tx.Where("cluster_id = ?", clusterID.String()).Order("revision DESC").Take(&latest).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			latest.Revision = swag.Int64(0)
+		case err != nil:
+			return errors.Wrap(err, "failed to get the latest revision")
+		case latest.SnapshotJSON == string(snapshotJSON):
+			return nil
+		}
+
+		createdAt := strfmt.DateTime(time.Now())
+		revision := &common.ClusterRevision{
+			ClusterRevision: models.ClusterRevision{
+				ClusterID: &clusterID,
+				Revision:  swag.Int64(*latest.Revision + 1),
+				Reason:    swag.String(reason),
+				CreatedAt: &createdAt,
+			},
+			SnapshotJSON: string(snapshotJSON),
+		}
+		if err = tx.Create(revision).Error; err != nil {
+			return errors.Wrap(err, "failed to store the revision")
+		}
+		if r.config.MaxRevisions > 0 {
+			if err = tx.Where("cluster_id = ? AND revision <= ?", clusterID.String(), *revision.Revision-r.config.MaxRevisions).
+				Delete(&common.ClusterRevision{}).Error; err != nil {
+				return errors.Wrap(err, "failed to delete old revisions")
+			}
+		}
+		ret = revision
+		return nil
+	})
+	return ret, err
+}
+
+// newSnapshot captures the configuration of the cluster that can be set through the API
+func newSnapshot(cluster *common.Cluster, manifests []*models.Manifest) (*models.ClusterRevisionSnapshot, error) {
+	clusterJSON, err := json.Marshal(cluster.Cluster)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to encode cluster %s", cluster.ID)
+	}
+	clusterParams := &models.V2ClusterUpdateParams{}
+	if err = json.Unmarshal(clusterJSON, clusterParams); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode the params of cluster %s", cluster.ID)
+	}
+	clusterParams.PullSecret = nil
+	clusterParams.OlmOperators = nil
+	// The legacy network fields are set from the network lists
+	clusterParams.ClusterNetworkCidr = nil
+	clusterParams.ClusterNetworkHostPrefix = nil
+	clusterParams.ServiceNetworkCidr = nil
+	clusterParams.MachineNetworkCidr = nil
+	// These fields are omitted from the cluster when they are empty, and are set explicitly so that rolling back to
+	// a revision where they were empty clears them
+	if clusterParams.HTTPProxy == nil {
+		clusterParams.HTTPProxy = swag.String("")
+	}
+	if clusterParams.HTTPSProxy == nil {
+		clusterParams.HTTPSProxy = swag.String("")
+	}
+	if clusterParams.NoProxy == nil {
+		clusterParams.NoProxy = swag.String("")
+	}
+	if clusterParams.AdditionalNtpSource == nil {
+		clusterParams.AdditionalNtpSource = swag.String("")
+	}
+
+	snapshot := &models.ClusterRevisionSnapshot{
+		Cluster:                   clusterParams,
+		IgnoredClusterValidations: cluster.IgnoredClusterValidations,
+		IgnoredHostValidations:    cluster.IgnoredHostValidations,
+		Operators:                 []*models.OperatorCreateParams{},
+		Hosts:                     []*models.ClusterRevisionHost{},
+		Manifests:                 []*models.Manifest{},
+	}
+	for _, operator := range cluster.MonitoredOperators {
+		if operator.OperatorType == models.OperatorTypeOlm && !operator.DependencyOnly {
+			snapshot.Operators = append(snapshot.Operators, &models.OperatorCreateParams{Name: operator.Name, Properties: operator.Properties})
+		}
+	}
+	sort.Slice(snapshot.Operators, func(i, j int) bool { return snapshot.Operators[i].Name < snapshot.Operators[j].Name })
+	for _, host := range cluster.Hosts {
+		hostName, _ := hostutil.GetCurrentHostName(host)
+		snapshot.Hosts = append(snapshot.Hosts, &models.ClusterRevisionHost{
+			HostID:             *host.ID,
+			HostRole:           string(host.Role),
+			HostName:           hostName,
+			InstallationDiskID: host.InstallationDiskID,
+		})
+	}
+	sort.Slice(snapshot.Hosts, func(i, j int) bool { return snapshot.Hosts[i].HostID < snapshot.Hosts[j].HostID })
+	for _, manifest := range manifests {
+		snapshot.Manifests = append(snapshot.Manifests, &models.Manifest{
+			Folder:         manifest.Folder,
+			FileName:       manifest.FileName,
+			ManifestSource: manifest.ManifestSource,
+		})
+	}
+	sort.Slice(snapshot.Manifests, func(i, j int) bool {
+		return manifestPath(snapshot.Manifests[i]) < manifestPath(snapshot.Manifests[j])
+	})
+	return snapshot, nil
+}
+
+func manifestPath(manifest *models.Manifest) string {
+	return filepath.Join(manifest.Folder, manifest.FileName)
+}
+
+// flatten maps each property of the snapshot to its JSON value, keyed by the path of the property
+func flatten(snapshot *models.ClusterRevisionSnapshot) (map[string]interface{}, error) {
+	ret := make(map[string]interface{})
+	if snapshot.Cluster != nil {
+		clusterJSON, err := json.Marshal(snapshot.Cluster)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to encode the cluster of the snapshot")
+		}
+		var fields map[string]interface{}
+		if err = json.Unmarshal(clusterJSON, &fields); err != nil {
+			return nil, errors.Wrap(err, "failed to decode the cluster of the snapshot")
+		}
+		for name, value := range fields {
+			ret["cluster."+name] = value
+		}
+	}
+	for _, operator := range snapshot.Operators {
+		ret["operators."+operator.Name] = operator.Properties
+	}
+	if snapshot.IgnoredClusterValidations != "" {
+		ret["ignored_cluster_validations"] = snapshot.IgnoredClusterValidations
+	}
+	if snapshot.IgnoredHostValidations != "" {
+		ret["ignored_host_validations"] = snapshot.IgnoredHostValidations
+	}
+	for _, manifest := range snapshot.Manifests {
+		ret["manifests."+manifestPath(manifest)] = manifest.ManifestSource
+	}
+	for _, host := range snapshot.Hosts {
+		prefix := fmt.Sprintf("hosts.%s.", host.HostID)
+		ret[prefix+"host_role"] = host.HostRole
+		ret[prefix+"host_name"] = host.HostName
+		ret[prefix+"installation_disk_id"] = host.InstallationDiskID
+	}
+	return ret, nil
+}
+
+// diff returns the changes from the base snapshot to the snapshot, sorted by path
+func diff(base, snapshot *models.ClusterRevisionSnapshot) ([]*models.ClusterRevisionChange, error) {
+	from, err := flatten(base)
+	if err != nil {
+		return nil, err
+	}
+	to, err := flatten(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(from)+len(to))
+	for path := range from {
+		paths = append(paths, path)
+	}
+	for path := range to {
+		if _, ok := from[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	changes := make([]*models.ClusterRevisionChange, 0)
+	for _, path := range paths {
+		if reflect.DeepEqual(from[path], to[path]) {
+			continue
+		}
+		changes = append(changes, &models.ClusterRevisionChange{
+			Path: swag.String(path),
+			From: from[path],
+			To:   to[path],
+		})
+	}
+	return changes, nil
+}
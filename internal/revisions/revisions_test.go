@@ -0,0 +1,196 @@
+package revisions
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// createTestCluster creates a cluster with a single host, and returns the ids of the cluster and of the host
+func createTestCluster(db *gorm.DB) (strfmt.UUID, strfmt.UUID) {
+	clusterID := strfmt.UUID(uuid.New().String())
+	infraEnvID := strfmt.UUID(uuid.New().String())
+	hostID := strfmt.UUID(uuid.New().String())
+	cluster := &common.Cluster{
+		Cluster: models.Cluster{
+			ID:                 &clusterID,
+			Name:               "cluster",
+			OpenshiftVersion:   "4.14",
+			BaseDNSDomain:      "example.com",
+			Status:             swag.String(models.ClusterStatusReady),
+			SchedulableMasters: swag.Bool(false),
+			MonitoredOperators: []*models.MonitoredOperator{
+				{Name: "odf", OperatorType: models.OperatorTypeOlm},
+				{Name: "lso", OperatorType: models.OperatorTypeOlm, DependencyOnly: true},
+				{Name: "console", OperatorType: models.OperatorTypeBuiltin},
+			},
+		},
+		PullSecret: "secret",
+	}
+	ExpectWithOffset(1, db.Create(cluster).Error).ToNot(HaveOccurred())
+	inventory, err := common.MarshalInventory(&models.Inventory{
+		Hostname:   "host-0",
+		Interfaces: []*models.Interface{{Name: "eth0", MacAddress: "52:54:00:aa:bb:cc"}},
+	})
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	host := &common.Host{Host: models.Host{
+		ID:                 &hostID,
+		InfraEnvID:         infraEnvID,
+		ClusterID:          &clusterID,
+		Status:             swag.String(models.HostStatusKnown),
+		Role:               models.HostRoleAutoAssign,
+		Inventory:          inventory,
+		InstallationDiskID: "/dev/disk/by-id/disk-0",
+	}}
+	ExpectWithOffset(1, db.Create(host).Error).ToNot(HaveOccurred())
+	return clusterID, hostID
+}
+
+var _ = Describe("Recorder", func() {
+	var (
+		ctx           = context.Background()
+		db            *gorm.DB
+		dbName        string
+		ctrl          *gomock.Controller
+		mockManifests *manifestsapi.MockClusterManifestsInternals
+		recorder      *Recorder
+		clusterID     strfmt.UUID
+		hostID        strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockManifests = manifestsapi.NewMockClusterManifestsInternals(ctrl)
+		mockManifests.EXPECT().ListClusterManifestsInternal(gomock.Any(), gomock.Any()).Return(models.ListManifests{}, nil).AnyTimes()
+		recorder = NewRecorder(db, mockManifests, Config{MaxRevisions: 2}, logrus.New())
+		clusterID, hostID = createTestCluster(db)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	revisionNumbers := func() []int64 {
+		var revisions []*common.ClusterRevision
+		ExpectWithOffset(1, db.Where("cluster_id = ?", clusterID.String()).Order("revision").Find(&revisions).Error).ToNot(HaveOccurred())
+		ret := []int64{}
+		for _, revision := range revisions {
+			ret = append(ret, *revision.Revision)
+		}
+		return ret
+	}
+
+	It("captures the configuration of the cluster", func() {
+		revision, err := recorder.recordRevision(ctx, clusterID, models.ClusterRevisionReasonRegister)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*revision.Revision).To(Equal(int64(1)))
+		Expect(*revision.Reason).To(Equal(models.ClusterRevisionReasonRegister))
+
+		model, err := toModel(revision)
+		Expect(err).ToNot(HaveOccurred())
+		snapshot := model.Snapshot
+		Expect(snapshot.Cluster.Name).To(Equal(swag.String("cluster")))
+		Expect(snapshot.Cluster.BaseDNSDomain).To(Equal(swag.String("example.com")))
+		Expect(snapshot.Cluster.PullSecret).To(BeNil())
+		Expect(snapshot.Cluster.HTTPProxy).To(Equal(swag.String("")))
+		Expect(snapshot.Operators).To(Equal([]*models.OperatorCreateParams{{Name: "odf"}}))
+		Expect(snapshot.Hosts).To(Equal([]*models.ClusterRevisionHost{{
+			HostID:             hostID,
+			HostRole:           string(models.HostRoleAutoAssign),
+			HostName:           "host-0",
+			InstallationDiskID: "/dev/disk/by-id/disk-0",
+		}}))
+	})
+
+	It("records the requested revisions in the background", func() {
+		recorder.RecordRevision(ctx, clusterID, models.ClusterRevisionReasonRegister)
+		Expect(revisionNumbers()).To(BeEmpty())
+
+		recorder.RecordPendingRevisions()
+		Expect(revisionNumbers()).To(Equal([]int64{1}))
+		recorder.RecordPendingRevisions()
+		Expect(revisionNumbers()).To(Equal([]int64{1}))
+	})
+
+	It("does not record a revision when the configuration did not change", func() {
+		recorder.RecordRevision(ctx, clusterID, models.ClusterRevisionReasonRegister)
+		recorder.RecordRevision(ctx, clusterID, models.ClusterRevisionReasonClusterUpdate)
+		recorder.RecordPendingRevisions()
+		Expect(revisionNumbers()).To(Equal([]int64{1}))
+
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).Update("name", "renamed").Error).ToNot(HaveOccurred())
+		recorder.RecordRevision(ctx, clusterID, models.ClusterRevisionReasonClusterUpdate)
+		recorder.RecordPendingRevisions()
+		Expect(revisionNumbers()).To(Equal([]int64{1, 2}))
+	})
+
+	It("deletes the revisions above the limit", func() {
+		for i, name := range []string{"a", "b", "c"} {
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).Update("name", name).Error).ToNot(HaveOccurred())
+			revision, err := recorder.recordRevision(ctx, clusterID, models.ClusterRevisionReasonClusterUpdate)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*revision.Revision).To(Equal(int64(i + 1)))
+		}
+		Expect(revisionNumbers()).To(Equal([]int64{2, 3}))
+	})
+
+	It("does not record revisions of missing clusters", func() {
+		_, err := recorder.recordRevision(ctx, strfmt.UUID(uuid.New().String()), models.ClusterRevisionReasonClusterUpdate)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("diff", func() {
+	hostID := strfmt.UUID(uuid.New().String())
+	newTestSnapshot := func() *models.ClusterRevisionSnapshot {
+		return &models.ClusterRevisionSnapshot{
+			Cluster:   &models.V2ClusterUpdateParams{Name: swag.String("cluster"), HTTPProxy: swag.String("")},
+			Operators: []*models.OperatorCreateParams{{Name: "odf"}},
+			Hosts: []*models.ClusterRevisionHost{
+				{HostID: hostID, HostRole: string(models.HostRoleAutoAssign), HostName: "host-0"},
+			},
+			Manifests: []*models.Manifest{{Folder: "openshift", FileName: "a.yaml", ManifestSource: "user"}},
+		}
+	}
+
+	It("returns no changes for equal snapshots", func() {
+		changes, err := diff(newTestSnapshot(), newTestSnapshot())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+	})
+
+	It("returns the changed properties sorted by path", func() {
+		base := newTestSnapshot()
+		snapshot := newTestSnapshot()
+		snapshot.Cluster.Name = swag.String("renamed")
+		snapshot.Cluster.HTTPProxy = swag.String("http://proxy.example.com")
+		snapshot.Operators = []*models.OperatorCreateParams{{Name: "cnv"}}
+		snapshot.Hosts[0].HostRole = string(models.HostRoleMaster)
+		snapshot.Manifests = nil
+		snapshot.IgnoredClusterValidations = `["all"]`
+
+		changes, err := diff(base, snapshot)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(Equal([]*models.ClusterRevisionChange{
+			{Path: swag.String("cluster.http_proxy"), From: "", To: "http://proxy.example.com"},
+			{Path: swag.String("cluster.name"), From: "cluster", To: "renamed"},
+			{Path: swag.String("hosts." + hostID.String() + ".host_role"), From: "auto-assign", To: "master"},
+			{Path: swag.String("ignored_cluster_validations"), To: `["all"]`},
+			{Path: swag.String("manifests.openshift/a.yaml"), From: "user"},
+			{Path: swag.String("operators.cnv"), To: ""},
+			{Path: swag.String("operators.odf"), From: ""},
+		}))
+	})
+})
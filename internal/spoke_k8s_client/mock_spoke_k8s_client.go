@@ -112,6 +112,20 @@ func (mr *MockSpokeK8sClientMockRecorder) DeleteAllOf(arg0, arg1 interface{}, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllOf", reflect.TypeOf((*MockSpokeK8sClient)(nil).DeleteAllOf), varargs...)
 }
 
+// DeleteCsr mocks base method.
+func (m *MockSpokeK8sClient) DeleteCsr(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCsr", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCsr indicates an expected call of DeleteCsr.
+func (mr *MockSpokeK8sClientMockRecorder) DeleteCsr(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCsr", reflect.TypeOf((*MockSpokeK8sClient)(nil).DeleteCsr), arg0, arg1)
+}
+
 // DeleteNode mocks base method.
 func (m *MockSpokeK8sClient) DeleteNode(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -36,6 +36,7 @@ type SpokeK8sClient interface {
 	client.Client
 	ListCsrs(ctx context.Context) (*certificatesv1.CertificateSigningRequestList, error)
 	ApproveCsr(ctx context.Context, csr *certificatesv1.CertificateSigningRequest) error
+	DeleteCsr(ctx context.Context, name string) error
 	GetNode(ctx context.Context, name string) (*corev1.Node, error)
 	PatchNodeLabels(ctx context.Context, nodeName string, nodeLabels string) error
 	PatchMachineConfigPoolPaused(ctx context.Context, pause bool, mcpName string) error
@@ -66,6 +67,10 @@ func (c *spokeK8sClient) ApproveCsr(ctx context.Context, csr *certificatesv1.Cer
 	return err
 }
 
+func (c *spokeK8sClient) DeleteCsr(ctx context.Context, name string) error {
+	return c.csrClient.Delete(ctx, name, metav1.DeleteOptions{})
+}
+
 func (c *spokeK8sClient) GetNode(ctx context.Context, name string) (*corev1.Node, error) {
 	node, err := c.nodesClient.Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
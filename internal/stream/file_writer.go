@@ -0,0 +1,77 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type FileWriterConfig struct {
+	Path string `envconfig:"FILE_WRITER_PATH" required:"true"`
+	// Sync flushes every notification to the disk before acknowledging it
+	Sync bool `envconfig:"FILE_WRITER_SYNC" default:"false"`
+}
+
+type fileRecord struct {
+	Time  time.Time   `json:"time"`
+	Key   string      `json:"key"`
+	Value interface{} `json:"value"`
+}
+
+// FileWriter appends the notifications to a file as JSON lines, e.g. for auditing air-gapped deployments.
+// The file is opened in append mode, so it can be rotated by truncating it.
+type FileWriter struct {
+	mutex  sync.Mutex
+	file   *os.File
+	config FileWriterConfig
+}
+
+func newFileWriter(_ logrus.FieldLogger, reporter *DeliveryReporter) (StreamWriter, error) {
+	config := FileWriterConfig{}
+	if err := envconfig.Process("", &config); err != nil {
+		return nil, err
+	}
+	writer, err := NewFileWriter(config)
+	if err != nil {
+		return nil, err
+	}
+	return newMeteredWriter("file", writer, reporter), nil
+}
+
+func NewFileWriter(config FileWriterConfig) (*FileWriter, error) {
+	file, err := os.OpenFile(config.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open notifications file %s", config.Path)
+	}
+	return &FileWriter{file: file, config: config}, nil
+}
+
+func (w *FileWriter) Write(ctx context.Context, key []byte, value interface{}) error {
+	line, err := json.Marshal(&fileRecord{Time: time.Now().UTC(), Key: string(key), Value: value})
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if _, err = w.file.Write(line); err != nil {
+		return errors.Wrapf(err, "failed to write to notifications file %s", w.config.Path)
+	}
+	if w.config.Sync {
+		return errors.Wrapf(w.file.Sync(), "failed to sync notifications file %s", w.config.Path)
+	}
+	return nil
+}
+
+func (w *FileWriter) Close() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.file.Close()
+}
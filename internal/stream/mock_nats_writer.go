@@ -0,0 +1,63 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: nats_writer.go
+
+// Package stream is a generated GoMock package.
+package stream
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	nats "github.com/nats-io/nats.go"
+)
+
+// MockNATSPublisher is a mock of NATSPublisher interface.
+type MockNATSPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockNATSPublisherMockRecorder
+}
+
+// MockNATSPublisherMockRecorder is the mock recorder for MockNATSPublisher.
+type MockNATSPublisherMockRecorder struct {
+	mock *MockNATSPublisher
+}
+
+// NewMockNATSPublisher creates a new mock instance.
+func NewMockNATSPublisher(ctrl *gomock.Controller) *MockNATSPublisher {
+	mock := &MockNATSPublisher{ctrl: ctrl}
+	mock.recorder = &MockNATSPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNATSPublisher) EXPECT() *MockNATSPublisherMockRecorder {
+	return m.recorder
+}
+
+// Drain mocks base method.
+func (m *MockNATSPublisher) Drain() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Drain")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Drain indicates an expected call of Drain.
+func (mr *MockNATSPublisherMockRecorder) Drain() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Drain", reflect.TypeOf((*MockNATSPublisher)(nil).Drain))
+}
+
+// PublishMsg mocks base method.
+func (m *MockNATSPublisher) PublishMsg(msg *nats.Msg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishMsg", msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishMsg indicates an expected call of PublishMsg.
+func (mr *MockNATSPublisherMockRecorder) PublishMsg(msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishMsg", reflect.TypeOf((*MockNATSPublisher)(nil).PublishMsg), msg)
+}
@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	common "github.com/openshift/assisted-service/internal/common"
+	gorm "gorm.io/gorm"
 )
 
 // MockNotifier is a mock of Notifier interface.
@@ -60,3 +61,17 @@ func (mr *MockNotifierMockRecorder) Notify(ctx, notifiable interface{}) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifier)(nil).Notify), ctx, notifiable)
 }
+
+// NotifyInTransaction mocks base method.
+func (m *MockNotifier) NotifyInTransaction(ctx context.Context, db *gorm.DB, fn func(*gorm.DB, NotifyFunc) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyInTransaction", ctx, db, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyInTransaction indicates an expected call of NotifyInTransaction.
+func (mr *MockNotifierMockRecorder) NotifyInTransaction(ctx, db, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyInTransaction", reflect.TypeOf((*MockNotifier)(nil).NotifyInTransaction), ctx, db, fn)
+}
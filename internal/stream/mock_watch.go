@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: watch.go
+
+// Package stream is a generated GoMock package.
+package stream
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWatcher is a mock of Watcher interface.
+type MockWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatcherMockRecorder
+}
+
+// MockWatcherMockRecorder is the mock recorder for MockWatcher.
+type MockWatcherMockRecorder struct {
+	mock *MockWatcher
+}
+
+// NewMockWatcher creates a new mock instance.
+func NewMockWatcher(ctrl *gomock.Controller) *MockWatcher {
+	mock := &MockWatcher{ctrl: ctrl}
+	mock.recorder = &MockWatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatcher) EXPECT() *MockWatcherMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method.
+func (m *MockWatcher) Subscribe(ctx context.Context, filter WatchFilter, since *int64) (WatchSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, filter, since)
+	ret0, _ := ret[0].(WatchSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockWatcherMockRecorder) Subscribe(ctx, filter, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockWatcher)(nil).Subscribe), ctx, filter, since)
+}
+
+// MockWatchSubscription is a mock of WatchSubscription interface.
+type MockWatchSubscription struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatchSubscriptionMockRecorder
+}
+
+// MockWatchSubscriptionMockRecorder is the mock recorder for MockWatchSubscription.
+type MockWatchSubscriptionMockRecorder struct {
+	mock *MockWatchSubscription
+}
+
+// NewMockWatchSubscription creates a new mock instance.
+func NewMockWatchSubscription(ctrl *gomock.Controller) *MockWatchSubscription {
+	mock := &MockWatchSubscription{ctrl: ctrl}
+	mock.recorder = &MockWatchSubscriptionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatchSubscription) EXPECT() *MockWatchSubscriptionMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockWatchSubscription) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockWatchSubscriptionMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockWatchSubscription)(nil).Close))
+}
+
+// Messages mocks base method.
+func (m *MockWatchSubscription) Messages() <-chan *WatchMessage {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Messages")
+	ret0, _ := ret[0].(<-chan *WatchMessage)
+	return ret0
+}
+
+// Messages indicates an expected call of Messages.
+func (mr *MockWatchSubscriptionMockRecorder) Messages() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Messages", reflect.TypeOf((*MockWatchSubscription)(nil).Messages))
+}
@@ -0,0 +1,97 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+//go:generate mockgen -source=nats_writer.go -package=stream -destination=mock_nats_writer.go
+
+const natsKeyHeader = "Assisted-Key"
+
+type NATSConfig struct {
+	URL string `envconfig:"NATS_URL" required:"true"`
+	// Notifications are published to <subject>.<notification name>, e.g. assisted-service.notifications.HostState
+	Subject         string `envconfig:"NATS_SUBJECT" default:"assisted-service.notifications"`
+	CredentialsFile string `envconfig:"NATS_CREDENTIALS_FILE" default:""`
+	Token           string `envconfig:"NATS_TOKEN" default:""`
+	CACertFile      string `envconfig:"NATS_CA_CERT_FILE" default:""`
+}
+
+// mocking the NATS connection for testing
+type NATSPublisher interface {
+	PublishMsg(msg *nats.Msg) error
+	Drain() error
+}
+
+type NATSWriter struct {
+	publisher NATSPublisher
+	subject   string
+	log       logrus.FieldLogger
+}
+
+func newNATSWriter(log logrus.FieldLogger, reporter *DeliveryReporter) (StreamWriter, error) {
+	config := NATSConfig{}
+	if err := envconfig.Process("", &config); err != nil {
+		return nil, err
+	}
+	options := []nats.Option{
+		nats.Name("assisted-service"),
+		// Keep reconnecting forever, messages are buffered by the client in the meantime
+		nats.MaxReconnects(-1),
+		nats.ReconnectWait(2 * time.Second),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			log.WithError(err).Warn("disconnected from NATS")
+		}),
+		nats.ReconnectHandler(func(conn *nats.Conn) {
+			log.Infof("reconnected to NATS at %s", conn.ConnectedUrl())
+		}),
+	}
+	if config.CredentialsFile != "" {
+		options = append(options, nats.UserCredentials(config.CredentialsFile))
+	}
+	if config.Token != "" {
+		options = append(options, nats.Token(config.Token))
+	}
+	if config.CACertFile != "" {
+		options = append(options, nats.RootCAs(config.CACertFile))
+	}
+	conn, err := nats.Connect(config.URL, options...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to NATS at %s", config.URL)
+	}
+	return newMeteredWriter("nats", NewNATSWriter(conn, config.Subject, log), reporter), nil
+}
+
+func NewNATSWriter(publisher NATSPublisher, subject string, log logrus.FieldLogger) *NATSWriter {
+	return &NATSWriter{publisher: publisher, subject: subject, log: log}
+}
+
+// Write publishes the notification. The client publishes asynchronously, hence a nil error means the
+// notification was buffered rather than received by the server.
+func (w *NATSWriter) Write(ctx context.Context, key []byte, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	subject := w.subject
+	if envelope, ok := value.(*Envelope); ok && envelope.Name != "" {
+		subject += "." + envelope.Name
+	}
+	msg := nats.NewMsg(subject)
+	msg.Data = data
+	msg.Header.Set(natsKeyHeader, string(key))
+	return w.publisher.PublishMsg(msg)
+}
+
+func (w *NATSWriter) Close() {
+	if err := w.publisher.Drain(); err != nil {
+		w.log.WithError(err).Warn("failed to drain NATS connection")
+	}
+}
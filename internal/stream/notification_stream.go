@@ -2,17 +2,28 @@ package stream
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
 )
 
 //go:generate mockgen -source=notification_stream.go -package=stream -destination=mock_notification_stream.go
 
+// NotifyFunc notifies a change that is persisted by the transaction it is passed along with
+type NotifyFunc func(notifiable common.Notifiable) error
+
 type Notifier interface {
 	Notify(ctx context.Context, notifiable common.Notifiable) error
+	// NotifyInTransaction runs fn in a database transaction along with a function that notifies the changes it
+	// persists. With the outbox enabled the notifications are written to the outbox by the transaction, so that
+	// they are delivered if and only if the changes are committed. Otherwise, fn runs without a transaction and
+	// the notifications are sent once it returns successfully.
+	NotifyInTransaction(ctx context.Context, db *gorm.DB, fn func(tx *gorm.DB, notify NotifyFunc) error) error
 	Close()
 }
 
@@ -26,6 +37,8 @@ type NotificationStream struct {
 	metadata interface{}
 	writer   StreamWriter
 	log      logrus.FieldLogger
+	// outbox is the database the notifications are written to when the outbox is enabled
+	outbox *gorm.DB
 }
 
 func NewNotificationStream(writer StreamWriter, logger logrus.FieldLogger, metadata interface{}) *NotificationStream {
@@ -37,6 +50,17 @@ func NewNotificationStream(writer StreamWriter, logger logrus.FieldLogger, metad
 
 }
 
+// NewOutboxNotificationStream creates a notification stream that writes the notifications to the outbox, from
+// which they are delivered to the writer by an OutboxRelay
+func NewOutboxNotificationStream(db *gorm.DB, writer StreamWriter, logger logrus.FieldLogger, metadata interface{}) *NotificationStream {
+	return &NotificationStream{
+		writer:   writer,
+		metadata: metadata,
+		log:      logger,
+		outbox:   db,
+	}
+}
+
 func (s *NotificationStream) Notify(ctx context.Context, notifiable common.Notifiable) error {
 	if s.writer == nil {
 		return nil
@@ -44,6 +68,9 @@ func (s *NotificationStream) Notify(ctx context.Context, notifiable common.Notif
 	if notifiable == nil || reflect.ValueOf(notifiable).IsNil() {
 		return fmt.Errorf("trying to notify on nil notifiable")
 	}
+	if s.outbox != nil {
+		return s.enqueue(s.outbox, notifiable)
+	}
 	key := ""
 	clusterID := notifiable.GetClusterID()
 	if clusterID != nil {
@@ -68,6 +95,52 @@ func (s *NotificationStream) Notify(ctx context.Context, notifiable common.Notif
 	return nil
 }
 
+func (s *NotificationStream) NotifyInTransaction(ctx context.Context, db *gorm.DB, fn func(tx *gorm.DB, notify NotifyFunc) error) error {
+	if s.outbox != nil && s.writer != nil {
+		return db.Transaction(func(tx *gorm.DB) error {
+			return fn(tx, func(notifiable common.Notifiable) error {
+				if notifiable == nil || reflect.ValueOf(notifiable).IsNil() {
+					return fmt.Errorf("trying to notify on nil notifiable")
+				}
+				return s.enqueue(tx, notifiable)
+			})
+		})
+	}
+
+	var notifiables []common.Notifiable
+	err := fn(db, func(notifiable common.Notifiable) error {
+		notifiables = append(notifiables, notifiable)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	// Failing to notify does not fail the change, which is already persisted
+	for _, notifiable := range notifiables {
+		if err = s.Notify(ctx, notifiable); err != nil {
+			s.log.WithError(err).Warning("failed to notify update event")
+		}
+	}
+	return nil
+}
+
+// enqueue writes the notification to the outbox using the given database, which is usually the transaction
+// that persists the change the notification is about
+func (s *NotificationStream) enqueue(db *gorm.DB, notifiable common.Notifiable) error {
+	payload, err := json.Marshal(notifiable.Payload())
+	if err != nil {
+		return errors.Wrapf(err, "failed to encode %s notification", notifiable.NotificationType())
+	}
+	notification := &common.OutboxNotification{
+		Type:    notifiable.NotificationType(),
+		Payload: string(payload),
+	}
+	if clusterID := notifiable.GetClusterID(); clusterID != nil {
+		notification.Key = clusterID.String()
+	}
+	return errors.Wrapf(db.Create(notification).Error, "failed to write %s notification to the outbox", notification.Type)
+}
+
 func (s *NotificationStream) Close() {
 	if s.writer != nil {
 		s.writer.Close()
@@ -219,3 +219,11 @@ func TestNotificationStream(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Notification stream")
 }
+
+var _ = BeforeSuite(func() {
+	common.InitializeDBTest()
+})
+
+var _ = AfterSuite(func() {
+	common.TerminateDBTest()
+})
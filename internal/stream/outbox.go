@@ -0,0 +1,169 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const maxOutboxErrorLength = 1024
+
+type OutboxConfig struct {
+	EnableOutbox  bool          `envconfig:"ENABLE_NOTIFICATION_OUTBOX" default:"false"`
+	RelayInterval time.Duration `envconfig:"NOTIFICATION_OUTBOX_RELAY_INTERVAL" default:"1s"`
+	BatchSize     int           `envconfig:"NOTIFICATION_OUTBOX_BATCH_SIZE" default:"500"`
+	// Timeout of writing a single notification
+	WriteTimeout time.Duration `envconfig:"NOTIFICATION_OUTBOX_WRITE_TIMEOUT" default:"30s"`
+	// Number of failed attempts to write a notification after which it's dead-lettered, zero means no limit
+	MaxAttempts int `envconfig:"NOTIFICATION_OUTBOX_MAX_ATTEMPTS" default:"10"`
+}
+
+// OutboxRelay delivers the notifications written to the outbox to the stream writer, and deletes them once they
+// are written. Relay is expected to be invoked periodically, and relays only on the leader replica.
+//
+// Delivery is at-least-once: a notification is written again if the replica stops, or loses the leadership,
+// after writing it and before deleting it. Notifications with the same key are written in the order they were
+// added to the outbox, so a notification is not written until the previous ones with its key are. A notification that
+// can't be decoded, or that fails to be written MaxAttempts times, is dead-lettered: it's kept in the outbox for
+// inspection, but it's no longer retried and it no longer holds back the notifications with its key.
+type OutboxRelay struct {
+	db       *gorm.DB
+	writer   StreamWriter
+	metadata interface{}
+	leader   leader.Leader
+	config   OutboxConfig
+	log      logrus.FieldLogger
+}
+
+func NewOutboxRelay(db *gorm.DB, writer StreamWriter, metadata interface{}, lead leader.Leader, config OutboxConfig, log logrus.FieldLogger) *OutboxRelay {
+	return &OutboxRelay{
+		db:       db,
+		writer:   writer,
+		metadata: metadata,
+		leader:   lead,
+		config:   config,
+		log:      log,
+	}
+}
+
+// NewOutboxRelay returns the relay of the notifications written to the outbox by the stream
+func (s *NotificationStream) NewOutboxRelay(lead leader.Leader, config OutboxConfig, log logrus.FieldLogger) *OutboxRelay {
+	return NewOutboxRelay(s.outbox, s.writer, s.metadata, lead, config, log)
+}
+
+func (r *OutboxRelay) Relay() {
+	if !r.leader.IsLeader() {
+		return
+	}
+	for {
+		relayed, err := r.relayBatch()
+		if err != nil {
+			r.log.WithError(err).Error("failed to relay outbox notifications")
+			return
+		}
+		// Keep draining as long as full batches are relayed, in order to catch up after an outage
+		if relayed < r.config.BatchSize || !r.leader.IsLeader() {
+			return
+		}
+	}
+}
+
+// relayBatch writes the oldest notifications of the outbox and returns the number of notifications that were
+// written
+func (r *OutboxRelay) relayBatch() (int, error) {
+	var notifications []*common.OutboxNotification
+	if err := r.db.Where("dead_lettered = ?", false).Order("id").Limit(r.config.BatchSize).Find(&notifications).Error; err != nil {
+		return 0, errors.Wrap(err, "failed to read the outbox")
+	}
+
+	blockedKeys := make(map[string]bool)
+	relayed := make([]int64, 0, len(notifications))
+	for _, notification := range notifications {
+		if blockedKeys[notification.Key] {
+			continue
+		}
+		payload, err := decodeOutboxPayload(notification)
+		if err != nil {
+			// Decoding is not going to succeed on a retry
+			r.recordFailure(notification, err, true)
+			continue
+		}
+		if err = r.write(notification, payload); err != nil {
+			r.log.WithError(err).Warnf("failed to relay %s notification %d with key %s", notification.Type, notification.ID, notification.Key)
+			deadLettered := r.config.MaxAttempts > 0 && notification.Attempts+1 >= r.config.MaxAttempts
+			r.recordFailure(notification, err, deadLettered)
+			// Notifications without a key aren't ordered, so they don't hold back each other
+			if !deadLettered && notification.Key != "" {
+				blockedKeys[notification.Key] = true
+			}
+			continue
+		}
+		relayed = append(relayed, notification.ID)
+	}
+	if len(relayed) > 0 {
+		if err := r.db.Where("id IN (?)", relayed).Delete(&common.OutboxNotification{}).Error; err != nil {
+			return 0, errors.Wrap(err, "failed to delete relayed notifications from the outbox")
+		}
+	}
+	return len(relayed), nil
+}
+
+func (r *OutboxRelay) write(notification *common.OutboxNotification, payload interface{}) error {
+	envelope := &Envelope{
+		Name:     notification.Type,
+		Payload:  payload,
+		Metadata: r.metadata,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), r.config.WriteTimeout)
+	defer cancel()
+	return r.writer.Write(ctx, []byte(notification.Key), envelope)
+}
+
+func (r *OutboxRelay) recordFailure(notification *common.OutboxNotification, cause error, deadLettered bool) {
+	lastError := cause.Error()
+	if len(lastError) > maxOutboxErrorLength {
+		lastError = lastError[:maxOutboxErrorLength]
+	}
+	if deadLettered {
+		r.log.WithError(cause).Errorf("dead-lettering %s notification %d with key %s after %d attempts",
+			notification.Type, notification.ID, notification.Key, notification.Attempts+1)
+	}
+	err := r.db.Model(&common.OutboxNotification{}).Where("id = ?", notification.ID).
+		Updates(map[string]interface{}{
+			"attempts":      notification.Attempts + 1,
+			"last_error":    lastError,
+			"dead_lettered": deadLettered,
+		}).Error
+	if err != nil {
+		r.log.WithError(err).Warnf("failed to record the failure to relay notification %d", notification.ID)
+	}
+}
+
+// decodeOutboxPayload restores the payload of the notification to the type it had when it was notified, since
+// some writers act according to the payload type
+func decodeOutboxPayload(notification *common.OutboxNotification) (interface{}, error) {
+	var payload interface{}
+	switch notification.Type {
+	case common.NotificationTypeCluster:
+		payload = &models.Cluster{}
+	case common.NotificationTypeHost:
+		payload = &models.Host{}
+	case common.NotificationTypeInfraEnv:
+		payload = &models.InfraEnv{}
+	case common.NotificationTypeEvent:
+		payload = &models.Event{}
+	default:
+		return json.RawMessage(notification.Payload), nil
+	}
+	if err := json.Unmarshal([]byte(notification.Payload), payload); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode %s notification %d", notification.Type, notification.ID)
+	}
+	return payload, nil
+}
@@ -0,0 +1,288 @@
+package stream_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("NotifyInTransaction", func() {
+	var (
+		ctx    = context.Background()
+		ctrl   *gomock.Controller
+		writer *stream.MockStreamWriter
+		logger *logrus.Logger
+	)
+
+	BeforeEach(func() {
+		logger = logrus.New()
+		logger.Out = io.Discard
+		ctrl = gomock.NewController(GinkgoT())
+		writer = stream.NewMockStreamWriter(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("notifies once the changes are persisted when the outbox is disabled", func() {
+		clusterID := strfmt.UUID(uuid.New().String())
+		notificationStream := stream.NewNotificationStream(writer, logger, nil)
+		written := false
+		writer.EXPECT().Write(ctx, []byte(clusterID.String()), gomock.Any()).Do(
+			func(context.Context, []byte, interface{}) { written = true }).Return(nil).Times(1)
+
+		err := notificationStream.NotifyInTransaction(ctx, nil, func(_ *gorm.DB, notify stream.NotifyFunc) error {
+			Expect(notify(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}})).To(Succeed())
+			Expect(written).To(BeFalse())
+			return nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(written).To(BeTrue())
+	})
+
+	It("does not notify when the changes fail", func() {
+		clusterID := strfmt.UUID(uuid.New().String())
+		notificationStream := stream.NewNotificationStream(writer, logger, nil)
+		writer.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := notificationStream.NotifyInTransaction(ctx, nil, func(_ *gorm.DB, notify stream.NotifyFunc) error {
+			Expect(notify(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}})).To(Succeed())
+			return errors.New("update failed")
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("does not fail the changes when the notification fails", func() {
+		clusterID := strfmt.UUID(uuid.New().String())
+		notificationStream := stream.NewNotificationStream(writer, logger, nil)
+		writer.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("broker is down")).Times(1)
+
+		err := notificationStream.NotifyInTransaction(ctx, nil, func(_ *gorm.DB, notify stream.NotifyFunc) error {
+			return notify(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}})
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("logs the notifications that fail", func() {
+		log, hook := test.NewNullLogger()
+		notificationStream := stream.NewNotificationStream(writer, log, nil)
+
+		err := notificationStream.NotifyInTransaction(ctx, nil, func(_ *gorm.DB, notify stream.NotifyFunc) error {
+			var cluster *common.Cluster
+			return notify(cluster)
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hook.LastEntry()).ToNot(BeNil())
+		Expect(hook.LastEntry().Level).To(Equal(logrus.WarnLevel))
+		Expect(hook.LastEntry().Message).To(Equal("failed to notify update event"))
+	})
+})
+
+var _ = Describe("Outbox", func() {
+	var (
+		ctx                context.Context
+		ctrl               *gomock.Controller
+		db                 *gorm.DB
+		dbName             string
+		writer             *stream.MockStreamWriter
+		mockLeader         *leader.MockLeader
+		notificationStream *stream.NotificationStream
+		relay              *stream.OutboxRelay
+		clusterID          strfmt.UUID
+		otherClusterID     strfmt.UUID
+		config             stream.OutboxConfig
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		writer = stream.NewMockStreamWriter(ctrl)
+		mockLeader = leader.NewMockLeader(ctrl)
+		logger := logrus.New()
+		logger.Out = io.Discard
+		config = stream.OutboxConfig{EnableOutbox: true, RelayInterval: time.Second, BatchSize: 10, WriteTimeout: time.Second, MaxAttempts: 2}
+		notificationStream = stream.NewOutboxNotificationStream(db, writer, logger, "metadata")
+		relay = notificationStream.NewOutboxRelay(mockLeader, config, logger)
+		clusterID = strfmt.UUID(uuid.New().String())
+		otherClusterID = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	outboxSize := func() int64 {
+		var count int64
+		ExpectWithOffset(1, db.Model(&common.OutboxNotification{}).Count(&count).Error).ToNot(HaveOccurred())
+		return count
+	}
+
+	updateClusterStatus := func(id strfmt.UUID, status string) error {
+		return notificationStream.NotifyInTransaction(ctx, db, func(tx *gorm.DB, notify stream.NotifyFunc) error {
+			cluster := &common.Cluster{Cluster: models.Cluster{ID: &id, Status: swag.String(status)}}
+			if err := tx.Save(cluster).Error; err != nil {
+				return err
+			}
+			return notify(cluster)
+		})
+	}
+
+	It("writes the notification in the transaction of the change", func() {
+		Expect(updateClusterStatus(clusterID, models.ClusterStatusInstalling)).To(Succeed())
+		Expect(outboxSize()).To(BeEquivalentTo(1))
+
+		err := notificationStream.NotifyInTransaction(ctx, db, func(tx *gorm.DB, notify stream.NotifyFunc) error {
+			Expect(notify(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}})).To(Succeed())
+			return errors.New("update failed")
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(outboxSize()).To(BeEquivalentTo(1))
+	})
+
+	It("relays the notifications with their original payload type", func() {
+		Expect(updateClusterStatus(clusterID, models.ClusterStatusInstalled)).To(Succeed())
+		hostID := strfmt.UUID(uuid.New().String())
+		Expect(notificationStream.Notify(ctx, &common.Host{Host: models.Host{ID: &hostID, ClusterID: &clusterID}})).To(Succeed())
+
+		mockLeader.EXPECT().IsLeader().Return(true).AnyTimes()
+		gomock.InOrder(
+			writer.EXPECT().Write(gomock.Any(), []byte(clusterID.String()), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ []byte, value interface{}) error {
+					envelope := value.(*stream.Envelope)
+					Expect(envelope.Name).To(Equal(common.NotificationTypeCluster))
+					Expect(envelope.Metadata).To(Equal("metadata"))
+					Expect(swag.StringValue(envelope.Payload.(*models.Cluster).Status)).To(Equal(models.ClusterStatusInstalled))
+					return nil
+				}),
+			writer.EXPECT().Write(gomock.Any(), []byte(clusterID.String()), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ []byte, value interface{}) error {
+					Expect(*value.(*stream.Envelope).Payload.(*models.Host).ID).To(Equal(hostID))
+					return nil
+				}),
+		)
+		relay.Relay()
+		Expect(outboxSize()).To(BeZero())
+	})
+
+	It("does not relay on replicas that are not the leader", func() {
+		Expect(updateClusterStatus(clusterID, models.ClusterStatusInstalled)).To(Succeed())
+		mockLeader.EXPECT().IsLeader().Return(false).AnyTimes()
+		writer.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		relay.Relay()
+		Expect(outboxSize()).To(BeEquivalentTo(1))
+	})
+
+	It("holds back the notifications of a key until the previous ones are written", func() {
+		Expect(updateClusterStatus(clusterID, models.ClusterStatusInstalling)).To(Succeed())
+		Expect(updateClusterStatus(otherClusterID, models.ClusterStatusInstalling)).To(Succeed())
+		Expect(updateClusterStatus(clusterID, models.ClusterStatusInstalled)).To(Succeed())
+		mockLeader.EXPECT().IsLeader().Return(true).AnyTimes()
+
+		writer.EXPECT().Write(gomock.Any(), []byte(clusterID.String()), gomock.Any()).Return(errors.New("broker is down")).Times(1)
+		writer.EXPECT().Write(gomock.Any(), []byte(otherClusterID.String()), gomock.Any()).Return(nil).Times(1)
+		relay.Relay()
+		var pending []*common.OutboxNotification
+		Expect(db.Order("id").Find(&pending).Error).ToNot(HaveOccurred())
+		Expect(pending).To(HaveLen(2))
+		Expect(pending[0].Attempts).To(Equal(1))
+		Expect(pending[0].LastError).To(ContainSubstring("broker is down"))
+
+		var statuses []string
+		writer.EXPECT().Write(gomock.Any(), []byte(clusterID.String()), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ []byte, value interface{}) error {
+				statuses = append(statuses, swag.StringValue(value.(*stream.Envelope).Payload.(*models.Cluster).Status))
+				return nil
+			}).Times(2)
+		relay.Relay()
+		Expect(statuses).To(Equal([]string{models.ClusterStatusInstalling, models.ClusterStatusInstalled}))
+		Expect(outboxSize()).To(BeZero())
+	})
+
+	deadLettered := func() []*common.OutboxNotification {
+		var ret []*common.OutboxNotification
+		ExpectWithOffset(1, db.Where("dead_lettered = ?", true).Order("id").Find(&ret).Error).ToNot(HaveOccurred())
+		return ret
+	}
+
+	It("dead-letters a notification that fails the maximum number of attempts", func() {
+		Expect(updateClusterStatus(clusterID, models.ClusterStatusInstalling)).To(Succeed())
+		Expect(updateClusterStatus(clusterID, models.ClusterStatusInstalled)).To(Succeed())
+		mockLeader.EXPECT().IsLeader().Return(true).AnyTimes()
+
+		gomock.InOrder(
+			writer.EXPECT().Write(gomock.Any(), []byte(clusterID.String()), gomock.Any()).Return(errors.New("rejected")).Times(1),
+			writer.EXPECT().Write(gomock.Any(), []byte(clusterID.String()), gomock.Any()).Return(errors.New("rejected")).Times(1),
+			writer.EXPECT().Write(gomock.Any(), []byte(clusterID.String()), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ []byte, value interface{}) error {
+					Expect(swag.StringValue(value.(*stream.Envelope).Payload.(*models.Cluster).Status)).To(Equal(models.ClusterStatusInstalled))
+					return nil
+				}).Times(1),
+		)
+		relay.Relay()
+		Expect(deadLettered()).To(BeEmpty())
+		relay.Relay()
+
+		notifications := deadLettered()
+		Expect(notifications).To(HaveLen(1))
+		Expect(notifications[0].Attempts).To(Equal(2))
+		Expect(notifications[0].LastError).To(ContainSubstring("rejected"))
+		Expect(outboxSize()).To(BeEquivalentTo(1))
+
+		// Dead-lettered notifications are not retried
+		relay.Relay()
+	})
+
+	It("dead-letters a notification that can't be decoded without writing it", func() {
+		Expect(db.Create(&common.OutboxNotification{Key: clusterID.String(), Type: common.NotificationTypeCluster, Payload: "not json"}).Error).ToNot(HaveOccurred())
+		Expect(updateClusterStatus(clusterID, models.ClusterStatusInstalled)).To(Succeed())
+		mockLeader.EXPECT().IsLeader().Return(true).AnyTimes()
+
+		writer.EXPECT().Write(gomock.Any(), []byte(clusterID.String()), gomock.Any()).Return(nil).Times(1)
+		relay.Relay()
+
+		notifications := deadLettered()
+		Expect(notifications).To(HaveLen(1))
+		Expect(notifications[0].Attempts).To(Equal(1))
+		Expect(notifications[0].LastError).To(ContainSubstring("failed to decode"))
+		Expect(outboxSize()).To(BeEquivalentTo(1))
+	})
+
+	It("doesn't hold back notifications without a key", func() {
+		Expect(db.Create(&common.OutboxNotification{Type: "custom", Payload: `"first"`}).Error).ToNot(HaveOccurred())
+		Expect(db.Create(&common.OutboxNotification{Type: "custom", Payload: `"second"`}).Error).ToNot(HaveOccurred())
+		mockLeader.EXPECT().IsLeader().Return(true).AnyTimes()
+
+		var written []string
+		writer.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ []byte, value interface{}) error {
+				payload := string(value.(*stream.Envelope).Payload.(json.RawMessage))
+				if payload == `"first"` {
+					return errors.New("rejected")
+				}
+				written = append(written, payload)
+				return nil
+			}).Times(2)
+		relay.Relay()
+		Expect(written).To(Equal([]string{`"second"`}))
+		Expect(outboxSize()).To(BeEquivalentTo(1))
+	})
+})
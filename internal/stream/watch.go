@@ -0,0 +1,367 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=watch.go -package=stream -destination=mock_watch.go
+
+const (
+	// watchPollLimit is the maximal number of notifications fetched from the DB on a single poll
+	watchPollLimit = 1000
+	// watchGapTimeout is how long an offset that was skipped while polling is waited for. Offsets are
+	// allocated before the inserting transaction commits, so a lower offset may become visible after
+	// a higher one. Offsets of rolled back inserts never show up.
+	watchGapTimeout = 10 * time.Second
+	// watchMaxGaps bounds the number of skipped offsets that are tracked at once
+	watchMaxGaps = 1000
+	// watchCleanupInterval is how often notifications older than the retention are deleted
+	watchCleanupInterval = time.Minute
+)
+
+type WatchConfig struct {
+	EnableWatchAPI    bool          `envconfig:"ENABLE_WATCH_API" default:"false"`
+	PollInterval      time.Duration `envconfig:"WATCH_POLL_INTERVAL" default:"1s"`
+	Retention         time.Duration `envconfig:"WATCH_RETENTION" default:"1h"`
+	HeartbeatInterval time.Duration `envconfig:"WATCH_HEARTBEAT_INTERVAL" default:"15s"`
+	SubscriberBuffer  int           `envconfig:"WATCH_SUBSCRIBER_BUFFER" default:"1024"`
+}
+
+// WatchFilter selects the notifications delivered to a watch subscription. Unset fields match all notifications.
+type WatchFilter struct {
+	ClusterID  *strfmt.UUID
+	InfraEnvID *strfmt.UUID
+	Types      []string
+}
+
+func (f *WatchFilter) matches(n *common.WatchNotification) bool {
+	if f.ClusterID != nil && (n.ClusterID == nil || *n.ClusterID != *f.ClusterID) {
+		return false
+	}
+	if f.InfraEnvID != nil && (n.InfraEnvID == nil || *n.InfraEnvID != *f.InfraEnvID) {
+		return false
+	}
+	return len(f.Types) == 0 || funk.ContainsString(f.Types, n.Type)
+}
+
+func (f *WatchFilter) apply(db *gorm.DB) *gorm.DB {
+	if f.ClusterID != nil {
+		db = db.Where("cluster_id = ?", f.ClusterID.String())
+	}
+	if f.InfraEnvID != nil {
+		db = db.Where("infra_env_id = ?", f.InfraEnvID.String())
+	}
+	if len(f.Types) > 0 {
+		db = db.Where("type IN (?)", f.Types)
+	}
+	return db
+}
+
+// WatchMessage is a notification delivered to a watch subscription
+type WatchMessage struct {
+	// Offset of the notification, to be used in order to resume a subscription
+	Offset int64
+	// Type of the notification, e.g. ClusterState
+	Type string
+	// JSON encoded Envelope, as written to the other stream writers
+	Data []byte
+}
+
+func toWatchMessage(n *common.WatchNotification) *WatchMessage {
+	return &WatchMessage{
+		Offset: n.ID,
+		Type:   n.Type,
+		Data:   []byte(n.Envelope),
+	}
+}
+
+type Watcher interface {
+	// Subscribe starts delivering the notifications that match the filter. If since is set, the notifications
+	// that were written after the one with the given offset are delivered first.
+	Subscribe(ctx context.Context, filter WatchFilter, since *int64) (WatchSubscription, error)
+}
+
+type WatchSubscription interface {
+	// Messages returns the channel notifications are delivered on. The channel is closed when the subscription
+	// is closed, or when the subscriber does not keep up with the notifications rate, in which case it is
+	// expected to subscribe again from the last offset it received.
+	Messages() <-chan *WatchMessage
+	Close()
+}
+
+// WatchWriter persists notifications so that they can be served by the watch API
+type WatchWriter struct {
+	db *gorm.DB
+}
+
+func NewWatchWriter(db *gorm.DB) *WatchWriter {
+	return &WatchWriter{db: db}
+}
+
+func (w *WatchWriter) Write(ctx context.Context, key []byte, value interface{}) error {
+	envelope, ok := value.(*Envelope)
+	if !ok {
+		return errors.Errorf("unexpected notification value of type %T", value)
+	}
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+	notification := &common.WatchNotification{
+		Type:     envelope.Name,
+		Envelope: string(data),
+	}
+	switch payload := envelope.Payload.(type) {
+	case *models.Cluster:
+		notification.ClusterID = payload.ID
+	case *models.Host:
+		notification.ClusterID = payload.ClusterID
+		notification.InfraEnvID = &payload.InfraEnvID
+		notification.HostID = payload.ID
+	case *models.InfraEnv:
+		notification.InfraEnvID = payload.ID
+		if payload.ClusterID != "" {
+			notification.ClusterID = &payload.ClusterID
+		}
+	case *models.Event:
+		notification.ClusterID = payload.ClusterID
+		notification.InfraEnvID = payload.InfraEnvID
+		notification.HostID = payload.HostID
+	default:
+		if len(key) > 0 {
+			clusterID := strfmt.UUID(key)
+			notification.ClusterID = &clusterID
+		}
+	}
+	return errors.Wrapf(w.db.Create(notification).Error, "failed to persist %s notification", envelope.Name)
+}
+
+func (w *WatchWriter) Close() {
+}
+
+// WatchFeed tails the persisted notifications and fans them out to the watch subscriptions of this replica.
+// Poll is expected to be invoked periodically.
+type WatchFeed struct {
+	db          *gorm.DB
+	log         logrus.FieldLogger
+	config      WatchConfig
+	mutex       sync.Mutex
+	subscribers map[*watchSubscription]struct{}
+	initialized bool
+	cursor      int64
+	gaps        map[int64]time.Time
+	lastCleanup time.Time
+}
+
+func NewWatchFeed(db *gorm.DB, log logrus.FieldLogger, config WatchConfig) *WatchFeed {
+	return &WatchFeed{
+		db:          db,
+		log:         log,
+		config:      config,
+		subscribers: make(map[*watchSubscription]struct{}),
+		gaps:        make(map[int64]time.Time),
+	}
+}
+
+func (f *WatchFeed) Subscribe(ctx context.Context, filter WatchFilter, since *int64) (WatchSubscription, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if !f.initialized {
+		var cursor int64
+		if err := f.db.Model(&common.WatchNotification{}).Select("COALESCE(MAX(id), 0)").Scan(&cursor).Error; err != nil {
+			return nil, errors.Wrap(err, "failed to get the latest notification offset")
+		}
+		f.cursor = cursor
+		f.gaps = make(map[int64]time.Time)
+		f.initialized = true
+	}
+	sub := &watchSubscription{
+		feed:   f,
+		filter: filter,
+		live:   make(chan *WatchMessage, f.config.SubscriberBuffer),
+		out:    make(chan *WatchMessage),
+		done:   make(chan struct{}),
+	}
+	f.subscribers[sub] = struct{}{}
+	go sub.run(since, f.cursor)
+	return sub, nil
+}
+
+func (f *WatchFeed) unsubscribe(sub *watchSubscription) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.removeSubscriber(sub)
+}
+
+// removeSubscriber must be called with the mutex held
+func (f *WatchFeed) removeSubscriber(sub *watchSubscription) {
+	if _, ok := f.subscribers[sub]; !ok {
+		return
+	}
+	delete(f.subscribers, sub)
+	close(sub.live)
+	if len(f.subscribers) == 0 {
+		// Nobody tails the feed anymore, the next subscription determines the offset to tail from
+		f.initialized = false
+	}
+}
+
+// Poll fetches the notifications that were written since the previous poll and delivers them to the subscriptions
+func (f *WatchFeed) Poll() {
+	f.deleteExpired()
+
+	f.mutex.Lock()
+	if !f.initialized {
+		f.mutex.Unlock()
+		return
+	}
+	cursor := f.cursor
+	gapIDs := make([]int64, 0, len(f.gaps))
+	for id := range f.gaps {
+		gapIDs = append(gapIDs, id)
+	}
+	f.mutex.Unlock()
+
+	tx := f.db.Where("id > ?", cursor)
+	if len(gapIDs) > 0 {
+		tx = tx.Or("id IN (?)", gapIDs)
+	}
+	var notifications []*common.WatchNotification
+	if err := tx.Order("id").Limit(watchPollLimit).Find(&notifications).Error; err != nil {
+		f.log.WithError(err).Warn("failed to poll watch notifications")
+		return
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if !f.initialized || f.cursor != cursor {
+		// All subscriptions were closed while polling
+		return
+	}
+	f.advance(notifications)
+	for sub := range f.subscribers {
+		for _, n := range notifications {
+			if !sub.filter.matches(n) {
+				continue
+			}
+			select {
+			case sub.live <- toWatchMessage(n):
+			default:
+				f.log.Warnf("watch subscriber does not keep up with notifications, closing its subscription at offset %d", n.ID)
+				f.removeSubscriber(sub)
+			}
+			if _, ok := f.subscribers[sub]; !ok {
+				break
+			}
+		}
+	}
+}
+
+// advance moves the cursor past the given notifications and tracks the offsets that were skipped. It must be
+// called with the mutex held.
+func (f *WatchFeed) advance(notifications []*common.WatchNotification) {
+	now := time.Now()
+	for id, since := range f.gaps {
+		if now.Sub(since) > watchGapTimeout {
+			delete(f.gaps, id)
+		}
+	}
+	for _, n := range notifications {
+		if n.ID <= f.cursor {
+			delete(f.gaps, n.ID)
+			continue
+		}
+		for id := f.cursor + 1; id < n.ID && len(f.gaps) < watchMaxGaps; id++ {
+			f.gaps[id] = now
+		}
+		f.cursor = n.ID
+	}
+}
+
+func (f *WatchFeed) deleteExpired() {
+	if time.Since(f.lastCleanup) < watchCleanupInterval {
+		return
+	}
+	f.lastCleanup = time.Now()
+	err := f.db.Where("created_at < ?", time.Now().Add(-f.config.Retention)).Delete(&common.WatchNotification{}).Error
+	if err != nil {
+		f.log.WithError(err).Warn("failed to delete expired watch notifications")
+	}
+}
+
+// backlog returns the notifications matching the filter with offsets in the range (after, upTo]
+func (f *WatchFeed) backlog(filter WatchFilter, after, upTo int64) ([]*common.WatchNotification, error) {
+	var notifications []*common.WatchNotification
+	err := filter.apply(f.db.Where("id > ? AND id <= ?", after, upTo)).Order("id").Limit(watchPollLimit).Find(&notifications).Error
+	return notifications, err
+}
+
+type watchSubscription struct {
+	feed      *WatchFeed
+	filter    WatchFilter
+	live      chan *WatchMessage
+	out       chan *WatchMessage
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func (s *watchSubscription) Messages() <-chan *WatchMessage {
+	return s.out
+}
+
+func (s *watchSubscription) Close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+		s.feed.unsubscribe(s)
+	})
+}
+
+func (s *watchSubscription) send(msg *WatchMessage) bool {
+	select {
+	case s.out <- msg:
+		return true
+	case <-s.done:
+		return false
+	}
+}
+
+// run delivers the backlog requested by the subscriber, followed by the live notifications
+func (s *watchSubscription) run(since *int64, upTo int64) {
+	defer close(s.out)
+	if since != nil {
+		after := *since
+		for after < upTo {
+			notifications, err := s.feed.backlog(s.filter, after, upTo)
+			if err != nil {
+				s.feed.log.WithError(err).Warnf("failed to get watch notifications backlog after offset %d", after)
+				s.feed.unsubscribe(s)
+				return
+			}
+			if len(notifications) == 0 {
+				break
+			}
+			for _, n := range notifications {
+				if !s.send(toWatchMessage(n)) {
+					return
+				}
+				after = n.ID
+			}
+		}
+	}
+	for msg := range s.live {
+		if !s.send(msg) {
+			return
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package stream_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("MultiWriter", func() {
+	var (
+		ctrl    *gomock.Controller
+		first   *stream.MockStreamWriter
+		second  *stream.MockStreamWriter
+		writer  *stream.MultiWriter
+		ctx     context.Context
+		key     []byte
+		payload *stream.Envelope
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		first = stream.NewMockStreamWriter(ctrl)
+		second = stream.NewMockStreamWriter(ctrl)
+		writer = stream.NewMultiWriter(first, second)
+		ctx = context.Background()
+		key = []byte(uuid.New().String())
+		payload = &stream.Envelope{Name: common.NotificationTypeCluster}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("writes to all the writers", func() {
+		first.EXPECT().Write(ctx, key, payload).Return(nil).Times(1)
+		second.EXPECT().Write(ctx, key, payload).Return(nil).Times(1)
+		Expect(writer.Write(ctx, key, payload)).To(Succeed())
+	})
+
+	It("writes to all the writers even if one fails", func() {
+		first.EXPECT().Write(ctx, key, payload).Return(errors.New("first failed")).Times(1)
+		second.EXPECT().Write(ctx, key, payload).Return(nil).Times(1)
+		err := writer.Write(ctx, key, payload)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("first failed"))
+	})
+
+	It("closes all the writers", func() {
+		first.EXPECT().Close().Times(1)
+		second.EXPECT().Close().Times(1)
+		writer.Close()
+	})
+})
+
+var _ = Describe("Watch", func() {
+	var (
+		db         *gorm.DB
+		dbName     string
+		ctx        context.Context
+		writer     *stream.WatchWriter
+		feed       *stream.WatchFeed
+		clusterID  strfmt.UUID
+		infraEnvID strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctx = context.Background()
+		logger := logrus.New()
+		logger.Out = io.Discard
+		writer = stream.NewWatchWriter(db)
+		feed = stream.NewWatchFeed(db, logger, stream.WatchConfig{Retention: time.Hour, SubscriberBuffer: 10})
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	writeCluster := func(id strfmt.UUID) {
+		envelope := &stream.Envelope{Name: common.NotificationTypeCluster, Payload: &models.Cluster{ID: &id}}
+		Expect(writer.Write(ctx, []byte(id.String()), envelope)).To(Succeed())
+	}
+
+	writeHost := func() {
+		hostID := strfmt.UUID(uuid.New().String())
+		envelope := &stream.Envelope{
+			Name:    common.NotificationTypeHost,
+			Payload: &models.Host{ID: &hostID, ClusterID: &clusterID, InfraEnvID: infraEnvID},
+		}
+		Expect(writer.Write(ctx, []byte(clusterID.String()), envelope)).To(Succeed())
+	}
+
+	receive := func(sub stream.WatchSubscription) *stream.WatchMessage {
+		var msg *stream.WatchMessage
+		Eventually(sub.Messages()).Should(Receive(&msg))
+		return msg
+	}
+
+	Context("WatchWriter", func() {
+		It("persists the notification with the IDs of its payload", func() {
+			writeHost()
+			var notifications []*common.WatchNotification
+			Expect(db.Find(&notifications).Error).ToNot(HaveOccurred())
+			Expect(notifications).To(HaveLen(1))
+			Expect(notifications[0].Type).To(Equal(common.NotificationTypeHost))
+			Expect(*notifications[0].ClusterID).To(Equal(clusterID))
+			Expect(*notifications[0].InfraEnvID).To(Equal(infraEnvID))
+			Expect(notifications[0].HostID).ToNot(BeNil())
+
+			var envelope map[string]interface{}
+			Expect(json.Unmarshal([]byte(notifications[0].Envelope), &envelope)).To(Succeed())
+			Expect(envelope["Name"]).To(Equal(common.NotificationTypeHost))
+		})
+
+		It("fails on values that are not envelopes", func() {
+			Expect(writer.Write(ctx, nil, "foo")).ToNot(Succeed())
+		})
+	})
+
+	Context("WatchFeed", func() {
+		It("delivers the notifications written after subscribing", func() {
+			writeCluster(clusterID)
+			sub, err := feed.Subscribe(ctx, stream.WatchFilter{}, nil)
+			Expect(err).ToNot(HaveOccurred())
+			defer sub.Close()
+
+			writeHost()
+			feed.Poll()
+			msg := receive(sub)
+			Expect(msg.Type).To(Equal(common.NotificationTypeHost))
+			Consistently(sub.Messages()).ShouldNot(Receive())
+		})
+
+		It("delivers only the notifications matching the filter", func() {
+			sub, err := feed.Subscribe(ctx, stream.WatchFilter{ClusterID: &clusterID, Types: []string{common.NotificationTypeCluster}}, nil)
+			Expect(err).ToNot(HaveOccurred())
+			defer sub.Close()
+
+			writeCluster(strfmt.UUID(uuid.New().String()))
+			writeHost()
+			writeCluster(clusterID)
+			feed.Poll()
+			msg := receive(sub)
+			Expect(msg.Type).To(Equal(common.NotificationTypeCluster))
+			Expect(string(msg.Data)).To(ContainSubstring(clusterID.String()))
+			Consistently(sub.Messages()).ShouldNot(Receive())
+		})
+
+		It("delivers the backlog since the given offset followed by the live notifications", func() {
+			writeCluster(clusterID)
+			first, err := feed.Subscribe(ctx, stream.WatchFilter{}, nil)
+			Expect(err).ToNot(HaveOccurred())
+			defer first.Close()
+			writeHost()
+			writeCluster(clusterID)
+			feed.Poll()
+			offset := receive(first).Offset
+
+			resumed, err := feed.Subscribe(ctx, stream.WatchFilter{}, swag.Int64(offset))
+			Expect(err).ToNot(HaveOccurred())
+			defer resumed.Close()
+			writeHost()
+			feed.Poll()
+
+			backlog := receive(resumed)
+			Expect(backlog.Type).To(Equal(common.NotificationTypeCluster))
+			Expect(backlog.Offset).To(BeNumerically(">", offset))
+			live := receive(resumed)
+			Expect(live.Type).To(Equal(common.NotificationTypeHost))
+			Expect(live.Offset).To(BeNumerically(">", backlog.Offset))
+		})
+
+		It("closes the subscription of a subscriber that does not keep up", func() {
+			feed = stream.NewWatchFeed(db, logrus.New(), stream.WatchConfig{Retention: time.Hour, SubscriberBuffer: 1})
+			sub, err := feed.Subscribe(ctx, stream.WatchFilter{}, nil)
+			Expect(err).ToNot(HaveOccurred())
+			defer sub.Close()
+
+			for i := 0; i < 5; i++ {
+				writeCluster(clusterID)
+			}
+			feed.Poll()
+			received := 0
+			for range sub.Messages() {
+				received++
+			}
+			Expect(received).To(BeNumerically("<", 5))
+		})
+
+		It("delivers nothing once closed", func() {
+			sub, err := feed.Subscribe(ctx, stream.WatchFilter{}, nil)
+			Expect(err).ToNot(HaveOccurred())
+			sub.Close()
+			writeCluster(clusterID)
+			feed.Poll()
+			Eventually(sub.Messages()).Should(BeClosed())
+		})
+	})
+})
@@ -0,0 +1,242 @@
+package stream
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kelseyhightower/envconfig"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	WebhookSignatureHeader = "X-Assisted-Signature"
+	WebhookTimestampHeader = "X-Assisted-Timestamp"
+	WebhookDeliveryHeader  = "X-Assisted-Delivery"
+	WebhookEventHeader     = "X-Assisted-Event"
+	WebhookKeyHeader       = "X-Assisted-Key"
+)
+
+type WebhookConfig struct {
+	URL string `envconfig:"WEBHOOK_URL" required:"true"`
+	// Secret used to sign the requests, requests are not signed if it is empty
+	Secret          string        `envconfig:"WEBHOOK_SECRET" default:""`
+	CACertFile      string        `envconfig:"WEBHOOK_CA_CERT_FILE" default:""`
+	Timeout         time.Duration `envconfig:"WEBHOOK_TIMEOUT" default:"10s"`
+	MaxRetries      int           `envconfig:"WEBHOOK_MAX_RETRIES" default:"5"`
+	RetryBackoff    time.Duration `envconfig:"WEBHOOK_RETRY_BACKOFF" default:"1s"`
+	MaxRetryBackoff time.Duration `envconfig:"WEBHOOK_MAX_RETRY_BACKOFF" default:"1m"`
+	QueueSize       int           `envconfig:"WEBHOOK_QUEUE_SIZE" default:"1000"`
+}
+
+type webhookDelivery struct {
+	id    string
+	event string
+	key   []byte
+	body  []byte
+}
+
+// WebhookWriter posts the notifications to an HTTP endpoint. Notifications are queued and delivered in order
+// by a single worker, which retries failed deliveries with exponential backoff. Each request carries the
+// headers:
+//
+//	X-Assisted-Delivery: unique ID of the notification, identical for all the attempts to deliver it
+//	X-Assisted-Event: name of the notification, e.g. HostState
+//	X-Assisted-Key: key of the notification, usually the cluster ID
+//	X-Assisted-Timestamp: unix time at which the request was sent
+//	X-Assisted-Signature: sha256=<hex encoded HMAC-SHA256 of "<timestamp>.<body>" keyed with the secret>
+type WebhookWriter struct {
+	config    WebhookConfig
+	client    *http.Client
+	log       logrus.FieldLogger
+	reporter  *DeliveryReporter
+	queue     chan *webhookDelivery
+	mutex     sync.RWMutex
+	closed    bool
+	ctx       context.Context
+	cancel    context.CancelFunc
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newWebhookWriter(log logrus.FieldLogger, reporter *DeliveryReporter) (StreamWriter, error) {
+	config := WebhookConfig{}
+	if err := envconfig.Process("", &config); err != nil {
+		return nil, err
+	}
+	client, err := newWebhookClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return NewWebhookWriter(config, client, log, reporter), nil
+}
+
+func newWebhookClient(config WebhookConfig) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.CACertFile != "" {
+		caCert, err := os.ReadFile(config.CACertFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read webhook CA certificate %s", config.CACertFile)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, errors.Errorf("failed to parse webhook CA certificate %s", config.CACertFile)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+	return &http.Client{Transport: transport, Timeout: config.Timeout}, nil
+}
+
+func NewWebhookWriter(config WebhookConfig, client *http.Client, log logrus.FieldLogger, reporter *DeliveryReporter) *WebhookWriter {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &WebhookWriter{
+		config:   config,
+		client:   client,
+		log:      log,
+		reporter: reporter,
+		queue:    make(chan *webhookDelivery, config.QueueSize),
+		ctx:      ctx,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
+
+// Write queues the notification for delivery. It fails only if the notification cannot be encoded or if the
+// queue is full, which happens when the endpoint is unavailable for a long period of time.
+func (w *WebhookWriter) Write(ctx context.Context, key []byte, value interface{}) error {
+	body, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	delivery := &webhookDelivery{
+		id:   uuid.New().String(),
+		key:  key,
+		body: body,
+	}
+	if envelope, ok := value.(*Envelope); ok {
+		delivery.event = envelope.Name
+	}
+
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+	if w.closed {
+		return errors.New("webhook writer is closed")
+	}
+	select {
+	case w.queue <- delivery:
+		return nil
+	default:
+		w.reporter.Report("webhook", false, 0)
+		return errors.Errorf("webhook queue is full, dropping %s notification", delivery.event)
+	}
+}
+
+// Close stops accepting notifications and waits for the queued ones to be delivered, giving up once the
+// request timeout elapses
+func (w *WebhookWriter) Close() {
+	w.closeOnce.Do(func() {
+		w.mutex.Lock()
+		w.closed = true
+		close(w.queue)
+		w.mutex.Unlock()
+		select {
+		case <-w.done:
+		case <-time.After(w.config.Timeout):
+			w.log.Warnf("giving up on delivering %d queued webhook notifications", len(w.queue))
+			w.cancel()
+			<-w.done
+		}
+		w.cancel()
+	})
+}
+
+func (w *WebhookWriter) run() {
+	defer close(w.done)
+	for delivery := range w.queue {
+		if w.ctx.Err() != nil {
+			continue
+		}
+		start := time.Now()
+		err := w.deliver(delivery)
+		w.reporter.Report("webhook", err == nil, time.Since(start))
+		if err != nil {
+			w.log.WithError(err).Warnf("failed to deliver %s notification %s", delivery.event, delivery.id)
+		}
+	}
+}
+
+func (w *WebhookWriter) deliver(delivery *webhookDelivery) error {
+	backoff := w.config.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		retryable, err := w.post(delivery)
+		if err == nil || !retryable || attempt >= w.config.MaxRetries {
+			return err
+		}
+		w.log.WithError(err).Debugf("failed to deliver %s notification %s, retrying in %s", delivery.event, delivery.id, backoff)
+		select {
+		case <-time.After(backoff):
+		case <-w.ctx.Done():
+			return errors.Wrap(err, "webhook writer closed while retrying")
+		}
+		backoff *= 2
+		if backoff > w.config.MaxRetryBackoff {
+			backoff = w.config.MaxRetryBackoff
+		}
+	}
+}
+
+// post sends a single delivery attempt and returns whether a failure is worth retrying
+func (w *WebhookWriter) post(delivery *webhookDelivery) (bool, error) {
+	req, err := http.NewRequestWithContext(w.ctx, http.MethodPost, w.config.URL, bytes.NewReader(delivery.body))
+	if err != nil {
+		return false, err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookDeliveryHeader, delivery.id)
+	req.Header.Set(WebhookEventHeader, delivery.event)
+	req.Header.Set(WebhookKeyHeader, string(delivery.key))
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+	if w.config.Secret != "" {
+		req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(w.config.Secret, timestamp, delivery.body))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	err = errors.Errorf("webhook responded with status %d", resp.StatusCode)
+	return resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests, err
+}
+
+// SignWebhookPayload returns the value of the signature header of a webhook request sent at the given unix time
+func SignWebhookPayload(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%s.", timestamp)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
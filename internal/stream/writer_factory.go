@@ -3,7 +3,9 @@ package stream
 import (
 	"context"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/openshift/assisted-service/pkg/kafka"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
@@ -24,14 +26,73 @@ func (w *DummyWriter) Close() {
 
 }
 
-// if streaming disabled this will return a dummy writer. Otherwise will try to return kafka writer
+// MultiWriter writes every notification to all of its writers
+type MultiWriter struct {
+	writers []StreamWriter
+}
+
+func NewMultiWriter(writers ...StreamWriter) *MultiWriter {
+	return &MultiWriter{writers: writers}
+}
+
+func (w *MultiWriter) Write(ctx context.Context, key []byte, value interface{}) error {
+	var result error
+	for _, writer := range w.writers {
+		if err := writer.Write(ctx, key, value); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+	return result
+}
+
+func (w *MultiWriter) Close() {
+	for _, writer := range w.writers {
+		writer.Close()
+	}
+}
+
+// if streaming disabled this will return a dummy writer. Otherwise will try to return the configured writers
 // and fail if any error is encountered
-func NewWriter(logger *logrus.Logger, enableNotificationStreaming bool) (StreamWriter, error) {
-	writer := &DummyWriter{}
+func NewWriter(logger *logrus.Logger, enableNotificationStreaming bool, writerNames []string, reporter *DeliveryReporter) (StreamWriter, error) {
 	if !enableNotificationStreaming {
 		logger.Info("Initializing event stream dummy writer")
-		return writer, nil
+		return &DummyWriter{}, nil
+	}
+	if len(writerNames) == 0 {
+		return nil, errors.New("event streaming is enabled but no event stream writer is configured")
+	}
+	writers := make([]StreamWriter, 0, len(writerNames))
+	for _, name := range writerNames {
+		factory, ok := lookupWriter(name)
+		if !ok {
+			closeWriters(writers)
+			return nil, errors.Errorf("unknown event stream writer %s, supported writers are %v", name, RegisteredWriters())
+		}
+		logger.Infof("Initializing event stream %s writer", name)
+		writer, err := factory(logger.WithField("writer", name), reporter)
+		if err != nil {
+			closeWriters(writers)
+			return nil, errors.Wrapf(err, "failed to initialize event stream %s writer", name)
+		}
+		writers = append(writers, writer)
+	}
+	if len(writers) == 1 {
+		return writers[0], nil
+	}
+	return NewMultiWriter(writers...), nil
+}
+
+func closeWriters(writers []StreamWriter) {
+	for _, writer := range writers {
+		writer.Close()
+	}
+}
+
+func newKafkaWriter(_ logrus.FieldLogger, reporter *DeliveryReporter) (StreamWriter, error) {
+	writer, err := kafka.NewWriter()
+	if err != nil {
+		return nil, err
 	}
-	logger.Info("Initializing event stream kafka writer")
-	return kafka.NewWriter()
+	// The kafka writer is asynchronous, hence only the enqueueing of the messages is measured
+	return newMeteredWriter("kafka", writer, reporter), nil
 }
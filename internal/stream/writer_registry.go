@@ -0,0 +1,102 @@
+package stream
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/sirupsen/logrus"
+)
+
+// WriterFactory creates a stream writer, reading its configuration from the environment
+type WriterFactory func(log logrus.FieldLogger, reporter *DeliveryReporter) (StreamWriter, error)
+
+var (
+	writerFactoriesMutex sync.RWMutex
+	writerFactories      = map[string]WriterFactory{
+		"kafka":   newKafkaWriter,
+		"webhook": newWebhookWriter,
+		"file":    newFileWriter,
+		"nats":    newNATSWriter,
+	}
+)
+
+// RegisterWriter makes a stream writer selectable by name in the EVENT_STREAM_WRITERS configuration.
+// Registering a writer with the name of an existing one replaces it.
+func RegisterWriter(name string, factory WriterFactory) {
+	writerFactoriesMutex.Lock()
+	defer writerFactoriesMutex.Unlock()
+	writerFactories[name] = factory
+}
+
+func lookupWriter(name string) (WriterFactory, bool) {
+	writerFactoriesMutex.RLock()
+	defer writerFactoriesMutex.RUnlock()
+	factory, ok := writerFactories[name]
+	return factory, ok
+}
+
+// RegisteredWriters returns the sorted names of the registered stream writers
+func RegisteredWriters() []string {
+	writerFactoriesMutex.RLock()
+	defer writerFactoriesMutex.RUnlock()
+	names := make([]string, 0, len(writerFactories))
+	for name := range writerFactories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DeliveryReporter reports the notification deliveries of the stream writers to the metrics manager.
+// The notification stream is created before the metrics manager, which depends on it through the events
+// handler, so the metrics manager is set once it is available and deliveries are not reported until then.
+type DeliveryReporter struct {
+	mutex      sync.RWMutex
+	metricsAPI metrics.API
+}
+
+func NewDeliveryReporter() *DeliveryReporter {
+	return &DeliveryReporter{}
+}
+
+func (r *DeliveryReporter) SetMetrics(metricsAPI metrics.API) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.metricsAPI = metricsAPI
+}
+
+func (r *DeliveryReporter) Report(writer string, success bool, duration time.Duration) {
+	if r == nil {
+		return
+	}
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	if r.metricsAPI != nil {
+		r.metricsAPI.NotificationDelivered(writer, success, duration)
+	}
+}
+
+// meteredWriter reports the deliveries of writers that deliver the notifications synchronously
+type meteredWriter struct {
+	name     string
+	writer   StreamWriter
+	reporter *DeliveryReporter
+}
+
+func newMeteredWriter(name string, writer StreamWriter, reporter *DeliveryReporter) *meteredWriter {
+	return &meteredWriter{name: name, writer: writer, reporter: reporter}
+}
+
+func (w *meteredWriter) Write(ctx context.Context, key []byte, value interface{}) error {
+	start := time.Now()
+	err := w.writer.Write(ctx, key, value)
+	w.reporter.Report(w.name, err == nil, time.Since(start))
+	return err
+}
+
+func (w *meteredWriter) Close() {
+	w.writer.Close()
+}
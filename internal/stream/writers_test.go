@@ -0,0 +1,380 @@
+package stream_test
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nats-io/nats.go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("NewWriter", func() {
+	var logger *logrus.Logger
+
+	BeforeEach(func() {
+		logger = logrus.New()
+		logger.Out = io.Discard
+	})
+
+	It("returns a dummy writer when streaming is disabled", func() {
+		writer, err := stream.NewWriter(logger, false, []string{"kafka"}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(writer).To(BeAssignableToTypeOf(&stream.DummyWriter{}))
+	})
+
+	It("fails on an unknown writer", func() {
+		_, err := stream.NewWriter(logger, true, []string{"carrier-pigeon"}, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unknown event stream writer carrier-pigeon"))
+	})
+
+	It("fails when no writer is configured", func() {
+		_, err := stream.NewWriter(logger, true, nil, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails when the writer configuration is missing", func() {
+		os.Unsetenv("FILE_WRITER_PATH")
+		_, err := stream.NewWriter(logger, true, []string{"file"}, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("creates the registered writers", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		defer ctrl.Finish()
+		first := stream.NewMockStreamWriter(ctrl)
+		second := stream.NewMockStreamWriter(ctrl)
+		stream.RegisterWriter("test-first", func(logrus.FieldLogger, *stream.DeliveryReporter) (stream.StreamWriter, error) {
+			return first, nil
+		})
+		stream.RegisterWriter("test-second", func(logrus.FieldLogger, *stream.DeliveryReporter) (stream.StreamWriter, error) {
+			return second, nil
+		})
+		Expect(stream.RegisteredWriters()).To(ContainElements("file", "kafka", "nats", "webhook", "test-first", "test-second"))
+
+		writer, err := stream.NewWriter(logger, true, []string{"test-first", "test-second"}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		first.EXPECT().Write(gomock.Any(), []byte("key"), "value").Return(nil).Times(1)
+		second.EXPECT().Write(gomock.Any(), []byte("key"), "value").Return(nil).Times(1)
+		Expect(writer.Write(context.Background(), []byte("key"), "value")).To(Succeed())
+	})
+
+	It("closes the writers that were created when a later one fails", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		defer ctrl.Finish()
+		first := stream.NewMockStreamWriter(ctrl)
+		stream.RegisterWriter("test-ok", func(logrus.FieldLogger, *stream.DeliveryReporter) (stream.StreamWriter, error) {
+			return first, nil
+		})
+		stream.RegisterWriter("test-failing", func(logrus.FieldLogger, *stream.DeliveryReporter) (stream.StreamWriter, error) {
+			return nil, errors.New("failed")
+		})
+		first.EXPECT().Close().Times(1)
+		_, err := stream.NewWriter(logger, true, []string{"test-ok", "test-failing"}, nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("DeliveryReporter", func() {
+	It("reports deliveries only once the metrics are set", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		defer ctrl.Finish()
+		metricsAPI := metrics.NewMockAPI(ctrl)
+		reporter := stream.NewDeliveryReporter()
+		reporter.Report("webhook", true, time.Second)
+
+		metricsAPI.EXPECT().NotificationDelivered("webhook", false, time.Second).Times(1)
+		reporter.SetMetrics(metricsAPI)
+		reporter.Report("webhook", false, time.Second)
+	})
+})
+
+var _ = Describe("WebhookWriter", func() {
+	const secret = "top-secret"
+
+	var (
+		server   *httptest.Server
+		handler  http.HandlerFunc
+		config   stream.WebhookConfig
+		writer   *stream.WebhookWriter
+		envelope *stream.Envelope
+		ctrl     *gomock.Controller
+		reporter *stream.DeliveryReporter
+		mockAPI  *metrics.MockAPI
+	)
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handler(w, r)
+		}))
+		config = stream.WebhookConfig{
+			URL:             server.URL,
+			Secret:          secret,
+			Timeout:         5 * time.Second,
+			MaxRetries:      2,
+			RetryBackoff:    10 * time.Millisecond,
+			MaxRetryBackoff: 20 * time.Millisecond,
+			QueueSize:       10,
+		}
+		envelope = &stream.Envelope{Name: common.NotificationTypeCluster, Payload: map[string]string{"foo": "bar"}}
+		ctrl = gomock.NewController(GinkgoT())
+		mockAPI = metrics.NewMockAPI(ctrl)
+		reporter = stream.NewDeliveryReporter()
+		reporter.SetMetrics(mockAPI)
+	})
+
+	AfterEach(func() {
+		writer.Close()
+		server.Close()
+		ctrl.Finish()
+	})
+
+	newWriter := func() {
+		logger := logrus.New()
+		logger.Out = io.Discard
+		writer = stream.NewWebhookWriter(config, server.Client(), logger, reporter)
+	}
+
+	It("posts signed notifications", func() {
+		requests := make(chan *http.Request, 1)
+		bodies := make(chan []byte, 1)
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			requests <- r
+			bodies <- body
+		}
+		mockAPI.EXPECT().NotificationDelivered("webhook", true, gomock.Any()).Times(1)
+		newWriter()
+		Expect(writer.Write(context.Background(), []byte("cluster-id"), envelope)).To(Succeed())
+
+		var req *http.Request
+		Eventually(requests).Should(Receive(&req))
+		body := <-bodies
+		Expect(req.Method).To(Equal(http.MethodPost))
+		Expect(req.Header.Get("Content-Type")).To(Equal("application/json"))
+		Expect(req.Header.Get(stream.WebhookEventHeader)).To(Equal(common.NotificationTypeCluster))
+		Expect(req.Header.Get(stream.WebhookKeyHeader)).To(Equal("cluster-id"))
+		Expect(req.Header.Get(stream.WebhookDeliveryHeader)).ToNot(BeEmpty())
+
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(req.Header.Get(stream.WebhookTimestampHeader) + "."))
+		mac.Write(body)
+		Expect(req.Header.Get(stream.WebhookSignatureHeader)).To(Equal("sha256=" + hex.EncodeToString(mac.Sum(nil))))
+
+		var received map[string]interface{}
+		Expect(json.Unmarshal(body, &received)).To(Succeed())
+		Expect(received["Name"]).To(Equal(common.NotificationTypeCluster))
+		writer.Close()
+	})
+
+	It("does not sign notifications without a secret", func() {
+		config.Secret = ""
+		requests := make(chan *http.Request, 1)
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			requests <- r
+		}
+		mockAPI.EXPECT().NotificationDelivered("webhook", true, gomock.Any()).Times(1)
+		newWriter()
+		Expect(writer.Write(context.Background(), nil, envelope)).To(Succeed())
+		var req *http.Request
+		Eventually(requests).Should(Receive(&req))
+		Expect(req.Header.Get(stream.WebhookSignatureHeader)).To(BeEmpty())
+		writer.Close()
+	})
+
+	It("retries server errors with the same delivery ID", func() {
+		var attempts int32
+		var mutex sync.Mutex
+		deliveryIDs := map[string]bool{}
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			deliveryIDs[r.Header.Get(stream.WebhookDeliveryHeader)] = true
+			mutex.Unlock()
+			if atomic.AddInt32(&attempts, 1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		}
+		mockAPI.EXPECT().NotificationDelivered("webhook", true, gomock.Any()).Times(1)
+		newWriter()
+		Expect(writer.Write(context.Background(), nil, envelope)).To(Succeed())
+		writer.Close()
+		Expect(atomic.LoadInt32(&attempts)).To(BeEquivalentTo(3))
+		Expect(deliveryIDs).To(HaveLen(1))
+	})
+
+	It("gives up after the maximal number of retries", func() {
+		var attempts int32
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&attempts, 1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		mockAPI.EXPECT().NotificationDelivered("webhook", false, gomock.Any()).Times(1)
+		newWriter()
+		Expect(writer.Write(context.Background(), nil, envelope)).To(Succeed())
+		writer.Close()
+		Expect(atomic.LoadInt32(&attempts)).To(BeEquivalentTo(3))
+	})
+
+	It("does not retry client errors", func() {
+		var attempts int32
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&attempts, 1)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		mockAPI.EXPECT().NotificationDelivered("webhook", false, gomock.Any()).Times(1)
+		newWriter()
+		Expect(writer.Write(context.Background(), nil, envelope)).To(Succeed())
+		writer.Close()
+		Expect(atomic.LoadInt32(&attempts)).To(BeEquivalentTo(1))
+	})
+
+	It("fails when the queue is full", func() {
+		config.QueueSize = 1
+		unblock := make(chan struct{})
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			<-unblock
+		}
+		mockAPI.EXPECT().NotificationDelivered("webhook", gomock.Any(), gomock.Any()).AnyTimes()
+		newWriter()
+		var err error
+		for i := 0; i < 3 && err == nil; i++ {
+			err = writer.Write(context.Background(), nil, envelope)
+		}
+		Expect(err).To(HaveOccurred())
+		close(unblock)
+		writer.Close()
+	})
+
+	It("fails after being closed", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {}
+		newWriter()
+		writer.Close()
+		Expect(writer.Write(context.Background(), nil, envelope)).ToNot(Succeed())
+	})
+})
+
+var _ = Describe("FileWriter", func() {
+	var (
+		dir    string
+		path   string
+		writer *stream.FileWriter
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "notifications")
+		Expect(err).ToNot(HaveOccurred())
+		path = filepath.Join(dir, "notifications.jsonl")
+		writer, err = stream.NewFileWriter(stream.FileWriterConfig{Path: path, Sync: true})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	readLines := func() []map[string]interface{} {
+		file, err := os.Open(path)
+		Expect(err).ToNot(HaveOccurred())
+		defer file.Close()
+		var lines []map[string]interface{}
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			var line map[string]interface{}
+			Expect(json.Unmarshal(scanner.Bytes(), &line)).To(Succeed())
+			lines = append(lines, line)
+		}
+		return lines
+	}
+
+	It("appends the notifications as JSON lines", func() {
+		Expect(writer.Write(context.Background(), []byte("first"), &stream.Envelope{Name: common.NotificationTypeCluster})).To(Succeed())
+		Expect(writer.Write(context.Background(), []byte("second"), &stream.Envelope{Name: common.NotificationTypeHost})).To(Succeed())
+		writer.Close()
+
+		writer, err := stream.NewFileWriter(stream.FileWriterConfig{Path: path})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(writer.Write(context.Background(), []byte("third"), &stream.Envelope{Name: common.NotificationTypeEvent})).To(Succeed())
+		writer.Close()
+
+		lines := readLines()
+		Expect(lines).To(HaveLen(3))
+		Expect(lines[0]["key"]).To(Equal("first"))
+		Expect(lines[0]["time"]).ToNot(BeEmpty())
+		Expect(lines[0]["value"]).To(HaveKeyWithValue("Name", common.NotificationTypeCluster))
+		Expect(lines[1]["key"]).To(Equal("second"))
+		Expect(lines[2]["key"]).To(Equal("third"))
+	})
+
+	It("fails to write unencodable values", func() {
+		Expect(writer.Write(context.Background(), nil, make(chan int))).ToNot(Succeed())
+		writer.Close()
+		Expect(readLines()).To(BeEmpty())
+	})
+
+	It("fails on an inaccessible path", func() {
+		_, err := stream.NewFileWriter(stream.FileWriterConfig{Path: filepath.Join(path, "not-a-dir", "file")})
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("NATSWriter", func() {
+	var (
+		ctrl      *gomock.Controller
+		publisher *stream.MockNATSPublisher
+		writer    *stream.NATSWriter
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		publisher = stream.NewMockNATSPublisher(ctrl)
+		logger := logrus.New()
+		logger.Out = io.Discard
+		writer = stream.NewNATSWriter(publisher, "assisted", logger)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("publishes notifications to a subject per notification name", func() {
+		publisher.EXPECT().PublishMsg(gomock.Any()).DoAndReturn(func(msg *nats.Msg) error {
+			Expect(msg.Subject).To(Equal("assisted.HostState"))
+			Expect(msg.Header.Get("Assisted-Key")).To(Equal("cluster-id"))
+			var received map[string]interface{}
+			Expect(json.Unmarshal(msg.Data, &received)).To(Succeed())
+			Expect(received["Name"]).To(Equal(common.NotificationTypeHost))
+			return nil
+		}).Times(1)
+		Expect(writer.Write(context.Background(), []byte("cluster-id"), &stream.Envelope{Name: common.NotificationTypeHost})).To(Succeed())
+	})
+
+	It("returns publishing errors", func() {
+		publisher.EXPECT().PublishMsg(gomock.Any()).Return(nats.ErrConnectionClosed).Times(1)
+		Expect(writer.Write(context.Background(), nil, &stream.Envelope{Name: common.NotificationTypeHost})).To(MatchError(nats.ErrConnectionClosed))
+	})
+
+	It("drains the connection when closed", func() {
+		publisher.EXPECT().Drain().Return(nil).Times(1)
+		writer.Close()
+	})
+})
@@ -0,0 +1,106 @@
+package subscriptions
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// errBlockedAddress is returned when a subscribed URL targets an address that the service must not send requests
+// to, e.g. the cloud metadata service or a service of the cluster that runs the assisted-service
+var errBlockedAddress = errors.New("the address is not allowed for subscriptions")
+
+var blockedNetworks = mustParseCIDRs(
+	// Shared address space (carrier-grade NAT)
+	"100.64.0.0/10",
+	// IPv4 benchmarking
+	"198.18.0.0/15",
+	// NAT64
+	"64:ff9b::/96",
+)
+
+// Host names that are resolved within the network of the service rather than on the internet
+var blockedHostSuffixes = []string{".localhost", ".local", ".internal", ".svc", ".cluster.local"}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	ret := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		ret = append(ret, network)
+	}
+	return ret
+}
+
+// isBlockedIP returns whether the IP address is a loopback, private, link-local or otherwise non-public address
+func isBlockedIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return true
+	}
+	for _, network := range blockedNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateHost rejects the host of a subscribed URL when it's an IP address that isn't public, or a name that is
+// resolved within the network of the service. Other names are checked when they are resolved, by the dialer
+func validateHost(host string) error {
+	if ip := net.ParseIP(host); ip != nil {
+		if isBlockedIP(ip) {
+			return errors.Wrapf(errBlockedAddress, "%s", host)
+		}
+		return nil
+	}
+	name := strings.TrimSuffix(strings.ToLower(host), ".")
+	if name == "localhost" || !strings.Contains(name, ".") {
+		return errors.Wrapf(errBlockedAddress, "%s", host)
+	}
+	for _, suffix := range blockedHostSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return errors.Wrapf(errBlockedAddress, "%s", host)
+		}
+	}
+	return nil
+}
+
+// dialControl rejects connections to addresses that aren't public. It runs after the name of the host is resolved,
+// for every connection, so it also applies to the names that resolve differently over time and to redirects
+func dialControl(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || isBlockedIP(ip) {
+		return errors.Wrapf(errBlockedAddress, "%s", host)
+	}
+	return nil
+}
+
+// newDeliveryClient returns the HTTP client that sends the deliveries. Unless private addresses are allowed, it
+// connects only to public addresses, and directly rather than through a proxy, which would hide the address it
+// connects to
+func newDeliveryClient(config Config) *http.Client {
+	if config.AllowPrivateURLs {
+		return &http.Client{Timeout: config.DeliveryTimeout}
+	}
+	dialer := &net.Dialer{
+		Timeout:   config.DeliveryTimeout,
+		KeepAlive: 30 * time.Second,
+		Control:   dialControl,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Timeout: config.DeliveryTimeout, Transport: transport}
+}
@@ -0,0 +1,76 @@
+package subscriptions
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+var _ = Describe("Subscription addresses", func() {
+	table.DescribeTable("rejects hosts that aren't public", func(host string) {
+		err := validateHost(host)
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, errBlockedAddress)).To(BeTrue())
+	},
+		table.Entry("cloud metadata service", "169.254.169.254"),
+		table.Entry("loopback", "127.0.0.1"),
+		table.Entry("unspecified", "0.0.0.0"),
+		table.Entry("private 10/8", "10.1.2.3"),
+		table.Entry("private 172.16/12", "172.16.0.1"),
+		table.Entry("private 192.168/16", "192.168.1.1"),
+		table.Entry("shared address space", "100.64.0.1"),
+		table.Entry("IPv6 loopback", "::1"),
+		table.Entry("IPv6 link-local", "fe80::1"),
+		table.Entry("IPv6 unique local", "fd00::1"),
+		table.Entry("IPv4-mapped IPv6 loopback", "::ffff:127.0.0.1"),
+		table.Entry("localhost", "localhost"),
+		table.Entry("single-label name", "my-service"),
+		table.Entry("service name", "my-service.my-namespace.svc"),
+		table.Entry("cluster domain", "kubernetes.default.svc.cluster.local"),
+		table.Entry("internal domain", "metadata.google.internal"),
+	)
+
+	table.DescribeTable("accepts public hosts", func(host string) {
+		Expect(validateHost(host)).To(Succeed())
+	},
+		table.Entry("IPv4 address", "8.8.8.8"),
+		table.Entry("IPv6 address", "2001:4860:4860::8888"),
+		table.Entry("name", "hooks.example.com"),
+	)
+
+	It("rejects private addresses when it connects", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, nil)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = newDeliveryClient(Config{DeliveryTimeout: time.Second}).Do(req)
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, errBlockedAddress)).To(BeTrue())
+
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, server.URL, nil)
+		Expect(err).ToNot(HaveOccurred())
+		resp, err := newDeliveryClient(Config{DeliveryTimeout: time.Second, AllowPrivateURLs: true}).Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Body.Close()).To(Succeed())
+	})
+
+	It("checks the resolved address of every connection, which covers redirects and names that resolve differently", func() {
+		target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer target.Close()
+		Expect(dialControl("tcp", target.Listener.Addr().String(), nil)).To(MatchError(ContainSubstring("not allowed")))
+		Expect(dialControl("tcp", "93.184.216.34:443", nil)).To(Succeed())
+	})
+})
@@ -0,0 +1,351 @@
+package subscriptions
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/subscriptions"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ restapi.SubscriptionsAPI = &Api{}
+
+type Api struct {
+	db      *gorm.DB
+	authz   auth.Authorizer
+	config  Config
+	secrets *secretCipher
+	log     logrus.FieldLogger
+}
+
+func NewApi(db *gorm.DB, authz auth.Authorizer, config Config, log logrus.FieldLogger) (*Api, error) {
+	secrets, err := newSecretCipher(config.SecretEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Api{
+		db:      db,
+		authz:   authz,
+		config:  config,
+		secrets: secrets,
+		log:     log,
+	}, nil
+}
+
+func (a *Api) V2ListSubscriptions(ctx context.Context, params operations.V2ListSubscriptionsParams) middleware.Responder {
+	if !a.config.EnableSubscriptions {
+		return notEnabled()
+	}
+	log := logutil.FromContext(ctx, a.log)
+	query := a.authz.OwnedBy(ctx, a.db).Order("created_at")
+	if params.ClusterID != nil {
+		query = query.Where("cluster_id = ?", params.ClusterID.String())
+	}
+	var subscriptions []*common.Subscription
+	if err := query.Find(&subscriptions).Error; err != nil {
+		log.WithError(err).Error("failed to list subscriptions")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	ret := make(models.SubscriptionList, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		ret = append(ret, toModel(subscription))
+	}
+	return operations.NewV2ListSubscriptionsOK().WithPayload(ret)
+}
+
+func (a *Api) V2RegisterSubscription(ctx context.Context, params operations.V2RegisterSubscriptionParams) middleware.Responder {
+	if !a.config.EnableSubscriptions {
+		return notEnabled()
+	}
+	log := logutil.FromContext(ctx, a.log)
+	createParams := params.NewSubscriptionParams
+	if err := a.validateURL(swag.StringValue(createParams.URL)); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if err := validateTriggers(createParams.Triggers); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if createParams.ClusterID != nil {
+		if err := a.checkClusterAccess(ctx, *createParams.ClusterID); err != nil {
+			return common.GenerateErrorResponder(err)
+		}
+	}
+
+	id := strfmt.UUID(uuid.New().String())
+	encryptedSecret, err := a.encryptSecret(id, createParams.Secret)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	now := strfmt.DateTime(time.Now())
+	triggers := createParams.Triggers
+	if triggers == nil {
+		triggers = &models.SubscriptionTriggers{}
+	}
+	subscription := &common.Subscription{
+		Subscription: models.Subscription{
+			ID:        &id,
+			URL:       createParams.URL,
+			ClusterID: createParams.ClusterID,
+			Triggers:  triggers,
+			Enabled:   swag.Bool(createParams.Enabled == nil || *createParams.Enabled),
+			UserName:  ocm.UserNameFromContext(ctx),
+			OrgID:     ocm.OrgIDFromContext(ctx),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		EncryptedSecret: encryptedSecret,
+	}
+	if err := a.db.Create(subscription).Error; err != nil {
+		log.WithError(err).Errorf("failed to create subscription for %s", swag.StringValue(createParams.URL))
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	log.Infof("Registered subscription %s for %s", id, swag.StringValue(createParams.URL))
+	return operations.NewV2RegisterSubscriptionCreated().WithPayload(toModel(subscription))
+}
+
+func (a *Api) V2GetSubscription(ctx context.Context, params operations.V2GetSubscriptionParams) middleware.Responder {
+	if !a.config.EnableSubscriptions {
+		return notEnabled()
+	}
+	subscription, err := a.getSubscription(ctx, a.db, params.SubscriptionID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewV2GetSubscriptionOK().WithPayload(toModel(subscription))
+}
+
+func (a *Api) V2UpdateSubscription(ctx context.Context, params operations.V2UpdateSubscriptionParams) middleware.Responder {
+	if !a.config.EnableSubscriptions {
+		return notEnabled()
+	}
+	log := logutil.FromContext(ctx, a.log)
+	updateParams := params.SubscriptionUpdateParams
+	if updateParams.URL != nil {
+		if err := a.validateURL(*updateParams.URL); err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+	if err := validateTriggers(updateParams.Triggers); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	var subscription *common.Subscription
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		if subscription, err = a.getSubscription(ctx, tx, params.SubscriptionID); err != nil {
+			return err
+		}
+		if updateParams.URL != nil {
+			subscription.URL = updateParams.URL
+		}
+		if updateParams.Secret != nil {
+			if subscription.EncryptedSecret, err = a.encryptSecret(*subscription.ID, *updateParams.Secret); err != nil {
+				return err
+			}
+		}
+		if updateParams.Triggers != nil {
+			subscription.Triggers = updateParams.Triggers
+		}
+		if updateParams.Enabled != nil {
+			subscription.Enabled = updateParams.Enabled
+		}
+		subscription.UpdatedAt = strfmt.DateTime(time.Now())
+		return tx.Save(subscription).Error
+	})
+	if err != nil {
+		log.WithError(err).Errorf("failed to update subscription %s", params.SubscriptionID)
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewV2UpdateSubscriptionCreated().WithPayload(toModel(subscription))
+}
+
+func (a *Api) V2DeregisterSubscription(ctx context.Context, params operations.V2DeregisterSubscriptionParams) middleware.Responder {
+	if !a.config.EnableSubscriptions {
+		return notEnabled()
+	}
+	log := logutil.FromContext(ctx, a.log)
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		subscription, err := a.getSubscription(ctx, tx, params.SubscriptionID)
+		if err != nil {
+			return err
+		}
+		deliveries := tx.Model(&common.SubscriptionDelivery{}).Select("id").Where("subscription_id = ?", subscription.ID.String())
+		if err = tx.Where("delivery_id IN (?)", deliveries).Delete(&models.SubscriptionDeliveryAttempt{}).Error; err != nil {
+			return err
+		}
+		if err = tx.Where("subscription_id = ?", subscription.ID.String()).Delete(&common.SubscriptionDelivery{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(subscription).Error
+	})
+	if err != nil {
+		log.WithError(err).Errorf("failed to deregister subscription %s", params.SubscriptionID)
+		return common.GenerateErrorResponder(err)
+	}
+	log.Infof("Deregistered subscription %s", params.SubscriptionID)
+	return operations.NewV2DeregisterSubscriptionNoContent()
+}
+
+func (a *Api) V2ListSubscriptionDeliveries(ctx context.Context, params operations.V2ListSubscriptionDeliveriesParams) middleware.Responder {
+	if !a.config.EnableSubscriptions {
+		return notEnabled()
+	}
+	log := logutil.FromContext(ctx, a.log)
+	if _, err := a.getSubscription(ctx, a.db, params.SubscriptionID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	query := a.db.Preload("AttemptLog", func(db *gorm.DB) *gorm.DB {
+		return db.Order("attempted_at DESC")
+	}).Where("subscription_id = ?", params.SubscriptionID.String())
+	if params.Status != nil {
+		query = query.Where("status = ?", *params.Status)
+	}
+	var deliveries []*common.SubscriptionDelivery
+	err := query.Order("created_at DESC").
+		Limit(int(swag.Int64Value(params.Limit))).
+		Offset(int(swag.Int64Value(params.Offset))).
+		Find(&deliveries).Error
+	if err != nil {
+		log.WithError(err).Errorf("failed to list the deliveries of subscription %s", params.SubscriptionID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	ret := make(models.SubscriptionDeliveryList, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		ret = append(ret, &delivery.SubscriptionDelivery)
+	}
+	return operations.NewV2ListSubscriptionDeliveriesOK().WithPayload(ret)
+}
+
+func (a *Api) V2RedeliverSubscriptionDelivery(ctx context.Context, params operations.V2RedeliverSubscriptionDeliveryParams) middleware.Responder {
+	if !a.config.EnableSubscriptions {
+		return notEnabled()
+	}
+	log := logutil.FromContext(ctx, a.log)
+	var delivery common.SubscriptionDelivery
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := a.getSubscription(ctx, tx, params.SubscriptionID); err != nil {
+			return err
+		}
+		err := tx.Take(&delivery, "id = ? AND subscription_id = ?", params.DeliveryID.String(), params.SubscriptionID.String()).Error
+		if err != nil {
+			return err
+		}
+		if swag.StringValue(delivery.Status) == models.SubscriptionDeliveryStatusPending {
+			return common.NewApiError(http.StatusConflict, errors.Errorf("delivery %s is still pending", params.DeliveryID))
+		}
+		delivery.Status = swag.String(models.SubscriptionDeliveryStatusPending)
+		delivery.StatusInfo = "Redelivery requested"
+		delivery.Attempts = 0
+		delivery.NextAttemptAt = strfmt.DateTime(time.Now())
+		return tx.Model(&delivery).Select("status", "status_info", "attempts", "next_attempt_at").Updates(&delivery).Error
+	})
+	if err != nil {
+		log.WithError(err).Errorf("failed to redeliver delivery %s of subscription %s", params.DeliveryID, params.SubscriptionID)
+		return common.GenerateErrorResponder(err)
+	}
+	log.Infof("Scheduled redelivery of delivery %s of subscription %s", params.DeliveryID, params.SubscriptionID)
+	return operations.NewV2RedeliverSubscriptionDeliveryAccepted().WithPayload(&delivery.SubscriptionDelivery)
+}
+
+// getSubscription returns the subscription if it is owned by the user, in the same way as clusters are
+// accessible by their owner and the members of its organization
+func (a *Api) getSubscription(ctx context.Context, db *gorm.DB, id strfmt.UUID) (*common.Subscription, error) {
+	var subscription common.Subscription
+	if err := a.authz.OwnedBy(ctx, db).Take(&subscription, "id = ?", id.String()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("subscription %s not found", id))
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return &subscription, nil
+}
+
+// encryptSecret returns the encrypted secret of the subscription, or an empty string if the subscription has no secret
+func (a *Api) encryptSecret(subscriptionID strfmt.UUID, secret string) (string, error) {
+	if secret == "" {
+		return "", nil
+	}
+	if a.secrets == nil {
+		return "", common.NewApiError(http.StatusNotImplemented, errors.New("no subscription secret encryption key is configured"))
+	}
+	encrypted, err := a.secrets.encrypt(subscriptionID, secret)
+	if err != nil {
+		return "", common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return encrypted, nil
+}
+
+func (a *Api) checkClusterAccess(ctx context.Context, clusterID strfmt.UUID) error {
+	allowed, err := canRead(ctx, a.authz, clusterID)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if !allowed {
+		return common.NewApiError(http.StatusNotFound, errors.New("Object Not Found"))
+	}
+	return nil
+}
+
+func (a *Api) validateURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.Wrapf(err, "invalid subscription URL %s", rawURL)
+	}
+	if parsed.Host == "" {
+		return errors.Errorf("subscription URL %s has no host", rawURL)
+	}
+	if !a.config.AllowPrivateURLs {
+		if err = validateHost(parsed.Hostname()); err != nil {
+			return errors.Wrapf(err, "invalid subscription URL %s", rawURL)
+		}
+	}
+	switch parsed.Scheme {
+	case "https":
+		return nil
+	case "http":
+		if a.config.AllowInsecureURLs {
+			return nil
+		}
+		return errors.Errorf("subscription URL %s must use https", rawURL)
+	default:
+		return errors.Errorf("subscription URL %s has unsupported scheme %s", rawURL, parsed.Scheme)
+	}
+}
+
+func validateTriggers(triggers *models.SubscriptionTriggers) error {
+	if triggers == nil {
+		return nil
+	}
+	for _, severity := range triggers.EventSeverities {
+		switch severity {
+		case models.EventSeverityInfo, models.EventSeverityWarning, models.EventSeverityError, models.EventSeverityCritical:
+		default:
+			return errors.Errorf("invalid event severity %s", severity)
+		}
+	}
+	return nil
+}
+
+func toModel(subscription *common.Subscription) *models.Subscription {
+	ret := subscription.Subscription
+	ret.Signed = subscription.EncryptedSecret != ""
+	return &ret
+}
+
+func notEnabled() middleware.Responder {
+	return common.NewApiError(http.StatusNotImplemented, errors.New("subscriptions are not enabled"))
+}
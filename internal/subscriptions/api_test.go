@@ -0,0 +1,230 @@
+package subscriptions
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	operations "github.com/openshift/assisted-service/restapi/operations/subscriptions"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Subscriptions API", func() {
+	var (
+		db           *gorm.DB
+		dbName       string
+		api          *Api
+		ctx          context.Context
+		otherOrgCtx  context.Context
+		clusterID    strfmt.UUID
+		otherCluster strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		cfg := &auth.Config{AuthType: auth.TypeRHSSO, EnableOrgTenancy: true}
+		authzHandler := auth.NewAuthzHandler(cfg, nil, logrus.New(), db)
+		var err error
+		api, err = NewApi(db, authzHandler, testConfig(), logrus.WithField("pkg", "subscriptions"))
+		Expect(err).ToNot(HaveOccurred())
+
+		clusterID = strfmt.UUID(uuid.New().String())
+		otherCluster = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, UserName: "user1", OrgID: "org1"}}).Error).ShouldNot(HaveOccurred())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &otherCluster, UserName: "user2", OrgID: "org2"}}).Error).ShouldNot(HaveOccurred())
+		ctx = userContext("user1", "org1")
+		otherOrgCtx = userContext("user2", "org2")
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	register := func(ctx context.Context, params *models.SubscriptionCreateParams) *models.Subscription {
+		reply := api.V2RegisterSubscription(ctx, operations.V2RegisterSubscriptionParams{NewSubscriptionParams: params})
+		ExpectWithOffset(1, reply).To(BeAssignableToTypeOf(operations.NewV2RegisterSubscriptionCreated()))
+		return reply.(*operations.V2RegisterSubscriptionCreated).Payload
+	}
+
+	It("is not implemented when subscriptions are disabled", func() {
+		api.config.EnableSubscriptions = false
+		verifyApiError(api.V2ListSubscriptions(ctx, operations.V2ListSubscriptionsParams{}), http.StatusNotImplemented)
+	})
+
+	It("registers a subscription owned by the user", func() {
+		subscription := register(ctx, &models.SubscriptionCreateParams{
+			URL:       swag.String("https://example.com/hook"),
+			Secret:    "secret",
+			ClusterID: &clusterID,
+			Triggers:  &models.SubscriptionTriggers{ClusterStatuses: pq.StringArray{models.ClusterStatusInstalled}},
+		})
+		Expect(subscription.UserName).To(Equal("user1"))
+		Expect(subscription.OrgID).To(Equal("org1"))
+		Expect(swag.BoolValue(subscription.Enabled)).To(BeTrue())
+		Expect(subscription.Signed).To(BeTrue())
+
+		var stored common.Subscription
+		Expect(db.Take(&stored, "id = ?", subscription.ID.String()).Error).ToNot(HaveOccurred())
+		Expect(stored.EncryptedSecret).ToNot(BeEmpty())
+		Expect(stored.EncryptedSecret).ToNot(ContainSubstring("secret"))
+		Expect(api.secrets.decrypt(*subscription.ID, stored.EncryptedSecret)).To(Equal("secret"))
+		Expect(stored.Triggers.ClusterStatuses).To(ConsistOf(models.ClusterStatusInstalled))
+	})
+
+	It("does not register subscriptions with a secret without a secret encryption key", func() {
+		api.secrets = nil
+		verifyApiError(api.V2RegisterSubscription(ctx, operations.V2RegisterSubscriptionParams{
+			NewSubscriptionParams: &models.SubscriptionCreateParams{URL: swag.String("https://example.com/hook"), Secret: "secret"},
+		}), http.StatusNotImplemented)
+		register(ctx, &models.SubscriptionCreateParams{URL: swag.String("https://example.com/hook")})
+	})
+
+	It("rejects invalid subscriptions", func() {
+		for _, params := range []*models.SubscriptionCreateParams{
+			{URL: swag.String("ftp://example.com/hook")},
+			{URL: swag.String("https:///hook")},
+			{URL: swag.String("https://example.com/hook"), Triggers: &models.SubscriptionTriggers{EventSeverities: pq.StringArray{"fatal"}}},
+		} {
+			verifyApiError(api.V2RegisterSubscription(ctx, operations.V2RegisterSubscriptionParams{NewSubscriptionParams: params}), http.StatusBadRequest)
+		}
+		api.config.AllowInsecureURLs = false
+		verifyApiError(api.V2RegisterSubscription(ctx, operations.V2RegisterSubscriptionParams{
+			NewSubscriptionParams: &models.SubscriptionCreateParams{URL: swag.String("http://example.com/hook")},
+		}), http.StatusBadRequest)
+		api.config.AllowPrivateURLs = false
+		for _, url := range []string{"https://169.254.169.254/latest/meta-data", "https://10.0.0.1/hook", "https://my-service.my-namespace.svc/hook"} {
+			verifyApiError(api.V2RegisterSubscription(ctx, operations.V2RegisterSubscriptionParams{
+				NewSubscriptionParams: &models.SubscriptionCreateParams{URL: swag.String(url)},
+			}), http.StatusBadRequest)
+		}
+	})
+
+	It("does not subscribe to clusters of another org", func() {
+		verifyApiError(api.V2RegisterSubscription(ctx, operations.V2RegisterSubscriptionParams{
+			NewSubscriptionParams: &models.SubscriptionCreateParams{URL: swag.String("https://example.com/hook"), ClusterID: &otherCluster},
+		}), http.StatusNotFound)
+	})
+
+	It("scopes the subscriptions by org", func() {
+		mine := register(ctx, &models.SubscriptionCreateParams{URL: swag.String("https://example.com/mine"), ClusterID: &clusterID})
+		theirs := register(otherOrgCtx, &models.SubscriptionCreateParams{URL: swag.String("https://example.com/theirs")})
+
+		reply := api.V2ListSubscriptions(ctx, operations.V2ListSubscriptionsParams{})
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2ListSubscriptionsOK()))
+		list := reply.(*operations.V2ListSubscriptionsOK).Payload
+		Expect(list).To(HaveLen(1))
+		Expect(*list[0].ID).To(Equal(*mine.ID))
+
+		verifyApiError(api.V2GetSubscription(ctx, operations.V2GetSubscriptionParams{SubscriptionID: *theirs.ID}), http.StatusNotFound)
+		verifyApiError(api.V2DeregisterSubscription(ctx, operations.V2DeregisterSubscriptionParams{SubscriptionID: *theirs.ID}), http.StatusNotFound)
+		Expect(api.V2GetSubscription(otherOrgCtx, operations.V2GetSubscriptionParams{SubscriptionID: *theirs.ID})).
+			To(BeAssignableToTypeOf(operations.NewV2GetSubscriptionOK()))
+	})
+
+	It("updates a subscription", func() {
+		subscription := register(ctx, &models.SubscriptionCreateParams{URL: swag.String("https://example.com/hook"), Secret: "secret"})
+		reply := api.V2UpdateSubscription(ctx, operations.V2UpdateSubscriptionParams{
+			SubscriptionID: *subscription.ID,
+			SubscriptionUpdateParams: &models.SubscriptionUpdateParams{
+				URL:     swag.String("https://example.com/other"),
+				Secret:  swag.String(""),
+				Enabled: swag.Bool(false),
+			},
+		})
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2UpdateSubscriptionCreated()))
+		updated := reply.(*operations.V2UpdateSubscriptionCreated).Payload
+		Expect(swag.StringValue(updated.URL)).To(Equal("https://example.com/other"))
+		Expect(swag.BoolValue(updated.Enabled)).To(BeFalse())
+		Expect(updated.Signed).To(BeFalse())
+	})
+
+	Context("deliveries", func() {
+		var (
+			subscription *models.Subscription
+			delivery     *common.SubscriptionDelivery
+		)
+
+		BeforeEach(func() {
+			subscription = register(ctx, &models.SubscriptionCreateParams{URL: swag.String("https://example.com/hook")})
+			deliveryID := strfmt.UUID(uuid.New().String())
+			delivery = &common.SubscriptionDelivery{
+				SubscriptionDelivery: models.SubscriptionDelivery{
+					ID:             &deliveryID,
+					SubscriptionID: subscription.ID,
+					ClusterID:      &clusterID,
+					Trigger:        "cluster_status:installed",
+					Status:         swag.String(models.SubscriptionDeliveryStatusFailed),
+					Attempts:       3,
+				},
+				DedupeKey: "key",
+				Payload:   "{}",
+			}
+			Expect(db.Create(delivery).Error).ToNot(HaveOccurred())
+			Expect(db.Create(&models.SubscriptionDeliveryAttempt{DeliveryID: deliveryID, StatusCode: http.StatusInternalServerError}).Error).ToNot(HaveOccurred())
+		})
+
+		It("lists the deliveries with their attempts", func() {
+			reply := api.V2ListSubscriptionDeliveries(ctx, operations.V2ListSubscriptionDeliveriesParams{
+				SubscriptionID: *subscription.ID,
+				Limit:          swag.Int64(100),
+				Offset:         swag.Int64(0),
+			})
+			Expect(reply).To(BeAssignableToTypeOf(operations.NewV2ListSubscriptionDeliveriesOK()))
+			list := reply.(*operations.V2ListSubscriptionDeliveriesOK).Payload
+			Expect(list).To(HaveLen(1))
+			Expect(list[0].AttemptLog).To(HaveLen(1))
+			Expect(list[0].AttemptLog[0].StatusCode).To(BeEquivalentTo(http.StatusInternalServerError))
+
+			reply = api.V2ListSubscriptionDeliveries(ctx, operations.V2ListSubscriptionDeliveriesParams{
+				SubscriptionID: *subscription.ID,
+				Status:         swag.String(models.SubscriptionDeliveryStatusPending),
+				Limit:          swag.Int64(100),
+				Offset:         swag.Int64(0),
+			})
+			Expect(reply.(*operations.V2ListSubscriptionDeliveriesOK).Payload).To(BeEmpty())
+		})
+
+		It("redelivers a failed delivery", func() {
+			reply := api.V2RedeliverSubscriptionDelivery(ctx, operations.V2RedeliverSubscriptionDeliveryParams{
+				SubscriptionID: *subscription.ID,
+				DeliveryID:     *delivery.ID,
+			})
+			Expect(reply).To(BeAssignableToTypeOf(operations.NewV2RedeliverSubscriptionDeliveryAccepted()))
+			var stored common.SubscriptionDelivery
+			Expect(db.Take(&stored, "id = ?", delivery.ID.String()).Error).ToNot(HaveOccurred())
+			Expect(swag.StringValue(stored.Status)).To(Equal(models.SubscriptionDeliveryStatusPending))
+			Expect(stored.Attempts).To(BeZero())
+
+			verifyApiError(api.V2RedeliverSubscriptionDelivery(ctx, operations.V2RedeliverSubscriptionDeliveryParams{
+				SubscriptionID: *subscription.ID,
+				DeliveryID:     *delivery.ID,
+			}), http.StatusConflict)
+		})
+
+		It("does not redeliver deliveries of another org", func() {
+			verifyApiError(api.V2RedeliverSubscriptionDelivery(otherOrgCtx, operations.V2RedeliverSubscriptionDeliveryParams{
+				SubscriptionID: *subscription.ID,
+				DeliveryID:     *delivery.ID,
+			}), http.StatusNotFound)
+		})
+
+		It("deletes the deliveries with the subscription", func() {
+			reply := api.V2DeregisterSubscription(ctx, operations.V2DeregisterSubscriptionParams{SubscriptionID: *subscription.ID})
+			Expect(reply).To(BeAssignableToTypeOf(operations.NewV2DeregisterSubscriptionNoContent()))
+			var count int64
+			Expect(db.Model(&common.SubscriptionDelivery{}).Count(&count).Error).ToNot(HaveOccurred())
+			Expect(count).To(BeZero())
+			Expect(db.Model(&models.SubscriptionDeliveryAttempt{}).Count(&count).Error).ToNot(HaveOccurred())
+			Expect(count).To(BeZero())
+		})
+	})
+})
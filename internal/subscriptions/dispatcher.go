@@ -0,0 +1,260 @@
+package subscriptions
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	maxAttemptErrorLength = 1024
+	cleanupInterval       = time.Hour
+)
+
+// Dispatcher sends the pending deliveries to the subscribed URLs. Deliver is expected to be invoked
+// periodically, possibly by several replicas, which claim distinct deliveries. Failed deliveries are retried
+// with exponential backoff until the maximal number of attempts is reached, except for client errors, which
+// are not expected to succeed on retry.
+type Dispatcher struct {
+	db          *gorm.DB
+	client      *http.Client
+	config      Config
+	secrets     *secretCipher
+	reporter    *stream.DeliveryReporter
+	log         logrus.FieldLogger
+	lastCleanup time.Time
+}
+
+func NewDispatcher(db *gorm.DB, config Config, reporter *stream.DeliveryReporter, log logrus.FieldLogger) (*Dispatcher, error) {
+	secrets, err := newSecretCipher(config.SecretEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Dispatcher{
+		db:       db,
+		client:   newDeliveryClient(config),
+		config:   config,
+		secrets:  secrets,
+		reporter: reporter,
+		log:      log,
+	}, nil
+}
+
+func (d *Dispatcher) Deliver() {
+	deliveries, err := d.claim()
+	if err != nil {
+		d.log.WithError(err).Error("failed to claim pending subscription deliveries")
+		return
+	}
+	if len(deliveries) > 0 {
+		subscriptions, err := d.subscriptionsOf(deliveries)
+		if err != nil {
+			d.log.WithError(err).Error("failed to get the subscriptions of the pending deliveries")
+			return
+		}
+		var wg sync.WaitGroup
+		for _, delivery := range deliveries {
+			wg.Add(1)
+			go func(delivery *common.SubscriptionDelivery) {
+				defer wg.Done()
+				d.deliver(delivery, subscriptions[delivery.SubscriptionID.String()])
+			}(delivery)
+		}
+		wg.Wait()
+	}
+	if time.Since(d.lastCleanup) > cleanupInterval {
+		d.cleanup()
+		d.lastCleanup = time.Now()
+	}
+}
+
+// claim leases a batch of due deliveries to this replica by postponing their next attempt beyond the time it
+// takes to attempt them, so that they are not claimed again in the meantime
+func (d *Dispatcher) claim() ([]*common.SubscriptionDelivery, error) {
+	var deliveries []*common.SubscriptionDelivery
+	err := d.db.Transaction(func(tx *gorm.DB) error {
+		query := tx.Where("status = ? AND next_attempt_at <= ?", models.SubscriptionDeliveryStatusPending, time.Now()).
+			Order("next_attempt_at").
+			Limit(d.config.DeliveryBatchSize)
+		if tx.Name() != "sqlite3" {
+			query = query.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+		}
+		if err := query.Find(&deliveries).Error; err != nil || len(deliveries) == 0 {
+			return err
+		}
+		ids := make([]string, 0, len(deliveries))
+		for _, delivery := range deliveries {
+			ids = append(ids, delivery.ID.String())
+		}
+		lease := time.Now().Add(2 * d.config.DeliveryTimeout)
+		return tx.Model(&common.SubscriptionDelivery{}).Where("id IN (?)", ids).Update("next_attempt_at", lease).Error
+	})
+	return deliveries, err
+}
+
+func (d *Dispatcher) subscriptionsOf(deliveries []*common.SubscriptionDelivery) (map[string]*common.Subscription, error) {
+	ids := make([]string, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		ids = append(ids, delivery.SubscriptionID.String())
+	}
+	var subscriptions []*common.Subscription
+	if err := d.db.Where("id IN (?)", ids).Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+	ret := make(map[string]*common.Subscription, len(subscriptions))
+	for _, subscription := range subscriptions {
+		ret[subscription.ID.String()] = subscription
+	}
+	return ret, nil
+}
+
+func (d *Dispatcher) deliver(delivery *common.SubscriptionDelivery, subscription *common.Subscription) {
+	log := d.log.WithFields(logrus.Fields{
+		"delivery_id":     delivery.ID.String(),
+		"subscription_id": delivery.SubscriptionID.String(),
+	})
+	if subscription == nil || !swag.BoolValue(subscription.Enabled) {
+		if err := d.updateDelivery(delivery, models.SubscriptionDeliveryStatusFailed, "Subscription is disabled", nil); err != nil {
+			log.WithError(err).Error("failed to update delivery of disabled subscription")
+		}
+		return
+	}
+
+	start := time.Now()
+	statusCode, retryable, err := d.post(delivery, subscription)
+	duration := time.Since(start)
+	d.reporter.Report("subscriptions", err == nil, duration)
+	attempt := &models.SubscriptionDeliveryAttempt{
+		DeliveryID:  *delivery.ID,
+		AttemptedAt: strfmt.DateTime(start),
+		StatusCode:  statusCode,
+		DurationMs:  duration.Milliseconds(),
+	}
+	delivery.Attempts++
+	var status, statusInfo string
+	switch {
+	case err == nil:
+		status, statusInfo = models.SubscriptionDeliveryStatusDelivered, fmt.Sprintf("Delivered after %d attempts", delivery.Attempts)
+	case !retryable || delivery.Attempts >= d.config.MaxAttempts:
+		attempt.Error = truncate(err.Error(), maxAttemptErrorLength)
+		status, statusInfo = models.SubscriptionDeliveryStatusFailed, fmt.Sprintf("Failed after %d attempts: %s", delivery.Attempts, attempt.Error)
+	default:
+		attempt.Error = truncate(err.Error(), maxAttemptErrorLength)
+		status, statusInfo = models.SubscriptionDeliveryStatusPending, fmt.Sprintf("Attempt %d failed: %s", delivery.Attempts, attempt.Error)
+		delivery.NextAttemptAt = strfmt.DateTime(time.Now().Add(d.backoff(delivery.Attempts)))
+	}
+	if err != nil {
+		log.WithError(err).Warnf("attempt %d to deliver %s to %s failed", delivery.Attempts, delivery.Trigger, swag.StringValue(subscription.URL))
+	}
+	if err := d.updateDelivery(delivery, status, statusInfo, attempt); err != nil {
+		log.WithError(err).Error("failed to update subscription delivery")
+	}
+}
+
+// post sends a single delivery attempt and returns the response status code and whether a failure is worth
+// retrying
+func (d *Dispatcher) post(delivery *common.SubscriptionDelivery, subscription *common.Subscription) (int64, bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.config.DeliveryTimeout)
+	defer cancel()
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, swag.StringValue(subscription.URL), bytes.NewReader(body))
+	if err != nil {
+		return 0, false, err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(stream.WebhookDeliveryHeader, delivery.ID.String())
+	req.Header.Set(stream.WebhookEventHeader, delivery.Trigger)
+	req.Header.Set(stream.WebhookKeyHeader, delivery.ClusterID.String())
+	req.Header.Set(stream.WebhookTimestampHeader, timestamp)
+	if subscription.EncryptedSecret != "" {
+		if d.secrets == nil {
+			return 0, false, errors.New("no subscription secret encryption key is configured to sign the delivery")
+		}
+		secret, err := d.secrets.decrypt(*subscription.ID, subscription.EncryptedSecret)
+		if err != nil {
+			return 0, false, err
+		}
+		req.Header.Set(stream.WebhookSignatureHeader, stream.SignWebhookPayload(secret, timestamp, body))
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, !errors.Is(err, errBlockedAddress), err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return int64(resp.StatusCode), false, nil
+	}
+	err = errors.Errorf("subscriber responded with status %d", resp.StatusCode)
+	return int64(resp.StatusCode), resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests, err
+}
+
+func (d *Dispatcher) backoff(attempts int64) time.Duration {
+	backoff := d.config.RetryBackoff
+	for i := int64(1); i < attempts && backoff < d.config.MaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > d.config.MaxRetryBackoff {
+		backoff = d.config.MaxRetryBackoff
+	}
+	return backoff
+}
+
+func (d *Dispatcher) updateDelivery(delivery *common.SubscriptionDelivery, status, statusInfo string, attempt *models.SubscriptionDeliveryAttempt) error {
+	return d.db.Transaction(func(tx *gorm.DB) error {
+		if attempt != nil {
+			if err := tx.Create(attempt).Error; err != nil {
+				return err
+			}
+		}
+		// The delivery may have been redelivered or deleted in the meantime, in which case the result of the
+		// attempt is only recorded in the attempt log
+		return tx.Model(&common.SubscriptionDelivery{}).
+			Where("id = ? AND status = ?", delivery.ID.String(), models.SubscriptionDeliveryStatusPending).
+			Updates(map[string]interface{}{
+				"status":          status,
+				"status_info":     statusInfo,
+				"attempts":        delivery.Attempts,
+				"next_attempt_at": time.Time(delivery.NextAttemptAt),
+			}).Error
+	})
+}
+
+// cleanup deletes the deliveries that are older than the retention, along with their attempts
+func (d *Dispatcher) cleanup() {
+	threshold := time.Now().Add(-d.config.DeliveryRetention)
+	err := d.db.Transaction(func(tx *gorm.DB) error {
+		expired := tx.Model(&common.SubscriptionDelivery{}).Select("id").Where("created_at < ?", threshold)
+		if err := tx.Where("delivery_id IN (?)", expired).Delete(&models.SubscriptionDeliveryAttempt{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("created_at < ?", threshold).Delete(&common.SubscriptionDelivery{}).Error
+	})
+	if err != nil {
+		d.log.WithError(err).Warn("failed to delete expired subscription deliveries")
+	}
+}
+
+func truncate(s string, length int) string {
+	if len(s) <= length {
+		return s
+	}
+	return s[:length]
+}
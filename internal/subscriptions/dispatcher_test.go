@@ -0,0 +1,250 @@
+package subscriptions
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Subscription deliveries", func() {
+	var (
+		db           *gorm.DB
+		dbName       string
+		writer       *Writer
+		dispatcher   *Dispatcher
+		server       *httptest.Server
+		responseCode int32
+		requests     chan *http.Request
+		bodies       chan []byte
+		clusterID    strfmt.UUID
+		otherCluster strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		cfg := &auth.Config{AuthType: auth.TypeRHSSO, EnableOrgTenancy: true}
+		authzHandler := auth.NewAuthzHandler(cfg, nil, logrus.New(), db)
+		writer = NewWriter(db, authzHandler, logrus.WithField("pkg", "subscriptions"))
+		var err error
+		dispatcher, err = NewDispatcher(db, testConfig(), nil, logrus.WithField("pkg", "subscriptions"))
+		Expect(err).ToNot(HaveOccurred())
+
+		atomic.StoreInt32(&responseCode, http.StatusOK)
+		requests = make(chan *http.Request, 10)
+		bodies = make(chan []byte, 10)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			requests <- r
+			bodies <- body
+			w.WriteHeader(int(atomic.LoadInt32(&responseCode)))
+		}))
+
+		clusterID = strfmt.UUID(uuid.New().String())
+		otherCluster = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, UserName: "user1", OrgID: "org1"}}).Error).ShouldNot(HaveOccurred())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &otherCluster, UserName: "user2", OrgID: "org2"}}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	subscribe := func(clusterID *strfmt.UUID, orgID, secret string, triggers *models.SubscriptionTriggers) *common.Subscription {
+		id := strfmt.UUID(uuid.New().String())
+		if triggers == nil {
+			triggers = &models.SubscriptionTriggers{}
+		}
+		var encryptedSecret string
+		if secret != "" {
+			var err error
+			encryptedSecret, err = dispatcher.secrets.encrypt(id, secret)
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		}
+		subscription := &common.Subscription{
+			Subscription: models.Subscription{
+				ID:        &id,
+				URL:       swag.String(server.URL),
+				ClusterID: clusterID,
+				Triggers:  triggers,
+				Enabled:   swag.Bool(true),
+				UserName:  "user1",
+				OrgID:     orgID,
+			},
+			EncryptedSecret: encryptedSecret,
+		}
+		ExpectWithOffset(1, db.Create(subscription).Error).ToNot(HaveOccurred())
+		return subscription
+	}
+
+	clusterState := func(id strfmt.UUID, status string, updatedAt time.Time) *stream.Envelope {
+		return &stream.Envelope{
+			Name: "ClusterState",
+			Payload: &models.Cluster{
+				ID:              &id,
+				Status:          swag.String(status),
+				StatusUpdatedAt: strfmt.DateTime(updatedAt),
+				UserName:        "user1",
+				OrgID:           "org1",
+			},
+		}
+	}
+
+	deliveriesOf := func(subscription *common.Subscription) []*common.SubscriptionDelivery {
+		var deliveries []*common.SubscriptionDelivery
+		ExpectWithOffset(1, db.Preload("AttemptLog").Where("subscription_id = ?", subscription.ID.String()).Find(&deliveries).Error).ToNot(HaveOccurred())
+		return deliveries
+	}
+
+	It("creates a single delivery per status transition", func() {
+		subscription := subscribe(&clusterID, "org1", "", &models.SubscriptionTriggers{
+			ClusterStatuses: pq.StringArray{models.ClusterStatusInstalled},
+		})
+		updatedAt := time.Now()
+		Expect(writer.Write(context.TODO(), nil, clusterState(clusterID, models.ClusterStatusInstalling, updatedAt))).To(Succeed())
+		Expect(deliveriesOf(subscription)).To(BeEmpty())
+
+		Expect(writer.Write(context.TODO(), nil, clusterState(clusterID, models.ClusterStatusInstalled, updatedAt))).To(Succeed())
+		Expect(writer.Write(context.TODO(), nil, clusterState(clusterID, models.ClusterStatusInstalled, updatedAt))).To(Succeed())
+		deliveries := deliveriesOf(subscription)
+		Expect(deliveries).To(HaveLen(1))
+		Expect(deliveries[0].Trigger).To(Equal("cluster_status:installed"))
+		Expect(swag.StringValue(deliveries[0].Status)).To(Equal(models.SubscriptionDeliveryStatusPending))
+	})
+
+	It("matches events by name and severity", func() {
+		subscription := subscribe(nil, "org1", "", &models.SubscriptionTriggers{
+			EventNames:      pq.StringArray{"host_validation_failed"},
+			EventSeverities: pq.StringArray{models.EventSeverityCritical},
+		})
+		for _, event := range []*models.Event{
+			{ClusterID: &clusterID, Name: "host_validation_failed", Severity: swag.String(models.EventSeverityWarning)},
+			{ClusterID: &clusterID, Name: "cluster_installation_failed", Severity: swag.String(models.EventSeverityCritical)},
+			{ClusterID: &clusterID, Name: "host_validation_fixed", Severity: swag.String(models.EventSeverityInfo)},
+			{ClusterID: &clusterID, Name: "metric", Category: models.EventCategoryMetrics, Severity: swag.String(models.EventSeverityCritical)},
+		} {
+			Expect(writer.Write(context.TODO(), nil, &stream.Envelope{Name: "Event", Payload: event})).To(Succeed())
+		}
+		triggers := []string{}
+		for _, delivery := range deliveriesOf(subscription) {
+			triggers = append(triggers, delivery.Trigger)
+		}
+		Expect(triggers).To(ConsistOf("event:host_validation_failed", "event:cluster_installation_failed"))
+	})
+
+	It("does not deliver changes of clusters the owner may not read", func() {
+		subscription := subscribe(nil, "org1", "", nil)
+		Expect(writer.Write(context.TODO(), nil, clusterState(otherCluster, models.ClusterStatusInstalled, time.Now()))).To(Succeed())
+		hostID := strfmt.UUID(uuid.New().String())
+		Expect(writer.Write(context.TODO(), nil, &stream.Envelope{
+			Name:    "HostState",
+			Payload: &models.Host{ID: &hostID, ClusterID: &otherCluster, Status: swag.String(models.HostStatusKnown)},
+		})).To(Succeed())
+		Expect(deliveriesOf(subscription)).To(BeEmpty())
+
+		Expect(writer.Write(context.TODO(), nil, &stream.Envelope{
+			Name:    "HostState",
+			Payload: &models.Host{ID: &hostID, ClusterID: &clusterID, Status: swag.String(models.HostStatusKnown)},
+		})).To(Succeed())
+		Expect(deliveriesOf(subscription)).To(HaveLen(1))
+	})
+
+	It("posts signed deliveries", func() {
+		subscription := subscribe(&clusterID, "org1", "secret", nil)
+		Expect(writer.Write(context.TODO(), nil, clusterState(clusterID, models.ClusterStatusInstalled, time.Now()))).To(Succeed())
+		dispatcher.Deliver()
+
+		var request *http.Request
+		var body []byte
+		Eventually(requests).Should(Receive(&request))
+		Eventually(bodies).Should(Receive(&body))
+		timestamp := request.Header.Get(stream.WebhookTimestampHeader)
+		Expect(request.Header.Get(stream.WebhookSignatureHeader)).To(Equal(stream.SignWebhookPayload("secret", timestamp, body)))
+		Expect(request.Header.Get(stream.WebhookEventHeader)).To(Equal("cluster_status:installed"))
+
+		var decoded deliveryBody
+		Expect(json.Unmarshal(body, &decoded)).To(Succeed())
+		Expect(decoded.SubscriptionID).To(Equal(*subscription.ID))
+		Expect(decoded.Name).To(Equal("ClusterState"))
+
+		deliveries := deliveriesOf(subscription)
+		Expect(deliveries).To(HaveLen(1))
+		Expect(request.Header.Get(stream.WebhookDeliveryHeader)).To(Equal(deliveries[0].ID.String()))
+		Expect(swag.StringValue(deliveries[0].Status)).To(Equal(models.SubscriptionDeliveryStatusDelivered))
+		Expect(deliveries[0].AttemptLog).To(HaveLen(1))
+		Expect(deliveries[0].AttemptLog[0].StatusCode).To(BeEquivalentTo(http.StatusOK))
+	})
+
+	It("retries server errors with backoff until the maximal number of attempts", func() {
+		atomic.StoreInt32(&responseCode, http.StatusServiceUnavailable)
+		subscription := subscribe(&clusterID, "org1", "", nil)
+		Expect(writer.Write(context.TODO(), nil, clusterState(clusterID, models.ClusterStatusInstalled, time.Now()))).To(Succeed())
+
+		for attempt := 1; attempt <= 3; attempt++ {
+			dispatcher.Deliver()
+			deliveries := deliveriesOf(subscription)
+			Expect(deliveries[0].Attempts).To(BeEquivalentTo(attempt))
+			Expect(deliveries[0].AttemptLog).To(HaveLen(attempt))
+			if attempt < 3 {
+				Expect(swag.StringValue(deliveries[0].Status)).To(Equal(models.SubscriptionDeliveryStatusPending))
+				Expect(time.Time(deliveries[0].NextAttemptAt)).To(BeTemporally(">", time.Now()))
+				// skip the backoff
+				Expect(db.Model(&common.SubscriptionDelivery{}).Where("id = ?", deliveries[0].ID.String()).
+					Update("next_attempt_at", time.Now().Add(-time.Second)).Error).ToNot(HaveOccurred())
+			} else {
+				Expect(swag.StringValue(deliveries[0].Status)).To(Equal(models.SubscriptionDeliveryStatusFailed))
+			}
+		}
+	})
+
+	It("does not retry client errors", func() {
+		atomic.StoreInt32(&responseCode, http.StatusBadRequest)
+		subscription := subscribe(&clusterID, "org1", "", nil)
+		Expect(writer.Write(context.TODO(), nil, clusterState(clusterID, models.ClusterStatusInstalled, time.Now()))).To(Succeed())
+		dispatcher.Deliver()
+		deliveries := deliveriesOf(subscription)
+		Expect(swag.StringValue(deliveries[0].Status)).To(Equal(models.SubscriptionDeliveryStatusFailed))
+		Expect(deliveries[0].AttemptLog[0].Error).To(ContainSubstring("400"))
+	})
+
+	It("does not connect to private addresses unless they are allowed", func() {
+		config := testConfig()
+		config.AllowPrivateURLs = false
+		var err error
+		dispatcher, err = NewDispatcher(db, config, nil, logrus.WithField("pkg", "subscriptions"))
+		Expect(err).ToNot(HaveOccurred())
+		subscription := subscribe(&clusterID, "org1", "", nil)
+		Expect(writer.Write(context.TODO(), nil, clusterState(clusterID, models.ClusterStatusInstalled, time.Now()))).To(Succeed())
+		dispatcher.Deliver()
+		Consistently(requests, 100*time.Millisecond).ShouldNot(Receive())
+		deliveries := deliveriesOf(subscription)
+		Expect(swag.StringValue(deliveries[0].Status)).To(Equal(models.SubscriptionDeliveryStatusFailed))
+		Expect(deliveries[0].AttemptLog[0].Error).To(ContainSubstring("not allowed"))
+	})
+
+	It("does not post deliveries of disabled subscriptions", func() {
+		subscription := subscribe(&clusterID, "org1", "", nil)
+		Expect(writer.Write(context.TODO(), nil, clusterState(clusterID, models.ClusterStatusInstalled, time.Now()))).To(Succeed())
+		Expect(db.Model(&common.Subscription{}).Where("id = ?", subscription.ID.String()).Update("enabled", false).Error).ToNot(HaveOccurred())
+		dispatcher.Deliver()
+		Consistently(requests, 100*time.Millisecond).ShouldNot(Receive())
+		Expect(swag.StringValue(deliveriesOf(subscription)[0].Status)).To(Equal(models.SubscriptionDeliveryStatusFailed))
+	})
+})
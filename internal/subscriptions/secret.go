@@ -0,0 +1,71 @@
+package subscriptions
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+)
+
+// secretKeySize is the size of the AES-256 key that encrypts the secrets of the subscriptions
+const secretKeySize = 32
+
+// secretCipher encrypts the secrets of the subscriptions before they are stored, so that they are never persisted in
+// plaintext. The ID of the subscription is authenticated along with its secret, so that the encrypted secret of a
+// subscription can't be copied to another one.
+type secretCipher struct {
+	aead cipher.AEAD
+}
+
+// newSecretCipher returns the cipher of the base64 encoded AES-256 key, or nil if no key is set
+func newSecretCipher(encodedKey string) (*secretCipher, error) {
+	if encodedKey == "" {
+		return nil, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode the subscription secret encryption key")
+	}
+	if len(key) != secretKeySize {
+		return nil, errors.Errorf("the subscription secret encryption key must have %d bytes, the key has %d", secretKeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the cipher")
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the cipher")
+	}
+	return &secretCipher{aead: aead}, nil
+}
+
+// encrypt returns the base64 encoded nonce and ciphertext of the secret of the subscription
+func (c *secretCipher) encrypt(subscriptionID strfmt.UUID, secret string) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", errors.Wrap(err, "failed to generate the nonce")
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(secret), []byte(subscriptionID))
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decrypt returns the secret of the subscription that encrypt returned
+func (c *secretCipher) decrypt(subscriptionID strfmt.UUID, encrypted string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to decode the secret of subscription %s", subscriptionID)
+	}
+	if len(sealed) < c.aead.NonceSize() {
+		return "", errors.Errorf("the secret of subscription %s is too short", subscriptionID)
+	}
+	nonce, ciphertext := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+	secret, err := c.aead.Open(nil, nonce, ciphertext, []byte(subscriptionID))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to decrypt the secret of subscription %s", subscriptionID)
+	}
+	return string(secret), nil
+}
@@ -0,0 +1,53 @@
+package subscriptions
+
+import (
+	"encoding/base64"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("secretCipher", func() {
+	var (
+		secrets        *secretCipher
+		subscriptionID strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		var err error
+		secrets, err = newSecretCipher(testSecretEncryptionKey)
+		Expect(err).ToNot(HaveOccurred())
+		subscriptionID = strfmt.UUID(uuid.New().String())
+	})
+
+	It("decrypts the encrypted secrets", func() {
+		encrypted, err := secrets.encrypt(subscriptionID, "secret")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(encrypted).ToNot(ContainSubstring("secret"))
+		Expect(secrets.decrypt(subscriptionID, encrypted)).To(Equal("secret"))
+
+		other, err := secrets.encrypt(subscriptionID, "secret")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(other).ToNot(Equal(encrypted))
+	})
+
+	It("does not decrypt the secret of another subscription", func() {
+		encrypted, err := secrets.encrypt(subscriptionID, "secret")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = secrets.decrypt(strfmt.UUID(uuid.New().String()), encrypted)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("has no cipher without a key", func() {
+		Expect(newSecretCipher("")).To(BeNil())
+	})
+
+	It("rejects invalid keys", func() {
+		_, err := newSecretCipher("not base64")
+		Expect(err).To(HaveOccurred())
+		_, err = newSecretCipher(base64.StdEncoding.EncodeToString([]byte("short")))
+		Expect(err).To(HaveOccurred())
+	})
+})
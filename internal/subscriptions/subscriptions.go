@@ -0,0 +1,70 @@
+package subscriptions
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	"github.com/pkg/errors"
+)
+
+type Config struct {
+	EnableSubscriptions bool `envconfig:"ENABLE_SUBSCRIPTIONS" default:"false"`
+	// Allows subscribing plain HTTP URLs, which should only be used for testing
+	AllowInsecureURLs bool `envconfig:"SUBSCRIPTIONS_ALLOW_INSECURE_URLS" default:"false"`
+	// Allows subscribing URLs of loopback, private and link-local addresses, which should only be used for testing
+	AllowPrivateURLs  bool          `envconfig:"SUBSCRIPTIONS_ALLOW_PRIVATE_URLS" default:"false"`
+	DeliveryInterval  time.Duration `envconfig:"SUBSCRIPTIONS_DELIVERY_INTERVAL" default:"5s"`
+	DeliveryTimeout   time.Duration `envconfig:"SUBSCRIPTIONS_DELIVERY_TIMEOUT" default:"10s"`
+	DeliveryBatchSize int           `envconfig:"SUBSCRIPTIONS_DELIVERY_BATCH_SIZE" default:"100"`
+	MaxAttempts       int64         `envconfig:"SUBSCRIPTIONS_MAX_ATTEMPTS" default:"8"`
+	RetryBackoff      time.Duration `envconfig:"SUBSCRIPTIONS_RETRY_BACKOFF" default:"30s"`
+	MaxRetryBackoff   time.Duration `envconfig:"SUBSCRIPTIONS_MAX_RETRY_BACKOFF" default:"1h"`
+	// Deliveries and their attempts are deleted once they are older than the retention
+	DeliveryRetention time.Duration `envconfig:"SUBSCRIPTIONS_DELIVERY_RETENTION" default:"168h"`
+	// The base64 encoded AES-256 key that the secrets of the subscriptions are encrypted with. Subscriptions with a
+	// secret cannot be registered when it is not set.
+	SecretEncryptionKey string `envconfig:"SUBSCRIPTIONS_SECRET_ENCRYPTION_KEY" default:""`
+}
+
+const (
+	triggerClusterStatus = "cluster_status"
+	triggerHostStatus    = "host_status"
+	triggerEvent         = "event"
+)
+
+// deliveryBody is the JSON body posted to the subscribed URL
+type deliveryBody struct {
+	DeliveryID     strfmt.UUID  `json:"delivery_id"`
+	SubscriptionID strfmt.UUID  `json:"subscription_id"`
+	Trigger        string       `json:"trigger"`
+	ClusterID      *strfmt.UUID `json:"cluster_id,omitempty"`
+	HostID         *strfmt.UUID `json:"host_id,omitempty"`
+	// Name of the notification, e.g. ClusterState
+	Name    string      `json:"name"`
+	Payload interface{} `json:"payload"`
+}
+
+// ownerContext returns a context that carries the identity of the owner of the subscription, so that the
+// authorizer can verify which clusters the owner may read
+func ownerContext(subscription *common.Subscription) context.Context {
+	payload := &ocm.AuthPayload{
+		Username:     subscription.UserName,
+		Organization: subscription.OrgID,
+		Role:         ocm.UserRole,
+	}
+	return context.WithValue(context.Background(), restapi.AuthKey, payload)
+}
+
+func canRead(ctx context.Context, authz auth.Authorizer, clusterID strfmt.UUID) (bool, error) {
+	canRead, err := authz.HasAccessTo(ctx, &common.Cluster{Cluster: models.Cluster{ID: &clusterID}}, auth.ReadAction)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to check access to cluster %s", clusterID)
+	}
+	return canRead, nil
+}
@@ -0,0 +1,60 @@
+package subscriptions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+)
+
+func TestSubscriptions(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Subscriptions test Suite")
+}
+
+var _ = BeforeSuite(func() {
+	common.InitializeDBTest()
+})
+
+var _ = AfterSuite(func() {
+	common.TerminateDBTest()
+})
+
+// testSecretEncryptionKey is a base64 encoded AES-256 key
+const testSecretEncryptionKey = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+
+func testConfig() Config {
+	return Config{
+		EnableSubscriptions: true,
+		AllowInsecureURLs:   true,
+		AllowPrivateURLs:    true,
+		DeliveryInterval:    time.Second,
+		DeliveryTimeout:     time.Second,
+		DeliveryBatchSize:   10,
+		MaxAttempts:         3,
+		RetryBackoff:        time.Minute,
+		MaxRetryBackoff:     time.Hour,
+		DeliveryRetention:   time.Hour,
+		SecretEncryptionKey: testSecretEncryptionKey,
+	}
+}
+
+func userContext(username, orgID string) context.Context {
+	payload := &ocm.AuthPayload{}
+	payload.Role = ocm.UserRole
+	payload.Username = username
+	payload.Organization = orgID
+	return context.WithValue(context.TODO(), restapi.AuthKey, payload)
+}
+
+func verifyApiError(responder middleware.Responder, expectedHttpStatus int32) {
+	ExpectWithOffset(1, responder).To(BeAssignableToTypeOf(common.NewApiError(expectedHttpStatus, nil)))
+	concreteError := responder.(*common.ApiErrorResponse)
+	ExpectWithOffset(1, concreteError.StatusCode()).To(Equal(expectedHttpStatus))
+}
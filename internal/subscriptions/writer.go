@@ -0,0 +1,213 @@
+package subscriptions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// change is a notification that may trigger deliveries to the subscriptions of its cluster
+type change struct {
+	kind      string
+	value     string
+	severity  string
+	clusterID strfmt.UUID
+	hostID    *strfmt.UUID
+	// Changes with the same dedupe key are delivered only once to each subscription, e.g. since the cluster
+	// state is notified on every update of the cluster, not only when its status changes
+	dedupeKey string
+	// The owner of the cluster, if known from the notification
+	owner *models.Cluster
+}
+
+func (c *change) trigger() string {
+	return fmt.Sprintf("%s:%s", c.kind, c.value)
+}
+
+func (c *change) matches(triggers *models.SubscriptionTriggers) bool {
+	if triggers == nil ||
+		len(triggers.ClusterStatuses)+len(triggers.HostStatuses)+len(triggers.EventNames)+len(triggers.EventSeverities) == 0 {
+		return true
+	}
+	switch c.kind {
+	case triggerClusterStatus:
+		return funk.ContainsString(triggers.ClusterStatuses, c.value)
+	case triggerHostStatus:
+		return funk.ContainsString(triggers.HostStatuses, c.value)
+	case triggerEvent:
+		return funk.ContainsString(triggers.EventNames, c.value) || funk.ContainsString(triggers.EventSeverities, c.severity)
+	}
+	return false
+}
+
+// Writer is a stream writer that creates the deliveries of the subscriptions triggered by the cluster and host
+// state notifications and by the cluster events. The deliveries are sent by the Dispatcher.
+type Writer struct {
+	db    *gorm.DB
+	authz auth.Authorizer
+	log   logrus.FieldLogger
+}
+
+var _ stream.StreamWriter = &Writer{}
+
+func NewWriter(db *gorm.DB, authz auth.Authorizer, log logrus.FieldLogger) *Writer {
+	return &Writer{db: db, authz: authz, log: log}
+}
+
+func (w *Writer) Write(ctx context.Context, key []byte, value interface{}) error {
+	envelope, ok := value.(*stream.Envelope)
+	if !ok {
+		return errors.Errorf("unexpected notification value of type %T", value)
+	}
+	c := toChange(envelope.Payload)
+	if c == nil {
+		return nil
+	}
+	subscriptions, err := w.subscriptionsOf(c)
+	if err != nil {
+		return errors.Wrapf(err, "failed to find the subscriptions of cluster %s", c.clusterID)
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	now := strfmt.DateTime(time.Now())
+	deliveries := make([]*common.SubscriptionDelivery, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		deliveryID := strfmt.UUID(uuid.New().String())
+		body, err := json.Marshal(&deliveryBody{
+			DeliveryID:     deliveryID,
+			SubscriptionID: *subscription.ID,
+			Trigger:        c.trigger(),
+			ClusterID:      &c.clusterID,
+			HostID:         c.hostID,
+			Name:           envelope.Name,
+			Payload:        envelope.Payload,
+		})
+		if err != nil {
+			return err
+		}
+		deliveries = append(deliveries, &common.SubscriptionDelivery{
+			SubscriptionDelivery: models.SubscriptionDelivery{
+				ID:             &deliveryID,
+				SubscriptionID: subscription.ID,
+				Trigger:        c.trigger(),
+				ClusterID:      &c.clusterID,
+				HostID:         c.hostID,
+				Status:         swag.String(models.SubscriptionDeliveryStatusPending),
+				NextAttemptAt:  now,
+				CreatedAt:      now,
+			},
+			DedupeKey: c.dedupeKey,
+			Payload:   string(body),
+		})
+	}
+	err = w.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&deliveries).Error
+	return errors.Wrapf(err, "failed to create the deliveries of %s", c.trigger())
+}
+
+func (w *Writer) Close() {
+}
+
+// subscriptionsOf returns the enabled subscriptions that are triggered by the change and whose owners may
+// read the cluster
+func (w *Writer) subscriptionsOf(c *change) ([]*common.Subscription, error) {
+	owner := c.owner
+	if owner == nil {
+		owner = &models.Cluster{}
+		err := w.db.Model(&common.Cluster{}).Select("user_name", "org_id").Take(owner, "id = ?", c.clusterID.String()).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var candidates []*common.Subscription
+	ownedByClusterOwner := w.db.Where("user_name = ?", owner.UserName)
+	if owner.OrgID != "" {
+		ownedByClusterOwner = ownedByClusterOwner.Or("org_id = ?", owner.OrgID)
+	}
+	err := w.db.Where("enabled = ?", true).
+		Where(w.db.Where("cluster_id = ?", c.clusterID.String()).
+			Or(w.db.Where("cluster_id IS NULL").Where(ownedByClusterOwner))).
+		Find(&candidates).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []*common.Subscription
+	for _, subscription := range candidates {
+		if !c.matches(subscription.Triggers) {
+			continue
+		}
+		allowed, err := canRead(ownerContext(subscription), w.authz, c.clusterID)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			w.log.Debugf("owner of subscription %s may not read cluster %s", subscription.ID, c.clusterID)
+			continue
+		}
+		ret = append(ret, subscription)
+	}
+	return ret, nil
+}
+
+func toChange(payload interface{}) *change {
+	switch p := payload.(type) {
+	case *models.Cluster:
+		if p.ID == nil {
+			return nil
+		}
+		status := swag.StringValue(p.Status)
+		return &change{
+			kind:      triggerClusterStatus,
+			value:     status,
+			clusterID: *p.ID,
+			dedupeKey: fmt.Sprintf("%s:%s:%s:%s", triggerClusterStatus, p.ID, status, p.StatusUpdatedAt),
+			owner:     p,
+		}
+	case *models.Host:
+		if p.ID == nil || p.ClusterID == nil {
+			return nil
+		}
+		status := swag.StringValue(p.Status)
+		return &change{
+			kind:      triggerHostStatus,
+			value:     status,
+			clusterID: *p.ClusterID,
+			hostID:    p.ID,
+			dedupeKey: fmt.Sprintf("%s:%s:%s:%s", triggerHostStatus, p.ID, status, p.StatusUpdatedAt),
+		}
+	case *models.Event:
+		// Metrics events are internal and are not visible to the users
+		if p.ClusterID == nil || p.Category == models.EventCategoryMetrics {
+			return nil
+		}
+		return &change{
+			kind:      triggerEvent,
+			value:     p.Name,
+			severity:  swag.StringValue(p.Severity),
+			clusterID: *p.ClusterID,
+			hostID:    p.HostID,
+			dedupeKey: fmt.Sprintf("%s:%s", triggerEvent, uuid.New().String()),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package uservalidations
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/itchyny/gojq"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/jq"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+)
+
+const (
+	// TargetHost is the target of validations that are evaluated for each host
+	TargetHost = common.ValidationTypeHost
+	// TargetCluster is the target of validations that are evaluated for each cluster
+	TargetCluster = common.ValidationTypeCluster
+
+	// Category is the category of the user-defined validations in the validations info of hosts and clusters
+	Category = "user-defined"
+)
+
+var idRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// Validation is a validation that is defined by the administrator of the service as a jq expression
+type Validation struct {
+	// ID identifies the validation in the validations info and in the ignored validations
+	ID string `json:"id"`
+	// Target is either host or cluster
+	Target string `json:"target"`
+	// Expression is a jq expression that returns true when the validation passes
+	Expression string `json:"expression"`
+	// SuccessMessage is the message of the validation when it passes
+	SuccessMessage string `json:"success_message,omitempty"`
+	// FailureMessage is the message of the validation when it fails
+	FailureMessage string `json:"failure_message,omitempty"`
+
+	query *jq.Query
+}
+
+// Validations are the user-defined validations of the service, they are decoded from a JSON list by envconfig
+type Validations []*Validation
+
+func (v *Validations) Decode(value string) error {
+	validations := Validations{}
+	if strings.TrimSpace(value) != "" {
+		if err := json.Unmarshal([]byte(value), &validations); err != nil {
+			return errors.Wrap(err, "failed to parse the user-defined validations")
+		}
+	}
+	ids := make(map[string]bool)
+	for _, validation := range validations {
+		if err := validation.complete(); err != nil {
+			return errors.Wrapf(err, "invalid user-defined validation %s", validation.ID)
+		}
+		key := validation.Target + "/" + validation.ID
+		if ids[key] {
+			return errors.Errorf("%s validation %s is defined more than once", validation.Target, validation.ID)
+		}
+		ids[key] = true
+	}
+	*v = validations
+	return nil
+}
+
+func (v *Validation) complete() error {
+	if !idRegex.MatchString(v.ID) {
+		return errors.New("the ID must consist of lower case alphanumeric characters or '-'")
+	}
+	switch v.Target {
+	case TargetHost:
+		if models.NewHostValidationID(models.HostValidationID(v.ID)).Validate(nil) == nil {
+			return errors.New("the ID is the ID of a built-in host validation")
+		}
+	case TargetCluster:
+		if models.NewClusterValidationID(models.ClusterValidationID(v.ID)).Validate(nil) == nil {
+			return errors.New("the ID is the ID of a built-in cluster validation")
+		}
+	default:
+		return errors.Errorf("the target must be %s or %s", TargetHost, TargetCluster)
+	}
+	if v.ID == "all" {
+		return errors.New("the ID all is reserved for ignoring all the validations")
+	}
+	if _, err := gojq.Parse(v.Expression); err != nil {
+		return errors.Wrap(err, "failed to parse the expression")
+	}
+	if v.SuccessMessage == "" {
+		v.SuccessMessage = fmt.Sprintf("The %s validation passed", v.ID)
+	}
+	if v.FailureMessage == "" {
+		v.FailureMessage = fmt.Sprintf("The %s validation failed", v.ID)
+	}
+	return nil
+}
+
+// Compile compiles the expressions of the validations, it must be called before they are evaluated
+func (v Validations) Compile(log *logrus.Logger) error {
+	tool, err := jq.NewTool().SetLogger(log).Build()
+	if err != nil {
+		return errors.Wrap(err, "failed to create the jq tool")
+	}
+	for _, validation := range v {
+		if validation.query, err = tool.Compile(validation.Expression); err != nil {
+			return errors.Wrapf(err, "failed to compile the expression of user-defined validation %s", validation.ID)
+		}
+	}
+	return nil
+}
+
+// ForTarget returns the validations of the given target
+func (v Validations) ForTarget(target string) Validations {
+	return funk.Filter(v, func(validation *Validation) bool { return validation.Target == target }).([]*Validation)
+}
+
+// IDs returns the IDs of the validations of the given target
+func (v Validations) IDs(target string) []string {
+	return funk.Map(v.ForTarget(target), func(validation *Validation) string { return validation.ID }).([]string)
+}
+
+// Evaluate evaluates the expression of the validation, which must return a boolean, on the given input
+func (v *Validation) Evaluate(input any) (bool, error) {
+	if v.query == nil {
+		return false, errors.Errorf("the expression of user-defined validation %s isn't compiled", v.ID)
+	}
+	var passed bool
+	if err := v.query.Evaluate(input, &passed); err != nil {
+		return false, errors.Wrapf(err, "failed to evaluate the expression of user-defined validation %s", v.ID)
+	}
+	return passed, nil
+}
+
+// HostInput is the input of the expressions of host validations. The inventory of the host is parsed so that
+// expressions can refer to its fields
+type HostInput struct {
+	Host      *models.Host      `json:"host"`
+	Inventory *models.Inventory `json:"inventory"`
+	Cluster   *models.Cluster   `json:"cluster,omitempty"`
+}
+
+// ClusterInput is the input of the expressions of cluster validations
+type ClusterInput struct {
+	Cluster *models.Cluster `json:"cluster"`
+	Hosts   []*HostInput    `json:"hosts"`
+}
+
+// NewHostInput returns the input of the expressions of host validations, the cluster may be nil for hosts that
+// aren't bound to a cluster
+func NewHostInput(host *models.Host, cluster *common.Cluster) (*HostInput, error) {
+	ret := &HostInput{
+		Host:    host,
+		Cluster: withoutHosts(cluster),
+	}
+	if host.Inventory != "" {
+		inventory, err := common.UnmarshalInventory(host.Inventory)
+		if err != nil {
+			return nil, err
+		}
+		ret.Inventory = inventory
+	}
+	return ret, nil
+}
+
+// NewClusterInput returns the input of the expressions of cluster validations
+func NewClusterInput(cluster *common.Cluster) (*ClusterInput, error) {
+	ret := &ClusterInput{
+		Cluster: withoutHosts(cluster),
+		Hosts:   make([]*HostInput, 0, len(cluster.Hosts)),
+	}
+	for _, host := range cluster.Hosts {
+		input, err := NewHostInput(host, nil)
+		if err != nil {
+			return nil, err
+		}
+		ret.Hosts = append(ret.Hosts, input)
+	}
+	return ret, nil
+}
+
+// withoutHosts returns a copy of the cluster without its hosts, which are part of the input on their own
+func withoutHosts(cluster *common.Cluster) *models.Cluster {
+	if cluster == nil {
+		return nil
+	}
+	ret := cluster.Cluster
+	ret.Hosts = nil
+	return &ret
+}
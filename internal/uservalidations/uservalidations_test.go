@@ -0,0 +1,123 @@
+package uservalidations
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("Decode", func() {
+	It("accepts an empty value", func() {
+		var validations Validations
+		Expect(validations.Decode("")).To(Succeed())
+		Expect(validations).To(BeEmpty())
+	})
+
+	It("sets the default messages", func() {
+		var validations Validations
+		Expect(validations.Decode(`[{"id": "two-nics", "target": "host", "expression": "(.inventory.interfaces | length) >= 2"},
+			{"id": "two-nics", "target": "cluster", "expression": "true", "failure_message": "Not enough NICs"}]`)).To(Succeed())
+		Expect(validations).To(HaveLen(2))
+		Expect(validations[0].SuccessMessage).To(Equal("The two-nics validation passed"))
+		Expect(validations[0].FailureMessage).To(Equal("The two-nics validation failed"))
+		Expect(validations[1].FailureMessage).To(Equal("Not enough NICs"))
+		Expect(validations.IDs(TargetHost)).To(Equal([]string{"two-nics"}))
+		Expect(validations.ForTarget(TargetCluster)).To(Equal(Validations{validations[1]}))
+	})
+
+	DescribeTable("rejects invalid validations",
+		func(value string) {
+			var validations Validations
+			Expect(validations.Decode(value)).ToNot(Succeed())
+		},
+		Entry("invalid JSON", `[{"id": `),
+		Entry("invalid ID", `[{"id": "Two_NICs", "target": "host", "expression": "true"}]`),
+		Entry("reserved ID", `[{"id": "all", "target": "host", "expression": "true"}]`),
+		Entry("built-in host validation", `[{"id": "has-inventory", "target": "host", "expression": "true"}]`),
+		Entry("built-in cluster validation", `[{"id": "api-vips-defined", "target": "cluster", "expression": "true"}]`),
+		Entry("invalid target", `[{"id": "two-nics", "target": "infra-env", "expression": "true"}]`),
+		Entry("invalid expression", `[{"id": "two-nics", "target": "host", "expression": ".inventory | ("}]`),
+		Entry("duplicate ID", `[{"id": "two-nics", "target": "host", "expression": "true"}, {"id": "two-nics", "target": "host", "expression": "false"}]`),
+	)
+})
+
+var _ = Describe("Evaluate", func() {
+	compile := func(target, expression string) *Validation {
+		var validations Validations
+		value, err := json.Marshal([]map[string]string{{"id": "test", "target": target, "expression": expression}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(validations.Decode(string(value))).To(Succeed())
+		Expect(validations.Compile(logrus.New())).To(Succeed())
+		return validations[0]
+	}
+
+	hostWithDisks := func(role models.HostRole, sizes ...int64) *models.Host {
+		inventory := models.Inventory{SystemVendor: &models.SystemVendor{Manufacturer: "Acme", ProductName: "R" + string(role)}}
+		for _, size := range sizes {
+			inventory.Disks = append(inventory.Disks, &models.Disk{SizeBytes: size})
+		}
+		b, err := json.Marshal(&inventory)
+		Expect(err).ToNot(HaveOccurred())
+		return &models.Host{Role: role, Inventory: string(b)}
+	}
+
+	It("fails when the validation isn't compiled", func() {
+		_, err := (&Validation{ID: "test"}).Evaluate(map[string]any{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("evaluates host expressions over the parsed inventory", func() {
+		validation := compile(TargetHost, "all(.inventory.disks[]; .size_bytes >= 500000000000)")
+		input, err := NewHostInput(hostWithDisks(models.HostRoleWorker, 600000000000), nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(validation.Evaluate(input)).To(BeTrue())
+
+		input, err = NewHostInput(hostWithDisks(models.HostRoleWorker, 600000000000, 100000000000), nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(validation.Evaluate(input)).To(BeFalse())
+	})
+
+	It("exposes the cluster to host expressions", func() {
+		validation := compile(TargetHost, `.cluster.name == "example"`)
+		input, err := NewHostInput(hostWithDisks(models.HostRoleMaster), &common.Cluster{Cluster: models.Cluster{Name: "example"}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(validation.Evaluate(input)).To(BeTrue())
+	})
+
+	It("evaluates cluster expressions over the hosts", func() {
+		validation := compile(TargetCluster, `[.hosts[] | select(.host.role == "master") | .inventory.system_vendor.product_name] | unique | length <= 1`)
+		cluster := &common.Cluster{Cluster: models.Cluster{Hosts: []*models.Host{
+			hostWithDisks(models.HostRoleMaster),
+			hostWithDisks(models.HostRoleMaster),
+			hostWithDisks(models.HostRoleWorker),
+		}}}
+		input, err := NewClusterInput(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(input.Cluster.Hosts).To(BeEmpty())
+		Expect(validation.Evaluate(input)).To(BeTrue())
+
+		cluster.Hosts[1].Inventory = `{"system_vendor": {"product_name": "Other"}}`
+		input, err = NewClusterInput(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(validation.Evaluate(input)).To(BeFalse())
+	})
+
+	It("fails when the expression doesn't return a boolean", func() {
+		validation := compile(TargetHost, ".inventory.disks | length")
+		input, err := NewHostInput(hostWithDisks(models.HostRoleWorker, 600000000000), nil)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = validation.Evaluate(input)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+func TestUserValidations(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "User-defined validations Tests")
+}
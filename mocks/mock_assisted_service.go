@@ -288,6 +288,34 @@ func (mr *MockInstallerAPIMockRecorder) UpdateInfraEnv(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInfraEnv", reflect.TypeOf((*MockInstallerAPI)(nil).UpdateInfraEnv), arg0, arg1)
 }
 
+// V2AcceptHostHardware mocks base method.
+func (m *MockInstallerAPI) V2AcceptHostHardware(arg0 context.Context, arg1 installer.V2AcceptHostHardwareParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2AcceptHostHardware", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2AcceptHostHardware indicates an expected call of V2AcceptHostHardware.
+func (mr *MockInstallerAPIMockRecorder) V2AcceptHostHardware(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2AcceptHostHardware", reflect.TypeOf((*MockInstallerAPI)(nil).V2AcceptHostHardware), arg0, arg1)
+}
+
+// V2BulkUpdateHosts mocks base method.
+func (m *MockInstallerAPI) V2BulkUpdateHosts(arg0 context.Context, arg1 installer.V2BulkUpdateHostsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2BulkUpdateHosts", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2BulkUpdateHosts indicates an expected call of V2BulkUpdateHosts.
+func (mr *MockInstallerAPIMockRecorder) V2BulkUpdateHosts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2BulkUpdateHosts", reflect.TypeOf((*MockInstallerAPI)(nil).V2BulkUpdateHosts), arg0, arg1)
+}
+
 // V2CancelInstallation mocks base method.
 func (m *MockInstallerAPI) V2CancelInstallation(arg0 context.Context, arg1 installer.V2CancelInstallationParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -414,6 +442,20 @@ func (mr *MockInstallerAPIMockRecorder) V2DownloadInfraEnvFiles(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DownloadInfraEnvFiles", reflect.TypeOf((*MockInstallerAPI)(nil).V2DownloadInfraEnvFiles), arg0, arg1)
 }
 
+// V2DryRunInstallCluster mocks base method.
+func (m *MockInstallerAPI) V2DryRunInstallCluster(arg0 context.Context, arg1 installer.V2DryRunInstallClusterParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2DryRunInstallCluster", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2DryRunInstallCluster indicates an expected call of V2DryRunInstallCluster.
+func (mr *MockInstallerAPIMockRecorder) V2DryRunInstallCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DryRunInstallCluster", reflect.TypeOf((*MockInstallerAPI)(nil).V2DryRunInstallCluster), arg0, arg1)
+}
+
 // V2GetCluster mocks base method.
 func (m *MockInstallerAPI) V2GetCluster(arg0 context.Context, arg1 installer.V2GetClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -456,6 +498,20 @@ func (mr *MockInstallerAPIMockRecorder) V2GetClusterInstallConfig(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetClusterInstallConfig", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetClusterInstallConfig), arg0, arg1)
 }
 
+// V2GetClusterNetworkTopology mocks base method.
+func (m *MockInstallerAPI) V2GetClusterNetworkTopology(arg0 context.Context, arg1 installer.V2GetClusterNetworkTopologyParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetClusterNetworkTopology", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetClusterNetworkTopology indicates an expected call of V2GetClusterNetworkTopology.
+func (mr *MockInstallerAPIMockRecorder) V2GetClusterNetworkTopology(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetClusterNetworkTopology", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetClusterNetworkTopology), arg0, arg1)
+}
+
 // V2GetClusterUISettings mocks base method.
 func (m *MockInstallerAPI) V2GetClusterUISettings(arg0 context.Context, arg1 installer.V2GetClusterUISettingsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -638,6 +694,20 @@ func (mr *MockInstallerAPIMockRecorder) V2ListClusters(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListClusters", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListClusters), arg0, arg1)
 }
 
+// V2ListHostHardwareRevisions mocks base method.
+func (m *MockInstallerAPI) V2ListHostHardwareRevisions(arg0 context.Context, arg1 installer.V2ListHostHardwareRevisionsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ListHostHardwareRevisions", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ListHostHardwareRevisions indicates an expected call of V2ListHostHardwareRevisions.
+func (mr *MockInstallerAPIMockRecorder) V2ListHostHardwareRevisions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListHostHardwareRevisions", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListHostHardwareRevisions), arg0, arg1)
+}
+
 // V2ListHosts mocks base method.
 func (m *MockInstallerAPI) V2ListHosts(arg0 context.Context, arg1 installer.V2ListHostsParams) middleware.Responder {
 	m.ctrl.T.Helper()
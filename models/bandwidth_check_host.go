@@ -0,0 +1,93 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BandwidthCheckHost bandwidth check host
+//
+// swagger:model bandwidth_check_host
+type BandwidthCheckHost struct {
+
+	// host id
+	// Required: true
+	// Format: uuid
+	HostID *strfmt.UUID `json:"host_id"`
+
+	// The address of the host to measure the bandwidth to.
+	// Required: true
+	IPAddress *string `json:"ip_address"`
+}
+
+// Validate validates this bandwidth check host
+func (m *BandwidthCheckHost) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIPAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BandwidthCheckHost) validateHostID(formats strfmt.Registry) error {
+
+	if err := validate.Required("host_id", "body", m.HostID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("host_id", "body", "uuid", m.HostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BandwidthCheckHost) validateIPAddress(formats strfmt.Registry) error {
+
+	if err := validate.Required("ip_address", "body", m.IPAddress); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this bandwidth check host based on context it is used
+func (m *BandwidthCheckHost) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BandwidthCheckHost) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BandwidthCheckHost) UnmarshalBinary(b []byte) error {
+	var res BandwidthCheckHost
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
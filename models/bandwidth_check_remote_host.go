@@ -0,0 +1,86 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BandwidthCheckRemoteHost bandwidth check remote host
+//
+// swagger:model bandwidth_check_remote_host
+type BandwidthCheckRemoteHost struct {
+
+	// The reason the bandwidth couldn't be measured.
+	Error string `json:"error,omitempty"`
+
+	// host id
+	// Format: uuid
+	HostID strfmt.UUID `json:"host_id,omitempty"`
+
+	// ip address
+	IPAddress string `json:"ip_address,omitempty"`
+
+	// Whether the bandwidth to the host was measured.
+	Successful bool `json:"successful,omitempty"`
+
+	// The measured throughput to the host, in Mbps.
+	ThroughputMbps float64 `json:"throughput_mbps,omitempty"`
+}
+
+// Validate validates this bandwidth check remote host
+func (m *BandwidthCheckRemoteHost) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BandwidthCheckRemoteHost) validateHostID(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("host_id", "body", "uuid", m.HostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this bandwidth check remote host based on context it is used
+func (m *BandwidthCheckRemoteHost) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BandwidthCheckRemoteHost) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BandwidthCheckRemoteHost) UnmarshalBinary(b []byte) error {
+	var res BandwidthCheckRemoteHost
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
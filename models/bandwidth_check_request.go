@@ -0,0 +1,146 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BandwidthCheckRequest bandwidth check request
+//
+// swagger:model bandwidth_check_request
+type BandwidthCheckRequest struct {
+
+	// The duration of the measurement to each host.
+	// Required: true
+	// Maximum: 60
+	// Minimum: 1
+	DurationSeconds *int64 `json:"duration_seconds"`
+
+	// The hosts to measure the bandwidth to.
+	// Required: true
+	Hosts []*BandwidthCheckHost `json:"hosts"`
+}
+
+// Validate validates this bandwidth check request
+func (m *BandwidthCheckRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDurationSeconds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHosts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BandwidthCheckRequest) validateDurationSeconds(formats strfmt.Registry) error {
+
+	if err := validate.Required("duration_seconds", "body", m.DurationSeconds); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("duration_seconds", "body", *m.DurationSeconds, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("duration_seconds", "body", *m.DurationSeconds, 60, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BandwidthCheckRequest) validateHosts(formats strfmt.Registry) error {
+
+	if err := validate.Required("hosts", "body", m.Hosts); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Hosts); i++ {
+		if swag.IsZero(m.Hosts[i]) { // not required
+			continue
+		}
+
+		if m.Hosts[i] != nil {
+			if err := m.Hosts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hosts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("hosts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this bandwidth check request based on the context it is used
+func (m *BandwidthCheckRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateHosts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BandwidthCheckRequest) contextValidateHosts(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Hosts); i++ {
+
+		if m.Hosts[i] != nil {
+			if err := m.Hosts[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hosts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("hosts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BandwidthCheckRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BandwidthCheckRequest) UnmarshalBinary(b []byte) error {
+	var res BandwidthCheckRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
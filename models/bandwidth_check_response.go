@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// BandwidthCheckResponse bandwidth check response
+//
+// swagger:model bandwidth_check_response
+type BandwidthCheckResponse struct {
+
+	// remote hosts
+	RemoteHosts []*BandwidthCheckRemoteHost `json:"remote_hosts"`
+}
+
+// Validate validates this bandwidth check response
+func (m *BandwidthCheckResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRemoteHosts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BandwidthCheckResponse) validateRemoteHosts(formats strfmt.Registry) error {
+	if swag.IsZero(m.RemoteHosts) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.RemoteHosts); i++ {
+		if swag.IsZero(m.RemoteHosts[i]) { // not required
+			continue
+		}
+
+		if m.RemoteHosts[i] != nil {
+			if err := m.RemoteHosts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("remote_hosts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("remote_hosts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this bandwidth check response based on the context it is used
+func (m *BandwidthCheckResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateRemoteHosts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BandwidthCheckResponse) contextValidateRemoteHosts(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.RemoteHosts); i++ {
+
+		if m.RemoteHosts[i] != nil {
+			if err := m.RemoteHosts[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("remote_hosts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("remote_hosts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BandwidthCheckResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BandwidthCheckResponse) UnmarshalBinary(b []byte) error {
+	var res BandwidthCheckResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// Bios bios
+//
+// swagger:model bios
+type Bios struct {
+
+	// release date
+	ReleaseDate string `json:"release_date,omitempty"`
+
+	// vendor
+	Vendor string `json:"vendor,omitempty"`
+
+	// version
+	Version string `json:"version,omitempty"`
+}
+
+// Validate validates this bios
+func (m *Bios) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this bios based on context it is used
+func (m *Bios) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Bios) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Bios) UnmarshalBinary(b []byte) error {
+	var res Bios
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
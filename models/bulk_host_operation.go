@@ -0,0 +1,250 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BulkHostOperation An operation on a host, identified by its ID or by the MAC address of one of its interfaces.
+//
+// swagger:model bulk-host-operation
+type BulkHostOperation struct {
+
+	// action
+	// Required: true
+	// Enum: [bind unbind set-role set-hostname set-installation-disk reset-validation approve]
+	Action *string `json:"action"`
+
+	// Whether the host is approved, for approve.
+	Approved *bool `json:"approved,omitempty"`
+
+	// The cluster that the host is bound to, for bind.
+	// Format: uuid
+	ClusterID *strfmt.UUID `json:"cluster_id,omitempty"`
+
+	// host id
+	// Format: uuid
+	HostID *strfmt.UUID `json:"host_id,omitempty"`
+
+	// The host name, for set-hostname.
+	HostName *string `json:"host_name,omitempty"`
+
+	// The role of the host, for set-role.
+	// Enum: [auto-assign master arbiter worker]
+	HostRole *string `json:"host_role,omitempty"`
+
+	// The id of the disk the host is installed on, for set-installation-disk.
+	InstallationDiskID *string `json:"installation_disk_id,omitempty"`
+
+	// mac address
+	// Pattern: ^([0-9A-Fa-f]{2}[:-]){5}([0-9A-Fa-f]{2})$
+	MacAddress *string `json:"mac_address,omitempty"`
+
+	// The validation to reset, for reset-validation.
+	ValidationID *string `json:"validation_id,omitempty"`
+}
+
+// Validate validates this bulk host operation
+func (m *BulkHostOperation) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHostRole(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMacAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var bulkHostOperationTypeActionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["bind","unbind","set-role","set-hostname","set-installation-disk","reset-validation","approve"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		bulkHostOperationTypeActionPropEnum = append(bulkHostOperationTypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// BulkHostOperationActionBind captures enum value "bind"
+	BulkHostOperationActionBind string = "bind"
+
+	// BulkHostOperationActionUnbind captures enum value "unbind"
+	BulkHostOperationActionUnbind string = "unbind"
+
+	// BulkHostOperationActionSetRole captures enum value "set-role"
+	BulkHostOperationActionSetRole string = "set-role"
+
+	// BulkHostOperationActionSetHostname captures enum value "set-hostname"
+	BulkHostOperationActionSetHostname string = "set-hostname"
+
+	// BulkHostOperationActionSetInstallationDisk captures enum value "set-installation-disk"
+	BulkHostOperationActionSetInstallationDisk string = "set-installation-disk"
+
+	// BulkHostOperationActionResetValidation captures enum value "reset-validation"
+	BulkHostOperationActionResetValidation string = "reset-validation"
+
+	// BulkHostOperationActionApprove captures enum value "approve"
+	BulkHostOperationActionApprove string = "approve"
+)
+
+// prop value enum
+func (m *BulkHostOperation) validateActionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, bulkHostOperationTypeActionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *BulkHostOperation) validateAction(formats strfmt.Registry) error {
+
+	if err := validate.Required("action", "body", m.Action); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateActionEnum("action", "body", *m.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BulkHostOperation) validateClusterID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ClusterID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("cluster_id", "body", "uuid", m.ClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BulkHostOperation) validateHostID(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("host_id", "body", "uuid", m.HostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var bulkHostOperationTypeHostRolePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["auto-assign","master","arbiter","worker"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		bulkHostOperationTypeHostRolePropEnum = append(bulkHostOperationTypeHostRolePropEnum, v)
+	}
+}
+
+const (
+
+	// BulkHostOperationHostRoleAutoAssign captures enum value "auto-assign"
+	BulkHostOperationHostRoleAutoAssign string = "auto-assign"
+
+	// BulkHostOperationHostRoleMaster captures enum value "master"
+	BulkHostOperationHostRoleMaster string = "master"
+
+	// BulkHostOperationHostRoleArbiter captures enum value "arbiter"
+	BulkHostOperationHostRoleArbiter string = "arbiter"
+
+	// BulkHostOperationHostRoleWorker captures enum value "worker"
+	BulkHostOperationHostRoleWorker string = "worker"
+)
+
+// prop value enum
+func (m *BulkHostOperation) validateHostRoleEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, bulkHostOperationTypeHostRolePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *BulkHostOperation) validateHostRole(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostRole) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateHostRoleEnum("host_role", "body", *m.HostRole); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BulkHostOperation) validateMacAddress(formats strfmt.Registry) error {
+	if swag.IsZero(m.MacAddress) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("mac_address", "body", *m.MacAddress, `^([0-9A-Fa-f]{2}[:-]){5}([0-9A-Fa-f]{2})$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this bulk host operation based on context it is used
+func (m *BulkHostOperation) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BulkHostOperation) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BulkHostOperation) UnmarshalBinary(b []byte) error {
+	var res BulkHostOperation
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BulkHostOperationResult bulk host operation result
+//
+// swagger:model bulk-host-operation-result
+type BulkHostOperationResult struct {
+
+	// The HTTP status code of the equivalent single host call, if the operation failed.
+	Code int64 `json:"code,omitempty"`
+
+	// The host that the operation applied to. Not set if the host was not found.
+	// Format: uuid
+	HostID strfmt.UUID `json:"host_id,omitempty"`
+
+	// The position of the operation in the request.
+	// Required: true
+	Index *int64 `json:"index"`
+
+	// The reason of the failure.
+	Reason string `json:"reason,omitempty"`
+
+	// not-applied operations were rolled back or skipped because another operation of the transaction failed.
+	// Required: true
+	// Enum: [succeeded failed not-applied]
+	Status *string `json:"status"`
+}
+
+// Validate validates this bulk host operation result
+func (m *BulkHostOperationResult) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIndex(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BulkHostOperationResult) validateHostID(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("host_id", "body", "uuid", m.HostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BulkHostOperationResult) validateIndex(formats strfmt.Registry) error {
+
+	if err := validate.Required("index", "body", m.Index); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var bulkHostOperationResultTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["succeeded","failed","not-applied"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		bulkHostOperationResultTypeStatusPropEnum = append(bulkHostOperationResultTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// BulkHostOperationResultStatusSucceeded captures enum value "succeeded"
+	BulkHostOperationResultStatusSucceeded string = "succeeded"
+
+	// BulkHostOperationResultStatusFailed captures enum value "failed"
+	BulkHostOperationResultStatusFailed string = "failed"
+
+	// BulkHostOperationResultStatusNotApplied captures enum value "not-applied"
+	BulkHostOperationResultStatusNotApplied string = "not-applied"
+)
+
+// prop value enum
+func (m *BulkHostOperationResult) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, bulkHostOperationResultTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *BulkHostOperationResult) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this bulk host operation result based on context it is used
+func (m *BulkHostOperationResult) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BulkHostOperationResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BulkHostOperationResult) UnmarshalBinary(b []byte) error {
+	var res BulkHostOperationResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,131 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BulkHostOperations bulk host operations
+//
+// swagger:model bulk-host-operations
+type BulkHostOperations struct {
+
+	// operations
+	// Required: true
+	// Max Items: 1000
+	// Min Items: 1
+	Operations []*BulkHostOperation `json:"operations"`
+}
+
+// Validate validates this bulk host operations
+func (m *BulkHostOperations) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateOperations(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BulkHostOperations) validateOperations(formats strfmt.Registry) error {
+
+	if err := validate.Required("operations", "body", m.Operations); err != nil {
+		return err
+	}
+
+	iOperationsSize := int64(len(m.Operations))
+
+	if err := validate.MinItems("operations", "body", iOperationsSize, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxItems("operations", "body", iOperationsSize, 1000); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Operations); i++ {
+		if swag.IsZero(m.Operations[i]) { // not required
+			continue
+		}
+
+		if m.Operations[i] != nil {
+			if err := m.Operations[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("operations" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("operations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this bulk host operations based on the context it is used
+func (m *BulkHostOperations) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateOperations(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BulkHostOperations) contextValidateOperations(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Operations); i++ {
+
+		if m.Operations[i] != nil {
+			if err := m.Operations[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("operations" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("operations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BulkHostOperations) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BulkHostOperations) UnmarshalBinary(b []byte) error {
+	var res BulkHostOperations
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
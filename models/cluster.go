@@ -36,6 +36,9 @@ type Cluster struct {
 	// The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.
 	APIVips []*APIVip `json:"api_vips" gorm:"foreignkey:ClusterID;references:ID"`
 
+	// Measure the network bandwidth between the hosts of the cluster and validate it against the minimum bandwidth of their role.
+	BandwidthCheckEnabled *bool `json:"bandwidth_check_enabled,omitempty" gorm:"default:false"`
+
 	// Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.
 	BaseDNSDomain string `json:"base_dns_domain,omitempty"`
 
@@ -88,6 +91,9 @@ type Cluster struct {
 	// JSON-formatted string containing the usage information by feature name
 	FeatureUsage string `json:"feature_usage,omitempty" gorm:"type:text"`
 
+	// JSON formatted firmware policy that the hosts of the cluster must comply with.
+	FirmwarePolicy *string `json:"firmware_policy,omitempty" gorm:"type:text"`
+
 	// (DEPRECATED) Please use 'control_plane_count' instead. Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster
 	// over multiple master nodes whereas 'None' installs a full cluster over one node.
 	//
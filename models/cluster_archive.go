@@ -0,0 +1,404 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClusterArchive The definition of a cluster, exported to be imported by another service.
+//
+// swagger:model cluster-archive
+type ClusterArchive struct {
+
+	// Properties of cluster-create-params. The pull secret and the SSH public key are in the secrets.
+	// Required: true
+	Cluster interface{} `json:"cluster"`
+
+	// exported at
+	// Format: date-time
+	ExportedAt strfmt.DateTime `json:"exported_at,omitempty"`
+
+	// The version of the format of the archive.
+	// Required: true
+	FormatVersion *int64 `json:"format_version"`
+
+	// The properties of the hosts of the cluster, identified by MAC address so that they can be applied to the same hosts when they register with the imported cluster.
+	Hosts []*ClusterPlanHost `json:"hosts"`
+
+	// ignored validations
+	IgnoredValidations *IgnoredValidations `json:"ignored_validations,omitempty"`
+
+	// infra envs
+	InfraEnvs []*ClusterArchiveInfraEnv `json:"infra_envs"`
+
+	// The custom manifests of the cluster.
+	Manifests []*CreateManifestParams `json:"manifests"`
+
+	// secrets
+	// Required: true
+	Secrets *ClusterArchiveSecrets `json:"secrets"`
+
+	// The ID of the exported cluster.
+	// Required: true
+	// Format: uuid
+	SourceClusterID *strfmt.UUID `json:"source_cluster_id"`
+
+	// The settings of the cluster in the UI.
+	UISettings string `json:"ui_settings,omitempty"`
+}
+
+// Validate validates this cluster archive
+func (m *ClusterArchive) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCluster(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExportedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFormatVersion(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHosts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIgnoredValidations(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInfraEnvs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateManifests(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSecrets(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSourceClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterArchive) validateCluster(formats strfmt.Registry) error {
+
+	if m.Cluster == nil {
+		return errors.Required("cluster", "body", nil)
+	}
+
+	return nil
+}
+
+func (m *ClusterArchive) validateExportedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.ExportedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("exported_at", "body", "date-time", m.ExportedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterArchive) validateFormatVersion(formats strfmt.Registry) error {
+
+	if err := validate.Required("format_version", "body", m.FormatVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterArchive) validateHosts(formats strfmt.Registry) error {
+	if swag.IsZero(m.Hosts) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Hosts); i++ {
+		if swag.IsZero(m.Hosts[i]) { // not required
+			continue
+		}
+
+		if m.Hosts[i] != nil {
+			if err := m.Hosts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hosts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("hosts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterArchive) validateIgnoredValidations(formats strfmt.Registry) error {
+	if swag.IsZero(m.IgnoredValidations) { // not required
+		return nil
+	}
+
+	if m.IgnoredValidations != nil {
+		if err := m.IgnoredValidations.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("ignored_validations")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("ignored_validations")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ClusterArchive) validateInfraEnvs(formats strfmt.Registry) error {
+	if swag.IsZero(m.InfraEnvs) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.InfraEnvs); i++ {
+		if swag.IsZero(m.InfraEnvs[i]) { // not required
+			continue
+		}
+
+		if m.InfraEnvs[i] != nil {
+			if err := m.InfraEnvs[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("infra_envs" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("infra_envs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterArchive) validateManifests(formats strfmt.Registry) error {
+	if swag.IsZero(m.Manifests) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Manifests); i++ {
+		if swag.IsZero(m.Manifests[i]) { // not required
+			continue
+		}
+
+		if m.Manifests[i] != nil {
+			if err := m.Manifests[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("manifests" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("manifests" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterArchive) validateSecrets(formats strfmt.Registry) error {
+
+	if err := validate.Required("secrets", "body", m.Secrets); err != nil {
+		return err
+	}
+
+	if m.Secrets != nil {
+		if err := m.Secrets.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("secrets")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("secrets")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ClusterArchive) validateSourceClusterID(formats strfmt.Registry) error {
+
+	if err := validate.Required("source_cluster_id", "body", m.SourceClusterID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("source_cluster_id", "body", "uuid", m.SourceClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this cluster archive based on the context it is used
+func (m *ClusterArchive) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateHosts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateIgnoredValidations(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateInfraEnvs(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateManifests(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateSecrets(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterArchive) contextValidateHosts(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Hosts); i++ {
+
+		if m.Hosts[i] != nil {
+			if err := m.Hosts[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hosts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("hosts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterArchive) contextValidateIgnoredValidations(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.IgnoredValidations != nil {
+		if err := m.IgnoredValidations.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("ignored_validations")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("ignored_validations")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ClusterArchive) contextValidateInfraEnvs(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.InfraEnvs); i++ {
+
+		if m.InfraEnvs[i] != nil {
+			if err := m.InfraEnvs[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("infra_envs" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("infra_envs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterArchive) contextValidateManifests(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Manifests); i++ {
+
+		if m.Manifests[i] != nil {
+			if err := m.Manifests[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("manifests" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("manifests" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterArchive) contextValidateSecrets(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Secrets != nil {
+		if err := m.Secrets.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("secrets")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("secrets")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterArchive) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterArchive) UnmarshalBinary(b []byte) error {
+	var res ClusterArchive
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,88 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClusterArchiveEncryptionKey cluster archive encryption key
+//
+// swagger:model cluster-archive-encryption-key
+type ClusterArchiveEncryptionKey struct {
+
+	// The SHA-256 fingerprint of the public key.
+	// Required: true
+	KeyID *string `json:"key_id"`
+
+	// The PEM encoded RSA public key.
+	// Required: true
+	PublicKey *string `json:"public_key"`
+}
+
+// Validate validates this cluster archive encryption key
+func (m *ClusterArchiveEncryptionKey) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateKeyID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePublicKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterArchiveEncryptionKey) validateKeyID(formats strfmt.Registry) error {
+
+	if err := validate.Required("key_id", "body", m.KeyID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterArchiveEncryptionKey) validatePublicKey(formats strfmt.Registry) error {
+
+	if err := validate.Required("public_key", "body", m.PublicKey); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this cluster archive encryption key based on context it is used
+func (m *ClusterArchiveEncryptionKey) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterArchiveEncryptionKey) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterArchiveEncryptionKey) UnmarshalBinary(b []byte) error {
+	var res ClusterArchiveEncryptionKey
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
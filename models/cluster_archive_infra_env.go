@@ -0,0 +1,93 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClusterArchiveInfraEnv cluster archive infra env
+//
+// swagger:model cluster-archive-infra-env
+type ClusterArchiveInfraEnv struct {
+
+	// Properties of infra-env-create-params. The pull secret and the SSH key are in the secrets of the archive.
+	// Required: true
+	Params interface{} `json:"params"`
+
+	// The ID of the exported infra-env.
+	// Required: true
+	// Format: uuid
+	SourceID *strfmt.UUID `json:"source_id"`
+}
+
+// Validate validates this cluster archive infra env
+func (m *ClusterArchiveInfraEnv) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateParams(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSourceID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterArchiveInfraEnv) validateParams(formats strfmt.Registry) error {
+
+	if m.Params == nil {
+		return errors.Required("params", "body", nil)
+	}
+
+	return nil
+}
+
+func (m *ClusterArchiveInfraEnv) validateSourceID(formats strfmt.Registry) error {
+
+	if err := validate.Required("source_id", "body", m.SourceID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("source_id", "body", "uuid", m.SourceID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this cluster archive infra env based on context it is used
+func (m *ClusterArchiveInfraEnv) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterArchiveInfraEnv) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterArchiveInfraEnv) UnmarshalBinary(b []byte) error {
+	var res ClusterArchiveInfraEnv
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,125 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClusterArchiveSecrets The secrets of the archive, encrypted with AES-256-GCM under a key that is encrypted with RSA-OAEP (SHA-256) to the key of the importing service.
+//
+// swagger:model cluster-archive-secrets
+type ClusterArchiveSecrets struct {
+
+	// ciphertext
+	// Required: true
+	// Format: byte
+	Ciphertext *strfmt.Base64 `json:"ciphertext"`
+
+	// encrypted key
+	// Required: true
+	// Format: byte
+	EncryptedKey *strfmt.Base64 `json:"encrypted_key"`
+
+	// The ID of the key that the archive is encrypted to.
+	// Required: true
+	KeyID *string `json:"key_id"`
+
+	// nonce
+	// Required: true
+	// Format: byte
+	Nonce *strfmt.Base64 `json:"nonce"`
+}
+
+// Validate validates this cluster archive secrets
+func (m *ClusterArchiveSecrets) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCiphertext(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEncryptedKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateKeyID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNonce(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterArchiveSecrets) validateCiphertext(formats strfmt.Registry) error {
+
+	if err := validate.Required("ciphertext", "body", m.Ciphertext); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterArchiveSecrets) validateEncryptedKey(formats strfmt.Registry) error {
+
+	if err := validate.Required("encrypted_key", "body", m.EncryptedKey); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterArchiveSecrets) validateKeyID(formats strfmt.Registry) error {
+
+	if err := validate.Required("key_id", "body", m.KeyID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterArchiveSecrets) validateNonce(formats strfmt.Registry) error {
+
+	if err := validate.Required("nonce", "body", m.Nonce); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this cluster archive secrets based on context it is used
+func (m *ClusterArchiveSecrets) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterArchiveSecrets) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterArchiveSecrets) UnmarshalBinary(b []byte) error {
+	var res ClusterArchiveSecrets
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -27,6 +27,9 @@ type ClusterCreateParams struct {
 	// The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.
 	APIVips []*APIVip `json:"api_vips"`
 
+	// Measure the network bandwidth between the hosts of the cluster and validate it against the minimum bandwidth of their role.
+	BandwidthCheckEnabled *bool `json:"bandwidth_check_enabled,omitempty"`
+
 	// Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.
 	BaseDNSDomain string `json:"base_dns_domain,omitempty"`
 
@@ -52,6 +55,9 @@ type ClusterCreateParams struct {
 	// Installation disks encryption mode and host roles to be applied.
 	DiskEncryption *DiskEncryption `json:"disk_encryption,omitempty" gorm:"embedded;embeddedPrefix:disk_encryption_"`
 
+	// The firmware settings that the hosts of the cluster must comply with.
+	FirmwarePolicy *FirmwarePolicy `json:"firmware_policy,omitempty"`
+
 	// (DEPRECATED) Please use 'control_plane_count' instead. Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster
 	// over multiple master nodes whereas 'None' installs a full cluster over one node.
 	//
@@ -167,6 +173,10 @@ func (m *ClusterCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateFirmwarePolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHighAvailabilityMode(formats); err != nil {
 		res = append(res, err)
 	}
@@ -382,6 +392,25 @@ func (m *ClusterCreateParams) validateDiskEncryption(formats strfmt.Registry) er
 	return nil
 }
 
+func (m *ClusterCreateParams) validateFirmwarePolicy(formats strfmt.Registry) error {
+	if swag.IsZero(m.FirmwarePolicy) { // not required
+		return nil
+	}
+
+	if m.FirmwarePolicy != nil {
+		if err := m.FirmwarePolicy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("firmware_policy")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("firmware_policy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 var clusterCreateParamsTypeHighAvailabilityModePropEnum []interface{}
 
 func init() {
@@ -753,6 +782,10 @@ func (m *ClusterCreateParams) ContextValidate(ctx context.Context, formats strfm
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateFirmwarePolicy(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateIgnitionEndpoint(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -843,6 +876,22 @@ func (m *ClusterCreateParams) contextValidateDiskEncryption(ctx context.Context,
 	return nil
 }
 
+func (m *ClusterCreateParams) contextValidateFirmwarePolicy(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.FirmwarePolicy != nil {
+		if err := m.FirmwarePolicy.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("firmware_policy")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("firmware_policy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) contextValidateIgnitionEndpoint(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.IgnitionEndpoint != nil {
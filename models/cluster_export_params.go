@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClusterExportParams cluster export params
+//
+// swagger:model cluster-export-params
+type ClusterExportParams struct {
+
+	// The PEM encoded RSA public key of the service that imports the archive, as returned by its v2GetClusterArchiveEncryptionKey.
+	// Required: true
+	RecipientPublicKey *string `json:"recipient_public_key"`
+}
+
+// Validate validates this cluster export params
+func (m *ClusterExportParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRecipientPublicKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterExportParams) validateRecipientPublicKey(formats strfmt.Registry) error {
+
+	if err := validate.Required("recipient_public_key", "body", m.RecipientPublicKey); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this cluster export params based on context it is used
+func (m *ClusterExportParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterExportParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterExportParams) UnmarshalBinary(b []byte) error {
+	var res ClusterExportParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -26,6 +26,9 @@ type ClusterHostRequirementsDetails struct {
 	// Required installation disk speed in ms
 	InstallationDiskSpeedThresholdMs int64 `json:"installation_disk_speed_threshold_ms,omitempty"`
 
+	// Minimum network bandwidth at L3 to other hosts of the same role, in Mbps. Validated only for clusters with bandwidth checks enabled.
+	NetworkBandwidthThresholdMbps *float64 `json:"network_bandwidth_threshold_mbps,omitempty"`
+
 	// Maximum network average latency (RTT) at L3 for role.
 	NetworkLatencyThresholdMs *float64 `json:"network_latency_threshold_ms,omitempty"`
 
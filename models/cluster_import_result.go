@@ -0,0 +1,174 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClusterImportResult cluster import result
+//
+// swagger:model cluster-import-result
+type ClusterImportResult struct {
+
+	// cluster
+	Cluster *Cluster `json:"cluster,omitempty"`
+
+	// A plan that applies the properties of the exported hosts, to be applied with v2ApplyClusterPlan once the hosts are discovered.
+	HostPlan *ClusterPlan `json:"host_plan,omitempty"`
+
+	// The IDs of the registered infra-envs, keyed by the IDs of the exported infra-envs.
+	InfraEnvIds map[string]strfmt.UUID `json:"infra_env_ids,omitempty"`
+}
+
+// Validate validates this cluster import result
+func (m *ClusterImportResult) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCluster(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHostPlan(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInfraEnvIds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterImportResult) validateCluster(formats strfmt.Registry) error {
+	if swag.IsZero(m.Cluster) { // not required
+		return nil
+	}
+
+	if m.Cluster != nil {
+		if err := m.Cluster.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cluster")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("cluster")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ClusterImportResult) validateHostPlan(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostPlan) { // not required
+		return nil
+	}
+
+	if m.HostPlan != nil {
+		if err := m.HostPlan.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("host_plan")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("host_plan")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ClusterImportResult) validateInfraEnvIds(formats strfmt.Registry) error {
+	if swag.IsZero(m.InfraEnvIds) { // not required
+		return nil
+	}
+
+	for k := range m.InfraEnvIds {
+
+		if err := validate.FormatOf("infra_env_ids"+"."+k, "body", "uuid", m.InfraEnvIds[k].String(), formats); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this cluster import result based on the context it is used
+func (m *ClusterImportResult) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCluster(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateHostPlan(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterImportResult) contextValidateCluster(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Cluster != nil {
+		if err := m.Cluster.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cluster")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("cluster")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ClusterImportResult) contextValidateHostPlan(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.HostPlan != nil {
+		if err := m.HostPlan.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("host_plan")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("host_plan")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterImportResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterImportResult) UnmarshalBinary(b []byte) error {
+	var res ClusterImportResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
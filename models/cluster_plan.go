@@ -0,0 +1,349 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClusterPlan The desired state of a cluster. Only the properties that are set are compared and applied.
+//
+// swagger:model cluster-plan
+type ClusterPlan struct {
+
+	// The desired cluster properties.
+	Cluster *V2ClusterUpdateParams `json:"cluster,omitempty"`
+
+	// The desired host properties, matched to the hosts of the cluster by MAC address.
+	Hosts []*ClusterPlanHost `json:"hosts"`
+
+	// The desired infra-env properties.
+	InfraEnv *InfraEnvUpdateParams `json:"infra_env,omitempty"`
+
+	// The infra-env that infra_env is applied to. Defaults to the single infra-env of the cluster.
+	// Format: uuid
+	InfraEnvID *strfmt.UUID `json:"infra_env_id,omitempty"`
+
+	// Installs the cluster once the rest of the plan is applied.
+	Install *bool `json:"install,omitempty"`
+
+	// The desired custom manifests.
+	Manifests []*CreateManifestParams `json:"manifests"`
+
+	// The desired OLM operators. Operators that are only installed as dependencies are ignored.
+	Operators []*OperatorCreateParams `json:"operators"`
+
+	// Deletes the custom manifests of the cluster that are not in manifests.
+	PruneManifests *bool `json:"prune_manifests,omitempty"`
+}
+
+// Validate validates this cluster plan
+func (m *ClusterPlan) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCluster(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHosts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInfraEnv(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInfraEnvID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateManifests(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOperators(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterPlan) validateCluster(formats strfmt.Registry) error {
+	if swag.IsZero(m.Cluster) { // not required
+		return nil
+	}
+
+	if m.Cluster != nil {
+		if err := m.Cluster.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cluster")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("cluster")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ClusterPlan) validateHosts(formats strfmt.Registry) error {
+	if swag.IsZero(m.Hosts) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Hosts); i++ {
+		if swag.IsZero(m.Hosts[i]) { // not required
+			continue
+		}
+
+		if m.Hosts[i] != nil {
+			if err := m.Hosts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hosts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("hosts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterPlan) validateInfraEnv(formats strfmt.Registry) error {
+	if swag.IsZero(m.InfraEnv) { // not required
+		return nil
+	}
+
+	if m.InfraEnv != nil {
+		if err := m.InfraEnv.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("infra_env")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("infra_env")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ClusterPlan) validateInfraEnvID(formats strfmt.Registry) error {
+	if swag.IsZero(m.InfraEnvID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("infra_env_id", "body", "uuid", m.InfraEnvID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterPlan) validateManifests(formats strfmt.Registry) error {
+	if swag.IsZero(m.Manifests) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Manifests); i++ {
+		if swag.IsZero(m.Manifests[i]) { // not required
+			continue
+		}
+
+		if m.Manifests[i] != nil {
+			if err := m.Manifests[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("manifests" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("manifests" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterPlan) validateOperators(formats strfmt.Registry) error {
+	if swag.IsZero(m.Operators) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Operators); i++ {
+		if swag.IsZero(m.Operators[i]) { // not required
+			continue
+		}
+
+		if m.Operators[i] != nil {
+			if err := m.Operators[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("operators" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("operators" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this cluster plan based on the context it is used
+func (m *ClusterPlan) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCluster(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateHosts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateInfraEnv(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateManifests(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateOperators(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterPlan) contextValidateCluster(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Cluster != nil {
+		if err := m.Cluster.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cluster")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("cluster")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ClusterPlan) contextValidateHosts(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Hosts); i++ {
+
+		if m.Hosts[i] != nil {
+			if err := m.Hosts[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hosts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("hosts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterPlan) contextValidateInfraEnv(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.InfraEnv != nil {
+		if err := m.InfraEnv.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("infra_env")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("infra_env")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ClusterPlan) contextValidateManifests(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Manifests); i++ {
+
+		if m.Manifests[i] != nil {
+			if err := m.Manifests[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("manifests" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("manifests" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterPlan) contextValidateOperators(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Operators); i++ {
+
+		if m.Operators[i] != nil {
+			if err := m.Operators[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("operators" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("operators" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterPlan) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterPlan) UnmarshalBinary(b []byte) error {
+	var res ClusterPlan
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,186 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClusterPlanChange cluster plan change
+//
+// swagger:model cluster-plan-change
+type ClusterPlanChange struct {
+
+	// action
+	// Required: true
+	// Enum: [create update delete install]
+	Action *string `json:"action"`
+
+	// The current value of the property. Omitted for sensitive properties.
+	Current interface{} `json:"current,omitempty"`
+
+	// The desired value of the property. Omitted for sensitive properties.
+	Desired interface{} `json:"desired,omitempty"`
+
+	// The changed property of the resource.
+	Field string `json:"field,omitempty"`
+
+	// The identifier of the changed resource. Manifests are identified by their folder and file name.
+	ID string `json:"id,omitempty"`
+
+	// resource
+	// Required: true
+	// Enum: [cluster infra-env host manifest operator]
+	Resource *string `json:"resource"`
+}
+
+// Validate validates this cluster plan change
+func (m *ClusterPlanChange) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateResource(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var clusterPlanChangeTypeActionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["create","update","delete","install"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterPlanChangeTypeActionPropEnum = append(clusterPlanChangeTypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterPlanChangeActionCreate captures enum value "create"
+	ClusterPlanChangeActionCreate string = "create"
+
+	// ClusterPlanChangeActionUpdate captures enum value "update"
+	ClusterPlanChangeActionUpdate string = "update"
+
+	// ClusterPlanChangeActionDelete captures enum value "delete"
+	ClusterPlanChangeActionDelete string = "delete"
+
+	// ClusterPlanChangeActionInstall captures enum value "install"
+	ClusterPlanChangeActionInstall string = "install"
+)
+
+// prop value enum
+func (m *ClusterPlanChange) validateActionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterPlanChangeTypeActionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ClusterPlanChange) validateAction(formats strfmt.Registry) error {
+
+	if err := validate.Required("action", "body", m.Action); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateActionEnum("action", "body", *m.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var clusterPlanChangeTypeResourcePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["cluster","infra-env","host","manifest","operator"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterPlanChangeTypeResourcePropEnum = append(clusterPlanChangeTypeResourcePropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterPlanChangeResourceCluster captures enum value "cluster"
+	ClusterPlanChangeResourceCluster string = "cluster"
+
+	// ClusterPlanChangeResourceInfraEnv captures enum value "infra-env"
+	ClusterPlanChangeResourceInfraEnv string = "infra-env"
+
+	// ClusterPlanChangeResourceHost captures enum value "host"
+	ClusterPlanChangeResourceHost string = "host"
+
+	// ClusterPlanChangeResourceManifest captures enum value "manifest"
+	ClusterPlanChangeResourceManifest string = "manifest"
+
+	// ClusterPlanChangeResourceOperator captures enum value "operator"
+	ClusterPlanChangeResourceOperator string = "operator"
+)
+
+// prop value enum
+func (m *ClusterPlanChange) validateResourceEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterPlanChangeTypeResourcePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ClusterPlanChange) validateResource(formats strfmt.Registry) error {
+
+	if err := validate.Required("resource", "body", m.Resource); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateResourceEnum("resource", "body", *m.Resource); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this cluster plan change based on context it is used
+func (m *ClusterPlanChange) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterPlanChange) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterPlanChange) UnmarshalBinary(b []byte) error {
+	var res ClusterPlanChange
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
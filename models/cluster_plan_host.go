@@ -0,0 +1,139 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClusterPlanHost cluster plan host
+//
+// swagger:model cluster-plan-host
+type ClusterPlanHost struct {
+
+	// host name
+	HostName *string `json:"host_name,omitempty"`
+
+	// host role
+	// Enum: [auto-assign master arbiter worker]
+	HostRole *string `json:"host_role,omitempty"`
+
+	// The id of the disk the host is installed on.
+	InstallationDiskID *string `json:"installation_disk_id,omitempty"`
+
+	// The MAC address of one of the interfaces of the host.
+	// Required: true
+	// Pattern: ^([0-9A-Fa-f]{2}[:-]){5}([0-9A-Fa-f]{2})$
+	MacAddress *string `json:"mac_address"`
+}
+
+// Validate validates this cluster plan host
+func (m *ClusterPlanHost) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHostRole(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMacAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var clusterPlanHostTypeHostRolePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["auto-assign","master","arbiter","worker"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterPlanHostTypeHostRolePropEnum = append(clusterPlanHostTypeHostRolePropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterPlanHostHostRoleAutoAssign captures enum value "auto-assign"
+	ClusterPlanHostHostRoleAutoAssign string = "auto-assign"
+
+	// ClusterPlanHostHostRoleMaster captures enum value "master"
+	ClusterPlanHostHostRoleMaster string = "master"
+
+	// ClusterPlanHostHostRoleArbiter captures enum value "arbiter"
+	ClusterPlanHostHostRoleArbiter string = "arbiter"
+
+	// ClusterPlanHostHostRoleWorker captures enum value "worker"
+	ClusterPlanHostHostRoleWorker string = "worker"
+)
+
+// prop value enum
+func (m *ClusterPlanHost) validateHostRoleEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterPlanHostTypeHostRolePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ClusterPlanHost) validateHostRole(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostRole) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateHostRoleEnum("host_role", "body", *m.HostRole); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterPlanHost) validateMacAddress(formats strfmt.Registry) error {
+
+	if err := validate.Required("mac_address", "body", m.MacAddress); err != nil {
+		return err
+	}
+
+	if err := validate.Pattern("mac_address", "body", *m.MacAddress, `^([0-9A-Fa-f]{2}[:-]){5}([0-9A-Fa-f]{2})$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this cluster plan host based on context it is used
+func (m *ClusterPlanHost) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterPlanHost) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterPlanHost) UnmarshalBinary(b []byte) error {
+	var res ClusterPlanHost
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,231 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClusterRevision cluster revision
+//
+// swagger:model cluster-revision
+type ClusterRevision struct {
+
+	// cluster id
+	// Required: true
+	// Format: uuid
+	ClusterID *strfmt.UUID `json:"cluster_id" gorm:"primaryKey"`
+
+	// created at
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"created_at" gorm:"type:timestamp with time zone"`
+
+	// The kind of update that recorded the revision.
+	// Required: true
+	// Enum: [register cluster-update host-update ignored-validations-update plan-apply rollback]
+	Reason *string `json:"reason"`
+
+	// The number of the revision, increasing for each revision of the cluster.
+	// Required: true
+	Revision *int64 `json:"revision" gorm:"primaryKey;autoIncrement:false"`
+
+	// snapshot
+	Snapshot *ClusterRevisionSnapshot `json:"snapshot,omitempty" gorm:"-"`
+}
+
+// Validate validates this cluster revision
+func (m *ClusterRevision) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReason(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRevision(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSnapshot(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterRevision) validateClusterID(formats strfmt.Registry) error {
+
+	if err := validate.Required("cluster_id", "body", m.ClusterID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("cluster_id", "body", "uuid", m.ClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterRevision) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("created_at", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var clusterRevisionTypeReasonPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["register","cluster-update","host-update","ignored-validations-update","plan-apply","rollback"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterRevisionTypeReasonPropEnum = append(clusterRevisionTypeReasonPropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterRevisionReasonRegister captures enum value "register"
+	ClusterRevisionReasonRegister string = "register"
+
+	// ClusterRevisionReasonClusterUpdate captures enum value "cluster-update"
+	ClusterRevisionReasonClusterUpdate string = "cluster-update"
+
+	// ClusterRevisionReasonHostUpdate captures enum value "host-update"
+	ClusterRevisionReasonHostUpdate string = "host-update"
+
+	// ClusterRevisionReasonIgnoredValidationsUpdate captures enum value "ignored-validations-update"
+	ClusterRevisionReasonIgnoredValidationsUpdate string = "ignored-validations-update"
+
+	// ClusterRevisionReasonPlanApply captures enum value "plan-apply"
+	ClusterRevisionReasonPlanApply string = "plan-apply"
+
+	// ClusterRevisionReasonRollback captures enum value "rollback"
+	ClusterRevisionReasonRollback string = "rollback"
+)
+
+// prop value enum
+func (m *ClusterRevision) validateReasonEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterRevisionTypeReasonPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ClusterRevision) validateReason(formats strfmt.Registry) error {
+
+	if err := validate.Required("reason", "body", m.Reason); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateReasonEnum("reason", "body", *m.Reason); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterRevision) validateRevision(formats strfmt.Registry) error {
+
+	if err := validate.Required("revision", "body", m.Revision); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterRevision) validateSnapshot(formats strfmt.Registry) error {
+	if swag.IsZero(m.Snapshot) { // not required
+		return nil
+	}
+
+	if m.Snapshot != nil {
+		if err := m.Snapshot.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("snapshot")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("snapshot")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this cluster revision based on the context it is used
+func (m *ClusterRevision) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateSnapshot(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterRevision) contextValidateSnapshot(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Snapshot != nil {
+		if err := m.Snapshot.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("snapshot")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("snapshot")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterRevision) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterRevision) UnmarshalBinary(b []byte) error {
+	var res ClusterRevision
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,77 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClusterRevisionChange cluster revision change
+//
+// swagger:model cluster-revision-change
+type ClusterRevisionChange struct {
+
+	// The value in the base configuration. Not set if the property is added.
+	From interface{} `json:"from,omitempty"`
+
+	// The changed property, e.g. cluster.api_vips, hosts.<host_id>.host_role or manifests.<folder>/<file_name>.
+	// Required: true
+	Path *string `json:"path"`
+
+	// The value in the revision. Not set if the property is removed.
+	To interface{} `json:"to,omitempty"`
+}
+
+// Validate validates this cluster revision change
+func (m *ClusterRevisionChange) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePath(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterRevisionChange) validatePath(formats strfmt.Registry) error {
+
+	if err := validate.Required("path", "body", m.Path); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this cluster revision change based on context it is used
+func (m *ClusterRevisionChange) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterRevisionChange) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterRevisionChange) UnmarshalBinary(b []byte) error {
+	var res ClusterRevisionChange
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,137 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClusterRevisionHost cluster revision host
+//
+// swagger:model cluster-revision-host
+type ClusterRevisionHost struct {
+
+	// host id
+	// Format: uuid
+	HostID strfmt.UUID `json:"host_id,omitempty"`
+
+	// host name
+	HostName string `json:"host_name,omitempty"`
+
+	// host role
+	// Enum: [auto-assign master arbiter worker]
+	HostRole string `json:"host_role,omitempty"`
+
+	// installation disk id
+	InstallationDiskID string `json:"installation_disk_id,omitempty"`
+}
+
+// Validate validates this cluster revision host
+func (m *ClusterRevisionHost) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHostRole(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterRevisionHost) validateHostID(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("host_id", "body", "uuid", m.HostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var clusterRevisionHostTypeHostRolePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["auto-assign","master","arbiter","worker"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterRevisionHostTypeHostRolePropEnum = append(clusterRevisionHostTypeHostRolePropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterRevisionHostHostRoleAutoAssign captures enum value "auto-assign"
+	ClusterRevisionHostHostRoleAutoAssign string = "auto-assign"
+
+	// ClusterRevisionHostHostRoleMaster captures enum value "master"
+	ClusterRevisionHostHostRoleMaster string = "master"
+
+	// ClusterRevisionHostHostRoleArbiter captures enum value "arbiter"
+	ClusterRevisionHostHostRoleArbiter string = "arbiter"
+
+	// ClusterRevisionHostHostRoleWorker captures enum value "worker"
+	ClusterRevisionHostHostRoleWorker string = "worker"
+)
+
+// prop value enum
+func (m *ClusterRevisionHost) validateHostRoleEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterRevisionHostTypeHostRolePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ClusterRevisionHost) validateHostRole(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostRole) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateHostRoleEnum("host_role", "body", m.HostRole); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this cluster revision host based on context it is used
+func (m *ClusterRevisionHost) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterRevisionHost) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterRevisionHost) UnmarshalBinary(b []byte) error {
+	var res ClusterRevisionHost
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,85 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClusterTemplateParameter cluster template parameter
+//
+// swagger:model cluster-template-parameter
+type ClusterTemplateParameter struct {
+
+	// The value of the parameter when it is not set on registration.
+	Default *string `json:"default,omitempty"`
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// name
+	// Required: true
+	// Pattern: ^[a-zA-Z_][a-zA-Z0-9_]*$
+	Name *string `json:"name"`
+
+	// Whether the parameter must be set on registration. Ignored if the parameter has a default.
+	Required *bool `json:"required,omitempty"`
+}
+
+// Validate validates this cluster template parameter
+func (m *ClusterTemplateParameter) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterTemplateParameter) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	if err := validate.Pattern("name", "body", *m.Name, `^[a-zA-Z_][a-zA-Z0-9_]*$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this cluster template parameter based on context it is used
+func (m *ClusterTemplateParameter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterTemplateParameter) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterTemplateParameter) UnmarshalBinary(b []byte) error {
+	var res ClusterTemplateParameter
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,179 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ClusterTemplateSpec The configuration of the clusters registered from a template. String values may reference the parameters of the template as ${name}, and the name of the registered cluster as ${cluster_name}.
+//
+// swagger:model cluster-template-spec
+type ClusterTemplateSpec struct {
+
+	// Properties of cluster-create-params that are set on the registered clusters. The pull secret cannot be set in a template.
+	ClusterParams interface{} `json:"cluster_params,omitempty"`
+
+	// Properties of infra-env-create-params. If set, an infra-env with these properties is created for each registered cluster. The pull secret and the cluster cannot be set in a template.
+	InfraEnvParams interface{} `json:"infra_env_params,omitempty"`
+
+	// The custom manifests that are created for each registered cluster.
+	Manifests []*CreateManifestParams `json:"manifests"`
+
+	// parameters
+	Parameters []*ClusterTemplateParameter `json:"parameters"`
+}
+
+// Validate validates this cluster template spec
+func (m *ClusterTemplateSpec) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateManifests(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateParameters(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterTemplateSpec) validateManifests(formats strfmt.Registry) error {
+	if swag.IsZero(m.Manifests) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Manifests); i++ {
+		if swag.IsZero(m.Manifests[i]) { // not required
+			continue
+		}
+
+		if m.Manifests[i] != nil {
+			if err := m.Manifests[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("manifests" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("manifests" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateSpec) validateParameters(formats strfmt.Registry) error {
+	if swag.IsZero(m.Parameters) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Parameters); i++ {
+		if swag.IsZero(m.Parameters[i]) { // not required
+			continue
+		}
+
+		if m.Parameters[i] != nil {
+			if err := m.Parameters[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("parameters" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("parameters" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this cluster template spec based on the context it is used
+func (m *ClusterTemplateSpec) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateManifests(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateParameters(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterTemplateSpec) contextValidateManifests(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Manifests); i++ {
+
+		if m.Manifests[i] != nil {
+			if err := m.Manifests[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("manifests" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("manifests" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateSpec) contextValidateParameters(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Parameters); i++ {
+
+		if m.Parameters[i] != nil {
+			if err := m.Parameters[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("parameters" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("parameters" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterTemplateSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterTemplateSpec) UnmarshalBinary(b []byte) error {
+	var res ClusterTemplateSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,94 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CustomStepRequest Request to run a custom step, which is a container that is defined by the administrator of the service.
+//
+// swagger:model custom_step_request
+type CustomStepRequest struct {
+
+	// The arguments of the container.
+	Args []string `json:"args"`
+
+	// The container image to run.
+	// Required: true
+	Image *string `json:"image"`
+
+	// The name of the custom step.
+	// Required: true
+	Name *string `json:"name"`
+
+	// The number of seconds after which the container is stopped.
+	Timeout int64 `json:"timeout,omitempty"`
+}
+
+// Validate validates this custom step request
+func (m *CustomStepRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateImage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CustomStepRequest) validateImage(formats strfmt.Registry) error {
+
+	if err := validate.Required("image", "body", m.Image); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CustomStepRequest) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this custom step request based on context it is used
+func (m *CustomStepRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CustomStepRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CustomStepRequest) UnmarshalBinary(b []byte) error {
+	var res CustomStepRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,62 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// CustomStepResult The result of the last run of a custom step on a host. The output of the container is expected to be a JSON document.
+//
+// swagger:model custom_step_result
+type CustomStepResult struct {
+
+	// The error of the container, or the reason why its output doesn't match the reply schema of the step.
+	Error string `json:"error,omitempty"`
+
+	// The exit code of the container.
+	ExitCode int64 `json:"exit_code,omitempty"`
+
+	// The name of the custom step.
+	Name string `json:"name,omitempty"`
+
+	// The output of the container.
+	Output string `json:"output,omitempty"`
+
+	// Whether the container succeeded and its output matches the reply schema of the step.
+	Succeeded bool `json:"succeeded,omitempty"`
+}
+
+// Validate validates this custom step result
+func (m *CustomStepResult) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this custom step result based on context it is used
+func (m *CustomStepResult) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CustomStepResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CustomStepResult) UnmarshalBinary(b []byte) error {
+	var res CustomStepResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
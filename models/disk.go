@@ -36,6 +36,9 @@ type Disk struct {
 	// hctl
 	Hctl string `json:"hctl,omitempty"`
 
+	// health
+	Health *DiskHealth `json:"health,omitempty"`
+
 	// A comma-separated list of disk names that this disk belongs to
 	Holders string `json:"holders,omitempty"`
 
@@ -93,6 +96,10 @@ func (m *Disk) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateHealth(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateInstallationEligibility(formats); err != nil {
 		res = append(res, err)
 	}
@@ -128,6 +135,25 @@ func (m *Disk) validateDriveType(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Disk) validateHealth(formats strfmt.Registry) error {
+	if swag.IsZero(m.Health) { // not required
+		return nil
+	}
+
+	if m.Health != nil {
+		if err := m.Health.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("health")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("health")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Disk) validateInstallationEligibility(formats strfmt.Registry) error {
 	if swag.IsZero(m.InstallationEligibility) { // not required
 		return nil
@@ -191,6 +217,10 @@ func (m *Disk) ContextValidate(ctx context.Context, formats strfmt.Registry) err
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateHealth(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateInstallationEligibility(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -223,6 +253,22 @@ func (m *Disk) contextValidateDriveType(ctx context.Context, formats strfmt.Regi
 	return nil
 }
 
+func (m *Disk) contextValidateHealth(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Health != nil {
+		if err := m.Health.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("health")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("health")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Disk) contextValidateInstallationEligibility(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.InstallationEligibility.ContextValidate(ctx, formats); err != nil {
@@ -0,0 +1,62 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// DiskHealth The health of the disk, as reported by its SMART data. Properties that the disk doesn't report are omitted.
+//
+// swagger:model disk_health
+type DiskHealth struct {
+
+	// The number of unrecovered data integrity errors of the disk.
+	MediaErrors *int64 `json:"media_errors,omitempty"`
+
+	// The number of sectors that were remapped to spare sectors because of errors.
+	ReallocatedSectors *int64 `json:"reallocated_sectors,omitempty"`
+
+	// Whether the disk passed its overall SMART self-assessment.
+	SmartPassed *bool `json:"smart_passed,omitempty"`
+
+	// The current temperature of the disk in degrees Celsius.
+	TemperatureCelsius *int64 `json:"temperature_celsius,omitempty"`
+
+	// The percentage of the rated endurance of the disk that was used. It can exceed 100.
+	WearLevelPercentage *int64 `json:"wear_level_percentage,omitempty"`
+}
+
+// Validate validates this disk health
+func (m *DiskHealth) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this disk health based on context it is used
+func (m *DiskHealth) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DiskHealth) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DiskHealth) UnmarshalBinary(b []byte) error {
+	var res DiskHealth
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,174 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// FirmwarePolicy Firmware settings that the hosts must comply with.
+//
+// swagger:model firmware-policy
+type FirmwarePolicy struct {
+
+	// The boot mode that the hosts must use. Hosts may use any boot mode if not set.
+	// Enum: [uefi bios]
+	BootMode string `json:"boot_mode,omitempty"`
+
+	// The minimum BIOS versions of the hosts, per manufacturer.
+	MinBiosVersions []*FirmwarePolicyBiosVersion `json:"min_bios_versions"`
+
+	// Whether the hosts must boot with Secure Boot enabled.
+	SecureBootRequired bool `json:"secure_boot_required,omitempty"`
+
+	// Whether the hosts must have the virtualization extensions of their CPU enabled.
+	VirtualizationRequired bool `json:"virtualization_required,omitempty"`
+}
+
+// Validate validates this firmware policy
+func (m *FirmwarePolicy) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBootMode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMinBiosVersions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var firmwarePolicyTypeBootModePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["uefi","bios"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		firmwarePolicyTypeBootModePropEnum = append(firmwarePolicyTypeBootModePropEnum, v)
+	}
+}
+
+const (
+
+	// FirmwarePolicyBootModeUefi captures enum value "uefi"
+	FirmwarePolicyBootModeUefi string = "uefi"
+
+	// FirmwarePolicyBootModeBios captures enum value "bios"
+	FirmwarePolicyBootModeBios string = "bios"
+)
+
+// prop value enum
+func (m *FirmwarePolicy) validateBootModeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, firmwarePolicyTypeBootModePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *FirmwarePolicy) validateBootMode(formats strfmt.Registry) error {
+	if swag.IsZero(m.BootMode) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateBootModeEnum("boot_mode", "body", m.BootMode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *FirmwarePolicy) validateMinBiosVersions(formats strfmt.Registry) error {
+	if swag.IsZero(m.MinBiosVersions) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.MinBiosVersions); i++ {
+		if swag.IsZero(m.MinBiosVersions[i]) { // not required
+			continue
+		}
+
+		if m.MinBiosVersions[i] != nil {
+			if err := m.MinBiosVersions[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("min_bios_versions" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("min_bios_versions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this firmware policy based on the context it is used
+func (m *FirmwarePolicy) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateMinBiosVersions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *FirmwarePolicy) contextValidateMinBiosVersions(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.MinBiosVersions); i++ {
+
+		if m.MinBiosVersions[i] != nil {
+			if err := m.MinBiosVersions[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("min_bios_versions" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("min_bios_versions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *FirmwarePolicy) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *FirmwarePolicy) UnmarshalBinary(b []byte) error {
+	var res FirmwarePolicy
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,88 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// FirmwarePolicyBiosVersion firmware policy bios version
+//
+// swagger:model firmware-policy-bios-version
+type FirmwarePolicyBiosVersion struct {
+
+	// The manufacturer of the hosts, as reported in the system vendor of their inventory. Compared case-insensitively.
+	// Required: true
+	Manufacturer *string `json:"manufacturer"`
+
+	// The minimum BIOS version of the hosts of the manufacturer. Versions are compared by their numeric components.
+	// Required: true
+	Version *string `json:"version"`
+}
+
+// Validate validates this firmware policy bios version
+func (m *FirmwarePolicyBiosVersion) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateManufacturer(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVersion(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *FirmwarePolicyBiosVersion) validateManufacturer(formats strfmt.Registry) error {
+
+	if err := validate.Required("manufacturer", "body", m.Manufacturer); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *FirmwarePolicyBiosVersion) validateVersion(formats strfmt.Registry) error {
+
+	if err := validate.Required("version", "body", m.Version); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this firmware policy bios version based on context it is used
+func (m *FirmwarePolicyBiosVersion) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *FirmwarePolicyBiosVersion) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *FirmwarePolicyBiosVersion) UnmarshalBinary(b []byte) error {
+	var res FirmwarePolicyBiosVersion
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// HardwareFingerprintChange hardware fingerprint change
+//
+// swagger:model hardware-fingerprint-change
+type HardwareFingerprintChange struct {
+
+	// component
+	// Required: true
+	// Enum: [cpu memory disk nic]
+	Component *string `json:"component"`
+
+	// The description of the component before the change.
+	From string `json:"from,omitempty"`
+
+	// The ID of the changed disk or the MAC address of the changed NIC.
+	ID string `json:"id,omitempty"`
+
+	// The description of the component after the change.
+	To string `json:"to,omitempty"`
+
+	// type
+	// Required: true
+	// Enum: [added removed changed]
+	Type *string `json:"type"`
+}
+
+// Validate validates this hardware fingerprint change
+func (m *HardwareFingerprintChange) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateComponent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var hardwareFingerprintChangeTypeComponentPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["cpu","memory","disk","nic"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		hardwareFingerprintChangeTypeComponentPropEnum = append(hardwareFingerprintChangeTypeComponentPropEnum, v)
+	}
+}
+
+const (
+
+	// HardwareFingerprintChangeComponentCPU captures enum value "cpu"
+	HardwareFingerprintChangeComponentCPU string = "cpu"
+
+	// HardwareFingerprintChangeComponentMemory captures enum value "memory"
+	HardwareFingerprintChangeComponentMemory string = "memory"
+
+	// HardwareFingerprintChangeComponentDisk captures enum value "disk"
+	HardwareFingerprintChangeComponentDisk string = "disk"
+
+	// HardwareFingerprintChangeComponentNic captures enum value "nic"
+	HardwareFingerprintChangeComponentNic string = "nic"
+)
+
+// prop value enum
+func (m *HardwareFingerprintChange) validateComponentEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, hardwareFingerprintChangeTypeComponentPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *HardwareFingerprintChange) validateComponent(formats strfmt.Registry) error {
+
+	if err := validate.Required("component", "body", m.Component); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateComponentEnum("component", "body", *m.Component); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var hardwareFingerprintChangeTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["added","removed","changed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		hardwareFingerprintChangeTypeTypePropEnum = append(hardwareFingerprintChangeTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// HardwareFingerprintChangeTypeAdded captures enum value "added"
+	HardwareFingerprintChangeTypeAdded string = "added"
+
+	// HardwareFingerprintChangeTypeRemoved captures enum value "removed"
+	HardwareFingerprintChangeTypeRemoved string = "removed"
+
+	// HardwareFingerprintChangeTypeChanged captures enum value "changed"
+	HardwareFingerprintChangeTypeChanged string = "changed"
+)
+
+// prop value enum
+func (m *HardwareFingerprintChange) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, hardwareFingerprintChangeTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *HardwareFingerprintChange) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", *m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this hardware fingerprint change based on context it is used
+func (m *HardwareFingerprintChange) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HardwareFingerprintChange) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HardwareFingerprintChange) UnmarshalBinary(b []byte) error {
+	var res HardwareFingerprintChange
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
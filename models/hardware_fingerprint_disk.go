@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// HardwareFingerprintDisk hardware fingerprint disk
+//
+// swagger:model hardware-fingerprint-disk
+type HardwareFingerprintDisk struct {
+
+	// The WWN of the disk, or its serial number or path if it has no WWN.
+	ID string `json:"id,omitempty"`
+
+	// model
+	Model string `json:"model,omitempty"`
+
+	// size bytes
+	SizeBytes int64 `json:"size_bytes,omitempty"`
+}
+
+// Validate validates this hardware fingerprint disk
+func (m *HardwareFingerprintDisk) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this hardware fingerprint disk based on context it is used
+func (m *HardwareFingerprintDisk) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HardwareFingerprintDisk) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HardwareFingerprintDisk) UnmarshalBinary(b []byte) error {
+	var res HardwareFingerprintDisk
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,53 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// HardwareFingerprintNic hardware fingerprint nic
+//
+// swagger:model hardware-fingerprint-nic
+type HardwareFingerprintNic struct {
+
+	// mac address
+	MacAddress string `json:"mac_address,omitempty"`
+
+	// product
+	Product string `json:"product,omitempty"`
+}
+
+// Validate validates this hardware fingerprint nic
+func (m *HardwareFingerprintNic) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this hardware fingerprint nic based on context it is used
+func (m *HardwareFingerprintNic) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HardwareFingerprintNic) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HardwareFingerprintNic) UnmarshalBinary(b []byte) error {
+	var res HardwareFingerprintNic
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -26,6 +26,9 @@ type Host struct {
 	// Contains a serialized api_vip_connectivity_response
 	APIVipConnectivity string `json:"api_vip_connectivity,omitempty" gorm:"type:text"`
 
+	// JSON-formatted results of the bandwidth checks of the host to other hosts of the cluster, see bandwidth_check_response.
+	Bandwidth string `json:"bandwidth,omitempty" gorm:"type:text"`
+
 	// bootstrap
 	Bootstrap bool `json:"bootstrap,omitempty"`
 
@@ -47,6 +50,9 @@ type Host struct {
 	// Format: date-time
 	CreatedAt timeext.Time `json:"created_at,omitempty" gorm:"type:timestamp with time zone"`
 
+	// JSON-formatted list of the results of the custom steps that are defined by the administrator of the service, see custom_step_result.
+	CustomStepResults string `json:"custom_step_results,omitempty" gorm:"type:text"`
+
 	// swagger:ignore
 	DeletedAt gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"type:timestamp with time zone;index"`
 
@@ -72,6 +78,13 @@ type Host struct {
 	// free addresses
 	FreeAddresses string `json:"free_addresses,omitempty" gorm:"type:text"`
 
+	// The time the hardware of the host changed after its validations passed. Cleared when the current hardware of the host is accepted.
+	// Format: date-time
+	HardwareDriftDetectedAt *strfmt.DateTime `json:"hardware_drift_detected_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// The hash of the hardware fingerprint of the latest inventory of the host.
+	HardwareFingerprintHash string `json:"hardware_fingerprint_hash,omitempty"`
+
 	// Self link.
 	// Required: true
 	Href *string `json:"href"`
@@ -219,6 +232,10 @@ func (m *Host) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateHardwareDriftDetectedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHref(formats); err != nil {
 		res = append(res, err)
 	}
@@ -337,6 +354,18 @@ func (m *Host) validateCreatedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Host) validateHardwareDriftDetectedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.HardwareDriftDetectedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("hardware_drift_detected_at", "body", "date-time", m.HardwareDriftDetectedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Host) validateHref(formats strfmt.Registry) error {
 
 	if err := validate.Required("href", "body", m.Href); err != nil {
@@ -0,0 +1,269 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// HostHardwareRevision host hardware revision
+//
+// swagger:model host-hardware-revision
+type HostHardwareRevision struct {
+
+	// The changes of the hardware from the previous revision.
+	Changes []*HardwareFingerprintChange `json:"changes" gorm:"-"`
+
+	// created at
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"created_at" gorm:"type:timestamp with time zone"`
+
+	// Whether the hardware changed after the validations of the host passed.
+	Drift bool `json:"drift,omitempty"`
+
+	// fingerprint
+	Fingerprint *HardwareFingerprint `json:"fingerprint,omitempty" gorm:"-"`
+
+	// The hash of the hardware fingerprint.
+	// Required: true
+	FingerprintHash *string `json:"fingerprint_hash"`
+
+	// host id
+	// Required: true
+	// Format: uuid
+	HostID *strfmt.UUID `json:"host_id" gorm:"primaryKey"`
+
+	// The status of the host when its inventory reported the hardware.
+	HostStatus string `json:"host_status,omitempty"`
+
+	// infra env id
+	// Required: true
+	// Format: uuid
+	InfraEnvID *strfmt.UUID `json:"infra_env_id" gorm:"primaryKey"`
+
+	// The number of the revision, increasing for each revision of the hardware of the host.
+	// Required: true
+	Revision *int64 `json:"revision" gorm:"primaryKey;autoIncrement:false"`
+}
+
+// Validate validates this host hardware revision
+func (m *HostHardwareRevision) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateChanges(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFingerprint(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFingerprintHash(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInfraEnvID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRevision(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HostHardwareRevision) validateChanges(formats strfmt.Registry) error {
+	if swag.IsZero(m.Changes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Changes); i++ {
+		if swag.IsZero(m.Changes[i]) { // not required
+			continue
+		}
+
+		if m.Changes[i] != nil {
+			if err := m.Changes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("changes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("changes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *HostHardwareRevision) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("created_at", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostHardwareRevision) validateFingerprint(formats strfmt.Registry) error {
+	if swag.IsZero(m.Fingerprint) { // not required
+		return nil
+	}
+
+	if m.Fingerprint != nil {
+		if err := m.Fingerprint.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("fingerprint")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("fingerprint")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *HostHardwareRevision) validateFingerprintHash(formats strfmt.Registry) error {
+
+	if err := validate.Required("fingerprint_hash", "body", m.FingerprintHash); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostHardwareRevision) validateHostID(formats strfmt.Registry) error {
+
+	if err := validate.Required("host_id", "body", m.HostID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("host_id", "body", "uuid", m.HostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostHardwareRevision) validateInfraEnvID(formats strfmt.Registry) error {
+
+	if err := validate.Required("infra_env_id", "body", m.InfraEnvID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("infra_env_id", "body", "uuid", m.InfraEnvID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostHardwareRevision) validateRevision(formats strfmt.Registry) error {
+
+	if err := validate.Required("revision", "body", m.Revision); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this host hardware revision based on the context it is used
+func (m *HostHardwareRevision) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateChanges(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateFingerprint(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HostHardwareRevision) contextValidateChanges(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Changes); i++ {
+
+		if m.Changes[i] != nil {
+			if err := m.Changes[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("changes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("changes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *HostHardwareRevision) contextValidateFingerprint(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Fingerprint != nil {
+		if err := m.Fingerprint.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("fingerprint")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("fingerprint")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HostHardwareRevision) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HostHardwareRevision) UnmarshalBinary(b []byte) error {
+	var res HostHardwareRevision
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
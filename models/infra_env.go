@@ -53,6 +53,9 @@ type InfraEnv struct {
 	// Format: date-time
 	ExpiresAt strfmt.DateTime `json:"expires_at,omitempty" gorm:"type:timestamp with time zone"`
 
+	// JSON formatted firmware policy that the hosts of the infra-env must comply with, until they are bound to a cluster.
+	FirmwarePolicy *string `json:"firmware_policy,omitempty" gorm:"type:text"`
+
 	// Image generator version.
 	GeneratorVersion string `json:"generator_version,omitempty"`
 
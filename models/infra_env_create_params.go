@@ -39,6 +39,9 @@ type InfraEnvCreateParams struct {
 	// Enum: [x86_64 aarch64 arm64 ppc64le s390x]
 	CPUArchitecture string `json:"cpu_architecture,omitempty"`
 
+	// The firmware settings that the hosts of the infra-env must comply with, until they are bound to a cluster.
+	FirmwarePolicy *FirmwarePolicy `json:"firmware_policy,omitempty"`
+
 	// JSON formatted string containing the user overrides for the initial ignition config.
 	IgnitionConfigOverride string `json:"ignition_config_override,omitempty"`
 
@@ -91,6 +94,10 @@ func (m *InfraEnvCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateFirmwarePolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateImageType(formats); err != nil {
 		res = append(res, err)
 	}
@@ -200,6 +207,25 @@ func (m *InfraEnvCreateParams) validateCPUArchitecture(formats strfmt.Registry)
 	return nil
 }
 
+func (m *InfraEnvCreateParams) validateFirmwarePolicy(formats strfmt.Registry) error {
+	if swag.IsZero(m.FirmwarePolicy) { // not required
+		return nil
+	}
+
+	if m.FirmwarePolicy != nil {
+		if err := m.FirmwarePolicy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("firmware_policy")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("firmware_policy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *InfraEnvCreateParams) validateImageType(formats strfmt.Registry) error {
 	if swag.IsZero(m.ImageType) { // not required
 		return nil
@@ -313,6 +339,10 @@ func (m *InfraEnvCreateParams) validateStaticNetworkConfig(formats strfmt.Regist
 func (m *InfraEnvCreateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateFirmwarePolicy(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateImageType(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -335,6 +365,22 @@ func (m *InfraEnvCreateParams) ContextValidate(ctx context.Context, formats strf
 	return nil
 }
 
+func (m *InfraEnvCreateParams) contextValidateFirmwarePolicy(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.FirmwarePolicy != nil {
+		if err := m.FirmwarePolicy.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("firmware_policy")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("firmware_policy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *InfraEnvCreateParams) contextValidateImageType(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.ImageType.ContextValidate(ctx, formats); err != nil {
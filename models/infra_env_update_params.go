@@ -27,6 +27,9 @@ type InfraEnvUpdateParams struct {
 	// Max Length: 65535
 	AdditionalTrustBundle *string `json:"additional_trust_bundle,omitempty"`
 
+	// The firmware settings that the hosts of the infra-env must comply with, until they are bound to a cluster. An empty policy removes the policy of the infra-env.
+	FirmwarePolicy *FirmwarePolicy `json:"firmware_policy,omitempty"`
+
 	// JSON formatted string containing the user overrides for the initial ignition config.
 	IgnitionConfigOverride string `json:"ignition_config_override,omitempty"`
 
@@ -66,6 +69,10 @@ func (m *InfraEnvUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateFirmwarePolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateImageType(formats); err != nil {
 		res = append(res, err)
 	}
@@ -104,6 +111,25 @@ func (m *InfraEnvUpdateParams) validateAdditionalTrustBundle(formats strfmt.Regi
 	return nil
 }
 
+func (m *InfraEnvUpdateParams) validateFirmwarePolicy(formats strfmt.Registry) error {
+	if swag.IsZero(m.FirmwarePolicy) { // not required
+		return nil
+	}
+
+	if m.FirmwarePolicy != nil {
+		if err := m.FirmwarePolicy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("firmware_policy")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("firmware_policy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *InfraEnvUpdateParams) validateImageType(formats strfmt.Registry) error {
 	if swag.IsZero(m.ImageType) { // not required
 		return nil
@@ -199,6 +225,10 @@ func (m *InfraEnvUpdateParams) validateStaticNetworkConfig(formats strfmt.Regist
 func (m *InfraEnvUpdateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateFirmwarePolicy(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateImageType(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -221,6 +251,22 @@ func (m *InfraEnvUpdateParams) ContextValidate(ctx context.Context, formats strf
 	return nil
 }
 
+func (m *InfraEnvUpdateParams) contextValidateFirmwarePolicy(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.FirmwarePolicy != nil {
+		if err := m.FirmwarePolicy.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("firmware_policy")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("firmware_policy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *InfraEnvUpdateParams) contextValidateImageType(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.ImageType.ContextValidate(ctx, formats); err != nil {
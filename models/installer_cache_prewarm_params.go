@@ -0,0 +1,80 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// InstallerCachePrewarmParams installer cache prewarm params
+//
+// swagger:model installer-cache-prewarm-params
+type InstallerCachePrewarmParams struct {
+
+	// Whether to pin the release, so that it is never evicted.
+	Pinned *bool `json:"pinned,omitempty"`
+
+	// The pull secret of the release image. It is only used for the extraction and is not stored.
+	PullSecret string `json:"pull_secret,omitempty"`
+
+	// The release image to extract the installer binary from.
+	// Required: true
+	ReleaseImage *string `json:"release_image"`
+
+	// A mirror of the release image to extract the installer binary from instead.
+	ReleaseImageMirror string `json:"release_image_mirror,omitempty"`
+}
+
+// Validate validates this installer cache prewarm params
+func (m *InstallerCachePrewarmParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateReleaseImage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InstallerCachePrewarmParams) validateReleaseImage(formats strfmt.Registry) error {
+
+	if err := validate.Required("release_image", "body", m.ReleaseImage); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this installer cache prewarm params based on context it is used
+func (m *InstallerCachePrewarmParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *InstallerCachePrewarmParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *InstallerCachePrewarmParams) UnmarshalBinary(b []byte) error {
+	var res InstallerCachePrewarmParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
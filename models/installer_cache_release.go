@@ -0,0 +1,158 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// InstallerCacheRelease A release in the installer cache.
+//
+// swagger:model installer-cache-release
+type InstallerCacheRelease struct {
+
+	// The name of the installer binary.
+	Binary string `json:"binary,omitempty"`
+
+	// Whether the installer binary is in the local cache of the replica that served the request.
+	Cached bool `json:"cached,omitempty"`
+
+	// The last time the installer binary was used.
+	// Format: date-time
+	LastUsedAt strfmt.DateTime `json:"last_used_at,omitempty"`
+
+	// The OpenShift version of the release, if known.
+	OpenshiftVersion string `json:"openshift_version,omitempty"`
+
+	// Whether the release is pinned, in which case it is never evicted.
+	Pinned bool `json:"pinned,omitempty"`
+
+	// The status of the pre-warming of the release, empty if it wasn't pre-warmed.
+	// Enum: [pending prewarming ready failed]
+	PrewarmStatus string `json:"prewarm_status,omitempty"`
+
+	// Additional information about the status of the pre-warming.
+	PrewarmStatusInfo string `json:"prewarm_status_info,omitempty"`
+
+	// The digest of the release image, which keys the binaries of the shared cache.
+	ReleaseDigest string `json:"release_digest,omitempty"`
+
+	// The release image the installer binary is extracted from. Empty for binaries of the shared cache whose release image is unknown.
+	ReleaseImage string `json:"release_image,omitempty"`
+
+	// Whether the installer binary is stored in the shared cache.
+	Shared bool `json:"shared,omitempty"`
+
+	// The size of the installer binary.
+	SizeBytes int64 `json:"size_bytes,omitempty"`
+}
+
+// Validate validates this installer cache release
+func (m *InstallerCacheRelease) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateLastUsedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePrewarmStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InstallerCacheRelease) validateLastUsedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastUsedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_used_at", "body", "date-time", m.LastUsedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var installerCacheReleaseTypePrewarmStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pending","prewarming","ready","failed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		installerCacheReleaseTypePrewarmStatusPropEnum = append(installerCacheReleaseTypePrewarmStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// InstallerCacheReleasePrewarmStatusPending captures enum value "pending"
+	InstallerCacheReleasePrewarmStatusPending string = "pending"
+
+	// InstallerCacheReleasePrewarmStatusPrewarming captures enum value "prewarming"
+	InstallerCacheReleasePrewarmStatusPrewarming string = "prewarming"
+
+	// InstallerCacheReleasePrewarmStatusReady captures enum value "ready"
+	InstallerCacheReleasePrewarmStatusReady string = "ready"
+
+	// InstallerCacheReleasePrewarmStatusFailed captures enum value "failed"
+	InstallerCacheReleasePrewarmStatusFailed string = "failed"
+)
+
+// prop value enum
+func (m *InstallerCacheRelease) validatePrewarmStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, installerCacheReleaseTypePrewarmStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *InstallerCacheRelease) validatePrewarmStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.PrewarmStatus) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validatePrewarmStatusEnum("prewarm_status", "body", m.PrewarmStatus); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this installer cache release based on context it is used
+func (m *InstallerCacheRelease) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *InstallerCacheRelease) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *InstallerCacheRelease) UnmarshalBinary(b []byte) error {
+	var res InstallerCacheRelease
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
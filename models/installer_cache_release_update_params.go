@@ -0,0 +1,88 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// InstallerCacheReleaseUpdateParams installer cache release update params
+//
+// swagger:model installer-cache-release-update-params
+type InstallerCacheReleaseUpdateParams struct {
+
+	// Whether the release is pinned, in which case it is never evicted.
+	// Required: true
+	Pinned *bool `json:"pinned"`
+
+	// The release image that was pre-warmed.
+	// Required: true
+	ReleaseImage *string `json:"release_image"`
+}
+
+// Validate validates this installer cache release update params
+func (m *InstallerCacheReleaseUpdateParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePinned(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReleaseImage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InstallerCacheReleaseUpdateParams) validatePinned(formats strfmt.Registry) error {
+
+	if err := validate.Required("pinned", "body", m.Pinned); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InstallerCacheReleaseUpdateParams) validateReleaseImage(formats strfmt.Registry) error {
+
+	if err := validate.Required("release_image", "body", m.ReleaseImage); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this installer cache release update params based on context it is used
+func (m *InstallerCacheReleaseUpdateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *InstallerCacheReleaseUpdateParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *InstallerCacheReleaseUpdateParams) UnmarshalBinary(b []byte) error {
+	var res InstallerCacheReleaseUpdateParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
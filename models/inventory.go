@@ -21,6 +21,9 @@ import (
 // swagger:model inventory
 type Inventory struct {
 
+	// bios
+	Bios *Bios `json:"bios,omitempty"`
+
 	// bmc address
 	BmcAddress string `json:"bmc_address,omitempty"`
 
@@ -63,6 +66,10 @@ type Inventory struct {
 func (m *Inventory) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateBios(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateBoot(formats); err != nil {
 		res = append(res, err)
 	}
@@ -105,6 +112,25 @@ func (m *Inventory) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Inventory) validateBios(formats strfmt.Registry) error {
+	if swag.IsZero(m.Bios) { // not required
+		return nil
+	}
+
+	if m.Bios != nil {
+		if err := m.Bios.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("bios")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("bios")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Inventory) validateBoot(formats strfmt.Registry) error {
 	if swag.IsZero(m.Boot) { // not required
 		return nil
@@ -334,6 +360,10 @@ func (m *Inventory) validateTpmVersion(formats strfmt.Registry) error {
 func (m *Inventory) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateBios(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateBoot(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -372,6 +402,22 @@ func (m *Inventory) ContextValidate(ctx context.Context, formats strfmt.Registry
 	return nil
 }
 
+func (m *Inventory) contextValidateBios(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Bios != nil {
+		if err := m.Bios.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("bios")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("bios")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Inventory) contextValidateBoot(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Boot != nil {
@@ -0,0 +1,74 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NetworkTopologyInterface network topology interface
+//
+// swagger:model network-topology-interface
+type NetworkTopologyInterface struct {
+
+	// ipv4 addresses
+	IPV4Addresses []string `json:"ipv4_addresses"`
+
+	// ipv6 addresses
+	IPV6Addresses []string `json:"ipv6_addresses"`
+
+	// mac address
+	MacAddress string `json:"mac_address,omitempty"`
+
+	// mtu
+	Mtu int64 `json:"mtu,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// The interface that a VLAN interface is defined on.
+	Parent string `json:"parent,omitempty"`
+
+	// speed mbps
+	SpeedMbps int64 `json:"speed_mbps,omitempty"`
+
+	// The type of the interface, such as physical, bond or vlan.
+	Type string `json:"type,omitempty"`
+
+	// The VLAN ID of a VLAN interface.
+	VlanID int64 `json:"vlan_id,omitempty"`
+}
+
+// Validate validates this network topology interface
+func (m *NetworkTopologyInterface) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this network topology interface based on context it is used
+func (m *NetworkTopologyInterface) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NetworkTopologyInterface) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NetworkTopologyInterface) UnmarshalBinary(b []byte) error {
+	var res NetworkTopologyInterface
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
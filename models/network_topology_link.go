@@ -0,0 +1,166 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NetworkTopologyLink The result of a connectivity check from an interface of a host to an address of another host.
+//
+// swagger:model network-topology-link
+type NetworkTopologyLink struct {
+
+	// average rtt ms
+	AverageRttMs float64 `json:"average_rtt_ms,omitempty"`
+
+	// packet loss percentage
+	PacketLossPercentage float64 `json:"packet_loss_percentage,omitempty"`
+
+	// source host id
+	// Format: uuid
+	SourceHostID strfmt.UUID `json:"source_host_id,omitempty"`
+
+	// source interface
+	SourceInterface string `json:"source_interface,omitempty"`
+
+	// successful
+	Successful bool `json:"successful,omitempty"`
+
+	// target address
+	TargetAddress string `json:"target_address,omitempty"`
+
+	// target host id
+	// Format: uuid
+	TargetHostID strfmt.UUID `json:"target_host_id,omitempty"`
+
+	// The interface of the target host that has the target address, if it is known.
+	TargetInterface string `json:"target_interface,omitempty"`
+
+	// The MAC address that answered an L2 check.
+	TargetMacAddress string `json:"target_mac_address,omitempty"`
+
+	// type
+	// Enum: [l2 l3]
+	Type string `json:"type,omitempty"`
+}
+
+// Validate validates this network topology link
+func (m *NetworkTopologyLink) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSourceHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTargetHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NetworkTopologyLink) validateSourceHostID(formats strfmt.Registry) error {
+	if swag.IsZero(m.SourceHostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("source_host_id", "body", "uuid", m.SourceHostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *NetworkTopologyLink) validateTargetHostID(formats strfmt.Registry) error {
+	if swag.IsZero(m.TargetHostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("target_host_id", "body", "uuid", m.TargetHostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var networkTopologyLinkTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["l2","l3"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		networkTopologyLinkTypeTypePropEnum = append(networkTopologyLinkTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// NetworkTopologyLinkTypeL2 captures enum value "l2"
+	NetworkTopologyLinkTypeL2 string = "l2"
+
+	// NetworkTopologyLinkTypeL3 captures enum value "l3"
+	NetworkTopologyLinkTypeL3 string = "l3"
+)
+
+// prop value enum
+func (m *NetworkTopologyLink) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, networkTopologyLinkTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *NetworkTopologyLink) validateType(formats strfmt.Registry) error {
+	if swag.IsZero(m.Type) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this network topology link based on context it is used
+func (m *NetworkTopologyLink) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NetworkTopologyLink) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NetworkTopologyLink) UnmarshalBinary(b []byte) error {
+	var res NetworkTopologyLink
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,84 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NetworkTopologySubnet network topology subnet
+//
+// swagger:model network-topology-subnet
+type NetworkTopologySubnet struct {
+
+	// cidr
+	Cidr string `json:"cidr,omitempty"`
+
+	// The hosts that have an address in the subnet.
+	HostIds []strfmt.UUID `json:"host_ids"`
+
+	// Whether the subnet is a machine network of the cluster.
+	MachineNetwork bool `json:"machine_network,omitempty"`
+}
+
+// Validate validates this network topology subnet
+func (m *NetworkTopologySubnet) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHostIds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NetworkTopologySubnet) validateHostIds(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostIds) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.HostIds); i++ {
+
+		if err := validate.FormatOf("host_ids"+"."+strconv.Itoa(i), "body", "uuid", m.HostIds[i].String(), formats); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validates this network topology subnet based on context it is used
+func (m *NetworkTopologySubnet) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NetworkTopologySubnet) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NetworkTopologySubnet) UnmarshalBinary(b []byte) error {
+	var res NetworkTopologySubnet
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
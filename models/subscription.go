@@ -0,0 +1,230 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Subscription subscription
+//
+// swagger:model subscription
+type Subscription struct {
+
+	// The subscribed cluster. All the clusters accessible by the owner of the subscription are subscribed if not set.
+	// Format: uuid
+	ClusterID *strfmt.UUID `json:"cluster_id,omitempty" gorm:"index"`
+
+	// created at
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// Whether notifications are delivered.
+	// Required: true
+	Enabled *bool `json:"enabled"`
+
+	// Unique identifier of the subscription.
+	// Required: true
+	// Format: uuid
+	ID *strfmt.UUID `json:"id" gorm:"primaryKey"`
+
+	// org id
+	OrgID string `json:"org_id,omitempty" gorm:"index"`
+
+	// Whether notifications are signed with a secret.
+	Signed bool `json:"signed,omitempty" gorm:"-"`
+
+	// The changes that trigger a delivery.
+	Triggers *SubscriptionTriggers `json:"triggers,omitempty" gorm:"embedded;embeddedPrefix:triggers_"`
+
+	// updated at
+	// Format: date-time
+	UpdatedAt strfmt.DateTime `json:"updated_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// The URL notifications are posted to.
+	// Required: true
+	URL *string `json:"url"`
+
+	// user name
+	UserName string `json:"user_name,omitempty" gorm:"index"`
+}
+
+// Validate validates this subscription
+func (m *Subscription) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEnabled(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTriggers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateURL(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Subscription) validateClusterID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ClusterID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("cluster_id", "body", "uuid", m.ClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Subscription) validateCreatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Subscription) validateEnabled(formats strfmt.Registry) error {
+
+	if err := validate.Required("enabled", "body", m.Enabled); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Subscription) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Subscription) validateTriggers(formats strfmt.Registry) error {
+	if swag.IsZero(m.Triggers) { // not required
+		return nil
+	}
+
+	if m.Triggers != nil {
+		if err := m.Triggers.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("triggers")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("triggers")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Subscription) validateUpdatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.UpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("updated_at", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Subscription) validateURL(formats strfmt.Registry) error {
+
+	if err := validate.Required("url", "body", m.URL); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this subscription based on the context it is used
+func (m *Subscription) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateTriggers(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Subscription) contextValidateTriggers(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Triggers != nil {
+		if err := m.Triggers.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("triggers")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("triggers")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Subscription) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Subscription) UnmarshalBinary(b []byte) error {
+	var res Subscription
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// SubscriptionCreateParams subscription create params
+//
+// swagger:model subscription-create-params
+type SubscriptionCreateParams struct {
+
+	// The cluster to subscribe to. All the clusters accessible by the user are subscribed if not set.
+	// Format: uuid
+	ClusterID *strfmt.UUID `json:"cluster_id,omitempty"`
+
+	// Whether notifications are delivered.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Secret used to sign the notifications with HMAC-SHA256. Notifications are not signed if not set.
+	Secret string `json:"secret,omitempty"`
+
+	// The changes that trigger a delivery.
+	Triggers *SubscriptionTriggers `json:"triggers,omitempty" gorm:"embedded;embeddedPrefix:triggers_"`
+
+	// The URL notifications are posted to.
+	// Required: true
+	URL *string `json:"url"`
+}
+
+// Validate validates this subscription create params
+func (m *SubscriptionCreateParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTriggers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateURL(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SubscriptionCreateParams) validateClusterID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ClusterID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("cluster_id", "body", "uuid", m.ClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SubscriptionCreateParams) validateTriggers(formats strfmt.Registry) error {
+	if swag.IsZero(m.Triggers) { // not required
+		return nil
+	}
+
+	if m.Triggers != nil {
+		if err := m.Triggers.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("triggers")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("triggers")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *SubscriptionCreateParams) validateURL(formats strfmt.Registry) error {
+
+	if err := validate.Required("url", "body", m.URL); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this subscription create params based on the context it is used
+func (m *SubscriptionCreateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateTriggers(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SubscriptionCreateParams) contextValidateTriggers(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Triggers != nil {
+		if err := m.Triggers.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("triggers")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("triggers")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SubscriptionCreateParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SubscriptionCreateParams) UnmarshalBinary(b []byte) error {
+	var res SubscriptionCreateParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,306 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// SubscriptionDelivery subscription delivery
+//
+// swagger:model subscription-delivery
+type SubscriptionDelivery struct {
+
+	// The delivery attempts, starting from the most recent.
+	AttemptLog []*SubscriptionDeliveryAttempt `json:"attempt_log" gorm:"foreignkey:DeliveryID;references:ID"`
+
+	// Number of delivery attempts since the delivery was created or redelivered.
+	Attempts int64 `json:"attempts,omitempty"`
+
+	// cluster id
+	// Format: uuid
+	ClusterID *strfmt.UUID `json:"cluster_id,omitempty"`
+
+	// created at
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty" gorm:"type:timestamp with time zone;index"`
+
+	// host id
+	// Format: uuid
+	HostID *strfmt.UUID `json:"host_id,omitempty"`
+
+	// Unique identifier of the delivery, sent in the X-Assisted-Delivery header.
+	// Required: true
+	// Format: uuid
+	ID *strfmt.UUID `json:"id" gorm:"primaryKey"`
+
+	// next attempt at
+	// Format: date-time
+	NextAttemptAt strfmt.DateTime `json:"next_attempt_at,omitempty" gorm:"type:timestamp with time zone;index"`
+
+	// status
+	// Required: true
+	// Enum: [pending delivered failed]
+	Status *string `json:"status" gorm:"index"`
+
+	// The result of the last delivery attempt.
+	StatusInfo string `json:"status_info,omitempty"`
+
+	// subscription id
+	// Required: true
+	// Format: uuid
+	SubscriptionID *strfmt.UUID `json:"subscription_id" gorm:"index;uniqueIndex:idx_subscription_deliveries_dedup,priority:1"`
+
+	// The change that triggered the delivery, e.g. cluster status 'installed' or event 'host_validation_failed'.
+	Trigger string `json:"trigger,omitempty"`
+}
+
+// Validate validates this subscription delivery
+func (m *SubscriptionDelivery) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAttemptLog(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNextAttemptAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSubscriptionID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SubscriptionDelivery) validateAttemptLog(formats strfmt.Registry) error {
+	if swag.IsZero(m.AttemptLog) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.AttemptLog); i++ {
+		if swag.IsZero(m.AttemptLog[i]) { // not required
+			continue
+		}
+
+		if m.AttemptLog[i] != nil {
+			if err := m.AttemptLog[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("attempt_log" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("attempt_log" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *SubscriptionDelivery) validateClusterID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ClusterID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("cluster_id", "body", "uuid", m.ClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SubscriptionDelivery) validateCreatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SubscriptionDelivery) validateHostID(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("host_id", "body", "uuid", m.HostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SubscriptionDelivery) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SubscriptionDelivery) validateNextAttemptAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.NextAttemptAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("next_attempt_at", "body", "date-time", m.NextAttemptAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var subscriptionDeliveryTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pending","delivered","failed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		subscriptionDeliveryTypeStatusPropEnum = append(subscriptionDeliveryTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// SubscriptionDeliveryStatusPending captures enum value "pending"
+	SubscriptionDeliveryStatusPending string = "pending"
+
+	// SubscriptionDeliveryStatusDelivered captures enum value "delivered"
+	SubscriptionDeliveryStatusDelivered string = "delivered"
+
+	// SubscriptionDeliveryStatusFailed captures enum value "failed"
+	SubscriptionDeliveryStatusFailed string = "failed"
+)
+
+// prop value enum
+func (m *SubscriptionDelivery) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, subscriptionDeliveryTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *SubscriptionDelivery) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SubscriptionDelivery) validateSubscriptionID(formats strfmt.Registry) error {
+
+	if err := validate.Required("subscription_id", "body", m.SubscriptionID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("subscription_id", "body", "uuid", m.SubscriptionID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this subscription delivery based on the context it is used
+func (m *SubscriptionDelivery) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAttemptLog(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SubscriptionDelivery) contextValidateAttemptLog(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.AttemptLog); i++ {
+
+		if m.AttemptLog[i] != nil {
+			if err := m.AttemptLog[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("attempt_log" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("attempt_log" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SubscriptionDelivery) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SubscriptionDelivery) UnmarshalBinary(b []byte) error {
+	var res SubscriptionDelivery
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
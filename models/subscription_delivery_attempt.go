@@ -0,0 +1,106 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// SubscriptionDeliveryAttempt subscription delivery attempt
+//
+// swagger:model subscription-delivery-attempt
+type SubscriptionDeliveryAttempt struct {
+
+	// attempted at
+	// Format: date-time
+	AttemptedAt strfmt.DateTime `json:"attempted_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// delivery id
+	// Format: uuid
+	DeliveryID strfmt.UUID `json:"delivery_id,omitempty" gorm:"index"`
+
+	// duration ms
+	DurationMs int64 `json:"duration_ms,omitempty"`
+
+	// The reason of the failure, if the attempt failed.
+	Error string `json:"error,omitempty" gorm:"type:varchar(1024)"`
+
+	// id
+	ID int64 `json:"id,omitempty" gorm:"primaryKey"`
+
+	// The HTTP status code of the response, unset if no response was received.
+	StatusCode int64 `json:"status_code,omitempty"`
+}
+
+// Validate validates this subscription delivery attempt
+func (m *SubscriptionDeliveryAttempt) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAttemptedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDeliveryID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SubscriptionDeliveryAttempt) validateAttemptedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.AttemptedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("attempted_at", "body", "date-time", m.AttemptedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SubscriptionDeliveryAttempt) validateDeliveryID(formats strfmt.Registry) error {
+	if swag.IsZero(m.DeliveryID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("delivery_id", "body", "uuid", m.DeliveryID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this subscription delivery attempt based on context it is used
+func (m *SubscriptionDeliveryAttempt) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SubscriptionDeliveryAttempt) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SubscriptionDeliveryAttempt) UnmarshalBinary(b []byte) error {
+	var res SubscriptionDeliveryAttempt
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
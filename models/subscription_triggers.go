@@ -0,0 +1,60 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/lib/pq"
+)
+
+// SubscriptionTriggers The changes that trigger a delivery. A delivery is triggered by any of the changes, or by any change if none is specified.
+//
+// swagger:model subscription-triggers
+type SubscriptionTriggers struct {
+
+	// Cluster statuses that trigger a delivery when a subscribed cluster enters them.
+	ClusterStatuses pq.StringArray `json:"cluster_statuses" gorm:"type:text[]"`
+
+	// Names of the events that trigger a delivery, e.g. host_validation_failed.
+	EventNames pq.StringArray `json:"event_names" gorm:"type:text[]"`
+
+	// Severities of the events that trigger a delivery.
+	EventSeverities pq.StringArray `json:"event_severities" gorm:"type:text[]"`
+
+	// Host statuses that trigger a delivery when a host of a subscribed cluster enters them.
+	HostStatuses pq.StringArray `json:"host_statuses" gorm:"type:text[]"`
+}
+
+// Validate validates this subscription triggers
+func (m *SubscriptionTriggers) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this subscription triggers based on context it is used
+func (m *SubscriptionTriggers) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SubscriptionTriggers) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SubscriptionTriggers) UnmarshalBinary(b []byte) error {
+	var res SubscriptionTriggers
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// SubscriptionUpdateParams subscription update params
+//
+// swagger:model subscription-update-params
+type SubscriptionUpdateParams struct {
+
+	// Whether notifications are delivered.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Secret used to sign the notifications with HMAC-SHA256. An empty secret disables signing.
+	Secret *string `json:"secret,omitempty"`
+
+	// The changes that trigger a delivery, replacing the existing ones.
+	Triggers *SubscriptionTriggers `json:"triggers,omitempty" gorm:"embedded;embeddedPrefix:triggers_"`
+
+	// The URL notifications are posted to.
+	URL *string `json:"url,omitempty"`
+}
+
+// Validate validates this subscription update params
+func (m *SubscriptionUpdateParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateTriggers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SubscriptionUpdateParams) validateTriggers(formats strfmt.Registry) error {
+	if swag.IsZero(m.Triggers) { // not required
+		return nil
+	}
+
+	if m.Triggers != nil {
+		if err := m.Triggers.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("triggers")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("triggers")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this subscription update params based on the context it is used
+func (m *SubscriptionUpdateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateTriggers(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SubscriptionUpdateParams) contextValidateTriggers(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Triggers != nil {
+		if err := m.Triggers.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("triggers")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("triggers")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SubscriptionUpdateParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SubscriptionUpdateParams) UnmarshalBinary(b []byte) error {
+	var res SubscriptionUpdateParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
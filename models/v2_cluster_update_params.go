@@ -30,6 +30,9 @@ type V2ClusterUpdateParams struct {
 	// The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.
 	APIVips []*APIVip `json:"api_vips"`
 
+	// Measure the network bandwidth between the hosts of the cluster and validate it against the minimum bandwidth of their role.
+	BandwidthCheckEnabled *bool `json:"bandwidth_check_enabled,omitempty"`
+
 	// Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.
 	BaseDNSDomain *string `json:"base_dns_domain,omitempty"`
 
@@ -51,6 +54,9 @@ type V2ClusterUpdateParams struct {
 	// Installation disks encryption mode and host roles to be applied.
 	DiskEncryption *DiskEncryption `json:"disk_encryption,omitempty" gorm:"embedded;embeddedPrefix:disk_encryption_"`
 
+	// The firmware settings that the hosts of the cluster must comply with. An empty policy removes the policy of the cluster.
+	FirmwarePolicy *FirmwarePolicy `json:"firmware_policy,omitempty"`
+
 	// A proxy URL to use for creating HTTP connections outside the cluster.
 	// http://\<username\>:\<pswd\>@\<ip\>:\<port\>
 	//
@@ -151,6 +157,10 @@ func (m *V2ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateFirmwarePolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHyperthreading(formats); err != nil {
 		res = append(res, err)
 	}
@@ -304,6 +314,25 @@ func (m *V2ClusterUpdateParams) validateDiskEncryption(formats strfmt.Registry)
 	return nil
 }
 
+func (m *V2ClusterUpdateParams) validateFirmwarePolicy(formats strfmt.Registry) error {
+	if swag.IsZero(m.FirmwarePolicy) { // not required
+		return nil
+	}
+
+	if m.FirmwarePolicy != nil {
+		if err := m.FirmwarePolicy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("firmware_policy")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("firmware_policy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 var v2ClusterUpdateParamsTypeHyperthreadingPropEnum []interface{}
 
 func init() {
@@ -626,6 +655,10 @@ func (m *V2ClusterUpdateParams) ContextValidate(ctx context.Context, formats str
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateFirmwarePolicy(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateIgnitionEndpoint(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -716,6 +749,22 @@ func (m *V2ClusterUpdateParams) contextValidateDiskEncryption(ctx context.Contex
 	return nil
 }
 
+func (m *V2ClusterUpdateParams) contextValidateFirmwarePolicy(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.FirmwarePolicy != nil {
+		if err := m.FirmwarePolicy.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("firmware_policy")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("firmware_policy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *V2ClusterUpdateParams) contextValidateIgnitionEndpoint(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.IgnitionEndpoint != nil {
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/NYTimes/gziphandler"
 	errormiddleware "github.com/go-openapi/errors"
 	"github.com/openshift/assisted-service/client"
 	"github.com/openshift/assisted-service/pkg/thread"
@@ -35,6 +36,19 @@ func WithMetricsResponderMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// WithGzipMiddleware returns middleware which compresses responses, except for the watch API server-sent events
+// stream that must reach the client as soon as each event is flushed
+func WithGzipMiddleware(next http.Handler) http.Handler {
+	gzipped := gziphandler.GzipHandler(next)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == client.DefaultBasePath+"/v2/watch" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		gzipped.ServeHTTP(w, r)
+	})
+}
+
 // WithHealthMiddleware returns middleware which responds to the /health endpoint
 func WithHealthMiddleware(next http.Handler, threads []*thread.Thread, logger logrus.FieldLogger, timeout time.Duration) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
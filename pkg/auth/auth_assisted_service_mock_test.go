@@ -73,6 +73,10 @@ func (f fakeInventory) V2InstallCluster(ctx context.Context, params installer.V2
 	return installer.NewV2InstallClusterAccepted()
 }
 
+func (f fakeInventory) V2DryRunInstallCluster(ctx context.Context, params installer.V2DryRunInstallClusterParams) middleware.Responder {
+	return installer.NewV2DryRunInstallClusterOK()
+}
+
 func (f fakeInventory) V2ListClusters(ctx context.Context, params installer.V2ListClustersParams) middleware.Responder {
 	return installer.NewV2ListClustersOK()
 }
@@ -105,6 +109,10 @@ func (f fakeInventory) V2UpdateHost(ctx context.Context, params installer.V2Upda
 	return installer.NewV2UpdateHostCreated()
 }
 
+func (f fakeInventory) V2BulkUpdateHosts(ctx context.Context, params installer.V2BulkUpdateHostsParams) middleware.Responder {
+	return installer.NewV2BulkUpdateHostsOK()
+}
+
 func (f fakeInventory) V2GetClusterInstallConfig(ctx context.Context, params installer.V2GetClusterInstallConfigParams) middleware.Responder {
 	return installer.NewV2GetClusterInstallConfigOK()
 }
@@ -213,6 +221,18 @@ func (f fakeInventory) V2InstallHost(ctx context.Context, params installer.V2Ins
 	return installer.NewV2InstallHostAccepted()
 }
 
+func (f fakeInventory) V2GetClusterNetworkTopology(ctx context.Context, params installer.V2GetClusterNetworkTopologyParams) middleware.Responder {
+	return installer.NewV2GetClusterNetworkTopologyOK()
+}
+
+func (f fakeInventory) V2ListHostHardwareRevisions(ctx context.Context, params installer.V2ListHostHardwareRevisionsParams) middleware.Responder {
+	return installer.NewV2ListHostHardwareRevisionsOK()
+}
+
+func (f fakeInventory) V2AcceptHostHardware(ctx context.Context, params installer.V2AcceptHostHardwareParams) middleware.Responder {
+	return installer.NewV2AcceptHostHardwareOK()
+}
+
 func (f fakeInventory) V2DownloadClusterCredentials(ctx context.Context, params installer.V2DownloadClusterCredentialsParams) middleware.Responder {
 	file, err := os.CreateTemp("/tmp", "test.file")
 	if err != nil {
@@ -298,6 +318,10 @@ func (f fakeEventsAPI) V2TriggerEvent(ctx context.Context, params eventsapi.V2Tr
 	return eventsapi.NewV2TriggerEventCreated()
 }
 
+func (f fakeEventsAPI) V2Watch(ctx context.Context, params eventsapi.V2WatchParams) middleware.Responder {
+	return eventsapi.NewV2WatchOK()
+}
+
 type fakeVersionsAPI struct{}
 
 func (f fakeVersionsAPI) V2ListComponentVersions(
@@ -34,7 +34,7 @@ func (q *Query) Evaluate(input any, output any, variables ...Variable) error {
 		names[i] = variable.name
 		values[i] = variable.value
 	}
-	if !reflect.DeepEqual(names, q.variables) {
+	if !slices.Equal(names, q.variables) {
 		return fmt.Errorf(
 			"query was compiled with variables %s but used with %s",
 			q.variables, names,
@@ -272,4 +272,20 @@ var _ = Describe("Tool", func() {
 		Expect(p.A).To(Equal("hello"))
 		Expect(p.B).To(Equal(123))
 	})
+
+	It("Evaluates compiled queries without variables", func() {
+		// Create the instance:
+		tool, err := NewTool().
+			SetLogger(logger).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Check that the compiled query can be evaluated:
+		query, err := tool.Compile(`.x > 1`)
+		Expect(err).ToNot(HaveOccurred())
+		var result bool
+		err = query.Evaluate(map[string]any{"x": 42}, &result)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeTrue())
+	})
 })
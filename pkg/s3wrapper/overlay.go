@@ -0,0 +1,207 @@
+package s3wrapper
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type overlayObject struct {
+	data     []byte
+	metadata map[string]string
+	deleted  bool
+}
+
+// Overlay is a copy-on-write view of an object store. Objects that are written or deleted through the overlay are
+// kept in memory, while the other objects are read from the underlying store, which is never modified.
+type Overlay struct {
+	base    API
+	lock    sync.RWMutex
+	objects map[string]*overlayObject
+}
+
+var _ API = &Overlay{}
+
+func NewOverlay(base API) *Overlay {
+	return &Overlay{
+		base:    base,
+		objects: make(map[string]*overlayObject),
+	}
+}
+
+// Written returns the objects that were written through the overlay and were not deleted afterwards, keyed by
+// object name
+func (o *Overlay) Written() map[string][]byte {
+	o.lock.RLock()
+	defer o.lock.RUnlock()
+	ret := make(map[string][]byte)
+	for name, obj := range o.objects {
+		if !obj.deleted {
+			ret[name] = obj.data
+		}
+	}
+	return ret
+}
+
+func (o *Overlay) get(objectName string) (*overlayObject, bool) {
+	o.lock.RLock()
+	defer o.lock.RUnlock()
+	obj, ok := o.objects[objectName]
+	return obj, ok
+}
+
+func (o *Overlay) put(objectName string, data []byte, metadata map[string]string) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	o.objects[objectName] = &overlayObject{data: data, metadata: metadata}
+}
+
+func (o *Overlay) IsAwsS3() bool {
+	return o.base.IsAwsS3()
+}
+
+func (o *Overlay) CreateBucket() error {
+	return nil
+}
+
+func (o *Overlay) Upload(ctx context.Context, data []byte, objectName string) error {
+	return o.UploadWithMetadata(ctx, data, objectName, nil)
+}
+
+func (o *Overlay) UploadWithMetadata(ctx context.Context, data []byte, objectName string, metadata map[string]string) error {
+	o.put(objectName, append([]byte(nil), data...), metadata)
+	return nil
+}
+
+func (o *Overlay) UploadStream(ctx context.Context, reader io.Reader, objectName string) error {
+	return o.UploadStreamWithMetadata(ctx, reader, objectName, nil)
+}
+
+func (o *Overlay) UploadStreamWithMetadata(ctx context.Context, reader io.Reader, objectName string, metadata map[string]string) error {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read data for object %s", objectName)
+	}
+	o.put(objectName, data, metadata)
+	return nil
+}
+
+func (o *Overlay) UploadFile(ctx context.Context, filePath, objectName string) error {
+	return o.UploadFileWithMetadata(ctx, filePath, objectName, nil)
+}
+
+func (o *Overlay) UploadFileWithMetadata(ctx context.Context, filePath, objectName string, metadata map[string]string) error {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read file %s", filePath)
+	}
+	o.put(objectName, data, metadata)
+	return nil
+}
+
+func (o *Overlay) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	if obj, ok := o.get(objectName); ok {
+		if obj.deleted {
+			return nil, 0, common.NotFound(objectName)
+		}
+		return io.NopCloser(bytes.NewReader(obj.data)), int64(len(obj.data)), nil
+	}
+	return o.base.Download(ctx, objectName)
+}
+
+func (o *Overlay) DoesObjectExist(ctx context.Context, objectName string) (bool, error) {
+	if obj, ok := o.get(objectName); ok {
+		return !obj.deleted, nil
+	}
+	return o.base.DoesObjectExist(ctx, objectName)
+}
+
+func (o *Overlay) WaitForObject(ctx context.Context, objectName string) error {
+	exists, err := o.DoesObjectExist(ctx, objectName)
+	if err != nil {
+		return errors.Wrapf(err, "error checking if object %s exists", objectName)
+	}
+	if !exists {
+		return errors.Errorf("object %s not found", objectName)
+	}
+	return nil
+}
+
+func (o *Overlay) DeleteObject(ctx context.Context, objectName string) (bool, error) {
+	exists, err := o.DoesObjectExist(ctx, objectName)
+	if err != nil {
+		return false, err
+	}
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	o.objects[objectName] = &overlayObject{deleted: true}
+	return exists, nil
+}
+
+func (o *Overlay) GetObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
+	if obj, ok := o.get(objectName); ok {
+		if obj.deleted {
+			return 0, common.NotFound(objectName)
+		}
+		return int64(len(obj.data)), nil
+	}
+	return o.base.GetObjectSizeBytes(ctx, objectName)
+}
+
+func (o *Overlay) GeneratePresignedDownloadURL(ctx context.Context, objectName string, downloadFilename string, duration time.Duration) (string, error) {
+	return "", errors.Errorf("presigned download URLs are not supported for object %s in an overlay", objectName)
+}
+
+// UpdateObjectTimestamp only reports whether the object exists, as the timestamps of the underlying store must not
+// be modified
+func (o *Overlay) UpdateObjectTimestamp(ctx context.Context, objectName string) (bool, error) {
+	return o.DoesObjectExist(ctx, objectName)
+}
+
+// ExpireObjects does nothing, as objects of the underlying store must not be deleted
+func (o *Overlay) ExpireObjects(ctx context.Context, prefix string, deleteTime time.Duration, callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+}
+
+func (o *Overlay) ListObjectsByPrefix(ctx context.Context, prefix string) ([]string, error) {
+	objects, err := o.ListObjectsByPrefixWithMetadata(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]string, 0, len(objects))
+	for _, obj := range objects {
+		ret = append(ret, obj.Path)
+	}
+	return ret, nil
+}
+
+func (o *Overlay) ListObjectsByPrefixWithMetadata(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	baseObjects, err := o.base.ListObjectsByPrefixWithMetadata(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	o.lock.RLock()
+	defer o.lock.RUnlock()
+	var ret []ObjectInfo
+	for _, obj := range baseObjects {
+		if _, ok := o.objects[obj.Path]; !ok {
+			ret = append(ret, obj)
+		}
+	}
+	for name, obj := range o.objects {
+		if !obj.deleted && strings.HasPrefix(name, prefix) {
+			ret = append(ret, ObjectInfo{Path: name, Metadata: obj.metadata})
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Path < ret[j].Path })
+	return ret, nil
+}
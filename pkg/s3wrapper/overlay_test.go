@@ -0,0 +1,112 @@
+package s3wrapper
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+)
+
+var _ = Describe("Overlay", func() {
+	var (
+		ctx     = context.Background()
+		ctrl    *gomock.Controller
+		base    *MockAPI
+		overlay *Overlay
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		base = NewMockAPI(ctrl)
+		overlay = NewOverlay(base)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	download := func(objectName string) string {
+		reader, length, err := overlay.Download(ctx, objectName)
+		Expect(err).ToNot(HaveOccurred())
+		data, err := io.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(int64(len(data))).To(Equal(length))
+		return string(data)
+	}
+
+	It("keeps written objects without writing to the base", func() {
+		Expect(overlay.Upload(ctx, []byte("data"), "cluster/file")).To(Succeed())
+		Expect(overlay.UploadStream(ctx, strings.NewReader("stream"), "cluster/stream")).To(Succeed())
+
+		Expect(download("cluster/file")).To(Equal("data"))
+		Expect(download("cluster/stream")).To(Equal("stream"))
+		exists, err := overlay.DoesObjectExist(ctx, "cluster/file")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+		Expect(overlay.Written()).To(Equal(map[string][]byte{
+			"cluster/file":   []byte("data"),
+			"cluster/stream": []byte("stream"),
+		}))
+	})
+
+	It("reads objects that were not written from the base", func() {
+		base.EXPECT().Download(ctx, "cluster/base").Return(io.NopCloser(strings.NewReader("base")), int64(4), nil).Times(1)
+		base.EXPECT().DoesObjectExist(ctx, "cluster/base").Return(true, nil).Times(1)
+
+		Expect(download("cluster/base")).To(Equal("base"))
+		exists, err := overlay.DoesObjectExist(ctx, "cluster/base")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+		Expect(overlay.Written()).To(BeEmpty())
+	})
+
+	It("hides deleted objects without deleting them from the base", func() {
+		base.EXPECT().DoesObjectExist(ctx, "cluster/base").Return(true, nil).Times(1)
+
+		deleted, err := overlay.DeleteObject(ctx, "cluster/base")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(BeTrue())
+
+		_, _, err = overlay.Download(ctx, "cluster/base")
+		Expect(err).To(BeAssignableToTypeOf(common.NotFound("")))
+		exists, err := overlay.DoesObjectExist(ctx, "cluster/base")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+	})
+
+	It("lists the objects of the base and the overlay", func() {
+		base.EXPECT().ListObjectsByPrefixWithMetadata(ctx, "cluster/").Return([]ObjectInfo{
+			{Path: "cluster/a"},
+			{Path: "cluster/b"},
+			{Path: "cluster/c", Metadata: map[string]string{"version": "base"}},
+		}, nil).Times(1)
+		base.EXPECT().DoesObjectExist(ctx, "cluster/b").Return(true, nil).Times(1)
+
+		Expect(overlay.UploadWithMetadata(ctx, []byte("c"), "cluster/c", map[string]string{"version": "overlay"})).To(Succeed())
+		Expect(overlay.Upload(ctx, []byte("d"), "cluster/d")).To(Succeed())
+		Expect(overlay.Upload(ctx, []byte("other"), "other/e")).To(Succeed())
+		_, err := overlay.DeleteObject(ctx, "cluster/b")
+		Expect(err).ToNot(HaveOccurred())
+
+		objects, err := overlay.ListObjectsByPrefixWithMetadata(ctx, "cluster/")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(Equal([]ObjectInfo{
+			{Path: "cluster/a"},
+			{Path: "cluster/c", Metadata: map[string]string{"version": "overlay"}},
+			{Path: "cluster/d"},
+		}))
+	})
+
+	It("does not expire or update objects of the base", func() {
+		base.EXPECT().DoesObjectExist(ctx, "cluster/base").Return(true, nil).Times(1)
+
+		updated, err := overlay.UpdateObjectTimestamp(ctx, "cluster/base")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated).To(BeTrue())
+		overlay.ExpireObjects(ctx, "cluster/", 0, nil)
+	})
+})
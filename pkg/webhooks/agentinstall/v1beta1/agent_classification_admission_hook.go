@@ -2,6 +2,7 @@ package v1beta1
 
 import (
 	"net/http"
+	"reflect"
 	"strings"
 
 	"github.com/itchyny/gojq"
@@ -167,6 +168,7 @@ func (a *AgentClassificationValidatingAdmissionHook) validateCreate(admissionSpe
 	if err != nil {
 		errs = append(errs, field.Invalid(f, newObject.Spec.Query, err.Error()))
 	}
+	errs = append(errs, validateAdditionalLabels(&newObject.Spec, f.Child("additionalLabels"))...)
 
 	if len(errs) > 0 {
 		contextLogger.Infof("Validation failed: %s", errs.ToAggregate().Error())
@@ -223,7 +225,8 @@ func (a *AgentClassificationValidatingAdmissionHook) validateUpdate(admissionSpe
 	}
 
 	// Validate that the label key and value haven't changed
-	if (oldObject.Spec.LabelKey != newObject.Spec.LabelKey) || (oldObject.Spec.LabelValue != newObject.Spec.LabelValue) {
+	if (oldObject.Spec.LabelKey != newObject.Spec.LabelKey) || (oldObject.Spec.LabelValue != newObject.Spec.LabelValue) ||
+		!reflect.DeepEqual(oldObject.Spec.AdditionalLabels, newObject.Spec.AdditionalLabels) {
 		return &admissionv1.AdmissionResponse{
 			Allowed: false,
 			Result: &metav1.Status{
@@ -239,3 +242,32 @@ func (a *AgentClassificationValidatingAdmissionHook) validateUpdate(admissionSpe
 		Allowed: true,
 	}
 }
+
+// validateAdditionalLabels validates that every additional label has a valid and unique key, and exactly one of a valid
+// value and a query that can be parsed
+func validateAdditionalLabels(spec *v1beta1.AgentClassificationSpec, f *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	keys := map[string]bool{spec.LabelKey: true}
+	for i, label := range spec.AdditionalLabels {
+		labelPath := f.Index(i)
+		if keys[label.Key] {
+			errs = append(errs, field.Duplicate(labelPath.Child("key"), label.Key))
+		}
+		keys[label.Key] = true
+		switch {
+		case (label.Value == "") == (label.ValueQuery == ""):
+			errs = append(errs, field.Invalid(labelPath, label.Key, "exactly one of value and valueQuery must be set"))
+		case label.ValueQuery != "":
+			errs = append(errs, validation.ValidateLabels(map[string]string{ClassificationLabelPrefix + label.Key: ""}, labelPath)...)
+			if _, err := gojq.Parse(label.ValueQuery); err != nil {
+				errs = append(errs, field.Invalid(labelPath.Child("valueQuery"), label.ValueQuery, err.Error()))
+			}
+		default:
+			errs = append(errs, validation.ValidateLabels(map[string]string{ClassificationLabelPrefix + label.Key: label.Value}, labelPath)...)
+			if strings.HasPrefix(label.Value, "QUERYERROR") {
+				errs = append(errs, field.Invalid(labelPath.Child("value"), label.Value, "label must not start with QUERYERROR as this is reserved"))
+			}
+		}
+	}
+	return errs
+}
@@ -166,6 +166,69 @@ var _ = Describe("agent classification web validate", func() {
 			operation:       admissionv1.Create,
 			expectedAllowed: false,
 		},
+		{
+			name: "Test AgentClassification additional labels are valid on create",
+			newSpec: v1beta1.AgentClassificationSpec{
+				LabelKey:   validKey,
+				LabelValue: validValue,
+				Query:      validQuery,
+				AdditionalLabels: []v1beta1.AgentClassificationLabel{
+					{Key: "memory-class", Value: "large"},
+					{Key: "gpu-count", ValueQuery: ".gpus | length"},
+				},
+			},
+			oldSpec:         v1beta1.AgentClassificationSpec{},
+			operation:       admissionv1.Create,
+			expectedAllowed: true,
+		},
+		{
+			name: "Test AgentClassification additional label key is duplicated on create",
+			newSpec: v1beta1.AgentClassificationSpec{
+				LabelKey:         validKey,
+				LabelValue:       validValue,
+				Query:            validQuery,
+				AdditionalLabels: []v1beta1.AgentClassificationLabel{{Key: validKey, Value: "large"}},
+			},
+			oldSpec:         v1beta1.AgentClassificationSpec{},
+			operation:       admissionv1.Create,
+			expectedAllowed: false,
+		},
+		{
+			name: "Test AgentClassification additional label has a value and a value query on create",
+			newSpec: v1beta1.AgentClassificationSpec{
+				LabelKey:         validKey,
+				LabelValue:       validValue,
+				Query:            validQuery,
+				AdditionalLabels: []v1beta1.AgentClassificationLabel{{Key: "gpu-count", Value: "1", ValueQuery: ".gpus | length"}},
+			},
+			oldSpec:         v1beta1.AgentClassificationSpec{},
+			operation:       admissionv1.Create,
+			expectedAllowed: false,
+		},
+		{
+			name: "Test AgentClassification additional label value query is invalid on create",
+			newSpec: v1beta1.AgentClassificationSpec{
+				LabelKey:         validKey,
+				LabelValue:       validValue,
+				Query:            validQuery,
+				AdditionalLabels: []v1beta1.AgentClassificationLabel{{Key: "gpu-count", ValueQuery: invalidQuery}},
+			},
+			oldSpec:         v1beta1.AgentClassificationSpec{},
+			operation:       admissionv1.Create,
+			expectedAllowed: false,
+		},
+		{
+			name: "Test AgentClassification additional label value is invalid on create",
+			newSpec: v1beta1.AgentClassificationSpec{
+				LabelKey:         validKey,
+				LabelValue:       validValue,
+				Query:            validQuery,
+				AdditionalLabels: []v1beta1.AgentClassificationLabel{{Key: "memory-class", Value: invalidValue}},
+			},
+			oldSpec:         v1beta1.AgentClassificationSpec{},
+			operation:       admissionv1.Create,
+			expectedAllowed: false,
+		},
 		{
 			name: "Test AgentClassification label key is changed on update",
 			newSpec: v1beta1.AgentClassificationSpec{
@@ -196,6 +259,40 @@ var _ = Describe("agent classification web validate", func() {
 			operation:       admissionv1.Update,
 			expectedAllowed: false,
 		},
+		{
+			name: "Test AgentClassification additional labels are changed on update",
+			newSpec: v1beta1.AgentClassificationSpec{
+				LabelKey:         validKey,
+				LabelValue:       validValue,
+				Query:            validQuery,
+				AdditionalLabels: []v1beta1.AgentClassificationLabel{{Key: "memory-class", Value: "small"}},
+			},
+			oldSpec: v1beta1.AgentClassificationSpec{
+				LabelKey:         validKey,
+				LabelValue:       validValue,
+				Query:            validQuery,
+				AdditionalLabels: []v1beta1.AgentClassificationLabel{{Key: "memory-class", Value: "large"}},
+			},
+			operation:       admissionv1.Update,
+			expectedAllowed: false,
+		},
+		{
+			name: "Test AgentClassification priority and dry run are changed on update",
+			newSpec: v1beta1.AgentClassificationSpec{
+				LabelKey:   validKey,
+				LabelValue: validValue,
+				Query:      validQuery,
+				Priority:   10,
+				DryRun:     true,
+			},
+			oldSpec: v1beta1.AgentClassificationSpec{
+				LabelKey:   validKey,
+				LabelValue: validValue,
+				Query:      validQuery,
+			},
+			operation:       admissionv1.Update,
+			expectedAllowed: true,
+		},
 		{
 			name: "Test AgentClassification query is changed on update",
 			newSpec: v1beta1.AgentClassificationSpec{
@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/go-openapi/errors"
@@ -15,11 +16,17 @@ import (
 	"github.com/go-openapi/runtime/security"
 
 	"github.com/openshift/assisted-service/restapi/operations"
+	"github.com/openshift/assisted-service/restapi/operations/cluster_archives"
+	"github.com/openshift/assisted-service/restapi/operations/cluster_plan"
+	"github.com/openshift/assisted-service/restapi/operations/cluster_revisions"
+	"github.com/openshift/assisted-service/restapi/operations/cluster_templates"
 	"github.com/openshift/assisted-service/restapi/operations/events"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/openshift/assisted-service/restapi/operations/installer_cache"
 	"github.com/openshift/assisted-service/restapi/operations/managed_domains"
 	"github.com/openshift/assisted-service/restapi/operations/manifests"
 	"github.com/openshift/assisted-service/restapi/operations/operators"
+	"github.com/openshift/assisted-service/restapi/operations/subscriptions"
 	"github.com/openshift/assisted-service/restapi/operations/versions"
 )
 
@@ -27,6 +34,71 @@ type contextKey string
 
 const AuthKey contextKey = "Auth"
 
+//go:generate mockery -name ClusterArchivesAPI -inpkg
+
+/* ClusterArchivesAPI  */
+type ClusterArchivesAPI interface {
+	/* V2ExportCluster Exports the definition of a cluster to an archive that can be imported by another service. The secrets of the cluster are encrypted with the key of the service that imports the archive. */
+	V2ExportCluster(ctx context.Context, params cluster_archives.V2ExportClusterParams) middleware.Responder
+
+	/* V2GetClusterArchiveEncryptionKey Retrieves the public key that the secrets of the archives imported by this service are encrypted with. Set it as the recipient key when exporting a cluster from another service. */
+	V2GetClusterArchiveEncryptionKey(ctx context.Context, params cluster_archives.V2GetClusterArchiveEncryptionKeyParams) middleware.Responder
+
+	/* V2ImportClusterArchive Registers a cluster, its infra-envs and its custom manifests from an archive exported by another service. The secrets of the archive must be encrypted with the key of this service. */
+	V2ImportClusterArchive(ctx context.Context, params cluster_archives.V2ImportClusterArchiveParams) middleware.Responder
+}
+
+//go:generate mockery -name ClusterPlanAPI -inpkg
+
+/* ClusterPlanAPI  */
+type ClusterPlanAPI interface {
+	/* V2ApplyClusterPlan Computes the changes needed to bring the cluster, its infra-env, hosts, custom manifests and operators
+	   to the state described by the plan, and applies them unless dry_run is set. Applying a plan that was
+	   already applied makes no changes.
+	*/
+	V2ApplyClusterPlan(ctx context.Context, params cluster_plan.V2ApplyClusterPlanParams) middleware.Responder
+}
+
+//go:generate mockery -name ClusterRevisionsAPI -inpkg
+
+/* ClusterRevisionsAPI  */
+type ClusterRevisionsAPI interface {
+	/* V2GetClusterRevisionDiff Returns the differences between a revision of the configuration of the cluster and its current
+	   configuration, or another revision if base_revision is set.
+	*/
+	V2GetClusterRevisionDiff(ctx context.Context, params cluster_revisions.V2GetClusterRevisionDiffParams) middleware.Responder
+
+	/* V2ListClusterRevisions Lists the revisions of the configuration of the cluster, newest first. A revision is recorded whenever
+	   an update changes the configuration of the cluster.
+	*/
+	V2ListClusterRevisions(ctx context.Context, params cluster_revisions.V2ListClusterRevisionsParams) middleware.Responder
+
+	/* V2RollbackClusterRevision Re-applies a revision of the configuration of the cluster. The changes are applied through the same
+	   updates and validations as the equivalent REST calls. Manifests are not rolled back.
+	*/
+	V2RollbackClusterRevision(ctx context.Context, params cluster_revisions.V2RollbackClusterRevisionParams) middleware.Responder
+}
+
+//go:generate mockery -name ClusterTemplatesAPI -inpkg
+
+/* ClusterTemplatesAPI  */
+type ClusterTemplatesAPI interface {
+	/* V2DeregisterClusterTemplate Deletes a cluster template. Clusters that were registered from the template are not modified. */
+	V2DeregisterClusterTemplate(ctx context.Context, params cluster_templates.V2DeregisterClusterTemplateParams) middleware.Responder
+
+	/* V2GetClusterTemplate Retrieves the details of the cluster template. */
+	V2GetClusterTemplate(ctx context.Context, params cluster_templates.V2GetClusterTemplateParams) middleware.Responder
+
+	/* V2ListClusterTemplates Lists the cluster templates that are accessible by the user. */
+	V2ListClusterTemplates(ctx context.Context, params cluster_templates.V2ListClusterTemplatesParams) middleware.Responder
+
+	/* V2RegisterClusterTemplate Creates a cluster template, from which clusters with the same configuration can be registered. */
+	V2RegisterClusterTemplate(ctx context.Context, params cluster_templates.V2RegisterClusterTemplateParams) middleware.Responder
+
+	/* V2UpdateClusterTemplate Updates a cluster template. Clusters that were registered from the template are not modified. */
+	V2UpdateClusterTemplate(ctx context.Context, params cluster_templates.V2UpdateClusterTemplateParams) middleware.Responder
+}
+
 //go:generate mockery -name EventsAPI -inpkg
 
 /* EventsAPI  */
@@ -36,6 +108,9 @@ type EventsAPI interface {
 
 	/* V2TriggerEvent Add new assisted installer event. */
 	V2TriggerEvent(ctx context.Context, params events.V2TriggerEventParams) middleware.Responder
+
+	/* V2Watch Streams cluster, host, infra-env and event notifications as server-sent events. */
+	V2Watch(ctx context.Context, params events.V2WatchParams) middleware.Responder
 }
 
 //go:generate mockery -name InstallerAPI -inpkg
@@ -133,6 +208,14 @@ type InstallerAPI interface {
 	/* V2UploadLogs Agent API to upload logs. */
 	V2UploadLogs(ctx context.Context, params installer.V2UploadLogsParams) middleware.Responder
 
+	/* V2AcceptHostHardware Accepts the current hardware of a host whose hardware changed after its validations passed, so that the
+	   hardware drift no longer blocks the installation.
+	*/
+	V2AcceptHostHardware(ctx context.Context, params installer.V2AcceptHostHardwareParams) middleware.Responder
+
+	/* V2BulkUpdateHosts Applies a list of operations to the hosts of the infra-env. The operations that update the hosts in place are applied in a single transaction, so either all of them are applied or none is. Bind and unbind operations are applied one by one before them. Each operation is subject to the same validations as the equivalent single host call. */
+	V2BulkUpdateHosts(ctx context.Context, params installer.V2BulkUpdateHostsParams) middleware.Responder
+
 	/* V2CompleteInstallation Agent API to mark a finalizing installation as complete and progress to 100%. */
 	V2CompleteInstallation(ctx context.Context, params installer.V2CompleteInstallationParams) middleware.Responder
 
@@ -148,12 +231,26 @@ type InstallerAPI interface {
 	/* V2DownloadInfraEnvFiles Downloads the customized ignition file for this host */
 	V2DownloadInfraEnvFiles(ctx context.Context, params installer.V2DownloadInfraEnvFilesParams) middleware.Responder
 
+	/* V2DryRunInstallCluster Renders the install-config, the install manifests and ignitions, the operator manifests and the installer
+	   arguments of each host, as the installation of the cluster would, and returns them as a tar.gz bundle.
+	   The cluster is not modified and its stored files are not changed. Generation errors are listed in the
+	   dry-run-report.json file of the bundle.
+	*/
+	V2DryRunInstallCluster(ctx context.Context, params installer.V2DryRunInstallClusterParams) middleware.Responder
+
 	/* V2GetCluster Retrieves the details of the OpenShift cluster. */
 	V2GetCluster(ctx context.Context, params installer.V2GetClusterParams) middleware.Responder
 
 	/* V2GetClusterInstallConfig Get the cluster's install config YAML. */
 	V2GetClusterInstallConfig(ctx context.Context, params installer.V2GetClusterInstallConfigParams) middleware.Responder
 
+	/* V2GetClusterNetworkTopology Returns the network topology of the hosts of the cluster, as discovered by their connectivity checks: the
+	   interfaces and subnets of the hosts, the L2 and L3 links between them with their latency and packet loss, and
+	   the majority groups of the cluster. The topology is returned as JSON, or as a Graphviz DOT graph when the dot
+	   format is requested.
+	*/
+	V2GetClusterNetworkTopology(ctx context.Context, params installer.V2GetClusterNetworkTopologyParams) middleware.Responder
+
 	/* V2GetHost Retrieves the details of the OpenShift host. */
 	V2GetHost(ctx context.Context, params installer.V2GetHostParams) middleware.Responder
 
@@ -181,6 +278,12 @@ type InstallerAPI interface {
 	/* V2ListClusters Retrieves the list of OpenShift clusters. */
 	V2ListClusters(ctx context.Context, params installer.V2ListClustersParams) middleware.Responder
 
+	/* V2ListHostHardwareRevisions Lists the revisions of the hardware of the host, newest first, with the changes of each revision from the
+	   previous one. A revision is recorded whenever the inventory of the host reports a different hardware
+	   fingerprint.
+	*/
+	V2ListHostHardwareRevisions(ctx context.Context, params installer.V2ListHostHardwareRevisionsParams) middleware.Responder
+
 	/* V2ListHosts Retrieves the list of OpenShift hosts that belong the infra-env. */
 	V2ListHosts(ctx context.Context, params installer.V2ListHostsParams) middleware.Responder
 
@@ -236,6 +339,20 @@ type InstallerAPI interface {
 	V2UploadClusterIngressCert(ctx context.Context, params installer.V2UploadClusterIngressCertParams) middleware.Responder
 }
 
+//go:generate mockery -name InstallerCacheAPI -inpkg
+
+/* InstallerCacheAPI  */
+type InstallerCacheAPI interface {
+	/* V2ListInstallerCacheReleases Lists the releases in the installer cache, with their size, last use and pin status. The local cache is the one of the replica that serves the request. */
+	V2ListInstallerCacheReleases(ctx context.Context, params installer_cache.V2ListInstallerCacheReleasesParams) middleware.Responder
+
+	/* V2PrewarmInstallerCacheRelease Pre-warms the installer cache with the installer binary of a release image ahead of demand. The binary is extracted in the background. */
+	V2PrewarmInstallerCacheRelease(ctx context.Context, params installer_cache.V2PrewarmInstallerCacheReleaseParams) middleware.Responder
+
+	/* V2UpdateInstallerCacheRelease Pins or unpins a release that was pre-warmed. Pinned releases are never evicted from the installer cache. */
+	V2UpdateInstallerCacheRelease(ctx context.Context, params installer_cache.V2UpdateInstallerCacheReleaseParams) middleware.Responder
+}
+
 //go:generate mockery -name ManagedDomainsAPI -inpkg
 
 /* ManagedDomainsAPI  */
@@ -287,6 +404,32 @@ type OperatorsAPI interface {
 	V2ReportMonitoredOperatorStatus(ctx context.Context, params operators.V2ReportMonitoredOperatorStatusParams) middleware.Responder
 }
 
+//go:generate mockery -name SubscriptionsAPI -inpkg
+
+/* SubscriptionsAPI  */
+type SubscriptionsAPI interface {
+	/* V2DeregisterSubscription Deletes a webhook subscription and its deliveries. */
+	V2DeregisterSubscription(ctx context.Context, params subscriptions.V2DeregisterSubscriptionParams) middleware.Responder
+
+	/* V2GetSubscription Retrieves the details of the webhook subscription. */
+	V2GetSubscription(ctx context.Context, params subscriptions.V2GetSubscriptionParams) middleware.Responder
+
+	/* V2ListSubscriptionDeliveries Lists the deliveries of the webhook subscription, along with their delivery attempts, starting from the most recent. */
+	V2ListSubscriptionDeliveries(ctx context.Context, params subscriptions.V2ListSubscriptionDeliveriesParams) middleware.Responder
+
+	/* V2ListSubscriptions Lists the webhook subscriptions of the organization. */
+	V2ListSubscriptions(ctx context.Context, params subscriptions.V2ListSubscriptionsParams) middleware.Responder
+
+	/* V2RedeliverSubscriptionDelivery Schedules the delivery to be sent again, regardless of its previous attempts. */
+	V2RedeliverSubscriptionDelivery(ctx context.Context, params subscriptions.V2RedeliverSubscriptionDeliveryParams) middleware.Responder
+
+	/* V2RegisterSubscription Registers a webhook that is called when the subscribed clusters and hosts change. */
+	V2RegisterSubscription(ctx context.Context, params subscriptions.V2RegisterSubscriptionParams) middleware.Responder
+
+	/* V2UpdateSubscription Updates a webhook subscription. */
+	V2UpdateSubscription(ctx context.Context, params subscriptions.V2UpdateSubscriptionParams) middleware.Responder
+}
+
 //go:generate mockery -name VersionsAPI -inpkg
 
 /* VersionsAPI  */
@@ -303,11 +446,17 @@ type VersionsAPI interface {
 
 // Config is configuration for Handler
 type Config struct {
+	ClusterArchivesAPI
+	ClusterPlanAPI
+	ClusterRevisionsAPI
+	ClusterTemplatesAPI
 	EventsAPI
 	InstallerAPI
+	InstallerCacheAPI
 	ManagedDomainsAPI
 	ManifestsAPI
 	OperatorsAPI
+	SubscriptionsAPI
 	VersionsAPI
 	Logger func(string, ...interface{})
 	// InnerMiddleware is for the handler executors. These do not apply to the swagger.json document.
@@ -390,6 +539,9 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 	}
 	api.BinProducer = runtime.ByteStreamProducer()
 	api.JSONProducer = runtime.JSONProducer()
+	api.TextEventStreamProducer = runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+		return errors.NotImplemented("textEventStream producer has not yet been implemented")
+	})
 	api.AgentAuthAuth = func(token string) (interface{}, error) {
 		if c.AuthAgentAuth == nil {
 			return token, nil
@@ -633,6 +785,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2UploadLogs(ctx, params)
 	})
+	api.InstallerV2AcceptHostHardwareHandler = installer.V2AcceptHostHardwareHandlerFunc(func(params installer.V2AcceptHostHardwareParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2AcceptHostHardware(ctx, params)
+	})
+	api.ClusterPlanV2ApplyClusterPlanHandler = cluster_plan.V2ApplyClusterPlanHandlerFunc(func(params cluster_plan.V2ApplyClusterPlanParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterPlanAPI.V2ApplyClusterPlan(ctx, params)
+	})
+	api.InstallerV2BulkUpdateHostsHandler = installer.V2BulkUpdateHostsHandlerFunc(func(params installer.V2BulkUpdateHostsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2BulkUpdateHosts(ctx, params)
+	})
 	api.InstallerV2CompleteInstallationHandler = installer.V2CompleteInstallationHandlerFunc(func(params installer.V2CompleteInstallationParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -643,11 +810,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2DeregisterCluster(ctx, params)
 	})
+	api.ClusterTemplatesV2DeregisterClusterTemplateHandler = cluster_templates.V2DeregisterClusterTemplateHandlerFunc(func(params cluster_templates.V2DeregisterClusterTemplateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.V2DeregisterClusterTemplate(ctx, params)
+	})
 	api.InstallerV2DeregisterHostHandler = installer.V2DeregisterHostHandlerFunc(func(params installer.V2DeregisterHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2DeregisterHost(ctx, params)
 	})
+	api.SubscriptionsV2DeregisterSubscriptionHandler = subscriptions.V2DeregisterSubscriptionHandlerFunc(func(params subscriptions.V2DeregisterSubscriptionParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.SubscriptionsAPI.V2DeregisterSubscription(ctx, params)
+	})
 	api.ManifestsV2DownloadClusterManifestHandler = manifests.V2DownloadClusterManifestHandlerFunc(func(params manifests.V2DownloadClusterManifestParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -663,16 +840,46 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2DownloadInfraEnvFiles(ctx, params)
 	})
+	api.InstallerV2DryRunInstallClusterHandler = installer.V2DryRunInstallClusterHandlerFunc(func(params installer.V2DryRunInstallClusterParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2DryRunInstallCluster(ctx, params)
+	})
+	api.ClusterArchivesV2ExportClusterHandler = cluster_archives.V2ExportClusterHandlerFunc(func(params cluster_archives.V2ExportClusterParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterArchivesAPI.V2ExportCluster(ctx, params)
+	})
 	api.InstallerV2GetClusterHandler = installer.V2GetClusterHandlerFunc(func(params installer.V2GetClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetCluster(ctx, params)
 	})
+	api.ClusterArchivesV2GetClusterArchiveEncryptionKeyHandler = cluster_archives.V2GetClusterArchiveEncryptionKeyHandlerFunc(func(params cluster_archives.V2GetClusterArchiveEncryptionKeyParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterArchivesAPI.V2GetClusterArchiveEncryptionKey(ctx, params)
+	})
 	api.InstallerV2GetClusterInstallConfigHandler = installer.V2GetClusterInstallConfigHandlerFunc(func(params installer.V2GetClusterInstallConfigParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetClusterInstallConfig(ctx, params)
 	})
+	api.InstallerV2GetClusterNetworkTopologyHandler = installer.V2GetClusterNetworkTopologyHandlerFunc(func(params installer.V2GetClusterNetworkTopologyParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GetClusterNetworkTopology(ctx, params)
+	})
+	api.ClusterRevisionsV2GetClusterRevisionDiffHandler = cluster_revisions.V2GetClusterRevisionDiffHandlerFunc(func(params cluster_revisions.V2GetClusterRevisionDiffParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterRevisionsAPI.V2GetClusterRevisionDiff(ctx, params)
+	})
+	api.ClusterTemplatesV2GetClusterTemplateHandler = cluster_templates.V2GetClusterTemplateHandlerFunc(func(params cluster_templates.V2GetClusterTemplateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.V2GetClusterTemplate(ctx, params)
+	})
 	api.InstallerV2GetHostHandler = installer.V2GetHostHandlerFunc(func(params installer.V2GetHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -698,11 +905,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetPreflightRequirements(ctx, params)
 	})
+	api.SubscriptionsV2GetSubscriptionHandler = subscriptions.V2GetSubscriptionHandlerFunc(func(params subscriptions.V2GetSubscriptionParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.SubscriptionsAPI.V2GetSubscription(ctx, params)
+	})
 	api.InstallerV2ImportClusterHandler = installer.V2ImportClusterHandlerFunc(func(params installer.V2ImportClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2ImportCluster(ctx, params)
 	})
+	api.ClusterArchivesV2ImportClusterArchiveHandler = cluster_archives.V2ImportClusterArchiveHandlerFunc(func(params cluster_archives.V2ImportClusterArchiveParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterArchivesAPI.V2ImportClusterArchive(ctx, params)
+	})
 	api.InstallerV2InstallClusterHandler = installer.V2InstallClusterHandlerFunc(func(params installer.V2InstallClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -713,6 +930,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2InstallHost(ctx, params)
 	})
+	api.ClusterRevisionsV2ListClusterRevisionsHandler = cluster_revisions.V2ListClusterRevisionsHandlerFunc(func(params cluster_revisions.V2ListClusterRevisionsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterRevisionsAPI.V2ListClusterRevisions(ctx, params)
+	})
+	api.ClusterTemplatesV2ListClusterTemplatesHandler = cluster_templates.V2ListClusterTemplatesHandlerFunc(func(params cluster_templates.V2ListClusterTemplatesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.V2ListClusterTemplates(ctx, params)
+	})
 	api.InstallerV2ListClustersHandler = installer.V2ListClustersHandlerFunc(func(params installer.V2ListClustersParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -728,16 +955,36 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.EventsAPI.V2ListEvents(ctx, params)
 	})
+	api.InstallerV2ListHostHardwareRevisionsHandler = installer.V2ListHostHardwareRevisionsHandlerFunc(func(params installer.V2ListHostHardwareRevisionsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ListHostHardwareRevisions(ctx, params)
+	})
 	api.InstallerV2ListHostsHandler = installer.V2ListHostsHandlerFunc(func(params installer.V2ListHostsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2ListHosts(ctx, params)
 	})
+	api.InstallerCacheV2ListInstallerCacheReleasesHandler = installer_cache.V2ListInstallerCacheReleasesHandlerFunc(func(params installer_cache.V2ListInstallerCacheReleasesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerCacheAPI.V2ListInstallerCacheReleases(ctx, params)
+	})
 	api.VersionsV2ListReleaseSourcesHandler = versions.V2ListReleaseSourcesHandlerFunc(func(params versions.V2ListReleaseSourcesParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.VersionsAPI.V2ListReleaseSources(ctx, params)
 	})
+	api.SubscriptionsV2ListSubscriptionDeliveriesHandler = subscriptions.V2ListSubscriptionDeliveriesHandlerFunc(func(params subscriptions.V2ListSubscriptionDeliveriesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.SubscriptionsAPI.V2ListSubscriptionDeliveries(ctx, params)
+	})
+	api.SubscriptionsV2ListSubscriptionsHandler = subscriptions.V2ListSubscriptionsHandlerFunc(func(params subscriptions.V2ListSubscriptionsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.SubscriptionsAPI.V2ListSubscriptions(ctx, params)
+	})
 	api.VersionsV2ListSupportedOpenshiftVersionsHandler = versions.V2ListSupportedOpenshiftVersionsHandlerFunc(func(params versions.V2ListSupportedOpenshiftVersionsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -748,11 +995,26 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2PostStepReply(ctx, params)
 	})
+	api.InstallerCacheV2PrewarmInstallerCacheReleaseHandler = installer_cache.V2PrewarmInstallerCacheReleaseHandlerFunc(func(params installer_cache.V2PrewarmInstallerCacheReleaseParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerCacheAPI.V2PrewarmInstallerCacheRelease(ctx, params)
+	})
+	api.SubscriptionsV2RedeliverSubscriptionDeliveryHandler = subscriptions.V2RedeliverSubscriptionDeliveryHandlerFunc(func(params subscriptions.V2RedeliverSubscriptionDeliveryParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.SubscriptionsAPI.V2RedeliverSubscriptionDelivery(ctx, params)
+	})
 	api.InstallerV2RegisterClusterHandler = installer.V2RegisterClusterHandlerFunc(func(params installer.V2RegisterClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2RegisterCluster(ctx, params)
 	})
+	api.ClusterTemplatesV2RegisterClusterTemplateHandler = cluster_templates.V2RegisterClusterTemplateHandlerFunc(func(params cluster_templates.V2RegisterClusterTemplateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.V2RegisterClusterTemplate(ctx, params)
+	})
 	api.InstallerV2RegisterDisconnectedClusterHandler = installer.V2RegisterDisconnectedClusterHandlerFunc(func(params installer.V2RegisterDisconnectedClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -763,6 +1025,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2RegisterHost(ctx, params)
 	})
+	api.SubscriptionsV2RegisterSubscriptionHandler = subscriptions.V2RegisterSubscriptionHandlerFunc(func(params subscriptions.V2RegisterSubscriptionParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.SubscriptionsAPI.V2RegisterSubscription(ctx, params)
+	})
 	api.OperatorsV2ReportMonitoredOperatorStatusHandler = operators.V2ReportMonitoredOperatorStatusHandlerFunc(func(params operators.V2ReportMonitoredOperatorStatusParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -783,6 +1050,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2ResetHostValidation(ctx, params)
 	})
+	api.ClusterRevisionsV2RollbackClusterRevisionHandler = cluster_revisions.V2RollbackClusterRevisionHandlerFunc(func(params cluster_revisions.V2RollbackClusterRevisionParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterRevisionsAPI.V2RollbackClusterRevision(ctx, params)
+	})
 	api.InstallerV2SetIgnoredValidationsHandler = installer.V2SetIgnoredValidationsHandlerFunc(func(params installer.V2SetIgnoredValidationsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -808,6 +1080,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2UpdateClusterLogsProgress(ctx, params)
 	})
+	api.ClusterTemplatesV2UpdateClusterTemplateHandler = cluster_templates.V2UpdateClusterTemplateHandlerFunc(func(params cluster_templates.V2UpdateClusterTemplateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.V2UpdateClusterTemplate(ctx, params)
+	})
 	api.InstallerV2UpdateHostHandler = installer.V2UpdateHostHandlerFunc(func(params installer.V2UpdateHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -833,11 +1110,26 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2UpdateHostLogsProgress(ctx, params)
 	})
+	api.InstallerCacheV2UpdateInstallerCacheReleaseHandler = installer_cache.V2UpdateInstallerCacheReleaseHandlerFunc(func(params installer_cache.V2UpdateInstallerCacheReleaseParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerCacheAPI.V2UpdateInstallerCacheRelease(ctx, params)
+	})
+	api.SubscriptionsV2UpdateSubscriptionHandler = subscriptions.V2UpdateSubscriptionHandlerFunc(func(params subscriptions.V2UpdateSubscriptionParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.SubscriptionsAPI.V2UpdateSubscription(ctx, params)
+	})
 	api.InstallerV2UploadClusterIngressCertHandler = installer.V2UploadClusterIngressCertHandlerFunc(func(params installer.V2UploadClusterIngressCertParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2UploadClusterIngressCert(ctx, params)
 	})
+	api.EventsV2WatchHandler = events.V2WatchHandlerFunc(func(params events.V2WatchParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventsAPI.V2Watch(ctx, params)
+	})
 	api.ServerShutdown = func() {}
 	return api.Serve(c.InnerMiddleware), api, nil
 }
@@ -18,6 +18,7 @@
 //	Produces:
 //	  - application/octet-stream
 //	  - application/json
+//	  - text/event-stream
 //
 // swagger:meta
 package restapi
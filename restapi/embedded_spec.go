@@ -41,7 +41,7 @@ func init() {
   "host": "api.openshift.com",
   "basePath": "/api/assisted-install",
   "paths": {
-    "/v2/clusters": {
+    "/v2/cluster-archives/encryption-key": {
       "get": {
         "security": [
           {
@@ -50,60 +50,18 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "watcherAuth": []
           }
         ],
-        "description": "Retrieves the list of OpenShift clusters.",
+        "description": "Retrieves the public key that the secrets of the archives imported by this service are encrypted with. Set it as the recipient key when exporting a cluster from another service.",
         "tags": [
-          "installer"
-        ],
-        "operationId": "v2ListClusters",
-        "parameters": [
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A specific cluster to retrieve.",
-            "name": "openshift_cluster_id",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
-            "name": "ams_subscription_ids",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Include hosts in the returned list.",
-            "name": "with_hosts",
-            "in": "query",
-            "allowEmptyValue": true
-          },
-          {
-            "type": "string",
-            "description": "If provided, returns only clusters that are owned by the specified user.",
-            "name": "owner",
-            "in": "query"
-          }
+          "cluster_archives"
         ],
+        "operationId": "v2GetClusterArchiveEncryptionKey",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-list"
+              "$ref": "#/definitions/cluster-archive-encryption-key"
             }
           },
           "401": {
@@ -118,40 +76,36 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
+      }
+    },
+    "/v2/cluster-archives/import": {
       "post": {
-        "description": "Creates a new OpenShift cluster definition.",
+        "description": "Registers a cluster, its infra-envs and its custom manifests from an archive exported by another service. The secrets of the archive must be encrypted with the key of this service.",
         "tags": [
-          "installer"
+          "cluster_archives"
         ],
-        "operationId": "v2RegisterCluster",
+        "operationId": "v2ImportClusterArchive",
         "parameters": [
           {
-            "description": "The properties describing the new cluster.",
-            "name": "new-cluster-params",
+            "description": "The exported cluster.",
+            "name": "archive",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/cluster-create-params"
+              "$ref": "#/definitions/cluster-archive"
             }
           }
         ],
@@ -159,7 +113,7 @@ func init() {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/cluster-import-result"
             }
           },
           "400": {
@@ -180,14 +134,14 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -195,7 +149,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/default-config": {
+    "/v2/cluster-templates": {
       "get": {
         "security": [
           {
@@ -206,68 +160,16 @@ func init() {
             ]
           }
         ],
-        "description": "Get the default values for various cluster properties.",
+        "description": "Lists the cluster templates that are accessible by the user.",
         "tags": [
-          "installer"
+          "cluster_templates"
         ],
-        "operationId": "V2GetClusterDefaultConfig",
+        "operationId": "v2ListClusterTemplates",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster_default_config"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/v2/clusters/disconnected": {
-      "post": {
-        "description": "Create a disconnected OpenShift cluster for offline installation with embedded ignition",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "v2RegisterDisconnectedCluster",
-        "parameters": [
-          {
-            "description": "Parameters for creating a disconnected cluster.",
-            "name": "new-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/disconnected-cluster-create-params"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster-template-list"
             }
           },
           "401": {
@@ -289,23 +191,21 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/import": {
+      },
       "post": {
-        "description": "Import an AI cluster using minimal data associated with existing OCP cluster, in order to allow adding day2 hosts to that cluster",
+        "description": "Creates a cluster template, from which clusters with the same configuration can be registered.",
         "tags": [
-          "installer"
+          "cluster_templates"
         ],
-        "operationId": "v2ImportCluster",
+        "operationId": "v2RegisterClusterTemplate",
         "parameters": [
           {
-            "description": "Parameters for importing a OCP cluster for adding nodes.",
-            "name": "new-import-cluster-params",
+            "description": "The properties describing the new cluster template.",
+            "name": "new-cluster-template-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/import-cluster-params"
+              "$ref": "#/definitions/cluster-template-create-params"
             }
           }
         ],
@@ -313,7 +213,7 @@ func init() {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/cluster-template"
             }
           },
           "400": {
@@ -343,7 +243,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}": {
+    "/v2/cluster-templates/{cluster_template_id}": {
       "get": {
         "security": [
           {
@@ -352,53 +252,28 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "watcherAuth": []
           }
         ],
-        "description": "Retrieves the details of the OpenShift cluster.",
+        "description": "Retrieves the details of the cluster template.",
         "tags": [
-          "installer"
+          "cluster_templates"
         ],
-        "operationId": "v2GetCluster",
+        "operationId": "v2GetClusterTemplate",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be retrieved.",
-            "name": "cluster_id",
+            "description": "The template to be retrieved.",
+            "name": "cluster_template_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is retrieving the cluster details.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
-          },
-          {
-            "type": "boolean",
-            "description": "If true, do not include hosts.",
-            "name": "exclude-hosts",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/cluster-template"
             }
           },
           "401": {
@@ -419,38 +294,26 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       },
       "delete": {
-        "description": "Deletes an OpenShift cluster definition.",
+        "description": "Deletes a cluster template. Clusters that were registered from the template are not modified.",
         "tags": [
-          "installer"
+          "cluster_templates"
         ],
-        "operationId": "v2DeregisterCluster",
+        "operationId": "v2DeregisterClusterTemplate",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be deregistered.",
-            "name": "cluster_id",
+            "description": "The template to be deleted.",
+            "name": "cluster_template_id",
             "in": "path",
             "required": true
           }
@@ -477,12 +340,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -498,27 +355,27 @@ func init() {
         }
       },
       "patch": {
-        "description": "Updates an OpenShift cluster definition.",
+        "description": "Updates a cluster template. Clusters that were registered from the template are not modified.",
         "tags": [
-          "installer"
+          "cluster_templates"
         ],
-        "operationId": "V2UpdateCluster",
+        "operationId": "v2UpdateClusterTemplate",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be updated.",
-            "name": "cluster_id",
+            "description": "The template to be updated.",
+            "name": "cluster_template_id",
             "in": "path",
             "required": true
           },
           {
             "description": "The properties to update.",
-            "name": "cluster-update-params",
+            "name": "cluster-template-update-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/v2-cluster-update-params"
+              "$ref": "#/definitions/cluster-template-update-params"
             }
           }
         ],
@@ -526,7 +383,7 @@ func init() {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/cluster-template"
             }
           },
           "400": {
@@ -553,12 +410,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -574,46 +425,81 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/allow-add-hosts": {
-      "post": {
-        "description": "Transforms installed cluster to a state which allows adding hosts.",
+    "/v2/clusters": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "watcherAuth": []
+          }
+        ],
+        "description": "Retrieves the list of OpenShift clusters.",
         "tags": [
           "installer"
         ],
-        "operationId": "TransformClusterToAddingHosts",
+        "operationId": "v2ListClusters",
         "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to transform.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "202": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
+            "description": "A specific cluster to retrieve.",
+            "name": "openshift_cluster_id",
+            "in": "query"
           },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
+            "name": "ams_subscription_ids",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Include hosts in the returned list.",
+            "name": "with_hosts",
+            "in": "query",
+            "allowEmptyValue": true
+          },
+          {
+            "type": "string",
+            "description": "If provided, returns only clusters that are owned by the specified user.",
+            "name": "owner",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-list"
             }
           },
-          "403": {
-            "description": "Forbidden.",
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
+          "403": {
+            "description": "Forbidden.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
           "405": {
@@ -622,46 +508,67 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/actions/allow-add-workers": {
+      },
       "post": {
-        "description": "Deprecated, maintained for legacy purposes. Does the same thing as allow-add-hosts. Use allow-add-hosts instead.",
+        "description": "Creates a new OpenShift cluster definition.",
         "tags": [
           "installer"
         ],
-        "operationId": "TransformClusterToDay2",
-        "deprecated": true,
+        "operationId": "v2RegisterCluster",
         "parameters": [
+          {
+            "description": "The properties describing the new cluster. When the cluster is registered from a template, the properties that are set override the ones of the template.",
+            "name": "new-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-create-params"
+            }
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to transform.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "description": "The template the cluster is registered from. The manifests and the infra-env of the template are created with the cluster.",
+            "name": "cluster_template_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "The values of the parameters of the template, formatted as name=value.",
+            "name": "template_parameters",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "201": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
             }
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -686,11 +593,49 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/v2/clusters/default-config": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the default values for various cluster properties.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2GetClusterDefaultConfig",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster_default_config"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
           },
           "500": {
             "description": "Error.",
@@ -701,30 +646,37 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/cancel": {
+    "/v2/clusters/disconnected": {
       "post": {
-        "description": "Cancels an ongoing installation.",
+        "description": "Create a disconnected OpenShift cluster for offline installation with embedded ignition",
         "tags": [
           "installer"
         ],
-        "operationId": "V2CancelInstallation",
+        "operationId": "v2RegisterDisconnectedCluster",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose installation is to be canceled.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "description": "Parameters for creating a disconnected cluster.",
+            "name": "new-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/disconnected-cluster-create-params"
+            }
           }
         ],
         "responses": {
-          "202": {
+          "201": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
             }
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -737,24 +689,58 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
+          }
+        }
+      }
+    },
+    "/v2/clusters/import": {
+      "post": {
+        "description": "Import an AI cluster using minimal data associated with existing OCP cluster, in order to allow adding day2 hosts to that cluster",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ImportCluster",
+        "parameters": [
+          {
+            "description": "Parameters for importing a OCP cluster for adding nodes.",
+            "name": "new-import-cluster-params",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/import-cluster-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
             }
           },
-          "409": {
+          "400": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -764,45 +750,59 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/complete-installation": {
-      "post": {
+    "/v2/clusters/{cluster_id}": {
+      "get": {
         "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
           {
             "agentAuth": []
+          },
+          {
+            "watcherAuth": []
           }
         ],
-        "description": "Agent API to mark a finalizing installation as complete and progress to 100%.",
+        "description": "Retrieves the details of the OpenShift cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2CompleteInstallation",
+        "operationId": "v2GetCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is being completing.",
+            "description": "The cluster to be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "description": "The final status of the cluster installation.",
-            "name": "completion-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/completion-params"
-            }
-          },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is completing the installation.",
+            "description": "The software version of the discovery agent that is retrieving the cluster details.",
             "name": "discovery_agent_version",
             "in": "header"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          },
+          {
+            "type": "boolean",
+            "description": "If true, do not include hosts.",
+            "name": "exclude-hosts",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
@@ -832,12 +832,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -851,37 +845,26 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/actions/install": {
-      "post": {
-        "description": "Installs the OpenShift cluster.",
-        "tags": [
-          "installer"
+      },
+      "delete": {
+        "description": "Deletes an OpenShift cluster definition.",
+        "tags": [
+          "installer"
         ],
-        "operationId": "v2InstallCluster",
+        "operationId": "v2DeregisterCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be installed.",
+            "description": "The cluster to be deregistered.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -920,32 +903,45 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/actions/reset": {
-      "post": {
-        "description": "Resets a failed installation.",
+      },
+      "patch": {
+        "description": "Updates an OpenShift cluster definition.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ResetCluster",
+        "operationId": "V2UpdateCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be reset.",
+            "description": "The cluster to be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "cluster-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/v2-cluster-update-params"
+            }
           }
         ],
         "responses": {
-          "202": {
+          "201": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
             }
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -985,35 +981,28 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/credentials": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the cluster admin credentials.",
+    "/v2/clusters/{cluster_id}/actions/allow-add-hosts": {
+      "post": {
+        "description": "Transforms installed cluster to a state which allows adding hosts.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetCredentials",
+        "operationId": "TransformClusterToAddingHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose admin credentials should be retrieved.",
+            "description": "The cluster to transform.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/credentials"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -1055,56 +1044,29 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/credentials": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Downloads credentials relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/v2/clusters/{cluster_id}/actions/allow-add-workers": {
+      "post": {
+        "description": "Deprecated, maintained for legacy purposes. Does the same thing as allow-add-hosts. Use allow-add-hosts instead.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2DownloadClusterCredentials",
+        "operationId": "TransformClusterToDay2",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the credential file that should be downloaded.",
+            "description": "The cluster to transform.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress"
-            ],
-            "type": "string",
-            "description": "The credential file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -1142,63 +1104,32 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/credentials-presigned": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the cluster admin credentials.",
+    "/v2/clusters/{cluster_id}/actions/cancel": {
+      "post": {
+        "description": "Cancels an ongoing installation.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetPresignedForClusterCredentials",
+        "operationId": "V2CancelInstallation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose installation is to be canceled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -1240,69 +1171,48 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/files": {
-      "get": {
+    "/v2/clusters/{cluster_id}/actions/complete-installation": {
+      "post": {
         "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
           {
             "agentAuth": []
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Downloads files relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Agent API to mark a finalizing installation as complete and progress to 100%.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2DownloadClusterFiles",
+        "operationId": "v2CompleteInstallation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose installation is being completing.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "install-config.yaml",
-              "custom_manifests.json",
-              "custom_manifests.yaml",
-              "arbiter.ign"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "The final status of the cluster installation.",
+            "name": "completion-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/completion-params"
+            }
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is downloading the file.",
+            "description": "The software version of the discovery agent that is completing the installation.",
             "name": "discovery_agent_version",
             "in": "header"
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -1350,84 +1260,38 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/files-presigned": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
+    "/v2/clusters/{cluster_id}/actions/dry-run": {
+      "post": {
+        "description": "Renders the install-config, the install manifests and ignitions, the operator manifests and the installer\narguments of each host, as the installation of the cluster would, and returns them as a tar.gz bundle.\nThe cluster is not modified and its stored files are not changed. Generation errors are listed in the\ndry-run-report.json file of the bundle.\n",
+        "produces": [
+          "application/octet-stream"
         ],
-        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetPresignedForClusterFiles",
+        "operationId": "v2DryRunInstallCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose installation is rendered.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
           },
-          {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "install-config.yaml",
-              "logs",
-              "manifests"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "If downloading logs, the type of logs to download.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "If downloading a file related to a host, the relevant host.",
-            "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
-            "name": "additional_name",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/presigned-url"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -1468,70 +1332,43 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/hosts": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Get a list of cluster hosts according to supplied filters.",
+    "/v2/clusters/{cluster_id}/actions/export": {
+      "post": {
+        "description": "Exports the definition of a cluster to an archive that can be imported by another service. The secrets of the cluster are encrypted with the key of the service that imports the archive.",
         "tags": [
-          "installer"
+          "cluster_archives"
         ],
-        "operationId": "ListClusterHosts",
+        "operationId": "v2ExportCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose hosts should be retrieved.",
+            "description": "The cluster to be exported.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "master",
-              "arbiter",
-              "worker",
-              "auto-assign"
-            ],
-            "type": "string",
-            "description": "Role to request.",
-            "name": "role",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Hosts status to request.",
-            "name": "status",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "If true return the host's inventory.",
-            "name": "with-inventory",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "If true return the host's connectivity.",
-            "name": "with-connectivity",
-            "in": "query"
+            "description": "The key of the service that imports the archive.",
+            "name": "export-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-export-params"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "$ref": "#/definitions/cluster-archive"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -1561,28 +1398,28 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/ignored-validations": {
-      "get": {
-        "description": "Fetch the validations which are to be ignored for this cluster.",
+    "/v2/clusters/{cluster_id}/actions/install": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetIgnoredValidations",
+        "operationId": "v2InstallCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose failing validations should be ignored according to this list.",
+            "description": "The cluster to be installed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/ignored-validations"
+              "$ref": "#/definitions/cluster"
             }
           },
           "400": {
@@ -1591,65 +1428,31 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "404": {
-            "description": "Error.",
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
-          }
-        }
-      },
-      "put": {
-        "description": "Register the validations which are to be ignored for this cluster.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "v2SetIgnoredValidations",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose failing validations should be ignored according to this list.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
           },
-          {
-            "description": "The validations to be ignored.",
-            "name": "ignored_validations",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ignored-validations"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "Success.",
+          "403": {
+            "description": "Forbidden.",
             "schema": {
-              "$ref": "#/definitions/ignored-validations"
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "400": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
-          "404": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -1664,37 +1467,28 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/install-config": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the cluster's install config YAML.",
+    "/v2/clusters/{cluster_id}/actions/reset": {
+      "post": {
+        "description": "Resets a failed installation.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetClusterInstallConfig",
+        "operationId": "v2ResetCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being retrieved.",
+            "description": "The cluster whose installation is to be reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -1721,6 +1515,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1728,40 +1528,37 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Override values in the install config.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/credentials": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the cluster admin credentials.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateClusterInstallConfig",
+        "operationId": "V2GetCredentials",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being updated.",
+            "description": "The cluster whose admin credentials should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "Install config overrides.",
-            "name": "install-config-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "string"
-            }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/credentials"
             }
           },
           "401": {
@@ -1788,6 +1585,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1797,54 +1600,49 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/logs": {
+    "/v2/clusters/{cluster_id}/downloads/credentials": {
       "get": {
         "security": [
           {
             "userAuth": [
-              "admin",
-              "read-only-admin",
               "user"
             ]
           },
           {
             "urlAuth": []
+          },
+          {
+            "agentAuth": []
           }
         ],
-        "description": "Download cluster logs.",
+        "description": "Downloads credentials relating to the installed/installing cluster.",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
           "installer"
         ],
-        "operationId": "V2DownloadClusterLogs",
+        "operationId": "V2DownloadClusterCredentials",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be downloaded.",
+            "description": "The cluster that owns the credential file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "enum": [
-              "host",
-              "controller",
-              "all"
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress"
             ],
             "type": "string",
-            "description": "The type of logs to be downloaded.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A specific host in the cluster whose logs should be downloaded.",
-            "name": "host_id",
-            "in": "query"
+            "description": "The credential file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
@@ -1889,72 +1687,61 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/v2/clusters/{cluster_id}/downloads/credentials-presigned": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "user"
+            ]
           }
         ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+        "description": "Get the cluster admin credentials.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2UploadLogs",
+        "operationId": "V2GetPresignedForClusterCredentials",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be uploaded.",
+            "description": "The cluster that owns the file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "maxLength": 262144000,
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
           {
             "enum": [
-              "host",
-              "controller"
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress"
             ],
             "type": "string",
-            "description": "The type of log file to be uploaded.",
-            "name": "logs_type",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
             "in": "query",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env ID of the host.",
-            "name": "infra_env_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
-            "name": "host_id",
-            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/presigned-url"
+            }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -1994,50 +1781,74 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/logs-progress": {
-      "put": {
+    "/v2/clusters/{cluster_id}/downloads/files": {
+      "get": {
         "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
           {
             "agentAuth": []
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Update log collection state and progress.",
+        "description": "Downloads files relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateClusterLogsProgress",
+        "operationId": "V2DownloadClusterFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster that owns the file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/logs-progress-params"
-            }
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "install-config.yaml",
+              "custom_manifests.json",
+              "custom_manifests.yaml",
+              "arbiter.ign"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is downloading the file.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -2084,32 +1895,69 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/manifests": {
+    "/v2/clusters/{cluster_id}/downloads/files-presigned": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Lists manifests for customizing cluster installation.",
+        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "V2ListClusterManifests",
+        "operationId": "V2GetPresignedForClusterFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the manifests should be listed.",
+            "description": "The cluster that owns the file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Include system generated manifests in results? Default is false.",
-            "name": "include_system_generated",
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "install-config.yaml",
+              "logs",
+              "manifests"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "If downloading logs, the type of logs to download.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "If downloading a file related to a host, the relevant host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
+            "name": "additional_name",
             "in": "query"
           }
         ],
@@ -2117,7 +1965,13 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-manifests"
+              "$ref": "#/definitions/presigned-url"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -2157,48 +2011,72 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/v2/clusters/{cluster_id}/hosts": {
+      "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
           }
         ],
-        "description": "Creates a manifest for customizing cluster installation.",
+        "description": "Get a list of cluster hosts according to supplied filters.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "V2CreateClusterManifest",
+        "operationId": "ListClusterHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be created.",
+            "description": "The cluster whose hosts should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The new manifest to create.",
-            "name": "CreateManifestParams",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/create-manifest-params"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/manifest"
-            }
+            "enum": [
+              "master",
+              "arbiter",
+              "worker",
+              "auto-assign"
+            ],
+            "type": "string",
+            "description": "Role to request.",
+            "name": "role",
+            "in": "query"
           },
-          "400": {
-            "description": "Error.",
+          {
+            "type": "string",
+            "description": "Hosts status to request.",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "If true return the host's inventory.",
+            "name": "with-inventory",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "If true return the host's connectivity.",
+            "name": "with-connectivity",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host-list"
             }
           },
           "401": {
@@ -2219,19 +2097,46 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/ignored-validations": {
+      "get": {
+        "description": "Fetch the validations which are to be ignored for this cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetIgnoredValidations",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose failing validations should be ignored according to this list.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/ignored-validations"
+            }
           },
-          "409": {
+          "400": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -2239,43 +2144,103 @@ func init() {
           }
         }
       },
-      "delete": {
-        "description": "Deletes a manifest from the cluster.",
+      "put": {
+        "description": "Register the validations which are to be ignored for this cluster.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "V2DeleteClusterManifest",
+        "operationId": "v2SetIgnoredValidations",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be deleted.",
+            "description": "The cluster whose failing validations should be ignored according to this list.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
+            "description": "The validations to be ignored.",
+            "name": "ignored_validations",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ignored-validations"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/ignored-validations"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/install-config": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the cluster's install config YAML.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetClusterInstallConfig",
+        "parameters": [
           {
             "type": "string",
-            "description": "The manifest file name to delete from the cluster.",
-            "name": "file_name",
-            "in": "query",
+            "format": "uuid",
+            "description": "The cluster whose install config is being retrieved.",
+            "name": "cluster_id",
+            "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "type": "string"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -2301,12 +2266,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2316,41 +2275,33 @@ func init() {
         }
       },
       "patch": {
-        "security": [
-          {
-            "userAuth": []
-          }
-        ],
-        "description": "Updates a manifest for customizing cluster installation.",
+        "description": "Override values in the install config.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "V2UpdateClusterManifest",
+        "operationId": "v2UpdateClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be updated.",
+            "description": "The cluster whose install config is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The manifest to be updated.",
-            "name": "UpdateManifestParams",
+            "description": "Install config overrides.",
+            "name": "install-config-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/update-manifest-params"
+              "type": "string"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/manifest"
-            }
+          "201": {
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -2382,12 +2333,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2397,47 +2342,54 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/manifests/files": {
+    "/v2/clusters/{cluster_id}/logs": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Downloads cluster manifest.",
+        "description": "Download cluster logs.",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "v2DownloadClusterManifest",
+        "operationId": "V2DownloadClusterLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be downloaded.",
+            "description": "The cluster whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "enum": [
-              "manifests",
-              "openshift"
+              "host",
+              "controller",
+              "all"
             ],
             "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
+            "description": "The type of logs to be downloaded.",
+            "name": "logs_type",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "The manifest file name to download.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "format": "uuid",
+            "description": "A specific host in the cluster whose logs should be downloaded.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
@@ -2484,42 +2436,72 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/monitored-operators": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Lists operators to be monitored for a cluster.",
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "V2ListOfClusterOperators",
+        "operationId": "V2UploadLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "The cluster whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "An operator in the specified cluster to return its data.",
-            "name": "operator_name",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success.",
+            "maxLength": 262144000,
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
+            "type": "string",
+            "description": "The type of log file to be uploaded.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env ID of the host.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs should be uploaded.",
+            "name": "host_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Bad Request",
             "schema": {
-              "$ref": "#/definitions/monitored-operators-list"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -2546,54 +2528,61 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      },
+      }
+    },
+    "/v2/clusters/{cluster_id}/logs-progress": {
       "put": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Controller API to report of monitored operators.",
+        "description": "Update log collection state and progress.",
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "v2ReportMonitoredOperatorStatus",
+        "operationId": "v2UpdateClusterLogsProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose operators are being monitored.",
+            "description": "The cluster whose log progress is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The operators monitor report.",
-            "name": "report-params",
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/operator-monitor-report"
+              "$ref": "#/definitions/logs-progress-params"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Bad Request",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -2640,37 +2629,40 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/preflight-requirements": {
+    "/v2/clusters/{cluster_id}/manifests": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "userAuth": []
           }
         ],
-        "description": "Get preflight requirements for a cluster.",
+        "description": "Lists manifests for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "v2GetPreflightRequirements",
+        "operationId": "V2ListClusterManifests",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return preflight requirements for.",
+            "description": "The cluster for which the manifests should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Include system generated manifests in results? Default is false.",
+            "name": "include_system_generated",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/preflight-hardware-requirements"
+              "$ref": "#/definitions/list-manifests"
             }
           },
           "401": {
@@ -2697,6 +2689,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2704,42 +2702,49 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/progress": {
-      "put": {
+      },
+      "post": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Update installation finalizing progress.",
+        "description": "Creates a manifest for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "v2UpdateClusterFinalizingProgress",
+        "operationId": "V2CreateClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster being updated.",
+            "description": "The cluster for which a new manifest should be created.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "New progress value.",
-            "name": "finalizing-progress",
+            "description": "The new manifest to create.",
+            "name": "CreateManifestParams",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/cluster-finalizing-progress"
+              "$ref": "#/definitions/create-manifest-params"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Update install progress."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/manifest"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -2765,56 +2770,57 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/supported-platforms": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "A list of platforms that this cluster can support in its current configuration.",
+      },
+      "delete": {
+        "description": "Deletes a manifest from the cluster.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "GetClusterSupportedPlatforms",
+        "operationId": "V2DeleteClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose platform types should be retrieved.",
+            "description": "The cluster whose manifest should be deleted.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
+            "type": "string",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to delete from the cluster.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/platform_type"
-              }
-            }
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -2834,6 +2840,18 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2841,30 +2859,42 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/ui-settings": {
-      "get": {
-        "description": "Fetch cluster specific UI settings.",
+      },
+      "patch": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Updates a manifest for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2GetClusterUISettings",
+        "operationId": "V2UpdateClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which UI settings should be retrieved.",
+            "description": "The cluster for which a new manifest should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The manifest to be updated.",
+            "name": "UpdateManifestParams",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/update-manifest-params"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/manifest"
             }
           },
           "400": {
@@ -2891,6 +2921,18 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2898,44 +2940,132 @@ func init() {
             }
           }
         }
-      },
-      "put": {
-        "description": "Update cluster specific UI settings.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/manifests/files": {
+      "get": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Downloads cluster manifest.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2UpdateClusterUISettings",
+        "operationId": "v2DownloadClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which UI settings should be updated.",
+            "description": "The cluster whose manifest should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Settings for the installer UI.",
-            "name": "ui-settings",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "string"
-            }
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
+            "type": "string",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to download.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "type": "file"
             }
           },
-          "400": {
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/monitored-operators": {
+      "get": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Lists operators to be monitored for a cluster.",
+        "tags": [
+          "operators",
+          "installer"
+        ],
+        "operationId": "V2ListOfClusterOperators",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to return operators for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "An operator in the specified cluster to return its data.",
+            "name": "operator_name",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/monitored-operators-list"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -2955,6 +3085,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2962,51 +3098,44 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
-      "post": {
+      },
+      "put": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Transfer the ingress certificate for the cluster.",
+        "description": "Controller API to report of monitored operators.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "v2UploadClusterIngressCert",
+        "operationId": "v2ReportMonitoredOperatorStatus",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to associate with the ingress certificate.",
+            "description": "The cluster whose operators are being monitored.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The ingress certificate.",
-            "name": "ingress-cert-params",
+            "description": "The operators monitor report.",
+            "name": "report-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
+              "$ref": "#/definitions/operator-monitor-report"
             }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success."
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3035,6 +3164,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -3050,7 +3185,7 @@ func init() {
         }
       }
     },
-    "/v2/component-versions": {
+    "/v2/clusters/{cluster_id}/network-topology": {
       "get": {
         "security": [
           {
@@ -3061,42 +3196,55 @@ func init() {
             ]
           }
         ],
-        "description": "List of component versions.",
+        "description": "Returns the network topology of the hosts of the cluster, as discovered by their connectivity checks: the\ninterfaces and subnets of the hosts, the L2 and L3 links between them with their latency and packet loss, and\nthe majority groups of the cluster. The topology is returned as JSON, or as a Graphviz DOT graph when the dot\nformat is requested.\n",
         "tags": [
-          "versions"
+          "installer"
         ],
-        "operationId": "v2ListComponentVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-versions"
-            }
-          }
-        }
-      }
-    },
-    "/v2/domains": {
-      "get": {
-        "security": [
+        "operationId": "v2GetClusterNetworkTopology",
+        "parameters": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose network topology is returned.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "json",
+              "dot"
+            ],
+            "type": "string",
+            "default": "json",
+            "description": "The format of the topology. The dot format returns a Graphviz DOT graph as text/vnd.graphviz.",
+            "name": "format",
+            "in": "query"
           }
         ],
-        "description": "List of managed DNS domains.",
-        "tags": [
-          "managed_domains"
-        ],
-        "operationId": "V2ListManagedDomains",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-managed-domains"
+              "$ref": "#/definitions/network-topology"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
@@ -3108,153 +3256,50 @@ func init() {
         }
       }
     },
-    "/v2/events": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "watcherAuth": []
-          }
-        ],
-        "description": "Lists events for a cluster.",
+    "/v2/clusters/{cluster_id}/plan": {
+      "post": {
+        "description": "Computes the changes needed to bring the cluster, its infra-env, hosts, custom manifests and operators\nto the state described by the plan, and applies them unless dry_run is set. Applying a plan that was\nalready applied makes no changes.\n",
         "tags": [
-          "events"
+          "cluster_plan"
         ],
-        "operationId": "v2ListEvents",
+        "operationId": "v2ApplyClusterPlan",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return events for.",
+            "description": "The cluster the plan is applied to.",
             "name": "cluster_id",
-            "in": "query"
+            "in": "path",
+            "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "A host in the specified cluster to return events for (DEPRECATED. Use ` + "`" + `host_ids` + "`" + ` instead).",
-            "name": "host_id",
+            "type": "boolean",
+            "default": false,
+            "description": "Only computes the changes, without applying them.",
+            "name": "dry_run",
             "in": "query"
           },
           {
-            "type": "array",
-            "items": {
-              "type": "string",
-              "format": "uuid"
-            },
-            "description": "Hosts in the specified cluster to return events for.",
-            "name": "host_ids",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env to return events for.",
-            "name": "infra_env_id",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "The maximum number of records to retrieve.",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "Number of records to skip before starting to return the records.",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "ascending",
-              "descending"
-            ],
-            "type": "string",
-            "default": "ascending",
-            "description": "Order by event_time of events retrieved.",
-            "name": "order",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "enum": [
-                "info",
-                "warning",
-                "error",
-                "critical"
-              ],
-              "type": "string"
-            },
-            "description": "Retrieved events severities.",
-            "name": "severities",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Retrieved events message pattern.",
-            "name": "message",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "Deleted hosts flag.",
-            "name": "deleted_hosts",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "Cluster level events flag.",
-            "name": "cluster_level",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "A comma-separated list of event categories.",
-            "name": "categories",
-            "in": "query"
+            "description": "The desired state of the cluster.",
+            "name": "plan",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-plan"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/event-list"
-            },
-            "headers": {
-              "Event-Count": {
-                "type": "integer",
-                "description": "Count of events retrieved."
-              },
-              "Severity-Count-Critical": {
-                "type": "integer",
-                "description": "Count of events with severity 'critical'."
-              },
-              "Severity-Count-Error": {
-                "type": "integer",
-                "description": "Count of events with severity 'error'."
-              },
-              "Severity-Count-Info": {
-                "type": "integer",
-                "description": "Count of events with severity 'info'."
-              },
-              "Severity-Count-Warning": {
-                "type": "integer",
-                "description": "Count of events with severity 'warning'."
-              }
+              "$ref": "#/definitions/cluster-plan-result"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -3281,6 +3326,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -3288,37 +3339,39 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/v2/clusters/{cluster_id}/preflight-requirements": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Add new assisted installer event.",
+        "description": "Get preflight requirements for a cluster.",
         "tags": [
-          "events"
+          "installer"
         ],
-        "operationId": "v2TriggerEvent",
+        "operationId": "v2GetPreflightRequirements",
         "parameters": [
           {
-            "description": "The event to be created.",
-            "name": "trigger-event-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/event"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to return preflight requirements for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/preflight-hardware-requirements"
             }
           },
           "401": {
@@ -3345,79 +3398,49 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Cluster cannot accept new agents due to its current state.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-env/{infra_env_id}/hosts/{host_id}/downloads/ignition": {
-      "get": {
+    "/v2/clusters/{cluster_id}/progress": {
+      "put": {
         "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
           {
             "agentAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Update installation finalizing progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2DownloadHostIgnition",
+        "operationId": "v2UpdateClusterFinalizingProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be downloaded.",
-            "name": "infra_env_id",
+            "description": "The cluster being updated.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be downloaded.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "New progress value.",
+            "name": "finalizing-progress",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-finalizing-progress"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "file"
-            }
+            "description": "Update install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -3443,12 +3466,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -3464,45 +3481,34 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs": {
+    "/v2/clusters/{cluster_id}/revisions": {
       "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "watcherAuth": []
-          }
-        ],
-        "description": "Retrieves the list of infra-envs.",
+        "description": "Lists the revisions of the configuration of the cluster, newest first. A revision is recorded whenever\nan update changes the configuration of the cluster.\n",
         "tags": [
-          "installer"
+          "cluster_revisions"
         ],
-        "operationId": "ListInfraEnvs",
+        "operationId": "v2ListClusterRevisions",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "If provided, returns only infra-envs which directly reference this cluster.",
+            "description": "The cluster whose revisions are used.",
             "name": "cluster_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "If provided, returns only infra-envs that are owned by the specified user.",
-            "name": "owner",
-            "in": "query"
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env-list"
+              "$ref": "#/definitions/cluster-revision-list"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -3517,6 +3523,12 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
@@ -3528,43 +3540,40 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
+      }
+    },
+    "/v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback": {
       "post": {
-        "description": "Creates a new OpenShift Discovery ISO.",
+        "description": "Re-applies a revision of the configuration of the cluster. The changes are applied through the same\nupdates and validations as the equivalent REST calls. Manifests are not rolled back.\n",
         "tags": [
-          "installer"
+          "cluster_revisions"
         ],
-        "operationId": "RegisterInfraEnv",
+        "operationId": "v2RollbackClusterRevision",
         "parameters": [
           {
-            "description": "The parameters for the generated ISO.",
-            "name": "infraenv-create-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/infra-env-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose revisions are used.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The revision of the configuration of the cluster.",
+            "name": "revision",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
+              "$ref": "#/definitions/cluster"
             }
           },
           "400": {
@@ -3608,59 +3617,53 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}": {
+    "/v2/clusters/{cluster_id}/revisions/{revision}/diff": {
       "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "imageAuth": []
-          },
-          {
-            "imageURLAuth": []
-          }
-        ],
-        "description": "Retrieves the details of the infra-env.",
+        "description": "Returns the differences between a revision of the configuration of the cluster and its current\nconfiguration, or another revision if base_revision is set.\n",
         "tags": [
-          "installer"
+          "cluster_revisions"
         ],
-        "operationId": "GetInfraEnv",
+        "operationId": "v2GetClusterRevisionDiff",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be retrieved.",
-            "name": "infra_env_id",
+            "description": "The cluster whose revisions are used.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The revision of the configuration of the cluster.",
+            "name": "revision",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The revision to compare with, instead of the current configuration.",
+            "name": "base_revision",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
+              "$ref": "#/definitions/cluster-revision-diff"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -3692,40 +3695,45 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
-      "delete": {
-        "description": "Deletes an infra-env.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/supported-platforms": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "A list of platforms that this cluster can support in its current configuration.",
         "tags": [
           "installer"
         ],
-        "operationId": "DeregisterInfraEnv",
+        "operationId": "GetClusterSupportedPlatforms",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be deleted.",
-            "name": "infra_env_id",
+            "description": "The cluster whose platform types should be retrieved.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/platform_type"
+              }
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -3745,62 +3753,101 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/ui-settings": {
+      "get": {
+        "description": "Fetch cluster specific UI settings.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2GetClusterUISettings",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which UI settings should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string"
+            }
           },
-          "409": {
+          "400": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
       },
-      "patch": {
-        "description": "Updates an infra-env.",
+      "put": {
+        "description": "Update cluster specific UI settings.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateInfraEnv",
+        "operationId": "V2UpdateClusterUISettings",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be updated.",
-            "name": "infra_env_id",
+            "description": "The cluster for which UI settings should be updated.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The properties to update.",
-            "name": "infra-env-update-params",
+            "description": "Settings for the installer UI.",
+            "name": "ui-settings",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/infra-env-update-params"
+              "type": "string"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
+              "type": "string"
             }
           },
           "400": {
@@ -3827,123 +3874,58 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/files": {
-      "get": {
+    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
+      "post": {
         "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
           {
             "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "imageAuth": []
-          },
-          {
-            "imageURLAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this host",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Transfer the ingress certificate for the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2DownloadInfraEnvFiles",
+        "operationId": "v2UploadClusterIngressCert",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env whose file should be downloaded.",
-            "name": "infra_env_id",
+            "description": "The cluster to associate with the ingress certificate.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "discovery.ign",
-              "ipxe-script",
-              "static-network-config"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "mac",
-            "description": "Mac address of the host running ipxe script.",
-            "name": "mac",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "discovery-image-always",
-              "boot-order-control"
-            ],
-            "type": "string",
-            "description": "Specify the script type to be served for iPXE.",
-            "name": "ipxe_script_type",
-            "in": "query"
+            "description": "The ingress certificate.",
+            "name": "ingress-cert-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ingress-cert-params"
+            }
           },
           {
-            "enum": [
-              "full-iso",
-              "minimal-iso",
-              "disconnected-iso"
-            ],
             "type": "string",
-            "description": "Overrides the ISO type for the discovery ignition.",
-            "name": "discovery_iso_type",
-            "in": "query"
+            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "file"
-            }
+          "201": {
+            "description": "Success."
           },
           "400": {
-            "description": "Bad Request.",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3972,24 +3954,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "503": {
             "description": "Unavailable.",
             "schema": {
@@ -3999,148 +3969,53 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/files-presigned": {
+    "/v2/component-versions": {
       "get": {
-        "description": "Creates a new pre-signed download URL for the infra-env.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "GetInfraEnvPresignedFileURL",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The file's infra-env.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "enum": [
-              "discovery.ign",
-              "ipxe-script"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
+        "security": [
           {
-            "enum": [
-              "discovery-image-always",
-              "boot-order-control"
-            ],
-            "type": "string",
-            "description": "Specify the script type to be served for iPXE.",
-            "name": "ipxe_script_type",
-            "in": "query"
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
+        "description": "List of component versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2ListComponentVersions",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/list-versions"
             }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/image-url": {
+    "/v2/domains": {
       "get": {
-        "description": "Creates a new pre-signed image download URL for the infra-env.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "GetInfraEnvDownloadURL",
-        "parameters": [
+        "security": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env to be retrieved.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
+        "description": "List of managed DNS domains.",
+        "tags": [
+          "managed_domains"
+        ],
+        "operationId": "V2ListManagedDomains",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
-            }
-          },
-          "400": {
-            "description": "Bad Request.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/list-managed-domains"
             }
           },
           "500": {
@@ -4148,23 +4023,11 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/minimal-initrd": {
+    "/v2/events": {
       "get": {
         "security": [
           {
@@ -4178,111 +4041,139 @@ func init() {
             "urlAuth": []
           },
           {
-            "imageAuth": []
+            "watcherAuth": []
           }
         ],
-        "description": "Get the initial ramdisk for minimal ISO based installations.\n",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Lists events for a cluster.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "DownloadMinimalInitrd",
+        "operationId": "v2ListEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that should be retrieved.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "string",
-              "format": "binary"
-            }
-          },
-          "204": {
-            "description": "Empty Success."
+            "description": "The cluster to return events for.",
+            "name": "cluster_id",
+            "in": "query"
           },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A host in the specified cluster to return events for (DEPRECATED. Use ` + "`" + `host_ids` + "`" + ` instead).",
+            "name": "host_id",
+            "in": "query"
           },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
+          {
+            "type": "array",
+            "items": {
+              "type": "string",
+              "format": "uuid"
+            },
+            "description": "Hosts in the specified cluster to return events for.",
+            "name": "host_ids",
+            "in": "query"
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to return events for.",
+            "name": "infra_env_id",
+            "in": "query"
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          {
+            "type": "integer",
+            "description": "The maximum number of records to retrieve.",
+            "name": "limit",
+            "in": "query"
           },
-          "409": {
-            "description": "Conflict.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          {
+            "type": "integer",
+            "description": "Number of records to skip before starting to return the records.",
+            "name": "offset",
+            "in": "query"
           },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts": {
-      "get": {
-        "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "enum": [
+              "ascending",
+              "descending"
+            ],
+            "type": "string",
+            "default": "ascending",
+            "description": "Order by event_time of events retrieved.",
+            "name": "order",
+            "in": "query"
           },
           {
-            "agentAuth": []
-          }
-        ],
-        "description": "Retrieves the list of OpenShift hosts that belong the infra-env.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "v2ListHosts",
-        "parameters": [
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "error",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "description": "Retrieved events severities.",
+            "name": "severities",
+            "in": "query"
+          },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The infra-env that the hosts are asociated with.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
+            "description": "Retrieved events message pattern.",
+            "name": "message",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Deleted hosts flag.",
+            "name": "deleted_hosts",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Cluster level events flag.",
+            "name": "cluster_level",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "$ref": "#/definitions/event-list"
+            },
+            "headers": {
+              "Event-Count": {
+                "type": "integer",
+                "description": "Count of events retrieved."
+              },
+              "Severity-Count-Critical": {
+                "type": "integer",
+                "description": "Count of events with severity 'critical'."
+              },
+              "Severity-Count-Error": {
+                "type": "integer",
+                "description": "Count of events with severity 'error'."
+              },
+              "Severity-Count-Info": {
+                "type": "integer",
+                "description": "Count of events with severity 'info'."
+              },
+              "Severity-Count-Warning": {
+                "type": "integer",
+                "description": "Count of events with severity 'warning'."
+              }
             }
           },
           "401": {
@@ -4297,26 +4188,20 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4329,42 +4214,25 @@ func init() {
             "agentAuth": []
           }
         ],
-        "description": "Registers a new OpenShift agent.",
+        "description": "Add new assisted installer event.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "v2RegisterHost",
+        "operationId": "v2TriggerEvent",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env that the agent is associated with.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The description of the agent being registered.",
-            "name": "new-host-params",
+            "description": "The event to be created.",
+            "name": "trigger-event-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-create-params"
+              "$ref": "#/definitions/event"
             }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is registering the agent.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host_registration_response"
-            }
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -4423,7 +4291,7 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}": {
+    "/v2/infra-env/{infra_env_id}/hosts/{host_id}/downloads/ignition": {
       "get": {
         "security": [
           {
@@ -4432,18 +4300,24 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "agentAuth": []
           }
         ],
-        "description": "Retrieves the details of the OpenShift host.",
+        "description": "Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetHost",
+        "operationId": "v2DownloadHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that should be retrieved.",
+            "description": "The infra-env of the host whose ignition file should be downloaded.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -4451,7 +4325,7 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that should be retrieved.",
+            "description": "The host whose ignition file should be downloaded.",
             "name": "host_id",
             "in": "path",
             "required": true
@@ -4461,7 +4335,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "file"
             }
           },
           "401": {
@@ -4488,52 +4362,66 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "delete": {
-        "description": "Deregisters an OpenShift host.",
+      }
+    },
+    "/v2/infra-envs": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "watcherAuth": []
+          }
+        ],
+        "description": "Retrieves the list of infra-envs.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2DeregisterHost",
+        "operationId": "ListInfraEnvs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that should be deregistered.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
+            "description": "If provided, returns only infra-envs which directly reference this cluster.",
+            "name": "cluster_id",
+            "in": "query"
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The host that should be deregistered.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "If provided, returns only infra-envs that are owned by the specified user.",
+            "name": "owner",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra-env-list"
             }
           },
           "401": {
@@ -4548,56 +4436,46 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
       },
-      "patch": {
-        "description": "Update an Openshift host",
+      "post": {
+        "description": "Creates a new OpenShift Discovery ISO.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHost",
+        "operationId": "RegisterInfraEnv",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env ID of the host to be updated.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that should be updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The properties to update.",
-            "name": "host-update-params",
+            "description": "The parameters for the generated ISO.",
+            "name": "infraenv-create-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-update-params"
+              "$ref": "#/definitions/infra-env-create-params"
             }
           }
         ],
@@ -4605,7 +4483,7 @@ func init() {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/infra-env"
             }
           },
           "400": {
@@ -4649,55 +4527,59 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/bind": {
-      "post": {
-        "description": "Bind host to a cluster",
+    "/v2/infra-envs/{infra_env_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "imageAuth": []
+          },
+          {
+            "imageURLAuth": []
+          }
+        ],
+        "description": "Retrieves the details of the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "BindHost",
+        "operationId": "GetInfraEnv",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being bound.",
+            "description": "The infra-env to be retrieved.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being bound.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The parameters for the host binding.",
-            "name": "bind-host-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/bind-host-params"
-            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra-env"
             }
           },
           "401": {
@@ -4743,39 +4625,26 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/install": {
-      "post": {
-        "description": "install specific host for day2 cluster.",
+      },
+      "delete": {
+        "description": "Deletes an infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2InstallHost",
+        "operationId": "DeregisterInfraEnv",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being installed.",
+            "description": "The infra-env to be deleted.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being installed.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -4795,6 +4664,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -4806,40 +4681,51 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset": {
-      "post": {
-        "description": "reset a failed host for day2 cluster.",
+      },
+      "patch": {
+        "description": "Updates an infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ResetHost",
+        "operationId": "UpdateInfraEnv",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being reset.",
+            "description": "The infra-env to be updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being reset.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "The properties to update.",
+            "name": "infra-env-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/infra-env-update-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/infra-env"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -4860,6 +4746,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -4871,52 +4763,106 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
-      "patch": {
-        "description": "Reset failed host validation. It may be performed on any host validation with persistent validation result.",
+    "/v2/infra-envs/{infra_env_id}/downloads/files": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "imageAuth": []
+          },
+          {
+            "imageURLAuth": []
+          }
+        ],
+        "description": "Downloads the customized ignition file for this host",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "summary": "Reset failed host validation.",
-        "operationId": "v2ResetHostValidation",
+        "operationId": "v2DownloadInfraEnvFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that its validation is being reset.",
+            "description": "The infra-env whose file should be downloaded.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
+            "enum": [
+              "discovery.ign",
+              "ipxe-script",
+              "static-network-config"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "The host that its validation is being reset.",
-            "name": "host_id",
-            "in": "path",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
             "required": true
           },
           {
             "type": "string",
-            "description": "The id of the validation being reset.",
-            "name": "validation_id",
-            "in": "path",
-            "required": true
+            "format": "mac",
+            "description": "Mac address of the host running ipxe script.",
+            "name": "mac",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "discovery-image-always",
+              "boot-order-control"
+            ],
+            "type": "string",
+            "description": "Specify the script type to be served for iPXE.",
+            "name": "ipxe_script_type",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "full-iso",
+              "minimal-iso",
+              "disconnected-iso"
+            ],
+            "type": "string",
+            "description": "Overrides the ISO type for the discovery ignition.",
+            "name": "discovery_iso_type",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "file"
             }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Bad Request.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4939,6 +4885,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -4950,46 +4902,65 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/unbind": {
-      "post": {
-        "description": "Unbind host to a cluster",
+    "/v2/infra-envs/{infra_env_id}/downloads/files-presigned": {
+      "get": {
+        "description": "Creates a new pre-signed download URL for the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "UnbindHost",
+        "operationId": "GetInfraEnvPresignedFileURL",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being bound.",
+            "description": "The file's infra-env.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
+            "enum": [
+              "discovery.ign",
+              "ipxe-script"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "The host that is being bound.",
-            "name": "host_id",
-            "in": "path",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
             "required": true
+          },
+          {
+            "enum": [
+              "discovery-image-always",
+              "boot-order-control"
+            ],
+            "type": "string",
+            "description": "Specify the script type to be served for iPXE.",
+            "name": "ipxe_script_type",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/presigned-url"
             }
           },
           "401": {
@@ -5016,12 +4987,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Conflict.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -5043,36 +5008,34 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition": {
+    "/v2/infra-envs/{infra_env_id}/downloads/image-url": {
       "get": {
-        "description": "Fetch the ignition file for this host as a string. In case of unbound host produces an error",
+        "description": "Creates a new pre-signed image download URL for the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetHostIgnition",
+        "operationId": "GetInfraEnvDownloadURL",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be obtained.",
+            "description": "The infra-env to be retrieved.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be obtained.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "$ref": "#/definitions/presigned-url"
+            }
+          },
+          "400": {
+            "description": "Bad Request.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -5118,50 +5081,54 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Patch the ignition file for this host",
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/downloads/minimal-initrd": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "imageAuth": []
+          }
+        ],
+        "description": "Get the initial ramdisk for minimal ISO based installations.\n",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostIgnition",
+        "operationId": "DownloadMinimalInitrd",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be updated.",
+            "description": "The infra-env of the host that should be retrieved.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Ignition config overrides.",
-            "name": "host-ignition-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-ignition-params"
-            }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "type": "string",
+              "format": "binary"
             }
           },
+          "204": {
+            "description": "Empty Success."
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -5186,14 +5153,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "409": {
+            "description": "Conflict.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -5201,51 +5168,40 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/installer-args": {
-      "patch": {
-        "description": "Updates a host's installer arguments.",
+    "/v2/infra-envs/{infra_env_id}/hosts": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the list of OpenShift hosts that belong the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostInstallerArgs",
+        "operationId": "v2ListHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose installer arguments should be updated.",
+            "description": "The infra-env that the hosts are asociated with.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose installer arguments should be updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The updated installer arguments.",
-            "name": "installer-args-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/installer-args-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host-list"
             }
           },
           "401": {
@@ -5260,24 +5216,12 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -5289,57 +5233,62 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Retrieves the next operations that the host agent needs to perform.",
+        "description": "Registers a new OpenShift agent.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetNextSteps",
+        "operationId": "v2RegisterHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is retrieving instructions.",
+            "description": "The infra-env that the agent is associated with.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is retrieving instructions.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "integer",
-            "description": "The time on the host as seconds since the Unix epoch.",
-            "name": "timestamp",
-            "in": "query"
+            "description": "The description of the agent being registered.",
+            "name": "new-host-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-create-params"
+            }
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is retrieving instructions.",
+            "description": "The software version of the discovery agent that is registering the agent.",
             "name": "discovery_agent_version",
             "in": "header"
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/steps"
+              "$ref": "#/definitions/host_registration_response"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -5366,6 +5315,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Cluster cannot accept new agents due to its current state.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -5385,53 +5340,40 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/actions/bulk": {
       "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Posts the result of the operations from the host agent.",
+        "description": "Applies a list of operations to the hosts of the infra-env. The operations that update the hosts in place are applied in a single transaction, so either all of them are applied or none is. Bind and unbind operations are applied one by one before them. Each operation is subject to the same validations as the equivalent single host call.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2PostStepReply",
+        "operationId": "v2BulkUpdateHosts",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is posting results.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is posting results.",
+            "description": "The infra-env of the hosts.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is posting results.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The results to be posted.",
-            "name": "reply",
+            "description": "The operations to apply.",
+            "name": "bulk-host-operations",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/step-reply"
+              "$ref": "#/definitions/bulk-host-operations"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "The result of each operation.",
+            "schema": {
+              "$ref": "#/definitions/bulk-host-operations-result"
+            }
           },
           "400": {
             "description": "Error.",
@@ -5457,50 +5399,36 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/logs-progress": {
-      "put": {
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Update log collection state and progress.",
+        "description": "Retrieves the details of the OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostLogsProgress",
+        "operationId": "v2GetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env whose log progress is being updated.",
+            "description": "The infra-env of the host that should be retrieved.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -5508,24 +5436,18 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose log progress is being updated.",
+            "description": "The host that should be retrieved.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/logs-progress-params"
-            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -5551,12 +5473,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -5568,39 +5484,20 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update installation progress.",
+      },
+      "delete": {
+        "description": "Deregisters an OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostInstallProgress",
+        "operationId": "v2DeregisterHost",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is updating progress.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host being updated.",
+            "description": "The infra-env of the host that should be deregistered.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -5608,24 +5505,21 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the host to update.",
+            "description": "The host that should be deregistered.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "New progress value.",
-            "name": "host-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-progress"
-            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Update install progress."
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -5656,36 +5550,54 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/regenerate-signing-key": {
-      "post": {
-        "description": "Regenerate InfraEnv token signing key.",
+      },
+      "patch": {
+        "description": "Update an Openshift host",
         "tags": [
           "installer"
         ],
-        "operationId": "RegenerateInfraEnvSigningKey",
+        "operationId": "v2UpdateHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The target InfraEnv.",
+            "description": "The infra-env ID of the host to be updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "host-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-update-params"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -5711,6 +5623,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -5720,57 +5638,58 @@ func init() {
         }
       }
     },
-    "/v2/openshift-versions": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the list of OpenShift supported versions.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/accept-hardware": {
+      "post": {
+        "description": "Accepts the current hardware of a host whose hardware changed after its validations passed, so that the\nhardware drift no longer blocks the installation.\n",
         "tags": [
-          "versions"
+          "installer"
         ],
-        "operationId": "v2ListSupportedOpenshiftVersions",
+        "operationId": "v2AcceptHostHardware",
         "parameters": [
           {
             "type": "string",
-            "description": "Retrieves only the versions that contain the specified substring in their display name.",
-            "name": "version",
-            "in": "query"
+            "format": "uuid",
+            "description": "The infra-env of the host.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "boolean",
-            "description": "If true, returns only the latest version for each minor.",
-            "name": "only_latest",
-            "in": "query"
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose hardware is accepted.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/openshift-versions"
+              "$ref": "#/definitions/host"
             }
           },
-          "400": {
-            "description": "Bad Request",
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "500": {
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -5778,77 +5697,45 @@ func init() {
         }
       }
     },
-    "/v2/operators/bundles": {
-      "get": {
-        "description": "Retrieves a list of available bundles filtered by support level.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/bind": {
+      "post": {
+        "description": "Bind host to a cluster",
         "tags": [
-          "operators"
+          "installer"
         ],
-        "summary": "Get list of available bundles",
-        "operationId": "V2ListBundles",
+        "operationId": "BindHost",
         "parameters": [
           {
             "type": "string",
-            "description": "Version of the OpenShift cluster. If the parameter is not specified, no filtering is applied.",
-            "name": "openshift_version",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "x86_64",
-              "aarch64",
-              "arm64",
-              "ppc64le",
-              "s390x",
-              "multi"
-            ],
-            "type": "string",
-            "default": "x86_64",
-            "description": "The CPU architecture of the image (x86_64/arm64/etc). openshift_version must be set.",
-            "name": "cpu_architecture",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "baremetal",
-              "none",
-              "nutanix",
-              "vsphere",
-              "external"
-            ],
-            "type": "string",
-            "description": "The provider platform type. openshift_version must be set.",
-            "name": "platform_type",
-            "in": "query"
+            "format": "uuid",
+            "description": "The infra-env of the host that is being bound.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external or if openshift_version is not set.",
-            "name": "external_platform_name",
-            "in": "query"
+            "format": "uuid",
+            "description": "The host that is being bound.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "array",
-            "items": {
-              "enum": [
-                "SNO"
-              ],
-              "type": "string"
-            },
-            "collectionFormat": "multi",
-            "description": "Array of feature IDs that affect bundle composition (e.g., [\"SNO\"] for Single Node OpenShift).",
-            "name": "feature_ids",
-            "in": "query"
+            "description": "The parameters for the host binding.",
+            "name": "bind-host-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bind-host-params"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success",
+            "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/bundle"
-              }
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
@@ -5857,8 +5744,44 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
-            "description": "Internal server error",
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -5866,57 +5789,64 @@ func init() {
         }
       }
     },
-    "/v2/operators/bundles/{id}": {
-      "get": {
-        "description": "Retrieves an array of operator properties for the specified bundle when some features are activated.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/install": {
+      "post": {
+        "description": "install specific host for day2 cluster.",
         "tags": [
-          "operators"
+          "installer"
         ],
-        "summary": "Get operator properties for a bundle",
-        "operationId": "V2GetBundle",
+        "operationId": "v2InstallHost",
         "parameters": [
           {
             "type": "string",
-            "description": "Identifier of the bundle, for example, ` + "`" + `virtualization` + "`" + ` or ` + "`" + `openshift-ai` + "`" + `.",
-            "name": "id",
+            "format": "uuid",
+            "description": "The infra-env of the host that is being installed.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "array",
-            "items": {
-              "enum": [
-                "SNO"
-              ],
-              "type": "string"
-            },
-            "collectionFormat": "multi",
-            "description": "Array of feature IDs that affect bundle composition (e.g., [\"SNO\"] for Single Node OpenShift).",
-            "name": "feature_ids",
-            "in": "query"
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being installed.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success",
+          "202": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/bundle"
+              "$ref": "#/definitions/host"
             }
           },
-          "400": {
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "404": {
-            "description": "Bundle not found",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
           "500": {
-            "description": "Internal server error",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -5924,74 +5854,36 @@ func init() {
         }
       }
     },
-    "/v2/release-sources": {
-      "get": {
-        "security": [
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset": {
+      "post": {
+        "description": "reset a failed host for day2 cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ResetHost",
+        "parameters": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves openshift release sources configuration.",
-        "tags": [
-          "versions"
-        ],
-        "operationId": "v2ListReleaseSources",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/release-sources"
-            }
-          }
-        }
-      }
-    },
-    "/v2/support-levels/architectures": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the architecture support-levels for each OpenShift version.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "GetSupportedArchitectures",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Version of the OpenShift cluster.",
-            "name": "openshift_version",
-            "in": "query",
-            "required": true
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that is being reset.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "object",
-              "properties": {
-                "architectures": {
-                  "description": "Keys will be one of architecture-support-level-id enum.",
-                  "$ref": "#/definitions/support-levels"
-                }
-              }
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -6012,8 +5904,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -6021,80 +5919,48 @@ func init() {
         }
       }
     },
-    "/v2/support-levels/features": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the features support levels for each OpenShift version.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
+      "patch": {
+        "description": "Reset failed host validation. It may be performed on any host validation with persistent validation result.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetSupportedFeatures",
+        "summary": "Reset failed host validation.",
+        "operationId": "v2ResetHostValidation",
         "parameters": [
           {
             "type": "string",
-            "description": "Version of the OpenShift cluster.",
-            "name": "openshift_version",
-            "in": "query",
+            "format": "uuid",
+            "description": "The infra-env of the host that its validation is being reset.",
+            "name": "infra_env_id",
+            "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "x86_64",
-              "aarch64",
-              "arm64",
-              "ppc64le",
-              "s390x",
-              "multi"
-            ],
-            "type": "string",
-            "default": "x86_64",
-            "description": "The CPU architecture of the image (x86_64/arm64/etc).",
-            "name": "cpu_architecture",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "baremetal",
-              "none",
-              "nutanix",
-              "vsphere",
-              "external"
-            ],
             "type": "string",
-            "description": "The provider platform type.",
-            "name": "platform_type",
-            "in": "query"
+            "format": "uuid",
+            "description": "The host that its validation is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external.",
-            "name": "external_platform_name",
-            "in": "query"
+            "description": "The id of the validation being reset.",
+            "name": "validation_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "object",
-              "properties": {
-                "features": {
-                  "description": "Keys will be one of features-support-level-id enum.",
-                  "$ref": "#/definitions/support-levels"
-                }
-              }
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -6117,8 +5983,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -6126,83 +5998,36 @@ func init() {
         }
       }
     },
-    "/v2/support-levels/features/detailed": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves detailed features information including support level, incompatibilities, and operator dependencies.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/unbind": {
+      "post": {
+        "description": "Unbind host to a cluster",
         "tags": [
           "installer"
         ],
-        "operationId": "GetDetailedSupportedFeatures",
+        "operationId": "UnbindHost",
         "parameters": [
           {
             "type": "string",
-            "description": "Version of the OpenShift cluster.",
-            "name": "openshift_version",
-            "in": "query",
+            "format": "uuid",
+            "description": "The infra-env of the host that is being bound.",
+            "name": "infra_env_id",
+            "in": "path",
             "required": true
           },
-          {
-            "enum": [
-              "x86_64",
-              "arm64",
-              "ppc64le",
-              "s390x",
-              "multi"
-            ],
-            "type": "string",
-            "default": "x86_64",
-            "description": "The CPU architecture of the image (x86_64/arm64/etc).",
-            "name": "cpu_architecture",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "baremetal",
-              "none",
-              "nutanix",
-              "vsphere",
-              "external"
-            ],
-            "type": "string",
-            "description": "The provider platform type.",
-            "name": "platform_type",
-            "in": "query"
-          },
           {
             "type": "string",
-            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external.",
-            "name": "external_platform_name",
-            "in": "query"
+            "format": "uuid",
+            "description": "The host that is being bound.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "object",
-              "properties": {
-                "features": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/feature"
-                  }
-                },
-                "operators": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/operator"
-                  }
-                }
-              }
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
@@ -6223,8 +6048,38 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Conflict.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
-            "description": "Internal Server Error.",
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -6232,51 +6087,36 @@ func init() {
         }
       }
     },
-    "/v2/supported-operators": {
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/hardware-revisions": {
       "get": {
-        "description": "Retrieves the list of supported operators.",
+        "description": "Lists the revisions of the hardware of the host, newest first, with the changes of each revision from the\nprevious one. A revision is recorded whenever the inventory of the host reports a different hardware\nfingerprint.\n",
         "tags": [
-          "operators"
+          "installer"
+        ],
+        "operationId": "v2ListHostHardwareRevisions",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose hardware revisions are listed.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
         ],
-        "operationId": "V2ListSupportedOperators",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string",
-                "enum": [
-                  "amd-gpu",
-                  "lso",
-                  "mtv",
-                  "openshift-ai",
-                  "osc",
-                  "servicemesh",
-                  "authorino",
-                  "cnv",
-                  "nvidia-gpu",
-                  "pipelines",
-                  "odf",
-                  "lvm",
-                  "mce",
-                  "node-feature-discovery",
-                  "serverless",
-                  "nmstate",
-                  "kmm",
-                  "node-healthcheck",
-                  "self-node-remediation",
-                  "fence-agents-remediation",
-                  "node-maintenance",
-                  "kube-descheduler",
-                  "cluster-observability",
-                  "numa-resources",
-                  "oadp",
-                  "metallb",
-                  "loki",
-                  "openshift-logging"
-                ]
-              }
+              "$ref": "#/definitions/host-hardware-revision-list"
             }
           },
           "401": {
@@ -6291,6 +6131,12 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -6300,27 +6146,27 @@ func init() {
         }
       }
     },
-    "/v2/supported-operators/{operator_name}": {
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition": {
       "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Lists properties for an operator.",
+        "description": "Fetch the ignition file for this host as a string. In case of unbound host produces an error",
         "tags": [
-          "operators"
+          "installer"
         ],
-        "operationId": "V2ListOperatorProperties",
+        "operationId": "v2GetHostIgnition",
         "parameters": [
           {
             "type": "string",
-            "description": "The operator name.",
-            "name": "operator_name",
+            "format": "uuid",
+            "description": "The infra-env of the host whose ignition file should be obtained.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition file should be obtained.",
+            "name": "host_id",
             "in": "path",
             "required": true
           }
@@ -6329,7 +6175,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/operator-properties"
+              "$ref": "#/definitions/host-ignition-params"
             }
           },
           "401": {
@@ -6350,2061 +6196,6042 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    }
-  },
-  "definitions": {
-    "api_vip": {
-      "description": "The virtual IP used to reach the OpenShift cluster's API.",
-      "type": "object",
-      "properties": {
-        "cluster_id": {
-          "description": "The cluster that this VIP is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "ip": {
-          "description": "The IP address.",
-          "$ref": "#/definitions/ip"
-        },
-        "verification": {
-          "description": "API VIP verification result.",
-          "$ref": "#/definitions/vip_verification"
-        }
-      }
-    },
-    "api_vip_connectivity_additional_request_header": {
-      "type": "object",
-      "properties": {
-        "key": {
-          "description": "Value of the header's key when making a request",
-          "type": "string"
-        },
-        "value": {
-          "description": "The value corresponding to the header key",
-          "type": "string"
-        }
-      }
-    },
-    "api_vip_connectivity_request": {
-      "type": "object",
-      "required": [
-        "url"
-      ],
-      "properties": {
-        "ca_certificate": {
-          "description": "A CA certficate to be used when contacting the URL via https.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "ignition_endpoint_token": {
-          "description": "A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url (DEPRECATED use request_headers to pass this token).",
-          "type": "string",
-          "x-nullable": true
-        },
-        "request_headers": {
-          "description": "Additional request headers to include when fetching the ignition from ignition_endpoint_url.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/api_vip_connectivity_additional_request_header"
+      },
+      "patch": {
+        "description": "Patch the ignition file for this host",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UpdateHostIgnition",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose ignition file should be updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           },
-          "x-nullable": true,
-          "x-omitempty": true
-        },
-        "url": {
-          "description": "URL address of the API.",
-          "type": "string"
-        },
-        "verify_cidr": {
-          "description": "Whether to verify if the API VIP belongs to one of the interfaces (DEPRECATED).",
-          "type": "boolean"
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition file should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Ignition config overrides.",
+            "name": "host-ignition-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-ignition-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "api_vip_connectivity_response": {
-      "description": "The response from the day-2 agent's attempt to download the worker ignition file from the API machine config server of the target cluster.\nNote - the name \"API VIP connectivity\" is old and misleading and is preserved for backwards compatibility.",
-      "type": "object",
-      "properties": {
-        "download_error": {
-          "description": "The error that occurred while downloading the worker ignition file, ignored when is_success is true",
-          "type": "string"
-        },
-        "ignition": {
-          "description": "Ignition file fetched from the target cluster's API machine config server.\nThis ignition file may be incomplete as almost all files / systemd units are removed from it by the agent in order to save space.",
-          "type": "string"
-        },
-        "is_success": {
-          "description": "Whether the agent was able to download the ignition or not",
-          "type": "boolean"
-        },
-        "url": {
-          "description": "This parameter mirrors the url parameter of the corresponding api_vip_connectivity_request",
-          "type": "string"
-        }
-      }
-    },
-    "architecture-support-level-id": {
-      "type": "string",
-      "enum": [
-        "X86_64_ARCHITECTURE",
-        "ARM64_ARCHITECTURE",
-        "PPC64LE_ARCHITECTURE",
-        "S390X_ARCHITECTURE",
-        "MULTIARCH_RELEASE_IMAGE"
-      ]
-    },
-    "bind-host-params": {
-      "required": [
-        "cluster_id"
-      ],
-      "properties": {
-        "cluster_id": {
-          "type": "string",
-          "format": "uuid"
-        }
-      }
-    },
-    "boot": {
-      "type": "object",
-      "properties": {
-        "command_line": {
-          "type": "string"
-        },
-        "current_boot_mode": {
-          "type": "string"
-        },
-        "device_type": {
-          "type": "string",
-          "enum": [
-            "persistent",
-            "ephemeral"
-          ]
-        },
-        "pxe_interface": {
-          "type": "string"
-        },
-        "secure_boot_state": {
-          "$ref": "#/definitions/secure-boot-state"
-        }
-      }
-    },
-    "bundle": {
-      "type": "object",
-      "properties": {
-        "description": {
-          "description": "Longer human friendly description for the bundle, usually one or more sentences.\n",
-          "type": "string"
-        },
-        "id": {
-          "description": "Unique identifier of the bundle, for example ` + "`" + `virtualization` + "`" + ` or ` + "`" + `openshift-ai-nvidia` + "`" + `.",
-          "type": "string"
-        },
-        "operators": {
-          "description": "List of operators associated with the bundle.",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/installer-args": {
+      "patch": {
+        "description": "Updates a host's installer arguments.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UpdateHostInstallerArgs",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose installer arguments should be updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose installer arguments should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The updated installer arguments.",
+            "name": "installer-args-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/installer-args-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "title": {
-          "description": "Short human friendly description for the bundle, usually only a few words, for example ` + "`" + `Virtualization` + "`" + ` or\n` + "`" + `OpenShift AI (NVIDIA)` + "`" + `.\n",
-          "type": "string"
         }
       }
     },
-    "cluster": {
-      "type": "object",
-      "required": [
-        "kind",
-        "id",
-        "href",
-        "image_info",
-        "status",
-        "status_info"
-      ],
-      "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string"
-        },
-        "ams_subscription_id": {
-          "description": "Unique identifier of the AMS subscription in OCM.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "api_vip_dns_name": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "api_vips": {
-          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/api_vip"
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions": {
+      "get": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the next operations that the host agent needs to perform.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetNextSteps",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that is retrieving instructions.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
-          "x-nullable": true
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string"
-        },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 128,
-          "minimum": 1
-        },
-        "cluster_networks": {
-          "description": "Cluster networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/cluster_network"
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is retrieving instructions.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
-          "x-nullable": true
-        },
-        "connectivity_majority_groups": {
-          "description": "Json formatted string containing the majority groups for connectivity checks.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "control_plane_count": {
-          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
-          "type": "integer"
-        },
-        "controller_logs_collected_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "controller_logs_started_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
-          "type": "string",
-          "default": "x86_64",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x",
-            "multi"
-          ],
-          "x-nullable": false
-        },
-        "created_at": {
-          "description": "The time that this cluster was created.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
+          {
+            "type": "integer",
+            "description": "The time on the host as seconds since the Unix epoch.",
+            "name": "timestamp",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving instructions.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
-        },
-        "deleted_at": {
-          "description": "swagger:ignore",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "gorm.io/gorm"
-            },
-            "type": "DeletedAt"
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/steps"
+            }
           },
-          "x-nullable": false
-        },
-        "disk_encryption": {
-          "description": "Information regarding hosts' installation disks encryption.",
-          "$ref": "#/definitions/disk-encryption"
-        },
-        "email_domain": {
-          "type": "string"
-        },
-        "enabled_host_count": {
-          "description": "hosts associated to this cluster that are not in 'disabled' state.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "feature_usage": {
-          "description": "JSON-formatted string containing the usage information by feature name",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "high_availability_mode": {
-          "description": "(DEPRECATED) Please use 'control_plane_count' instead. Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
-          "type": "string",
-          "default": "Full",
-          "enum": [
-            "Full",
-            "None"
-          ]
-        },
-        "host_networks": {
-          "description": "List of host networks to be filled during query.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_network"
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
           },
-          "x-go-custom-tag": "gorm:\"-\"",
-          "x-nullable": true
-        },
-        "hosts": {
-          "description": "Hosts that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host"
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
           },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
-        },
-        "href": {
-          "description": "Self link.",
-          "type": "string"
-        },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string"
-        },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"column:https_proxy\""
-        },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, arbiter nodes, worker nodes, or a combination of them.",
-          "type": "string",
-          "enum": [
-            "none",
-            "masters",
-            "arbiters",
-            "workers",
-            "masters,arbiters",
-            "masters,workers",
-            "arbiters,workers",
-            "masters,arbiters,workers",
-            "all"
-          ]
-        },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "ignition_endpoint": {
-          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
-          "$ref": "#/definitions/ignition-endpoint"
-        },
-        "ignored_cluster_validations": {
-          "description": "Json formatted string containing a list of cluster validations to be ignored. May also contain a list with a single string \"all\" to ignore all cluster validations. Some validations cannot be ignored.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "ignored_host_validations": {
-          "description": "Json formatted string containing a list of host validations to be ignored. May also contain a list with a single string \"all\" to ignore all host validations. Some validations cannot be ignored.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "image_info": {
-          "$ref": "#/definitions/image_info"
-        },
-        "imported": {
-          "description": "Indicates whether this cluster is an imported day-2 cluster or a\nregular cluster. Clusters are considered imported when they are\ncreated via the ../clusters/import endpoint. Day-2 clusters converted\nfrom day-1 clusters by kube-api controllers or the\n../clusters/\u003ccluster_id\u003e/actions/allow-add-hosts endpoint are not\nconsidered imported. Imported clusters usually lack a lot of\ninformation and are filled with default values that don't necessarily\nreflect the actual cluster they represent",
-          "type": "boolean",
-          "default": false
-        },
-        "ingress_vips": {
-          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ingress_vip"
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
-          "x-nullable": true
-        },
-        "install_completed_at": {
-          "description": "The time that this cluster completed installation.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "install_config_overrides": {
-          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"networking\":{\"networkType\": \"OVNKubernetes\"},\"fips\":true}"
-        },
-        "install_started_at": {
-          "description": "The time that this cluster started installation.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "ip_collisions": {
-          "description": "Json formatted string containing ip collisions detected in the cluster.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object,\n'AddHostsCluster' for cluster that add hosts to existing OCP cluster,\n'DisconnectedCluster' for clusters with embedded ignition for offline installation,\n",
-          "type": "string",
-          "enum": [
-            "Cluster",
-            "AddHostsCluster",
-            "DisconnectedCluster"
-          ]
-        },
-        "last-installation-preparation": {
-          "$ref": "#/definitions/last-installation-preparation"
-        },
-        "load_balancer": {
-          "$ref": "#/definitions/load_balancer"
-        },
-        "logs_info": {
-          "description": "The progress of log collection or empty if logs are not applicable",
-          "$ref": "#/definitions/logs_state"
-        },
-        "machine_network_cidr": {
-          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "machine_networks": {
-          "description": "Machine networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/machine_network"
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
-          "x-nullable": true
-        },
-        "monitored_operators": {
-          "description": "Operators that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/monitored-operator"
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
-        },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string"
-        },
-        "network_type": {
-          "description": "The desired network type used.",
-          "type": "string",
-          "enum": [
-            "OpenShiftSDN",
-            "OVNKubernetes"
-          ],
-          "x-nullable": true
-        },
-        "no_proxy": {
-          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string"
-        },
-        "ocp_release_image": {
-          "description": "OpenShift release image URI.",
-          "type": "string"
-        },
-        "openshift_cluster_id": {
-          "description": "Cluster ID on OCP system.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
-        },
-        "org_id": {
-          "type": "string"
-        },
-        "org_soft_timeouts_enabled": {
-          "description": "Indication if organization soft timeouts is enabled for the cluster.",
-          "type": "boolean"
-        },
-        "platform": {
-          "$ref": "#/definitions/platform"
-        },
-        "progress": {
-          "description": "Installation progress percentages of the cluster.",
-          "$ref": "#/definitions/cluster-progress-info"
-        },
-        "pull_secret_set": {
-          "description": "True if the pull secret has been added to the cluster.",
-          "type": "boolean"
-        },
-        "ready_host_count": {
-          "description": "hosts associated to this cluster that are in 'known' state.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "schedulable_masters": {
-          "description": "Schedule workloads on masters",
-          "type": "boolean",
-          "default": false
-        },
-        "schedulable_masters_forced_true": {
-          "description": "Indicates if schedule workloads on masters will be enabled regardless the value of 'schedulable_masters' property.\nSet to 'true' when not enough hosts are associated with this cluster to disable the scheduling on masters.\n",
-          "type": "boolean",
-          "default": true
-        },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "service_networks": {
-          "description": "Service networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/service_network"
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
-          "x-nullable": true
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
-          "type": "string"
-        },
-        "status": {
-          "description": "Status of the OpenShift cluster.",
-          "type": "string",
-          "enum": [
-            "insufficient",
-            "ready",
-            "error",
-            "preparing-for-installation",
-            "pending-for-input",
-            "installing",
-            "finalizing",
-            "installed",
-            "adding-hosts",
-            "cancelled",
-            "installing-pending-user-action",
-            "unmonitored"
-          ]
-        },
-        "status_info": {
-          "description": "Additional information pertaining to the status of the OpenShift cluster.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
-        },
-        "status_updated_at": {
-          "description": "The last time that the cluster status was updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "tags": {
-          "description": "A comma-separated list of tags that are associated to the cluster.",
-          "type": "string"
-        },
-        "total_host_count": {
-          "description": "All hosts associated to this cluster.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "updated_at": {
-          "description": "The last time that this cluster was updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "user_managed_networking": {
-          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "user_name": {
-          "type": "string"
-        },
-        "validations_info": {
-          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "x-nullable": true
         }
-      }
-    },
-    "cluster-create-params": {
-      "type": "object",
-      "required": [
-        "name",
-        "openshift_version",
-        "pull_secret"
-      ],
-      "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "api_vips": {
-          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/api_vip"
+      },
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
           }
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string"
-        },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "default": "10.128.0.0/14",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "default": 23,
-          "maximum": 128,
-          "minimum": 1
-        },
-        "cluster_networks": {
-          "description": "Cluster networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/cluster_network"
+        ],
+        "description": "Posts the result of the operations from the host agent.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2PostStepReply",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is posting results.",
+            "name": "discovery_agent_version",
+            "in": "header"
           },
-          "x-nullable": true
-        },
-        "control_plane_count": {
-          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
-          "type": "integer",
-          "x-nullable": true
-        },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
-          "type": "string",
-          "default": "x86_64",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x",
-            "multi"
-          ],
-          "x-nullable": false
-        },
-        "disk_encryption": {
-          "description": "Installation disks encryption mode and host roles to be applied.",
-          "$ref": "#/definitions/disk-encryption"
-        },
-        "high_availability_mode": {
-          "description": "(DEPRECATED) Please use 'control_plane_count' instead. Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
-          "type": "string",
-          "default": "Full",
-          "enum": [
-            "Full",
-            "None"
-          ]
-        },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
-        },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
-        },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, arbiter nodes, worker nodes, or a combination of them.",
-          "type": "string",
-          "default": "all",
-          "enum": [
-            "none",
-            "masters",
-            "arbiters",
-            "workers",
-            "masters,arbiters",
-            "masters,workers",
-            "arbiters,workers",
-            "masters,arbiters,workers",
-            "all"
-          ]
-        },
-        "ignition_endpoint": {
-          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
-          "$ref": "#/definitions/ignition-endpoint"
-        },
-        "ingress_vips": {
-          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ingress_vip"
-          }
-        },
-        "load_balancer": {
-          "$ref": "#/definitions/load_balancer"
-        },
-        "machine_networks": {
-          "description": "Machine networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/machine_network"
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that is posting results.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           },
-          "x-nullable": true
-        },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string",
-          "maxLength": 54,
-          "minLength": 1
-        },
-        "network_type": {
-          "description": "The desired network type used.",
-          "type": "string",
-          "enum": [
-            "OpenShiftSDN",
-            "OVNKubernetes"
-          ],
-          "x-nullable": true
-        },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "ocp_release_image": {
-          "description": "OpenShift release image URI.",
-          "type": "string"
-        },
-        "olm_operators": {
-          "description": "List of OLM operators to be installed.\nFor the full list of supported operators, check the endpoint ` + "`" + `/v2/supported-operators` + "`" + `:\n",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-create-params"
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is posting results.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The results to be posted.",
+            "name": "reply",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/step-reply"
+            }
           }
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
-        },
-        "platform": {
-          "x-nullable": true,
-          "$ref": "#/definitions/platform"
-        },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
-          "type": "string"
-        },
-        "schedulable_masters": {
-          "description": "Schedule workloads on masters",
-          "type": "boolean",
-          "default": false
-        },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "default": "172.30.0.0/16",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "service_networks": {
-          "description": "Service networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/service_network"
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
           },
-          "x-nullable": true
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
-          "type": "string"
-        },
-        "tags": {
-          "description": "A comma-separated list of tags that are associated to the cluster.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "user_managed_networking": {
-          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "default": false,
-          "x-nullable": true
-        },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "default": false,
-          "x-nullable": true
-        }
-      }
-    },
-    "cluster-finalizing-progress": {
-      "type": "object",
-      "properties": {
-        "finalizing_stage": {
-          "$ref": "#/definitions/finalizing-stage"
-        }
-      }
-    },
-    "cluster-host-requirements": {
-      "type": "object",
-      "properties": {
-        "host_id": {
-          "description": "Unique identifier of the host the requirements relate to.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "ocp": {
-          "description": "Host requirements for the OCP installation",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "operators": {
-          "description": "Host requirements related to requested operators",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-host-requirements"
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "total": {
-          "description": "Total host requirements for the cluster configuration",
-          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "cluster-host-requirements-details": {
-      "type": "object",
-      "properties": {
-        "cpu_cores": {
-          "description": "Required number of CPU cores",
-          "type": "integer"
-        },
-        "disk_size_gb": {
-          "description": "Required disk size in GB",
-          "type": "integer"
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/logs-progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update log collection state and progress.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UpdateHostLogsProgress",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env whose log progress is being updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose log progress is being updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Update cluster install progress."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update installation progress.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UpdateHostInstallProgress",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is updating progress.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host being updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The ID of the host to update.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "New progress value.",
+            "name": "host-progress",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-progress"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Update install progress."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/regenerate-signing-key": {
+      "post": {
+        "description": "Regenerate InfraEnv token signing key.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "RegenerateInfraEnvSigningKey",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The target InfraEnv.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/installer-cache/releases": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
+          }
+        ],
+        "description": "Lists the releases in the installer cache, with their size, last use and pin status. The local cache is the one of the replica that serves the request.",
+        "tags": [
+          "installer_cache"
+        ],
+        "operationId": "v2ListInstallerCacheReleases",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/installer-cache-release-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Pre-warms the installer cache with the installer binary of a release image ahead of demand. The binary is extracted in the background.",
+        "tags": [
+          "installer_cache"
+        ],
+        "operationId": "v2PrewarmInstallerCacheRelease",
+        "parameters": [
+          {
+            "description": "The release image to pre-warm.",
+            "name": "prewarm-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/installer-cache-prewarm-params"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/installer-cache-release"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "security": [
+          {
+            "userAuth": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Pins or unpins a release that was pre-warmed. Pinned releases are never evicted from the installer cache.",
+        "tags": [
+          "installer_cache"
+        ],
+        "operationId": "v2UpdateInstallerCacheRelease",
+        "parameters": [
+          {
+            "description": "The release image and its pin status.",
+            "name": "update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/installer-cache-release-update-params"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/installer-cache-release"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/openshift-versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift supported versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2ListSupportedOpenshiftVersions",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Retrieves only the versions that contain the specified substring in their display name.",
+            "name": "version",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "If true, returns only the latest version for each minor.",
+            "name": "only_latest",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/openshift-versions"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/operators/bundles": {
+      "get": {
+        "description": "Retrieves a list of available bundles filtered by support level.",
+        "tags": [
+          "operators"
+        ],
+        "summary": "Get list of available bundles",
+        "operationId": "V2ListBundles",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Version of the OpenShift cluster. If the parameter is not specified, no filtering is applied.",
+            "name": "openshift_version",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "x86_64",
+              "aarch64",
+              "arm64",
+              "ppc64le",
+              "s390x",
+              "multi"
+            ],
+            "type": "string",
+            "default": "x86_64",
+            "description": "The CPU architecture of the image (x86_64/arm64/etc). openshift_version must be set.",
+            "name": "cpu_architecture",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "baremetal",
+              "none",
+              "nutanix",
+              "vsphere",
+              "external"
+            ],
+            "type": "string",
+            "description": "The provider platform type. openshift_version must be set.",
+            "name": "platform_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external or if openshift_version is not set.",
+            "name": "external_platform_name",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "SNO"
+              ],
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Array of feature IDs that affect bundle composition (e.g., [\"SNO\"] for Single Node OpenShift).",
+            "name": "feature_ids",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/bundle"
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/operators/bundles/{id}": {
+      "get": {
+        "description": "Retrieves an array of operator properties for the specified bundle when some features are activated.",
+        "tags": [
+          "operators"
+        ],
+        "summary": "Get operator properties for a bundle",
+        "operationId": "V2GetBundle",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Identifier of the bundle, for example, ` + "`" + `virtualization` + "`" + ` or ` + "`" + `openshift-ai` + "`" + `.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "SNO"
+              ],
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Array of feature IDs that affect bundle composition (e.g., [\"SNO\"] for Single Node OpenShift).",
+            "name": "feature_ids",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/bundle"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Bundle not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/release-sources": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves openshift release sources configuration.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2ListReleaseSources",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/release-sources"
+            }
+          }
+        }
+      }
+    },
+    "/v2/subscriptions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the webhook subscriptions of the organization.",
+        "tags": [
+          "subscriptions"
+        ],
+        "operationId": "v2ListSubscriptions",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "If provided, returns only subscriptions for this cluster.",
+            "name": "cluster_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/subscription-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Registers a webhook that is called when the subscribed clusters and hosts change.",
+        "tags": [
+          "subscriptions"
+        ],
+        "operationId": "v2RegisterSubscription",
+        "parameters": [
+          {
+            "description": "The properties describing the new subscription.",
+            "name": "new-subscription-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/subscription-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/subscription"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/subscriptions/{subscription_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the details of the webhook subscription.",
+        "tags": [
+          "subscriptions"
+        ],
+        "operationId": "v2GetSubscription",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The subscription to be retrieved.",
+            "name": "subscription_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/subscription"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes a webhook subscription and its deliveries.",
+        "tags": [
+          "subscriptions"
+        ],
+        "operationId": "v2DeregisterSubscription",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The subscription to be deleted.",
+            "name": "subscription_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Updates a webhook subscription.",
+        "tags": [
+          "subscriptions"
+        ],
+        "operationId": "v2UpdateSubscription",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The subscription to be updated.",
+            "name": "subscription_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "subscription-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/subscription-update-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/subscription"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/subscriptions/{subscription_id}/deliveries": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the deliveries of the webhook subscription, along with their delivery attempts, starting from the most recent.",
+        "tags": [
+          "subscriptions"
+        ],
+        "operationId": "v2ListSubscriptionDeliveries",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The subscription to list the deliveries of.",
+            "name": "subscription_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "pending",
+              "delivered",
+              "failed"
+            ],
+            "type": "string",
+            "description": "If provided, returns only deliveries with this status.",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "The maximum number of records to retrieve.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Number of records to skip before starting to return the records.",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/subscription-delivery-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/subscriptions/{subscription_id}/deliveries/{delivery_id}/actions/redeliver": {
+      "post": {
+        "description": "Schedules the delivery to be sent again, regardless of its previous attempts.",
+        "tags": [
+          "subscriptions"
+        ],
+        "operationId": "v2RedeliverSubscriptionDelivery",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The subscription of the delivery.",
+            "name": "subscription_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The delivery to be sent again.",
+            "name": "delivery_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/subscription-delivery"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/support-levels/architectures": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the architecture support-levels for each OpenShift version.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetSupportedArchitectures",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Version of the OpenShift cluster.",
+            "name": "openshift_version",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "architectures": {
+                  "description": "Keys will be one of architecture-support-level-id enum.",
+                  "$ref": "#/definitions/support-levels"
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/support-levels/features": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the features support levels for each OpenShift version.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetSupportedFeatures",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Version of the OpenShift cluster.",
+            "name": "openshift_version",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "x86_64",
+              "aarch64",
+              "arm64",
+              "ppc64le",
+              "s390x",
+              "multi"
+            ],
+            "type": "string",
+            "default": "x86_64",
+            "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+            "name": "cpu_architecture",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "baremetal",
+              "none",
+              "nutanix",
+              "vsphere",
+              "external"
+            ],
+            "type": "string",
+            "description": "The provider platform type.",
+            "name": "platform_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external.",
+            "name": "external_platform_name",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "features": {
+                  "description": "Keys will be one of features-support-level-id enum.",
+                  "$ref": "#/definitions/support-levels"
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/support-levels/features/detailed": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves detailed features information including support level, incompatibilities, and operator dependencies.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetDetailedSupportedFeatures",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Version of the OpenShift cluster.",
+            "name": "openshift_version",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "x86_64",
+              "arm64",
+              "ppc64le",
+              "s390x",
+              "multi"
+            ],
+            "type": "string",
+            "default": "x86_64",
+            "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+            "name": "cpu_architecture",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "baremetal",
+              "none",
+              "nutanix",
+              "vsphere",
+              "external"
+            ],
+            "type": "string",
+            "description": "The provider platform type.",
+            "name": "platform_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external.",
+            "name": "external_platform_name",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "features": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/feature"
+                  }
+                },
+                "operators": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/operator"
+                  }
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/supported-operators": {
+      "get": {
+        "description": "Retrieves the list of supported operators.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "V2ListSupportedOperators",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string",
+                "enum": [
+                  "amd-gpu",
+                  "lso",
+                  "mtv",
+                  "openshift-ai",
+                  "osc",
+                  "servicemesh",
+                  "authorino",
+                  "cnv",
+                  "nvidia-gpu",
+                  "pipelines",
+                  "odf",
+                  "lvm",
+                  "mce",
+                  "node-feature-discovery",
+                  "serverless",
+                  "nmstate",
+                  "kmm",
+                  "node-healthcheck",
+                  "self-node-remediation",
+                  "fence-agents-remediation",
+                  "node-maintenance",
+                  "kube-descheduler",
+                  "cluster-observability",
+                  "numa-resources",
+                  "oadp",
+                  "metallb",
+                  "loki",
+                  "openshift-logging"
+                ]
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/supported-operators/{operator_name}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists properties for an operator.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "V2ListOperatorProperties",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The operator name.",
+            "name": "operator_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/operator-properties"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/watch": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "watcherAuth": []
+          }
+        ],
+        "description": "Streams cluster, host, infra-env and event notifications as server-sent events.",
+        "produces": [
+          "text/event-stream"
+        ],
+        "tags": [
+          "events"
+        ],
+        "operationId": "v2Watch",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to stream notifications for.",
+            "name": "cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to stream notifications for.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "ClusterState",
+                "HostState",
+                "InfraEnv",
+                "Event"
+              ],
+              "type": "string"
+            },
+            "description": "The notification types to stream. All types are streamed if not specified.",
+            "name": "notification_types",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Resume the stream after the notification with this offset.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resume the stream after the notification with this offset, as sent by reconnecting server-sent events clients. Ignored if 'since' is specified.",
+            "name": "Last-Event-ID",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "400": {
+            "description": "Bad Request.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "api_vip": {
+      "description": "The virtual IP used to reach the OpenShift cluster's API.",
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster that this VIP is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "ip": {
+          "description": "The IP address.",
+          "$ref": "#/definitions/ip"
+        },
+        "verification": {
+          "description": "API VIP verification result.",
+          "$ref": "#/definitions/vip_verification"
+        }
+      }
+    },
+    "api_vip_connectivity_additional_request_header": {
+      "type": "object",
+      "properties": {
+        "key": {
+          "description": "Value of the header's key when making a request",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value corresponding to the header key",
+          "type": "string"
+        }
+      }
+    },
+    "api_vip_connectivity_request": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "ca_certificate": {
+          "description": "A CA certficate to be used when contacting the URL via https.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "ignition_endpoint_token": {
+          "description": "A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url (DEPRECATED use request_headers to pass this token).",
+          "type": "string",
+          "x-nullable": true
+        },
+        "request_headers": {
+          "description": "Additional request headers to include when fetching the ignition from ignition_endpoint_url.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/api_vip_connectivity_additional_request_header"
+          },
+          "x-nullable": true,
+          "x-omitempty": true
+        },
+        "url": {
+          "description": "URL address of the API.",
+          "type": "string"
+        },
+        "verify_cidr": {
+          "description": "Whether to verify if the API VIP belongs to one of the interfaces (DEPRECATED).",
+          "type": "boolean"
+        }
+      }
+    },
+    "api_vip_connectivity_response": {
+      "description": "The response from the day-2 agent's attempt to download the worker ignition file from the API machine config server of the target cluster.\nNote - the name \"API VIP connectivity\" is old and misleading and is preserved for backwards compatibility.",
+      "type": "object",
+      "properties": {
+        "download_error": {
+          "description": "The error that occurred while downloading the worker ignition file, ignored when is_success is true",
+          "type": "string"
+        },
+        "ignition": {
+          "description": "Ignition file fetched from the target cluster's API machine config server.\nThis ignition file may be incomplete as almost all files / systemd units are removed from it by the agent in order to save space.",
+          "type": "string"
+        },
+        "is_success": {
+          "description": "Whether the agent was able to download the ignition or not",
+          "type": "boolean"
+        },
+        "url": {
+          "description": "This parameter mirrors the url parameter of the corresponding api_vip_connectivity_request",
+          "type": "string"
+        }
+      }
+    },
+    "architecture-support-level-id": {
+      "type": "string",
+      "enum": [
+        "X86_64_ARCHITECTURE",
+        "ARM64_ARCHITECTURE",
+        "PPC64LE_ARCHITECTURE",
+        "S390X_ARCHITECTURE",
+        "MULTIARCH_RELEASE_IMAGE"
+      ]
+    },
+    "bandwidth_check_host": {
+      "type": "object",
+      "required": [
+        "host_id",
+        "ip_address"
+      ],
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "ip_address": {
+          "description": "The address of the host to measure the bandwidth to.",
+          "type": "string"
+        }
+      }
+    },
+    "bandwidth_check_remote_host": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "The reason the bandwidth couldn't be measured.",
+          "type": "string"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "ip_address": {
+          "type": "string"
+        },
+        "successful": {
+          "description": "Whether the bandwidth to the host was measured.",
+          "type": "boolean"
+        },
+        "throughput_mbps": {
+          "description": "The measured throughput to the host, in Mbps.",
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "bandwidth_check_request": {
+      "type": "object",
+      "required": [
+        "hosts",
+        "duration_seconds"
+      ],
+      "properties": {
+        "duration_seconds": {
+          "description": "The duration of the measurement to each host.",
+          "type": "integer",
+          "maximum": 60,
+          "minimum": 1
+        },
+        "hosts": {
+          "description": "The hosts to measure the bandwidth to.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bandwidth_check_host"
+          }
+        }
+      }
+    },
+    "bandwidth_check_response": {
+      "type": "object",
+      "properties": {
+        "remote_hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bandwidth_check_remote_host"
+          }
+        }
+      }
+    },
+    "bind-host-params": {
+      "required": [
+        "cluster_id"
+      ],
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "bios": {
+      "type": "object",
+      "properties": {
+        "release_date": {
+          "type": "string"
+        },
+        "vendor": {
+          "type": "string"
+        },
+        "version": {
+          "type": "string"
+        }
+      }
+    },
+    "boot": {
+      "type": "object",
+      "properties": {
+        "command_line": {
+          "type": "string"
+        },
+        "current_boot_mode": {
+          "type": "string"
+        },
+        "device_type": {
+          "type": "string",
+          "enum": [
+            "persistent",
+            "ephemeral"
+          ]
+        },
+        "pxe_interface": {
+          "type": "string"
+        },
+        "secure_boot_state": {
+          "$ref": "#/definitions/secure-boot-state"
+        }
+      }
+    },
+    "bulk-host-operation": {
+      "description": "An operation on a host, identified by its ID or by the MAC address of one of its interfaces.",
+      "type": "object",
+      "required": [
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "bind",
+            "unbind",
+            "set-role",
+            "set-hostname",
+            "set-installation-disk",
+            "reset-validation",
+            "approve"
+          ]
+        },
+        "approved": {
+          "description": "Whether the host is approved, for approve.",
+          "type": "boolean",
+          "default": true
+        },
+        "cluster_id": {
+          "description": "The cluster that the host is bound to, for bind.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "host_name": {
+          "description": "The host name, for set-hostname.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "host_role": {
+          "description": "The role of the host, for set-role.",
+          "type": "string",
+          "enum": [
+            "auto-assign",
+            "master",
+            "arbiter",
+            "worker"
+          ],
+          "x-nullable": true
+        },
+        "installation_disk_id": {
+          "description": "The id of the disk the host is installed on, for set-installation-disk.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "mac_address": {
+          "type": "string",
+          "pattern": "^([0-9A-Fa-f]{2}[:-]){5}([0-9A-Fa-f]{2})$",
+          "x-nullable": true
+        },
+        "validation_id": {
+          "description": "The validation to reset, for reset-validation.",
+          "type": "string",
+          "x-nullable": true
+        }
+      }
+    },
+    "bulk-host-operation-result": {
+      "type": "object",
+      "required": [
+        "index",
+        "status"
+      ],
+      "properties": {
+        "code": {
+          "description": "The HTTP status code of the equivalent single host call, if the operation failed.",
+          "type": "integer"
+        },
+        "host_id": {
+          "description": "The host that the operation applied to. Not set if the host was not found.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "index": {
+          "description": "The position of the operation in the request.",
+          "type": "integer"
+        },
+        "reason": {
+          "description": "The reason of the failure.",
+          "type": "string"
+        },
+        "status": {
+          "description": "not-applied operations were rolled back or skipped because another operation of the transaction failed.",
+          "type": "string",
+          "enum": [
+            "succeeded",
+            "failed",
+            "not-applied"
+          ]
+        }
+      }
+    },
+    "bulk-host-operations": {
+      "type": "object",
+      "required": [
+        "operations"
+      ],
+      "properties": {
+        "operations": {
+          "type": "array",
+          "maxItems": 1000,
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/bulk-host-operation"
+          }
+        }
+      }
+    },
+    "bulk-host-operations-result": {
+      "type": "object",
+      "required": [
+        "results"
+      ],
+      "properties": {
+        "results": {
+          "description": "The results of the operations, in the order of the operations.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bulk-host-operation-result"
+          }
+        }
+      }
+    },
+    "bundle": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "description": "Longer human friendly description for the bundle, usually one or more sentences.\n",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the bundle, for example ` + "`" + `virtualization` + "`" + ` or ` + "`" + `openshift-ai-nvidia` + "`" + `.",
+          "type": "string"
+        },
+        "operators": {
+          "description": "List of operators associated with the bundle.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "title": {
+          "description": "Short human friendly description for the bundle, usually only a few words, for example ` + "`" + `Virtualization` + "`" + ` or\n` + "`" + `OpenShift AI (NVIDIA)` + "`" + `.\n",
+          "type": "string"
+        }
+      }
+    },
+    "cluster": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "image_info",
+        "status",
+        "status_info"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        },
+        "ams_subscription_id": {
+          "description": "Unique identifier of the AMS subscription in OCM.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "api_vip_dns_name": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vips": {
+          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/api_vip"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "x-nullable": true
+        },
+        "bandwidth_check_enabled": {
+          "description": "Measure the network bandwidth between the hosts of the cluster and validate it against the minimum bandwidth of their role.",
+          "type": "boolean",
+          "default": false,
+          "x-go-custom-tag": "gorm:\"default:false\""
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "cluster_networks": {
+          "description": "Cluster networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "x-nullable": true
+        },
+        "connectivity_majority_groups": {
+          "description": "Json formatted string containing the majority groups for connectivity checks.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "control_plane_count": {
+          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
+          "type": "integer"
+        },
+        "controller_logs_collected_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "controller_logs_started_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x",
+            "multi"
+          ],
+          "x-nullable": false
+        },
+        "created_at": {
+          "description": "The time that this cluster was created.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "deleted_at": {
+          "description": "swagger:ignore",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "gorm.io/gorm"
+            },
+            "type": "DeletedAt"
+          },
+          "x-nullable": false
+        },
+        "disk_encryption": {
+          "description": "Information regarding hosts' installation disks encryption.",
+          "$ref": "#/definitions/disk-encryption"
+        },
+        "email_domain": {
+          "type": "string"
+        },
+        "enabled_host_count": {
+          "description": "hosts associated to this cluster that are not in 'disabled' state.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "feature_usage": {
+          "description": "JSON-formatted string containing the usage information by feature name",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "firmware_policy": {
+          "description": "JSON formatted firmware policy that the hosts of the cluster must comply with.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "x-nullable": true
+        },
+        "high_availability_mode": {
+          "description": "(DEPRECATED) Please use 'control_plane_count' instead. Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "host_networks": {
+          "description": "List of host networks to be filled during query.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_network"
+          },
+          "x-go-custom-tag": "gorm:\"-\"",
+          "x-nullable": true
+        },
+        "hosts": {
+          "description": "Hosts that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string"
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"column:https_proxy\""
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, arbiter nodes, worker nodes, or a combination of them.",
+          "type": "string",
+          "enum": [
+            "none",
+            "masters",
+            "arbiters",
+            "workers",
+            "masters,arbiters",
+            "masters,workers",
+            "arbiters,workers",
+            "masters,arbiters,workers",
+            "all"
+          ]
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "ignition_endpoint": {
+          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+          "$ref": "#/definitions/ignition-endpoint"
+        },
+        "ignored_cluster_validations": {
+          "description": "Json formatted string containing a list of cluster validations to be ignored. May also contain a list with a single string \"all\" to ignore all cluster validations. Some validations cannot be ignored.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "ignored_host_validations": {
+          "description": "Json formatted string containing a list of host validations to be ignored. May also contain a list with a single string \"all\" to ignore all host validations. Some validations cannot be ignored.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "image_info": {
+          "$ref": "#/definitions/image_info"
+        },
+        "imported": {
+          "description": "Indicates whether this cluster is an imported day-2 cluster or a\nregular cluster. Clusters are considered imported when they are\ncreated via the ../clusters/import endpoint. Day-2 clusters converted\nfrom day-1 clusters by kube-api controllers or the\n../clusters/\u003ccluster_id\u003e/actions/allow-add-hosts endpoint are not\nconsidered imported. Imported clusters usually lack a lot of\ninformation and are filled with default values that don't necessarily\nreflect the actual cluster they represent",
+          "type": "boolean",
+          "default": false
+        },
+        "ingress_vips": {
+          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ingress_vip"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "x-nullable": true
+        },
+        "install_completed_at": {
+          "description": "The time that this cluster completed installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "{\"networking\":{\"networkType\": \"OVNKubernetes\"},\"fips\":true}"
+        },
+        "install_started_at": {
+          "description": "The time that this cluster started installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "ip_collisions": {
+          "description": "Json formatted string containing ip collisions detected in the cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object,\n'AddHostsCluster' for cluster that add hosts to existing OCP cluster,\n'DisconnectedCluster' for clusters with embedded ignition for offline installation,\n",
+          "type": "string",
+          "enum": [
+            "Cluster",
+            "AddHostsCluster",
+            "DisconnectedCluster"
+          ]
+        },
+        "last-installation-preparation": {
+          "$ref": "#/definitions/last-installation-preparation"
+        },
+        "load_balancer": {
+          "$ref": "#/definitions/load_balancer"
+        },
+        "logs_info": {
+          "description": "The progress of log collection or empty if logs are not applicable",
+          "$ref": "#/definitions/logs_state"
+        },
+        "machine_network_cidr": {
+          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "machine_networks": {
+          "description": "Machine networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/machine_network"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "x-nullable": true
+        },
+        "monitored_operators": {
+          "description": "Operators that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/monitored-operator"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "network_type": {
+          "description": "The desired network type used.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ],
+          "x-nullable": true
+        },
+        "no_proxy": {
+          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string"
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "openshift_cluster_id": {
+          "description": "Cluster ID on OCP system.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string"
+        },
+        "org_soft_timeouts_enabled": {
+          "description": "Indication if organization soft timeouts is enabled for the cluster.",
+          "type": "boolean"
+        },
+        "platform": {
+          "$ref": "#/definitions/platform"
+        },
+        "progress": {
+          "description": "Installation progress percentages of the cluster.",
+          "$ref": "#/definitions/cluster-progress-info"
+        },
+        "pull_secret_set": {
+          "description": "True if the pull secret has been added to the cluster.",
+          "type": "boolean"
+        },
+        "ready_host_count": {
+          "description": "hosts associated to this cluster that are in 'known' state.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "schedulable_masters": {
+          "description": "Schedule workloads on masters",
+          "type": "boolean",
+          "default": false
+        },
+        "schedulable_masters_forced_true": {
+          "description": "Indicates if schedule workloads on masters will be enabled regardless the value of 'schedulable_masters' property.\nSet to 'true' when not enough hosts are associated with this cluster to disable the scheduling on masters.\n",
+          "type": "boolean",
+          "default": true
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "service_networks": {
+          "description": "Service networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "x-nullable": true
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "status": {
+          "description": "Status of the OpenShift cluster.",
+          "type": "string",
+          "enum": [
+            "insufficient",
+            "ready",
+            "error",
+            "preparing-for-installation",
+            "pending-for-input",
+            "installing",
+            "finalizing",
+            "installed",
+            "adding-hosts",
+            "cancelled",
+            "installing-pending-user-action",
+            "unmonitored"
+          ]
+        },
+        "status_info": {
+          "description": "Additional information pertaining to the status of the OpenShift cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        },
+        "status_updated_at": {
+          "description": "The last time that the cluster status was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "tags": {
+          "description": "A comma-separated list of tags that are associated to the cluster.",
+          "type": "string"
+        },
+        "total_host_count": {
+          "description": "All hosts associated to this cluster.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "updated_at": {
+          "description": "The last time that this cluster was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "user_managed_networking": {
+          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "user_name": {
+          "type": "string"
+        },
+        "validations_info": {
+          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-archive": {
+      "description": "The definition of a cluster, exported to be imported by another service.",
+      "type": "object",
+      "required": [
+        "format_version",
+        "source_cluster_id",
+        "cluster",
+        "secrets"
+      ],
+      "properties": {
+        "cluster": {
+          "description": "Properties of cluster-create-params. The pull secret and the SSH public key are in the secrets.",
+          "type": "object",
+          "additionalProperties": true
+        },
+        "exported_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "format_version": {
+          "description": "The version of the format of the archive.",
+          "type": "integer"
+        },
+        "hosts": {
+          "description": "The properties of the hosts of the cluster, identified by MAC address so that they can be applied to the same hosts when they register with the imported cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-plan-host"
+          }
+        },
+        "ignored_validations": {
+          "$ref": "#/definitions/ignored-validations"
+        },
+        "infra_envs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-archive-infra-env"
+          }
+        },
+        "manifests": {
+          "description": "The custom manifests of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/create-manifest-params"
+          }
+        },
+        "secrets": {
+          "$ref": "#/definitions/cluster-archive-secrets"
+        },
+        "source_cluster_id": {
+          "description": "The ID of the exported cluster.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "ui_settings": {
+          "description": "The settings of the cluster in the UI.",
+          "type": "string"
+        }
+      }
+    },
+    "cluster-archive-encryption-key": {
+      "type": "object",
+      "required": [
+        "key_id",
+        "public_key"
+      ],
+      "properties": {
+        "key_id": {
+          "description": "The SHA-256 fingerprint of the public key.",
+          "type": "string"
+        },
+        "public_key": {
+          "description": "The PEM encoded RSA public key.",
+          "type": "string"
+        }
+      }
+    },
+    "cluster-archive-infra-env": {
+      "type": "object",
+      "required": [
+        "source_id",
+        "params"
+      ],
+      "properties": {
+        "params": {
+          "description": "Properties of infra-env-create-params. The pull secret and the SSH key are in the secrets of the archive.",
+          "type": "object",
+          "additionalProperties": true
+        },
+        "source_id": {
+          "description": "The ID of the exported infra-env.",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "cluster-archive-secrets": {
+      "description": "The secrets of the archive, encrypted with AES-256-GCM under a key that is encrypted with RSA-OAEP (SHA-256) to the key of the importing service.",
+      "type": "object",
+      "required": [
+        "key_id",
+        "encrypted_key",
+        "nonce",
+        "ciphertext"
+      ],
+      "properties": {
+        "ciphertext": {
+          "type": "string",
+          "format": "byte"
+        },
+        "encrypted_key": {
+          "type": "string",
+          "format": "byte"
+        },
+        "key_id": {
+          "description": "The ID of the key that the archive is encrypted to.",
+          "type": "string"
+        },
+        "nonce": {
+          "type": "string",
+          "format": "byte"
+        }
+      }
+    },
+    "cluster-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "openshift_version",
+        "pull_secret"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vips": {
+          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/api_vip"
+          }
+        },
+        "bandwidth_check_enabled": {
+          "description": "Measure the network bandwidth between the hosts of the cluster and validate it against the minimum bandwidth of their role.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "10.128.0.0/14",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "default": 23,
+          "maximum": 128,
+          "minimum": 1
+        },
+        "cluster_networks": {
+          "description": "Cluster networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          },
+          "x-nullable": true
+        },
+        "control_plane_count": {
+          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
+          "type": "integer",
+          "x-nullable": true
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x",
+            "multi"
+          ],
+          "x-nullable": false
+        },
+        "disk_encryption": {
+          "description": "Installation disks encryption mode and host roles to be applied.",
+          "$ref": "#/definitions/disk-encryption"
+        },
+        "firmware_policy": {
+          "description": "The firmware settings that the hosts of the cluster must comply with.",
+          "$ref": "#/definitions/firmware-policy"
+        },
+        "high_availability_mode": {
+          "description": "(DEPRECATED) Please use 'control_plane_count' instead. Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, arbiter nodes, worker nodes, or a combination of them.",
+          "type": "string",
+          "default": "all",
+          "enum": [
+            "none",
+            "masters",
+            "arbiters",
+            "workers",
+            "masters,arbiters",
+            "masters,workers",
+            "arbiters,workers",
+            "masters,arbiters,workers",
+            "all"
+          ]
+        },
+        "ignition_endpoint": {
+          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+          "$ref": "#/definitions/ignition-endpoint"
+        },
+        "ingress_vips": {
+          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ingress_vip"
+          }
+        },
+        "load_balancer": {
+          "$ref": "#/definitions/load_balancer"
+        },
+        "machine_networks": {
+          "description": "Machine networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/machine_network"
+          },
+          "x-nullable": true
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1
+        },
+        "network_type": {
+          "description": "The desired network type used.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ],
+          "x-nullable": true
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.\nFor the full list of supported operators, check the endpoint ` + "`" + `/v2/supported-operators` + "`" + `:\n",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "platform": {
+          "x-nullable": true,
+          "$ref": "#/definitions/platform"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        },
+        "schedulable_masters": {
+          "description": "Schedule workloads on masters",
+          "type": "boolean",
+          "default": false
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "172.30.0.0/16",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "service_networks": {
+          "description": "Service networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          },
+          "x-nullable": true
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "tags": {
+          "description": "A comma-separated list of tags that are associated to the cluster.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "user_managed_networking": {
+          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-export-params": {
+      "type": "object",
+      "required": [
+        "recipient_public_key"
+      ],
+      "properties": {
+        "recipient_public_key": {
+          "description": "The PEM encoded RSA public key of the service that imports the archive, as returned by its v2GetClusterArchiveEncryptionKey.",
+          "type": "string"
+        }
+      }
+    },
+    "cluster-finalizing-progress": {
+      "type": "object",
+      "properties": {
+        "finalizing_stage": {
+          "$ref": "#/definitions/finalizing-stage"
+        }
+      }
+    },
+    "cluster-host-requirements": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "description": "Unique identifier of the host the requirements relate to.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "ocp": {
+          "description": "Host requirements for the OCP installation",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        },
+        "operators": {
+          "description": "Host requirements related to requested operators",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-host-requirements"
+          }
+        },
+        "total": {
+          "description": "Total host requirements for the cluster configuration",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        }
+      }
+    },
+    "cluster-host-requirements-details": {
+      "type": "object",
+      "properties": {
+        "cpu_cores": {
+          "description": "Required number of CPU cores",
+          "type": "integer"
+        },
+        "disk_size_gb": {
+          "description": "Required disk size in GB",
+          "type": "integer"
+        },
+        "installation_disk_speed_threshold_ms": {
+          "description": "Required installation disk speed in ms",
+          "type": "integer"
+        },
+        "network_bandwidth_threshold_mbps": {
+          "description": "Minimum network bandwidth at L3 to other hosts of the same role, in Mbps. Validated only for clusters with bandwidth checks enabled.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "network_latency_threshold_ms": {
+          "description": "Maximum network average latency (RTT) at L3 for role.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "packet_loss_percentage": {
+          "description": "Maximum packet loss allowed at L3 for role.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "ram_mib": {
+          "description": "Required number of RAM in MiB",
+          "type": "integer"
+        },
+        "tpm_enabled_in_bios": {
+          "description": "Whether TPM module should be enabled in host's BIOS.",
+          "type": "boolean"
+        }
+      }
+    },
+    "cluster-host-requirements-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-host-requirements"
+      }
+    },
+    "cluster-import-result": {
+      "type": "object",
+      "properties": {
+        "cluster": {
+          "$ref": "#/definitions/cluster"
+        },
+        "host_plan": {
+          "description": "A plan that applies the properties of the exported hosts, to be applied with v2ApplyClusterPlan once the hosts are discovered.",
+          "$ref": "#/definitions/cluster-plan"
+        },
+        "infra_env_ids": {
+          "description": "The IDs of the registered infra-envs, keyed by the IDs of the exported infra-envs.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string",
+            "format": "uuid"
+          }
+        }
+      }
+    },
+    "cluster-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster"
+      }
+    },
+    "cluster-plan": {
+      "description": "The desired state of a cluster. Only the properties that are set are compared and applied.",
+      "type": "object",
+      "properties": {
+        "cluster": {
+          "description": "The desired cluster properties.",
+          "$ref": "#/definitions/v2-cluster-update-params"
+        },
+        "hosts": {
+          "description": "The desired host properties, matched to the hosts of the cluster by MAC address.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-plan-host"
+          }
+        },
+        "infra_env": {
+          "description": "The desired infra-env properties.",
+          "$ref": "#/definitions/infra-env-update-params"
+        },
+        "infra_env_id": {
+          "description": "The infra-env that infra_env is applied to. Defaults to the single infra-env of the cluster.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "install": {
+          "description": "Installs the cluster once the rest of the plan is applied.",
+          "type": "boolean",
+          "default": false
+        },
+        "manifests": {
+          "description": "The desired custom manifests.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/create-manifest-params"
+          }
+        },
+        "operators": {
+          "description": "The desired OLM operators. Operators that are only installed as dependencies are ignored.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          },
+          "x-nullable": true
+        },
+        "prune_manifests": {
+          "description": "Deletes the custom manifests of the cluster that are not in manifests.",
+          "type": "boolean",
+          "default": false
+        }
+      }
+    },
+    "cluster-plan-change": {
+      "type": "object",
+      "required": [
+        "resource",
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "create",
+            "update",
+            "delete",
+            "install"
+          ]
+        },
+        "current": {
+          "description": "The current value of the property. Omitted for sensitive properties.",
+          "x-nullable": true
+        },
+        "desired": {
+          "description": "The desired value of the property. Omitted for sensitive properties.",
+          "x-nullable": true
+        },
+        "field": {
+          "description": "The changed property of the resource.",
+          "type": "string"
+        },
+        "id": {
+          "description": "The identifier of the changed resource. Manifests are identified by their folder and file name.",
+          "type": "string"
+        },
+        "resource": {
+          "type": "string",
+          "enum": [
+            "cluster",
+            "infra-env",
+            "host",
+            "manifest",
+            "operator"
+          ]
+        }
+      }
+    },
+    "cluster-plan-host": {
+      "type": "object",
+      "required": [
+        "mac_address"
+      ],
+      "properties": {
+        "host_name": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "host_role": {
+          "type": "string",
+          "enum": [
+            "auto-assign",
+            "master",
+            "arbiter",
+            "worker"
+          ],
+          "x-nullable": true
+        },
+        "installation_disk_id": {
+          "description": "The id of the disk the host is installed on.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "mac_address": {
+          "description": "The MAC address of one of the interfaces of the host.",
+          "type": "string",
+          "pattern": "^([0-9A-Fa-f]{2}[:-]){5}([0-9A-Fa-f]{2})$"
+        }
+      }
+    },
+    "cluster-plan-result": {
+      "type": "object",
+      "required": [
+        "changes",
+        "applied"
+      ],
+      "properties": {
+        "applied": {
+          "description": "Whether the changes were applied.",
+          "type": "boolean"
+        },
+        "changes": {
+          "description": "The changes needed to bring the cluster to the state described by the plan.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-plan-change"
+          }
+        },
+        "cluster": {
+          "description": "The cluster once the changes are applied.",
+          "$ref": "#/definitions/cluster"
+        }
+      }
+    },
+    "cluster-progress-info": {
+      "type": "object",
+      "properties": {
+        "finalizing_stage": {
+          "$ref": "#/definitions/finalizing-stage"
+        },
+        "finalizing_stage_percentage": {
+          "type": "integer"
+        },
+        "finalizing_stage_started_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "finalizing_stage_timed_out": {
+          "type": "boolean"
+        },
+        "installing_stage_percentage": {
+          "type": "integer"
+        },
+        "preparing_for_installation_stage_percentage": {
+          "type": "integer"
+        },
+        "total_percentage": {
+          "type": "integer"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
+    },
+    "cluster-revision": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "revision",
+        "reason",
+        "created_at"
+      ],
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "reason": {
+          "description": "The kind of update that recorded the revision.",
+          "type": "string",
+          "enum": [
+            "register",
+            "cluster-update",
+            "host-update",
+            "ignored-validations-update",
+            "plan-apply",
+            "rollback"
+          ]
+        },
+        "revision": {
+          "description": "The number of the revision, increasing for each revision of the cluster.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"primaryKey;autoIncrement:false\""
+        },
+        "snapshot": {
+          "$ref": "#/definitions/cluster-revision-snapshot"
+        }
+      }
+    },
+    "cluster-revision-change": {
+      "type": "object",
+      "required": [
+        "path"
+      ],
+      "properties": {
+        "from": {
+          "description": "The value in the base configuration. Not set if the property is added."
+        },
+        "path": {
+          "description": "The changed property, e.g. cluster.api_vips, hosts.\u003chost_id\u003e.host_role or manifests.\u003cfolder\u003e/\u003cfile_name\u003e.",
+          "type": "string"
+        },
+        "to": {
+          "description": "The value in the revision. Not set if the property is removed."
+        }
+      }
+    },
+    "cluster-revision-diff": {
+      "type": "object",
+      "required": [
+        "revision",
+        "changes"
+      ],
+      "properties": {
+        "base_revision": {
+          "description": "The revision the changes are computed from. Not set when they are computed from the current configuration.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-revision-change"
+          }
+        },
+        "revision": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "cluster-revision-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "host_name": {
+          "type": "string"
+        },
+        "host_role": {
+          "type": "string",
+          "enum": [
+            "auto-assign",
+            "master",
+            "arbiter",
+            "worker"
+          ]
+        },
+        "installation_disk_id": {
+          "type": "string"
+        }
+      }
+    },
+    "cluster-revision-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-revision"
+      }
+    },
+    "cluster-revision-snapshot": {
+      "description": "The user-settable configuration of a cluster.",
+      "type": "object",
+      "properties": {
+        "cluster": {
+          "description": "The cluster properties, as set by V2UpdateCluster. The pull secret is not included.",
+          "$ref": "#/definitions/v2-cluster-update-params"
+        },
+        "hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-revision-host"
+          }
+        },
+        "ignored_cluster_validations": {
+          "description": "JSON-formatted list of the ignored cluster validation IDs.",
+          "type": "string"
+        },
+        "ignored_host_validations": {
+          "description": "JSON-formatted list of the ignored host validation IDs.",
+          "type": "string"
+        },
+        "manifests": {
+          "description": "The custom manifests of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/manifest"
+          }
+        },
+        "operators": {
+          "description": "The OLM operators that were not only installed as a dependency of another operator.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        }
+      },
+      "x-go-custom-tag": "gorm:\"-\""
+    },
+    "cluster-template": {
+      "type": "object",
+      "required": [
+        "id",
+        "name"
+      ],
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "description": {
+          "description": "Description of the template.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the template.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "name": {
+          "description": "Name of the template.",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "source": {
+          "description": "Whether the template is managed through the API, or by a ClusterTemplate resource of the kube-api. Templates managed by the kube-api cannot be modified through the API.",
+          "type": "string",
+          "enum": [
+            "api",
+            "kube-api"
+          ]
+        },
+        "spec": {
+          "$ref": "#/definitions/cluster-template-spec"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "user_name": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "cluster-template-create-params": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the template.",
+          "type": "string",
+          "minLength": 1
+        },
+        "spec": {
+          "$ref": "#/definitions/cluster-template-spec"
+        }
+      }
+    },
+    "cluster-template-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-template"
+      }
+    },
+    "cluster-template-parameter": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "default": {
+          "description": "The value of the parameter when it is not set on registration.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "description": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string",
+          "pattern": "^[a-zA-Z_][a-zA-Z0-9_]*$"
+        },
+        "required": {
+          "description": "Whether the parameter must be set on registration. Ignored if the parameter has a default.",
+          "type": "boolean",
+          "default": false
+        }
+      }
+    },
+    "cluster-template-spec": {
+      "description": "The configuration of the clusters registered from a template. String values may reference the parameters of the template as ${name}, and the name of the registered cluster as ${cluster_name}.",
+      "type": "object",
+      "properties": {
+        "cluster_params": {
+          "description": "Properties of cluster-create-params that are set on the registered clusters. The pull secret cannot be set in a template.",
+          "type": "object",
+          "additionalProperties": true
+        },
+        "infra_env_params": {
+          "description": "Properties of infra-env-create-params. If set, an infra-env with these properties is created for each registered cluster. The pull secret and the cluster cannot be set in a template.",
+          "type": "object",
+          "additionalProperties": true
+        },
+        "manifests": {
+          "description": "The custom manifests that are created for each registered cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/create-manifest-params"
+          }
+        },
+        "parameters": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-template-parameter"
+          }
+        }
+      },
+      "x-go-custom-tag": "gorm:\"-\""
+    },
+    "cluster-template-update-params": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "name": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": true
+        },
+        "spec": {
+          "description": "Replaces the configuration of the template.",
+          "$ref": "#/definitions/cluster-template-spec"
+        }
+      }
+    },
+    "cluster-validation-id": {
+      "type": "string",
+      "enum": [
+        "machine-cidr-defined",
+        "cluster-cidr-defined",
+        "service-cidr-defined",
+        "no-cidrs-overlapping",
+        "networks-same-address-families",
+        "network-prefix-valid",
+        "machine-cidr-equals-to-calculated-cidr",
+        "api-vips-defined",
+        "api-vips-valid",
+        "ingress-vips-defined",
+        "ingress-vips-valid",
+        "all-hosts-are-ready-to-install",
+        "sufficient-masters-count",
+        "dns-domain-defined",
+        "pull-secret-set",
+        "ntp-server-configured",
+        "lso-requirements-satisfied",
+        "ocs-requirements-satisfied",
+        "odf-requirements-satisfied",
+        "cnv-requirements-satisfied",
+        "lvm-requirements-satisfied",
+        "mce-requirements-satisfied",
+        "mtv-requirements-satisfied",
+        "osc-requirements-satisfied",
+        "network-type-valid",
+        "platform-requirements-satisfied",
+        "node-feature-discovery-requirements-satisfied",
+        "nvidia-gpu-requirements-satisfied",
+        "pipelines-requirements-satisfied",
+        "servicemesh-requirements-satisfied",
+        "serverless-requirements-satisfied",
+        "openshift-ai-requirements-satisfied",
+        "openshift-ai-gpu-requirements-satisfied",
+        "authorino-requirements-satisfied",
+        "nmstate-requirements-satisfied",
+        "amd-gpu-requirements-satisfied",
+        "kmm-requirements-satisfied",
+        "node-healthcheck-requirements-satisfied",
+        "self-node-remediation-requirements-satisfied",
+        "fence-agents-remediation-requirements-satisfied",
+        "node-maintenance-requirements-satisfied",
+        "kube-descheduler-requirements-satisfied",
+        "cluster-observability-requirements-satisfied",
+        "numa-resources-requirements-satisfied",
+        "oadp-requirements-satisfied",
+        "metallb-requirements-satisfied",
+        "loki-requirements-satisfied",
+        "openshift-logging-requirements-satisfied"
+      ]
+    },
+    "cluster_default_config": {
+      "type": "object",
+      "properties": {
+        "cluster_network_cidr": {
+          "type": "string",
+          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+        },
+        "cluster_network_host_prefix": {
+          "type": "integer",
+          "maximum": 32,
+          "minimum": 1
+        },
+        "cluster_networks_dualstack": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          }
+        },
+        "cluster_networks_ipv4": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          }
+        },
+        "forbidden_hostnames": {
+          "description": "This provides a list of forbidden hostnames. If this list is empty or not present, this implies that the UI should fall back to a hard coded list.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "inactive_deletion_hours": {
+          "type": "integer"
+        },
+        "ntp_source": {
+          "type": "string",
+          "x-omitempty": false
+        },
+        "service_network_cidr": {
+          "type": "string",
+          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+        },
+        "service_networks_dualstack": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          }
+        },
+        "service_networks_ipv4": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          }
+        }
+      }
+    },
+    "cluster_network": {
+      "description": "A network from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "description": "The IP block address pool.",
+          "$ref": "#/definitions/subnet"
+        },
+        "cluster_id": {
+          "description": "The cluster that this network is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example if is set to 23, then each node is assigned a /23 subnet out of the given CIDR, which allows for 510 (2^(32 - 23) - 2) pod IPs addresses.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        }
+      }
+    },
+    "completion-params": {
+      "type": "object",
+      "required": [
+        "is_success"
+      ],
+      "properties": {
+        "data": {
+          "description": "additional data from the cluster",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
+          }
+        },
+        "error_info": {
+          "type": "string"
+        },
+        "is_success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "connectivity-check-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "nics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-check-nic"
+          }
+        }
+      }
+    },
+    "connectivity-check-nic": {
+      "type": "object",
+      "properties": {
+        "ip_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          }
+        },
+        "mac": {
+          "type": "string",
+          "format": "mac"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "connectivity-check-params": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/connectivity-check-host"
+      }
+    },
+    "connectivity-remote-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "l2_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l2-connectivity"
+          }
+        },
+        "l3_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l3-connectivity"
+          }
+        },
+        "mtu_report": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/mtu-report"
+          }
+        }
+      }
+    },
+    "connectivity-report": {
+      "type": "object",
+      "properties": {
+        "remote_hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-remote-host"
+          }
+        }
+      }
+    },
+    "container_image_availability": {
+      "type": "object",
+      "properties": {
+        "download_rate": {
+          "description": "The rate of size/time in seconds MBps.",
+          "type": "number"
+        },
+        "name": {
+          "description": "A fully qualified image name (FQIN).",
+          "type": "string"
+        },
+        "result": {
+          "$ref": "#/definitions/container_image_availability_result"
+        },
+        "size_bytes": {
+          "description": "Size of the image in bytes.",
+          "type": "number"
+        },
+        "time": {
+          "description": "Seconds it took to pull the image.",
+          "type": "number"
+        }
+      }
+    },
+    "container_image_availability_request": {
+      "type": "object",
+      "required": [
+        "images"
+      ],
+      "properties": {
+        "images": {
+          "description": "List of image names to be checked.",
+          "type": "array",
+          "items": {
+            "description": "A fully qualified image name (FQIN).",
+            "type": "string",
+            "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+          }
+        },
+        "timeout": {
+          "description": "Positive number represents a timeout in seconds for a pull operation.",
+          "type": "integer"
+        }
+      }
+    },
+    "container_image_availability_response": {
+      "type": "object",
+      "required": [
+        "images"
+      ],
+      "properties": {
+        "images": {
+          "description": "List of images that were checked.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/container_image_availability"
+          }
+        }
+      }
+    },
+    "container_image_availability_result": {
+      "description": "Image availability result.",
+      "type": "string",
+      "enum": [
+        "success",
+        "failure"
+      ]
+    },
+    "cpu": {
+      "type": "object",
+      "properties": {
+        "architecture": {
+          "type": "string"
+        },
+        "count": {
+          "type": "integer"
+        },
+        "flags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "frequency": {
+          "type": "number"
+        },
+        "model_name": {
+          "type": "string"
+        }
+      }
+    },
+    "create-manifest-params": {
+      "type": "object",
+      "required": [
+        "file_name",
+        "content"
+      ],
+      "properties": {
+        "content": {
+          "description": "base64 encoded manifest content.",
+          "type": "string"
+        },
+        "file_name": {
+          "description": "The name of the manifest to customize the installed OCP cluster.",
+          "type": "string",
+          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$"
+        },
+        "folder": {
+          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+          "type": "string",
+          "default": "manifests",
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
+        }
+      }
+    },
+    "credentials": {
+      "type": "object",
+      "properties": {
+        "console_url": {
+          "type": "string"
+        },
+        "password": {
+          "type": "string"
+        },
+        "username": {
+          "type": "string"
+        }
+      }
+    },
+    "custom_step_request": {
+      "description": "Request to run a custom step, which is a container that is defined by the administrator of the service.",
+      "type": "object",
+      "required": [
+        "name",
+        "image"
+      ],
+      "properties": {
+        "args": {
+          "description": "The arguments of the container.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "image": {
+          "description": "The container image to run.",
+          "type": "string"
+        },
+        "name": {
+          "description": "The name of the custom step.",
+          "type": "string"
+        },
+        "timeout": {
+          "description": "The number of seconds after which the container is stopped.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "custom_step_result": {
+      "description": "The result of the last run of a custom step on a host. The output of the container is expected to be a JSON document.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "The error of the container, or the reason why its output doesn't match the reply schema of the step.",
+          "type": "string"
+        },
+        "exit_code": {
+          "description": "The exit code of the container.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "description": "The name of the custom step.",
+          "type": "string"
+        },
+        "output": {
+          "description": "The output of the container.",
+          "type": "string"
+        },
+        "succeeded": {
+          "description": "Whether the container succeeded and its output matches the reply schema of the step.",
+          "type": "boolean"
+        }
+      }
+    },
+    "dhcp_allocation_request": {
+      "type": "object",
+      "required": [
+        "interface",
+        "api_vip_mac",
+        "ingress_vip_mac"
+      ],
+      "properties": {
+        "api_vip_lease": {
+          "description": "Contents of lease file to be used for API virtual IP.",
+          "type": "string"
+        },
+        "api_vip_mac": {
+          "description": "MAC address for the API virtual IP.",
+          "type": "string",
+          "format": "mac"
+        },
+        "ingress_vip_lease": {
+          "description": "Contents of lease file to be used for for Ingress virtual IP.",
+          "type": "string"
+        },
+        "ingress_vip_mac": {
+          "description": "MAC address for the Ingress virtual IP.",
+          "type": "string",
+          "format": "mac"
+        },
+        "interface": {
+          "description": "The network interface (NIC) to run the DHCP requests on.",
+          "type": "string"
+        }
+      }
+    },
+    "dhcp_allocation_response": {
+      "type": "object",
+      "required": [
+        "api_vip_address",
+        "ingress_vip_address"
+      ],
+      "properties": {
+        "api_vip_address": {
+          "description": "The IPv4 address that was allocated by DHCP for the API virtual IP.",
+          "type": "string",
+          "format": "ipv4"
+        },
+        "api_vip_lease": {
+          "description": "Contents of last acquired lease for API virtual IP.",
+          "type": "string"
+        },
+        "ingress_vip_address": {
+          "description": "The IPv4 address that was allocated by DHCP for the Ingress virtual IP.",
+          "type": "string",
+          "format": "ipv4"
+        },
+        "ingress_vip_lease": {
+          "description": "Contents of last acquired lease for Ingress virtual IP.",
+          "type": "string"
+        }
+      }
+    },
+    "disconnected-cluster-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "openshift_version"
+      ],
+      "properties": {
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        }
+      }
+    },
+    "disk": {
+      "type": "object",
+      "properties": {
+        "bootable": {
+          "type": "boolean"
+        },
+        "by_id": {
+          "description": "by-id is the World Wide Number of the device which guaranteed to be unique for every storage device",
+          "type": "string"
+        },
+        "by_path": {
+          "description": "by-path is the shortest physical path to the device",
+          "type": "string"
+        },
+        "drive_type": {
+          "$ref": "#/definitions/drive_type"
+        },
+        "has_uuid": {
+          "type": "boolean"
+        },
+        "hctl": {
+          "type": "string"
+        },
+        "health": {
+          "$ref": "#/definitions/disk_health"
+        },
+        "holders": {
+          "description": "A comma-separated list of disk names that this disk belongs to",
+          "type": "string"
+        },
+        "id": {
+          "description": "Determine the disk's unique identifier which is the by-id field if it exists and fallback to the by-path field otherwise",
+          "type": "string"
+        },
+        "installation_eligibility": {
+          "type": "object",
+          "properties": {
+            "eligible": {
+              "description": "Whether the disk is eligible for installation or not.",
+              "type": "boolean"
+            },
+            "not_eligible_reasons": {
+              "description": "Reasons for why this disk is not eligible for installation.",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "x-nullable": false
+        },
+        "io_perf": {
+          "$ref": "#/definitions/io_perf"
+        },
+        "is_installation_media": {
+          "description": "Whether the disk appears to be an installation media or not",
+          "type": "boolean"
+        },
+        "iscsi": {
+          "$ref": "#/definitions/iscsi"
+        },
+        "model": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "partitionTypes": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "removable": {
+          "type": "boolean"
+        },
+        "serial": {
+          "type": "string"
+        },
+        "size_bytes": {
+          "type": "integer"
+        },
+        "smart": {
+          "type": "string"
+        },
+        "vendor": {
+          "type": "string"
+        },
+        "wwn": {
+          "type": "string"
+        }
+      }
+    },
+    "disk-config-params": {
+      "type": "object",
+      "required": [
+        "id"
+      ],
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/disk-role"
+        }
+      }
+    },
+    "disk-encryption": {
+      "type": "object",
+      "properties": {
+        "enable_on": {
+          "description": "Enable/disable disk encryption on master nodes, arbiter nodes, worker nodes, or a combination of them.",
+          "type": "string",
+          "default": "none",
+          "enum": [
+            "none",
+            "masters",
+            "arbiters",
+            "workers",
+            "masters,arbiters",
+            "masters,workers",
+            "arbiters,workers",
+            "masters,arbiters,workers",
+            "all"
+          ]
+        },
+        "mode": {
+          "description": "The disk encryption mode to use.",
+          "type": "string",
+          "default": "tpmv2",
+          "enum": [
+            "tpmv2",
+            "tang"
+          ]
+        },
+        "tang_servers": {
+          "description": "JSON-formatted string containing additional information regarding tang's configuration",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "[{\"url\":\"http://tang.example.com:7500\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu9\"}, {\"url\":\"http://tang.example.com:7501\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu8\"}]"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:disk_encryption_\""
+    },
+    "disk-role": {
+      "type": "string",
+      "enum": [
+        "none",
+        "install"
+      ]
+    },
+    "disk-skip-formatting-params": {
+      "description": "Allows an addition or removal of a host disk from the host's skip_formatting_disks list",
+      "type": "object",
+      "required": [
+        "disk_id",
+        "skip_formatting"
+      ],
+      "properties": {
+        "disk_id": {
+          "description": "The ID of the disk that is being added to or removed from the host's skip_formatting_disks list",
+          "type": "string"
+        },
+        "skip_formatting": {
+          "description": "True if you wish to add the disk to the skip_formatting_disks list, false if you wish to remove it",
+          "type": "boolean"
+        }
+      }
+    },
+    "disk_health": {
+      "description": "The health of the disk, as reported by its SMART data. Properties that the disk doesn't report are omitted.",
+      "type": "object",
+      "properties": {
+        "media_errors": {
+          "description": "The number of unrecovered data integrity errors of the disk.",
+          "type": "integer",
+          "x-nullable": true
+        },
+        "reallocated_sectors": {
+          "description": "The number of sectors that were remapped to spare sectors because of errors.",
+          "type": "integer",
+          "x-nullable": true
+        },
+        "smart_passed": {
+          "description": "Whether the disk passed its overall SMART self-assessment.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "temperature_celsius": {
+          "description": "The current temperature of the disk in degrees Celsius.",
+          "type": "integer",
+          "x-nullable": true
+        },
+        "wear_level_percentage": {
+          "description": "The percentage of the rated endurance of the disk that was used. It can exceed 100.",
+          "type": "integer",
+          "x-nullable": true
+        }
+      }
+    },
+    "disk_info": {
+      "type": "object",
+      "properties": {
+        "disk_speed": {
+          "$ref": "#/definitions/disk_speed"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "path": {
+          "type": "string"
+        }
+      }
+    },
+    "disk_speed": {
+      "type": "object",
+      "properties": {
+        "exit_code": {
+          "type": "integer"
+        },
+        "speed_ms": {
+          "type": "integer"
+        },
+        "tested": {
+          "type": "boolean"
+        }
+      }
+    },
+    "disk_speed_check_request": {
+      "type": "object",
+      "required": [
+        "path"
+      ],
+      "properties": {
+        "path": {
+          "description": "--filename argument for fio (expects a file or a block device path).",
+          "type": "string"
+        }
+      }
+    },
+    "disk_speed_check_response": {
+      "type": "object",
+      "properties": {
+        "io_sync_duration": {
+          "description": "The 99th percentile of fdatasync durations in milliseconds.",
+          "type": "integer"
+        },
+        "path": {
+          "description": "The device path.",
+          "type": "string"
+        }
+      }
+    },
+    "domain_resolution_request": {
+      "type": "object",
+      "required": [
+        "domains"
+      ],
+      "properties": {
+        "domains": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "x-go-type": {
+              "import": {
+                "path": "github.com/openshift/assisted-service/models"
+              },
+              "type": "DomainResolutionRequestDomain"
+            }
+          }
+        }
+      }
+    },
+    "domain_resolution_response": {
+      "type": "object",
+      "required": [
+        "resolutions"
+      ],
+      "properties": {
+        "resolutions": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "required": [
+              "domain_name"
+            ],
+            "properties": {
+              "cnames": {
+                "description": "The cnames that were resolved for the domain, empty if none",
+                "type": "array",
+                "items": {
+                  "type": "string"
+                }
+              },
+              "domain_name": {
+                "description": "The domain that was resolved",
+                "type": "string"
+              },
+              "ipv4_addresses": {
+                "description": "The IPv4 addresses of the domain, empty if none",
+                "type": "array",
+                "items": {
+                  "type": "string",
+                  "format": "ipv4"
+                }
+              },
+              "ipv6_addresses": {
+                "description": "The IPv6 addresses of the domain, empty if none",
+                "type": "array",
+                "items": {
+                  "type": "string",
+                  "format": "ipv6"
+                }
+              }
+            },
+            "x-go-name": "DomainResolutionResponseDomain"
+          }
+        }
+      }
+    },
+    "download_boot_artifacts_request": {
+      "description": "Information sent to the agent for downloading artifacts to boot a host into discovery.",
+      "type": "object",
+      "required": [
+        "kernel_url",
+        "rootfs_url",
+        "initrd_url",
+        "host_fs_mount_dir"
+      ],
+      "properties": {
+        "host_fs_mount_dir": {
+          "description": "The base directory on the host that contains the /boot folder. The host will download boot\nartifacts into a folder in this directory.",
+          "type": "string"
+        },
+        "initrd_url": {
+          "description": "URL address to download the initrd.",
+          "type": "string"
+        },
+        "kernel_url": {
+          "description": "URL address to download the kernel.",
+          "type": "string"
+        },
+        "rootfs_url": {
+          "description": "URL address to download the rootfs.",
+          "type": "string"
+        }
+      }
+    },
+    "drive_type": {
+      "type": "string",
+      "enum": [
+        "Unknown",
+        "HDD",
+        "FDD",
+        "ODD",
+        "SSD",
+        "virtual",
+        "Multipath",
+        "iSCSI",
+        "FC",
+        "LVM",
+        "RAID",
+        "ECKD",
+        "ECKD (ESE)",
+        "FBA"
+      ]
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "code",
+        "reason"
+      ],
+      "properties": {
+        "code": {
+          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
+          "type": "string"
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 504,
+          "minimum": 400
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will always be 'Error'.",
+          "type": "string",
+          "enum": [
+            "Error"
+          ]
+        },
+        "reason": {
+          "description": "Human-readable description of the error.",
+          "type": "string"
+        }
+      }
+    },
+    "event": {
+      "type": "object",
+      "required": [
+        "severity",
+        "message",
+        "event_time"
+      ],
+      "properties": {
+        "category": {
+          "type": "string",
+          "enum": [
+            "user",
+            "metrics"
+          ],
+          "x-go-custom-tag": "gorm:\"default:'user'\""
+        },
+        "cluster_id": {
+          "description": "Unique identifier of the cluster this event relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "event_time": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "host_id": {
+          "description": "Unique identifier of the host this event relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "infra_env_id": {
+          "description": "Unique identifier of the infra-env this event relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "message": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
+        },
+        "name": {
+          "description": "Event Name.",
+          "type": "string"
+        },
+        "props": {
+          "description": "Additional properties for the event in JSON format.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "request_id": {
+          "description": "Unique identifier of the request that caused this event to occur.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "info",
+            "warning",
+            "error",
+            "critical"
+          ]
+        }
+      }
+    },
+    "event-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/event"
+      }
+    },
+    "feature": {
+      "type": "object",
+      "required": [
+        "feature-support-level-id",
+        "support_level",
+        "incompatibilities"
+      ],
+      "properties": {
+        "feature-support-level-id": {
+          "$ref": "#/definitions/feature-support-level-id"
+        },
+        "incompatibilities": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/feature-support-level-id"
+          }
         },
-        "installation_disk_speed_threshold_ms": {
-          "description": "Required installation disk speed in ms",
-          "type": "integer"
+        "reason": {
+          "$ref": "#/definitions/incompatibility-reason"
         },
-        "network_latency_threshold_ms": {
-          "description": "Maximum network average latency (RTT) at L3 for role.",
-          "type": "number",
-          "format": "double",
-          "x-nullable": true
+        "support_level": {
+          "$ref": "#/definitions/support-level"
+        }
+      }
+    },
+    "feature-support-level-id": {
+      "type": "string",
+      "enum": [
+        "SNO",
+        "TNA",
+        "TNF",
+        "VIP_AUTO_ALLOC",
+        "CUSTOM_MANIFEST",
+        "SINGLE_NODE_EXPANSION",
+        "LVM",
+        "ODF",
+        "LSO",
+        "CNV",
+        "MCE",
+        "MTV",
+        "OSC",
+        "NUTANIX_INTEGRATION",
+        "BAREMETAL_PLATFORM",
+        "NONE_PLATFORM",
+        "VSPHERE_INTEGRATION",
+        "DUAL_STACK_VIPS",
+        "CLUSTER_MANAGED_NETWORKING",
+        "USER_MANAGED_NETWORKING",
+        "MINIMAL_ISO",
+        "FULL_ISO",
+        "EXTERNAL_PLATFORM_OCI",
+        "DUAL_STACK",
+        "PLATFORM_MANAGED_NETWORKING",
+        "EXTERNAL_PLATFORM",
+        "OVN_NETWORK_TYPE",
+        "SDN_NETWORK_TYPE",
+        "NODE_FEATURE_DISCOVERY",
+        "NVIDIA_GPU",
+        "PIPELINES",
+        "SERVICEMESH",
+        "SERVERLESS",
+        "OPENSHIFT_AI",
+        "NON_STANDARD_HA_CONTROL_PLANE",
+        "AUTHORINO",
+        "USER_MANAGED_LOAD_BALANCER",
+        "NMSTATE",
+        "AMD_GPU",
+        "KMM",
+        "NODE_HEALTHCHECK",
+        "SELF_NODE_REMEDIATION",
+        "FENCE_AGENTS_REMEDIATION",
+        "NODE_MAINTENANCE",
+        "KUBE_DESCHEDULER",
+        "CLUSTER_OBSERVABILITY",
+        "NUMA_RESOURCES",
+        "OADP",
+        "METALLB",
+        "DUAL_STACK_PRIMARY_IPV6",
+        "LOKI",
+        "OPENSHIFT_LOGGING"
+      ],
+      "x-nullable": false
+    },
+    "fencing-credentials-params": {
+      "type": "object",
+      "required": [
+        "address",
+        "username",
+        "password"
+      ],
+      "properties": {
+        "address": {
+          "description": "The URL of the host's BMC, for example https://bmc1.example.com.",
+          "type": "string"
         },
-        "packet_loss_percentage": {
-          "description": "Maximum packet loss allowed at L3 for role.",
-          "type": "number",
-          "format": "double",
-          "x-nullable": true
+        "certificate_verification": {
+          "description": "Whether to enable or disable certificate verification when connecting to the host's BMC.",
+          "type": "string",
+          "default": "Enabled",
+          "enum": [
+            "Enabled",
+            "Disabled"
+          ]
         },
-        "ram_mib": {
-          "description": "Required number of RAM in MiB",
-          "type": "integer"
+        "password": {
+          "description": "The password to connect to the host's BMC.",
+          "type": "string"
         },
-        "tpm_enabled_in_bios": {
-          "description": "Whether TPM module should be enabled in host's BIOS.",
+        "username": {
+          "description": "The username to connect to the host's BMC.",
+          "type": "string"
+        }
+      }
+    },
+    "finalizing-stage": {
+      "description": "Cluster finalizing stage managed by controller",
+      "type": "string",
+      "enum": [
+        "Waiting for cluster operators",
+        "Adding router ca",
+        "Applying olm manifests",
+        "Waiting for olm operators csv initialization",
+        "Waiting for olm operators csv",
+        "Waiting for OLM operator setup jobs",
+        "Done"
+      ]
+    },
+    "firmware-policy": {
+      "description": "Firmware settings that the hosts must comply with.",
+      "type": "object",
+      "properties": {
+        "boot_mode": {
+          "description": "The boot mode that the hosts must use. Hosts may use any boot mode if not set.",
+          "type": "string",
+          "enum": [
+            "uefi",
+            "bios"
+          ]
+        },
+        "min_bios_versions": {
+          "description": "The minimum BIOS versions of the hosts, per manufacturer.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/firmware-policy-bios-version"
+          }
+        },
+        "secure_boot_required": {
+          "description": "Whether the hosts must boot with Secure Boot enabled.",
+          "type": "boolean"
+        },
+        "virtualization_required": {
+          "description": "Whether the hosts must have the virtualization extensions of their CPU enabled.",
           "type": "boolean"
         }
       }
     },
-    "cluster-host-requirements-list": {
+    "firmware-policy-bios-version": {
+      "type": "object",
+      "required": [
+        "manufacturer",
+        "version"
+      ],
+      "properties": {
+        "manufacturer": {
+          "description": "The manufacturer of the hosts, as reported in the system vendor of their inventory. Compared case-insensitively.",
+          "type": "string"
+        },
+        "version": {
+          "description": "The minimum BIOS version of the hosts of the manufacturer. Versions are compared by their numeric components.",
+          "type": "string"
+        }
+      }
+    },
+    "free-addresses-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/cluster-host-requirements"
+        "type": "string",
+        "format": "ipv4"
       }
     },
-    "cluster-list": {
+    "free_addresses_request": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/cluster"
+        "type": "string",
+        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
       }
     },
-    "cluster-progress-info": {
+    "free_network_addresses": {
       "type": "object",
       "properties": {
-        "finalizing_stage": {
-          "$ref": "#/definitions/finalizing-stage"
-        },
-        "finalizing_stage_percentage": {
-          "type": "integer"
+        "free_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "ipv4"
+          }
         },
-        "finalizing_stage_started_at": {
+        "network": {
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "finalizing_stage_timed_out": {
-          "type": "boolean"
-        },
-        "installing_stage_percentage": {
-          "type": "integer"
-        },
-        "preparing_for_installation_stage_percentage": {
-          "type": "integer"
-        },
-        "total_percentage": {
-          "type": "integer"
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
+      }
     },
-    "cluster-validation-id": {
-      "type": "string",
-      "enum": [
-        "machine-cidr-defined",
-        "cluster-cidr-defined",
-        "service-cidr-defined",
-        "no-cidrs-overlapping",
-        "networks-same-address-families",
-        "network-prefix-valid",
-        "machine-cidr-equals-to-calculated-cidr",
-        "api-vips-defined",
-        "api-vips-valid",
-        "ingress-vips-defined",
-        "ingress-vips-valid",
-        "all-hosts-are-ready-to-install",
-        "sufficient-masters-count",
-        "dns-domain-defined",
-        "pull-secret-set",
-        "ntp-server-configured",
-        "lso-requirements-satisfied",
-        "ocs-requirements-satisfied",
-        "odf-requirements-satisfied",
-        "cnv-requirements-satisfied",
-        "lvm-requirements-satisfied",
-        "mce-requirements-satisfied",
-        "mtv-requirements-satisfied",
-        "osc-requirements-satisfied",
-        "network-type-valid",
-        "platform-requirements-satisfied",
-        "node-feature-discovery-requirements-satisfied",
-        "nvidia-gpu-requirements-satisfied",
-        "pipelines-requirements-satisfied",
-        "servicemesh-requirements-satisfied",
-        "serverless-requirements-satisfied",
-        "openshift-ai-requirements-satisfied",
-        "openshift-ai-gpu-requirements-satisfied",
-        "authorino-requirements-satisfied",
-        "nmstate-requirements-satisfied",
-        "amd-gpu-requirements-satisfied",
-        "kmm-requirements-satisfied",
-        "node-healthcheck-requirements-satisfied",
-        "self-node-remediation-requirements-satisfied",
-        "fence-agents-remediation-requirements-satisfied",
-        "node-maintenance-requirements-satisfied",
-        "kube-descheduler-requirements-satisfied",
-        "cluster-observability-requirements-satisfied",
-        "numa-resources-requirements-satisfied",
-        "oadp-requirements-satisfied",
-        "metallb-requirements-satisfied",
-        "loki-requirements-satisfied",
-        "openshift-logging-requirements-satisfied"
-      ]
+    "free_networks_addresses": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/free_network_addresses"
+      }
+    },
+    "gpu": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "description": "Device address (for example \"0000:00:02.0\")",
+          "type": "string"
+        },
+        "device_id": {
+          "description": "ID of the device (for example \"3ea0\")",
+          "type": "string"
+        },
+        "name": {
+          "description": "Product name of the device (for example \"UHD Graphics 620 (Whiskey Lake)\")",
+          "type": "string"
+        },
+        "vendor": {
+          "description": "The name of the device vendor (for example \"Intel Corporation\")",
+          "type": "string"
+        },
+        "vendor_id": {
+          "description": "ID of the vendor (for example \"8086\")",
+          "type": "string"
+        }
+      }
     },
-    "cluster_default_config": {
+    "hardware-fingerprint": {
+      "description": "The normalized hardware of a host, without the properties that change between boots.",
       "type": "object",
       "properties": {
-        "cluster_network_cidr": {
-          "type": "string",
-          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+        "cpu_count": {
+          "type": "integer"
         },
-        "cluster_network_host_prefix": {
-          "type": "integer",
-          "maximum": 32,
-          "minimum": 1
+        "cpu_model": {
+          "type": "string"
         },
-        "cluster_networks_dualstack": {
+        "disks": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/cluster_network"
+            "$ref": "#/definitions/hardware-fingerprint-disk"
           }
         },
-        "cluster_networks_ipv4": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/cluster_network"
-          }
+        "memory_bytes": {
+          "description": "The physical memory of the host.",
+          "type": "integer"
         },
-        "forbidden_hostnames": {
-          "description": "This provides a list of forbidden hostnames. If this list is empty or not present, this implies that the UI should fall back to a hard coded list.",
+        "nics": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/hardware-fingerprint-nic"
           }
+        }
+      },
+      "x-go-custom-tag": "gorm:\"-\""
+    },
+    "hardware-fingerprint-change": {
+      "type": "object",
+      "required": [
+        "component",
+        "type"
+      ],
+      "properties": {
+        "component": {
+          "type": "string",
+          "enum": [
+            "cpu",
+            "memory",
+            "disk",
+            "nic"
+          ]
         },
-        "inactive_deletion_hours": {
-          "type": "integer"
+        "from": {
+          "description": "The description of the component before the change.",
+          "type": "string"
         },
-        "ntp_source": {
-          "type": "string",
-          "x-omitempty": false
+        "id": {
+          "description": "The ID of the changed disk or the MAC address of the changed NIC.",
+          "type": "string"
         },
-        "service_network_cidr": {
+        "to": {
+          "description": "The description of the component after the change.",
+          "type": "string"
+        },
+        "type": {
           "type": "string",
-          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+          "enum": [
+            "added",
+            "removed",
+            "changed"
+          ]
+        }
+      }
+    },
+    "hardware-fingerprint-disk": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "description": "The WWN of the disk, or its serial number or path if it has no WWN.",
+          "type": "string"
         },
-        "service_networks_dualstack": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/service_network"
-          }
+        "model": {
+          "type": "string"
         },
-        "service_networks_ipv4": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/service_network"
-          }
+        "size_bytes": {
+          "type": "integer"
         }
       }
     },
-    "cluster_network": {
-      "description": "A network from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+    "hardware-fingerprint-nic": {
       "type": "object",
       "properties": {
-        "cidr": {
-          "description": "The IP block address pool.",
-          "$ref": "#/definitions/subnet"
-        },
-        "cluster_id": {
-          "description": "The cluster that this network is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
+        "mac_address": {
+          "type": "string"
         },
-        "host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example if is set to 23, then each node is assigned a /23 subnet out of the given CIDR, which allows for 510 (2^(32 - 23) - 2) pod IPs addresses.",
-          "type": "integer",
-          "maximum": 128,
-          "minimum": 1
+        "product": {
+          "type": "string"
         }
       }
     },
-    "completion-params": {
+    "host": {
       "type": "object",
       "required": [
-        "is_success"
+        "kind",
+        "id",
+        "href",
+        "status",
+        "status_info"
       ],
       "properties": {
-        "data": {
-          "description": "additional data from the cluster",
-          "type": "object",
-          "additionalProperties": {
-            "type": "object"
+        "api_vip_connectivity": {
+          "description": "Contains a serialized api_vip_connectivity_response",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "bandwidth": {
+          "description": "JSON-formatted results of the bandwidth checks of the host to other hosts of the cluster, see bandwidth_check_response.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "bootstrap": {
+          "type": "boolean"
+        },
+        "checked_in_at": {
+          "description": "The last time the host's agent communicated with the service.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "cluster_id": {
+          "description": "The cluster that this host is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"foreignkey:Cluster\"",
+          "x-nullable": true
+        },
+        "connection_timed_out": {
+          "description": "Indicate that connection to assisted service was timed out when soft timeout is enabled.",
+          "type": "boolean"
+        },
+        "connectivity": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
           }
         },
-        "error_info": {
+        "custom_step_results": {
+          "description": "JSON-formatted list of the results of the custom steps that are defined by the administrator of the service, see custom_step_result.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "deleted_at": {
+          "description": "swagger:ignore",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "gorm.io/gorm"
+            },
+            "type": "DeletedAt"
+          },
+          "x-nullable": false
+        },
+        "discovery_agent_version": {
           "type": "string"
         },
-        "is_success": {
+        "disks_info": {
+          "description": "Additional information about disks, formatted as JSON.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "disks_to_be_formatted": {
+          "description": "A comma-separated list of disks that will be formatted once\ninstallation begins, unless otherwise set to be skipped by\nskip_formatting_disks. This means that this list also includes disks\nthat appear in skip_formatting_disks. This property is managed by the\nservice and cannot be modified by the user.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "domain_name_resolutions": {
+          "description": "The domain name resolution result.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "fencing_credentials": {
+          "description": "The host's BMC credentials that will be used in TNF.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "free_addresses": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "hardware_drift_detected_at": {
+          "description": "The time the hardware of the host changed after its validations passed. Cleared when the current hardware of the host is accepted.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "hardware_fingerprint_hash": {
+          "description": "The hash of the hardware fingerprint of the latest inventory of the host.",
+          "type": "string"
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "ignition_config_overrides": {
+          "description": "Json formatted string containing the user overrides for the host's pointer ignition",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
+        },
+        "ignition_endpoint_token_set": {
+          "description": "True if the token to fetch the ignition from ignition_endpoint_url is set.",
           "type": "boolean"
-        }
-      }
-    },
-    "connectivity-check-host": {
-      "type": "object",
-      "properties": {
-        "host_id": {
+        },
+        "images_status": {
+          "description": "Array of image statuses.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "infra_env_id": {
+          "description": "The infra-env that this host is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey;foreignkey:InfraEnvID\""
+        },
+        "installation_disk_id": {
+          "description": "Contains the inventory disk id to install on.",
+          "type": "string"
+        },
+        "installation_disk_path": {
+          "description": "Contains the inventory disk path, This field is replaced by installation_disk_id field and used for backward compatability with the old UI.",
           "type": "string",
-          "format": "uuid"
+          "example": "/dev/sda"
         },
-        "nics": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/connectivity-check-nic"
-          }
-        }
-      }
-    },
-    "connectivity-check-nic": {
-      "type": "object",
-      "properties": {
-        "ip_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string",
-            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
-          }
+        "installer_args": {
+          "type": "string"
         },
-        "mac": {
+        "installer_version": {
+          "description": "Installer version.",
+          "type": "string"
+        },
+        "inventory": {
           "type": "string",
-          "format": "mac"
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "name": {
-          "type": "string"
-        }
-      }
-    },
-    "connectivity-check-params": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/connectivity-check-host"
-      }
-    },
-    "connectivity-remote-host": {
-      "type": "object",
-      "properties": {
-        "host_id": {
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
           "type": "string",
-          "format": "uuid"
+          "enum": [
+            "Host",
+            "AddToExistingClusterHost"
+          ]
         },
-        "l2_connectivity": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/l2-connectivity"
-          }
+        "logs_collected_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "l3_connectivity": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/l3-connectivity"
-          }
+        "logs_info": {
+          "description": "The progress of log collection or empty if logs are not applicable",
+          "$ref": "#/definitions/logs_state"
         },
-        "mtu_report": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/mtu-report"
-          }
-        }
-      }
-    },
-    "connectivity-report": {
-      "type": "object",
-      "properties": {
-        "remote_hosts": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/connectivity-remote-host"
-          }
-        }
-      }
-    },
-    "container_image_availability": {
-      "type": "object",
-      "properties": {
-        "download_rate": {
-          "description": "The rate of size/time in seconds MBps.",
-          "type": "number"
+        "logs_started_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "name": {
-          "description": "A fully qualified image name (FQIN).",
+        "machine_config_pool_name": {
           "type": "string"
         },
-        "result": {
-          "$ref": "#/definitions/container_image_availability_result"
+        "media_status": {
+          "type": "string",
+          "default": "connected",
+          "enum": [
+            "connected",
+            "disconnected"
+          ],
+          "x-nullable": true
         },
-        "size_bytes": {
-          "description": "Size of the image in bytes.",
-          "type": "number"
+        "node_labels": {
+          "description": "Json containing node's labels.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "time": {
-          "description": "Seconds it took to pull the image.",
-          "type": "number"
-        }
-      }
-    },
-    "container_image_availability_request": {
-      "type": "object",
-      "required": [
-        "images"
-      ],
-      "properties": {
-        "images": {
-          "description": "List of image names to be checked.",
-          "type": "array",
-          "items": {
-            "description": "A fully qualified image name (FQIN).",
-            "type": "string",
-            "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
-          }
+        "ntp_sources": {
+          "description": "The configured NTP sources on the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "timeout": {
-          "description": "Positive number represents a timeout in seconds for a pull operation.",
-          "type": "integer"
-        }
-      }
-    },
-    "container_image_availability_response": {
-      "type": "object",
-      "required": [
-        "images"
-      ],
-      "properties": {
-        "images": {
-          "description": "List of images that were checked.",
+        "progress": {
+          "$ref": "#/definitions/host-progress-info"
+        },
+        "progress_stages": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/container_image_availability"
-          }
-        }
-      }
-    },
-    "container_image_availability_result": {
-      "description": "Image availability result.",
-      "type": "string",
-      "enum": [
-        "success",
-        "failure"
-      ]
-    },
-    "cpu": {
-      "type": "object",
-      "properties": {
-        "architecture": {
+            "$ref": "#/definitions/host-stage"
+          },
+          "x-go-custom-tag": "gorm:\"-\"",
+          "x-nullable": true
+        },
+        "registered_at": {
+          "description": "The last time the host's agent tried to register in the service.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "requested_hostname": {
           "type": "string"
         },
-        "count": {
-          "type": "integer"
+        "role": {
+          "$ref": "#/definitions/host-role"
         },
-        "flags": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "skip_formatting_disks": {
+          "description": "A comma-seperated list of host disks that the service will avoid\nformatting.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "frequency": {
-          "type": "number"
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "model_name": {
-          "type": "string"
-        }
-      }
-    },
-    "create-manifest-params": {
-      "type": "object",
-      "required": [
-        "file_name",
-        "content"
-      ],
-      "properties": {
-        "content": {
-          "description": "base64 encoded manifest content.",
-          "type": "string"
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "discovering",
+            "known",
+            "disconnected",
+            "insufficient",
+            "disabled",
+            "preparing-for-installation",
+            "preparing-failed",
+            "preparing-successful",
+            "pending-for-input",
+            "installing",
+            "installing-in-progress",
+            "installing-pending-user-action",
+            "resetting-pending-user-action",
+            "installed",
+            "error",
+            "resetting",
+            "added-to-existing-cluster",
+            "cancelled",
+            "binding",
+            "unbinding",
+            "unbinding-pending-user-action",
+            "known-unbound",
+            "disconnected-unbound",
+            "insufficient-unbound",
+            "disabled-unbound",
+            "discovering-unbound",
+            "reclaiming",
+            "reclaiming-rebooting"
+          ]
         },
-        "file_name": {
-          "description": "The name of the manifest to customize the installed OCP cluster.",
+        "status_info": {
           "type": "string",
-          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$"
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         },
-        "folder": {
-          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+        "status_updated_at": {
+          "description": "The last time that the host status was updated.",
           "type": "string",
-          "default": "manifests",
-          "enum": [
-            "manifests",
-            "openshift"
-          ]
-        }
-      }
-    },
-    "credentials": {
-      "type": "object",
-      "properties": {
-        "console_url": {
-          "type": "string"
-        },
-        "password": {
-          "type": "string"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "username": {
-          "type": "string"
-        }
-      }
-    },
-    "dhcp_allocation_request": {
-      "type": "object",
-      "required": [
-        "interface",
-        "api_vip_mac",
-        "ingress_vip_mac"
-      ],
-      "properties": {
-        "api_vip_lease": {
-          "description": "Contents of lease file to be used for API virtual IP.",
-          "type": "string"
+        "suggested_role": {
+          "$ref": "#/definitions/host-role"
         },
-        "api_vip_mac": {
-          "description": "MAC address for the API virtual IP.",
+        "tang_connectivity": {
           "type": "string",
-          "format": "mac"
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "ingress_vip_lease": {
-          "description": "Contents of lease file to be used for for Ingress virtual IP.",
-          "type": "string"
+        "timestamp": {
+          "description": "The time on the host as seconds since the Unix epoch.",
+          "type": "integer"
         },
-        "ingress_vip_mac": {
-          "description": "MAC address for the Ingress virtual IP.",
+        "updated_at": {
           "type": "string",
-          "format": "mac"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
         },
-        "interface": {
-          "description": "The network interface (NIC) to run the DHCP requests on.",
+        "user_name": {
           "type": "string"
+        },
+        "validations_info": {
+          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         }
       }
     },
-    "dhcp_allocation_response": {
+    "host-create-params": {
       "type": "object",
       "required": [
-        "api_vip_address",
-        "ingress_vip_address"
+        "host_id"
       ],
       "properties": {
-        "api_vip_address": {
-          "description": "The IPv4 address that was allocated by DHCP for the API virtual IP.",
-          "type": "string",
-          "format": "ipv4"
-        },
-        "api_vip_lease": {
-          "description": "Contents of last acquired lease for API virtual IP.",
+        "discovery_agent_version": {
           "type": "string"
         },
-        "ingress_vip_address": {
-          "description": "The IPv4 address that was allocated by DHCP for the Ingress virtual IP.",
+        "host_id": {
           "type": "string",
-          "format": "ipv4"
-        },
-        "ingress_vip_lease": {
-          "description": "Contents of last acquired lease for Ingress virtual IP.",
-          "type": "string"
+          "format": "uuid"
         }
       }
     },
-    "disconnected-cluster-create-params": {
+    "host-hardware-revision": {
       "type": "object",
       "required": [
-        "name",
-        "openshift_version"
+        "host_id",
+        "infra_env_id",
+        "revision",
+        "created_at",
+        "fingerprint_hash"
       ],
       "properties": {
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string",
-          "maxLength": 54,
-          "minLength": 1
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
-        }
-      }
-    },
-    "disk": {
-      "type": "object",
-      "properties": {
-        "bootable": {
-          "type": "boolean"
-        },
-        "by_id": {
-          "description": "by-id is the World Wide Number of the device which guaranteed to be unique for every storage device",
-          "type": "string"
-        },
-        "by_path": {
-          "description": "by-path is the shortest physical path to the device",
-          "type": "string"
-        },
-        "drive_type": {
-          "$ref": "#/definitions/drive_type"
-        },
-        "has_uuid": {
-          "type": "boolean"
-        },
-        "hctl": {
-          "type": "string"
-        },
-        "holders": {
-          "description": "A comma-separated list of disk names that this disk belongs to",
-          "type": "string"
-        },
-        "id": {
-          "description": "Determine the disk's unique identifier which is the by-id field if it exists and fallback to the by-path field otherwise",
-          "type": "string"
-        },
-        "installation_eligibility": {
-          "type": "object",
-          "properties": {
-            "eligible": {
-              "description": "Whether the disk is eligible for installation or not.",
-              "type": "boolean"
-            },
-            "not_eligible_reasons": {
-              "description": "Reasons for why this disk is not eligible for installation.",
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
+        "changes": {
+          "description": "The changes of the hardware from the previous revision.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/hardware-fingerprint-change"
           },
-          "x-nullable": false
+          "x-go-custom-tag": "gorm:\"-\""
         },
-        "io_perf": {
-          "$ref": "#/definitions/io_perf"
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "is_installation_media": {
-          "description": "Whether the disk appears to be an installation media or not",
+        "drift": {
+          "description": "Whether the hardware changed after the validations of the host passed.",
           "type": "boolean"
         },
-        "iscsi": {
-          "$ref": "#/definitions/iscsi"
-        },
-        "model": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "partitionTypes": {
-          "type": "string"
-        },
-        "path": {
-          "type": "string"
-        },
-        "removable": {
-          "type": "boolean"
+        "fingerprint": {
+          "$ref": "#/definitions/hardware-fingerprint"
         },
-        "serial": {
+        "fingerprint_hash": {
+          "description": "The hash of the hardware fingerprint.",
           "type": "string"
         },
-        "size_bytes": {
-          "type": "integer"
+        "host_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "smart": {
+        "host_status": {
+          "description": "The status of the host when its inventory reported the hardware.",
           "type": "string"
         },
-        "vendor": {
-          "type": "string"
+        "infra_env_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "wwn": {
+        "revision": {
+          "description": "The number of the revision, increasing for each revision of the hardware of the host.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"primaryKey;autoIncrement:false\""
+        }
+      }
+    },
+    "host-hardware-revision-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host-hardware-revision"
+      }
+    },
+    "host-ignition-params": {
+      "properties": {
+        "config": {
           "type": "string"
         }
       }
     },
-    "disk-config-params": {
+    "host-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host"
+      }
+    },
+    "host-progress": {
       "type": "object",
-      "required": [
-        "id"
-      ],
       "properties": {
-        "id": {
-          "type": "string"
+        "current_stage": {
+          "$ref": "#/definitions/host-stage"
         },
-        "role": {
-          "$ref": "#/definitions/disk-role"
+        "progress_info": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         }
       }
     },
-    "disk-encryption": {
+    "host-progress-info": {
       "type": "object",
       "properties": {
-        "enable_on": {
-          "description": "Enable/disable disk encryption on master nodes, arbiter nodes, worker nodes, or a combination of them.",
+        "current_stage": {
+          "$ref": "#/definitions/host-stage"
+        },
+        "installation_percentage": {
+          "type": "integer"
+        },
+        "progress_info": {
           "type": "string",
-          "default": "none",
-          "enum": [
-            "none",
-            "masters",
-            "arbiters",
-            "workers",
-            "masters,arbiters",
-            "masters,workers",
-            "arbiters,workers",
-            "masters,arbiters,workers",
-            "all"
-          ]
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         },
-        "mode": {
-          "description": "The disk encryption mode to use.",
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started.",
           "type": "string",
-          "default": "tpmv2",
-          "enum": [
-            "tpmv2",
-            "tang"
-          ]
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "tang_servers": {
-          "description": "JSON-formatted string containing additional information regarding tang's configuration",
+        "stage_timed_out": {
+          "description": "Indicate of the current stage has been timed out.",
+          "type": "boolean"
+        },
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "[{\"url\":\"http://tang.example.com:7500\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu9\"}, {\"url\":\"http://tang.example.com:7501\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu8\"}]"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         }
       },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:disk_encryption_\""
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
     },
-    "disk-role": {
+    "host-role": {
       "type": "string",
       "enum": [
-        "none",
-        "install"
+        "auto-assign",
+        "master",
+        "arbiter",
+        "worker",
+        "bootstrap"
       ]
     },
-    "disk-skip-formatting-params": {
-      "description": "Allows an addition or removal of a host disk from the host's skip_formatting_disks list",
+    "host-role-update-params": {
+      "type": "string",
+      "enum": [
+        "auto-assign",
+        "master",
+        "arbiter",
+        "worker"
+      ]
+    },
+    "host-stage": {
+      "type": "string",
+      "enum": [
+        "Starting installation",
+        "Waiting for control plane",
+        "Waiting for bootkube",
+        "Waiting for controller",
+        "Installing",
+        "Writing image to disk",
+        "Copying registry data to disk",
+        "Rebooting",
+        "Waiting for ignition",
+        "Configuring",
+        "Joined",
+        "Done",
+        "Failed"
+      ]
+    },
+    "host-type-hardware-requirements": {
       "type": "object",
-      "required": [
-        "disk_id",
-        "skip_formatting"
-      ],
       "properties": {
-        "disk_id": {
-          "description": "The ID of the disk that is being added to or removed from the host's skip_formatting_disks list",
-          "type": "string"
+        "qualitative": {
+          "description": "Host requirements that cannot be quantified at the time of calculation. Descriptions or formulas of requiements",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "skip_formatting": {
-          "description": "True if you wish to add the disk to the skip_formatting_disks list, false if you wish to remove it",
-          "type": "boolean"
+        "quantitative": {
+          "description": "Host requirements that can be quantified",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "disk_info": {
+    "host-type-hardware-requirements-wrapper": {
       "type": "object",
       "properties": {
-        "disk_speed": {
-          "$ref": "#/definitions/disk_speed"
-        },
-        "id": {
-          "type": "string",
-          "format": "uuid"
+        "master": {
+          "description": "Requirements towards a master node",
+          "$ref": "#/definitions/host-type-hardware-requirements"
         },
-        "path": {
-          "type": "string"
+        "worker": {
+          "description": "Requirements towards a worker node",
+          "$ref": "#/definitions/host-type-hardware-requirements"
         }
       }
     },
-    "disk_speed": {
+    "host-update-params": {
       "type": "object",
       "properties": {
-        "exit_code": {
-          "type": "integer"
+        "disks_selected_config": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/disk-config-params"
+          },
+          "x-nullable": true
         },
-        "speed_ms": {
-          "type": "integer"
+        "disks_skip_formatting": {
+          "description": "Allows changing the host's skip_formatting_disks parameter",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/disk-skip-formatting-params"
+          },
+          "x-nullable": true
         },
-        "tested": {
-          "type": "boolean"
+        "fencing_credentials": {
+          "description": "The host's BMC credentials that will be used in TNF.",
+          "x-nullable": true,
+          "$ref": "#/definitions/fencing-credentials-params"
+        },
+        "host_name": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "host_role": {
+          "type": "string",
+          "enum": [
+            "auto-assign",
+            "master",
+            "arbiter",
+            "worker"
+          ],
+          "x-nullable": true
+        },
+        "ignition_endpoint_http_headers": {
+          "description": "JSON-formatted string of additional HTTP headers when fetching the ignition.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ignition-endpoint-http-headers-params"
+          },
+          "x-nullable": true
+        },
+        "ignition_endpoint_token": {
+          "description": "A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "machine_config_pool_name": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "node_labels": {
+          "description": "Labels to be added to the corresponding node.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/node-label-params"
+          },
+          "x-nullable": true
         }
       }
     },
-    "disk_speed_check_request": {
-      "type": "object",
-      "required": [
-        "path"
-      ],
-      "properties": {
-        "path": {
-          "description": "--filename argument for fio (expects a file or a block device path).",
-          "type": "string"
-        }
-      }
+    "host-validation-id": {
+      "type": "string",
+      "enum": [
+        "connected",
+        "media-connected",
+        "has-inventory",
+        "has-min-cpu-cores",
+        "has-min-valid-disks",
+        "has-min-memory",
+        "machine-cidr-defined",
+        "has-cpu-cores-for-role",
+        "has-memory-for-role",
+        "hostname-unique",
+        "hostname-valid",
+        "belongs-to-machine-cidr",
+        "ignition-downloadable",
+        "belongs-to-majority-group",
+        "valid-platform-network-settings",
+        "ntp-synced",
+        "time-synced-between-host-and-service",
+        "container-images-available",
+        "lso-requirements-satisfied",
+        "ocs-requirements-satisfied",
+        "odf-requirements-satisfied",
+        "lvm-requirements-satisfied",
+        "mce-requirements-satisfied",
+        "mtv-requirements-satisfied",
+        "osc-requirements-satisfied",
+        "sufficient-installation-disk-speed",
+        "cnv-requirements-satisfied",
+        "sufficient-network-latency-requirement-for-role",
+        "sufficient-packet-loss-requirement-for-role",
+        "sufficient-network-bandwidth-requirement-for-role",
+        "has-default-route",
+        "api-domain-name-resolved-correctly",
+        "api-int-domain-name-resolved-correctly",
+        "apps-domain-name-resolved-correctly",
+        "release-domain-name-resolved-correctly",
+        "compatible-with-cluster-platform",
+        "dns-wildcard-not-configured",
+        "disk-encryption-requirements-satisfied",
+        "non-overlapping-subnets",
+        "vsphere-disk-uuid-enabled",
+        "compatible-agent",
+        "no-skip-installation-disk",
+        "no-skip-missing-disk",
+        "no-ip-collisions-in-network",
+        "no-iscsi-nic-belongs-to-machine-cidr",
+        "node-feature-discovery-requirements-satisfied",
+        "nvidia-gpu-requirements-satisfied",
+        "pipelines-requirements-satisfied",
+        "servicemesh-requirements-satisfied",
+        "serverless-requirements-satisfied",
+        "openshift-ai-requirements-satisfied",
+        "authorino-requirements-satisfied",
+        "mtu-valid",
+        "nmstate-requirements-satisfied",
+        "amd-gpu-requirements-satisfied",
+        "kmm-requirements-satisfied",
+        "node-healthcheck-requirements-satisfied",
+        "self-node-remediation-requirements-satisfied",
+        "fence-agents-remediation-requirements-satisfied",
+        "node-maintenance-requirements-satisfied",
+        "kube-descheduler-requirements-satisfied",
+        "cluster-observability-requirements-satisfied",
+        "numa-resources-requirements-satisfied",
+        "oadp-requirements-satisfied",
+        "metallb-requirements-satisfied",
+        "loki-requirements-satisfied",
+        "openshift-logging-requirements-satisfied",
+        "custom-steps-succeeded",
+        "firmware-policy-satisfied",
+        "disks-healthy"
+      ]
     },
-    "disk_speed_check_response": {
+    "host_network": {
       "type": "object",
       "properties": {
-        "io_sync_duration": {
-          "description": "The 99th percentile of fdatasync durations in milliseconds.",
-          "type": "integer"
-        },
-        "path": {
-          "description": "The device path.",
+        "cidr": {
           "type": "string"
-        }
-      }
-    },
-    "domain_resolution_request": {
-      "type": "object",
-      "required": [
-        "domains"
-      ],
-      "properties": {
-        "domains": {
+        },
+        "host_ids": {
           "type": "array",
           "items": {
-            "type": "object",
-            "x-go-type": {
-              "import": {
-                "path": "github.com/openshift/assisted-service/models"
-              },
-              "type": "DomainResolutionRequestDomain"
-            }
+            "type": "string",
+            "format": "uuid"
           }
         }
       }
     },
-    "domain_resolution_response": {
-      "type": "object",
-      "required": [
-        "resolutions"
-      ],
-      "properties": {
-        "resolutions": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "required": [
-              "domain_name"
-            ],
-            "properties": {
-              "cnames": {
-                "description": "The cnames that were resolved for the domain, empty if none",
-                "type": "array",
-                "items": {
+    "host_registration_response": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/host"
+        },
+        {
+          "properties": {
+            "next_step_runner_command": {
+              "description": "Command for starting the next step runner",
+              "type": "object",
+              "properties": {
+                "args": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "command": {
                   "type": "string"
-                }
-              },
-              "domain_name": {
-                "description": "The domain that was resolved",
-                "type": "string"
-              },
-              "ipv4_addresses": {
-                "description": "The IPv4 addresses of the domain, empty if none",
-                "type": "array",
-                "items": {
-                  "type": "string",
-                  "format": "ipv4"
-                }
-              },
-              "ipv6_addresses": {
-                "description": "The IPv6 addresses of the domain, empty if none",
-                "type": "array",
-                "items": {
-                  "type": "string",
-                  "format": "ipv6"
+                },
+                "retry_seconds": {
+                  "description": "How long in seconds to wait before retrying registration if the command fails",
+                  "type": "integer"
                 }
               }
-            },
-            "x-go-name": "DomainResolutionResponseDomain"
+            }
           }
         }
-      }
+      ]
     },
-    "download_boot_artifacts_request": {
-      "description": "Information sent to the agent for downloading artifacts to boot a host into discovery.",
+    "host_static_network_config": {
       "type": "object",
-      "required": [
-        "kernel_url",
-        "rootfs_url",
-        "initrd_url",
-        "host_fs_mount_dir"
-      ],
       "properties": {
-        "host_fs_mount_dir": {
-          "description": "The base directory on the host that contains the /boot folder. The host will download boot\nartifacts into a folder in this directory.",
-          "type": "string"
-        },
-        "initrd_url": {
-          "description": "URL address to download the initrd.",
-          "type": "string"
-        },
-        "kernel_url": {
-          "description": "URL address to download the kernel.",
-          "type": "string"
+        "mac_interface_map": {
+          "description": "mapping of host macs to logical interfaces used in the network yaml",
+          "$ref": "#/definitions/mac_interface_map"
         },
-        "rootfs_url": {
-          "description": "URL address to download the rootfs.",
+        "network_yaml": {
+          "description": "yaml string that can be processed by nmstate",
           "type": "string"
         }
       }
     },
-    "drive_type": {
-      "type": "string",
-      "enum": [
-        "Unknown",
-        "HDD",
-        "FDD",
-        "ODD",
-        "SSD",
-        "virtual",
-        "Multipath",
-        "iSCSI",
-        "FC",
-        "LVM",
-        "RAID",
-        "ECKD",
-        "ECKD (ESE)",
-        "FBA"
-      ]
+    "ignition-endpoint": {
+      "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+      "type": "object",
+      "properties": {
+        "ca_certificate": {
+          "description": "base64 encoded CA certficate to be used when contacting the URL via https.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "url": {
+          "description": "The URL for the ignition endpoint.",
+          "type": "string",
+          "x-nullable": true
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:ignition_endpoint_\""
     },
-    "error": {
+    "ignition-endpoint-http-headers-params": {
       "type": "object",
       "required": [
-        "kind",
-        "id",
-        "href",
-        "code",
-        "reason"
+        "key",
+        "value"
       ],
       "properties": {
-        "code": {
-          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
-          "type": "string"
-        },
-        "href": {
-          "description": "Self link.",
+        "key": {
+          "description": "The key for the http header's key-value pair.",
           "type": "string"
         },
-        "id": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 504,
-          "minimum": 400
-        },
-        "kind": {
-          "description": "Indicates the type of this object. Will always be 'Error'.",
-          "type": "string",
-          "enum": [
-            "Error"
-          ]
-        },
-        "reason": {
-          "description": "Human-readable description of the error.",
+        "value": {
+          "description": "The value for the http header's key-value pair.",
           "type": "string"
         }
       }
     },
-    "event": {
+    "ignored-validations": {
       "type": "object",
-      "required": [
-        "severity",
-        "message",
-        "event_time"
-      ],
       "properties": {
-        "category": {
-          "type": "string",
-          "enum": [
-            "user",
-            "metrics"
-          ],
-          "x-go-custom-tag": "gorm:\"default:'user'\""
-        },
-        "cluster_id": {
-          "description": "Unique identifier of the cluster this event relates to.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\"",
-          "x-nullable": true
-        },
-        "event_time": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "host_id": {
-          "description": "Unique identifier of the host this event relates to.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\"",
-          "x-nullable": true
-        },
-        "infra_env_id": {
-          "description": "Unique identifier of the infra-env this event relates to.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\"",
-          "x-nullable": true
-        },
-        "message": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
-        },
-        "name": {
-          "description": "Event Name.",
-          "type": "string"
-        },
-        "props": {
-          "description": "Additional properties for the event in JSON format.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "request_id": {
-          "description": "Unique identifier of the request that caused this event to occur.",
+        "cluster-validation-ids": {
+          "description": "JSON-formatted list of cluster validation IDs that will be ignored for all hosts that belong to this cluster. It may also contain a list with a single string \"all\" to ignore all cluster validations. Some validations cannot be ignored.",
           "type": "string",
-          "format": "uuid"
+          "format": "string"
         },
-        "severity": {
+        "host-validation-ids": {
+          "description": "JSON-formatted list of host validation IDs that will be ignored for all hosts that belong to this cluster. It may also contain a list with a single string \"all\" to ignore all host validations. Some validations cannot be ignored.",
           "type": "string",
-          "enum": [
-            "info",
-            "warning",
-            "error",
-            "critical"
-          ]
+          "format": "string"
         }
       }
     },
-    "event-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/event"
-      }
-    },
-    "feature": {
+    "image-create-params": {
       "type": "object",
-      "required": [
-        "feature-support-level-id",
-        "support_level",
-        "incompatibilities"
-      ],
       "properties": {
-        "feature-support-level-id": {
-          "$ref": "#/definitions/feature-support-level-id"
+        "image_type": {
+          "description": "Type of image that should be generated.",
+          "$ref": "#/definitions/image_type"
         },
-        "incompatibilities": {
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
+        },
+        "static_network_config": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/feature-support-level-id"
+            "$ref": "#/definitions/host_static_network_config"
           }
-        },
-        "reason": {
-          "$ref": "#/definitions/incompatibility-reason"
-        },
-        "support_level": {
-          "$ref": "#/definitions/support-level"
         }
       }
     },
-    "feature-support-level-id": {
-      "type": "string",
-      "enum": [
-        "SNO",
-        "TNA",
-        "TNF",
-        "VIP_AUTO_ALLOC",
-        "CUSTOM_MANIFEST",
-        "SINGLE_NODE_EXPANSION",
-        "LVM",
-        "ODF",
-        "LSO",
-        "CNV",
-        "MCE",
-        "MTV",
-        "OSC",
-        "NUTANIX_INTEGRATION",
-        "BAREMETAL_PLATFORM",
-        "NONE_PLATFORM",
-        "VSPHERE_INTEGRATION",
-        "DUAL_STACK_VIPS",
-        "CLUSTER_MANAGED_NETWORKING",
-        "USER_MANAGED_NETWORKING",
-        "MINIMAL_ISO",
-        "FULL_ISO",
-        "EXTERNAL_PLATFORM_OCI",
-        "DUAL_STACK",
-        "PLATFORM_MANAGED_NETWORKING",
-        "EXTERNAL_PLATFORM",
-        "OVN_NETWORK_TYPE",
-        "SDN_NETWORK_TYPE",
-        "NODE_FEATURE_DISCOVERY",
-        "NVIDIA_GPU",
-        "PIPELINES",
-        "SERVICEMESH",
-        "SERVERLESS",
-        "OPENSHIFT_AI",
-        "NON_STANDARD_HA_CONTROL_PLANE",
-        "AUTHORINO",
-        "USER_MANAGED_LOAD_BALANCER",
-        "NMSTATE",
-        "AMD_GPU",
-        "KMM",
-        "NODE_HEALTHCHECK",
-        "SELF_NODE_REMEDIATION",
-        "FENCE_AGENTS_REMEDIATION",
-        "NODE_MAINTENANCE",
-        "KUBE_DESCHEDULER",
-        "CLUSTER_OBSERVABILITY",
-        "NUMA_RESOURCES",
-        "OADP",
-        "METALLB",
-        "DUAL_STACK_PRIMARY_IPV6",
-        "LOKI",
-        "OPENSHIFT_LOGGING"
-      ],
-      "x-nullable": false
-    },
-    "fencing-credentials-params": {
+    "image_info": {
       "type": "object",
-      "required": [
-        "address",
-        "username",
-        "password"
-      ],
       "properties": {
-        "address": {
-          "description": "The URL of the host's BMC, for example https://bmc1.example.com.",
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "download_url": {
           "type": "string"
         },
-        "certificate_verification": {
-          "description": "Whether to enable or disable certificate verification when connecting to the host's BMC.",
+        "expires_at": {
           "type": "string",
-          "default": "Enabled",
-          "enum": [
-            "Enabled",
-            "Disabled"
-          ]
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "password": {
-          "description": "The password to connect to the host's BMC.",
+        "generator_version": {
+          "description": "Image generator version.",
           "type": "string"
         },
-        "username": {
-          "description": "The username to connect to the host's BMC.",
+        "size_bytes": {
+          "type": "integer"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
+        },
+        "static_network_config": {
+          "description": "static network configuration string in the format expected by discovery ignition generation",
           "type": "string"
+        },
+        "type": {
+          "$ref": "#/definitions/image_type"
         }
-      }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:image_\""
     },
-    "finalizing-stage": {
-      "description": "Cluster finalizing stage managed by controller",
+    "image_type": {
       "type": "string",
       "enum": [
-        "Waiting for cluster operators",
-        "Adding router ca",
-        "Applying olm manifests",
-        "Waiting for olm operators csv initialization",
-        "Waiting for olm operators csv",
-        "Waiting for OLM operator setup jobs",
-        "Done"
+        "full-iso",
+        "minimal-iso",
+        "disconnected-iso"
       ]
     },
-    "free-addresses-list": {
-      "type": "array",
-      "items": {
-        "type": "string",
-        "format": "ipv4"
-      }
-    },
-    "free_addresses_request": {
-      "type": "array",
-      "items": {
-        "type": "string",
-        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
-      }
-    },
-    "free_network_addresses": {
-      "type": "object",
-      "properties": {
-        "free_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string",
-            "format": "ipv4"
-          }
-        },
-        "network": {
-          "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
-        }
-      }
-    },
-    "free_networks_addresses": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/free_network_addresses"
-      }
-    },
-    "gpu": {
+    "import-cluster-params": {
       "type": "object",
+      "required": [
+        "name",
+        "api_vip_dnsname",
+        "openshift_cluster_id"
+      ],
       "properties": {
-        "address": {
-          "description": "Device address (for example \"0000:00:02.0\")",
-          "type": "string"
-        },
-        "device_id": {
-          "description": "ID of the device (for example \"3ea0\")",
+        "api_vip_dnsname": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
           "type": "string"
         },
         "name": {
-          "description": "Product name of the device (for example \"UHD Graphics 620 (Whiskey Lake)\")",
+          "description": "OpenShift cluster name.",
           "type": "string"
         },
-        "vendor": {
-          "description": "The name of the device vendor (for example \"Intel Corporation\")",
-          "type": "string"
+        "openshift_cluster_id": {
+          "description": "The id of the OCP cluster, that hosts will be added to",
+          "type": "string",
+          "format": "uuid"
         },
-        "vendor_id": {
-          "description": "ID of the vendor (for example \"8086\")",
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
           "type": "string"
         }
       }
     },
-    "host": {
+    "incompatibility-reason": {
+      "type": "string",
+      "enum": [
+        "cpuArchitecture",
+        "platform",
+        "openshiftVersion",
+        "ociExternalIntegrationDisabled"
+      ]
+    },
+    "infra-env": {
       "type": "object",
       "required": [
         "kind",
         "id",
         "href",
-        "status",
-        "status_info"
-      ],
-      "properties": {
-        "api_vip_connectivity": {
-          "description": "Contains a serialized api_vip_connectivity_response",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "bootstrap": {
-          "type": "boolean"
+        "name",
+        "type",
+        "updated_at",
+        "created_at"
+      ],
+      "properties": {
+        "additional_ntp_sources": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
         },
-        "checked_in_at": {
-          "description": "The last time the host's agent communicated with the service.",
+        "additional_trust_bundle": {
+          "description": "PEM-encoded X.509 certificate bundle. Hosts discovered by this\ninfra-env will trust the certificates in this bundle. Clusters formed\nfrom the hosts discovered by this infra-env will also trust the\ncertificates in this bundle.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "x-nullable": false
         },
         "cluster_id": {
-          "description": "The cluster that this host is associated with.",
+          "description": "If set, all hosts that register will be associated with the specified cluster.",
           "type": "string",
           "format": "uuid",
-          "x-go-custom-tag": "gorm:\"foreignkey:Cluster\"",
-          "x-nullable": true
-        },
-        "connection_timed_out": {
-          "description": "Indicate that connection to assisted service was timed out when soft timeout is enabled.",
-          "type": "boolean"
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "connectivity": {
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x"
+          ],
+          "x-nullable": false
         },
         "created_at": {
           "type": "string",
@@ -8420,46 +12247,26 @@ func init() {
             "type": "Time"
           }
         },
-        "deleted_at": {
-          "description": "swagger:ignore",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "gorm.io/gorm"
-            },
-            "type": "DeletedAt"
-          },
-          "x-nullable": false
-        },
-        "discovery_agent_version": {
+        "download_url": {
           "type": "string"
         },
-        "disks_info": {
-          "description": "Additional information about disks, formatted as JSON.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "disks_to_be_formatted": {
-          "description": "A comma-separated list of disks that will be formatted once\ninstallation begins, unless otherwise set to be skipped by\nskip_formatting_disks. This means that this list also includes disks\nthat appear in skip_formatting_disks. This property is managed by the\nservice and cannot be modified by the user.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "email_domain": {
+          "type": "string"
         },
-        "domain_name_resolutions": {
-          "description": "The domain name resolution result.",
+        "expires_at": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "fencing_credentials": {
-          "description": "The host's BMC credentials that will be used in TNF.",
+        "firmware_policy": {
+          "description": "JSON formatted firmware policy that the hosts of the infra-env must comply with, until they are bound to a cluster.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "x-nullable": true
         },
-        "free_addresses": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "generator_version": {
+          "description": "Image generator version.",
+          "type": "string"
         },
         "href": {
           "description": "Self link.",
@@ -8471,1609 +12278,2165 @@ func init() {
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "ignition_config_overrides": {
-          "description": "Json formatted string containing the user overrides for the host's pointer ignition",
+        "ignition_config_override": {
+          "description": "Json formatted string containing the user overrides for the initial ignition config.",
+          "type": "string"
+        },
+        "kernel_arguments": {
+          "description": "JSON formatted string array representing the discovery image kernel arguments.",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
+          "x-nullable": true
         },
-        "ignition_endpoint_token_set": {
-          "description": "True if the token to fetch the ignition from ignition_endpoint_url is set.",
+        "kind": {
+          "description": "Indicates the type of this object.",
+          "type": "string",
+          "enum": [
+            "InfraEnv"
+          ]
+        },
+        "name": {
+          "description": "Name of the infra-env.",
+          "type": "string"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster (used to infer the RHCOS version - temporary until generic logic implemented).",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string"
+        },
+        "proxy": {
+          "$ref": "#/definitions/proxy"
+        },
+        "pull_secret_set": {
+          "description": "True if the pull secret has been added to the cluster.",
           "type": "boolean"
         },
-        "images_status": {
-          "description": "Array of image statuses.",
+        "rendezvous_ip": {
+          "description": "The IP address of the host that will act as the rendezvous (bootstrap) node for agent-based installations.\nThis is optional for disconnected-iso image type and specifies which host will run the assisted service\nduring the bootstrap phase. All other hosts will connect to this IP to coordinate the installation.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "pattern": "^(?:$|(?:(?:(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\\.){3}(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])|(?:([0-9a-fA-F]{1,4}:){7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:(:[0-9a-fA-F]{1,4}){1,6}|:(:[0-9a-fA-F]{1,4}){1,7}|:|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9]))))$",
+          "x-nullable": true
         },
-        "infra_env_id": {
-          "description": "The infra-env that this host is associated with.",
+        "size_bytes": {
+          "type": "integer"
+        },
+        "ssh_authorized_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
+        },
+        "static_network_config": {
+          "description": "static network configuration string in the format expected by discovery ignition generation.",
+          "type": "string"
+        },
+        "type": {
+          "$ref": "#/definitions/image_type"
+        },
+        "updated_at": {
+          "description": "The last time that this infra-env was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "user_name": {
+          "type": "string"
+        }
+      }
+    },
+    "infra-env-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "pull_secret"
+      ],
+      "properties": {
+        "additional_ntp_sources": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "additional_trust_bundle": {
+          "description": "PEM-encoded X.509 certificate bundle. Hosts discovered by this\ninfra-env will trust the certificates in this bundle. Clusters formed\nfrom the hosts discovered by this infra-env will also trust the\ncertificates in this bundle.",
+          "type": "string",
+          "maxLength": 65535,
+          "x-nullable": false
+        },
+        "cluster_id": {
+          "description": "If set, all hosts that register will be associated with the specified cluster.",
           "type": "string",
           "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey;foreignkey:InfraEnvID\""
+          "x-nullable": true
         },
-        "installation_disk_id": {
-          "description": "Contains the inventory disk id to install on.",
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x"
+          ],
+          "x-nullable": false
+        },
+        "firmware_policy": {
+          "description": "The firmware settings that the hosts of the infra-env must comply with, until they are bound to a cluster.",
+          "$ref": "#/definitions/firmware-policy"
+        },
+        "ignition_config_override": {
+          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
           "type": "string"
         },
-        "installation_disk_path": {
-          "description": "Contains the inventory disk path, This field is replaced by installation_disk_id field and used for backward compatability with the old UI.",
+        "image_type": {
+          "$ref": "#/definitions/image_type"
+        },
+        "kernel_arguments": {
+          "$ref": "#/definitions/kernel_arguments"
+        },
+        "name": {
+          "description": "Name of the infra-env.",
+          "type": "string"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster (used to infer the RHCOS version - temporary until generic logic implemented).",
+          "type": "string"
+        },
+        "proxy": {
+          "$ref": "#/definitions/proxy"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        },
+        "rendezvous_ip": {
+          "description": "The IP address of the host that will act as the rendezvous (bootstrap) node for agent-based installations.\nThis is optional for disconnected-iso image type and specifies which host will run the assisted service\nduring the bootstrap phase. All other hosts will connect to this IP to coordinate the installation.",
           "type": "string",
-          "example": "/dev/sda"
+          "pattern": "^(?:$|(?:(?:(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\\.){3}(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])|(?:([0-9a-fA-F]{1,4}:){7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:(:[0-9a-fA-F]{1,4}){1,6}|:(:[0-9a-fA-F]{1,4}){1,7}|:|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9]))))$",
+          "x-nullable": true
+        },
+        "ssh_authorized_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "static_network_config": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
+          }
+        }
+      }
+    },
+    "infra-env-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/infra-env"
+      }
+    },
+    "infra-env-update-params": {
+      "type": "object",
+      "properties": {
+        "additional_ntp_sources": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "additional_trust_bundle": {
+          "description": "Allows users to change the additional_trust_bundle infra-env field",
+          "type": "string",
+          "maxLength": 65535,
+          "x-nullable": true
         },
-        "installer_args": {
-          "type": "string"
+        "firmware_policy": {
+          "description": "The firmware settings that the hosts of the infra-env must comply with, until they are bound to a cluster. An empty policy removes the policy of the infra-env.",
+          "$ref": "#/definitions/firmware-policy"
         },
-        "installer_version": {
-          "description": "Installer version.",
+        "ignition_config_override": {
+          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
           "type": "string"
         },
-        "inventory": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "image_type": {
+          "$ref": "#/definitions/image_type"
         },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
-          "type": "string",
-          "enum": [
-            "Host",
-            "AddToExistingClusterHost"
-          ]
+        "kernel_arguments": {
+          "$ref": "#/definitions/kernel_arguments"
         },
-        "logs_collected_at": {
+        "openshift_version": {
+          "description": "Version of the OS image",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "logs_info": {
-          "description": "The progress of log collection or empty if logs are not applicable",
-          "$ref": "#/definitions/logs_state"
+          "x-nullable": true
         },
-        "logs_started_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "proxy": {
+          "$ref": "#/definitions/proxy"
         },
-        "machine_config_pool_name": {
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
           "type": "string"
         },
-        "media_status": {
+        "rendezvous_ip": {
+          "description": "The IP address of the host that will act as the rendezvous (bootstrap) node for agent-based installations.\nThis is optional for disconnected-iso image type and specifies which host will run the assisted service\nduring the bootstrap phase. All other hosts will connect to this IP to coordinate the installation.",
           "type": "string",
-          "default": "connected",
-          "enum": [
-            "connected",
-            "disconnected"
-          ],
+          "pattern": "^(?:$|(?:(?:(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\\.){3}(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])|(?:([0-9a-fA-F]{1,4}:){7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:(:[0-9a-fA-F]{1,4}){1,6}|:(:[0-9a-fA-F]{1,4}){1,7}|:|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9]))))$",
           "x-nullable": true
         },
-        "node_labels": {
-          "description": "Json containing node's labels.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "ntp_sources": {
-          "description": "The configured NTP sources on the host.",
+        "ssh_authorized_key": {
+          "description": "SSH public key for debugging the installation.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "progress": {
-          "$ref": "#/definitions/host-progress-info"
+          "x-nullable": true
         },
-        "progress_stages": {
+        "static_network_config": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/host-stage"
-          },
-          "x-go-custom-tag": "gorm:\"-\"",
-          "x-nullable": true
+            "$ref": "#/definitions/host_static_network_config"
+          }
+        }
+      }
+    },
+    "infra_error": {
+      "type": "object",
+      "required": [
+        "code",
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 403,
+          "minimum": 401
         },
-        "registered_at": {
-          "description": "The last time the host's agent tried to register in the service.",
+        "message": {
+          "description": "Human-readable description of the error.",
+          "type": "string"
+        }
+      }
+    },
+    "ingress-cert-params": {
+      "type": "string"
+    },
+    "ingress_vip": {
+      "description": "The virtual IP used for cluster ingress traffic.",
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster that this VIP is associated with.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "requested_hostname": {
+        "ip": {
+          "description": "The IP address.",
+          "$ref": "#/definitions/ip"
+        },
+        "verification": {
+          "description": "Ingress VIP verification result.",
+          "$ref": "#/definitions/vip_verification"
+        }
+      }
+    },
+    "install_cmd_request": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "infra_env_id",
+        "host_id",
+        "role",
+        "boot_device",
+        "controller_image",
+        "installer_image"
+      ],
+      "properties": {
+        "boot_device": {
+          "description": "Boot device to write image on",
           "type": "string"
         },
-        "role": {
-          "$ref": "#/definitions/host-role"
+        "check_cvo": {
+          "description": "Check CVO status if needed",
+          "type": "boolean",
+          "default": true
         },
-        "skip_formatting_disks": {
-          "description": "A comma-seperated list of host disks that the service will avoid\nformatting.",
+        "cluster_id": {
+          "description": "Cluster id",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "format": "uuid"
         },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "control_plane_count": {
+          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
+          "type": "integer"
         },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated.",
+        "controller_image": {
+          "description": "Assisted installer controller image",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
         },
-        "status": {
-          "type": "string",
-          "enum": [
-            "discovering",
-            "known",
-            "disconnected",
-            "insufficient",
-            "disabled",
-            "preparing-for-installation",
-            "preparing-failed",
-            "preparing-successful",
-            "pending-for-input",
-            "installing",
-            "installing-in-progress",
-            "installing-pending-user-action",
-            "resetting-pending-user-action",
-            "installed",
-            "error",
-            "resetting",
-            "added-to-existing-cluster",
-            "cancelled",
-            "binding",
-            "unbinding",
-            "unbinding-pending-user-action",
-            "known-unbound",
-            "disconnected-unbound",
-            "insufficient-unbound",
-            "disabled-unbound",
-            "discovering-unbound",
-            "reclaiming",
-            "reclaiming-rebooting"
-          ]
+        "coreos_image": {
+          "description": "CoreOS container image to use if installing to the local device",
+          "type": "string"
         },
-        "status_info": {
+        "disks_to_format": {
+          "description": "List of disks to format",
+          "type": "array",
+          "items": {
+            "description": "Disk to format",
+            "type": "string"
+          }
+        },
+        "enable_skip_mco_reboot": {
+          "description": "If true, assisted service will attempt to skip MCO reboot",
+          "type": "boolean"
+        },
+        "host_id": {
+          "description": "Host id",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+          "format": "uuid"
         },
-        "status_updated_at": {
-          "description": "The last time that the host status was updated.",
+        "infra_env_id": {
+          "description": "Infra env id",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "format": "uuid"
         },
-        "suggested_role": {
-          "$ref": "#/definitions/host-role"
+        "installer_args": {
+          "description": "Core-os installer addtional args",
+          "type": "string"
         },
-        "tang_connectivity": {
+        "installer_image": {
+          "description": "Assisted installer image",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "timestamp": {
-          "description": "The time on the host as seconds since the Unix epoch.",
-          "type": "integer"
+          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
         },
-        "updated_at": {
+        "mco_image": {
+          "description": "Machine config operator image",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
-          }
+          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+        },
+        "must_gather_image": {
+          "description": "Must-gather images to use",
+          "type": "string"
+        },
+        "notify_num_reboots": {
+          "description": "If true, notify number of reboots by assisted controller",
+          "type": "boolean"
         },
-        "user_name": {
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
           "type": "string"
         },
-        "validations_info": {
-          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "proxy": {
+          "$ref": "#/definitions/proxy"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "service_ips": {
+          "description": "List of service ips",
+          "type": "array",
+          "items": {
+            "description": "Service ip.",
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          }
+        },
+        "skip_installation_disk_cleanup": {
+          "description": "Skip formatting installation disk",
+          "type": "boolean"
         }
       }
     },
-    "host-create-params": {
+    "installer-args-params": {
+      "type": "object",
+      "properties": {
+        "args": {
+          "description": "List of additional arguments passed to coreos-installer",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "--append-karg",
+            "ip=192.0.2.2::192.0.2.254:255.255.255.0:core0.example.com:enp1s0:none",
+            "--save-partindex",
+            "1",
+            "-n"
+          ]
+        }
+      }
+    },
+    "installer-cache-prewarm-params": {
       "type": "object",
       "required": [
-        "host_id"
+        "release_image"
       ],
       "properties": {
-        "discovery_agent_version": {
+        "pinned": {
+          "description": "Whether to pin the release, so that it is never evicted.",
+          "type": "boolean",
+          "default": false
+        },
+        "pull_secret": {
+          "description": "The pull secret of the release image. It is only used for the extraction and is not stored.",
           "type": "string"
         },
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
+        "release_image": {
+          "description": "The release image to extract the installer binary from.",
+          "type": "string"
+        },
+        "release_image_mirror": {
+          "description": "A mirror of the release image to extract the installer binary from instead.",
+          "type": "string"
         }
       }
     },
-    "host-ignition-params": {
+    "installer-cache-release": {
+      "description": "A release in the installer cache.",
+      "type": "object",
       "properties": {
-        "config": {
+        "binary": {
+          "description": "The name of the installer binary.",
+          "type": "string"
+        },
+        "cached": {
+          "description": "Whether the installer binary is in the local cache of the replica that served the request.",
+          "type": "boolean"
+        },
+        "last_used_at": {
+          "description": "The last time the installer binary was used.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "openshift_version": {
+          "description": "The OpenShift version of the release, if known.",
+          "type": "string"
+        },
+        "pinned": {
+          "description": "Whether the release is pinned, in which case it is never evicted.",
+          "type": "boolean"
+        },
+        "prewarm_status": {
+          "description": "The status of the pre-warming of the release, empty if it wasn't pre-warmed.",
+          "type": "string",
+          "enum": [
+            "pending",
+            "prewarming",
+            "ready",
+            "failed"
+          ]
+        },
+        "prewarm_status_info": {
+          "description": "Additional information about the status of the pre-warming.",
+          "type": "string"
+        },
+        "release_digest": {
+          "description": "The digest of the release image, which keys the binaries of the shared cache.",
+          "type": "string"
+        },
+        "release_image": {
+          "description": "The release image the installer binary is extracted from. Empty for binaries of the shared cache whose release image is unknown.",
           "type": "string"
+        },
+        "shared": {
+          "description": "Whether the installer binary is stored in the shared cache.",
+          "type": "boolean"
+        },
+        "size_bytes": {
+          "description": "The size of the installer binary.",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "host-list": {
+    "installer-cache-release-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/host"
+        "$ref": "#/definitions/installer-cache-release"
       }
     },
-    "host-progress": {
+    "installer-cache-release-update-params": {
       "type": "object",
+      "required": [
+        "release_image",
+        "pinned"
+      ],
       "properties": {
-        "current_stage": {
-          "$ref": "#/definitions/host-stage"
+        "pinned": {
+          "description": "Whether the release is pinned, in which case it is never evicted.",
+          "type": "boolean"
         },
-        "progress_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        "release_image": {
+          "description": "The release image that was pre-warmed.",
+          "type": "string"
         }
       }
     },
-    "host-progress-info": {
+    "interface": {
       "type": "object",
       "properties": {
-        "current_stage": {
-          "$ref": "#/definitions/host-stage"
+        "biosdevname": {
+          "type": "string"
         },
-        "installation_percentage": {
+        "client_id": {
+          "type": "string"
+        },
+        "flags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "has_carrier": {
+          "type": "boolean"
+        },
+        "ipv4_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ipv6_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mac_address": {
+          "type": "string"
+        },
+        "mtu": {
           "type": "integer"
         },
-        "progress_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        "name": {
+          "type": "string"
         },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "product": {
+          "type": "string"
         },
-        "stage_timed_out": {
-          "description": "Indicate of the current stage has been timed out.",
-          "type": "boolean"
+        "speed_mbps": {
+          "type": "integer"
         },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "type": {
+          "type": "string"
+        },
+        "vendor": {
+          "type": "string"
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
-    },
-    "host-role": {
-      "type": "string",
-      "enum": [
-        "auto-assign",
-        "master",
-        "arbiter",
-        "worker",
-        "bootstrap"
-      ]
+      }
     },
-    "host-role-update-params": {
-      "type": "string",
-      "enum": [
-        "auto-assign",
-        "master",
-        "arbiter",
-        "worker"
-      ]
+    "inventory": {
+      "type": "object",
+      "properties": {
+        "bios": {
+          "$ref": "#/definitions/bios"
+        },
+        "bmc_address": {
+          "type": "string"
+        },
+        "bmc_v6address": {
+          "type": "string"
+        },
+        "boot": {
+          "$ref": "#/definitions/boot"
+        },
+        "cpu": {
+          "$ref": "#/definitions/cpu"
+        },
+        "disks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/disk"
+          }
+        },
+        "gpus": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/gpu"
+          }
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "interfaces": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/interface"
+          }
+        },
+        "memory": {
+          "$ref": "#/definitions/memory"
+        },
+        "routes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/route"
+          }
+        },
+        "system_vendor": {
+          "$ref": "#/definitions/system_vendor"
+        },
+        "tpm_version": {
+          "type": "string",
+          "enum": [
+            "none",
+            "1.2",
+            "2.0"
+          ]
+        }
+      }
     },
-    "host-stage": {
-      "type": "string",
-      "enum": [
-        "Starting installation",
-        "Waiting for control plane",
-        "Waiting for bootkube",
-        "Waiting for controller",
-        "Installing",
-        "Writing image to disk",
-        "Copying registry data to disk",
-        "Rebooting",
-        "Waiting for ignition",
-        "Configuring",
-        "Joined",
-        "Done",
-        "Failed"
-      ]
+    "io_perf": {
+      "type": "object",
+      "properties": {
+        "sync_duration": {
+          "description": "99th percentile of fsync duration in milliseconds",
+          "type": "integer"
+        }
+      }
     },
-    "host-type-hardware-requirements": {
+    "ip": {
+      "type": "string",
+      "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
+      "x-go-custom-tag": "gorm:\"primaryKey;type:inet\""
+    },
+    "iscsi": {
       "type": "object",
       "properties": {
-        "qualitative": {
-          "description": "Host requirements that cannot be quantified at the time of calculation. Descriptions or formulas of requiements",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "quantitative": {
-          "description": "Host requirements that can be quantified",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+        "host_ip_address": {
+          "description": "Host IP address used to reach iSCSI target",
+          "type": "string"
         }
       }
     },
-    "host-type-hardware-requirements-wrapper": {
+    "kernel_argument": {
+      "description": "pair of [operation, argument] specifying the argument and what operation should be applied on it.",
       "type": "object",
       "properties": {
-        "master": {
-          "description": "Requirements towards a master node",
-          "$ref": "#/definitions/host-type-hardware-requirements"
+        "operation": {
+          "description": "The operation to apply on the kernel argument.",
+          "type": "string",
+          "enum": [
+            "append",
+            "replace",
+            "delete"
+          ]
         },
-        "worker": {
-          "description": "Requirements towards a worker node",
-          "$ref": "#/definitions/host-type-hardware-requirements"
+        "value": {
+          "description": "Kernel argument can have the form \u003cparameter\u003e or \u003cparameter\u003e=\u003cvalue\u003e. The following examples should\nbe supported:\nrd.net.timeout.carrier=60\nisolcpus=1,2,10-20,100-2000:2/25\nquiet\nThe parsing by the command line parser in linux kernel is much looser and this pattern follows it.\n",
+          "type": "string",
+          "pattern": "^(?:(?:[^ \\t\\n\\r\"]+)|(?:\"[^\"]*\"))+$"
         }
       }
     },
-    "host-update-params": {
+    "kernel_arguments": {
+      "description": "List of kernel arugment objects that define the operations and values to be applied.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/kernel_argument"
+      },
+      "x-omitempty": false
+    },
+    "l2-connectivity": {
       "type": "object",
       "properties": {
-        "disks_selected_config": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/disk-config-params"
-          },
-          "x-nullable": true
+        "outgoing_ip_address": {
+          "type": "string"
         },
-        "disks_skip_formatting": {
-          "description": "Allows changing the host's skip_formatting_disks parameter",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/disk-skip-formatting-params"
-          },
-          "x-nullable": true
+        "outgoing_nic": {
+          "type": "string"
         },
-        "fencing_credentials": {
-          "description": "The host's BMC credentials that will be used in TNF.",
-          "x-nullable": true,
-          "$ref": "#/definitions/fencing-credentials-params"
+        "remote_ip_address": {
+          "type": "string"
         },
-        "host_name": {
-          "type": "string",
-          "x-nullable": true
+        "remote_mac": {
+          "type": "string"
         },
-        "host_role": {
-          "type": "string",
-          "enum": [
-            "auto-assign",
-            "master",
-            "arbiter",
-            "worker"
-          ],
-          "x-nullable": true
+        "successful": {
+          "type": "boolean"
+        }
+      }
+    },
+    "l3-connectivity": {
+      "type": "object",
+      "properties": {
+        "average_rtt_ms": {
+          "description": "Average round trip time in milliseconds.",
+          "type": "number",
+          "format": "double",
+          "x-go-name": "AverageRTTMs"
         },
-        "ignition_endpoint_http_headers": {
-          "description": "JSON-formatted string of additional HTTP headers when fetching the ignition.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ignition-endpoint-http-headers-params"
-          },
-          "x-nullable": true
+        "outgoing_nic": {
+          "type": "string"
         },
-        "ignition_endpoint_token": {
-          "description": "A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url.",
-          "type": "string",
-          "x-nullable": true
+        "packet_loss_percentage": {
+          "description": "Percentage of packets lost during connectivity check.",
+          "type": "number",
+          "format": "double"
         },
-        "machine_config_pool_name": {
+        "remote_ip_address": {
+          "type": "string"
+        },
+        "successful": {
+          "type": "boolean"
+        }
+      }
+    },
+    "last-installation-preparation": {
+      "description": "Gives the status of the last installation preparation (if any)",
+      "type": "object",
+      "properties": {
+        "reason": {
+          "description": "The reason for the preparation status if applicable",
+          "type": "string"
+        },
+        "status": {
+          "description": "The last installation preparation status",
           "type": "string",
-          "x-nullable": true
+          "default": "not_started",
+          "enum": [
+            "not_started",
+            "failed",
+            "success"
+          ],
+          "x-nullable": false
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:last_installation_preparation_\"",
+      "x-nullable": false
+    },
+    "list-managed-domains": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/managed-domain"
+      }
+    },
+    "list-manifests": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/manifest"
+      }
+    },
+    "list-versions": {
+      "type": "object",
+      "properties": {
+        "release_tag": {
+          "type": "string"
         },
-        "node_labels": {
-          "description": "Labels to be added to the corresponding node.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/node-label-params"
-          },
-          "x-nullable": true
+        "versions": {
+          "$ref": "#/definitions/versions"
         }
       }
     },
-    "host-validation-id": {
-      "type": "string",
-      "enum": [
-        "connected",
-        "media-connected",
-        "has-inventory",
-        "has-min-cpu-cores",
-        "has-min-valid-disks",
-        "has-min-memory",
-        "machine-cidr-defined",
-        "has-cpu-cores-for-role",
-        "has-memory-for-role",
-        "hostname-unique",
-        "hostname-valid",
-        "belongs-to-machine-cidr",
-        "ignition-downloadable",
-        "belongs-to-majority-group",
-        "valid-platform-network-settings",
-        "ntp-synced",
-        "time-synced-between-host-and-service",
-        "container-images-available",
-        "lso-requirements-satisfied",
-        "ocs-requirements-satisfied",
-        "odf-requirements-satisfied",
-        "lvm-requirements-satisfied",
-        "mce-requirements-satisfied",
-        "mtv-requirements-satisfied",
-        "osc-requirements-satisfied",
-        "sufficient-installation-disk-speed",
-        "cnv-requirements-satisfied",
-        "sufficient-network-latency-requirement-for-role",
-        "sufficient-packet-loss-requirement-for-role",
-        "has-default-route",
-        "api-domain-name-resolved-correctly",
-        "api-int-domain-name-resolved-correctly",
-        "apps-domain-name-resolved-correctly",
-        "release-domain-name-resolved-correctly",
-        "compatible-with-cluster-platform",
-        "dns-wildcard-not-configured",
-        "disk-encryption-requirements-satisfied",
-        "non-overlapping-subnets",
-        "vsphere-disk-uuid-enabled",
-        "compatible-agent",
-        "no-skip-installation-disk",
-        "no-skip-missing-disk",
-        "no-ip-collisions-in-network",
-        "no-iscsi-nic-belongs-to-machine-cidr",
-        "node-feature-discovery-requirements-satisfied",
-        "nvidia-gpu-requirements-satisfied",
-        "pipelines-requirements-satisfied",
-        "servicemesh-requirements-satisfied",
-        "serverless-requirements-satisfied",
-        "openshift-ai-requirements-satisfied",
-        "authorino-requirements-satisfied",
-        "mtu-valid",
-        "nmstate-requirements-satisfied",
-        "amd-gpu-requirements-satisfied",
-        "kmm-requirements-satisfied",
-        "node-healthcheck-requirements-satisfied",
-        "self-node-remediation-requirements-satisfied",
-        "fence-agents-remediation-requirements-satisfied",
-        "node-maintenance-requirements-satisfied",
-        "kube-descheduler-requirements-satisfied",
-        "cluster-observability-requirements-satisfied",
-        "numa-resources-requirements-satisfied",
-        "oadp-requirements-satisfied",
-        "metallb-requirements-satisfied",
-        "loki-requirements-satisfied",
-        "openshift-logging-requirements-satisfied"
-      ]
+    "load_balancer": {
+      "type": "object",
+      "properties": {
+        "type": {
+          "description": "Indicates if the load balancer will be managed by the cluster or by the user. This is optional and The\ndefault is ` + "`" + `cluster-managed` + "`" + `.\n\n` + "`" + `cluster-managed` + "`" + ` means that the cluster will start the components that assign the API and ingress VIPs to the\nnodes of the cluster automatically.\n\n` + "`" + `user-managed` + "`" + ` means that the user is responsible for configuring an external load balancer and assign the\nAPI and ingress VIPs to it. Note that this configuration needs to be completed before starting the\ninstallation of the cluster, as it is needed during the installation process.\n",
+          "type": "string",
+          "enum": [
+            "cluster-managed",
+            "user-managed"
+          ],
+          "x-go-custom-tag": "gorm:\"not null;check:load_balancer_type in ('cluster-managed', 'user-managed');default:'cluster-managed'\""
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:load_balancer_\""
     },
-    "host_network": {
+    "logs-progress-params": {
       "type": "object",
+      "required": [
+        "logs_state"
+      ],
       "properties": {
-        "cidr": {
-          "type": "string"
+        "logs_state": {
+          "description": "The state of collecting logs.",
+          "$ref": "#/definitions/logs_state"
+        }
+      }
+    },
+    "logs_gather_cmd_request": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "infra_env_id",
+        "host_id",
+        "bootstrap",
+        "installer_gather"
+      ],
+      "properties": {
+        "bootstrap": {
+          "description": "Host is bootstrap or not",
+          "type": "boolean"
         },
-        "host_ids": {
+        "cluster_id": {
+          "description": "Cluster id",
+          "type": "string",
+          "format": "uuid"
+        },
+        "host_id": {
+          "description": "Host id",
+          "type": "string",
+          "format": "uuid"
+        },
+        "infra_env_id": {
+          "description": "Infra env id",
+          "type": "string",
+          "format": "uuid"
+        },
+        "installer_gather": {
+          "description": "Run installer gather logs",
+          "type": "boolean",
+          "default": true
+        },
+        "master_ips": {
+          "description": "List of master ips",
           "type": "array",
           "items": {
+            "description": "Master ip.",
             "type": "string",
-            "format": "uuid"
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
           }
         }
       }
     },
-    "host_registration_response": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/host"
-        },
-        {
-          "properties": {
-            "next_step_runner_command": {
-              "description": "Command for starting the next step runner",
-              "type": "object",
-              "properties": {
-                "args": {
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                },
-                "command": {
-                  "type": "string"
-                },
-                "retry_seconds": {
-                  "description": "How long in seconds to wait before retrying registration if the command fails",
-                  "type": "integer"
-                }
-              }
-            }
+    "logs_state": {
+      "type": "string",
+      "enum": [
+        "requested",
+        "collecting",
+        "completed",
+        "timeout",
+        ""
+      ],
+      "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+    },
+    "logs_type": {
+      "type": "string",
+      "enum": [
+        "host",
+        "controller",
+        "all",
+        ""
+      ]
+    },
+    "mac_interface_map": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "logical_nic_name": {
+            "description": "nic name used in the yaml, which relates 1:1 to the mac address",
+            "type": "string"
+          },
+          "mac_address": {
+            "description": "mac address present on the host",
+            "type": "string",
+            "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
           }
         }
-      ]
+      }
     },
-    "host_static_network_config": {
+    "machine_network": {
+      "description": "A network that all hosts belonging to the cluster should have an interface with IP address in. The VIPs (if exist) belong to this network.",
       "type": "object",
       "properties": {
-        "mac_interface_map": {
-          "description": "mapping of host macs to logical interfaces used in the network yaml",
-          "$ref": "#/definitions/mac_interface_map"
+        "cidr": {
+          "description": "The IP block address pool.",
+          "$ref": "#/definitions/subnet"
         },
-        "network_yaml": {
-          "description": "yaml string that can be processed by nmstate",
-          "type": "string"
+        "cluster_id": {
+          "description": "The cluster that this network is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         }
       }
     },
-    "ignition-endpoint": {
-      "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+    "managed-domain": {
       "type": "object",
       "properties": {
-        "ca_certificate": {
-          "description": "base64 encoded CA certficate to be used when contacting the URL via https.",
-          "type": "string",
-          "x-nullable": true
+        "domain": {
+          "type": "string"
         },
-        "url": {
-          "description": "The URL for the ignition endpoint.",
+        "provider": {
           "type": "string",
-          "x-nullable": true
+          "enum": [
+            "route53"
+          ]
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:ignition_endpoint_\""
+      }
     },
-    "ignition-endpoint-http-headers-params": {
+    "manifest": {
       "type": "object",
-      "required": [
-        "key",
-        "value"
-      ],
       "properties": {
-        "key": {
-          "description": "The key for the http header's key-value pair.",
+        "file_name": {
+          "description": "The file name prefaced by the folder that contains it.",
           "type": "string"
         },
-        "value": {
-          "description": "The value for the http header's key-value pair.",
-          "type": "string"
+        "folder": {
+          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+          "type": "string",
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
+        },
+        "manifest_source": {
+          "description": "Describes whether manifest is sourced from a user or created by the system.",
+          "type": "string",
+          "enum": [
+            "user",
+            "system"
+          ]
         }
       }
     },
-    "ignored-validations": {
+    "memory": {
       "type": "object",
       "properties": {
-        "cluster-validation-ids": {
-          "description": "JSON-formatted list of cluster validation IDs that will be ignored for all hosts that belong to this cluster. It may also contain a list with a single string \"all\" to ignore all cluster validations. Some validations cannot be ignored.",
-          "type": "string",
-          "format": "string"
+        "physical_bytes": {
+          "type": "integer"
         },
-        "host-validation-ids": {
-          "description": "JSON-formatted list of host validation IDs that will be ignored for all hosts that belong to this cluster. It may also contain a list with a single string \"all\" to ignore all host validations. Some validations cannot be ignored.",
-          "type": "string",
-          "format": "string"
+        "physical_bytes_method": {
+          "description": "The method by which the physical memory was set",
+          "$ref": "#/definitions/memory_method"
+        },
+        "usable_bytes": {
+          "type": "integer"
         }
       }
     },
-    "image-create-params": {
+    "memory_method": {
+      "type": "string",
+      "enum": [
+        "dmidecode",
+        "ghw",
+        "meminfo"
+      ]
+    },
+    "monitored-operator": {
       "type": "object",
       "properties": {
-        "image_type": {
-          "description": "Type of image that should be generated.",
-          "$ref": "#/definitions/image_type"
+        "bundles": {
+          "description": "List of identifier of the bundles associated with the operator. Can be empty.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
+          }
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
+        "cluster_id": {
+          "description": "The cluster that this operator is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "dependency_only": {
+          "description": "Whether the operator can't be installed without being required by another operator.",
+          "type": "boolean"
+        },
+        "name": {
+          "description": "Unique name of the operator.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "namespace": {
+          "description": "Namespace where to deploy an operator. Only some operators require a namespace.",
           "type": "string"
         },
-        "static_network_config": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_static_network_config"
-          }
-        }
-      }
-    },
-    "image_info": {
-      "type": "object",
-      "properties": {
-        "created_at": {
+        "operator_type": {
+          "$ref": "#/definitions/operator-type"
+        },
+        "properties": {
+          "description": "Blob of operator-dependent parameters that are required for installation.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
-          }
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "download_url": {
+        "status": {
+          "$ref": "#/definitions/operator-status"
+        },
+        "status_info": {
+          "description": "Detailed information about the operator state.",
           "type": "string"
         },
-        "expires_at": {
+        "status_updated_at": {
+          "description": "Time at which the operator was last updated.",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "generator_version": {
-          "description": "Image generator version.",
+        "subscription_name": {
+          "description": "The name of the subscription of the operator.",
           "type": "string"
         },
-        "size_bytes": {
+        "timeout_seconds": {
+          "description": "Positive number represents a timeout in seconds for the operator to be available.",
           "type": "integer"
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string"
-        },
-        "static_network_config": {
-          "description": "static network configuration string in the format expected by discovery ignition generation",
+        "version": {
+          "description": "Operator version",
           "type": "string"
-        },
-        "type": {
-          "$ref": "#/definitions/image_type"
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:image_\""
+      }
     },
-    "image_type": {
-      "type": "string",
-      "enum": [
-        "full-iso",
-        "minimal-iso",
-        "disconnected-iso"
-      ]
+    "monitored-operators-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/monitored-operator"
+      }
     },
-    "import-cluster-params": {
+    "mtu-report": {
       "type": "object",
-      "required": [
-        "name",
-        "api_vip_dnsname",
-        "openshift_cluster_id"
-      ],
       "properties": {
-        "api_vip_dnsname": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
-          "type": "string"
+        "mtu_successful": {
+          "type": "boolean"
         },
-        "name": {
-          "description": "OpenShift cluster name.",
+        "outgoing_nic": {
           "type": "string"
         },
-        "openshift_cluster_id": {
-          "description": "The id of the OCP cluster, that hosts will be added to",
-          "type": "string",
-          "format": "uuid"
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+        "remote_ip_address": {
           "type": "string"
         }
       }
     },
-    "incompatibility-reason": {
-      "type": "string",
-      "enum": [
-        "cpuArchitecture",
-        "platform",
-        "openshiftVersion",
-        "ociExternalIntegrationDisabled"
-      ]
-    },
-    "infra-env": {
+    "network-topology": {
+      "description": "The network topology of the hosts of a cluster, as discovered by their connectivity checks.",
       "type": "object",
-      "required": [
-        "kind",
-        "id",
-        "href",
-        "name",
-        "type",
-        "updated_at",
-        "created_at"
-      ],
       "properties": {
-        "additional_ntp_sources": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string"
-        },
-        "additional_trust_bundle": {
-          "description": "PEM-encoded X.509 certificate bundle. Hosts discovered by this\ninfra-env will trust the certificates in this bundle. Clusters formed\nfrom the hosts discovered by this infra-env will also trust the\ncertificates in this bundle.",
-          "type": "string",
-          "x-nullable": false
-        },
         "cluster_id": {
-          "description": "If set, all hosts that register will be associated with the specified cluster.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\""
-        },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
           "type": "string",
-          "default": "x86_64",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x"
-          ],
-          "x-nullable": false
+          "format": "uuid"
         },
-        "created_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
+        "hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-host"
           }
         },
-        "download_url": {
-          "type": "string"
-        },
-        "email_domain": {
-          "type": "string"
-        },
-        "expires_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "links": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-link"
+          }
         },
-        "generator_version": {
-          "description": "Image generator version.",
-          "type": "string"
+        "majority_groups": {
+          "description": "The majority groups of the cluster, by CIDR for L2 connectivity and by address family (IPv4, IPv6) for L3 connectivity.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "type": "string",
+              "format": "uuid"
+            }
+          }
         },
-        "href": {
-          "description": "Self link.",
+        "subnets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-subnet"
+          }
+        }
+      }
+    },
+    "network-topology-host": {
+      "type": "object",
+      "properties": {
+        "hostname": {
           "type": "string"
         },
         "id": {
-          "description": "Unique identifier of the object.",
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "ignition_config_override": {
-          "description": "Json formatted string containing the user overrides for the initial ignition config.",
-          "type": "string"
+          "format": "uuid"
         },
-        "kernel_arguments": {
-          "description": "JSON formatted string array representing the discovery image kernel arguments.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "x-nullable": true
+        "interfaces": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-interface"
+          }
         },
-        "kind": {
-          "description": "Indicates the type of this object.",
-          "type": "string",
-          "enum": [
-            "InfraEnv"
-          ]
+        "majority_groups": {
+          "description": "The majority groups that the host belongs to.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "name": {
-          "description": "Name of the infra-env.",
-          "type": "string"
+        "outside_majority_groups": {
+          "description": "The majority groups of the cluster that the host doesn't belong to.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster (used to infer the RHCOS version - temporary until generic logic implemented).",
-          "type": "string"
+        "role": {
+          "$ref": "#/definitions/host-role"
         },
-        "org_id": {
+        "status": {
           "type": "string"
+        }
+      }
+    },
+    "network-topology-interface": {
+      "type": "object",
+      "properties": {
+        "ipv4_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "proxy": {
-          "$ref": "#/definitions/proxy"
-        },
-        "pull_secret_set": {
-          "description": "True if the pull secret has been added to the cluster.",
-          "type": "boolean"
+        "ipv6_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "rendezvous_ip": {
-          "description": "The IP address of the host that will act as the rendezvous (bootstrap) node for agent-based installations.\nThis is optional for disconnected-iso image type and specifies which host will run the assisted service\nduring the bootstrap phase. All other hosts will connect to this IP to coordinate the installation.",
-          "type": "string",
-          "pattern": "^(?:$|(?:(?:(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\\.){3}(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])|(?:([0-9a-fA-F]{1,4}:){7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:(:[0-9a-fA-F]{1,4}){1,6}|:(:[0-9a-fA-F]{1,4}){1,7}|:|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9]))))$",
-          "x-nullable": true
+        "mac_address": {
+          "type": "string"
         },
-        "size_bytes": {
+        "mtu": {
           "type": "integer"
         },
-        "ssh_authorized_key": {
-          "description": "SSH public key for debugging the installation.",
+        "name": {
           "type": "string"
         },
-        "static_network_config": {
-          "description": "static network configuration string in the format expected by discovery ignition generation.",
+        "parent": {
+          "description": "The interface that a VLAN interface is defined on.",
           "type": "string"
         },
-        "type": {
-          "$ref": "#/definitions/image_type"
-        },
-        "updated_at": {
-          "description": "The last time that this infra-env was updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
-          }
+        "speed_mbps": {
+          "type": "integer"
         },
-        "user_name": {
+        "type": {
+          "description": "The type of the interface, such as physical, bond or vlan.",
           "type": "string"
+        },
+        "vlan_id": {
+          "description": "The VLAN ID of a VLAN interface.",
+          "type": "integer"
         }
       }
     },
-    "infra-env-create-params": {
+    "network-topology-link": {
+      "description": "The result of a connectivity check from an interface of a host to an address of another host.",
       "type": "object",
-      "required": [
-        "name",
-        "pull_secret"
-      ],
       "properties": {
-        "additional_ntp_sources": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "additional_trust_bundle": {
-          "description": "PEM-encoded X.509 certificate bundle. Hosts discovered by this\ninfra-env will trust the certificates in this bundle. Clusters formed\nfrom the hosts discovered by this infra-env will also trust the\ncertificates in this bundle.",
-          "type": "string",
-          "maxLength": 65535,
-          "x-nullable": false
+        "average_rtt_ms": {
+          "type": "number"
         },
-        "cluster_id": {
-          "description": "If set, all hosts that register will be associated with the specified cluster.",
-          "type": "string",
-          "format": "uuid",
-          "x-nullable": true
+        "packet_loss_percentage": {
+          "type": "number"
         },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+        "source_host_id": {
           "type": "string",
-          "default": "x86_64",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x"
-          ],
-          "x-nullable": false
+          "format": "uuid"
         },
-        "ignition_config_override": {
-          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
+        "source_interface": {
           "type": "string"
         },
-        "image_type": {
-          "$ref": "#/definitions/image_type"
-        },
-        "kernel_arguments": {
-          "$ref": "#/definitions/kernel_arguments"
-        },
-        "name": {
-          "description": "Name of the infra-env.",
-          "type": "string"
+        "successful": {
+          "type": "boolean"
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster (used to infer the RHCOS version - temporary until generic logic implemented).",
+        "target_address": {
           "type": "string"
         },
-        "proxy": {
-          "$ref": "#/definitions/proxy"
+        "target_host_id": {
+          "type": "string",
+          "format": "uuid"
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+        "target_interface": {
+          "description": "The interface of the target host that has the target address, if it is known.",
           "type": "string"
         },
-        "rendezvous_ip": {
-          "description": "The IP address of the host that will act as the rendezvous (bootstrap) node for agent-based installations.\nThis is optional for disconnected-iso image type and specifies which host will run the assisted service\nduring the bootstrap phase. All other hosts will connect to this IP to coordinate the installation.",
-          "type": "string",
-          "pattern": "^(?:$|(?:(?:(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\\.){3}(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])|(?:([0-9a-fA-F]{1,4}:){7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:(:[0-9a-fA-F]{1,4}){1,6}|:(:[0-9a-fA-F]{1,4}){1,7}|:|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9]))))$",
-          "x-nullable": true
+        "target_mac_address": {
+          "description": "The MAC address that answered an L2 check.",
+          "type": "string"
         },
-        "ssh_authorized_key": {
-          "description": "SSH public key for debugging the installation.",
+        "type": {
           "type": "string",
-          "x-nullable": true
+          "enum": [
+            "l2",
+            "l3"
+          ]
+        }
+      }
+    },
+    "network-topology-subnet": {
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "type": "string"
         },
-        "static_network_config": {
+        "host_ids": {
+          "description": "The hosts that have an address in the subnet.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/host_static_network_config"
+            "type": "string",
+            "format": "uuid"
           }
+        },
+        "machine_network": {
+          "description": "Whether the subnet is a machine network of the cluster.",
+          "type": "boolean"
         }
       }
     },
-    "infra-env-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/infra-env"
-      }
-    },
-    "infra-env-update-params": {
+    "next_step_cmd_request": {
       "type": "object",
+      "required": [
+        "infra_env_id",
+        "host_id",
+        "agent_version"
+      ],
       "properties": {
-        "additional_ntp_sources": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+        "agent_version": {
+          "description": "Agent image version",
           "type": "string",
-          "x-nullable": true
+          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
         },
-        "additional_trust_bundle": {
-          "description": "Allows users to change the additional_trust_bundle infra-env field",
+        "host_id": {
+          "description": "Host id",
           "type": "string",
-          "maxLength": 65535,
-          "x-nullable": true
+          "format": "uuid"
         },
-        "ignition_config_override": {
-          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
+        "infra_env_id": {
+          "description": "Infra env id",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "node-label-params": {
+      "type": "object",
+      "required": [
+        "key",
+        "value"
+      ],
+      "properties": {
+        "key": {
+          "description": "The key for the label's key-value pair.",
           "type": "string"
         },
-        "image_type": {
-          "$ref": "#/definitions/image_type"
-        },
-        "kernel_arguments": {
-          "$ref": "#/definitions/kernel_arguments"
+        "value": {
+          "description": "The value for the label's key-value pair.",
+          "type": "string"
+        }
+      }
+    },
+    "ntp_source": {
+      "type": "object",
+      "properties": {
+        "source_name": {
+          "description": "NTP source name or IP.",
+          "type": "string"
         },
-        "openshift_version": {
-          "description": "Version of the OS image",
-          "type": "string",
-          "x-nullable": true
+        "source_state": {
+          "description": "Indication of state of an NTP source.",
+          "$ref": "#/definitions/source_state"
+        }
+      }
+    },
+    "ntp_synchronization_request": {
+      "type": "object",
+      "required": [
+        "ntp_source"
+      ],
+      "properties": {
+        "ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        }
+      }
+    },
+    "ntp_synchronization_response": {
+      "properties": {
+        "ntp_sources": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ntp_source"
+          }
+        }
+      }
+    },
+    "openshift-version": {
+      "type": "object",
+      "required": [
+        "display_name",
+        "support_level",
+        "cpu_architectures"
+      ],
+      "properties": {
+        "cpu_architectures": {
+          "description": "Available CPU architectures.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "proxy": {
-          "$ref": "#/definitions/proxy"
+        "default": {
+          "description": "Indication that the version is the recommended one.",
+          "type": "boolean"
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+        "display_name": {
+          "description": "Name of the version to be presented to the user.",
           "type": "string"
         },
-        "rendezvous_ip": {
-          "description": "The IP address of the host that will act as the rendezvous (bootstrap) node for agent-based installations.\nThis is optional for disconnected-iso image type and specifies which host will run the assisted service\nduring the bootstrap phase. All other hosts will connect to this IP to coordinate the installation.",
+        "support_level": {
+          "description": "Level of support of the version.",
           "type": "string",
-          "pattern": "^(?:$|(?:(?:(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\\.){3}(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])|(?:([0-9a-fA-F]{1,4}:){7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:(:[0-9a-fA-F]{1,4}){1,6}|:(:[0-9a-fA-F]{1,4}){1,7}|:|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9]))))$",
-          "x-nullable": true
+          "enum": [
+            "beta",
+            "production",
+            "maintenance",
+            "end-of-life"
+          ]
+        }
+      }
+    },
+    "openshift-versions": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/openshift-version"
+      }
+    },
+    "operator": {
+      "type": "object",
+      "required": [
+        "feature-support-level-id",
+        "support_level",
+        "incompatibilities",
+        "name",
+        "dependencies"
+      ],
+      "properties": {
+        "dependencies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/feature-support-level-id"
+          }
         },
-        "ssh_authorized_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string",
-          "x-nullable": true
+        "feature-support-level-id": {
+          "$ref": "#/definitions/feature-support-level-id"
         },
-        "static_network_config": {
+        "incompatibilities": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/host_static_network_config"
+            "$ref": "#/definitions/feature-support-level-id"
           }
+        },
+        "name": {
+          "description": "Name of the operator",
+          "type": "string"
+        },
+        "reason": {
+          "$ref": "#/definitions/incompatibility-reason"
+        },
+        "support_level": {
+          "$ref": "#/definitions/support-level"
         }
       }
     },
-    "infra_error": {
+    "operator-create-params": {
       "type": "object",
-      "required": [
-        "code",
-        "message"
-      ],
       "properties": {
-        "code": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 403,
-          "minimum": 401
-        },
-        "message": {
-          "description": "Human-readable description of the error.",
+        "name": {
           "type": "string"
+        },
+        "properties": {
+          "description": "Blob of operator-dependent parameters that are required for installation.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         }
       }
     },
-    "ingress-cert-params": {
-      "type": "string"
-    },
-    "ingress_vip": {
-      "description": "The virtual IP used for cluster ingress traffic.",
+    "operator-hardware-requirements": {
       "type": "object",
       "properties": {
-        "cluster_id": {
-          "description": "The cluster that this VIP is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
+        "dependencies": {
+          "description": "List of other operator unique names that are required to be installed. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "ip": {
-          "description": "The IP address.",
-          "$ref": "#/definitions/ip"
+        "operator_name": {
+          "description": "Unique name of the operator. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
+          "type": "string"
         },
-        "verification": {
-          "description": "Ingress VIP verification result.",
-          "$ref": "#/definitions/vip_verification"
+        "requirements": {
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
         }
       }
     },
-    "install_cmd_request": {
+    "operator-host-requirements": {
       "type": "object",
-      "required": [
-        "cluster_id",
-        "infra_env_id",
-        "host_id",
-        "role",
-        "boot_device",
-        "controller_image",
-        "installer_image"
-      ],
       "properties": {
-        "boot_device": {
-          "description": "Boot device to write image on",
+        "operator_name": {
+          "description": "Name of the operator",
           "type": "string"
         },
-        "check_cvo": {
-          "description": "Check CVO status if needed",
-          "type": "boolean",
-          "default": true
-        },
-        "cluster_id": {
-          "description": "Cluster id",
-          "type": "string",
-          "format": "uuid"
-        },
-        "control_plane_count": {
-          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
-          "type": "integer"
-        },
-        "controller_image": {
-          "description": "Assisted installer controller image",
-          "type": "string",
-          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
-        },
-        "coreos_image": {
-          "description": "CoreOS container image to use if installing to the local device",
+        "requirements": {
+          "description": "Host requirements for the operator",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        }
+      }
+    },
+    "operator-monitor-report": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "Unique name of the operator.",
           "type": "string"
         },
-        "disks_to_format": {
-          "description": "List of disks to format",
-          "type": "array",
-          "items": {
-            "description": "Disk to format",
-            "type": "string"
-          }
-        },
-        "enable_skip_mco_reboot": {
-          "description": "If true, assisted service will attempt to skip MCO reboot",
-          "type": "boolean"
-        },
-        "host_id": {
-          "description": "Host id",
-          "type": "string",
-          "format": "uuid"
-        },
-        "infra_env_id": {
-          "description": "Infra env id",
-          "type": "string",
-          "format": "uuid"
+        "status": {
+          "$ref": "#/definitions/operator-status"
         },
-        "installer_args": {
-          "description": "Core-os installer addtional args",
+        "status_info": {
+          "description": "Detailed information about the operator state.",
           "type": "string"
         },
-        "installer_image": {
-          "description": "Assisted installer image",
+        "version": {
+          "description": "operator version.",
+          "type": "string"
+        }
+      }
+    },
+    "operator-properties": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/operator-property"
+      }
+    },
+    "operator-property": {
+      "type": "object",
+      "properties": {
+        "data_type": {
+          "description": "Type of the property",
           "type": "string",
-          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+          "enum": [
+            "boolean",
+            "string",
+            "integer",
+            "float"
+          ]
         },
-        "mco_image": {
-          "description": "Machine config operator image",
-          "type": "string",
-          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+        "default_value": {
+          "description": "Default value for the property",
+          "type": "string"
         },
-        "must_gather_image": {
-          "description": "Must-gather images to use",
+        "description": {
+          "description": "Description of a property",
           "type": "string"
         },
-        "notify_num_reboots": {
-          "description": "If true, notify number of reboots by assisted controller",
+        "mandatory": {
+          "description": "Indicates whether the property is reqired",
           "type": "boolean"
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+        "name": {
+          "description": "Name of the property",
           "type": "string"
         },
-        "proxy": {
-          "$ref": "#/definitions/proxy"
-        },
-        "role": {
-          "$ref": "#/definitions/host-role"
-        },
-        "service_ips": {
-          "description": "List of service ips",
+        "options": {
+          "description": "Values to select from",
           "type": "array",
           "items": {
-            "description": "Service ip.",
-            "type": "string",
-            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+            "type": "string"
           }
+        }
+      }
+    },
+    "operator-status": {
+      "description": "Represents the operator state.",
+      "type": "string",
+      "enum": [
+        "failed",
+        "progressing",
+        "available"
+      ]
+    },
+    "operator-type": {
+      "description": "Kind of operator. Different types are monitored by the service differently.",
+      "type": "string",
+      "enum": [
+        "builtin",
+        "olm"
+      ]
+    },
+    "os-image": {
+      "type": "object",
+      "required": [
+        "openshift_version",
+        "cpu_architecture",
+        "url",
+        "version"
+      ],
+      "properties": {
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x"
+          ],
+          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
+        },
+        "openshift_version": {
+          "description": "Version of the operating system image",
+          "type": "string",
+          "example": "4.12"
         },
-        "skip_installation_disk_cleanup": {
-          "description": "Skip formatting installation disk",
-          "type": "boolean"
+        "url": {
+          "description": "The base OS image used for the discovery iso.",
+          "type": "string"
+        },
+        "version": {
+          "description": "Build ID of the OS image.",
+          "type": "string"
         }
       }
     },
-    "installer-args-params": {
+    "os-images": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/os-image"
+      }
+    },
+    "platform": {
+      "description": "The configuration for the specific platform upon which to perform the installation.",
       "type": "object",
+      "required": [
+        "type"
+      ],
       "properties": {
-        "args": {
-          "description": "List of additional arguments passed to coreos-installer",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "example": [
-            "--append-karg",
-            "ip=192.0.2.2::192.0.2.254:255.255.255.0:core0.example.com:enp1s0:none",
-            "--save-partindex",
-            "1",
-            "-n"
-          ]
+        "external": {
+          "x-nullable": true,
+          "$ref": "#/definitions/platform_external"
+        },
+        "type": {
+          "$ref": "#/definitions/platform_type"
         }
-      }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:platform_\""
     },
-    "interface": {
+    "platform_external": {
+      "description": "Configuration used when installing with an external platform type.",
       "type": "object",
       "properties": {
-        "biosdevname": {
-          "type": "string"
-        },
-        "client_id": {
-          "type": "string"
-        },
-        "flags": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "has_carrier": {
-          "type": "boolean"
+        "cloud_controller_manager": {
+          "description": "When set to external, this property will enable an external cloud provider.",
+          "type": "string",
+          "default": "",
+          "enum": [
+            "",
+            "External"
+          ],
+          "x-nullable": true
         },
-        "ipv4_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "platform_name": {
+          "description": "Holds the arbitrary string representing the infrastructure provider name.",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": true
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:external_\""
+    },
+    "platform_type": {
+      "type": "string",
+      "enum": [
+        "baremetal",
+        "nutanix",
+        "vsphere",
+        "none",
+        "external"
+      ]
+    },
+    "preflight-hardware-requirements": {
+      "type": "object",
+      "properties": {
+        "ocp": {
+          "description": "Preflight OCP requirements",
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
         },
-        "ipv6_addresses": {
+        "operators": {
+          "description": "Preflight operators hardware requirements",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/operator-hardware-requirements"
           }
-        },
-        "mac_address": {
-          "type": "string"
-        },
-        "mtu": {
-          "type": "integer"
-        },
-        "name": {
-          "type": "string"
-        },
-        "product": {
-          "type": "string"
-        },
-        "speed_mbps": {
-          "type": "integer"
-        },
-        "type": {
-          "type": "string"
-        },
-        "vendor": {
-          "type": "string"
         }
       }
     },
-    "inventory": {
+    "presigned-url": {
       "type": "object",
+      "required": [
+        "url"
+      ],
       "properties": {
-        "bmc_address": {
-          "type": "string"
+        "expires_at": {
+          "description": "Expiration time for the URL token.",
+          "type": "string",
+          "format": "date-time"
         },
-        "bmc_v6address": {
+        "url": {
+          "description": "Pre-signed URL for downloading the infra-env discovery image.",
           "type": "string"
+        }
+      }
+    },
+    "proxy": {
+      "type": "object",
+      "properties": {
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
         },
-        "boot": {
-          "$ref": "#/definitions/boot"
-        },
-        "cpu": {
-          "$ref": "#/definitions/cpu"
-        },
-        "disks": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/disk"
-          }
-        },
-        "gpus": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/gpu"
-          }
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"column:https_proxy\"",
+          "x-nullable": true
         },
-        "hostname": {
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:proxy_\""
+    },
+    "reboot_for_reclaim_request": {
+      "description": "Information sent to the agent for rebooting a host into discovery.",
+      "type": "object",
+      "required": [
+        "host_fs_mount_dir"
+      ],
+      "properties": {
+        "host_fs_mount_dir": {
+          "description": "The base directory on the host that contains the /boot folder. The host needs to\nchroot into this directory in order to properly reboot.",
           "type": "string"
+        }
+      }
+    },
+    "release-channel": {
+      "description": "Release channel.",
+      "type": "string",
+      "enum": [
+        "candidate",
+        "fast",
+        "stable",
+        "eus"
+      ]
+    },
+    "release-image": {
+      "type": "object",
+      "required": [
+        "openshift_version",
+        "cpu_architecture",
+        "url",
+        "version"
+      ],
+      "properties": {
+        "cpu_architecture": {
+          "description": "(DEPRECATED) The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x",
+            "multi"
+          ],
+          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
         },
-        "interfaces": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/interface"
-          }
-        },
-        "memory": {
-          "$ref": "#/definitions/memory"
-        },
-        "routes": {
+        "cpu_architectures": {
+          "description": "List of CPU architectures provided by the image.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/route"
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
           }
         },
-        "system_vendor": {
-          "$ref": "#/definitions/system_vendor"
+        "default": {
+          "description": "Indication that the version is the recommended one.",
+          "type": "boolean"
         },
-        "tpm_version": {
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "support_level": {
+          "description": "Level of support of the version.",
           "type": "string",
           "enum": [
-            "none",
-            "1.2",
-            "2.0"
+            "beta",
+            "production",
+            "maintenance",
+            "end-of-life"
           ]
+        },
+        "url": {
+          "description": "The installation image of the OpenShift cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primarykey\""
+        },
+        "version": {
+          "description": "OCP version from the release metadata.",
+          "type": "string"
         }
       }
     },
-    "io_perf": {
-      "type": "object",
-      "properties": {
-        "sync_duration": {
-          "description": "99th percentile of fsync duration in milliseconds",
-          "type": "integer"
-        }
-      }
-    },
-    "ip": {
-      "type": "string",
-      "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
-      "x-go-custom-tag": "gorm:\"primaryKey;type:inet\""
-    },
-    "iscsi": {
-      "type": "object",
-      "properties": {
-        "host_ip_address": {
-          "description": "Host IP address used to reach iSCSI target",
-          "type": "string"
-        }
+    "release-images": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/release-image"
       }
     },
-    "kernel_argument": {
-      "description": "pair of [operation, argument] specifying the argument and what operation should be applied on it.",
+    "release-source": {
       "type": "object",
+      "required": [
+        "openshift_version",
+        "multi_cpu_architectures",
+        "upgrade_channels"
+      ],
       "properties": {
-        "operation": {
-          "description": "The operation to apply on the kernel argument.",
-          "type": "string",
-          "enum": [
-            "append",
-            "replace",
-            "delete"
-          ]
+        "multi_cpu_architectures": {
+          "type": "array",
+          "items": {
+            "description": "Supported CPU architecture for multi-architecture releases in this OpenShift version..",
+            "type": "string",
+            "enum": [
+              "x86_64",
+              "aarch64",
+              "arm64",
+              "ppc64le",
+              "s390x"
+            ]
+          }
         },
-        "value": {
-          "description": "Kernel argument can have the form \u003cparameter\u003e or \u003cparameter\u003e=\u003cvalue\u003e. The following examples should\nbe supported:\nrd.net.timeout.carrier=60\nisolcpus=1,2,10-20,100-2000:2/25\nquiet\nThe parsing by the command line parser in linux kernel is much looser and this pattern follows it.\n",
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
           "type": "string",
-          "pattern": "^(?:(?:[^ \\t\\n\\r\"]+)|(?:\"[^\"]*\"))+$"
+          "example": "4.14"
+        },
+        "upgrade_channels": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/upgrade-channel"
+          }
         }
       }
     },
-    "kernel_arguments": {
-      "description": "List of kernel arugment objects that define the operations and values to be applied.",
+    "release-sources": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/kernel_argument"
-      },
-      "x-omitempty": false
+        "$ref": "#/definitions/release-source"
+      }
     },
-    "l2-connectivity": {
+    "route": {
       "type": "object",
       "properties": {
-        "outgoing_ip_address": {
+        "destination": {
+          "description": "The destination network or destination host",
           "type": "string"
         },
-        "outgoing_nic": {
-          "type": "string"
+        "family": {
+          "description": "Defines whether this is an IPv4 (4) or IPv6 route (6)",
+          "type": "integer",
+          "format": "int32"
         },
-        "remote_ip_address": {
+        "gateway": {
+          "description": "Gateway address where the packets are sent",
           "type": "string"
         },
-        "remote_mac": {
+        "interface": {
+          "description": "Interface to which packets for this route will be sent",
           "type": "string"
         },
-        "successful": {
-          "type": "boolean"
+        "metric": {
+          "description": "Route priority metric",
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
-    "l3-connectivity": {
+    "secure-boot-state": {
+      "type": "string",
+      "enum": [
+        "Unknown",
+        "NotSupported",
+        "Enabled",
+        "Disabled"
+      ]
+    },
+    "service_network": {
+      "description": "IP address block for service IP blocks.",
       "type": "object",
       "properties": {
-        "average_rtt_ms": {
-          "description": "Average round trip time in milliseconds.",
-          "type": "number",
-          "format": "double",
-          "x-go-name": "AverageRTTMs"
-        },
-        "outgoing_nic": {
-          "type": "string"
-        },
-        "packet_loss_percentage": {
-          "description": "Percentage of packets lost during connectivity check.",
-          "type": "number",
-          "format": "double"
-        },
-        "remote_ip_address": {
-          "type": "string"
+        "cidr": {
+          "description": "The IP block address pool.",
+          "$ref": "#/definitions/subnet"
         },
-        "successful": {
-          "type": "boolean"
+        "cluster_id": {
+          "description": "A network to use for service IP addresses. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         }
       }
     },
-    "last-installation-preparation": {
-      "description": "Gives the status of the last installation preparation (if any)",
+    "source_state": {
+      "type": "string",
+      "enum": [
+        "synced",
+        "combined",
+        "not_combined",
+        "error",
+        "variable",
+        "unreachable"
+      ]
+    },
+    "step": {
       "type": "object",
       "properties": {
-        "reason": {
-          "description": "The reason for the preparation status if applicable",
+        "args": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "step_id": {
           "type": "string"
         },
-        "status": {
-          "description": "The last installation preparation status",
-          "type": "string",
-          "default": "not_started",
-          "enum": [
-            "not_started",
-            "failed",
-            "success"
-          ],
-          "x-nullable": false
+        "step_type": {
+          "$ref": "#/definitions/step-type"
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:last_installation_preparation_\"",
-      "x-nullable": false
-    },
-    "list-managed-domains": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/managed-domain"
-      }
-    },
-    "list-manifests": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/manifest"
       }
     },
-    "list-versions": {
+    "step-reply": {
       "type": "object",
       "properties": {
-        "release_tag": {
+        "error": {
           "type": "string"
         },
-        "versions": {
-          "$ref": "#/definitions/versions"
+        "exit_code": {
+          "type": "integer"
+        },
+        "output": {
+          "type": "string"
+        },
+        "step_id": {
+          "type": "string"
+        },
+        "step_type": {
+          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "load_balancer": {
-      "type": "object",
-      "properties": {
-        "type": {
-          "description": "Indicates if the load balancer will be managed by the cluster or by the user. This is optional and The\ndefault is ` + "`" + `cluster-managed` + "`" + `.\n\n` + "`" + `cluster-managed` + "`" + ` means that the cluster will start the components that assign the API and ingress VIPs to the\nnodes of the cluster automatically.\n\n` + "`" + `user-managed` + "`" + ` means that the user is responsible for configuring an external load balancer and assign the\nAPI and ingress VIPs to it. Note that this configuration needs to be completed before starting the\ninstallation of the cluster, as it is needed during the installation process.\n",
-          "type": "string",
-          "enum": [
-            "cluster-managed",
-            "user-managed"
-          ],
-          "x-go-custom-tag": "gorm:\"not null;check:load_balancer_type in ('cluster-managed', 'user-managed');default:'cluster-managed'\""
-        }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:load_balancer_\""
+    "step-type": {
+      "type": "string",
+      "enum": [
+        "connectivity-check",
+        "execute",
+        "inventory",
+        "install",
+        "free-network-addresses",
+        "dhcp-lease-allocate",
+        "api-vip-connectivity-check",
+        "tang-connectivity-check",
+        "ntp-synchronizer",
+        "installation-disk-speed-check",
+        "container-image-availability",
+        "domain-resolution",
+        "stop-installation",
+        "logs-gather",
+        "next-step-runner",
+        "upgrade-agent",
+        "download-boot-artifacts",
+        "reboot-for-reclaim",
+        "verify-vips",
+        "custom-step",
+        "bandwidth-check"
+      ]
     },
-    "logs-progress-params": {
+    "steps": {
       "type": "object",
-      "required": [
-        "logs_state"
-      ],
       "properties": {
-        "logs_state": {
-          "description": "The state of collecting logs.",
-          "$ref": "#/definitions/logs_state"
+        "instructions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/step"
+          }
+        },
+        "next_instruction_seconds": {
+          "type": "integer"
+        },
+        "post_step_action": {
+          "description": "What to do after finishing to run step instructions",
+          "type": "string",
+          "default": "continue",
+          "enum": [
+            "exit",
+            "continue"
+          ]
         }
       }
     },
-    "logs_gather_cmd_request": {
+    "steps-reply": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/step-reply"
+      }
+    },
+    "subnet": {
+      "type": "string",
+      "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+      "x-go-custom-tag": "gorm:\"primaryKey;type:cidr\""
+    },
+    "subscription": {
       "type": "object",
       "required": [
-        "cluster_id",
-        "infra_env_id",
-        "host_id",
-        "bootstrap",
-        "installer_gather"
+        "id",
+        "url",
+        "enabled"
       ],
       "properties": {
-        "bootstrap": {
-          "description": "Host is bootstrap or not",
-          "type": "boolean"
-        },
         "cluster_id": {
-          "description": "Cluster id",
+          "description": "The subscribed cluster. All the clusters accessible by the owner of the subscription are subscribed if not set.",
           "type": "string",
-          "format": "uuid"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
         },
-        "host_id": {
-          "description": "Host id",
+        "created_at": {
           "type": "string",
-          "format": "uuid"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "infra_env_id": {
-          "description": "Infra env id",
+        "enabled": {
+          "description": "Whether notifications are delivered.",
+          "type": "boolean"
+        },
+        "id": {
+          "description": "Unique identifier of the subscription.",
           "type": "string",
-          "format": "uuid"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "installer_gather": {
-          "description": "Run installer gather logs",
+        "org_id": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "signed": {
+          "description": "Whether notifications are signed with a secret.",
           "type": "boolean",
-          "default": true
+          "x-go-custom-tag": "gorm:\"-\""
         },
-        "master_ips": {
-          "description": "List of master ips",
-          "type": "array",
-          "items": {
-            "description": "Master ip.",
-            "type": "string",
-            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
-          }
+        "triggers": {
+          "description": "The changes that trigger a delivery.",
+          "$ref": "#/definitions/subscription-triggers"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "url": {
+          "description": "The URL notifications are posted to.",
+          "type": "string"
+        },
+        "user_name": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
         }
       }
     },
-    "logs_state": {
-      "type": "string",
-      "enum": [
-        "requested",
-        "collecting",
-        "completed",
-        "timeout",
-        ""
+    "subscription-create-params": {
+      "type": "object",
+      "required": [
+        "url"
       ],
-      "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
-    },
-    "logs_type": {
-      "type": "string",
-      "enum": [
-        "host",
-        "controller",
-        "all",
-        ""
-      ]
-    },
-    "mac_interface_map": {
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "logical_nic_name": {
-            "description": "nic name used in the yaml, which relates 1:1 to the mac address",
-            "type": "string"
-          },
-          "mac_address": {
-            "description": "mac address present on the host",
-            "type": "string",
-            "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
-          }
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster to subscribe to. All the clusters accessible by the user are subscribed if not set.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "enabled": {
+          "description": "Whether notifications are delivered.",
+          "type": "boolean",
+          "default": true,
+          "x-nullable": true
+        },
+        "secret": {
+          "description": "Secret used to sign the notifications with HMAC-SHA256. Notifications are not signed if not set.",
+          "type": "string"
+        },
+        "triggers": {
+          "description": "The changes that trigger a delivery.",
+          "$ref": "#/definitions/subscription-triggers"
+        },
+        "url": {
+          "description": "The URL notifications are posted to.",
+          "type": "string"
         }
       }
     },
-    "machine_network": {
-      "description": "A network that all hosts belonging to the cluster should have an interface with IP address in. The VIPs (if exist) belong to this network.",
+    "subscription-delivery": {
       "type": "object",
+      "required": [
+        "id",
+        "subscription_id",
+        "status"
+      ],
       "properties": {
-        "cidr": {
-          "description": "The IP block address pool.",
-          "$ref": "#/definitions/subnet"
+        "attempt_log": {
+          "description": "The delivery attempts, starting from the most recent.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/subscription-delivery-attempt"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:DeliveryID;references:ID\""
+        },
+        "attempts": {
+          "description": "Number of delivery attempts since the delivery was created or redelivered.",
+          "type": "integer"
         },
         "cluster_id": {
-          "description": "The cluster that this network is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "id": {
+          "description": "Unique identifier of the delivery, sent in the X-Assisted-Delivery header.",
           "type": "string",
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primaryKey\""
-        }
-      }
-    },
-    "managed-domain": {
-      "type": "object",
-      "properties": {
-        "domain": {
-          "type": "string"
         },
-        "provider": {
+        "next_attempt_at": {
           "type": "string",
-          "enum": [
-            "route53"
-          ]
-        }
-      }
-    },
-    "manifest": {
-      "type": "object",
-      "properties": {
-        "file_name": {
-          "description": "The file name prefaced by the folder that contains it.",
-          "type": "string"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
         },
-        "folder": {
-          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+        "status": {
           "type": "string",
           "enum": [
-            "manifests",
-            "openshift"
-          ]
+            "pending",
+            "delivered",
+            "failed"
+          ],
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "manifest_source": {
-          "description": "Describes whether manifest is sourced from a user or created by the system.",
+        "status_info": {
+          "description": "The result of the last delivery attempt.",
+          "type": "string"
+        },
+        "subscription_id": {
           "type": "string",
-          "enum": [
-            "user",
-            "system"
-          ]
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index;uniqueIndex:idx_subscription_deliveries_dedup,priority:1\""
+        },
+        "trigger": {
+          "description": "The change that triggered the delivery, e.g. cluster status 'installed' or event 'host_validation_failed'.",
+          "type": "string"
         }
       }
     },
-    "memory": {
+    "subscription-delivery-attempt": {
       "type": "object",
       "properties": {
-        "physical_bytes": {
-          "type": "integer"
+        "attempted_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "physical_bytes_method": {
-          "description": "The method by which the physical memory was set",
-          "$ref": "#/definitions/memory_method"
+        "delivery_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "usable_bytes": {
+        "duration_ms": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "error": {
+          "description": "The reason of the failure, if the attempt failed.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(1024)\""
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "status_code": {
+          "description": "The HTTP status code of the response, unset if no response was received.",
           "type": "integer"
         }
       }
     },
-    "memory_method": {
-      "type": "string",
-      "enum": [
-        "dmidecode",
-        "ghw",
-        "meminfo"
-      ]
+    "subscription-delivery-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/subscription-delivery"
+      }
+    },
+    "subscription-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/subscription"
+      }
     },
-    "monitored-operator": {
+    "subscription-triggers": {
+      "description": "The changes that trigger a delivery. A delivery is triggered by any of the changes, or by any change if none is specified.",
       "type": "object",
       "properties": {
-        "bundles": {
-          "description": "List of identifier of the bundles associated with the operator. Can be empty.",
+        "cluster_statuses": {
+          "description": "Cluster statuses that trigger a delivery when a subscribed cluster enters them.",
           "type": "array",
           "items": {
             "type": "string"
@@ -10089,1463 +14452,2297 @@ func init() {
             "type": "StringArray"
           }
         },
-        "cluster_id": {
-          "description": "The cluster that this operator is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "dependency_only": {
-          "description": "Whether the operator can't be installed without being required by another operator.",
-          "type": "boolean"
-        },
-        "name": {
-          "description": "Unique name of the operator.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
+        "event_names": {
+          "description": "Names of the events that trigger a delivery, e.g. host_validation_failed.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
+          }
         },
-        "namespace": {
-          "description": "Namespace where to deploy an operator. Only some operators require a namespace.",
-          "type": "string"
+        "event_severities": {
+          "description": "Severities of the events that trigger a delivery.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
+          }
         },
-        "operator_type": {
-          "$ref": "#/definitions/operator-type"
+        "host_statuses": {
+          "description": "Host statuses that trigger a delivery when a host of a subscribed cluster enters them.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
+          }
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:triggers_\""
+    },
+    "subscription-update-params": {
+      "type": "object",
+      "properties": {
+        "enabled": {
+          "description": "Whether notifications are delivered.",
+          "type": "boolean",
+          "x-nullable": true
         },
-        "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
+        "secret": {
+          "description": "Secret used to sign the notifications with HMAC-SHA256. An empty secret disables signing.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "status": {
-          "$ref": "#/definitions/operator-status"
+          "x-nullable": true
         },
-        "status_info": {
-          "description": "Detailed information about the operator state.",
-          "type": "string"
+        "triggers": {
+          "description": "The changes that trigger a delivery, replacing the existing ones.",
+          "x-nullable": true,
+          "$ref": "#/definitions/subscription-triggers"
         },
-        "status_updated_at": {
-          "description": "Time at which the operator was last updated.",
+        "url": {
+          "description": "The URL notifications are posted to.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "subscription_name": {
-          "description": "The name of the subscription of the operator.",
+          "x-nullable": true
+        }
+      }
+    },
+    "support-level": {
+      "type": "string",
+      "enum": [
+        "supported",
+        "unsupported",
+        "tech-preview",
+        "dev-preview",
+        "unavailable"
+      ],
+      "x-nullable": false
+    },
+    "support-levels": {
+      "description": "Map of feature ID or CPU architecture alongside their support level",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/support-level"
+      }
+    },
+    "system_vendor": {
+      "type": "object",
+      "properties": {
+        "manufacturer": {
           "type": "string"
         },
-        "timeout_seconds": {
-          "description": "Positive number represents a timeout in seconds for the operator to be available.",
-          "type": "integer"
+        "product_name": {
+          "type": "string"
         },
-        "version": {
-          "description": "Operator version",
+        "serial_number": {
           "type": "string"
+        },
+        "virtual": {
+          "description": "Whether the machine appears to be a virtual machine or not",
+          "type": "boolean"
         }
       }
     },
-    "monitored-operators-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/monitored-operator"
+    "tang_connectivity_request": {
+      "type": "object",
+      "required": [
+        "tang_servers"
+      ],
+      "properties": {
+        "tang_servers": {
+          "description": "JSON-formatted string containing additional information regarding tang's configuration",
+          "type": "string"
+        }
       }
     },
-    "mtu-report": {
+    "tang_connectivity_response": {
       "type": "object",
       "properties": {
-        "mtu_successful": {
+        "is_success": {
+          "description": "Tang check result.",
           "type": "boolean"
         },
-        "outgoing_nic": {
-          "type": "string"
-        },
-        "remote_ip_address": {
-          "type": "string"
+        "tang_server_response": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "payload": {
+                "description": "Tang response payload.",
+                "type": "string"
+              },
+              "signatures": {
+                "type": "array",
+                "items": {
+                  "type": "object",
+                  "properties": {
+                    "protected": {
+                      "type": "string"
+                    },
+                    "signature": {
+                      "type": "string"
+                    }
+                  },
+                  "x-go-name": "TangServerSignatures"
+                }
+              },
+              "tang_url": {
+                "description": "Tang URL.",
+                "type": "string"
+              }
+            },
+            "x-go-name": "TangServerResponse"
+          }
         }
       }
     },
-    "next_step_cmd_request": {
+    "update-manifest-params": {
       "type": "object",
       "required": [
-        "infra_env_id",
-        "host_id",
-        "agent_version"
+        "folder",
+        "file_name"
       ],
       "properties": {
-        "agent_version": {
-          "description": "Agent image version",
+        "file_name": {
+          "description": "The file name for the manifest to modify.",
           "type": "string",
-          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$",
+          "x-nullable": false
         },
-        "host_id": {
-          "description": "Host id",
+        "folder": {
+          "description": "The folder for the manifest to modify.",
           "type": "string",
-          "format": "uuid"
+          "default": "manifests",
+          "enum": [
+            "manifests",
+            "openshift"
+          ],
+          "x-nullable": false
         },
-        "infra_env_id": {
-          "description": "Infra env id",
+        "updated_content": {
+          "description": "The new base64 encoded manifest content.",
           "type": "string",
-          "format": "uuid"
+          "x-nullable": true
+        },
+        "updated_file_name": {
+          "description": "The new file name for the manifest.",
+          "type": "string",
+          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$",
+          "x-nullable": true
+        },
+        "updated_folder": {
+          "description": "The new folder for the manifest. Manifests can be placed in 'manifests' or 'openshift' directories.",
+          "type": "string",
+          "default": "manifests",
+          "enum": [
+            "manifests",
+            "openshift"
+          ],
+          "x-nullable": true
         }
       }
     },
-    "node-label-params": {
+    "upgrade-channel": {
       "type": "object",
       "required": [
-        "key",
-        "value"
+        "cpu_architecture",
+        "channels"
       ],
       "properties": {
-        "key": {
-          "description": "The key for the label's key-value pair.",
-          "type": "string"
+        "channels": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/release-channel"
+          }
         },
-        "value": {
-          "description": "The value for the label's key-value pair.",
-          "type": "string"
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image.",
+          "type": "string",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x",
+            "multi"
+          ]
         }
       }
     },
-    "ntp_source": {
+    "upgrade_agent_request": {
       "type": "object",
       "properties": {
-        "source_name": {
-          "description": "NTP source name or IP.",
+        "agent_image": {
+          "description": "Full image reference of the image that the agent should upgrade to, for example\n` + "`" + `quay.io/registry-proxy.engineering.redhat.com/rh-osbs/openshift4-assisted-installer-agent-rhel8:v1.0.0-142` + "`" + `.\n",
           "type": "string"
-        },
-        "source_state": {
-          "description": "Indication of state of an NTP source.",
-          "$ref": "#/definitions/source_state"
         }
       }
     },
-    "ntp_synchronization_request": {
+    "upgrade_agent_response": {
       "type": "object",
-      "required": [
-        "ntp_source"
-      ],
       "properties": {
-        "ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+        "agent_image": {
+          "description": "Full image reference of the image that the agent has upgraded to, for example\n` + "`" + `quay.io/registry-proxy.engineering.redhat.com/rh-osbs/openshift4-assisted-installer-agent-rhel8:v1.0.0-142` + "`" + `.\n",
           "type": "string"
+        },
+        "result": {
+          "$ref": "#/definitions/upgrade_agent_result"
         }
       }
     },
-    "ntp_synchronization_response": {
+    "upgrade_agent_result": {
+      "description": "Agent upgrade result.",
+      "type": "string",
+      "enum": [
+        "success",
+        "failure"
+      ]
+    },
+    "usage": {
+      "type": "object",
       "properties": {
-        "ntp_sources": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ntp_source"
+        "data": {
+          "description": "additional properties of the feature",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
           }
+        },
+        "id": {
+          "description": "Unique idenftifier of the feature",
+          "type": "string"
+        },
+        "name": {
+          "description": "name of the feature to track",
+          "type": "string"
         }
       }
     },
-    "openshift-version": {
+    "v2-cluster-update-params": {
       "type": "object",
-      "required": [
-        "display_name",
-        "support_level",
-        "cpu_architectures"
-      ],
       "properties": {
-        "cpu_architectures": {
-          "description": "Available CPU architectures.",
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vip_dns_name": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vips": {
+          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
           "type": "array",
           "items": {
-            "type": "string"
-          }
+            "$ref": "#/definitions/api_vip"
+          },
+          "x-nullable": true
         },
-        "default": {
-          "description": "Indication that the version is the recommended one.",
-          "type": "boolean"
+        "bandwidth_check_enabled": {
+          "description": "Measure the network bandwidth between the hosts of the cluster and validate it against the minimum bandwidth of their role.",
+          "type": "boolean",
+          "x-nullable": true
         },
-        "display_name": {
-          "description": "Name of the version to be presented to the user.",
-          "type": "string"
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string",
+          "x-nullable": true
         },
-        "support_level": {
-          "description": "Level of support of the version.",
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "cluster_networks": {
+          "description": "Cluster networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          },
+          "x-nullable": true
+        },
+        "control_plane_count": {
+          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
+          "type": "integer",
+          "x-nullable": true
+        },
+        "disk_encryption": {
+          "description": "Installation disks encryption mode and host roles to be applied.",
+          "$ref": "#/definitions/disk-encryption"
+        },
+        "firmware_policy": {
+          "description": "The firmware settings that the hosts of the cluster must comply with. An empty policy removes the policy of the cluster.",
+          "$ref": "#/definitions/firmware-policy"
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, arbiter nodes, worker nodes, or a combination of them.",
           "type": "string",
           "enum": [
-            "beta",
-            "production",
-            "maintenance",
-            "end-of-life"
-          ]
-        }
-      }
-    },
-    "openshift-versions": {
-      "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/openshift-version"
-      }
-    },
-    "operator": {
-      "type": "object",
-      "required": [
-        "feature-support-level-id",
-        "support_level",
-        "incompatibilities",
-        "name",
-        "dependencies"
-      ],
-      "properties": {
-        "dependencies": {
+            "none",
+            "masters",
+            "arbiters",
+            "workers",
+            "masters,arbiters",
+            "masters,workers",
+            "arbiters,workers",
+            "masters,arbiters,workers",
+            "all"
+          ],
+          "x-nullable": true
+        },
+        "ignition_endpoint": {
+          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+          "$ref": "#/definitions/ignition-endpoint"
+        },
+        "ingress_vips": {
+          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/feature-support-level-id"
-          }
+            "$ref": "#/definitions/ingress_vip"
+          },
+          "x-nullable": true
         },
-        "feature-support-level-id": {
-          "$ref": "#/definitions/feature-support-level-id"
+        "load_balancer": {
+          "$ref": "#/definitions/load_balancer"
         },
-        "incompatibilities": {
+        "machine_network_cidr": {
+          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "machine_networks": {
+          "description": "Machine networks that are associated with this cluster.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/feature-support-level-id"
-          }
+            "$ref": "#/definitions/machine_network"
+          },
+          "x-nullable": true
         },
         "name": {
-          "description": "Name of the operator",
-          "type": "string"
+          "description": "OpenShift cluster name.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1,
+          "x-nullable": true
         },
-        "reason": {
-          "$ref": "#/definitions/incompatibility-reason"
+        "network_type": {
+          "description": "The desired network type used.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ],
+          "x-nullable": true
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.\nFor the full list of supported operators, check the endpoint ` + "`" + `/v2/supported-operators` + "`" + `:\n",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "platform": {
+          "$ref": "#/definitions/platform"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "schedulable_masters": {
+          "description": "Schedule workloads on masters",
+          "type": "boolean",
+          "default": false
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "service_networks": {
+          "description": "Service networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          },
+          "x-nullable": true
         },
-        "support_level": {
-          "$ref": "#/definitions/support-level"
-        }
-      }
-    },
-    "operator-create-params": {
-      "type": "object",
-      "properties": {
-        "name": {
-          "type": "string"
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string",
+          "x-nullable": true
         },
-        "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
+        "tags": {
+          "description": "A comma-separated list of tags that are associated to the cluster.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "x-nullable": true
+        },
+        "user_managed_networking": {
+          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
         }
       }
     },
-    "operator-hardware-requirements": {
+    "verified_vip": {
+      "description": "Single VIP verification result.",
       "type": "object",
       "properties": {
-        "dependencies": {
-          "description": "List of other operator unique names that are required to be installed. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "verification": {
+          "$ref": "#/definitions/vip_verification"
         },
-        "operator_name": {
-          "description": "Unique name of the operator. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
-          "type": "string"
+        "vip": {
+          "$ref": "#/definitions/ip"
         },
-        "requirements": {
-          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
+        "vip_type": {
+          "$ref": "#/definitions/vip_type"
         }
       }
     },
-    "operator-host-requirements": {
+    "verify_vip": {
+      "description": "Request to verify single vip.",
       "type": "object",
       "properties": {
-        "operator_name": {
-          "description": "Name of the operator",
-          "type": "string"
+        "vip": {
+          "$ref": "#/definitions/ip"
         },
-        "requirements": {
-          "description": "Host requirements for the operator",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+        "vip_type": {
+          "$ref": "#/definitions/vip_type"
         }
       }
     },
-    "operator-monitor-report": {
-      "type": "object",
-      "properties": {
-        "name": {
-          "description": "Unique name of the operator.",
-          "type": "string"
-        },
-        "status": {
-          "$ref": "#/definitions/operator-status"
-        },
-        "status_info": {
-          "description": "Detailed information about the operator state.",
-          "type": "string"
-        },
-        "version": {
-          "description": "operator version.",
-          "type": "string"
-        }
+    "verify_vips_request": {
+      "description": "list of vips to be verified.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/verify_vip"
       }
     },
-    "operator-properties": {
+    "verify_vips_response": {
+      "description": "list of verified vips.",
       "type": "array",
       "items": {
-        "$ref": "#/definitions/operator-property"
+        "$ref": "#/definitions/verified_vip"
       }
     },
-    "operator-property": {
+    "versioned-host-requirements": {
       "type": "object",
       "properties": {
-        "data_type": {
-          "description": "Type of the property",
-          "type": "string",
-          "enum": [
-            "boolean",
-            "string",
-            "integer",
-            "float"
-          ]
+        "arbiter": {
+          "description": "Arbiter node requirements",
+          "x-go-name": "ArbiterRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         },
-        "default_value": {
-          "description": "Default value for the property",
-          "type": "string"
+        "edge-worker": {
+          "description": "Edge Worker OpenShift node requirements",
+          "x-go-name": "EdgeWorkerRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         },
-        "description": {
-          "description": "Description of a property",
-          "type": "string"
+        "master": {
+          "description": "Master node requirements",
+          "x-go-name": "MasterRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         },
-        "mandatory": {
-          "description": "Indicates whether the property is reqired",
-          "type": "boolean"
+        "sno": {
+          "description": "Single node OpenShift node requirements",
+          "x-go-name": "SNORequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         },
-        "name": {
-          "description": "Name of the property",
+        "version": {
+          "description": "Version of the component for which requirements are defined",
           "type": "string"
         },
-        "options": {
-          "description": "Values to select from",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "worker": {
+          "description": "Worker node requirements",
+          "x-go-name": "WorkerRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "operator-status": {
-      "description": "Represents the operator state.",
+    "versions": {
+      "type": "object",
+      "additionalProperties": {
+        "type": "string"
+      }
+    },
+    "vip_type": {
+      "description": "The vip type.",
       "type": "string",
       "enum": [
-        "failed",
-        "progressing",
-        "available"
+        "api",
+        "ingress"
       ]
     },
-    "operator-type": {
-      "description": "Kind of operator. Different types are monitored by the service differently.",
+    "vip_verification": {
+      "description": "vip verification result.",
       "type": "string",
+      "default": "unverified",
       "enum": [
-        "builtin",
-        "olm"
+        "unverified",
+        "failed",
+        "succeeded"
       ]
+    }
+  },
+  "securityDefinitions": {
+    "agentAuth": {
+      "type": "apiKey",
+      "name": "X-Secret-Key",
+      "in": "header"
     },
-    "os-image": {
-      "type": "object",
-      "required": [
-        "openshift_version",
-        "cpu_architecture",
-        "url",
-        "version"
-      ],
-      "properties": {
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
-          "type": "string",
-          "default": "x86_64",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x"
-          ],
-          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
-        },
-        "openshift_version": {
-          "description": "Version of the operating system image",
-          "type": "string",
-          "example": "4.12"
-        },
-        "url": {
-          "description": "The base OS image used for the discovery iso.",
-          "type": "string"
-        },
-        "version": {
-          "description": "Build ID of the OS image.",
-          "type": "string"
-        }
-      }
+    "imageAuth": {
+      "type": "apiKey",
+      "name": "Image-Token",
+      "in": "header"
+    },
+    "imageURLAuth": {
+      "type": "apiKey",
+      "name": "image_token",
+      "in": "query"
+    },
+    "urlAuth": {
+      "type": "apiKey",
+      "name": "api_key",
+      "in": "query"
+    },
+    "userAuth": {
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    },
+    "watcherAuth": {
+      "type": "apiKey",
+      "name": "Watcher-Authorization",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "userAuth": [
+        "admin",
+        "user"
+      ]
+    }
+  ],
+  "tags": [
+    {
+      "description": "Agent-driven installation",
+      "name": "Assisted installation"
+    },
+    {
+      "description": "Events related to a cluster installation.",
+      "name": "events"
+    },
+    {
+      "description": "General OpenShift cluster installation APIs.",
+      "name": "installer"
     },
-    "os-images": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/os-image"
-      }
+    {
+      "description": "Managed dns domains for a cluster installation.",
+      "name": "managed_domains"
     },
-    "platform": {
-      "description": "The configuration for the specific platform upon which to perform the installation.",
-      "type": "object",
-      "required": [
-        "type"
-      ],
-      "properties": {
-        "external": {
-          "x-nullable": true,
-          "$ref": "#/definitions/platform_external"
-        },
-        "type": {
-          "$ref": "#/definitions/platform_type"
-        }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:platform_\""
+    {
+      "description": "Manifests for customizing a cluster installation.",
+      "name": "manifests"
     },
-    "platform_external": {
-      "description": "Configuration used when installing with an external platform type.",
-      "type": "object",
-      "properties": {
-        "cloud_controller_manager": {
-          "description": "When set to external, this property will enable an external cloud provider.",
-          "type": "string",
-          "default": "",
-          "enum": [
-            "",
-            "External"
-          ],
-          "x-nullable": true
-        },
-        "platform_name": {
-          "description": "Holds the arbitrary string representing the infrastructure provider name.",
-          "type": "string",
-          "minLength": 1,
-          "x-nullable": true
-        }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:external_\""
+    {
+      "description": "Information regarding supported operators.",
+      "name": "operators"
     },
-    "platform_type": {
-      "type": "string",
-      "enum": [
-        "baremetal",
-        "nutanix",
-        "vsphere",
-        "none",
-        "external"
-      ]
+    {
+      "description": "Information regarding versions.",
+      "name": "versions"
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Assisted installation",
+    "title": "AssistedInstall",
+    "license": {
+      "name": "Apache 2.0",
+      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
     },
-    "preflight-hardware-requirements": {
-      "type": "object",
-      "properties": {
-        "ocp": {
-          "description": "Preflight OCP requirements",
-          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
-        },
-        "operators": {
-          "description": "Preflight operators hardware requirements",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-hardware-requirements"
+    "version": "1.0.0"
+  },
+  "host": "api.openshift.com",
+  "basePath": "/api/assisted-install",
+  "paths": {
+    "/v2/cluster-archives/encryption-key": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the public key that the secrets of the archives imported by this service are encrypted with. Set it as the recipient key when exporting a cluster from another service.",
+        "tags": [
+          "cluster_archives"
+        ],
+        "operationId": "v2GetClusterArchiveEncryptionKey",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-archive-encryption-key"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "presigned-url": {
-      "type": "object",
-      "required": [
-        "url"
-      ],
-      "properties": {
-        "expires_at": {
-          "description": "Expiration time for the URL token.",
-          "type": "string",
-          "format": "date-time"
-        },
-        "url": {
-          "description": "Pre-signed URL for downloading the infra-env discovery image.",
-          "type": "string"
+    "/v2/cluster-archives/import": {
+      "post": {
+        "description": "Registers a cluster, its infra-envs and its custom manifests from an archive exported by another service. The secrets of the archive must be encrypted with the key of this service.",
+        "tags": [
+          "cluster_archives"
+        ],
+        "operationId": "v2ImportClusterArchive",
+        "parameters": [
+          {
+            "description": "The exported cluster.",
+            "name": "archive",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-archive"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-import-result"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "proxy": {
-      "type": "object",
-      "properties": {
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
-        },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"column:https_proxy\"",
-          "x-nullable": true
-        },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string",
-          "x-nullable": true
+    "/v2/cluster-templates": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the cluster templates that are accessible by the user.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "v2ListClusterTemplates",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:proxy_\""
-    },
-    "reboot_for_reclaim_request": {
-      "description": "Information sent to the agent for rebooting a host into discovery.",
-      "type": "object",
-      "required": [
-        "host_fs_mount_dir"
-      ],
-      "properties": {
-        "host_fs_mount_dir": {
-          "description": "The base directory on the host that contains the /boot folder. The host needs to\nchroot into this directory in order to properly reboot.",
-          "type": "string"
+      "post": {
+        "description": "Creates a cluster template, from which clusters with the same configuration can be registered.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "v2RegisterClusterTemplate",
+        "parameters": [
+          {
+            "description": "The properties describing the new cluster template.",
+            "name": "new-cluster-template-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-template-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "release-channel": {
-      "description": "Release channel.",
-      "type": "string",
-      "enum": [
-        "candidate",
-        "fast",
-        "stable",
-        "eus"
-      ]
-    },
-    "release-image": {
-      "type": "object",
-      "required": [
-        "openshift_version",
-        "cpu_architecture",
-        "url",
-        "version"
-      ],
-      "properties": {
-        "cpu_architecture": {
-          "description": "(DEPRECATED) The CPU architecture of the image (x86_64/arm64/etc).",
-          "type": "string",
-          "default": "x86_64",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x",
-            "multi"
-          ],
-          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
-        },
-        "cpu_architectures": {
-          "description": "List of CPU architectures provided by the image.",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/v2/cluster-templates/{cluster_template_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the details of the cluster template.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "v2GetClusterTemplate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The template to be retrieved.",
+            "name": "cluster_template_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template"
+            }
           },
-          "x-go-custom-tag": "gorm:\"type:text[]\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "github.com/lib/pq"
-            },
-            "type": "StringArray"
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "default": {
-          "description": "Indication that the version is the recommended one.",
-          "type": "boolean"
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
-        },
-        "support_level": {
-          "description": "Level of support of the version.",
-          "type": "string",
-          "enum": [
-            "beta",
-            "production",
-            "maintenance",
-            "end-of-life"
-          ]
-        },
-        "url": {
-          "description": "The installation image of the OpenShift cluster.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"primarykey\""
-        },
-        "version": {
-          "description": "OCP version from the release metadata.",
-          "type": "string"
         }
-      }
-    },
-    "release-images": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/release-image"
-      }
-    },
-    "release-source": {
-      "type": "object",
-      "required": [
-        "openshift_version",
-        "multi_cpu_architectures",
-        "upgrade_channels"
-      ],
-      "properties": {
-        "multi_cpu_architectures": {
-          "type": "array",
-          "items": {
-            "description": "Supported CPU architecture for multi-architecture releases in this OpenShift version..",
+      },
+      "delete": {
+        "description": "Deletes a cluster template. Clusters that were registered from the template are not modified.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "v2DeregisterClusterTemplate",
+        "parameters": [
+          {
             "type": "string",
-            "enum": [
-              "x86_64",
-              "aarch64",
-              "arm64",
-              "ppc64le",
-              "s390x"
-            ]
+            "format": "uuid",
+            "description": "The template to be deleted.",
+            "name": "cluster_template_id",
+            "in": "path",
+            "required": true
           }
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string",
-          "example": "4.14"
-        },
-        "upgrade_channels": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/upgrade-channel"
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "release-sources": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/release-source"
-      }
-    },
-    "route": {
-      "type": "object",
-      "properties": {
-        "destination": {
-          "description": "The destination network or destination host",
-          "type": "string"
-        },
-        "family": {
-          "description": "Defines whether this is an IPv4 (4) or IPv6 route (6)",
-          "type": "integer",
-          "format": "int32"
-        },
-        "gateway": {
-          "description": "Gateway address where the packets are sent",
-          "type": "string"
-        },
-        "interface": {
-          "description": "Interface to which packets for this route will be sent",
-          "type": "string"
-        },
-        "metric": {
-          "description": "Route priority metric",
-          "type": "integer",
-          "format": "int32"
-        }
-      }
-    },
-    "secure-boot-state": {
-      "type": "string",
-      "enum": [
-        "Unknown",
-        "NotSupported",
-        "Enabled",
-        "Disabled"
-      ]
-    },
-    "service_network": {
-      "description": "IP address block for service IP blocks.",
-      "type": "object",
-      "properties": {
-        "cidr": {
-          "description": "The IP block address pool.",
-          "$ref": "#/definitions/subnet"
-        },
-        "cluster_id": {
-          "description": "A network to use for service IP addresses. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        }
-      }
-    },
-    "source_state": {
-      "type": "string",
-      "enum": [
-        "synced",
-        "combined",
-        "not_combined",
-        "error",
-        "variable",
-        "unreachable"
-      ]
-    },
-    "step": {
-      "type": "object",
-      "properties": {
-        "args": {
-          "type": "array",
-          "items": {
-            "type": "string"
+      },
+      "patch": {
+        "description": "Updates a cluster template. Clusters that were registered from the template are not modified.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "v2UpdateClusterTemplate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The template to be updated.",
+            "name": "cluster_template_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "cluster-template-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-template-update-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "step_id": {
-          "type": "string"
-        },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "step-reply": {
-      "type": "object",
-      "properties": {
-        "error": {
-          "type": "string"
-        },
-        "exit_code": {
-          "type": "integer"
-        },
-        "output": {
-          "type": "string"
-        },
-        "step_id": {
-          "type": "string"
-        },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
+    "/v2/clusters": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "watcherAuth": []
+          }
+        ],
+        "description": "Retrieves the list of OpenShift clusters.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ListClusters",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A specific cluster to retrieve.",
+            "name": "openshift_cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
+            "name": "ams_subscription_ids",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Include hosts in the returned list.",
+            "name": "with_hosts",
+            "in": "query",
+            "allowEmptyValue": true
+          },
+          {
+            "type": "string",
+            "description": "If provided, returns only clusters that are owned by the specified user.",
+            "name": "owner",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "step-type": {
-      "type": "string",
-      "enum": [
-        "connectivity-check",
-        "execute",
-        "inventory",
-        "install",
-        "free-network-addresses",
-        "dhcp-lease-allocate",
-        "api-vip-connectivity-check",
-        "tang-connectivity-check",
-        "ntp-synchronizer",
-        "installation-disk-speed-check",
-        "container-image-availability",
-        "domain-resolution",
-        "stop-installation",
-        "logs-gather",
-        "next-step-runner",
-        "upgrade-agent",
-        "download-boot-artifacts",
-        "reboot-for-reclaim",
-        "verify-vips"
-      ]
-    },
-    "steps": {
-      "type": "object",
-      "properties": {
-        "instructions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/step"
+      },
+      "post": {
+        "description": "Creates a new OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2RegisterCluster",
+        "parameters": [
+          {
+            "description": "The properties describing the new cluster. When the cluster is registered from a template, the properties that are set override the ones of the template.",
+            "name": "new-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-create-params"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The template the cluster is registered from. The manifests and the infra-env of the template are created with the cluster.",
+            "name": "cluster_template_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "The values of the parameters of the template, formatted as name=value.",
+            "name": "template_parameters",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "next_instruction_seconds": {
-          "type": "integer"
-        },
-        "post_step_action": {
-          "description": "What to do after finishing to run step instructions",
-          "type": "string",
-          "default": "continue",
-          "enum": [
-            "exit",
-            "continue"
-          ]
         }
       }
     },
-    "steps-reply": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/step-reply"
-      }
-    },
-    "subnet": {
-      "type": "string",
-      "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-      "x-go-custom-tag": "gorm:\"primaryKey;type:cidr\""
-    },
-    "support-level": {
-      "type": "string",
-      "enum": [
-        "supported",
-        "unsupported",
-        "tech-preview",
-        "dev-preview",
-        "unavailable"
-      ],
-      "x-nullable": false
-    },
-    "support-levels": {
-      "description": "Map of feature ID or CPU architecture alongside their support level",
-      "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/support-level"
-      }
-    },
-    "system_vendor": {
-      "type": "object",
-      "properties": {
-        "manufacturer": {
-          "type": "string"
-        },
-        "product_name": {
-          "type": "string"
-        },
-        "serial_number": {
-          "type": "string"
-        },
-        "virtual": {
-          "description": "Whether the machine appears to be a virtual machine or not",
-          "type": "boolean"
+    "/v2/clusters/default-config": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the default values for various cluster properties.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2GetClusterDefaultConfig",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster_default_config"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "tang_connectivity_request": {
-      "type": "object",
-      "required": [
-        "tang_servers"
-      ],
-      "properties": {
-        "tang_servers": {
-          "description": "JSON-formatted string containing additional information regarding tang's configuration",
-          "type": "string"
+    "/v2/clusters/disconnected": {
+      "post": {
+        "description": "Create a disconnected OpenShift cluster for offline installation with embedded ignition",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2RegisterDisconnectedCluster",
+        "parameters": [
+          {
+            "description": "Parameters for creating a disconnected cluster.",
+            "name": "new-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/disconnected-cluster-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "tang_connectivity_response": {
-      "type": "object",
-      "properties": {
-        "is_success": {
-          "description": "Tang check result.",
-          "type": "boolean"
-        },
-        "tang_server_response": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "payload": {
-                "description": "Tang response payload.",
-                "type": "string"
-              },
-              "signatures": {
-                "type": "array",
-                "items": {
-                  "type": "object",
-                  "properties": {
-                    "protected": {
-                      "type": "string"
-                    },
-                    "signature": {
-                      "type": "string"
-                    }
-                  },
-                  "x-go-name": "TangServerSignatures"
-                }
-              },
-              "tang_url": {
-                "description": "Tang URL.",
-                "type": "string"
-              }
-            },
-            "x-go-name": "TangServerResponse"
+    "/v2/clusters/import": {
+      "post": {
+        "description": "Import an AI cluster using minimal data associated with existing OCP cluster, in order to allow adding day2 hosts to that cluster",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ImportCluster",
+        "parameters": [
+          {
+            "description": "Parameters for importing a OCP cluster for adding nodes.",
+            "name": "new-import-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/import-cluster-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "update-manifest-params": {
-      "type": "object",
-      "required": [
-        "folder",
-        "file_name"
-      ],
-      "properties": {
-        "file_name": {
-          "description": "The file name for the manifest to modify.",
-          "type": "string",
-          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$",
-          "x-nullable": false
-        },
-        "folder": {
-          "description": "The folder for the manifest to modify.",
-          "type": "string",
-          "default": "manifests",
-          "enum": [
-            "manifests",
-            "openshift"
-          ],
-          "x-nullable": false
-        },
-        "updated_content": {
-          "description": "The new base64 encoded manifest content.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "updated_file_name": {
-          "description": "The new file name for the manifest.",
-          "type": "string",
-          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$",
-          "x-nullable": true
-        },
-        "updated_folder": {
-          "description": "The new folder for the manifest. Manifests can be placed in 'manifests' or 'openshift' directories.",
-          "type": "string",
-          "default": "manifests",
-          "enum": [
-            "manifests",
-            "openshift"
-          ],
-          "x-nullable": true
+    "/v2/clusters/{cluster_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "watcherAuth": []
+          }
+        ],
+        "description": "Retrieves the details of the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving the cluster details.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          },
+          {
+            "type": "boolean",
+            "description": "If true, do not include hosts.",
+            "name": "exclude-hosts",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "upgrade-channel": {
-      "type": "object",
-      "required": [
-        "cpu_architecture",
-        "channels"
-      ],
-      "properties": {
-        "channels": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/release-channel"
+      },
+      "delete": {
+        "description": "Deletes an OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2DeregisterCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be deregistered.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image.",
-          "type": "string",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x",
-            "multi"
-          ]
         }
-      }
-    },
-    "upgrade_agent_request": {
-      "type": "object",
-      "properties": {
-        "agent_image": {
-          "description": "Full image reference of the image that the agent should upgrade to, for example\n` + "`" + `quay.io/registry-proxy.engineering.redhat.com/rh-osbs/openshift4-assisted-installer-agent-rhel8:v1.0.0-142` + "`" + `.\n",
-          "type": "string"
+      },
+      "patch": {
+        "description": "Updates an OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2UpdateCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "cluster-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/v2-cluster-update-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "upgrade_agent_response": {
-      "type": "object",
-      "properties": {
-        "agent_image": {
-          "description": "Full image reference of the image that the agent has upgraded to, for example\n` + "`" + `quay.io/registry-proxy.engineering.redhat.com/rh-osbs/openshift4-assisted-installer-agent-rhel8:v1.0.0-142` + "`" + `.\n",
-          "type": "string"
-        },
-        "result": {
-          "$ref": "#/definitions/upgrade_agent_result"
+    "/v2/clusters/{cluster_id}/actions/allow-add-hosts": {
+      "post": {
+        "description": "Transforms installed cluster to a state which allows adding hosts.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "TransformClusterToAddingHosts",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to transform.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "upgrade_agent_result": {
-      "description": "Agent upgrade result.",
-      "type": "string",
-      "enum": [
-        "success",
-        "failure"
-      ]
-    },
-    "usage": {
-      "type": "object",
-      "properties": {
-        "data": {
-          "description": "additional properties of the feature",
-          "type": "object",
-          "additionalProperties": {
-            "type": "object"
+    "/v2/clusters/{cluster_id}/actions/allow-add-workers": {
+      "post": {
+        "description": "Deprecated, maintained for legacy purposes. Does the same thing as allow-add-hosts. Use allow-add-hosts instead.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "TransformClusterToDay2",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to transform.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "id": {
-          "description": "Unique idenftifier of the feature",
-          "type": "string"
-        },
-        "name": {
-          "description": "name of the feature to track",
-          "type": "string"
         }
       }
     },
-    "v2-cluster-update-params": {
-      "type": "object",
-      "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "api_vip_dns_name": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "api_vips": {
-          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/api_vip"
+    "/v2/clusters/{cluster_id}/actions/cancel": {
+      "post": {
+        "description": "Cancels an ongoing installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2CancelInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is to be canceled.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
           },
-          "x-nullable": true
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 128,
-          "minimum": 1,
-          "x-nullable": true
-        },
-        "cluster_networks": {
-          "description": "Cluster networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/cluster_network"
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
           },
-          "x-nullable": true
-        },
-        "control_plane_count": {
-          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
-          "type": "integer",
-          "x-nullable": true
-        },
-        "disk_encryption": {
-          "description": "Installation disks encryption mode and host roles to be applied.",
-          "$ref": "#/definitions/disk-encryption"
-        },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
-        },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
-        },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, arbiter nodes, worker nodes, or a combination of them.",
-          "type": "string",
-          "enum": [
-            "none",
-            "masters",
-            "arbiters",
-            "workers",
-            "masters,arbiters",
-            "masters,workers",
-            "arbiters,workers",
-            "masters,arbiters,workers",
-            "all"
-          ],
-          "x-nullable": true
-        },
-        "ignition_endpoint": {
-          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
-          "$ref": "#/definitions/ignition-endpoint"
-        },
-        "ingress_vips": {
-          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ingress_vip"
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
           },
-          "x-nullable": true
-        },
-        "load_balancer": {
-          "$ref": "#/definitions/load_balancer"
-        },
-        "machine_network_cidr": {
-          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
-        },
-        "machine_networks": {
-          "description": "Machine networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/machine_network"
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
-          "x-nullable": true
-        },
-        "name": {
-          "description": "OpenShift cluster name.",
-          "type": "string",
-          "maxLength": 54,
-          "minLength": 1,
-          "x-nullable": true
-        },
-        "network_type": {
-          "description": "The desired network type used.",
-          "type": "string",
-          "enum": [
-            "OpenShiftSDN",
-            "OVNKubernetes"
-          ],
-          "x-nullable": true
-        },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "olm_operators": {
-          "description": "List of OLM operators to be installed.\nFor the full list of supported operators, check the endpoint ` + "`" + `/v2/supported-operators` + "`" + `:\n",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-create-params"
-          }
-        },
-        "platform": {
-          "$ref": "#/definitions/platform"
-        },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "schedulable_masters": {
-          "description": "Schedule workloads on masters",
-          "type": "boolean",
-          "default": false
-        },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
-        },
-        "service_networks": {
-          "description": "Service networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/service_network"
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
-          "x-nullable": true
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "tags": {
-          "description": "A comma-separated list of tags that are associated to the cluster.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "user_managed_networking": {
-          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "x-nullable": true
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "verified_vip": {
-      "description": "Single VIP verification result.",
-      "type": "object",
-      "properties": {
-        "verification": {
-          "$ref": "#/definitions/vip_verification"
-        },
-        "vip": {
-          "$ref": "#/definitions/ip"
-        },
-        "vip_type": {
-          "$ref": "#/definitions/vip_type"
+    "/v2/clusters/{cluster_id}/actions/complete-installation": {
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to mark a finalizing installation as complete and progress to 100%.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2CompleteInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is being completing.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The final status of the cluster installation.",
+            "name": "completion-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/completion-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is completing the installation.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "verify_vip": {
-      "description": "Request to verify single vip.",
-      "type": "object",
-      "properties": {
-        "vip": {
-          "$ref": "#/definitions/ip"
-        },
-        "vip_type": {
-          "$ref": "#/definitions/vip_type"
+    "/v2/clusters/{cluster_id}/actions/dry-run": {
+      "post": {
+        "description": "Renders the install-config, the install manifests and ignitions, the operator manifests and the installer\narguments of each host, as the installation of the cluster would, and returns them as a tar.gz bundle.\nThe cluster is not modified and its stored files are not changed. Generation errors are listed in the\ndry-run-report.json file of the bundle.\n",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2DryRunInstallCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is rendered.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "verify_vips_request": {
-      "description": "list of vips to be verified.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/verify_vip"
-      }
-    },
-    "verify_vips_response": {
-      "description": "list of verified vips.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/verified_vip"
-      }
-    },
-    "versioned-host-requirements": {
-      "type": "object",
-      "properties": {
-        "arbiter": {
-          "description": "Arbiter node requirements",
-          "x-go-name": "ArbiterRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "edge-worker": {
-          "description": "Edge Worker OpenShift node requirements",
-          "x-go-name": "EdgeWorkerRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "master": {
-          "description": "Master node requirements",
-          "x-go-name": "MasterRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "sno": {
-          "description": "Single node OpenShift node requirements",
-          "x-go-name": "SNORequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "version": {
-          "description": "Version of the component for which requirements are defined",
-          "type": "string"
-        },
-        "worker": {
-          "description": "Worker node requirements",
-          "x-go-name": "WorkerRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+    "/v2/clusters/{cluster_id}/actions/export": {
+      "post": {
+        "description": "Exports the definition of a cluster to an archive that can be imported by another service. The secrets of the cluster are encrypted with the key of the service that imports the archive.",
+        "tags": [
+          "cluster_archives"
+        ],
+        "operationId": "v2ExportCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be exported.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The key of the service that imports the archive.",
+            "name": "export-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-export-params"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-archive"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "versions": {
-      "type": "object",
-      "additionalProperties": {
-        "type": "string"
+    "/v2/clusters/{cluster_id}/actions/install": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2InstallCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be installed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
       }
     },
-    "vip_type": {
-      "description": "The vip type.",
-      "type": "string",
-      "enum": [
-        "api",
-        "ingress"
-      ]
-    },
-    "vip_verification": {
-      "description": "vip verification result.",
-      "type": "string",
-      "default": "unverified",
-      "enum": [
-        "unverified",
-        "failed",
-        "succeeded"
-      ]
-    }
-  },
-  "securityDefinitions": {
-    "agentAuth": {
-      "type": "apiKey",
-      "name": "X-Secret-Key",
-      "in": "header"
-    },
-    "imageAuth": {
-      "type": "apiKey",
-      "name": "Image-Token",
-      "in": "header"
-    },
-    "imageURLAuth": {
-      "type": "apiKey",
-      "name": "image_token",
-      "in": "query"
-    },
-    "urlAuth": {
-      "type": "apiKey",
-      "name": "api_key",
-      "in": "query"
-    },
-    "userAuth": {
-      "type": "apiKey",
-      "name": "Authorization",
-      "in": "header"
-    },
-    "watcherAuth": {
-      "type": "apiKey",
-      "name": "Watcher-Authorization",
-      "in": "header"
-    }
-  },
-  "security": [
-    {
-      "userAuth": [
-        "admin",
-        "user"
-      ]
-    }
-  ],
-  "tags": [
-    {
-      "description": "Agent-driven installation",
-      "name": "Assisted installation"
-    },
-    {
-      "description": "Events related to a cluster installation.",
-      "name": "events"
-    },
-    {
-      "description": "General OpenShift cluster installation APIs.",
-      "name": "installer"
-    },
-    {
-      "description": "Managed dns domains for a cluster installation.",
-      "name": "managed_domains"
-    },
-    {
-      "description": "Manifests for customizing a cluster installation.",
-      "name": "manifests"
-    },
-    {
-      "description": "Information regarding supported operators.",
-      "name": "operators"
-    },
-    {
-      "description": "Information regarding versions.",
-      "name": "versions"
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http",
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Assisted installation",
-    "title": "AssistedInstall",
-    "license": {
-      "name": "Apache 2.0",
-      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+    "/v2/clusters/{cluster_id}/actions/reset": {
+      "post": {
+        "description": "Resets a failed installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ResetCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is to be reset.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
     },
-    "version": "1.0.0"
-  },
-  "host": "api.openshift.com",
-  "basePath": "/api/assisted-install",
-  "paths": {
-    "/v2/clusters": {
+    "/v2/clusters/{cluster_id}/credentials": {
       "get": {
         "security": [
           {
             "userAuth": [
-              "admin",
-              "read-only-admin",
               "user"
             ]
-          },
-          {
-            "watcherAuth": []
           }
         ],
-        "description": "Retrieves the list of OpenShift clusters.",
+        "description": "Get the cluster admin credentials.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ListClusters",
+        "operationId": "V2GetCredentials",
         "parameters": [
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "A specific cluster to retrieve.",
-            "name": "openshift_cluster_id",
-            "in": "query"
+            "description": "The cluster whose admin credentials should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/credentials"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/downloads/credentials": {
+      "get": {
+        "security": [
           {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
-            "name": "ams_subscription_ids",
-            "in": "query"
+            "userAuth": [
+              "user"
+            ]
           },
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Include hosts in the returned list.",
-            "name": "with_hosts",
-            "in": "query",
-            "allowEmptyValue": true
+            "urlAuth": []
           },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Downloads credentials relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2DownloadClusterCredentials",
+        "parameters": [
           {
             "type": "string",
-            "description": "If provided, returns only clusters that are owned by the specified user.",
-            "name": "owner",
-            "in": "query"
+            "format": "uuid",
+            "description": "The cluster that owns the credential file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress"
+            ],
+            "type": "string",
+            "description": "The credential file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-list"
+              "type": "file"
             }
           },
           "401": {
@@ -11560,12 +16757,24 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11579,29 +16788,49 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "description": "Creates a new OpenShift cluster definition.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/downloads/credentials-presigned": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the cluster admin credentials.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2RegisterCluster",
+        "operationId": "V2GetPresignedForClusterCredentials",
         "parameters": [
           {
-            "description": "The properties describing the new cluster.",
-            "name": "new-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/cluster-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/presigned-url"
             }
           },
           "400": {
@@ -11622,12 +16851,24 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11637,7 +16878,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/default-config": {
+    "/v2/clusters/{cluster_id}/downloads/files": {
       "get": {
         "security": [
           {
@@ -11646,18 +16887,60 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Get the default values for various cluster properties.",
+        "description": "Downloads files relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetClusterDefaultConfig",
+        "operationId": "V2DownloadClusterFiles",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "install-config.yaml",
+              "custom_manifests.json",
+              "custom_manifests.yaml",
+              "arbiter.ign"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is downloading the file.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster_default_config"
+              "type": "file"
             }
           },
           "401": {
@@ -11672,60 +16955,32 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "500": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/v2/clusters/disconnected": {
-      "post": {
-        "description": "Create a disconnected OpenShift cluster for offline installation with embedded ignition",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "v2RegisterDisconnectedCluster",
-        "parameters": [
-          {
-            "description": "Parameters for creating a disconnected cluster.",
-            "name": "new-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/disconnected-cluster-create-params"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
           },
-          "400": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "401": {
-            "description": "Unauthorized.",
+          "409": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
-          "403": {
-            "description": "Forbidden.",
+          "500": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -11733,29 +16988,77 @@ func init() {
         }
       }
     },
-    "/v2/clusters/import": {
-      "post": {
-        "description": "Import an AI cluster using minimal data associated with existing OCP cluster, in order to allow adding day2 hosts to that cluster",
+    "/v2/clusters/{cluster_id}/downloads/files-presigned": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ImportCluster",
+        "operationId": "V2GetPresignedForClusterFiles",
         "parameters": [
           {
-            "description": "Parameters for importing a OCP cluster for adding nodes.",
-            "name": "new-import-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/import-cluster-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "install-config.yaml",
+              "logs",
+              "manifests"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "If downloading logs, the type of logs to download.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "If downloading a file related to a host, the relevant host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
+            "name": "additional_name",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/presigned-url"
             }
           },
           "400": {
@@ -11776,6 +17079,24 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11785,7 +17106,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}": {
+    "/v2/clusters/{cluster_id}/hosts": {
       "get": {
         "security": [
           {
@@ -11797,42 +17118,50 @@ func init() {
           },
           {
             "agentAuth": []
-          },
-          {
-            "watcherAuth": []
           }
         ],
-        "description": "Retrieves the details of the OpenShift cluster.",
+        "description": "Get a list of cluster hosts according to supplied filters.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetCluster",
+        "operationId": "ListClusterHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be retrieved.",
+            "description": "The cluster whose hosts should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
+          {
+            "enum": [
+              "master",
+              "arbiter",
+              "worker",
+              "auto-assign"
+            ],
+            "type": "string",
+            "description": "Role to request.",
+            "name": "role",
+            "in": "query"
+          },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is retrieving the cluster details.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "Hosts status to request.",
+            "name": "status",
+            "in": "query"
           },
           {
             "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
+            "description": "If true return the host's inventory.",
+            "name": "with-inventory",
+            "in": "query"
           },
           {
             "type": "boolean",
-            "description": "If true, do not include hosts.",
-            "name": "exclude-hosts",
+            "description": "If true return the host's connectivity.",
+            "name": "with-connectivity",
             "in": "query"
           }
         ],
@@ -11840,7 +17169,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/host-list"
             }
           },
           "401": {
@@ -11861,45 +17190,90 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/ignored-validations": {
+      "get": {
+        "description": "Fetch the validations which are to be ignored for this cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetIgnoredValidations",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose failing validations should be ignored according to this list.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/ignored-validations"
+            }
           },
-          "500": {
+          "400": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
       },
-      "delete": {
-        "description": "Deletes an OpenShift cluster definition.",
+      "put": {
+        "description": "Register the validations which are to be ignored for this cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2DeregisterCluster",
+        "operationId": "v2SetIgnoredValidations",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be deregistered.",
+            "description": "The cluster whose failing validations should be ignored according to this list.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The validations to be ignored.",
+            "name": "ignored_validations",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ignored-validations"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/ignored-validations"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -11919,18 +17293,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11938,43 +17300,39 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Updates an OpenShift cluster definition.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/install-config": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the cluster's install config YAML.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2UpdateCluster",
+        "operationId": "v2GetClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be updated.",
+            "description": "The cluster whose install config is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The properties to update.",
-            "name": "cluster-update-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/v2-cluster-update-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "type": "string"
             }
           },
           "401": {
@@ -12001,12 +17359,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -12014,30 +17366,40 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/actions/allow-add-hosts": {
-      "post": {
-        "description": "Transforms installed cluster to a state which allows adding hosts.",
+      },
+      "patch": {
+        "description": "Override values in the install config.",
         "tags": [
           "installer"
         ],
-        "operationId": "TransformClusterToAddingHosts",
+        "operationId": "v2UpdateClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to transform.",
+            "description": "The cluster whose install config is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "Install config overrides.",
+            "name": "install-config-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -12064,12 +17426,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -12079,29 +17435,61 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/allow-add-workers": {
-      "post": {
-        "description": "Deprecated, maintained for legacy purposes. Does the same thing as allow-add-hosts. Use allow-add-hosts instead.",
+    "/v2/clusters/{cluster_id}/logs": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Download cluster logs.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "TransformClusterToDay2",
-        "deprecated": true,
+        "operationId": "V2DownloadClusterLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to transform.",
+            "description": "The cluster whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "The type of logs to be downloaded.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A specific host in the cluster whose logs should be downloaded.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "type": "file"
             }
           },
           "401": {
@@ -12141,30 +17529,72 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/actions/cancel": {
+      },
       "post": {
-        "description": "Cancels an ongoing installation.",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "V2CancelInstallation",
+        "operationId": "V2UploadLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be canceled.",
+            "description": "The cluster whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "maxLength": 262144000,
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
+            "type": "string",
+            "description": "The type of log file to be uploaded.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env ID of the host.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs should be uploaded.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Bad Request",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -12202,53 +17632,50 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/complete-installation": {
-      "post": {
+    "/v2/clusters/{cluster_id}/logs-progress": {
+      "put": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Agent API to mark a finalizing installation as complete and progress to 100%.",
+        "description": "Update log collection state and progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2CompleteInstallation",
+        "operationId": "v2UpdateClusterLogsProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is being completing.",
+            "description": "The cluster whose log progress is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The final status of the cluster installation.",
-            "name": "completion-params",
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/completion-params"
+              "$ref": "#/definitions/logs-progress-params"
             }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is completing the installation.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -12295,34 +17722,40 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/install": {
-      "post": {
-        "description": "Installs the OpenShift cluster.",
+    "/v2/clusters/{cluster_id}/manifests": {
+      "get": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Lists manifests for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "v2InstallCluster",
+        "operationId": "V2ListClusterManifests",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be installed.",
+            "description": "The cluster for which the manifests should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Include system generated manifests in results? Default is false.",
+            "name": "include_system_generated",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/list-manifests"
             }
           },
           "401": {
@@ -12362,30 +17795,48 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/actions/reset": {
+      },
       "post": {
-        "description": "Resets a failed installation.",
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Creates a manifest for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "v2ResetCluster",
+        "operationId": "V2CreateClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be reset.",
+            "description": "The cluster for which a new manifest should be created.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The new manifest to create.",
+            "name": "CreateManifestParams",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/create-manifest-params"
+            }
           }
         ],
         "responses": {
-          "202": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/manifest"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -12425,38 +17876,44 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/credentials": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the cluster admin credentials.",
+      },
+      "delete": {
+        "description": "Deletes a manifest from the cluster.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2GetCredentials",
+        "operationId": "V2DeleteClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose admin credentials should be retrieved.",
+            "description": "The cluster whose manifest should be deleted.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
+            "type": "string",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to delete from the cluster.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/credentials"
-            }
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -12495,58 +17952,48 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/downloads/credentials": {
-      "get": {
+      },
+      "patch": {
         "security": [
           {
-            "userAuth": [
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "agentAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Downloads credentials relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Updates a manifest for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2DownloadClusterCredentials",
+        "operationId": "V2UpdateClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the credential file that should be downloaded.",
+            "description": "The cluster for which a new manifest should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress"
-            ],
-            "type": "string",
-            "description": "The credential file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "The manifest to be updated.",
+            "name": "UpdateManifestParams",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/update-manifest-params"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/manifest"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -12584,47 +18031,48 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/credentials-presigned": {
+    "/v2/clusters/{cluster_id}/manifests/files": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "user"
-            ]
+            "userAuth": []
           }
         ],
-        "description": "Get the cluster admin credentials.",
+        "description": "Downloads cluster manifest.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2GetPresignedForClusterCredentials",
+        "operationId": "v2DownloadClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose manifest should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "enum": [
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress"
+              "manifests",
+              "openshift"
             ],
             "type": "string",
-            "description": "The file to be downloaded.",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to download.",
             "name": "file_name",
             "in": "query",
             "required": true
@@ -12634,13 +18082,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -12682,69 +18124,40 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/files": {
+    "/v2/clusters/{cluster_id}/monitored-operators": {
       "get": {
         "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
           {
             "agentAuth": []
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Downloads files relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Lists operators to be monitored for a cluster.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "V2DownloadClusterFiles",
+        "operationId": "V2ListOfClusterOperators",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster to return operators for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "install-config.yaml",
-              "custom_manifests.json",
-              "custom_manifests.yaml",
-              "arbiter.ign"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is downloading the file.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "An operator in the specified cluster to return its data.",
+            "name": "operator_name",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/monitored-operators-list"
             }
           },
           "401": {
@@ -12771,102 +18184,51 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/downloads/files-presigned": {
-      "get": {
+      },
+      "put": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "agentAuth": []
           }
         ],
-        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
+        "description": "Controller API to report of monitored operators.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "V2GetPresignedForClusterFiles",
+        "operationId": "v2ReportMonitoredOperatorStatus",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose operators are being monitored.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "install-config.yaml",
-              "logs",
-              "manifests"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "If downloading logs, the type of logs to download.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "If downloading a file related to a host, the relevant host.",
-            "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
-            "name": "additional_name",
-            "in": "query"
+            "description": "The operators monitor report.",
+            "name": "report-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/operator-monitor-report"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/presigned-url"
-            }
+            "description": "Success."
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -12906,11 +18268,17 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/hosts": {
+    "/v2/clusters/{cluster_id}/network-topology": {
       "get": {
         "security": [
           {
@@ -12919,53 +18287,31 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "agentAuth": []
           }
         ],
-        "description": "Get a list of cluster hosts according to supplied filters.",
+        "description": "Returns the network topology of the hosts of the cluster, as discovered by their connectivity checks: the\ninterfaces and subnets of the hosts, the L2 and L3 links between them with their latency and packet loss, and\nthe majority groups of the cluster. The topology is returned as JSON, or as a Graphviz DOT graph when the dot\nformat is requested.\n",
         "tags": [
           "installer"
         ],
-        "operationId": "ListClusterHosts",
+        "operationId": "v2GetClusterNetworkTopology",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose hosts should be retrieved.",
+            "description": "The cluster whose network topology is returned.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "enum": [
-              "master",
-              "arbiter",
-              "worker",
-              "auto-assign"
+              "json",
+              "dot"
             ],
             "type": "string",
-            "description": "Role to request.",
-            "name": "role",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Hosts status to request.",
-            "name": "status",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "If true return the host's inventory.",
-            "name": "with-inventory",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "If true return the host's connectivity.",
-            "name": "with-connectivity",
+            "default": "json",
+            "description": "The format of the topology. The dot format returns a Graphviz DOT graph as text/vnd.graphviz.",
+            "name": "format",
             "in": "query"
           }
         ],
@@ -12973,7 +18319,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "$ref": "#/definitions/network-topology"
             }
           },
           "401": {
@@ -13003,74 +18349,44 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/ignored-validations": {
-      "get": {
-        "description": "Fetch the validations which are to be ignored for this cluster.",
+    "/v2/clusters/{cluster_id}/plan": {
+      "post": {
+        "description": "Computes the changes needed to bring the cluster, its infra-env, hosts, custom manifests and operators\nto the state described by the plan, and applies them unless dry_run is set. Applying a plan that was\nalready applied makes no changes.\n",
         "tags": [
-          "installer"
+          "cluster_plan"
         ],
-        "operationId": "v2GetIgnoredValidations",
+        "operationId": "v2ApplyClusterPlan",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose failing validations should be ignored according to this list.",
+            "description": "The cluster the plan is applied to.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/ignored-validations"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
-      "put": {
-        "description": "Register the validations which are to be ignored for this cluster.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "v2SetIgnoredValidations",
-        "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose failing validations should be ignored according to this list.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "type": "boolean",
+            "default": false,
+            "description": "Only computes the changes, without applying them.",
+            "name": "dry_run",
+            "in": "query"
           },
           {
-            "description": "The validations to be ignored.",
-            "name": "ignored_validations",
+            "description": "The desired state of the cluster.",
+            "name": "plan",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ignored-validations"
+              "$ref": "#/definitions/cluster-plan"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/ignored-validations"
+              "$ref": "#/definitions/cluster-plan-result"
             }
           },
           "400": {
@@ -13097,6 +18413,18 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13106,7 +18434,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/install-config": {
+    "/v2/clusters/{cluster_id}/preflight-requirements": {
       "get": {
         "security": [
           {
@@ -13117,16 +18445,16 @@ func init() {
             ]
           }
         ],
-        "description": "Get the cluster's install config YAML.",
+        "description": "Get preflight requirements for a cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetClusterInstallConfig",
+        "operationId": "v2GetPreflightRequirements",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being retrieved.",
+            "description": "The cluster to return preflight requirements for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -13136,7 +18464,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/preflight-hardware-requirements"
             }
           },
           "401": {
@@ -13170,41 +18498,42 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Override values in the install config.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update installation finalizing progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateClusterInstallConfig",
+        "operationId": "v2UpdateClusterFinalizingProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being updated.",
+            "description": "The cluster being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Install config overrides.",
-            "name": "install-config-params",
+            "description": "New progress value.",
+            "name": "finalizing-progress",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/cluster-finalizing-progress"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "200": {
+            "description": "Update install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -13235,65 +18564,44 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/v2/clusters/{cluster_id}/logs": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Download cluster logs.",
-        "produces": [
-          "application/octet-stream"
-        ],
-        "tags": [
-          "installer"
-        ],
-        "operationId": "V2DownloadClusterLogs",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose logs should be downloaded.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "The type of logs to be downloaded.",
-            "name": "logs_type",
-            "in": "query"
           },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/revisions": {
+      "get": {
+        "description": "Lists the revisions of the configuration of the cluster, newest first. A revision is recorded whenever\nan update changes the configuration of the cluster.\n",
+        "tags": [
+          "cluster_revisions"
+        ],
+        "operationId": "v2ListClusterRevisions",
+        "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "A specific host in the cluster whose logs should be downloaded.",
-            "name": "host_id",
-            "in": "query"
+            "description": "The cluster whose revisions are used.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/cluster-revision-list"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -13320,12 +18628,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13333,70 +18635,42 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback": {
       "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+        "description": "Re-applies a revision of the configuration of the cluster. The changes are applied through the same\nupdates and validations as the equivalent REST calls. Manifests are not rolled back.\n",
         "tags": [
-          "installer"
+          "cluster_revisions"
         ],
-        "operationId": "V2UploadLogs",
+        "operationId": "v2RollbackClusterRevision",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be uploaded.",
+            "description": "The cluster whose revisions are used.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "maxLength": 262144000,
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
-          {
-            "enum": [
-              "host",
-              "controller"
-            ],
-            "type": "string",
-            "description": "The type of log file to be uploaded.",
-            "name": "logs_type",
-            "in": "query",
+            "type": "integer",
+            "format": "int64",
+            "description": "The revision of the configuration of the cluster.",
+            "name": "revision",
+            "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env ID of the host.",
-            "name": "infra_env_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
-            "name": "host_id",
-            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -13436,50 +18710,54 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/logs-progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update log collection state and progress.",
+    "/v2/clusters/{cluster_id}/revisions/{revision}/diff": {
+      "get": {
+        "description": "Returns the differences between a revision of the configuration of the cluster and its current\nconfiguration, or another revision if base_revision is set.\n",
         "tags": [
-          "installer"
+          "cluster_revisions"
         ],
-        "operationId": "v2UpdateClusterLogsProgress",
+        "operationId": "v2GetClusterRevisionDiff",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster whose revisions are used.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/logs-progress-params"
-            }
+            "type": "integer",
+            "format": "int64",
+            "description": "The revision of the configuration of the cluster.",
+            "name": "revision",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The revision to compare with, instead of the current configuration.",
+            "name": "base_revision",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-revision-diff"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -13505,61 +18783,49 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/manifests": {
+    "/v2/clusters/{cluster_id}/supported-platforms": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Lists manifests for customizing cluster installation.",
+        "description": "A list of platforms that this cluster can support in its current configuration.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "V2ListClusterManifests",
+        "operationId": "GetClusterSupportedPlatforms",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the manifests should be listed.",
+            "description": "The cluster whose platform types should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Include system generated manifests in results? Default is false.",
-            "name": "include_system_generated",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-manifests"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/platform_type"
+              }
             }
           },
           "401": {
@@ -13580,18 +18846,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13599,42 +18853,30 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "security": [
-          {
-            "userAuth": []
-          }
-        ],
-        "description": "Creates a manifest for customizing cluster installation.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/ui-settings": {
+      "get": {
+        "description": "Fetch cluster specific UI settings.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "V2CreateClusterManifest",
+        "operationId": "V2GetClusterUISettings",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be created.",
+            "description": "The cluster for which UI settings should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The new manifest to create.",
-            "name": "CreateManifestParams",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/create-manifest-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/manifest"
+              "type": "string"
             }
           },
           "400": {
@@ -13661,18 +18903,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13681,43 +18911,43 @@ func init() {
           }
         }
       },
-      "delete": {
-        "description": "Deletes a manifest from the cluster.",
+      "put": {
+        "description": "Update cluster specific UI settings.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "V2DeleteClusterManifest",
+        "operationId": "V2UpdateClusterUISettings",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be deleted.",
+            "description": "The cluster for which UI settings should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The manifest file name to delete from the cluster.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "Settings for the installer UI.",
+            "name": "ui-settings",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -13737,18 +18967,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13756,43 +18974,48 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
+      }
+    },
+    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
+      "post": {
         "security": [
           {
-            "userAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Updates a manifest for customizing cluster installation.",
+        "description": "Transfer the ingress certificate for the cluster.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "V2UpdateClusterManifest",
+        "operationId": "v2UploadClusterIngressCert",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be updated.",
+            "description": "The cluster to associate with the ingress certificate.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The manifest to be updated.",
-            "name": "UpdateManifestParams",
+            "description": "The ingress certificate.",
+            "name": "ingress-cert-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/update-manifest-params"
+              "$ref": "#/definitions/ingress-cert-params"
             }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/manifest"
-            }
+          "201": {
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -13824,12 +19047,70 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/component-versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of component versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2ListComponentVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-versions"
+            }
+          }
+        }
+      }
+    },
+    "/v2/domains": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of managed DNS domains.",
+        "tags": [
+          "managed_domains"
+        ],
+        "operationId": "V2ListManagedDomains",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-managed-domains"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13839,54 +19120,158 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/manifests/files": {
+    "/v2/events": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "watcherAuth": []
           }
         ],
-        "description": "Downloads cluster manifest.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Lists events for a cluster.",
         "tags": [
-          "manifests"
+          "events"
         ],
-        "operationId": "v2DownloadClusterManifest",
+        "operationId": "v2ListEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be downloaded.",
+            "description": "The cluster to return events for.",
             "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A host in the specified cluster to return events for (DEPRECATED. Use ` + "`" + `host_ids` + "`" + ` instead).",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string",
+              "format": "uuid"
+            },
+            "description": "Hosts in the specified cluster to return events for.",
+            "name": "host_ids",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to return events for.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "The maximum number of records to retrieve.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Number of records to skip before starting to return the records.",
+            "name": "offset",
+            "in": "query"
           },
           {
             "enum": [
-              "manifests",
-              "openshift"
+              "ascending",
+              "descending"
             ],
             "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
+            "default": "ascending",
+            "description": "Order by event_time of events retrieved.",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "error",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "description": "Retrieved events severities.",
+            "name": "severities",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "The manifest file name to download.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "Retrieved events message pattern.",
+            "name": "message",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Deleted hosts flag.",
+            "name": "deleted_hosts",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Cluster level events flag.",
+            "name": "cluster_level",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/event-list"
+            },
+            "headers": {
+              "Event-Count": {
+                "minimum": 0,
+                "type": "integer",
+                "description": "Count of events retrieved."
+              },
+              "Severity-Count-Critical": {
+                "minimum": 0,
+                "type": "integer",
+                "description": "Count of events with severity 'critical'."
+              },
+              "Severity-Count-Error": {
+                "minimum": 0,
+                "type": "integer",
+                "description": "Count of events with severity 'error'."
+              },
+              "Severity-Count-Info": {
+                "minimum": 0,
+                "type": "integer",
+                "description": "Count of events with severity 'info'."
+              },
+              "Severity-Count-Warning": {
+                "minimum": 0,
+                "type": "integer",
+                "description": "Count of events with severity 'warning'."
+              }
             }
           },
           "401": {
@@ -13913,12 +19298,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13926,42 +19305,37 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/monitored-operators": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Lists operators to be monitored for a cluster.",
+        "description": "Add new assisted installer event.",
         "tags": [
-          "operators",
-          "installer"
+          "events"
         ],
-        "operationId": "V2ListOfClusterOperators",
+        "operationId": "v2TriggerEvent",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster to return operators for.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "An operator in the specified cluster to return its data.",
-            "name": "operator_name",
-            "in": "query"
+            "description": "The event to be created.",
+            "name": "trigger-event-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/event"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/monitored-operators-list"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -13988,53 +19362,78 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Cluster cannot accept new agents due to its current state.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/v2/infra-env/{infra_env_id}/hosts/{host_id}/downloads/ignition": {
+      "get": {
         "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
           {
             "agentAuth": []
           }
         ],
-        "description": "Controller API to report of monitored operators.",
+        "description": "Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "v2ReportMonitoredOperatorStatus",
+        "operationId": "v2DownloadHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose operators are being monitored.",
-            "name": "cluster_id",
+            "description": "The infra-env of the host whose ignition file should be downloaded.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The operators monitor report.",
-            "name": "report-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/operator-monitor-report"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition file should be downloaded.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Bad Request",
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -14082,7 +19481,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/preflight-requirements": {
+    "/v2/infra-envs": {
       "get": {
         "security": [
           {
@@ -14091,28 +19490,36 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "watcherAuth": []
           }
         ],
-        "description": "Get preflight requirements for a cluster.",
+        "description": "Retrieves the list of infra-envs.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetPreflightRequirements",
+        "operationId": "ListInfraEnvs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return preflight requirements for.",
+            "description": "If provided, returns only infra-envs which directly reference this cluster.",
             "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If provided, returns only infra-envs that are owned by the specified user.",
+            "name": "owner",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/preflight-hardware-requirements"
+              "$ref": "#/definitions/infra-env-list"
             }
           },
           "401": {
@@ -14127,61 +19534,61 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update installation finalizing progress.",
+      },
+      "post": {
+        "description": "Creates a new OpenShift Discovery ISO.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateClusterFinalizingProgress",
+        "operationId": "RegisterInfraEnv",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster being updated.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "New progress value.",
-            "name": "finalizing-progress",
+            "description": "The parameters for the generated ISO.",
+            "name": "infraenv-create-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/cluster-finalizing-progress"
+              "$ref": "#/definitions/infra-env-create-params"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Update install progress."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/infra-env"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -14207,14 +19614,20 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14222,7 +19635,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/supported-platforms": {
+    "/v2/infra-envs/{infra_env_id}": {
       "get": {
         "security": [
           {
@@ -14231,19 +19644,31 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "imageAuth": []
+          },
+          {
+            "imageURLAuth": []
           }
         ],
-        "description": "A list of platforms that this cluster can support in its current configuration.",
+        "description": "Retrieves the details of the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterSupportedPlatforms",
+        "operationId": "GetInfraEnv",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose platform types should be retrieved.",
-            "name": "cluster_id",
+            "description": "The infra-env to be retrieved.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
           }
@@ -14252,10 +19677,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/platform_type"
-              }
+              "$ref": "#/definitions/infra-env"
             }
           },
           "401": {
@@ -14267,53 +19689,60 @@ func init() {
           "403": {
             "description": "Forbidden.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
-          "404": {
-            "description": "Error.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/ui-settings": {
-      "get": {
-        "description": "Fetch cluster specific UI settings.",
+      },
+      "delete": {
+        "description": "Deletes an infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetClusterUISettings",
+        "operationId": "DeregisterInfraEnv",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which UI settings should be retrieved.",
-            "name": "cluster_id",
+            "description": "The infra-env to be deleted.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "string"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -14333,44 +19762,62 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       },
-      "put": {
-        "description": "Update cluster specific UI settings.",
+      "patch": {
+        "description": "Updates an infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2UpdateClusterUISettings",
+        "operationId": "UpdateInfraEnv",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which UI settings should be updated.",
-            "name": "cluster_id",
+            "description": "The infra-env to be updated.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Settings for the installer UI.",
-            "name": "ui-settings",
+            "description": "The properties to update.",
+            "name": "infra-env-update-params",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/infra-env-update-params"
             }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/infra-env"
             }
           },
           "400": {
@@ -14397,58 +19844,123 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
-      "post": {
+    "/v2/infra-envs/{infra_env_id}/downloads/files": {
+      "get": {
         "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
           {
             "agentAuth": []
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "imageAuth": []
+          },
+          {
+            "imageURLAuth": []
           }
         ],
-        "description": "Transfer the ingress certificate for the cluster.",
+        "description": "Downloads the customized ignition file for this host",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2UploadClusterIngressCert",
+        "operationId": "v2DownloadInfraEnvFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to associate with the ingress certificate.",
-            "name": "cluster_id",
+            "description": "The infra-env whose file should be downloaded.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The ingress certificate.",
-            "name": "ingress-cert-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
-            }
+            "enum": [
+              "discovery.ign",
+              "ipxe-script",
+              "static-network-config"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "format": "mac",
+            "description": "Mac address of the host running ipxe script.",
+            "name": "mac",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "discovery-image-always",
+              "boot-order-control"
+            ],
+            "type": "string",
+            "description": "Specify the script type to be served for iPXE.",
+            "name": "ipxe_script_type",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "full-iso",
+              "minimal-iso",
+              "disconnected-iso"
+            ],
+            "type": "string",
+            "description": "Overrides the ISO type for the discovery ignition.",
+            "name": "discovery_iso_type",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14477,196 +19989,68 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/v2/component-versions": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "List of component versions.",
-        "tags": [
-          "versions"
-        ],
-        "operationId": "v2ListComponentVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-versions"
-            }
-          }
-        }
-      }
-    },
-    "/v2/domains": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "List of managed DNS domains.",
-        "tags": [
-          "managed_domains"
-        ],
-        "operationId": "V2ListManagedDomains",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-managed-domains"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/v2/events": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
           },
-          {
-            "urlAuth": []
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
-          {
-            "watcherAuth": []
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        ],
-        "description": "Lists events for a cluster.",
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/downloads/files-presigned": {
+      "get": {
+        "description": "Creates a new pre-signed download URL for the infra-env.",
         "tags": [
-          "events"
+          "installer"
         ],
-        "operationId": "v2ListEvents",
+        "operationId": "GetInfraEnvPresignedFileURL",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return events for.",
-            "name": "cluster_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A host in the specified cluster to return events for (DEPRECATED. Use ` + "`" + `host_ids` + "`" + ` instead).",
-            "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string",
-              "format": "uuid"
-            },
-            "description": "Hosts in the specified cluster to return events for.",
-            "name": "host_ids",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env to return events for.",
+            "description": "The file's infra-env.",
             "name": "infra_env_id",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "The maximum number of records to retrieve.",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "Number of records to skip before starting to return the records.",
-            "name": "offset",
-            "in": "query"
+            "in": "path",
+            "required": true
           },
           {
             "enum": [
-              "ascending",
-              "descending"
+              "discovery.ign",
+              "ipxe-script"
             ],
             "type": "string",
-            "default": "ascending",
-            "description": "Order by event_time of events retrieved.",
-            "name": "order",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "enum": [
-                "info",
-                "warning",
-                "error",
-                "critical"
-              ],
-              "type": "string"
-            },
-            "description": "Retrieved events severities.",
-            "name": "severities",
-            "in": "query"
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           },
           {
+            "enum": [
+              "discovery-image-always",
+              "boot-order-control"
+            ],
             "type": "string",
-            "description": "Retrieved events message pattern.",
-            "name": "message",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "Deleted hosts flag.",
-            "name": "deleted_hosts",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "Cluster level events flag.",
-            "name": "cluster_level",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "A comma-separated list of event categories.",
-            "name": "categories",
+            "description": "Specify the script type to be served for iPXE.",
+            "name": "ipxe_script_type",
             "in": "query"
           }
         ],
@@ -14674,34 +20058,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/event-list"
-            },
-            "headers": {
-              "Event-Count": {
-                "minimum": 0,
-                "type": "integer",
-                "description": "Count of events retrieved."
-              },
-              "Severity-Count-Critical": {
-                "minimum": 0,
-                "type": "integer",
-                "description": "Count of events with severity 'critical'."
-              },
-              "Severity-Count-Error": {
-                "minimum": 0,
-                "type": "integer",
-                "description": "Count of events with severity 'error'."
-              },
-              "Severity-Count-Info": {
-                "minimum": 0,
-                "type": "integer",
-                "description": "Count of events with severity 'info'."
-              },
-              "Severity-Count-Warning": {
-                "minimum": 0,
-                "type": "integer",
-                "description": "Count of events with severity 'warning'."
-              }
+              "$ref": "#/definitions/presigned-url"
             }
           },
           "401": {
@@ -14733,37 +20090,48 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Add new assisted installer event.",
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/downloads/image-url": {
+      "get": {
+        "description": "Creates a new pre-signed image download URL for the infra-env.",
         "tags": [
-          "events"
+          "installer"
         ],
-        "operationId": "v2TriggerEvent",
+        "operationId": "GetInfraEnvDownloadURL",
         "parameters": [
           {
-            "description": "The event to be created.",
-            "name": "trigger-event-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/event"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to be retrieved.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/presigned-url"
+            }
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14792,12 +20160,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Cluster cannot accept new agents due to its current state.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -14819,7 +20181,7 @@ func init() {
         }
       }
     },
-    "/v2/infra-env/{infra_env_id}/hosts/{host_id}/downloads/ignition": {
+    "/v2/infra-envs/{infra_env_id}/downloads/minimal-initrd": {
       "get": {
         "security": [
           {
@@ -14830,42 +20192,41 @@ func init() {
             ]
           },
           {
-            "agentAuth": []
+            "urlAuth": []
+          },
+          {
+            "imageAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned",
+        "description": "Get the initial ramdisk for minimal ISO based installations.\n",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2DownloadHostIgnition",
+        "operationId": "DownloadMinimalInitrd",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be downloaded.",
+            "description": "The infra-env of the host that should be retrieved.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be downloaded.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "type": "string",
+              "format": "binary"
             }
           },
+          "204": {
+            "description": "Empty Success."
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -14891,7 +20252,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Error.",
+            "description": "Conflict.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14901,17 +20262,11 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs": {
+    "/v2/infra-envs/{infra_env_id}/hosts": {
       "get": {
         "security": [
           {
@@ -14922,34 +20277,29 @@ func init() {
             ]
           },
           {
-            "watcherAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Retrieves the list of infra-envs.",
+        "description": "Retrieves the list of OpenShift hosts that belong the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "ListInfraEnvs",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "If provided, returns only infra-envs which directly reference this cluster.",
-            "name": "cluster_id",
-            "in": "query"
-          },
+        "operationId": "v2ListHosts",
+        "parameters": [
           {
             "type": "string",
-            "description": "If provided, returns only infra-envs that are owned by the specified user.",
-            "name": "owner",
-            "in": "query"
+            "format": "uuid",
+            "description": "The infra-env that the hosts are asociated with.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env-list"
+              "$ref": "#/definitions/host-list"
             }
           },
           "401": {
@@ -14991,27 +20341,46 @@ func init() {
         }
       },
       "post": {
-        "description": "Creates a new OpenShift Discovery ISO.",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Registers a new OpenShift agent.",
         "tags": [
           "installer"
         ],
-        "operationId": "RegisterInfraEnv",
+        "operationId": "v2RegisterHost",
         "parameters": [
           {
-            "description": "The parameters for the generated ISO.",
-            "name": "infraenv-create-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env that the agent is associated with.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The description of the agent being registered.",
+            "name": "new-host-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/infra-env-create-params"
+              "$ref": "#/definitions/host-create-params"
             }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is registering the agent.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
+              "$ref": "#/definitions/host_registration_response"
             }
           },
           "400": {
@@ -15045,7 +20414,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Error.",
+            "description": "Cluster cannot accept new agents due to its current state.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15061,53 +20430,53 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "imageAuth": []
-          },
-          {
-            "imageURLAuth": []
-          }
-        ],
-        "description": "Retrieves the details of the infra-env.",
+    "/v2/infra-envs/{infra_env_id}/hosts/actions/bulk": {
+      "post": {
+        "description": "Applies a list of operations to the hosts of the infra-env. The operations that update the hosts in place are applied in a single transaction, so either all of them are applied or none is. Bind and unbind operations are applied one by one before them. Each operation is subject to the same validations as the equivalent single host call.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetInfraEnv",
+        "operationId": "v2BulkUpdateHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be retrieved.",
+            "description": "The infra-env of the hosts.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The operations to apply.",
+            "name": "bulk-host-operations",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bulk-host-operations"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
+            "description": "The result of each operation.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
+              "$ref": "#/definitions/bulk-host-operations-result"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -15128,51 +20497,55 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
-      "delete": {
-        "description": "Deletes an infra-env.",
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the details of the OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "DeregisterInfraEnv",
+        "operationId": "v2GetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be deleted.",
+            "description": "The infra-env of the host that should be retrieved.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be retrieved.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -15198,12 +20571,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -15218,37 +20585,33 @@ func init() {
           }
         }
       },
-      "patch": {
-        "description": "Updates an infra-env.",
+      "delete": {
+        "description": "Deregisters an OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateInfraEnv",
+        "operationId": "v2DeregisterHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be updated.",
+            "description": "The infra-env of the host that should be deregistered.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The properties to update.",
-            "name": "infra-env-update-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/infra-env-update-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be deregistered.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/infra-env"
-            }
+          "204": {
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -15280,117 +20643,56 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/downloads/files": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "imageAuth": []
-          },
-          {
-            "imageURLAuth": []
-          }
-        ],
-        "description": "Downloads the customized ignition file for this host",
-        "produces": [
-          "application/octet-stream"
-        ],
+      },
+      "patch": {
+        "description": "Update an Openshift host",
         "tags": [
           "installer"
         ],
-        "operationId": "v2DownloadInfraEnvFiles",
+        "operationId": "v2UpdateHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env whose file should be downloaded.",
+            "description": "The infra-env ID of the host to be updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "discovery.ign",
-              "ipxe-script",
-              "static-network-config"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "mac",
-            "description": "Mac address of the host running ipxe script.",
-            "name": "mac",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "discovery-image-always",
-              "boot-order-control"
-            ],
             "type": "string",
-            "description": "Specify the script type to be served for iPXE.",
-            "name": "ipxe_script_type",
-            "in": "query"
+            "format": "uuid",
+            "description": "The host that should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           },
           {
-            "enum": [
-              "full-iso",
-              "minimal-iso",
-              "disconnected-iso"
-            ],
-            "type": "string",
-            "description": "Overrides the ISO type for the discovery ignition.",
-            "name": "discovery_iso_type",
-            "in": "query"
+            "description": "The properties to update.",
+            "name": "host-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-update-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
-            "description": "Bad Request.",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15430,15 +20732,62 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/accept-hardware": {
+      "post": {
+        "description": "Accepts the current hardware of a host whose hardware changed after its validations passed, so that the\nhardware drift no longer blocks the installation.\n",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2AcceptHostHardware",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose hardware is accepted.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
           },
-          "501": {
-            "description": "Not implemented.",
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15446,49 +20795,51 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/files-presigned": {
-      "get": {
-        "description": "Creates a new pre-signed download URL for the infra-env.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/bind": {
+      "post": {
+        "description": "Bind host to a cluster",
         "tags": [
           "installer"
         ],
-        "operationId": "GetInfraEnvPresignedFileURL",
+        "operationId": "BindHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The file's infra-env.",
+            "description": "The infra-env of the host that is being bound.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "discovery.ign",
-              "ipxe-script"
-            ],
             "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
+            "format": "uuid",
+            "description": "The host that is being bound.",
+            "name": "host_id",
+            "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "discovery-image-always",
-              "boot-order-control"
-            ],
-            "type": "string",
-            "description": "Specify the script type to be served for iPXE.",
-            "name": "ipxe_script_type",
-            "in": "query"
+            "description": "The parameters for the host binding.",
+            "name": "bind-host-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bind-host-params"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -15536,34 +20887,36 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/image-url": {
-      "get": {
-        "description": "Creates a new pre-signed image download URL for the infra-env.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/install": {
+      "post": {
+        "description": "install specific host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetInfraEnvDownloadURL",
+        "operationId": "v2InstallHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be retrieved.",
+            "description": "The infra-env of the host that is being installed.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being installed.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
-            }
-          },
-          "400": {
-            "description": "Bad Request.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -15584,8 +20937,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15595,68 +20948,42 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/minimal-initrd": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "imageAuth": []
-          }
-        ],
-        "description": "Get the initial ramdisk for minimal ISO based installations.\n",
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset": {
+      "post": {
+        "description": "reset a failed host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadMinimalInitrd",
+        "operationId": "v2ResetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that should be retrieved.",
+            "description": "The infra-env of the host that is being reset.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/host"
             }
           },
-          "204": {
-            "description": "Empty Success."
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -15675,14 +21002,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
-            "description": "Conflict.",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15696,40 +21017,50 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Retrieves the list of OpenShift hosts that belong the infra-env.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
+      "patch": {
+        "description": "Reset failed host validation. It may be performed on any host validation with persistent validation result.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ListHosts",
+        "summary": "Reset failed host validation.",
+        "operationId": "v2ResetHostValidation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env that the hosts are asociated with.",
+            "description": "The infra-env of the host that its validation is being reset.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that its validation is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The id of the validation being reset.",
+            "name": "validation_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -15744,73 +21075,57 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/unbind": {
       "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Registers a new OpenShift agent.",
+        "description": "Unbind host to a cluster",
         "tags": [
           "installer"
         ],
-        "operationId": "v2RegisterHost",
+        "operationId": "UnbindHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env that the agent is associated with.",
+            "description": "The infra-env of the host that is being bound.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
-          {
-            "description": "The description of the agent being registered.",
-            "name": "new-host-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-create-params"
-            }
-          },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is registering the agent.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "format": "uuid",
+            "description": "The host that is being bound.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host_registration_response"
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
@@ -15844,7 +21159,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Cluster cannot accept new agents due to its current state.",
+            "description": "Conflict.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15870,27 +21185,18 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}": {
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/hardware-revisions": {
       "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the details of the OpenShift host.",
+        "description": "Lists the revisions of the hardware of the host, newest first, with the changes of each revision from the\nprevious one. A revision is recorded whenever the inventory of the host reports a different hardware\nfingerprint.\n",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetHost",
+        "operationId": "v2ListHostHardwareRevisions",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that should be retrieved.",
+            "description": "The infra-env of the host.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -15898,7 +21204,7 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that should be retrieved.",
+            "description": "The host whose hardware revisions are listed.",
             "name": "host_id",
             "in": "path",
             "required": true
@@ -15908,7 +21214,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/host-hardware-revision-list"
             }
           },
           "401": {
@@ -15929,37 +21235,27 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
-      "delete": {
-        "description": "Deregisters an OpenShift host.",
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition": {
+      "get": {
+        "description": "Fetch the ignition file for this host as a string. In case of unbound host produces an error",
         "tags": [
           "installer"
         ],
-        "operationId": "v2DeregisterHost",
+        "operationId": "v2GetHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that should be deregistered.",
+            "description": "The infra-env of the host whose ignition file should be obtained.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -15967,20 +21263,17 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that should be deregistered.",
+            "description": "The host whose ignition file should be obtained.",
             "name": "host_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host-ignition-params"
             }
           },
           "401": {
@@ -16012,20 +21305,32 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       },
       "patch": {
-        "description": "Update an Openshift host",
+        "description": "Patch the ignition file for this host",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHost",
+        "operationId": "v2UpdateHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env ID of the host to be updated.",
+            "description": "The infra-env of the host whose ignition file should be updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -16033,27 +21338,24 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that should be updated.",
+            "description": "The host whose ignition file should be updated.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The properties to update.",
-            "name": "host-update-params",
+            "description": "Ignition config overrides.",
+            "name": "host-ignition-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-update-params"
+              "$ref": "#/definitions/host-ignition-params"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host"
-            }
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -16085,14 +21387,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -16100,18 +21402,18 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/bind": {
-      "post": {
-        "description": "Bind host to a cluster",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/installer-args": {
+      "patch": {
+        "description": "Updates a host's installer arguments.",
         "tags": [
           "installer"
         ],
-        "operationId": "BindHost",
+        "operationId": "v2UpdateHostInstallerArgs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being bound.",
+            "description": "The infra-env of the host whose installer arguments should be updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -16119,23 +21421,23 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being bound.",
+            "description": "The host whose installer arguments should be updated.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The parameters for the host binding.",
-            "name": "bind-host-params",
+            "description": "The updated installer arguments.",
+            "name": "installer-args-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/bind-host-params"
+              "$ref": "#/definitions/installer-args-params"
             }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/host"
@@ -16171,20 +21473,20 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -16192,18 +21494,23 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/install": {
-      "post": {
-        "description": "install specific host for day2 cluster.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions": {
+      "get": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the next operations that the host agent needs to perform.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2InstallHost",
+        "operationId": "v2GetNextSteps",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being installed.",
+            "description": "The infra-env of the host that is retrieving instructions.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -16211,17 +21518,29 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being installed.",
+            "description": "The host that is retrieving instructions.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The time on the host as seconds since the Unix epoch.",
+            "name": "timestamp",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving instructions.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/steps"
             }
           },
           "401": {
@@ -16242,8 +21561,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -16253,22 +21572,43 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset": {
+      },
       "post": {
-        "description": "reset a failed host for day2 cluster.",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Posts the result of the operations from the host agent.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ResetHost",
+        "operationId": "v2PostStepReply",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is posting results.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being reset.",
+            "description": "The infra-env of the host that is posting results.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -16276,17 +21616,28 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being reset.",
+            "description": "The host that is posting results.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The results to be posted.",
+            "name": "reply",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/step-reply"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -16307,8 +21658,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -16318,23 +21669,39 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
-      "patch": {
-        "description": "Reset failed host validation. It may be performed on any host validation with persistent validation result.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/logs-progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update log collection state and progress.",
         "tags": [
           "installer"
         ],
-        "summary": "Reset failed host validation.",
-        "operationId": "v2ResetHostValidation",
+        "operationId": "v2UpdateHostLogsProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that its validation is being reset.",
+            "description": "The infra-env whose log progress is being updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -16342,31 +21709,24 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that its validation is being reset.",
+            "description": "The host whose log progress is being updated.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "The id of the validation being reset.",
-            "name": "validation_id",
-            "in": "path",
-            "required": true
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Bad Request",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -16386,6 +21746,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -16397,22 +21763,45 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/unbind": {
-      "post": {
-        "description": "Unbind host to a cluster",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update installation progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "UnbindHost",
+        "operationId": "v2UpdateHostInstallProgress",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is updating progress.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being bound.",
+            "description": "The infra-env of the host being updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -16420,24 +21809,24 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being bound.",
+            "description": "The ID of the host to update.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "New progress value.",
+            "name": "host-progress",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-progress"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+            "description": "Update install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -16463,24 +21852,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Conflict.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "503": {
             "description": "Unavailable.",
             "schema": {
@@ -16490,37 +21867,26 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition": {
-      "get": {
-        "description": "Fetch the ignition file for this host as a string. In case of unbound host produces an error",
+    "/v2/infra-envs/{infra_env_id}/regenerate-signing-key": {
+      "post": {
+        "description": "Regenerate InfraEnv token signing key.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetHostIgnition",
+        "operationId": "RegenerateInfraEnvSigningKey",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be obtained.",
+            "description": "The target InfraEnv.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be obtained.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host-ignition-params"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -16551,57 +21917,82 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/v2/installer-cache/releases": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
+          }
+        ],
+        "description": "Lists the releases in the installer cache, with their size, last use and pin status. The local cache is the one of the replica that serves the request.",
+        "tags": [
+          "installer_cache"
+        ],
+        "operationId": "v2ListInstallerCacheReleases",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/installer-cache-release-list"
+            }
           },
-          "501": {
-            "description": "Not implemented.",
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
       },
-      "patch": {
-        "description": "Patch the ignition file for this host",
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Pre-warms the installer cache with the installer binary of a release image ahead of demand. The binary is extracted in the background.",
         "tags": [
-          "installer"
+          "installer_cache"
         ],
-        "operationId": "v2UpdateHostIgnition",
+        "operationId": "v2PrewarmInstallerCacheRelease",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be updated.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Ignition config overrides.",
-            "name": "host-ignition-params",
+            "description": "The release image to pre-warm.",
+            "name": "prewarm-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "$ref": "#/definitions/installer-cache-prewarm-params"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/installer-cache-release"
+            }
           },
           "400": {
             "description": "Error.",
@@ -16621,72 +22012,43 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
-        }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/installer-args": {
+        }
+      },
       "patch": {
-        "description": "Updates a host's installer arguments.",
+        "security": [
+          {
+            "userAuth": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Pins or unpins a release that was pre-warmed. Pinned releases are never evicted from the installer cache.",
         "tags": [
-          "installer"
+          "installer_cache"
         ],
-        "operationId": "v2UpdateHostInstallerArgs",
+        "operationId": "v2UpdateInstallerCacheRelease",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env of the host whose installer arguments should be updated.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose installer arguments should be updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The updated installer arguments.",
-            "name": "installer-args-params",
+            "description": "The release image and its pin status.",
+            "name": "update-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/installer-args-params"
+              "$ref": "#/definitions/installer-cache-release-update-params"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/installer-cache-release"
             }
           },
           "400": {
@@ -16713,14 +22075,54 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/v2/openshift-versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift supported versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2ListSupportedOpenshiftVersions",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Retrieves only the versions that contain the specified substring in their display name.",
+            "name": "version",
+            "in": "query"
           },
-          "409": {
-            "description": "Error.",
+          {
+            "type": "boolean",
+            "description": "If true, returns only the latest version for each minor.",
+            "name": "only_latest",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/openshift-versions"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -16731,8 +22133,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -16740,150 +22142,208 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions": {
+    "/v2/operators/bundles": {
       "get": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Retrieves the next operations that the host agent needs to perform.",
+        "description": "Retrieves a list of available bundles filtered by support level.",
         "tags": [
-          "installer"
+          "operators"
         ],
-        "operationId": "v2GetNextSteps",
+        "summary": "Get list of available bundles",
+        "operationId": "V2ListBundles",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The infra-env of the host that is retrieving instructions.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
+            "description": "Version of the OpenShift cluster. If the parameter is not specified, no filtering is applied.",
+            "name": "openshift_version",
+            "in": "query"
           },
           {
+            "enum": [
+              "x86_64",
+              "aarch64",
+              "arm64",
+              "ppc64le",
+              "s390x",
+              "multi"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "The host that is retrieving instructions.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "default": "x86_64",
+            "description": "The CPU architecture of the image (x86_64/arm64/etc). openshift_version must be set.",
+            "name": "cpu_architecture",
+            "in": "query"
           },
           {
-            "type": "integer",
-            "description": "The time on the host as seconds since the Unix epoch.",
-            "name": "timestamp",
+            "enum": [
+              "baremetal",
+              "none",
+              "nutanix",
+              "vsphere",
+              "external"
+            ],
+            "type": "string",
+            "description": "The provider platform type. openshift_version must be set.",
+            "name": "platform_type",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is retrieving instructions.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external or if openshift_version is not set.",
+            "name": "external_platform_name",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "SNO"
+              ],
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Array of feature IDs that affect bundle composition (e.g., [\"SNO\"] for Single Node OpenShift).",
+            "name": "feature_ids",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/steps"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
+            "description": "Success",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/bundle"
+              }
             }
           },
-          "403": {
-            "description": "Forbidden.",
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
-          "404": {
-            "description": "Error.",
+          "500": {
+            "description": "Internal server error",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/v2/operators/bundles/{id}": {
+      "get": {
+        "description": "Retrieves an array of operator properties for the specified bundle when some features are activated.",
+        "tags": [
+          "operators"
+        ],
+        "summary": "Get operator properties for a bundle",
+        "operationId": "V2GetBundle",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Identifier of the bundle, for example, ` + "`" + `virtualization` + "`" + ` or ` + "`" + `openshift-ai` + "`" + `.",
+            "name": "id",
+            "in": "path",
+            "required": true
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "SNO"
+              ],
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Array of feature IDs that affect bundle composition (e.g., [\"SNO\"] for Single Node OpenShift).",
+            "name": "feature_ids",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/bundle"
             }
           },
-          "500": {
+          "400": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "404": {
+            "description": "Bundle not found",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Internal server error",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/v2/release-sources": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves openshift release sources configuration.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2ListReleaseSources",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/release-sources"
+            }
+          }
+        }
+      }
+    },
+    "/v2/subscriptions": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Posts the result of the operations from the host agent.",
+        "description": "Lists the webhook subscriptions of the organization.",
         "tags": [
-          "installer"
+          "subscriptions"
         ],
-        "operationId": "v2PostStepReply",
+        "operationId": "v2ListSubscriptions",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is posting results.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env of the host that is posting results.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is posting results.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The results to be posted.",
-            "name": "reply",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/step-reply"
-            }
+            "description": "If provided, returns only subscriptions for this cluster.",
+            "name": "cluster_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/subscription-list"
             }
           },
           "401": {
@@ -16898,18 +22358,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -16921,58 +22369,38 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/logs-progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update log collection state and progress.",
+      },
+      "post": {
+        "description": "Registers a webhook that is called when the subscribed clusters and hosts change.",
         "tags": [
-          "installer"
+          "subscriptions"
         ],
-        "operationId": "v2UpdateHostLogsProgress",
+        "operationId": "v2RegisterSubscription",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env whose log progress is being updated.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose log progress is being updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
+            "description": "The properties describing the new subscription.",
+            "name": "new-subscription-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/logs-progress-params"
+              "$ref": "#/definitions/subscription-create-params"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/subscription"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -16992,18 +22420,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -17015,64 +22431,42 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/progress": {
-      "put": {
+    "/v2/subscriptions/{subscription_id}": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Update installation progress.",
+        "description": "Retrieves the details of the webhook subscription.",
         "tags": [
-          "installer"
+          "subscriptions"
         ],
-        "operationId": "v2UpdateHostInstallProgress",
+        "operationId": "v2GetSubscription",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is updating progress.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env of the host being updated.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the host to update.",
-            "name": "host_id",
+            "description": "The subscription to be retrieved.",
+            "name": "subscription_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "New progress value.",
-            "name": "host-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-progress"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Update install progress."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/subscription"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -17092,40 +22486,32 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/regenerate-signing-key": {
-      "post": {
-        "description": "Regenerate InfraEnv token signing key.",
+      },
+      "delete": {
+        "description": "Deletes a webhook subscription and its deliveries.",
         "tags": [
-          "installer"
+          "subscriptions"
         ],
-        "operationId": "RegenerateInfraEnvSigningKey",
+        "operationId": "v2DeregisterSubscription",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The target InfraEnv.",
-            "name": "infra_env_id",
+            "description": "The subscription to be deleted.",
+            "name": "subscription_id",
             "in": "path",
             "required": true
           }
@@ -17152,60 +22538,72 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/v2/openshift-versions": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the list of OpenShift supported versions.",
+      },
+      "patch": {
+        "description": "Updates a webhook subscription.",
         "tags": [
-          "versions"
+          "subscriptions"
         ],
-        "operationId": "v2ListSupportedOpenshiftVersions",
+        "operationId": "v2UpdateSubscription",
         "parameters": [
           {
             "type": "string",
-            "description": "Retrieves only the versions that contain the specified substring in their display name.",
-            "name": "version",
-            "in": "query"
+            "format": "uuid",
+            "description": "The subscription to be updated.",
+            "name": "subscription_id",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "boolean",
-            "description": "If true, returns only the latest version for each minor.",
-            "name": "only_latest",
-            "in": "query"
+            "description": "The properties to update.",
+            "name": "subscription-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/subscription-update-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/openshift-versions"
+              "$ref": "#/definitions/subscription"
             }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -17216,8 +22614,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -17225,87 +22623,92 @@ func init() {
         }
       }
     },
-    "/v2/operators/bundles": {
+    "/v2/subscriptions/{subscription_id}/deliveries": {
       "get": {
-        "description": "Retrieves a list of available bundles filtered by support level.",
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the deliveries of the webhook subscription, along with their delivery attempts, starting from the most recent.",
         "tags": [
-          "operators"
+          "subscriptions"
         ],
-        "summary": "Get list of available bundles",
-        "operationId": "V2ListBundles",
+        "operationId": "v2ListSubscriptionDeliveries",
         "parameters": [
           {
             "type": "string",
-            "description": "Version of the OpenShift cluster. If the parameter is not specified, no filtering is applied.",
-            "name": "openshift_version",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "x86_64",
-              "aarch64",
-              "arm64",
-              "ppc64le",
-              "s390x",
-              "multi"
-            ],
-            "type": "string",
-            "default": "x86_64",
-            "description": "The CPU architecture of the image (x86_64/arm64/etc). openshift_version must be set.",
-            "name": "cpu_architecture",
-            "in": "query"
+            "format": "uuid",
+            "description": "The subscription to list the deliveries of.",
+            "name": "subscription_id",
+            "in": "path",
+            "required": true
           },
           {
             "enum": [
-              "baremetal",
-              "none",
-              "nutanix",
-              "vsphere",
-              "external"
+              "pending",
+              "delivered",
+              "failed"
             ],
             "type": "string",
-            "description": "The provider platform type. openshift_version must be set.",
-            "name": "platform_type",
+            "description": "If provided, returns only deliveries with this status.",
+            "name": "status",
             "in": "query"
           },
           {
-            "type": "string",
-            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external or if openshift_version is not set.",
-            "name": "external_platform_name",
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "The maximum number of records to retrieve.",
+            "name": "limit",
             "in": "query"
           },
           {
-            "type": "array",
-            "items": {
-              "enum": [
-                "SNO"
-              ],
-              "type": "string"
-            },
-            "collectionFormat": "multi",
-            "description": "Array of feature IDs that affect bundle composition (e.g., [\"SNO\"] for Single Node OpenShift).",
-            "name": "feature_ids",
+            "minimum": 0,
+            "type": "integer",
+            "description": "Number of records to skip before starting to return the records.",
+            "name": "offset",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Success",
+            "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/bundle"
-              }
+              "$ref": "#/definitions/subscription-delivery-list"
             }
           },
-          "400": {
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
           "500": {
-            "description": "Internal server error",
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -17313,85 +22716,72 @@ func init() {
         }
       }
     },
-    "/v2/operators/bundles/{id}": {
-      "get": {
-        "description": "Retrieves an array of operator properties for the specified bundle when some features are activated.",
+    "/v2/subscriptions/{subscription_id}/deliveries/{delivery_id}/actions/redeliver": {
+      "post": {
+        "description": "Schedules the delivery to be sent again, regardless of its previous attempts.",
         "tags": [
-          "operators"
+          "subscriptions"
         ],
-        "summary": "Get operator properties for a bundle",
-        "operationId": "V2GetBundle",
+        "operationId": "v2RedeliverSubscriptionDelivery",
         "parameters": [
           {
             "type": "string",
-            "description": "Identifier of the bundle, for example, ` + "`" + `virtualization` + "`" + ` or ` + "`" + `openshift-ai` + "`" + `.",
-            "name": "id",
+            "format": "uuid",
+            "description": "The subscription of the delivery.",
+            "name": "subscription_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "array",
-            "items": {
-              "enum": [
-                "SNO"
-              ],
-              "type": "string"
-            },
-            "collectionFormat": "multi",
-            "description": "Array of feature IDs that affect bundle composition (e.g., [\"SNO\"] for Single Node OpenShift).",
-            "name": "feature_ids",
-            "in": "query"
+            "type": "string",
+            "format": "uuid",
+            "description": "The delivery to be sent again.",
+            "name": "delivery_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success",
+          "202": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/bundle"
+              "$ref": "#/definitions/subscription-delivery"
             }
           },
-          "400": {
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "404": {
-            "description": "Bundle not found",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
           "500": {
-            "description": "Internal server error",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/v2/release-sources": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves openshift release sources configuration.",
-        "tags": [
-          "versions"
-        ],
-        "operationId": "v2ListReleaseSources",
-        "responses": {
-          "200": {
-            "description": "Success.",
+          },
+          "501": {
+            "description": "Not implemented.",
             "schema": {
-              "$ref": "#/definitions/release-sources"
+              "$ref": "#/definitions/error"
             }
           }
         }
@@ -17776,7 +23166,114 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/operator-properties"
+              "$ref": "#/definitions/operator-properties"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/watch": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "watcherAuth": []
+          }
+        ],
+        "description": "Streams cluster, host, infra-env and event notifications as server-sent events.",
+        "produces": [
+          "text/event-stream"
+        ],
+        "tags": [
+          "events"
+        ],
+        "operationId": "v2Watch",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to stream notifications for.",
+            "name": "cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to stream notifications for.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "ClusterState",
+                "HostState",
+                "InfraEnv",
+                "Event"
+              ],
+              "type": "string"
+            },
+            "description": "The notification types to stream. All types are streamed if not specified.",
+            "name": "notification_types",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "description": "Resume the stream after the notification with this offset.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resume the stream after the notification with this offset, as sent by reconnecting server-sent events clients. Ignored if 'since' is specified.",
+            "name": "Last-Event-ID",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "400": {
+            "description": "Bad Request.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -17802,6 +23299,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
@@ -18026,6 +23529,81 @@ func init() {
         "MULTIARCH_RELEASE_IMAGE"
       ]
     },
+    "bandwidth_check_host": {
+      "type": "object",
+      "required": [
+        "host_id",
+        "ip_address"
+      ],
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "ip_address": {
+          "description": "The address of the host to measure the bandwidth to.",
+          "type": "string"
+        }
+      }
+    },
+    "bandwidth_check_remote_host": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "The reason the bandwidth couldn't be measured.",
+          "type": "string"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "ip_address": {
+          "type": "string"
+        },
+        "successful": {
+          "description": "Whether the bandwidth to the host was measured.",
+          "type": "boolean"
+        },
+        "throughput_mbps": {
+          "description": "The measured throughput to the host, in Mbps.",
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "bandwidth_check_request": {
+      "type": "object",
+      "required": [
+        "hosts",
+        "duration_seconds"
+      ],
+      "properties": {
+        "duration_seconds": {
+          "description": "The duration of the measurement to each host.",
+          "type": "integer",
+          "maximum": 60,
+          "minimum": 1
+        },
+        "hosts": {
+          "description": "The hosts to measure the bandwidth to.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bandwidth_check_host"
+          }
+        }
+      }
+    },
+    "bandwidth_check_response": {
+      "type": "object",
+      "properties": {
+        "remote_hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bandwidth_check_remote_host"
+          }
+        }
+      }
+    },
     "bind-host-params": {
       "required": [
         "cluster_id"
@@ -18037,6 +23615,20 @@ func init() {
         }
       }
     },
+    "bios": {
+      "type": "object",
+      "properties": {
+        "release_date": {
+          "type": "string"
+        },
+        "vendor": {
+          "type": "string"
+        },
+        "version": {
+          "type": "string"
+        }
+      }
+    },
     "boot": {
       "type": "object",
       "properties": {
@@ -18061,6 +23653,140 @@ func init() {
         }
       }
     },
+    "bulk-host-operation": {
+      "description": "An operation on a host, identified by its ID or by the MAC address of one of its interfaces.",
+      "type": "object",
+      "required": [
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "bind",
+            "unbind",
+            "set-role",
+            "set-hostname",
+            "set-installation-disk",
+            "reset-validation",
+            "approve"
+          ]
+        },
+        "approved": {
+          "description": "Whether the host is approved, for approve.",
+          "type": "boolean",
+          "default": true
+        },
+        "cluster_id": {
+          "description": "The cluster that the host is bound to, for bind.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "host_name": {
+          "description": "The host name, for set-hostname.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "host_role": {
+          "description": "The role of the host, for set-role.",
+          "type": "string",
+          "enum": [
+            "auto-assign",
+            "master",
+            "arbiter",
+            "worker"
+          ],
+          "x-nullable": true
+        },
+        "installation_disk_id": {
+          "description": "The id of the disk the host is installed on, for set-installation-disk.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "mac_address": {
+          "type": "string",
+          "pattern": "^([0-9A-Fa-f]{2}[:-]){5}([0-9A-Fa-f]{2})$",
+          "x-nullable": true
+        },
+        "validation_id": {
+          "description": "The validation to reset, for reset-validation.",
+          "type": "string",
+          "x-nullable": true
+        }
+      }
+    },
+    "bulk-host-operation-result": {
+      "type": "object",
+      "required": [
+        "index",
+        "status"
+      ],
+      "properties": {
+        "code": {
+          "description": "The HTTP status code of the equivalent single host call, if the operation failed.",
+          "type": "integer"
+        },
+        "host_id": {
+          "description": "The host that the operation applied to. Not set if the host was not found.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "index": {
+          "description": "The position of the operation in the request.",
+          "type": "integer"
+        },
+        "reason": {
+          "description": "The reason of the failure.",
+          "type": "string"
+        },
+        "status": {
+          "description": "not-applied operations were rolled back or skipped because another operation of the transaction failed.",
+          "type": "string",
+          "enum": [
+            "succeeded",
+            "failed",
+            "not-applied"
+          ]
+        }
+      }
+    },
+    "bulk-host-operations": {
+      "type": "object",
+      "required": [
+        "operations"
+      ],
+      "properties": {
+        "operations": {
+          "type": "array",
+          "maxItems": 1000,
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/bulk-host-operation"
+          }
+        }
+      }
+    },
+    "bulk-host-operations-result": {
+      "type": "object",
+      "required": [
+        "results"
+      ],
+      "properties": {
+        "results": {
+          "description": "The results of the operations, in the order of the operations.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bulk-host-operation-result"
+          }
+        }
+      }
+    },
     "bundle": {
       "type": "object",
       "properties": {
@@ -18119,6 +23845,12 @@ func init() {
           "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
           "x-nullable": true
         },
+        "bandwidth_check_enabled": {
+          "description": "Measure the network bandwidth between the hosts of the cluster and validate it against the minimum bandwidth of their role.",
+          "type": "boolean",
+          "default": false,
+          "x-go-custom-tag": "gorm:\"default:false\""
+        },
         "base_dns_domain": {
           "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
           "type": "string"
@@ -18223,6 +23955,12 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "firmware_policy": {
+          "description": "JSON formatted firmware policy that the hosts of the cluster must comply with.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "x-nullable": true
+        },
         "high_availability_mode": {
           "description": "(DEPRECATED) Please use 'control_plane_count' instead. Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
           "type": "string",
@@ -18519,18 +24257,142 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
-        "user_name": {
+        "user_name": {
+          "type": "string"
+        },
+        "validations_info": {
+          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-archive": {
+      "description": "The definition of a cluster, exported to be imported by another service.",
+      "type": "object",
+      "required": [
+        "format_version",
+        "source_cluster_id",
+        "cluster",
+        "secrets"
+      ],
+      "properties": {
+        "cluster": {
+          "description": "Properties of cluster-create-params. The pull secret and the SSH public key are in the secrets.",
+          "type": "object",
+          "additionalProperties": true
+        },
+        "exported_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "format_version": {
+          "description": "The version of the format of the archive.",
+          "type": "integer"
+        },
+        "hosts": {
+          "description": "The properties of the hosts of the cluster, identified by MAC address so that they can be applied to the same hosts when they register with the imported cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-plan-host"
+          }
+        },
+        "ignored_validations": {
+          "$ref": "#/definitions/ignored-validations"
+        },
+        "infra_envs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-archive-infra-env"
+          }
+        },
+        "manifests": {
+          "description": "The custom manifests of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/create-manifest-params"
+          }
+        },
+        "secrets": {
+          "$ref": "#/definitions/cluster-archive-secrets"
+        },
+        "source_cluster_id": {
+          "description": "The ID of the exported cluster.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "ui_settings": {
+          "description": "The settings of the cluster in the UI.",
+          "type": "string"
+        }
+      }
+    },
+    "cluster-archive-encryption-key": {
+      "type": "object",
+      "required": [
+        "key_id",
+        "public_key"
+      ],
+      "properties": {
+        "key_id": {
+          "description": "The SHA-256 fingerprint of the public key.",
+          "type": "string"
+        },
+        "public_key": {
+          "description": "The PEM encoded RSA public key.",
+          "type": "string"
+        }
+      }
+    },
+    "cluster-archive-infra-env": {
+      "type": "object",
+      "required": [
+        "source_id",
+        "params"
+      ],
+      "properties": {
+        "params": {
+          "description": "Properties of infra-env-create-params. The pull secret and the SSH key are in the secrets of the archive.",
+          "type": "object",
+          "additionalProperties": true
+        },
+        "source_id": {
+          "description": "The ID of the exported infra-env.",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "cluster-archive-secrets": {
+      "description": "The secrets of the archive, encrypted with AES-256-GCM under a key that is encrypted with RSA-OAEP (SHA-256) to the key of the importing service.",
+      "type": "object",
+      "required": [
+        "key_id",
+        "encrypted_key",
+        "nonce",
+        "ciphertext"
+      ],
+      "properties": {
+        "ciphertext": {
+          "type": "string",
+          "format": "byte"
+        },
+        "encrypted_key": {
+          "type": "string",
+          "format": "byte"
+        },
+        "key_id": {
+          "description": "The ID of the key that the archive is encrypted to.",
           "type": "string"
         },
-        "validations_info": {
-          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
+        "nonce": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "x-nullable": true
+          "format": "byte"
         }
       }
     },
@@ -18554,6 +24416,12 @@ func init() {
             "$ref": "#/definitions/api_vip"
           }
         },
+        "bandwidth_check_enabled": {
+          "description": "Measure the network bandwidth between the hosts of the cluster and validate it against the minimum bandwidth of their role.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        },
         "base_dns_domain": {
           "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
           "type": "string"
@@ -18602,6 +24470,10 @@ func init() {
           "description": "Installation disks encryption mode and host roles to be applied.",
           "$ref": "#/definitions/disk-encryption"
         },
+        "firmware_policy": {
+          "description": "The firmware settings that the hosts of the cluster must comply with.",
+          "$ref": "#/definitions/firmware-policy"
+        },
         "high_availability_mode": {
           "description": "(DEPRECATED) Please use 'control_plane_count' instead. Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
           "type": "string",
@@ -18662,198 +24534,692 @@ func init() {
         "name": {
           "description": "Name of the OpenShift cluster.",
           "type": "string",
-          "maxLength": 54,
-          "minLength": 1
+          "maxLength": 54,
+          "minLength": 1
+        },
+        "network_type": {
+          "description": "The desired network type used.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ],
+          "x-nullable": true
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.\nFor the full list of supported operators, check the endpoint ` + "`" + `/v2/supported-operators` + "`" + `:\n",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "platform": {
+          "x-nullable": true,
+          "$ref": "#/definitions/platform"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        },
+        "schedulable_masters": {
+          "description": "Schedule workloads on masters",
+          "type": "boolean",
+          "default": false
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "172.30.0.0/16",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "service_networks": {
+          "description": "Service networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          },
+          "x-nullable": true
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "tags": {
+          "description": "A comma-separated list of tags that are associated to the cluster.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "user_managed_networking": {
+          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-export-params": {
+      "type": "object",
+      "required": [
+        "recipient_public_key"
+      ],
+      "properties": {
+        "recipient_public_key": {
+          "description": "The PEM encoded RSA public key of the service that imports the archive, as returned by its v2GetClusterArchiveEncryptionKey.",
+          "type": "string"
+        }
+      }
+    },
+    "cluster-finalizing-progress": {
+      "type": "object",
+      "properties": {
+        "finalizing_stage": {
+          "$ref": "#/definitions/finalizing-stage"
+        }
+      }
+    },
+    "cluster-host-requirements": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "description": "Unique identifier of the host the requirements relate to.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "ocp": {
+          "description": "Host requirements for the OCP installation",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        },
+        "operators": {
+          "description": "Host requirements related to requested operators",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-host-requirements"
+          }
+        },
+        "total": {
+          "description": "Total host requirements for the cluster configuration",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        }
+      }
+    },
+    "cluster-host-requirements-details": {
+      "type": "object",
+      "properties": {
+        "cpu_cores": {
+          "description": "Required number of CPU cores",
+          "type": "integer"
+        },
+        "disk_size_gb": {
+          "description": "Required disk size in GB",
+          "type": "integer"
+        },
+        "installation_disk_speed_threshold_ms": {
+          "description": "Required installation disk speed in ms",
+          "type": "integer"
+        },
+        "network_bandwidth_threshold_mbps": {
+          "description": "Minimum network bandwidth at L3 to other hosts of the same role, in Mbps. Validated only for clusters with bandwidth checks enabled.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "network_latency_threshold_ms": {
+          "description": "Maximum network average latency (RTT) at L3 for role.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "packet_loss_percentage": {
+          "description": "Maximum packet loss allowed at L3 for role.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "ram_mib": {
+          "description": "Required number of RAM in MiB",
+          "type": "integer"
+        },
+        "tpm_enabled_in_bios": {
+          "description": "Whether TPM module should be enabled in host's BIOS.",
+          "type": "boolean"
+        }
+      }
+    },
+    "cluster-host-requirements-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-host-requirements"
+      }
+    },
+    "cluster-import-result": {
+      "type": "object",
+      "properties": {
+        "cluster": {
+          "$ref": "#/definitions/cluster"
+        },
+        "host_plan": {
+          "description": "A plan that applies the properties of the exported hosts, to be applied with v2ApplyClusterPlan once the hosts are discovered.",
+          "$ref": "#/definitions/cluster-plan"
+        },
+        "infra_env_ids": {
+          "description": "The IDs of the registered infra-envs, keyed by the IDs of the exported infra-envs.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string",
+            "format": "uuid"
+          }
+        }
+      }
+    },
+    "cluster-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster"
+      }
+    },
+    "cluster-plan": {
+      "description": "The desired state of a cluster. Only the properties that are set are compared and applied.",
+      "type": "object",
+      "properties": {
+        "cluster": {
+          "description": "The desired cluster properties.",
+          "$ref": "#/definitions/v2-cluster-update-params"
+        },
+        "hosts": {
+          "description": "The desired host properties, matched to the hosts of the cluster by MAC address.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-plan-host"
+          }
+        },
+        "infra_env": {
+          "description": "The desired infra-env properties.",
+          "$ref": "#/definitions/infra-env-update-params"
+        },
+        "infra_env_id": {
+          "description": "The infra-env that infra_env is applied to. Defaults to the single infra-env of the cluster.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "install": {
+          "description": "Installs the cluster once the rest of the plan is applied.",
+          "type": "boolean",
+          "default": false
+        },
+        "manifests": {
+          "description": "The desired custom manifests.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/create-manifest-params"
+          }
+        },
+        "operators": {
+          "description": "The desired OLM operators. Operators that are only installed as dependencies are ignored.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          },
+          "x-nullable": true
+        },
+        "prune_manifests": {
+          "description": "Deletes the custom manifests of the cluster that are not in manifests.",
+          "type": "boolean",
+          "default": false
+        }
+      }
+    },
+    "cluster-plan-change": {
+      "type": "object",
+      "required": [
+        "resource",
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "create",
+            "update",
+            "delete",
+            "install"
+          ]
+        },
+        "current": {
+          "description": "The current value of the property. Omitted for sensitive properties.",
+          "x-nullable": true
+        },
+        "desired": {
+          "description": "The desired value of the property. Omitted for sensitive properties.",
+          "x-nullable": true
+        },
+        "field": {
+          "description": "The changed property of the resource.",
+          "type": "string"
+        },
+        "id": {
+          "description": "The identifier of the changed resource. Manifests are identified by their folder and file name.",
+          "type": "string"
+        },
+        "resource": {
+          "type": "string",
+          "enum": [
+            "cluster",
+            "infra-env",
+            "host",
+            "manifest",
+            "operator"
+          ]
+        }
+      }
+    },
+    "cluster-plan-host": {
+      "type": "object",
+      "required": [
+        "mac_address"
+      ],
+      "properties": {
+        "host_name": {
+          "type": "string",
+          "x-nullable": true
         },
-        "network_type": {
-          "description": "The desired network type used.",
+        "host_role": {
           "type": "string",
           "enum": [
-            "OpenShiftSDN",
-            "OVNKubernetes"
+            "auto-assign",
+            "master",
+            "arbiter",
+            "worker"
           ],
           "x-nullable": true
         },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+        "installation_disk_id": {
+          "description": "The id of the disk the host is installed on.",
           "type": "string",
           "x-nullable": true
         },
-        "ocp_release_image": {
-          "description": "OpenShift release image URI.",
-          "type": "string"
+        "mac_address": {
+          "description": "The MAC address of one of the interfaces of the host.",
+          "type": "string",
+          "pattern": "^([0-9A-Fa-f]{2}[:-]){5}([0-9A-Fa-f]{2})$"
+        }
+      }
+    },
+    "cluster-plan-result": {
+      "type": "object",
+      "required": [
+        "changes",
+        "applied"
+      ],
+      "properties": {
+        "applied": {
+          "description": "Whether the changes were applied.",
+          "type": "boolean"
         },
-        "olm_operators": {
-          "description": "List of OLM operators to be installed.\nFor the full list of supported operators, check the endpoint ` + "`" + `/v2/supported-operators` + "`" + `:\n",
+        "changes": {
+          "description": "The changes needed to bring the cluster to the state described by the plan.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/operator-create-params"
+            "$ref": "#/definitions/cluster-plan-change"
           }
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
+        "cluster": {
+          "description": "The cluster once the changes are applied.",
+          "$ref": "#/definitions/cluster"
+        }
+      }
+    },
+    "cluster-progress-info": {
+      "type": "object",
+      "properties": {
+        "finalizing_stage": {
+          "$ref": "#/definitions/finalizing-stage"
         },
-        "platform": {
-          "x-nullable": true,
-          "$ref": "#/definitions/platform"
+        "finalizing_stage_percentage": {
+          "type": "integer"
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
-          "type": "string"
+        "finalizing_stage_started_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "schedulable_masters": {
-          "description": "Schedule workloads on masters",
-          "type": "boolean",
-          "default": false
+        "finalizing_stage_timed_out": {
+          "type": "boolean"
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "default": "172.30.0.0/16",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        "installing_stage_percentage": {
+          "type": "integer"
         },
-        "service_networks": {
-          "description": "Service networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/service_network"
-          },
-          "x-nullable": true
+        "preparing_for_installation_stage_percentage": {
+          "type": "integer"
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
-          "type": "string"
+        "total_percentage": {
+          "type": "integer"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
+    },
+    "cluster-revision": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "revision",
+        "reason",
+        "created_at"
+      ],
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "tags": {
-          "description": "A comma-separated list of tags that are associated to the cluster.",
+        "created_at": {
           "type": "string",
-          "x-nullable": true
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "user_managed_networking": {
-          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "default": false,
-          "x-nullable": true
+        "reason": {
+          "description": "The kind of update that recorded the revision.",
+          "type": "string",
+          "enum": [
+            "register",
+            "cluster-update",
+            "host-update",
+            "ignored-validations-update",
+            "plan-apply",
+            "rollback"
+          ]
         },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "default": false,
-          "x-nullable": true
+        "revision": {
+          "description": "The number of the revision, increasing for each revision of the cluster.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"primaryKey;autoIncrement:false\""
+        },
+        "snapshot": {
+          "$ref": "#/definitions/cluster-revision-snapshot"
         }
       }
     },
-    "cluster-finalizing-progress": {
+    "cluster-revision-change": {
+      "type": "object",
+      "required": [
+        "path"
+      ],
+      "properties": {
+        "from": {
+          "description": "The value in the base configuration. Not set if the property is added."
+        },
+        "path": {
+          "description": "The changed property, e.g. cluster.api_vips, hosts.\u003chost_id\u003e.host_role or manifests.\u003cfolder\u003e/\u003cfile_name\u003e.",
+          "type": "string"
+        },
+        "to": {
+          "description": "The value in the revision. Not set if the property is removed."
+        }
+      }
+    },
+    "cluster-revision-diff": {
       "type": "object",
+      "required": [
+        "revision",
+        "changes"
+      ],
       "properties": {
-        "finalizing_stage": {
-          "$ref": "#/definitions/finalizing-stage"
+        "base_revision": {
+          "description": "The revision the changes are computed from. Not set when they are computed from the current configuration.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-revision-change"
+          }
+        },
+        "revision": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "cluster-host-requirements": {
+    "cluster-revision-host": {
       "type": "object",
       "properties": {
         "host_id": {
-          "description": "Unique identifier of the host the requirements relate to.",
           "type": "string",
           "format": "uuid"
         },
-        "ocp": {
-          "description": "Host requirements for the OCP installation",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+        "host_name": {
+          "type": "string"
         },
-        "operators": {
-          "description": "Host requirements related to requested operators",
+        "host_role": {
+          "type": "string",
+          "enum": [
+            "auto-assign",
+            "master",
+            "arbiter",
+            "worker"
+          ]
+        },
+        "installation_disk_id": {
+          "type": "string"
+        }
+      }
+    },
+    "cluster-revision-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-revision"
+      }
+    },
+    "cluster-revision-snapshot": {
+      "description": "The user-settable configuration of a cluster.",
+      "type": "object",
+      "properties": {
+        "cluster": {
+          "description": "The cluster properties, as set by V2UpdateCluster. The pull secret is not included.",
+          "$ref": "#/definitions/v2-cluster-update-params"
+        },
+        "hosts": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/operator-host-requirements"
+            "$ref": "#/definitions/cluster-revision-host"
           }
         },
-        "total": {
-          "description": "Total host requirements for the cluster configuration",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+        "ignored_cluster_validations": {
+          "description": "JSON-formatted list of the ignored cluster validation IDs.",
+          "type": "string"
+        },
+        "ignored_host_validations": {
+          "description": "JSON-formatted list of the ignored host validation IDs.",
+          "type": "string"
+        },
+        "manifests": {
+          "description": "The custom manifests of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/manifest"
+          }
+        },
+        "operators": {
+          "description": "The OLM operators that were not only installed as a dependency of another operator.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
         }
-      }
+      },
+      "x-go-custom-tag": "gorm:\"-\""
     },
-    "cluster-host-requirements-details": {
+    "cluster-template": {
       "type": "object",
+      "required": [
+        "id",
+        "name"
+      ],
       "properties": {
-        "cpu_cores": {
-          "description": "Required number of CPU cores",
-          "type": "integer"
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "disk_size_gb": {
-          "description": "Required disk size in GB",
-          "type": "integer"
+        "description": {
+          "description": "Description of the template.",
+          "type": "string"
         },
-        "installation_disk_speed_threshold_ms": {
-          "description": "Required installation disk speed in ms",
-          "type": "integer"
+        "id": {
+          "description": "Unique identifier of the template.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "network_latency_threshold_ms": {
-          "description": "Maximum network average latency (RTT) at L3 for role.",
-          "type": "number",
-          "format": "double",
-          "x-nullable": true
+        "name": {
+          "description": "Name of the template.",
+          "type": "string"
         },
-        "packet_loss_percentage": {
-          "description": "Maximum packet loss allowed at L3 for role.",
-          "type": "number",
-          "format": "double",
-          "x-nullable": true
+        "org_id": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "ram_mib": {
-          "description": "Required number of RAM in MiB",
-          "type": "integer"
+        "source": {
+          "description": "Whether the template is managed through the API, or by a ClusterTemplate resource of the kube-api. Templates managed by the kube-api cannot be modified through the API.",
+          "type": "string",
+          "enum": [
+            "api",
+            "kube-api"
+          ]
         },
-        "tpm_enabled_in_bios": {
-          "description": "Whether TPM module should be enabled in host's BIOS.",
-          "type": "boolean"
+        "spec": {
+          "$ref": "#/definitions/cluster-template-spec"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "user_name": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
         }
       }
     },
-    "cluster-host-requirements-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/cluster-host-requirements"
+    "cluster-template-create-params": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the template.",
+          "type": "string",
+          "minLength": 1
+        },
+        "spec": {
+          "$ref": "#/definitions/cluster-template-spec"
+        }
       }
     },
-    "cluster-list": {
+    "cluster-template-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/cluster"
+        "$ref": "#/definitions/cluster-template"
       }
     },
-    "cluster-progress-info": {
+    "cluster-template-parameter": {
       "type": "object",
+      "required": [
+        "name"
+      ],
       "properties": {
-        "finalizing_stage": {
-          "$ref": "#/definitions/finalizing-stage"
+        "default": {
+          "description": "The value of the parameter when it is not set on registration.",
+          "type": "string",
+          "x-nullable": true
         },
-        "finalizing_stage_percentage": {
-          "type": "integer"
+        "description": {
+          "type": "string"
         },
-        "finalizing_stage_started_at": {
+        "name": {
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "pattern": "^[a-zA-Z_][a-zA-Z0-9_]*$"
         },
-        "finalizing_stage_timed_out": {
-          "type": "boolean"
+        "required": {
+          "description": "Whether the parameter must be set on registration. Ignored if the parameter has a default.",
+          "type": "boolean",
+          "default": false
+        }
+      }
+    },
+    "cluster-template-spec": {
+      "description": "The configuration of the clusters registered from a template. String values may reference the parameters of the template as ${name}, and the name of the registered cluster as ${cluster_name}.",
+      "type": "object",
+      "properties": {
+        "cluster_params": {
+          "description": "Properties of cluster-create-params that are set on the registered clusters. The pull secret cannot be set in a template.",
+          "type": "object",
+          "additionalProperties": true
         },
-        "installing_stage_percentage": {
-          "type": "integer"
+        "infra_env_params": {
+          "description": "Properties of infra-env-create-params. If set, an infra-env with these properties is created for each registered cluster. The pull secret and the cluster cannot be set in a template.",
+          "type": "object",
+          "additionalProperties": true
         },
-        "preparing_for_installation_stage_percentage": {
-          "type": "integer"
+        "manifests": {
+          "description": "The custom manifests that are created for each registered cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/create-manifest-params"
+          }
         },
-        "total_percentage": {
-          "type": "integer"
+        "parameters": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-template-parameter"
+          }
         }
       },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
+      "x-go-custom-tag": "gorm:\"-\""
+    },
+    "cluster-template-update-params": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "name": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": true
+        },
+        "spec": {
+          "description": "Replaces the configuration of the template.",
+          "$ref": "#/definitions/cluster-template-spec"
+        }
+      }
     },
     "cluster-validation-id": {
       "type": "string",
@@ -19217,6 +25583,63 @@ func init() {
         }
       }
     },
+    "custom_step_request": {
+      "description": "Request to run a custom step, which is a container that is defined by the administrator of the service.",
+      "type": "object",
+      "required": [
+        "name",
+        "image"
+      ],
+      "properties": {
+        "args": {
+          "description": "The arguments of the container.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "image": {
+          "description": "The container image to run.",
+          "type": "string"
+        },
+        "name": {
+          "description": "The name of the custom step.",
+          "type": "string"
+        },
+        "timeout": {
+          "description": "The number of seconds after which the container is stopped.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "custom_step_result": {
+      "description": "The result of the last run of a custom step on a host. The output of the container is expected to be a JSON document.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "The error of the container, or the reason why its output doesn't match the reply schema of the step.",
+          "type": "string"
+        },
+        "exit_code": {
+          "description": "The exit code of the container.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "description": "The name of the custom step.",
+          "type": "string"
+        },
+        "output": {
+          "description": "The output of the container.",
+          "type": "string"
+        },
+        "succeeded": {
+          "description": "Whether the container succeeded and its output matches the reply schema of the step.",
+          "type": "boolean"
+        }
+      }
+    },
     "dhcp_allocation_request": {
       "type": "object",
       "required": [
@@ -19318,6 +25741,9 @@ func init() {
         "hctl": {
           "type": "string"
         },
+        "health": {
+          "$ref": "#/definitions/disk_health"
+        },
         "holders": {
           "description": "A comma-separated list of disk names that this disk belongs to",
           "type": "string"
@@ -19461,6 +25887,37 @@ func init() {
         }
       }
     },
+    "disk_health": {
+      "description": "The health of the disk, as reported by its SMART data. Properties that the disk doesn't report are omitted.",
+      "type": "object",
+      "properties": {
+        "media_errors": {
+          "description": "The number of unrecovered data integrity errors of the disk.",
+          "type": "integer",
+          "x-nullable": true
+        },
+        "reallocated_sectors": {
+          "description": "The number of sectors that were remapped to spare sectors because of errors.",
+          "type": "integer",
+          "x-nullable": true
+        },
+        "smart_passed": {
+          "description": "Whether the disk passed its overall SMART self-assessment.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "temperature_celsius": {
+          "description": "The current temperature of the disk in degrees Celsius.",
+          "type": "integer",
+          "x-nullable": true
+        },
+        "wear_level_percentage": {
+          "description": "The percentage of the rated endurance of the disk that was used. It can exceed 100.",
+          "type": "integer",
+          "x-nullable": true
+        }
+      }
+    },
     "disk_info": {
       "type": "object",
       "properties": {
@@ -19838,6 +26295,52 @@ func init() {
         "Done"
       ]
     },
+    "firmware-policy": {
+      "description": "Firmware settings that the hosts must comply with.",
+      "type": "object",
+      "properties": {
+        "boot_mode": {
+          "description": "The boot mode that the hosts must use. Hosts may use any boot mode if not set.",
+          "type": "string",
+          "enum": [
+            "uefi",
+            "bios"
+          ]
+        },
+        "min_bios_versions": {
+          "description": "The minimum BIOS versions of the hosts, per manufacturer.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/firmware-policy-bios-version"
+          }
+        },
+        "secure_boot_required": {
+          "description": "Whether the hosts must boot with Secure Boot enabled.",
+          "type": "boolean"
+        },
+        "virtualization_required": {
+          "description": "Whether the hosts must have the virtualization extensions of their CPU enabled.",
+          "type": "boolean"
+        }
+      }
+    },
+    "firmware-policy-bios-version": {
+      "type": "object",
+      "required": [
+        "manufacturer",
+        "version"
+      ],
+      "properties": {
+        "manufacturer": {
+          "description": "The manufacturer of the hosts, as reported in the system vendor of their inventory. Compared case-insensitively.",
+          "type": "string"
+        },
+        "version": {
+          "description": "The minimum BIOS version of the hosts of the manufacturer. Versions are compared by their numeric components.",
+          "type": "string"
+        }
+      }
+    },
     "free-addresses-list": {
       "type": "array",
       "items": {
@@ -19899,6 +26402,99 @@ func init() {
         }
       }
     },
+    "hardware-fingerprint": {
+      "description": "The normalized hardware of a host, without the properties that change between boots.",
+      "type": "object",
+      "properties": {
+        "cpu_count": {
+          "type": "integer"
+        },
+        "cpu_model": {
+          "type": "string"
+        },
+        "disks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/hardware-fingerprint-disk"
+          }
+        },
+        "memory_bytes": {
+          "description": "The physical memory of the host.",
+          "type": "integer"
+        },
+        "nics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/hardware-fingerprint-nic"
+          }
+        }
+      },
+      "x-go-custom-tag": "gorm:\"-\""
+    },
+    "hardware-fingerprint-change": {
+      "type": "object",
+      "required": [
+        "component",
+        "type"
+      ],
+      "properties": {
+        "component": {
+          "type": "string",
+          "enum": [
+            "cpu",
+            "memory",
+            "disk",
+            "nic"
+          ]
+        },
+        "from": {
+          "description": "The description of the component before the change.",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the changed disk or the MAC address of the changed NIC.",
+          "type": "string"
+        },
+        "to": {
+          "description": "The description of the component after the change.",
+          "type": "string"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "added",
+            "removed",
+            "changed"
+          ]
+        }
+      }
+    },
+    "hardware-fingerprint-disk": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "description": "The WWN of the disk, or its serial number or path if it has no WWN.",
+          "type": "string"
+        },
+        "model": {
+          "type": "string"
+        },
+        "size_bytes": {
+          "type": "integer"
+        }
+      }
+    },
+    "hardware-fingerprint-nic": {
+      "type": "object",
+      "properties": {
+        "mac_address": {
+          "type": "string"
+        },
+        "product": {
+          "type": "string"
+        }
+      }
+    },
     "host": {
       "type": "object",
       "required": [
@@ -19914,6 +26510,11 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "bandwidth": {
+          "description": "JSON-formatted results of the bandwidth checks of the host to other hosts of the cluster, see bandwidth_check_response.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "bootstrap": {
           "type": "boolean"
         },
@@ -19952,6 +26553,11 @@ func init() {
             "type": "Time"
           }
         },
+        "custom_step_results": {
+          "description": "JSON-formatted list of the results of the custom steps that are defined by the administrator of the service, see custom_step_result.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "deleted_at": {
           "description": "swagger:ignore",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
@@ -19993,6 +26599,17 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "hardware_drift_detected_at": {
+          "description": "The time the hardware of the host changed after its validations passed. Cleared when the current hardware of the host is accepted.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "hardware_fingerprint_hash": {
+          "description": "The hash of the hardware fingerprint of the latest inventory of the host.",
+          "type": "string"
+        },
         "href": {
           "description": "Self link.",
           "type": "string"
@@ -20202,25 +26819,87 @@ func init() {
         "validations_info": {
           "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "x-go-custom-tag": "gorm:\"type:text\""
+        }
+      }
+    },
+    "host-create-params": {
+      "type": "object",
+      "required": [
+        "host_id"
+      ],
+      "properties": {
+        "discovery_agent_version": {
+          "type": "string"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
         }
       }
     },
-    "host-create-params": {
+    "host-hardware-revision": {
       "type": "object",
       "required": [
-        "host_id"
+        "host_id",
+        "infra_env_id",
+        "revision",
+        "created_at",
+        "fingerprint_hash"
       ],
       "properties": {
-        "discovery_agent_version": {
+        "changes": {
+          "description": "The changes of the hardware from the previous revision.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/hardware-fingerprint-change"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "drift": {
+          "description": "Whether the hardware changed after the validations of the host passed.",
+          "type": "boolean"
+        },
+        "fingerprint": {
+          "$ref": "#/definitions/hardware-fingerprint"
+        },
+        "fingerprint_hash": {
+          "description": "The hash of the hardware fingerprint.",
           "type": "string"
         },
         "host_id": {
           "type": "string",
-          "format": "uuid"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "host_status": {
+          "description": "The status of the host when its inventory reported the hardware.",
+          "type": "string"
+        },
+        "infra_env_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "revision": {
+          "description": "The number of the revision, increasing for each revision of the hardware of the host.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"primaryKey;autoIncrement:false\""
         }
       }
     },
+    "host-hardware-revision-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host-hardware-revision"
+      }
+    },
     "host-ignition-params": {
       "properties": {
         "config": {
@@ -20440,6 +27119,7 @@ func init() {
         "cnv-requirements-satisfied",
         "sufficient-network-latency-requirement-for-role",
         "sufficient-packet-loss-requirement-for-role",
+        "sufficient-network-bandwidth-requirement-for-role",
         "has-default-route",
         "api-domain-name-resolved-correctly",
         "api-int-domain-name-resolved-correctly",
@@ -20476,7 +27156,10 @@ func init() {
         "oadp-requirements-satisfied",
         "metallb-requirements-satisfied",
         "loki-requirements-satisfied",
-        "openshift-logging-requirements-satisfied"
+        "openshift-logging-requirements-satisfied",
+        "custom-steps-succeeded",
+        "firmware-policy-satisfied",
+        "disks-healthy"
       ]
     },
     "host_network": {
@@ -20761,6 +27444,12 @@ func init() {
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
+        "firmware_policy": {
+          "description": "JSON formatted firmware policy that the hosts of the infra-env must comply with, until they are bound to a cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "x-nullable": true
+        },
         "generator_version": {
           "description": "Image generator version.",
           "type": "string"
@@ -20888,6 +27577,10 @@ func init() {
           ],
           "x-nullable": false
         },
+        "firmware_policy": {
+          "description": "The firmware settings that the hosts of the infra-env must comply with, until they are bound to a cluster.",
+          "$ref": "#/definitions/firmware-policy"
+        },
         "ignition_config_override": {
           "description": "JSON formatted string containing the user overrides for the initial ignition config.",
           "type": "string"
@@ -20952,6 +27645,10 @@ func init() {
           "maxLength": 65535,
           "x-nullable": true
         },
+        "firmware_policy": {
+          "description": "The firmware settings that the hosts of the infra-env must comply with, until they are bound to a cluster. An empty policy removes the policy of the infra-env.",
+          "$ref": "#/definitions/firmware-policy"
+        },
         "ignition_config_override": {
           "description": "JSON formatted string containing the user overrides for the initial ignition config.",
           "type": "string"
@@ -21163,6 +27860,112 @@ func init() {
         }
       }
     },
+    "installer-cache-prewarm-params": {
+      "type": "object",
+      "required": [
+        "release_image"
+      ],
+      "properties": {
+        "pinned": {
+          "description": "Whether to pin the release, so that it is never evicted.",
+          "type": "boolean",
+          "default": false
+        },
+        "pull_secret": {
+          "description": "The pull secret of the release image. It is only used for the extraction and is not stored.",
+          "type": "string"
+        },
+        "release_image": {
+          "description": "The release image to extract the installer binary from.",
+          "type": "string"
+        },
+        "release_image_mirror": {
+          "description": "A mirror of the release image to extract the installer binary from instead.",
+          "type": "string"
+        }
+      }
+    },
+    "installer-cache-release": {
+      "description": "A release in the installer cache.",
+      "type": "object",
+      "properties": {
+        "binary": {
+          "description": "The name of the installer binary.",
+          "type": "string"
+        },
+        "cached": {
+          "description": "Whether the installer binary is in the local cache of the replica that served the request.",
+          "type": "boolean"
+        },
+        "last_used_at": {
+          "description": "The last time the installer binary was used.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "openshift_version": {
+          "description": "The OpenShift version of the release, if known.",
+          "type": "string"
+        },
+        "pinned": {
+          "description": "Whether the release is pinned, in which case it is never evicted.",
+          "type": "boolean"
+        },
+        "prewarm_status": {
+          "description": "The status of the pre-warming of the release, empty if it wasn't pre-warmed.",
+          "type": "string",
+          "enum": [
+            "pending",
+            "prewarming",
+            "ready",
+            "failed"
+          ]
+        },
+        "prewarm_status_info": {
+          "description": "Additional information about the status of the pre-warming.",
+          "type": "string"
+        },
+        "release_digest": {
+          "description": "The digest of the release image, which keys the binaries of the shared cache.",
+          "type": "string"
+        },
+        "release_image": {
+          "description": "The release image the installer binary is extracted from. Empty for binaries of the shared cache whose release image is unknown.",
+          "type": "string"
+        },
+        "shared": {
+          "description": "Whether the installer binary is stored in the shared cache.",
+          "type": "boolean"
+        },
+        "size_bytes": {
+          "description": "The size of the installer binary.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "installer-cache-release-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/installer-cache-release"
+      }
+    },
+    "installer-cache-release-update-params": {
+      "type": "object",
+      "required": [
+        "release_image",
+        "pinned"
+      ],
+      "properties": {
+        "pinned": {
+          "description": "Whether the release is pinned, in which case it is never evicted.",
+          "type": "boolean"
+        },
+        "release_image": {
+          "description": "The release image that was pre-warmed.",
+          "type": "string"
+        }
+      }
+    },
     "interface": {
       "type": "object",
       "properties": {
@@ -21219,6 +28022,9 @@ func init() {
     "inventory": {
       "type": "object",
       "properties": {
+        "bios": {
+          "$ref": "#/definitions/bios"
+        },
         "bmc_address": {
           "type": "string"
         },
@@ -21666,23 +28472,205 @@ func init() {
         }
       }
     },
-    "monitored-operators-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/monitored-operator"
-      }
-    },
-    "mtu-report": {
+    "monitored-operators-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/monitored-operator"
+      }
+    },
+    "mtu-report": {
+      "type": "object",
+      "properties": {
+        "mtu_successful": {
+          "type": "boolean"
+        },
+        "outgoing_nic": {
+          "type": "string"
+        },
+        "remote_ip_address": {
+          "type": "string"
+        }
+      }
+    },
+    "network-topology": {
+      "description": "The network topology of the hosts of a cluster, as discovered by their connectivity checks.",
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-host"
+          }
+        },
+        "links": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-link"
+          }
+        },
+        "majority_groups": {
+          "description": "The majority groups of the cluster, by CIDR for L2 connectivity and by address family (IPv4, IPv6) for L3 connectivity.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "type": "string",
+              "format": "uuid"
+            }
+          }
+        },
+        "subnets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-subnet"
+          }
+        }
+      }
+    },
+    "network-topology-host": {
+      "type": "object",
+      "properties": {
+        "hostname": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "interfaces": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-interface"
+          }
+        },
+        "majority_groups": {
+          "description": "The majority groups that the host belongs to.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "outside_majority_groups": {
+          "description": "The majority groups of the cluster that the host doesn't belong to.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "network-topology-interface": {
+      "type": "object",
+      "properties": {
+        "ipv4_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ipv6_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mac_address": {
+          "type": "string"
+        },
+        "mtu": {
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        },
+        "parent": {
+          "description": "The interface that a VLAN interface is defined on.",
+          "type": "string"
+        },
+        "speed_mbps": {
+          "type": "integer"
+        },
+        "type": {
+          "description": "The type of the interface, such as physical, bond or vlan.",
+          "type": "string"
+        },
+        "vlan_id": {
+          "description": "The VLAN ID of a VLAN interface.",
+          "type": "integer"
+        }
+      }
+    },
+    "network-topology-link": {
+      "description": "The result of a connectivity check from an interface of a host to an address of another host.",
+      "type": "object",
+      "properties": {
+        "average_rtt_ms": {
+          "type": "number"
+        },
+        "packet_loss_percentage": {
+          "type": "number"
+        },
+        "source_host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "source_interface": {
+          "type": "string"
+        },
+        "successful": {
+          "type": "boolean"
+        },
+        "target_address": {
+          "type": "string"
+        },
+        "target_host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "target_interface": {
+          "description": "The interface of the target host that has the target address, if it is known.",
+          "type": "string"
+        },
+        "target_mac_address": {
+          "description": "The MAC address that answered an L2 check.",
+          "type": "string"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "l2",
+            "l3"
+          ]
+        }
+      }
+    },
+    "network-topology-subnet": {
       "type": "object",
       "properties": {
-        "mtu_successful": {
-          "type": "boolean"
-        },
-        "outgoing_nic": {
+        "cidr": {
           "type": "string"
         },
-        "remote_ip_address": {
-          "type": "string"
+        "host_ids": {
+          "description": "The hosts that have an address in the subnet.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        },
+        "machine_network": {
+          "description": "Whether the subnet is a machine network of the cluster.",
+          "type": "boolean"
         }
       }
     },
@@ -22375,7 +29363,9 @@ func init() {
         "upgrade-agent",
         "download-boot-artifacts",
         "reboot-for-reclaim",
-        "verify-vips"
+        "verify-vips",
+        "custom-step",
+        "bandwidth-check"
       ]
     },
     "steps": {
@@ -22412,6 +29402,311 @@ func init() {
       "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
       "x-go-custom-tag": "gorm:\"primaryKey;type:cidr\""
     },
+    "subscription": {
+      "type": "object",
+      "required": [
+        "id",
+        "url",
+        "enabled"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "The subscribed cluster. All the clusters accessible by the owner of the subscription are subscribed if not set.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "enabled": {
+          "description": "Whether notifications are delivered.",
+          "type": "boolean"
+        },
+        "id": {
+          "description": "Unique identifier of the subscription.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "org_id": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "signed": {
+          "description": "Whether notifications are signed with a secret.",
+          "type": "boolean",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "triggers": {
+          "description": "The changes that trigger a delivery.",
+          "$ref": "#/definitions/subscription-triggers"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "url": {
+          "description": "The URL notifications are posted to.",
+          "type": "string"
+        },
+        "user_name": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "subscription-create-params": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster to subscribe to. All the clusters accessible by the user are subscribed if not set.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "enabled": {
+          "description": "Whether notifications are delivered.",
+          "type": "boolean",
+          "default": true,
+          "x-nullable": true
+        },
+        "secret": {
+          "description": "Secret used to sign the notifications with HMAC-SHA256. Notifications are not signed if not set.",
+          "type": "string"
+        },
+        "triggers": {
+          "description": "The changes that trigger a delivery.",
+          "$ref": "#/definitions/subscription-triggers"
+        },
+        "url": {
+          "description": "The URL notifications are posted to.",
+          "type": "string"
+        }
+      }
+    },
+    "subscription-delivery": {
+      "type": "object",
+      "required": [
+        "id",
+        "subscription_id",
+        "status"
+      ],
+      "properties": {
+        "attempt_log": {
+          "description": "The delivery attempts, starting from the most recent.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/subscription-delivery-attempt"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:DeliveryID;references:ID\""
+        },
+        "attempts": {
+          "description": "Number of delivery attempts since the delivery was created or redelivered.",
+          "type": "integer"
+        },
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "id": {
+          "description": "Unique identifier of the delivery, sent in the X-Assisted-Delivery header.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "next_attempt_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "delivered",
+            "failed"
+          ],
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "status_info": {
+          "description": "The result of the last delivery attempt.",
+          "type": "string"
+        },
+        "subscription_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index;uniqueIndex:idx_subscription_deliveries_dedup,priority:1\""
+        },
+        "trigger": {
+          "description": "The change that triggered the delivery, e.g. cluster status 'installed' or event 'host_validation_failed'.",
+          "type": "string"
+        }
+      }
+    },
+    "subscription-delivery-attempt": {
+      "type": "object",
+      "properties": {
+        "attempted_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "delivery_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "duration_ms": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "error": {
+          "description": "The reason of the failure, if the attempt failed.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(1024)\""
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "status_code": {
+          "description": "The HTTP status code of the response, unset if no response was received.",
+          "type": "integer"
+        }
+      }
+    },
+    "subscription-delivery-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/subscription-delivery"
+      }
+    },
+    "subscription-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/subscription"
+      }
+    },
+    "subscription-triggers": {
+      "description": "The changes that trigger a delivery. A delivery is triggered by any of the changes, or by any change if none is specified.",
+      "type": "object",
+      "properties": {
+        "cluster_statuses": {
+          "description": "Cluster statuses that trigger a delivery when a subscribed cluster enters them.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
+          }
+        },
+        "event_names": {
+          "description": "Names of the events that trigger a delivery, e.g. host_validation_failed.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
+          }
+        },
+        "event_severities": {
+          "description": "Severities of the events that trigger a delivery.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
+          }
+        },
+        "host_statuses": {
+          "description": "Host statuses that trigger a delivery when a host of a subscribed cluster enters them.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
+          }
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:triggers_\""
+    },
+    "subscription-update-params": {
+      "type": "object",
+      "properties": {
+        "enabled": {
+          "description": "Whether notifications are delivered.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "secret": {
+          "description": "Secret used to sign the notifications with HMAC-SHA256. An empty secret disables signing.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "triggers": {
+          "description": "The changes that trigger a delivery, replacing the existing ones.",
+          "x-nullable": true,
+          "$ref": "#/definitions/subscription-triggers"
+        },
+        "url": {
+          "description": "The URL notifications are posted to.",
+          "type": "string",
+          "x-nullable": true
+        }
+      }
+    },
     "support-level": {
       "type": "string",
       "enum": [
@@ -22618,6 +29913,11 @@ func init() {
           },
           "x-nullable": true
         },
+        "bandwidth_check_enabled": {
+          "description": "Measure the network bandwidth between the hosts of the cluster and validate it against the minimum bandwidth of their role.",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "base_dns_domain": {
           "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
           "type": "string",
@@ -22653,6 +29953,10 @@ func init() {
           "description": "Installation disks encryption mode and host roles to be applied.",
           "$ref": "#/definitions/disk-encryption"
         },
+        "firmware_policy": {
+          "description": "The firmware settings that the hosts of the cluster must comply with. An empty policy removes the policy of the cluster.",
+          "$ref": "#/definitions/firmware-policy"
+        },
         "http_proxy": {
           "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
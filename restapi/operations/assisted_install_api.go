@@ -7,6 +7,7 @@ package operations
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -19,11 +20,17 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/openshift/assisted-service/restapi/operations/cluster_archives"
+	"github.com/openshift/assisted-service/restapi/operations/cluster_plan"
+	"github.com/openshift/assisted-service/restapi/operations/cluster_revisions"
+	"github.com/openshift/assisted-service/restapi/operations/cluster_templates"
 	"github.com/openshift/assisted-service/restapi/operations/events"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/openshift/assisted-service/restapi/operations/installer_cache"
 	"github.com/openshift/assisted-service/restapi/operations/managed_domains"
 	"github.com/openshift/assisted-service/restapi/operations/manifests"
 	"github.com/openshift/assisted-service/restapi/operations/operators"
+	"github.com/openshift/assisted-service/restapi/operations/subscriptions"
 	"github.com/openshift/assisted-service/restapi/operations/versions"
 )
 
@@ -50,6 +57,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 
 		BinProducer:  runtime.ByteStreamProducer(),
 		JSONProducer: runtime.JSONProducer(),
+		TextEventStreamProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("textEventStream producer has not yet been implemented")
+		}),
 
 		InstallerBindHostHandler: installer.BindHostHandlerFunc(func(params installer.BindHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.BindHost has not yet been implemented")
@@ -171,15 +181,30 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2UploadLogsHandler: installer.V2UploadLogsHandlerFunc(func(params installer.V2UploadLogsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UploadLogs has not yet been implemented")
 		}),
+		InstallerV2AcceptHostHardwareHandler: installer.V2AcceptHostHardwareHandlerFunc(func(params installer.V2AcceptHostHardwareParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2AcceptHostHardware has not yet been implemented")
+		}),
+		ClusterPlanV2ApplyClusterPlanHandler: cluster_plan.V2ApplyClusterPlanHandlerFunc(func(params cluster_plan.V2ApplyClusterPlanParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_plan.V2ApplyClusterPlan has not yet been implemented")
+		}),
+		InstallerV2BulkUpdateHostsHandler: installer.V2BulkUpdateHostsHandlerFunc(func(params installer.V2BulkUpdateHostsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2BulkUpdateHosts has not yet been implemented")
+		}),
 		InstallerV2CompleteInstallationHandler: installer.V2CompleteInstallationHandlerFunc(func(params installer.V2CompleteInstallationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2CompleteInstallation has not yet been implemented")
 		}),
 		InstallerV2DeregisterClusterHandler: installer.V2DeregisterClusterHandlerFunc(func(params installer.V2DeregisterClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DeregisterCluster has not yet been implemented")
 		}),
+		ClusterTemplatesV2DeregisterClusterTemplateHandler: cluster_templates.V2DeregisterClusterTemplateHandlerFunc(func(params cluster_templates.V2DeregisterClusterTemplateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.V2DeregisterClusterTemplate has not yet been implemented")
+		}),
 		InstallerV2DeregisterHostHandler: installer.V2DeregisterHostHandlerFunc(func(params installer.V2DeregisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DeregisterHost has not yet been implemented")
 		}),
+		SubscriptionsV2DeregisterSubscriptionHandler: subscriptions.V2DeregisterSubscriptionHandlerFunc(func(params subscriptions.V2DeregisterSubscriptionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation subscriptions.V2DeregisterSubscription has not yet been implemented")
+		}),
 		ManifestsV2DownloadClusterManifestHandler: manifests.V2DownloadClusterManifestHandlerFunc(func(params manifests.V2DownloadClusterManifestParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation manifests.V2DownloadClusterManifest has not yet been implemented")
 		}),
@@ -189,12 +214,30 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2DownloadInfraEnvFilesHandler: installer.V2DownloadInfraEnvFilesHandlerFunc(func(params installer.V2DownloadInfraEnvFilesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DownloadInfraEnvFiles has not yet been implemented")
 		}),
+		InstallerV2DryRunInstallClusterHandler: installer.V2DryRunInstallClusterHandlerFunc(func(params installer.V2DryRunInstallClusterParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2DryRunInstallCluster has not yet been implemented")
+		}),
+		ClusterArchivesV2ExportClusterHandler: cluster_archives.V2ExportClusterHandlerFunc(func(params cluster_archives.V2ExportClusterParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_archives.V2ExportCluster has not yet been implemented")
+		}),
 		InstallerV2GetClusterHandler: installer.V2GetClusterHandlerFunc(func(params installer.V2GetClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetCluster has not yet been implemented")
 		}),
+		ClusterArchivesV2GetClusterArchiveEncryptionKeyHandler: cluster_archives.V2GetClusterArchiveEncryptionKeyHandlerFunc(func(params cluster_archives.V2GetClusterArchiveEncryptionKeyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_archives.V2GetClusterArchiveEncryptionKey has not yet been implemented")
+		}),
 		InstallerV2GetClusterInstallConfigHandler: installer.V2GetClusterInstallConfigHandlerFunc(func(params installer.V2GetClusterInstallConfigParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetClusterInstallConfig has not yet been implemented")
 		}),
+		InstallerV2GetClusterNetworkTopologyHandler: installer.V2GetClusterNetworkTopologyHandlerFunc(func(params installer.V2GetClusterNetworkTopologyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GetClusterNetworkTopology has not yet been implemented")
+		}),
+		ClusterRevisionsV2GetClusterRevisionDiffHandler: cluster_revisions.V2GetClusterRevisionDiffHandlerFunc(func(params cluster_revisions.V2GetClusterRevisionDiffParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_revisions.V2GetClusterRevisionDiff has not yet been implemented")
+		}),
+		ClusterTemplatesV2GetClusterTemplateHandler: cluster_templates.V2GetClusterTemplateHandlerFunc(func(params cluster_templates.V2GetClusterTemplateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.V2GetClusterTemplate has not yet been implemented")
+		}),
 		InstallerV2GetHostHandler: installer.V2GetHostHandlerFunc(func(params installer.V2GetHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetHost has not yet been implemented")
 		}),
@@ -210,15 +253,27 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2GetPreflightRequirementsHandler: installer.V2GetPreflightRequirementsHandlerFunc(func(params installer.V2GetPreflightRequirementsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetPreflightRequirements has not yet been implemented")
 		}),
+		SubscriptionsV2GetSubscriptionHandler: subscriptions.V2GetSubscriptionHandlerFunc(func(params subscriptions.V2GetSubscriptionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation subscriptions.V2GetSubscription has not yet been implemented")
+		}),
 		InstallerV2ImportClusterHandler: installer.V2ImportClusterHandlerFunc(func(params installer.V2ImportClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ImportCluster has not yet been implemented")
 		}),
+		ClusterArchivesV2ImportClusterArchiveHandler: cluster_archives.V2ImportClusterArchiveHandlerFunc(func(params cluster_archives.V2ImportClusterArchiveParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_archives.V2ImportClusterArchive has not yet been implemented")
+		}),
 		InstallerV2InstallClusterHandler: installer.V2InstallClusterHandlerFunc(func(params installer.V2InstallClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2InstallCluster has not yet been implemented")
 		}),
 		InstallerV2InstallHostHandler: installer.V2InstallHostHandlerFunc(func(params installer.V2InstallHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2InstallHost has not yet been implemented")
 		}),
+		ClusterRevisionsV2ListClusterRevisionsHandler: cluster_revisions.V2ListClusterRevisionsHandlerFunc(func(params cluster_revisions.V2ListClusterRevisionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_revisions.V2ListClusterRevisions has not yet been implemented")
+		}),
+		ClusterTemplatesV2ListClusterTemplatesHandler: cluster_templates.V2ListClusterTemplatesHandlerFunc(func(params cluster_templates.V2ListClusterTemplatesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.V2ListClusterTemplates has not yet been implemented")
+		}),
 		InstallerV2ListClustersHandler: installer.V2ListClustersHandlerFunc(func(params installer.V2ListClustersParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ListClusters has not yet been implemented")
 		}),
@@ -228,27 +283,51 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		EventsV2ListEventsHandler: events.V2ListEventsHandlerFunc(func(params events.V2ListEventsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation events.V2ListEvents has not yet been implemented")
 		}),
+		InstallerV2ListHostHardwareRevisionsHandler: installer.V2ListHostHardwareRevisionsHandlerFunc(func(params installer.V2ListHostHardwareRevisionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ListHostHardwareRevisions has not yet been implemented")
+		}),
 		InstallerV2ListHostsHandler: installer.V2ListHostsHandlerFunc(func(params installer.V2ListHostsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ListHosts has not yet been implemented")
 		}),
+		InstallerCacheV2ListInstallerCacheReleasesHandler: installer_cache.V2ListInstallerCacheReleasesHandlerFunc(func(params installer_cache.V2ListInstallerCacheReleasesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer_cache.V2ListInstallerCacheReleases has not yet been implemented")
+		}),
 		VersionsV2ListReleaseSourcesHandler: versions.V2ListReleaseSourcesHandlerFunc(func(params versions.V2ListReleaseSourcesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation versions.V2ListReleaseSources has not yet been implemented")
 		}),
+		SubscriptionsV2ListSubscriptionDeliveriesHandler: subscriptions.V2ListSubscriptionDeliveriesHandlerFunc(func(params subscriptions.V2ListSubscriptionDeliveriesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation subscriptions.V2ListSubscriptionDeliveries has not yet been implemented")
+		}),
+		SubscriptionsV2ListSubscriptionsHandler: subscriptions.V2ListSubscriptionsHandlerFunc(func(params subscriptions.V2ListSubscriptionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation subscriptions.V2ListSubscriptions has not yet been implemented")
+		}),
 		VersionsV2ListSupportedOpenshiftVersionsHandler: versions.V2ListSupportedOpenshiftVersionsHandlerFunc(func(params versions.V2ListSupportedOpenshiftVersionsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation versions.V2ListSupportedOpenshiftVersions has not yet been implemented")
 		}),
 		InstallerV2PostStepReplyHandler: installer.V2PostStepReplyHandlerFunc(func(params installer.V2PostStepReplyParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2PostStepReply has not yet been implemented")
 		}),
+		InstallerCacheV2PrewarmInstallerCacheReleaseHandler: installer_cache.V2PrewarmInstallerCacheReleaseHandlerFunc(func(params installer_cache.V2PrewarmInstallerCacheReleaseParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer_cache.V2PrewarmInstallerCacheRelease has not yet been implemented")
+		}),
+		SubscriptionsV2RedeliverSubscriptionDeliveryHandler: subscriptions.V2RedeliverSubscriptionDeliveryHandlerFunc(func(params subscriptions.V2RedeliverSubscriptionDeliveryParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation subscriptions.V2RedeliverSubscriptionDelivery has not yet been implemented")
+		}),
 		InstallerV2RegisterClusterHandler: installer.V2RegisterClusterHandlerFunc(func(params installer.V2RegisterClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2RegisterCluster has not yet been implemented")
 		}),
+		ClusterTemplatesV2RegisterClusterTemplateHandler: cluster_templates.V2RegisterClusterTemplateHandlerFunc(func(params cluster_templates.V2RegisterClusterTemplateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.V2RegisterClusterTemplate has not yet been implemented")
+		}),
 		InstallerV2RegisterDisconnectedClusterHandler: installer.V2RegisterDisconnectedClusterHandlerFunc(func(params installer.V2RegisterDisconnectedClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2RegisterDisconnectedCluster has not yet been implemented")
 		}),
 		InstallerV2RegisterHostHandler: installer.V2RegisterHostHandlerFunc(func(params installer.V2RegisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2RegisterHost has not yet been implemented")
 		}),
+		SubscriptionsV2RegisterSubscriptionHandler: subscriptions.V2RegisterSubscriptionHandlerFunc(func(params subscriptions.V2RegisterSubscriptionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation subscriptions.V2RegisterSubscription has not yet been implemented")
+		}),
 		OperatorsV2ReportMonitoredOperatorStatusHandler: operators.V2ReportMonitoredOperatorStatusHandlerFunc(func(params operators.V2ReportMonitoredOperatorStatusParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operators.V2ReportMonitoredOperatorStatus has not yet been implemented")
 		}),
@@ -261,6 +340,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2ResetHostValidationHandler: installer.V2ResetHostValidationHandlerFunc(func(params installer.V2ResetHostValidationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ResetHostValidation has not yet been implemented")
 		}),
+		ClusterRevisionsV2RollbackClusterRevisionHandler: cluster_revisions.V2RollbackClusterRevisionHandlerFunc(func(params cluster_revisions.V2RollbackClusterRevisionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_revisions.V2RollbackClusterRevision has not yet been implemented")
+		}),
 		InstallerV2SetIgnoredValidationsHandler: installer.V2SetIgnoredValidationsHandlerFunc(func(params installer.V2SetIgnoredValidationsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2SetIgnoredValidations has not yet been implemented")
 		}),
@@ -276,6 +358,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2UpdateClusterLogsProgressHandler: installer.V2UpdateClusterLogsProgressHandlerFunc(func(params installer.V2UpdateClusterLogsProgressParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UpdateClusterLogsProgress has not yet been implemented")
 		}),
+		ClusterTemplatesV2UpdateClusterTemplateHandler: cluster_templates.V2UpdateClusterTemplateHandlerFunc(func(params cluster_templates.V2UpdateClusterTemplateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.V2UpdateClusterTemplate has not yet been implemented")
+		}),
 		InstallerV2UpdateHostHandler: installer.V2UpdateHostHandlerFunc(func(params installer.V2UpdateHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UpdateHost has not yet been implemented")
 		}),
@@ -291,9 +376,18 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2UpdateHostLogsProgressHandler: installer.V2UpdateHostLogsProgressHandlerFunc(func(params installer.V2UpdateHostLogsProgressParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UpdateHostLogsProgress has not yet been implemented")
 		}),
+		InstallerCacheV2UpdateInstallerCacheReleaseHandler: installer_cache.V2UpdateInstallerCacheReleaseHandlerFunc(func(params installer_cache.V2UpdateInstallerCacheReleaseParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer_cache.V2UpdateInstallerCacheRelease has not yet been implemented")
+		}),
+		SubscriptionsV2UpdateSubscriptionHandler: subscriptions.V2UpdateSubscriptionHandlerFunc(func(params subscriptions.V2UpdateSubscriptionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation subscriptions.V2UpdateSubscription has not yet been implemented")
+		}),
 		InstallerV2UploadClusterIngressCertHandler: installer.V2UploadClusterIngressCertHandlerFunc(func(params installer.V2UploadClusterIngressCertParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UploadClusterIngressCert has not yet been implemented")
 		}),
+		EventsV2WatchHandler: events.V2WatchHandlerFunc(func(params events.V2WatchParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation events.V2Watch has not yet been implemented")
+		}),
 
 		// Applies when the "X-Secret-Key" header is set
 		AgentAuthAuth: func(token string) (interface{}, error) {
@@ -362,6 +456,9 @@ type AssistedInstallAPI struct {
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
+	// TextEventStreamProducer registers a producer for the following mime types:
+	//   - text/event-stream
+	TextEventStreamProducer runtime.Producer
 
 	// AgentAuthAuth registers a function that takes a token and returns a principal
 	// it performs authentication based on an api key X-Secret-Key provided in the header
@@ -470,22 +567,44 @@ type AssistedInstallAPI struct {
 	InstallerV2UpdateClusterUISettingsHandler installer.V2UpdateClusterUISettingsHandler
 	// InstallerV2UploadLogsHandler sets the operation handler for the v2 upload logs operation
 	InstallerV2UploadLogsHandler installer.V2UploadLogsHandler
+	// InstallerV2AcceptHostHardwareHandler sets the operation handler for the v2 accept host hardware operation
+	InstallerV2AcceptHostHardwareHandler installer.V2AcceptHostHardwareHandler
+	// ClusterPlanV2ApplyClusterPlanHandler sets the operation handler for the v2 apply cluster plan operation
+	ClusterPlanV2ApplyClusterPlanHandler cluster_plan.V2ApplyClusterPlanHandler
+	// InstallerV2BulkUpdateHostsHandler sets the operation handler for the v2 bulk update hosts operation
+	InstallerV2BulkUpdateHostsHandler installer.V2BulkUpdateHostsHandler
 	// InstallerV2CompleteInstallationHandler sets the operation handler for the v2 complete installation operation
 	InstallerV2CompleteInstallationHandler installer.V2CompleteInstallationHandler
 	// InstallerV2DeregisterClusterHandler sets the operation handler for the v2 deregister cluster operation
 	InstallerV2DeregisterClusterHandler installer.V2DeregisterClusterHandler
+	// ClusterTemplatesV2DeregisterClusterTemplateHandler sets the operation handler for the v2 deregister cluster template operation
+	ClusterTemplatesV2DeregisterClusterTemplateHandler cluster_templates.V2DeregisterClusterTemplateHandler
 	// InstallerV2DeregisterHostHandler sets the operation handler for the v2 deregister host operation
 	InstallerV2DeregisterHostHandler installer.V2DeregisterHostHandler
+	// SubscriptionsV2DeregisterSubscriptionHandler sets the operation handler for the v2 deregister subscription operation
+	SubscriptionsV2DeregisterSubscriptionHandler subscriptions.V2DeregisterSubscriptionHandler
 	// ManifestsV2DownloadClusterManifestHandler sets the operation handler for the v2 download cluster manifest operation
 	ManifestsV2DownloadClusterManifestHandler manifests.V2DownloadClusterManifestHandler
 	// InstallerV2DownloadHostIgnitionHandler sets the operation handler for the v2 download host ignition operation
 	InstallerV2DownloadHostIgnitionHandler installer.V2DownloadHostIgnitionHandler
 	// InstallerV2DownloadInfraEnvFilesHandler sets the operation handler for the v2 download infra env files operation
 	InstallerV2DownloadInfraEnvFilesHandler installer.V2DownloadInfraEnvFilesHandler
+	// InstallerV2DryRunInstallClusterHandler sets the operation handler for the v2 dry run install cluster operation
+	InstallerV2DryRunInstallClusterHandler installer.V2DryRunInstallClusterHandler
+	// ClusterArchivesV2ExportClusterHandler sets the operation handler for the v2 export cluster operation
+	ClusterArchivesV2ExportClusterHandler cluster_archives.V2ExportClusterHandler
 	// InstallerV2GetClusterHandler sets the operation handler for the v2 get cluster operation
 	InstallerV2GetClusterHandler installer.V2GetClusterHandler
+	// ClusterArchivesV2GetClusterArchiveEncryptionKeyHandler sets the operation handler for the v2 get cluster archive encryption key operation
+	ClusterArchivesV2GetClusterArchiveEncryptionKeyHandler cluster_archives.V2GetClusterArchiveEncryptionKeyHandler
 	// InstallerV2GetClusterInstallConfigHandler sets the operation handler for the v2 get cluster install config operation
 	InstallerV2GetClusterInstallConfigHandler installer.V2GetClusterInstallConfigHandler
+	// InstallerV2GetClusterNetworkTopologyHandler sets the operation handler for the v2 get cluster network topology operation
+	InstallerV2GetClusterNetworkTopologyHandler installer.V2GetClusterNetworkTopologyHandler
+	// ClusterRevisionsV2GetClusterRevisionDiffHandler sets the operation handler for the v2 get cluster revision diff operation
+	ClusterRevisionsV2GetClusterRevisionDiffHandler cluster_revisions.V2GetClusterRevisionDiffHandler
+	// ClusterTemplatesV2GetClusterTemplateHandler sets the operation handler for the v2 get cluster template operation
+	ClusterTemplatesV2GetClusterTemplateHandler cluster_templates.V2GetClusterTemplateHandler
 	// InstallerV2GetHostHandler sets the operation handler for the v2 get host operation
 	InstallerV2GetHostHandler installer.V2GetHostHandler
 	// InstallerV2GetHostIgnitionHandler sets the operation handler for the v2 get host ignition operation
@@ -496,32 +615,56 @@ type AssistedInstallAPI struct {
 	InstallerV2GetNextStepsHandler installer.V2GetNextStepsHandler
 	// InstallerV2GetPreflightRequirementsHandler sets the operation handler for the v2 get preflight requirements operation
 	InstallerV2GetPreflightRequirementsHandler installer.V2GetPreflightRequirementsHandler
+	// SubscriptionsV2GetSubscriptionHandler sets the operation handler for the v2 get subscription operation
+	SubscriptionsV2GetSubscriptionHandler subscriptions.V2GetSubscriptionHandler
 	// InstallerV2ImportClusterHandler sets the operation handler for the v2 import cluster operation
 	InstallerV2ImportClusterHandler installer.V2ImportClusterHandler
+	// ClusterArchivesV2ImportClusterArchiveHandler sets the operation handler for the v2 import cluster archive operation
+	ClusterArchivesV2ImportClusterArchiveHandler cluster_archives.V2ImportClusterArchiveHandler
 	// InstallerV2InstallClusterHandler sets the operation handler for the v2 install cluster operation
 	InstallerV2InstallClusterHandler installer.V2InstallClusterHandler
 	// InstallerV2InstallHostHandler sets the operation handler for the v2 install host operation
 	InstallerV2InstallHostHandler installer.V2InstallHostHandler
+	// ClusterRevisionsV2ListClusterRevisionsHandler sets the operation handler for the v2 list cluster revisions operation
+	ClusterRevisionsV2ListClusterRevisionsHandler cluster_revisions.V2ListClusterRevisionsHandler
+	// ClusterTemplatesV2ListClusterTemplatesHandler sets the operation handler for the v2 list cluster templates operation
+	ClusterTemplatesV2ListClusterTemplatesHandler cluster_templates.V2ListClusterTemplatesHandler
 	// InstallerV2ListClustersHandler sets the operation handler for the v2 list clusters operation
 	InstallerV2ListClustersHandler installer.V2ListClustersHandler
 	// VersionsV2ListComponentVersionsHandler sets the operation handler for the v2 list component versions operation
 	VersionsV2ListComponentVersionsHandler versions.V2ListComponentVersionsHandler
 	// EventsV2ListEventsHandler sets the operation handler for the v2 list events operation
 	EventsV2ListEventsHandler events.V2ListEventsHandler
+	// InstallerV2ListHostHardwareRevisionsHandler sets the operation handler for the v2 list host hardware revisions operation
+	InstallerV2ListHostHardwareRevisionsHandler installer.V2ListHostHardwareRevisionsHandler
 	// InstallerV2ListHostsHandler sets the operation handler for the v2 list hosts operation
 	InstallerV2ListHostsHandler installer.V2ListHostsHandler
+	// InstallerCacheV2ListInstallerCacheReleasesHandler sets the operation handler for the v2 list installer cache releases operation
+	InstallerCacheV2ListInstallerCacheReleasesHandler installer_cache.V2ListInstallerCacheReleasesHandler
 	// VersionsV2ListReleaseSourcesHandler sets the operation handler for the v2 list release sources operation
 	VersionsV2ListReleaseSourcesHandler versions.V2ListReleaseSourcesHandler
+	// SubscriptionsV2ListSubscriptionDeliveriesHandler sets the operation handler for the v2 list subscription deliveries operation
+	SubscriptionsV2ListSubscriptionDeliveriesHandler subscriptions.V2ListSubscriptionDeliveriesHandler
+	// SubscriptionsV2ListSubscriptionsHandler sets the operation handler for the v2 list subscriptions operation
+	SubscriptionsV2ListSubscriptionsHandler subscriptions.V2ListSubscriptionsHandler
 	// VersionsV2ListSupportedOpenshiftVersionsHandler sets the operation handler for the v2 list supported openshift versions operation
 	VersionsV2ListSupportedOpenshiftVersionsHandler versions.V2ListSupportedOpenshiftVersionsHandler
 	// InstallerV2PostStepReplyHandler sets the operation handler for the v2 post step reply operation
 	InstallerV2PostStepReplyHandler installer.V2PostStepReplyHandler
+	// InstallerCacheV2PrewarmInstallerCacheReleaseHandler sets the operation handler for the v2 prewarm installer cache release operation
+	InstallerCacheV2PrewarmInstallerCacheReleaseHandler installer_cache.V2PrewarmInstallerCacheReleaseHandler
+	// SubscriptionsV2RedeliverSubscriptionDeliveryHandler sets the operation handler for the v2 redeliver subscription delivery operation
+	SubscriptionsV2RedeliverSubscriptionDeliveryHandler subscriptions.V2RedeliverSubscriptionDeliveryHandler
 	// InstallerV2RegisterClusterHandler sets the operation handler for the v2 register cluster operation
 	InstallerV2RegisterClusterHandler installer.V2RegisterClusterHandler
+	// ClusterTemplatesV2RegisterClusterTemplateHandler sets the operation handler for the v2 register cluster template operation
+	ClusterTemplatesV2RegisterClusterTemplateHandler cluster_templates.V2RegisterClusterTemplateHandler
 	// InstallerV2RegisterDisconnectedClusterHandler sets the operation handler for the v2 register disconnected cluster operation
 	InstallerV2RegisterDisconnectedClusterHandler installer.V2RegisterDisconnectedClusterHandler
 	// InstallerV2RegisterHostHandler sets the operation handler for the v2 register host operation
 	InstallerV2RegisterHostHandler installer.V2RegisterHostHandler
+	// SubscriptionsV2RegisterSubscriptionHandler sets the operation handler for the v2 register subscription operation
+	SubscriptionsV2RegisterSubscriptionHandler subscriptions.V2RegisterSubscriptionHandler
 	// OperatorsV2ReportMonitoredOperatorStatusHandler sets the operation handler for the v2 report monitored operator status operation
 	OperatorsV2ReportMonitoredOperatorStatusHandler operators.V2ReportMonitoredOperatorStatusHandler
 	// InstallerV2ResetClusterHandler sets the operation handler for the v2 reset cluster operation
@@ -530,6 +673,8 @@ type AssistedInstallAPI struct {
 	InstallerV2ResetHostHandler installer.V2ResetHostHandler
 	// InstallerV2ResetHostValidationHandler sets the operation handler for the v2 reset host validation operation
 	InstallerV2ResetHostValidationHandler installer.V2ResetHostValidationHandler
+	// ClusterRevisionsV2RollbackClusterRevisionHandler sets the operation handler for the v2 rollback cluster revision operation
+	ClusterRevisionsV2RollbackClusterRevisionHandler cluster_revisions.V2RollbackClusterRevisionHandler
 	// InstallerV2SetIgnoredValidationsHandler sets the operation handler for the v2 set ignored validations operation
 	InstallerV2SetIgnoredValidationsHandler installer.V2SetIgnoredValidationsHandler
 	// EventsV2TriggerEventHandler sets the operation handler for the v2 trigger event operation
@@ -540,6 +685,8 @@ type AssistedInstallAPI struct {
 	InstallerV2UpdateClusterInstallConfigHandler installer.V2UpdateClusterInstallConfigHandler
 	// InstallerV2UpdateClusterLogsProgressHandler sets the operation handler for the v2 update cluster logs progress operation
 	InstallerV2UpdateClusterLogsProgressHandler installer.V2UpdateClusterLogsProgressHandler
+	// ClusterTemplatesV2UpdateClusterTemplateHandler sets the operation handler for the v2 update cluster template operation
+	ClusterTemplatesV2UpdateClusterTemplateHandler cluster_templates.V2UpdateClusterTemplateHandler
 	// InstallerV2UpdateHostHandler sets the operation handler for the v2 update host operation
 	InstallerV2UpdateHostHandler installer.V2UpdateHostHandler
 	// InstallerV2UpdateHostIgnitionHandler sets the operation handler for the v2 update host ignition operation
@@ -550,8 +697,14 @@ type AssistedInstallAPI struct {
 	InstallerV2UpdateHostInstallerArgsHandler installer.V2UpdateHostInstallerArgsHandler
 	// InstallerV2UpdateHostLogsProgressHandler sets the operation handler for the v2 update host logs progress operation
 	InstallerV2UpdateHostLogsProgressHandler installer.V2UpdateHostLogsProgressHandler
+	// InstallerCacheV2UpdateInstallerCacheReleaseHandler sets the operation handler for the v2 update installer cache release operation
+	InstallerCacheV2UpdateInstallerCacheReleaseHandler installer_cache.V2UpdateInstallerCacheReleaseHandler
+	// SubscriptionsV2UpdateSubscriptionHandler sets the operation handler for the v2 update subscription operation
+	SubscriptionsV2UpdateSubscriptionHandler subscriptions.V2UpdateSubscriptionHandler
 	// InstallerV2UploadClusterIngressCertHandler sets the operation handler for the v2 upload cluster ingress cert operation
 	InstallerV2UploadClusterIngressCertHandler installer.V2UploadClusterIngressCertHandler
+	// EventsV2WatchHandler sets the operation handler for the v2 watch operation
+	EventsV2WatchHandler events.V2WatchHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -634,6 +787,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
+	if o.TextEventStreamProducer == nil {
+		unregistered = append(unregistered, "TextEventStreamProducer")
+	}
 
 	if o.AgentAuthAuth == nil {
 		unregistered = append(unregistered, "XSecretKeyAuth")
@@ -774,15 +930,30 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2UploadLogsHandler == nil {
 		unregistered = append(unregistered, "installer.V2UploadLogsHandler")
 	}
+	if o.InstallerV2AcceptHostHardwareHandler == nil {
+		unregistered = append(unregistered, "installer.V2AcceptHostHardwareHandler")
+	}
+	if o.ClusterPlanV2ApplyClusterPlanHandler == nil {
+		unregistered = append(unregistered, "cluster_plan.V2ApplyClusterPlanHandler")
+	}
+	if o.InstallerV2BulkUpdateHostsHandler == nil {
+		unregistered = append(unregistered, "installer.V2BulkUpdateHostsHandler")
+	}
 	if o.InstallerV2CompleteInstallationHandler == nil {
 		unregistered = append(unregistered, "installer.V2CompleteInstallationHandler")
 	}
 	if o.InstallerV2DeregisterClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2DeregisterClusterHandler")
 	}
+	if o.ClusterTemplatesV2DeregisterClusterTemplateHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.V2DeregisterClusterTemplateHandler")
+	}
 	if o.InstallerV2DeregisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2DeregisterHostHandler")
 	}
+	if o.SubscriptionsV2DeregisterSubscriptionHandler == nil {
+		unregistered = append(unregistered, "subscriptions.V2DeregisterSubscriptionHandler")
+	}
 	if o.ManifestsV2DownloadClusterManifestHandler == nil {
 		unregistered = append(unregistered, "manifests.V2DownloadClusterManifestHandler")
 	}
@@ -792,12 +963,30 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2DownloadInfraEnvFilesHandler == nil {
 		unregistered = append(unregistered, "installer.V2DownloadInfraEnvFilesHandler")
 	}
+	if o.InstallerV2DryRunInstallClusterHandler == nil {
+		unregistered = append(unregistered, "installer.V2DryRunInstallClusterHandler")
+	}
+	if o.ClusterArchivesV2ExportClusterHandler == nil {
+		unregistered = append(unregistered, "cluster_archives.V2ExportClusterHandler")
+	}
 	if o.InstallerV2GetClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetClusterHandler")
 	}
+	if o.ClusterArchivesV2GetClusterArchiveEncryptionKeyHandler == nil {
+		unregistered = append(unregistered, "cluster_archives.V2GetClusterArchiveEncryptionKeyHandler")
+	}
 	if o.InstallerV2GetClusterInstallConfigHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetClusterInstallConfigHandler")
 	}
+	if o.InstallerV2GetClusterNetworkTopologyHandler == nil {
+		unregistered = append(unregistered, "installer.V2GetClusterNetworkTopologyHandler")
+	}
+	if o.ClusterRevisionsV2GetClusterRevisionDiffHandler == nil {
+		unregistered = append(unregistered, "cluster_revisions.V2GetClusterRevisionDiffHandler")
+	}
+	if o.ClusterTemplatesV2GetClusterTemplateHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.V2GetClusterTemplateHandler")
+	}
 	if o.InstallerV2GetHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetHostHandler")
 	}
@@ -813,15 +1002,27 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2GetPreflightRequirementsHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetPreflightRequirementsHandler")
 	}
+	if o.SubscriptionsV2GetSubscriptionHandler == nil {
+		unregistered = append(unregistered, "subscriptions.V2GetSubscriptionHandler")
+	}
 	if o.InstallerV2ImportClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2ImportClusterHandler")
 	}
+	if o.ClusterArchivesV2ImportClusterArchiveHandler == nil {
+		unregistered = append(unregistered, "cluster_archives.V2ImportClusterArchiveHandler")
+	}
 	if o.InstallerV2InstallClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2InstallClusterHandler")
 	}
 	if o.InstallerV2InstallHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2InstallHostHandler")
 	}
+	if o.ClusterRevisionsV2ListClusterRevisionsHandler == nil {
+		unregistered = append(unregistered, "cluster_revisions.V2ListClusterRevisionsHandler")
+	}
+	if o.ClusterTemplatesV2ListClusterTemplatesHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.V2ListClusterTemplatesHandler")
+	}
 	if o.InstallerV2ListClustersHandler == nil {
 		unregistered = append(unregistered, "installer.V2ListClustersHandler")
 	}
@@ -831,27 +1032,51 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.EventsV2ListEventsHandler == nil {
 		unregistered = append(unregistered, "events.V2ListEventsHandler")
 	}
+	if o.InstallerV2ListHostHardwareRevisionsHandler == nil {
+		unregistered = append(unregistered, "installer.V2ListHostHardwareRevisionsHandler")
+	}
 	if o.InstallerV2ListHostsHandler == nil {
 		unregistered = append(unregistered, "installer.V2ListHostsHandler")
 	}
+	if o.InstallerCacheV2ListInstallerCacheReleasesHandler == nil {
+		unregistered = append(unregistered, "installer_cache.V2ListInstallerCacheReleasesHandler")
+	}
 	if o.VersionsV2ListReleaseSourcesHandler == nil {
 		unregistered = append(unregistered, "versions.V2ListReleaseSourcesHandler")
 	}
+	if o.SubscriptionsV2ListSubscriptionDeliveriesHandler == nil {
+		unregistered = append(unregistered, "subscriptions.V2ListSubscriptionDeliveriesHandler")
+	}
+	if o.SubscriptionsV2ListSubscriptionsHandler == nil {
+		unregistered = append(unregistered, "subscriptions.V2ListSubscriptionsHandler")
+	}
 	if o.VersionsV2ListSupportedOpenshiftVersionsHandler == nil {
 		unregistered = append(unregistered, "versions.V2ListSupportedOpenshiftVersionsHandler")
 	}
 	if o.InstallerV2PostStepReplyHandler == nil {
 		unregistered = append(unregistered, "installer.V2PostStepReplyHandler")
 	}
+	if o.InstallerCacheV2PrewarmInstallerCacheReleaseHandler == nil {
+		unregistered = append(unregistered, "installer_cache.V2PrewarmInstallerCacheReleaseHandler")
+	}
+	if o.SubscriptionsV2RedeliverSubscriptionDeliveryHandler == nil {
+		unregistered = append(unregistered, "subscriptions.V2RedeliverSubscriptionDeliveryHandler")
+	}
 	if o.InstallerV2RegisterClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2RegisterClusterHandler")
 	}
+	if o.ClusterTemplatesV2RegisterClusterTemplateHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.V2RegisterClusterTemplateHandler")
+	}
 	if o.InstallerV2RegisterDisconnectedClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2RegisterDisconnectedClusterHandler")
 	}
 	if o.InstallerV2RegisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2RegisterHostHandler")
 	}
+	if o.SubscriptionsV2RegisterSubscriptionHandler == nil {
+		unregistered = append(unregistered, "subscriptions.V2RegisterSubscriptionHandler")
+	}
 	if o.OperatorsV2ReportMonitoredOperatorStatusHandler == nil {
 		unregistered = append(unregistered, "operators.V2ReportMonitoredOperatorStatusHandler")
 	}
@@ -864,6 +1089,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2ResetHostValidationHandler == nil {
 		unregistered = append(unregistered, "installer.V2ResetHostValidationHandler")
 	}
+	if o.ClusterRevisionsV2RollbackClusterRevisionHandler == nil {
+		unregistered = append(unregistered, "cluster_revisions.V2RollbackClusterRevisionHandler")
+	}
 	if o.InstallerV2SetIgnoredValidationsHandler == nil {
 		unregistered = append(unregistered, "installer.V2SetIgnoredValidationsHandler")
 	}
@@ -879,6 +1107,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2UpdateClusterLogsProgressHandler == nil {
 		unregistered = append(unregistered, "installer.V2UpdateClusterLogsProgressHandler")
 	}
+	if o.ClusterTemplatesV2UpdateClusterTemplateHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.V2UpdateClusterTemplateHandler")
+	}
 	if o.InstallerV2UpdateHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2UpdateHostHandler")
 	}
@@ -894,9 +1125,18 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2UpdateHostLogsProgressHandler == nil {
 		unregistered = append(unregistered, "installer.V2UpdateHostLogsProgressHandler")
 	}
+	if o.InstallerCacheV2UpdateInstallerCacheReleaseHandler == nil {
+		unregistered = append(unregistered, "installer_cache.V2UpdateInstallerCacheReleaseHandler")
+	}
+	if o.SubscriptionsV2UpdateSubscriptionHandler == nil {
+		unregistered = append(unregistered, "subscriptions.V2UpdateSubscriptionHandler")
+	}
 	if o.InstallerV2UploadClusterIngressCertHandler == nil {
 		unregistered = append(unregistered, "installer.V2UploadClusterIngressCertHandler")
 	}
+	if o.EventsV2WatchHandler == nil {
+		unregistered = append(unregistered, "events.V2WatchHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -978,6 +1218,8 @@ func (o *AssistedInstallAPI) ProducersFor(mediaTypes []string) map[string]runtim
 			result["application/octet-stream"] = o.BinProducer
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "text/event-stream":
+			result["text/event-stream"] = o.TextEventStreamProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -1181,6 +1423,18 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/accept-hardware"] = installer.NewV2AcceptHostHardware(o.context, o.InstallerV2AcceptHostHardwareHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/clusters/{cluster_id}/plan"] = cluster_plan.NewV2ApplyClusterPlan(o.context, o.ClusterPlanV2ApplyClusterPlanHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/hosts/actions/bulk"] = installer.NewV2BulkUpdateHosts(o.context, o.InstallerV2BulkUpdateHostsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/v2/clusters/{cluster_id}/actions/complete-installation"] = installer.NewV2CompleteInstallation(o.context, o.InstallerV2CompleteInstallationHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1189,7 +1443,15 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/v2/cluster-templates/{cluster_template_id}"] = cluster_templates.NewV2DeregisterClusterTemplate(o.context, o.ClusterTemplatesV2DeregisterClusterTemplateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}"] = installer.NewV2DeregisterHost(o.context, o.InstallerV2DeregisterHostHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/v2/subscriptions/{subscription_id}"] = subscriptions.NewV2DeregisterSubscription(o.context, o.SubscriptionsV2DeregisterSubscriptionHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1202,6 +1464,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/downloads/files"] = installer.NewV2DownloadInfraEnvFiles(o.context, o.InstallerV2DownloadInfraEnvFilesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/clusters/{cluster_id}/actions/dry-run"] = installer.NewV2DryRunInstallCluster(o.context, o.InstallerV2DryRunInstallClusterHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/clusters/{cluster_id}/actions/export"] = cluster_archives.NewV2ExportCluster(o.context, o.ClusterArchivesV2ExportClusterHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1209,10 +1479,26 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/cluster-archives/encryption-key"] = cluster_archives.NewV2GetClusterArchiveEncryptionKey(o.context, o.ClusterArchivesV2GetClusterArchiveEncryptionKeyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/clusters/{cluster_id}/install-config"] = installer.NewV2GetClusterInstallConfig(o.context, o.InstallerV2GetClusterInstallConfigHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/network-topology"] = installer.NewV2GetClusterNetworkTopology(o.context, o.InstallerV2GetClusterNetworkTopologyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/revisions/{revision}/diff"] = cluster_revisions.NewV2GetClusterRevisionDiff(o.context, o.ClusterRevisionsV2GetClusterRevisionDiffHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/cluster-templates/{cluster_template_id}"] = cluster_templates.NewV2GetClusterTemplate(o.context, o.ClusterTemplatesV2GetClusterTemplateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}"] = installer.NewV2GetHost(o.context, o.InstallerV2GetHostHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1230,6 +1516,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/v2/clusters/{cluster_id}/preflight-requirements"] = installer.NewV2GetPreflightRequirements(o.context, o.InstallerV2GetPreflightRequirementsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/subscriptions/{subscription_id}"] = subscriptions.NewV2GetSubscription(o.context, o.SubscriptionsV2GetSubscriptionHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1237,6 +1527,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/v2/cluster-archives/import"] = cluster_archives.NewV2ImportClusterArchive(o.context, o.ClusterArchivesV2ImportClusterArchiveHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/v2/clusters/{cluster_id}/actions/install"] = installer.NewV2InstallCluster(o.context, o.InstallerV2InstallClusterHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1245,6 +1539,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/revisions"] = cluster_revisions.NewV2ListClusterRevisions(o.context, o.ClusterRevisionsV2ListClusterRevisionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/cluster-templates"] = cluster_templates.NewV2ListClusterTemplates(o.context, o.ClusterTemplatesV2ListClusterTemplatesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/clusters"] = installer.NewV2ListClusters(o.context, o.InstallerV2ListClustersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1257,14 +1559,30 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/hardware-revisions"] = installer.NewV2ListHostHardwareRevisions(o.context, o.InstallerV2ListHostHardwareRevisionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts"] = installer.NewV2ListHosts(o.context, o.InstallerV2ListHostsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/installer-cache/releases"] = installer_cache.NewV2ListInstallerCacheReleases(o.context, o.InstallerCacheV2ListInstallerCacheReleasesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/release-sources"] = versions.NewV2ListReleaseSources(o.context, o.VersionsV2ListReleaseSourcesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/subscriptions/{subscription_id}/deliveries"] = subscriptions.NewV2ListSubscriptionDeliveries(o.context, o.SubscriptionsV2ListSubscriptionDeliveriesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/subscriptions"] = subscriptions.NewV2ListSubscriptions(o.context, o.SubscriptionsV2ListSubscriptionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/openshift-versions"] = versions.NewV2ListSupportedOpenshiftVersions(o.context, o.VersionsV2ListSupportedOpenshiftVersionsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1273,15 +1591,31 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/v2/installer-cache/releases"] = installer_cache.NewV2PrewarmInstallerCacheRelease(o.context, o.InstallerCacheV2PrewarmInstallerCacheReleaseHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/subscriptions/{subscription_id}/deliveries/{delivery_id}/actions/redeliver"] = subscriptions.NewV2RedeliverSubscriptionDelivery(o.context, o.SubscriptionsV2RedeliverSubscriptionDeliveryHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/v2/clusters"] = installer.NewV2RegisterCluster(o.context, o.InstallerV2RegisterClusterHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/v2/cluster-templates"] = cluster_templates.NewV2RegisterClusterTemplate(o.context, o.ClusterTemplatesV2RegisterClusterTemplateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/v2/clusters/disconnected"] = installer.NewV2RegisterDisconnectedCluster(o.context, o.InstallerV2RegisterDisconnectedClusterHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/hosts"] = installer.NewV2RegisterHost(o.context, o.InstallerV2RegisterHostHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/subscriptions"] = subscriptions.NewV2RegisterSubscription(o.context, o.SubscriptionsV2RegisterSubscriptionHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -1298,6 +1632,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
 	o.handlers["PATCH"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}"] = installer.NewV2ResetHostValidation(o.context, o.InstallerV2ResetHostValidationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback"] = cluster_revisions.NewV2RollbackClusterRevision(o.context, o.ClusterRevisionsV2RollbackClusterRevisionHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -1321,6 +1659,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
+	o.handlers["PATCH"]["/v2/cluster-templates/{cluster_template_id}"] = cluster_templates.NewV2UpdateClusterTemplate(o.context, o.ClusterTemplatesV2UpdateClusterTemplateHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
 	o.handlers["PATCH"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}"] = installer.NewV2UpdateHost(o.context, o.InstallerV2UpdateHostHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
@@ -1338,10 +1680,22 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/logs-progress"] = installer.NewV2UpdateHostLogsProgress(o.context, o.InstallerV2UpdateHostLogsProgressHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/v2/installer-cache/releases"] = installer_cache.NewV2UpdateInstallerCacheRelease(o.context, o.InstallerCacheV2UpdateInstallerCacheReleaseHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/v2/subscriptions/{subscription_id}"] = subscriptions.NewV2UpdateSubscription(o.context, o.SubscriptionsV2UpdateSubscriptionHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/v2/clusters/{cluster_id}/uploads/ingress-cert"] = installer.NewV2UploadClusterIngressCert(o.context, o.InstallerV2UploadClusterIngressCertHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/watch"] = events.NewV2Watch(o.context, o.EventsV2WatchHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
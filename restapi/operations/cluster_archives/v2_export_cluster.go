@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_archives
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ExportClusterHandlerFunc turns a function with the right signature into a v2 export cluster handler
+type V2ExportClusterHandlerFunc func(V2ExportClusterParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ExportClusterHandlerFunc) Handle(params V2ExportClusterParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ExportClusterHandler interface for that can handle valid v2 export cluster params
+type V2ExportClusterHandler interface {
+	Handle(V2ExportClusterParams, interface{}) middleware.Responder
+}
+
+// NewV2ExportCluster creates a new http.Handler for the v2 export cluster operation
+func NewV2ExportCluster(ctx *middleware.Context, handler V2ExportClusterHandler) *V2ExportCluster {
+	return &V2ExportCluster{Context: ctx, Handler: handler}
+}
+
+/*
+	V2ExportCluster swagger:route POST /v2/clusters/{cluster_id}/actions/export cluster_archives v2ExportCluster
+
+Exports the definition of a cluster to an archive that can be imported by another service. The secrets of the cluster are encrypted with the key of the service that imports the archive.
+*/
+type V2ExportCluster struct {
+	Context *middleware.Context
+	Handler V2ExportClusterHandler
+}
+
+func (o *V2ExportCluster) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ExportClusterParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
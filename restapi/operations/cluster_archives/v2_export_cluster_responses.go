@@ -0,0 +1,284 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_archives
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ExportClusterOKCode is the HTTP code returned for type V2ExportClusterOK
+const V2ExportClusterOKCode int = 200
+
+/*
+V2ExportClusterOK Success.
+
+swagger:response v2ExportClusterOK
+*/
+type V2ExportClusterOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ClusterArchive `json:"body,omitempty"`
+}
+
+// NewV2ExportClusterOK creates V2ExportClusterOK with default headers values
+func NewV2ExportClusterOK() *V2ExportClusterOK {
+
+	return &V2ExportClusterOK{}
+}
+
+// WithPayload adds the payload to the v2 export cluster o k response
+func (o *V2ExportClusterOK) WithPayload(payload *models.ClusterArchive) *V2ExportClusterOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 export cluster o k response
+func (o *V2ExportClusterOK) SetPayload(payload *models.ClusterArchive) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ExportClusterOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ExportClusterBadRequestCode is the HTTP code returned for type V2ExportClusterBadRequest
+const V2ExportClusterBadRequestCode int = 400
+
+/*
+V2ExportClusterBadRequest Error.
+
+swagger:response v2ExportClusterBadRequest
+*/
+type V2ExportClusterBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ExportClusterBadRequest creates V2ExportClusterBadRequest with default headers values
+func NewV2ExportClusterBadRequest() *V2ExportClusterBadRequest {
+
+	return &V2ExportClusterBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 export cluster bad request response
+func (o *V2ExportClusterBadRequest) WithPayload(payload *models.Error) *V2ExportClusterBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 export cluster bad request response
+func (o *V2ExportClusterBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ExportClusterBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ExportClusterUnauthorizedCode is the HTTP code returned for type V2ExportClusterUnauthorized
+const V2ExportClusterUnauthorizedCode int = 401
+
+/*
+V2ExportClusterUnauthorized Unauthorized.
+
+swagger:response v2ExportClusterUnauthorized
+*/
+type V2ExportClusterUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ExportClusterUnauthorized creates V2ExportClusterUnauthorized with default headers values
+func NewV2ExportClusterUnauthorized() *V2ExportClusterUnauthorized {
+
+	return &V2ExportClusterUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 export cluster unauthorized response
+func (o *V2ExportClusterUnauthorized) WithPayload(payload *models.InfraError) *V2ExportClusterUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 export cluster unauthorized response
+func (o *V2ExportClusterUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ExportClusterUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ExportClusterForbiddenCode is the HTTP code returned for type V2ExportClusterForbidden
+const V2ExportClusterForbiddenCode int = 403
+
+/*
+V2ExportClusterForbidden Forbidden.
+
+swagger:response v2ExportClusterForbidden
+*/
+type V2ExportClusterForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ExportClusterForbidden creates V2ExportClusterForbidden with default headers values
+func NewV2ExportClusterForbidden() *V2ExportClusterForbidden {
+
+	return &V2ExportClusterForbidden{}
+}
+
+// WithPayload adds the payload to the v2 export cluster forbidden response
+func (o *V2ExportClusterForbidden) WithPayload(payload *models.InfraError) *V2ExportClusterForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 export cluster forbidden response
+func (o *V2ExportClusterForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ExportClusterForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ExportClusterNotFoundCode is the HTTP code returned for type V2ExportClusterNotFound
+const V2ExportClusterNotFoundCode int = 404
+
+/*
+V2ExportClusterNotFound Error.
+
+swagger:response v2ExportClusterNotFound
+*/
+type V2ExportClusterNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ExportClusterNotFound creates V2ExportClusterNotFound with default headers values
+func NewV2ExportClusterNotFound() *V2ExportClusterNotFound {
+
+	return &V2ExportClusterNotFound{}
+}
+
+// WithPayload adds the payload to the v2 export cluster not found response
+func (o *V2ExportClusterNotFound) WithPayload(payload *models.Error) *V2ExportClusterNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 export cluster not found response
+func (o *V2ExportClusterNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ExportClusterNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ExportClusterInternalServerErrorCode is the HTTP code returned for type V2ExportClusterInternalServerError
+const V2ExportClusterInternalServerErrorCode int = 500
+
+/*
+V2ExportClusterInternalServerError Error.
+
+swagger:response v2ExportClusterInternalServerError
+*/
+type V2ExportClusterInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ExportClusterInternalServerError creates V2ExportClusterInternalServerError with default headers values
+func NewV2ExportClusterInternalServerError() *V2ExportClusterInternalServerError {
+
+	return &V2ExportClusterInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 export cluster internal server error response
+func (o *V2ExportClusterInternalServerError) WithPayload(payload *models.Error) *V2ExportClusterInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 export cluster internal server error response
+func (o *V2ExportClusterInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ExportClusterInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
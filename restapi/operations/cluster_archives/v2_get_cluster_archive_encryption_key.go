@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_archives
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2GetClusterArchiveEncryptionKeyHandlerFunc turns a function with the right signature into a v2 get cluster archive encryption key handler
+type V2GetClusterArchiveEncryptionKeyHandlerFunc func(V2GetClusterArchiveEncryptionKeyParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2GetClusterArchiveEncryptionKeyHandlerFunc) Handle(params V2GetClusterArchiveEncryptionKeyParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2GetClusterArchiveEncryptionKeyHandler interface for that can handle valid v2 get cluster archive encryption key params
+type V2GetClusterArchiveEncryptionKeyHandler interface {
+	Handle(V2GetClusterArchiveEncryptionKeyParams, interface{}) middleware.Responder
+}
+
+// NewV2GetClusterArchiveEncryptionKey creates a new http.Handler for the v2 get cluster archive encryption key operation
+func NewV2GetClusterArchiveEncryptionKey(ctx *middleware.Context, handler V2GetClusterArchiveEncryptionKeyHandler) *V2GetClusterArchiveEncryptionKey {
+	return &V2GetClusterArchiveEncryptionKey{Context: ctx, Handler: handler}
+}
+
+/*
+	V2GetClusterArchiveEncryptionKey swagger:route GET /v2/cluster-archives/encryption-key cluster_archives v2GetClusterArchiveEncryptionKey
+
+Retrieves the public key that the secrets of the archives imported by this service are encrypted with. Set it as the recipient key when exporting a cluster from another service.
+*/
+type V2GetClusterArchiveEncryptionKey struct {
+	Context *middleware.Context
+	Handler V2GetClusterArchiveEncryptionKeyHandler
+}
+
+func (o *V2GetClusterArchiveEncryptionKey) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2GetClusterArchiveEncryptionKeyParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,239 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_archives
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetClusterArchiveEncryptionKeyOKCode is the HTTP code returned for type V2GetClusterArchiveEncryptionKeyOK
+const V2GetClusterArchiveEncryptionKeyOKCode int = 200
+
+/*
+V2GetClusterArchiveEncryptionKeyOK Success.
+
+swagger:response v2GetClusterArchiveEncryptionKeyOK
+*/
+type V2GetClusterArchiveEncryptionKeyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ClusterArchiveEncryptionKey `json:"body,omitempty"`
+}
+
+// NewV2GetClusterArchiveEncryptionKeyOK creates V2GetClusterArchiveEncryptionKeyOK with default headers values
+func NewV2GetClusterArchiveEncryptionKeyOK() *V2GetClusterArchiveEncryptionKeyOK {
+
+	return &V2GetClusterArchiveEncryptionKeyOK{}
+}
+
+// WithPayload adds the payload to the v2 get cluster archive encryption key o k response
+func (o *V2GetClusterArchiveEncryptionKeyOK) WithPayload(payload *models.ClusterArchiveEncryptionKey) *V2GetClusterArchiveEncryptionKeyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster archive encryption key o k response
+func (o *V2GetClusterArchiveEncryptionKeyOK) SetPayload(payload *models.ClusterArchiveEncryptionKey) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterArchiveEncryptionKeyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterArchiveEncryptionKeyUnauthorizedCode is the HTTP code returned for type V2GetClusterArchiveEncryptionKeyUnauthorized
+const V2GetClusterArchiveEncryptionKeyUnauthorizedCode int = 401
+
+/*
+V2GetClusterArchiveEncryptionKeyUnauthorized Unauthorized.
+
+swagger:response v2GetClusterArchiveEncryptionKeyUnauthorized
+*/
+type V2GetClusterArchiveEncryptionKeyUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetClusterArchiveEncryptionKeyUnauthorized creates V2GetClusterArchiveEncryptionKeyUnauthorized with default headers values
+func NewV2GetClusterArchiveEncryptionKeyUnauthorized() *V2GetClusterArchiveEncryptionKeyUnauthorized {
+
+	return &V2GetClusterArchiveEncryptionKeyUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 get cluster archive encryption key unauthorized response
+func (o *V2GetClusterArchiveEncryptionKeyUnauthorized) WithPayload(payload *models.InfraError) *V2GetClusterArchiveEncryptionKeyUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster archive encryption key unauthorized response
+func (o *V2GetClusterArchiveEncryptionKeyUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterArchiveEncryptionKeyUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterArchiveEncryptionKeyForbiddenCode is the HTTP code returned for type V2GetClusterArchiveEncryptionKeyForbidden
+const V2GetClusterArchiveEncryptionKeyForbiddenCode int = 403
+
+/*
+V2GetClusterArchiveEncryptionKeyForbidden Forbidden.
+
+swagger:response v2GetClusterArchiveEncryptionKeyForbidden
+*/
+type V2GetClusterArchiveEncryptionKeyForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetClusterArchiveEncryptionKeyForbidden creates V2GetClusterArchiveEncryptionKeyForbidden with default headers values
+func NewV2GetClusterArchiveEncryptionKeyForbidden() *V2GetClusterArchiveEncryptionKeyForbidden {
+
+	return &V2GetClusterArchiveEncryptionKeyForbidden{}
+}
+
+// WithPayload adds the payload to the v2 get cluster archive encryption key forbidden response
+func (o *V2GetClusterArchiveEncryptionKeyForbidden) WithPayload(payload *models.InfraError) *V2GetClusterArchiveEncryptionKeyForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster archive encryption key forbidden response
+func (o *V2GetClusterArchiveEncryptionKeyForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterArchiveEncryptionKeyForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterArchiveEncryptionKeyInternalServerErrorCode is the HTTP code returned for type V2GetClusterArchiveEncryptionKeyInternalServerError
+const V2GetClusterArchiveEncryptionKeyInternalServerErrorCode int = 500
+
+/*
+V2GetClusterArchiveEncryptionKeyInternalServerError Error.
+
+swagger:response v2GetClusterArchiveEncryptionKeyInternalServerError
+*/
+type V2GetClusterArchiveEncryptionKeyInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterArchiveEncryptionKeyInternalServerError creates V2GetClusterArchiveEncryptionKeyInternalServerError with default headers values
+func NewV2GetClusterArchiveEncryptionKeyInternalServerError() *V2GetClusterArchiveEncryptionKeyInternalServerError {
+
+	return &V2GetClusterArchiveEncryptionKeyInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 get cluster archive encryption key internal server error response
+func (o *V2GetClusterArchiveEncryptionKeyInternalServerError) WithPayload(payload *models.Error) *V2GetClusterArchiveEncryptionKeyInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster archive encryption key internal server error response
+func (o *V2GetClusterArchiveEncryptionKeyInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterArchiveEncryptionKeyInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterArchiveEncryptionKeyNotImplementedCode is the HTTP code returned for type V2GetClusterArchiveEncryptionKeyNotImplemented
+const V2GetClusterArchiveEncryptionKeyNotImplementedCode int = 501
+
+/*
+V2GetClusterArchiveEncryptionKeyNotImplemented Not implemented.
+
+swagger:response v2GetClusterArchiveEncryptionKeyNotImplemented
+*/
+type V2GetClusterArchiveEncryptionKeyNotImplemented struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterArchiveEncryptionKeyNotImplemented creates V2GetClusterArchiveEncryptionKeyNotImplemented with default headers values
+func NewV2GetClusterArchiveEncryptionKeyNotImplemented() *V2GetClusterArchiveEncryptionKeyNotImplemented {
+
+	return &V2GetClusterArchiveEncryptionKeyNotImplemented{}
+}
+
+// WithPayload adds the payload to the v2 get cluster archive encryption key not implemented response
+func (o *V2GetClusterArchiveEncryptionKeyNotImplemented) WithPayload(payload *models.Error) *V2GetClusterArchiveEncryptionKeyNotImplemented {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster archive encryption key not implemented response
+func (o *V2GetClusterArchiveEncryptionKeyNotImplemented) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterArchiveEncryptionKeyNotImplemented) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(501)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_archives
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ImportClusterArchiveHandlerFunc turns a function with the right signature into a v2 import cluster archive handler
+type V2ImportClusterArchiveHandlerFunc func(V2ImportClusterArchiveParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ImportClusterArchiveHandlerFunc) Handle(params V2ImportClusterArchiveParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ImportClusterArchiveHandler interface for that can handle valid v2 import cluster archive params
+type V2ImportClusterArchiveHandler interface {
+	Handle(V2ImportClusterArchiveParams, interface{}) middleware.Responder
+}
+
+// NewV2ImportClusterArchive creates a new http.Handler for the v2 import cluster archive operation
+func NewV2ImportClusterArchive(ctx *middleware.Context, handler V2ImportClusterArchiveHandler) *V2ImportClusterArchive {
+	return &V2ImportClusterArchive{Context: ctx, Handler: handler}
+}
+
+/*
+	V2ImportClusterArchive swagger:route POST /v2/cluster-archives/import cluster_archives v2ImportClusterArchive
+
+Registers a cluster, its infra-envs and its custom manifests from an archive exported by another service. The secrets of the archive must be encrypted with the key of this service.
+*/
+type V2ImportClusterArchive struct {
+	Context *middleware.Context
+	Handler V2ImportClusterArchiveHandler
+}
+
+func (o *V2ImportClusterArchive) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ImportClusterArchiveParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,284 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_archives
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ImportClusterArchiveCreatedCode is the HTTP code returned for type V2ImportClusterArchiveCreated
+const V2ImportClusterArchiveCreatedCode int = 201
+
+/*
+V2ImportClusterArchiveCreated Success.
+
+swagger:response v2ImportClusterArchiveCreated
+*/
+type V2ImportClusterArchiveCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ClusterImportResult `json:"body,omitempty"`
+}
+
+// NewV2ImportClusterArchiveCreated creates V2ImportClusterArchiveCreated with default headers values
+func NewV2ImportClusterArchiveCreated() *V2ImportClusterArchiveCreated {
+
+	return &V2ImportClusterArchiveCreated{}
+}
+
+// WithPayload adds the payload to the v2 import cluster archive created response
+func (o *V2ImportClusterArchiveCreated) WithPayload(payload *models.ClusterImportResult) *V2ImportClusterArchiveCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 import cluster archive created response
+func (o *V2ImportClusterArchiveCreated) SetPayload(payload *models.ClusterImportResult) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ImportClusterArchiveCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ImportClusterArchiveBadRequestCode is the HTTP code returned for type V2ImportClusterArchiveBadRequest
+const V2ImportClusterArchiveBadRequestCode int = 400
+
+/*
+V2ImportClusterArchiveBadRequest Error.
+
+swagger:response v2ImportClusterArchiveBadRequest
+*/
+type V2ImportClusterArchiveBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ImportClusterArchiveBadRequest creates V2ImportClusterArchiveBadRequest with default headers values
+func NewV2ImportClusterArchiveBadRequest() *V2ImportClusterArchiveBadRequest {
+
+	return &V2ImportClusterArchiveBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 import cluster archive bad request response
+func (o *V2ImportClusterArchiveBadRequest) WithPayload(payload *models.Error) *V2ImportClusterArchiveBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 import cluster archive bad request response
+func (o *V2ImportClusterArchiveBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ImportClusterArchiveBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ImportClusterArchiveUnauthorizedCode is the HTTP code returned for type V2ImportClusterArchiveUnauthorized
+const V2ImportClusterArchiveUnauthorizedCode int = 401
+
+/*
+V2ImportClusterArchiveUnauthorized Unauthorized.
+
+swagger:response v2ImportClusterArchiveUnauthorized
+*/
+type V2ImportClusterArchiveUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ImportClusterArchiveUnauthorized creates V2ImportClusterArchiveUnauthorized with default headers values
+func NewV2ImportClusterArchiveUnauthorized() *V2ImportClusterArchiveUnauthorized {
+
+	return &V2ImportClusterArchiveUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 import cluster archive unauthorized response
+func (o *V2ImportClusterArchiveUnauthorized) WithPayload(payload *models.InfraError) *V2ImportClusterArchiveUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 import cluster archive unauthorized response
+func (o *V2ImportClusterArchiveUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ImportClusterArchiveUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ImportClusterArchiveForbiddenCode is the HTTP code returned for type V2ImportClusterArchiveForbidden
+const V2ImportClusterArchiveForbiddenCode int = 403
+
+/*
+V2ImportClusterArchiveForbidden Forbidden.
+
+swagger:response v2ImportClusterArchiveForbidden
+*/
+type V2ImportClusterArchiveForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ImportClusterArchiveForbidden creates V2ImportClusterArchiveForbidden with default headers values
+func NewV2ImportClusterArchiveForbidden() *V2ImportClusterArchiveForbidden {
+
+	return &V2ImportClusterArchiveForbidden{}
+}
+
+// WithPayload adds the payload to the v2 import cluster archive forbidden response
+func (o *V2ImportClusterArchiveForbidden) WithPayload(payload *models.InfraError) *V2ImportClusterArchiveForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 import cluster archive forbidden response
+func (o *V2ImportClusterArchiveForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ImportClusterArchiveForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ImportClusterArchiveInternalServerErrorCode is the HTTP code returned for type V2ImportClusterArchiveInternalServerError
+const V2ImportClusterArchiveInternalServerErrorCode int = 500
+
+/*
+V2ImportClusterArchiveInternalServerError Error.
+
+swagger:response v2ImportClusterArchiveInternalServerError
+*/
+type V2ImportClusterArchiveInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ImportClusterArchiveInternalServerError creates V2ImportClusterArchiveInternalServerError with default headers values
+func NewV2ImportClusterArchiveInternalServerError() *V2ImportClusterArchiveInternalServerError {
+
+	return &V2ImportClusterArchiveInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 import cluster archive internal server error response
+func (o *V2ImportClusterArchiveInternalServerError) WithPayload(payload *models.Error) *V2ImportClusterArchiveInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 import cluster archive internal server error response
+func (o *V2ImportClusterArchiveInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ImportClusterArchiveInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ImportClusterArchiveNotImplementedCode is the HTTP code returned for type V2ImportClusterArchiveNotImplemented
+const V2ImportClusterArchiveNotImplementedCode int = 501
+
+/*
+V2ImportClusterArchiveNotImplemented Not implemented.
+
+swagger:response v2ImportClusterArchiveNotImplemented
+*/
+type V2ImportClusterArchiveNotImplemented struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ImportClusterArchiveNotImplemented creates V2ImportClusterArchiveNotImplemented with default headers values
+func NewV2ImportClusterArchiveNotImplemented() *V2ImportClusterArchiveNotImplemented {
+
+	return &V2ImportClusterArchiveNotImplemented{}
+}
+
+// WithPayload adds the payload to the v2 import cluster archive not implemented response
+func (o *V2ImportClusterArchiveNotImplemented) WithPayload(payload *models.Error) *V2ImportClusterArchiveNotImplemented {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 import cluster archive not implemented response
+func (o *V2ImportClusterArchiveNotImplemented) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ImportClusterArchiveNotImplemented) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(501)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_plan
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ApplyClusterPlanHandlerFunc turns a function with the right signature into a v2 apply cluster plan handler
+type V2ApplyClusterPlanHandlerFunc func(V2ApplyClusterPlanParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ApplyClusterPlanHandlerFunc) Handle(params V2ApplyClusterPlanParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ApplyClusterPlanHandler interface for that can handle valid v2 apply cluster plan params
+type V2ApplyClusterPlanHandler interface {
+	Handle(V2ApplyClusterPlanParams, interface{}) middleware.Responder
+}
+
+// NewV2ApplyClusterPlan creates a new http.Handler for the v2 apply cluster plan operation
+func NewV2ApplyClusterPlan(ctx *middleware.Context, handler V2ApplyClusterPlanHandler) *V2ApplyClusterPlan {
+	return &V2ApplyClusterPlan{Context: ctx, Handler: handler}
+}
+
+/*
+	V2ApplyClusterPlan swagger:route POST /v2/clusters/{cluster_id}/plan cluster_plan v2ApplyClusterPlan
+
+Computes the changes needed to bring the cluster, its infra-env, hosts, custom manifests and operators
+to the state described by the plan, and applies them unless dry_run is set. Applying a plan that was
+already applied makes no changes.
+*/
+type V2ApplyClusterPlan struct {
+	Context *middleware.Context
+	Handler V2ApplyClusterPlanHandler
+}
+
+func (o *V2ApplyClusterPlan) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ApplyClusterPlanParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,374 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_plan
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ApplyClusterPlanOKCode is the HTTP code returned for type V2ApplyClusterPlanOK
+const V2ApplyClusterPlanOKCode int = 200
+
+/*
+V2ApplyClusterPlanOK Success.
+
+swagger:response v2ApplyClusterPlanOK
+*/
+type V2ApplyClusterPlanOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ClusterPlanResult `json:"body,omitempty"`
+}
+
+// NewV2ApplyClusterPlanOK creates V2ApplyClusterPlanOK with default headers values
+func NewV2ApplyClusterPlanOK() *V2ApplyClusterPlanOK {
+
+	return &V2ApplyClusterPlanOK{}
+}
+
+// WithPayload adds the payload to the v2 apply cluster plan o k response
+func (o *V2ApplyClusterPlanOK) WithPayload(payload *models.ClusterPlanResult) *V2ApplyClusterPlanOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 apply cluster plan o k response
+func (o *V2ApplyClusterPlanOK) SetPayload(payload *models.ClusterPlanResult) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ApplyClusterPlanOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ApplyClusterPlanBadRequestCode is the HTTP code returned for type V2ApplyClusterPlanBadRequest
+const V2ApplyClusterPlanBadRequestCode int = 400
+
+/*
+V2ApplyClusterPlanBadRequest Error.
+
+swagger:response v2ApplyClusterPlanBadRequest
+*/
+type V2ApplyClusterPlanBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ApplyClusterPlanBadRequest creates V2ApplyClusterPlanBadRequest with default headers values
+func NewV2ApplyClusterPlanBadRequest() *V2ApplyClusterPlanBadRequest {
+
+	return &V2ApplyClusterPlanBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 apply cluster plan bad request response
+func (o *V2ApplyClusterPlanBadRequest) WithPayload(payload *models.Error) *V2ApplyClusterPlanBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 apply cluster plan bad request response
+func (o *V2ApplyClusterPlanBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ApplyClusterPlanBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ApplyClusterPlanUnauthorizedCode is the HTTP code returned for type V2ApplyClusterPlanUnauthorized
+const V2ApplyClusterPlanUnauthorizedCode int = 401
+
+/*
+V2ApplyClusterPlanUnauthorized Unauthorized.
+
+swagger:response v2ApplyClusterPlanUnauthorized
+*/
+type V2ApplyClusterPlanUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ApplyClusterPlanUnauthorized creates V2ApplyClusterPlanUnauthorized with default headers values
+func NewV2ApplyClusterPlanUnauthorized() *V2ApplyClusterPlanUnauthorized {
+
+	return &V2ApplyClusterPlanUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 apply cluster plan unauthorized response
+func (o *V2ApplyClusterPlanUnauthorized) WithPayload(payload *models.InfraError) *V2ApplyClusterPlanUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 apply cluster plan unauthorized response
+func (o *V2ApplyClusterPlanUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ApplyClusterPlanUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ApplyClusterPlanForbiddenCode is the HTTP code returned for type V2ApplyClusterPlanForbidden
+const V2ApplyClusterPlanForbiddenCode int = 403
+
+/*
+V2ApplyClusterPlanForbidden Forbidden.
+
+swagger:response v2ApplyClusterPlanForbidden
+*/
+type V2ApplyClusterPlanForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ApplyClusterPlanForbidden creates V2ApplyClusterPlanForbidden with default headers values
+func NewV2ApplyClusterPlanForbidden() *V2ApplyClusterPlanForbidden {
+
+	return &V2ApplyClusterPlanForbidden{}
+}
+
+// WithPayload adds the payload to the v2 apply cluster plan forbidden response
+func (o *V2ApplyClusterPlanForbidden) WithPayload(payload *models.InfraError) *V2ApplyClusterPlanForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 apply cluster plan forbidden response
+func (o *V2ApplyClusterPlanForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ApplyClusterPlanForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ApplyClusterPlanNotFoundCode is the HTTP code returned for type V2ApplyClusterPlanNotFound
+const V2ApplyClusterPlanNotFoundCode int = 404
+
+/*
+V2ApplyClusterPlanNotFound Error.
+
+swagger:response v2ApplyClusterPlanNotFound
+*/
+type V2ApplyClusterPlanNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ApplyClusterPlanNotFound creates V2ApplyClusterPlanNotFound with default headers values
+func NewV2ApplyClusterPlanNotFound() *V2ApplyClusterPlanNotFound {
+
+	return &V2ApplyClusterPlanNotFound{}
+}
+
+// WithPayload adds the payload to the v2 apply cluster plan not found response
+func (o *V2ApplyClusterPlanNotFound) WithPayload(payload *models.Error) *V2ApplyClusterPlanNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 apply cluster plan not found response
+func (o *V2ApplyClusterPlanNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ApplyClusterPlanNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ApplyClusterPlanMethodNotAllowedCode is the HTTP code returned for type V2ApplyClusterPlanMethodNotAllowed
+const V2ApplyClusterPlanMethodNotAllowedCode int = 405
+
+/*
+V2ApplyClusterPlanMethodNotAllowed Method Not Allowed.
+
+swagger:response v2ApplyClusterPlanMethodNotAllowed
+*/
+type V2ApplyClusterPlanMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ApplyClusterPlanMethodNotAllowed creates V2ApplyClusterPlanMethodNotAllowed with default headers values
+func NewV2ApplyClusterPlanMethodNotAllowed() *V2ApplyClusterPlanMethodNotAllowed {
+
+	return &V2ApplyClusterPlanMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 apply cluster plan method not allowed response
+func (o *V2ApplyClusterPlanMethodNotAllowed) WithPayload(payload *models.Error) *V2ApplyClusterPlanMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 apply cluster plan method not allowed response
+func (o *V2ApplyClusterPlanMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ApplyClusterPlanMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ApplyClusterPlanConflictCode is the HTTP code returned for type V2ApplyClusterPlanConflict
+const V2ApplyClusterPlanConflictCode int = 409
+
+/*
+V2ApplyClusterPlanConflict Error.
+
+swagger:response v2ApplyClusterPlanConflict
+*/
+type V2ApplyClusterPlanConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ApplyClusterPlanConflict creates V2ApplyClusterPlanConflict with default headers values
+func NewV2ApplyClusterPlanConflict() *V2ApplyClusterPlanConflict {
+
+	return &V2ApplyClusterPlanConflict{}
+}
+
+// WithPayload adds the payload to the v2 apply cluster plan conflict response
+func (o *V2ApplyClusterPlanConflict) WithPayload(payload *models.Error) *V2ApplyClusterPlanConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 apply cluster plan conflict response
+func (o *V2ApplyClusterPlanConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ApplyClusterPlanConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ApplyClusterPlanInternalServerErrorCode is the HTTP code returned for type V2ApplyClusterPlanInternalServerError
+const V2ApplyClusterPlanInternalServerErrorCode int = 500
+
+/*
+V2ApplyClusterPlanInternalServerError Error.
+
+swagger:response v2ApplyClusterPlanInternalServerError
+*/
+type V2ApplyClusterPlanInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ApplyClusterPlanInternalServerError creates V2ApplyClusterPlanInternalServerError with default headers values
+func NewV2ApplyClusterPlanInternalServerError() *V2ApplyClusterPlanInternalServerError {
+
+	return &V2ApplyClusterPlanInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 apply cluster plan internal server error response
+func (o *V2ApplyClusterPlanInternalServerError) WithPayload(payload *models.Error) *V2ApplyClusterPlanInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 apply cluster plan internal server error response
+func (o *V2ApplyClusterPlanInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ApplyClusterPlanInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
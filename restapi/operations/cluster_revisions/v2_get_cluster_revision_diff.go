@@ -0,0 +1,70 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_revisions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2GetClusterRevisionDiffHandlerFunc turns a function with the right signature into a v2 get cluster revision diff handler
+type V2GetClusterRevisionDiffHandlerFunc func(V2GetClusterRevisionDiffParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2GetClusterRevisionDiffHandlerFunc) Handle(params V2GetClusterRevisionDiffParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2GetClusterRevisionDiffHandler interface for that can handle valid v2 get cluster revision diff params
+type V2GetClusterRevisionDiffHandler interface {
+	Handle(V2GetClusterRevisionDiffParams, interface{}) middleware.Responder
+}
+
+// NewV2GetClusterRevisionDiff creates a new http.Handler for the v2 get cluster revision diff operation
+func NewV2GetClusterRevisionDiff(ctx *middleware.Context, handler V2GetClusterRevisionDiffHandler) *V2GetClusterRevisionDiff {
+	return &V2GetClusterRevisionDiff{Context: ctx, Handler: handler}
+}
+
+/*
+	V2GetClusterRevisionDiff swagger:route GET /v2/clusters/{cluster_id}/revisions/{revision}/diff cluster_revisions v2GetClusterRevisionDiff
+
+Returns the differences between a revision of the configuration of the cluster and its current
+configuration, or another revision if base_revision is set.
+*/
+type V2GetClusterRevisionDiff struct {
+	Context *middleware.Context
+	Handler V2GetClusterRevisionDiffHandler
+}
+
+func (o *V2GetClusterRevisionDiff) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2GetClusterRevisionDiffParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
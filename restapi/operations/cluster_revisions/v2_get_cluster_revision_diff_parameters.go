@@ -0,0 +1,156 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_revisions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewV2GetClusterRevisionDiffParams creates a new V2GetClusterRevisionDiffParams object
+//
+// There are no default values defined in the spec.
+func NewV2GetClusterRevisionDiffParams() V2GetClusterRevisionDiffParams {
+
+	return V2GetClusterRevisionDiffParams{}
+}
+
+// V2GetClusterRevisionDiffParams contains all the bound params for the v2 get cluster revision diff operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2GetClusterRevisionDiff
+type V2GetClusterRevisionDiffParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The revision to compare with, instead of the current configuration.
+	  In: query
+	*/
+	BaseRevision *int64
+	/*The cluster whose revisions are used.
+	  Required: true
+	  In: path
+	*/
+	ClusterID strfmt.UUID
+	/*The revision of the configuration of the cluster.
+	  Required: true
+	  In: path
+	*/
+	Revision int64
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2GetClusterRevisionDiffParams() beforehand.
+func (o *V2GetClusterRevisionDiffParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qBaseRevision, qhkBaseRevision, _ := qs.GetOK("base_revision")
+	if err := o.bindBaseRevision(qBaseRevision, qhkBaseRevision, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rClusterID, rhkClusterID, _ := route.Params.GetOK("cluster_id")
+	if err := o.bindClusterID(rClusterID, rhkClusterID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rRevision, rhkRevision, _ := route.Params.GetOK("revision")
+	if err := o.bindRevision(rRevision, rhkRevision, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindBaseRevision binds and validates parameter BaseRevision from query.
+func (o *V2GetClusterRevisionDiffParams) bindBaseRevision(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("base_revision", "query", "int64", raw)
+	}
+	o.BaseRevision = &value
+
+	return nil
+}
+
+// bindClusterID binds and validates parameter ClusterID from path.
+func (o *V2GetClusterRevisionDiffParams) bindClusterID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("cluster_id", "path", "strfmt.UUID", raw)
+	}
+	o.ClusterID = *(value.(*strfmt.UUID))
+
+	if err := o.validateClusterID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateClusterID carries on validations for parameter ClusterID
+func (o *V2GetClusterRevisionDiffParams) validateClusterID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("cluster_id", "path", "uuid", o.ClusterID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindRevision binds and validates parameter Revision from path.
+func (o *V2GetClusterRevisionDiffParams) bindRevision(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("revision", "path", "int64", raw)
+	}
+	o.Revision = value
+
+	return nil
+}
@@ -0,0 +1,329 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_revisions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetClusterRevisionDiffOKCode is the HTTP code returned for type V2GetClusterRevisionDiffOK
+const V2GetClusterRevisionDiffOKCode int = 200
+
+/*
+V2GetClusterRevisionDiffOK Success.
+
+swagger:response v2GetClusterRevisionDiffOK
+*/
+type V2GetClusterRevisionDiffOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ClusterRevisionDiff `json:"body,omitempty"`
+}
+
+// NewV2GetClusterRevisionDiffOK creates V2GetClusterRevisionDiffOK with default headers values
+func NewV2GetClusterRevisionDiffOK() *V2GetClusterRevisionDiffOK {
+
+	return &V2GetClusterRevisionDiffOK{}
+}
+
+// WithPayload adds the payload to the v2 get cluster revision diff o k response
+func (o *V2GetClusterRevisionDiffOK) WithPayload(payload *models.ClusterRevisionDiff) *V2GetClusterRevisionDiffOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster revision diff o k response
+func (o *V2GetClusterRevisionDiffOK) SetPayload(payload *models.ClusterRevisionDiff) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterRevisionDiffOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterRevisionDiffBadRequestCode is the HTTP code returned for type V2GetClusterRevisionDiffBadRequest
+const V2GetClusterRevisionDiffBadRequestCode int = 400
+
+/*
+V2GetClusterRevisionDiffBadRequest Error.
+
+swagger:response v2GetClusterRevisionDiffBadRequest
+*/
+type V2GetClusterRevisionDiffBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterRevisionDiffBadRequest creates V2GetClusterRevisionDiffBadRequest with default headers values
+func NewV2GetClusterRevisionDiffBadRequest() *V2GetClusterRevisionDiffBadRequest {
+
+	return &V2GetClusterRevisionDiffBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 get cluster revision diff bad request response
+func (o *V2GetClusterRevisionDiffBadRequest) WithPayload(payload *models.Error) *V2GetClusterRevisionDiffBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster revision diff bad request response
+func (o *V2GetClusterRevisionDiffBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterRevisionDiffBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterRevisionDiffUnauthorizedCode is the HTTP code returned for type V2GetClusterRevisionDiffUnauthorized
+const V2GetClusterRevisionDiffUnauthorizedCode int = 401
+
+/*
+V2GetClusterRevisionDiffUnauthorized Unauthorized.
+
+swagger:response v2GetClusterRevisionDiffUnauthorized
+*/
+type V2GetClusterRevisionDiffUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetClusterRevisionDiffUnauthorized creates V2GetClusterRevisionDiffUnauthorized with default headers values
+func NewV2GetClusterRevisionDiffUnauthorized() *V2GetClusterRevisionDiffUnauthorized {
+
+	return &V2GetClusterRevisionDiffUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 get cluster revision diff unauthorized response
+func (o *V2GetClusterRevisionDiffUnauthorized) WithPayload(payload *models.InfraError) *V2GetClusterRevisionDiffUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster revision diff unauthorized response
+func (o *V2GetClusterRevisionDiffUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterRevisionDiffUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterRevisionDiffForbiddenCode is the HTTP code returned for type V2GetClusterRevisionDiffForbidden
+const V2GetClusterRevisionDiffForbiddenCode int = 403
+
+/*
+V2GetClusterRevisionDiffForbidden Forbidden.
+
+swagger:response v2GetClusterRevisionDiffForbidden
+*/
+type V2GetClusterRevisionDiffForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetClusterRevisionDiffForbidden creates V2GetClusterRevisionDiffForbidden with default headers values
+func NewV2GetClusterRevisionDiffForbidden() *V2GetClusterRevisionDiffForbidden {
+
+	return &V2GetClusterRevisionDiffForbidden{}
+}
+
+// WithPayload adds the payload to the v2 get cluster revision diff forbidden response
+func (o *V2GetClusterRevisionDiffForbidden) WithPayload(payload *models.InfraError) *V2GetClusterRevisionDiffForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster revision diff forbidden response
+func (o *V2GetClusterRevisionDiffForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterRevisionDiffForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterRevisionDiffNotFoundCode is the HTTP code returned for type V2GetClusterRevisionDiffNotFound
+const V2GetClusterRevisionDiffNotFoundCode int = 404
+
+/*
+V2GetClusterRevisionDiffNotFound Error.
+
+swagger:response v2GetClusterRevisionDiffNotFound
+*/
+type V2GetClusterRevisionDiffNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterRevisionDiffNotFound creates V2GetClusterRevisionDiffNotFound with default headers values
+func NewV2GetClusterRevisionDiffNotFound() *V2GetClusterRevisionDiffNotFound {
+
+	return &V2GetClusterRevisionDiffNotFound{}
+}
+
+// WithPayload adds the payload to the v2 get cluster revision diff not found response
+func (o *V2GetClusterRevisionDiffNotFound) WithPayload(payload *models.Error) *V2GetClusterRevisionDiffNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster revision diff not found response
+func (o *V2GetClusterRevisionDiffNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterRevisionDiffNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterRevisionDiffMethodNotAllowedCode is the HTTP code returned for type V2GetClusterRevisionDiffMethodNotAllowed
+const V2GetClusterRevisionDiffMethodNotAllowedCode int = 405
+
+/*
+V2GetClusterRevisionDiffMethodNotAllowed Method Not Allowed.
+
+swagger:response v2GetClusterRevisionDiffMethodNotAllowed
+*/
+type V2GetClusterRevisionDiffMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterRevisionDiffMethodNotAllowed creates V2GetClusterRevisionDiffMethodNotAllowed with default headers values
+func NewV2GetClusterRevisionDiffMethodNotAllowed() *V2GetClusterRevisionDiffMethodNotAllowed {
+
+	return &V2GetClusterRevisionDiffMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 get cluster revision diff method not allowed response
+func (o *V2GetClusterRevisionDiffMethodNotAllowed) WithPayload(payload *models.Error) *V2GetClusterRevisionDiffMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster revision diff method not allowed response
+func (o *V2GetClusterRevisionDiffMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterRevisionDiffMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterRevisionDiffInternalServerErrorCode is the HTTP code returned for type V2GetClusterRevisionDiffInternalServerError
+const V2GetClusterRevisionDiffInternalServerErrorCode int = 500
+
+/*
+V2GetClusterRevisionDiffInternalServerError Error.
+
+swagger:response v2GetClusterRevisionDiffInternalServerError
+*/
+type V2GetClusterRevisionDiffInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterRevisionDiffInternalServerError creates V2GetClusterRevisionDiffInternalServerError with default headers values
+func NewV2GetClusterRevisionDiffInternalServerError() *V2GetClusterRevisionDiffInternalServerError {
+
+	return &V2GetClusterRevisionDiffInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 get cluster revision diff internal server error response
+func (o *V2GetClusterRevisionDiffInternalServerError) WithPayload(payload *models.Error) *V2GetClusterRevisionDiffInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster revision diff internal server error response
+func (o *V2GetClusterRevisionDiffInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterRevisionDiffInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
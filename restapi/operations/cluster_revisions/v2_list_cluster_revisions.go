@@ -0,0 +1,70 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_revisions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListClusterRevisionsHandlerFunc turns a function with the right signature into a v2 list cluster revisions handler
+type V2ListClusterRevisionsHandlerFunc func(V2ListClusterRevisionsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListClusterRevisionsHandlerFunc) Handle(params V2ListClusterRevisionsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListClusterRevisionsHandler interface for that can handle valid v2 list cluster revisions params
+type V2ListClusterRevisionsHandler interface {
+	Handle(V2ListClusterRevisionsParams, interface{}) middleware.Responder
+}
+
+// NewV2ListClusterRevisions creates a new http.Handler for the v2 list cluster revisions operation
+func NewV2ListClusterRevisions(ctx *middleware.Context, handler V2ListClusterRevisionsHandler) *V2ListClusterRevisions {
+	return &V2ListClusterRevisions{Context: ctx, Handler: handler}
+}
+
+/*
+	V2ListClusterRevisions swagger:route GET /v2/clusters/{cluster_id}/revisions cluster_revisions v2ListClusterRevisions
+
+Lists the revisions of the configuration of the cluster, newest first. A revision is recorded whenever
+an update changes the configuration of the cluster.
+*/
+type V2ListClusterRevisions struct {
+	Context *middleware.Context
+	Handler V2ListClusterRevisionsHandler
+}
+
+func (o *V2ListClusterRevisions) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListClusterRevisionsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
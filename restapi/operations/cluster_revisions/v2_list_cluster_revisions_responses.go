@@ -0,0 +1,332 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_revisions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListClusterRevisionsOKCode is the HTTP code returned for type V2ListClusterRevisionsOK
+const V2ListClusterRevisionsOKCode int = 200
+
+/*
+V2ListClusterRevisionsOK Success.
+
+swagger:response v2ListClusterRevisionsOK
+*/
+type V2ListClusterRevisionsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.ClusterRevisionList `json:"body,omitempty"`
+}
+
+// NewV2ListClusterRevisionsOK creates V2ListClusterRevisionsOK with default headers values
+func NewV2ListClusterRevisionsOK() *V2ListClusterRevisionsOK {
+
+	return &V2ListClusterRevisionsOK{}
+}
+
+// WithPayload adds the payload to the v2 list cluster revisions o k response
+func (o *V2ListClusterRevisionsOK) WithPayload(payload models.ClusterRevisionList) *V2ListClusterRevisionsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster revisions o k response
+func (o *V2ListClusterRevisionsOK) SetPayload(payload models.ClusterRevisionList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterRevisionsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.ClusterRevisionList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListClusterRevisionsBadRequestCode is the HTTP code returned for type V2ListClusterRevisionsBadRequest
+const V2ListClusterRevisionsBadRequestCode int = 400
+
+/*
+V2ListClusterRevisionsBadRequest Error.
+
+swagger:response v2ListClusterRevisionsBadRequest
+*/
+type V2ListClusterRevisionsBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListClusterRevisionsBadRequest creates V2ListClusterRevisionsBadRequest with default headers values
+func NewV2ListClusterRevisionsBadRequest() *V2ListClusterRevisionsBadRequest {
+
+	return &V2ListClusterRevisionsBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 list cluster revisions bad request response
+func (o *V2ListClusterRevisionsBadRequest) WithPayload(payload *models.Error) *V2ListClusterRevisionsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster revisions bad request response
+func (o *V2ListClusterRevisionsBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterRevisionsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListClusterRevisionsUnauthorizedCode is the HTTP code returned for type V2ListClusterRevisionsUnauthorized
+const V2ListClusterRevisionsUnauthorizedCode int = 401
+
+/*
+V2ListClusterRevisionsUnauthorized Unauthorized.
+
+swagger:response v2ListClusterRevisionsUnauthorized
+*/
+type V2ListClusterRevisionsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListClusterRevisionsUnauthorized creates V2ListClusterRevisionsUnauthorized with default headers values
+func NewV2ListClusterRevisionsUnauthorized() *V2ListClusterRevisionsUnauthorized {
+
+	return &V2ListClusterRevisionsUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list cluster revisions unauthorized response
+func (o *V2ListClusterRevisionsUnauthorized) WithPayload(payload *models.InfraError) *V2ListClusterRevisionsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster revisions unauthorized response
+func (o *V2ListClusterRevisionsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterRevisionsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListClusterRevisionsForbiddenCode is the HTTP code returned for type V2ListClusterRevisionsForbidden
+const V2ListClusterRevisionsForbiddenCode int = 403
+
+/*
+V2ListClusterRevisionsForbidden Forbidden.
+
+swagger:response v2ListClusterRevisionsForbidden
+*/
+type V2ListClusterRevisionsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListClusterRevisionsForbidden creates V2ListClusterRevisionsForbidden with default headers values
+func NewV2ListClusterRevisionsForbidden() *V2ListClusterRevisionsForbidden {
+
+	return &V2ListClusterRevisionsForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list cluster revisions forbidden response
+func (o *V2ListClusterRevisionsForbidden) WithPayload(payload *models.InfraError) *V2ListClusterRevisionsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster revisions forbidden response
+func (o *V2ListClusterRevisionsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterRevisionsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListClusterRevisionsNotFoundCode is the HTTP code returned for type V2ListClusterRevisionsNotFound
+const V2ListClusterRevisionsNotFoundCode int = 404
+
+/*
+V2ListClusterRevisionsNotFound Error.
+
+swagger:response v2ListClusterRevisionsNotFound
+*/
+type V2ListClusterRevisionsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListClusterRevisionsNotFound creates V2ListClusterRevisionsNotFound with default headers values
+func NewV2ListClusterRevisionsNotFound() *V2ListClusterRevisionsNotFound {
+
+	return &V2ListClusterRevisionsNotFound{}
+}
+
+// WithPayload adds the payload to the v2 list cluster revisions not found response
+func (o *V2ListClusterRevisionsNotFound) WithPayload(payload *models.Error) *V2ListClusterRevisionsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster revisions not found response
+func (o *V2ListClusterRevisionsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterRevisionsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListClusterRevisionsMethodNotAllowedCode is the HTTP code returned for type V2ListClusterRevisionsMethodNotAllowed
+const V2ListClusterRevisionsMethodNotAllowedCode int = 405
+
+/*
+V2ListClusterRevisionsMethodNotAllowed Method Not Allowed.
+
+swagger:response v2ListClusterRevisionsMethodNotAllowed
+*/
+type V2ListClusterRevisionsMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListClusterRevisionsMethodNotAllowed creates V2ListClusterRevisionsMethodNotAllowed with default headers values
+func NewV2ListClusterRevisionsMethodNotAllowed() *V2ListClusterRevisionsMethodNotAllowed {
+
+	return &V2ListClusterRevisionsMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 list cluster revisions method not allowed response
+func (o *V2ListClusterRevisionsMethodNotAllowed) WithPayload(payload *models.Error) *V2ListClusterRevisionsMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster revisions method not allowed response
+func (o *V2ListClusterRevisionsMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterRevisionsMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListClusterRevisionsInternalServerErrorCode is the HTTP code returned for type V2ListClusterRevisionsInternalServerError
+const V2ListClusterRevisionsInternalServerErrorCode int = 500
+
+/*
+V2ListClusterRevisionsInternalServerError Error.
+
+swagger:response v2ListClusterRevisionsInternalServerError
+*/
+type V2ListClusterRevisionsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListClusterRevisionsInternalServerError creates V2ListClusterRevisionsInternalServerError with default headers values
+func NewV2ListClusterRevisionsInternalServerError() *V2ListClusterRevisionsInternalServerError {
+
+	return &V2ListClusterRevisionsInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list cluster revisions internal server error response
+func (o *V2ListClusterRevisionsInternalServerError) WithPayload(payload *models.Error) *V2ListClusterRevisionsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster revisions internal server error response
+func (o *V2ListClusterRevisionsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterRevisionsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_revisions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2RollbackClusterRevisionHandlerFunc turns a function with the right signature into a v2 rollback cluster revision handler
+type V2RollbackClusterRevisionHandlerFunc func(V2RollbackClusterRevisionParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2RollbackClusterRevisionHandlerFunc) Handle(params V2RollbackClusterRevisionParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2RollbackClusterRevisionHandler interface for that can handle valid v2 rollback cluster revision params
+type V2RollbackClusterRevisionHandler interface {
+	Handle(V2RollbackClusterRevisionParams, interface{}) middleware.Responder
+}
+
+// NewV2RollbackClusterRevision creates a new http.Handler for the v2 rollback cluster revision operation
+func NewV2RollbackClusterRevision(ctx *middleware.Context, handler V2RollbackClusterRevisionHandler) *V2RollbackClusterRevision {
+	return &V2RollbackClusterRevision{Context: ctx, Handler: handler}
+}
+
+/*
+	V2RollbackClusterRevision swagger:route POST /v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback cluster_revisions v2RollbackClusterRevision
+
+Re-applies a revision of the configuration of the cluster. The changes are applied through the same
+updates and validations as the equivalent REST calls. Manifests are not rolled back.
+*/
+type V2RollbackClusterRevision struct {
+	Context *middleware.Context
+	Handler V2RollbackClusterRevisionHandler
+}
+
+func (o *V2RollbackClusterRevision) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2RollbackClusterRevisionParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,374 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_revisions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2RollbackClusterRevisionOKCode is the HTTP code returned for type V2RollbackClusterRevisionOK
+const V2RollbackClusterRevisionOKCode int = 200
+
+/*
+V2RollbackClusterRevisionOK Success.
+
+swagger:response v2RollbackClusterRevisionOK
+*/
+type V2RollbackClusterRevisionOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Cluster `json:"body,omitempty"`
+}
+
+// NewV2RollbackClusterRevisionOK creates V2RollbackClusterRevisionOK with default headers values
+func NewV2RollbackClusterRevisionOK() *V2RollbackClusterRevisionOK {
+
+	return &V2RollbackClusterRevisionOK{}
+}
+
+// WithPayload adds the payload to the v2 rollback cluster revision o k response
+func (o *V2RollbackClusterRevisionOK) WithPayload(payload *models.Cluster) *V2RollbackClusterRevisionOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 rollback cluster revision o k response
+func (o *V2RollbackClusterRevisionOK) SetPayload(payload *models.Cluster) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RollbackClusterRevisionOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RollbackClusterRevisionBadRequestCode is the HTTP code returned for type V2RollbackClusterRevisionBadRequest
+const V2RollbackClusterRevisionBadRequestCode int = 400
+
+/*
+V2RollbackClusterRevisionBadRequest Error.
+
+swagger:response v2RollbackClusterRevisionBadRequest
+*/
+type V2RollbackClusterRevisionBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RollbackClusterRevisionBadRequest creates V2RollbackClusterRevisionBadRequest with default headers values
+func NewV2RollbackClusterRevisionBadRequest() *V2RollbackClusterRevisionBadRequest {
+
+	return &V2RollbackClusterRevisionBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 rollback cluster revision bad request response
+func (o *V2RollbackClusterRevisionBadRequest) WithPayload(payload *models.Error) *V2RollbackClusterRevisionBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 rollback cluster revision bad request response
+func (o *V2RollbackClusterRevisionBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RollbackClusterRevisionBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RollbackClusterRevisionUnauthorizedCode is the HTTP code returned for type V2RollbackClusterRevisionUnauthorized
+const V2RollbackClusterRevisionUnauthorizedCode int = 401
+
+/*
+V2RollbackClusterRevisionUnauthorized Unauthorized.
+
+swagger:response v2RollbackClusterRevisionUnauthorized
+*/
+type V2RollbackClusterRevisionUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2RollbackClusterRevisionUnauthorized creates V2RollbackClusterRevisionUnauthorized with default headers values
+func NewV2RollbackClusterRevisionUnauthorized() *V2RollbackClusterRevisionUnauthorized {
+
+	return &V2RollbackClusterRevisionUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 rollback cluster revision unauthorized response
+func (o *V2RollbackClusterRevisionUnauthorized) WithPayload(payload *models.InfraError) *V2RollbackClusterRevisionUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 rollback cluster revision unauthorized response
+func (o *V2RollbackClusterRevisionUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RollbackClusterRevisionUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RollbackClusterRevisionForbiddenCode is the HTTP code returned for type V2RollbackClusterRevisionForbidden
+const V2RollbackClusterRevisionForbiddenCode int = 403
+
+/*
+V2RollbackClusterRevisionForbidden Forbidden.
+
+swagger:response v2RollbackClusterRevisionForbidden
+*/
+type V2RollbackClusterRevisionForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2RollbackClusterRevisionForbidden creates V2RollbackClusterRevisionForbidden with default headers values
+func NewV2RollbackClusterRevisionForbidden() *V2RollbackClusterRevisionForbidden {
+
+	return &V2RollbackClusterRevisionForbidden{}
+}
+
+// WithPayload adds the payload to the v2 rollback cluster revision forbidden response
+func (o *V2RollbackClusterRevisionForbidden) WithPayload(payload *models.InfraError) *V2RollbackClusterRevisionForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 rollback cluster revision forbidden response
+func (o *V2RollbackClusterRevisionForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RollbackClusterRevisionForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RollbackClusterRevisionNotFoundCode is the HTTP code returned for type V2RollbackClusterRevisionNotFound
+const V2RollbackClusterRevisionNotFoundCode int = 404
+
+/*
+V2RollbackClusterRevisionNotFound Error.
+
+swagger:response v2RollbackClusterRevisionNotFound
+*/
+type V2RollbackClusterRevisionNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RollbackClusterRevisionNotFound creates V2RollbackClusterRevisionNotFound with default headers values
+func NewV2RollbackClusterRevisionNotFound() *V2RollbackClusterRevisionNotFound {
+
+	return &V2RollbackClusterRevisionNotFound{}
+}
+
+// WithPayload adds the payload to the v2 rollback cluster revision not found response
+func (o *V2RollbackClusterRevisionNotFound) WithPayload(payload *models.Error) *V2RollbackClusterRevisionNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 rollback cluster revision not found response
+func (o *V2RollbackClusterRevisionNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RollbackClusterRevisionNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RollbackClusterRevisionMethodNotAllowedCode is the HTTP code returned for type V2RollbackClusterRevisionMethodNotAllowed
+const V2RollbackClusterRevisionMethodNotAllowedCode int = 405
+
+/*
+V2RollbackClusterRevisionMethodNotAllowed Method Not Allowed.
+
+swagger:response v2RollbackClusterRevisionMethodNotAllowed
+*/
+type V2RollbackClusterRevisionMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RollbackClusterRevisionMethodNotAllowed creates V2RollbackClusterRevisionMethodNotAllowed with default headers values
+func NewV2RollbackClusterRevisionMethodNotAllowed() *V2RollbackClusterRevisionMethodNotAllowed {
+
+	return &V2RollbackClusterRevisionMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 rollback cluster revision method not allowed response
+func (o *V2RollbackClusterRevisionMethodNotAllowed) WithPayload(payload *models.Error) *V2RollbackClusterRevisionMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 rollback cluster revision method not allowed response
+func (o *V2RollbackClusterRevisionMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RollbackClusterRevisionMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RollbackClusterRevisionConflictCode is the HTTP code returned for type V2RollbackClusterRevisionConflict
+const V2RollbackClusterRevisionConflictCode int = 409
+
+/*
+V2RollbackClusterRevisionConflict Error.
+
+swagger:response v2RollbackClusterRevisionConflict
+*/
+type V2RollbackClusterRevisionConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RollbackClusterRevisionConflict creates V2RollbackClusterRevisionConflict with default headers values
+func NewV2RollbackClusterRevisionConflict() *V2RollbackClusterRevisionConflict {
+
+	return &V2RollbackClusterRevisionConflict{}
+}
+
+// WithPayload adds the payload to the v2 rollback cluster revision conflict response
+func (o *V2RollbackClusterRevisionConflict) WithPayload(payload *models.Error) *V2RollbackClusterRevisionConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 rollback cluster revision conflict response
+func (o *V2RollbackClusterRevisionConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RollbackClusterRevisionConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RollbackClusterRevisionInternalServerErrorCode is the HTTP code returned for type V2RollbackClusterRevisionInternalServerError
+const V2RollbackClusterRevisionInternalServerErrorCode int = 500
+
+/*
+V2RollbackClusterRevisionInternalServerError Error.
+
+swagger:response v2RollbackClusterRevisionInternalServerError
+*/
+type V2RollbackClusterRevisionInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RollbackClusterRevisionInternalServerError creates V2RollbackClusterRevisionInternalServerError with default headers values
+func NewV2RollbackClusterRevisionInternalServerError() *V2RollbackClusterRevisionInternalServerError {
+
+	return &V2RollbackClusterRevisionInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 rollback cluster revision internal server error response
+func (o *V2RollbackClusterRevisionInternalServerError) WithPayload(payload *models.Error) *V2RollbackClusterRevisionInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 rollback cluster revision internal server error response
+func (o *V2RollbackClusterRevisionInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RollbackClusterRevisionInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
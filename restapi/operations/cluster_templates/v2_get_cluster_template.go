@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2GetClusterTemplateHandlerFunc turns a function with the right signature into a v2 get cluster template handler
+type V2GetClusterTemplateHandlerFunc func(V2GetClusterTemplateParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2GetClusterTemplateHandlerFunc) Handle(params V2GetClusterTemplateParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2GetClusterTemplateHandler interface for that can handle valid v2 get cluster template params
+type V2GetClusterTemplateHandler interface {
+	Handle(V2GetClusterTemplateParams, interface{}) middleware.Responder
+}
+
+// NewV2GetClusterTemplate creates a new http.Handler for the v2 get cluster template operation
+func NewV2GetClusterTemplate(ctx *middleware.Context, handler V2GetClusterTemplateHandler) *V2GetClusterTemplate {
+	return &V2GetClusterTemplate{Context: ctx, Handler: handler}
+}
+
+/*
+	V2GetClusterTemplate swagger:route GET /v2/cluster-templates/{cluster_template_id} cluster_templates v2GetClusterTemplate
+
+Retrieves the details of the cluster template.
+*/
+type V2GetClusterTemplate struct {
+	Context *middleware.Context
+	Handler V2GetClusterTemplateHandler
+}
+
+func (o *V2GetClusterTemplate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2GetClusterTemplateParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
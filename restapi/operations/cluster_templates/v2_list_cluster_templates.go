@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListClusterTemplatesHandlerFunc turns a function with the right signature into a v2 list cluster templates handler
+type V2ListClusterTemplatesHandlerFunc func(V2ListClusterTemplatesParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListClusterTemplatesHandlerFunc) Handle(params V2ListClusterTemplatesParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListClusterTemplatesHandler interface for that can handle valid v2 list cluster templates params
+type V2ListClusterTemplatesHandler interface {
+	Handle(V2ListClusterTemplatesParams, interface{}) middleware.Responder
+}
+
+// NewV2ListClusterTemplates creates a new http.Handler for the v2 list cluster templates operation
+func NewV2ListClusterTemplates(ctx *middleware.Context, handler V2ListClusterTemplatesHandler) *V2ListClusterTemplates {
+	return &V2ListClusterTemplates{Context: ctx, Handler: handler}
+}
+
+/*
+	V2ListClusterTemplates swagger:route GET /v2/cluster-templates cluster_templates v2ListClusterTemplates
+
+Lists the cluster templates that are accessible by the user.
+*/
+type V2ListClusterTemplates struct {
+	Context *middleware.Context
+	Handler V2ListClusterTemplatesHandler
+}
+
+func (o *V2ListClusterTemplates) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListClusterTemplatesParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,197 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListClusterTemplatesOKCode is the HTTP code returned for type V2ListClusterTemplatesOK
+const V2ListClusterTemplatesOKCode int = 200
+
+/*
+V2ListClusterTemplatesOK Success.
+
+swagger:response v2ListClusterTemplatesOK
+*/
+type V2ListClusterTemplatesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.ClusterTemplateList `json:"body,omitempty"`
+}
+
+// NewV2ListClusterTemplatesOK creates V2ListClusterTemplatesOK with default headers values
+func NewV2ListClusterTemplatesOK() *V2ListClusterTemplatesOK {
+
+	return &V2ListClusterTemplatesOK{}
+}
+
+// WithPayload adds the payload to the v2 list cluster templates o k response
+func (o *V2ListClusterTemplatesOK) WithPayload(payload models.ClusterTemplateList) *V2ListClusterTemplatesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster templates o k response
+func (o *V2ListClusterTemplatesOK) SetPayload(payload models.ClusterTemplateList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterTemplatesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.ClusterTemplateList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListClusterTemplatesUnauthorizedCode is the HTTP code returned for type V2ListClusterTemplatesUnauthorized
+const V2ListClusterTemplatesUnauthorizedCode int = 401
+
+/*
+V2ListClusterTemplatesUnauthorized Unauthorized.
+
+swagger:response v2ListClusterTemplatesUnauthorized
+*/
+type V2ListClusterTemplatesUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListClusterTemplatesUnauthorized creates V2ListClusterTemplatesUnauthorized with default headers values
+func NewV2ListClusterTemplatesUnauthorized() *V2ListClusterTemplatesUnauthorized {
+
+	return &V2ListClusterTemplatesUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list cluster templates unauthorized response
+func (o *V2ListClusterTemplatesUnauthorized) WithPayload(payload *models.InfraError) *V2ListClusterTemplatesUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster templates unauthorized response
+func (o *V2ListClusterTemplatesUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterTemplatesUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListClusterTemplatesForbiddenCode is the HTTP code returned for type V2ListClusterTemplatesForbidden
+const V2ListClusterTemplatesForbiddenCode int = 403
+
+/*
+V2ListClusterTemplatesForbidden Forbidden.
+
+swagger:response v2ListClusterTemplatesForbidden
+*/
+type V2ListClusterTemplatesForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListClusterTemplatesForbidden creates V2ListClusterTemplatesForbidden with default headers values
+func NewV2ListClusterTemplatesForbidden() *V2ListClusterTemplatesForbidden {
+
+	return &V2ListClusterTemplatesForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list cluster templates forbidden response
+func (o *V2ListClusterTemplatesForbidden) WithPayload(payload *models.InfraError) *V2ListClusterTemplatesForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster templates forbidden response
+func (o *V2ListClusterTemplatesForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterTemplatesForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListClusterTemplatesInternalServerErrorCode is the HTTP code returned for type V2ListClusterTemplatesInternalServerError
+const V2ListClusterTemplatesInternalServerErrorCode int = 500
+
+/*
+V2ListClusterTemplatesInternalServerError Error.
+
+swagger:response v2ListClusterTemplatesInternalServerError
+*/
+type V2ListClusterTemplatesInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListClusterTemplatesInternalServerError creates V2ListClusterTemplatesInternalServerError with default headers values
+func NewV2ListClusterTemplatesInternalServerError() *V2ListClusterTemplatesInternalServerError {
+
+	return &V2ListClusterTemplatesInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list cluster templates internal server error response
+func (o *V2ListClusterTemplatesInternalServerError) WithPayload(payload *models.Error) *V2ListClusterTemplatesInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster templates internal server error response
+func (o *V2ListClusterTemplatesInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterTemplatesInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
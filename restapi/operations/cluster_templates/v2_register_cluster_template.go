@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2RegisterClusterTemplateHandlerFunc turns a function with the right signature into a v2 register cluster template handler
+type V2RegisterClusterTemplateHandlerFunc func(V2RegisterClusterTemplateParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2RegisterClusterTemplateHandlerFunc) Handle(params V2RegisterClusterTemplateParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2RegisterClusterTemplateHandler interface for that can handle valid v2 register cluster template params
+type V2RegisterClusterTemplateHandler interface {
+	Handle(V2RegisterClusterTemplateParams, interface{}) middleware.Responder
+}
+
+// NewV2RegisterClusterTemplate creates a new http.Handler for the v2 register cluster template operation
+func NewV2RegisterClusterTemplate(ctx *middleware.Context, handler V2RegisterClusterTemplateHandler) *V2RegisterClusterTemplate {
+	return &V2RegisterClusterTemplate{Context: ctx, Handler: handler}
+}
+
+/*
+	V2RegisterClusterTemplate swagger:route POST /v2/cluster-templates cluster_templates v2RegisterClusterTemplate
+
+Creates a cluster template, from which clusters with the same configuration can be registered.
+*/
+type V2RegisterClusterTemplate struct {
+	Context *middleware.Context
+	Handler V2RegisterClusterTemplateHandler
+}
+
+func (o *V2RegisterClusterTemplate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2RegisterClusterTemplateParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
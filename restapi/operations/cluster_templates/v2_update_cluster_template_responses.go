@@ -0,0 +1,329 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2UpdateClusterTemplateCreatedCode is the HTTP code returned for type V2UpdateClusterTemplateCreated
+const V2UpdateClusterTemplateCreatedCode int = 201
+
+/*
+V2UpdateClusterTemplateCreated Success.
+
+swagger:response v2UpdateClusterTemplateCreated
+*/
+type V2UpdateClusterTemplateCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ClusterTemplate `json:"body,omitempty"`
+}
+
+// NewV2UpdateClusterTemplateCreated creates V2UpdateClusterTemplateCreated with default headers values
+func NewV2UpdateClusterTemplateCreated() *V2UpdateClusterTemplateCreated {
+
+	return &V2UpdateClusterTemplateCreated{}
+}
+
+// WithPayload adds the payload to the v2 update cluster template created response
+func (o *V2UpdateClusterTemplateCreated) WithPayload(payload *models.ClusterTemplate) *V2UpdateClusterTemplateCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update cluster template created response
+func (o *V2UpdateClusterTemplateCreated) SetPayload(payload *models.ClusterTemplate) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateClusterTemplateCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateClusterTemplateBadRequestCode is the HTTP code returned for type V2UpdateClusterTemplateBadRequest
+const V2UpdateClusterTemplateBadRequestCode int = 400
+
+/*
+V2UpdateClusterTemplateBadRequest Error.
+
+swagger:response v2UpdateClusterTemplateBadRequest
+*/
+type V2UpdateClusterTemplateBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateClusterTemplateBadRequest creates V2UpdateClusterTemplateBadRequest with default headers values
+func NewV2UpdateClusterTemplateBadRequest() *V2UpdateClusterTemplateBadRequest {
+
+	return &V2UpdateClusterTemplateBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 update cluster template bad request response
+func (o *V2UpdateClusterTemplateBadRequest) WithPayload(payload *models.Error) *V2UpdateClusterTemplateBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update cluster template bad request response
+func (o *V2UpdateClusterTemplateBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateClusterTemplateBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateClusterTemplateUnauthorizedCode is the HTTP code returned for type V2UpdateClusterTemplateUnauthorized
+const V2UpdateClusterTemplateUnauthorizedCode int = 401
+
+/*
+V2UpdateClusterTemplateUnauthorized Unauthorized.
+
+swagger:response v2UpdateClusterTemplateUnauthorized
+*/
+type V2UpdateClusterTemplateUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2UpdateClusterTemplateUnauthorized creates V2UpdateClusterTemplateUnauthorized with default headers values
+func NewV2UpdateClusterTemplateUnauthorized() *V2UpdateClusterTemplateUnauthorized {
+
+	return &V2UpdateClusterTemplateUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 update cluster template unauthorized response
+func (o *V2UpdateClusterTemplateUnauthorized) WithPayload(payload *models.InfraError) *V2UpdateClusterTemplateUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update cluster template unauthorized response
+func (o *V2UpdateClusterTemplateUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateClusterTemplateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateClusterTemplateForbiddenCode is the HTTP code returned for type V2UpdateClusterTemplateForbidden
+const V2UpdateClusterTemplateForbiddenCode int = 403
+
+/*
+V2UpdateClusterTemplateForbidden Forbidden.
+
+swagger:response v2UpdateClusterTemplateForbidden
+*/
+type V2UpdateClusterTemplateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2UpdateClusterTemplateForbidden creates V2UpdateClusterTemplateForbidden with default headers values
+func NewV2UpdateClusterTemplateForbidden() *V2UpdateClusterTemplateForbidden {
+
+	return &V2UpdateClusterTemplateForbidden{}
+}
+
+// WithPayload adds the payload to the v2 update cluster template forbidden response
+func (o *V2UpdateClusterTemplateForbidden) WithPayload(payload *models.InfraError) *V2UpdateClusterTemplateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update cluster template forbidden response
+func (o *V2UpdateClusterTemplateForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateClusterTemplateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateClusterTemplateNotFoundCode is the HTTP code returned for type V2UpdateClusterTemplateNotFound
+const V2UpdateClusterTemplateNotFoundCode int = 404
+
+/*
+V2UpdateClusterTemplateNotFound Error.
+
+swagger:response v2UpdateClusterTemplateNotFound
+*/
+type V2UpdateClusterTemplateNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateClusterTemplateNotFound creates V2UpdateClusterTemplateNotFound with default headers values
+func NewV2UpdateClusterTemplateNotFound() *V2UpdateClusterTemplateNotFound {
+
+	return &V2UpdateClusterTemplateNotFound{}
+}
+
+// WithPayload adds the payload to the v2 update cluster template not found response
+func (o *V2UpdateClusterTemplateNotFound) WithPayload(payload *models.Error) *V2UpdateClusterTemplateNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update cluster template not found response
+func (o *V2UpdateClusterTemplateNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateClusterTemplateNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateClusterTemplateConflictCode is the HTTP code returned for type V2UpdateClusterTemplateConflict
+const V2UpdateClusterTemplateConflictCode int = 409
+
+/*
+V2UpdateClusterTemplateConflict Error.
+
+swagger:response v2UpdateClusterTemplateConflict
+*/
+type V2UpdateClusterTemplateConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateClusterTemplateConflict creates V2UpdateClusterTemplateConflict with default headers values
+func NewV2UpdateClusterTemplateConflict() *V2UpdateClusterTemplateConflict {
+
+	return &V2UpdateClusterTemplateConflict{}
+}
+
+// WithPayload adds the payload to the v2 update cluster template conflict response
+func (o *V2UpdateClusterTemplateConflict) WithPayload(payload *models.Error) *V2UpdateClusterTemplateConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update cluster template conflict response
+func (o *V2UpdateClusterTemplateConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateClusterTemplateConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateClusterTemplateInternalServerErrorCode is the HTTP code returned for type V2UpdateClusterTemplateInternalServerError
+const V2UpdateClusterTemplateInternalServerErrorCode int = 500
+
+/*
+V2UpdateClusterTemplateInternalServerError Error.
+
+swagger:response v2UpdateClusterTemplateInternalServerError
+*/
+type V2UpdateClusterTemplateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateClusterTemplateInternalServerError creates V2UpdateClusterTemplateInternalServerError with default headers values
+func NewV2UpdateClusterTemplateInternalServerError() *V2UpdateClusterTemplateInternalServerError {
+
+	return &V2UpdateClusterTemplateInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 update cluster template internal server error response
+func (o *V2UpdateClusterTemplateInternalServerError) WithPayload(payload *models.Error) *V2UpdateClusterTemplateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update cluster template internal server error response
+func (o *V2UpdateClusterTemplateInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateClusterTemplateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2WatchHandlerFunc turns a function with the right signature into a v2 watch handler
+type V2WatchHandlerFunc func(V2WatchParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2WatchHandlerFunc) Handle(params V2WatchParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2WatchHandler interface for that can handle valid v2 watch params
+type V2WatchHandler interface {
+	Handle(V2WatchParams, interface{}) middleware.Responder
+}
+
+// NewV2Watch creates a new http.Handler for the v2 watch operation
+func NewV2Watch(ctx *middleware.Context, handler V2WatchHandler) *V2Watch {
+	return &V2Watch{Context: ctx, Handler: handler}
+}
+
+/*
+	V2Watch swagger:route GET /v2/watch events v2Watch
+
+Streams cluster, host, infra-env and event notifications as server-sent events.
+*/
+type V2Watch struct {
+	Context *middleware.Context
+	Handler V2WatchHandler
+}
+
+func (o *V2Watch) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2WatchParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,257 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewV2WatchParams creates a new V2WatchParams object
+//
+// There are no default values defined in the spec.
+func NewV2WatchParams() V2WatchParams {
+
+	return V2WatchParams{}
+}
+
+// V2WatchParams contains all the bound params for the v2 watch operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2Watch
+type V2WatchParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Resume the stream after the notification with this offset, as sent by reconnecting server-sent events clients. Ignored if 'since' is specified.
+	  In: header
+	*/
+	LastEventID *string
+	/*The cluster to stream notifications for.
+	  In: query
+	*/
+	ClusterID *strfmt.UUID
+	/*The infra-env to stream notifications for.
+	  In: query
+	*/
+	InfraEnvID *strfmt.UUID
+	/*The notification types to stream. All types are streamed if not specified.
+	  In: query
+	*/
+	NotificationTypes []string
+	/*Resume the stream after the notification with this offset.
+	  Minimum: 0
+	  In: query
+	*/
+	Since *int64
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2WatchParams() beforehand.
+func (o *V2WatchParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	if err := o.bindLastEventID(r.Header[http.CanonicalHeaderKey("Last-Event-ID")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qClusterID, qhkClusterID, _ := qs.GetOK("cluster_id")
+	if err := o.bindClusterID(qClusterID, qhkClusterID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qInfraEnvID, qhkInfraEnvID, _ := qs.GetOK("infra_env_id")
+	if err := o.bindInfraEnvID(qInfraEnvID, qhkInfraEnvID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qNotificationTypes, qhkNotificationTypes, _ := qs.GetOK("notification_types")
+	if err := o.bindNotificationTypes(qNotificationTypes, qhkNotificationTypes, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSince, qhkSince, _ := qs.GetOK("since")
+	if err := o.bindSince(qSince, qhkSince, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindLastEventID binds and validates parameter LastEventID from header.
+func (o *V2WatchParams) bindLastEventID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.LastEventID = &raw
+
+	return nil
+}
+
+// bindClusterID binds and validates parameter ClusterID from query.
+func (o *V2WatchParams) bindClusterID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("cluster_id", "query", "strfmt.UUID", raw)
+	}
+	o.ClusterID = (value.(*strfmt.UUID))
+
+	if err := o.validateClusterID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateClusterID carries on validations for parameter ClusterID
+func (o *V2WatchParams) validateClusterID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("cluster_id", "query", "uuid", o.ClusterID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindInfraEnvID binds and validates parameter InfraEnvID from query.
+func (o *V2WatchParams) bindInfraEnvID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("infra_env_id", "query", "strfmt.UUID", raw)
+	}
+	o.InfraEnvID = (value.(*strfmt.UUID))
+
+	if err := o.validateInfraEnvID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateInfraEnvID carries on validations for parameter InfraEnvID
+func (o *V2WatchParams) validateInfraEnvID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("infra_env_id", "query", "uuid", o.InfraEnvID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindNotificationTypes binds and validates array parameter NotificationTypes from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *V2WatchParams) bindNotificationTypes(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvNotificationTypes string
+	if len(rawData) > 0 {
+		qvNotificationTypes = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	notificationTypesIC := swag.SplitByFormat(qvNotificationTypes, "")
+	if len(notificationTypesIC) == 0 {
+		return nil
+	}
+
+	var notificationTypesIR []string
+	for i, notificationTypesIV := range notificationTypesIC {
+		notificationTypesI := notificationTypesIV
+
+		if err := validate.EnumCase(fmt.Sprintf("%s.%v", "notification_types", i), "query", notificationTypesI, []interface{}{"ClusterState", "HostState", "InfraEnv", "Event"}, true); err != nil {
+			return err
+		}
+
+		notificationTypesIR = append(notificationTypesIR, notificationTypesI)
+	}
+
+	o.NotificationTypes = notificationTypesIR
+
+	return nil
+}
+
+// bindSince binds and validates parameter Since from query.
+func (o *V2WatchParams) bindSince(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("since", "query", "int64", raw)
+	}
+	o.Since = &value
+
+	if err := o.validateSince(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSince carries on validations for parameter Since
+func (o *V2WatchParams) validateSince(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("since", "query", *o.Since, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,328 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2WatchOKCode is the HTTP code returned for type V2WatchOK
+const V2WatchOKCode int = 200
+
+/*
+V2WatchOK Success.
+
+swagger:response v2WatchOK
+*/
+type V2WatchOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewV2WatchOK creates V2WatchOK with default headers values
+func NewV2WatchOK() *V2WatchOK {
+
+	return &V2WatchOK{}
+}
+
+// WithPayload adds the payload to the v2 watch o k response
+func (o *V2WatchOK) WithPayload(payload io.ReadCloser) *V2WatchOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 watch o k response
+func (o *V2WatchOK) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2WatchOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2WatchBadRequestCode is the HTTP code returned for type V2WatchBadRequest
+const V2WatchBadRequestCode int = 400
+
+/*
+V2WatchBadRequest Bad Request.
+
+swagger:response v2WatchBadRequest
+*/
+type V2WatchBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2WatchBadRequest creates V2WatchBadRequest with default headers values
+func NewV2WatchBadRequest() *V2WatchBadRequest {
+
+	return &V2WatchBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 watch bad request response
+func (o *V2WatchBadRequest) WithPayload(payload *models.Error) *V2WatchBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 watch bad request response
+func (o *V2WatchBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2WatchBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2WatchUnauthorizedCode is the HTTP code returned for type V2WatchUnauthorized
+const V2WatchUnauthorizedCode int = 401
+
+/*
+V2WatchUnauthorized Unauthorized.
+
+swagger:response v2WatchUnauthorized
+*/
+type V2WatchUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2WatchUnauthorized creates V2WatchUnauthorized with default headers values
+func NewV2WatchUnauthorized() *V2WatchUnauthorized {
+
+	return &V2WatchUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 watch unauthorized response
+func (o *V2WatchUnauthorized) WithPayload(payload *models.InfraError) *V2WatchUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 watch unauthorized response
+func (o *V2WatchUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2WatchUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2WatchForbiddenCode is the HTTP code returned for type V2WatchForbidden
+const V2WatchForbiddenCode int = 403
+
+/*
+V2WatchForbidden Forbidden.
+
+swagger:response v2WatchForbidden
+*/
+type V2WatchForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2WatchForbidden creates V2WatchForbidden with default headers values
+func NewV2WatchForbidden() *V2WatchForbidden {
+
+	return &V2WatchForbidden{}
+}
+
+// WithPayload adds the payload to the v2 watch forbidden response
+func (o *V2WatchForbidden) WithPayload(payload *models.InfraError) *V2WatchForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 watch forbidden response
+func (o *V2WatchForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2WatchForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2WatchNotFoundCode is the HTTP code returned for type V2WatchNotFound
+const V2WatchNotFoundCode int = 404
+
+/*
+V2WatchNotFound Error.
+
+swagger:response v2WatchNotFound
+*/
+type V2WatchNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2WatchNotFound creates V2WatchNotFound with default headers values
+func NewV2WatchNotFound() *V2WatchNotFound {
+
+	return &V2WatchNotFound{}
+}
+
+// WithPayload adds the payload to the v2 watch not found response
+func (o *V2WatchNotFound) WithPayload(payload *models.Error) *V2WatchNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 watch not found response
+func (o *V2WatchNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2WatchNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2WatchInternalServerErrorCode is the HTTP code returned for type V2WatchInternalServerError
+const V2WatchInternalServerErrorCode int = 500
+
+/*
+V2WatchInternalServerError Error.
+
+swagger:response v2WatchInternalServerError
+*/
+type V2WatchInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2WatchInternalServerError creates V2WatchInternalServerError with default headers values
+func NewV2WatchInternalServerError() *V2WatchInternalServerError {
+
+	return &V2WatchInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 watch internal server error response
+func (o *V2WatchInternalServerError) WithPayload(payload *models.Error) *V2WatchInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 watch internal server error response
+func (o *V2WatchInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2WatchInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2WatchNotImplementedCode is the HTTP code returned for type V2WatchNotImplemented
+const V2WatchNotImplementedCode int = 501
+
+/*
+V2WatchNotImplemented Not implemented.
+
+swagger:response v2WatchNotImplemented
+*/
+type V2WatchNotImplemented struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2WatchNotImplemented creates V2WatchNotImplemented with default headers values
+func NewV2WatchNotImplemented() *V2WatchNotImplemented {
+
+	return &V2WatchNotImplemented{}
+}
+
+// WithPayload adds the payload to the v2 watch not implemented response
+func (o *V2WatchNotImplemented) WithPayload(payload *models.Error) *V2WatchNotImplemented {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 watch not implemented response
+func (o *V2WatchNotImplemented) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2WatchNotImplemented) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(501)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
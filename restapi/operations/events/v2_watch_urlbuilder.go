@@ -0,0 +1,142 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// V2WatchURL generates an URL for the v2 watch operation
+type V2WatchURL struct {
+	ClusterID         *strfmt.UUID
+	InfraEnvID        *strfmt.UUID
+	NotificationTypes []string
+	Since             *int64
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *V2WatchURL) WithBasePath(bp string) *V2WatchURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *V2WatchURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *V2WatchURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/v2/watch"
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/api/assisted-install"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	var clusterIDQ string
+	if o.ClusterID != nil {
+		clusterIDQ = o.ClusterID.String()
+	}
+	if clusterIDQ != "" {
+		qs.Set("cluster_id", clusterIDQ)
+	}
+
+	var infraEnvIDQ string
+	if o.InfraEnvID != nil {
+		infraEnvIDQ = o.InfraEnvID.String()
+	}
+	if infraEnvIDQ != "" {
+		qs.Set("infra_env_id", infraEnvIDQ)
+	}
+
+	var notificationTypesIR []string
+	for _, notificationTypesI := range o.NotificationTypes {
+		notificationTypesIS := notificationTypesI
+		if notificationTypesIS != "" {
+			notificationTypesIR = append(notificationTypesIR, notificationTypesIS)
+		}
+	}
+
+	notificationTypes := swag.JoinByFormat(notificationTypesIR, "")
+
+	if len(notificationTypes) > 0 {
+		qsv := notificationTypes[0]
+		if qsv != "" {
+			qs.Set("notification_types", qsv)
+		}
+	}
+
+	var sinceQ string
+	if o.Since != nil {
+		sinceQ = swag.FormatInt64(*o.Since)
+	}
+	if sinceQ != "" {
+		qs.Set("since", sinceQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *V2WatchURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *V2WatchURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *V2WatchURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on V2WatchURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on V2WatchURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *V2WatchURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
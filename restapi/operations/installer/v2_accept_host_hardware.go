@@ -0,0 +1,70 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2AcceptHostHardwareHandlerFunc turns a function with the right signature into a v2 accept host hardware handler
+type V2AcceptHostHardwareHandlerFunc func(V2AcceptHostHardwareParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2AcceptHostHardwareHandlerFunc) Handle(params V2AcceptHostHardwareParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2AcceptHostHardwareHandler interface for that can handle valid v2 accept host hardware params
+type V2AcceptHostHardwareHandler interface {
+	Handle(V2AcceptHostHardwareParams, interface{}) middleware.Responder
+}
+
+// NewV2AcceptHostHardware creates a new http.Handler for the v2 accept host hardware operation
+func NewV2AcceptHostHardware(ctx *middleware.Context, handler V2AcceptHostHardwareHandler) *V2AcceptHostHardware {
+	return &V2AcceptHostHardware{Context: ctx, Handler: handler}
+}
+
+/*
+	V2AcceptHostHardware swagger:route POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/accept-hardware installer v2AcceptHostHardware
+
+Accepts the current hardware of a host whose hardware changed after its validations passed, so that the
+hardware drift no longer blocks the installation.
+*/
+type V2AcceptHostHardware struct {
+	Context *middleware.Context
+	Handler V2AcceptHostHardwareHandler
+}
+
+func (o *V2AcceptHostHardware) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2AcceptHostHardwareParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
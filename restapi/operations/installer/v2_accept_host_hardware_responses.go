@@ -0,0 +1,239 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2AcceptHostHardwareOKCode is the HTTP code returned for type V2AcceptHostHardwareOK
+const V2AcceptHostHardwareOKCode int = 200
+
+/*
+V2AcceptHostHardwareOK Success.
+
+swagger:response v2AcceptHostHardwareOK
+*/
+type V2AcceptHostHardwareOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Host `json:"body,omitempty"`
+}
+
+// NewV2AcceptHostHardwareOK creates V2AcceptHostHardwareOK with default headers values
+func NewV2AcceptHostHardwareOK() *V2AcceptHostHardwareOK {
+
+	return &V2AcceptHostHardwareOK{}
+}
+
+// WithPayload adds the payload to the v2 accept host hardware o k response
+func (o *V2AcceptHostHardwareOK) WithPayload(payload *models.Host) *V2AcceptHostHardwareOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 accept host hardware o k response
+func (o *V2AcceptHostHardwareOK) SetPayload(payload *models.Host) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2AcceptHostHardwareOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2AcceptHostHardwareUnauthorizedCode is the HTTP code returned for type V2AcceptHostHardwareUnauthorized
+const V2AcceptHostHardwareUnauthorizedCode int = 401
+
+/*
+V2AcceptHostHardwareUnauthorized Unauthorized.
+
+swagger:response v2AcceptHostHardwareUnauthorized
+*/
+type V2AcceptHostHardwareUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2AcceptHostHardwareUnauthorized creates V2AcceptHostHardwareUnauthorized with default headers values
+func NewV2AcceptHostHardwareUnauthorized() *V2AcceptHostHardwareUnauthorized {
+
+	return &V2AcceptHostHardwareUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 accept host hardware unauthorized response
+func (o *V2AcceptHostHardwareUnauthorized) WithPayload(payload *models.InfraError) *V2AcceptHostHardwareUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 accept host hardware unauthorized response
+func (o *V2AcceptHostHardwareUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2AcceptHostHardwareUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2AcceptHostHardwareForbiddenCode is the HTTP code returned for type V2AcceptHostHardwareForbidden
+const V2AcceptHostHardwareForbiddenCode int = 403
+
+/*
+V2AcceptHostHardwareForbidden Forbidden.
+
+swagger:response v2AcceptHostHardwareForbidden
+*/
+type V2AcceptHostHardwareForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2AcceptHostHardwareForbidden creates V2AcceptHostHardwareForbidden with default headers values
+func NewV2AcceptHostHardwareForbidden() *V2AcceptHostHardwareForbidden {
+
+	return &V2AcceptHostHardwareForbidden{}
+}
+
+// WithPayload adds the payload to the v2 accept host hardware forbidden response
+func (o *V2AcceptHostHardwareForbidden) WithPayload(payload *models.InfraError) *V2AcceptHostHardwareForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 accept host hardware forbidden response
+func (o *V2AcceptHostHardwareForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2AcceptHostHardwareForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2AcceptHostHardwareNotFoundCode is the HTTP code returned for type V2AcceptHostHardwareNotFound
+const V2AcceptHostHardwareNotFoundCode int = 404
+
+/*
+V2AcceptHostHardwareNotFound Error.
+
+swagger:response v2AcceptHostHardwareNotFound
+*/
+type V2AcceptHostHardwareNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2AcceptHostHardwareNotFound creates V2AcceptHostHardwareNotFound with default headers values
+func NewV2AcceptHostHardwareNotFound() *V2AcceptHostHardwareNotFound {
+
+	return &V2AcceptHostHardwareNotFound{}
+}
+
+// WithPayload adds the payload to the v2 accept host hardware not found response
+func (o *V2AcceptHostHardwareNotFound) WithPayload(payload *models.Error) *V2AcceptHostHardwareNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 accept host hardware not found response
+func (o *V2AcceptHostHardwareNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2AcceptHostHardwareNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2AcceptHostHardwareInternalServerErrorCode is the HTTP code returned for type V2AcceptHostHardwareInternalServerError
+const V2AcceptHostHardwareInternalServerErrorCode int = 500
+
+/*
+V2AcceptHostHardwareInternalServerError Error.
+
+swagger:response v2AcceptHostHardwareInternalServerError
+*/
+type V2AcceptHostHardwareInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2AcceptHostHardwareInternalServerError creates V2AcceptHostHardwareInternalServerError with default headers values
+func NewV2AcceptHostHardwareInternalServerError() *V2AcceptHostHardwareInternalServerError {
+
+	return &V2AcceptHostHardwareInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 accept host hardware internal server error response
+func (o *V2AcceptHostHardwareInternalServerError) WithPayload(payload *models.Error) *V2AcceptHostHardwareInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 accept host hardware internal server error response
+func (o *V2AcceptHostHardwareInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2AcceptHostHardwareInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
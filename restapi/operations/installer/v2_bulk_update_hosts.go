@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2BulkUpdateHostsHandlerFunc turns a function with the right signature into a v2 bulk update hosts handler
+type V2BulkUpdateHostsHandlerFunc func(V2BulkUpdateHostsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2BulkUpdateHostsHandlerFunc) Handle(params V2BulkUpdateHostsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2BulkUpdateHostsHandler interface for that can handle valid v2 bulk update hosts params
+type V2BulkUpdateHostsHandler interface {
+	Handle(V2BulkUpdateHostsParams, interface{}) middleware.Responder
+}
+
+// NewV2BulkUpdateHosts creates a new http.Handler for the v2 bulk update hosts operation
+func NewV2BulkUpdateHosts(ctx *middleware.Context, handler V2BulkUpdateHostsHandler) *V2BulkUpdateHosts {
+	return &V2BulkUpdateHosts{Context: ctx, Handler: handler}
+}
+
+/*
+	V2BulkUpdateHosts swagger:route POST /v2/infra-envs/{infra_env_id}/hosts/actions/bulk installer v2BulkUpdateHosts
+
+Applies a list of operations to the hosts of the infra-env. The operations that update the hosts in place are applied in a single transaction, so either all of them are applied or none is. Bind and unbind operations are applied one by one before them. Each operation is subject to the same validations as the equivalent single host call.
+*/
+type V2BulkUpdateHosts struct {
+	Context *middleware.Context
+	Handler V2BulkUpdateHostsHandler
+}
+
+func (o *V2BulkUpdateHosts) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2BulkUpdateHostsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,284 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2BulkUpdateHostsOKCode is the HTTP code returned for type V2BulkUpdateHostsOK
+const V2BulkUpdateHostsOKCode int = 200
+
+/*
+V2BulkUpdateHostsOK The result of each operation.
+
+swagger:response v2BulkUpdateHostsOK
+*/
+type V2BulkUpdateHostsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BulkHostOperationsResult `json:"body,omitempty"`
+}
+
+// NewV2BulkUpdateHostsOK creates V2BulkUpdateHostsOK with default headers values
+func NewV2BulkUpdateHostsOK() *V2BulkUpdateHostsOK {
+
+	return &V2BulkUpdateHostsOK{}
+}
+
+// WithPayload adds the payload to the v2 bulk update hosts o k response
+func (o *V2BulkUpdateHostsOK) WithPayload(payload *models.BulkHostOperationsResult) *V2BulkUpdateHostsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bulk update hosts o k response
+func (o *V2BulkUpdateHostsOK) SetPayload(payload *models.BulkHostOperationsResult) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BulkUpdateHostsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2BulkUpdateHostsBadRequestCode is the HTTP code returned for type V2BulkUpdateHostsBadRequest
+const V2BulkUpdateHostsBadRequestCode int = 400
+
+/*
+V2BulkUpdateHostsBadRequest Error.
+
+swagger:response v2BulkUpdateHostsBadRequest
+*/
+type V2BulkUpdateHostsBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2BulkUpdateHostsBadRequest creates V2BulkUpdateHostsBadRequest with default headers values
+func NewV2BulkUpdateHostsBadRequest() *V2BulkUpdateHostsBadRequest {
+
+	return &V2BulkUpdateHostsBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 bulk update hosts bad request response
+func (o *V2BulkUpdateHostsBadRequest) WithPayload(payload *models.Error) *V2BulkUpdateHostsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bulk update hosts bad request response
+func (o *V2BulkUpdateHostsBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BulkUpdateHostsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2BulkUpdateHostsUnauthorizedCode is the HTTP code returned for type V2BulkUpdateHostsUnauthorized
+const V2BulkUpdateHostsUnauthorizedCode int = 401
+
+/*
+V2BulkUpdateHostsUnauthorized Unauthorized.
+
+swagger:response v2BulkUpdateHostsUnauthorized
+*/
+type V2BulkUpdateHostsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2BulkUpdateHostsUnauthorized creates V2BulkUpdateHostsUnauthorized with default headers values
+func NewV2BulkUpdateHostsUnauthorized() *V2BulkUpdateHostsUnauthorized {
+
+	return &V2BulkUpdateHostsUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 bulk update hosts unauthorized response
+func (o *V2BulkUpdateHostsUnauthorized) WithPayload(payload *models.InfraError) *V2BulkUpdateHostsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bulk update hosts unauthorized response
+func (o *V2BulkUpdateHostsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BulkUpdateHostsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2BulkUpdateHostsForbiddenCode is the HTTP code returned for type V2BulkUpdateHostsForbidden
+const V2BulkUpdateHostsForbiddenCode int = 403
+
+/*
+V2BulkUpdateHostsForbidden Forbidden.
+
+swagger:response v2BulkUpdateHostsForbidden
+*/
+type V2BulkUpdateHostsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2BulkUpdateHostsForbidden creates V2BulkUpdateHostsForbidden with default headers values
+func NewV2BulkUpdateHostsForbidden() *V2BulkUpdateHostsForbidden {
+
+	return &V2BulkUpdateHostsForbidden{}
+}
+
+// WithPayload adds the payload to the v2 bulk update hosts forbidden response
+func (o *V2BulkUpdateHostsForbidden) WithPayload(payload *models.InfraError) *V2BulkUpdateHostsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bulk update hosts forbidden response
+func (o *V2BulkUpdateHostsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BulkUpdateHostsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2BulkUpdateHostsNotFoundCode is the HTTP code returned for type V2BulkUpdateHostsNotFound
+const V2BulkUpdateHostsNotFoundCode int = 404
+
+/*
+V2BulkUpdateHostsNotFound Error.
+
+swagger:response v2BulkUpdateHostsNotFound
+*/
+type V2BulkUpdateHostsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2BulkUpdateHostsNotFound creates V2BulkUpdateHostsNotFound with default headers values
+func NewV2BulkUpdateHostsNotFound() *V2BulkUpdateHostsNotFound {
+
+	return &V2BulkUpdateHostsNotFound{}
+}
+
+// WithPayload adds the payload to the v2 bulk update hosts not found response
+func (o *V2BulkUpdateHostsNotFound) WithPayload(payload *models.Error) *V2BulkUpdateHostsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bulk update hosts not found response
+func (o *V2BulkUpdateHostsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BulkUpdateHostsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2BulkUpdateHostsInternalServerErrorCode is the HTTP code returned for type V2BulkUpdateHostsInternalServerError
+const V2BulkUpdateHostsInternalServerErrorCode int = 500
+
+/*
+V2BulkUpdateHostsInternalServerError Error.
+
+swagger:response v2BulkUpdateHostsInternalServerError
+*/
+type V2BulkUpdateHostsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2BulkUpdateHostsInternalServerError creates V2BulkUpdateHostsInternalServerError with default headers values
+func NewV2BulkUpdateHostsInternalServerError() *V2BulkUpdateHostsInternalServerError {
+
+	return &V2BulkUpdateHostsInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 bulk update hosts internal server error response
+func (o *V2BulkUpdateHostsInternalServerError) WithPayload(payload *models.Error) *V2BulkUpdateHostsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bulk update hosts internal server error response
+func (o *V2BulkUpdateHostsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BulkUpdateHostsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2DryRunInstallClusterHandlerFunc turns a function with the right signature into a v2 dry run install cluster handler
+type V2DryRunInstallClusterHandlerFunc func(V2DryRunInstallClusterParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2DryRunInstallClusterHandlerFunc) Handle(params V2DryRunInstallClusterParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2DryRunInstallClusterHandler interface for that can handle valid v2 dry run install cluster params
+type V2DryRunInstallClusterHandler interface {
+	Handle(V2DryRunInstallClusterParams, interface{}) middleware.Responder
+}
+
+// NewV2DryRunInstallCluster creates a new http.Handler for the v2 dry run install cluster operation
+func NewV2DryRunInstallCluster(ctx *middleware.Context, handler V2DryRunInstallClusterHandler) *V2DryRunInstallCluster {
+	return &V2DryRunInstallCluster{Context: ctx, Handler: handler}
+}
+
+/*
+	V2DryRunInstallCluster swagger:route POST /v2/clusters/{cluster_id}/actions/dry-run installer v2DryRunInstallCluster
+
+Renders the install-config, the install manifests and ignitions, the operator manifests and the installer
+arguments of each host, as the installation of the cluster would, and returns them as a tar.gz bundle.
+The cluster is not modified and its stored files are not changed. Generation errors are listed in the
+dry-run-report.json file of the bundle.
+*/
+type V2DryRunInstallCluster struct {
+	Context *middleware.Context
+	Handler V2DryRunInstallClusterHandler
+}
+
+func (o *V2DryRunInstallCluster) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2DryRunInstallClusterParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,373 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DryRunInstallClusterOKCode is the HTTP code returned for type V2DryRunInstallClusterOK
+const V2DryRunInstallClusterOKCode int = 200
+
+/*
+V2DryRunInstallClusterOK Success.
+
+swagger:response v2DryRunInstallClusterOK
+*/
+type V2DryRunInstallClusterOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewV2DryRunInstallClusterOK creates V2DryRunInstallClusterOK with default headers values
+func NewV2DryRunInstallClusterOK() *V2DryRunInstallClusterOK {
+
+	return &V2DryRunInstallClusterOK{}
+}
+
+// WithPayload adds the payload to the v2 dry run install cluster o k response
+func (o *V2DryRunInstallClusterOK) WithPayload(payload io.ReadCloser) *V2DryRunInstallClusterOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 dry run install cluster o k response
+func (o *V2DryRunInstallClusterOK) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DryRunInstallClusterOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2DryRunInstallClusterBadRequestCode is the HTTP code returned for type V2DryRunInstallClusterBadRequest
+const V2DryRunInstallClusterBadRequestCode int = 400
+
+/*
+V2DryRunInstallClusterBadRequest Error.
+
+swagger:response v2DryRunInstallClusterBadRequest
+*/
+type V2DryRunInstallClusterBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DryRunInstallClusterBadRequest creates V2DryRunInstallClusterBadRequest with default headers values
+func NewV2DryRunInstallClusterBadRequest() *V2DryRunInstallClusterBadRequest {
+
+	return &V2DryRunInstallClusterBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 dry run install cluster bad request response
+func (o *V2DryRunInstallClusterBadRequest) WithPayload(payload *models.Error) *V2DryRunInstallClusterBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 dry run install cluster bad request response
+func (o *V2DryRunInstallClusterBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DryRunInstallClusterBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DryRunInstallClusterUnauthorizedCode is the HTTP code returned for type V2DryRunInstallClusterUnauthorized
+const V2DryRunInstallClusterUnauthorizedCode int = 401
+
+/*
+V2DryRunInstallClusterUnauthorized Unauthorized.
+
+swagger:response v2DryRunInstallClusterUnauthorized
+*/
+type V2DryRunInstallClusterUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DryRunInstallClusterUnauthorized creates V2DryRunInstallClusterUnauthorized with default headers values
+func NewV2DryRunInstallClusterUnauthorized() *V2DryRunInstallClusterUnauthorized {
+
+	return &V2DryRunInstallClusterUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 dry run install cluster unauthorized response
+func (o *V2DryRunInstallClusterUnauthorized) WithPayload(payload *models.InfraError) *V2DryRunInstallClusterUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 dry run install cluster unauthorized response
+func (o *V2DryRunInstallClusterUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DryRunInstallClusterUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DryRunInstallClusterForbiddenCode is the HTTP code returned for type V2DryRunInstallClusterForbidden
+const V2DryRunInstallClusterForbiddenCode int = 403
+
+/*
+V2DryRunInstallClusterForbidden Forbidden.
+
+swagger:response v2DryRunInstallClusterForbidden
+*/
+type V2DryRunInstallClusterForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DryRunInstallClusterForbidden creates V2DryRunInstallClusterForbidden with default headers values
+func NewV2DryRunInstallClusterForbidden() *V2DryRunInstallClusterForbidden {
+
+	return &V2DryRunInstallClusterForbidden{}
+}
+
+// WithPayload adds the payload to the v2 dry run install cluster forbidden response
+func (o *V2DryRunInstallClusterForbidden) WithPayload(payload *models.InfraError) *V2DryRunInstallClusterForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 dry run install cluster forbidden response
+func (o *V2DryRunInstallClusterForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DryRunInstallClusterForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DryRunInstallClusterNotFoundCode is the HTTP code returned for type V2DryRunInstallClusterNotFound
+const V2DryRunInstallClusterNotFoundCode int = 404
+
+/*
+V2DryRunInstallClusterNotFound Error.
+
+swagger:response v2DryRunInstallClusterNotFound
+*/
+type V2DryRunInstallClusterNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DryRunInstallClusterNotFound creates V2DryRunInstallClusterNotFound with default headers values
+func NewV2DryRunInstallClusterNotFound() *V2DryRunInstallClusterNotFound {
+
+	return &V2DryRunInstallClusterNotFound{}
+}
+
+// WithPayload adds the payload to the v2 dry run install cluster not found response
+func (o *V2DryRunInstallClusterNotFound) WithPayload(payload *models.Error) *V2DryRunInstallClusterNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 dry run install cluster not found response
+func (o *V2DryRunInstallClusterNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DryRunInstallClusterNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DryRunInstallClusterMethodNotAllowedCode is the HTTP code returned for type V2DryRunInstallClusterMethodNotAllowed
+const V2DryRunInstallClusterMethodNotAllowedCode int = 405
+
+/*
+V2DryRunInstallClusterMethodNotAllowed Method Not Allowed.
+
+swagger:response v2DryRunInstallClusterMethodNotAllowed
+*/
+type V2DryRunInstallClusterMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DryRunInstallClusterMethodNotAllowed creates V2DryRunInstallClusterMethodNotAllowed with default headers values
+func NewV2DryRunInstallClusterMethodNotAllowed() *V2DryRunInstallClusterMethodNotAllowed {
+
+	return &V2DryRunInstallClusterMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 dry run install cluster method not allowed response
+func (o *V2DryRunInstallClusterMethodNotAllowed) WithPayload(payload *models.Error) *V2DryRunInstallClusterMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 dry run install cluster method not allowed response
+func (o *V2DryRunInstallClusterMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DryRunInstallClusterMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DryRunInstallClusterConflictCode is the HTTP code returned for type V2DryRunInstallClusterConflict
+const V2DryRunInstallClusterConflictCode int = 409
+
+/*
+V2DryRunInstallClusterConflict Error.
+
+swagger:response v2DryRunInstallClusterConflict
+*/
+type V2DryRunInstallClusterConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DryRunInstallClusterConflict creates V2DryRunInstallClusterConflict with default headers values
+func NewV2DryRunInstallClusterConflict() *V2DryRunInstallClusterConflict {
+
+	return &V2DryRunInstallClusterConflict{}
+}
+
+// WithPayload adds the payload to the v2 dry run install cluster conflict response
+func (o *V2DryRunInstallClusterConflict) WithPayload(payload *models.Error) *V2DryRunInstallClusterConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 dry run install cluster conflict response
+func (o *V2DryRunInstallClusterConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DryRunInstallClusterConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DryRunInstallClusterInternalServerErrorCode is the HTTP code returned for type V2DryRunInstallClusterInternalServerError
+const V2DryRunInstallClusterInternalServerErrorCode int = 500
+
+/*
+V2DryRunInstallClusterInternalServerError Error.
+
+swagger:response v2DryRunInstallClusterInternalServerError
+*/
+type V2DryRunInstallClusterInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DryRunInstallClusterInternalServerError creates V2DryRunInstallClusterInternalServerError with default headers values
+func NewV2DryRunInstallClusterInternalServerError() *V2DryRunInstallClusterInternalServerError {
+
+	return &V2DryRunInstallClusterInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 dry run install cluster internal server error response
+func (o *V2DryRunInstallClusterInternalServerError) WithPayload(payload *models.Error) *V2DryRunInstallClusterInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 dry run install cluster internal server error response
+func (o *V2DryRunInstallClusterInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DryRunInstallClusterInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
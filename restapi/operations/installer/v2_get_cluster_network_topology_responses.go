@@ -0,0 +1,239 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetClusterNetworkTopologyOKCode is the HTTP code returned for type V2GetClusterNetworkTopologyOK
+const V2GetClusterNetworkTopologyOKCode int = 200
+
+/*
+V2GetClusterNetworkTopologyOK Success.
+
+swagger:response v2GetClusterNetworkTopologyOK
+*/
+type V2GetClusterNetworkTopologyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.NetworkTopology `json:"body,omitempty"`
+}
+
+// NewV2GetClusterNetworkTopologyOK creates V2GetClusterNetworkTopologyOK with default headers values
+func NewV2GetClusterNetworkTopologyOK() *V2GetClusterNetworkTopologyOK {
+
+	return &V2GetClusterNetworkTopologyOK{}
+}
+
+// WithPayload adds the payload to the v2 get cluster network topology o k response
+func (o *V2GetClusterNetworkTopologyOK) WithPayload(payload *models.NetworkTopology) *V2GetClusterNetworkTopologyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster network topology o k response
+func (o *V2GetClusterNetworkTopologyOK) SetPayload(payload *models.NetworkTopology) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterNetworkTopologyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterNetworkTopologyUnauthorizedCode is the HTTP code returned for type V2GetClusterNetworkTopologyUnauthorized
+const V2GetClusterNetworkTopologyUnauthorizedCode int = 401
+
+/*
+V2GetClusterNetworkTopologyUnauthorized Unauthorized.
+
+swagger:response v2GetClusterNetworkTopologyUnauthorized
+*/
+type V2GetClusterNetworkTopologyUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetClusterNetworkTopologyUnauthorized creates V2GetClusterNetworkTopologyUnauthorized with default headers values
+func NewV2GetClusterNetworkTopologyUnauthorized() *V2GetClusterNetworkTopologyUnauthorized {
+
+	return &V2GetClusterNetworkTopologyUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 get cluster network topology unauthorized response
+func (o *V2GetClusterNetworkTopologyUnauthorized) WithPayload(payload *models.InfraError) *V2GetClusterNetworkTopologyUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster network topology unauthorized response
+func (o *V2GetClusterNetworkTopologyUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterNetworkTopologyUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterNetworkTopologyForbiddenCode is the HTTP code returned for type V2GetClusterNetworkTopologyForbidden
+const V2GetClusterNetworkTopologyForbiddenCode int = 403
+
+/*
+V2GetClusterNetworkTopologyForbidden Forbidden.
+
+swagger:response v2GetClusterNetworkTopologyForbidden
+*/
+type V2GetClusterNetworkTopologyForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetClusterNetworkTopologyForbidden creates V2GetClusterNetworkTopologyForbidden with default headers values
+func NewV2GetClusterNetworkTopologyForbidden() *V2GetClusterNetworkTopologyForbidden {
+
+	return &V2GetClusterNetworkTopologyForbidden{}
+}
+
+// WithPayload adds the payload to the v2 get cluster network topology forbidden response
+func (o *V2GetClusterNetworkTopologyForbidden) WithPayload(payload *models.InfraError) *V2GetClusterNetworkTopologyForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster network topology forbidden response
+func (o *V2GetClusterNetworkTopologyForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterNetworkTopologyForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterNetworkTopologyNotFoundCode is the HTTP code returned for type V2GetClusterNetworkTopologyNotFound
+const V2GetClusterNetworkTopologyNotFoundCode int = 404
+
+/*
+V2GetClusterNetworkTopologyNotFound Error.
+
+swagger:response v2GetClusterNetworkTopologyNotFound
+*/
+type V2GetClusterNetworkTopologyNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterNetworkTopologyNotFound creates V2GetClusterNetworkTopologyNotFound with default headers values
+func NewV2GetClusterNetworkTopologyNotFound() *V2GetClusterNetworkTopologyNotFound {
+
+	return &V2GetClusterNetworkTopologyNotFound{}
+}
+
+// WithPayload adds the payload to the v2 get cluster network topology not found response
+func (o *V2GetClusterNetworkTopologyNotFound) WithPayload(payload *models.Error) *V2GetClusterNetworkTopologyNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster network topology not found response
+func (o *V2GetClusterNetworkTopologyNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterNetworkTopologyNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterNetworkTopologyInternalServerErrorCode is the HTTP code returned for type V2GetClusterNetworkTopologyInternalServerError
+const V2GetClusterNetworkTopologyInternalServerErrorCode int = 500
+
+/*
+V2GetClusterNetworkTopologyInternalServerError Error.
+
+swagger:response v2GetClusterNetworkTopologyInternalServerError
+*/
+type V2GetClusterNetworkTopologyInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterNetworkTopologyInternalServerError creates V2GetClusterNetworkTopologyInternalServerError with default headers values
+func NewV2GetClusterNetworkTopologyInternalServerError() *V2GetClusterNetworkTopologyInternalServerError {
+
+	return &V2GetClusterNetworkTopologyInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 get cluster network topology internal server error response
+func (o *V2GetClusterNetworkTopologyInternalServerError) WithPayload(payload *models.Error) *V2GetClusterNetworkTopologyInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster network topology internal server error response
+func (o *V2GetClusterNetworkTopologyInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterNetworkTopologyInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
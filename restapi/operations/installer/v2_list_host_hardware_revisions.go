@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListHostHardwareRevisionsHandlerFunc turns a function with the right signature into a v2 list host hardware revisions handler
+type V2ListHostHardwareRevisionsHandlerFunc func(V2ListHostHardwareRevisionsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListHostHardwareRevisionsHandlerFunc) Handle(params V2ListHostHardwareRevisionsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListHostHardwareRevisionsHandler interface for that can handle valid v2 list host hardware revisions params
+type V2ListHostHardwareRevisionsHandler interface {
+	Handle(V2ListHostHardwareRevisionsParams, interface{}) middleware.Responder
+}
+
+// NewV2ListHostHardwareRevisions creates a new http.Handler for the v2 list host hardware revisions operation
+func NewV2ListHostHardwareRevisions(ctx *middleware.Context, handler V2ListHostHardwareRevisionsHandler) *V2ListHostHardwareRevisions {
+	return &V2ListHostHardwareRevisions{Context: ctx, Handler: handler}
+}
+
+/*
+	V2ListHostHardwareRevisions swagger:route GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/hardware-revisions installer v2ListHostHardwareRevisions
+
+Lists the revisions of the hardware of the host, newest first, with the changes of each revision from the
+previous one. A revision is recorded whenever the inventory of the host reports a different hardware
+fingerprint.
+*/
+type V2ListHostHardwareRevisions struct {
+	Context *middleware.Context
+	Handler V2ListHostHardwareRevisionsHandler
+}
+
+func (o *V2ListHostHardwareRevisions) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListHostHardwareRevisionsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,242 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListHostHardwareRevisionsOKCode is the HTTP code returned for type V2ListHostHardwareRevisionsOK
+const V2ListHostHardwareRevisionsOKCode int = 200
+
+/*
+V2ListHostHardwareRevisionsOK Success.
+
+swagger:response v2ListHostHardwareRevisionsOK
+*/
+type V2ListHostHardwareRevisionsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.HostHardwareRevisionList `json:"body,omitempty"`
+}
+
+// NewV2ListHostHardwareRevisionsOK creates V2ListHostHardwareRevisionsOK with default headers values
+func NewV2ListHostHardwareRevisionsOK() *V2ListHostHardwareRevisionsOK {
+
+	return &V2ListHostHardwareRevisionsOK{}
+}
+
+// WithPayload adds the payload to the v2 list host hardware revisions o k response
+func (o *V2ListHostHardwareRevisionsOK) WithPayload(payload models.HostHardwareRevisionList) *V2ListHostHardwareRevisionsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host hardware revisions o k response
+func (o *V2ListHostHardwareRevisionsOK) SetPayload(payload models.HostHardwareRevisionList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostHardwareRevisionsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.HostHardwareRevisionList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListHostHardwareRevisionsUnauthorizedCode is the HTTP code returned for type V2ListHostHardwareRevisionsUnauthorized
+const V2ListHostHardwareRevisionsUnauthorizedCode int = 401
+
+/*
+V2ListHostHardwareRevisionsUnauthorized Unauthorized.
+
+swagger:response v2ListHostHardwareRevisionsUnauthorized
+*/
+type V2ListHostHardwareRevisionsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListHostHardwareRevisionsUnauthorized creates V2ListHostHardwareRevisionsUnauthorized with default headers values
+func NewV2ListHostHardwareRevisionsUnauthorized() *V2ListHostHardwareRevisionsUnauthorized {
+
+	return &V2ListHostHardwareRevisionsUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list host hardware revisions unauthorized response
+func (o *V2ListHostHardwareRevisionsUnauthorized) WithPayload(payload *models.InfraError) *V2ListHostHardwareRevisionsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host hardware revisions unauthorized response
+func (o *V2ListHostHardwareRevisionsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostHardwareRevisionsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListHostHardwareRevisionsForbiddenCode is the HTTP code returned for type V2ListHostHardwareRevisionsForbidden
+const V2ListHostHardwareRevisionsForbiddenCode int = 403
+
+/*
+V2ListHostHardwareRevisionsForbidden Forbidden.
+
+swagger:response v2ListHostHardwareRevisionsForbidden
+*/
+type V2ListHostHardwareRevisionsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListHostHardwareRevisionsForbidden creates V2ListHostHardwareRevisionsForbidden with default headers values
+func NewV2ListHostHardwareRevisionsForbidden() *V2ListHostHardwareRevisionsForbidden {
+
+	return &V2ListHostHardwareRevisionsForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list host hardware revisions forbidden response
+func (o *V2ListHostHardwareRevisionsForbidden) WithPayload(payload *models.InfraError) *V2ListHostHardwareRevisionsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host hardware revisions forbidden response
+func (o *V2ListHostHardwareRevisionsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostHardwareRevisionsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListHostHardwareRevisionsNotFoundCode is the HTTP code returned for type V2ListHostHardwareRevisionsNotFound
+const V2ListHostHardwareRevisionsNotFoundCode int = 404
+
+/*
+V2ListHostHardwareRevisionsNotFound Error.
+
+swagger:response v2ListHostHardwareRevisionsNotFound
+*/
+type V2ListHostHardwareRevisionsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListHostHardwareRevisionsNotFound creates V2ListHostHardwareRevisionsNotFound with default headers values
+func NewV2ListHostHardwareRevisionsNotFound() *V2ListHostHardwareRevisionsNotFound {
+
+	return &V2ListHostHardwareRevisionsNotFound{}
+}
+
+// WithPayload adds the payload to the v2 list host hardware revisions not found response
+func (o *V2ListHostHardwareRevisionsNotFound) WithPayload(payload *models.Error) *V2ListHostHardwareRevisionsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host hardware revisions not found response
+func (o *V2ListHostHardwareRevisionsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostHardwareRevisionsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListHostHardwareRevisionsInternalServerErrorCode is the HTTP code returned for type V2ListHostHardwareRevisionsInternalServerError
+const V2ListHostHardwareRevisionsInternalServerErrorCode int = 500
+
+/*
+V2ListHostHardwareRevisionsInternalServerError Error.
+
+swagger:response v2ListHostHardwareRevisionsInternalServerError
+*/
+type V2ListHostHardwareRevisionsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListHostHardwareRevisionsInternalServerError creates V2ListHostHardwareRevisionsInternalServerError with default headers values
+func NewV2ListHostHardwareRevisionsInternalServerError() *V2ListHostHardwareRevisionsInternalServerError {
+
+	return &V2ListHostHardwareRevisionsInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list host hardware revisions internal server error response
+func (o *V2ListHostHardwareRevisionsInternalServerError) WithPayload(payload *models.Error) *V2ListHostHardwareRevisionsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host hardware revisions internal server error response
+func (o *V2ListHostHardwareRevisionsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostHardwareRevisionsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/validate"
 
 	"github.com/openshift/assisted-service/models"
@@ -34,11 +35,20 @@ type V2RegisterClusterParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
-	/*The properties describing the new cluster.
+	/*The template the cluster is registered from. The manifests and the infra-env of the template are created with the cluster.
+	  In: query
+	*/
+	ClusterTemplateID *strfmt.UUID
+	/*The properties describing the new cluster. When the cluster is registered from a template, the properties that are set override the ones of the template.
 	  Required: true
 	  In: body
 	*/
 	NewClusterParams *models.ClusterCreateParams
+	/*The values of the parameters of the template, formatted as name=value.
+	  In: query
+	  Collection Format: multi
+	*/
+	TemplateParameters []string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -50,6 +60,13 @@ func (o *V2RegisterClusterParams) BindRequest(r *http.Request, route *middleware
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
+	qClusterTemplateID, qhkClusterTemplateID, _ := qs.GetOK("cluster_template_id")
+	if err := o.bindClusterTemplateID(qClusterTemplateID, qhkClusterTemplateID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body models.ClusterCreateParams
@@ -77,8 +94,72 @@ func (o *V2RegisterClusterParams) BindRequest(r *http.Request, route *middleware
 	} else {
 		res = append(res, errors.Required("newClusterParams", "body", ""))
 	}
+
+	qTemplateParameters, qhkTemplateParameters, _ := qs.GetOK("template_parameters")
+	if err := o.bindTemplateParameters(qTemplateParameters, qhkTemplateParameters, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
+
+// bindClusterTemplateID binds and validates parameter ClusterTemplateID from query.
+func (o *V2RegisterClusterParams) bindClusterTemplateID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("cluster_template_id", "query", "strfmt.UUID", raw)
+	}
+	o.ClusterTemplateID = (value.(*strfmt.UUID))
+
+	if err := o.validateClusterTemplateID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateClusterTemplateID carries on validations for parameter ClusterTemplateID
+func (o *V2RegisterClusterParams) validateClusterTemplateID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("cluster_template_id", "query", "uuid", o.ClusterTemplateID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindTemplateParameters binds and validates array parameter TemplateParameters from query.
+//
+// Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
+func (o *V2RegisterClusterParams) bindTemplateParameters(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	// CollectionFormat: multi
+	templateParametersIC := rawData
+	if len(templateParametersIC) == 0 {
+		return nil
+	}
+
+	var templateParametersIR []string
+	for _, templateParametersIV := range templateParametersIC {
+		templateParametersI := templateParametersIV
+
+		templateParametersIR = append(templateParametersIR, templateParametersI)
+	}
+
+	o.TemplateParameters = templateParametersIR
+
+	return nil
+}
@@ -193,6 +193,51 @@ func (o *V2RegisterClusterForbidden) WriteResponse(rw http.ResponseWriter, produ
 	}
 }
 
+// V2RegisterClusterNotFoundCode is the HTTP code returned for type V2RegisterClusterNotFound
+const V2RegisterClusterNotFoundCode int = 404
+
+/*
+V2RegisterClusterNotFound Error.
+
+swagger:response v2RegisterClusterNotFound
+*/
+type V2RegisterClusterNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RegisterClusterNotFound creates V2RegisterClusterNotFound with default headers values
+func NewV2RegisterClusterNotFound() *V2RegisterClusterNotFound {
+
+	return &V2RegisterClusterNotFound{}
+}
+
+// WithPayload adds the payload to the v2 register cluster not found response
+func (o *V2RegisterClusterNotFound) WithPayload(payload *models.Error) *V2RegisterClusterNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register cluster not found response
+func (o *V2RegisterClusterNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterClusterNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // V2RegisterClusterMethodNotAllowedCode is the HTTP code returned for type V2RegisterClusterMethodNotAllowed
 const V2RegisterClusterMethodNotAllowedCode int = 405
 
@@ -9,11 +9,19 @@ import (
 	"errors"
 	"net/url"
 	golangswaggerpaths "path"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // V2RegisterClusterURL generates an URL for the v2 register cluster operation
 type V2RegisterClusterURL struct {
+	ClusterTemplateID  *strfmt.UUID
+	TemplateParameters []string
+
 	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
 }
 
 // WithBasePath sets the base path for this url builder, only required when it's different from the
@@ -43,6 +51,32 @@ func (o *V2RegisterClusterURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var clusterTemplateIDQ string
+	if o.ClusterTemplateID != nil {
+		clusterTemplateIDQ = o.ClusterTemplateID.String()
+	}
+	if clusterTemplateIDQ != "" {
+		qs.Set("cluster_template_id", clusterTemplateIDQ)
+	}
+
+	var templateParametersIR []string
+	for _, templateParametersI := range o.TemplateParameters {
+		templateParametersIS := templateParametersI
+		if templateParametersIS != "" {
+			templateParametersIR = append(templateParametersIR, templateParametersIS)
+		}
+	}
+
+	templateParameters := swag.JoinByFormat(templateParametersIR, "multi")
+
+	for _, qsv := range templateParameters {
+		qs.Add("template_parameters", qsv)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer_cache
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListInstallerCacheReleasesHandlerFunc turns a function with the right signature into a v2 list installer cache releases handler
+type V2ListInstallerCacheReleasesHandlerFunc func(V2ListInstallerCacheReleasesParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListInstallerCacheReleasesHandlerFunc) Handle(params V2ListInstallerCacheReleasesParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListInstallerCacheReleasesHandler interface for that can handle valid v2 list installer cache releases params
+type V2ListInstallerCacheReleasesHandler interface {
+	Handle(V2ListInstallerCacheReleasesParams, interface{}) middleware.Responder
+}
+
+// NewV2ListInstallerCacheReleases creates a new http.Handler for the v2 list installer cache releases operation
+func NewV2ListInstallerCacheReleases(ctx *middleware.Context, handler V2ListInstallerCacheReleasesHandler) *V2ListInstallerCacheReleases {
+	return &V2ListInstallerCacheReleases{Context: ctx, Handler: handler}
+}
+
+/*
+	V2ListInstallerCacheReleases swagger:route GET /v2/installer-cache/releases installer_cache v2ListInstallerCacheReleases
+
+Lists the releases in the installer cache, with their size, last use and pin status. The local cache is the one of the replica that serves the request.
+*/
+type V2ListInstallerCacheReleases struct {
+	Context *middleware.Context
+	Handler V2ListInstallerCacheReleasesHandler
+}
+
+func (o *V2ListInstallerCacheReleases) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListInstallerCacheReleasesParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
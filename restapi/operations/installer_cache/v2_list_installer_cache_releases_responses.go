@@ -0,0 +1,197 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer_cache
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListInstallerCacheReleasesOKCode is the HTTP code returned for type V2ListInstallerCacheReleasesOK
+const V2ListInstallerCacheReleasesOKCode int = 200
+
+/*
+V2ListInstallerCacheReleasesOK Success.
+
+swagger:response v2ListInstallerCacheReleasesOK
+*/
+type V2ListInstallerCacheReleasesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.InstallerCacheReleaseList `json:"body,omitempty"`
+}
+
+// NewV2ListInstallerCacheReleasesOK creates V2ListInstallerCacheReleasesOK with default headers values
+func NewV2ListInstallerCacheReleasesOK() *V2ListInstallerCacheReleasesOK {
+
+	return &V2ListInstallerCacheReleasesOK{}
+}
+
+// WithPayload adds the payload to the v2 list installer cache releases o k response
+func (o *V2ListInstallerCacheReleasesOK) WithPayload(payload models.InstallerCacheReleaseList) *V2ListInstallerCacheReleasesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list installer cache releases o k response
+func (o *V2ListInstallerCacheReleasesOK) SetPayload(payload models.InstallerCacheReleaseList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListInstallerCacheReleasesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.InstallerCacheReleaseList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListInstallerCacheReleasesUnauthorizedCode is the HTTP code returned for type V2ListInstallerCacheReleasesUnauthorized
+const V2ListInstallerCacheReleasesUnauthorizedCode int = 401
+
+/*
+V2ListInstallerCacheReleasesUnauthorized Unauthorized.
+
+swagger:response v2ListInstallerCacheReleasesUnauthorized
+*/
+type V2ListInstallerCacheReleasesUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListInstallerCacheReleasesUnauthorized creates V2ListInstallerCacheReleasesUnauthorized with default headers values
+func NewV2ListInstallerCacheReleasesUnauthorized() *V2ListInstallerCacheReleasesUnauthorized {
+
+	return &V2ListInstallerCacheReleasesUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list installer cache releases unauthorized response
+func (o *V2ListInstallerCacheReleasesUnauthorized) WithPayload(payload *models.InfraError) *V2ListInstallerCacheReleasesUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list installer cache releases unauthorized response
+func (o *V2ListInstallerCacheReleasesUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListInstallerCacheReleasesUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListInstallerCacheReleasesForbiddenCode is the HTTP code returned for type V2ListInstallerCacheReleasesForbidden
+const V2ListInstallerCacheReleasesForbiddenCode int = 403
+
+/*
+V2ListInstallerCacheReleasesForbidden Forbidden.
+
+swagger:response v2ListInstallerCacheReleasesForbidden
+*/
+type V2ListInstallerCacheReleasesForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListInstallerCacheReleasesForbidden creates V2ListInstallerCacheReleasesForbidden with default headers values
+func NewV2ListInstallerCacheReleasesForbidden() *V2ListInstallerCacheReleasesForbidden {
+
+	return &V2ListInstallerCacheReleasesForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list installer cache releases forbidden response
+func (o *V2ListInstallerCacheReleasesForbidden) WithPayload(payload *models.InfraError) *V2ListInstallerCacheReleasesForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list installer cache releases forbidden response
+func (o *V2ListInstallerCacheReleasesForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListInstallerCacheReleasesForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListInstallerCacheReleasesInternalServerErrorCode is the HTTP code returned for type V2ListInstallerCacheReleasesInternalServerError
+const V2ListInstallerCacheReleasesInternalServerErrorCode int = 500
+
+/*
+V2ListInstallerCacheReleasesInternalServerError Error.
+
+swagger:response v2ListInstallerCacheReleasesInternalServerError
+*/
+type V2ListInstallerCacheReleasesInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListInstallerCacheReleasesInternalServerError creates V2ListInstallerCacheReleasesInternalServerError with default headers values
+func NewV2ListInstallerCacheReleasesInternalServerError() *V2ListInstallerCacheReleasesInternalServerError {
+
+	return &V2ListInstallerCacheReleasesInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list installer cache releases internal server error response
+func (o *V2ListInstallerCacheReleasesInternalServerError) WithPayload(payload *models.Error) *V2ListInstallerCacheReleasesInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list installer cache releases internal server error response
+func (o *V2ListInstallerCacheReleasesInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListInstallerCacheReleasesInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
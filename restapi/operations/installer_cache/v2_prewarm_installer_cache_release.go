@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer_cache
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2PrewarmInstallerCacheReleaseHandlerFunc turns a function with the right signature into a v2 prewarm installer cache release handler
+type V2PrewarmInstallerCacheReleaseHandlerFunc func(V2PrewarmInstallerCacheReleaseParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2PrewarmInstallerCacheReleaseHandlerFunc) Handle(params V2PrewarmInstallerCacheReleaseParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2PrewarmInstallerCacheReleaseHandler interface for that can handle valid v2 prewarm installer cache release params
+type V2PrewarmInstallerCacheReleaseHandler interface {
+	Handle(V2PrewarmInstallerCacheReleaseParams, interface{}) middleware.Responder
+}
+
+// NewV2PrewarmInstallerCacheRelease creates a new http.Handler for the v2 prewarm installer cache release operation
+func NewV2PrewarmInstallerCacheRelease(ctx *middleware.Context, handler V2PrewarmInstallerCacheReleaseHandler) *V2PrewarmInstallerCacheRelease {
+	return &V2PrewarmInstallerCacheRelease{Context: ctx, Handler: handler}
+}
+
+/*
+	V2PrewarmInstallerCacheRelease swagger:route POST /v2/installer-cache/releases installer_cache v2PrewarmInstallerCacheRelease
+
+Pre-warms the installer cache with the installer binary of a release image ahead of demand. The binary is extracted in the background.
+*/
+type V2PrewarmInstallerCacheRelease struct {
+	Context *middleware.Context
+	Handler V2PrewarmInstallerCacheReleaseHandler
+}
+
+func (o *V2PrewarmInstallerCacheRelease) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2PrewarmInstallerCacheReleaseParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,239 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer_cache
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2PrewarmInstallerCacheReleaseAcceptedCode is the HTTP code returned for type V2PrewarmInstallerCacheReleaseAccepted
+const V2PrewarmInstallerCacheReleaseAcceptedCode int = 202
+
+/*
+V2PrewarmInstallerCacheReleaseAccepted Success.
+
+swagger:response v2PrewarmInstallerCacheReleaseAccepted
+*/
+type V2PrewarmInstallerCacheReleaseAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InstallerCacheRelease `json:"body,omitempty"`
+}
+
+// NewV2PrewarmInstallerCacheReleaseAccepted creates V2PrewarmInstallerCacheReleaseAccepted with default headers values
+func NewV2PrewarmInstallerCacheReleaseAccepted() *V2PrewarmInstallerCacheReleaseAccepted {
+
+	return &V2PrewarmInstallerCacheReleaseAccepted{}
+}
+
+// WithPayload adds the payload to the v2 prewarm installer cache release accepted response
+func (o *V2PrewarmInstallerCacheReleaseAccepted) WithPayload(payload *models.InstallerCacheRelease) *V2PrewarmInstallerCacheReleaseAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 prewarm installer cache release accepted response
+func (o *V2PrewarmInstallerCacheReleaseAccepted) SetPayload(payload *models.InstallerCacheRelease) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2PrewarmInstallerCacheReleaseAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2PrewarmInstallerCacheReleaseBadRequestCode is the HTTP code returned for type V2PrewarmInstallerCacheReleaseBadRequest
+const V2PrewarmInstallerCacheReleaseBadRequestCode int = 400
+
+/*
+V2PrewarmInstallerCacheReleaseBadRequest Error.
+
+swagger:response v2PrewarmInstallerCacheReleaseBadRequest
+*/
+type V2PrewarmInstallerCacheReleaseBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2PrewarmInstallerCacheReleaseBadRequest creates V2PrewarmInstallerCacheReleaseBadRequest with default headers values
+func NewV2PrewarmInstallerCacheReleaseBadRequest() *V2PrewarmInstallerCacheReleaseBadRequest {
+
+	return &V2PrewarmInstallerCacheReleaseBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 prewarm installer cache release bad request response
+func (o *V2PrewarmInstallerCacheReleaseBadRequest) WithPayload(payload *models.Error) *V2PrewarmInstallerCacheReleaseBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 prewarm installer cache release bad request response
+func (o *V2PrewarmInstallerCacheReleaseBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2PrewarmInstallerCacheReleaseBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2PrewarmInstallerCacheReleaseUnauthorizedCode is the HTTP code returned for type V2PrewarmInstallerCacheReleaseUnauthorized
+const V2PrewarmInstallerCacheReleaseUnauthorizedCode int = 401
+
+/*
+V2PrewarmInstallerCacheReleaseUnauthorized Unauthorized.
+
+swagger:response v2PrewarmInstallerCacheReleaseUnauthorized
+*/
+type V2PrewarmInstallerCacheReleaseUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2PrewarmInstallerCacheReleaseUnauthorized creates V2PrewarmInstallerCacheReleaseUnauthorized with default headers values
+func NewV2PrewarmInstallerCacheReleaseUnauthorized() *V2PrewarmInstallerCacheReleaseUnauthorized {
+
+	return &V2PrewarmInstallerCacheReleaseUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 prewarm installer cache release unauthorized response
+func (o *V2PrewarmInstallerCacheReleaseUnauthorized) WithPayload(payload *models.InfraError) *V2PrewarmInstallerCacheReleaseUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 prewarm installer cache release unauthorized response
+func (o *V2PrewarmInstallerCacheReleaseUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2PrewarmInstallerCacheReleaseUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2PrewarmInstallerCacheReleaseForbiddenCode is the HTTP code returned for type V2PrewarmInstallerCacheReleaseForbidden
+const V2PrewarmInstallerCacheReleaseForbiddenCode int = 403
+
+/*
+V2PrewarmInstallerCacheReleaseForbidden Forbidden.
+
+swagger:response v2PrewarmInstallerCacheReleaseForbidden
+*/
+type V2PrewarmInstallerCacheReleaseForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2PrewarmInstallerCacheReleaseForbidden creates V2PrewarmInstallerCacheReleaseForbidden with default headers values
+func NewV2PrewarmInstallerCacheReleaseForbidden() *V2PrewarmInstallerCacheReleaseForbidden {
+
+	return &V2PrewarmInstallerCacheReleaseForbidden{}
+}
+
+// WithPayload adds the payload to the v2 prewarm installer cache release forbidden response
+func (o *V2PrewarmInstallerCacheReleaseForbidden) WithPayload(payload *models.InfraError) *V2PrewarmInstallerCacheReleaseForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 prewarm installer cache release forbidden response
+func (o *V2PrewarmInstallerCacheReleaseForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2PrewarmInstallerCacheReleaseForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2PrewarmInstallerCacheReleaseInternalServerErrorCode is the HTTP code returned for type V2PrewarmInstallerCacheReleaseInternalServerError
+const V2PrewarmInstallerCacheReleaseInternalServerErrorCode int = 500
+
+/*
+V2PrewarmInstallerCacheReleaseInternalServerError Error.
+
+swagger:response v2PrewarmInstallerCacheReleaseInternalServerError
+*/
+type V2PrewarmInstallerCacheReleaseInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2PrewarmInstallerCacheReleaseInternalServerError creates V2PrewarmInstallerCacheReleaseInternalServerError with default headers values
+func NewV2PrewarmInstallerCacheReleaseInternalServerError() *V2PrewarmInstallerCacheReleaseInternalServerError {
+
+	return &V2PrewarmInstallerCacheReleaseInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 prewarm installer cache release internal server error response
+func (o *V2PrewarmInstallerCacheReleaseInternalServerError) WithPayload(payload *models.Error) *V2PrewarmInstallerCacheReleaseInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 prewarm installer cache release internal server error response
+func (o *V2PrewarmInstallerCacheReleaseInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2PrewarmInstallerCacheReleaseInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer_cache
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2UpdateInstallerCacheReleaseHandlerFunc turns a function with the right signature into a v2 update installer cache release handler
+type V2UpdateInstallerCacheReleaseHandlerFunc func(V2UpdateInstallerCacheReleaseParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2UpdateInstallerCacheReleaseHandlerFunc) Handle(params V2UpdateInstallerCacheReleaseParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2UpdateInstallerCacheReleaseHandler interface for that can handle valid v2 update installer cache release params
+type V2UpdateInstallerCacheReleaseHandler interface {
+	Handle(V2UpdateInstallerCacheReleaseParams, interface{}) middleware.Responder
+}
+
+// NewV2UpdateInstallerCacheRelease creates a new http.Handler for the v2 update installer cache release operation
+func NewV2UpdateInstallerCacheRelease(ctx *middleware.Context, handler V2UpdateInstallerCacheReleaseHandler) *V2UpdateInstallerCacheRelease {
+	return &V2UpdateInstallerCacheRelease{Context: ctx, Handler: handler}
+}
+
+/*
+	V2UpdateInstallerCacheRelease swagger:route PATCH /v2/installer-cache/releases installer_cache v2UpdateInstallerCacheRelease
+
+Pins or unpins a release that was pre-warmed. Pinned releases are never evicted from the installer cache.
+*/
+type V2UpdateInstallerCacheRelease struct {
+	Context *middleware.Context
+	Handler V2UpdateInstallerCacheReleaseHandler
+}
+
+func (o *V2UpdateInstallerCacheRelease) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2UpdateInstallerCacheReleaseParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,84 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer_cache
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/validate"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2UpdateInstallerCacheReleaseParams creates a new V2UpdateInstallerCacheReleaseParams object
+//
+// There are no default values defined in the spec.
+func NewV2UpdateInstallerCacheReleaseParams() V2UpdateInstallerCacheReleaseParams {
+
+	return V2UpdateInstallerCacheReleaseParams{}
+}
+
+// V2UpdateInstallerCacheReleaseParams contains all the bound params for the v2 update installer cache release operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2UpdateInstallerCacheRelease
+type V2UpdateInstallerCacheReleaseParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The release image and its pin status.
+	  Required: true
+	  In: body
+	*/
+	UpdateParams *models.InstallerCacheReleaseUpdateParams
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2UpdateInstallerCacheReleaseParams() beforehand.
+func (o *V2UpdateInstallerCacheReleaseParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.InstallerCacheReleaseUpdateParams
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("updateParams", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("updateParams", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			ctx := validate.WithOperationRequest(r.Context())
+			if err := body.ContextValidate(ctx, route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.UpdateParams = &body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("updateParams", "body", ""))
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
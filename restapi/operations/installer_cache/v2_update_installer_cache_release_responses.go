@@ -0,0 +1,284 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer_cache
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2UpdateInstallerCacheReleaseOKCode is the HTTP code returned for type V2UpdateInstallerCacheReleaseOK
+const V2UpdateInstallerCacheReleaseOKCode int = 200
+
+/*
+V2UpdateInstallerCacheReleaseOK Success.
+
+swagger:response v2UpdateInstallerCacheReleaseOK
+*/
+type V2UpdateInstallerCacheReleaseOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InstallerCacheRelease `json:"body,omitempty"`
+}
+
+// NewV2UpdateInstallerCacheReleaseOK creates V2UpdateInstallerCacheReleaseOK with default headers values
+func NewV2UpdateInstallerCacheReleaseOK() *V2UpdateInstallerCacheReleaseOK {
+
+	return &V2UpdateInstallerCacheReleaseOK{}
+}
+
+// WithPayload adds the payload to the v2 update installer cache release o k response
+func (o *V2UpdateInstallerCacheReleaseOK) WithPayload(payload *models.InstallerCacheRelease) *V2UpdateInstallerCacheReleaseOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update installer cache release o k response
+func (o *V2UpdateInstallerCacheReleaseOK) SetPayload(payload *models.InstallerCacheRelease) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateInstallerCacheReleaseOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateInstallerCacheReleaseBadRequestCode is the HTTP code returned for type V2UpdateInstallerCacheReleaseBadRequest
+const V2UpdateInstallerCacheReleaseBadRequestCode int = 400
+
+/*
+V2UpdateInstallerCacheReleaseBadRequest Error.
+
+swagger:response v2UpdateInstallerCacheReleaseBadRequest
+*/
+type V2UpdateInstallerCacheReleaseBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateInstallerCacheReleaseBadRequest creates V2UpdateInstallerCacheReleaseBadRequest with default headers values
+func NewV2UpdateInstallerCacheReleaseBadRequest() *V2UpdateInstallerCacheReleaseBadRequest {
+
+	return &V2UpdateInstallerCacheReleaseBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 update installer cache release bad request response
+func (o *V2UpdateInstallerCacheReleaseBadRequest) WithPayload(payload *models.Error) *V2UpdateInstallerCacheReleaseBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update installer cache release bad request response
+func (o *V2UpdateInstallerCacheReleaseBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateInstallerCacheReleaseBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateInstallerCacheReleaseUnauthorizedCode is the HTTP code returned for type V2UpdateInstallerCacheReleaseUnauthorized
+const V2UpdateInstallerCacheReleaseUnauthorizedCode int = 401
+
+/*
+V2UpdateInstallerCacheReleaseUnauthorized Unauthorized.
+
+swagger:response v2UpdateInstallerCacheReleaseUnauthorized
+*/
+type V2UpdateInstallerCacheReleaseUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2UpdateInstallerCacheReleaseUnauthorized creates V2UpdateInstallerCacheReleaseUnauthorized with default headers values
+func NewV2UpdateInstallerCacheReleaseUnauthorized() *V2UpdateInstallerCacheReleaseUnauthorized {
+
+	return &V2UpdateInstallerCacheReleaseUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 update installer cache release unauthorized response
+func (o *V2UpdateInstallerCacheReleaseUnauthorized) WithPayload(payload *models.InfraError) *V2UpdateInstallerCacheReleaseUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update installer cache release unauthorized response
+func (o *V2UpdateInstallerCacheReleaseUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateInstallerCacheReleaseUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateInstallerCacheReleaseForbiddenCode is the HTTP code returned for type V2UpdateInstallerCacheReleaseForbidden
+const V2UpdateInstallerCacheReleaseForbiddenCode int = 403
+
+/*
+V2UpdateInstallerCacheReleaseForbidden Forbidden.
+
+swagger:response v2UpdateInstallerCacheReleaseForbidden
+*/
+type V2UpdateInstallerCacheReleaseForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2UpdateInstallerCacheReleaseForbidden creates V2UpdateInstallerCacheReleaseForbidden with default headers values
+func NewV2UpdateInstallerCacheReleaseForbidden() *V2UpdateInstallerCacheReleaseForbidden {
+
+	return &V2UpdateInstallerCacheReleaseForbidden{}
+}
+
+// WithPayload adds the payload to the v2 update installer cache release forbidden response
+func (o *V2UpdateInstallerCacheReleaseForbidden) WithPayload(payload *models.InfraError) *V2UpdateInstallerCacheReleaseForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update installer cache release forbidden response
+func (o *V2UpdateInstallerCacheReleaseForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateInstallerCacheReleaseForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateInstallerCacheReleaseNotFoundCode is the HTTP code returned for type V2UpdateInstallerCacheReleaseNotFound
+const V2UpdateInstallerCacheReleaseNotFoundCode int = 404
+
+/*
+V2UpdateInstallerCacheReleaseNotFound Error.
+
+swagger:response v2UpdateInstallerCacheReleaseNotFound
+*/
+type V2UpdateInstallerCacheReleaseNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateInstallerCacheReleaseNotFound creates V2UpdateInstallerCacheReleaseNotFound with default headers values
+func NewV2UpdateInstallerCacheReleaseNotFound() *V2UpdateInstallerCacheReleaseNotFound {
+
+	return &V2UpdateInstallerCacheReleaseNotFound{}
+}
+
+// WithPayload adds the payload to the v2 update installer cache release not found response
+func (o *V2UpdateInstallerCacheReleaseNotFound) WithPayload(payload *models.Error) *V2UpdateInstallerCacheReleaseNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update installer cache release not found response
+func (o *V2UpdateInstallerCacheReleaseNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateInstallerCacheReleaseNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateInstallerCacheReleaseInternalServerErrorCode is the HTTP code returned for type V2UpdateInstallerCacheReleaseInternalServerError
+const V2UpdateInstallerCacheReleaseInternalServerErrorCode int = 500
+
+/*
+V2UpdateInstallerCacheReleaseInternalServerError Error.
+
+swagger:response v2UpdateInstallerCacheReleaseInternalServerError
+*/
+type V2UpdateInstallerCacheReleaseInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateInstallerCacheReleaseInternalServerError creates V2UpdateInstallerCacheReleaseInternalServerError with default headers values
+func NewV2UpdateInstallerCacheReleaseInternalServerError() *V2UpdateInstallerCacheReleaseInternalServerError {
+
+	return &V2UpdateInstallerCacheReleaseInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 update installer cache release internal server error response
+func (o *V2UpdateInstallerCacheReleaseInternalServerError) WithPayload(payload *models.Error) *V2UpdateInstallerCacheReleaseInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update installer cache release internal server error response
+func (o *V2UpdateInstallerCacheReleaseInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateInstallerCacheReleaseInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
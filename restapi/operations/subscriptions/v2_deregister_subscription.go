@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2DeregisterSubscriptionHandlerFunc turns a function with the right signature into a v2 deregister subscription handler
+type V2DeregisterSubscriptionHandlerFunc func(V2DeregisterSubscriptionParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2DeregisterSubscriptionHandlerFunc) Handle(params V2DeregisterSubscriptionParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2DeregisterSubscriptionHandler interface for that can handle valid v2 deregister subscription params
+type V2DeregisterSubscriptionHandler interface {
+	Handle(V2DeregisterSubscriptionParams, interface{}) middleware.Responder
+}
+
+// NewV2DeregisterSubscription creates a new http.Handler for the v2 deregister subscription operation
+func NewV2DeregisterSubscription(ctx *middleware.Context, handler V2DeregisterSubscriptionHandler) *V2DeregisterSubscription {
+	return &V2DeregisterSubscription{Context: ctx, Handler: handler}
+}
+
+/*
+	V2DeregisterSubscription swagger:route DELETE /v2/subscriptions/{subscription_id} subscriptions v2DeregisterSubscription
+
+Deletes a webhook subscription and its deliveries.
+*/
+type V2DeregisterSubscription struct {
+	Context *middleware.Context
+	Handler V2DeregisterSubscriptionHandler
+}
+
+func (o *V2DeregisterSubscription) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2DeregisterSubscriptionParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
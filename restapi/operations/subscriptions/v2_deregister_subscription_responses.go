@@ -0,0 +1,264 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DeregisterSubscriptionNoContentCode is the HTTP code returned for type V2DeregisterSubscriptionNoContent
+const V2DeregisterSubscriptionNoContentCode int = 204
+
+/*
+V2DeregisterSubscriptionNoContent Success.
+
+swagger:response v2DeregisterSubscriptionNoContent
+*/
+type V2DeregisterSubscriptionNoContent struct {
+}
+
+// NewV2DeregisterSubscriptionNoContent creates V2DeregisterSubscriptionNoContent with default headers values
+func NewV2DeregisterSubscriptionNoContent() *V2DeregisterSubscriptionNoContent {
+
+	return &V2DeregisterSubscriptionNoContent{}
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterSubscriptionNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// V2DeregisterSubscriptionUnauthorizedCode is the HTTP code returned for type V2DeregisterSubscriptionUnauthorized
+const V2DeregisterSubscriptionUnauthorizedCode int = 401
+
+/*
+V2DeregisterSubscriptionUnauthorized Unauthorized.
+
+swagger:response v2DeregisterSubscriptionUnauthorized
+*/
+type V2DeregisterSubscriptionUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DeregisterSubscriptionUnauthorized creates V2DeregisterSubscriptionUnauthorized with default headers values
+func NewV2DeregisterSubscriptionUnauthorized() *V2DeregisterSubscriptionUnauthorized {
+
+	return &V2DeregisterSubscriptionUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 deregister subscription unauthorized response
+func (o *V2DeregisterSubscriptionUnauthorized) WithPayload(payload *models.InfraError) *V2DeregisterSubscriptionUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 deregister subscription unauthorized response
+func (o *V2DeregisterSubscriptionUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterSubscriptionUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeregisterSubscriptionForbiddenCode is the HTTP code returned for type V2DeregisterSubscriptionForbidden
+const V2DeregisterSubscriptionForbiddenCode int = 403
+
+/*
+V2DeregisterSubscriptionForbidden Forbidden.
+
+swagger:response v2DeregisterSubscriptionForbidden
+*/
+type V2DeregisterSubscriptionForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DeregisterSubscriptionForbidden creates V2DeregisterSubscriptionForbidden with default headers values
+func NewV2DeregisterSubscriptionForbidden() *V2DeregisterSubscriptionForbidden {
+
+	return &V2DeregisterSubscriptionForbidden{}
+}
+
+// WithPayload adds the payload to the v2 deregister subscription forbidden response
+func (o *V2DeregisterSubscriptionForbidden) WithPayload(payload *models.InfraError) *V2DeregisterSubscriptionForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 deregister subscription forbidden response
+func (o *V2DeregisterSubscriptionForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterSubscriptionForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeregisterSubscriptionNotFoundCode is the HTTP code returned for type V2DeregisterSubscriptionNotFound
+const V2DeregisterSubscriptionNotFoundCode int = 404
+
+/*
+V2DeregisterSubscriptionNotFound Error.
+
+swagger:response v2DeregisterSubscriptionNotFound
+*/
+type V2DeregisterSubscriptionNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DeregisterSubscriptionNotFound creates V2DeregisterSubscriptionNotFound with default headers values
+func NewV2DeregisterSubscriptionNotFound() *V2DeregisterSubscriptionNotFound {
+
+	return &V2DeregisterSubscriptionNotFound{}
+}
+
+// WithPayload adds the payload to the v2 deregister subscription not found response
+func (o *V2DeregisterSubscriptionNotFound) WithPayload(payload *models.Error) *V2DeregisterSubscriptionNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 deregister subscription not found response
+func (o *V2DeregisterSubscriptionNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterSubscriptionNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeregisterSubscriptionInternalServerErrorCode is the HTTP code returned for type V2DeregisterSubscriptionInternalServerError
+const V2DeregisterSubscriptionInternalServerErrorCode int = 500
+
+/*
+V2DeregisterSubscriptionInternalServerError Error.
+
+swagger:response v2DeregisterSubscriptionInternalServerError
+*/
+type V2DeregisterSubscriptionInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DeregisterSubscriptionInternalServerError creates V2DeregisterSubscriptionInternalServerError with default headers values
+func NewV2DeregisterSubscriptionInternalServerError() *V2DeregisterSubscriptionInternalServerError {
+
+	return &V2DeregisterSubscriptionInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 deregister subscription internal server error response
+func (o *V2DeregisterSubscriptionInternalServerError) WithPayload(payload *models.Error) *V2DeregisterSubscriptionInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 deregister subscription internal server error response
+func (o *V2DeregisterSubscriptionInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterSubscriptionInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeregisterSubscriptionNotImplementedCode is the HTTP code returned for type V2DeregisterSubscriptionNotImplemented
+const V2DeregisterSubscriptionNotImplementedCode int = 501
+
+/*
+V2DeregisterSubscriptionNotImplemented Not implemented.
+
+swagger:response v2DeregisterSubscriptionNotImplemented
+*/
+type V2DeregisterSubscriptionNotImplemented struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DeregisterSubscriptionNotImplemented creates V2DeregisterSubscriptionNotImplemented with default headers values
+func NewV2DeregisterSubscriptionNotImplemented() *V2DeregisterSubscriptionNotImplemented {
+
+	return &V2DeregisterSubscriptionNotImplemented{}
+}
+
+// WithPayload adds the payload to the v2 deregister subscription not implemented response
+func (o *V2DeregisterSubscriptionNotImplemented) WithPayload(payload *models.Error) *V2DeregisterSubscriptionNotImplemented {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 deregister subscription not implemented response
+func (o *V2DeregisterSubscriptionNotImplemented) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterSubscriptionNotImplemented) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(501)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
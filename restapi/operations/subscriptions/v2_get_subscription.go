@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2GetSubscriptionHandlerFunc turns a function with the right signature into a v2 get subscription handler
+type V2GetSubscriptionHandlerFunc func(V2GetSubscriptionParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2GetSubscriptionHandlerFunc) Handle(params V2GetSubscriptionParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2GetSubscriptionHandler interface for that can handle valid v2 get subscription params
+type V2GetSubscriptionHandler interface {
+	Handle(V2GetSubscriptionParams, interface{}) middleware.Responder
+}
+
+// NewV2GetSubscription creates a new http.Handler for the v2 get subscription operation
+func NewV2GetSubscription(ctx *middleware.Context, handler V2GetSubscriptionHandler) *V2GetSubscription {
+	return &V2GetSubscription{Context: ctx, Handler: handler}
+}
+
+/*
+	V2GetSubscription swagger:route GET /v2/subscriptions/{subscription_id} subscriptions v2GetSubscription
+
+Retrieves the details of the webhook subscription.
+*/
+type V2GetSubscription struct {
+	Context *middleware.Context
+	Handler V2GetSubscriptionHandler
+}
+
+func (o *V2GetSubscription) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2GetSubscriptionParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
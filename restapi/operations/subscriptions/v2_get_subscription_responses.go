@@ -0,0 +1,284 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetSubscriptionOKCode is the HTTP code returned for type V2GetSubscriptionOK
+const V2GetSubscriptionOKCode int = 200
+
+/*
+V2GetSubscriptionOK Success.
+
+swagger:response v2GetSubscriptionOK
+*/
+type V2GetSubscriptionOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Subscription `json:"body,omitempty"`
+}
+
+// NewV2GetSubscriptionOK creates V2GetSubscriptionOK with default headers values
+func NewV2GetSubscriptionOK() *V2GetSubscriptionOK {
+
+	return &V2GetSubscriptionOK{}
+}
+
+// WithPayload adds the payload to the v2 get subscription o k response
+func (o *V2GetSubscriptionOK) WithPayload(payload *models.Subscription) *V2GetSubscriptionOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get subscription o k response
+func (o *V2GetSubscriptionOK) SetPayload(payload *models.Subscription) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetSubscriptionOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetSubscriptionUnauthorizedCode is the HTTP code returned for type V2GetSubscriptionUnauthorized
+const V2GetSubscriptionUnauthorizedCode int = 401
+
+/*
+V2GetSubscriptionUnauthorized Unauthorized.
+
+swagger:response v2GetSubscriptionUnauthorized
+*/
+type V2GetSubscriptionUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetSubscriptionUnauthorized creates V2GetSubscriptionUnauthorized with default headers values
+func NewV2GetSubscriptionUnauthorized() *V2GetSubscriptionUnauthorized {
+
+	return &V2GetSubscriptionUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 get subscription unauthorized response
+func (o *V2GetSubscriptionUnauthorized) WithPayload(payload *models.InfraError) *V2GetSubscriptionUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get subscription unauthorized response
+func (o *V2GetSubscriptionUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetSubscriptionUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetSubscriptionForbiddenCode is the HTTP code returned for type V2GetSubscriptionForbidden
+const V2GetSubscriptionForbiddenCode int = 403
+
+/*
+V2GetSubscriptionForbidden Forbidden.
+
+swagger:response v2GetSubscriptionForbidden
+*/
+type V2GetSubscriptionForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetSubscriptionForbidden creates V2GetSubscriptionForbidden with default headers values
+func NewV2GetSubscriptionForbidden() *V2GetSubscriptionForbidden {
+
+	return &V2GetSubscriptionForbidden{}
+}
+
+// WithPayload adds the payload to the v2 get subscription forbidden response
+func (o *V2GetSubscriptionForbidden) WithPayload(payload *models.InfraError) *V2GetSubscriptionForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get subscription forbidden response
+func (o *V2GetSubscriptionForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetSubscriptionForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetSubscriptionNotFoundCode is the HTTP code returned for type V2GetSubscriptionNotFound
+const V2GetSubscriptionNotFoundCode int = 404
+
+/*
+V2GetSubscriptionNotFound Error.
+
+swagger:response v2GetSubscriptionNotFound
+*/
+type V2GetSubscriptionNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetSubscriptionNotFound creates V2GetSubscriptionNotFound with default headers values
+func NewV2GetSubscriptionNotFound() *V2GetSubscriptionNotFound {
+
+	return &V2GetSubscriptionNotFound{}
+}
+
+// WithPayload adds the payload to the v2 get subscription not found response
+func (o *V2GetSubscriptionNotFound) WithPayload(payload *models.Error) *V2GetSubscriptionNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get subscription not found response
+func (o *V2GetSubscriptionNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetSubscriptionNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetSubscriptionInternalServerErrorCode is the HTTP code returned for type V2GetSubscriptionInternalServerError
+const V2GetSubscriptionInternalServerErrorCode int = 500
+
+/*
+V2GetSubscriptionInternalServerError Error.
+
+swagger:response v2GetSubscriptionInternalServerError
+*/
+type V2GetSubscriptionInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetSubscriptionInternalServerError creates V2GetSubscriptionInternalServerError with default headers values
+func NewV2GetSubscriptionInternalServerError() *V2GetSubscriptionInternalServerError {
+
+	return &V2GetSubscriptionInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 get subscription internal server error response
+func (o *V2GetSubscriptionInternalServerError) WithPayload(payload *models.Error) *V2GetSubscriptionInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get subscription internal server error response
+func (o *V2GetSubscriptionInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetSubscriptionInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetSubscriptionNotImplementedCode is the HTTP code returned for type V2GetSubscriptionNotImplemented
+const V2GetSubscriptionNotImplementedCode int = 501
+
+/*
+V2GetSubscriptionNotImplemented Not implemented.
+
+swagger:response v2GetSubscriptionNotImplemented
+*/
+type V2GetSubscriptionNotImplemented struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetSubscriptionNotImplemented creates V2GetSubscriptionNotImplemented with default headers values
+func NewV2GetSubscriptionNotImplemented() *V2GetSubscriptionNotImplemented {
+
+	return &V2GetSubscriptionNotImplemented{}
+}
+
+// WithPayload adds the payload to the v2 get subscription not implemented response
+func (o *V2GetSubscriptionNotImplemented) WithPayload(payload *models.Error) *V2GetSubscriptionNotImplemented {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get subscription not implemented response
+func (o *V2GetSubscriptionNotImplemented) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetSubscriptionNotImplemented) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(501)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
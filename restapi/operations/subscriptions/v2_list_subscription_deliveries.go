@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListSubscriptionDeliveriesHandlerFunc turns a function with the right signature into a v2 list subscription deliveries handler
+type V2ListSubscriptionDeliveriesHandlerFunc func(V2ListSubscriptionDeliveriesParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListSubscriptionDeliveriesHandlerFunc) Handle(params V2ListSubscriptionDeliveriesParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListSubscriptionDeliveriesHandler interface for that can handle valid v2 list subscription deliveries params
+type V2ListSubscriptionDeliveriesHandler interface {
+	Handle(V2ListSubscriptionDeliveriesParams, interface{}) middleware.Responder
+}
+
+// NewV2ListSubscriptionDeliveries creates a new http.Handler for the v2 list subscription deliveries operation
+func NewV2ListSubscriptionDeliveries(ctx *middleware.Context, handler V2ListSubscriptionDeliveriesHandler) *V2ListSubscriptionDeliveries {
+	return &V2ListSubscriptionDeliveries{Context: ctx, Handler: handler}
+}
+
+/*
+	V2ListSubscriptionDeliveries swagger:route GET /v2/subscriptions/{subscription_id}/deliveries subscriptions v2ListSubscriptionDeliveries
+
+Lists the deliveries of the webhook subscription, along with their delivery attempts, starting from the most recent.
+*/
+type V2ListSubscriptionDeliveries struct {
+	Context *middleware.Context
+	Handler V2ListSubscriptionDeliveriesHandler
+}
+
+func (o *V2ListSubscriptionDeliveries) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListSubscriptionDeliveriesParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,287 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListSubscriptionDeliveriesOKCode is the HTTP code returned for type V2ListSubscriptionDeliveriesOK
+const V2ListSubscriptionDeliveriesOKCode int = 200
+
+/*
+V2ListSubscriptionDeliveriesOK Success.
+
+swagger:response v2ListSubscriptionDeliveriesOK
+*/
+type V2ListSubscriptionDeliveriesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.SubscriptionDeliveryList `json:"body,omitempty"`
+}
+
+// NewV2ListSubscriptionDeliveriesOK creates V2ListSubscriptionDeliveriesOK with default headers values
+func NewV2ListSubscriptionDeliveriesOK() *V2ListSubscriptionDeliveriesOK {
+
+	return &V2ListSubscriptionDeliveriesOK{}
+}
+
+// WithPayload adds the payload to the v2 list subscription deliveries o k response
+func (o *V2ListSubscriptionDeliveriesOK) WithPayload(payload models.SubscriptionDeliveryList) *V2ListSubscriptionDeliveriesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list subscription deliveries o k response
+func (o *V2ListSubscriptionDeliveriesOK) SetPayload(payload models.SubscriptionDeliveryList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListSubscriptionDeliveriesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.SubscriptionDeliveryList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListSubscriptionDeliveriesUnauthorizedCode is the HTTP code returned for type V2ListSubscriptionDeliveriesUnauthorized
+const V2ListSubscriptionDeliveriesUnauthorizedCode int = 401
+
+/*
+V2ListSubscriptionDeliveriesUnauthorized Unauthorized.
+
+swagger:response v2ListSubscriptionDeliveriesUnauthorized
+*/
+type V2ListSubscriptionDeliveriesUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListSubscriptionDeliveriesUnauthorized creates V2ListSubscriptionDeliveriesUnauthorized with default headers values
+func NewV2ListSubscriptionDeliveriesUnauthorized() *V2ListSubscriptionDeliveriesUnauthorized {
+
+	return &V2ListSubscriptionDeliveriesUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list subscription deliveries unauthorized response
+func (o *V2ListSubscriptionDeliveriesUnauthorized) WithPayload(payload *models.InfraError) *V2ListSubscriptionDeliveriesUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list subscription deliveries unauthorized response
+func (o *V2ListSubscriptionDeliveriesUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListSubscriptionDeliveriesUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListSubscriptionDeliveriesForbiddenCode is the HTTP code returned for type V2ListSubscriptionDeliveriesForbidden
+const V2ListSubscriptionDeliveriesForbiddenCode int = 403
+
+/*
+V2ListSubscriptionDeliveriesForbidden Forbidden.
+
+swagger:response v2ListSubscriptionDeliveriesForbidden
+*/
+type V2ListSubscriptionDeliveriesForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListSubscriptionDeliveriesForbidden creates V2ListSubscriptionDeliveriesForbidden with default headers values
+func NewV2ListSubscriptionDeliveriesForbidden() *V2ListSubscriptionDeliveriesForbidden {
+
+	return &V2ListSubscriptionDeliveriesForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list subscription deliveries forbidden response
+func (o *V2ListSubscriptionDeliveriesForbidden) WithPayload(payload *models.InfraError) *V2ListSubscriptionDeliveriesForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list subscription deliveries forbidden response
+func (o *V2ListSubscriptionDeliveriesForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListSubscriptionDeliveriesForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListSubscriptionDeliveriesNotFoundCode is the HTTP code returned for type V2ListSubscriptionDeliveriesNotFound
+const V2ListSubscriptionDeliveriesNotFoundCode int = 404
+
+/*
+V2ListSubscriptionDeliveriesNotFound Error.
+
+swagger:response v2ListSubscriptionDeliveriesNotFound
+*/
+type V2ListSubscriptionDeliveriesNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListSubscriptionDeliveriesNotFound creates V2ListSubscriptionDeliveriesNotFound with default headers values
+func NewV2ListSubscriptionDeliveriesNotFound() *V2ListSubscriptionDeliveriesNotFound {
+
+	return &V2ListSubscriptionDeliveriesNotFound{}
+}
+
+// WithPayload adds the payload to the v2 list subscription deliveries not found response
+func (o *V2ListSubscriptionDeliveriesNotFound) WithPayload(payload *models.Error) *V2ListSubscriptionDeliveriesNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list subscription deliveries not found response
+func (o *V2ListSubscriptionDeliveriesNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListSubscriptionDeliveriesNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListSubscriptionDeliveriesInternalServerErrorCode is the HTTP code returned for type V2ListSubscriptionDeliveriesInternalServerError
+const V2ListSubscriptionDeliveriesInternalServerErrorCode int = 500
+
+/*
+V2ListSubscriptionDeliveriesInternalServerError Error.
+
+swagger:response v2ListSubscriptionDeliveriesInternalServerError
+*/
+type V2ListSubscriptionDeliveriesInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListSubscriptionDeliveriesInternalServerError creates V2ListSubscriptionDeliveriesInternalServerError with default headers values
+func NewV2ListSubscriptionDeliveriesInternalServerError() *V2ListSubscriptionDeliveriesInternalServerError {
+
+	return &V2ListSubscriptionDeliveriesInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list subscription deliveries internal server error response
+func (o *V2ListSubscriptionDeliveriesInternalServerError) WithPayload(payload *models.Error) *V2ListSubscriptionDeliveriesInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list subscription deliveries internal server error response
+func (o *V2ListSubscriptionDeliveriesInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListSubscriptionDeliveriesInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListSubscriptionDeliveriesNotImplementedCode is the HTTP code returned for type V2ListSubscriptionDeliveriesNotImplemented
+const V2ListSubscriptionDeliveriesNotImplementedCode int = 501
+
+/*
+V2ListSubscriptionDeliveriesNotImplemented Not implemented.
+
+swagger:response v2ListSubscriptionDeliveriesNotImplemented
+*/
+type V2ListSubscriptionDeliveriesNotImplemented struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListSubscriptionDeliveriesNotImplemented creates V2ListSubscriptionDeliveriesNotImplemented with default headers values
+func NewV2ListSubscriptionDeliveriesNotImplemented() *V2ListSubscriptionDeliveriesNotImplemented {
+
+	return &V2ListSubscriptionDeliveriesNotImplemented{}
+}
+
+// WithPayload adds the payload to the v2 list subscription deliveries not implemented response
+func (o *V2ListSubscriptionDeliveriesNotImplemented) WithPayload(payload *models.Error) *V2ListSubscriptionDeliveriesNotImplemented {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list subscription deliveries not implemented response
+func (o *V2ListSubscriptionDeliveriesNotImplemented) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListSubscriptionDeliveriesNotImplemented) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(501)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListSubscriptionsHandlerFunc turns a function with the right signature into a v2 list subscriptions handler
+type V2ListSubscriptionsHandlerFunc func(V2ListSubscriptionsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListSubscriptionsHandlerFunc) Handle(params V2ListSubscriptionsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListSubscriptionsHandler interface for that can handle valid v2 list subscriptions params
+type V2ListSubscriptionsHandler interface {
+	Handle(V2ListSubscriptionsParams, interface{}) middleware.Responder
+}
+
+// NewV2ListSubscriptions creates a new http.Handler for the v2 list subscriptions operation
+func NewV2ListSubscriptions(ctx *middleware.Context, handler V2ListSubscriptionsHandler) *V2ListSubscriptions {
+	return &V2ListSubscriptions{Context: ctx, Handler: handler}
+}
+
+/*
+	V2ListSubscriptions swagger:route GET /v2/subscriptions subscriptions v2ListSubscriptions
+
+Lists the webhook subscriptions of the organization.
+*/
+type V2ListSubscriptions struct {
+	Context *middleware.Context
+	Handler V2ListSubscriptionsHandler
+}
+
+func (o *V2ListSubscriptions) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListSubscriptionsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,242 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListSubscriptionsOKCode is the HTTP code returned for type V2ListSubscriptionsOK
+const V2ListSubscriptionsOKCode int = 200
+
+/*
+V2ListSubscriptionsOK Success.
+
+swagger:response v2ListSubscriptionsOK
+*/
+type V2ListSubscriptionsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.SubscriptionList `json:"body,omitempty"`
+}
+
+// NewV2ListSubscriptionsOK creates V2ListSubscriptionsOK with default headers values
+func NewV2ListSubscriptionsOK() *V2ListSubscriptionsOK {
+
+	return &V2ListSubscriptionsOK{}
+}
+
+// WithPayload adds the payload to the v2 list subscriptions o k response
+func (o *V2ListSubscriptionsOK) WithPayload(payload models.SubscriptionList) *V2ListSubscriptionsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list subscriptions o k response
+func (o *V2ListSubscriptionsOK) SetPayload(payload models.SubscriptionList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListSubscriptionsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.SubscriptionList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListSubscriptionsUnauthorizedCode is the HTTP code returned for type V2ListSubscriptionsUnauthorized
+const V2ListSubscriptionsUnauthorizedCode int = 401
+
+/*
+V2ListSubscriptionsUnauthorized Unauthorized.
+
+swagger:response v2ListSubscriptionsUnauthorized
+*/
+type V2ListSubscriptionsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListSubscriptionsUnauthorized creates V2ListSubscriptionsUnauthorized with default headers values
+func NewV2ListSubscriptionsUnauthorized() *V2ListSubscriptionsUnauthorized {
+
+	return &V2ListSubscriptionsUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list subscriptions unauthorized response
+func (o *V2ListSubscriptionsUnauthorized) WithPayload(payload *models.InfraError) *V2ListSubscriptionsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list subscriptions unauthorized response
+func (o *V2ListSubscriptionsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListSubscriptionsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListSubscriptionsForbiddenCode is the HTTP code returned for type V2ListSubscriptionsForbidden
+const V2ListSubscriptionsForbiddenCode int = 403
+
+/*
+V2ListSubscriptionsForbidden Forbidden.
+
+swagger:response v2ListSubscriptionsForbidden
+*/
+type V2ListSubscriptionsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListSubscriptionsForbidden creates V2ListSubscriptionsForbidden with default headers values
+func NewV2ListSubscriptionsForbidden() *V2ListSubscriptionsForbidden {
+
+	return &V2ListSubscriptionsForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list subscriptions forbidden response
+func (o *V2ListSubscriptionsForbidden) WithPayload(payload *models.InfraError) *V2ListSubscriptionsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list subscriptions forbidden response
+func (o *V2ListSubscriptionsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListSubscriptionsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListSubscriptionsInternalServerErrorCode is the HTTP code returned for type V2ListSubscriptionsInternalServerError
+const V2ListSubscriptionsInternalServerErrorCode int = 500
+
+/*
+V2ListSubscriptionsInternalServerError Error.
+
+swagger:response v2ListSubscriptionsInternalServerError
+*/
+type V2ListSubscriptionsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListSubscriptionsInternalServerError creates V2ListSubscriptionsInternalServerError with default headers values
+func NewV2ListSubscriptionsInternalServerError() *V2ListSubscriptionsInternalServerError {
+
+	return &V2ListSubscriptionsInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list subscriptions internal server error response
+func (o *V2ListSubscriptionsInternalServerError) WithPayload(payload *models.Error) *V2ListSubscriptionsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list subscriptions internal server error response
+func (o *V2ListSubscriptionsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListSubscriptionsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListSubscriptionsNotImplementedCode is the HTTP code returned for type V2ListSubscriptionsNotImplemented
+const V2ListSubscriptionsNotImplementedCode int = 501
+
+/*
+V2ListSubscriptionsNotImplemented Not implemented.
+
+swagger:response v2ListSubscriptionsNotImplemented
+*/
+type V2ListSubscriptionsNotImplemented struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListSubscriptionsNotImplemented creates V2ListSubscriptionsNotImplemented with default headers values
+func NewV2ListSubscriptionsNotImplemented() *V2ListSubscriptionsNotImplemented {
+
+	return &V2ListSubscriptionsNotImplemented{}
+}
+
+// WithPayload adds the payload to the v2 list subscriptions not implemented response
+func (o *V2ListSubscriptionsNotImplemented) WithPayload(payload *models.Error) *V2ListSubscriptionsNotImplemented {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list subscriptions not implemented response
+func (o *V2ListSubscriptionsNotImplemented) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListSubscriptionsNotImplemented) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(501)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
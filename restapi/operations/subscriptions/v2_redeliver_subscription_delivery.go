@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2RedeliverSubscriptionDeliveryHandlerFunc turns a function with the right signature into a v2 redeliver subscription delivery handler
+type V2RedeliverSubscriptionDeliveryHandlerFunc func(V2RedeliverSubscriptionDeliveryParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2RedeliverSubscriptionDeliveryHandlerFunc) Handle(params V2RedeliverSubscriptionDeliveryParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2RedeliverSubscriptionDeliveryHandler interface for that can handle valid v2 redeliver subscription delivery params
+type V2RedeliverSubscriptionDeliveryHandler interface {
+	Handle(V2RedeliverSubscriptionDeliveryParams, interface{}) middleware.Responder
+}
+
+// NewV2RedeliverSubscriptionDelivery creates a new http.Handler for the v2 redeliver subscription delivery operation
+func NewV2RedeliverSubscriptionDelivery(ctx *middleware.Context, handler V2RedeliverSubscriptionDeliveryHandler) *V2RedeliverSubscriptionDelivery {
+	return &V2RedeliverSubscriptionDelivery{Context: ctx, Handler: handler}
+}
+
+/*
+	V2RedeliverSubscriptionDelivery swagger:route POST /v2/subscriptions/{subscription_id}/deliveries/{delivery_id}/actions/redeliver subscriptions v2RedeliverSubscriptionDelivery
+
+Schedules the delivery to be sent again, regardless of its previous attempts.
+*/
+type V2RedeliverSubscriptionDelivery struct {
+	Context *middleware.Context
+	Handler V2RedeliverSubscriptionDeliveryHandler
+}
+
+func (o *V2RedeliverSubscriptionDelivery) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2RedeliverSubscriptionDeliveryParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
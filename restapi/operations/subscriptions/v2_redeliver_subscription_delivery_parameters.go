@@ -0,0 +1,134 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewV2RedeliverSubscriptionDeliveryParams creates a new V2RedeliverSubscriptionDeliveryParams object
+//
+// There are no default values defined in the spec.
+func NewV2RedeliverSubscriptionDeliveryParams() V2RedeliverSubscriptionDeliveryParams {
+
+	return V2RedeliverSubscriptionDeliveryParams{}
+}
+
+// V2RedeliverSubscriptionDeliveryParams contains all the bound params for the v2 redeliver subscription delivery operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2RedeliverSubscriptionDelivery
+type V2RedeliverSubscriptionDeliveryParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The delivery to be sent again.
+	  Required: true
+	  In: path
+	*/
+	DeliveryID strfmt.UUID
+	/*The subscription of the delivery.
+	  Required: true
+	  In: path
+	*/
+	SubscriptionID strfmt.UUID
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2RedeliverSubscriptionDeliveryParams() beforehand.
+func (o *V2RedeliverSubscriptionDeliveryParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rDeliveryID, rhkDeliveryID, _ := route.Params.GetOK("delivery_id")
+	if err := o.bindDeliveryID(rDeliveryID, rhkDeliveryID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rSubscriptionID, rhkSubscriptionID, _ := route.Params.GetOK("subscription_id")
+	if err := o.bindSubscriptionID(rSubscriptionID, rhkSubscriptionID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindDeliveryID binds and validates parameter DeliveryID from path.
+func (o *V2RedeliverSubscriptionDeliveryParams) bindDeliveryID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("delivery_id", "path", "strfmt.UUID", raw)
+	}
+	o.DeliveryID = *(value.(*strfmt.UUID))
+
+	if err := o.validateDeliveryID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateDeliveryID carries on validations for parameter DeliveryID
+func (o *V2RedeliverSubscriptionDeliveryParams) validateDeliveryID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("delivery_id", "path", "uuid", o.DeliveryID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindSubscriptionID binds and validates parameter SubscriptionID from path.
+func (o *V2RedeliverSubscriptionDeliveryParams) bindSubscriptionID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("subscription_id", "path", "strfmt.UUID", raw)
+	}
+	o.SubscriptionID = *(value.(*strfmt.UUID))
+
+	if err := o.validateSubscriptionID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSubscriptionID carries on validations for parameter SubscriptionID
+func (o *V2RedeliverSubscriptionDeliveryParams) validateSubscriptionID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("subscription_id", "path", "uuid", o.SubscriptionID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,329 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2RedeliverSubscriptionDeliveryAcceptedCode is the HTTP code returned for type V2RedeliverSubscriptionDeliveryAccepted
+const V2RedeliverSubscriptionDeliveryAcceptedCode int = 202
+
+/*
+V2RedeliverSubscriptionDeliveryAccepted Success.
+
+swagger:response v2RedeliverSubscriptionDeliveryAccepted
+*/
+type V2RedeliverSubscriptionDeliveryAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.SubscriptionDelivery `json:"body,omitempty"`
+}
+
+// NewV2RedeliverSubscriptionDeliveryAccepted creates V2RedeliverSubscriptionDeliveryAccepted with default headers values
+func NewV2RedeliverSubscriptionDeliveryAccepted() *V2RedeliverSubscriptionDeliveryAccepted {
+
+	return &V2RedeliverSubscriptionDeliveryAccepted{}
+}
+
+// WithPayload adds the payload to the v2 redeliver subscription delivery accepted response
+func (o *V2RedeliverSubscriptionDeliveryAccepted) WithPayload(payload *models.SubscriptionDelivery) *V2RedeliverSubscriptionDeliveryAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 redeliver subscription delivery accepted response
+func (o *V2RedeliverSubscriptionDeliveryAccepted) SetPayload(payload *models.SubscriptionDelivery) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RedeliverSubscriptionDeliveryAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RedeliverSubscriptionDeliveryUnauthorizedCode is the HTTP code returned for type V2RedeliverSubscriptionDeliveryUnauthorized
+const V2RedeliverSubscriptionDeliveryUnauthorizedCode int = 401
+
+/*
+V2RedeliverSubscriptionDeliveryUnauthorized Unauthorized.
+
+swagger:response v2RedeliverSubscriptionDeliveryUnauthorized
+*/
+type V2RedeliverSubscriptionDeliveryUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2RedeliverSubscriptionDeliveryUnauthorized creates V2RedeliverSubscriptionDeliveryUnauthorized with default headers values
+func NewV2RedeliverSubscriptionDeliveryUnauthorized() *V2RedeliverSubscriptionDeliveryUnauthorized {
+
+	return &V2RedeliverSubscriptionDeliveryUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 redeliver subscription delivery unauthorized response
+func (o *V2RedeliverSubscriptionDeliveryUnauthorized) WithPayload(payload *models.InfraError) *V2RedeliverSubscriptionDeliveryUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 redeliver subscription delivery unauthorized response
+func (o *V2RedeliverSubscriptionDeliveryUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RedeliverSubscriptionDeliveryUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RedeliverSubscriptionDeliveryForbiddenCode is the HTTP code returned for type V2RedeliverSubscriptionDeliveryForbidden
+const V2RedeliverSubscriptionDeliveryForbiddenCode int = 403
+
+/*
+V2RedeliverSubscriptionDeliveryForbidden Forbidden.
+
+swagger:response v2RedeliverSubscriptionDeliveryForbidden
+*/
+type V2RedeliverSubscriptionDeliveryForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2RedeliverSubscriptionDeliveryForbidden creates V2RedeliverSubscriptionDeliveryForbidden with default headers values
+func NewV2RedeliverSubscriptionDeliveryForbidden() *V2RedeliverSubscriptionDeliveryForbidden {
+
+	return &V2RedeliverSubscriptionDeliveryForbidden{}
+}
+
+// WithPayload adds the payload to the v2 redeliver subscription delivery forbidden response
+func (o *V2RedeliverSubscriptionDeliveryForbidden) WithPayload(payload *models.InfraError) *V2RedeliverSubscriptionDeliveryForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 redeliver subscription delivery forbidden response
+func (o *V2RedeliverSubscriptionDeliveryForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RedeliverSubscriptionDeliveryForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RedeliverSubscriptionDeliveryNotFoundCode is the HTTP code returned for type V2RedeliverSubscriptionDeliveryNotFound
+const V2RedeliverSubscriptionDeliveryNotFoundCode int = 404
+
+/*
+V2RedeliverSubscriptionDeliveryNotFound Error.
+
+swagger:response v2RedeliverSubscriptionDeliveryNotFound
+*/
+type V2RedeliverSubscriptionDeliveryNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RedeliverSubscriptionDeliveryNotFound creates V2RedeliverSubscriptionDeliveryNotFound with default headers values
+func NewV2RedeliverSubscriptionDeliveryNotFound() *V2RedeliverSubscriptionDeliveryNotFound {
+
+	return &V2RedeliverSubscriptionDeliveryNotFound{}
+}
+
+// WithPayload adds the payload to the v2 redeliver subscription delivery not found response
+func (o *V2RedeliverSubscriptionDeliveryNotFound) WithPayload(payload *models.Error) *V2RedeliverSubscriptionDeliveryNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 redeliver subscription delivery not found response
+func (o *V2RedeliverSubscriptionDeliveryNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RedeliverSubscriptionDeliveryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RedeliverSubscriptionDeliveryConflictCode is the HTTP code returned for type V2RedeliverSubscriptionDeliveryConflict
+const V2RedeliverSubscriptionDeliveryConflictCode int = 409
+
+/*
+V2RedeliverSubscriptionDeliveryConflict Error.
+
+swagger:response v2RedeliverSubscriptionDeliveryConflict
+*/
+type V2RedeliverSubscriptionDeliveryConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RedeliverSubscriptionDeliveryConflict creates V2RedeliverSubscriptionDeliveryConflict with default headers values
+func NewV2RedeliverSubscriptionDeliveryConflict() *V2RedeliverSubscriptionDeliveryConflict {
+
+	return &V2RedeliverSubscriptionDeliveryConflict{}
+}
+
+// WithPayload adds the payload to the v2 redeliver subscription delivery conflict response
+func (o *V2RedeliverSubscriptionDeliveryConflict) WithPayload(payload *models.Error) *V2RedeliverSubscriptionDeliveryConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 redeliver subscription delivery conflict response
+func (o *V2RedeliverSubscriptionDeliveryConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RedeliverSubscriptionDeliveryConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RedeliverSubscriptionDeliveryInternalServerErrorCode is the HTTP code returned for type V2RedeliverSubscriptionDeliveryInternalServerError
+const V2RedeliverSubscriptionDeliveryInternalServerErrorCode int = 500
+
+/*
+V2RedeliverSubscriptionDeliveryInternalServerError Error.
+
+swagger:response v2RedeliverSubscriptionDeliveryInternalServerError
+*/
+type V2RedeliverSubscriptionDeliveryInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RedeliverSubscriptionDeliveryInternalServerError creates V2RedeliverSubscriptionDeliveryInternalServerError with default headers values
+func NewV2RedeliverSubscriptionDeliveryInternalServerError() *V2RedeliverSubscriptionDeliveryInternalServerError {
+
+	return &V2RedeliverSubscriptionDeliveryInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 redeliver subscription delivery internal server error response
+func (o *V2RedeliverSubscriptionDeliveryInternalServerError) WithPayload(payload *models.Error) *V2RedeliverSubscriptionDeliveryInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 redeliver subscription delivery internal server error response
+func (o *V2RedeliverSubscriptionDeliveryInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RedeliverSubscriptionDeliveryInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RedeliverSubscriptionDeliveryNotImplementedCode is the HTTP code returned for type V2RedeliverSubscriptionDeliveryNotImplemented
+const V2RedeliverSubscriptionDeliveryNotImplementedCode int = 501
+
+/*
+V2RedeliverSubscriptionDeliveryNotImplemented Not implemented.
+
+swagger:response v2RedeliverSubscriptionDeliveryNotImplemented
+*/
+type V2RedeliverSubscriptionDeliveryNotImplemented struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RedeliverSubscriptionDeliveryNotImplemented creates V2RedeliverSubscriptionDeliveryNotImplemented with default headers values
+func NewV2RedeliverSubscriptionDeliveryNotImplemented() *V2RedeliverSubscriptionDeliveryNotImplemented {
+
+	return &V2RedeliverSubscriptionDeliveryNotImplemented{}
+}
+
+// WithPayload adds the payload to the v2 redeliver subscription delivery not implemented response
+func (o *V2RedeliverSubscriptionDeliveryNotImplemented) WithPayload(payload *models.Error) *V2RedeliverSubscriptionDeliveryNotImplemented {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 redeliver subscription delivery not implemented response
+func (o *V2RedeliverSubscriptionDeliveryNotImplemented) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RedeliverSubscriptionDeliveryNotImplemented) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(501)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2RegisterSubscriptionHandlerFunc turns a function with the right signature into a v2 register subscription handler
+type V2RegisterSubscriptionHandlerFunc func(V2RegisterSubscriptionParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2RegisterSubscriptionHandlerFunc) Handle(params V2RegisterSubscriptionParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2RegisterSubscriptionHandler interface for that can handle valid v2 register subscription params
+type V2RegisterSubscriptionHandler interface {
+	Handle(V2RegisterSubscriptionParams, interface{}) middleware.Responder
+}
+
+// NewV2RegisterSubscription creates a new http.Handler for the v2 register subscription operation
+func NewV2RegisterSubscription(ctx *middleware.Context, handler V2RegisterSubscriptionHandler) *V2RegisterSubscription {
+	return &V2RegisterSubscription{Context: ctx, Handler: handler}
+}
+
+/*
+	V2RegisterSubscription swagger:route POST /v2/subscriptions subscriptions v2RegisterSubscription
+
+Registers a webhook that is called when the subscribed clusters and hosts change.
+*/
+type V2RegisterSubscription struct {
+	Context *middleware.Context
+	Handler V2RegisterSubscriptionHandler
+}
+
+func (o *V2RegisterSubscription) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2RegisterSubscriptionParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
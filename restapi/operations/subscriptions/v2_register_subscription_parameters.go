@@ -0,0 +1,84 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/validate"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2RegisterSubscriptionParams creates a new V2RegisterSubscriptionParams object
+//
+// There are no default values defined in the spec.
+func NewV2RegisterSubscriptionParams() V2RegisterSubscriptionParams {
+
+	return V2RegisterSubscriptionParams{}
+}
+
+// V2RegisterSubscriptionParams contains all the bound params for the v2 register subscription operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2RegisterSubscription
+type V2RegisterSubscriptionParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The properties describing the new subscription.
+	  Required: true
+	  In: body
+	*/
+	NewSubscriptionParams *models.SubscriptionCreateParams
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2RegisterSubscriptionParams() beforehand.
+func (o *V2RegisterSubscriptionParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.SubscriptionCreateParams
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("newSubscriptionParams", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("newSubscriptionParams", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			ctx := validate.WithOperationRequest(r.Context())
+			if err := body.ContextValidate(ctx, route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.NewSubscriptionParams = &body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("newSubscriptionParams", "body", ""))
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,329 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2RegisterSubscriptionCreatedCode is the HTTP code returned for type V2RegisterSubscriptionCreated
+const V2RegisterSubscriptionCreatedCode int = 201
+
+/*
+V2RegisterSubscriptionCreated Success.
+
+swagger:response v2RegisterSubscriptionCreated
+*/
+type V2RegisterSubscriptionCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Subscription `json:"body,omitempty"`
+}
+
+// NewV2RegisterSubscriptionCreated creates V2RegisterSubscriptionCreated with default headers values
+func NewV2RegisterSubscriptionCreated() *V2RegisterSubscriptionCreated {
+
+	return &V2RegisterSubscriptionCreated{}
+}
+
+// WithPayload adds the payload to the v2 register subscription created response
+func (o *V2RegisterSubscriptionCreated) WithPayload(payload *models.Subscription) *V2RegisterSubscriptionCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register subscription created response
+func (o *V2RegisterSubscriptionCreated) SetPayload(payload *models.Subscription) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterSubscriptionCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RegisterSubscriptionBadRequestCode is the HTTP code returned for type V2RegisterSubscriptionBadRequest
+const V2RegisterSubscriptionBadRequestCode int = 400
+
+/*
+V2RegisterSubscriptionBadRequest Error.
+
+swagger:response v2RegisterSubscriptionBadRequest
+*/
+type V2RegisterSubscriptionBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RegisterSubscriptionBadRequest creates V2RegisterSubscriptionBadRequest with default headers values
+func NewV2RegisterSubscriptionBadRequest() *V2RegisterSubscriptionBadRequest {
+
+	return &V2RegisterSubscriptionBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 register subscription bad request response
+func (o *V2RegisterSubscriptionBadRequest) WithPayload(payload *models.Error) *V2RegisterSubscriptionBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register subscription bad request response
+func (o *V2RegisterSubscriptionBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterSubscriptionBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RegisterSubscriptionUnauthorizedCode is the HTTP code returned for type V2RegisterSubscriptionUnauthorized
+const V2RegisterSubscriptionUnauthorizedCode int = 401
+
+/*
+V2RegisterSubscriptionUnauthorized Unauthorized.
+
+swagger:response v2RegisterSubscriptionUnauthorized
+*/
+type V2RegisterSubscriptionUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2RegisterSubscriptionUnauthorized creates V2RegisterSubscriptionUnauthorized with default headers values
+func NewV2RegisterSubscriptionUnauthorized() *V2RegisterSubscriptionUnauthorized {
+
+	return &V2RegisterSubscriptionUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 register subscription unauthorized response
+func (o *V2RegisterSubscriptionUnauthorized) WithPayload(payload *models.InfraError) *V2RegisterSubscriptionUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register subscription unauthorized response
+func (o *V2RegisterSubscriptionUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterSubscriptionUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RegisterSubscriptionForbiddenCode is the HTTP code returned for type V2RegisterSubscriptionForbidden
+const V2RegisterSubscriptionForbiddenCode int = 403
+
+/*
+V2RegisterSubscriptionForbidden Forbidden.
+
+swagger:response v2RegisterSubscriptionForbidden
+*/
+type V2RegisterSubscriptionForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2RegisterSubscriptionForbidden creates V2RegisterSubscriptionForbidden with default headers values
+func NewV2RegisterSubscriptionForbidden() *V2RegisterSubscriptionForbidden {
+
+	return &V2RegisterSubscriptionForbidden{}
+}
+
+// WithPayload adds the payload to the v2 register subscription forbidden response
+func (o *V2RegisterSubscriptionForbidden) WithPayload(payload *models.InfraError) *V2RegisterSubscriptionForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register subscription forbidden response
+func (o *V2RegisterSubscriptionForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterSubscriptionForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RegisterSubscriptionNotFoundCode is the HTTP code returned for type V2RegisterSubscriptionNotFound
+const V2RegisterSubscriptionNotFoundCode int = 404
+
+/*
+V2RegisterSubscriptionNotFound Error.
+
+swagger:response v2RegisterSubscriptionNotFound
+*/
+type V2RegisterSubscriptionNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RegisterSubscriptionNotFound creates V2RegisterSubscriptionNotFound with default headers values
+func NewV2RegisterSubscriptionNotFound() *V2RegisterSubscriptionNotFound {
+
+	return &V2RegisterSubscriptionNotFound{}
+}
+
+// WithPayload adds the payload to the v2 register subscription not found response
+func (o *V2RegisterSubscriptionNotFound) WithPayload(payload *models.Error) *V2RegisterSubscriptionNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register subscription not found response
+func (o *V2RegisterSubscriptionNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterSubscriptionNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RegisterSubscriptionInternalServerErrorCode is the HTTP code returned for type V2RegisterSubscriptionInternalServerError
+const V2RegisterSubscriptionInternalServerErrorCode int = 500
+
+/*
+V2RegisterSubscriptionInternalServerError Error.
+
+swagger:response v2RegisterSubscriptionInternalServerError
+*/
+type V2RegisterSubscriptionInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RegisterSubscriptionInternalServerError creates V2RegisterSubscriptionInternalServerError with default headers values
+func NewV2RegisterSubscriptionInternalServerError() *V2RegisterSubscriptionInternalServerError {
+
+	return &V2RegisterSubscriptionInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 register subscription internal server error response
+func (o *V2RegisterSubscriptionInternalServerError) WithPayload(payload *models.Error) *V2RegisterSubscriptionInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register subscription internal server error response
+func (o *V2RegisterSubscriptionInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterSubscriptionInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RegisterSubscriptionNotImplementedCode is the HTTP code returned for type V2RegisterSubscriptionNotImplemented
+const V2RegisterSubscriptionNotImplementedCode int = 501
+
+/*
+V2RegisterSubscriptionNotImplemented Not implemented.
+
+swagger:response v2RegisterSubscriptionNotImplemented
+*/
+type V2RegisterSubscriptionNotImplemented struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RegisterSubscriptionNotImplemented creates V2RegisterSubscriptionNotImplemented with default headers values
+func NewV2RegisterSubscriptionNotImplemented() *V2RegisterSubscriptionNotImplemented {
+
+	return &V2RegisterSubscriptionNotImplemented{}
+}
+
+// WithPayload adds the payload to the v2 register subscription not implemented response
+func (o *V2RegisterSubscriptionNotImplemented) WithPayload(payload *models.Error) *V2RegisterSubscriptionNotImplemented {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register subscription not implemented response
+func (o *V2RegisterSubscriptionNotImplemented) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterSubscriptionNotImplemented) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(501)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2UpdateSubscriptionHandlerFunc turns a function with the right signature into a v2 update subscription handler
+type V2UpdateSubscriptionHandlerFunc func(V2UpdateSubscriptionParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2UpdateSubscriptionHandlerFunc) Handle(params V2UpdateSubscriptionParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2UpdateSubscriptionHandler interface for that can handle valid v2 update subscription params
+type V2UpdateSubscriptionHandler interface {
+	Handle(V2UpdateSubscriptionParams, interface{}) middleware.Responder
+}
+
+// NewV2UpdateSubscription creates a new http.Handler for the v2 update subscription operation
+func NewV2UpdateSubscription(ctx *middleware.Context, handler V2UpdateSubscriptionHandler) *V2UpdateSubscription {
+	return &V2UpdateSubscription{Context: ctx, Handler: handler}
+}
+
+/*
+	V2UpdateSubscription swagger:route PATCH /v2/subscriptions/{subscription_id} subscriptions v2UpdateSubscription
+
+Updates a webhook subscription.
+*/
+type V2UpdateSubscription struct {
+	Context *middleware.Context
+	Handler V2UpdateSubscriptionHandler
+}
+
+func (o *V2UpdateSubscription) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2UpdateSubscriptionParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,329 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2UpdateSubscriptionCreatedCode is the HTTP code returned for type V2UpdateSubscriptionCreated
+const V2UpdateSubscriptionCreatedCode int = 201
+
+/*
+V2UpdateSubscriptionCreated Success.
+
+swagger:response v2UpdateSubscriptionCreated
+*/
+type V2UpdateSubscriptionCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Subscription `json:"body,omitempty"`
+}
+
+// NewV2UpdateSubscriptionCreated creates V2UpdateSubscriptionCreated with default headers values
+func NewV2UpdateSubscriptionCreated() *V2UpdateSubscriptionCreated {
+
+	return &V2UpdateSubscriptionCreated{}
+}
+
+// WithPayload adds the payload to the v2 update subscription created response
+func (o *V2UpdateSubscriptionCreated) WithPayload(payload *models.Subscription) *V2UpdateSubscriptionCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update subscription created response
+func (o *V2UpdateSubscriptionCreated) SetPayload(payload *models.Subscription) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateSubscriptionCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateSubscriptionBadRequestCode is the HTTP code returned for type V2UpdateSubscriptionBadRequest
+const V2UpdateSubscriptionBadRequestCode int = 400
+
+/*
+V2UpdateSubscriptionBadRequest Error.
+
+swagger:response v2UpdateSubscriptionBadRequest
+*/
+type V2UpdateSubscriptionBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateSubscriptionBadRequest creates V2UpdateSubscriptionBadRequest with default headers values
+func NewV2UpdateSubscriptionBadRequest() *V2UpdateSubscriptionBadRequest {
+
+	return &V2UpdateSubscriptionBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 update subscription bad request response
+func (o *V2UpdateSubscriptionBadRequest) WithPayload(payload *models.Error) *V2UpdateSubscriptionBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update subscription bad request response
+func (o *V2UpdateSubscriptionBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateSubscriptionBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateSubscriptionUnauthorizedCode is the HTTP code returned for type V2UpdateSubscriptionUnauthorized
+const V2UpdateSubscriptionUnauthorizedCode int = 401
+
+/*
+V2UpdateSubscriptionUnauthorized Unauthorized.
+
+swagger:response v2UpdateSubscriptionUnauthorized
+*/
+type V2UpdateSubscriptionUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2UpdateSubscriptionUnauthorized creates V2UpdateSubscriptionUnauthorized with default headers values
+func NewV2UpdateSubscriptionUnauthorized() *V2UpdateSubscriptionUnauthorized {
+
+	return &V2UpdateSubscriptionUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 update subscription unauthorized response
+func (o *V2UpdateSubscriptionUnauthorized) WithPayload(payload *models.InfraError) *V2UpdateSubscriptionUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update subscription unauthorized response
+func (o *V2UpdateSubscriptionUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateSubscriptionUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateSubscriptionForbiddenCode is the HTTP code returned for type V2UpdateSubscriptionForbidden
+const V2UpdateSubscriptionForbiddenCode int = 403
+
+/*
+V2UpdateSubscriptionForbidden Forbidden.
+
+swagger:response v2UpdateSubscriptionForbidden
+*/
+type V2UpdateSubscriptionForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2UpdateSubscriptionForbidden creates V2UpdateSubscriptionForbidden with default headers values
+func NewV2UpdateSubscriptionForbidden() *V2UpdateSubscriptionForbidden {
+
+	return &V2UpdateSubscriptionForbidden{}
+}
+
+// WithPayload adds the payload to the v2 update subscription forbidden response
+func (o *V2UpdateSubscriptionForbidden) WithPayload(payload *models.InfraError) *V2UpdateSubscriptionForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update subscription forbidden response
+func (o *V2UpdateSubscriptionForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateSubscriptionForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateSubscriptionNotFoundCode is the HTTP code returned for type V2UpdateSubscriptionNotFound
+const V2UpdateSubscriptionNotFoundCode int = 404
+
+/*
+V2UpdateSubscriptionNotFound Error.
+
+swagger:response v2UpdateSubscriptionNotFound
+*/
+type V2UpdateSubscriptionNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateSubscriptionNotFound creates V2UpdateSubscriptionNotFound with default headers values
+func NewV2UpdateSubscriptionNotFound() *V2UpdateSubscriptionNotFound {
+
+	return &V2UpdateSubscriptionNotFound{}
+}
+
+// WithPayload adds the payload to the v2 update subscription not found response
+func (o *V2UpdateSubscriptionNotFound) WithPayload(payload *models.Error) *V2UpdateSubscriptionNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update subscription not found response
+func (o *V2UpdateSubscriptionNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateSubscriptionNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateSubscriptionInternalServerErrorCode is the HTTP code returned for type V2UpdateSubscriptionInternalServerError
+const V2UpdateSubscriptionInternalServerErrorCode int = 500
+
+/*
+V2UpdateSubscriptionInternalServerError Error.
+
+swagger:response v2UpdateSubscriptionInternalServerError
+*/
+type V2UpdateSubscriptionInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateSubscriptionInternalServerError creates V2UpdateSubscriptionInternalServerError with default headers values
+func NewV2UpdateSubscriptionInternalServerError() *V2UpdateSubscriptionInternalServerError {
+
+	return &V2UpdateSubscriptionInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 update subscription internal server error response
+func (o *V2UpdateSubscriptionInternalServerError) WithPayload(payload *models.Error) *V2UpdateSubscriptionInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update subscription internal server error response
+func (o *V2UpdateSubscriptionInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateSubscriptionInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateSubscriptionNotImplementedCode is the HTTP code returned for type V2UpdateSubscriptionNotImplemented
+const V2UpdateSubscriptionNotImplementedCode int = 501
+
+/*
+V2UpdateSubscriptionNotImplemented Not implemented.
+
+swagger:response v2UpdateSubscriptionNotImplemented
+*/
+type V2UpdateSubscriptionNotImplemented struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateSubscriptionNotImplemented creates V2UpdateSubscriptionNotImplemented with default headers values
+func NewV2UpdateSubscriptionNotImplemented() *V2UpdateSubscriptionNotImplemented {
+
+	return &V2UpdateSubscriptionNotImplemented{}
+}
+
+// WithPayload adds the payload to the v2 update subscription not implemented response
+func (o *V2UpdateSubscriptionNotImplemented) WithPayload(payload *models.Error) *V2UpdateSubscriptionNotImplemented {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update subscription not implemented response
+func (o *V2UpdateSubscriptionNotImplemented) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateSubscriptionNotImplemented) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(501)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}